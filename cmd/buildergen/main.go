@@ -0,0 +1,48 @@
+// Command buildergen scaffolds a new resource package built on the common builder framework. Given the GVK of a
+// resource and the import path of its API types, it writes a builder with New, Pull and List functions together with a
+// unit test skeleton using the testhelper configs. For example, from the root of the repository:
+//
+//	go run ./cmd/buildergen -gvk k8s.ovn.org/v1/RouteAdvertisements \
+//		-import github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/crd/routeadvertisements/v1 -cluster-scoped
+//
+// The scaffolded package only provides the generic CRUD methods; With methods and any resource specific helpers are
+// left to be added by hand.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+)
+
+func main() {
+	gvk := flag.String("gvk", "", "group/version/Kind of the resource, for example route.openshift.io/v1/Route")
+	importPath := flag.String("import", "", "import path of the package containing the API types and scheme")
+	alias := flag.String("alias", "", "import alias of the API package, derived from the group and version if empty")
+	packageName := flag.String("package", "", "name of the scaffolded package, derived from the kind if empty")
+	attacher := flag.String("scheme-attacher", "AddToScheme", "name of the scheme attacher in the API package")
+	groupVersion := flag.String("group-version-var", "GroupVersion", "name of the GroupVersion var in the API package")
+	clusterScoped := flag.Bool("cluster-scoped", false, "whether the resource is cluster scoped")
+	root := flag.String("root", ".", "root of the eco-goinfra repository")
+	outputDir := flag.String("output", "", "directory of the scaffolded package, pkg/<package> under root if empty")
+	skipGenerate := flag.Bool("skip-generate", false, "do not run go generate for the scaffolded package")
+
+	flag.Parse()
+
+	err := scaffold(options{
+		gvk:           *gvk,
+		importPath:    *importPath,
+		alias:         *alias,
+		packageName:   *packageName,
+		attacher:      *attacher,
+		groupVersion:  *groupVersion,
+		clusterScoped: *clusterScoped,
+		root:          *root,
+		outputDir:     *outputDir,
+		skipGenerate:  *skipGenerate,
+	})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "buildergen: %v\n", err)
+		os.Exit(1)
+	}
+}
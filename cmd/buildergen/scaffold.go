@@ -0,0 +1,390 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"slices"
+	"strings"
+	"text/template"
+	"unicode"
+)
+
+// options holds the command line options of a single buildergen run.
+type options struct {
+	gvk           string
+	importPath    string
+	alias         string
+	packageName   string
+	attacher      string
+	groupVersion  string
+	clusterScoped bool
+	root          string
+	outputDir     string
+	skipGenerate  bool
+}
+
+// scaffoldData is the input of the templates.
+type scaffoldData struct {
+	Package      string
+	Kind         string
+	Alias        string
+	Object       string
+	Attacher     string
+	GroupVersion string
+	Namespaced   bool
+	GVKVar       string
+	ListgenPath  string
+	Imports      []string
+	TestImports  []string
+}
+
+var builderTemplate = template.Must(template.New("builder").Parse(`package {{ .Package }}
+
+import (
+	"context"
+
+{{ range .Imports }}	{{ . }}
+{{ end }})
+
+// Builder provides a struct for the {{ .Kind }} resource containing a connection to the cluster and the {{ .Kind }}
+// definition.
+type Builder struct {
+	common.EmbeddableBuilder[{{ .Object }}, *{{ .Object }}]
+	common.EmbeddableCreator[{{ .Object }}, Builder, *{{ .Object }}, *Builder]
+	common.EmbeddableDeleter[{{ .Object }}, *{{ .Object }}]
+	common.EmbeddableUpdater[{{ .Object }}, Builder, *{{ .Object }}, *Builder]
+}
+
+// AttachMixins wires the embedded CRUD mixins to this builder instance.
+func (builder *Builder) AttachMixins() {
+	builder.EmbeddableCreator.SetBase(builder)
+	builder.EmbeddableDeleter.SetBase(builder)
+	builder.EmbeddableUpdater.SetBase(builder)
+}
+
+// GetGVK returns the {{ .Kind }} GVK for this builder.
+func (builder *Builder) GetGVK() schema.GroupVersionKind {
+	return {{ .GroupVersion }}.WithKind("{{ .Kind }}")
+}
+{{ if .Namespaced }}
+// NewBuilder creates a new instance of Builder.
+func NewBuilder(apiClient *clients.Settings, name, nsname string) *Builder {
+	klog.V(100).Infof(
+		"Initializing new {{ .Kind }} structure with the following params: name: %s, nsname: %s", name, nsname)
+
+	return common.NewNamespacedBuilder[{{ .Object }}, Builder](
+		apiClient, {{ .Attacher }}, name, nsname)
+}
+
+// Pull pulls an existing {{ .Kind }} into a Builder struct.
+func Pull(apiClient *clients.Settings, name, nsname string) (*Builder, error) {
+	klog.V(100).Infof("Pulling existing {{ .Kind }} %s under namespace %s from cluster", name, nsname)
+
+	return common.PullNamespacedBuilder[{{ .Object }}, Builder](
+		context.TODO(), apiClient, {{ .Attacher }}, name, nsname)
+}
+{{ else }}
+// NewBuilder creates a new instance of Builder.
+func NewBuilder(apiClient *clients.Settings, name string) *Builder {
+	klog.V(100).Infof("Initializing new {{ .Kind }} structure with the following params: name: %s", name)
+
+	return common.NewClusterScopedBuilder[{{ .Object }}, Builder](
+		apiClient, {{ .Attacher }}, name)
+}
+
+// Pull pulls an existing {{ .Kind }} into a Builder struct.
+func Pull(apiClient *clients.Settings, name string) (*Builder, error) {
+	klog.V(100).Infof("Pulling existing {{ .Kind }} %s from cluster", name)
+
+	return common.PullClusterScopedBuilder[{{ .Object }}, Builder](
+		context.TODO(), apiClient, {{ .Attacher }}, name)
+}
+
+// List returns the {{ .Kind }} builders on the cluster matching the provided options.
+func List(apiClient *clients.Settings, options ...runtimeclient.ListOption) ([]*Builder, error) {
+	return common.List[{{ .Object }}, {{ .Object }}List, Builder](
+		context.TODO(), apiClient, {{ .Attacher }}, options...)
+}
+{{ end }}`))
+
+var testTemplate = template.Must(template.New("test").Parse(`package {{ .Package }}
+
+import (
+	"testing"
+
+{{ range .TestImports }}	{{ . }}
+{{ end }})
+
+var {{ .GVKVar }} = {{ .GroupVersion }}.WithKind("{{ .Kind }}")
+
+func TestNewBuilder(t *testing.T) {
+	t.Parallel()
+
+	testhelper.New{{ if .Namespaced }}Namespaced{{ else }}ClusterScoped{{ end }}BuilderTestConfig(
+		NewBuilder,
+		{{ .Attacher }},
+		{{ .GVKVar }},
+	).ExecuteTests(t)
+}
+
+func TestPull(t *testing.T) {
+	t.Parallel()
+
+	testhelper.New{{ if .Namespaced }}Namespaced{{ else }}ClusterScoped{{ end }}PullTestConfig(
+		Pull,
+		{{ .Attacher }},
+		{{ .GVKVar }},
+	).ExecuteTests(t)
+}
+
+func TestBuilderMethods(t *testing.T) {
+	t.Parallel()
+
+	commonTestConfig := testhelper.NewCommonTestConfig[{{ .Object }}, Builder](
+		{{ .Attacher }},
+		{{ .GVKVar }},
+		testhelper.ResourceScope{{ if .Namespaced }}Namespaced{{ else }}ClusterScoped{{ end }},
+	)
+
+	testhelper.NewTestSuite().
+		With(testhelper.NewGetTestConfig(commonTestConfig)).
+		With(testhelper.NewExistsTestConfig(commonTestConfig)).
+		With(testhelper.NewCreateTestConfig(commonTestConfig)).
+		With(testhelper.NewDeleterTestConfig(commonTestConfig)).
+		With(testhelper.NewUpdateTestConfig(commonTestConfig)).
+		Run(t)
+}
+{{ if .Namespaced }}
+func TestList(t *testing.T) {
+	t.Parallel()
+
+	testhelper.NewNamespacedListTestConfig(
+		func(apiClient *clients.Settings, nsname string, _ ...runtimeclient.ListOptions) ([]*Builder, error) {
+			return List(apiClient, nsname)
+		},
+		{{ .Attacher }},
+		{{ .GVKVar }},
+	).ExecuteTests(t)
+}
+
+func TestListInAllNamespaces(t *testing.T) {
+	t.Parallel()
+
+	testhelper.NewListTestConfig(ListInAllNamespaces, {{ .Attacher }}, {{ .GVKVar }}).ExecuteTests(t)
+}
+{{ else }}
+func TestList(t *testing.T) {
+	t.Parallel()
+
+	testhelper.NewListTestConfig(List, {{ .Attacher }}, {{ .GVKVar }}).ExecuteTests(t)
+}
+{{ end }}`))
+
+var generateTemplate = template.Must(template.New("generate").Parse(`package {{ .Package }}
+
+//go:generate go run {{ .ListgenPath }} -builder Builder
+`))
+
+// scaffold writes the builder, test and, for namespaced resources, go:generate files of a new package. Existing files
+// are never overwritten.
+func scaffold(opts options) error {
+	data, err := newScaffoldData(opts)
+	if err != nil {
+		return err
+	}
+
+	outputDir := opts.outputDir
+	if outputDir == "" {
+		outputDir = filepath.Join(opts.root, "pkg", data.Package)
+	}
+
+	baseName := strings.ToLower(data.Kind)
+	files := map[string]*template.Template{
+		baseName + ".go":      builderTemplate,
+		baseName + "_test.go": testTemplate,
+	}
+
+	if data.Namespaced {
+		listgenPath, err := relativePath(outputDir, filepath.Join(opts.root, "internal", "listgen"))
+		if err != nil {
+			return err
+		}
+
+		data.ListgenPath = filepath.ToSlash(listgenPath)
+		files["generate.go"] = generateTemplate
+	}
+
+	for name := range files {
+		if _, err := os.Stat(filepath.Join(outputDir, name)); err == nil {
+			return fmt.Errorf("file %s already exists in %s", name, outputDir)
+		}
+	}
+
+	err = os.MkdirAll(outputDir, 0o755)
+	if err != nil {
+		return err
+	}
+
+	for name, fileTemplate := range files {
+		source, err := render(fileTemplate, data)
+		if err != nil {
+			return fmt.Errorf("failed to render %s: %w", name, err)
+		}
+
+		err = os.WriteFile(filepath.Join(outputDir, name), source, 0o644)
+		if err != nil {
+			return err
+		}
+	}
+
+	if !data.Namespaced || opts.skipGenerate {
+		return nil
+	}
+
+	command := exec.Command("go", "generate", ".")
+	command.Dir = outputDir
+	command.Stdout = os.Stdout
+	command.Stderr = os.Stderr
+
+	return command.Run()
+}
+
+// newScaffoldData validates opts and derives the template input from them.
+func newScaffoldData(opts options) (scaffoldData, error) {
+	group, version, kind, err := parseGVK(opts.gvk)
+	if err != nil {
+		return scaffoldData{}, err
+	}
+
+	if opts.importPath == "" {
+		return scaffoldData{}, fmt.Errorf("import path of the API package cannot be empty")
+	}
+
+	alias := opts.alias
+	if alias == "" {
+		alias = defaultAlias(group, version)
+	}
+
+	packageName := opts.packageName
+	if packageName == "" {
+		packageName = strings.ToLower(kind)
+	}
+
+	data := scaffoldData{
+		Package:      packageName,
+		Kind:         kind,
+		Alias:        alias,
+		Object:       alias + "." + kind,
+		Attacher:     alias + "." + opts.attacher,
+		GroupVersion: alias + "." + opts.groupVersion,
+		Namespaced:   !opts.clusterScoped,
+		GVKVar:       string(unicode.ToLower(rune(kind[0]))) + kind[1:] + "GVK",
+	}
+
+	apiImport := fmt.Sprintf("%s %q", alias, opts.importPath)
+	data.Imports = sortImports(apiImport,
+		`"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"`,
+		`"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common"`,
+		`"k8s.io/apimachinery/pkg/runtime/schema"`,
+		`"k8s.io/klog/v2"`)
+	data.TestImports = sortImports(apiImport, `"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common/testhelper"`)
+
+	runtimeClientImport := `runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"`
+
+	if data.Namespaced {
+		data.TestImports = sortImports(append(data.TestImports, runtimeClientImport,
+			`"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"`)...)
+	} else {
+		data.Imports = sortImports(append(data.Imports, runtimeClientImport)...)
+	}
+
+	return data, nil
+}
+
+// parseGVK splits a GVK in the group/version/Kind form. The group is omitted for the core API, as in v1/ConfigMap.
+func parseGVK(gvk string) (string, string, string, error) {
+	parts := strings.Split(gvk, "/")
+
+	var group, version, kind string
+
+	switch len(parts) {
+	case 2:
+		version, kind = parts[0], parts[1]
+	case 3:
+		group, version, kind = parts[0], parts[1], parts[2]
+	default:
+		return "", "", "", fmt.Errorf("gvk %q must be in the group/version/Kind form", gvk)
+	}
+
+	if version == "" || kind == "" || !unicode.IsUpper(rune(kind[0])) {
+		return "", "", "", fmt.Errorf("gvk %q must have a version and a capitalized kind", gvk)
+	}
+
+	return group, version, kind, nil
+}
+
+// defaultAlias returns the import alias used for an API package throughout the repository, which is the first label
+// of the group followed by the version, such as routev1 for route.openshift.io/v1 and corev1 for the core API.
+func defaultAlias(group, version string) string {
+	name := "core"
+
+	if group != "" {
+		name = strings.Split(group, ".")[0]
+	}
+
+	name = strings.Map(func(char rune) rune {
+		if unicode.IsLetter(char) || unicode.IsDigit(char) {
+			return unicode.ToLower(char)
+		}
+
+		return -1
+	}, name)
+
+	return name + version
+}
+
+// sortImports orders import lines by their path, ignoring any alias.
+func sortImports(imports ...string) []string {
+	slices.SortFunc(imports, func(first, second string) int {
+		return strings.Compare(importPathOf(first), importPathOf(second))
+	})
+
+	return imports
+}
+
+// importPathOf returns the quoted path of an import line.
+func importPathOf(line string) string {
+	return line[strings.Index(line, `"`):]
+}
+
+// relativePath returns target relative to base, resolving both against the working directory first so that an absolute
+// output directory can be combined with a relative repository root.
+func relativePath(base, target string) (string, error) {
+	absoluteBase, err := filepath.Abs(base)
+	if err != nil {
+		return "", err
+	}
+
+	absoluteTarget, err := filepath.Abs(target)
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Rel(absoluteBase, absoluteTarget)
+}
+
+// render executes fileTemplate for data and formats the result.
+func render(fileTemplate *template.Template, data scaffoldData) ([]byte, error) {
+	var buffer bytes.Buffer
+
+	err := fileTemplate.Execute(&buffer, data)
+	if err != nil {
+		return nil, err
+	}
+
+	return format.Source(buffer.Bytes())
+}
@@ -0,0 +1,175 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestScaffold(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name             string
+		options          options
+		expectedFiles    []string
+		expectedContains map[string][]string
+	}{
+		{
+			name: "namespaced resource",
+			options: options{
+				gvk:          "route.openshift.io/v1/Route",
+				importPath:   "github.com/openshift/api/route/v1",
+				attacher:     "AddToScheme",
+				groupVersion: "GroupVersion",
+				skipGenerate: true,
+			},
+			expectedFiles: []string{"route.go", "route_test.go", "generate.go"},
+			expectedContains: map[string][]string{
+				"route.go": {
+					"package route",
+					`routev1 "github.com/openshift/api/route/v1"`,
+					"common.EmbeddableBuilder[routev1.Route, *routev1.Route]",
+					"return routev1.GroupVersion.WithKind(\"Route\")",
+					"common.NewNamespacedBuilder[routev1.Route, Builder](",
+					"common.PullNamespacedBuilder[routev1.Route, Builder](",
+				},
+				"route_test.go": {
+					"var routeGVK = routev1.GroupVersion.WithKind(\"Route\")",
+					"testhelper.NewNamespacedBuilderTestConfig(",
+					"testhelper.ResourceScopeNamespaced,",
+					"testhelper.NewListTestConfig(ListInAllNamespaces, routev1.AddToScheme, routeGVK)",
+				},
+				"generate.go": {"//go:generate go run ../../internal/listgen -builder Builder"},
+			},
+		},
+		{
+			name: "cluster scoped resource",
+			options: options{
+				gvk:           "imageregistry.operator.openshift.io/v1/ImagePruner",
+				importPath:    "github.com/openshift/api/imageregistry/v1",
+				packageName:   "imageprune",
+				attacher:      "Install",
+				groupVersion:  "GroupVersion",
+				clusterScoped: true,
+			},
+			expectedFiles: []string{"imagepruner.go", "imagepruner_test.go"},
+			expectedContains: map[string][]string{
+				"imagepruner.go": {
+					"package imageprune",
+					"common.NewClusterScopedBuilder[imageregistryv1.ImagePruner, Builder](",
+					"common.List[imageregistryv1.ImagePruner, imageregistryv1.ImagePrunerList, Builder](",
+					"context.TODO(), apiClient, imageregistryv1.Install, options...)",
+				},
+				"imagepruner_test.go": {
+					"var imagePrunerGVK = imageregistryv1.GroupVersion.WithKind(\"ImagePruner\")",
+					"testhelper.NewClusterScopedPullTestConfig(",
+					"testhelper.NewListTestConfig(List, imageregistryv1.Install, imagePrunerGVK)",
+				},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			root := t.TempDir()
+			testCase.options.root = root
+
+			err := scaffold(testCase.options)
+			require.NoError(t, err)
+
+			entries, err := os.ReadDir(filepath.Join(root, "pkg"))
+			require.NoError(t, err)
+			require.Len(t, entries, 1)
+
+			packageDir := filepath.Join(root, "pkg", entries[0].Name())
+
+			for _, fileName := range testCase.expectedFiles {
+				content, err := os.ReadFile(filepath.Join(packageDir, fileName))
+				require.NoError(t, err)
+
+				for _, expected := range testCase.expectedContains[fileName] {
+					assert.Contains(t, string(content), expected)
+				}
+			}
+
+			if testCase.options.clusterScoped {
+				assert.NoFileExists(t, filepath.Join(packageDir, "generate.go"))
+			}
+		})
+	}
+}
+
+func TestScaffoldExistingFile(t *testing.T) {
+	t.Parallel()
+
+	outputDir := t.TempDir()
+	err := os.WriteFile(filepath.Join(outputDir, "route.go"), []byte("package route\n"), 0o600)
+	require.NoError(t, err)
+
+	err = scaffold(options{
+		gvk:          "route.openshift.io/v1/Route",
+		importPath:   "github.com/openshift/api/route/v1",
+		attacher:     "AddToScheme",
+		groupVersion: "GroupVersion",
+		outputDir:    outputDir,
+		skipGenerate: true,
+	})
+	assert.ErrorContains(t, err, "file route.go already exists")
+
+	content, err := os.ReadFile(filepath.Join(outputDir, "route.go"))
+	require.NoError(t, err)
+	assert.Equal(t, "package route\n", string(content))
+}
+
+func TestParseGVK(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		gvk             string
+		expectedGroup   string
+		expectedVersion string
+		expectedKind    string
+		expectedError   bool
+	}{
+		{
+			gvk:             "route.openshift.io/v1/Route",
+			expectedGroup:   "route.openshift.io",
+			expectedVersion: "v1",
+			expectedKind:    "Route",
+		},
+		{gvk: "v1/ConfigMap", expectedVersion: "v1", expectedKind: "ConfigMap"},
+		{gvk: "Route", expectedError: true},
+		{gvk: "route.openshift.io/v1/route", expectedError: true},
+		{gvk: "a/b/c/D", expectedError: true},
+	}
+
+	for _, testCase := range testCases {
+		group, version, kind, err := parseGVK(testCase.gvk)
+
+		if testCase.expectedError {
+			assert.Error(t, err, testCase.gvk)
+
+			continue
+		}
+
+		assert.NoError(t, err)
+		assert.Equal(t, testCase.expectedGroup, group)
+		assert.Equal(t, testCase.expectedVersion, version)
+		assert.Equal(t, testCase.expectedKind, kind)
+	}
+}
+
+func TestDefaultAlias(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "routev1", defaultAlias("route.openshift.io", "v1"))
+	assert.Equal(t, "corev1", defaultAlias("", "v1"))
+	assert.Equal(t, "k8sv1", defaultAlias("k8s.ovn.org", "v1"))
+	assert.Equal(t, "nmstatev1beta1", defaultAlias("nm-state.io", "v1beta1"))
+}
@@ -3,10 +3,9 @@ package integration
 
 import (
 	"errors"
-	"fmt"
-	"math/rand"
 	"time"
 
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/names"
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/namespace"
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/pod"
 	corev1 "k8s.io/api/core/v1"
@@ -14,8 +13,7 @@ import (
 
 // CreateRandomNamespace generates a random namespace name for testing.
 func CreateRandomNamespace() string {
-	// Generate a random namespace name with rand library
-	return fmt.Sprintf("test-namespace-%d", rand.Intn(100000))
+	return names.GenerateName("test-namespace")
 }
 
 // PreEmptiveNamespaceDeleteAndSetup deletes the namespace preemptively and sets it up for the test.
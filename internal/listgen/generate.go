@@ -0,0 +1,461 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"text/template"
+)
+
+const (
+	commonImportPath       = "github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common"
+	commonErrorsImportPath = "github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common/errors"
+	commonKeyImportPath    = "github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common/key"
+	clientsImportPath      = "github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	klogImportPath         = "k8s.io/klog/v2"
+	runtimeClientPath      = "sigs.k8s.io/controller-runtime/pkg/client"
+
+	generatedFilePrefix = "zz_generated_"
+)
+
+// config holds the command line options of a single listgen run.
+type config struct {
+	builder     string
+	listName    string
+	listAllName string
+	output      string
+}
+
+// builderInfo describes a namespaced builder as found in the parsed package.
+type builderInfo struct {
+	Package    string
+	Builder    string
+	Kind       string
+	Object     string
+	ObjectList string
+	Attacher   string
+
+	// imports maps the name used in the package source to the import path for each package referenced by Object
+	// and Attacher.
+	imports map[string]string
+}
+
+// templateData is the input of listTemplate.
+type templateData struct {
+	builderInfo
+
+	Imports     []importSpec
+	ListName    string
+	ListAllName string
+}
+
+// importSpec is a single line of the import block of the generated file.
+type importSpec struct {
+	Name string
+	Path string
+}
+
+var listTemplate = template.Must(template.New("list").Parse(`// Code generated by listgen. DO NOT EDIT.
+
+package {{ .Package }}
+
+import (
+	"context"
+
+{{ range .Imports }}	{{ if .Name }}{{ .Name }} {{ end }}"{{ .Path }}"
+{{ end }})
+{{ if .ListName }}
+// {{ .ListName }} returns the {{ .Kind }} builders in the provided namespace matching the provided options.
+// If nsname is empty, the default namespace of apiClient is used.
+func {{ .ListName }}(
+	apiClient *clients.Settings, nsname string, options ...runtimeclient.ListOption) ([]*{{ .Builder }}, error) {
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	if nsname == "" {
+		klog.V(100).Info("{{ .Kind }} 'nsname' parameter can not be empty")
+
+		return nil, commonerrors.NewBuilderFieldEmpty(
+			commonkey.NewResourceKey("{{ .Kind }}", "", ""), commonerrors.BuilderFieldNamespace)
+	}
+
+	allOptions := append([]runtimeclient.ListOption{runtimeclient.InNamespace(nsname)}, options...)
+
+	return common.List[{{ .Object }}, {{ .ObjectList }}, {{ .Builder }}](
+		context.TODO(), apiClient, {{ .Attacher }}, allOptions...)
+}
+{{ end }}{{ if .ListAllName }}
+// {{ .ListAllName }} returns the {{ .Kind }} builders in all namespaces matching the provided options.
+func {{ .ListAllName }}(
+	apiClient *clients.Settings, options ...runtimeclient.ListOption) ([]*{{ .Builder }}, error) {
+	return common.List[{{ .Object }}, {{ .ObjectList }}, {{ .Builder }}](
+		context.TODO(), apiClient, {{ .Attacher }}, options...)
+}
+{{ end }}`))
+
+// run generates the list functions for the builder described by cfg in the package in dir. If every function already
+// exists in the package, no file is written.
+func run(dir string, cfg config) error {
+	files, err := parsePackage(dir)
+	if err != nil {
+		return err
+	}
+
+	info, err := findBuilder(files, cfg.builder)
+	if err != nil {
+		return err
+	}
+
+	listName, listAllName := listFunctionNames(info, cfg)
+	declared := declaredFunctions(files)
+
+	if declared[listName] {
+		listName = ""
+	}
+
+	if declared[listAllName] {
+		listAllName = ""
+	}
+
+	output := cfg.output
+	if output == "" {
+		output = generatedFilePrefix + strings.ToLower(info.Kind) + "_list.go"
+	}
+
+	outputPath := filepath.Join(dir, output)
+
+	if listName == "" && listAllName == "" {
+		err = os.Remove(outputPath)
+		if err != nil && !os.IsNotExist(err) {
+			return err
+		}
+
+		return nil
+	}
+
+	source, err := render(info, listName, listAllName)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(outputPath, source, 0o644)
+}
+
+// parsePackage parses every non-test, non-generated Go file in dir. Generated files are skipped so that rerunning the
+// generator does not see its own output as existing declarations.
+func parsePackage(dir string) ([]*ast.File, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	fileSet := token.NewFileSet()
+
+	var files []*ast.File
+
+	for _, entry := range entries {
+		name := entry.Name()
+
+		if entry.IsDir() || !strings.HasSuffix(name, ".go") || strings.HasSuffix(name, "_test.go") ||
+			strings.HasPrefix(name, generatedFilePrefix) {
+			continue
+		}
+
+		file, err := parser.ParseFile(fileSet, filepath.Join(dir, name), nil, parser.SkipObjectResolution)
+		if err != nil {
+			return nil, err
+		}
+
+		files = append(files, file)
+	}
+
+	if len(files) == 0 {
+		return nil, fmt.Errorf("no Go files found in %s", dir)
+	}
+
+	return files, nil
+}
+
+// findBuilder locates the builder struct and its namespaced constructor or pull function in files.
+func findBuilder(files []*ast.File, builderName string) (builderInfo, error) {
+	info := builderInfo{
+		Package: files[0].Name.Name,
+		Builder: builderName,
+		imports: make(map[string]string),
+	}
+
+	var objectType, attacher ast.Expr
+
+	for _, file := range files {
+		imports := fileImports(file)
+
+		ast.Inspect(file, func(node ast.Node) bool {
+			switch node := node.(type) {
+			case *ast.TypeSpec:
+				if node.Name.Name == builderName && objectType == nil {
+					objectType = embeddedObjectType(node, imports)
+					addImports(info.imports, objectType, imports)
+				}
+			case *ast.CallExpr:
+				if attacher == nil {
+					attacher = namespacedAttacher(node, builderName, imports)
+					addImports(info.imports, attacher, imports)
+				}
+			}
+
+			return true
+		})
+	}
+
+	if objectType == nil {
+		return info, fmt.Errorf("type %s embedding common.EmbeddableBuilder not found", builderName)
+	}
+
+	if attacher == nil {
+		return info, fmt.Errorf("builder %s is not namespaced: no call to common.NewNamespacedBuilder or "+
+			"common.PullNamespacedBuilder found", builderName)
+	}
+
+	info.Object = exprString(objectType)
+	info.ObjectList = info.Object + "List"
+	info.Attacher = exprString(attacher)
+
+	switch objectType := objectType.(type) {
+	case *ast.SelectorExpr:
+		info.Kind = objectType.Sel.Name
+	case *ast.Ident:
+		info.Kind = objectType.Name
+	default:
+		return info, fmt.Errorf("unsupported object type %s for builder %s", info.Object, builderName)
+	}
+
+	return info, nil
+}
+
+// embeddedObjectType returns the object type argument of the common.EmbeddableBuilder embedded in spec, or nil if spec
+// does not embed it.
+func embeddedObjectType(spec *ast.TypeSpec, imports map[string]string) ast.Expr {
+	structType, ok := spec.Type.(*ast.StructType)
+	if !ok {
+		return nil
+	}
+
+	for _, field := range structType.Fields.List {
+		if len(field.Names) > 0 {
+			continue
+		}
+
+		indexList, ok := field.Type.(*ast.IndexListExpr)
+		if ok && isCommonSelector(indexList.X, imports, "EmbeddableBuilder") {
+			return indexList.Indices[0]
+		}
+	}
+
+	return nil
+}
+
+// namespacedAttacher returns the scheme attacher argument if call is common.NewNamespacedBuilder or
+// common.PullNamespacedBuilder instantiated for builderName, otherwise nil.
+func namespacedAttacher(call *ast.CallExpr, builderName string, imports map[string]string) ast.Expr {
+	indexList, ok := call.Fun.(*ast.IndexListExpr)
+	if !ok || len(indexList.Indices) < 2 {
+		return nil
+	}
+
+	builderIdent, ok := indexList.Indices[1].(*ast.Ident)
+	if !ok || builderIdent.Name != builderName {
+		return nil
+	}
+
+	switch {
+	case isCommonSelector(indexList.X, imports, "NewNamespacedBuilder") && len(call.Args) > 1:
+		return call.Args[1]
+	case isCommonSelector(indexList.X, imports, "PullNamespacedBuilder") && len(call.Args) > 2:
+		return call.Args[2]
+	default:
+		return nil
+	}
+}
+
+// isCommonSelector checks whether expr refers to the provided name in the common package.
+func isCommonSelector(expr ast.Expr, imports map[string]string, name string) bool {
+	selector, ok := expr.(*ast.SelectorExpr)
+	if !ok || selector.Sel.Name != name {
+		return false
+	}
+
+	pkg, ok := selector.X.(*ast.Ident)
+
+	return ok && imports[pkg.Name] == commonImportPath
+}
+
+// fileImports maps the name each import of file is referred to by to its path.
+func fileImports(file *ast.File) map[string]string {
+	imports := make(map[string]string)
+
+	for _, spec := range file.Imports {
+		importPath, err := strconv.Unquote(spec.Path.Value)
+		if err != nil {
+			continue
+		}
+
+		name := packageName(importPath)
+		if spec.Name != nil {
+			name = spec.Name.Name
+		}
+
+		imports[name] = importPath
+	}
+
+	return imports
+}
+
+// packageName returns the default name of the package with the provided import path, skipping a module major version
+// suffix such as the v2 in k8s.io/klog/v2.
+func packageName(importPath string) string {
+	name := path.Base(importPath)
+
+	if major, err := strconv.Atoi(strings.TrimPrefix(name, "v")); err == nil && name[0] == 'v' && major > 1 {
+		if parent := path.Base(path.Dir(importPath)); parent != "." && parent != "/" {
+			return parent
+		}
+	}
+
+	return name
+}
+
+// addImports records the import of every package referenced by expr.
+func addImports(dst map[string]string, expr ast.Expr, imports map[string]string) {
+	if expr == nil {
+		return
+	}
+
+	ast.Inspect(expr, func(node ast.Node) bool {
+		selector, ok := node.(*ast.SelectorExpr)
+		if !ok {
+			return true
+		}
+
+		if pkg, ok := selector.X.(*ast.Ident); ok {
+			if importPath, found := imports[pkg.Name]; found {
+				dst[pkg.Name] = importPath
+			}
+		}
+
+		return false
+	})
+}
+
+// declaredFunctions returns the names of all package level functions declared in files.
+func declaredFunctions(files []*ast.File) map[string]bool {
+	declared := make(map[string]bool)
+
+	for _, file := range files {
+		for _, decl := range file.Decls {
+			if funcDecl, ok := decl.(*ast.FuncDecl); ok && funcDecl.Recv == nil {
+				declared[funcDecl.Name.Name] = true
+			}
+		}
+	}
+
+	return declared
+}
+
+// listFunctionNames returns the names of the generated functions. The primary Builder type of a package gets List and
+// ListInAllNamespaces, while other builders get the pluralized kind in the name, such as ListRoutes and
+// ListRoutesInAllNamespaces.
+func listFunctionNames(info builderInfo, cfg config) (string, string) {
+	listName := cfg.listName
+	if listName == "" {
+		listName = "List"
+
+		if info.Builder != "Builder" {
+			listName += pluralize(info.Kind)
+		}
+	}
+
+	listAllName := cfg.listAllName
+	if listAllName == "" {
+		listAllName = listName + "InAllNamespaces"
+	}
+
+	return listName, listAllName
+}
+
+// pluralize returns the English plural of kind, covering the suffixes that appear in Kubernetes kinds.
+func pluralize(kind string) string {
+	switch {
+	case strings.HasSuffix(kind, "y") && !strings.HasSuffix(kind, "ay") && !strings.HasSuffix(kind, "ey"):
+		return strings.TrimSuffix(kind, "y") + "ies"
+	case strings.HasSuffix(kind, "s"), strings.HasSuffix(kind, "x"), strings.HasSuffix(kind, "ch"):
+		return kind + "es"
+	default:
+		return kind + "s"
+	}
+}
+
+// render executes listTemplate for info and formats the result. Empty function names are not generated.
+func render(info builderInfo, listName, listAllName string) ([]byte, error) {
+	imports := map[string]string{
+		"clients":       clientsImportPath,
+		"common":        commonImportPath,
+		"runtimeclient": runtimeClientPath,
+	}
+
+	if listName != "" {
+		imports["commonerrors"] = commonErrorsImportPath
+		imports["commonkey"] = commonKeyImportPath
+		imports["klog"] = klogImportPath
+	}
+
+	for name, importPath := range info.imports {
+		imports[name] = importPath
+	}
+
+	data := templateData{
+		builderInfo: info,
+		ListName:    listName,
+		ListAllName: listAllName,
+	}
+
+	for name, importPath := range imports {
+		spec := importSpec{Path: importPath}
+
+		if name != packageName(importPath) {
+			spec.Name = name
+		}
+
+		data.Imports = append(data.Imports, spec)
+	}
+
+	slices.SortFunc(data.Imports, func(first, second importSpec) int {
+		return strings.Compare(first.Path, second.Path)
+	})
+
+	var buffer bytes.Buffer
+
+	err := listTemplate.Execute(&buffer, data)
+	if err != nil {
+		return nil, err
+	}
+
+	return format.Source(buffer.Bytes())
+}
+
+// exprString prints expr as it appears in the source.
+func exprString(expr ast.Expr) string {
+	var buffer bytes.Buffer
+
+	_ = printer.Fprint(&buffer, token.NewFileSet(), expr)
+
+	return buffer.String()
+}
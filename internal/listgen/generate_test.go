@@ -0,0 +1,181 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testBuilderSource = `package sample
+
+import (
+	"context"
+
+	samplev1 "example.com/sample/api/v1"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common"
+)
+
+type Builder struct {
+	common.EmbeddableBuilder[samplev1.Policy, *samplev1.Policy]
+}
+
+type ProxyBuilder struct {
+	common.EmbeddableBuilder[samplev1.Proxy, *samplev1.Proxy]
+}
+
+type ClusterBuilder struct {
+	common.EmbeddableBuilder[samplev1.Cluster, *samplev1.Cluster]
+}
+
+func NewBuilder(apiClient *clients.Settings, name, nsname string) *Builder {
+	return common.NewNamespacedBuilder[samplev1.Policy, Builder](apiClient, samplev1.AddToScheme, name, nsname)
+}
+
+func PullProxy(apiClient *clients.Settings, name, nsname string) (*ProxyBuilder, error) {
+	return common.PullNamespacedBuilder[samplev1.Proxy, ProxyBuilder](
+		context.TODO(), apiClient, samplev1.AddToScheme, name, nsname)
+}
+
+func NewClusterBuilder(apiClient *clients.Settings, name string) *ClusterBuilder {
+	return common.NewClusterScopedBuilder[samplev1.Cluster, ClusterBuilder](apiClient, samplev1.AddToScheme, name)
+}
+
+func ListInAllNamespaces(apiClient *clients.Settings) ([]*Builder, error) {
+	return nil, nil
+}
+`
+
+func TestRun(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name             string
+		config           config
+		expectedFile     string
+		expectedContains []string
+		expectedMissing  []string
+		expectedError    string
+	}{
+		{
+			name:         "primary builder skips declared functions",
+			config:       config{builder: "Builder"},
+			expectedFile: "zz_generated_policy_list.go",
+			expectedContains: []string{
+				"// Code generated by listgen. DO NOT EDIT.",
+				"func List(\n\tapiClient *clients.Settings, nsname string, options ...runtimeclient.ListOption) " +
+					"([]*Builder, error) {",
+				"nsname = apiClient.ResolveNamespace(nsname)",
+				`commonkey.NewResourceKey("Policy", "", "")`,
+				"common.List[samplev1.Policy, samplev1.PolicyList, Builder](",
+				`samplev1 "example.com/sample/api/v1"`,
+			},
+			expectedMissing: []string{"func ListInAllNamespaces("},
+		},
+		{
+			name:         "other builder uses pluralized kind",
+			config:       config{builder: "ProxyBuilder"},
+			expectedFile: "zz_generated_proxy_list.go",
+			expectedContains: []string{
+				"func ListProxies(",
+				"func ListProxiesInAllNamespaces(",
+				"context.TODO(), apiClient, samplev1.AddToScheme, options...)",
+			},
+		},
+		{
+			name:         "names and output can be overridden",
+			config:       config{builder: "ProxyBuilder", listName: "ListAll", listAllName: "ListEverywhere", output: "out.go"},
+			expectedFile: "out.go",
+			expectedContains: []string{
+				"func ListAll(",
+				"func ListEverywhere(",
+			},
+		},
+		{
+			name:          "cluster scoped builder",
+			config:        config{builder: "ClusterBuilder"},
+			expectedError: "builder ClusterBuilder is not namespaced",
+		},
+		{
+			name:          "missing builder",
+			config:        config{builder: "MissingBuilder"},
+			expectedError: "type MissingBuilder embedding common.EmbeddableBuilder not found",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			dir := t.TempDir()
+			err := os.WriteFile(filepath.Join(dir, "sample.go"), []byte(testBuilderSource), 0o600)
+			require.NoError(t, err)
+
+			err = run(dir, testCase.config)
+			if testCase.expectedError != "" {
+				assert.ErrorContains(t, err, testCase.expectedError)
+
+				return
+			}
+
+			require.NoError(t, err)
+
+			generated, err := os.ReadFile(filepath.Join(dir, testCase.expectedFile))
+			require.NoError(t, err)
+
+			for _, expected := range testCase.expectedContains {
+				assert.Contains(t, string(generated), expected)
+			}
+
+			for _, missing := range testCase.expectedMissing {
+				assert.NotContains(t, string(generated), missing)
+			}
+		})
+	}
+}
+
+func TestRunRemovesStaleOutput(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	source := testBuilderSource + "\nfunc List(apiClient *clients.Settings, nsname string) ([]*Builder, error) {\n" +
+		"\treturn nil, nil\n}\n"
+
+	err := os.WriteFile(filepath.Join(dir, "sample.go"), []byte(source), 0o600)
+	require.NoError(t, err)
+
+	stalePath := filepath.Join(dir, "zz_generated_policy_list.go")
+	err = os.WriteFile(stalePath, []byte("package sample\n"), 0o600)
+	require.NoError(t, err)
+
+	err = run(dir, config{builder: "Builder"})
+	require.NoError(t, err)
+	assert.NoFileExists(t, stalePath)
+}
+
+func TestPluralize(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]string{
+		"Route":         "Routes",
+		"NetworkPolicy": "NetworkPolicies",
+		"Gateway":       "Gateways",
+		"Ingress":       "Ingresses",
+		"Proxy":         "Proxies",
+	}
+
+	for kind, expected := range testCases {
+		assert.Equal(t, expected, pluralize(kind))
+	}
+}
+
+func TestPackageName(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "klog", packageName("k8s.io/klog/v2"))
+	assert.Equal(t, "v1", packageName("k8s.io/api/core/v1"))
+	assert.Equal(t, "common", packageName(commonImportPath))
+}
@@ -0,0 +1,36 @@
+// Command listgen generates List and ListInAllNamespaces functions for a namespaced builder that uses the common
+// builder framework. It is meant to be run through go:generate from the package containing the builder:
+//
+//	//go:generate go run ../../internal/listgen -builder PreCachingConfigBuilder
+//
+// The object type and scheme attacher are read from the builder's embedded common.EmbeddableBuilder and from its call
+// to common.NewNamespacedBuilder or common.PullNamespacedBuilder, so the generated functions always match the
+// constructor. Functions that are already declared in the package are not generated, which allows existing hand
+// written list functions to be kept.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+)
+
+func main() {
+	builderName := flag.String("builder", "Builder", "name of the builder type to generate list functions for")
+	listName := flag.String("list", "", "name of the namespaced list function, derived from the kind if empty")
+	listAllName := flag.String("list-all", "", "name of the all namespaces list function, derived from the kind if empty")
+	output := flag.String("output", "", "name of the generated file, derived from the builder if empty")
+
+	flag.Parse()
+
+	err := run(".", config{
+		builder:     *builderName,
+		listName:    *listName,
+		listAllName: *listAllName,
+		output:      *output,
+	})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "listgen: %v\n", err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,83 @@
+package drift
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"gopkg.in/yaml.v2"
+)
+
+// RepoConfig is a single entry in the sync tool config files. It describes an upstream API directory and where it is
+// synced to under pkg.
+type RepoConfig struct {
+	Sync               bool                `yaml:"sync"`
+	Name               string              `yaml:"name"`
+	RepoLink           string              `yaml:"repo_link"`
+	Branch             string              `yaml:"branch"`
+	Commit             string              `yaml:"commit"`
+	RemoteAPIDirectory string              `yaml:"remote_api_directory"`
+	LocalAPIDirectory  string              `yaml:"local_api_directory"`
+	ReplaceImports     []map[string]string `yaml:"replace_imports"`
+	Excludes           []string            `yaml:"excludes"`
+}
+
+// Ref returns the upstream revision the config is pinned to. This is the commit if one is set and the branch
+// otherwise.
+func (config RepoConfig) Ref() string {
+	if config.Commit != "" {
+		return config.Commit
+	}
+
+	return config.Branch
+}
+
+// LoadRepoConfigs reads every config file under path, which may be either a single file or a directory, and returns
+// all of the entries in them in order.
+func LoadRepoConfigs(path string) ([]RepoConfig, error) {
+	var repoConfigs []RepoConfig
+
+	err := filepath.WalkDir(path, func(filePath string, dirEntry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if dirEntry.IsDir() {
+			return nil
+		}
+
+		configs, err := readRepoConfigs(filePath)
+		if err != nil {
+			return fmt.Errorf("failed to read config file %s: %w", filePath, err)
+		}
+
+		repoConfigs = append(repoConfigs, configs...)
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return repoConfigs, nil
+}
+
+// readRepoConfigs decodes the list of entries in a single config file.
+func readRepoConfigs(filePath string) ([]RepoConfig, error) {
+	openedFile, err := os.Open(filePath)
+	if err != nil {
+		return nil, err
+	}
+
+	defer openedFile.Close()
+
+	var configs []RepoConfig
+
+	err = yaml.NewDecoder(openedFile).Decode(&configs)
+	if err != nil {
+		return nil, err
+	}
+
+	return configs, nil
+}
@@ -0,0 +1,247 @@
+// Package drift detects when the API types synced under pkg/schemes no longer match the upstream revision in the sync
+// tool config files. Upstream files go through the same excludes, package renames and import replacements as during a
+// sync before being compared, so a report without drift means rerunning the sync would not change anything.
+//
+// The upstream revision is the commit of a config if it sets one and the tip of its branch otherwise. Configs without a
+// commit are therefore compared against a moving target and may report drift without any local change.
+package drift
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"k8s.io/klog/v2"
+)
+
+// ChangeType describes how a single file differs between upstream and the local copy.
+type ChangeType string
+
+const (
+	// ChangeAdded means the file exists upstream but has not been synced.
+	ChangeAdded ChangeType = "added"
+	// ChangeRemoved means the file exists locally but no longer exists upstream.
+	ChangeRemoved ChangeType = "removed"
+	// ChangeModified means the file exists in both places with different contents.
+	ChangeModified ChangeType = "modified"
+)
+
+// FileDrift is a single file that differs between upstream and the local copy.
+type FileDrift struct {
+	// Path is the path of the file relative to the API directory, using forward slashes.
+	Path   string
+	Change ChangeType
+}
+
+// Report holds the result of verifying a single sync config.
+type Report struct {
+	Name              string
+	LocalAPIDirectory string
+	// Ref is the upstream commit or branch the local copy was compared against.
+	Ref   string
+	Files []FileDrift
+}
+
+// HasDrift returns true if any file differs between upstream and the local copy.
+func (report Report) HasDrift() bool {
+	return len(report.Files) > 0
+}
+
+// String returns a human readable summary of the report, with one line per drifted file.
+func (report Report) String() string {
+	if !report.HasDrift() {
+		return fmt.Sprintf("%s: %s is in sync with %s", report.Name, report.LocalAPIDirectory, report.Ref)
+	}
+
+	var builder strings.Builder
+
+	fmt.Fprintf(&builder, "%s: %s has drifted from %s:", report.Name, report.LocalAPIDirectory, report.Ref)
+
+	for _, file := range report.Files {
+		fmt.Fprintf(&builder, "\n\t%s %s", file.Change, file.Path)
+	}
+
+	return builder.String()
+}
+
+// VerifyAll verifies every config with sync enabled against the local copies under pkgDir, which is the pkg directory
+// of the repository. Local API directories nested inside another config's directory are ignored when verifying the
+// outer one, since they are synced separately. Errors for individual configs do not stop the remaining ones from being
+// verified and are joined in the returned error.
+func VerifyAll(ctx context.Context, configs []RepoConfig, pkgDir string, fetch Fetcher) ([]Report, error) {
+	var (
+		reports []Report
+		errs    []error
+	)
+
+	for _, config := range configs {
+		if !config.Sync {
+			klog.V(100).Infof("Sync disabled for repo %s. Skip verifying %s", config.Name, config.LocalAPIDirectory)
+
+			continue
+		}
+
+		report, err := Verify(ctx, config, pkgDir, fetch, nestedDirectories(config, configs)...)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to verify %s: %w", config.LocalAPIDirectory, err))
+
+			continue
+		}
+
+		reports = append(reports, report)
+	}
+
+	return reports, errors.Join(errs...)
+}
+
+// Verify fetches the upstream API directory of config into a temporary directory and compares it to the local copy
+// under pkgDir. The ignored paths are relative to the local API directory and are skipped in the local copy.
+func Verify(
+	ctx context.Context, config RepoConfig, pkgDir string, fetch Fetcher, ignored ...string) (Report, error) {
+	report := Report{Name: config.Name, LocalAPIDirectory: config.LocalAPIDirectory, Ref: config.Ref()}
+
+	if fetch == nil {
+		return report, fmt.Errorf("fetcher cannot be nil")
+	}
+
+	tempDir, err := os.MkdirTemp("", "drift-"+config.Name)
+	if err != nil {
+		return report, err
+	}
+
+	defer os.RemoveAll(tempDir)
+
+	err = fetch(ctx, config, tempDir)
+	if err != nil {
+		return report, fmt.Errorf("failed to fetch %s at %s: %w", config.RepoLink, config.Ref(), err)
+	}
+
+	report.Files, err = Compare(
+		filepath.Join(tempDir, config.RemoteAPIDirectory),
+		filepath.Join(pkgDir, config.LocalAPIDirectory),
+		config,
+		ignored...)
+	if err != nil {
+		return report, err
+	}
+
+	return report, nil
+}
+
+// Compare returns the files that differ between upstreamDir and localDir, sorted by path. The upstream files are
+// transformed according to config before being compared, exactly as the sync tool does before copying them. The
+// ignored paths are relative to localDir and are skipped along with everything under them.
+func Compare(upstreamDir, localDir string, config RepoConfig, ignored ...string) ([]FileDrift, error) {
+	upstreamFiles, err := readTree(upstreamDir, func(relativePath string) bool {
+		return IsExcluded(relativePath, config.Excludes)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read upstream directory: %w", err)
+	}
+
+	localFiles, err := readTree(localDir, func(relativePath string) bool {
+		return slices.ContainsFunc(ignored, func(ignoredPath string) bool {
+			ignoredPath = filepath.ToSlash(filepath.Clean(ignoredPath))
+
+			return relativePath == ignoredPath || strings.HasPrefix(relativePath, ignoredPath+"/")
+		})
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read local directory: %w", err)
+	}
+
+	var drift []FileDrift
+
+	for _, relativePath := range slices.Sorted(maps.Keys(upstreamFiles)) {
+		localContent, found := localFiles[relativePath]
+		if !found {
+			drift = append(drift, FileDrift{Path: relativePath, Change: ChangeAdded})
+
+			continue
+		}
+
+		if !bytes.Equal(Transform(config, relativePath, upstreamFiles[relativePath]), localContent) {
+			drift = append(drift, FileDrift{Path: relativePath, Change: ChangeModified})
+		}
+	}
+
+	for _, relativePath := range slices.Sorted(maps.Keys(localFiles)) {
+		if _, found := upstreamFiles[relativePath]; !found {
+			drift = append(drift, FileDrift{Path: relativePath, Change: ChangeRemoved})
+		}
+	}
+
+	slices.SortStableFunc(drift, func(first, second FileDrift) int {
+		return strings.Compare(first.Path, second.Path)
+	})
+
+	return drift, nil
+}
+
+// readTree returns the contents of every file under root keyed by its slash separated path relative to root. Paths for
+// which skip returns true are not read, and for directories neither is anything under them.
+func readTree(root string, skip func(relativePath string) bool) (map[string][]byte, error) {
+	files := make(map[string][]byte)
+
+	err := filepath.WalkDir(root, func(filePath string, dirEntry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if filePath == root {
+			return nil
+		}
+
+		relativePath, err := filepath.Rel(root, filePath)
+		if err != nil {
+			return err
+		}
+
+		relativePath = filepath.ToSlash(relativePath)
+
+		if skip(relativePath) {
+			if dirEntry.IsDir() {
+				return filepath.SkipDir
+			}
+
+			return nil
+		}
+
+		if dirEntry.IsDir() {
+			return nil
+		}
+
+		content, err := os.ReadFile(filePath)
+		if err != nil {
+			return err
+		}
+
+		files[relativePath] = content
+
+		return nil
+	})
+
+	return files, err
+}
+
+// nestedDirectories returns the local API directories of other configs that are inside the local API directory of
+// config, relative to it.
+func nestedDirectories(config RepoConfig, configs []RepoConfig) []string {
+	var nested []string
+
+	for _, other := range configs {
+		relativePath, found := strings.CutPrefix(other.LocalAPIDirectory, config.LocalAPIDirectory+"/")
+		if found {
+			nested = append(nested, relativePath)
+		}
+	}
+
+	return nested
+}
@@ -0,0 +1,283 @@
+package drift
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testConfig = RepoConfig{
+	Sync:               true,
+	Name:               "operator",
+	RepoLink:           "https://example.com/operator",
+	Branch:             "main",
+	Commit:             "0123456789abcdef",
+	RemoteAPIDirectory: "api/v1",
+	LocalAPIDirectory:  "schemes/operator/operatortypes",
+	ReplaceImports: []map[string]string{{
+		"old": `"example.com/operator/api/v1/config"`,
+		"new": `"github.com/rh-ecosystem-edge/eco-goinfra/pkg/schemes/operator/operatortypes/config"`,
+	}},
+	Excludes: []string{"*_test.go", "webhooks"},
+}
+
+const (
+	upstreamTypes = "package v1\n\nimport _ \"example.com/operator/api/v1/config\"\n"
+	localTypes    = "package operatortypes\n\n" +
+		"import _ \"github.com/rh-ecosystem-edge/eco-goinfra/pkg/schemes/operator/operatortypes/config\"\n"
+)
+
+func TestCompare(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		upstreamFiles map[string]string
+		localFiles    map[string]string
+		ignored       []string
+		expectedDrift []FileDrift
+	}{
+		{
+			name:          "transformed files match",
+			upstreamFiles: map[string]string{"types.go": upstreamTypes, "README.md": "docs"},
+			localFiles:    map[string]string{"types.go": localTypes, "README.md": "docs"},
+		},
+		{
+			name: "excluded upstream files are skipped",
+			upstreamFiles: map[string]string{
+				"types.go":               upstreamTypes,
+				"types_test.go":          "package v1",
+				"webhooks/webhook.go":    "package webhooks",
+				"config/config_test.go":  "package config",
+				"config/nested/webhooks": "not a directory",
+			},
+			localFiles: map[string]string{"types.go": localTypes},
+		},
+		{
+			name:          "ignored local directories are skipped",
+			upstreamFiles: map[string]string{"types.go": upstreamTypes},
+			localFiles:    map[string]string{"types.go": localTypes, "internal/consts/consts.go": "package consts"},
+			ignored:       []string{"internal/consts"},
+		},
+		{
+			name:          "added, modified and removed files are reported in path order",
+			upstreamFiles: map[string]string{"b.go": upstreamTypes, "c.go": "package v1\n\ntype C struct{}\n"},
+			localFiles:    map[string]string{"a.go": localTypes, "b.go": "package operatortypes\n"},
+			expectedDrift: []FileDrift{
+				{Path: "a.go", Change: ChangeRemoved},
+				{Path: "b.go", Change: ChangeModified},
+				{Path: "c.go", Change: ChangeAdded},
+			},
+		},
+		{
+			name:          "nested paths use forward slashes",
+			upstreamFiles: map[string]string{"config/config.go": "package config\n"},
+			localFiles:    map[string]string{},
+			expectedDrift: []FileDrift{{Path: "config/config.go", Change: ChangeAdded}},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			upstreamDir := writeTestTree(t, testCase.upstreamFiles)
+			localDir := writeTestTree(t, testCase.localFiles)
+
+			drift, err := Compare(upstreamDir, localDir, testConfig, testCase.ignored...)
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.expectedDrift, drift)
+		})
+	}
+}
+
+func TestCompareMissingDirectory(t *testing.T) {
+	t.Parallel()
+
+	_, err := Compare(filepath.Join(t.TempDir(), "missing"), t.TempDir(), testConfig)
+	assert.ErrorContains(t, err, "failed to read upstream directory")
+}
+
+func TestPrepareUpstream(t *testing.T) {
+	t.Parallel()
+
+	upstreamDir := writeTestTree(t, map[string]string{
+		"types.go":              upstreamTypes,
+		"types_test.go":         "package v1",
+		"webhooks/webhook.go":   "package webhooks",
+		"config/config_test.go": "package config",
+		"README.md":             "package v1",
+	})
+
+	require.NoError(t, PrepareUpstream(upstreamDir, testConfig))
+
+	files, err := readTree(upstreamDir, func(string) bool { return false })
+	require.NoError(t, err)
+	assert.Equal(t, map[string][]byte{
+		"types.go":  []byte(localTypes),
+		"README.md": []byte("package v1"),
+	}, files)
+}
+
+func TestVerify(t *testing.T) {
+	t.Parallel()
+
+	pkgDir := t.TempDir()
+	writeTestFiles(t, filepath.Join(pkgDir, testConfig.LocalAPIDirectory), map[string]string{"types.go": localTypes})
+
+	testCases := []struct {
+		name          string
+		fetch         Fetcher
+		expectedDrift []FileDrift
+		expectedError string
+	}{
+		{
+			name:  "in sync",
+			fetch: testFetcher(t, map[string]string{"types.go": upstreamTypes}),
+		},
+		{
+			name:          "drifted",
+			fetch:         testFetcher(t, map[string]string{"types.go": upstreamTypes, "new_types.go": "package v1\n"}),
+			expectedDrift: []FileDrift{{Path: "new_types.go", Change: ChangeAdded}},
+		},
+		{
+			name: "fetch failure",
+			fetch: func(context.Context, RepoConfig, string) error {
+				return errors.New("network unreachable")
+			},
+			expectedError: "failed to fetch https://example.com/operator at 0123456789abcdef: network unreachable",
+		},
+		{
+			name:          "nil fetcher",
+			fetch:         nil,
+			expectedError: "fetcher cannot be nil",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			report, err := Verify(context.TODO(), testConfig, pkgDir, testCase.fetch)
+
+			if testCase.expectedError != "" {
+				assert.EqualError(t, err, testCase.expectedError)
+
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, testConfig.Name, report.Name)
+			assert.Equal(t, testConfig.LocalAPIDirectory, report.LocalAPIDirectory)
+			assert.Equal(t, testConfig.Commit, report.Ref)
+			assert.Equal(t, testCase.expectedDrift, report.Files)
+			assert.Equal(t, len(testCase.expectedDrift) > 0, report.HasDrift())
+		})
+	}
+}
+
+func TestVerifyAll(t *testing.T) {
+	t.Parallel()
+
+	nestedConfig := testConfig
+	nestedConfig.Name = "consts"
+	nestedConfig.RemoteAPIDirectory = "internal/consts"
+	nestedConfig.LocalAPIDirectory = testConfig.LocalAPIDirectory + "/internal/consts"
+
+	disabledConfig := testConfig
+	disabledConfig.Name = "disabled"
+	disabledConfig.Sync = false
+
+	pkgDir := t.TempDir()
+	writeTestFiles(t, filepath.Join(pkgDir, testConfig.LocalAPIDirectory), map[string]string{
+		"types.go":                  localTypes,
+		"internal/consts/consts.go": "package consts\n",
+	})
+
+	fetch := func(_ context.Context, config RepoConfig, dir string) error {
+		files := map[string]map[string]string{
+			"api/v1":          {"types.go": upstreamTypes},
+			"internal/consts": {"consts.go": "package consts\n\nconst Name = \"operator\"\n"},
+		}
+
+		writeTestFiles(t, filepath.Join(dir, config.RemoteAPIDirectory), files[config.RemoteAPIDirectory])
+
+		return nil
+	}
+
+	reports, err := VerifyAll(context.TODO(), []RepoConfig{testConfig, nestedConfig, disabledConfig}, pkgDir, fetch)
+	require.NoError(t, err)
+	require.Len(t, reports, 2)
+
+	assert.False(t, reports[0].HasDrift(), reports[0].String())
+	assert.True(t, reports[1].HasDrift())
+	assert.Equal(t, []FileDrift{{Path: "consts.go", Change: ChangeModified}}, reports[1].Files)
+	assert.Equal(t, "consts: schemes/operator/operatortypes/internal/consts has drifted from 0123456789abcdef:\n"+
+		"\tmodified consts.go", reports[1].String())
+}
+
+func TestLoadRepoConfigs(t *testing.T) {
+	t.Parallel()
+
+	configs, err := LoadRepoConfigs("../configs")
+	require.NoError(t, err)
+	require.NotEmpty(t, configs)
+
+	for _, config := range configs {
+		assert.NotEmpty(t, config.Name)
+		assert.NotEmpty(t, config.RepoLink, config.Name)
+		assert.NotEmpty(t, config.Ref(), config.Name)
+		assert.NotEmpty(t, config.RemoteAPIDirectory, config.Name)
+		assert.Regexp(t, "^schemes/", config.LocalAPIDirectory, config.Name)
+	}
+
+	_, err = LoadRepoConfigs(filepath.Join(t.TempDir(), "missing"))
+	assert.Error(t, err)
+}
+
+func TestRepoConfigRef(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "0123456789abcdef", testConfig.Ref())
+	assert.Equal(t, "main", RepoConfig{Branch: "main"}.Ref())
+}
+
+// testFetcher returns a Fetcher that writes files into the remote API directory instead of cloning a repo.
+func testFetcher(t *testing.T, files map[string]string) Fetcher {
+	t.Helper()
+
+	return func(_ context.Context, config RepoConfig, dir string) error {
+		writeTestFiles(t, filepath.Join(dir, config.RemoteAPIDirectory), files)
+
+		return nil
+	}
+}
+
+// writeTestTree writes files into a new temporary directory and returns its path.
+func writeTestTree(t *testing.T, files map[string]string) string {
+	t.Helper()
+
+	dir := t.TempDir()
+	writeTestFiles(t, dir, files)
+
+	return dir
+}
+
+// writeTestFiles writes each file, keyed by its slash separated path relative to dir, creating directories as needed.
+func writeTestFiles(t *testing.T, dir string, files map[string]string) {
+	t.Helper()
+
+	require.NoError(t, os.MkdirAll(dir, 0o755))
+
+	for relativePath, content := range files {
+		filePath := filepath.Join(dir, filepath.FromSlash(relativePath))
+
+		require.NoError(t, os.MkdirAll(filepath.Dir(filePath), 0o755))
+		require.NoError(t, os.WriteFile(filePath, []byte(content), 0o600))
+	}
+}
@@ -0,0 +1,55 @@
+package drift
+
+import (
+	"context"
+	"fmt"
+	"os/exec"
+
+	"k8s.io/klog/v2"
+)
+
+// Fetcher checks out the remote API directory of config into dir, so that it is available at
+// filepath.Join(dir, config.RemoteAPIDirectory).
+type Fetcher func(ctx context.Context, config RepoConfig, dir string) error
+
+// GitFetch is a Fetcher that uses a sparse git clone of the upstream repo. When the config is pinned to a commit, the
+// full history of the branch is fetched without any trees so that the commit can be checked out, otherwise only the
+// tip of the branch is fetched.
+func GitFetch(ctx context.Context, config RepoConfig, dir string) error {
+	klog.V(100).Infof("Cloning repo %s from %s at %s into %s", config.Name, config.RepoLink, config.Ref(), dir)
+
+	cloneArgs := []string{"clone", "-n", "--filter=tree:0", "-b", config.Branch}
+	if config.Commit == "" {
+		cloneArgs = append(cloneArgs, "--depth=1")
+	}
+
+	err := runGit(ctx, "", append(cloneArgs, config.RepoLink, dir)...)
+	if err != nil {
+		return err
+	}
+
+	err = runGit(ctx, dir, "sparse-checkout", "set", "--no-cone", config.RemoteAPIDirectory)
+	if err != nil {
+		return err
+	}
+
+	checkoutArgs := []string{"checkout"}
+	if config.Commit != "" {
+		checkoutArgs = append(checkoutArgs, config.Commit)
+	}
+
+	return runGit(ctx, dir, checkoutArgs...)
+}
+
+// runGit runs git with the provided args in dir, including its output in the returned error.
+func runGit(ctx context.Context, dir string, args ...string) error {
+	command := exec.CommandContext(ctx, "git", args...)
+	command.Dir = dir
+
+	output, err := command.CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("failed to run git %v: %w: %s", args, err, output)
+	}
+
+	return nil
+}
@@ -0,0 +1,90 @@
+package drift
+
+import (
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+// IsExcluded checks whether any element of relativePath matches one of the exclude patterns, so excluding a directory
+// excludes everything under it. Both the sync and the drift check use it to decide which upstream files are skipped.
+func IsExcluded(relativePath string, excludes []string) bool {
+	for element := range strings.SplitSeq(relativePath, "/") {
+		for _, pattern := range excludes {
+			if matched, _ := filepath.Match(pattern, element); matched {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// Transform applies the package rename and import replacements of config to an upstream Go file. Other files are
+// returned unchanged. Both the sync and the drift check use it so the copied and compared contents always match.
+func Transform(config RepoConfig, relativePath string, content []byte) []byte {
+	if path.Ext(relativePath) != ".go" {
+		return content
+	}
+
+	transformed := strings.ReplaceAll(string(content),
+		"package "+path.Base(config.RemoteAPIDirectory), "package "+path.Base(config.LocalAPIDirectory))
+
+	for _, importMap := range config.ReplaceImports {
+		transformed = strings.ReplaceAll(transformed, importMap["old"], importMap["new"])
+	}
+
+	return []byte(transformed)
+}
+
+// PrepareUpstream removes the files excluded by config from upstreamDir and transforms the remaining ones in place, so
+// that upstreamDir can be copied over the local API directory.
+func PrepareUpstream(upstreamDir string, config RepoConfig) error {
+	return filepath.WalkDir(upstreamDir, func(filePath string, dirEntry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if filePath == upstreamDir {
+			return nil
+		}
+
+		relativePath, err := filepath.Rel(upstreamDir, filePath)
+		if err != nil {
+			return err
+		}
+
+		relativePath = filepath.ToSlash(relativePath)
+
+		if IsExcluded(relativePath, config.Excludes) {
+			err = os.RemoveAll(filePath)
+			if err != nil {
+				return err
+			}
+
+			if dirEntry.IsDir() {
+				return filepath.SkipDir
+			}
+
+			return nil
+		}
+
+		if dirEntry.IsDir() {
+			return nil
+		}
+
+		content, err := os.ReadFile(filePath)
+		if err != nil {
+			return err
+		}
+
+		transformed := Transform(config, relativePath, content)
+		if string(transformed) == string(content) {
+			return nil
+		}
+
+		return os.WriteFile(filePath, transformed, 0)
+	})
+}
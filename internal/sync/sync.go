@@ -1,37 +1,25 @@
 package main
 
 import (
+	"context"
 	"flag"
-	"fmt"
-	"io/fs"
 	"os"
 	"os/exec"
 	"path"
 	"path/filepath"
 	"runtime"
-	"strings"
 
-	"gopkg.in/yaml.v2"
+	"github.com/rh-ecosystem-edge/eco-goinfra/internal/sync/drift"
 	"k8s.io/klog/v2"
 )
 
-type repo struct {
-	Sync               bool                `yaml:"sync"`
-	Name               string              `yaml:"name"`
-	RepoLink           string              `yaml:"repo_link"`
-	Branch             string              `yaml:"branch"`
-	RemoteAPIDirectory string              `yaml:"remote_api_directory"`
-	LocalAPIDirectory  string              `yaml:"local_api_directory"`
-	ReplaceImports     []map[string]string `yaml:"replace_imports"`
-	Excludes           []string            `yaml:"excludes"`
-}
-
 func main() {
 	klog.InitFlags(nil)
 
 	_ = flag.Set("logtostderr", "true")
 	_ = flag.Set("v", "100")
 	configFiles := flag.String("config-file", "internal/sync/configs", "path to config files")
+	verify := flag.Bool("verify", false, "report drift from upstream instead of syncing")
 
 	flag.Parse()
 
@@ -39,6 +27,12 @@ func main() {
 
 	config := newConfig(*configFiles)
 
+	if *verify {
+		verifyRemoteRepos(config)
+
+		return
+	}
+
 	klog.V(100).Info("Initiating repository sync")
 
 	for _, repo := range config {
@@ -51,7 +45,33 @@ func main() {
 	}
 }
 
-func syncRemoteRepo(repo *repo) {
+// verifyRemoteRepos compares every synced local API directory to its pinned upstream revision and exits with error
+// code 1 if any of them have drifted or could not be verified.
+func verifyRemoteRepos(config []drift.RepoConfig) {
+	klog.V(100).Info("Verifying synced repositories")
+
+	reports, err := drift.VerifyAll(context.TODO(), config, "./pkg", drift.GitFetch)
+
+	drifted := false
+
+	for _, report := range reports {
+		klog.V(100).Info(report.String())
+
+		drifted = drifted || report.HasDrift()
+	}
+
+	if err != nil {
+		klog.V(100).Infof("Failed to verify repos due to %v. Exit with error 1", err)
+		os.Exit(1)
+	}
+
+	if drifted {
+		klog.V(100).Info("Synced repos have drifted from upstream. Exit with error 1")
+		os.Exit(1)
+	}
+}
+
+func syncRemoteRepo(repo *drift.RepoConfig) {
 	klog.V(100).Infof("Syncing repo: %s, destination repo link: %s", repo.Name, repo.RemoteAPIDirectory)
 
 	_, b, _, _ := runtime.Caller(0)
@@ -85,39 +105,15 @@ func syncRemoteRepo(repo *repo) {
 }
 
 // excludeAndRefactor excludes and refactors files in the clonedDir to prepare them for being compared or copied to the
-// localDir.
-func excludeAndRefactor(clonedDir, localDir string, repo *repo) {
+// localDir. It shares its logic with the drift check, so verifying and syncing always agree.
+func excludeAndRefactor(clonedDir, localDir string, repo *drift.RepoConfig) {
 	klog.V(100).Infof("Updating %s to match expected state of %s", clonedDir, localDir)
 
-	if len(repo.Excludes) > 0 {
-		klog.V(100).Infof("Remove excluded files under %s", path.Base(clonedDir))
-
-		err := excludeFiles(clonedDir, repo.Excludes...)
-		if err != nil {
-			klog.V(100).Infof("Failed to remove excluded files due to %v. Exit with error 1", err)
-			os.Exit(1)
-		}
-	}
-
-	klog.V(100).Infof("Replace cloned package name: %s with the local package name: %s",
-		path.Base(clonedDir), path.Base(localDir))
-
-	err := refactor(
-		fmt.Sprintf("package %s", path.Base(clonedDir)),
-		fmt.Sprintf("package %s", path.Base(localDir)),
-		clonedDir, "*.go")
+	err := drift.PrepareUpstream(clonedDir, *repo)
 	if err != nil {
-		klog.V(100).Infof("Failed to replace package names due to %v. Exit with error 1", err)
+		klog.V(100).Infof("Failed to exclude and refactor files due to %v. Exit with error 1", err)
 		os.Exit(1)
 	}
-
-	for _, importMap := range repo.ReplaceImports {
-		err = refactor(importMap["old"], importMap["new"], clonedDir, "*.go")
-		if err != nil {
-			klog.V(100).Infof("Failed to refactor files due to %v. Exit with error 1", err)
-			os.Exit(1)
-		}
-	}
 }
 
 func copyClonedToLocal(clonedDir, localDir string) {
@@ -143,7 +139,7 @@ func copyClonedToLocal(clonedDir, localDir string) {
 	}
 }
 
-func gitClone(localPath string, repo *repo) {
+func gitClone(localPath string, repo *drift.RepoConfig) {
 	klog.V(100).Infof("Cloning repo %s from %s", repo.Name, repo.RepoLink)
 	localDirectory := path.Join(localPath, repo.Name)
 
@@ -159,24 +155,9 @@ func gitClone(localPath string, repo *repo) {
 		}
 	}
 
-	err := execCmd(
-		localPath,
-		"git",
-		[]string{"clone", "-n", "--depth=1", "--filter=tree:0", "-b", repo.Branch, repo.RepoLink, repo.Name})
-	if err != nil {
-		klog.V(100).Info("Failed to clone repo due to cmd error. Exit with error code 1")
-		os.Exit(1)
-	}
-
-	err = execCmd(localDirectory, "git", []string{"sparse-checkout", "set", "--no-cone", repo.RemoteAPIDirectory})
+	err := drift.GitFetch(context.TODO(), *repo, localDirectory)
 	if err != nil {
-		klog.V(100).Info("Failed to sparse-checkout repo due to cmd error. Exit with error code 1")
-		os.Exit(1)
-	}
-
-	err = execCmd(localDirectory, "git", []string{"checkout"})
-	if err != nil {
-		klog.V(100).Info("Failed to checkout repo due to cmd error. Exit with error code 1")
+		klog.V(100).Infof("Failed to clone repo due to %v. Exit with error code 1", err)
 		os.Exit(1)
 	}
 }
@@ -200,31 +181,12 @@ func execCmd(dirName, binary string, args []string) error {
 	return nil
 }
 
-func newConfig(pathToConfigFiles string) []repo {
-	var repoConfigs []repo
-
+func newConfig(pathToConfigFiles string) []drift.RepoConfig {
 	klog.V(100).Info("Read files in configs directory")
 
-	err := filepath.Walk(pathToConfigFiles, func(path string, info os.FileInfo, err error) error {
-		if !info.IsDir() {
-			klog.V(100).Infof("Loading config file %s", info.Name())
-
-			var config []repo
-
-			err := readFile(&config, path)
-			if err != nil {
-				klog.V(100).Infof("Error to read config file: %v", err)
-
-				return err
-			}
-
-			repoConfigs = append(repoConfigs, config...)
-		}
-
-		return nil
-	})
+	repoConfigs, err := drift.LoadRepoConfigs(pathToConfigFiles)
 	if err != nil {
-		klog.V(100).Infof("Error to list files in directory %s due to %v", pathToConfigFiles, err)
+		klog.V(100).Infof("Error to read config files in %s due to %v", pathToConfigFiles, err)
 
 		return nil
 	}
@@ -237,93 +199,3 @@ func newConfig(pathToConfigFiles string) []repo {
 
 	return repoConfigs
 }
-
-func readFile(cfg *[]repo, cfgFile string) error {
-	openedCfgFile, err := os.Open(cfgFile)
-	if err != nil {
-		return err
-	}
-
-	defer openedCfgFile.Close()
-
-	decoder := yaml.NewDecoder(openedCfgFile)
-
-	err = decoder.Decode(&cfg)
-	if err != nil {
-		return err
-	}
-
-	return nil
-}
-
-func refactor(oldLine, newLine, root string, patterns ...string) error {
-	return filepath.WalkDir(root, refactorFunc(oldLine, newLine, patterns))
-}
-
-func refactorFunc(oldLine, newLine string, filePatterns []string) fs.WalkDirFunc {
-	return func(filePath string, dirEntry fs.DirEntry, err error) error {
-		if err != nil {
-			return err
-		}
-
-		if dirEntry.IsDir() {
-			return nil
-		}
-
-		var matched bool
-
-		for _, pattern := range filePatterns {
-			var err error
-
-			matched, err = filepath.Match(pattern, dirEntry.Name())
-			if err != nil {
-				return err
-			}
-
-			if matched {
-				oldContents, err := os.ReadFile(filePath)
-				if err != nil {
-					return err
-				}
-
-				klog.V(100).Infof("Refactoring: %s", filePath)
-
-				newContents := strings.ReplaceAll(string(oldContents), oldLine, newLine)
-
-				err = os.WriteFile(filePath, []byte(newContents), 0)
-				if err != nil {
-					return err
-				}
-			}
-		}
-
-		return nil
-	}
-}
-
-func excludeFiles(path string, patterns ...string) error {
-	return filepath.WalkDir(path, excludeFunc(path, patterns))
-}
-
-func excludeFunc(root string, patterns []string) fs.WalkDirFunc {
-	return func(filePath string, dirEntry fs.DirEntry, err error) error {
-		if filePath == root {
-			return nil
-		}
-
-		for _, pattern := range patterns {
-			match, err := filepath.Match(pattern, filepath.Base(filePath))
-			if err != nil {
-				return err
-			}
-
-			if match {
-				klog.V(100).Infof("Found that path %s matches %s - Excluding", filePath, pattern)
-
-				return os.RemoveAll(filePath)
-			}
-		}
-
-		return nil
-	}
-}
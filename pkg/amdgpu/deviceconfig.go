@@ -11,6 +11,7 @@ import (
 
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/json"
 	goclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -80,6 +81,8 @@ func NewBuilderFromObjectString(apiClient *clients.Settings, almExample string)
 
 // Pull loads an existing DeviceConfig into Builder struct.
 func Pull(apiClient *clients.Settings, name, namespace string) (*Builder, error) {
+	namespace = apiClient.ResolveNamespace(namespace)
+
 	klog.V(100).Infof("Pulling existing deviceConfig name: %s in namespace: %s", name, namespace)
 
 	if apiClient == nil {
@@ -267,6 +270,13 @@ func getDeviceConfigFromAlmExample(almExample string) (*amdgpuv1.DeviceConfig, e
 	return &deviceConfigList.Items[0], nil
 }
 
+// GetGVR returns DeviceConfig's GroupVersionResource which could be used for Clean function.
+func GetGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group: "amd.com", Version: "v1alpha1", Resource: "deviceconfigs",
+	}
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *Builder) validate() (bool, error) {
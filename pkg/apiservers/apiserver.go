@@ -8,6 +8,7 @@ import (
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/logging"
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/msg"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/klog/v2"
 	goclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -162,6 +163,13 @@ func (builder *APIServerBuilder) WithTLSSecurityProfile(
 	return builder
 }
 
+// GetAPIServerGVR returns APIServer's GroupVersionResource which could be used for Clean function.
+func GetAPIServerGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group: "config.openshift.io", Version: "v1", Resource: "apiservers",
+	}
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *APIServerBuilder) validate() (bool, error) {
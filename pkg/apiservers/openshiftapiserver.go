@@ -9,6 +9,7 @@ import (
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/logging"
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/msg"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/klog/v2"
 
@@ -219,6 +220,13 @@ func (builder *OpenshiftAPIServerBuilder) WaitAllPodsAtTheLatestGeneration(timeo
 	return nil
 }
 
+// GetOpenShiftAPIServerGVR returns OpenShiftAPIServer's GroupVersionResource which could be used for Clean function.
+func GetOpenShiftAPIServerGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group: "operator.openshift.io", Version: "v1", Resource: "openshiftapiservers",
+	}
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *OpenshiftAPIServerBuilder) validate() (bool, error) {
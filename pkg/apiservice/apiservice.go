@@ -0,0 +1,343 @@
+package apiservice
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common"
+	apiregistrationv1 "github.com/rh-ecosystem-edge/eco-goinfra/pkg/schemes/apiregistration/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
+	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// MetricsAPIServiceName is the name of the APIService serving the resource metrics API used by kubectl top and
+	// the HorizontalPodAutoscaler.
+	MetricsAPIServiceName = "v1beta1.metrics.k8s.io"
+	// PackageServerAPIServiceName is the name of the APIService serving the OLM PackageManifests.
+	PackageServerAPIServiceName = "v1.packages.operators.coreos.com"
+
+	// defaultGroupPriorityMinimum is the group priority set by NewBuilder, which is below every built-in group.
+	defaultGroupPriorityMinimum int32 = 1000
+	// defaultVersionPriority is the version priority set by NewBuilder.
+	defaultVersionPriority int32 = 15
+	// maxGroupPriorityMinimum is the highest group priority accepted by the API server.
+	maxGroupPriorityMinimum int32 = 20000
+	// maxVersionPriority is the highest version priority accepted by the API server.
+	maxVersionPriority int32 = 1000
+)
+
+// Builder provides a struct for the APIService resource containing a connection to the cluster and the APIService
+// definition. APIServices register aggregated APIs, such as the metrics and OLM packageserver APIs, with the
+// Kubernetes API server.
+type Builder struct {
+	common.EmbeddableBuilder[apiregistrationv1.APIService, *apiregistrationv1.APIService]
+	common.EmbeddableWithOptions[apiregistrationv1.APIService, Builder,
+		*apiregistrationv1.APIService, *Builder, AdditionalOptions]
+	common.EmbeddableCreator[apiregistrationv1.APIService, Builder, *apiregistrationv1.APIService, *Builder]
+	common.EmbeddableDeleter[apiregistrationv1.APIService, *apiregistrationv1.APIService]
+	common.EmbeddableUpdater[apiregistrationv1.APIService, Builder, *apiregistrationv1.APIService, *Builder]
+}
+
+// AdditionalOptions are optional mutations applied via WithOptions.
+type AdditionalOptions func(builder *Builder) (*Builder, error)
+
+// AttachMixins wires the embedded CRUD mixins to this builder instance.
+func (builder *Builder) AttachMixins() {
+	builder.EmbeddableWithOptions.SetBase(builder)
+	builder.EmbeddableCreator.SetBase(builder)
+	builder.EmbeddableDeleter.SetBase(builder)
+	builder.EmbeddableUpdater.SetBase(builder)
+}
+
+// GetGVK returns the APIService GVK for this builder.
+func (builder *Builder) GetGVK() schema.GroupVersionKind {
+	return apiregistrationv1.GroupVersion.WithKind("APIService")
+}
+
+// NewBuilder creates a new instance of Builder for the provided API group and version. The APIService is named
+// version.group, as required by the API server, and is served locally until WithService is used.
+func NewBuilder(apiClient *clients.Settings, group, version string) *Builder {
+	klog.V(100).Infof(
+		"Initializing new APIService structure with the following params: group: %s, version: %s", group, version)
+
+	builder := common.NewClusterScopedBuilder[apiregistrationv1.APIService, Builder](
+		apiClient, apiregistrationv1.AddToScheme, fmt.Sprintf("%s.%s", version, group))
+	if builder.GetError() != nil {
+		return builder
+	}
+
+	if group == "" {
+		builder.SetError(fmt.Errorf("apiService 'group' cannot be empty"))
+
+		return builder
+	}
+
+	if version == "" {
+		builder.SetError(fmt.Errorf("apiService 'version' cannot be empty"))
+
+		return builder
+	}
+
+	builder.Definition.Spec.Group = group
+	builder.Definition.Spec.Version = version
+	builder.Definition.Spec.GroupPriorityMinimum = defaultGroupPriorityMinimum
+	builder.Definition.Spec.VersionPriority = defaultVersionPriority
+
+	return builder
+}
+
+// Pull pulls an existing APIService, such as v1beta1.metrics.k8s.io, from the cluster.
+func Pull(apiClient *clients.Settings, name string) (*Builder, error) {
+	klog.V(100).Infof("Pulling existing APIService %s from cluster", name)
+
+	return common.PullClusterScopedBuilder[apiregistrationv1.APIService, Builder](
+		context.TODO(), apiClient, apiregistrationv1.AddToScheme, name)
+}
+
+// List returns the APIServices on the cluster.
+func List(apiClient *clients.Settings, options ...runtimeclient.ListOption) ([]*Builder, error) {
+	return common.List[apiregistrationv1.APIService, apiregistrationv1.APIServiceList, Builder](
+		context.TODO(), apiClient, apiregistrationv1.AddToScheme, options...)
+}
+
+// WithService sets the service the aggregated API is served by. Requests for the API are proxied by the API server
+// to the service on the provided port.
+func (builder *Builder) WithService(name, nsname string, port int32) *Builder {
+	if err := common.Validate(builder); err != nil {
+		return builder
+	}
+
+	klog.V(100).Infof("Setting service of APIService %s to %s/%s:%d", builder.Definition.Name, nsname, name, port)
+
+	if name == "" {
+		builder.SetError(fmt.Errorf("apiService service 'name' cannot be empty"))
+
+		return builder
+	}
+
+	if nsname == "" {
+		builder.SetError(fmt.Errorf("apiService service 'nsname' cannot be empty"))
+
+		return builder
+	}
+
+	if port < 1 || port > 65535 {
+		builder.SetError(fmt.Errorf("apiService service 'port' must be between 1 and 65535, got %d", port))
+
+		return builder
+	}
+
+	builder.Definition.Spec.Service = &apiregistrationv1.ServiceReference{
+		Name:      name,
+		Namespace: nsname,
+		Port:      ptr.To(port),
+	}
+
+	return builder
+}
+
+// WithPriority sets the priority of the API group and of the version within the group. Clients prefer groups and
+// versions with higher priorities.
+func (builder *Builder) WithPriority(groupPriorityMinimum, versionPriority int32) *Builder {
+	if err := common.Validate(builder); err != nil {
+		return builder
+	}
+
+	klog.V(100).Infof("Setting priority of APIService %s to group %d and version %d",
+		builder.Definition.Name, groupPriorityMinimum, versionPriority)
+
+	if groupPriorityMinimum < 1 || groupPriorityMinimum > maxGroupPriorityMinimum {
+		builder.SetError(fmt.Errorf("apiService 'groupPriorityMinimum' must be between 1 and %d, got %d",
+			maxGroupPriorityMinimum, groupPriorityMinimum))
+
+		return builder
+	}
+
+	if versionPriority < 1 || versionPriority > maxVersionPriority {
+		builder.SetError(fmt.Errorf("apiService 'versionPriority' must be between 1 and %d, got %d",
+			maxVersionPriority, versionPriority))
+
+		return builder
+	}
+
+	builder.Definition.Spec.GroupPriorityMinimum = groupPriorityMinimum
+	builder.Definition.Spec.VersionPriority = versionPriority
+
+	return builder
+}
+
+// WithCABundle sets the PEM encoded CA bundle used to verify the serving certificate of the aggregated API server.
+// It disables InsecureSkipTLSVerify since the two are mutually exclusive.
+func (builder *Builder) WithCABundle(caBundle []byte) *Builder {
+	if err := common.Validate(builder); err != nil {
+		return builder
+	}
+
+	klog.V(100).Infof("Setting CA bundle of APIService %s", builder.Definition.Name)
+
+	if len(caBundle) == 0 {
+		builder.SetError(fmt.Errorf("apiService 'caBundle' cannot be empty"))
+
+		return builder
+	}
+
+	builder.Definition.Spec.CABundle = caBundle
+	builder.Definition.Spec.InsecureSkipTLSVerify = false
+
+	return builder
+}
+
+// IsAvailable returns whether the APIService currently has the Available condition set to True. It returns false if
+// the APIService cannot be retrieved.
+func (builder *Builder) IsAvailable() bool {
+	condition, err := builder.GetAvailableCondition()
+	if err != nil {
+		klog.V(100).Infof("Failed to get Available condition of APIService: %v", err)
+
+		return false
+	}
+
+	return condition != nil && condition.Status == apiregistrationv1.ConditionTrue
+}
+
+// GetAvailableCondition refreshes the APIService and returns its Available condition. The condition is nil if the
+// API server has not reported it yet.
+func (builder *Builder) GetAvailableCondition() (*apiregistrationv1.APIServiceCondition, error) {
+	apiService, err := builder.refresh()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, condition := range apiService.Status.Conditions {
+		if condition.Type == apiregistrationv1.Available {
+			return &condition, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// WaitForAvailable waits up to timeout for the APIService to become Available. On timeout, the error includes the
+// reason and message of the last Available condition, which usually explains why the aggregated API is unreachable.
+func (builder *Builder) WaitForAvailable(timeout time.Duration) error {
+	if err := common.Validate(builder); err != nil {
+		return err
+	}
+
+	klog.V(100).Infof("Waiting up to %s for APIService %s to become available", timeout, builder.Definition.Name)
+
+	var lastCondition *apiregistrationv1.APIServiceCondition
+
+	err := wait.PollUntilContextTimeout(
+		context.TODO(), time.Second, timeout, true, func(ctx context.Context) (bool, error) {
+			condition, err := builder.GetAvailableCondition()
+			if err != nil {
+				klog.V(100).Infof("Failed to get APIService %s: %v", builder.Definition.Name, err)
+
+				return false, nil
+			}
+
+			lastCondition = condition
+
+			return condition != nil && condition.Status == apiregistrationv1.ConditionTrue, nil
+		})
+	if err != nil && lastCondition != nil {
+		return fmt.Errorf("apiService %s is not available: %s: %s: %w",
+			builder.Definition.Name, lastCondition.Reason, lastCondition.Message, err)
+	}
+
+	return err
+}
+
+// ListUnavailable returns the APIServices on the cluster that do not have the Available condition set to True.
+func ListUnavailable(apiClient *clients.Settings) ([]*Builder, error) {
+	apiServices, err := List(apiClient)
+	if err != nil {
+		return nil, err
+	}
+
+	var unavailable []*Builder
+
+	for _, apiService := range apiServices {
+		if !hasAvailableCondition(apiService.Object) {
+			unavailable = append(unavailable, apiService)
+		}
+	}
+
+	return unavailable, nil
+}
+
+// WaitForAllAvailable waits up to timeout for every APIService on the cluster to become Available, which gates suites
+// on flaky aggregated APIs before they run. On timeout, the error lists the APIServices that are still unavailable.
+func WaitForAllAvailable(apiClient *clients.Settings, timeout time.Duration) error {
+	klog.V(100).Infof("Waiting up to %s for all APIServices to become available", timeout)
+
+	var unavailableNames []string
+
+	err := wait.PollUntilContextTimeout(
+		context.TODO(), time.Second, timeout, true, func(ctx context.Context) (bool, error) {
+			unavailable, err := ListUnavailable(apiClient)
+			if err != nil {
+				klog.V(100).Infof("Failed to list APIServices: %v", err)
+
+				return false, nil
+			}
+
+			unavailableNames = nil
+
+			for _, apiService := range unavailable {
+				unavailableNames = append(unavailableNames, apiService.Definition.Name)
+			}
+
+			return len(unavailableNames) == 0, nil
+		})
+	if err != nil && len(unavailableNames) > 0 {
+		return fmt.Errorf("apiServices %v are not available: %w", unavailableNames, err)
+	}
+
+	return err
+}
+
+// refresh updates the Object of the builder from the cluster and returns it.
+func (builder *Builder) refresh() (*apiregistrationv1.APIService, error) {
+	if err := common.Validate(builder); err != nil {
+		return nil, err
+	}
+
+	apiService, err := builder.Get()
+	if err != nil {
+		return nil, err
+	}
+
+	builder.Object = apiService
+
+	return apiService, nil
+}
+
+// hasAvailableCondition returns whether the APIService has the Available condition set to True.
+func hasAvailableCondition(apiService *apiregistrationv1.APIService) bool {
+	if apiService == nil {
+		return false
+	}
+
+	for _, condition := range apiService.Status.Conditions {
+		if condition.Type == apiregistrationv1.Available {
+			return condition.Status == apiregistrationv1.ConditionTrue
+		}
+	}
+
+	return false
+}
+
+// GetGVR returns APIService's GroupVersionResource which could be used for Clean function.
+func GetGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group: "apiregistration.k8s.io", Version: "v1", Resource: "apiservices",
+	}
+}
@@ -0,0 +1,368 @@
+package apiservice
+
+import (
+	"testing"
+	"time"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common/testhelper"
+	apiregistrationv1 "github.com/rh-ecosystem-edge/eco-goinfra/pkg/schemes/apiregistration/v1"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+)
+
+const (
+	defaultAPIServiceGroup   = "metrics.k8s.io"
+	defaultAPIServiceVersion = "v1beta1"
+)
+
+var apiServiceGVK = apiregistrationv1.GroupVersion.WithKind("APIService")
+
+func TestNewBuilder(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		group         string
+		version       string
+		client        bool
+		expectedError string
+	}{
+		{
+			name:    "valid builder",
+			group:   defaultAPIServiceGroup,
+			version: defaultAPIServiceVersion,
+			client:  true,
+		},
+		{
+			name:          "empty group",
+			version:       defaultAPIServiceVersion,
+			client:        true,
+			expectedError: "apiService 'group' cannot be empty",
+		},
+		{
+			name:          "empty version",
+			group:         defaultAPIServiceGroup,
+			client:        true,
+			expectedError: "apiService 'version' cannot be empty",
+		},
+		{
+			name:          "nil client",
+			group:         defaultAPIServiceGroup,
+			version:       defaultAPIServiceVersion,
+			client:        false,
+			expectedError: "apiClient for APIService",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			var testSettings *clients.Settings
+
+			if testCase.client {
+				testSettings = clients.GetTestClients(clients.TestClientParams{})
+			}
+
+			testBuilder := NewBuilder(testSettings, testCase.group, testCase.version)
+
+			if testCase.expectedError != "" {
+				assert.ErrorContains(t, testBuilder.GetError(), testCase.expectedError)
+
+				return
+			}
+
+			assert.NoError(t, testBuilder.GetError())
+			assert.Equal(t, MetricsAPIServiceName, testBuilder.Definition.Name)
+			assert.Equal(t, defaultAPIServiceGroup, testBuilder.Definition.Spec.Group)
+			assert.Equal(t, defaultAPIServiceVersion, testBuilder.Definition.Spec.Version)
+			assert.Equal(t, defaultGroupPriorityMinimum, testBuilder.Definition.Spec.GroupPriorityMinimum)
+			assert.Equal(t, defaultVersionPriority, testBuilder.Definition.Spec.VersionPriority)
+			assert.Equal(t, apiServiceGVK, testBuilder.GetGVK())
+		})
+	}
+}
+
+func TestPull(t *testing.T) {
+	t.Parallel()
+
+	testhelper.NewClusterScopedPullTestConfig(Pull, apiregistrationv1.AddToScheme, apiServiceGVK).ExecuteTests(t)
+}
+
+func TestList(t *testing.T) {
+	t.Parallel()
+
+	testhelper.NewListTestConfig(List, apiregistrationv1.AddToScheme, apiServiceGVK).ExecuteTests(t)
+}
+
+func TestAPIServiceMethods(t *testing.T) {
+	t.Parallel()
+
+	commonTestConfig := testhelper.NewCommonTestConfig[apiregistrationv1.APIService, Builder](
+		apiregistrationv1.AddToScheme,
+		apiServiceGVK,
+		testhelper.ResourceScopeClusterScoped,
+	)
+
+	testhelper.NewTestSuite().
+		With(testhelper.NewGetTestConfig(commonTestConfig)).
+		With(testhelper.NewExistsTestConfig(commonTestConfig)).
+		With(testhelper.NewCreateTestConfig(commonTestConfig)).
+		With(testhelper.NewDeleterTestConfig(commonTestConfig)).
+		With(testhelper.NewUpdateTestConfig(commonTestConfig)).
+		Run(t)
+}
+
+func TestAPIServiceWithService(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		serviceName   string
+		nsname        string
+		port          int32
+		expectedError string
+	}{
+		{serviceName: "metrics-server", nsname: "kube-system", port: 443},
+		{nsname: "kube-system", port: 443, expectedError: "apiService service 'name' cannot be empty"},
+		{serviceName: "metrics-server", port: 443, expectedError: "apiService service 'nsname' cannot be empty"},
+		{
+			serviceName:   "metrics-server",
+			nsname:        "kube-system",
+			port:          0,
+			expectedError: "apiService service 'port' must be between 1 and 65535, got 0",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidTestBuilder(clients.GetTestClients(clients.TestClientParams{})).
+			WithService(testCase.serviceName, testCase.nsname, testCase.port)
+
+		if testCase.expectedError != "" {
+			assert.EqualError(t, testBuilder.GetError(), testCase.expectedError)
+
+			continue
+		}
+
+		assert.NoError(t, testBuilder.GetError())
+		assert.Equal(t, &apiregistrationv1.ServiceReference{
+			Name:      testCase.serviceName,
+			Namespace: testCase.nsname,
+			Port:      ptr.To(testCase.port),
+		}, testBuilder.Definition.Spec.Service)
+	}
+}
+
+func TestAPIServiceWithPriority(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		groupPriorityMinimum int32
+		versionPriority      int32
+		expectedError        string
+	}{
+		{groupPriorityMinimum: 100, versionPriority: 100},
+		{
+			groupPriorityMinimum: 0,
+			versionPriority:      100,
+			expectedError:        "apiService 'groupPriorityMinimum' must be between 1 and 20000, got 0",
+		},
+		{
+			groupPriorityMinimum: 100,
+			versionPriority:      1001,
+			expectedError:        "apiService 'versionPriority' must be between 1 and 1000, got 1001",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidTestBuilder(clients.GetTestClients(clients.TestClientParams{})).
+			WithPriority(testCase.groupPriorityMinimum, testCase.versionPriority)
+
+		if testCase.expectedError != "" {
+			assert.EqualError(t, testBuilder.GetError(), testCase.expectedError)
+
+			continue
+		}
+
+		assert.NoError(t, testBuilder.GetError())
+		assert.Equal(t, testCase.groupPriorityMinimum, testBuilder.Definition.Spec.GroupPriorityMinimum)
+		assert.Equal(t, testCase.versionPriority, testBuilder.Definition.Spec.VersionPriority)
+	}
+}
+
+func TestAPIServiceWithCABundle(t *testing.T) {
+	t.Parallel()
+
+	testBuilder := buildValidTestBuilder(clients.GetTestClients(clients.TestClientParams{}))
+	testBuilder.Definition.Spec.InsecureSkipTLSVerify = true
+
+	testBuilder = testBuilder.WithCABundle([]byte("ca"))
+	assert.NoError(t, testBuilder.GetError())
+	assert.Equal(t, []byte("ca"), testBuilder.Definition.Spec.CABundle)
+	assert.False(t, testBuilder.Definition.Spec.InsecureSkipTLSVerify)
+
+	testBuilder = buildValidTestBuilder(clients.GetTestClients(clients.TestClientParams{})).WithCABundle(nil)
+	assert.EqualError(t, testBuilder.GetError(), "apiService 'caBundle' cannot be empty")
+}
+
+func TestAPIServiceIsAvailable(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		conditions []apiregistrationv1.APIServiceCondition
+		exists     bool
+		expected   bool
+	}{
+		{conditions: buildAvailableConditions(apiregistrationv1.ConditionTrue), exists: true, expected: true},
+		{conditions: buildAvailableConditions(apiregistrationv1.ConditionFalse), exists: true, expected: false},
+		{conditions: nil, exists: true, expected: false},
+		{exists: false, expected: false},
+	}
+
+	for _, testCase := range testCases {
+		var runtimeObjects []runtime.Object
+
+		if testCase.exists {
+			runtimeObjects = append(runtimeObjects, buildDummyAPIService(MetricsAPIServiceName, testCase.conditions))
+		}
+
+		testBuilder := buildValidTestBuilder(buildTestClientWithObjects(runtimeObjects))
+
+		assert.Equal(t, testCase.expected, testBuilder.IsAvailable())
+	}
+}
+
+func TestAPIServiceWaitForAvailable(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		conditions    []apiregistrationv1.APIServiceCondition
+		exists        bool
+		expectedError string
+	}{
+		{
+			conditions: buildAvailableConditions(apiregistrationv1.ConditionTrue),
+			exists:     true,
+		},
+		{
+			conditions: buildAvailableConditions(apiregistrationv1.ConditionFalse),
+			exists:     true,
+			expectedError: "apiService v1beta1.metrics.k8s.io is not available: FailedDiscoveryCheck: " +
+				"failing or missing response: context deadline exceeded",
+		},
+		{
+			exists:        false,
+			expectedError: "context deadline exceeded",
+		},
+	}
+
+	for _, testCase := range testCases {
+		var runtimeObjects []runtime.Object
+
+		if testCase.exists {
+			runtimeObjects = append(runtimeObjects, buildDummyAPIService(MetricsAPIServiceName, testCase.conditions))
+		}
+
+		testBuilder := buildValidTestBuilder(buildTestClientWithObjects(runtimeObjects))
+
+		err := testBuilder.WaitForAvailable(time.Second)
+
+		if testCase.expectedError != "" {
+			assert.EqualError(t, err, testCase.expectedError)
+
+			continue
+		}
+
+		assert.NoError(t, err)
+	}
+}
+
+func TestListUnavailable(t *testing.T) {
+	t.Parallel()
+
+	testSettings := buildTestClientWithObjects([]runtime.Object{
+		buildDummyAPIService(MetricsAPIServiceName, buildAvailableConditions(apiregistrationv1.ConditionFalse)),
+		buildDummyAPIService(PackageServerAPIServiceName, buildAvailableConditions(apiregistrationv1.ConditionTrue)),
+		buildDummyAPIService("v1.apps", nil),
+	})
+
+	unavailable, err := ListUnavailable(testSettings)
+	assert.NoError(t, err)
+
+	var names []string
+
+	for _, apiService := range unavailable {
+		names = append(names, apiService.Definition.Name)
+	}
+
+	assert.ElementsMatch(t, []string{MetricsAPIServiceName, "v1.apps"}, names)
+
+	_, err = ListUnavailable(nil)
+	assert.Error(t, err)
+}
+
+func TestWaitForAllAvailable(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		objects       []runtime.Object
+		expectedError string
+	}{
+		{
+			objects: []runtime.Object{
+				buildDummyAPIService(PackageServerAPIServiceName, buildAvailableConditions(apiregistrationv1.ConditionTrue)),
+			},
+		},
+		{
+			objects: []runtime.Object{
+				buildDummyAPIService(MetricsAPIServiceName, buildAvailableConditions(apiregistrationv1.ConditionFalse)),
+				buildDummyAPIService(PackageServerAPIServiceName, buildAvailableConditions(apiregistrationv1.ConditionTrue)),
+			},
+			expectedError: "apiServices [v1beta1.metrics.k8s.io] are not available: context deadline exceeded",
+		},
+	}
+
+	for _, testCase := range testCases {
+		err := WaitForAllAvailable(buildTestClientWithObjects(testCase.objects), time.Second)
+
+		if testCase.expectedError != "" {
+			assert.EqualError(t, err, testCase.expectedError)
+
+			continue
+		}
+
+		assert.NoError(t, err)
+	}
+}
+
+func buildValidTestBuilder(apiClient *clients.Settings) *Builder {
+	return NewBuilder(apiClient, defaultAPIServiceGroup, defaultAPIServiceVersion)
+}
+
+func buildTestClientWithObjects(objects []runtime.Object) *clients.Settings {
+	return clients.GetTestClients(clients.TestClientParams{
+		K8sMockObjects:  objects,
+		SchemeAttachers: []clients.SchemeAttacher{apiregistrationv1.AddToScheme},
+	})
+}
+
+func buildDummyAPIService(
+	name string, conditions []apiregistrationv1.APIServiceCondition) *apiregistrationv1.APIService {
+	return &apiregistrationv1.APIService{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Status:     apiregistrationv1.APIServiceStatus{Conditions: conditions},
+	}
+}
+
+func buildAvailableConditions(status apiregistrationv1.ConditionStatus) []apiregistrationv1.APIServiceCondition {
+	condition := apiregistrationv1.APIServiceCondition{Type: apiregistrationv1.Available, Status: status}
+
+	if status != apiregistrationv1.ConditionTrue {
+		condition.Reason = "FailedDiscoveryCheck"
+		condition.Message = "failing or missing response"
+	}
+
+	return []apiregistrationv1.APIServiceCondition{condition}
+}
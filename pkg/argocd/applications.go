@@ -17,6 +17,7 @@ import (
 	argocdtypes "github.com/rh-ecosystem-edge/eco-goinfra/pkg/schemes/argocd/argocdtypes/v1alpha1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/klog/v2"
 	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
@@ -36,6 +37,8 @@ type ApplicationBuilder struct {
 
 // PullApplication pulls existing application into ApplicationBuilder struct.
 func PullApplication(apiClient *clients.Settings, name, nsname string) (*ApplicationBuilder, error) {
+	nsname = apiClient.ResolveNamespace(nsname)
+
 	klog.V(100).Infof("Pulling existing Application name %s under namespace %s from cluster", name, nsname)
 
 	if apiClient == nil {
@@ -455,6 +458,13 @@ func (builder *ApplicationBuilder) WaitForSourceUpdate(synced bool, timeout time
 		})
 }
 
+// GetApplicationGVR returns Application's GroupVersionResource which could be used for Clean function.
+func GetApplicationGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group: "argoproj.io", Version: "v1alpha1", Resource: "applications",
+	}
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *ApplicationBuilder) validate() (bool, error) {
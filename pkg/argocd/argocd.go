@@ -9,6 +9,7 @@ import (
 	argocdoperator "github.com/rh-ecosystem-edge/eco-goinfra/pkg/schemes/argocd/argocdoperator"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/klog/v2"
 	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -28,6 +29,8 @@ type Builder struct {
 
 // NewBuilder creates a new instance of Builder.
 func NewBuilder(apiClient *clients.Settings, name, nsname string) *Builder {
+	nsname = apiClient.ResolveNamespace(nsname)
+
 	klog.V(100).Infof("Initializing new ArgoCD structure with the following params: name: %s, nsname: %s", name, nsname)
 
 	if apiClient == nil {
@@ -75,6 +78,8 @@ func NewBuilder(apiClient *clients.Settings, name, nsname string) *Builder {
 
 // Pull pulls existing argocd from cluster.
 func Pull(apiClient *clients.Settings, name, nsname string) (*Builder, error) {
+	nsname = apiClient.ResolveNamespace(nsname)
+
 	klog.V(100).Infof("Pulling existing argocd name %s under namespace %s from cluster", name, nsname)
 
 	if apiClient == nil {
@@ -236,6 +241,13 @@ func (builder *Builder) Update(force bool) (*Builder, error) {
 	return builder, err
 }
 
+// GetArgoCDGVR returns ArgoCD's GroupVersionResource which could be used for Clean function.
+func GetArgoCDGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group: "argoproj.io", Version: "v1beta1", Resource: "argocds",
+	}
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *Builder) validate() (bool, error) {
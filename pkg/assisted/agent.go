@@ -12,6 +12,7 @@ import (
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/schemes/assisted/models"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/klog/v2"
 	goclient "sigs.k8s.io/controller-runtime/pkg/client"
@@ -54,6 +55,8 @@ func newAgentBuilder(apiClient goclient.Client, definition *agentInstallV1Beta1.
 
 // PullAgent pulls existing agent from cluster.
 func PullAgent(apiClient *clients.Settings, name, nsname string) (*agentBuilder, error) {
+	nsname = apiClient.ResolveNamespace(nsname)
+
 	klog.V(100).Infof("Pulling existing agent name %s under namespace %s from cluster", name, nsname)
 
 	if apiClient == nil {
@@ -352,6 +355,13 @@ func (builder *agentBuilder) Delete() error {
 	return nil
 }
 
+// GetAgentGVR returns Agent's GroupVersionResource which could be used for Clean function.
+func GetAgentGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group: "agent-install.openshift.io", Version: "v1beta1", Resource: "agents",
+	}
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *agentBuilder) validate() (bool, error) {
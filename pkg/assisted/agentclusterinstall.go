@@ -19,6 +19,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/klog/v2"
 	goclient "sigs.k8s.io/controller-runtime/pkg/client"
@@ -57,6 +58,8 @@ func NewAgentClusterInstallBuilder(
 	masterCount int,
 	workerCount int,
 	network hiveextV1Beta1.Networking) *AgentClusterInstallBuilder {
+	nsname = apiClient.ResolveNamespace(nsname)
+
 	if apiClient == nil {
 		klog.V(100).Info("The apiClient cannot be nil")
 
@@ -521,6 +524,8 @@ func (builder *AgentClusterInstallBuilder) Get() (*hiveextV1Beta1.AgentClusterIn
 
 // PullAgentClusterInstall pulls existing agentclusterinstall from cluster.
 func PullAgentClusterInstall(apiClient *clients.Settings, name, nsname string) (*AgentClusterInstallBuilder, error) {
+	nsname = apiClient.ResolveNamespace(nsname)
+
 	klog.V(100).Infof("Pulling existing agentclusterinstall name %s under namespace %s from cluster", name, nsname)
 
 	if apiClient == nil {
@@ -731,6 +736,13 @@ func (builder *AgentClusterInstallBuilder) getCondition(
 		builder.Definition.Name, builder.Definition.Namespace, conditionType)
 }
 
+// GetAgentClusterInstallGVR returns AgentClusterInstall's GroupVersionResource which could be used for Clean function.
+func GetAgentClusterInstallGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group: "extensions.hive.openshift.io", Version: "v1beta1", Resource: "agentclusterinstalls",
+	}
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *AgentClusterInstallBuilder) validate() (bool, error) {
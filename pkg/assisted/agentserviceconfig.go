@@ -15,6 +15,7 @@ import (
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/klog/v2"
 	goclient "sigs.k8s.io/controller-runtime/pkg/client"
@@ -506,6 +507,13 @@ func GetDefaultStorageSpec(defaultStorageSize string) (corev1.PersistentVolumeCl
 	return defaultSpec, nil
 }
 
+// GetAgentServiceConfigGVR returns AgentServiceConfig's GroupVersionResource which could be used for Clean function.
+func GetAgentServiceConfigGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group: "agent-install.openshift.io", Version: "v1beta1", Resource: "agentserviceconfigs",
+	}
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *AgentServiceConfigBuilder) validate() (bool, error) {
@@ -16,6 +16,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/klog/v2"
 
@@ -41,6 +42,8 @@ type InfraEnvAdditionalOptions func(builder *InfraEnvBuilder) (*InfraEnvBuilder,
 
 // NewInfraEnvBuilder creates a new instance of InfraEnvBuilder.
 func NewInfraEnvBuilder(apiClient *clients.Settings, name, nsname, psName string) *InfraEnvBuilder {
+	nsname = apiClient.ResolveNamespace(nsname)
+
 	klog.V(100).Infof(
 		"Initializing new infraenv structure with the following params: "+
 			"name: %s, namespace: %s, pull-secret: %s",
@@ -710,6 +713,8 @@ func (builder *InfraEnvBuilder) Get() (*agentInstallV1Beta1.InfraEnv, error) {
 
 // PullInfraEnvInstall pulls existing infraenv from cluster.
 func PullInfraEnvInstall(apiClient *clients.Settings, name, nsname string) (*InfraEnvBuilder, error) {
+	nsname = apiClient.ResolveNamespace(nsname)
+
 	klog.V(100).Infof("Pulling existing infraenv name %s under namespace %s from cluster", name, nsname)
 
 	if apiClient == nil {
@@ -884,6 +889,13 @@ func (builder *InfraEnvBuilder) Exists() bool {
 	return err == nil || !k8serrors.IsNotFound(err)
 }
 
+// GetInfraEnvGVR returns InfraEnv's GroupVersionResource which could be used for Clean function.
+func GetInfraEnvGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group: "agent-install.openshift.io", Version: "v1beta1", Resource: "infraenvs",
+	}
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *InfraEnvBuilder) validate() (bool, error) {
@@ -12,6 +12,7 @@ import (
 
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 )
 
 // NmStateConfigBuilder provides struct for the NMStateConfig object containing connection to
@@ -29,6 +30,8 @@ type NmStateConfigBuilder struct {
 
 // NewNmStateConfigBuilder creates a new instance of NMStateConfig Builder.
 func NewNmStateConfigBuilder(apiClient *clients.Settings, name, namespace string) *NmStateConfigBuilder {
+	namespace = apiClient.ResolveNamespace(namespace)
+
 	klog.V(100).Infof("Initializing new nmstateconfig structure with the name: %s in namespace: %s", name, namespace)
 
 	if apiClient == nil {
@@ -216,6 +219,13 @@ func ListNmStateConfigs(apiClient *clients.Settings, namespace string) ([]*NmSta
 	return nmstateConfigObjects, err
 }
 
+// GetNMStateConfigGVR returns NMStateConfig's GroupVersionResource which could be used for Clean function.
+func GetNMStateConfigGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group: "agent-install.openshift.io", Version: "v1beta1", Resource: "nmstateconfigs",
+	}
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *NmStateConfigBuilder) validate() (bool, error) {
@@ -0,0 +1,372 @@
+// Package baseline runs short standardized probes against a cluster and returns a structured report of the results.
+// Running it before a performance test quantifies the environmental noise of the cluster, such as a slow API server,
+// slow etcd disks or a slow image registry, so that results from different runs or clusters can be compared.
+package baseline
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/events"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/kepler"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/pod"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/klog/v2"
+)
+
+const (
+	// DefaultAPIRequests is the number of requests sent to the API server when Options.APIRequests is not set.
+	DefaultAPIRequests = 20
+	// DefaultEtcdFsyncWindow is the window the etcd fsync latency is computed over when Options.EtcdFsyncWindow is
+	// not set.
+	DefaultEtcdFsyncWindow = 5 * time.Minute
+	// DefaultPodStartTimeout is how long the probe pod may take to start when Options.PodStartTimeout is not set.
+	DefaultPodStartTimeout = 5 * time.Minute
+
+	// probePodName is the name of the pod created by MeasurePodStart.
+	probePodName = "baseline-pod-start-probe"
+	// etcdFsyncMetric is the histogram of the latency of the fsync calls made by etcd to persist its write-ahead log.
+	etcdFsyncMetric = "etcd_disk_wal_fsync_duration_seconds_bucket"
+)
+
+// pulledImageRegex matches the message of the Pulled event the kubelet emits after pulling an image, such as
+// Successfully pulled image "quay.io/example/image:latest" in 1.234s (1.234s including waiting). The submatch is the
+// pull duration.
+var pulledImageRegex = regexp.MustCompile(`Successfully pulled image "[^"]*" in ((?:[0-9.]+(?:ns|us|µs|ms|s|m|h))+)`)
+
+// Options configures the probes run by Run. Namespace and Image are required, the other fields have defaults.
+type Options struct {
+	// Namespace is the existing namespace the probe pod is created in.
+	Namespace string
+	// Image is the image of the probe pod. It is always pulled so that the image pull time can be measured.
+	Image string
+	// APIRequests is the number of requests sent to the API server to measure its latency.
+	APIRequests int
+	// EtcdFsyncWindow is the window the etcd fsync latency is computed over. It must be at least one minute.
+	EtcdFsyncWindow time.Duration
+	// PodStartTimeout is how long the probe pod may take to start.
+	PodStartTimeout time.Duration
+	// Querier runs the Prometheus queries. It defaults to querying the Prometheus pod of the cluster monitoring stack.
+	Querier kepler.Querier
+}
+
+// LatencyStats summarizes a set of latency samples.
+type LatencyStats struct {
+	// Samples is the number of samples the statistics are computed from.
+	Samples int
+	// Min is the lowest latency.
+	Min time.Duration
+	// Mean is the average latency.
+	Mean time.Duration
+	// P50 is the median latency.
+	P50 time.Duration
+	// P99 is the 99th percentile latency.
+	P99 time.Duration
+	// Max is the highest latency.
+	Max time.Duration
+}
+
+// PodStartResult contains the results of starting the probe pod.
+type PodStartResult struct {
+	// NodeName is the node the probe pod was scheduled on.
+	NodeName string
+	// StartLatency is the time from creating the pod until it is running, which includes pulling its image.
+	StartLatency time.Duration
+	// ImagePullTime is the time the kubelet reported for pulling the image. It is zero if ImageCached is true.
+	ImagePullTime time.Duration
+	// ImageCached is whether the kubelet used an image already present on the node instead of pulling it.
+	ImageCached bool
+}
+
+// Report contains the results of the probes run by Run. Fields of probes that failed are left empty.
+type Report struct {
+	// StartTime is when the probes started.
+	StartTime time.Time
+	// Duration is how long running all the probes took.
+	Duration time.Duration
+	// APILatency is the latency of listing namespaces from the API server.
+	APILatency *LatencyStats
+	// EtcdFsyncP99 is the 99th percentile latency of the etcd write-ahead log fsync, keyed by etcd member instance.
+	EtcdFsyncP99 map[string]time.Duration
+	// PodStart contains the pod start latency and image pull time.
+	PodStart *PodStartResult
+}
+
+// Run runs all the probes and returns their results. A failed probe does not stop the others: the report contains the
+// results of the probes that succeeded and the returned error joins the errors of those that failed.
+func Run(apiClient *clients.Settings, options Options) (*Report, error) {
+	if apiClient == nil {
+		klog.V(100).Info("The apiClient of the baseline is nil")
+
+		return nil, fmt.Errorf("baseline 'apiClient' cannot be nil")
+	}
+
+	if options.Namespace == "" {
+		klog.V(100).Info("The namespace of the baseline is empty")
+
+		return nil, fmt.Errorf("baseline 'namespace' cannot be empty")
+	}
+
+	if options.Image == "" {
+		klog.V(100).Info("The image of the baseline is empty")
+
+		return nil, fmt.Errorf("baseline 'image' cannot be empty")
+	}
+
+	options = withDefaults(apiClient, options)
+
+	klog.V(100).Infof("Running baseline probes in namespace %s with image %s", options.Namespace, options.Image)
+
+	report := &Report{StartTime: time.Now()}
+
+	var errs []error
+
+	apiLatency, err := MeasureAPILatency(apiClient, options.APIRequests)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("api latency probe failed: %w", err))
+	}
+
+	report.APILatency = apiLatency
+
+	etcdFsync, err := MeasureEtcdFsync(options.Querier, options.EtcdFsyncWindow)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("etcd fsync probe failed: %w", err))
+	}
+
+	report.EtcdFsyncP99 = etcdFsync
+
+	podStart, err := MeasurePodStart(apiClient, options.Namespace, options.Image, options.PodStartTimeout)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("pod start probe failed: %w", err))
+	}
+
+	report.PodStart = podStart
+	report.Duration = time.Since(report.StartTime)
+
+	return report, errors.Join(errs...)
+}
+
+// MeasureAPILatency sends requests sequential requests listing a single namespace to the API server and returns the
+// statistics of their latency. Listing is served from etcd, so the latency includes the etcd read.
+func MeasureAPILatency(apiClient *clients.Settings, requests int) (*LatencyStats, error) {
+	if apiClient == nil {
+		klog.V(100).Info("The apiClient of the baseline is nil")
+
+		return nil, fmt.Errorf("baseline 'apiClient' cannot be nil")
+	}
+
+	if requests < 1 {
+		klog.V(100).Infof("The number of API requests %d is less than 1", requests)
+
+		return nil, fmt.Errorf("baseline 'requests' must be at least 1, got %d", requests)
+	}
+
+	klog.V(100).Infof("Measuring API server latency with %d requests", requests)
+
+	samples := make([]time.Duration, 0, requests)
+
+	for range requests {
+		start := time.Now()
+
+		_, err := apiClient.Namespaces().List(context.TODO(), metav1.ListOptions{Limit: 1})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list namespaces: %w", err)
+		}
+
+		samples = append(samples, time.Since(start))
+	}
+
+	return computeLatencyStats(samples), nil
+}
+
+// MeasureEtcdFsync returns the 99th percentile latency of the etcd write-ahead log fsync over window, keyed by etcd
+// member instance. Values above 10ms are usually a sign of disks too slow for etcd.
+func MeasureEtcdFsync(querier kepler.Querier, window time.Duration) (map[string]time.Duration, error) {
+	if querier == nil {
+		klog.V(100).Info("The querier of the baseline is nil")
+
+		return nil, fmt.Errorf("baseline 'querier' cannot be nil")
+	}
+
+	if window < time.Minute {
+		klog.V(100).Infof("The etcd fsync window %s is less than one minute", window)
+
+		return nil, fmt.Errorf("baseline etcd fsync 'window' must be at least one minute, got %s", window)
+	}
+
+	klog.V(100).Infof("Measuring etcd fsync latency over %s", window)
+
+	samples, err := querier.Query(fmt.Sprintf(`histogram_quantile(0.99, sum by (instance, le) (rate(%s[%ds])))`,
+		etcdFsyncMetric, int64(window/time.Second)))
+	if err != nil {
+		return nil, err
+	}
+
+	fsyncLatencies := make(map[string]time.Duration)
+
+	for _, sample := range samples {
+		// Members without fsyncs in the window have no rate, which histogram_quantile returns as NaN.
+		if math.IsNaN(sample.Value) {
+			continue
+		}
+
+		fsyncLatencies[sample.Labels["instance"]] = time.Duration(sample.Value * float64(time.Second))
+	}
+
+	if len(fsyncLatencies) == 0 {
+		return nil, fmt.Errorf("no etcd fsync samples found in the last %s", window)
+	}
+
+	return fsyncLatencies, nil
+}
+
+// MeasurePodStart creates a pod in nsname that always pulls image, waits up to timeout for it to be running and
+// returns how long it took along with the image pull time reported by the kubelet. The pod is deleted afterwards.
+func MeasurePodStart(
+	apiClient *clients.Settings, nsname, image string, timeout time.Duration) (*PodStartResult, error) {
+	probePod := pod.NewBuilder(apiClient, probePodName, nsname, image)
+	if err := probePod.GetError(); err != nil {
+		return nil, err
+	}
+
+	klog.V(100).Infof("Measuring start latency of pod %s in namespace %s with image %s", probePodName, nsname, image)
+
+	probePod.Definition.Spec.Containers[0].ImagePullPolicy = corev1.PullAlways
+	probePod.Definition.Spec.TerminationGracePeriodSeconds = new(int64)
+
+	start := time.Now()
+
+	probePod, err := probePod.CreateAndWaitUntilRunning(timeout)
+	if err != nil {
+		return nil, errors.Join(fmt.Errorf("pod %s did not start: %w", probePodName, err), deleteProbePod(probePod))
+	}
+
+	result := &PodStartResult{StartLatency: time.Since(start)}
+
+	if probePod.Object == nil {
+		return result, errors.Join(
+			fmt.Errorf("pod %s has no status after starting", probePodName), deleteProbePod(probePod))
+	}
+
+	result.NodeName = probePod.Object.Spec.NodeName
+	result.ImagePullTime, result.ImageCached, err = getImagePullTime(apiClient, nsname, probePod.Object.UID)
+
+	return result, errors.Join(err, deleteProbePod(probePod))
+}
+
+// getImagePullTime returns the image pull time reported by the kubelet in the Pulled event of the pod with the given
+// UID, and whether the image was already present on the node.
+func getImagePullTime(apiClient *clients.Settings, nsname string, podUID types.UID) (time.Duration, bool, error) {
+	podEvents, err := events.List(apiClient, nsname, metav1.ListOptions{
+		FieldSelector: fields.SelectorFromSet(fields.Set{
+			"involvedObject.name": probePodName,
+			"involvedObject.uid":  string(podUID),
+			"reason":              "Pulled",
+		}).String(),
+	})
+	if err != nil {
+		return 0, false, fmt.Errorf("failed to list events of pod %s: %w", probePodName, err)
+	}
+
+	for _, event := range podEvents {
+		pullTime, cached, err := parsePulledMessage(event.Object.Message)
+		if err == nil {
+			return pullTime, cached, nil
+		}
+	}
+
+	return 0, false, fmt.Errorf("no Pulled event found for pod %s", probePodName)
+}
+
+// parsePulledMessage returns the pull duration in the message of a Pulled event and whether the event reports that the
+// image was already present on the node instead.
+func parsePulledMessage(message string) (time.Duration, bool, error) {
+	if strings.Contains(message, "already present on machine") {
+		return 0, true, nil
+	}
+
+	submatches := pulledImageRegex.FindStringSubmatch(message)
+	if submatches == nil {
+		return 0, false, fmt.Errorf("no image pull time found in message %q", message)
+	}
+
+	pullTime, err := time.ParseDuration(submatches[1])
+	if err != nil {
+		return 0, false, fmt.Errorf("failed to parse image pull time %q: %w", submatches[1], err)
+	}
+
+	return pullTime, false, nil
+}
+
+// deleteProbePod deletes the probe pod without waiting for its containers to stop.
+func deleteProbePod(probePod *pod.Builder) error {
+	if probePod == nil {
+		return nil
+	}
+
+	_, err := probePod.DeleteImmediate()
+	if err != nil {
+		return fmt.Errorf("failed to delete pod %s: %w", probePodName, err)
+	}
+
+	return nil
+}
+
+// computeLatencyStats returns the statistics of the samples, which must not be empty.
+func computeLatencyStats(samples []time.Duration) *LatencyStats {
+	sorted := slices.Clone(samples)
+	slices.Sort(sorted)
+
+	var total time.Duration
+
+	for _, sample := range sorted {
+		total += sample
+	}
+
+	return &LatencyStats{
+		Samples: len(sorted),
+		Min:     sorted[0],
+		Mean:    total / time.Duration(len(sorted)),
+		P50:     percentile(sorted, 50),
+		P99:     percentile(sorted, 99),
+		Max:     sorted[len(sorted)-1],
+	}
+}
+
+// percentile returns the nearest-rank percentile of the sorted samples.
+func percentile(sorted []time.Duration, percent int) time.Duration {
+	rank := (percent*len(sorted) + 99) / 100
+
+	return sorted[max(rank, 1)-1]
+}
+
+// withDefaults returns the options with the unset fields replaced by their defaults.
+func withDefaults(apiClient *clients.Settings, options Options) Options {
+	if options.APIRequests == 0 {
+		options.APIRequests = DefaultAPIRequests
+	}
+
+	if options.EtcdFsyncWindow == 0 {
+		options.EtcdFsyncWindow = DefaultEtcdFsyncWindow
+	}
+
+	if options.PodStartTimeout == 0 {
+		options.PodStartTimeout = DefaultPodStartTimeout
+	}
+
+	if options.Querier == nil {
+		options.Querier = kepler.NewPrometheusPodQuerier(apiClient)
+	}
+
+	return options
+}
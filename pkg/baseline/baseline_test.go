@@ -0,0 +1,238 @@
+package baseline
+
+import (
+	"fmt"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/kepler"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// fakeQuerier records the queries it receives and returns the configured samples or error.
+type fakeQuerier struct {
+	queries []string
+	samples []kepler.Sample
+	err     error
+}
+
+// Query records the query and returns the configured samples or error.
+func (querier *fakeQuerier) Query(query string) ([]kepler.Sample, error) {
+	querier.queries = append(querier.queries, query)
+
+	return querier.samples, querier.err
+}
+
+func TestRunValidation(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		apiClient     *clients.Settings
+		options       Options
+		expectedError string
+	}{
+		{
+			options:       Options{Namespace: "test-namespace", Image: "test-image"},
+			expectedError: "baseline 'apiClient' cannot be nil",
+		},
+		{
+			apiClient:     buildTestClients(),
+			options:       Options{Image: "test-image"},
+			expectedError: "baseline 'namespace' cannot be empty",
+		},
+		{
+			apiClient:     buildTestClients(),
+			options:       Options{Namespace: "test-namespace"},
+			expectedError: "baseline 'image' cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		report, err := Run(testCase.apiClient, testCase.options)
+		assert.Nil(t, report)
+		assert.EqualError(t, err, testCase.expectedError)
+	}
+}
+
+func TestRunPartialReport(t *testing.T) {
+	t.Parallel()
+
+	querier := &fakeQuerier{err: fmt.Errorf("prometheus unavailable")}
+
+	// The fake client never runs the pod, so the pod start probe times out while the API latency probe succeeds.
+	report, err := Run(buildTestClients(), Options{
+		Namespace:       "test-namespace",
+		Image:           "test-image",
+		APIRequests:     3,
+		PodStartTimeout: time.Second,
+		Querier:         querier,
+	})
+	assert.ErrorContains(t, err, "etcd fsync probe failed: prometheus unavailable")
+	assert.ErrorContains(t, err, "pod start probe failed: pod baseline-pod-start-probe did not start")
+	assert.NotNil(t, report)
+	assert.Equal(t, 3, report.APILatency.Samples)
+	assert.Nil(t, report.EtcdFsyncP99)
+	assert.Nil(t, report.PodStart)
+	assert.Equal(t,
+		[]string{`histogram_quantile(0.99, sum by (instance, le) ` +
+			`(rate(etcd_disk_wal_fsync_duration_seconds_bucket[300s])))`},
+		querier.queries)
+}
+
+func TestMeasureAPILatency(t *testing.T) {
+	t.Parallel()
+
+	stats, err := MeasureAPILatency(buildTestClients(), 5)
+	assert.NoError(t, err)
+	assert.Equal(t, 5, stats.Samples)
+	assert.LessOrEqual(t, stats.Min, stats.P50)
+	assert.LessOrEqual(t, stats.P50, stats.Max)
+
+	_, err = MeasureAPILatency(nil, 5)
+	assert.EqualError(t, err, "baseline 'apiClient' cannot be nil")
+
+	_, err = MeasureAPILatency(buildTestClients(), 0)
+	assert.EqualError(t, err, "baseline 'requests' must be at least 1, got 0")
+}
+
+func TestMeasureEtcdFsync(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		querier       kepler.Querier
+		window        time.Duration
+		expected      map[string]time.Duration
+		expectedError string
+	}{
+		{
+			querier: &fakeQuerier{samples: []kepler.Sample{
+				{Labels: map[string]string{"instance": "master-0"}, Value: 0.004},
+				{Labels: map[string]string{"instance": "master-1"}, Value: math.NaN()},
+				{Labels: map[string]string{"instance": "master-2"}, Value: 0.012},
+			}},
+			window: time.Minute,
+			expected: map[string]time.Duration{
+				"master-0": 4 * time.Millisecond,
+				"master-2": 12 * time.Millisecond,
+			},
+		},
+		{
+			querier:       &fakeQuerier{samples: []kepler.Sample{{Value: math.NaN()}}},
+			window:        time.Minute,
+			expectedError: "no etcd fsync samples found in the last 1m0s",
+		},
+		{
+			querier:       &fakeQuerier{err: fmt.Errorf("prometheus unavailable")},
+			window:        time.Minute,
+			expectedError: "prometheus unavailable",
+		},
+		{
+			querier:       &fakeQuerier{},
+			window:        30 * time.Second,
+			expectedError: "baseline etcd fsync 'window' must be at least one minute, got 30s",
+		},
+		{
+			window:        time.Minute,
+			expectedError: "baseline 'querier' cannot be nil",
+		},
+	}
+
+	for _, testCase := range testCases {
+		fsyncLatencies, err := MeasureEtcdFsync(testCase.querier, testCase.window)
+		if testCase.expectedError != "" {
+			assert.EqualError(t, err, testCase.expectedError)
+
+			continue
+		}
+
+		assert.NoError(t, err)
+		assert.Equal(t, testCase.expected, fsyncLatencies)
+	}
+}
+
+func TestParsePulledMessage(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		message          string
+		expectedPullTime time.Duration
+		expectedCached   bool
+		expectedError    string
+	}{
+		{
+			message: `Successfully pulled image "quay.io/example/image:latest" in 1.234s ` +
+				`(1.234s including waiting). Image size: 1000 bytes.`,
+			expectedPullTime: 1234 * time.Millisecond,
+		},
+		{
+			message:          `Successfully pulled image "quay.io/example/image:latest" in 1m2.5s (1m2.5s including waiting)`,
+			expectedPullTime: time.Minute + 2500*time.Millisecond,
+		},
+		{
+			message:          `Successfully pulled image "quay.io/example/image:latest" in 850ms`,
+			expectedPullTime: 850 * time.Millisecond,
+		},
+		{
+			message:        `Container image "quay.io/example/image:latest" already present on machine`,
+			expectedCached: true,
+		},
+		{
+			message:       `Pulling image "quay.io/example/image:latest"`,
+			expectedError: `no image pull time found in message "Pulling image \"quay.io/example/image:latest\""`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		pullTime, cached, err := parsePulledMessage(testCase.message)
+		if testCase.expectedError != "" {
+			assert.EqualError(t, err, testCase.expectedError)
+
+			continue
+		}
+
+		assert.NoError(t, err)
+		assert.Equal(t, testCase.expectedPullTime, pullTime)
+		assert.Equal(t, testCase.expectedCached, cached)
+	}
+}
+
+func TestComputeLatencyStats(t *testing.T) {
+	t.Parallel()
+
+	var samples []time.Duration
+
+	for index := 100; index >= 1; index-- {
+		samples = append(samples, time.Duration(index)*time.Millisecond)
+	}
+
+	assert.Equal(t, &LatencyStats{
+		Samples: 100,
+		Min:     time.Millisecond,
+		Mean:    50500 * time.Microsecond,
+		P50:     50 * time.Millisecond,
+		P99:     99 * time.Millisecond,
+		Max:     100 * time.Millisecond,
+	}, computeLatencyStats(samples))
+
+	assert.Equal(t, &LatencyStats{
+		Samples: 1,
+		Min:     time.Second,
+		Mean:    time.Second,
+		P50:     time.Second,
+		P99:     time.Second,
+		Max:     time.Second,
+	}, computeLatencyStats([]time.Duration{time.Second}))
+}
+
+func buildTestClients() *clients.Settings {
+	return clients.GetTestClients(clients.TestClientParams{
+		K8sMockObjects: []runtime.Object{
+			&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "test-namespace"}},
+		},
+	})
+}
@@ -855,6 +855,109 @@ func (bmc *BMC) BootFromCD(isoURL, virtualMediaID string) error {
 	return err
 }
 
+// SetBootOverride overrides the device the system boots from, such as redfish.PxeBootSourceOverrideTarget or
+// redfish.CdBootSourceOverrideTarget. The override applies only to the next boot unless persistent is true, in which
+// case it applies to every boot until cleared using ClearBootOverride. The system must be reset for it to take effect.
+func (bmc *BMC) SetBootOverride(target redfish.BootSourceOverrideTarget, persistent bool) error {
+	if valid, err := bmc.validateRedfish(); !valid {
+		return err
+	}
+
+	klog.V(100).Infof("Setting boot override to %s (persistent: %t) from redfish endpoint", target, persistent)
+
+	if target == "" || target == redfish.NoneBootSourceOverrideTarget {
+		klog.V(100).Info("target param cannot be empty or None")
+
+		return fmt.Errorf("target param cannot be empty or None")
+	}
+
+	overrideEnabled := redfish.OnceBootSourceOverrideEnabled
+	if persistent {
+		overrideEnabled = redfish.ContinuousBootSourceOverrideEnabled
+	}
+
+	return bmc.setBoot(redfish.Boot{
+		BootSourceOverrideEnabled: overrideEnabled,
+		BootSourceOverrideTarget:  target,
+	})
+}
+
+// ClearBootOverride disables any boot override of the system so that it boots following its boot order again.
+func (bmc *BMC) ClearBootOverride() error {
+	if valid, err := bmc.validateRedfish(); !valid {
+		return err
+	}
+
+	klog.V(100).Info("Clearing boot override from redfish endpoint")
+
+	return bmc.setBoot(redfish.Boot{
+		BootSourceOverrideEnabled: redfish.DisabledBootSourceOverrideEnabled,
+		BootSourceOverrideTarget:  redfish.NoneBootSourceOverrideTarget,
+	})
+}
+
+// InsertVirtualMedia inserts the image available at imageURL in the virtual media with virtualMediaID. Unlike
+// BootFromCD, the boot override of the system is left unchanged.
+func (bmc *BMC) InsertVirtualMedia(imageURL, virtualMediaID string) error {
+	if valid, err := bmc.validateRedfish(); !valid {
+		return err
+	}
+
+	klog.V(100).Infof("Inserting image %s in virtual media %s from redfish endpoint", imageURL, virtualMediaID)
+
+	if imageURL == "" {
+		klog.V(100).Info("imageURL param cannot be empty")
+
+		return fmt.Errorf("imageURL param cannot be empty")
+	}
+
+	return bmc.withVirtualMedia(virtualMediaID, func(virtualMedia *redfish.VirtualMedia) error {
+		return virtualMedia.InsertMedia(imageURL, true, true)
+	})
+}
+
+// EjectVirtualMedia ejects the image inserted in the virtual media with virtualMediaID. Ejecting a virtual media with
+// no image inserted is a no-op.
+func (bmc *BMC) EjectVirtualMedia(virtualMediaID string) error {
+	if valid, err := bmc.validateRedfish(); !valid {
+		return err
+	}
+
+	klog.V(100).Infof("Ejecting virtual media %s from redfish endpoint", virtualMediaID)
+
+	return bmc.withVirtualMedia(virtualMediaID, func(virtualMedia *redfish.VirtualMedia) error {
+		if !virtualMedia.Inserted {
+			klog.V(100).Infof("Virtual media %s has no image inserted", virtualMediaID)
+
+			return nil
+		}
+
+		return virtualMedia.EjectMedia()
+	})
+}
+
+// VirtualMediaImage returns the image inserted in the virtual media with virtualMediaID. It returns an empty string if
+// no image is inserted.
+func (bmc *BMC) VirtualMediaImage(virtualMediaID string) (string, error) {
+	if valid, err := bmc.validateRedfish(); !valid {
+		return "", err
+	}
+
+	klog.V(100).Infof("Getting image of virtual media %s from redfish endpoint", virtualMediaID)
+
+	var image string
+
+	err := bmc.withVirtualMedia(virtualMediaID, func(virtualMedia *redfish.VirtualMedia) error {
+		if virtualMedia.Inserted {
+			image = virtualMedia.Image
+		}
+
+		return nil
+	})
+
+	return image, err
+}
+
 // RunCLICommand runs a CLI command in the BMC's console over SSH. This method will block until the command has
 // finished, and its output is copied to stdout and/or stderr if applicable. If combineOutput is true, stderr content is
 // merged in stdout. The timeout param is used to avoid the caller to be stuck forever in case something goes wrong or
@@ -1128,6 +1231,84 @@ func redfishGetPowerControl(
 	return nil, fmt.Errorf("failed to get power control: no chassis with power link found")
 }
 
+// setBoot connects to the redfish API and sets the boot properties of the system.
+func (bmc *BMC) setBoot(boot redfish.Boot) error {
+	redfishClient, cancel, err := redfishConnect(
+		bmc.host,
+		bmc.redfishUser.Name,
+		bmc.redfishUser.Password,
+		bmc.timeOuts.Redfish)
+	if err != nil {
+		klog.V(100).Infof("Redfish connection error: %v", err)
+
+		return fmt.Errorf("redfish connection error: %w", err)
+	}
+
+	defer func() {
+		redfishClient.Logout()
+		cancel()
+	}()
+
+	system, err := redfishGetSystem(redfishClient, bmc.systemIndex)
+	if err != nil {
+		klog.V(100).Infof("Failed to get redfish system: %v", err)
+
+		return fmt.Errorf("failed to get redfish system: %w", err)
+	}
+
+	klog.V(100).Infof("Setting new Boot value: %+v", boot)
+
+	return system.SetBoot(boot)
+}
+
+// withVirtualMedia connects to the redfish API and calls mediaFunc with the virtual media with virtualMediaID of the
+// system.
+func (bmc *BMC) withVirtualMedia(virtualMediaID string, mediaFunc func(*redfish.VirtualMedia) error) error {
+	if virtualMediaID == "" {
+		klog.V(100).Info("virtualMediaID param cannot be empty")
+
+		return fmt.Errorf("virtualMediaID param cannot be empty")
+	}
+
+	redfishClient, cancel, err := redfishConnect(
+		bmc.host,
+		bmc.redfishUser.Name,
+		bmc.redfishUser.Password,
+		bmc.timeOuts.Redfish)
+	if err != nil {
+		klog.V(100).Infof("Redfish connection error: %v", err)
+
+		return fmt.Errorf("redfish connection error: %w", err)
+	}
+
+	defer func() {
+		redfishClient.Logout()
+		cancel()
+	}()
+
+	system, err := redfishGetSystem(redfishClient, bmc.systemIndex)
+	if err != nil {
+		klog.V(100).Infof("Failed to get redfish system: %v", err)
+
+		return fmt.Errorf("failed to get redfish system: %w", err)
+	}
+
+	virtualMedia, err := system.VirtualMedia()
+	if err != nil {
+		klog.V(100).Infof("Failed to retrieve virtual media: %v", err)
+
+		return fmt.Errorf("failed to retrieve virtual media: %w", err)
+	}
+
+	for _, media := range virtualMedia {
+		if media.ID == virtualMediaID {
+			return mediaFunc(media)
+		}
+	}
+
+	return fmt.Errorf("virtual media %s not found", virtualMediaID)
+}
+
 // validateRedfish performs the same validations as in validate but also checks for a valid redfish user.
 func (bmc *BMC) validateRedfish() (bool, error) {
 	if valid, err := bmc.validate(); !valid {
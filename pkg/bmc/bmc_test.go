@@ -795,6 +795,154 @@ func TestBMCBootFromCD(t *testing.T) {
 	}
 }
 
+func TestBMCSetBootOverride(t *testing.T) {
+	// Create fake redfish endpoint.
+	redfishServer := createFakeRedfishLocalServer(false, redfishAPIResponseCallbacks{})
+	defer redfishServer.Close()
+
+	host := strings.Split(redfishServer.URL, "//")[1]
+
+	testCases := []struct {
+		target        redfish.BootSourceOverrideTarget
+		persistent    bool
+		expectedError error
+	}{
+		{
+			target:        redfish.PxeBootSourceOverrideTarget,
+			persistent:    false,
+			expectedError: nil,
+		},
+		{
+			target:        redfish.HddBootSourceOverrideTarget,
+			persistent:    true,
+			expectedError: nil,
+		},
+		{
+			target:        "",
+			expectedError: fmt.Errorf("target param cannot be empty or None"),
+		},
+		{
+			target:        redfish.NoneBootSourceOverrideTarget,
+			expectedError: fmt.Errorf("target param cannot be empty or None"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		bmc := New(host).WithRedfishUser(defaultUsername, defaultPassword)
+		err := bmc.SetBootOverride(testCase.target, testCase.persistent)
+
+		assert.Equal(t, testCase.expectedError, err)
+	}
+}
+
+func TestBMCClearBootOverride(t *testing.T) {
+	// Create fake redfish endpoint.
+	redfishServer := createFakeRedfishLocalServer(false, redfishAPIResponseCallbacks{})
+	defer redfishServer.Close()
+
+	host := strings.Split(redfishServer.URL, "//")[1]
+
+	bmc := New(host).WithRedfishUser(defaultUsername, defaultPassword)
+	err := bmc.ClearBootOverride()
+	assert.Nil(t, err)
+}
+
+func TestBMCInsertVirtualMedia(t *testing.T) {
+	// Create fake redfish endpoint.
+	redfishServer := createFakeRedfishLocalServer(false, redfishAPIResponseCallbacks{})
+	defer redfishServer.Close()
+
+	host := strings.Split(redfishServer.URL, "//")[1]
+
+	testCases := []struct {
+		imageURL       string
+		virtualMediaID string
+		expectedError  error
+	}{
+		{
+			imageURL:       "isoImage",
+			virtualMediaID: "1",
+			expectedError:  nil,
+		},
+		{
+			imageURL:       "",
+			virtualMediaID: "1",
+			expectedError:  fmt.Errorf("imageURL param cannot be empty"),
+		},
+		{
+			imageURL:       "isoImage",
+			virtualMediaID: "",
+			expectedError:  fmt.Errorf("virtualMediaID param cannot be empty"),
+		},
+		{
+			imageURL:       "isoImage",
+			virtualMediaID: "4",
+			expectedError:  fmt.Errorf("virtual media 4 not found"),
+		},
+		{
+			imageURL:       "isoImage",
+			virtualMediaID: "2",
+			expectedError:  fmt.Errorf("redfish service does not support VirtualMedia.InsertMedia calls"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		bmc := New(host).WithRedfishUser(defaultUsername, defaultPassword)
+		err := bmc.InsertVirtualMedia(testCase.imageURL, testCase.virtualMediaID)
+
+		assert.Equal(t, testCase.expectedError, err)
+	}
+}
+
+func TestBMCEjectVirtualMedia(t *testing.T) {
+	// Create fake redfish endpoint.
+	redfishServer := createFakeRedfishLocalServer(false, redfishAPIResponseCallbacks{})
+	defer redfishServer.Close()
+
+	host := strings.Split(redfishServer.URL, "//")[1]
+
+	testCases := []struct {
+		virtualMediaID string
+		expectedError  error
+	}{
+		{
+			virtualMediaID: "1",
+			expectedError:  nil,
+		},
+		{
+			virtualMediaID: "",
+			expectedError:  fmt.Errorf("virtualMediaID param cannot be empty"),
+		},
+		{
+			virtualMediaID: "4",
+			expectedError:  fmt.Errorf("virtual media 4 not found"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		bmc := New(host).WithRedfishUser(defaultUsername, defaultPassword)
+		err := bmc.EjectVirtualMedia(testCase.virtualMediaID)
+
+		assert.Equal(t, testCase.expectedError, err)
+	}
+}
+
+func TestBMCVirtualMediaImage(t *testing.T) {
+	// Create fake redfish endpoint.
+	redfishServer := createFakeRedfishLocalServer(false, redfishAPIResponseCallbacks{})
+	defer redfishServer.Close()
+
+	host := strings.Split(redfishServer.URL, "//")[1]
+
+	bmc := New(host).WithRedfishUser(defaultUsername, defaultPassword)
+	image, err := bmc.VirtualMediaImage("1")
+	assert.Nil(t, err)
+	assert.Empty(t, image)
+
+	_, err = bmc.VirtualMediaImage("4")
+	assert.Equal(t, fmt.Errorf("virtual media 4 not found"), err)
+}
+
 func getDelayResponseCallbackFn(t *testing.T, respDelay time.Duration) func(r *http.Request) {
 	t.Helper()
 
@@ -0,0 +1,119 @@
+package bmc
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	bmhv1alpha1 "github.com/metal3-io/baremetal-operator/apis/metal3.io/v1alpha1"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/klog/v2"
+	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// bmcCredentialsUsernameKey is the key of the username in the BMC credentials secret of a BareMetalHost.
+	bmcCredentialsUsernameKey = "username"
+	// bmcCredentialsPasswordKey is the key of the password in the BMC credentials secret of a BareMetalHost.
+	bmcCredentialsPasswordKey = "password"
+)
+
+// NewFromBMH returns a BMC struct for accessing the Redfish API of the BMC of the provided BareMetalHost. The host is
+// taken from the BMC address of the BareMetalHost and the Redfish user from its BMC credentials secret. Only Redfish
+// based BMC addresses, such as redfish-virtualmedia://10.1.1.1/redfish/v1/Systems/1 or idrac-virtualmedia://10.1.1.1,
+// are supported. The system in the address is not used, so WithRedfishSystemIndex must be called for BMCs that manage
+// more than one system.
+func NewFromBMH(apiClient *clients.Settings, bmhName, bmhNamespace string) (*BMC, error) {
+	klog.V(100).Infof("Creating new BMC structure from BareMetalHost %s in namespace %s", bmhName, bmhNamespace)
+
+	if apiClient == nil {
+		klog.V(100).Info("The apiClient is nil")
+
+		return nil, fmt.Errorf("bmc 'apiClient' cannot be nil")
+	}
+
+	if bmhName == "" {
+		klog.V(100).Info("The BareMetalHost name is empty")
+
+		return nil, fmt.Errorf("bmc 'bmhName' cannot be empty")
+	}
+
+	if bmhNamespace == "" {
+		klog.V(100).Info("The BareMetalHost namespace is empty")
+
+		return nil, fmt.Errorf("bmc 'bmhNamespace' cannot be empty")
+	}
+
+	err := apiClient.AttachScheme(bmhv1alpha1.AddToScheme)
+	if err != nil {
+		klog.V(100).Info("Failed to add bmh v1alpha1 scheme to client schemes")
+
+		return nil, err
+	}
+
+	bareMetalHost := &bmhv1alpha1.BareMetalHost{}
+
+	err = apiClient.Client.Get(context.TODO(),
+		runtimeclient.ObjectKey{Name: bmhName, Namespace: bmhNamespace}, bareMetalHost)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get BareMetalHost %s in namespace %s: %w", bmhName, bmhNamespace, err)
+	}
+
+	host, err := parseRedfishAddress(bareMetalHost.Spec.BMC.Address)
+	if err != nil {
+		return nil, fmt.Errorf("BareMetalHost %s in namespace %s: %w", bmhName, bmhNamespace, err)
+	}
+
+	if bareMetalHost.Spec.BMC.CredentialsName == "" {
+		return nil, fmt.Errorf("BareMetalHost %s in namespace %s has no BMC credentials secret", bmhName, bmhNamespace)
+	}
+
+	secret := &corev1.Secret{}
+
+	err = apiClient.Client.Get(context.TODO(),
+		runtimeclient.ObjectKey{Name: bareMetalHost.Spec.BMC.CredentialsName, Namespace: bmhNamespace}, secret)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get BMC credentials secret %s in namespace %s: %w",
+			bareMetalHost.Spec.BMC.CredentialsName, bmhNamespace, err)
+	}
+
+	bmc := New(host).WithRedfishUser(
+		string(secret.Data[bmcCredentialsUsernameKey]), string(secret.Data[bmcCredentialsPasswordKey]))
+	if valid, err := bmc.validate(); !valid {
+		return nil, fmt.Errorf("invalid BMC credentials secret %s in namespace %s: %w",
+			bareMetalHost.Spec.BMC.CredentialsName, bmhNamespace, err)
+	}
+
+	return bmc, nil
+}
+
+// parseRedfishAddress returns the host, including the port if any, of a Redfish based BMC address of a BareMetalHost.
+// Since the Redfish client always uses HTTPS, addresses explicitly requesting HTTP are rejected.
+func parseRedfishAddress(address string) (string, error) {
+	if address == "" {
+		return "", fmt.Errorf("BMC address cannot be empty")
+	}
+
+	bmcURL, err := url.Parse(address)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse BMC address %s: %w", address, err)
+	}
+
+	driver, transport, _ := strings.Cut(bmcURL.Scheme, "+")
+
+	if !strings.Contains(driver, "redfish") && !strings.HasPrefix(driver, "idrac") {
+		return "", fmt.Errorf("BMC address %s does not use a Redfish based driver", address)
+	}
+
+	if transport == "http" {
+		return "", fmt.Errorf("BMC address %s uses http, but only https is supported", address)
+	}
+
+	if bmcURL.Host == "" {
+		return "", fmt.Errorf("BMC address %s has no host", address)
+	}
+
+	return bmcURL.Host, nil
+}
@@ -0,0 +1,200 @@
+package bmc
+
+import (
+	"fmt"
+	"testing"
+
+	bmhv1alpha1 "github.com/metal3-io/baremetal-operator/apis/metal3.io/v1alpha1"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	defaultBMHName        = "test-bmh"
+	defaultBMHNamespace   = "test-ns"
+	defaultBMHSecretName  = "test-bmh-bmc-secret"
+	defaultBMHBMCAddress  = "redfish-virtualmedia://10.1.1.1/redfish/v1/Systems/1"
+	defaultBMHExpectedBMC = "10.1.1.1"
+)
+
+func TestNewFromBMH(t *testing.T) {
+	testCases := []struct {
+		bmhName       string
+		bmhNamespace  string
+		objects       []runtime.Object
+		client        bool
+		expectedError error
+	}{
+		{
+			bmhName:      defaultBMHName,
+			bmhNamespace: defaultBMHNamespace,
+			objects: []runtime.Object{
+				buildDummyBMH(defaultBMHBMCAddress, defaultBMHSecretName),
+				buildDummyBMCSecret(defaultUsername, defaultPassword),
+			},
+			client: true,
+		},
+		{
+			bmhName:       "",
+			bmhNamespace:  defaultBMHNamespace,
+			client:        true,
+			expectedError: fmt.Errorf("bmc 'bmhName' cannot be empty"),
+		},
+		{
+			bmhName:       defaultBMHName,
+			bmhNamespace:  "",
+			client:        true,
+			expectedError: fmt.Errorf("bmc 'bmhNamespace' cannot be empty"),
+		},
+		{
+			bmhName:       defaultBMHName,
+			bmhNamespace:  defaultBMHNamespace,
+			client:        false,
+			expectedError: fmt.Errorf("bmc 'apiClient' cannot be nil"),
+		},
+		{
+			bmhName:      defaultBMHName,
+			bmhNamespace: defaultBMHNamespace,
+			objects:      []runtime.Object{},
+			client:       true,
+			expectedError: fmt.Errorf("failed to get BareMetalHost test-bmh in namespace test-ns: " +
+				"baremetalhosts.metal3.io \"test-bmh\" not found"),
+		},
+		{
+			bmhName:      defaultBMHName,
+			bmhNamespace: defaultBMHNamespace,
+			objects:      []runtime.Object{buildDummyBMH("ipmi://10.1.1.1", defaultBMHSecretName)},
+			client:       true,
+			expectedError: fmt.Errorf("BareMetalHost test-bmh in namespace test-ns: " +
+				"BMC address ipmi://10.1.1.1 does not use a Redfish based driver"),
+		},
+		{
+			bmhName:      defaultBMHName,
+			bmhNamespace: defaultBMHNamespace,
+			objects:      []runtime.Object{buildDummyBMH(defaultBMHBMCAddress, "")},
+			client:       true,
+			expectedError: fmt.Errorf(
+				"BareMetalHost test-bmh in namespace test-ns has no BMC credentials secret"),
+		},
+		{
+			bmhName:      defaultBMHName,
+			bmhNamespace: defaultBMHNamespace,
+			objects:      []runtime.Object{buildDummyBMH(defaultBMHBMCAddress, defaultBMHSecretName)},
+			client:       true,
+			expectedError: fmt.Errorf("failed to get BMC credentials secret test-bmh-bmc-secret in namespace " +
+				"test-ns: secrets \"test-bmh-bmc-secret\" not found"),
+		},
+		{
+			bmhName:      defaultBMHName,
+			bmhNamespace: defaultBMHNamespace,
+			objects: []runtime.Object{
+				buildDummyBMH(defaultBMHBMCAddress, defaultBMHSecretName),
+				buildDummyBMCSecret("", defaultPassword),
+			},
+			client: true,
+			expectedError: fmt.Errorf("invalid BMC credentials secret test-bmh-bmc-secret in namespace test-ns: " +
+				"redfish 'username' cannot be empty"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		var testSettings *clients.Settings
+
+		if testCase.client {
+			testSettings = clients.GetTestClients(clients.TestClientParams{
+				K8sMockObjects:  testCase.objects,
+				SchemeAttachers: []clients.SchemeAttacher{bmhv1alpha1.AddToScheme},
+			})
+		}
+
+		bmc, err := NewFromBMH(testSettings, testCase.bmhName, testCase.bmhNamespace)
+		if testCase.expectedError != nil {
+			assert.Equal(t, testCase.expectedError.Error(), err.Error())
+
+			continue
+		}
+
+		assert.Nil(t, err)
+		assert.Equal(t, defaultBMHExpectedBMC, bmc.host)
+		assert.Equal(t, defaultUsername, bmc.redfishUser.Name)
+		assert.Equal(t, defaultPassword, bmc.redfishUser.Password)
+	}
+}
+
+func TestParseRedfishAddress(t *testing.T) {
+	testCases := []struct {
+		address       string
+		expectedHost  string
+		expectedError error
+	}{
+		{
+			address:      "redfish-virtualmedia://10.1.1.1/redfish/v1/Systems/1",
+			expectedHost: "10.1.1.1",
+		},
+		{
+			address:      "redfish+https://bmc.example.com:8443/redfish/v1/Systems/1",
+			expectedHost: "bmc.example.com:8443",
+		},
+		{
+			address:      "idrac-virtualmedia://[fd00::1]/redfish/v1/Systems/System.Embedded.1",
+			expectedHost: "[fd00::1]",
+		},
+		{
+			address: "redfish+http://10.1.1.1/redfish/v1/Systems/1",
+			expectedError: fmt.Errorf(
+				"BMC address redfish+http://10.1.1.1/redfish/v1/Systems/1 uses http, but only https is supported"),
+		},
+		{
+			address:       "ipmi://10.1.1.1",
+			expectedError: fmt.Errorf("BMC address ipmi://10.1.1.1 does not use a Redfish based driver"),
+		},
+		{
+			address:       "redfish-virtualmedia:///redfish/v1/Systems/1",
+			expectedError: fmt.Errorf("BMC address redfish-virtualmedia:///redfish/v1/Systems/1 has no host"),
+		},
+		{
+			address:       "",
+			expectedError: fmt.Errorf("BMC address cannot be empty"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		host, err := parseRedfishAddress(testCase.address)
+
+		assert.Equal(t, testCase.expectedError, err)
+		assert.Equal(t, testCase.expectedHost, host)
+	}
+}
+
+// buildDummyBMH returns a BareMetalHost with the provided BMC address and credentials secret name.
+func buildDummyBMH(address, credentialsName string) *bmhv1alpha1.BareMetalHost {
+	return &bmhv1alpha1.BareMetalHost{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      defaultBMHName,
+			Namespace: defaultBMHNamespace,
+		},
+		Spec: bmhv1alpha1.BareMetalHostSpec{
+			BMC: bmhv1alpha1.BMCDetails{
+				Address:         address,
+				CredentialsName: credentialsName,
+			},
+		},
+	}
+}
+
+// buildDummyBMCSecret returns a BMC credentials secret with the provided username and password.
+func buildDummyBMCSecret(username, password string) *corev1.Secret {
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      defaultBMHSecretName,
+			Namespace: defaultBMHNamespace,
+		},
+		Data: map[string][]byte{
+			bmcCredentialsUsernameKey: []byte(username),
+			bmcCredentialsPasswordKey: []byte(password),
+		},
+	}
+}
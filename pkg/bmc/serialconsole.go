@@ -0,0 +1,140 @@
+package bmc
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	"k8s.io/klog/v2"
+)
+
+// serialConsoleLogSuffix is appended to the node name to get the name of the file the serial console log is saved to.
+const serialConsoleLogSuffix = "-serial-console.log"
+
+// CaptureSerialConsole opens the serial console and copies its output to the provided writer for the provided duration,
+// returning the number of bytes written. The capture stops early if the console is closed by the BMC. The serial
+// console is always closed before returning, so it must not already be opened. See OpenSerialConsole for the meaning
+// of openConsoleCliCmd and the required users.
+func (bmc *BMC) CaptureSerialConsole(
+	openConsoleCliCmd string, duration time.Duration, output io.Writer) (int64, error) {
+	if valid, err := bmc.validate(); !valid {
+		return 0, err
+	}
+
+	klog.V(100).Infof("Capturing serial console of %v for %s", bmc.host, duration)
+
+	if duration <= 0 {
+		klog.V(100).Info("The serial console capture duration is less than or equal to zero")
+
+		return 0, fmt.Errorf("serial console 'duration' cannot be less than or equal to zero")
+	}
+
+	if output == nil {
+		klog.V(100).Info("The serial console output is nil")
+
+		return 0, fmt.Errorf("serial console 'output' cannot be nil")
+	}
+
+	reader, _, err := bmc.OpenSerialConsole(openConsoleCliCmd)
+	if err != nil {
+		return 0, err
+	}
+
+	return copySerialConsole(output, reader, bmc.CloseSerialConsole, duration)
+}
+
+// SaveSerialConsoleLog captures the serial console for the provided duration, as in CaptureSerialConsole, and saves it
+// to <artifactsDir>/<nodeName>-serial-console.log, returning the path to the file. The directory is created if it does
+// not exist and an existing log for the node is overwritten. It is meant to be called when a node fails to come back
+// after a disruption so the log may be attached to the test artifacts.
+func (bmc *BMC) SaveSerialConsoleLog(
+	nodeName, artifactsDir, openConsoleCliCmd string, duration time.Duration) (string, error) {
+	if valid, err := bmc.validateRedfish(); !valid {
+		return "", err
+	}
+
+	if valid, err := bmc.validateSSH(); !valid {
+		return "", err
+	}
+
+	klog.V(100).Infof("Saving serial console log of node %s from %v to %s", nodeName, bmc.host, artifactsDir)
+
+	if nodeName == "" {
+		klog.V(100).Info("The serial console log nodeName is empty")
+
+		return "", fmt.Errorf("serial console log 'nodeName' cannot be empty")
+	}
+
+	if artifactsDir == "" {
+		klog.V(100).Info("The serial console log artifactsDir is empty")
+
+		return "", fmt.Errorf("serial console log 'artifactsDir' cannot be empty")
+	}
+
+	err := os.MkdirAll(artifactsDir, 0o755)
+	if err != nil {
+		return "", fmt.Errorf("failed to create serial console log directory %s: %w", artifactsDir, err)
+	}
+
+	logPath := filepath.Join(artifactsDir, nodeName+serialConsoleLogSuffix)
+
+	logFile, err := os.Create(logPath)
+	if err != nil {
+		return "", fmt.Errorf("failed to create serial console log file %s: %w", logPath, err)
+	}
+
+	defer logFile.Close()
+
+	written, err := bmc.CaptureSerialConsole(openConsoleCliCmd, duration, logFile)
+	if err != nil {
+		return "", fmt.Errorf("failed to capture serial console log of node %s: %w", nodeName, err)
+	}
+
+	klog.V(100).Infof("Saved %d bytes of serial console log of node %s to %s", written, nodeName, logPath)
+
+	return logPath, nil
+}
+
+// copySerialConsole copies from the serial console reader to output until either the duration elapses or the reader
+// is exhausted, then calls closeConsole. Since closing the console is what unblocks a pending read once the duration
+// elapses, read errors after that point are expected and ignored.
+func copySerialConsole(
+	output io.Writer, reader io.Reader, closeConsole func() error, duration time.Duration) (int64, error) {
+	type copyResult struct {
+		written int64
+		err     error
+	}
+
+	copyDone := make(chan copyResult, 1)
+
+	go func() {
+		written, err := io.Copy(output, reader)
+		copyDone <- copyResult{written: written, err: err}
+	}()
+
+	timer := time.NewTimer(duration)
+	defer timer.Stop()
+
+	select {
+	case result := <-copyDone:
+		klog.V(100).Info("Serial console closed before the capture duration elapsed")
+
+		closeErr := closeConsole()
+		if result.err != nil {
+			return result.written, fmt.Errorf("failed to read serial console: %w", result.err)
+		}
+
+		return result.written, closeErr
+	case <-timer.C:
+		// If the console cannot be closed, the pending read may never return so there is no point in waiting.
+		if err := closeConsole(); err != nil {
+			return 0, err
+		}
+
+		result := <-copyDone
+
+		return result.written, nil
+	}
+}
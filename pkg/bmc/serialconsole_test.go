@@ -0,0 +1,155 @@
+package bmc
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBMCCaptureSerialConsole(t *testing.T) {
+	testCases := []struct {
+		bmc           *BMC
+		duration      time.Duration
+		output        io.Writer
+		expectedError error
+	}{
+		{
+			bmc:           New(defaultHost).WithRedfishUser(defaultUsername, defaultPassword),
+			duration:      0,
+			output:        &bytes.Buffer{},
+			expectedError: fmt.Errorf("serial console 'duration' cannot be less than or equal to zero"),
+		},
+		{
+			bmc:           New(defaultHost).WithRedfishUser(defaultUsername, defaultPassword),
+			duration:      time.Second,
+			output:        nil,
+			expectedError: fmt.Errorf("serial console 'output' cannot be nil"),
+		},
+		{
+			bmc:           New(defaultHost).WithRedfishUser(defaultUsername, defaultPassword),
+			duration:      time.Second,
+			output:        &bytes.Buffer{},
+			expectedError: fmt.Errorf("cannot access ssh with nil user"),
+		},
+		{
+			bmc:           New(""),
+			duration:      time.Second,
+			output:        &bytes.Buffer{},
+			expectedError: fmt.Errorf("bmc 'host' cannot be empty"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		written, err := testCase.bmc.CaptureSerialConsole("console com2", testCase.duration, testCase.output)
+
+		assert.Equal(t, testCase.expectedError, err)
+		assert.Equal(t, int64(0), written)
+	}
+}
+
+func TestBMCSaveSerialConsoleLog(t *testing.T) {
+	testCases := []struct {
+		bmc           *BMC
+		nodeName      string
+		artifactsDir  string
+		expectedError error
+	}{
+		{
+			bmc:           New(defaultHost).WithRedfishUser(defaultUsername, defaultPassword),
+			nodeName:      "worker-0",
+			artifactsDir:  t.TempDir(),
+			expectedError: fmt.Errorf("cannot access ssh with nil user"),
+		},
+		{
+			bmc: New(defaultHost).
+				WithRedfishUser(defaultUsername, defaultPassword).WithSSHUser(defaultUsername, defaultPassword),
+			nodeName:      "",
+			artifactsDir:  t.TempDir(),
+			expectedError: fmt.Errorf("serial console log 'nodeName' cannot be empty"),
+		},
+		{
+			bmc: New(defaultHost).
+				WithRedfishUser(defaultUsername, defaultPassword).WithSSHUser(defaultUsername, defaultPassword),
+			nodeName:      "worker-0",
+			artifactsDir:  "",
+			expectedError: fmt.Errorf("serial console log 'artifactsDir' cannot be empty"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		logPath, err := testCase.bmc.SaveSerialConsoleLog(
+			testCase.nodeName, testCase.artifactsDir, "console com2", time.Second)
+
+		assert.Equal(t, testCase.expectedError, err)
+		assert.Empty(t, logPath)
+		assert.NoFileExists(t, filepath.Join(testCase.artifactsDir, testCase.nodeName+serialConsoleLogSuffix))
+	}
+}
+
+func TestCopySerialConsole(t *testing.T) {
+	testCases := []struct {
+		closeWriter    bool
+		closeError     error
+		expectedOutput string
+		expectedError  error
+	}{
+		{
+			closeWriter:    false,
+			expectedOutput: "boot log",
+		},
+		{
+			closeWriter:    true,
+			expectedOutput: "boot log",
+		},
+		{
+			closeWriter:    false,
+			closeError:     fmt.Errorf("failed to close"),
+			expectedOutput: "boot log",
+			expectedError:  fmt.Errorf("failed to close"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		reader, writer := io.Pipe()
+		written := make(chan struct{})
+
+		go func() {
+			_, _ = writer.Write([]byte(testCase.expectedOutput))
+
+			if testCase.closeWriter {
+				_ = writer.Close()
+			}
+
+			close(written)
+		}()
+
+		closeConsole := func() error {
+			<-written
+
+			if testCase.closeError != nil {
+				return testCase.closeError
+			}
+
+			return writer.Close()
+		}
+
+		output := &bytes.Buffer{}
+		count, err := copySerialConsole(output, reader, closeConsole, 100*time.Millisecond)
+
+		assert.Equal(t, testCase.expectedError, err)
+
+		if testCase.expectedError != nil {
+			_ = writer.Close()
+
+			continue
+		}
+
+		assert.Equal(t, int64(len(testCase.expectedOutput)), count)
+		assert.Equal(t, testCase.expectedOutput, output.String())
+	}
+}
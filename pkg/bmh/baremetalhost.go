@@ -7,6 +7,7 @@ import (
 
 	goclient "sigs.k8s.io/controller-runtime/pkg/client"
 
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/klog/v2"
 
@@ -40,6 +41,8 @@ type AdditionalOptions func(builder *BmhBuilder) (*BmhBuilder, error)
 // NewBuilder creates a new instance of BmhBuilder.
 func NewBuilder(
 	apiClient *clients.Settings, name, nsname, bmcAddress, bmcSecretName, bootMacAddress, bootMode string) *BmhBuilder {
+	nsname = apiClient.ResolveNamespace(nsname)
+
 	if apiClient == nil {
 		klog.V(100).Info("The apiClient cannot be nil")
 
@@ -373,6 +376,8 @@ func (builder *BmhBuilder) WithOptions(options ...AdditionalOptions) *BmhBuilder
 
 // Pull pulls existing baremetalhost from cluster.
 func Pull(apiClient *clients.Settings, name, nsname string) (*BmhBuilder, error) {
+	nsname = apiClient.ResolveNamespace(nsname)
+
 	klog.V(100).Infof("Pulling existing baremetalhost name %s under namespace %s from cluster", name, nsname)
 
 	if apiClient == nil {
@@ -700,6 +705,13 @@ func (builder *BmhBuilder) WaitUntilAnnotationExists(annotation string, timeout
 	return builder, nil
 }
 
+// GetBareMetalHostGVR returns BareMetalHost's GroupVersionResource which could be used for Clean function.
+func GetBareMetalHostGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group: "metal3.io", Version: "v1alpha1", Resource: "baremetalhosts",
+	}
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *BmhBuilder) validate() (bool, error) {
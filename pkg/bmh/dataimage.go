@@ -9,6 +9,7 @@ import (
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/msg"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/klog/v2"
 	goclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -24,6 +25,8 @@ type DataImageBuilder struct {
 
 // PullDataImage retrieves an existing DataImage resource from the cluster.
 func PullDataImage(apiClient *clients.Settings, name, nsname string) (*DataImageBuilder, error) {
+	nsname = apiClient.ResolveNamespace(nsname)
+
 	klog.V(100).Infof("Pulling existing dataimage name %s under namespace %s from cluster", name, nsname)
 
 	if apiClient == nil {
@@ -136,6 +139,13 @@ func (builder *DataImageBuilder) Exists() bool {
 	return err == nil || !k8serrors.IsNotFound(err)
 }
 
+// GetDataImageGVR returns DataImage's GroupVersionResource which could be used for Clean function.
+func GetDataImageGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group: "metal3.io", Version: "v1alpha1", Resource: "dataimages",
+	}
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *DataImageBuilder) validate() (bool, error) {
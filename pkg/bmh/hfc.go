@@ -9,6 +9,7 @@ import (
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/msg"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/klog/v2"
 	goclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -25,6 +26,8 @@ type HFCBuilder struct {
 
 // PullHFC pulls an existing HostFirmwareComponents from the cluster.
 func PullHFC(apiClient *clients.Settings, name, nsname string) (*HFCBuilder, error) {
+	nsname = apiClient.ResolveNamespace(nsname)
+
 	klog.V(100).Infof("Pulling existing HostFirmwareComponents name %s under namespace %s from cluster", name, nsname)
 
 	if apiClient == nil {
@@ -113,6 +116,14 @@ func (builder *HFCBuilder) Exists() bool {
 	return err == nil || !k8serrors.IsNotFound(err)
 }
 
+// GetHostFirmwareComponentsGVR returns HostFirmwareComponents' GroupVersionResource
+// which could be used for Clean function.
+func GetHostFirmwareComponentsGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group: "metal3.io", Version: "v1alpha1", Resource: "hostfirmwarecomponents",
+	}
+}
+
 // validate checks that the builder, definition, and apiClient are properly initialized and there is no errorMsg.
 func (builder *HFCBuilder) validate() (bool, error) {
 	resourceCRD := "hostFirmwareComponents"
@@ -9,6 +9,7 @@ import (
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/msg"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/klog/v2"
 	goclient "sigs.k8s.io/controller-runtime/pkg/client"
@@ -26,6 +27,8 @@ type HFSBuilder struct {
 
 // PullHFS pulls an existing HostFirmwareSettings from the cluster.
 func PullHFS(apiClient *clients.Settings, name, nsname string) (*HFSBuilder, error) {
+	nsname = apiClient.ResolveNamespace(nsname)
+
 	klog.V(100).Infof("Pulling existing HostFirmwareSettings name %s under namespace %s from cluster", name, nsname)
 
 	if apiClient == nil {
@@ -214,6 +217,13 @@ func (builder *HFSBuilder) Delete() error {
 	return nil
 }
 
+// GetHostFirmwareSettingsGVR returns HostFirmwareSettings' GroupVersionResource which could be used for Clean function.
+func GetHostFirmwareSettingsGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group: "metal3.io", Version: "v1alpha1", Resource: "hostfirmwaresettings",
+	}
+}
+
 // validate checks that the builder, definition, and apiClient are properly initialized and there is no errorMsg.
 func (builder *HFSBuilder) validate() (bool, error) {
 	resourceCRD := "hostFirmwareSettings"
@@ -21,6 +21,8 @@ const (
 
 // List returns bareMetalHosts inventory in the given namespace.
 func List(apiClient *clients.Settings, nsname string, options ...goclient.ListOptions) ([]*BmhBuilder, error) {
+	nsname = apiClient.ResolveNamespace(nsname)
+
 	if apiClient == nil || apiClient.Client == nil {
 		klog.V(100).Info("BareMetalHosts 'apiClient' parameter can not be empty")
 
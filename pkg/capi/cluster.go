@@ -0,0 +1,186 @@
+// Package capi provides builders for the Cluster API (CAPI) resources used to deploy bare-metal clusters with the
+// Metal3 infrastructure provider (CAPM3): the Cluster and MachineDeployment resources of CAPI and the
+// Metal3MachineTemplate and Metal3Machine resources of CAPM3. It also provides waiters for their provisioning,
+// analogous to the machine package for the Machine API.
+package capi
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common"
+	clusterv1 "github.com/rh-ecosystem-edge/eco-goinfra/pkg/schemes/capi/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/klog/v2"
+)
+
+// ClusterBuilder provides a struct for the Cluster resource containing a connection to the cluster and the Cluster
+// definition. A Cluster ties together the infrastructure and control plane resources of a cluster managed by CAPI.
+type ClusterBuilder struct {
+	common.EmbeddableBuilder[clusterv1.Cluster, *clusterv1.Cluster]
+	common.EmbeddableCreator[clusterv1.Cluster, ClusterBuilder, *clusterv1.Cluster, *ClusterBuilder]
+	common.EmbeddableDeleter[clusterv1.Cluster, *clusterv1.Cluster]
+	common.EmbeddableUpdater[clusterv1.Cluster, ClusterBuilder, *clusterv1.Cluster, *ClusterBuilder]
+}
+
+// AttachMixins wires the embedded CRUD mixins to this builder instance.
+func (builder *ClusterBuilder) AttachMixins() {
+	builder.EmbeddableCreator.SetBase(builder)
+	builder.EmbeddableDeleter.SetBase(builder)
+	builder.EmbeddableUpdater.SetBase(builder)
+}
+
+// GetGVK returns the Cluster GVK for this builder.
+func (builder *ClusterBuilder) GetGVK() schema.GroupVersionKind {
+	return clusterv1.GroupVersion.WithKind("Cluster")
+}
+
+// NewClusterBuilder creates a new instance of ClusterBuilder. The infrastructure of the cluster, such as a
+// Metal3Cluster, must be set using WithInfrastructureRef before it is created.
+func NewClusterBuilder(apiClient *clients.Settings, name, nsname string) *ClusterBuilder {
+	klog.V(100).Infof(
+		"Initializing new Cluster structure with the following params: name: %s, namespace: %s", name, nsname)
+
+	return common.NewNamespacedBuilder[clusterv1.Cluster, ClusterBuilder](
+		apiClient, clusterv1.AddToScheme, name, nsname)
+}
+
+// PullCluster retrieves an existing Cluster from the cluster.
+func PullCluster(apiClient *clients.Settings, name, nsname string) (*ClusterBuilder, error) {
+	return common.PullNamespacedBuilder[clusterv1.Cluster, ClusterBuilder](
+		context.TODO(), apiClient, clusterv1.AddToScheme, name, nsname)
+}
+
+// WithInfrastructureRef sets the reference to the provider-specific resource describing the infrastructure of the
+// cluster, such as a Metal3Cluster.
+func (builder *ClusterBuilder) WithInfrastructureRef(ref corev1.ObjectReference) *ClusterBuilder {
+	if err := common.Validate(builder); err != nil {
+		return builder
+	}
+
+	klog.V(100).Infof("Setting infrastructure of Cluster %s in namespace %s to %s %s",
+		builder.Definition.Name, builder.Definition.Namespace, ref.Kind, ref.Name)
+
+	if err := validateObjectReference("cluster infrastructureRef", ref); err != nil {
+		builder.SetError(err)
+
+		return builder
+	}
+
+	builder.Definition.Spec.InfrastructureRef = &ref
+
+	return builder
+}
+
+// WithControlPlaneRef sets the reference to the provider-specific resource describing the control plane of the
+// cluster.
+func (builder *ClusterBuilder) WithControlPlaneRef(ref corev1.ObjectReference) *ClusterBuilder {
+	if err := common.Validate(builder); err != nil {
+		return builder
+	}
+
+	klog.V(100).Infof("Setting control plane of Cluster %s in namespace %s to %s %s",
+		builder.Definition.Name, builder.Definition.Namespace, ref.Kind, ref.Name)
+
+	if err := validateObjectReference("cluster controlPlaneRef", ref); err != nil {
+		builder.SetError(err)
+
+		return builder
+	}
+
+	builder.Definition.Spec.ControlPlaneRef = &ref
+
+	return builder
+}
+
+// WithClusterNetwork sets the CIDRs from which pod and service addresses are allocated. The service CIDRs are optional.
+func (builder *ClusterBuilder) WithClusterNetwork(podCIDRs, serviceCIDRs []string) *ClusterBuilder {
+	if err := common.Validate(builder); err != nil {
+		return builder
+	}
+
+	klog.V(100).Infof("Setting cluster network of Cluster %s in namespace %s to pods %v and services %v",
+		builder.Definition.Name, builder.Definition.Namespace, podCIDRs, serviceCIDRs)
+
+	if len(podCIDRs) == 0 {
+		builder.SetError(fmt.Errorf("cluster 'podCIDRs' cannot be empty"))
+
+		return builder
+	}
+
+	if builder.Definition.Spec.ClusterNetwork == nil {
+		builder.Definition.Spec.ClusterNetwork = &clusterv1.ClusterNetwork{}
+	}
+
+	builder.Definition.Spec.ClusterNetwork.Pods = &clusterv1.NetworkRanges{CIDRBlocks: podCIDRs}
+	builder.Definition.Spec.ClusterNetwork.Services = nil
+
+	if len(serviceCIDRs) > 0 {
+		builder.Definition.Spec.ClusterNetwork.Services = &clusterv1.NetworkRanges{CIDRBlocks: serviceCIDRs}
+	}
+
+	return builder
+}
+
+// WaitUntilProvisioned waits up to timeout for the Cluster to reach the Provisioned phase with its infrastructure
+// ready and, if it has a control plane reference, its control plane ready. It returns early if the Cluster reports a
+// failure. On timeout, the error includes the last phase of the Cluster.
+func (builder *ClusterBuilder) WaitUntilProvisioned(timeout time.Duration) error {
+	if err := common.Validate(builder); err != nil {
+		return err
+	}
+
+	resource := describeResource("cluster", builder.Definition.Name, builder.Definition.Namespace)
+
+	return waitForProvisioning(resource, timeout, func() (bool, string, error) {
+		cluster, err := builder.Get()
+		if err != nil {
+			klog.V(100).Infof("Failed to get %s: %v", resource, err)
+
+			return false, "", nil
+		}
+
+		builder.Object = cluster
+
+		if err := getFailure(resource, cluster.Status.FailureReason, cluster.Status.FailureMessage); err != nil {
+			return false, "", err
+		}
+
+		status := fmt.Sprintf("phase %q, infrastructureReady %t, controlPlaneReady %t",
+			cluster.Status.Phase, cluster.Status.InfrastructureReady, cluster.Status.ControlPlaneReady)
+
+		provisioned := cluster.Status.Phase == string(clusterv1.ClusterPhaseProvisioned) &&
+			cluster.Status.InfrastructureReady &&
+			(cluster.Spec.ControlPlaneRef == nil || cluster.Status.ControlPlaneReady)
+
+		return provisioned, status, nil
+	})
+}
+
+// validateObjectReference returns an error if the reference, described by field in the error, has no kind, API
+// version, or name.
+func validateObjectReference(field string, ref corev1.ObjectReference) error {
+	if ref.Kind == "" {
+		return fmt.Errorf("%s 'kind' cannot be empty", field)
+	}
+
+	if ref.APIVersion == "" {
+		return fmt.Errorf("%s 'apiVersion' cannot be empty", field)
+	}
+
+	if ref.Name == "" {
+		return fmt.Errorf("%s 'name' cannot be empty", field)
+	}
+
+	return nil
+}
+
+// GetClusterGVR returns Cluster's GroupVersionResource which could be used for Clean function.
+func GetClusterGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group: "cluster.x-k8s.io", Version: "v1beta1", Resource: "clusters",
+	}
+}
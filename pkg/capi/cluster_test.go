@@ -0,0 +1,200 @@
+package capi
+
+import (
+	"testing"
+	"time"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common/testhelper"
+	clusterv1 "github.com/rh-ecosystem-edge/eco-goinfra/pkg/schemes/capi/v1beta1"
+	capm3v1beta1 "github.com/rh-ecosystem-edge/eco-goinfra/pkg/schemes/capm3/v1beta1"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+)
+
+const (
+	defaultClusterName      = "test-cluster"
+	defaultCAPINamespace    = "test-namespace"
+	defaultMetal3ClusterRef = "test-metal3-cluster"
+)
+
+var clusterGVK = clusterv1.GroupVersion.WithKind("Cluster")
+
+func TestNewClusterBuilder(t *testing.T) {
+	t.Parallel()
+
+	testhelper.NewNamespacedBuilderTestConfig[clusterv1.Cluster, ClusterBuilder](
+		NewClusterBuilder, clusterv1.AddToScheme, clusterGVK).ExecuteTests(t)
+}
+
+func TestPullCluster(t *testing.T) {
+	t.Parallel()
+
+	testhelper.NewNamespacedPullTestConfig[clusterv1.Cluster, ClusterBuilder](
+		PullCluster, clusterv1.AddToScheme, clusterGVK).ExecuteTests(t)
+}
+
+func TestClusterBuilderMethods(t *testing.T) {
+	t.Parallel()
+
+	commonConfig := testhelper.NewCommonTestConfig[clusterv1.Cluster, ClusterBuilder](
+		clusterv1.AddToScheme, clusterGVK, testhelper.ResourceScopeNamespaced)
+
+	testhelper.NewTestSuite().
+		With(testhelper.NewGetTestConfig(commonConfig)).
+		With(testhelper.NewExistsTestConfig(commonConfig)).
+		With(testhelper.NewCreateTestConfig(commonConfig)).
+		With(testhelper.NewDeleterTestConfig(commonConfig)).
+		With(testhelper.NewUpdateTestConfig(commonConfig)).
+		Run(t)
+}
+
+func TestClusterWithRefs(t *testing.T) {
+	t.Parallel()
+
+	infrastructureRef := buildDummyMetal3ClusterRef()
+	controlPlaneRef := corev1.ObjectReference{
+		APIVersion: "controlplane.cluster.x-k8s.io/v1beta1",
+		Kind:       "KubeadmControlPlane",
+		Name:       "test-control-plane",
+	}
+
+	testBuilder := newTestClusterBuilder(nil).WithInfrastructureRef(infrastructureRef).WithControlPlaneRef(controlPlaneRef)
+	assert.NoError(t, testBuilder.GetError())
+	assert.Equal(t, &infrastructureRef, testBuilder.Definition.Spec.InfrastructureRef)
+	assert.Equal(t, &controlPlaneRef, testBuilder.Definition.Spec.ControlPlaneRef)
+
+	testBuilder = newTestClusterBuilder(nil).WithInfrastructureRef(corev1.ObjectReference{})
+	assert.EqualError(t, testBuilder.GetError(), "cluster infrastructureRef 'kind' cannot be empty")
+
+	testBuilder = newTestClusterBuilder(nil).WithControlPlaneRef(corev1.ObjectReference{Kind: "KubeadmControlPlane"})
+	assert.EqualError(t, testBuilder.GetError(), "cluster controlPlaneRef 'apiVersion' cannot be empty")
+
+	testBuilder = newTestClusterBuilder(nil).WithInfrastructureRef(
+		corev1.ObjectReference{APIVersion: capm3v1beta1.GroupVersion.String(), Kind: "Metal3Cluster"})
+	assert.EqualError(t, testBuilder.GetError(), "cluster infrastructureRef 'name' cannot be empty")
+}
+
+func TestClusterWithClusterNetwork(t *testing.T) {
+	t.Parallel()
+
+	testBuilder := newTestClusterBuilder(nil).WithClusterNetwork([]string{"10.128.0.0/14"}, []string{"172.30.0.0/16"})
+	assert.NoError(t, testBuilder.GetError())
+	assert.Equal(t, &clusterv1.ClusterNetwork{
+		Pods:     &clusterv1.NetworkRanges{CIDRBlocks: []string{"10.128.0.0/14"}},
+		Services: &clusterv1.NetworkRanges{CIDRBlocks: []string{"172.30.0.0/16"}},
+	}, testBuilder.Definition.Spec.ClusterNetwork)
+
+	testBuilder = testBuilder.WithClusterNetwork([]string{"10.132.0.0/14"}, nil)
+	assert.NoError(t, testBuilder.GetError())
+	assert.Equal(t, &clusterv1.ClusterNetwork{
+		Pods: &clusterv1.NetworkRanges{CIDRBlocks: []string{"10.132.0.0/14"}},
+	}, testBuilder.Definition.Spec.ClusterNetwork)
+
+	testBuilder = newTestClusterBuilder(nil).WithClusterNetwork(nil, []string{"172.30.0.0/16"})
+	assert.EqualError(t, testBuilder.GetError(), "cluster 'podCIDRs' cannot be empty")
+}
+
+func TestClusterWaitUntilProvisioned(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name            string
+		status          clusterv1.ClusterStatus
+		controlPlaneRef bool
+		expectedError   string
+	}{
+		{
+			name: "provisioned",
+			status: clusterv1.ClusterStatus{
+				Phase:               string(clusterv1.ClusterPhaseProvisioned),
+				InfrastructureReady: true,
+			},
+		},
+		{
+			name: "provisioned with control plane",
+			status: clusterv1.ClusterStatus{
+				Phase:               string(clusterv1.ClusterPhaseProvisioned),
+				InfrastructureReady: true,
+				ControlPlaneReady:   true,
+			},
+			controlPlaneRef: true,
+		},
+		{
+			name: "control plane not ready",
+			status: clusterv1.ClusterStatus{
+				Phase:               string(clusterv1.ClusterPhaseProvisioned),
+				InfrastructureReady: true,
+			},
+			controlPlaneRef: true,
+			expectedError: "cluster test-cluster in namespace test-namespace is not provisioned: phase \"Provisioned\", " +
+				"infrastructureReady true, controlPlaneReady false: context deadline exceeded",
+		},
+		{
+			name: "failed",
+			status: clusterv1.ClusterStatus{
+				Phase:          string(clusterv1.ClusterPhaseFailed),
+				FailureReason:  ptr.To("InvalidConfiguration"),
+				FailureMessage: ptr.To("Metal3Cluster not found"),
+			},
+			expectedError: "cluster test-cluster in namespace test-namespace failed: InvalidConfiguration: " +
+				"Metal3Cluster not found",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			cluster := buildDummyCluster()
+			cluster.Status = testCase.status
+
+			if testCase.controlPlaneRef {
+				cluster.Spec.ControlPlaneRef = &corev1.ObjectReference{Name: "test-control-plane"}
+			}
+
+			err := newTestClusterBuilder([]runtime.Object{cluster}).WaitUntilProvisioned(time.Second)
+			if testCase.expectedError != "" {
+				assert.EqualError(t, err, testCase.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+
+	err := newTestClusterBuilder(nil).WaitUntilProvisioned(time.Second)
+	assert.EqualError(t, err, "cluster test-cluster in namespace test-namespace is not provisioned: status unknown: "+
+		"context deadline exceeded")
+}
+
+func newTestClusterBuilder(objects []runtime.Object) *ClusterBuilder {
+	return NewClusterBuilder(buildTestClientWithCAPI(objects), defaultClusterName, defaultCAPINamespace)
+}
+
+func buildDummyCluster() *clusterv1.Cluster {
+	return &clusterv1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      defaultClusterName,
+			Namespace: defaultCAPINamespace,
+		},
+		Spec: clusterv1.ClusterSpec{InfrastructureRef: ptr.To(buildDummyMetal3ClusterRef())},
+	}
+}
+
+func buildDummyMetal3ClusterRef() corev1.ObjectReference {
+	return corev1.ObjectReference{
+		APIVersion: capm3v1beta1.GroupVersion.String(),
+		Kind:       "Metal3Cluster",
+		Name:       defaultMetal3ClusterRef,
+	}
+}
+
+func buildTestClientWithCAPI(objects []runtime.Object) *clients.Settings {
+	return clients.GetTestClients(clients.TestClientParams{
+		K8sMockObjects:  objects,
+		SchemeAttachers: []clients.SchemeAttacher{clusterv1.AddToScheme, capm3v1beta1.AddToScheme},
+	})
+}
@@ -0,0 +1,6 @@
+package capi
+
+//go:generate go run ../../internal/listgen -builder ClusterBuilder
+//go:generate go run ../../internal/listgen -builder MachineDeploymentBuilder
+//go:generate go run ../../internal/listgen -builder Metal3MachineBuilder
+//go:generate go run ../../internal/listgen -builder Metal3MachineTemplateBuilder
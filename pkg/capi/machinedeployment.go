@@ -0,0 +1,234 @@
+package capi
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"time"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common"
+	clusterv1 "github.com/rh-ecosystem-edge/eco-goinfra/pkg/schemes/capi/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
+)
+
+// MachineDeploymentBuilder provides a struct for the MachineDeployment resource containing a connection to the cluster
+// and the MachineDeployment definition. A MachineDeployment manages a set of Machines, and their infrastructure
+// machines such as Metal3Machines, created from the same templates.
+type MachineDeploymentBuilder struct {
+	common.EmbeddableBuilder[clusterv1.MachineDeployment, *clusterv1.MachineDeployment]
+	common.EmbeddableCreator[clusterv1.MachineDeployment, MachineDeploymentBuilder,
+		*clusterv1.MachineDeployment, *MachineDeploymentBuilder]
+	common.EmbeddableDeleter[clusterv1.MachineDeployment, *clusterv1.MachineDeployment]
+	common.EmbeddableUpdater[clusterv1.MachineDeployment, MachineDeploymentBuilder,
+		*clusterv1.MachineDeployment, *MachineDeploymentBuilder]
+}
+
+// AttachMixins wires the embedded CRUD mixins to this builder instance.
+func (builder *MachineDeploymentBuilder) AttachMixins() {
+	builder.EmbeddableCreator.SetBase(builder)
+	builder.EmbeddableDeleter.SetBase(builder)
+	builder.EmbeddableUpdater.SetBase(builder)
+}
+
+// GetGVK returns the MachineDeployment GVK for this builder.
+func (builder *MachineDeploymentBuilder) GetGVK() schema.GroupVersionKind {
+	return clusterv1.GroupVersion.WithKind("MachineDeployment")
+}
+
+// GetImmutableFields returns the cluster name, which cannot be changed once the MachineDeployment is created.
+func (builder *MachineDeploymentBuilder) GetImmutableFields(latest *clusterv1.MachineDeployment) []string {
+	return []string{"spec.clusterName"}
+}
+
+// NewMachineDeploymentBuilder creates a new instance of MachineDeploymentBuilder for machines of the Cluster
+// clusterName. The machines are selected by the cluster and deployment name labels, which are set on the machine
+// template. The infrastructure template, such as a Metal3MachineTemplate, must be set using WithInfrastructureRef
+// before it is created.
+func NewMachineDeploymentBuilder(
+	apiClient *clients.Settings, name, nsname, clusterName string) *MachineDeploymentBuilder {
+	klog.V(100).Infof(
+		"Initializing new MachineDeployment structure with the following params: name: %s, namespace: %s, cluster: %s",
+		name, nsname, clusterName)
+
+	builder := common.NewNamespacedBuilder[clusterv1.MachineDeployment, MachineDeploymentBuilder](
+		apiClient, clusterv1.AddToScheme, name, nsname)
+	if builder.GetError() != nil {
+		return builder
+	}
+
+	if clusterName == "" {
+		builder.SetError(fmt.Errorf("machineDeployment 'clusterName' cannot be empty"))
+
+		return builder
+	}
+
+	labels := map[string]string{
+		clusterv1.ClusterNameLabel:           clusterName,
+		clusterv1.MachineDeploymentNameLabel: name,
+	}
+
+	builder.Definition.Spec.ClusterName = clusterName
+	builder.Definition.Spec.Selector.MatchLabels = labels
+	builder.Definition.Spec.Template.Labels = maps.Clone(labels)
+	builder.Definition.Spec.Template.Spec.ClusterName = clusterName
+
+	return builder
+}
+
+// PullMachineDeployment retrieves an existing MachineDeployment from the cluster.
+func PullMachineDeployment(apiClient *clients.Settings, name, nsname string) (*MachineDeploymentBuilder, error) {
+	return common.PullNamespacedBuilder[clusterv1.MachineDeployment, MachineDeploymentBuilder](
+		context.TODO(), apiClient, clusterv1.AddToScheme, name, nsname)
+}
+
+// WithReplicas sets the number of desired machines. Updating the replicas of an existing MachineDeployment scales it,
+// which WaitUntilReady waits for.
+func (builder *MachineDeploymentBuilder) WithReplicas(replicas int32) *MachineDeploymentBuilder {
+	if err := common.Validate(builder); err != nil {
+		return builder
+	}
+
+	klog.V(100).Infof("Setting replicas of MachineDeployment %s in namespace %s to %d",
+		builder.Definition.Name, builder.Definition.Namespace, replicas)
+
+	if replicas < 0 {
+		builder.SetError(fmt.Errorf("machineDeployment 'replicas' cannot be negative"))
+
+		return builder
+	}
+
+	builder.Definition.Spec.Replicas = ptr.To(replicas)
+
+	return builder
+}
+
+// WithVersion sets the Kubernetes version of the machines, such as v1.31.2.
+func (builder *MachineDeploymentBuilder) WithVersion(version string) *MachineDeploymentBuilder {
+	if err := common.Validate(builder); err != nil {
+		return builder
+	}
+
+	klog.V(100).Infof("Setting version of MachineDeployment %s in namespace %s to %s",
+		builder.Definition.Name, builder.Definition.Namespace, version)
+
+	if version == "" {
+		builder.SetError(fmt.Errorf("machineDeployment 'version' cannot be empty"))
+
+		return builder
+	}
+
+	builder.Definition.Spec.Template.Spec.Version = ptr.To(version)
+
+	return builder
+}
+
+// WithInfrastructureRef sets the reference to the infrastructure template of the machines, usually from
+// Metal3MachineTemplateBuilder.GetInfrastructureRef.
+func (builder *MachineDeploymentBuilder) WithInfrastructureRef(ref corev1.ObjectReference) *MachineDeploymentBuilder {
+	if err := common.Validate(builder); err != nil {
+		return builder
+	}
+
+	klog.V(100).Infof("Setting infrastructure of MachineDeployment %s in namespace %s to %s %s",
+		builder.Definition.Name, builder.Definition.Namespace, ref.Kind, ref.Name)
+
+	if err := validateObjectReference("machineDeployment infrastructureRef", ref); err != nil {
+		builder.SetError(err)
+
+		return builder
+	}
+
+	builder.Definition.Spec.Template.Spec.InfrastructureRef = ref
+
+	return builder
+}
+
+// WithBootstrapConfigRef sets the reference to the bootstrap provider template of the machines, replacing any
+// bootstrap data secret.
+func (builder *MachineDeploymentBuilder) WithBootstrapConfigRef(ref corev1.ObjectReference) *MachineDeploymentBuilder {
+	if err := common.Validate(builder); err != nil {
+		return builder
+	}
+
+	klog.V(100).Infof("Setting bootstrap config of MachineDeployment %s in namespace %s to %s %s",
+		builder.Definition.Name, builder.Definition.Namespace, ref.Kind, ref.Name)
+
+	if err := validateObjectReference("machineDeployment bootstrap configRef", ref); err != nil {
+		builder.SetError(err)
+
+		return builder
+	}
+
+	builder.Definition.Spec.Template.Spec.Bootstrap = clusterv1.Bootstrap{ConfigRef: &ref}
+
+	return builder
+}
+
+// WithBootstrapDataSecret sets the name of the secret holding the bootstrap data of the machines, such as an ignition
+// config, replacing any bootstrap config reference.
+func (builder *MachineDeploymentBuilder) WithBootstrapDataSecret(secretName string) *MachineDeploymentBuilder {
+	if err := common.Validate(builder); err != nil {
+		return builder
+	}
+
+	klog.V(100).Infof("Setting bootstrap data secret of MachineDeployment %s in namespace %s to %s",
+		builder.Definition.Name, builder.Definition.Namespace, secretName)
+
+	if secretName == "" {
+		builder.SetError(fmt.Errorf("machineDeployment bootstrap 'secretName' cannot be empty"))
+
+		return builder
+	}
+
+	builder.Definition.Spec.Template.Spec.Bootstrap = clusterv1.Bootstrap{DataSecretName: ptr.To(secretName)}
+
+	return builder
+}
+
+// WaitUntilReady waits up to timeout for the MachineDeployment to observe its latest generation and for all of its
+// desired replicas to be updated and ready. It returns early if the MachineDeployment reaches the Failed phase. On
+// timeout, the error includes the last replica counts of the MachineDeployment.
+func (builder *MachineDeploymentBuilder) WaitUntilReady(timeout time.Duration) error {
+	if err := common.Validate(builder); err != nil {
+		return err
+	}
+
+	resource := describeResource("machineDeployment", builder.Definition.Name, builder.Definition.Namespace)
+
+	return waitForProvisioning(resource, timeout, func() (bool, string, error) {
+		machineDeployment, err := builder.Get()
+		if err != nil {
+			klog.V(100).Infof("Failed to get %s: %v", resource, err)
+
+			return false, "", nil
+		}
+
+		builder.Object = machineDeployment
+
+		if machineDeployment.Status.Phase == string(clusterv1.MachineDeploymentPhaseFailed) {
+			return false, "", fmt.Errorf("%s is in phase %s", resource, machineDeployment.Status.Phase)
+		}
+
+		// The replicas default to 1 when they are not set.
+		desired := ptr.Deref(machineDeployment.Spec.Replicas, 1)
+		status := machineDeployment.Status
+		statusMessage := fmt.Sprintf("%d of %d replicas ready, %d updated, %d total",
+			status.ReadyReplicas, desired, status.UpdatedReplicas, status.Replicas)
+
+		ready := status.ObservedGeneration >= machineDeployment.Generation &&
+			status.Replicas == desired && status.UpdatedReplicas == desired && status.ReadyReplicas == desired
+
+		return ready, statusMessage, nil
+	})
+}
+
+// GetMachineDeploymentGVR returns MachineDeployment's GroupVersionResource which could be used for Clean function.
+func GetMachineDeploymentGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group: "cluster.x-k8s.io", Version: "v1beta1", Resource: "machinedeployments",
+	}
+}
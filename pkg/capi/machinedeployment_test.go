@@ -0,0 +1,201 @@
+package capi
+
+import (
+	"testing"
+	"time"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common/testhelper"
+	clusterv1 "github.com/rh-ecosystem-edge/eco-goinfra/pkg/schemes/capi/v1beta1"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+)
+
+const defaultMachineDeploymentName = "test-workers"
+
+var machineDeploymentGVK = clusterv1.GroupVersion.WithKind("MachineDeployment")
+
+func TestNewMachineDeploymentBuilder(t *testing.T) {
+	t.Parallel()
+
+	testhelper.NewNamespacedBuilderTestConfig[clusterv1.MachineDeployment, MachineDeploymentBuilder](
+		func(apiClient *clients.Settings, name, nsname string) *MachineDeploymentBuilder {
+			return NewMachineDeploymentBuilder(apiClient, name, nsname, defaultClusterName)
+		}, clusterv1.AddToScheme, machineDeploymentGVK).ExecuteTests(t)
+}
+
+func TestNewMachineDeploymentBuilderClusterName(t *testing.T) {
+	t.Parallel()
+
+	testBuilder := NewMachineDeploymentBuilder(
+		buildTestClientWithCAPI(nil), defaultMachineDeploymentName, defaultCAPINamespace, "")
+	assert.EqualError(t, testBuilder.GetError(), "machineDeployment 'clusterName' cannot be empty")
+
+	testBuilder = newTestMachineDeploymentBuilder(nil)
+	assert.NoError(t, testBuilder.GetError())
+
+	expectedLabels := map[string]string{
+		clusterv1.ClusterNameLabel:           defaultClusterName,
+		clusterv1.MachineDeploymentNameLabel: defaultMachineDeploymentName,
+	}
+
+	assert.Equal(t, defaultClusterName, testBuilder.Definition.Spec.ClusterName)
+	assert.Equal(t, defaultClusterName, testBuilder.Definition.Spec.Template.Spec.ClusterName)
+	assert.Equal(t, expectedLabels, testBuilder.Definition.Spec.Selector.MatchLabels)
+	assert.Equal(t, expectedLabels, testBuilder.Definition.Spec.Template.Labels)
+}
+
+func TestPullMachineDeployment(t *testing.T) {
+	t.Parallel()
+
+	testhelper.NewNamespacedPullTestConfig[clusterv1.MachineDeployment, MachineDeploymentBuilder](
+		PullMachineDeployment, clusterv1.AddToScheme, machineDeploymentGVK).ExecuteTests(t)
+}
+
+func TestMachineDeploymentBuilderMethods(t *testing.T) {
+	t.Parallel()
+
+	commonConfig := testhelper.NewCommonTestConfig[clusterv1.MachineDeployment, MachineDeploymentBuilder](
+		clusterv1.AddToScheme, machineDeploymentGVK, testhelper.ResourceScopeNamespaced)
+
+	testhelper.NewTestSuite().
+		With(testhelper.NewGetTestConfig(commonConfig)).
+		With(testhelper.NewExistsTestConfig(commonConfig)).
+		With(testhelper.NewCreateTestConfig(commonConfig)).
+		With(testhelper.NewDeleterTestConfig(commonConfig)).
+		With(testhelper.NewUpdateTestConfig(commonConfig)).
+		Run(t)
+}
+
+func TestMachineDeploymentWithMachineSpec(t *testing.T) {
+	t.Parallel()
+
+	infrastructureRef := buildDummyMetal3MachineTemplateRef()
+	bootstrapRef := corev1.ObjectReference{
+		APIVersion: "bootstrap.cluster.x-k8s.io/v1beta1",
+		Kind:       "KubeadmConfigTemplate",
+		Name:       "test-bootstrap",
+	}
+
+	testBuilder := newTestMachineDeploymentBuilder(nil).
+		WithReplicas(3).
+		WithVersion("v1.31.2").
+		WithInfrastructureRef(infrastructureRef).
+		WithBootstrapConfigRef(bootstrapRef)
+	assert.NoError(t, testBuilder.GetError())
+	assert.Equal(t, ptr.To[int32](3), testBuilder.Definition.Spec.Replicas)
+	assert.Equal(t, ptr.To("v1.31.2"), testBuilder.Definition.Spec.Template.Spec.Version)
+	assert.Equal(t, infrastructureRef, testBuilder.Definition.Spec.Template.Spec.InfrastructureRef)
+	assert.Equal(t, clusterv1.Bootstrap{ConfigRef: &bootstrapRef}, testBuilder.Definition.Spec.Template.Spec.Bootstrap)
+
+	testBuilder = testBuilder.WithBootstrapDataSecret("worker-user-data")
+	assert.NoError(t, testBuilder.GetError())
+	assert.Equal(t, clusterv1.Bootstrap{DataSecretName: ptr.To("worker-user-data")},
+		testBuilder.Definition.Spec.Template.Spec.Bootstrap)
+
+	testCases := []struct {
+		builder       *MachineDeploymentBuilder
+		expectedError string
+	}{
+		{
+			builder:       newTestMachineDeploymentBuilder(nil).WithReplicas(-1),
+			expectedError: "machineDeployment 'replicas' cannot be negative",
+		},
+		{
+			builder:       newTestMachineDeploymentBuilder(nil).WithVersion(""),
+			expectedError: "machineDeployment 'version' cannot be empty",
+		},
+		{
+			builder:       newTestMachineDeploymentBuilder(nil).WithInfrastructureRef(corev1.ObjectReference{}),
+			expectedError: "machineDeployment infrastructureRef 'kind' cannot be empty",
+		},
+		{
+			builder:       newTestMachineDeploymentBuilder(nil).WithBootstrapConfigRef(corev1.ObjectReference{}),
+			expectedError: "machineDeployment bootstrap configRef 'kind' cannot be empty",
+		},
+		{
+			builder:       newTestMachineDeploymentBuilder(nil).WithBootstrapDataSecret(""),
+			expectedError: "machineDeployment bootstrap 'secretName' cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		assert.EqualError(t, testCase.builder.GetError(), testCase.expectedError)
+	}
+}
+
+func TestMachineDeploymentWaitUntilReady(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		replicas      *int32
+		status        clusterv1.MachineDeploymentStatus
+		expectedError string
+	}{
+		{
+			name:     "ready",
+			replicas: ptr.To[int32](2),
+			status: clusterv1.MachineDeploymentStatus{
+				Replicas: 2, UpdatedReplicas: 2, ReadyReplicas: 2, AvailableReplicas: 2,
+				Phase: string(clusterv1.MachineDeploymentPhaseRunning),
+			},
+		},
+		{
+			name:   "default replicas",
+			status: clusterv1.MachineDeploymentStatus{Replicas: 1, UpdatedReplicas: 1, ReadyReplicas: 1},
+		},
+		{
+			name:     "scaling up",
+			replicas: ptr.To[int32](3),
+			status: clusterv1.MachineDeploymentStatus{
+				Replicas: 3, UpdatedReplicas: 3, ReadyReplicas: 2,
+				Phase: string(clusterv1.MachineDeploymentPhaseScalingUp),
+			},
+			expectedError: "machineDeployment test-workers in namespace test-namespace is not provisioned: " +
+				"2 of 3 replicas ready, 3 updated, 3 total: context deadline exceeded",
+		},
+		{
+			name:     "failed",
+			replicas: ptr.To[int32](3),
+			status:   clusterv1.MachineDeploymentStatus{Phase: string(clusterv1.MachineDeploymentPhaseFailed)},
+			expectedError: "machineDeployment test-workers in namespace test-namespace is in phase " +
+				"Failed",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			machineDeployment := buildDummyMachineDeployment()
+			machineDeployment.Spec.Replicas = testCase.replicas
+			machineDeployment.Status = testCase.status
+
+			err := newTestMachineDeploymentBuilder([]runtime.Object{machineDeployment}).WaitUntilReady(time.Second)
+			if testCase.expectedError != "" {
+				assert.EqualError(t, err, testCase.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func newTestMachineDeploymentBuilder(objects []runtime.Object) *MachineDeploymentBuilder {
+	return NewMachineDeploymentBuilder(
+		buildTestClientWithCAPI(objects), defaultMachineDeploymentName, defaultCAPINamespace, defaultClusterName)
+}
+
+func buildDummyMachineDeployment() *clusterv1.MachineDeployment {
+	return &clusterv1.MachineDeployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      defaultMachineDeploymentName,
+			Namespace: defaultCAPINamespace,
+		},
+		Spec: clusterv1.MachineDeploymentSpec{ClusterName: defaultClusterName},
+	}
+}
@@ -0,0 +1,141 @@
+package capi
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common"
+	clusterv1 "github.com/rh-ecosystem-edge/eco-goinfra/pkg/schemes/capi/v1beta1"
+	capm3v1beta1 "github.com/rh-ecosystem-edge/eco-goinfra/pkg/schemes/capm3/v1beta1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/klog/v2"
+	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Metal3MachineBuilder provides a struct for the Metal3Machine resource containing a connection to the cluster and the
+// Metal3Machine definition. Metal3Machines are created by CAPI from the Metal3MachineTemplate of a MachineDeployment
+// and each consumes a BareMetalHost, so they can only be pulled, listed, and deleted.
+type Metal3MachineBuilder struct {
+	common.EmbeddableBuilder[capm3v1beta1.Metal3Machine, *capm3v1beta1.Metal3Machine]
+	common.EmbeddableDeleter[capm3v1beta1.Metal3Machine, *capm3v1beta1.Metal3Machine]
+}
+
+// AttachMixins wires the embedded CRUD mixins to this builder instance.
+func (builder *Metal3MachineBuilder) AttachMixins() {
+	builder.EmbeddableDeleter.SetBase(builder)
+}
+
+// GetGVK returns the Metal3Machine GVK for this builder.
+func (builder *Metal3MachineBuilder) GetGVK() schema.GroupVersionKind {
+	return capm3v1beta1.GroupVersion.WithKind("Metal3Machine")
+}
+
+// PullMetal3Machine retrieves an existing Metal3Machine from the cluster.
+func PullMetal3Machine(apiClient *clients.Settings, name, nsname string) (*Metal3MachineBuilder, error) {
+	return common.PullNamespacedBuilder[capm3v1beta1.Metal3Machine, Metal3MachineBuilder](
+		context.TODO(), apiClient, capm3v1beta1.AddToScheme, name, nsname)
+}
+
+// ListMetal3Machines returns the Metal3Machines in the namespace matching the provided options.
+func ListMetal3Machines(
+	apiClient *clients.Settings, nsname string, options ...runtimeclient.ListOption) ([]*Metal3MachineBuilder, error) {
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	if nsname == "" {
+		klog.V(100).Info("Metal3Machine 'nsname' parameter can not be empty")
+
+		return nil, fmt.Errorf("failed to list metal3Machines, 'nsname' parameter is empty")
+	}
+
+	allOptions := append([]runtimeclient.ListOption{runtimeclient.InNamespace(nsname)}, options...)
+
+	return common.List[capm3v1beta1.Metal3Machine, capm3v1beta1.Metal3MachineList, Metal3MachineBuilder](
+		context.TODO(), apiClient, capm3v1beta1.AddToScheme, allOptions...)
+}
+
+// ListMetal3MachinesForCluster returns the Metal3Machines in the namespace of the Cluster clusterName, optionally only
+// those of the MachineDeployment machineDeploymentName.
+func ListMetal3MachinesForCluster(
+	apiClient *clients.Settings, clusterName, nsname, machineDeploymentName string) ([]*Metal3MachineBuilder, error) {
+	if clusterName == "" {
+		klog.V(100).Info("Metal3Machine 'clusterName' parameter can not be empty")
+
+		return nil, fmt.Errorf("failed to list metal3Machines, 'clusterName' parameter is empty")
+	}
+
+	labels := runtimeclient.MatchingLabels{clusterv1.ClusterNameLabel: clusterName}
+
+	if machineDeploymentName != "" {
+		labels[clusterv1.MachineDeploymentNameLabel] = machineDeploymentName
+	}
+
+	return ListMetal3Machines(apiClient, nsname, labels)
+}
+
+// GetBareMetalHost returns the namespace and name of the BareMetalHost consumed by the Metal3Machine, which are set
+// once CAPM3 has associated it with a host.
+func (builder *Metal3MachineBuilder) GetBareMetalHost() (string, string, error) {
+	if err := common.Validate(builder); err != nil {
+		return "", "", err
+	}
+
+	metal3Machine, err := builder.Get()
+	if err != nil {
+		return "", "", err
+	}
+
+	builder.Object = metal3Machine
+
+	host, ok := metal3Machine.Annotations[capm3v1beta1.HostAnnotation]
+	if !ok {
+		return "", "", fmt.Errorf("metal3Machine %s in namespace %s is not associated with a BareMetalHost",
+			builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	hostNamespace, hostName, found := strings.Cut(host, "/")
+	if !found || hostNamespace == "" || hostName == "" {
+		return "", "", fmt.Errorf("metal3Machine %s in namespace %s has invalid BareMetalHost annotation %q",
+			builder.Definition.Name, builder.Definition.Namespace, host)
+	}
+
+	return hostNamespace, hostName, nil
+}
+
+// WaitUntilReady waits up to timeout for the BareMetalHost of the Metal3Machine to be provisioned and the
+// Metal3Machine to report that it is ready. It returns early if the Metal3Machine reports a failure. On timeout, the
+// error includes the last phase of the Metal3Machine.
+func (builder *Metal3MachineBuilder) WaitUntilReady(timeout time.Duration) error {
+	if err := common.Validate(builder); err != nil {
+		return err
+	}
+
+	resource := describeResource("metal3Machine", builder.Definition.Name, builder.Definition.Namespace)
+
+	return waitForProvisioning(resource, timeout, func() (bool, string, error) {
+		metal3Machine, err := builder.Get()
+		if err != nil {
+			klog.V(100).Infof("Failed to get %s: %v", resource, err)
+
+			return false, "", nil
+		}
+
+		builder.Object = metal3Machine
+
+		err = getFailure(resource, metal3Machine.Status.FailureReason, metal3Machine.Status.FailureMessage)
+		if err != nil {
+			return false, "", err
+		}
+
+		return metal3Machine.Status.Ready, fmt.Sprintf("phase %q", metal3Machine.Status.Phase), nil
+	})
+}
+
+// GetMetal3MachineGVR returns Metal3Machine's GroupVersionResource which could be used for Clean function.
+func GetMetal3MachineGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group: "infrastructure.cluster.x-k8s.io", Version: "v1beta1", Resource: "metal3machines",
+	}
+}
@@ -0,0 +1,188 @@
+package capi
+
+import (
+	"testing"
+	"time"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common/testhelper"
+	clusterv1 "github.com/rh-ecosystem-edge/eco-goinfra/pkg/schemes/capi/v1beta1"
+	capm3v1beta1 "github.com/rh-ecosystem-edge/eco-goinfra/pkg/schemes/capm3/v1beta1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+)
+
+const defaultMetal3MachineName = "test-workers-abcde"
+
+var metal3MachineGVK = capm3v1beta1.GroupVersion.WithKind("Metal3Machine")
+
+func TestPullMetal3Machine(t *testing.T) {
+	t.Parallel()
+
+	testhelper.NewNamespacedPullTestConfig[capm3v1beta1.Metal3Machine, Metal3MachineBuilder](
+		PullMetal3Machine, capm3v1beta1.AddToScheme, metal3MachineGVK).ExecuteTests(t)
+}
+
+func TestMetal3MachineBuilderMethods(t *testing.T) {
+	t.Parallel()
+
+	commonConfig := testhelper.NewCommonTestConfig[capm3v1beta1.Metal3Machine, Metal3MachineBuilder](
+		capm3v1beta1.AddToScheme, metal3MachineGVK, testhelper.ResourceScopeNamespaced)
+
+	testhelper.NewTestSuite().
+		With(testhelper.NewGetTestConfig(commonConfig)).
+		With(testhelper.NewExistsTestConfig(commonConfig)).
+		With(testhelper.NewDeleterTestConfig(commonConfig)).
+		Run(t)
+}
+
+func TestListMetal3MachinesForCluster(t *testing.T) {
+	t.Parallel()
+
+	otherDeployment := buildDummyMetal3Machine("test-infra-fghij")
+	otherDeployment.Labels[clusterv1.MachineDeploymentNameLabel] = "test-infra"
+
+	otherCluster := buildDummyMetal3Machine("other-workers-klmno")
+	otherCluster.Labels[clusterv1.ClusterNameLabel] = "other-cluster"
+
+	testClient := buildTestClientWithCAPI(
+		[]runtime.Object{buildDummyMetal3Machine(defaultMetal3MachineName), otherDeployment, otherCluster})
+
+	builders, err := ListMetal3MachinesForCluster(
+		testClient, defaultClusterName, defaultCAPINamespace, defaultMachineDeploymentName)
+	require.NoError(t, err)
+	require.Len(t, builders, 1)
+	assert.Equal(t, defaultMetal3MachineName, builders[0].Definition.Name)
+
+	builders, err = ListMetal3MachinesForCluster(testClient, defaultClusterName, defaultCAPINamespace, "")
+	assert.NoError(t, err)
+	assert.Len(t, builders, 2)
+
+	_, err = ListMetal3MachinesForCluster(testClient, "", defaultCAPINamespace, "")
+	assert.EqualError(t, err, "failed to list metal3Machines, 'clusterName' parameter is empty")
+
+	_, err = ListMetal3Machines(testClient, "")
+	assert.EqualError(t, err, "failed to list metal3Machines, 'nsname' parameter is empty")
+}
+
+func TestMetal3MachineGetBareMetalHost(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name              string
+		annotation        string
+		expectedNamespace string
+		expectedName      string
+		expectedError     string
+	}{
+		{
+			name:              "associated",
+			annotation:        "openshift-machine-api/worker-0",
+			expectedNamespace: "openshift-machine-api",
+			expectedName:      "worker-0",
+		},
+		{
+			name: "not associated",
+			expectedError: "metal3Machine test-workers-abcde in namespace test-namespace is not associated with a " +
+				"BareMetalHost",
+		},
+		{
+			name:       "invalid",
+			annotation: "worker-0",
+			expectedError: "metal3Machine test-workers-abcde in namespace test-namespace has invalid BareMetalHost " +
+				"annotation \"worker-0\"",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			metal3Machine := buildDummyMetal3Machine(defaultMetal3MachineName)
+			if testCase.annotation != "" {
+				metal3Machine.Annotations = map[string]string{capm3v1beta1.HostAnnotation: testCase.annotation}
+			}
+
+			testBuilder := newTestMetal3MachineBuilder(t, metal3Machine)
+
+			hostNamespace, hostName, err := testBuilder.GetBareMetalHost()
+			if testCase.expectedError != "" {
+				assert.EqualError(t, err, testCase.expectedError)
+
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.expectedNamespace, hostNamespace)
+			assert.Equal(t, testCase.expectedName, hostName)
+		})
+	}
+}
+
+func TestMetal3MachineWaitUntilReady(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		status        capm3v1beta1.Metal3MachineStatus
+		expectedError string
+	}{
+		{
+			name:   "ready",
+			status: capm3v1beta1.Metal3MachineStatus{Ready: true, Phase: "Running"},
+		},
+		{
+			name:   "provisioning",
+			status: capm3v1beta1.Metal3MachineStatus{Phase: "Provisioning"},
+			expectedError: "metal3Machine test-workers-abcde in namespace test-namespace is not provisioned: " +
+				"phase \"Provisioning\": context deadline exceeded",
+		},
+		{
+			name:   "failed",
+			status: capm3v1beta1.Metal3MachineStatus{FailureMessage: ptr.To("no available host")},
+			expectedError: "metal3Machine test-workers-abcde in namespace test-namespace failed: unknown reason: " +
+				"no available host",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			metal3Machine := buildDummyMetal3Machine(defaultMetal3MachineName)
+			metal3Machine.Status = testCase.status
+
+			err := newTestMetal3MachineBuilder(t, metal3Machine).WaitUntilReady(time.Second)
+			if testCase.expectedError != "" {
+				assert.EqualError(t, err, testCase.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func newTestMetal3MachineBuilder(t *testing.T, metal3Machine *capm3v1beta1.Metal3Machine) *Metal3MachineBuilder {
+	t.Helper()
+
+	testBuilder, err := PullMetal3Machine(
+		buildTestClientWithCAPI([]runtime.Object{metal3Machine}), metal3Machine.Name, metal3Machine.Namespace)
+	require.NoError(t, err)
+
+	return testBuilder
+}
+
+func buildDummyMetal3Machine(name string) *capm3v1beta1.Metal3Machine {
+	return &capm3v1beta1.Metal3Machine{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: defaultCAPINamespace,
+			Labels: map[string]string{
+				clusterv1.ClusterNameLabel:           defaultClusterName,
+				clusterv1.MachineDeploymentNameLabel: defaultMachineDeploymentName,
+			},
+		},
+	}
+}
@@ -0,0 +1,205 @@
+package capi
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common"
+	capm3v1beta1 "github.com/rh-ecosystem-edge/eco-goinfra/pkg/schemes/capm3/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
+)
+
+// Metal3MachineTemplateBuilder provides a struct for the Metal3MachineTemplate resource containing a connection to the
+// cluster and the Metal3MachineTemplate definition. A Metal3MachineTemplate describes how the BareMetalHosts consumed
+// by the Metal3Machines of a MachineDeployment are selected and provisioned. Its spec is immutable, so it has no
+// Update method; create a new template and reference it from the MachineDeployment instead.
+type Metal3MachineTemplateBuilder struct {
+	common.EmbeddableBuilder[capm3v1beta1.Metal3MachineTemplate, *capm3v1beta1.Metal3MachineTemplate]
+	common.EmbeddableCreator[capm3v1beta1.Metal3MachineTemplate, Metal3MachineTemplateBuilder,
+		*capm3v1beta1.Metal3MachineTemplate, *Metal3MachineTemplateBuilder]
+	common.EmbeddableDeleter[capm3v1beta1.Metal3MachineTemplate, *capm3v1beta1.Metal3MachineTemplate]
+}
+
+// AttachMixins wires the embedded CRUD mixins to this builder instance.
+func (builder *Metal3MachineTemplateBuilder) AttachMixins() {
+	builder.EmbeddableCreator.SetBase(builder)
+	builder.EmbeddableDeleter.SetBase(builder)
+}
+
+// GetGVK returns the Metal3MachineTemplate GVK for this builder.
+func (builder *Metal3MachineTemplateBuilder) GetGVK() schema.GroupVersionKind {
+	return capm3v1beta1.GroupVersion.WithKind("Metal3MachineTemplate")
+}
+
+// NewMetal3MachineTemplateBuilder creates a new instance of Metal3MachineTemplateBuilder. What is provisioned on the
+// hosts must be set using either WithImage or WithCustomDeploy before it is created.
+func NewMetal3MachineTemplateBuilder(apiClient *clients.Settings, name, nsname string) *Metal3MachineTemplateBuilder {
+	klog.V(100).Infof(
+		"Initializing new Metal3MachineTemplate structure with the following params: name: %s, namespace: %s",
+		name, nsname)
+
+	return common.NewNamespacedBuilder[capm3v1beta1.Metal3MachineTemplate, Metal3MachineTemplateBuilder](
+		apiClient, capm3v1beta1.AddToScheme, name, nsname)
+}
+
+// PullMetal3MachineTemplate retrieves an existing Metal3MachineTemplate from the cluster.
+func PullMetal3MachineTemplate(
+	apiClient *clients.Settings, name, nsname string) (*Metal3MachineTemplateBuilder, error) {
+	return common.PullNamespacedBuilder[capm3v1beta1.Metal3MachineTemplate, Metal3MachineTemplateBuilder](
+		context.TODO(), apiClient, capm3v1beta1.AddToScheme, name, nsname)
+}
+
+// WithImage provisions the hosts with the disk image at url, verified using checksum, replacing any custom deploy
+// method. The checksum is either a checksum value or the URL of a checksum file.
+func (builder *Metal3MachineTemplateBuilder) WithImage(url, checksum string) *Metal3MachineTemplateBuilder {
+	if err := common.Validate(builder); err != nil {
+		return builder
+	}
+
+	klog.V(100).Infof("Setting image of Metal3MachineTemplate %s in namespace %s to %s",
+		builder.Definition.Name, builder.Definition.Namespace, url)
+
+	if url == "" {
+		builder.SetError(fmt.Errorf("metal3MachineTemplate image 'url' cannot be empty"))
+
+		return builder
+	}
+
+	if checksum == "" {
+		builder.SetError(fmt.Errorf("metal3MachineTemplate image 'checksum' cannot be empty"))
+
+		return builder
+	}
+
+	builder.Definition.Spec.Template.Spec.Image = capm3v1beta1.Image{URL: url, Checksum: checksum}
+	builder.Definition.Spec.Template.Spec.CustomDeploy = nil
+
+	return builder
+}
+
+// WithCustomDeploy provisions the hosts using the custom deploy method of the deploy ramdisk, such as install_coreos,
+// replacing any image.
+func (builder *Metal3MachineTemplateBuilder) WithCustomDeploy(method string) *Metal3MachineTemplateBuilder {
+	if err := common.Validate(builder); err != nil {
+		return builder
+	}
+
+	klog.V(100).Infof("Setting custom deploy method of Metal3MachineTemplate %s in namespace %s to %s",
+		builder.Definition.Name, builder.Definition.Namespace, method)
+
+	if method == "" {
+		builder.SetError(fmt.Errorf("metal3MachineTemplate custom deploy 'method' cannot be empty"))
+
+		return builder
+	}
+
+	builder.Definition.Spec.Template.Spec.CustomDeploy = &capm3v1beta1.CustomDeploy{Method: method}
+	builder.Definition.Spec.Template.Spec.Image = capm3v1beta1.Image{}
+
+	return builder
+}
+
+// WithHostSelector limits the BareMetalHosts that can be consumed to those with all of the labels.
+func (builder *Metal3MachineTemplateBuilder) WithHostSelector(
+	matchLabels map[string]string) *Metal3MachineTemplateBuilder {
+	if err := common.Validate(builder); err != nil {
+		return builder
+	}
+
+	klog.V(100).Infof("Setting host selector of Metal3MachineTemplate %s in namespace %s to %v",
+		builder.Definition.Name, builder.Definition.Namespace, matchLabels)
+
+	if len(matchLabels) == 0 {
+		builder.SetError(fmt.Errorf("metal3MachineTemplate host selector 'matchLabels' cannot be empty"))
+
+		return builder
+	}
+
+	builder.Definition.Spec.Template.Spec.HostSelector.MatchLabels = matchLabels
+
+	return builder
+}
+
+// WithDataTemplate sets the Metal3DataTemplate in the same namespace used to render the metadata and network data of
+// the hosts.
+func (builder *Metal3MachineTemplateBuilder) WithDataTemplate(dataTemplateName string) *Metal3MachineTemplateBuilder {
+	if err := common.Validate(builder); err != nil {
+		return builder
+	}
+
+	klog.V(100).Infof("Setting data template of Metal3MachineTemplate %s in namespace %s to %s",
+		builder.Definition.Name, builder.Definition.Namespace, dataTemplateName)
+
+	if dataTemplateName == "" {
+		builder.SetError(fmt.Errorf("metal3MachineTemplate 'dataTemplateName' cannot be empty"))
+
+		return builder
+	}
+
+	builder.Definition.Spec.Template.Spec.DataTemplate = &corev1.ObjectReference{Name: dataTemplateName}
+
+	return builder
+}
+
+// WithAutomatedCleaningMode sets whether the disks of the hosts are cleaned during provisioning and deprovisioning.
+func (builder *Metal3MachineTemplateBuilder) WithAutomatedCleaningMode(
+	mode capm3v1beta1.AutomatedCleaningMode) *Metal3MachineTemplateBuilder {
+	if err := common.Validate(builder); err != nil {
+		return builder
+	}
+
+	klog.V(100).Infof("Setting automated cleaning mode of Metal3MachineTemplate %s in namespace %s to %s",
+		builder.Definition.Name, builder.Definition.Namespace, mode)
+
+	if mode != capm3v1beta1.CleaningModeDisabled && mode != capm3v1beta1.CleaningModeMetadata {
+		builder.SetError(fmt.Errorf("metal3MachineTemplate automated cleaning mode %q is not supported", mode))
+
+		return builder
+	}
+
+	builder.Definition.Spec.Template.Spec.AutomatedCleaningMode = ptr.To(string(mode))
+
+	return builder
+}
+
+// WithNodeReuse sets whether the hosts released by a rolling upgrade of the MachineDeployment are reused for its new
+// machines.
+func (builder *Metal3MachineTemplateBuilder) WithNodeReuse(nodeReuse bool) *Metal3MachineTemplateBuilder {
+	if err := common.Validate(builder); err != nil {
+		return builder
+	}
+
+	klog.V(100).Infof("Setting node reuse of Metal3MachineTemplate %s in namespace %s to %t",
+		builder.Definition.Name, builder.Definition.Namespace, nodeReuse)
+
+	builder.Definition.Spec.NodeReuse = nodeReuse
+
+	return builder
+}
+
+// GetInfrastructureRef returns the reference to the Metal3MachineTemplate used as the infrastructure template of a
+// MachineDeployment, see MachineDeploymentBuilder.WithInfrastructureRef.
+func (builder *Metal3MachineTemplateBuilder) GetInfrastructureRef() (corev1.ObjectReference, error) {
+	if err := common.Validate(builder); err != nil {
+		return corev1.ObjectReference{}, err
+	}
+
+	return corev1.ObjectReference{
+		APIVersion: capm3v1beta1.GroupVersion.String(),
+		Kind:       builder.GetGVK().Kind,
+		Name:       builder.Definition.Name,
+		Namespace:  builder.Definition.Namespace,
+	}, nil
+}
+
+// GetMetal3MachineTemplateGVR returns Metal3MachineTemplate's GroupVersionResource
+// which could be used for Clean function.
+func GetMetal3MachineTemplateGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group: "infrastructure.cluster.x-k8s.io", Version: "v1beta1", Resource: "metal3machinetemplates",
+	}
+}
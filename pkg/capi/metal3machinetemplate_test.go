@@ -0,0 +1,127 @@
+package capi
+
+import (
+	"testing"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common/testhelper"
+	capm3v1beta1 "github.com/rh-ecosystem-edge/eco-goinfra/pkg/schemes/capm3/v1beta1"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
+)
+
+const (
+	defaultMetal3MachineTemplateName = "test-workers-template"
+	defaultImageURL                  = "http://172.22.0.1/images/rhcos.qcow2"
+	defaultImageChecksum             = "http://172.22.0.1/images/rhcos.qcow2.sha256sum"
+)
+
+var metal3MachineTemplateGVK = capm3v1beta1.GroupVersion.WithKind("Metal3MachineTemplate")
+
+func TestNewMetal3MachineTemplateBuilder(t *testing.T) {
+	t.Parallel()
+
+	testhelper.NewNamespacedBuilderTestConfig[capm3v1beta1.Metal3MachineTemplate, Metal3MachineTemplateBuilder](
+		NewMetal3MachineTemplateBuilder, capm3v1beta1.AddToScheme, metal3MachineTemplateGVK).ExecuteTests(t)
+}
+
+func TestPullMetal3MachineTemplate(t *testing.T) {
+	t.Parallel()
+
+	testhelper.NewNamespacedPullTestConfig[capm3v1beta1.Metal3MachineTemplate, Metal3MachineTemplateBuilder](
+		PullMetal3MachineTemplate, capm3v1beta1.AddToScheme, metal3MachineTemplateGVK).ExecuteTests(t)
+}
+
+func TestMetal3MachineTemplateBuilderMethods(t *testing.T) {
+	t.Parallel()
+
+	commonConfig := testhelper.NewCommonTestConfig[capm3v1beta1.Metal3MachineTemplate, Metal3MachineTemplateBuilder](
+		capm3v1beta1.AddToScheme, metal3MachineTemplateGVK, testhelper.ResourceScopeNamespaced)
+
+	testhelper.NewTestSuite().
+		With(testhelper.NewGetTestConfig(commonConfig)).
+		With(testhelper.NewExistsTestConfig(commonConfig)).
+		With(testhelper.NewCreateTestConfig(commonConfig)).
+		With(testhelper.NewDeleterTestConfig(commonConfig)).
+		Run(t)
+}
+
+func TestMetal3MachineTemplateWithProvisioning(t *testing.T) {
+	t.Parallel()
+
+	testBuilder := newTestMetal3MachineTemplateBuilder().WithCustomDeploy("install_coreos").
+		WithImage(defaultImageURL, defaultImageChecksum)
+	assert.NoError(t, testBuilder.GetError())
+	assert.Equal(t, capm3v1beta1.Image{URL: defaultImageURL, Checksum: defaultImageChecksum},
+		testBuilder.Definition.Spec.Template.Spec.Image)
+	assert.Nil(t, testBuilder.Definition.Spec.Template.Spec.CustomDeploy)
+
+	testBuilder = testBuilder.WithCustomDeploy("install_coreos")
+	assert.NoError(t, testBuilder.GetError())
+	assert.Equal(t, &capm3v1beta1.CustomDeploy{Method: "install_coreos"},
+		testBuilder.Definition.Spec.Template.Spec.CustomDeploy)
+	assert.Empty(t, testBuilder.Definition.Spec.Template.Spec.Image)
+
+	testBuilder = newTestMetal3MachineTemplateBuilder().WithImage("", defaultImageChecksum)
+	assert.EqualError(t, testBuilder.GetError(), "metal3MachineTemplate image 'url' cannot be empty")
+
+	testBuilder = newTestMetal3MachineTemplateBuilder().WithImage(defaultImageURL, "")
+	assert.EqualError(t, testBuilder.GetError(), "metal3MachineTemplate image 'checksum' cannot be empty")
+
+	testBuilder = newTestMetal3MachineTemplateBuilder().WithCustomDeploy("")
+	assert.EqualError(t, testBuilder.GetError(), "metal3MachineTemplate custom deploy 'method' cannot be empty")
+}
+
+func TestMetal3MachineTemplateWithHostOptions(t *testing.T) {
+	t.Parallel()
+
+	hostLabels := map[string]string{"infraenvs.agent-install.openshift.io": "workers"}
+
+	testBuilder := newTestMetal3MachineTemplateBuilder().
+		WithHostSelector(hostLabels).
+		WithDataTemplate("test-data-template").
+		WithAutomatedCleaningMode(capm3v1beta1.CleaningModeDisabled).
+		WithNodeReuse(true)
+	assert.NoError(t, testBuilder.GetError())
+
+	templateSpec := testBuilder.Definition.Spec.Template.Spec
+	assert.Equal(t, hostLabels, templateSpec.HostSelector.MatchLabels)
+	assert.Equal(t, &corev1.ObjectReference{Name: "test-data-template"}, templateSpec.DataTemplate)
+	assert.Equal(t, ptr.To("disabled"), templateSpec.AutomatedCleaningMode)
+	assert.True(t, testBuilder.Definition.Spec.NodeReuse)
+
+	testBuilder = newTestMetal3MachineTemplateBuilder().WithHostSelector(nil)
+	assert.EqualError(t, testBuilder.GetError(), "metal3MachineTemplate host selector 'matchLabels' cannot be empty")
+
+	testBuilder = newTestMetal3MachineTemplateBuilder().WithDataTemplate("")
+	assert.EqualError(t, testBuilder.GetError(), "metal3MachineTemplate 'dataTemplateName' cannot be empty")
+
+	testBuilder = newTestMetal3MachineTemplateBuilder().WithAutomatedCleaningMode("full")
+	assert.EqualError(t, testBuilder.GetError(), "metal3MachineTemplate automated cleaning mode \"full\" is not supported")
+}
+
+func TestMetal3MachineTemplateGetInfrastructureRef(t *testing.T) {
+	t.Parallel()
+
+	ref, err := newTestMetal3MachineTemplateBuilder().GetInfrastructureRef()
+	assert.NoError(t, err)
+	assert.Equal(t, buildDummyMetal3MachineTemplateRef(), ref)
+
+	_, err = NewMetal3MachineTemplateBuilder(nil, defaultMetal3MachineTemplateName, defaultCAPINamespace).
+		GetInfrastructureRef()
+	assert.Error(t, err)
+}
+
+func newTestMetal3MachineTemplateBuilder() *Metal3MachineTemplateBuilder {
+	return NewMetal3MachineTemplateBuilder(
+		buildTestClientWithCAPI(nil), defaultMetal3MachineTemplateName, defaultCAPINamespace)
+}
+
+func buildDummyMetal3MachineTemplateRef() corev1.ObjectReference {
+	return corev1.ObjectReference{
+		APIVersion: "infrastructure.cluster.x-k8s.io/v1beta1",
+		Kind:       "Metal3MachineTemplate",
+		Name:       defaultMetal3MachineTemplateName,
+		Namespace:  defaultCAPINamespace,
+	}
+}
@@ -0,0 +1,67 @@
+package capi
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
+)
+
+// provisioningPollInterval is how often the status of the resources is checked while waiting for them to be
+// provisioned, which takes several minutes on bare-metal hosts.
+const provisioningPollInterval = 10 * time.Second
+
+// provisioningChecker refreshes a resource and returns whether it is provisioned and a short description of its status
+// used in errors. It returns an error only when the resource reports a terminal failure, which stops the wait.
+type provisioningChecker func() (provisioned bool, status string, err error)
+
+// waitForProvisioning waits up to timeout for isProvisioned to report that the resource is provisioned. Failing to get
+// the resource is retried. On timeout, the error includes the last status of the resource. The resource is only used
+// in logs and errors.
+func waitForProvisioning(resource string, timeout time.Duration, isProvisioned provisioningChecker) error {
+	klog.V(100).Infof("Waiting up to %s for %s to be provisioned", timeout, resource)
+
+	lastStatus := "status unknown"
+
+	err := wait.PollUntilContextTimeout(
+		context.TODO(), provisioningPollInterval, timeout, true, func(ctx context.Context) (bool, error) {
+			provisioned, status, err := isProvisioned()
+			if err != nil {
+				return false, err
+			}
+
+			if status != "" {
+				lastStatus = status
+			}
+
+			return provisioned, nil
+		})
+	if err == nil {
+		return nil
+	}
+
+	if wait.Interrupted(err) {
+		return fmt.Errorf("%s is not provisioned: %s: %w", resource, lastStatus, err)
+	}
+
+	return err
+}
+
+// getFailure returns an error for the terminal failure reported in the failureReason and failureMessage status fields
+// of the resource, or nil if neither is set.
+func getFailure(resource string, failureReason, failureMessage *string) error {
+	if failureReason == nil && failureMessage == nil {
+		return nil
+	}
+
+	return fmt.Errorf("%s failed: %s: %s", resource, ptr.Deref(failureReason, "unknown reason"),
+		ptr.Deref(failureMessage, "no message"))
+}
+
+// describeResource returns the kind, name, and namespace of a resource for use in logs and errors.
+func describeResource(kind, name, nsname string) string {
+	return fmt.Sprintf("%s %s in namespace %s", kind, name, nsname)
+}
@@ -0,0 +1,263 @@
+package certificate
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/logging"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/secret"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/klog/v2"
+)
+
+const (
+	// certificateNotAfterAnnotation is set by the library-go certificate rotation controllers on the secrets they
+	// manage. Removing it makes the controller rotate the certificate.
+	certificateNotAfterAnnotation = "auth.openshift.io/certificate-not-after"
+	// serviceServingCertAnnotation is set by the service CA operator on the serving certificate secrets it creates
+	// for services. Deleting such a secret makes the operator issue a new certificate.
+	serviceServingCertAnnotation = "service.beta.openshift.io/originating-service-name"
+	// rotationPollInterval is how often the certificate is checked while waiting for it to be rotated.
+	rotationPollInterval = 5 * time.Second
+)
+
+// DefaultCertificateNamespaces are the namespaces holding the serving and client certificates of the control plane.
+// They are inspected by ListCertificates when no namespaces are provided.
+var DefaultCertificateNamespaces = []string{
+	"openshift-config-managed",
+	"openshift-etcd",
+	"openshift-ingress",
+	"openshift-kube-apiserver",
+	"openshift-kube-apiserver-operator",
+	"openshift-kube-controller-manager",
+	"openshift-kube-controller-manager-operator",
+	"openshift-kube-scheduler",
+	"openshift-service-ca",
+}
+
+// CertificateInfo describes the leaf certificate stored in the tls.crt key of a secret.
+type CertificateInfo struct {
+	// Namespace is the namespace of the secret.
+	Namespace string
+	// SecretName is the name of the secret.
+	SecretName string
+	// Subject is the subject of the certificate.
+	Subject string
+	// Issuer is the issuer of the certificate.
+	Issuer string
+	// SerialNumber is the serial number of the certificate. It changes when the certificate is rotated.
+	SerialNumber string
+	// DNSNames are the DNS subject alternative names of the certificate.
+	DNSNames []string
+	// IsCA is whether the certificate is a certificate authority, such as a signer.
+	IsCA bool
+	// NotBefore is when the certificate becomes valid.
+	NotBefore time.Time
+	// NotAfter is when the certificate expires.
+	NotAfter time.Time
+}
+
+// TimeToExpiry returns how long until the certificate expires. It is negative for expired certificates.
+func (info CertificateInfo) TimeToExpiry() time.Duration {
+	return time.Until(info.NotAfter)
+}
+
+// DaysToExpiry returns the number of whole days until the certificate expires. It is negative for expired
+// certificates.
+func (info CertificateInfo) DaysToExpiry() int {
+	return int(info.TimeToExpiry().Hours() / 24)
+}
+
+// ListCertificates returns the certificates of the TLS secrets in the provided namespaces, sorted by expiry with the
+// soonest to expire first. If no namespaces are provided, DefaultCertificateNamespaces are used. Secrets that do not
+// contain a parsable certificate are skipped.
+func ListCertificates(apiClient *clients.Settings, namespaces ...string) ([]CertificateInfo, error) {
+	if apiClient == nil {
+		klog.V(100).Info("The apiClient is empty")
+
+		return nil, fmt.Errorf("certificate list 'apiClient' cannot be empty")
+	}
+
+	if len(namespaces) == 0 {
+		namespaces = DefaultCertificateNamespaces
+	}
+
+	klog.V(100).Infof("Listing certificates in namespaces %v", namespaces)
+
+	var certificates []CertificateInfo
+
+	for _, namespace := range namespaces {
+		secretList, err := apiClient.Secrets(namespace).List(logging.DiscardContext(), metav1.ListOptions{})
+		if err != nil {
+			klog.V(100).Infof("Failed to list secrets in namespace %s: %v", namespace, err)
+
+			return nil, err
+		}
+
+		for index := range secretList.Items {
+			info, err := parseCertificateSecret(&secretList.Items[index])
+			if err != nil {
+				klog.V(100).Infof("Skipping secret %s in namespace %s: %v",
+					secretList.Items[index].Name, namespace, err)
+
+				continue
+			}
+
+			certificates = append(certificates, *info)
+		}
+	}
+
+	sort.SliceStable(certificates, func(i, j int) bool {
+		return certificates[i].NotAfter.Before(certificates[j].NotAfter)
+	})
+
+	return certificates, nil
+}
+
+// ListExpiringCertificates returns the certificates from ListCertificates that expire within the provided duration,
+// including those that have already expired.
+func ListExpiringCertificates(
+	apiClient *clients.Settings, within time.Duration, namespaces ...string) ([]CertificateInfo, error) {
+	certificates, err := ListCertificates(apiClient, namespaces...)
+	if err != nil {
+		return nil, err
+	}
+
+	klog.V(100).Infof("Filtering certificates expiring within %s", within)
+
+	var expiring []CertificateInfo
+
+	for _, info := range certificates {
+		if info.TimeToExpiry() <= within {
+			expiring = append(expiring, info)
+		}
+	}
+
+	return expiring, nil
+}
+
+// GetCertificate returns the certificate stored in the secret with the provided name and namespace.
+func GetCertificate(apiClient *clients.Settings, name, nsname string) (*CertificateInfo, error) {
+	secretBuilder, err := secret.Pull(apiClient, name, nsname)
+	if err != nil {
+		return nil, err
+	}
+
+	return parseCertificateSecret(secretBuilder.Object)
+}
+
+// ForceRotation makes the operator managing the certificate in the secret with the provided name and namespace issue
+// a new one. Certificates managed by the library-go rotation controllers are rotated by removing their not-after
+// annotation and service serving certificates are rotated by deleting their secret. Other secrets are not supported.
+func ForceRotation(apiClient *clients.Settings, name, nsname string) error {
+	secretBuilder, err := secret.Pull(apiClient, name, nsname)
+	if err != nil {
+		return err
+	}
+
+	klog.V(100).Infof("Forcing rotation of certificate in secret %s in namespace %s", name, nsname)
+
+	annotations := secretBuilder.Definition.Annotations
+
+	if _, ok := annotations[certificateNotAfterAnnotation]; ok {
+		delete(secretBuilder.Definition.Annotations, certificateNotAfterAnnotation)
+
+		_, err = secretBuilder.Update()
+		if err != nil {
+			return fmt.Errorf("failed to remove %s annotation from secret %s in namespace %s: %w",
+				certificateNotAfterAnnotation, name, nsname, err)
+		}
+
+		return nil
+	}
+
+	if _, ok := annotations[serviceServingCertAnnotation]; ok {
+		err = secretBuilder.Delete()
+		if err != nil {
+			return fmt.Errorf("failed to delete service serving certificate secret %s in namespace %s: %w",
+				name, nsname, err)
+		}
+
+		return nil
+	}
+
+	klog.V(100).Infof("The secret %s in namespace %s is not managed by a supported rotation controller", name, nsname)
+
+	return fmt.Errorf("rotation of certificate in secret %s in namespace %s is not supported", name, nsname)
+}
+
+// WaitForRotation waits up to timeout for the certificate in the secret with the provided name and namespace to have
+// a serial number different from previousSerial, then returns the new certificate.
+func WaitForRotation(
+	apiClient *clients.Settings, name, nsname, previousSerial string, timeout time.Duration) (*CertificateInfo, error) {
+	if previousSerial == "" {
+		klog.V(100).Info("The previous certificate serial number is empty")
+
+		return nil, fmt.Errorf("certificate 'previousSerial' cannot be empty")
+	}
+
+	klog.V(100).Infof("Waiting up to %s for certificate in secret %s in namespace %s to be rotated",
+		timeout, name, nsname)
+
+	var rotated *CertificateInfo
+
+	err := wait.PollUntilContextTimeout(
+		context.TODO(), rotationPollInterval, timeout, true, func(ctx context.Context) (bool, error) {
+			info, err := GetCertificate(apiClient, name, nsname)
+			if err != nil {
+				klog.V(100).Infof("Failed to get certificate in secret %s in namespace %s: %v", name, nsname, err)
+
+				return false, nil
+			}
+
+			if info.SerialNumber == previousSerial {
+				return false, nil
+			}
+
+			rotated = info
+
+			return true, nil
+		})
+	if err != nil {
+		return nil, fmt.Errorf("certificate in secret %s in namespace %s was not rotated: %w", name, nsname, err)
+	}
+
+	return rotated, nil
+}
+
+// parseCertificateSecret parses the first certificate in the tls.crt key of secret, which is the leaf certificate.
+func parseCertificateSecret(secret *corev1.Secret) (*CertificateInfo, error) {
+	certPEM, ok := secret.Data[corev1.TLSCertKey]
+	if !ok || len(certPEM) == 0 {
+		return nil, fmt.Errorf("secret has no %s key", corev1.TLSCertKey)
+	}
+
+	block, _ := pem.Decode(certPEM)
+	if block == nil || block.Type != "CERTIFICATE" {
+		return nil, fmt.Errorf("%s does not contain a PEM encoded certificate", corev1.TLSCertKey)
+	}
+
+	certificate, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse certificate: %w", err)
+	}
+
+	return &CertificateInfo{
+		Namespace:    secret.Namespace,
+		SecretName:   secret.Name,
+		Subject:      certificate.Subject.String(),
+		Issuer:       certificate.Issuer.String(),
+		SerialNumber: certificate.SerialNumber.String(),
+		DNSNames:     certificate.DNSNames,
+		IsCA:         certificate.IsCA,
+		NotBefore:    certificate.NotBefore,
+		NotAfter:     certificate.NotAfter,
+	}, nil
+}
@@ -0,0 +1,289 @@
+package certificate
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const defaultCertNamespace = "openshift-kube-apiserver"
+
+func TestCertificateInfoDaysToExpiry(t *testing.T) {
+	testCases := []struct {
+		notAfter     time.Time
+		expectedDays int
+	}{
+		{
+			notAfter:     time.Now().Add(30*24*time.Hour + time.Hour),
+			expectedDays: 30,
+		},
+		{
+			notAfter:     time.Now().Add(-48*time.Hour - time.Hour),
+			expectedDays: -2,
+		},
+	}
+
+	for _, testCase := range testCases {
+		info := CertificateInfo{NotAfter: testCase.notAfter}
+		assert.Equal(t, testCase.expectedDays, info.DaysToExpiry())
+	}
+}
+
+func TestListCertificates(t *testing.T) {
+	testCases := []struct {
+		client          bool
+		namespaces      []string
+		expectedSecrets []string
+		expectedError   error
+	}{
+		{
+			client:          true,
+			namespaces:      []string{defaultCertNamespace},
+			expectedSecrets: []string{"short-lived", "long-lived"},
+			expectedError:   nil,
+		},
+		{
+			client:          true,
+			namespaces:      []string{"other"},
+			expectedSecrets: nil,
+			expectedError:   nil,
+		},
+		{
+			client:          false,
+			namespaces:      []string{defaultCertNamespace},
+			expectedSecrets: nil,
+			expectedError:   fmt.Errorf("certificate list 'apiClient' cannot be empty"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		var testSettings *clients.Settings
+
+		if testCase.client {
+			testSettings = buildTestClientWithCertificateSecrets(t)
+		}
+
+		certificates, err := ListCertificates(testSettings, testCase.namespaces...)
+		assert.Equal(t, testCase.expectedError, err)
+		assert.Equal(t, testCase.expectedSecrets, getCertificateSecretNames(certificates))
+	}
+}
+
+func TestListExpiringCertificates(t *testing.T) {
+	testCases := []struct {
+		within          time.Duration
+		expectedSecrets []string
+	}{
+		{
+			within:          7 * 24 * time.Hour,
+			expectedSecrets: []string{"short-lived"},
+		},
+		{
+			within:          time.Hour,
+			expectedSecrets: nil,
+		},
+		{
+			within:          400 * 24 * time.Hour,
+			expectedSecrets: []string{"short-lived", "long-lived"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		certificates, err := ListExpiringCertificates(
+			buildTestClientWithCertificateSecrets(t), testCase.within, defaultCertNamespace)
+		assert.Nil(t, err)
+		assert.Equal(t, testCase.expectedSecrets, getCertificateSecretNames(certificates))
+	}
+}
+
+func TestGetCertificate(t *testing.T) {
+	testCases := []struct {
+		name          string
+		expectedError string
+	}{
+		{
+			name:          "long-lived",
+			expectedError: "",
+		},
+		{
+			name:          "not-a-certificate",
+			expectedError: "tls.crt does not contain a PEM encoded certificate",
+		},
+		{
+			name:          "missing",
+			expectedError: fmt.Sprintf("secret object missing does not exist in namespace %s", defaultCertNamespace),
+		},
+	}
+
+	for _, testCase := range testCases {
+		info, err := GetCertificate(buildTestClientWithCertificateSecrets(t), testCase.name, defaultCertNamespace)
+
+		if testCase.expectedError != "" {
+			assert.EqualError(t, err, testCase.expectedError)
+
+			continue
+		}
+
+		assert.Nil(t, err)
+		assert.Equal(t, "CN=long-lived", info.Subject)
+		assert.Equal(t, []string{"long-lived.example.com"}, info.DNSNames)
+		assert.Equal(t, "2", info.SerialNumber)
+		assert.Equal(t, 364, info.DaysToExpiry())
+	}
+}
+
+func TestForceRotation(t *testing.T) {
+	testCases := []struct {
+		name          string
+		expectedError string
+	}{
+		{
+			name:          "long-lived",
+			expectedError: "",
+		},
+		{
+			name:          "short-lived",
+			expectedError: "",
+		},
+		{
+			name: "not-a-certificate",
+			expectedError: fmt.Sprintf(
+				"rotation of certificate in secret not-a-certificate in namespace %s is not supported", defaultCertNamespace),
+		},
+	}
+
+	for _, testCase := range testCases {
+		testSettings := buildTestClientWithCertificateSecrets(t)
+
+		err := ForceRotation(testSettings, testCase.name, defaultCertNamespace)
+		if testCase.expectedError != "" {
+			assert.EqualError(t, err, testCase.expectedError)
+
+			continue
+		}
+
+		assert.Nil(t, err)
+
+		rotated, err := testSettings.Secrets(defaultCertNamespace).Get(t.Context(), testCase.name, metav1.GetOptions{})
+
+		switch testCase.name {
+		case "long-lived":
+			assert.Nil(t, err)
+			assert.NotContains(t, rotated.Annotations, certificateNotAfterAnnotation)
+		case "short-lived":
+			assert.NotNil(t, err)
+		}
+	}
+}
+
+func TestWaitForRotation(t *testing.T) {
+	testCases := []struct {
+		previousSerial string
+		expectedError  string
+	}{
+		{
+			previousSerial: "1",
+			expectedError:  "",
+		},
+		{
+			previousSerial: "2",
+			expectedError: fmt.Sprintf(
+				"certificate in secret long-lived in namespace %s was not rotated: context deadline exceeded",
+				defaultCertNamespace),
+		},
+		{
+			previousSerial: "",
+			expectedError:  "certificate 'previousSerial' cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		info, err := WaitForRotation(
+			buildTestClientWithCertificateSecrets(t), "long-lived", defaultCertNamespace, testCase.previousSerial, time.Second)
+
+		if testCase.expectedError != "" {
+			assert.EqualError(t, err, testCase.expectedError)
+
+			continue
+		}
+
+		assert.Nil(t, err)
+		assert.Equal(t, "2", info.SerialNumber)
+	}
+}
+
+// buildTestClientWithCertificateSecrets returns a client with a long lived certificate managed by a rotation
+// controller, a short lived service serving certificate, and a secret without a certificate.
+func buildTestClientWithCertificateSecrets(t *testing.T) *clients.Settings {
+	t.Helper()
+
+	return clients.GetTestClients(clients.TestClientParams{
+		K8sMockObjects: []runtime.Object{
+			buildDummyCertificateSecret(t, "long-lived", 2, 365*24*time.Hour,
+				map[string]string{certificateNotAfterAnnotation: "2027-01-01T00:00:00Z"}),
+			buildDummyCertificateSecret(t, "short-lived", 1, 3*24*time.Hour,
+				map[string]string{serviceServingCertAnnotation: "short-lived"}),
+			&corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "not-a-certificate", Namespace: defaultCertNamespace},
+				Data:       map[string][]byte{corev1.TLSCertKey: []byte("invalid")},
+			},
+		},
+	})
+}
+
+// buildDummyCertificateSecret returns a TLS secret with a self-signed certificate with the provided serial number
+// that expires after validity.
+func buildDummyCertificateSecret(
+	t *testing.T, name string, serial int64, validity time.Duration, annotations map[string]string) *corev1.Secret {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.Nil(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(serial),
+		Subject:      pkix.Name{CommonName: name},
+		DNSNames:     []string{name + ".example.com"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(validity),
+	}
+
+	certDER, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	assert.Nil(t, err)
+
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        name,
+			Namespace:   defaultCertNamespace,
+			Annotations: annotations,
+		},
+		Type: corev1.SecretTypeTLS,
+		Data: map[string][]byte{
+			corev1.TLSCertKey: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certDER}),
+		},
+	}
+}
+
+// getCertificateSecretNames returns the secret names of certificates in order.
+func getCertificateSecretNames(certificates []CertificateInfo) []string {
+	var names []string
+
+	for _, info := range certificates {
+		names = append(names, info.SecretName)
+	}
+
+	return names
+}
@@ -0,0 +1,399 @@
+package certificate
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net"
+	"slices"
+	"strconv"
+	"time"
+
+	configv1 "github.com/openshift/api/config/v1"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/apiservers"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/configmap"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/route"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/service"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/klog/v2"
+)
+
+const (
+	// DefaultIngressCAConfigMap is the name of the configmap in DefaultIngressCANamespace holding the CA bundle that
+	// signs the default certificate of the ingress controller, which is used by routes without their own certificate.
+	DefaultIngressCAConfigMap = "default-ingress-cert"
+	// DefaultIngressCANamespace is the namespace of DefaultIngressCAConfigMap.
+	DefaultIngressCANamespace = "openshift-config-managed"
+	// DefaultIngressCAKey is the key of the CA bundle in DefaultIngressCAConfigMap.
+	DefaultIngressCAKey = "ca-bundle.crt"
+	// ServiceCAConfigMap is the name of the configmap present in every namespace holding the CA bundle that signs the
+	// serving certificates issued to services by the service CA operator.
+	ServiceCAConfigMap = "openshift-service-ca.crt"
+	// ServiceCAKey is the key of the CA bundle in ServiceCAConfigMap.
+	ServiceCAKey = "service-ca.crt"
+	// routeTLSPort is the port the ingress controller serves routes over TLS on.
+	routeTLSPort = 443
+)
+
+// tlsVersions maps the TLS protocol versions of TLS security profiles to their crypto/tls values.
+var tlsVersions = map[configv1.TLSProtocolVersion]uint16{
+	configv1.VersionTLS10: tls.VersionTLS10,
+	configv1.VersionTLS11: tls.VersionTLS11,
+	configv1.VersionTLS12: tls.VersionTLS12,
+	configv1.VersionTLS13: tls.VersionTLS13,
+}
+
+// openSSLCipherSuites maps the OpenSSL names used by TLS security profiles for TLS 1.2 and older cipher suites to the
+// IANA names used by crypto/tls. TLS 1.3 cipher suites use their IANA names in profiles.
+var openSSLCipherSuites = map[string]string{
+	"ECDHE-ECDSA-AES128-GCM-SHA256": "TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256",
+	"ECDHE-RSA-AES128-GCM-SHA256":   "TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256",
+	"ECDHE-ECDSA-AES256-GCM-SHA384": "TLS_ECDHE_ECDSA_WITH_AES_256_GCM_SHA384",
+	"ECDHE-RSA-AES256-GCM-SHA384":   "TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384",
+	"ECDHE-ECDSA-CHACHA20-POLY1305": "TLS_ECDHE_ECDSA_WITH_CHACHA20_POLY1305_SHA256",
+	"ECDHE-RSA-CHACHA20-POLY1305":   "TLS_ECDHE_RSA_WITH_CHACHA20_POLY1305_SHA256",
+	"ECDHE-ECDSA-AES128-SHA256":     "TLS_ECDHE_ECDSA_WITH_AES_128_CBC_SHA256",
+	"ECDHE-RSA-AES128-SHA256":       "TLS_ECDHE_RSA_WITH_AES_128_CBC_SHA256",
+	"ECDHE-ECDSA-AES128-SHA":        "TLS_ECDHE_ECDSA_WITH_AES_128_CBC_SHA",
+	"ECDHE-RSA-AES128-SHA":          "TLS_ECDHE_RSA_WITH_AES_128_CBC_SHA",
+	"ECDHE-ECDSA-AES256-SHA":        "TLS_ECDHE_ECDSA_WITH_AES_256_CBC_SHA",
+	"ECDHE-RSA-AES256-SHA":          "TLS_ECDHE_RSA_WITH_AES_256_CBC_SHA",
+	"AES128-GCM-SHA256":             "TLS_RSA_WITH_AES_128_GCM_SHA256",
+	"AES256-GCM-SHA384":             "TLS_RSA_WITH_AES_256_GCM_SHA384",
+	"AES128-SHA256":                 "TLS_RSA_WITH_AES_128_CBC_SHA256",
+	"AES128-SHA":                    "TLS_RSA_WITH_AES_128_CBC_SHA",
+	"AES256-SHA":                    "TLS_RSA_WITH_AES_256_CBC_SHA",
+	"DES-CBC3-SHA":                  "TLS_RSA_WITH_3DES_EDE_CBC_SHA",
+}
+
+// TLSEndpoint is a TLS endpoint to validate.
+type TLSEndpoint struct {
+	// Address is the host and port to connect to.
+	Address string
+	// ServerName is sent using SNI and is the hostname the certificate is validated against.
+	ServerName string
+}
+
+// TLSConnectionInfo describes the TLS connection negotiated with an endpoint.
+type TLSConnectionInfo struct {
+	// ServerName is the server name the connection was made with.
+	ServerName string
+	// Version is the negotiated TLS version, such as tls.VersionTLS13.
+	Version uint16
+	// CipherSuite is the negotiated cipher suite.
+	CipherSuite uint16
+	// PeerCertificates is the certificate chain presented by the endpoint, starting with the leaf certificate.
+	PeerCertificates []*x509.Certificate
+}
+
+// VersionName returns the name of the negotiated TLS version, such as TLS 1.3.
+func (info *TLSConnectionInfo) VersionName() string {
+	return tls.VersionName(info.Version)
+}
+
+// CipherSuiteName returns the IANA name of the negotiated cipher suite.
+func (info *TLSConnectionInfo) CipherSuiteName() string {
+	return tls.CipherSuiteName(info.CipherSuite)
+}
+
+// GetRouteEndpoint returns the TLS endpoint of the route, served by the ingress controller on port 443 of the route
+// host.
+func GetRouteEndpoint(apiClient *clients.Settings, name, nsname string) (*TLSEndpoint, error) {
+	routeBuilder, err := route.Pull(apiClient, name, nsname)
+	if err != nil {
+		return nil, err
+	}
+
+	host := routeBuilder.Object.Spec.Host
+	if host == "" && len(routeBuilder.Object.Status.Ingress) > 0 {
+		host = routeBuilder.Object.Status.Ingress[0].Host
+	}
+
+	if host == "" {
+		return nil, fmt.Errorf("route %s in namespace %s has no host", name, nsname)
+	}
+
+	if routeBuilder.Object.Spec.TLS == nil {
+		return nil, fmt.Errorf("route %s in namespace %s is not secured by TLS", name, nsname)
+	}
+
+	return &TLSEndpoint{Address: net.JoinHostPort(host, strconv.Itoa(routeTLSPort)), ServerName: host}, nil
+}
+
+// GetServiceEndpoint returns the TLS endpoint of the service on the provided port. The endpoint is the cluster IP of
+// the service, so it is only reachable from the cluster network, and the server name is the service DNS name covered
+// by certificates issued by the service CA operator.
+func GetServiceEndpoint(apiClient *clients.Settings, name, nsname string, port int32) (*TLSEndpoint, error) {
+	serviceBuilder, err := service.Pull(apiClient, name, nsname)
+	if err != nil {
+		return nil, err
+	}
+
+	if !slices.ContainsFunc(serviceBuilder.Object.Spec.Ports, func(servicePort corev1.ServicePort) bool {
+		return servicePort.Port == port
+	}) {
+		return nil, fmt.Errorf("service %s in namespace %s has no port %d", name, nsname, port)
+	}
+
+	clusterIP := serviceBuilder.Object.Spec.ClusterIP
+	if clusterIP == "" || clusterIP == "None" {
+		return nil, fmt.Errorf("service %s in namespace %s has no cluster IP", name, nsname)
+	}
+
+	return &TLSEndpoint{
+		Address:    net.JoinHostPort(clusterIP, strconv.Itoa(int(port))),
+		ServerName: fmt.Sprintf("%s.%s.svc", name, nsname),
+	}, nil
+}
+
+// GetCAPool returns a certificate pool containing the PEM encoded certificates stored in key of the configmap, such as
+// DefaultIngressCAConfigMap or ServiceCAConfigMap.
+func GetCAPool(apiClient *clients.Settings, name, nsname, key string) (*x509.CertPool, error) {
+	configMapBuilder, err := configmap.Pull(apiClient, name, nsname)
+	if err != nil {
+		return nil, err
+	}
+
+	bundle, ok := configMapBuilder.Object.Data[key]
+	if !ok || bundle == "" {
+		return nil, fmt.Errorf("configmap %s in namespace %s has no %s key", name, nsname, key)
+	}
+
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM([]byte(bundle)) {
+		return nil, fmt.Errorf("configmap %s in namespace %s key %s contains no PEM certificates", name, nsname, key)
+	}
+
+	return pool, nil
+}
+
+// InspectTLSEndpoint connects to the endpoint and returns the negotiated TLS connection. The certificate chain is not
+// verified so that invalid certificates can be inspected, use ValidateCertificateChain to verify it.
+func InspectTLSEndpoint(endpoint *TLSEndpoint, timeout time.Duration) (*TLSConnectionInfo, error) {
+	if endpoint == nil {
+		return nil, fmt.Errorf("tls 'endpoint' cannot be nil")
+	}
+
+	klog.V(100).Infof("Inspecting TLS endpoint %s with server name %s", endpoint.Address, endpoint.ServerName)
+
+	state, err := dialTLS(endpoint, timeout, &tls.Config{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to TLS endpoint %s: %w", endpoint.Address, err)
+	}
+
+	if len(state.PeerCertificates) == 0 {
+		return nil, fmt.Errorf("TLS endpoint %s presented no certificates", endpoint.Address)
+	}
+
+	return &TLSConnectionInfo{
+		ServerName:       endpoint.ServerName,
+		Version:          state.Version,
+		CipherSuite:      state.CipherSuite,
+		PeerCertificates: state.PeerCertificates,
+	}, nil
+}
+
+// ValidateCertificateChain checks that the certificate chain presented by the endpoint is valid now, chains to one of
+// the roots, and covers the server name. If roots is nil, the system roots are used.
+func ValidateCertificateChain(info *TLSConnectionInfo, roots *x509.CertPool) error {
+	if info == nil || len(info.PeerCertificates) == 0 {
+		return fmt.Errorf("tls connection info has no certificates")
+	}
+
+	intermediates := x509.NewCertPool()
+	for _, certificate := range info.PeerCertificates[1:] {
+		intermediates.AddCert(certificate)
+	}
+
+	_, err := info.PeerCertificates[0].Verify(x509.VerifyOptions{
+		DNSName:       info.ServerName,
+		Roots:         roots,
+		Intermediates: intermediates,
+	})
+	if err != nil {
+		return fmt.Errorf("certificate chain of %s is invalid: %w", info.ServerName, err)
+	}
+
+	return nil
+}
+
+// ValidateSANCoverage checks that the subject alternative names of the certificate cover each of the hostnames, which
+// may also be IP addresses.
+func ValidateSANCoverage(certificate *x509.Certificate, hostnames ...string) error {
+	if certificate == nil {
+		return fmt.Errorf("tls 'certificate' cannot be nil")
+	}
+
+	var uncovered []string
+
+	for _, hostname := range hostnames {
+		if err := certificate.VerifyHostname(hostname); err != nil {
+			uncovered = append(uncovered, hostname)
+		}
+	}
+
+	if len(uncovered) > 0 {
+		return fmt.Errorf("certificate %s does not cover %v, its SANs are DNS %v and IP %v",
+			certificate.Subject, uncovered, certificate.DNSNames, certificate.IPAddresses)
+	}
+
+	return nil
+}
+
+// GetClusterTLSProfile returns the TLS profile of the cluster APIServer config, which applies to the API servers and
+// the components following the cluster-wide profile. The Intermediate profile is returned if none is set.
+func GetClusterTLSProfile(apiClient *clients.Settings) (*configv1.TLSProfileSpec, error) {
+	apiServerBuilder, err := apiservers.PullAPIServer(apiClient)
+	if err != nil {
+		return nil, err
+	}
+
+	return ResolveTLSProfile(apiServerBuilder.Object.Spec.TLSSecurityProfile)
+}
+
+// ResolveTLSProfile returns the ciphers and minimum TLS version of the TLS security profile, such as the profile of
+// the APIServer config or of an IngressController. The Intermediate profile is returned for nil profiles, matching the
+// default of the cluster.
+func ResolveTLSProfile(profile *configv1.TLSSecurityProfile) (*configv1.TLSProfileSpec, error) {
+	if profile == nil || profile.Type == "" {
+		return configv1.TLSProfiles[configv1.TLSProfileIntermediateType], nil
+	}
+
+	if profile.Type == configv1.TLSProfileCustomType {
+		if profile.Custom == nil {
+			return nil, fmt.Errorf("custom tls security profile has no custom spec")
+		}
+
+		return &profile.Custom.TLSProfileSpec, nil
+	}
+
+	spec, ok := configv1.TLSProfiles[profile.Type]
+	if !ok {
+		return nil, fmt.Errorf("tls security profile type %q is not supported", profile.Type)
+	}
+
+	return spec, nil
+}
+
+// ValidateTLSProfile checks that the endpoint adheres to the TLS profile. It checks that the negotiated version is at
+// least the minimum version of the profile, that the negotiated cipher suite is allowed by the profile, that
+// connections using older versions are rejected, and, if the profile allows TLS 1.2, that connections offering only
+// TLS 1.2 cipher suites not allowed by the profile are rejected. TLS 1.3 cipher suites are not configurable so they are
+// not checked.
+func ValidateTLSProfile(endpoint *TLSEndpoint, profile *configv1.TLSProfileSpec, timeout time.Duration) error {
+	if profile == nil {
+		return fmt.Errorf("tls 'profile' cannot be nil")
+	}
+
+	minVersion, ok := tlsVersions[profile.MinTLSVersion]
+	if !ok {
+		return fmt.Errorf("tls profile minimum version %q is not supported", profile.MinTLSVersion)
+	}
+
+	info, err := InspectTLSEndpoint(endpoint, timeout)
+	if err != nil {
+		return err
+	}
+
+	klog.V(100).Infof("Validating TLS endpoint %s negotiating %s with %s against profile with minimum version %s",
+		endpoint.Address, info.VersionName(), info.CipherSuiteName(), profile.MinTLSVersion)
+
+	var violations []error
+
+	if info.Version < minVersion {
+		violations = append(violations, fmt.Errorf("negotiated %s is older than the minimum %s",
+			info.VersionName(), tls.VersionName(minVersion)))
+	}
+
+	allowedCiphers := getAllowedCipherSuites(profile)
+
+	if info.Version < tls.VersionTLS13 && !slices.Contains(allowedCiphers, info.CipherSuiteName()) {
+		violations = append(violations, fmt.Errorf("negotiated cipher suite %s is not allowed", info.CipherSuiteName()))
+	}
+
+	if minVersion > tls.VersionTLS10 {
+		state, err := dialTLS(endpoint, timeout, &tls.Config{MinVersion: tls.VersionTLS10, MaxVersion: minVersion - 1})
+		if err == nil {
+			violations = append(violations, fmt.Errorf("accepted %s which is older than the minimum %s",
+				tls.VersionName(state.Version), tls.VersionName(minVersion)))
+		}
+	}
+
+	if minVersion <= tls.VersionTLS12 {
+		disallowed := getDisallowedCipherSuites(allowedCiphers)
+
+		if len(disallowed) > 0 {
+			state, err := dialTLS(endpoint, timeout, &tls.Config{
+				MinVersion:   minVersion,
+				MaxVersion:   tls.VersionTLS12,
+				CipherSuites: disallowed,
+			})
+			if err == nil {
+				violations = append(violations, fmt.Errorf("accepted cipher suite %s which is not allowed",
+					tls.CipherSuiteName(state.CipherSuite)))
+			}
+		}
+	}
+
+	if len(violations) > 0 {
+		return fmt.Errorf("TLS endpoint %s does not adhere to the tls profile: %w",
+			endpoint.Address, errors.Join(violations...))
+	}
+
+	return nil
+}
+
+// getAllowedCipherSuites returns the IANA names of the cipher suites allowed by the profile. Unknown OpenSSL names are
+// kept as is, so they never match a negotiated cipher suite.
+func getAllowedCipherSuites(profile *configv1.TLSProfileSpec) []string {
+	allowed := make([]string, 0, len(profile.Ciphers))
+
+	for _, cipher := range profile.Ciphers {
+		if ianaName, ok := openSSLCipherSuites[cipher]; ok {
+			cipher = ianaName
+		}
+
+		allowed = append(allowed, cipher)
+	}
+
+	return allowed
+}
+
+// getDisallowedCipherSuites returns the TLS 1.2 cipher suites supported by crypto/tls that are not allowed.
+func getDisallowedCipherSuites(allowed []string) []uint16 {
+	var disallowed []uint16
+
+	for _, suite := range slices.Concat(tls.CipherSuites(), tls.InsecureCipherSuites()) {
+		if !slices.Contains(suite.SupportedVersions, tls.VersionTLS12) || slices.Contains(allowed, suite.Name) {
+			continue
+		}
+
+		disallowed = append(disallowed, suite.ID)
+	}
+
+	return disallowed
+}
+
+// dialTLS connects to the endpoint using the config, without verifying the certificate chain, and returns the state of
+// the connection.
+func dialTLS(endpoint *TLSEndpoint, timeout time.Duration, config *tls.Config) (*tls.ConnectionState, error) {
+	if endpoint == nil {
+		return nil, fmt.Errorf("tls 'endpoint' cannot be nil")
+	}
+
+	config.ServerName = endpoint.ServerName
+	config.InsecureSkipVerify = true
+
+	conn, err := tls.DialWithDialer(&net.Dialer{Timeout: timeout}, "tcp", endpoint.Address, config)
+	if err != nil {
+		return nil, err
+	}
+
+	defer conn.Close()
+
+	state := conn.ConnectionState()
+
+	return &state, nil
+}
@@ -0,0 +1,351 @@
+package certificate
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"testing"
+	"time"
+
+	configv1 "github.com/openshift/api/config/v1"
+	routev1 "github.com/openshift/api/route/v1"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	defaultTLSServerName = "tls.example.com"
+	defaultTLSNamespace  = "tls-ns"
+	defaultTLSTimeout    = 5 * time.Second
+)
+
+func TestGetRouteEndpoint(t *testing.T) {
+	testCases := []struct {
+		route            *routev1.Route
+		expectedEndpoint *TLSEndpoint
+		expectedError    string
+	}{
+		{
+			route: buildDummyRoute(defaultTLSServerName, &routev1.TLSConfig{Termination: routev1.TLSTerminationEdge}),
+			expectedEndpoint: &TLSEndpoint{
+				Address:    defaultTLSServerName + ":443",
+				ServerName: defaultTLSServerName,
+			},
+		},
+		{
+			route:         buildDummyRoute(defaultTLSServerName, nil),
+			expectedError: "route tls-route in namespace tls-ns is not secured by TLS",
+		},
+		{
+			route:         buildDummyRoute("", &routev1.TLSConfig{Termination: routev1.TLSTerminationEdge}),
+			expectedError: "route tls-route in namespace tls-ns has no host",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testSettings := buildTestClientWithTLSObjects(testCase.route)
+
+		endpoint, err := GetRouteEndpoint(testSettings, "tls-route", defaultTLSNamespace)
+		if testCase.expectedError != "" {
+			assert.EqualError(t, err, testCase.expectedError)
+
+			continue
+		}
+
+		assert.NoError(t, err)
+		assert.Equal(t, testCase.expectedEndpoint, endpoint)
+	}
+}
+
+func TestGetServiceEndpoint(t *testing.T) {
+	testSettings := clients.GetTestClients(clients.TestClientParams{K8sMockObjects: []runtime.Object{&corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Name: "tls-svc", Namespace: defaultTLSNamespace},
+		Spec: corev1.ServiceSpec{
+			ClusterIP: "fd02::10",
+			Ports:     []corev1.ServicePort{{Port: 8443}},
+		},
+	}}})
+
+	endpoint, err := GetServiceEndpoint(testSettings, "tls-svc", defaultTLSNamespace, 8443)
+	assert.NoError(t, err)
+	assert.Equal(t, &TLSEndpoint{Address: "[fd02::10]:8443", ServerName: "tls-svc.tls-ns.svc"}, endpoint)
+
+	_, err = GetServiceEndpoint(testSettings, "tls-svc", defaultTLSNamespace, 443)
+	assert.EqualError(t, err, "service tls-svc in namespace tls-ns has no port 443")
+}
+
+func TestGetCAPool(t *testing.T) {
+	caPEM, _ := buildTestTLSCertificate(t, defaultTLSServerName)
+
+	testSettings := buildTestClientWithTLSObjects(&corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: ServiceCAConfigMap, Namespace: defaultTLSNamespace},
+		Data:       map[string]string{ServiceCAKey: string(caPEM), "invalid": "not a certificate"},
+	})
+
+	pool, err := GetCAPool(testSettings, ServiceCAConfigMap, defaultTLSNamespace, ServiceCAKey)
+	assert.NoError(t, err)
+	assert.NotNil(t, pool)
+
+	_, err = GetCAPool(testSettings, ServiceCAConfigMap, defaultTLSNamespace, "missing")
+	assert.EqualError(t, err, "configmap openshift-service-ca.crt in namespace tls-ns has no missing key")
+
+	_, err = GetCAPool(testSettings, ServiceCAConfigMap, defaultTLSNamespace, "invalid")
+	assert.EqualError(t, err, "configmap openshift-service-ca.crt in namespace tls-ns key invalid contains no PEM "+
+		"certificates")
+}
+
+func TestResolveTLSProfile(t *testing.T) {
+	customSpec := configv1.TLSProfileSpec{Ciphers: []string{"ECDHE-RSA-AES128-GCM-SHA256"}, MinTLSVersion: "VersionTLS12"}
+
+	testCases := []struct {
+		profile       *configv1.TLSSecurityProfile
+		expectedSpec  *configv1.TLSProfileSpec
+		expectedError string
+	}{
+		{
+			profile:      nil,
+			expectedSpec: configv1.TLSProfiles[configv1.TLSProfileIntermediateType],
+		},
+		{
+			profile:      &configv1.TLSSecurityProfile{Type: configv1.TLSProfileModernType},
+			expectedSpec: configv1.TLSProfiles[configv1.TLSProfileModernType],
+		},
+		{
+			profile: &configv1.TLSSecurityProfile{
+				Type:   configv1.TLSProfileCustomType,
+				Custom: &configv1.CustomTLSProfile{TLSProfileSpec: customSpec},
+			},
+			expectedSpec: &customSpec,
+		},
+		{
+			profile:       &configv1.TLSSecurityProfile{Type: configv1.TLSProfileCustomType},
+			expectedError: "custom tls security profile has no custom spec",
+		},
+		{
+			profile:       &configv1.TLSSecurityProfile{Type: "Unknown"},
+			expectedError: "tls security profile type \"Unknown\" is not supported",
+		},
+	}
+
+	for _, testCase := range testCases {
+		spec, err := ResolveTLSProfile(testCase.profile)
+		if testCase.expectedError != "" {
+			assert.EqualError(t, err, testCase.expectedError)
+
+			continue
+		}
+
+		assert.NoError(t, err)
+		assert.Equal(t, testCase.expectedSpec, spec)
+	}
+}
+
+func TestGetClusterTLSProfile(t *testing.T) {
+	testSettings := buildTestClientWithTLSObjects(&configv1.APIServer{
+		ObjectMeta: metav1.ObjectMeta{Name: "cluster"},
+		Spec: configv1.APIServerSpec{
+			TLSSecurityProfile: &configv1.TLSSecurityProfile{Type: configv1.TLSProfileOldType},
+		},
+	})
+
+	spec, err := GetClusterTLSProfile(testSettings)
+	assert.NoError(t, err)
+	assert.Equal(t, configv1.TLSProfiles[configv1.TLSProfileOldType], spec)
+}
+
+func TestInspectTLSEndpoint(t *testing.T) {
+	caPEM, serverCert := buildTestTLSCertificate(t, defaultTLSServerName)
+	endpoint := startTestTLSServer(t, &tls.Config{Certificates: []tls.Certificate{serverCert}})
+
+	info, err := InspectTLSEndpoint(endpoint, defaultTLSTimeout)
+	assert.NoError(t, err)
+	assert.Equal(t, "TLS 1.3", info.VersionName())
+	assert.NotEmpty(t, info.CipherSuiteName())
+	assert.Len(t, info.PeerCertificates, 1)
+
+	roots := x509.NewCertPool()
+	roots.AppendCertsFromPEM(caPEM)
+
+	assert.NoError(t, ValidateCertificateChain(info, roots))
+	assert.ErrorContains(t, ValidateCertificateChain(info, x509.NewCertPool()), "certificate chain of tls.example.com is "+
+		"invalid")
+
+	info.ServerName = "other.example.com"
+	assert.ErrorContains(t, ValidateCertificateChain(info, roots), "certificate is valid for tls.example.com")
+
+	assert.NoError(t, ValidateSANCoverage(info.PeerCertificates[0], defaultTLSServerName, "127.0.0.1"))
+	assert.EqualError(t, ValidateSANCoverage(info.PeerCertificates[0], defaultTLSServerName, "other.example.com"),
+		"certificate CN=tls.example.com does not cover [other.example.com], its SANs are DNS [tls.example.com] and "+
+			"IP [127.0.0.1]")
+
+	_, err = InspectTLSEndpoint(nil, defaultTLSTimeout)
+	assert.EqualError(t, err, "tls 'endpoint' cannot be nil")
+
+	assert.EqualError(t, ValidateCertificateChain(nil, roots), "tls connection info has no certificates")
+}
+
+func TestValidateTLSProfile(t *testing.T) {
+	_, serverCert := buildTestTLSCertificate(t, defaultTLSServerName)
+
+	intermediate := configv1.TLSProfiles[configv1.TLSProfileIntermediateType]
+
+	testCases := []struct {
+		serverConfig  *tls.Config
+		profile       *configv1.TLSProfileSpec
+		expectedError []string
+	}{
+		{
+			serverConfig: &tls.Config{MinVersion: tls.VersionTLS13},
+			profile:      intermediate,
+		},
+		{
+			serverConfig: &tls.Config{
+				MinVersion:   tls.VersionTLS12,
+				MaxVersion:   tls.VersionTLS12,
+				CipherSuites: []uint16{tls.TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256},
+			},
+			profile: intermediate,
+		},
+		{
+			serverConfig: &tls.Config{MinVersion: tls.VersionTLS12, MaxVersion: tls.VersionTLS12},
+			profile:      configv1.TLSProfiles[configv1.TLSProfileModernType],
+			expectedError: []string{
+				"negotiated TLS 1.2 is older than the minimum TLS 1.3",
+				"accepted TLS 1.2 which is older than the minimum TLS 1.3",
+			},
+		},
+		{
+			serverConfig: &tls.Config{MinVersion: tls.VersionTLS10, MaxVersion: tls.VersionTLS12},
+			profile:      intermediate,
+			expectedError: []string{
+				"accepted TLS 1.1 which is older than the minimum TLS 1.2",
+			},
+		},
+		{
+			serverConfig: &tls.Config{
+				MinVersion:   tls.VersionTLS12,
+				MaxVersion:   tls.VersionTLS12,
+				CipherSuites: []uint16{tls.TLS_ECDHE_ECDSA_WITH_AES_128_CBC_SHA},
+			},
+			profile: intermediate,
+			expectedError: []string{
+				"negotiated cipher suite TLS_ECDHE_ECDSA_WITH_AES_128_CBC_SHA is not allowed",
+				"accepted cipher suite TLS_ECDHE_ECDSA_WITH_AES_128_CBC_SHA which is not allowed",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase.serverConfig.Certificates = []tls.Certificate{serverCert}
+		endpoint := startTestTLSServer(t, testCase.serverConfig)
+
+		err := ValidateTLSProfile(endpoint, testCase.profile, defaultTLSTimeout)
+		if len(testCase.expectedError) == 0 {
+			assert.NoError(t, err)
+
+			continue
+		}
+
+		for _, expectedError := range testCase.expectedError {
+			assert.ErrorContains(t, err, expectedError)
+		}
+	}
+
+	err := ValidateTLSProfile(&TLSEndpoint{}, nil, defaultTLSTimeout)
+	assert.EqualError(t, err, "tls 'profile' cannot be nil")
+
+	err = ValidateTLSProfile(&TLSEndpoint{}, &configv1.TLSProfileSpec{MinTLSVersion: "VersionTLS9"}, defaultTLSTimeout)
+	assert.EqualError(t, err, "tls profile minimum version \"VersionTLS9\" is not supported")
+}
+
+// startTestTLSServer starts a TLS server on the loopback interface completing handshakes using the config and returns
+// its endpoint. The server is stopped when the test ends.
+func startTestTLSServer(t *testing.T, config *tls.Config) *TLSEndpoint {
+	t.Helper()
+
+	listener, err := tls.Listen("tcp", "127.0.0.1:0", config)
+	assert.NoError(t, err)
+
+	t.Cleanup(func() { _ = listener.Close() })
+
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+
+			_ = conn.(*tls.Conn).Handshake()
+			_ = conn.Close()
+		}
+	}()
+
+	return &TLSEndpoint{Address: listener.Addr().String(), ServerName: defaultTLSServerName}
+}
+
+// buildTestTLSCertificate returns a PEM encoded CA certificate and a serving certificate signed by it covering the DNS
+// name and the loopback IP.
+func buildTestTLSCertificate(t *testing.T, dnsName string) ([]byte, tls.Certificate) {
+	t.Helper()
+
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+
+	caTemplate := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test-ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+
+	caDER, err := x509.CreateCertificate(rand.Reader, caTemplate, caTemplate, &caKey.PublicKey, caKey)
+	assert.NoError(t, err)
+
+	caCert, err := x509.ParseCertificate(caDER)
+	assert.NoError(t, err)
+
+	serverKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+
+	serverTemplate := &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      pkix.Name{CommonName: dnsName},
+		DNSNames:     []string{dnsName},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+
+	serverDER, err := x509.CreateCertificate(rand.Reader, serverTemplate, caCert, &serverKey.PublicKey, caKey)
+	assert.NoError(t, err)
+
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: caDER}),
+		tls.Certificate{Certificate: [][]byte{serverDER}, PrivateKey: serverKey}
+}
+
+func buildDummyRoute(host string, tlsConfig *routev1.TLSConfig) *routev1.Route {
+	return &routev1.Route{
+		ObjectMeta: metav1.ObjectMeta{Name: "tls-route", Namespace: defaultTLSNamespace},
+		Spec:       routev1.RouteSpec{Host: host, TLS: tlsConfig},
+	}
+}
+
+func buildTestClientWithTLSObjects(objects ...runtime.Object) *clients.Settings {
+	return clients.GetTestClients(clients.TestClientParams{
+		K8sMockObjects:  objects,
+		SchemeAttachers: []clients.SchemeAttacher{configv1.Install, routev1.AddToScheme},
+	})
+}
@@ -44,6 +44,7 @@ type CguBuilder struct {
 	common.EmbeddableCreator[v1alpha1.ClusterGroupUpgrade, CguBuilder, *v1alpha1.ClusterGroupUpgrade, *CguBuilder]
 	common.EmbeddableDeleteReturner[v1alpha1.ClusterGroupUpgrade, CguBuilder, *v1alpha1.ClusterGroupUpgrade, *CguBuilder]
 	common.EmbeddableForceUpdater[v1alpha1.ClusterGroupUpgrade, CguBuilder, *v1alpha1.ClusterGroupUpgrade, *CguBuilder]
+	common.EmbeddableConditionGetter[metav1.Condition, v1alpha1.ClusterGroupUpgrade, *v1alpha1.ClusterGroupUpgrade]
 }
 
 // AttachMixins wires the embedded CRUD mixins to this builder instance.
@@ -51,6 +52,7 @@ func (builder *CguBuilder) AttachMixins() {
 	builder.EmbeddableCreator.SetBase(builder)
 	builder.EmbeddableDeleteReturner.SetBase(builder)
 	builder.EmbeddableForceUpdater.SetBase(builder)
+	builder.EmbeddableConditionGetter.SetBase(builder)
 }
 
 // GetGVK returns the ClusterGroupUpgrade GVK for this builder.
@@ -362,3 +364,10 @@ func (builder *CguBuilder) WaitUntilBackupStarts(timeout time.Duration) (*CguBui
 
 	return nil, err
 }
+
+// GetClusterGroupUpgradeGVR returns ClusterGroupUpgrade's GroupVersionResource which could be used for Clean function.
+func GetClusterGroupUpgradeGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group: "ran.openshift.io", Version: "v1alpha1", Resource: "clustergroupupgrades",
+	}
+}
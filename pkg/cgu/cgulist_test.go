@@ -4,7 +4,9 @@ import (
 	"testing"
 
 	"github.com/openshift-kni/cluster-group-upgrades-operator/pkg/api/clustergroupupgrades/v1alpha1"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common/testhelper"
+	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 func TestListInAllNamespaces(t *testing.T) {
@@ -16,3 +18,38 @@ func TestListInAllNamespaces(t *testing.T) {
 		cguGVK,
 	).ExecuteTests(t)
 }
+
+func TestList(t *testing.T) {
+	t.Parallel()
+
+	testhelper.NewNamespacedListTestConfig(
+		func(apiClient *clients.Settings, nsname string, _ ...runtimeclient.ListOptions) ([]*CguBuilder, error) {
+			return List(apiClient, nsname)
+		},
+		v1alpha1.AddToScheme,
+		cguGVK,
+	).ExecuteTests(t)
+}
+
+func TestListPreCachingConfigs(t *testing.T) {
+	t.Parallel()
+
+	testhelper.NewNamespacedListTestConfig(
+		func(
+			apiClient *clients.Settings, nsname string, _ ...runtimeclient.ListOptions) ([]*PreCachingConfigBuilder, error) {
+			return ListPreCachingConfigs(apiClient, nsname)
+		},
+		v1alpha1.AddToScheme,
+		preCachingConfigGVK,
+	).ExecuteTests(t)
+}
+
+func TestListPreCachingConfigsInAllNamespaces(t *testing.T) {
+	t.Parallel()
+
+	testhelper.NewListTestConfig(
+		ListPreCachingConfigsInAllNamespaces,
+		v1alpha1.AddToScheme,
+		preCachingConfigGVK,
+	).ExecuteTests(t)
+}
@@ -0,0 +1,4 @@
+package cgu
+
+//go:generate go run ../../internal/listgen -builder CguBuilder -list List -list-all ListInAllNamespaces
+//go:generate go run ../../internal/listgen -builder PreCachingConfigBuilder
@@ -47,3 +47,10 @@ func PullPreCachingConfig(apiClient *clients.Settings, name, nsname string) (*Pr
 	return common.PullNamespacedBuilder[v1alpha1.PreCachingConfig, PreCachingConfigBuilder](
 		context.TODO(), apiClient, v1alpha1.AddToScheme, name, nsname)
 }
+
+// GetPreCachingConfigGVR returns PreCachingConfig's GroupVersionResource which could be used for Clean function.
+func GetPreCachingConfigGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group: "ran.openshift.io", Version: "v1alpha1", Resource: "precachingconfigs",
+	}
+}
@@ -0,0 +1,131 @@
+// Package chaos provides the disruptions commonly injected by resiliency suites, such as impairing the network of a
+// node, killing and pausing processes on it, or stepping its clock and blocking NTP. Commands are run in the host
+// namespaces of the node through a kubelet.NodeExecutor, typically a kubelet.DebugPodExecutor backed by privileged
+// node pods.
+//
+// Disruptions that can be undone return a Handle whose Restore method reverts them. Handles are safe to restore more
+// than once, so Restore may be both called explicitly and registered as a cleanup, for example with DeferCleanup, to
+// make sure nodes are never left impaired when a test fails.
+package chaos
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/kubelet"
+	"k8s.io/klog/v2"
+)
+
+// Handle reverts a single disruption injected on a node.
+type Handle struct {
+	// NodeName is the node the disruption was injected on.
+	NodeName string
+	// Description describes the disruption, such as the tc command used to inject it.
+	Description string
+
+	restoreFunc func() error
+	mutex       sync.Mutex
+	restored    bool
+}
+
+// Restore reverts the disruption. Once it succeeds, later calls are no-ops, but failed attempts may be retried.
+func (handle *Handle) Restore() error {
+	if handle == nil {
+		klog.V(100).Info("The chaos handle is nil")
+
+		return fmt.Errorf("chaos handle cannot be nil")
+	}
+
+	handle.mutex.Lock()
+	defer handle.mutex.Unlock()
+
+	if handle.restored {
+		klog.V(100).Infof("Chaos %q on node %s is already restored", handle.Description, handle.NodeName)
+
+		return nil
+	}
+
+	klog.V(100).Infof("Restoring chaos %q on node %s", handle.Description, handle.NodeName)
+
+	if err := handle.restoreFunc(); err != nil {
+		return fmt.Errorf("failed to restore chaos %q on node %s: %w", handle.Description, handle.NodeName, err)
+	}
+
+	handle.restored = true
+
+	return nil
+}
+
+// IsRestored returns whether the disruption has been successfully reverted.
+func (handle *Handle) IsRestored() bool {
+	if handle == nil {
+		return false
+	}
+
+	handle.mutex.Lock()
+	defer handle.mutex.Unlock()
+
+	return handle.restored
+}
+
+// RestoreAll restores every provided handle, skipping nil ones, and returns the joined errors of those that failed.
+// Handles are restored in reverse order so that disruptions stacked on the same node are undone in the right order.
+func RestoreAll(handles ...*Handle) error {
+	var errs []error
+
+	for idx := len(handles) - 1; idx >= 0; idx-- {
+		if handles[idx] == nil {
+			continue
+		}
+
+		if err := handles[idx].Restore(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// newHandle returns a Handle that runs the provided command on the node to revert the disruption.
+func newHandle(executor kubelet.NodeExecutor, nodeName, description string, restoreCommand ...string) *Handle {
+	return newMultiCommandHandle(executor, nodeName, description, restoreCommand)
+}
+
+// newMultiCommandHandle returns a Handle that runs each of the provided commands on the node, in order, to revert the
+// disruption. All commands are run even if some fail.
+func newMultiCommandHandle(
+	executor kubelet.NodeExecutor, nodeName, description string, restoreCommands ...[]string) *Handle {
+	return &Handle{
+		NodeName:    nodeName,
+		Description: description,
+		restoreFunc: func() error {
+			var errs []error
+
+			for _, restoreCommand := range restoreCommands {
+				if _, err := executor.ExecOnNode(nodeName, restoreCommand...); err != nil {
+					errs = append(errs, err)
+				}
+			}
+
+			return errors.Join(errs...)
+		},
+	}
+}
+
+// validateTarget checks the executor and node name common to every disruption.
+func validateTarget(executor kubelet.NodeExecutor, nodeName string) error {
+	if executor == nil {
+		klog.V(100).Info("The chaos executor is nil")
+
+		return fmt.Errorf("chaos 'executor' cannot be nil")
+	}
+
+	if nodeName == "" {
+		klog.V(100).Info("The chaos nodeName is empty")
+
+		return fmt.Errorf("chaos 'nodeName' cannot be empty")
+	}
+
+	return nil
+}
@@ -0,0 +1,106 @@
+package chaos
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeExecutor records the commands it receives and returns the output or error configured for the first word of
+// the command.
+type fakeExecutor struct {
+	commands [][]string
+	outputs  map[string]string
+	errs     map[string]error
+}
+
+// ExecOnNode records the command and returns the configured output or error.
+func (executor *fakeExecutor) ExecOnNode(nodeName string, command ...string) (string, error) {
+	executor.commands = append(executor.commands, command)
+
+	return executor.outputs[command[0]], executor.errs[command[0]]
+}
+
+// joinedCommands returns the recorded commands with their arguments joined by spaces.
+func (executor *fakeExecutor) joinedCommands() []string {
+	var commands []string
+
+	for _, command := range executor.commands {
+		commands = append(commands, strings.Join(command, " "))
+	}
+
+	return commands
+}
+
+func TestHandleRestore(t *testing.T) {
+	var attempts int
+
+	handle := &Handle{
+		NodeName:    "worker-0",
+		Description: "test chaos",
+		restoreFunc: func() error {
+			attempts++
+
+			if attempts == 1 {
+				return fmt.Errorf("restore failed")
+			}
+
+			return nil
+		},
+	}
+
+	err := handle.Restore()
+	assert.EqualError(t, err, "failed to restore chaos \"test chaos\" on node worker-0: restore failed")
+	assert.False(t, handle.IsRestored())
+
+	err = handle.Restore()
+	assert.NoError(t, err)
+	assert.True(t, handle.IsRestored())
+
+	err = handle.Restore()
+	assert.NoError(t, err)
+	assert.Equal(t, 2, attempts)
+
+	var nilHandle *Handle
+
+	assert.EqualError(t, nilHandle.Restore(), "chaos handle cannot be nil")
+	assert.False(t, nilHandle.IsRestored())
+}
+
+func TestRestoreAll(t *testing.T) {
+	var order []string
+
+	newTestHandle := func(name string, err error) *Handle {
+		return &Handle{
+			NodeName:    "worker-0",
+			Description: name,
+			restoreFunc: func() error {
+				order = append(order, name)
+
+				return err
+			},
+		}
+	}
+
+	err := RestoreAll(
+		newTestHandle("first", nil), nil, newTestHandle("second", fmt.Errorf("failed")), newTestHandle("third", nil))
+	assert.EqualError(t, err, "failed to restore chaos \"second\" on node worker-0: failed")
+	assert.Equal(t, []string{"third", "second", "first"}, order)
+
+	assert.NoError(t, RestoreAll())
+}
+
+func TestNewHandle(t *testing.T) {
+	executor := &fakeExecutor{}
+
+	handle := newHandle(executor, "worker-0", "test chaos", "tc", "qdisc", "del", "dev", "eth1", "root")
+	assert.NoError(t, handle.Restore())
+	assert.Equal(t, []string{"tc qdisc del dev eth1 root"}, executor.joinedCommands())
+
+	executor = &fakeExecutor{errs: map[string]error{"tc": fmt.Errorf("exec failed")}}
+
+	handle = newHandle(executor, "worker-0", "test chaos", "tc", "qdisc", "del", "dev", "eth1", "root")
+	assert.EqualError(t, handle.Restore(), "failed to restore chaos \"test chaos\" on node worker-0: exec failed")
+}
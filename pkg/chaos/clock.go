@@ -0,0 +1,152 @@
+package chaos
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/kubelet"
+	"k8s.io/klog/v2"
+)
+
+const (
+	// NTPPort is the UDP port used by NTP.
+	NTPPort = 123
+	// SystemClock is the clock device name phc_ctl uses for the system clock.
+	SystemClock = "CLOCK_REALTIME"
+
+	// maxFrequencyPPB is the largest frequency offset accepted by the kernel, in parts per billion.
+	maxFrequencyPPB = 32768000
+)
+
+// StepClock steps the system clock of the node by offset, which may be negative, and is truncated to whole seconds.
+// Restoring the returned handle steps the clock back by the same offset, which is only correct if nothing has
+// disciplined the clock in the meantime. When phc2sys or chronyd is running it will correct the step on its own, so
+// either stop it or block NTP first, or do not restore the handle once the clock has been corrected.
+func StepClock(executor kubelet.NodeExecutor, nodeName string, offset time.Duration) (*Handle, error) {
+	if err := validateTarget(executor, nodeName); err != nil {
+		return nil, err
+	}
+
+	klog.V(100).Infof("Stepping system clock of node %s by %s", nodeName, offset)
+
+	seconds := int64(offset / time.Second)
+	if seconds == 0 {
+		klog.V(100).Info("The clock step offset is less than a second")
+
+		return nil, fmt.Errorf("clock step 'offset' must be at least one second, got %s", offset)
+	}
+
+	_, err := executor.ExecOnNode(nodeName, buildStepClockCommand(seconds)...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to step system clock of node %s by %s: %w", nodeName, offset, err)
+	}
+
+	return newHandle(executor, nodeName, fmt.Sprintf("step system clock by %ds", seconds),
+		buildStepClockCommand(-seconds)...), nil
+}
+
+// SkewClock sets the frequency offset of the clock on the node to frequencyPPB parts per billion using phc_ctl, which
+// must be available on the node. The clock may be SystemClock or a PTP hardware clock such as /dev/ptp0. Restoring the
+// returned handle resets the frequency offset to zero, discarding any adjustment previously made by a servo such as
+// phc2sys, which is expected to resume disciplining the clock afterwards. The servo must be stopped for the skew to
+// persist.
+func SkewClock(executor kubelet.NodeExecutor, nodeName, clock string, frequencyPPB float64) (*Handle, error) {
+	if err := validateTarget(executor, nodeName); err != nil {
+		return nil, err
+	}
+
+	klog.V(100).Infof("Skewing clock %s of node %s by %v ppb", clock, nodeName, frequencyPPB)
+
+	if clock == "" {
+		klog.V(100).Info("The clock skew clock is empty")
+
+		return nil, fmt.Errorf("clock skew 'clock' cannot be empty")
+	}
+
+	if frequencyPPB < -maxFrequencyPPB || frequencyPPB > maxFrequencyPPB {
+		return nil, fmt.Errorf("clock skew 'frequencyPPB' must be between -%d and %d, got %v",
+			maxFrequencyPPB, maxFrequencyPPB, frequencyPPB)
+	}
+
+	_, err := executor.ExecOnNode(nodeName, buildSkewClockCommand(clock, frequencyPPB)...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to skew clock %s of node %s: %w", clock, nodeName, err)
+	}
+
+	return newHandle(executor, nodeName, fmt.Sprintf("skew clock %s by %v ppb", clock, frequencyPPB),
+		buildSkewClockCommand(clock, 0)...), nil
+}
+
+// BlockNTP drops all NTP traffic to and from the node so that chronyd loses its time sources, for example to put the
+// node into holdover. It is the same as calling BlockUDPPort with NTPPort.
+func BlockNTP(executor kubelet.NodeExecutor, nodeName string) (*Handle, error) {
+	return BlockUDPPort(executor, nodeName, NTPPort)
+}
+
+// BlockUDPPort inserts iptables and ip6tables rules on the node dropping UDP traffic sent to or received from the port
+// on remote hosts, such as NTPPort or the PTP event port 319. Restoring the returned handle deletes the rules. If
+// inserting any rule fails, the rules already inserted are deleted before returning.
+func BlockUDPPort(executor kubelet.NodeExecutor, nodeName string, port uint16) (*Handle, error) {
+	if err := validateTarget(executor, nodeName); err != nil {
+		return nil, err
+	}
+
+	klog.V(100).Infof("Blocking UDP port %d on node %s", port, nodeName)
+
+	if port == 0 {
+		klog.V(100).Info("The blocked UDP port is zero")
+
+		return nil, fmt.Errorf("firewall 'port' cannot be zero")
+	}
+
+	var deleteCommands [][]string
+
+	for _, ruleSpec := range buildBlockUDPPortRuleSpecs(port) {
+		_, err := executor.ExecOnNode(nodeName, append([]string{ruleSpec[0], "-I"}, ruleSpec[1:]...)...)
+		if err != nil {
+			err = fmt.Errorf("failed to block UDP port %d on node %s: %w", port, nodeName, err)
+
+			rollbackErr := newMultiCommandHandle(executor, nodeName, "", deleteCommands...).restoreFunc()
+			if rollbackErr != nil {
+				err = errors.Join(err, fmt.Errorf("failed to delete rules already inserted: %w", rollbackErr))
+			}
+
+			return nil, err
+		}
+
+		deleteCommands = append(deleteCommands, append([]string{ruleSpec[0], "-D"}, ruleSpec[1:]...))
+	}
+
+	return newMultiCommandHandle(executor, nodeName, fmt.Sprintf("block UDP port %d", port), deleteCommands...), nil
+}
+
+// buildStepClockCommand returns the date command stepping the system clock by the provided number of seconds.
+func buildStepClockCommand(seconds int64) []string {
+	return []string{"date", "--set", fmt.Sprintf("%+d seconds", seconds)}
+}
+
+// buildSkewClockCommand returns the phc_ctl command setting the frequency offset of the clock.
+func buildSkewClockCommand(clock string, frequencyPPB float64) []string {
+	return []string{"phc_ctl", clock, "freq", strconv.FormatFloat(frequencyPPB, 'f', -1, 64)}
+}
+
+// buildBlockUDPPortRuleSpecs returns the rules dropping UDP traffic on the port, each as the binary followed by the
+// chain and rule specification, without the -I or -D operation. A comment identifies the rules as injected by chaos.
+func buildBlockUDPPortRuleSpecs(port uint16) [][]string {
+	var ruleSpecs [][]string
+
+	comment := fmt.Sprintf("chaos-block-udp-%d", port)
+
+	for _, binary := range []string{"iptables", "ip6tables"} {
+		for _, rule := range []struct{ chain, portFlag string }{{"OUTPUT", "--dport"}, {"INPUT", "--sport"}} {
+			ruleSpecs = append(ruleSpecs, []string{
+				binary, rule.chain, "-p", "udp", rule.portFlag, strconv.Itoa(int(port)),
+				"-m", "comment", "--comment", comment, "-j", "DROP",
+			})
+		}
+	}
+
+	return ruleSpecs
+}
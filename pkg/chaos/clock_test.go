@@ -0,0 +1,140 @@
+package chaos
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStepClock(t *testing.T) {
+	testCases := []struct {
+		offset           time.Duration
+		err              error
+		expectedCommands []string
+		expectedError    string
+	}{
+		{
+			offset:           90 * time.Second,
+			expectedCommands: []string{"date --set +90 seconds", "date --set -90 seconds"},
+		},
+		{
+			offset:           -1500 * time.Millisecond,
+			expectedCommands: []string{"date --set -1 seconds", "date --set +1 seconds"},
+		},
+		{
+			offset:        500 * time.Millisecond,
+			expectedError: "clock step 'offset' must be at least one second, got 500ms",
+		},
+		{
+			offset:        time.Minute,
+			err:           fmt.Errorf("exec failed"),
+			expectedError: "failed to step system clock of node worker-0 by 1m0s: exec failed",
+		},
+	}
+
+	for _, testCase := range testCases {
+		executor := &fakeExecutor{errs: map[string]error{"date": testCase.err}}
+
+		handle, err := StepClock(executor, "worker-0", testCase.offset)
+		if testCase.expectedError != "" {
+			assert.EqualError(t, err, testCase.expectedError)
+
+			continue
+		}
+
+		assert.NoError(t, err)
+		assert.NoError(t, handle.Restore())
+		assert.Equal(t, testCase.expectedCommands, executor.joinedCommands())
+	}
+}
+
+func TestSkewClock(t *testing.T) {
+	testCases := []struct {
+		clock            string
+		frequencyPPB     float64
+		err              error
+		expectedCommands []string
+		expectedError    string
+	}{
+		{
+			clock:            SystemClock,
+			frequencyPPB:     -100.5,
+			expectedCommands: []string{"phc_ctl CLOCK_REALTIME freq -100.5", "phc_ctl CLOCK_REALTIME freq 0"},
+		},
+		{
+			clock:            "/dev/ptp0",
+			frequencyPPB:     1000,
+			expectedCommands: []string{"phc_ctl /dev/ptp0 freq 1000", "phc_ctl /dev/ptp0 freq 0"},
+		},
+		{
+			clock:         "",
+			frequencyPPB:  1000,
+			expectedError: "clock skew 'clock' cannot be empty",
+		},
+		{
+			clock:         SystemClock,
+			frequencyPPB:  40000000,
+			expectedError: "clock skew 'frequencyPPB' must be between -32768000 and 32768000, got 4e+07",
+		},
+		{
+			clock:         SystemClock,
+			frequencyPPB:  1000,
+			err:           fmt.Errorf("exec failed"),
+			expectedError: "failed to skew clock CLOCK_REALTIME of node worker-0: exec failed",
+		},
+	}
+
+	for _, testCase := range testCases {
+		executor := &fakeExecutor{errs: map[string]error{"phc_ctl": testCase.err}}
+
+		handle, err := SkewClock(executor, "worker-0", testCase.clock, testCase.frequencyPPB)
+		if testCase.expectedError != "" {
+			assert.EqualError(t, err, testCase.expectedError)
+
+			continue
+		}
+
+		assert.NoError(t, err)
+		assert.NoError(t, handle.Restore())
+		assert.Equal(t, testCase.expectedCommands, executor.joinedCommands())
+	}
+}
+
+func TestBlockNTP(t *testing.T) {
+	executor := &fakeExecutor{}
+
+	handle, err := BlockNTP(executor, "worker-0")
+	assert.NoError(t, err)
+	assert.Equal(t, "block UDP port 123", handle.Description)
+
+	assert.NoError(t, handle.Restore())
+	assert.Equal(t, []string{
+		"iptables -I OUTPUT -p udp --dport 123 -m comment --comment chaos-block-udp-123 -j DROP",
+		"iptables -I INPUT -p udp --sport 123 -m comment --comment chaos-block-udp-123 -j DROP",
+		"ip6tables -I OUTPUT -p udp --dport 123 -m comment --comment chaos-block-udp-123 -j DROP",
+		"ip6tables -I INPUT -p udp --sport 123 -m comment --comment chaos-block-udp-123 -j DROP",
+		"iptables -D OUTPUT -p udp --dport 123 -m comment --comment chaos-block-udp-123 -j DROP",
+		"iptables -D INPUT -p udp --sport 123 -m comment --comment chaos-block-udp-123 -j DROP",
+		"ip6tables -D OUTPUT -p udp --dport 123 -m comment --comment chaos-block-udp-123 -j DROP",
+		"ip6tables -D INPUT -p udp --sport 123 -m comment --comment chaos-block-udp-123 -j DROP",
+	}, executor.joinedCommands())
+}
+
+func TestBlockUDPPort(t *testing.T) {
+	executor := &fakeExecutor{errs: map[string]error{"ip6tables": fmt.Errorf("exec failed")}}
+
+	_, err := BlockUDPPort(executor, "worker-0", 319)
+	assert.EqualError(t, err, "failed to block UDP port 319 on node worker-0: exec failed")
+	assert.Equal(t, []string{
+		"iptables -I OUTPUT -p udp --dport 319 -m comment --comment chaos-block-udp-319 -j DROP",
+		"iptables -I INPUT -p udp --sport 319 -m comment --comment chaos-block-udp-319 -j DROP",
+		"ip6tables -I OUTPUT -p udp --dport 319 -m comment --comment chaos-block-udp-319 -j DROP",
+		"iptables -D OUTPUT -p udp --dport 319 -m comment --comment chaos-block-udp-319 -j DROP",
+		"iptables -D INPUT -p udp --sport 319 -m comment --comment chaos-block-udp-319 -j DROP",
+	}, executor.joinedCommands())
+
+	_, err = BlockUDPPort(&fakeExecutor{}, "worker-0", 0)
+	assert.EqualError(t, err, "firewall 'port' cannot be zero")
+}
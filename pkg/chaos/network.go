@@ -0,0 +1,96 @@
+package chaos
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/kubelet"
+	"k8s.io/klog/v2"
+)
+
+// NetworkImpairment describes the netem parameters applied to the egress traffic of an interface. At least one of
+// Delay and LossPercent must be set.
+type NetworkImpairment struct {
+	// Delay is added to every packet sent on the interface.
+	Delay time.Duration
+	// Jitter is the random variation of Delay. It requires Delay to be set.
+	Jitter time.Duration
+	// LossPercent is the percentage of packets sent on the interface that are dropped, between 0 and 100.
+	LossPercent float64
+}
+
+// InjectNetworkImpairment replaces the root qdisc of the interface on the node with a netem qdisc applying the
+// impairment. Restoring the returned handle deletes the root qdisc, which reverts the interface to the default qdisc
+// of the kernel rather than any custom qdisc previously configured. Impairing the interface used to reach the API
+// server also prevents restoring it, so the impairment should target other interfaces or be short lived.
+func InjectNetworkImpairment(
+	executor kubelet.NodeExecutor, nodeName, interfaceName string, impairment NetworkImpairment) (*Handle, error) {
+	if err := validateTarget(executor, nodeName); err != nil {
+		return nil, err
+	}
+
+	klog.V(100).Infof("Injecting network impairment %+v on interface %s of node %s", impairment, interfaceName, nodeName)
+
+	command, err := buildNetemCommand(interfaceName, impairment)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = executor.ExecOnNode(nodeName, command...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to inject network impairment on interface %s of node %s: %w",
+			interfaceName, nodeName, err)
+	}
+
+	return newHandle(executor, nodeName, strings.Join(command, " "),
+		"tc", "qdisc", "del", "dev", interfaceName, "root"), nil
+}
+
+// buildNetemCommand returns the tc command replacing the root qdisc of the interface with netem.
+func buildNetemCommand(interfaceName string, impairment NetworkImpairment) ([]string, error) {
+	if interfaceName == "" {
+		klog.V(100).Info("The network impairment interfaceName is empty")
+
+		return nil, fmt.Errorf("network impairment 'interfaceName' cannot be empty")
+	}
+
+	if impairment.Delay < 0 || impairment.Jitter < 0 {
+		return nil, fmt.Errorf("network impairment delay and jitter cannot be negative")
+	}
+
+	if impairment.Jitter > 0 && impairment.Delay == 0 {
+		return nil, fmt.Errorf("network impairment jitter requires a delay")
+	}
+
+	if impairment.LossPercent < 0 || impairment.LossPercent > 100 {
+		return nil, fmt.Errorf("network impairment loss percent must be between 0 and 100, got %v",
+			impairment.LossPercent)
+	}
+
+	if impairment.Delay == 0 && impairment.LossPercent == 0 {
+		return nil, fmt.Errorf("network impairment must set a delay or a loss percent")
+	}
+
+	command := []string{"tc", "qdisc", "replace", "dev", interfaceName, "root", "netem"}
+
+	if impairment.Delay > 0 {
+		command = append(command, "delay", formatNetemTime(impairment.Delay))
+
+		if impairment.Jitter > 0 {
+			command = append(command, formatNetemTime(impairment.Jitter))
+		}
+	}
+
+	if impairment.LossPercent > 0 {
+		command = append(command, "loss", strconv.FormatFloat(impairment.LossPercent, 'f', -1, 64)+"%")
+	}
+
+	return command, nil
+}
+
+// formatNetemTime formats the duration in microseconds, the precision tc accepts for netem times.
+func formatNetemTime(duration time.Duration) string {
+	return strconv.FormatInt(duration.Microseconds(), 10) + "us"
+}
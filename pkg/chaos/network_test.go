@@ -0,0 +1,130 @@
+package chaos
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuildNetemCommand(t *testing.T) {
+	testCases := []struct {
+		interfaceName   string
+		impairment      NetworkImpairment
+		expectedCommand []string
+		expectedError   string
+	}{
+		{
+			interfaceName:   "eth1",
+			impairment:      NetworkImpairment{Delay: 100 * time.Millisecond},
+			expectedCommand: []string{"tc", "qdisc", "replace", "dev", "eth1", "root", "netem", "delay", "100000us"},
+		},
+		{
+			interfaceName: "eth1",
+			impairment:    NetworkImpairment{Delay: 100 * time.Millisecond, Jitter: 10 * time.Millisecond, LossPercent: 0.5},
+			expectedCommand: []string{
+				"tc", "qdisc", "replace", "dev", "eth1", "root", "netem", "delay", "100000us", "10000us", "loss", "0.5%"},
+		},
+		{
+			interfaceName:   "eth1",
+			impairment:      NetworkImpairment{LossPercent: 100},
+			expectedCommand: []string{"tc", "qdisc", "replace", "dev", "eth1", "root", "netem", "loss", "100%"},
+		},
+		{
+			interfaceName: "",
+			impairment:    NetworkImpairment{LossPercent: 10},
+			expectedError: "network impairment 'interfaceName' cannot be empty",
+		},
+		{
+			interfaceName: "eth1",
+			impairment:    NetworkImpairment{},
+			expectedError: "network impairment must set a delay or a loss percent",
+		},
+		{
+			interfaceName: "eth1",
+			impairment:    NetworkImpairment{Jitter: time.Millisecond, LossPercent: 10},
+			expectedError: "network impairment jitter requires a delay",
+		},
+		{
+			interfaceName: "eth1",
+			impairment:    NetworkImpairment{Delay: -time.Millisecond},
+			expectedError: "network impairment delay and jitter cannot be negative",
+		},
+		{
+			interfaceName: "eth1",
+			impairment:    NetworkImpairment{LossPercent: 101},
+			expectedError: "network impairment loss percent must be between 0 and 100, got 101",
+		},
+	}
+
+	for _, testCase := range testCases {
+		command, err := buildNetemCommand(testCase.interfaceName, testCase.impairment)
+		if testCase.expectedError != "" {
+			assert.EqualError(t, err, testCase.expectedError)
+
+			continue
+		}
+
+		assert.NoError(t, err)
+		assert.Equal(t, testCase.expectedCommand, command)
+	}
+}
+
+func TestInjectNetworkImpairment(t *testing.T) {
+	testCases := []struct {
+		executor      *fakeExecutor
+		nodeName      string
+		expectedError string
+	}{
+		{
+			executor: &fakeExecutor{},
+			nodeName: "worker-0",
+		},
+		{
+			executor:      &fakeExecutor{errs: map[string]error{"tc": fmt.Errorf("exec failed")}},
+			nodeName:      "worker-0",
+			expectedError: "failed to inject network impairment on interface eth1 of node worker-0: exec failed",
+		},
+		{
+			executor:      &fakeExecutor{},
+			nodeName:      "",
+			expectedError: "chaos 'nodeName' cannot be empty",
+		},
+		{
+			executor:      nil,
+			nodeName:      "worker-0",
+			expectedError: "chaos 'executor' cannot be nil",
+		},
+	}
+
+	for _, testCase := range testCases {
+		var (
+			handle *Handle
+			err    error
+		)
+
+		if testCase.executor == nil {
+			handle, err = InjectNetworkImpairment(nil, testCase.nodeName, "eth1", NetworkImpairment{LossPercent: 10})
+		} else {
+			handle, err = InjectNetworkImpairment(
+				testCase.executor, testCase.nodeName, "eth1", NetworkImpairment{LossPercent: 10})
+		}
+
+		if testCase.expectedError != "" {
+			assert.EqualError(t, err, testCase.expectedError)
+
+			continue
+		}
+
+		assert.NoError(t, err)
+		assert.Equal(t, "worker-0", handle.NodeName)
+		assert.Equal(t, "tc qdisc replace dev eth1 root netem loss 10%", handle.Description)
+
+		assert.NoError(t, handle.Restore())
+		assert.Equal(t, []string{
+			"tc qdisc replace dev eth1 root netem loss 10%",
+			"tc qdisc del dev eth1 root",
+		}, testCase.executor.joinedCommands())
+	}
+}
@@ -0,0 +1,174 @@
+package chaos
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/kubelet"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/klog/v2"
+)
+
+// processNameRegex matches the process names accepted by the process helpers. Since the name is passed to a shell,
+// only characters that cannot change the meaning of the command are allowed.
+var processNameRegex = regexp.MustCompile(`^[A-Za-z0-9._-]{1,15}$`)
+
+// GetProcessIDs returns the IDs of the processes on the node whose name, as reported in /proc/<pid>/comm, is exactly
+// processName, such as ptp4l or ovnkube. Names are truncated to 15 characters by the kernel. It returns an empty slice
+// if there is no matching process.
+func GetProcessIDs(executor kubelet.NodeExecutor, nodeName, processName string) ([]int, error) {
+	if err := validateTarget(executor, nodeName); err != nil {
+		return nil, err
+	}
+
+	if err := validateProcessName(processName); err != nil {
+		return nil, err
+	}
+
+	klog.V(100).Infof("Getting IDs of process %s on node %s", processName, nodeName)
+
+	// pgrep exits with status 1 when there is no match, which must not be reported as a failure.
+	output, err := executor.ExecOnNode(nodeName, "sh", "-c", fmt.Sprintf("pgrep -x %s || true", processName))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get IDs of process %s on node %s: %w", processName, nodeName, err)
+	}
+
+	return parseProcessIDs(output)
+}
+
+// KillProcess sends the signal, such as syscall.SIGKILL or syscall.SIGTERM, to every process on the node named
+// processName and returns their IDs. It returns an error if there is no such process. Killed processes are expected
+// to be restarted by systemd or the kubelet, which can be checked using WaitForProcessRestart.
+func KillProcess(
+	executor kubelet.NodeExecutor, nodeName, processName string, signal syscall.Signal) ([]int, error) {
+	klog.V(100).Infof("Killing process %s on node %s with signal %d", processName, nodeName, signal)
+
+	return signalProcess(executor, nodeName, processName, signal)
+}
+
+// PauseProcess stops every process on the node named processName using SIGSTOP. Restoring the returned handle resumes
+// the same processes using SIGCONT. It returns an error if there is no such process.
+func PauseProcess(executor kubelet.NodeExecutor, nodeName, processName string) (*Handle, error) {
+	klog.V(100).Infof("Pausing process %s on node %s", processName, nodeName)
+
+	processIDs, err := signalProcess(executor, nodeName, processName, syscall.SIGSTOP)
+	if err != nil {
+		return nil, err
+	}
+
+	return newHandle(executor, nodeName, fmt.Sprintf("pause process %s %v", processName, processIDs),
+		buildKillCommand(syscall.SIGCONT, processIDs)...), nil
+}
+
+// WaitForProcessRestart waits up to timeout for a process named processName to be running on the node with an ID not
+// in oldProcessIDs, such as the IDs returned by KillProcess, and returns the IDs of the running processes.
+func WaitForProcessRestart(executor kubelet.NodeExecutor,
+	nodeName, processName string, oldProcessIDs []int, timeout time.Duration) ([]int, error) {
+	if err := validateTarget(executor, nodeName); err != nil {
+		return nil, err
+	}
+
+	if err := validateProcessName(processName); err != nil {
+		return nil, err
+	}
+
+	klog.V(100).Infof("Waiting for process %s to restart on node %s", processName, nodeName)
+
+	var processIDs []int
+
+	err := wait.PollUntilContextTimeout(
+		context.TODO(), time.Second, timeout, true, func(ctx context.Context) (bool, error) {
+			var err error
+
+			processIDs, err = GetProcessIDs(executor, nodeName, processName)
+			if err != nil {
+				klog.V(100).Infof("Failed to get IDs of process %s on node %s: %v", processName, nodeName, err)
+
+				return false, nil
+			}
+
+			for _, processID := range processIDs {
+				if !slices.Contains(oldProcessIDs, processID) {
+					return true, nil
+				}
+			}
+
+			return false, nil
+		})
+	if err != nil {
+		return nil, fmt.Errorf("process %s did not restart on node %s: %w", processName, nodeName, err)
+	}
+
+	return processIDs, nil
+}
+
+// signalProcess sends the signal to every process on the node named processName and returns their IDs.
+func signalProcess(
+	executor kubelet.NodeExecutor, nodeName, processName string, signal syscall.Signal) ([]int, error) {
+	processIDs, err := GetProcessIDs(executor, nodeName, processName)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(processIDs) == 0 {
+		return nil, fmt.Errorf("no process %s found on node %s", processName, nodeName)
+	}
+
+	_, err = executor.ExecOnNode(nodeName, buildKillCommand(signal, processIDs)...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send signal %d to process %s on node %s: %w", signal, processName, nodeName, err)
+	}
+
+	return processIDs, nil
+}
+
+// buildKillCommand returns the kill command sending the signal to the processes.
+func buildKillCommand(signal syscall.Signal, processIDs []int) []string {
+	command := []string{"kill", "-" + strconv.Itoa(int(signal))}
+
+	for _, processID := range processIDs {
+		command = append(command, strconv.Itoa(processID))
+	}
+
+	return command
+}
+
+// parseProcessIDs parses the newline separated process IDs printed by pgrep.
+func parseProcessIDs(output string) ([]int, error) {
+	processIDs := []int{}
+
+	for _, field := range strings.Fields(output) {
+		processID, err := strconv.Atoi(field)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse process ID %q: %w", field, err)
+		}
+
+		processIDs = append(processIDs, processID)
+	}
+
+	return processIDs, nil
+}
+
+// validateProcessName checks that the process name is safe to pass to pgrep through a shell.
+func validateProcessName(processName string) error {
+	if processName == "" {
+		klog.V(100).Info("The chaos processName is empty")
+
+		return fmt.Errorf("chaos 'processName' cannot be empty")
+	}
+
+	if !processNameRegex.MatchString(processName) {
+		klog.V(100).Infof("The chaos processName %q is invalid", processName)
+
+		return fmt.Errorf("chaos 'processName' %q must be at most 15 alphanumeric, '.', '_' or '-' characters",
+			processName)
+	}
+
+	return nil
+}
@@ -0,0 +1,176 @@
+package chaos
+
+import (
+	"fmt"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetProcessIDs(t *testing.T) {
+	testCases := []struct {
+		processName   string
+		output        string
+		err           error
+		expectedIDs   []int
+		expectedError string
+	}{
+		{
+			processName: "ptp4l",
+			output:      "1234\n5678\n",
+			expectedIDs: []int{1234, 5678},
+		},
+		{
+			processName: "ptp4l",
+			output:      "",
+			expectedIDs: []int{},
+		},
+		{
+			processName:   "ptp4l",
+			output:        "1234\nabc\n",
+			expectedError: "failed to parse process ID \"abc\": strconv.Atoi: parsing \"abc\": invalid syntax",
+		},
+		{
+			processName:   "ptp4l",
+			err:           fmt.Errorf("exec failed"),
+			expectedError: "failed to get IDs of process ptp4l on node worker-0: exec failed",
+		},
+		{
+			processName:   "",
+			expectedError: "chaos 'processName' cannot be empty",
+		},
+		{
+			processName: "ptp4l; reboot",
+			expectedError: "chaos 'processName' \"ptp4l; reboot\" must be at most 15 alphanumeric, '.', '_' or '-' " +
+				"characters",
+		},
+	}
+
+	for _, testCase := range testCases {
+		executor := &fakeExecutor{
+			outputs: map[string]string{"sh": testCase.output},
+			errs:    map[string]error{"sh": testCase.err},
+		}
+
+		processIDs, err := GetProcessIDs(executor, "worker-0", testCase.processName)
+		if testCase.expectedError != "" {
+			assert.EqualError(t, err, testCase.expectedError)
+
+			continue
+		}
+
+		assert.NoError(t, err)
+		assert.Equal(t, testCase.expectedIDs, processIDs)
+		assert.Equal(t, []string{"sh -c pgrep -x ptp4l || true"}, executor.joinedCommands())
+	}
+}
+
+func TestKillProcess(t *testing.T) {
+	testCases := []struct {
+		output           string
+		killErr          error
+		expectedIDs      []int
+		expectedCommands []string
+		expectedError    string
+	}{
+		{
+			output:           "1234\n5678\n",
+			expectedIDs:      []int{1234, 5678},
+			expectedCommands: []string{"sh -c pgrep -x ptp4l || true", "kill -9 1234 5678"},
+		},
+		{
+			output:        "",
+			expectedError: "no process ptp4l found on node worker-0",
+		},
+		{
+			output:        "1234\n",
+			killErr:       fmt.Errorf("exec failed"),
+			expectedError: "failed to send signal 9 to process ptp4l on node worker-0: exec failed",
+		},
+	}
+
+	for _, testCase := range testCases {
+		executor := &fakeExecutor{
+			outputs: map[string]string{"sh": testCase.output},
+			errs:    map[string]error{"kill": testCase.killErr},
+		}
+
+		processIDs, err := KillProcess(executor, "worker-0", "ptp4l", syscall.SIGKILL)
+		if testCase.expectedError != "" {
+			assert.EqualError(t, err, testCase.expectedError)
+
+			continue
+		}
+
+		assert.NoError(t, err)
+		assert.Equal(t, testCase.expectedIDs, processIDs)
+		assert.Equal(t, testCase.expectedCommands, executor.joinedCommands())
+	}
+}
+
+func TestPauseProcess(t *testing.T) {
+	executor := &fakeExecutor{outputs: map[string]string{"sh": "1234\n"}}
+
+	handle, err := PauseProcess(executor, "worker-0", "ptp4l")
+	assert.NoError(t, err)
+	assert.Equal(t, "pause process ptp4l [1234]", handle.Description)
+
+	assert.NoError(t, handle.Restore())
+	assert.Equal(t, []string{
+		"sh -c pgrep -x ptp4l || true",
+		"kill -19 1234",
+		"kill -18 1234",
+	}, executor.joinedCommands())
+
+	_, err = PauseProcess(&fakeExecutor{}, "worker-0", "ptp4l")
+	assert.EqualError(t, err, "no process ptp4l found on node worker-0")
+}
+
+func TestWaitForProcessRestart(t *testing.T) {
+	testCases := []struct {
+		output        string
+		oldIDs        []int
+		expectedIDs   []int
+		expectedError string
+	}{
+		{
+			output:      "5678\n",
+			oldIDs:      []int{1234},
+			expectedIDs: []int{5678},
+		},
+		{
+			output:      "1234\n5678\n",
+			oldIDs:      []int{1234},
+			expectedIDs: []int{1234, 5678},
+		},
+		{
+			output:        "1234\n",
+			oldIDs:        []int{1234},
+			expectedError: "process ptp4l did not restart on node worker-0: context deadline exceeded",
+		},
+		{
+			output:        "",
+			oldIDs:        []int{1234},
+			expectedError: "process ptp4l did not restart on node worker-0: context deadline exceeded",
+		},
+	}
+
+	for _, testCase := range testCases {
+		executor := &fakeExecutor{outputs: map[string]string{"sh": testCase.output}}
+
+		processIDs, err := WaitForProcessRestart(executor, "worker-0", "ptp4l", testCase.oldIDs, 100*time.Millisecond)
+		if testCase.expectedError != "" {
+			assert.EqualError(t, err, testCase.expectedError)
+
+			continue
+		}
+
+		assert.NoError(t, err)
+		assert.Equal(t, testCase.expectedIDs, processIDs)
+	}
+
+	_, err := WaitForProcessRestart(&fakeExecutor{}, "worker-0", "", nil, time.Second)
+	assert.EqualError(t, err, "chaos 'processName' cannot be empty")
+}
@@ -0,0 +1,151 @@
+// Package clean removes resources by their GroupVersionResource, regardless of which builder created them. It is meant
+// for brute-force cleanup of shared test environments, where resources left behind by earlier suites must be removed
+// before the next suite runs. The GroupVersionResources are usually provided by the GetGVR functions of the builder
+// packages, such as pod.GetGVR or sriov.GetSriovNetworkNodePolicyGVR.
+package clean
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/logging"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/klog/v2"
+)
+
+// Everything is a selector matching all resources. Since an empty namespace and an empty selector together would delete
+// every resource of the GroupVersionResource in the cluster, that combination is rejected and Everything must be used
+// instead to do so on purpose.
+const Everything = "*"
+
+// ByGVR deletes every resource of the provided GroupVersionResource matching the label selector. If namespace is
+// empty, matching resources in all namespaces are deleted, which is also how cluster-scoped resources are cleaned. An
+// empty selector matches all resources in the namespace, but must not be combined with an empty namespace; use
+// Everything to delete all resources in all namespaces. Resources that no longer exist are ignored and a failure to
+// delete one resource does not stop the others from being deleted; all of the failures are returned joined.
+func ByGVR(apiClient *clients.Settings, gvr schema.GroupVersionResource, namespace, selector string) error {
+	resourceClient, listOptions, err := getResourceClient(apiClient, gvr, namespace, selector)
+	if err != nil {
+		return err
+	}
+
+	klog.V(100).Infof("Cleaning %s in namespace %q matching selector %q", gvr.String(), namespace, selector)
+
+	return deleteResources(logging.DiscardContext(), apiClient, resourceClient, gvr, listOptions)
+}
+
+// ByGVRAndWait deletes the resources matching the label selector the same way as ByGVR, then waits until none of them
+// remain, such as while their finalizers run. Both deleting and waiting must complete within timeout.
+func ByGVRAndWait(
+	apiClient *clients.Settings,
+	gvr schema.GroupVersionResource,
+	namespace, selector string,
+	timeout time.Duration) error {
+	resourceClient, listOptions, err := getResourceClient(apiClient, gvr, namespace, selector)
+	if err != nil {
+		return err
+	}
+
+	klog.V(100).Infof("Cleaning %s in namespace %q matching selector %q", gvr.String(), namespace, selector)
+
+	ctx, cancel := context.WithTimeout(logging.DiscardContext(), timeout)
+	defer cancel()
+
+	err = deleteResources(ctx, apiClient, resourceClient, gvr, listOptions)
+	if err != nil {
+		return err
+	}
+
+	klog.V(100).Infof("Waiting for %s in namespace %q matching selector %q to be deleted",
+		gvr.String(), namespace, selector)
+
+	return wait.PollUntilContextCancel(ctx, time.Second, true, func(ctx context.Context) (bool, error) {
+		resources, err := resourceClient.List(ctx, listOptions)
+		if err != nil {
+			klog.V(100).Infof("Failed to list %s: %v", gvr.Resource, err)
+
+			return false, nil
+		}
+
+		return len(resources.Items) == 0, nil
+	})
+}
+
+// deleteResources deletes every resource listed by resourceClient with listOptions, ignoring resources that no longer
+// exist. All of the failures are returned joined.
+func deleteResources(
+	ctx context.Context,
+	apiClient *clients.Settings,
+	resourceClient dynamic.ResourceInterface,
+	gvr schema.GroupVersionResource,
+	listOptions metav1.ListOptions) error {
+	resources, err := resourceClient.List(ctx, listOptions)
+	if err != nil {
+		return fmt.Errorf("failed to list %s: %w", gvr.Resource, err)
+	}
+
+	var errs []error
+
+	for _, resource := range resources.Items {
+		klog.V(100).Infof("Deleting %s %s in namespace %q", gvr.Resource, resource.GetName(), resource.GetNamespace())
+
+		err := apiClient.Resource(gvr).Namespace(resource.GetNamespace()).Delete(
+			ctx, resource.GetName(), metav1.DeleteOptions{})
+		if err != nil && !k8serrors.IsNotFound(err) {
+			errs = append(errs, fmt.Errorf("failed to delete %s %s in namespace %q: %w",
+				gvr.Resource, resource.GetName(), resource.GetNamespace(), err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// getResourceClient validates the arguments common to the clean functions and returns the client used to list the
+// resources along with the options selecting them.
+func getResourceClient(
+	apiClient *clients.Settings,
+	gvr schema.GroupVersionResource,
+	namespace, selector string) (dynamic.ResourceInterface, metav1.ListOptions, error) {
+	if apiClient == nil {
+		klog.V(100).Info("The apiClient of the clean is nil")
+
+		return nil, metav1.ListOptions{}, fmt.Errorf("clean 'apiClient' cannot be nil")
+	}
+
+	if gvr.Resource == "" || gvr.Version == "" {
+		klog.V(100).Infof("The GroupVersionResource %s of the clean is incomplete", gvr.String())
+
+		return nil, metav1.ListOptions{}, fmt.Errorf("clean 'gvr' must have a version and resource")
+	}
+
+	if namespace == "" && selector == "" {
+		klog.V(100).Info("The namespace and selector of the clean are both empty")
+
+		return nil, metav1.ListOptions{}, fmt.Errorf(
+			"clean 'namespace' and 'selector' cannot both be empty, use clean.Everything to select all resources")
+	}
+
+	if selector == Everything {
+		selector = ""
+	}
+
+	if _, err := labels.Parse(selector); err != nil {
+		klog.V(100).Infof("The selector %q of the clean is invalid: %v", selector, err)
+
+		return nil, metav1.ListOptions{}, fmt.Errorf("clean 'selector' is invalid: %w", err)
+	}
+
+	if apiClient.Interface == nil {
+		return nil, metav1.ListOptions{}, fmt.Errorf("clean 'apiClient' does not support dynamic resource operations")
+	}
+
+	return apiClient.Resource(gvr).Namespace(namespace), metav1.ListOptions{LabelSelector: selector}, nil
+}
@@ -0,0 +1,158 @@
+package clean
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/configmap"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/rbac"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	clienttesting "k8s.io/client-go/testing"
+)
+
+const defaultCleanSelector = "app=test"
+
+func TestByGVR(t *testing.T) {
+	testCases := []struct {
+		gvr           schema.GroupVersionResource
+		namespace     string
+		selector      string
+		expectedLeft  []string
+		expectedError string
+	}{
+		{
+			gvr:          configmap.GetGVR(),
+			namespace:    "ns-a",
+			selector:     defaultCleanSelector,
+			expectedLeft: []string{"ns-a/other", "ns-b/matching", "ns-b/other"},
+		},
+		{
+			gvr:          configmap.GetGVR(),
+			selector:     defaultCleanSelector,
+			expectedLeft: []string{"ns-a/other", "ns-b/other"},
+		},
+		{
+			gvr:          configmap.GetGVR(),
+			namespace:    "ns-b",
+			expectedLeft: []string{"ns-a/matching", "ns-a/other"},
+		},
+		{
+			gvr:          rbac.GetClusterRoleGVR(),
+			selector:     defaultCleanSelector,
+			expectedLeft: []string{"/other"},
+		},
+		{
+			gvr:          configmap.GetGVR(),
+			selector:     Everything,
+			expectedLeft: []string{},
+		},
+		{
+			gvr: configmap.GetGVR(),
+			expectedError: "clean 'namespace' and 'selector' cannot both be empty, " +
+				"use clean.Everything to select all resources",
+		},
+		{
+			gvr:      configmap.GetGVR(),
+			selector: "app in (",
+			expectedError: "clean 'selector' is invalid: unable to parse requirement: found '', " +
+				"expected: ',', ')' or identifier",
+		},
+		{
+			gvr:           schema.GroupVersionResource{Version: "v1"},
+			expectedError: "clean 'gvr' must have a version and resource",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testSettings := buildTestClientWithCleanObjects()
+
+		err := ByGVR(testSettings, testCase.gvr, testCase.namespace, testCase.selector)
+		if testCase.expectedError != "" {
+			assert.EqualError(t, err, testCase.expectedError)
+
+			continue
+		}
+
+		assert.NoError(t, err)
+		assert.ElementsMatch(t, testCase.expectedLeft, listCleanObjects(t, testSettings, testCase.gvr))
+	}
+
+	err := ByGVR(nil, configmap.GetGVR(), "", "")
+	assert.EqualError(t, err, "clean 'apiClient' cannot be nil")
+}
+
+func TestByGVRAndWait(t *testing.T) {
+	testSettings := buildTestClientWithCleanObjects()
+
+	err := ByGVRAndWait(testSettings, configmap.GetGVR(), "", defaultCleanSelector, time.Second)
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []string{"ns-a/other", "ns-b/other"},
+		listCleanObjects(t, testSettings, configmap.GetGVR()))
+
+	err = ByGVRAndWait(nil, configmap.GetGVR(), "", "", time.Second)
+	assert.EqualError(t, err, "clean 'apiClient' cannot be nil")
+
+	// Deleting never removes the resources, so waiting must stop at the timeout.
+	testSettings = buildTestClientWithCleanObjects()
+
+	dynamicClient, ok := testSettings.Interface.(*dynamicfake.FakeDynamicClient)
+	require.True(t, ok)
+
+	dynamicClient.PrependReactor("delete", "configmaps", func(clienttesting.Action) (bool, runtime.Object, error) {
+		return true, nil, nil
+	})
+
+	err = ByGVRAndWait(testSettings, configmap.GetGVR(), "", defaultCleanSelector, 100*time.Millisecond)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func buildTestClientWithCleanObjects() *clients.Settings {
+	return &clients.Settings{Interface: dynamicfake.NewSimpleDynamicClientWithCustomListKinds(
+		runtime.NewScheme(), map[schema.GroupVersionResource]string{
+			configmap.GetGVR():       "ConfigMapList",
+			rbac.GetClusterRoleGVR(): "ClusterRoleList",
+		},
+		buildCleanObject("v1", "ConfigMap", "ns-a", "matching", true),
+		buildCleanObject("v1", "ConfigMap", "ns-a", "other", false),
+		buildCleanObject("v1", "ConfigMap", "ns-b", "matching", true),
+		buildCleanObject("v1", "ConfigMap", "ns-b", "other", false),
+		buildCleanObject("rbac.authorization.k8s.io/v1", "ClusterRole", "", "matching", true),
+		buildCleanObject("rbac.authorization.k8s.io/v1", "ClusterRole", "", "other", false),
+	)}
+}
+
+func buildCleanObject(apiVersion, kind, namespace, name string, matching bool) *unstructured.Unstructured {
+	object := &unstructured.Unstructured{}
+	object.SetAPIVersion(apiVersion)
+	object.SetKind(kind)
+	object.SetNamespace(namespace)
+	object.SetName(name)
+
+	if matching {
+		object.SetLabels(map[string]string{"app": "test"})
+	}
+
+	return object
+}
+
+func listCleanObjects(t *testing.T, apiClient *clients.Settings, gvr schema.GroupVersionResource) []string {
+	t.Helper()
+
+	objects, err := apiClient.Resource(gvr).List(t.Context(), metav1.ListOptions{})
+	assert.NoError(t, err)
+
+	var names []string
+	for _, object := range objects.Items {
+		names = append(names, object.GetNamespace()+"/"+object.GetName())
+	}
+
+	return names
+}
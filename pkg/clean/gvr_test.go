@@ -0,0 +1,511 @@
+package clean
+
+import (
+	"testing"
+
+	lokiv1 "github.com/grafana/loki/operator/apis/loki/v1"
+	mnpv1beta1 "github.com/k8snetworkplumbingwg/multi-networkpolicy/pkg/apis/k8s.cni.cncf.io/v1beta1"
+	nadV1 "github.com/k8snetworkplumbingwg/network-attachment-definition-client/pkg/apis/k8s.cni.cncf.io/v1"
+	srIovV1 "github.com/k8snetworkplumbingwg/sriov-network-operator/api/v1"
+	kedav1alpha1 "github.com/kedacore/keda-olm-operator/api/keda/v1alpha1"
+	kedav2v1alpha1 "github.com/kedacore/keda/v2/apis/keda/v1alpha1"
+	noobaav1alpha1 "github.com/kube-object-storage/lib-bucket-provisioner/pkg/apis/objectbucket.io/v1alpha1"
+	bmhv1alpha1 "github.com/metal3-io/baremetal-operator/apis/metal3.io/v1alpha1"
+	nmstateV1 "github.com/nmstate/kubernetes-nmstate/api/v1"
+	nmstateV1beta1 "github.com/nmstate/kubernetes-nmstate/api/v1beta1"
+	cguv1alpha1 "github.com/openshift-kni/cluster-group-upgrades-operator/pkg/api/clustergroupupgrades/v1alpha1"
+	lcav1 "github.com/openshift-kni/lifecycle-agent/api/imagebasedupgrade/v1"
+	lcasgv1 "github.com/openshift-kni/lifecycle-agent/api/seedgenerator/v1"
+	nropv1 "github.com/openshift-kni/numaresources-operator/api/v1"
+	hardwaremanagementv1alpha1 "github.com/openshift-kni/oran-o2ims/api/hardwaremanagement/v1alpha1"
+	provisioningv1alpha1 "github.com/openshift-kni/oran-o2ims/api/provisioning/v1alpha1"
+	buildv1 "github.com/openshift/api/build/v1"
+	configv1 "github.com/openshift/api/config/v1"
+	imagev1 "github.com/openshift/api/image/v1"
+	imageregistryv1 "github.com/openshift/api/imageregistry/v1"
+	machinev1beta1 "github.com/openshift/api/machine/v1beta1"
+	mcv1 "github.com/openshift/api/machineconfiguration/v1"
+	networkv1 "github.com/openshift/api/network/v1"
+	oauthv1 "github.com/openshift/api/oauth/v1"
+	operatorv1 "github.com/openshift/api/operator/v1"
+	operatorv1alpha1 "github.com/openshift/api/operator/v1alpha1"
+	routev1 "github.com/openshift/api/route/v1"
+	securityv1 "github.com/openshift/api/security/v1"
+	observabilityv1 "github.com/openshift/cluster-logging-operator/api/observability/v1"
+	nfdv1 "github.com/openshift/cluster-nfd-operator/api/v1"
+	performanceprofilev2 "github.com/openshift/cluster-node-tuning-operator/pkg/apis/performanceprofile/v2"
+	tunedv1 "github.com/openshift/cluster-node-tuning-operator/pkg/apis/tuned/v1"
+	eskv1 "github.com/openshift/elasticsearch-operator/apis/logging/v1"
+	lsov1alpha1 "github.com/openshift/local-storage-operator/api/v1alpha1"
+	egressfirewallv1 "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/crd/egressfirewall/v1"
+	egressipv1 "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/crd/egressip/v1"
+	egresssvcv1 "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/crd/egressservice/v1"
+	monv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	odfoperatorv1alpha1 "github.com/red-hat-storage/odf-operator/api/v1alpha1"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/amdgpu"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/apiservers"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/apiservice"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/argocd"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/assisted"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/bmh"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/capi"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/certificate"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/cgu"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clusterlogging"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clusteroperator"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clusterversion"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/compliance"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/configmap"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/console"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/crd"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/daemonset"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/deployment"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/dns"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/egressfirewall"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/egressip"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/egressservice"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/endpointslice"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/etcd"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/events"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/externalsecrets"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/gatewayapi"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/hive"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/ibgu"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/ibi"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/icsp"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/idms"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/imagebuild"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/imageregistry"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/imagestream"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/infrastructure"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/ingress"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/insights"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/inteldeviceplugins"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/itms"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/keda"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/kepler"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/kmm"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/kserve"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/lca"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/lease"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/lso"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/machine"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/mco"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/medik8s"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/metallb"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/monitoring"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/nad"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/namespace"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/network"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/networkpolicy"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/neuron"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/nfd"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/nmstate"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/nodes"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/nodesconfig"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/nrop"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/nto"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/nvidiagpu"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/oadp"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/oauth"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/ocm"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/olm"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/oran"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/ovn"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/pfstatus"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/pod"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/poddisruptionbudget"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/proxy"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/ptp"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/rbac"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/replicaset"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/resourcequotas"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/route"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/scc"
+	amdgpuv1 "github.com/rh-ecosystem-edge/eco-goinfra/pkg/schemes/amd/gpu-operator/api/v1alpha1"
+	apiregistrationv1 "github.com/rh-ecosystem-edge/eco-goinfra/pkg/schemes/apiregistration/v1"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/schemes/argocd/argocdoperator"
+	argocdtypes "github.com/rh-ecosystem-edge/eco-goinfra/pkg/schemes/argocd/argocdtypes/v1alpha1"
+	hiveextV1Beta1 "github.com/rh-ecosystem-edge/eco-goinfra/pkg/schemes/assisted/api/hiveextension/v1beta1"
+	agentInstallV1Beta1 "github.com/rh-ecosystem-edge/eco-goinfra/pkg/schemes/assisted/api/v1beta1"
+	clusterv1 "github.com/rh-ecosystem-edge/eco-goinfra/pkg/schemes/capi/v1beta1"
+	capm3v1beta1 "github.com/rh-ecosystem-edge/eco-goinfra/pkg/schemes/capm3/v1beta1"
+	compliancev1alpha1 "github.com/rh-ecosystem-edge/eco-goinfra/pkg/schemes/compliance/v1alpha1"
+	esv1 "github.com/rh-ecosystem-edge/eco-goinfra/pkg/schemes/externalsecrets/v1"
+	sriovfectypes "github.com/rh-ecosystem-edge/eco-goinfra/pkg/schemes/fec/fectypes"
+	sriovvrbtypes "github.com/rh-ecosystem-edge/eco-goinfra/pkg/schemes/fec/vrbtypes"
+	gatewayv1 "github.com/rh-ecosystem-edge/eco-goinfra/pkg/schemes/gatewayapi/v1"
+	hiveV1 "github.com/rh-ecosystem-edge/eco-goinfra/pkg/schemes/hive/api/v1"
+	ibguv1alpha1 "github.com/rh-ecosystem-edge/eco-goinfra/pkg/schemes/imagebasedgroupupgrades/v1alpha1"
+	ibiv1alpha1 "github.com/rh-ecosystem-edge/eco-goinfra/pkg/schemes/imagebasedinstall/api/hiveextensions/v1alpha1"
+	idpv1 "github.com/rh-ecosystem-edge/eco-goinfra/pkg/schemes/inteldeviceplugins/v1"
+	lcaipcv1 "github.com/rh-ecosystem-edge/eco-goinfra/pkg/schemes/ipchange/api/ipconfig/v1"
+	keplerv1alpha1 "github.com/rh-ecosystem-edge/eco-goinfra/pkg/schemes/kepler/v1alpha1"
+	mcmV1Beta1 "github.com/rh-ecosystem-edge/eco-goinfra/pkg/schemes/kmm-hub/v1beta1"
+	bmcV1Beta1 "github.com/rh-ecosystem-edge/eco-goinfra/pkg/schemes/kmm/v1beta1"
+	kmmv1beta2 "github.com/rh-ecosystem-edge/eco-goinfra/pkg/schemes/kmm/v1beta2"
+	kservev1alpha1 "github.com/rh-ecosystem-edge/eco-goinfra/pkg/schemes/kserve/v1alpha1"
+	kservev1beta1 "github.com/rh-ecosystem-edge/eco-goinfra/pkg/schemes/kserve/v1beta1"
+	farv1alpha1 "github.com/rh-ecosystem-edge/eco-goinfra/pkg/schemes/medik8s/fenceagentsremediation/v1alpha1"
+	nhcv1alpha1 "github.com/rh-ecosystem-edge/eco-goinfra/pkg/schemes/medik8s/nodehealthcheck/v1alpha1"
+	nmv1beta1 "github.com/rh-ecosystem-edge/eco-goinfra/pkg/schemes/medik8s/nodemaintenance/v1beta1"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/schemes/metallb/frrtypes"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/schemes/metallb/mlboperator"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/schemes/metallb/mlbtypes"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/schemes/metallb/mlbtypesv1beta2"
+	monv1alpha1 "github.com/rh-ecosystem-edge/eco-goinfra/pkg/schemes/monitoring/v1alpha1"
+	neuronv1beta1 "github.com/rh-ecosystem-edge/eco-goinfra/pkg/schemes/neuron/v1beta1"
+	nfdv1alpha1 "github.com/rh-ecosystem-edge/eco-goinfra/pkg/schemes/nfd/v1alpha1"
+	nvidiagpuv1 "github.com/rh-ecosystem-edge/eco-goinfra/pkg/schemes/nvidiagpu/nvidiagputypes"
+	oadpv1alpha1 "github.com/rh-ecosystem-edge/eco-goinfra/pkg/schemes/oadp/api/v1alpha1"
+	ocmclusterv1 "github.com/rh-ecosystem-edge/eco-goinfra/pkg/schemes/ocm/clusterv1"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/schemes/ocm/kacv1"
+	ocsoperatorv1 "github.com/rh-ecosystem-edge/eco-goinfra/pkg/schemes/ocs/operatorv1"
+	operatorsv1 "github.com/rh-ecosystem-edge/eco-goinfra/pkg/schemes/olm/operators/v1"
+	oplmV1alpha1 "github.com/rh-ecosystem-edge/eco-goinfra/pkg/schemes/olm/operators/v1alpha1"
+	operatorsv2 "github.com/rh-ecosystem-edge/eco-goinfra/pkg/schemes/olm/operators/v2"
+	pkgserverv1 "github.com/rh-ecosystem-edge/eco-goinfra/pkg/schemes/olm/package-server/operators/v1"
+	ovnv1 "github.com/rh-ecosystem-edge/eco-goinfra/pkg/schemes/ovn/routeadvertisement/v1"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/schemes/pfstatus/pfstatustypes"
+	ptpv1 "github.com/rh-ecosystem-edge/eco-goinfra/pkg/schemes/ptp/v1"
+	ptpv2alpha1 "github.com/rh-ecosystem-edge/eco-goinfra/pkg/schemes/ptp/v2alpha1"
+	siteconfigv1alpha1 "github.com/rh-ecosystem-edge/eco-goinfra/pkg/schemes/siteconfig/v1alpha1"
+	volsyncv1alpha1 "github.com/rh-ecosystem-edge/eco-goinfra/pkg/schemes/volsync/v1alpha1"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/secret"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/service"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/serviceaccount"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/servicemesh"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/siteconfig"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/sriov"
+	sriovfec "github.com/rh-ecosystem-edge/eco-goinfra/pkg/sriov-fec"
+	sriovvrb "github.com/rh-ecosystem-edge/eco-goinfra/pkg/sriov-vrb"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/statefulset"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/storage"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/velero"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/volsync"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/webhook"
+	"github.com/stretchr/testify/assert"
+	velerov1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	admregv1 "k8s.io/api/admissionregistration/v1"
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	certificatesv1 "k8s.io/api/certificates/v1"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	apiextv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	istiov1 "maistra.io/api/core/v1"
+	istiov2 "maistra.io/api/core/v2"
+	ocmoperatorv1 "open-cluster-management.io/api/operator/v1"
+	policiesv1 "open-cluster-management.io/governance-policy-propagator/api/v1"
+	policiesv1beta1 "open-cluster-management.io/governance-policy-propagator/api/v1beta1"
+	placementrulev1 "open-cluster-management.io/multicloud-operators-subscription/pkg/apis/apps/placementrule/v1"
+)
+
+// TestGVRsMatchSchemeKinds checks that the GroupVersionResource returned by every GVR function of the builder packages
+// matches the kind of the object its builder manages, since these are typed by hand and mostly used by clean.
+func TestGVRsMatchSchemeKinds(t *testing.T) {
+	testCases := []struct {
+		gvr      schema.GroupVersionResource
+		object   runtime.Object
+		attacher clients.SchemeAttacher
+		// resource is the plural name of the resource when it cannot be guessed from the kind.
+		resource string
+	}{
+		{gvr: amdgpu.GetGVR(), object: &amdgpuv1.DeviceConfig{}, attacher: amdgpuv1.AddToScheme},
+		{gvr: apiservers.GetAPIServerGVR(), object: &configv1.APIServer{}, attacher: configv1.Install},
+		{gvr: apiservers.GetKubeAPIServerGVR(), object: &operatorv1.KubeAPIServer{}},
+		{gvr: apiservers.GetOpenShiftAPIServerGVR(), object: &operatorv1.OpenShiftAPIServer{}},
+		{gvr: apiservice.GetGVR(), object: &apiregistrationv1.APIService{}, attacher: apiregistrationv1.AddToScheme},
+		{gvr: argocd.GetApplicationGVR(), object: &argocdtypes.Application{}, attacher: argocdtypes.AddToScheme},
+		{gvr: argocd.GetArgoCDGVR(), object: &argocdoperator.ArgoCD{}, attacher: argocdoperator.AddToScheme},
+		{gvr: assisted.GetAgentGVR(), object: &agentInstallV1Beta1.Agent{}},
+		{gvr: assisted.GetAgentClusterInstallGVR(), object: &hiveextV1Beta1.AgentClusterInstall{},
+			attacher: hiveextV1Beta1.AddToScheme},
+		{gvr: assisted.GetAgentServiceConfigGVR(), object: &agentInstallV1Beta1.AgentServiceConfig{}},
+		{gvr: assisted.GetInfraEnvGVR(), object: &agentInstallV1Beta1.InfraEnv{}},
+		{gvr: assisted.GetNMStateConfigGVR(), object: &agentInstallV1Beta1.NMStateConfig{}},
+		{gvr: bmh.GetBareMetalHostGVR(), object: &bmhv1alpha1.BareMetalHost{}, attacher: bmhv1alpha1.AddToScheme},
+		{gvr: bmh.GetDataImageGVR(), object: &bmhv1alpha1.DataImage{}, attacher: bmhv1alpha1.AddToScheme},
+		{gvr: bmh.GetHostFirmwareComponentsGVR(), object: &bmhv1alpha1.HostFirmwareComponents{},
+			attacher: bmhv1alpha1.AddToScheme, resource: "hostfirmwarecomponents"},
+		{gvr: bmh.GetHostFirmwareSettingsGVR(), object: &bmhv1alpha1.HostFirmwareSettings{},
+			attacher: bmhv1alpha1.AddToScheme, resource: "hostfirmwaresettings"},
+		{gvr: capi.GetClusterGVR(), object: &clusterv1.Cluster{}, attacher: clusterv1.AddToScheme},
+		{gvr: capi.GetMachineDeploymentGVR(), object: &clusterv1.MachineDeployment{}, attacher: clusterv1.AddToScheme},
+		{gvr: capi.GetMetal3MachineGVR(), object: &capm3v1beta1.Metal3Machine{}, attacher: capm3v1beta1.AddToScheme},
+		{gvr: capi.GetMetal3MachineTemplateGVR(), object: &capm3v1beta1.Metal3MachineTemplate{},
+			attacher: capm3v1beta1.AddToScheme},
+		{gvr: certificate.GetGVR(), object: &certificatesv1.CertificateSigningRequest{},
+			attacher: certificatesv1.AddToScheme},
+		{gvr: cgu.GetClusterGroupUpgradeGVR(), object: &cguv1alpha1.ClusterGroupUpgrade{},
+			attacher: cguv1alpha1.AddToScheme},
+		{gvr: cgu.GetPreCachingConfigGVR(), object: &cguv1alpha1.PreCachingConfig{}, attacher: cguv1alpha1.AddToScheme},
+		{gvr: clusterlogging.GetClusterLogForwarderGVR(), object: &observabilityv1.ClusterLogForwarder{},
+			attacher: observabilityv1.AddToScheme},
+		{gvr: clusterlogging.GetElasticsearchGVR(), object: &eskv1.Elasticsearch{},
+			attacher: eskv1.AddToScheme, resource: "elasticsearches"},
+		{gvr: clusterlogging.GetLokiStackGVR(), object: &lokiv1.LokiStack{}, attacher: lokiv1.AddToScheme},
+		{gvr: clusteroperator.GetGVR(), object: &configv1.ClusterOperator{}},
+		{gvr: clusterversion.GetGVR(), object: &configv1.ClusterVersion{}, attacher: configv1.Install},
+		{gvr: compliance.GetComplianceCheckResultGVR(), object: &compliancev1alpha1.ComplianceCheckResult{},
+			attacher: compliancev1alpha1.AddToScheme},
+		{gvr: compliance.GetComplianceScanGVR(), object: &compliancev1alpha1.ComplianceScan{},
+			attacher: compliancev1alpha1.AddToScheme},
+		{gvr: compliance.GetComplianceRemediationGVR(), object: &compliancev1alpha1.ComplianceRemediation{},
+			attacher: compliancev1alpha1.AddToScheme},
+		{gvr: compliance.GetScanSettingBindingGVR(), object: &compliancev1alpha1.ScanSettingBinding{},
+			attacher: compliancev1alpha1.AddToScheme},
+		{gvr: configmap.GetGVR(), object: &corev1.ConfigMap{}, attacher: corev1.AddToScheme},
+		{gvr: console.GetConsoleGVR(), object: &configv1.Console{}, attacher: configv1.Install},
+		{gvr: console.GetConsoleOperatorGVR(), object: &operatorv1.Console{}},
+		{gvr: crd.GetGVR(), object: &apiextv1.CustomResourceDefinition{}, attacher: apiextv1.AddToScheme},
+		{gvr: daemonset.GetGVR(), object: &appsv1.DaemonSet{}},
+		{gvr: deployment.GetGVR(), object: &appsv1.Deployment{}},
+		{gvr: dns.GetGVR(), object: &configv1.DNS{}, attacher: configv1.Install},
+		{gvr: egressfirewall.GetEgressFirewallGVR(), object: &egressfirewallv1.EgressFirewall{},
+			attacher: egressfirewallv1.AddToScheme},
+		{gvr: egressfirewall.GetEgressNetworkPolicyGVR(), object: &networkv1.EgressNetworkPolicy{},
+			attacher: networkv1.Install},
+		{gvr: egressip.GetGVR(), object: &egressipv1.EgressIP{}, attacher: egressipv1.AddToScheme},
+		{gvr: egressservice.GetGVR(), object: &egresssvcv1.EgressService{}, attacher: egresssvcv1.AddToScheme},
+		{gvr: endpointslice.GetGVR(), object: &discoveryv1.EndpointSlice{}, attacher: discoveryv1.AddToScheme},
+		{gvr: etcd.GetGVR(), object: &operatorv1.Etcd{}},
+		{gvr: events.GetGVR(), object: &corev1.Event{}},
+		{gvr: externalsecrets.GetClusterSecretStoreGVR(), object: &esv1.ClusterSecretStore{},
+			attacher: esv1.AddToScheme},
+		{gvr: externalsecrets.GetExternalSecretGVR(), object: &esv1.ExternalSecret{}, attacher: esv1.AddToScheme},
+		{gvr: externalsecrets.GetSecretStoreGVR(), object: &esv1.SecretStore{}, attacher: esv1.AddToScheme},
+		{gvr: gatewayapi.GetGatewayGVR(), object: &gatewayv1.Gateway{},
+			attacher: gatewayv1.AddToScheme, resource: "gateways"},
+		{gvr: gatewayapi.GetGatewayClassGVR(), object: &gatewayv1.GatewayClass{}, attacher: gatewayv1.AddToScheme},
+		{gvr: gatewayapi.GetHTTPRouteGVR(), object: &gatewayv1.HTTPRoute{}, attacher: gatewayv1.AddToScheme},
+		{gvr: hive.GetClusterDeploymentGVR(), object: &hiveV1.ClusterDeployment{}, attacher: hiveV1.AddToScheme},
+		{gvr: hive.GetClusterImageSetGVR(), object: &hiveV1.ClusterImageSet{}, attacher: hiveV1.AddToScheme},
+		{gvr: hive.GetHiveConfigGVR(), object: &hiveV1.HiveConfig{}, attacher: hiveV1.AddToScheme},
+		{gvr: ibgu.GetGVR(), object: &ibguv1alpha1.ImageBasedGroupUpgrade{}, attacher: ibguv1alpha1.AddToScheme},
+		{gvr: ibi.GetGVR(), object: &ibiv1alpha1.ImageClusterInstall{}, attacher: ibiv1alpha1.AddToScheme},
+		{gvr: icsp.GetGVR(), object: &operatorv1alpha1.ImageContentSourcePolicy{}, attacher: operatorv1alpha1.Install},
+		{gvr: idms.GetGVR(), object: &configv1.ImageDigestMirrorSet{}, attacher: configv1.AddToScheme},
+		{gvr: imagebuild.GetBuildGVR(), object: &buildv1.Build{}, attacher: buildv1.Install},
+		{gvr: imagebuild.GetJobGVR(), object: &batchv1.Job{}, attacher: batchv1.AddToScheme},
+		{gvr: imageregistry.GetImagePrunerGVR(), object: &imageregistryv1.ImagePruner{},
+			attacher: imageregistryv1.Install},
+		{gvr: imageregistry.GetConfigGVR(), object: &imageregistryv1.Config{}, attacher: imageregistryv1.Install},
+		{gvr: imagestream.GetGVR(), object: &imagev1.ImageStream{}, attacher: imagev1.AddToScheme},
+		{gvr: infrastructure.GetGVR(), object: &configv1.Infrastructure{}, attacher: configv1.Install},
+		{gvr: ingress.GetIngressGVR(), object: &networkingv1.Ingress{}, attacher: networkingv1.AddToScheme},
+		{gvr: ingress.GetIngressControllerGVR(), object: &operatorv1.IngressController{}},
+		{gvr: insights.GetGVR(), object: &configv1.InsightsDataGather{}, attacher: configv1.Install},
+		{gvr: inteldeviceplugins.GetDsaDevicePluginGVR(), object: &idpv1.DsaDevicePlugin{},
+			attacher: idpv1.AddToScheme},
+		{gvr: inteldeviceplugins.GetQatDevicePluginGVR(), object: &idpv1.QatDevicePlugin{},
+			attacher: idpv1.AddToScheme},
+		{gvr: inteldeviceplugins.GetSgxDevicePluginGVR(), object: &idpv1.SgxDevicePlugin{},
+			attacher: idpv1.AddToScheme},
+		{gvr: itms.GetGVR(), object: &configv1.ImageTagMirrorSet{}, attacher: configv1.Install},
+		{gvr: keda.GetKedaControllerGVR(), object: &kedav1alpha1.KedaController{}, attacher: kedav1alpha1.AddToScheme},
+		{gvr: keda.GetScaledObjectGVR(), object: &kedav2v1alpha1.ScaledObject{}, attacher: kedav2v1alpha1.AddToScheme},
+		{gvr: keda.GetTriggerAuthenticationGVR(), object: &kedav2v1alpha1.TriggerAuthentication{},
+			attacher: kedav2v1alpha1.AddToScheme},
+		{gvr: kepler.GetGVR(), object: &keplerv1alpha1.Kepler{}, attacher: keplerv1alpha1.AddToScheme},
+		{gvr: kmm.GetBootModuleConfigGVR(), object: &bmcV1Beta1.BootModuleConfig{}, attacher: bmcV1Beta1.AddToScheme},
+		{gvr: kmm.GetManagedClusterModuleGVR(), object: &mcmV1Beta1.ManagedClusterModule{},
+			attacher: mcmV1Beta1.AddToScheme},
+		{gvr: kmm.GetModuleGVR(), object: &bmcV1Beta1.Module{}, attacher: bmcV1Beta1.AddToScheme},
+		{gvr: kmm.GetPreflightValidationGVR(), object: &kmmv1beta2.PreflightValidation{},
+			attacher: kmmv1beta2.AddToScheme},
+		{gvr: kmm.GetPreflightValidationOCPGVR(), object: &kmmv1beta2.PreflightValidationOCP{},
+			attacher: kmmv1beta2.AddToScheme, resource: "preflightvalidationsocp"},
+		{gvr: kserve.GetInferenceServiceGVR(), object: &kservev1beta1.InferenceService{},
+			attacher: kservev1beta1.AddToScheme},
+		{gvr: kserve.GetServingRuntimeGVR(), object: &kservev1alpha1.ServingRuntime{},
+			attacher: kservev1alpha1.AddToScheme},
+		{gvr: lca.GetImageBasedUpgradeGVR(), object: &lcav1.ImageBasedUpgrade{}, attacher: lcav1.AddToScheme},
+		{gvr: lca.GetIPConfigGVR(), object: &lcaipcv1.IPConfig{}, attacher: lcaipcv1.AddToScheme},
+		{gvr: lca.GetSeedGeneratorGVR(), object: &lcasgv1.SeedGenerator{}, attacher: lcasgv1.AddToScheme},
+		{gvr: lease.GetGVR(), object: &coordinationv1.Lease{}, attacher: coordinationv1.AddToScheme},
+		{gvr: lso.GetLocalVolumeDiscoveryGVR(), object: &lsov1alpha1.LocalVolumeDiscovery{},
+			attacher: lsov1alpha1.AddToScheme},
+		{gvr: lso.GetLocalVolumeSetGVR(), object: &lsov1alpha1.LocalVolumeSet{}, attacher: lsov1alpha1.AddToScheme},
+		{gvr: machine.GetGVR(), object: &machinev1beta1.MachineSet{}, attacher: machinev1beta1.Install},
+		{gvr: mco.GetKubeletConfigGVR(), object: &mcv1.KubeletConfig{}, attacher: mcv1.Install},
+		{gvr: mco.GetMachineConfigGVR(), object: &mcv1.MachineConfig{}, attacher: mcv1.Install},
+		{gvr: mco.GetMachineConfigPoolGVR(), object: &mcv1.MachineConfigPool{}, attacher: mcv1.Install},
+		{gvr: medik8s.GetFenceAgentsRemediationGVR(), object: &farv1alpha1.FenceAgentsRemediation{},
+			attacher: farv1alpha1.AddToScheme},
+		{gvr: medik8s.GetNodeHealthCheckGVR(), object: &nhcv1alpha1.NodeHealthCheck{},
+			attacher: nhcv1alpha1.AddToScheme},
+		{gvr: medik8s.GetNodeMaintenanceGVR(), object: &nmv1beta1.NodeMaintenance{}, attacher: nmv1beta1.AddToScheme},
+		{gvr: metallb.GetIPAddressPoolGVR(), object: &mlbtypes.IPAddressPool{}, attacher: mlbtypes.AddToScheme},
+		{gvr: metallb.GetBFDProfileGVR(), object: &mlbtypes.BFDProfile{}, attacher: mlbtypes.AddToScheme},
+		{gvr: metallb.GetBGPAdvertisementGVR(), object: &mlbtypes.BGPAdvertisement{}, attacher: mlbtypes.AddToScheme},
+		{gvr: metallb.GetBGPPeerGVR(), object: &mlbtypesv1beta2.BGPPeer{}, attacher: mlbtypesv1beta2.AddToScheme},
+		{gvr: metallb.GetBGPSessionStateGVR(), object: &frrtypes.BGPSessionState{}, attacher: frrtypes.AddToScheme},
+		{gvr: metallb.GetFrrConfigurationGVR(), object: &frrtypes.FRRConfiguration{}, attacher: frrtypes.AddToScheme},
+		{gvr: metallb.GetFrrNodeStateGVR(), object: &frrtypes.FRRNodeState{}, attacher: frrtypes.AddToScheme},
+		{gvr: metallb.GetL2AdvertisementGVR(), object: &mlbtypes.L2Advertisement{}, attacher: mlbtypes.AddToScheme},
+		{gvr: metallb.GetMetalLbIoGVR(), object: &mlboperator.MetalLB{}, attacher: mlboperator.AddToScheme},
+		{gvr: metallb.GetServiceBGPStatusGVR(), object: &mlbtypes.ServiceBGPStatus{}, attacher: mlbtypes.AddToScheme},
+		{gvr: monitoring.GetAlertmanagerConfigGVR(), object: &monv1alpha1.AlertmanagerConfig{},
+			attacher: monv1alpha1.AddToScheme},
+		{gvr: monitoring.GetServiceMonitorGVR(), object: &monv1.ServiceMonitor{}, attacher: monv1.AddToScheme},
+		{gvr: nad.GetGVR(), object: &nadV1.NetworkAttachmentDefinition{},
+			attacher: nadV1.AddToScheme, resource: "network-attachment-definitions"},
+		{gvr: namespace.GetGVR(), object: &corev1.Namespace{}, attacher: corev1.AddToScheme},
+		{gvr: network.GetConfigGVR(), object: &configv1.Network{}, attacher: configv1.Install},
+		{gvr: network.GetOperatorGVR(), object: &operatorv1.Network{}, attacher: operatorv1.Install},
+		{gvr: networkpolicy.GetMultiNetworkGVR(), object: &mnpv1beta1.MultiNetworkPolicy{},
+			attacher: mnpv1beta1.AddToScheme, resource: "multi-networkpolicies"},
+		{gvr: networkpolicy.GetGVR(), object: &networkingv1.NetworkPolicy{}, attacher: networkingv1.AddToScheme},
+		{gvr: neuron.GetGVR(), object: &neuronv1beta1.DeviceConfig{}, attacher: neuronv1beta1.AddToScheme},
+		{gvr: nfd.GetNodeFeatureDiscoveryGVR(), object: &nfdv1.NodeFeatureDiscovery{}, attacher: nfdv1.AddToScheme},
+		{gvr: nfd.GetNodeFeatureRuleGVR(), object: &nfdv1alpha1.NodeFeatureRule{}, attacher: nfdv1alpha1.AddToScheme},
+		{gvr: nmstate.GetNMStateGVR(), object: &nmstateV1.NMState{}, attacher: nmstateV1.AddToScheme},
+		{gvr: nmstate.GetNodeNetworkStateGVR(), object: &nmstateV1beta1.NodeNetworkState{},
+			attacher: nmstateV1beta1.AddToScheme},
+		{gvr: nmstate.GetNodeNetworkConfigurationPolicyGVR(), object: &nmstateV1.NodeNetworkConfigurationPolicy{},
+			attacher: nmstateV1.AddToScheme},
+		{gvr: nodes.GetGVR(), object: &corev1.Node{}},
+		{gvr: nodesconfig.GetNodesConfigIoGVR(), object: &configv1.Node{}},
+		{gvr: nrop.GetNUMAResourcesOperatorGVR(), object: &nropv1.NUMAResourcesOperator{},
+			attacher: nropv1.AddToScheme},
+		{gvr: nrop.GetNUMAResourcesSchedulerGVR(), object: &nropv1.NUMAResourcesScheduler{},
+			attacher: nropv1.AddToScheme},
+		{gvr: nto.GetPerformanceProfileGVR(), object: &performanceprofilev2.PerformanceProfile{},
+			attacher: performanceprofilev2.AddToScheme},
+		{gvr: nto.GetTunedGVR(), object: &tunedv1.Tuned{}, attacher: tunedv1.AddToScheme},
+		{gvr: nvidiagpu.GetGVR(), object: &nvidiagpuv1.ClusterPolicy{}, attacher: nvidiagpuv1.AddToScheme},
+		{gvr: oadp.GetGVR(), object: &oadpv1alpha1.DataProtectionApplication{}, attacher: oadpv1alpha1.AddToScheme},
+		{gvr: oauth.GetGVR(), object: &oauthv1.OAuthClient{}, attacher: oauthv1.AddToScheme},
+		{gvr: ocm.GetKlusterletAddonConfigGVR(), object: &kacv1.KlusterletAddonConfig{},
+			attacher: kacv1.SchemeBuilder.AddToScheme},
+		{gvr: ocm.GetKlusterletGVR(), object: &ocmoperatorv1.Klusterlet{}, attacher: ocmoperatorv1.Install},
+		{gvr: ocm.GetManagedClusterGVR(), object: &ocmclusterv1.ManagedCluster{}, attacher: ocmclusterv1.Install},
+		{gvr: ocm.GetPlacementBindingGVR(), object: &policiesv1.PlacementBinding{}, attacher: policiesv1.AddToScheme},
+		{gvr: ocm.GetPlacementRuleGVR(), object: &placementrulev1.PlacementRule{},
+			attacher: placementrulev1.AddToScheme},
+		{gvr: ocm.GetPolicyGVR(), object: &policiesv1.Policy{}, attacher: policiesv1.AddToScheme},
+		{gvr: ocm.GetPolicySetGVR(), object: &policiesv1beta1.PolicySet{}, attacher: policiesv1beta1.AddToScheme},
+		{gvr: olm.GetCatalogSourceGVR(), object: &oplmV1alpha1.CatalogSource{}, attacher: oplmV1alpha1.AddToScheme},
+		{gvr: olm.GetClusterServiceVersionGVR(), object: &oplmV1alpha1.ClusterServiceVersion{},
+			attacher: oplmV1alpha1.AddToScheme},
+		{gvr: olm.GetInstallPlanGVR(), object: &oplmV1alpha1.InstallPlan{}, attacher: oplmV1alpha1.AddToScheme},
+		{gvr: olm.GetOperatorConditionGVR(), object: &operatorsv2.OperatorCondition{},
+			attacher: operatorsv2.AddToScheme},
+		{gvr: olm.GetOperatorGroupGVR(), object: &operatorsv1.OperatorGroup{}, attacher: operatorsv1.AddToScheme},
+		{gvr: olm.GetPackageManifestGVR(), object: &pkgserverv1.PackageManifest{}, attacher: pkgserverv1.AddToScheme},
+		{gvr: olm.GetSubscriptionGVR(), object: &oplmV1alpha1.Subscription{}, attacher: oplmV1alpha1.AddToScheme},
+		{gvr: oran.GetAllocatedNodeGVR(), object: &hardwaremanagementv1alpha1.AllocatedNode{},
+			attacher: hardwaremanagementv1alpha1.AddToScheme},
+		{gvr: oran.GetClusterTemplateGVR(), object: &provisioningv1alpha1.ClusterTemplate{},
+			attacher: provisioningv1alpha1.AddToScheme},
+		{gvr: oran.GetHardwareProfileGVR(), object: &hardwaremanagementv1alpha1.HardwareProfile{},
+			attacher: hardwaremanagementv1alpha1.AddToScheme},
+		{gvr: oran.GetNodeAllocationRequestGVR(), object: &hardwaremanagementv1alpha1.NodeAllocationRequest{},
+			attacher: hardwaremanagementv1alpha1.AddToScheme},
+		{gvr: oran.GetProvisioningRequestGVR(), object: &provisioningv1alpha1.ProvisioningRequest{},
+			attacher: provisioningv1alpha1.AddToScheme},
+		{gvr: ovn.GetRouteAdvertisementGVR(), object: &ovnv1.RouteAdvertisements{},
+			attacher: ovnv1.AddToScheme, resource: "routeadvertisements"},
+		{gvr: pfstatus.GetPfStatusConfigurationGVR(), object: &pfstatustypes.PFLACPMonitor{},
+			attacher: pfstatustypes.AddToScheme},
+		{gvr: pod.GetGVR(), object: &corev1.Pod{}, attacher: corev1.AddToScheme},
+		{gvr: poddisruptionbudget.GetGVR(), object: &policyv1.PodDisruptionBudget{}},
+		{gvr: proxy.GetGVR(), object: &configv1.Proxy{}, attacher: configv1.Install},
+		{gvr: ptp.GetHardwareConfigGVR(), object: &ptpv2alpha1.HardwareConfig{}, attacher: ptpv2alpha1.AddToScheme},
+		{gvr: ptp.GetPtpConfigGVR(), object: &ptpv1.PtpConfig{}, attacher: ptpv1.AddToScheme},
+		{gvr: ptp.GetPtpOperatorConfigGVR(), object: &ptpv1.PtpOperatorConfig{}, attacher: ptpv1.AddToScheme},
+		{gvr: rbac.GetClusterRoleGVR(), object: &rbacv1.ClusterRole{}},
+		{gvr: rbac.GetClusterRoleBindingGVR(), object: &rbacv1.ClusterRoleBinding{}},
+		{gvr: rbac.GetRoleGVR(), object: &rbacv1.Role{}},
+		{gvr: rbac.GetRoleBindingGVR(), object: &rbacv1.RoleBinding{}},
+		{gvr: replicaset.GetGVR(), object: &appsv1.ReplicaSet{}},
+		{gvr: resourcequotas.GetGVR(), object: &corev1.ResourceQuota{}},
+		{gvr: route.GetGVR(), object: &routev1.Route{}, attacher: routev1.AddToScheme},
+		{gvr: scc.GetGVR(), object: &securityv1.SecurityContextConstraints{},
+			attacher: securityv1.Install, resource: "securitycontextconstraints"},
+		{gvr: secret.GetGVR(), object: &corev1.Secret{}},
+		{gvr: service.GetGVR(), object: &corev1.Service{}},
+		{gvr: serviceaccount.GetGVR(), object: &corev1.ServiceAccount{}},
+		{gvr: servicemesh.GetServiceMeshControlPlaneGVR(), object: &istiov2.ServiceMeshControlPlane{},
+			attacher: istiov2.AddToScheme},
+		{gvr: servicemesh.GetServiceMeshMemberRollGVR(), object: &istiov1.ServiceMeshMemberRoll{},
+			attacher: istiov1.AddToScheme},
+		{gvr: siteconfig.GetGVR(), object: &siteconfigv1alpha1.ClusterInstance{},
+			attacher: siteconfigv1alpha1.AddToScheme},
+		{gvr: sriovfec.GetSriovFecClusterConfigIoGVR(), object: &sriovfectypes.SriovFecClusterConfig{},
+			attacher: sriovfectypes.AddToScheme},
+		{gvr: sriovfec.GetSriovFecNodeConfigIoGVR(), object: &sriovfectypes.SriovFecNodeConfig{},
+			attacher: sriovfectypes.AddToScheme},
+		{gvr: sriovvrb.GetSriovVrbClusterConfigIoGVR(), object: &sriovvrbtypes.SriovVrbClusterConfig{},
+			attacher: sriovvrbtypes.AddToScheme},
+		{gvr: sriovvrb.GetSriovVrbNodeConfigIoGVR(), object: &sriovvrbtypes.SriovVrbNodeConfig{},
+			attacher: sriovvrbtypes.AddToScheme},
+		{gvr: sriov.GetSriovNetworksGVR(), object: &srIovV1.SriovNetwork{}, attacher: srIovV1.AddToScheme},
+		{gvr: sriov.GetSriovNetworkNodeStateGVR(), object: &srIovV1.SriovNetworkNodeState{},
+			attacher: srIovV1.AddToScheme},
+		{gvr: sriov.GetSriovOperatorConfigGVR(), object: &srIovV1.SriovOperatorConfig{}, attacher: srIovV1.AddToScheme},
+		{gvr: sriov.GetSriovNetworkNodePolicyGVR(), object: &srIovV1.SriovNetworkNodePolicy{},
+			attacher: srIovV1.AddToScheme},
+		{gvr: sriov.GetSriovNetworkPoolConfigGVR(), object: &srIovV1.SriovNetworkPoolConfig{},
+			attacher: srIovV1.AddToScheme},
+		{gvr: statefulset.GetGVR(), object: &appsv1.StatefulSet{}},
+		{gvr: storage.GetObjectBucketClaimGVR(), object: &noobaav1alpha1.ObjectBucketClaim{},
+			attacher: noobaav1alpha1.AddToScheme},
+		{gvr: storage.GetStorageClusterGVR(), object: &ocsoperatorv1.StorageCluster{},
+			attacher: ocsoperatorv1.AddToScheme},
+		{gvr: storage.GetStorageSystemGVR(), object: &odfoperatorv1alpha1.StorageSystem{},
+			attacher: odfoperatorv1alpha1.AddToScheme},
+		{gvr: storage.GetPersistentVolumeGVR(), object: &corev1.PersistentVolume{}},
+		{gvr: storage.GetPersistentVolumeClaimGVR(), object: &corev1.PersistentVolumeClaim{}},
+		{gvr: storage.GetStorageClassGVR(), object: &storagev1.StorageClass{}},
+		{gvr: velero.GetBackupGVR(), object: &velerov1.Backup{}, attacher: velerov1.AddToScheme},
+		{gvr: velero.GetBackupStorageLocationGVR(), object: &velerov1.BackupStorageLocation{},
+			attacher: velerov1.AddToScheme},
+		{gvr: velero.GetRestoreGVR(), object: &velerov1.Restore{}, attacher: velerov1.AddToScheme},
+		{gvr: volsync.GetReplicationDestinationGVR(), object: &volsyncv1alpha1.ReplicationDestination{},
+			attacher: volsyncv1alpha1.AddToScheme},
+		{gvr: volsync.GetReplicationSourceGVR(), object: &volsyncv1alpha1.ReplicationSource{},
+			attacher: volsyncv1alpha1.AddToScheme},
+		{gvr: webhook.GetMutatingWebhookConfigurationGVR(), object: &admregv1.MutatingWebhookConfiguration{},
+			attacher: admregv1.AddToScheme},
+		{gvr: webhook.GetValidatingWebhookConfigurationGVR(), object: &admregv1.ValidatingWebhookConfiguration{},
+			attacher: admregv1.AddToScheme},
+	}
+
+	for _, testCase := range testCases {
+		testScheme := runtime.NewScheme()
+		assert.NoError(t, clients.SetScheme(testScheme))
+
+		if testCase.attacher != nil {
+			assert.NoError(t, testCase.attacher(testScheme))
+		}
+
+		gvks, _, err := testScheme.ObjectKinds(testCase.object)
+		if !assert.NoError(t, err, "GVR %s", testCase.gvr.String()) {
+			continue
+		}
+
+		var expectedGVRs []schema.GroupVersionResource
+
+		for _, gvk := range gvks {
+			expectedGVR, _ := meta.UnsafeGuessKindToResource(gvk)
+			if testCase.resource != "" {
+				expectedGVR.Resource = testCase.resource
+			}
+
+			expectedGVRs = append(expectedGVRs, expectedGVR)
+		}
+
+		assert.Contains(t, expectedGVRs, testCase.gvr, "GVR %s", testCase.gvr.String())
+	}
+}
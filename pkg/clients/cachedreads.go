@@ -0,0 +1,194 @@
+package clients
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	runtimeClient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+)
+
+// CachedReadOptions configures the cached reads enabled by EnableCachedReads.
+type CachedReadOptions struct {
+	// Objects are the types, such as &corev1.Pod{}, whose Get and List calls are served from the cache. They must be
+	// registered in the scheme of the client.
+	Objects []runtimeClient.Object
+	// Namespaces restricts the cache to these namespaces. When empty, objects in all namespaces are cached.
+	Namespaces []string
+	// ReadThrough reads objects not found in the cache from the API server, so objects created moments ago and not
+	// yet seen by the cache are still found.
+	ReadThrough bool
+}
+
+// cachedReads holds the state of the cached reads of a client so they can be disabled.
+type cachedReads struct {
+	client runtimeClient.Client
+	cancel context.CancelFunc
+}
+
+// EnableCachedReads starts a shared informer-backed cache for the given types and serves Get and List calls for them
+// from it, such as those made by the Exists and Get methods of builders, to reduce API server load when polling many
+// resources. It blocks until the cache has synced or ctx is done. Reads of other types, unstructured objects, and
+// lists using field selectors or pagination are still sent to the API server.
+//
+// Cached reads may return objects that were recently deleted or updated, so they are best suited to polling objects
+// that tests wait on rather than objects they create and delete. Builders keep using the client they were created
+// with, so cached reads must be enabled before creating builders and not concurrently with using the client.
+func (settings *Settings) EnableCachedReads(ctx context.Context, options CachedReadOptions) error {
+	if settings == nil || settings.Client == nil {
+		klog.V(100).Info("APIClient is nil")
+
+		return fmt.Errorf("cannot enable cached reads with nil client")
+	}
+
+	if settings.Config == nil {
+		return fmt.Errorf("cannot enable cached reads for client without a rest config")
+	}
+
+	if len(options.Objects) == 0 {
+		return fmt.Errorf("cached reads 'objects' cannot be empty")
+	}
+
+	if settings.cachedReads != nil {
+		return fmt.Errorf("cached reads are already enabled")
+	}
+
+	cacheOptions := cache.Options{
+		Scheme:                      settings.Client.Scheme(),
+		Mapper:                      settings.Client.RESTMapper(),
+		ReaderFailOnMissingInformer: true,
+	}
+
+	if len(options.Namespaces) > 0 {
+		cacheOptions.DefaultNamespaces = make(map[string]cache.Config, len(options.Namespaces))
+
+		for _, namespace := range options.Namespaces {
+			cacheOptions.DefaultNamespaces[namespace] = cache.Config{}
+		}
+	}
+
+	informerCache, err := cache.New(settings.Config, cacheOptions)
+	if err != nil {
+		return fmt.Errorf("failed to create cache: %w", err)
+	}
+
+	gvks := make(map[schema.GroupVersionKind]bool, len(options.Objects))
+
+	for _, object := range options.Objects {
+		gvk, err := apiutil.GVKForObject(object, cacheOptions.Scheme)
+		if err != nil {
+			return fmt.Errorf("failed to get GVK of cached object: %w", err)
+		}
+
+		if _, err := informerCache.GetInformer(ctx, object); err != nil {
+			return fmt.Errorf("failed to create informer for %s: %w", gvk, err)
+		}
+
+		gvks[gvk] = true
+	}
+
+	// The cache runs until cached reads are disabled rather than until ctx is done, since ctx only bounds the sync.
+	cacheCtx, cancel := context.WithCancel(context.Background())
+
+	go func() {
+		if err := informerCache.Start(cacheCtx); err != nil {
+			klog.V(100).Infof("Cache for cached reads stopped: %v", err)
+		}
+	}()
+
+	if !informerCache.WaitForCacheSync(ctx) {
+		cancel()
+
+		return fmt.Errorf("failed to sync cache for cached reads: %w", context.Cause(ctx))
+	}
+
+	klog.V(100).Infof("Enabled cached reads for %d types", len(gvks))
+
+	settings.cachedReads = &cachedReads{client: settings.Client, cancel: cancel}
+	settings.Client = &cachedClient{
+		Client:      settings.Client,
+		cache:       informerCache,
+		gvks:        gvks,
+		readThrough: options.ReadThrough,
+	}
+
+	return nil
+}
+
+// DisableCachedReads stops the cache started by EnableCachedReads and sends all reads to the API server again. It
+// does nothing if cached reads are not enabled.
+func (settings *Settings) DisableCachedReads() {
+	if settings == nil || settings.cachedReads == nil {
+		return
+	}
+
+	klog.V(100).Info("Disabling cached reads")
+
+	settings.cachedReads.cancel()
+	settings.Client = settings.cachedReads.client
+	settings.cachedReads = nil
+}
+
+// cachedClient is a runtimeClient.Client serving Get and List calls for some types from a cache.
+type cachedClient struct {
+	runtimeClient.Client
+	cache       runtimeClient.Reader
+	gvks        map[schema.GroupVersionKind]bool
+	readThrough bool
+}
+
+// Get retrieves the object from the cache if its type is cached, falling back to the API server if it is not found
+// and read-through is enabled.
+func (client *cachedClient) Get(
+	ctx context.Context, key runtimeClient.ObjectKey, obj runtimeClient.Object, opts ...runtimeClient.GetOption) error {
+	if !client.isCached(obj, false) {
+		return client.Client.Get(ctx, key, obj, opts...)
+	}
+
+	err := client.cache.Get(ctx, key, obj, opts...)
+	if client.readThrough && k8serrors.IsNotFound(err) {
+		return client.Client.Get(ctx, key, obj, opts...)
+	}
+
+	return err
+}
+
+// List retrieves the list from the cache if the type of its items is cached and the options are supported by the
+// cache.
+func (client *cachedClient) List(
+	ctx context.Context, list runtimeClient.ObjectList, opts ...runtimeClient.ListOption) error {
+	listOptions := &runtimeClient.ListOptions{}
+	listOptions.ApplyOptions(opts)
+
+	if listOptions.FieldSelector != nil || listOptions.Limit > 0 || listOptions.Continue != "" ||
+		!client.isCached(list, true) {
+		return client.Client.List(ctx, list, opts...)
+	}
+
+	return client.cache.List(ctx, list, opts...)
+}
+
+// isCached returns whether reads of obj are served from the cache. Unstructured objects are never cached since the
+// cache keeps typed objects.
+func (client *cachedClient) isCached(obj runtime.Object, isList bool) bool {
+	if _, isUnstructured := obj.(runtime.Unstructured); isUnstructured {
+		return false
+	}
+
+	gvk, err := apiutil.GVKForObject(obj, client.Scheme())
+	if err != nil {
+		return false
+	}
+
+	if isList {
+		gvk.Kind = strings.TrimSuffix(gvk.Kind, "List")
+	}
+
+	return client.gvks[gvk]
+}
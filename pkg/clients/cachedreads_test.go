@@ -0,0 +1,152 @@
+package clients
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/rest"
+	runtimeClient "sigs.k8s.io/controller-runtime/pkg/client"
+	fakeRuntimeClient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestCachedClientGet(t *testing.T) {
+	testCases := []struct {
+		name           string
+		readThrough    bool
+		getObject      runtimeClient.Object
+		key            runtimeClient.ObjectKey
+		expectedData   string
+		expectNotFound bool
+	}{
+		{
+			name:         "cached type served from cache",
+			getObject:    &corev1.ConfigMap{},
+			key:          runtimeClient.ObjectKey{Name: "both", Namespace: "test"},
+			expectedData: "cache",
+		},
+		{
+			name:           "missing from cache without read-through",
+			getObject:      &corev1.ConfigMap{},
+			key:            runtimeClient.ObjectKey{Name: "server-only", Namespace: "test"},
+			expectNotFound: true,
+		},
+		{
+			name:         "missing from cache with read-through",
+			readThrough:  true,
+			getObject:    &corev1.ConfigMap{},
+			key:          runtimeClient.ObjectKey{Name: "server-only", Namespace: "test"},
+			expectedData: "server",
+		},
+		{
+			name:         "uncached type served from server",
+			getObject:    &corev1.Secret{},
+			key:          runtimeClient.ObjectKey{Name: "both", Namespace: "test"},
+			expectedData: "server",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testClient := buildTestCachedClient(testCase.readThrough)
+
+			err := testClient.Get(context.TODO(), testCase.key, testCase.getObject)
+			if testCase.expectNotFound {
+				assert.True(t, k8serrors.IsNotFound(err))
+
+				return
+			}
+
+			assert.NoError(t, err)
+
+			switch object := testCase.getObject.(type) {
+			case *corev1.ConfigMap:
+				assert.Equal(t, testCase.expectedData, object.Data["source"])
+			case *corev1.Secret:
+				assert.Equal(t, testCase.expectedData, string(object.Data["source"]))
+			}
+		})
+	}
+}
+
+func TestCachedClientList(t *testing.T) {
+	testClient := buildTestCachedClient(false)
+
+	configMaps := &corev1.ConfigMapList{}
+	assert.NoError(t, testClient.List(context.TODO(), configMaps))
+	assert.Len(t, configMaps.Items, 1)
+
+	// Pagination is not supported by the cache so the list is sent to the server.
+	assert.NoError(t, testClient.List(context.TODO(), configMaps, runtimeClient.Limit(10)))
+	assert.Len(t, configMaps.Items, 2)
+
+	// Unstructured lists are never served from the cache.
+	unstructuredList := &unstructured.UnstructuredList{}
+	unstructuredList.SetAPIVersion("v1")
+	unstructuredList.SetKind("ConfigMapList")
+	assert.NoError(t, testClient.List(context.TODO(), unstructuredList))
+	assert.Len(t, unstructuredList.Items, 2)
+}
+
+func TestEnableCachedReadsValidation(t *testing.T) {
+	var nilSettings *Settings
+
+	assert.EqualError(t, nilSettings.EnableCachedReads(context.TODO(), CachedReadOptions{}),
+		"cannot enable cached reads with nil client")
+
+	testSettings := GetTestClients(TestClientParams{})
+	assert.EqualError(t, testSettings.EnableCachedReads(context.TODO(), CachedReadOptions{}),
+		"cannot enable cached reads for client without a rest config")
+
+	testSettings.Config = &rest.Config{Host: "https://127.0.0.1:0"}
+	assert.EqualError(t, testSettings.EnableCachedReads(context.TODO(), CachedReadOptions{}),
+		"cached reads 'objects' cannot be empty")
+
+	testSettings.cachedReads = &cachedReads{client: testSettings.Client, cancel: func() {}}
+	assert.EqualError(t, testSettings.EnableCachedReads(context.TODO(), CachedReadOptions{
+		Objects: []runtimeClient.Object{&corev1.Pod{}},
+	}), "cached reads are already enabled")
+
+	originalClient := testSettings.Client
+	testSettings.Client = &cachedClient{Client: originalClient}
+	testSettings.DisableCachedReads()
+	assert.Nil(t, testSettings.cachedReads)
+	assert.Equal(t, originalClient, testSettings.Client)
+
+	// Disabling cached reads when they are not enabled does nothing.
+	testSettings.DisableCachedReads()
+	nilSettings.DisableCachedReads()
+}
+
+// buildTestCachedClient returns a cachedClient caching ConfigMaps whose cache only holds the "both" ConfigMap, while
+// the server holds the "both" and "server-only" ConfigMaps and a "both" Secret.
+func buildTestCachedClient(readThrough bool) *cachedClient {
+	serverClient := fakeRuntimeClient.NewClientBuilder().WithObjects(
+		buildTestConfigMap("both", "server"),
+		buildTestConfigMap("server-only", "server"),
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "both", Namespace: "test"},
+			Data:       map[string][]byte{"source": []byte("server")},
+		},
+	).Build()
+	cacheClient := fakeRuntimeClient.NewClientBuilder().WithObjects(buildTestConfigMap("both", "cache")).Build()
+
+	return &cachedClient{
+		Client:      serverClient,
+		cache:       cacheClient,
+		gvks:        map[schema.GroupVersionKind]bool{corev1.SchemeGroupVersion.WithKind("ConfigMap"): true},
+		readThrough: readThrough,
+	}
+}
+
+func buildTestConfigMap(name, source string) *corev1.ConfigMap {
+	return &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "test"},
+		Data:       map[string]string{"source": source},
+	}
+}
@@ -0,0 +1,140 @@
+package clients
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	configV1 "github.com/openshift/api/config/v1"
+	corev1 "k8s.io/api/core/v1"
+	apiExt "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/discovery/cached/memory"
+	"k8s.io/klog/v2"
+	runtimeClient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// infrastructureName is the name of the singleton OpenShift Infrastructure object.
+const infrastructureName = "cluster"
+
+// HasAPIResource uses discovery to check whether the cluster serves the provided GVK. It returns false without an error
+// if the group version is not served at all, so suites can skip tests for APIs that are not installed. Discovery
+// results are cached by clients created using New, so call InvalidateDiscoveryCache after installing new APIs.
+func (settings *Settings) HasAPIResource(gvk schema.GroupVersionKind) (bool, error) {
+	if settings == nil || (settings.K8sClient == nil && settings.discovery == nil) {
+		klog.V(100).Info("APIClient is nil")
+
+		return false, fmt.Errorf("cannot discover API resources with nil client")
+	}
+
+	klog.V(100).Infof("Checking whether the cluster serves %s", gvk)
+
+	var discoveryClient discovery.DiscoveryInterface = settings.discovery
+	if settings.discovery == nil {
+		discoveryClient = settings.K8sClient.Discovery()
+	}
+
+	resourceList, err := discoveryClient.ServerResourcesForGroupVersion(gvk.GroupVersion().String())
+	if k8serrors.IsNotFound(err) || errors.Is(err, memory.ErrCacheNotFound) {
+		return false, nil
+	}
+
+	if err != nil {
+		return false, fmt.Errorf("failed to discover resources for %s: %w", gvk.GroupVersion(), err)
+	}
+
+	for _, resource := range resourceList.APIResources {
+		// Subresources such as pods/status share the kind of their parent resource.
+		if resource.Kind == gvk.Kind && !strings.Contains(resource.Name, "/") {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// InvalidateDiscoveryCache clears the cached discovery results, so that APIs installed since they were cached, such as
+// the CRDs of a newly installed operator, are found by HasAPIResource.
+func (settings *Settings) InvalidateDiscoveryCache() {
+	if settings == nil || settings.discovery == nil {
+		return
+	}
+
+	klog.V(100).Info("Invalidating discovery cache")
+
+	settings.discovery.Invalidate()
+}
+
+// HasCRD checks whether a CustomResourceDefinition with the provided name, such as ptpconfigs.ptp.openshift.io, exists
+// on the cluster.
+func (settings *Settings) HasCRD(name string) (bool, error) {
+	if settings == nil || settings.Client == nil {
+		klog.V(100).Info("APIClient is nil")
+
+		return false, fmt.Errorf("cannot check CRDs with nil client")
+	}
+
+	if name == "" {
+		return false, fmt.Errorf("crd name cannot be empty")
+	}
+
+	klog.V(100).Infof("Checking whether CRD %s exists", name)
+
+	err := settings.Client.Get(context.TODO(), runtimeClient.ObjectKey{Name: name}, &apiExt.CustomResourceDefinition{})
+	if k8serrors.IsNotFound(err) {
+		return false, nil
+	}
+
+	if err != nil {
+		return false, fmt.Errorf("failed to get CRD %s: %w", name, err)
+	}
+
+	return true, nil
+}
+
+// IsOpenShift checks whether the cluster is an OpenShift cluster by discovering the ClusterVersion API, which is only
+// served by OpenShift.
+func (settings *Settings) IsOpenShift() (bool, error) {
+	return settings.HasAPIResource(configV1.GroupVersion.WithKind("ClusterVersion"))
+}
+
+// IsSNO checks whether the cluster is a single node cluster. On OpenShift this is based on the control plane topology
+// of the Infrastructure object, otherwise the cluster is single node if it has exactly one node. Clients in reduced
+// mode always count the nodes.
+func (settings *Settings) IsSNO() (bool, error) {
+	isOpenShift := false
+
+	if !settings.IsReduced() {
+		var err error
+
+		isOpenShift, err = settings.IsOpenShift()
+		if err != nil {
+			return false, err
+		}
+	}
+
+	if isOpenShift {
+		infrastructure := &configV1.Infrastructure{}
+
+		err := settings.Client.Get(context.TODO(), runtimeClient.ObjectKey{Name: infrastructureName}, infrastructure)
+		if err != nil {
+			return false, fmt.Errorf("failed to get infrastructure %s: %w", infrastructureName, err)
+		}
+
+		return infrastructure.Status.ControlPlaneTopology == configV1.SingleReplicaTopologyMode, nil
+	}
+
+	klog.V(100).Info("Cluster is not OpenShift, checking the number of nodes")
+
+	nodes := &corev1.NodeList{}
+
+	err := settings.Client.List(context.TODO(), nodes)
+	if err != nil {
+		return false, fmt.Errorf("failed to list nodes: %w", err)
+	}
+
+	return len(nodes.Items) == 1, nil
+}
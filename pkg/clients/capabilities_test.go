@@ -0,0 +1,233 @@
+package clients
+
+import (
+	"testing"
+
+	configV1 "github.com/openshift/api/config/v1"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	apiExt "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/discovery/cached/memory"
+	fakediscovery "k8s.io/client-go/discovery/fake"
+	runtimeClient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var testOpenShiftResources = []*metav1.APIResourceList{{
+	GroupVersion: configV1.GroupVersion.String(),
+	APIResources: []metav1.APIResource{
+		{Name: "clusterversions", Kind: "ClusterVersion"},
+		{Name: "clusterversions/status", Kind: "ClusterVersion"},
+		{Name: "infrastructures/status", Kind: "Infrastructure"},
+	},
+}}
+
+func TestHasAPIResource(t *testing.T) {
+	testCases := []struct {
+		gvk           schema.GroupVersionKind
+		resources     []*metav1.APIResourceList
+		nilSettings   bool
+		expected      bool
+		expectedError string
+	}{
+		{
+			gvk:       configV1.GroupVersion.WithKind("ClusterVersion"),
+			resources: testOpenShiftResources,
+			expected:  true,
+		},
+		{
+			gvk:       configV1.GroupVersion.WithKind("Infrastructure"),
+			resources: testOpenShiftResources,
+			expected:  false,
+		},
+		{
+			gvk:      configV1.GroupVersion.WithKind("ClusterVersion"),
+			expected: false,
+		},
+		{
+			gvk:           configV1.GroupVersion.WithKind("ClusterVersion"),
+			nilSettings:   true,
+			expectedError: "cannot discover API resources with nil client",
+		},
+	}
+
+	for _, testCase := range testCases {
+		var testSettings *Settings
+
+		if !testCase.nilSettings {
+			testSettings = buildCapabilitiesTestClient(testCase.resources)
+		}
+
+		hasResource, err := testSettings.HasAPIResource(testCase.gvk)
+
+		if testCase.expectedError != "" {
+			assert.EqualError(t, err, testCase.expectedError)
+		} else {
+			assert.NoError(t, err)
+		}
+
+		assert.Equal(t, testCase.expected, hasResource)
+	}
+}
+
+func TestHasAPIResourceCachesDiscovery(t *testing.T) {
+	coreResources := []*metav1.APIResourceList{{
+		GroupVersion: corev1.SchemeGroupVersion.String(),
+		APIResources: []metav1.APIResource{{Name: "nodes", Kind: "Node"}},
+	}}
+
+	testSettings := buildCapabilitiesTestClient(coreResources)
+	fakeDiscovery := testSettings.K8sClient.Discovery().(*fakediscovery.FakeDiscovery)
+	testSettings.discovery = memory.NewMemCacheClient(fakeDiscovery)
+
+	clusterVersionGVK := configV1.GroupVersion.WithKind("ClusterVersion")
+
+	hasResource, err := testSettings.HasAPIResource(clusterVersionGVK)
+	assert.NoError(t, err)
+	assert.False(t, hasResource)
+
+	// The API is installed after it was discovered, so it is only found once the cache is invalidated.
+	fakeDiscovery.Resources = append(coreResources, testOpenShiftResources...)
+	actionCount := len(fakeDiscovery.Actions())
+
+	hasResource, err = testSettings.HasAPIResource(clusterVersionGVK)
+	assert.NoError(t, err)
+	assert.False(t, hasResource)
+	assert.Len(t, fakeDiscovery.Actions(), actionCount)
+
+	testSettings.InvalidateDiscoveryCache()
+
+	hasResource, err = testSettings.HasAPIResource(clusterVersionGVK)
+	assert.NoError(t, err)
+	assert.True(t, hasResource)
+}
+
+func TestHasCRD(t *testing.T) {
+	testCases := []struct {
+		name          string
+		expected      bool
+		expectedError string
+	}{
+		{
+			name:     "ptpconfigs.ptp.openshift.io",
+			expected: true,
+		},
+		{
+			name:     "missing.example.com",
+			expected: false,
+		},
+		{
+			name:          "",
+			expectedError: "crd name cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testSettings := buildCapabilitiesTestClient(nil, &apiExt.CustomResourceDefinition{
+			ObjectMeta: metav1.ObjectMeta{Name: "ptpconfigs.ptp.openshift.io"},
+		})
+
+		hasCRD, err := testSettings.HasCRD(testCase.name)
+
+		if testCase.expectedError != "" {
+			assert.EqualError(t, err, testCase.expectedError)
+		} else {
+			assert.NoError(t, err)
+		}
+
+		assert.Equal(t, testCase.expected, hasCRD)
+	}
+
+	var nilSettings *Settings
+
+	_, err := nilSettings.HasCRD("ptpconfigs.ptp.openshift.io")
+	assert.EqualError(t, err, "cannot check CRDs with nil client")
+}
+
+func TestIsOpenShift(t *testing.T) {
+	isOpenShift, err := buildCapabilitiesTestClient(testOpenShiftResources).IsOpenShift()
+	assert.NoError(t, err)
+	assert.True(t, isOpenShift)
+
+	isOpenShift, err = buildCapabilitiesTestClient(nil).IsOpenShift()
+	assert.NoError(t, err)
+	assert.False(t, isOpenShift)
+}
+
+func TestIsSNO(t *testing.T) {
+	testCases := []struct {
+		resources     []*metav1.APIResourceList
+		objects       []runtimeClient.Object
+		reduced       bool
+		expected      bool
+		expectedError string
+	}{
+		{
+			resources: testOpenShiftResources,
+			objects:   []runtimeClient.Object{buildDummyInfrastructure(configV1.SingleReplicaTopologyMode)},
+			expected:  true,
+		},
+		{
+			resources: testOpenShiftResources,
+			objects: []runtimeClient.Object{
+				buildDummyInfrastructure(configV1.HighlyAvailableTopologyMode), buildDummyCapabilitiesNode("node-0"),
+			},
+			expected: false,
+		},
+		{
+			resources:     testOpenShiftResources,
+			expectedError: "failed to get infrastructure cluster",
+		},
+		{
+			objects:  []runtimeClient.Object{buildDummyCapabilitiesNode("node-0")},
+			expected: true,
+		},
+		{
+			objects:  []runtimeClient.Object{buildDummyCapabilitiesNode("node-0"), buildDummyCapabilitiesNode("node-1")},
+			expected: false,
+		},
+		{
+			resources: testOpenShiftResources,
+			objects:   []runtimeClient.Object{buildDummyCapabilitiesNode("node-0")},
+			reduced:   true,
+			expected:  true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testSettings := buildCapabilitiesTestClient(testCase.resources, testCase.objects...)
+		testSettings.reduced = testCase.reduced
+
+		isSNO, err := testSettings.IsSNO()
+
+		if testCase.expectedError != "" {
+			assert.ErrorContains(t, err, testCase.expectedError)
+		} else {
+			assert.NoError(t, err)
+		}
+
+		assert.Equal(t, testCase.expected, isSNO)
+	}
+}
+
+// buildCapabilitiesTestClient returns test clients that discover the provided resources and whose runtime client
+// contains the provided objects.
+func buildCapabilitiesTestClient(resources []*metav1.APIResourceList, objects ...runtimeClient.Object) *Settings {
+	testSettings, clientBuilder := GetModifiableTestClients(TestClientParams{})
+	testSettings.Client = clientBuilder.WithObjects(objects...).Build()
+	testSettings.K8sClient.Discovery().(*fakediscovery.FakeDiscovery).Resources = resources
+
+	return testSettings
+}
+
+func buildDummyInfrastructure(topology configV1.TopologyMode) *configV1.Infrastructure {
+	return &configV1.Infrastructure{
+		ObjectMeta: metav1.ObjectMeta{Name: infrastructureName},
+		Status:     configV1.InfrastructureStatus{ControlPlaneTopology: topology},
+	}
+}
+
+func buildDummyCapabilitiesNode(name string) *corev1.Node {
+	return &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: name}}
+}
@@ -3,10 +3,15 @@ package clients
 import (
 	"fmt"
 	"os"
+	"path/filepath"
+	"time"
 
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/discovery/cached/memory"
 	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/klog/v2"
+	"k8s.io/utils/clock"
 
 	clientConfigV1 "github.com/openshift/client-go/config/clientset/versioned/typed/config/v1"
 	v1security "github.com/openshift/client-go/security/clientset/versioned/typed/security/v1"
@@ -18,7 +23,9 @@ import (
 	networkV1Client "k8s.io/client-go/kubernetes/typed/networking/v1"
 	rbacV1Client "k8s.io/client-go/kubernetes/typed/rbac/v1"
 	"k8s.io/client-go/rest"
+	"k8s.io/client-go/restmapper"
 	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
 	runtimeClient "sigs.k8s.io/controller-runtime/pkg/client"
 
 	configV1 "github.com/openshift/api/config/v1"
@@ -65,38 +72,111 @@ type Settings struct {
 	storageV1Client.StorageV1Interface
 	policyv1clientTyped.PolicyV1Interface
 	scheme *runtime.Scheme
+	// schemeLock serializes attaching schemes. See AttachScheme.
+	schemeLock *schemeLock
+	// discovery caches the API resources served by the cluster. See InvalidateDiscoveryCache.
+	discovery discovery.CachedDiscoveryInterface
+	// lazy loads the kubeconfig on the first request for clients created using NewLazy.
+	lazy *lazyTransport
+	// cachedReads is set while reads of some types are served from a cache. See EnableCachedReads.
+	cachedReads *cachedReads
+	// reduced is set for clusters that do not serve the OpenShift config APIs, such as MicroShift. See NewReduced.
+	reduced bool
+	// defaultNamespace is used by namespaced builders given an empty namespace. See NamespacedClient.
+	defaultNamespace string
+	// quotaRetryTimeout is how long builders retry create requests rejected by quota admission. See QuotaRetryClient.
+	quotaRetryTimeout time.Duration
 }
 
 // SchemeAttacher represents a function that can modify the clients current schemes.
 type SchemeAttacher func(*runtime.Scheme) error
 
-// New returns a *Settings with the given kubeconfig.
-func New(kubeconfig string) *Settings {
-	var (
-		config *rest.Config
-		err    error
-	)
+// New returns a *Settings with the given kubeconfig. If the kubeconfig is empty, the KUBECONFIG environment variable
+// is used, which may list several kubeconfigs to merge as kubectl does, and then the in-cluster config. The options
+// override the settings of the current context. If there is no kubeconfig and the options set the server using
+// WithServer, the client is built from the options alone, so no kubeconfig file needs to be written to disk.
+func New(kubeconfig string, options ...Option) *Settings {
+	config, kubeconfig, err := loadConfig(kubeconfig, newClientOptions(options))
+	if err != nil {
+		klog.V(100).Infof("Failed to load kubeconfig: %v", err)
+
+		return nil
+	}
+
+	clientSet, err := newForConfig(config)
+	if err != nil {
+		klog.V(100).Infof("Failed to create apiClient: %v", err)
+
+		return nil
+	}
+
+	clientSet.KubeconfigPath = kubeconfig
+
+	return clientSet
+}
+
+// loadConfig loads the client config from the current context of the kubeconfig with the options applied, falling
+// back to the kubeconfigs listed in the KUBECONFIG environment variable, then to the options alone if they provide the
+// server, and then to the in-cluster config if it is empty. It returns the kubeconfig that was used, if any.
+func loadConfig(kubeconfig string, options *clientOptions) (*rest.Config, string, error) {
+	loadingRules := &clientcmd.ClientConfigLoadingRules{ExplicitPath: kubeconfig}
 
 	if kubeconfig == "" {
 		kubeconfig = os.Getenv("KUBECONFIG")
+		loadingRules = &clientcmd.ClientConfigLoadingRules{Precedence: filepath.SplitList(kubeconfig)}
 	}
 
-	if kubeconfig != "" {
-		klog.V(100).Infof("Loading kube client config from path %s", kubeconfig)
+	if kubeconfig == "" && options.hasServer() {
+		klog.V(100).Infof("Using kube client config for server %s", options.overrides.ClusterInfo.Server)
 
-		config, err = clientcmd.BuildConfigFromFlags("", kubeconfig)
-	} else {
+		config, err := clientcmd.NewDefaultClientConfig(*clientcmdapi.NewConfig(), &options.overrides).ClientConfig()
+		if err != nil {
+			return nil, "", err
+		}
+
+		return config, "", nil
+	}
+
+	if kubeconfig == "" {
 		klog.V(100).Info("Using in-cluster kube client config")
 
-		config, err = rest.InClusterConfig()
+		config, err := rest.InClusterConfig()
+		if err != nil {
+			return nil, "", err
+		}
+
+		options.applyToRESTConfig(config)
+
+		return config, "", nil
 	}
 
+	klog.V(100).Infof("Loading kube client config from path %s", kubeconfig)
+
+	config, err := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(loadingRules, &options.overrides).ClientConfig()
 	if err != nil {
-		klog.V(100).Infof("Failed to load kubeconfig: %v", err)
+		return nil, "", err
+	}
 
-		return nil
+	return config, kubeconfig, nil
+}
+
+// newForConfig returns a *Settings whose clients use the provided config. Creating the clients does not contact the
+// API server.
+func newForConfig(config *rest.Config) (*Settings, error) {
+	clientScheme := runtime.NewScheme()
+
+	err := SetScheme(clientScheme)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load apiClient scheme: %w", err)
 	}
 
+	return newForConfigAndScheme(config, clientScheme, newSchemeLock())
+}
+
+// newForConfigAndScheme returns a *Settings whose clients use the provided config and whose runtime client uses the
+// provided scheme, along with the lock serializing attaching schemes to it. The runtime client resolves kinds using a
+// RESTMapper backed by the cached discovery of the client, so builders sharing the client share discovery results.
+func newForConfigAndScheme(config *rest.Config, clientScheme *runtime.Scheme, lock *schemeLock) (*Settings, error) {
 	clientSet := &Settings{}
 	clientSet.CoreV1Interface = coreV1Client.NewForConfigOrDie(config)
 	clientSet.ConfigV1Interface = clientConfigV1.NewForConfigOrDie(config)
@@ -111,30 +191,41 @@ func New(kubeconfig string) *Settings {
 	clientSet.PolicyV1Interface = policyv1clientTyped.NewForConfigOrDie(config)
 	clientSet.K8sClient = kubernetes.NewForConfigOrDie(config)
 	clientSet.Config = config
+	clientSet.discovery = memory.NewMemCacheClient(clientSet.K8sClient.Discovery())
+	clientSet.scheme = clientScheme
+	clientSet.schemeLock = lock
 
-	clientSet.scheme = runtime.NewScheme()
-
-	err = SetScheme(clientSet.scheme)
-	if err != nil {
-		klog.V(100).Info("Error to load apiClient scheme")
-
-		return nil
-	}
+	var err error
 
 	clientSet.Client, err = runtimeClient.New(config, runtimeClient.Options{
 		Scheme: clientSet.scheme,
+		Mapper: restmapper.NewDeferredDiscoveryRESTMapper(clientSet.discovery),
 	})
 	if err != nil {
-		klog.V(100).Info("Error to create apiClient")
+		return nil, fmt.Errorf("failed to create runtime client: %w", err)
+	}
+
+	return clientSet, nil
+}
 
+// NewReduced returns a *Settings with the given kubeconfig for clusters that do not serve the OpenShift config APIs,
+// such as MicroShift. Helpers that support it skip the config.openshift.io resources instead of failing on them.
+func NewReduced(kubeconfig string, options ...Option) *Settings {
+	clientSet := New(kubeconfig, options...)
+	if clientSet == nil {
 		return nil
 	}
 
-	clientSet.KubeconfigPath = kubeconfig
+	clientSet.reduced = true
 
 	return clientSet
 }
 
+// IsReduced returns whether the client was created in reduced mode for clusters without the OpenShift config APIs.
+func (settings *Settings) IsReduced() bool {
+	return settings != nil && settings.reduced
+}
+
 // SetScheme returns mutated apiClient's scheme.
 func SetScheme(crScheme *runtime.Scheme) error {
 	if err := scheme.AddToScheme(crScheme); err != nil {
@@ -183,7 +274,8 @@ func (settings *Settings) GetAPIClient() (*Settings, error) {
 	return settings, nil
 }
 
-// AttachScheme attaches a scheme to the client's current scheme.
+// AttachScheme attaches a scheme to the client's current scheme. Attaching is serialized, so builders constructed
+// concurrently with the same client can attach their scheme on every construction.
 func (settings *Settings) AttachScheme(attacher SchemeAttacher) error {
 	if settings == nil {
 		klog.V(100).Info("APIClient is nil")
@@ -191,12 +283,22 @@ func (settings *Settings) AttachScheme(attacher SchemeAttacher) error {
 		return fmt.Errorf("cannot add scheme to nil client")
 	}
 
-	err := attacher(settings.scheme)
-	if err != nil {
-		return err
+	targetScheme := settings.scheme
+	if settings.Client != nil {
+		targetScheme = settings.Client.Scheme()
 	}
 
-	return nil
+	if targetScheme == nil {
+		klog.V(100).Info("APIClient scheme is nil")
+
+		return fmt.Errorf("cannot add scheme to client without a scheme")
+	}
+
+	if settings.schemeLock == nil {
+		return attacher(targetScheme)
+	}
+
+	return settings.schemeLock.attach(targetScheme, attacher)
 }
 
 // TestClientParams provides the struct to store the parameters for the test client.
@@ -205,6 +307,14 @@ type TestClientParams struct {
 	GVK              []schema.GroupVersionKind
 	SchemeAttachers  []SchemeAttacher
 	InterceptorFuncs interceptor.Funcs
+	// StatusSimulators simulate controllers updating objects created through the fake runtime client, such as an
+	// operator marking a CR as ready. See StatusSimulator.
+	StatusSimulators []StatusSimulator
+	// Clock decides when StatusSimulators apply. It defaults to the real clock. Use a TestClock to travel forward in
+	// time instead of sleeping.
+	Clock clock.PassiveClock
+	// Reduced creates the test clients in reduced mode, as NewReduced does.
+	Reduced bool
 }
 
 // GetTestClients returns a fake clientset for testing.
@@ -220,7 +330,7 @@ func GetTestClients(tcp TestClientParams) *Settings {
 //
 //nolint:funlen,gocyclo
 func GetModifiableTestClients(tcp TestClientParams) (*Settings, *fakeRuntimeClient.ClientBuilder) {
-	clientSet := &Settings{}
+	clientSet := &Settings{reduced: tcp.Reduced, schemeLock: newSchemeLock()}
 
 	var k8sClientObjects, genericClientObjects []runtime.Object
 
@@ -327,9 +437,15 @@ func GetModifiableTestClients(tcp TestClientParams) (*Settings, *fakeRuntimeClie
 			return nil, nil
 		}
 	}
+
+	interceptorFuncs := tcp.InterceptorFuncs
+	if len(tcp.StatusSimulators) > 0 {
+		interceptorFuncs = withStatusSimulators(interceptorFuncs, tcp.StatusSimulators, tcp.Clock)
+	}
+
 	// Add fake runtime client to clientSet runtime client
 	clientBuilder := fakeRuntimeClient.NewClientBuilder().WithScheme(clientSet.scheme).
-		WithRuntimeObjects(genericClientObjects...).WithInterceptorFuncs(tcp.InterceptorFuncs)
+		WithRuntimeObjects(genericClientObjects...).WithInterceptorFuncs(interceptorFuncs)
 
 	return clientSet, clientBuilder
 }
@@ -0,0 +1,155 @@
+package clients
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+	"k8s.io/klog/v2"
+)
+
+// KubeconfigContext describes a context of a kubeconfig.
+type KubeconfigContext struct {
+	// Name is the name of the context.
+	Name string
+	// Cluster is the name of the cluster of the context.
+	Cluster string
+	// Server is the URL of the API server of the cluster. It is empty if the cluster is not in the kubeconfig.
+	Server string
+	// User is the name of the user of the context.
+	User string
+	// Namespace is the default namespace of the context.
+	Namespace string
+	// Current is whether the context is the current context of the kubeconfig.
+	Current bool
+}
+
+// NewForContext returns a *Settings for the context contextName of the kubeconfig rather than its current context, so
+// suites targeting several clusters can create a client for each without changing the kubeconfig or the KUBECONFIG
+// environment variable. As with New, an empty kubeconfig falls back to KUBECONFIG, which may list several kubeconfigs
+// to merge, but never to the in-cluster config. It returns nil if the context does not exist.
+func NewForContext(kubeconfig, contextName string, options ...Option) *Settings {
+	klog.V(100).Infof("Creating apiClient for context %q of kubeconfig %q", contextName, kubeconfig)
+
+	if contextName == "" {
+		klog.V(100).Info("The contextName is empty")
+
+		return nil
+	}
+
+	if _, err := newKubeconfigPathOptions(kubeconfig); err != nil {
+		klog.V(100).Infof("Failed to create apiClient for context %s: %v", contextName, err)
+
+		return nil
+	}
+
+	return New(kubeconfig, append(slices.Clone(options), WithContext(contextName))...)
+}
+
+// ListContexts returns the contexts of the kubeconfig sorted by name. As with New, an empty kubeconfig falls back to
+// the KUBECONFIG environment variable, which may list several kubeconfigs to merge.
+func ListContexts(kubeconfig string) ([]KubeconfigContext, error) {
+	config, _, err := loadRawKubeconfig(kubeconfig)
+	if err != nil {
+		return nil, err
+	}
+
+	var contexts []KubeconfigContext
+
+	for _, name := range slices.Sorted(maps.Keys(config.Contexts)) {
+		kubeContext := config.Contexts[name]
+		kubeconfigContext := KubeconfigContext{
+			Name:      name,
+			Cluster:   kubeContext.Cluster,
+			User:      kubeContext.AuthInfo,
+			Namespace: kubeContext.Namespace,
+			Current:   name == config.CurrentContext,
+		}
+
+		if cluster, ok := config.Clusters[kubeContext.Cluster]; ok {
+			kubeconfigContext.Server = cluster.Server
+		}
+
+		contexts = append(contexts, kubeconfigContext)
+	}
+
+	return contexts, nil
+}
+
+// GetCurrentContext returns the name of the current context of the kubeconfig, which is empty if it is not set. As
+// with New, an empty kubeconfig falls back to the KUBECONFIG environment variable.
+func GetCurrentContext(kubeconfig string) (string, error) {
+	config, _, err := loadRawKubeconfig(kubeconfig)
+	if err != nil {
+		return "", err
+	}
+
+	return config.CurrentContext, nil
+}
+
+// UseContext sets the current context of the kubeconfig to contextName, as oc config use-context does. Since this
+// changes the kubeconfig for every client loading it later, prefer NewForContext to target a context from a single
+// suite. When an empty kubeconfig falls back to several kubeconfigs listed in KUBECONFIG, the current context is
+// written to the first one that exists.
+func UseContext(kubeconfig, contextName string) error {
+	klog.V(100).Infof("Setting current context of kubeconfig %q to %q", kubeconfig, contextName)
+
+	if contextName == "" {
+		return fmt.Errorf("cannot use empty context")
+	}
+
+	config, pathOptions, err := loadRawKubeconfig(kubeconfig)
+	if err != nil {
+		return err
+	}
+
+	if _, ok := config.Contexts[contextName]; !ok {
+		return fmt.Errorf("context %s not found in kubeconfig", contextName)
+	}
+
+	config.CurrentContext = contextName
+
+	err = clientcmd.ModifyConfig(pathOptions, *config, false)
+	if err != nil {
+		return fmt.Errorf("failed to set current context to %s: %w", contextName, err)
+	}
+
+	return nil
+}
+
+// loadRawKubeconfig loads the kubeconfig, or the kubeconfigs listed in the KUBECONFIG environment variable if it is
+// empty, without resolving the current context. It also returns the path options used to write it back.
+func loadRawKubeconfig(kubeconfig string) (*clientcmdapi.Config, *clientcmd.PathOptions, error) {
+	pathOptions, err := newKubeconfigPathOptions(kubeconfig)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	loadingRules := *pathOptions.LoadingRules
+	loadingRules.Precedence = pathOptions.GetLoadingPrecedence()
+
+	config, err := loadingRules.Load()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to load kubeconfig: %w", err)
+	}
+
+	return config, pathOptions, nil
+}
+
+// newKubeconfigPathOptions returns the path options for the kubeconfig, or for the kubeconfigs listed in the KUBECONFIG
+// environment variable if it is empty. Unlike kubectl, it does not fall back to ~/.kube/config, to match New.
+func newKubeconfigPathOptions(kubeconfig string) (*clientcmd.PathOptions, error) {
+	pathOptions := &clientcmd.PathOptions{
+		EnvVar:       clientcmd.RecommendedConfigPathEnvVar,
+		LoadingRules: &clientcmd.ClientConfigLoadingRules{ExplicitPath: kubeconfig},
+	}
+
+	if kubeconfig == "" && len(pathOptions.GetEnvVarFiles()) == 0 {
+		return nil, fmt.Errorf("no kubeconfig given and the %s environment variable is not set",
+			clientcmd.RecommendedConfigPathEnvVar)
+	}
+
+	return pathOptions, nil
+}
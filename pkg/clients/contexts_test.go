@@ -0,0 +1,141 @@
+package clients
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testContextsKubeconfigTemplate = `apiVersion: v1
+kind: Config
+clusters:
+- name: hub
+  cluster:
+    server: %s
+- name: spoke
+  cluster:
+    server: %s
+contexts:
+- name: hub
+  context:
+    cluster: hub
+    user: admin
+- name: spoke
+  context:
+    cluster: spoke
+    user: admin
+    namespace: openshift-ptp
+- name: stale
+  context:
+    cluster: deleted
+    user: admin
+current-context: hub
+users:
+- name: admin
+  user: {}
+`
+
+func TestNewForContext(t *testing.T) {
+	hubServer := newTestAPIServer(t, "", "")
+	spokeServer := newTestAPIServer(t, "", "")
+	kubeconfig := writeTestContextsKubeconfig(t, hubServer.URL, spokeServer.URL)
+
+	t.Setenv("KUBECONFIG", "")
+
+	hubSettings := NewForContext(kubeconfig, "hub")
+	require.NotNil(t, hubSettings)
+	assert.Equal(t, hubServer.URL, hubSettings.Config.Host)
+	assert.NoError(t, hubSettings.Ping(context.TODO()))
+
+	spokeSettings := NewForContext(kubeconfig, "spoke")
+	require.NotNil(t, spokeSettings)
+	assert.Equal(t, spokeServer.URL, spokeSettings.Config.Host)
+	assert.NoError(t, spokeSettings.Ping(context.TODO()))
+
+	// Creating clients for other contexts does not change the kubeconfig.
+	currentContext, err := GetCurrentContext(kubeconfig)
+	require.NoError(t, err)
+	assert.Equal(t, "hub", currentContext)
+
+	assert.Nil(t, NewForContext(kubeconfig, "unknown"))
+	assert.Nil(t, NewForContext(kubeconfig, ""))
+	assert.Nil(t, NewForContext("", "spoke"))
+
+	t.Setenv("KUBECONFIG", kubeconfig)
+
+	spokeSettings = NewForContext("", "spoke", WithContext("hub"))
+	require.NotNil(t, spokeSettings)
+	assert.Equal(t, spokeServer.URL, spokeSettings.Config.Host)
+}
+
+func TestListContexts(t *testing.T) {
+	kubeconfig := writeTestContextsKubeconfig(t, "https://api.hub.example.com:6443", "https://api.spoke.example.com:6443")
+
+	contexts, err := ListContexts(kubeconfig)
+	require.NoError(t, err)
+	assert.Equal(t, []KubeconfigContext{
+		{Name: "hub", Cluster: "hub", Server: "https://api.hub.example.com:6443", User: "admin", Current: true},
+		{
+			Name:      "spoke",
+			Cluster:   "spoke",
+			Server:    "https://api.spoke.example.com:6443",
+			User:      "admin",
+			Namespace: "openshift-ptp",
+		},
+		{Name: "stale", Cluster: "deleted", User: "admin"},
+	}, contexts)
+
+	_, err = ListContexts(filepath.Join(t.TempDir(), "missing"))
+	assert.ErrorContains(t, err, "failed to load kubeconfig")
+
+	t.Setenv("KUBECONFIG", "")
+
+	_, err = ListContexts("")
+	assert.EqualError(t, err, "no kubeconfig given and the KUBECONFIG environment variable is not set")
+}
+
+func TestUseContext(t *testing.T) {
+	kubeconfig := writeTestContextsKubeconfig(t, "https://api.hub.example.com:6443", "https://api.spoke.example.com:6443")
+
+	require.NoError(t, UseContext(kubeconfig, "spoke"))
+
+	currentContext, err := GetCurrentContext(kubeconfig)
+	require.NoError(t, err)
+	assert.Equal(t, "spoke", currentContext)
+
+	assert.EqualError(t, UseContext(kubeconfig, "unknown"), "context unknown not found in kubeconfig")
+	assert.EqualError(t, UseContext(kubeconfig, ""), "cannot use empty context")
+
+	// With a list of kubeconfigs, the current context is written to the first one and the others are unchanged.
+	tempDir := t.TempDir()
+	firstKubeconfig := filepath.Join(tempDir, "first")
+	require.NoError(t, os.WriteFile(
+		firstKubeconfig, []byte("apiVersion: v1\nkind: Config\ncurrent-context: hub\n"), 0o600))
+
+	t.Setenv("KUBECONFIG", firstKubeconfig+string(filepath.ListSeparator)+kubeconfig)
+
+	require.NoError(t, UseContext("", "stale"))
+
+	currentContext, err = GetCurrentContext(firstKubeconfig)
+	require.NoError(t, err)
+	assert.Equal(t, "stale", currentContext)
+
+	currentContext, err = GetCurrentContext(kubeconfig)
+	require.NoError(t, err)
+	assert.Equal(t, "spoke", currentContext)
+}
+
+func writeTestContextsKubeconfig(t *testing.T, hubServer, spokeServer string) string {
+	t.Helper()
+
+	kubeconfig := filepath.Join(t.TempDir(), "kubeconfig")
+	require.NoError(t, os.WriteFile(
+		kubeconfig, fmt.Appendf(nil, testContextsKubeconfigTemplate, hubServer, spokeServer), 0o600))
+
+	return kubeconfig
+}
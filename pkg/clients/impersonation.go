@@ -0,0 +1,58 @@
+package clients
+
+import (
+	"fmt"
+
+	"k8s.io/client-go/rest"
+	"k8s.io/klog/v2"
+)
+
+// WithImpersonation returns a new *Settings for the same cluster whose requests impersonate the given user and
+// groups, so builders can be exercised under a restricted identity to test RBAC without creating real users. Service
+// accounts are impersonated using their username, system:serviceaccount:<namespace>:<name>, along with the
+// system:serviceaccounts and system:serviceaccounts:<namespace> groups if the test depends on them. The identity of
+// the original client must be allowed to impersonate the user and groups.
+//
+// The derived client shares the scheme of the original client, so schemes attached to either are available to both,
+// while the original client is left unchanged. Cached reads are not carried over.
+func (settings *Settings) WithImpersonation(user string, groups []string) (*Settings, error) {
+	if settings == nil {
+		klog.V(100).Info("APIClient is nil")
+
+		return nil, fmt.Errorf("cannot impersonate user with nil client")
+	}
+
+	if user == "" {
+		return nil, fmt.Errorf("impersonated 'user' cannot be empty")
+	}
+
+	config, err := settings.RESTConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	klog.V(100).Infof("Creating apiClient impersonating user %s with groups %v", user, groups)
+
+	config.Impersonate = rest.ImpersonationConfig{UserName: user, Groups: groups}
+
+	clientScheme := settings.scheme
+	if settings.Client != nil {
+		clientScheme = settings.Client.Scheme()
+	}
+
+	lock := settings.schemeLock
+	if clientScheme == nil || lock == nil {
+		return nil, fmt.Errorf("cannot impersonate user with client without a scheme")
+	}
+
+	impersonated, err := newForConfigAndScheme(config, clientScheme, lock)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create apiClient impersonating user %s: %w", user, err)
+	}
+
+	impersonated.KubeconfigPath = settings.KubeconfigPath
+	impersonated.reduced = settings.reduced
+	impersonated.defaultNamespace = settings.defaultNamespace
+
+	return impersonated, nil
+}
@@ -0,0 +1,67 @@
+package clients
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/rest"
+)
+
+func TestWithImpersonation(t *testing.T) {
+	var (
+		impersonatedUser   string
+		impersonatedGroups []string
+	)
+
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		impersonatedUser = request.Header.Get("Impersonate-User")
+		impersonatedGroups = request.Header.Values("Impersonate-Group")
+
+		writer.Header().Set("Content-Type", "application/json")
+		_, _ = writer.Write([]byte(`{"apiVersion":"v1","kind":"Namespace","metadata":{"name":"default"}}`))
+	}))
+	t.Cleanup(server.Close)
+
+	testSettings, err := newForConfig(&rest.Config{Host: server.URL})
+	require.NoError(t, err)
+
+	testSettings.KubeconfigPath = "kubeconfig"
+	testSettings.reduced = true
+
+	impersonated, err := testSettings.WithImpersonation(
+		"system:serviceaccount:test:restricted", []string{"system:serviceaccounts", "system:serviceaccounts:test"})
+	require.NoError(t, err)
+	assert.Equal(t, "kubeconfig", impersonated.KubeconfigPath)
+	assert.True(t, impersonated.IsReduced())
+	assert.Same(t, testSettings.Client.Scheme(), impersonated.Client.Scheme())
+
+	_, err = impersonated.Namespaces().Get(context.TODO(), "default", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, "system:serviceaccount:test:restricted", impersonatedUser)
+	assert.Equal(t, []string{"system:serviceaccounts", "system:serviceaccounts:test"}, impersonatedGroups)
+
+	// The original client must not impersonate anyone.
+	_, err = testSettings.Namespaces().Get(context.TODO(), "default", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Empty(t, impersonatedUser)
+	assert.Empty(t, impersonatedGroups)
+	assert.Empty(t, testSettings.Config.Impersonate.UserName)
+}
+
+func TestWithImpersonationValidation(t *testing.T) {
+	var nilSettings *Settings
+
+	_, err := nilSettings.WithImpersonation("user", nil)
+	assert.EqualError(t, err, "cannot impersonate user with nil client")
+
+	_, err = GetTestClients(TestClientParams{}).WithImpersonation("", nil)
+	assert.EqualError(t, err, "impersonated 'user' cannot be empty")
+
+	_, err = GetTestClients(TestClientParams{}).WithImpersonation("user", nil)
+	assert.EqualError(t, err, "cannot get REST config of client without a REST config")
+}
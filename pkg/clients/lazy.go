@@ -0,0 +1,188 @@
+package clients
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+
+	"k8s.io/client-go/rest"
+	"k8s.io/klog/v2"
+)
+
+// lazyHost is the placeholder host used by the clients of a lazy *Settings until the kubeconfig is loaded.
+const lazyHost = "https://lazy-kubeconfig.invalid"
+
+// NewLazy returns a *Settings with the given kubeconfig that only loads it when the first request is sent, so it can be
+// created in package init before the kubeconfig exists or flags are parsed. As with New, an empty kubeconfig falls back
+// to the KUBECONFIG environment variable, read when the kubeconfig is loaded, then to the options alone if they set the
+// server, and then to the in-cluster config, and the options override the settings of the current context. If loading
+// fails, every request fails with the loading error and loading is retried on the next request. Call Ping to load the
+// kubeconfig and check that the API server is reachable before running tests.
+//
+// Config holds a placeholder until the kubeconfig is loaded and is then updated in place to the loaded config, so
+// helpers building their own transports, such as those executing commands in pods, must use RESTConfig rather than
+// reading Config directly.
+func NewLazy(kubeconfig string, options ...Option) *Settings {
+	klog.V(100).Infof("Creating lazy apiClient for kubeconfig %q", kubeconfig)
+
+	transport := &lazyTransport{kubeconfig: kubeconfig, options: newClientOptions(options)}
+	transport.config = &rest.Config{Host: lazyHost, Transport: transport}
+
+	clientSet, err := newForConfig(transport.config)
+	if err != nil {
+		klog.V(100).Infof("Failed to create lazy apiClient: %v", err)
+
+		return nil
+	}
+
+	clientSet.KubeconfigPath = kubeconfig
+	clientSet.lazy = transport
+
+	return clientSet
+}
+
+// Ping checks that the API server can be reached and that the client is authorized to use it, loading the kubeconfig
+// first if the client was created using NewLazy. It is intended to fail fast with a clear error before running tests.
+func (settings *Settings) Ping(ctx context.Context) error {
+	if settings == nil || settings.K8sClient == nil {
+		klog.V(100).Info("APIClient is nil")
+
+		return fmt.Errorf("cannot ping API server with nil client")
+	}
+
+	if settings.lazy != nil {
+		if err := settings.lazy.load(); err != nil {
+			return err
+		}
+	}
+
+	restClient := settings.K8sClient.Discovery().RESTClient()
+	if restClient == nil || settings.Config == nil {
+		return fmt.Errorf("cannot ping API server with client that has no REST client")
+	}
+
+	var host string
+
+	settings.lazy.withLock(func() { host = settings.Config.Host })
+
+	klog.V(100).Infof("Pinging API server %s", host)
+
+	err := restClient.Get().AbsPath("/version").Do(ctx).Error()
+	if err != nil {
+		return fmt.Errorf("failed to reach API server %s: %w", host, err)
+	}
+
+	return nil
+}
+
+// RESTConfig returns a copy of the REST config of the client, loading the kubeconfig first if the client was created
+// using NewLazy. It is meant for helpers that build their own transports from the config, such as those executing
+// commands in pods, so they use the loaded config along with its proxy, dialer and CA settings.
+func (settings *Settings) RESTConfig() (*rest.Config, error) {
+	if settings == nil {
+		klog.V(100).Info("APIClient is nil")
+
+		return nil, fmt.Errorf("cannot get REST config of nil client")
+	}
+
+	if settings.lazy != nil {
+		if err := settings.lazy.load(); err != nil {
+			return nil, err
+		}
+	}
+
+	if settings.Config == nil {
+		return nil, fmt.Errorf("cannot get REST config of client without a REST config")
+	}
+
+	var config *rest.Config
+
+	settings.lazy.withLock(func() { config = rest.CopyConfig(settings.Config) })
+
+	return config, nil
+}
+
+// lazyTransport loads the kubeconfig on the first request and then forwards every request to the API server it points
+// to, using a transport built from the loaded config.
+type lazyTransport struct {
+	kubeconfig string
+	options    *clientOptions
+	// config is the placeholder config of the clients, updated in place once the kubeconfig is loaded.
+	config *rest.Config
+
+	mutex     sync.Mutex
+	loaded    bool
+	transport http.RoundTripper
+	serverURL *url.URL
+}
+
+// RoundTrip implements http.RoundTripper by forwarding the request to the API server of the loaded kubeconfig.
+func (transport *lazyTransport) RoundTrip(request *http.Request) (*http.Response, error) {
+	if err := transport.load(); err != nil {
+		return nil, err
+	}
+
+	request = request.Clone(request.Context())
+	request.Host = ""
+	request.URL.Scheme = transport.serverURL.Scheme
+	request.URL.Host = transport.serverURL.Host
+	request.URL.Path = strings.TrimSuffix(transport.serverURL.Path, "/") + request.URL.Path
+
+	if request.URL.RawPath != "" {
+		request.URL.RawPath = strings.TrimSuffix(transport.serverURL.EscapedPath(), "/") + request.URL.RawPath
+	}
+
+	return transport.transport.RoundTrip(request)
+}
+
+// load loads the kubeconfig and builds the transport to its API server, unless this was already done successfully.
+func (transport *lazyTransport) load() error {
+	transport.mutex.Lock()
+	defer transport.mutex.Unlock()
+
+	if transport.loaded {
+		return nil
+	}
+
+	config, _, err := loadConfig(transport.kubeconfig, transport.options)
+	if err != nil {
+		return fmt.Errorf("failed to load kubeconfig %q: %w", transport.kubeconfig, err)
+	}
+
+	serverURL, _, err := rest.DefaultServerUrlFor(config)
+	if err != nil {
+		return fmt.Errorf("failed to parse API server of kubeconfig %q: %w", transport.kubeconfig, err)
+	}
+
+	roundTripper, err := rest.TransportFor(config)
+	if err != nil {
+		return fmt.Errorf("failed to create transport for kubeconfig %q: %w", transport.kubeconfig, err)
+	}
+
+	klog.V(100).Infof("Loaded lazy kubeconfig %q for API server %s", transport.kubeconfig, serverURL)
+
+	transport.serverURL = serverURL
+	transport.transport = roundTripper
+	*transport.config = *rest.CopyConfig(config)
+	transport.loaded = true
+
+	return nil
+}
+
+// withLock runs fn while holding the lock guarding the loaded config. It runs fn directly if transport is nil, since
+// the config of clients created using New never changes.
+func (transport *lazyTransport) withLock(fn func()) {
+	if transport == nil {
+		fn()
+
+		return
+	}
+
+	transport.mutex.Lock()
+	defer transport.mutex.Unlock()
+
+	fn()
+}
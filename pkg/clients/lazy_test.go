@@ -0,0 +1,152 @@
+package clients
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/restmapper"
+)
+
+const testKubeconfigTemplate = `apiVersion: v1
+kind: Config
+clusters:
+- name: test
+  cluster:
+    server: %s
+contexts:
+- name: test
+  context:
+    cluster: test
+    user: test
+current-context: test
+users:
+- name: test
+  user: {}
+`
+
+func TestNewLazy(t *testing.T) {
+	server := newTestAPIServer(t, "/prefix", "")
+	kubeconfig := filepath.Join(t.TempDir(), "kubeconfig")
+
+	// The kubeconfig does not exist yet, which must not prevent creating the client.
+	testSettings := NewLazy(kubeconfig)
+	require.NotNil(t, testSettings)
+	assert.Equal(t, kubeconfig, testSettings.KubeconfigPath)
+
+	err := testSettings.Ping(context.TODO())
+	assert.ErrorContains(t, err, fmt.Sprintf("failed to load kubeconfig %q", kubeconfig))
+
+	_, err = testSettings.Namespaces().Get(context.TODO(), "default", metav1.GetOptions{})
+	assert.ErrorContains(t, err, fmt.Sprintf("failed to load kubeconfig %q", kubeconfig))
+
+	err = os.WriteFile(kubeconfig, fmt.Appendf(nil, testKubeconfigTemplate, server.URL+"/prefix"), 0o600)
+	require.NoError(t, err)
+
+	err = testSettings.Ping(context.TODO())
+	assert.NoError(t, err)
+	assert.Equal(t, server.URL+"/prefix", testSettings.Config.Host)
+
+	namespace, err := testSettings.Namespaces().Get(context.TODO(), "default", metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Equal(t, "default", namespace.Name)
+}
+
+func TestRESTConfig(t *testing.T) {
+	server := newTestAPIServer(t, "", "")
+	kubeconfig := filepath.Join(t.TempDir(), "kubeconfig")
+
+	err := os.WriteFile(kubeconfig, fmt.Appendf(nil, testKubeconfigTemplate, server.URL), 0o600)
+	require.NoError(t, err)
+
+	// The lazy client has not sent any request yet, so getting its config must load the kubeconfig.
+	config, err := NewLazy(kubeconfig).RESTConfig()
+	require.NoError(t, err)
+	assert.Equal(t, server.URL, config.Host)
+	assert.Nil(t, config.Transport)
+
+	testSettings, err := newForConfig(&rest.Config{Host: server.URL})
+	require.NoError(t, err)
+
+	config, err = testSettings.RESTConfig()
+	require.NoError(t, err)
+	assert.Equal(t, server.URL, config.Host)
+	assert.NotSame(t, testSettings.Config, config)
+
+	_, err = NewLazy(filepath.Join(t.TempDir(), "missing")).RESTConfig()
+	assert.ErrorContains(t, err, "failed to load kubeconfig")
+
+	_, err = GetTestClients(TestClientParams{}).RESTConfig()
+	assert.EqualError(t, err, "cannot get REST config of client without a REST config")
+
+	var nilSettings *Settings
+
+	_, err = nilSettings.RESTConfig()
+	assert.EqualError(t, err, "cannot get REST config of nil client")
+}
+
+func TestPing(t *testing.T) {
+	server := newTestAPIServer(t, "", "test-token")
+
+	testSettings, err := newForConfig(&rest.Config{Host: server.URL, BearerToken: "test-token"})
+	require.NoError(t, err)
+	assert.NoError(t, testSettings.Ping(context.TODO()))
+
+	testSettings, err = newForConfig(&rest.Config{Host: server.URL, BearerToken: "wrong-token"})
+	require.NoError(t, err)
+	assert.ErrorContains(t, testSettings.Ping(context.TODO()), "failed to reach API server "+server.URL)
+
+	assert.EqualError(t, GetTestClients(TestClientParams{}).Ping(context.TODO()),
+		"cannot ping API server with client that has no REST client")
+
+	var nilSettings *Settings
+
+	assert.EqualError(t, nilSettings.Ping(context.TODO()), "cannot ping API server with nil client")
+}
+
+func TestNewForConfigRESTMapper(t *testing.T) {
+	server := newTestAPIServer(t, "", "")
+
+	testSettings, err := newForConfig(&rest.Config{Host: server.URL})
+	require.NoError(t, err)
+
+	// The runtime client must resolve kinds through the cached discovery client rather than its own mapper.
+	assert.IsType(t, &restmapper.DeferredDiscoveryRESTMapper{}, testSettings.Client.RESTMapper())
+}
+
+// newTestAPIServer returns a server serving the version and the default namespace under pathPrefix. If token is not
+// empty, requests must be authenticated with it.
+func newTestAPIServer(t *testing.T, pathPrefix, token string) *httptest.Server {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc(pathPrefix+"/version", func(writer http.ResponseWriter, request *http.Request) {
+		_, _ = writer.Write([]byte(`{"major":"1","minor":"34","gitVersion":"v1.34.0"}`))
+	})
+	mux.HandleFunc(pathPrefix+"/api/v1/namespaces/default", func(writer http.ResponseWriter, request *http.Request) {
+		writer.Header().Set("Content-Type", "application/json")
+		_, _ = writer.Write([]byte(`{"apiVersion":"v1","kind":"Namespace","metadata":{"name":"default"}}`))
+	})
+
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		if token != "" && request.Header.Get("Authorization") != "Bearer "+token {
+			writer.WriteHeader(http.StatusUnauthorized)
+
+			return
+		}
+
+		mux.ServeHTTP(writer, request)
+	}))
+
+	t.Cleanup(server.Close)
+
+	return server
+}
@@ -0,0 +1,270 @@
+package clients
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+	"k8s.io/klog/v2"
+	runtimeClient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// DefaultFieldManager is the field manager used by ApplyManifests when ApplyOptions does not specify one.
+const DefaultFieldManager = "eco-goinfra"
+
+// manifestExtensions are the file extensions read by ApplyManifestsFromDir.
+var manifestExtensions = []string{".yaml", ".yml", ".json"}
+
+// ApplyOptions configures how ApplyManifests applies objects.
+type ApplyOptions struct {
+	// FieldManager is the name of the field manager used for server-side apply. Defaults to DefaultFieldManager.
+	FieldManager string
+	// Namespace is used for namespaced objects that do not set one. If it is empty, such objects cause an error.
+	Namespace string
+	// ForceConflicts takes ownership of fields managed by other field managers instead of failing.
+	ForceConflicts bool
+}
+
+// AppliedObject is a handle to an object applied by ApplyManifests that can be used to check on it and to clean it up,
+// similar to a builder.
+type AppliedObject struct {
+	// Object is the object as returned by the API server after it was applied.
+	Object *unstructured.Unstructured
+	// Namespaced is whether the object is namespaced, as resolved through the REST mapper.
+	Namespaced bool
+
+	apiClient runtimeClient.Client
+}
+
+// Exists checks whether the object still exists on the cluster.
+func (object *AppliedObject) Exists(ctx context.Context) bool {
+	if object == nil || object.Object == nil || object.apiClient == nil {
+		return false
+	}
+
+	existing := &unstructured.Unstructured{}
+	existing.SetGroupVersionKind(object.Object.GroupVersionKind())
+
+	err := object.apiClient.Get(ctx, runtimeClient.ObjectKeyFromObject(object.Object), existing)
+
+	return err == nil
+}
+
+// Delete removes the object from the cluster. It is not an error if the object no longer exists.
+func (object *AppliedObject) Delete(ctx context.Context) error {
+	if object == nil || object.Object == nil {
+		return fmt.Errorf("cannot delete nil applied object")
+	}
+
+	if object.apiClient == nil {
+		return fmt.Errorf("cannot delete applied object with nil apiClient")
+	}
+
+	klog.V(100).Infof("Deleting applied %s %s in namespace %s",
+		object.Object.GetKind(), object.Object.GetName(), object.Object.GetNamespace())
+
+	err := object.apiClient.Delete(ctx, object.Object)
+	if err != nil && !k8serrors.IsNotFound(err) {
+		return err
+	}
+
+	return nil
+}
+
+// DeleteAppliedObjects deletes the objects in the reverse order they were applied in, so that objects such as
+// namespaces are removed after the objects inside them. It attempts every deletion and returns all errors joined.
+func DeleteAppliedObjects(ctx context.Context, objects []*AppliedObject) error {
+	var errs []error
+
+	for _, object := range slices.Backward(objects) {
+		err := object.Delete(ctx)
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// ApplyManifests decodes the YAML or JSON documents in reader and server-side applies each object in order. Objects of
+// kind List are expanded into their items. Every object is decoded and resolved through the REST mapper before any are
+// applied, so malformed manifests or unknown kinds do not leave partial changes on the cluster. If applying an object
+// fails, the objects applied before it are returned along with the error so they can be cleaned up.
+func (settings *Settings) ApplyManifests(
+	ctx context.Context, reader io.Reader, options ApplyOptions) ([]*AppliedObject, error) {
+	if settings == nil || settings.Client == nil {
+		klog.V(100).Info("APIClient is nil")
+
+		return nil, fmt.Errorf("cannot apply manifests with nil apiClient")
+	}
+
+	if reader == nil {
+		return nil, fmt.Errorf("cannot apply manifests from nil reader")
+	}
+
+	objects, err := decodeManifests(reader)
+	if err != nil {
+		return nil, err
+	}
+
+	toApply := make([]*AppliedObject, 0, len(objects))
+
+	for _, object := range objects {
+		appliedObject, err := settings.resolveManifest(object, options.Namespace)
+		if err != nil {
+			return nil, err
+		}
+
+		toApply = append(toApply, appliedObject)
+	}
+
+	fieldManager := options.FieldManager
+	if fieldManager == "" {
+		fieldManager = DefaultFieldManager
+	}
+
+	applyOptions := []runtimeClient.ApplyOption{runtimeClient.FieldOwner(fieldManager)}
+	if options.ForceConflicts {
+		applyOptions = append(applyOptions, runtimeClient.ForceOwnership)
+	}
+
+	for index, object := range toApply {
+		klog.V(100).Infof("Applying %s %s in namespace %s",
+			object.Object.GetKind(), object.Object.GetName(), object.Object.GetNamespace())
+
+		err := settings.Client.Apply(ctx, runtimeClient.ApplyConfigurationFromUnstructured(object.Object), applyOptions...)
+		if err != nil {
+			return toApply[:index], fmt.Errorf("failed to apply %s %s: %w",
+				object.Object.GetKind(), object.Object.GetName(), err)
+		}
+	}
+
+	return toApply, nil
+}
+
+// ApplyManifestsFromDir applies the manifests in every .yaml, .yml and .json file in dir, in lexical order of the file
+// names. Subdirectories are not read. See ApplyManifests for how each file is applied.
+func (settings *Settings) ApplyManifestsFromDir(
+	ctx context.Context, dir string, options ApplyOptions) ([]*AppliedObject, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read manifest directory %s: %w", dir, err)
+	}
+
+	var applied []*AppliedObject
+
+	for _, entry := range entries {
+		if entry.IsDir() || !slices.Contains(manifestExtensions, filepath.Ext(entry.Name())) {
+			continue
+		}
+
+		fileApplied, err := settings.applyManifestFile(ctx, filepath.Join(dir, entry.Name()), options)
+		applied = append(applied, fileApplied...)
+
+		if err != nil {
+			return applied, err
+		}
+	}
+
+	return applied, nil
+}
+
+// applyManifestFile applies the manifests in a single file.
+func (settings *Settings) applyManifestFile(
+	ctx context.Context, filePath string, options ApplyOptions) ([]*AppliedObject, error) {
+	file, err := os.Open(filePath)
+	if err != nil {
+		return nil, err
+	}
+
+	defer file.Close()
+
+	applied, err := settings.ApplyManifests(ctx, file, options)
+	if err != nil {
+		return applied, fmt.Errorf("failed to apply manifests from %s: %w", filePath, err)
+	}
+
+	return applied, nil
+}
+
+// resolveManifest looks up the scope of object through the REST mapper and defaults or clears its namespace to match.
+func (settings *Settings) resolveManifest(object *unstructured.Unstructured, namespace string) (*AppliedObject, error) {
+	gvk := object.GroupVersionKind()
+
+	mapping, err := settings.Client.RESTMapper().RESTMapping(gvk.GroupKind(), gvk.Version)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve %s for %s: %w", gvk, object.GetName(), err)
+	}
+
+	namespaced := mapping.Scope.Name() == meta.RESTScopeNameNamespace
+
+	switch {
+	case namespaced && object.GetNamespace() == "":
+		if namespace == "" {
+			return nil, fmt.Errorf("namespaced %s %s has no namespace and no default was provided",
+				gvk.Kind, object.GetName())
+		}
+
+		object.SetNamespace(namespace)
+	case !namespaced && object.GetNamespace() != "":
+		klog.V(100).Infof("Ignoring namespace %s of cluster scoped %s %s",
+			object.GetNamespace(), gvk.Kind, object.GetName())
+
+		object.SetNamespace("")
+	}
+
+	return &AppliedObject{Object: object, Namespaced: namespaced, apiClient: settings.Client}, nil
+}
+
+// decodeManifests decodes every YAML or JSON document in reader, skipping empty documents and expanding lists.
+func decodeManifests(reader io.Reader) ([]*unstructured.Unstructured, error) {
+	decoder := utilyaml.NewYAMLOrJSONDecoder(reader, 4096)
+
+	var objects []*unstructured.Unstructured
+
+	for index := 0; ; index++ {
+		var document map[string]any
+
+		err := decoder.Decode(&document)
+		if errors.Is(err, io.EOF) {
+			return objects, nil
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode manifest document %d: %w", index, err)
+		}
+
+		if len(document) == 0 {
+			continue
+		}
+
+		object := &unstructured.Unstructured{Object: document}
+
+		if object.GetAPIVersion() == "" || object.GetKind() == "" {
+			return nil, fmt.Errorf("manifest document %d must set apiVersion and kind", index)
+		}
+
+		if !object.IsList() {
+			objects = append(objects, object)
+
+			continue
+		}
+
+		list, err := object.ToList()
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode list in manifest document %d: %w", index, err)
+		}
+
+		for itemIndex := range list.Items {
+			objects = append(objects, &list.Items[itemIndex])
+		}
+	}
+}
@@ -0,0 +1,228 @@
+package clients
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	runtimeClient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+)
+
+const (
+	testManifestNamespace = "test-manifest-namespace"
+	testManifests         = `---
+apiVersion: v1
+kind: Namespace
+metadata:
+  name: test-manifest-namespace
+---
+# empty documents are skipped
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: first
+data:
+  key: value
+---
+apiVersion: v1
+kind: List
+items:
+- apiVersion: v1
+  kind: ConfigMap
+  metadata:
+    name: second
+    namespace: other-namespace
+`
+)
+
+func TestApplyManifests(t *testing.T) {
+	testCases := []struct {
+		manifests       string
+		options         ApplyOptions
+		interceptors    interceptor.Funcs
+		expectedApplied []string
+		expectedError   string
+	}{
+		{
+			manifests:       testManifests,
+			options:         ApplyOptions{Namespace: testManifestNamespace},
+			expectedApplied: []string{"/test-manifest-namespace", "test-manifest-namespace/first", "other-namespace/second"},
+		},
+		{
+			manifests:     testManifests,
+			expectedError: "namespaced ConfigMap first has no namespace and no default was provided",
+		},
+		{
+			manifests:       "apiVersion: v1\nkind: Namespace\nmetadata:\n  name: ns\n  namespace: ignored\n",
+			options:         ApplyOptions{FieldManager: "test-manager", ForceConflicts: true},
+			expectedApplied: []string{"/ns"},
+		},
+		{
+			manifests:     "apiVersion: example.com/v1\nkind: Unknown\nmetadata:\n  name: unknown\n",
+			expectedError: "failed to resolve example.com/v1, Kind=Unknown for unknown",
+		},
+		{
+			manifests:     "metadata:\n  name: no-kind\n",
+			expectedError: "manifest document 0 must set apiVersion and kind",
+		},
+		{
+			manifests:     "apiVersion: v1\nkind: [\n",
+			expectedError: "failed to decode manifest document 0",
+		},
+		{
+			manifests: testManifests,
+			options:   ApplyOptions{Namespace: testManifestNamespace},
+			interceptors: interceptor.Funcs{Apply: func(
+				ctx context.Context,
+				client runtimeClient.WithWatch,
+				obj runtime.ApplyConfiguration,
+				opts ...runtimeClient.ApplyOption,
+			) error {
+				if strings.Contains(toString(obj), "second") {
+					return errors.New("simulated apply failure")
+				}
+
+				return client.Apply(ctx, obj, opts...)
+			}},
+			expectedApplied: []string{"/test-manifest-namespace", "test-manifest-namespace/first"},
+			expectedError:   "failed to apply ConfigMap second: simulated apply failure",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testSettings := buildManifestTestClient(testCase.interceptors)
+
+		applied, err := testSettings.ApplyManifests(
+			context.TODO(), strings.NewReader(testCase.manifests), testCase.options)
+
+		if testCase.expectedError != "" {
+			assert.ErrorContains(t, err, testCase.expectedError)
+		} else {
+			assert.NoError(t, err)
+		}
+
+		assert.Equal(t, testCase.expectedApplied, appliedKeys(applied))
+
+		for _, object := range applied {
+			assert.True(t, object.Exists(context.TODO()))
+		}
+	}
+}
+
+func TestApplyManifestsInvalidInput(t *testing.T) {
+	var nilSettings *Settings
+
+	_, err := nilSettings.ApplyManifests(context.TODO(), strings.NewReader(testManifests), ApplyOptions{})
+	assert.EqualError(t, err, "cannot apply manifests with nil apiClient")
+
+	_, err = buildManifestTestClient(interceptor.Funcs{}).ApplyManifests(context.TODO(), nil, ApplyOptions{})
+	assert.EqualError(t, err, "cannot apply manifests from nil reader")
+}
+
+func TestApplyManifestsFromDir(t *testing.T) {
+	dir := t.TempDir()
+
+	files := map[string]string{
+		"01-namespace.yaml": "apiVersion: v1\nkind: Namespace\nmetadata:\n  name: test-manifest-namespace\n",
+		"02-configmap.json": `{"apiVersion": "v1", "kind": "ConfigMap", "metadata": {"name": "from-json"}}`,
+		"03-ignored.txt":    "not a manifest",
+	}
+
+	for name, content := range files {
+		assert.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(content), 0o600))
+	}
+
+	assert.NoError(t, os.Mkdir(filepath.Join(dir, "nested.yaml"), 0o755))
+
+	testSettings := buildManifestTestClient(interceptor.Funcs{})
+
+	applied, err := testSettings.ApplyManifestsFromDir(
+		context.TODO(), dir, ApplyOptions{Namespace: testManifestNamespace})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"/test-manifest-namespace", "test-manifest-namespace/from-json"}, appliedKeys(applied))
+
+	_, err = testSettings.ApplyManifestsFromDir(context.TODO(), filepath.Join(dir, "missing"), ApplyOptions{})
+	assert.ErrorContains(t, err, "failed to read manifest directory")
+}
+
+func TestDeleteAppliedObjects(t *testing.T) {
+	testSettings := buildManifestTestClient(interceptor.Funcs{})
+
+	applied, err := testSettings.ApplyManifests(
+		context.TODO(), strings.NewReader(testManifests), ApplyOptions{Namespace: testManifestNamespace})
+	assert.NoError(t, err)
+	assert.Len(t, applied, 3)
+
+	var deleted []string
+
+	testSettings.Client = interceptor.NewClient(testSettings.Client.(runtimeClient.WithWatch), interceptor.Funcs{
+		Delete: func(
+			ctx context.Context, client runtimeClient.WithWatch, obj runtimeClient.Object, opts ...runtimeClient.DeleteOption,
+		) error {
+			deleted = append(deleted, obj.GetName())
+
+			return client.Delete(ctx, obj, opts...)
+		},
+	})
+
+	for _, object := range applied {
+		object.apiClient = testSettings.Client
+	}
+
+	err = DeleteAppliedObjects(context.TODO(), applied)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"second", "first", "test-manifest-namespace"}, deleted)
+
+	for _, object := range applied {
+		assert.False(t, object.Exists(context.TODO()))
+	}
+
+	// Deleting objects that are already gone is not an error.
+	assert.NoError(t, DeleteAppliedObjects(context.TODO(), applied))
+
+	err = DeleteAppliedObjects(context.TODO(), []*AppliedObject{nil})
+	assert.EqualError(t, err, "cannot delete nil applied object")
+}
+
+// buildManifestTestClient returns test clients whose REST mapper knows about namespaces and configmaps.
+func buildManifestTestClient(interceptors interceptor.Funcs) *Settings {
+	restMapper := meta.NewDefaultRESTMapper(nil)
+	restMapper.Add(corev1.SchemeGroupVersion.WithKind("Namespace"), meta.RESTScopeRoot)
+	restMapper.Add(corev1.SchemeGroupVersion.WithKind("ConfigMap"), meta.RESTScopeNamespace)
+
+	testSettings, clientBuilder := GetModifiableTestClients(TestClientParams{InterceptorFuncs: interceptors})
+	testSettings.Client = clientBuilder.WithRESTMapper(restMapper).Build()
+
+	return testSettings
+}
+
+// appliedKeys returns the namespace/name of each applied object.
+func appliedKeys(applied []*AppliedObject) []string {
+	var keys []string
+
+	for _, object := range applied {
+		keys = append(keys, runtimeClient.ObjectKeyFromObject(object.Object).String())
+	}
+
+	return keys
+}
+
+// toString returns a string representation of an apply configuration for matching in interceptors.
+func toString(obj runtime.ApplyConfiguration) string {
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return ""
+	}
+
+	return string(data)
+}
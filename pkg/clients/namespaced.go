@@ -0,0 +1,56 @@
+package clients
+
+import (
+	"fmt"
+
+	"k8s.io/klog/v2"
+)
+
+// NamespacedClient returns a copy of the client whose default namespace is namespace. Constructors and Pull functions
+// of namespaced builders given an empty namespace use the default namespace of the client instead, so suites working in
+// a per-test namespace do not need to repeat it:
+//
+//	testClient, err := APIClient.NamespacedClient("test-ns")
+//	configMapBuilder := configmap.NewBuilder(testClient, "config", "")
+//
+// This applies to the New and Pull functions of every namespaced builder. List functions are not affected, since an
+// empty namespace there usually means all namespaces. An explicit namespace is always used as given. The copy shares
+// the underlying clients, scheme and cached reads of the original client, which is left unchanged.
+func (settings *Settings) NamespacedClient(namespace string) (*Settings, error) {
+	if settings == nil {
+		klog.V(100).Info("APIClient is nil")
+
+		return nil, fmt.Errorf("cannot create namespaced client from nil client")
+	}
+
+	if namespace == "" {
+		return nil, fmt.Errorf("namespaced client 'namespace' cannot be empty")
+	}
+
+	klog.V(100).Infof("Creating apiClient with default namespace %s", namespace)
+
+	namespaced := *settings
+	namespaced.defaultNamespace = namespace
+
+	return &namespaced, nil
+}
+
+// DefaultNamespace returns the default namespace of the client set using NamespacedClient, or an empty string if it
+// has none.
+func (settings *Settings) DefaultNamespace() string {
+	if settings == nil {
+		return ""
+	}
+
+	return settings.defaultNamespace
+}
+
+// ResolveNamespace returns nsname, or the default namespace of the client if nsname is empty. It is meant for builder
+// constructors taking a namespace and is safe to call on a nil client.
+func (settings *Settings) ResolveNamespace(nsname string) string {
+	if nsname != "" {
+		return nsname
+	}
+
+	return settings.DefaultNamespace()
+}
@@ -0,0 +1,33 @@
+package clients
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNamespacedClient(t *testing.T) {
+	testSettings := GetTestClients(TestClientParams{})
+
+	namespaced, err := testSettings.NamespacedClient("test-ns")
+	require.NoError(t, err)
+	assert.Equal(t, "test-ns", namespaced.DefaultNamespace())
+	assert.Equal(t, "test-ns", namespaced.ResolveNamespace(""))
+	assert.Equal(t, "other-ns", namespaced.ResolveNamespace("other-ns"))
+	assert.Equal(t, testSettings.Client, namespaced.Client)
+
+	// The original client must not get a default namespace.
+	assert.Empty(t, testSettings.DefaultNamespace())
+	assert.Empty(t, testSettings.ResolveNamespace(""))
+
+	_, err = testSettings.NamespacedClient("")
+	assert.EqualError(t, err, "namespaced client 'namespace' cannot be empty")
+
+	var nilSettings *Settings
+
+	_, err = nilSettings.NamespacedClient("test-ns")
+	assert.EqualError(t, err, "cannot create namespaced client from nil client")
+	assert.Empty(t, nilSettings.DefaultNamespace())
+	assert.Equal(t, "other-ns", nilSettings.ResolveNamespace("other-ns"))
+}
@@ -0,0 +1,141 @@
+package clients
+
+import (
+	"maps"
+	"slices"
+
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+)
+
+// Option configures how New, NewReduced and NewLazy connect to the cluster. Options override the settings of the
+// current context of the kubeconfig, or of the in-cluster config if no kubeconfig is used.
+type Option func(*clientOptions)
+
+// clientOptions holds the settings applied by the options.
+type clientOptions struct {
+	overrides clientcmd.ConfigOverrides
+}
+
+// WithCAFile verifies the certificate of the API server using the CA bundle at path rather than the CA of the
+// kubeconfig, such as when the cluster is reached through a re-encrypting proxy.
+func WithCAFile(path string) Option {
+	return func(options *clientOptions) {
+		options.overrides.ClusterInfo.CertificateAuthority = path
+	}
+}
+
+// WithInsecureSkipVerify disables verifying the certificate of the API server. Since verifying is disabled, any CA of
+// the kubeconfig is ignored. It should only be used for lab environments.
+func WithInsecureSkipVerify() Option {
+	return func(options *clientOptions) {
+		options.overrides.ClusterInfo.InsecureSkipTLSVerify = true
+	}
+}
+
+// WithTLSServerName verifies the certificate of the API server against serverName rather than the host of the API
+// server URL, such as when the cluster is reached through a proxy with a different hostname.
+func WithTLSServerName(serverName string) Option {
+	return func(options *clientOptions) {
+		options.overrides.ClusterInfo.TLSServerName = serverName
+	}
+}
+
+// WithServer connects to the API server at host, such as https://api.example.com:6443, rather than the server of the
+// kubeconfig. When no kubeconfig is given and KUBECONFIG is not set, the client is built from the options alone
+// instead of the in-cluster config, so combined with WithBearerToken or WithExecCredential and WithCAFile no
+// kubeconfig file is needed.
+func WithServer(host string) Option {
+	return func(options *clientOptions) {
+		options.overrides.ClusterInfo.Server = host
+	}
+}
+
+// WithBearerToken authenticates using the bearer token, such as a service account token, rather than the credentials
+// of the kubeconfig. Since client-go only sends credentials over TLS, the token is not used for http servers.
+func WithBearerToken(token string) Option {
+	return func(options *clientOptions) {
+		options.overrides.AuthInfo.Token = token
+	}
+}
+
+// WithExecCredential authenticates using the credentials returned by a client-go exec credential plugin, such as
+// those used to get tokens for hosted control planes or cloud providers, rather than the credentials of the
+// kubeconfig. The command is run with args and the environment variables in env added to the current environment, and
+// must print an ExecCredential of the client.authentication.k8s.io/v1 API. It is never given access to standard input.
+func WithExecCredential(command string, args []string, env map[string]string) Option {
+	return func(options *clientOptions) {
+		execConfig := &clientcmdapi.ExecConfig{
+			Command:         command,
+			Args:            args,
+			APIVersion:      "client.authentication.k8s.io/v1",
+			InteractiveMode: clientcmdapi.NeverExecInteractiveMode,
+		}
+
+		for _, name := range slices.Sorted(maps.Keys(env)) {
+			execConfig.Env = append(execConfig.Env, clientcmdapi.ExecEnvVar{Name: name, Value: env[name]})
+		}
+
+		options.overrides.AuthInfo.Exec = execConfig
+	}
+}
+
+// WithContext uses the context contextName of the kubeconfig rather than its current context, without changing the
+// kubeconfig. It has no effect when no kubeconfig is used. See also NewForContext.
+func WithContext(contextName string) Option {
+	return func(options *clientOptions) {
+		options.overrides.CurrentContext = contextName
+	}
+}
+
+// newClientOptions returns the settings applied by the options, skipping any nil option.
+func newClientOptions(options []Option) *clientOptions {
+	clientOptions := &clientOptions{}
+
+	for _, option := range options {
+		if option != nil {
+			option(clientOptions)
+		}
+	}
+
+	return clientOptions
+}
+
+// applyToRESTConfig applies the options to a config not loaded from a kubeconfig, such as the in-cluster config,
+// following the same rules as clientcmd does for kubeconfig overrides.
+func (options *clientOptions) applyToRESTConfig(config *rest.Config) {
+	clusterInfo := options.overrides.ClusterInfo
+
+	if clusterInfo.InsecureSkipTLSVerify || clusterInfo.CertificateAuthority != "" {
+		config.Insecure = clusterInfo.InsecureSkipTLSVerify
+		config.CAFile = clusterInfo.CertificateAuthority
+		config.CAData = nil
+	}
+
+	if clusterInfo.TLSServerName != "" {
+		config.ServerName = clusterInfo.TLSServerName
+	}
+
+	if clusterInfo.Server != "" {
+		config.Host = clusterInfo.Server
+	}
+
+	authInfo := options.overrides.AuthInfo
+
+	if authInfo.Token != "" {
+		config.BearerToken = authInfo.Token
+		config.BearerTokenFile = ""
+	}
+
+	if authInfo.Exec != nil {
+		config.ExecProvider = authInfo.Exec
+		config.BearerToken = ""
+		config.BearerTokenFile = ""
+	}
+}
+
+// hasServer returns whether the options provide the API server, so the client can be built without a kubeconfig.
+func (options *clientOptions) hasServer() bool {
+	return options.overrides.ClusterInfo.Server != ""
+}
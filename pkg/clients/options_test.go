@@ -0,0 +1,270 @@
+package clients
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/client-go/rest"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+)
+
+const testTLSKubeconfigTemplate = `apiVersion: v1
+kind: Config
+clusters:
+- name: test
+  cluster:
+    server: %s
+    certificate-authority: %s
+contexts:
+- name: test
+  context:
+    cluster: test
+    user: test
+current-context: test
+users:
+- name: test
+  user: {}
+`
+
+func TestNewWithTLSOptions(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		_, _ = writer.Write([]byte(`{"major":"1","minor":"34","gitVersion":"v1.34.0"}`))
+	}))
+	t.Cleanup(server.Close)
+
+	tempDir := t.TempDir()
+	caFile := filepath.Join(tempDir, "ca.crt")
+	require.NoError(t, os.WriteFile(caFile,
+		pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}), 0o600))
+
+	// The kubeconfig refers to a CA that did not sign the certificate of the server, as when a proxy re-encrypts.
+	wrongCAFile := filepath.Join(tempDir, "wrong-ca.crt")
+	require.NoError(t, os.WriteFile(wrongCAFile, newTestCACertificate(t), 0o600))
+
+	kubeconfig := filepath.Join(tempDir, "kubeconfig")
+	require.NoError(t, os.WriteFile(kubeconfig,
+		fmt.Appendf(nil, testTLSKubeconfigTemplate, server.URL, wrongCAFile), 0o600))
+
+	testCases := []struct {
+		name    string
+		options []Option
+		valid   bool
+	}{
+		{name: "kubeconfig CA", valid: false},
+		{name: "custom CA", options: []Option{WithCAFile(caFile)}, valid: true},
+		{name: "insecure", options: []Option{WithInsecureSkipVerify()}, valid: true},
+		// The test server certificate is valid for example.com.
+		{
+			name:    "custom CA and server name",
+			options: []Option{WithCAFile(caFile), WithTLSServerName("example.com")},
+			valid:   true,
+		},
+		{
+			name:    "custom CA and wrong server name",
+			options: []Option{WithCAFile(caFile), WithTLSServerName("other.com")},
+		},
+		{name: "nil option", options: []Option{nil, WithCAFile(caFile)}, valid: true},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testSettings := New(kubeconfig, testCase.options...)
+			require.NotNil(t, testSettings)
+
+			err := testSettings.Ping(context.TODO())
+			if testCase.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, "certificate")
+			}
+
+			lazySettings := NewLazy(kubeconfig, testCase.options...)
+			assert.Equal(t, testCase.valid, lazySettings.Ping(context.TODO()) == nil)
+		})
+	}
+}
+
+func TestNewWithKubeconfigList(t *testing.T) {
+	server := newTestAPIServer(t, "", "")
+	tempDir := t.TempDir()
+
+	// The first kubeconfig only selects the context defined by the second, so both must be merged.
+	firstKubeconfig := filepath.Join(tempDir, "first")
+	require.NoError(t, os.WriteFile(
+		firstKubeconfig, []byte("apiVersion: v1\nkind: Config\ncurrent-context: test\n"), 0o600))
+
+	secondKubeconfig := filepath.Join(tempDir, "second")
+	require.NoError(t, os.WriteFile(secondKubeconfig, fmt.Appendf(nil, testKubeconfigTemplate, server.URL), 0o600))
+
+	kubeconfigList := firstKubeconfig + string(filepath.ListSeparator) + secondKubeconfig
+	t.Setenv("KUBECONFIG", kubeconfigList)
+
+	testSettings := New("")
+	require.NotNil(t, testSettings)
+	assert.Equal(t, kubeconfigList, testSettings.KubeconfigPath)
+	assert.Equal(t, server.URL, testSettings.Config.Host)
+	assert.NoError(t, testSettings.Ping(context.TODO()))
+}
+
+func TestNewWithCredentialOptions(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		if request.Header.Get("Authorization") != "Bearer test-token" {
+			writer.WriteHeader(http.StatusUnauthorized)
+
+			return
+		}
+
+		_, _ = writer.Write([]byte(`{"major":"1","minor":"34","gitVersion":"v1.34.0"}`))
+	}))
+	t.Cleanup(server.Close)
+
+	tempDir := t.TempDir()
+	caFile := filepath.Join(tempDir, "ca.crt")
+	require.NoError(t, os.WriteFile(caFile,
+		pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}), 0o600))
+
+	kubeconfig := filepath.Join(tempDir, "kubeconfig")
+	require.NoError(t, os.WriteFile(kubeconfig,
+		fmt.Appendf(nil, testTLSKubeconfigTemplate, server.URL, caFile), 0o600))
+
+	// The plugin prints the token found in its environment, so the test also checks the environment is passed.
+	plugin := filepath.Join(tempDir, "plugin.sh")
+	require.NoError(t, os.WriteFile(plugin, []byte(`#!/bin/sh
+echo '{"apiVersion":"client.authentication.k8s.io/v1","kind":"ExecCredential","status":{"token":"'"$TEST_TOKEN"'"}}'
+`), 0o700))
+
+	testCases := []struct {
+		name       string
+		kubeconfig string
+		options    []Option
+		valid      bool
+	}{
+		{name: "kubeconfig credentials", kubeconfig: kubeconfig, valid: false},
+		{name: "bearer token", kubeconfig: kubeconfig, options: []Option{WithBearerToken("test-token")}, valid: true},
+		{
+			name:       "wrong bearer token",
+			kubeconfig: kubeconfig,
+			options:    []Option{WithBearerToken("wrong-token")},
+		},
+		{
+			name:       "exec credential",
+			kubeconfig: kubeconfig,
+			options: []Option{
+				WithExecCredential(plugin, nil, map[string]string{"TEST_TOKEN": "test-token"}),
+			},
+			valid: true,
+		},
+		{
+			name:    "server without kubeconfig",
+			options: []Option{WithServer(server.URL), WithCAFile(caFile), WithBearerToken("test-token")},
+			valid:   true,
+		},
+		{
+			name: "server without kubeconfig and exec credential",
+			options: []Option{
+				WithServer(server.URL),
+				WithCAFile(caFile),
+				WithExecCredential(plugin, nil, map[string]string{"TEST_TOKEN": "test-token"}),
+			},
+			valid: true,
+		},
+	}
+
+	t.Setenv("KUBECONFIG", "")
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testSettings := New(testCase.kubeconfig, testCase.options...)
+			require.NotNil(t, testSettings)
+			assert.Equal(t, testCase.kubeconfig, testSettings.KubeconfigPath)
+
+			err := testSettings.Ping(context.TODO())
+			if testCase.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, "provide credentials")
+			}
+
+			lazySettings := NewLazy(testCase.kubeconfig, testCase.options...)
+			assert.Equal(t, testCase.valid, lazySettings.Ping(context.TODO()) == nil)
+		})
+	}
+}
+
+func TestClientOptionsApplyToRESTConfig(t *testing.T) {
+	config := &rest.Config{TLSClientConfig: rest.TLSClientConfig{CAData: []byte("ca"), CAFile: "ca.crt"}}
+	newClientOptions([]Option{WithTLSServerName("api.example.com")}).applyToRESTConfig(config)
+
+	assert.Equal(t, "api.example.com", config.ServerName)
+	assert.Equal(t, []byte("ca"), config.CAData)
+
+	newClientOptions([]Option{WithInsecureSkipVerify()}).applyToRESTConfig(config)
+
+	assert.True(t, config.Insecure)
+	assert.Empty(t, config.CAFile)
+	assert.Nil(t, config.CAData)
+
+	newClientOptions([]Option{WithCAFile("custom.crt")}).applyToRESTConfig(config)
+
+	assert.False(t, config.Insecure)
+	assert.Equal(t, "custom.crt", config.CAFile)
+
+	config.BearerTokenFile = "/var/run/secrets/kubernetes.io/serviceaccount/token"
+	newClientOptions([]Option{WithServer("https://api.example.com:6443"), WithBearerToken("token")}).
+		applyToRESTConfig(config)
+
+	assert.Equal(t, "https://api.example.com:6443", config.Host)
+	assert.Equal(t, "token", config.BearerToken)
+	assert.Empty(t, config.BearerTokenFile)
+
+	newClientOptions([]Option{WithExecCredential("plugin", []string{"get-token"}, map[string]string{
+		"B": "2",
+		"A": "1",
+	})}).applyToRESTConfig(config)
+
+	require.NotNil(t, config.ExecProvider)
+	assert.Equal(t, "plugin", config.ExecProvider.Command)
+	assert.Equal(t, []string{"get-token"}, config.ExecProvider.Args)
+	assert.Equal(t, []clientcmdapi.ExecEnvVar{{Name: "A", Value: "1"}, {Name: "B", Value: "2"}},
+		config.ExecProvider.Env)
+	assert.Equal(t, clientcmdapi.NeverExecInteractiveMode, config.ExecProvider.InteractiveMode)
+	assert.Empty(t, config.BearerToken)
+}
+
+// newTestCACertificate returns a PEM encoded self-signed CA certificate unrelated to the httptest certificate.
+func newTestCACertificate(t *testing.T) []byte {
+	t.Helper()
+
+	privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test-ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+
+	certificate, err := x509.CreateCertificate(rand.Reader, template, template, &privateKey.PublicKey, privateKey)
+	require.NoError(t, err)
+
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certificate})
+}
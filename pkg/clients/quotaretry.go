@@ -0,0 +1,48 @@
+package clients
+
+import (
+	"fmt"
+	"time"
+
+	"k8s.io/klog/v2"
+)
+
+// QuotaRetryClient returns a copy of the client whose builders retry create requests rejected by quota admission for
+// up to timeout, with exponential backoff capped at thirty seconds. It suits scale suites that intentionally run close
+// to ResourceQuota limits:
+//
+//	scaleClient, err := APIClient.QuotaRetryClient(5 * time.Minute)
+//	_, err = configmap.NewBuilder(scaleClient, "config", "scale-ns").Create()
+//
+// Requests are retried while a quota, including quotas scoped to priority classes, is exhausted or not yet computed,
+// or while the API server is throttling requests. Other errors, such as validation errors, are returned immediately.
+// Only builders using the common create implementation retry. The copy shares the underlying clients, scheme and
+// cached reads of the original client, which is left unchanged.
+func (settings *Settings) QuotaRetryClient(timeout time.Duration) (*Settings, error) {
+	if settings == nil {
+		klog.V(100).Info("APIClient is nil")
+
+		return nil, fmt.Errorf("cannot create quota retry client from nil client")
+	}
+
+	if timeout <= 0 {
+		return nil, fmt.Errorf("quota retry client 'timeout' must be positive")
+	}
+
+	klog.V(100).Infof("Creating apiClient retrying creates rejected by quota admission for %s", timeout)
+
+	quotaRetry := *settings
+	quotaRetry.quotaRetryTimeout = timeout
+
+	return &quotaRetry, nil
+}
+
+// QuotaRetryTimeout returns how long builders retry create requests rejected by quota admission, set using
+// QuotaRetryClient, or zero if they do not retry.
+func (settings *Settings) QuotaRetryTimeout() time.Duration {
+	if settings == nil {
+		return 0
+	}
+
+	return settings.quotaRetryTimeout
+}
@@ -0,0 +1,30 @@
+package clients
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQuotaRetryClient(t *testing.T) {
+	testSettings := GetTestClients(TestClientParams{})
+
+	quotaRetry, err := testSettings.QuotaRetryClient(time.Minute)
+	require.NoError(t, err)
+	assert.Equal(t, time.Minute, quotaRetry.QuotaRetryTimeout())
+	assert.Equal(t, testSettings.Client, quotaRetry.Client)
+
+	// The original client must not retry.
+	assert.Zero(t, testSettings.QuotaRetryTimeout())
+
+	_, err = testSettings.QuotaRetryClient(0)
+	assert.EqualError(t, err, "quota retry client 'timeout' must be positive")
+
+	var nilSettings *Settings
+
+	_, err = nilSettings.QuotaRetryClient(time.Minute)
+	assert.EqualError(t, err, "cannot create quota retry client from nil client")
+	assert.Zero(t, nilSettings.QuotaRetryTimeout())
+}
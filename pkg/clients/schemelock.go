@@ -0,0 +1,28 @@
+package clients
+
+import (
+	"sync"
+
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// schemeLock serializes running scheme attachers on the schemes of a client, since runtime.Scheme is not safe for
+// concurrent registration. Attachers are run on every call rather than cached: registering types already known to a
+// scheme is cheap and harmless, while telling two attachers apart would require an identity that function values do
+// not have.
+type schemeLock struct {
+	mutex sync.Mutex
+}
+
+// newSchemeLock returns a new schemeLock.
+func newSchemeLock() *schemeLock {
+	return &schemeLock{}
+}
+
+// attach runs the attacher on the scheme while holding the lock.
+func (lock *schemeLock) attach(scheme *runtime.Scheme, attacher SchemeAttacher) error {
+	lock.mutex.Lock()
+	defer lock.mutex.Unlock()
+
+	return attacher(scheme)
+}
@@ -0,0 +1,100 @@
+package clients
+
+import (
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+var (
+	testSchemeGroupA = schema.GroupVersion{Group: "a.example.com", Version: "v1"}
+	testSchemeGroupB = schema.GroupVersion{Group: "b.example.com", Version: "v1"}
+)
+
+// testSchemeBuilder counts how many times its AddToScheme is run.
+type testSchemeBuilder struct {
+	groupVersion schema.GroupVersion
+	mutex        sync.Mutex
+	calls        int
+	err          error
+}
+
+func (builder *testSchemeBuilder) AddToScheme(scheme *runtime.Scheme) error {
+	builder.mutex.Lock()
+	defer builder.mutex.Unlock()
+
+	builder.calls++
+
+	if builder.err != nil {
+		return builder.err
+	}
+
+	scheme.AddKnownTypeWithName(builder.groupVersion.WithKind("Dummy"), &runtime.Unknown{})
+
+	return nil
+}
+
+func TestAttachSchemeRepeatedly(t *testing.T) {
+	builderA := &testSchemeBuilder{groupVersion: testSchemeGroupA}
+	builderB := &testSchemeBuilder{groupVersion: testSchemeGroupB}
+
+	testSettings := GetTestClients(TestClientParams{})
+
+	for range 3 {
+		assert.NoError(t, testSettings.AttachScheme(builderA.AddToScheme))
+		assert.NoError(t, testSettings.AttachScheme(builderB.AddToScheme))
+	}
+
+	assert.Equal(t, 3, builderA.calls)
+	assert.Equal(t, 3, builderB.calls)
+	assert.True(t, testSettings.Scheme().Recognizes(testSchemeGroupA.WithKind("Dummy")))
+	assert.True(t, testSettings.Scheme().Recognizes(testSchemeGroupB.WithKind("Dummy")))
+}
+
+func TestAttachSchemeReturnsAttacherError(t *testing.T) {
+	failingBuilder := &testSchemeBuilder{groupVersion: testSchemeGroupA, err: errors.New("attach failed")}
+
+	testSettings := GetTestClients(TestClientParams{})
+
+	assert.EqualError(t, testSettings.AttachScheme(failingBuilder.AddToScheme), "attach failed")
+
+	failingBuilder.err = nil
+
+	assert.NoError(t, testSettings.AttachScheme(failingBuilder.AddToScheme))
+	assert.True(t, testSettings.Scheme().Recognizes(testSchemeGroupA.WithKind("Dummy")))
+}
+
+func TestAttachSchemeConcurrently(t *testing.T) {
+	testSettings := GetTestClients(TestClientParams{})
+
+	var waitGroup sync.WaitGroup
+
+	for index := range 20 {
+		groupVersion := schema.GroupVersion{Group: "concurrent.example.com", Version: "v" + string(rune('a'+index))}
+		builder := &testSchemeBuilder{groupVersion: groupVersion}
+
+		waitGroup.Go(func() {
+			assert.NoError(t, testSettings.AttachScheme(builder.AddToScheme))
+		})
+	}
+
+	waitGroup.Wait()
+
+	for index := range 20 {
+		groupVersion := schema.GroupVersion{Group: "concurrent.example.com", Version: "v" + string(rune('a'+index))}
+		assert.True(t, testSettings.Scheme().Recognizes(groupVersion.WithKind("Dummy")))
+	}
+}
+
+func TestAttachSchemeWithoutScheme(t *testing.T) {
+	noopAttacher := func(*runtime.Scheme) error { return nil }
+
+	var nilSettings *Settings
+
+	assert.EqualError(t, nilSettings.AttachScheme(noopAttacher), "cannot add scheme to nil client")
+	assert.EqualError(t, (&Settings{}).AttachScheme(noopAttacher), "cannot add scheme to client without a scheme")
+}
@@ -0,0 +1,280 @@
+package clients
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/clock"
+	runtimeClient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+)
+
+// StatusSimulator simulates a controller reconciling objects created through the fake runtime client returned by
+// GetTestClients. Once After has elapsed since an object matching Matches was created, Mutate is applied to the stored
+// object the next time it is read using Get or List. This allows testing WaitFor* helpers without writing interceptors
+// for each test.
+type StatusSimulator struct {
+	// Matches selects the created objects the simulator applies to. If nil, all created objects match.
+	Matches func(obj runtimeClient.Object) bool
+	// After is how long after creation the mutation becomes visible. It is measured using TestClientParams.Clock.
+	After time.Duration
+	// Mutate modifies the object, typically its status. It is called with a fresh copy of the stored object.
+	Mutate func(obj runtimeClient.Object)
+}
+
+// MatchName returns a StatusSimulator.Matches function selecting objects with the provided name and namespace. Use an
+// empty nsname for cluster-scoped objects.
+func MatchName(name, nsname string) func(obj runtimeClient.Object) bool {
+	return func(obj runtimeClient.Object) bool {
+		return obj.GetName() == name && obj.GetNamespace() == nsname
+	}
+}
+
+// MatchType returns a StatusSimulator.Matches function selecting objects with the same Go type as sample.
+func MatchType(sample runtimeClient.Object) func(obj runtimeClient.Object) bool {
+	sampleType := fmt.Sprintf("%T", sample)
+
+	return func(obj runtimeClient.Object) bool {
+		return fmt.Sprintf("%T", obj) == sampleType
+	}
+}
+
+// SetStatusCondition returns a StatusSimulator.Mutate function that adds condition to status.conditions of the object,
+// replacing any existing condition of the same type. It works for any object whose conditions use the type, status,
+// reason, message, and lastTransitionTime fields of metav1.Condition. If the LastTransitionTime of the condition is
+// zero, the current time is used.
+func SetStatusCondition(condition metav1.Condition) func(obj runtimeClient.Object) {
+	return func(obj runtimeClient.Object) {
+		if condition.LastTransitionTime.IsZero() {
+			condition.LastTransitionTime = metav1.Now()
+		}
+
+		content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+		if err != nil {
+			klog.V(100).Infof("Failed to convert object %s to unstructured: %v", obj.GetName(), err)
+
+			return
+		}
+
+		conditionContent, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&condition)
+		if err != nil {
+			klog.V(100).Infof("Failed to convert condition %s to unstructured: %v", condition.Type, err)
+
+			return
+		}
+
+		conditions, _, _ := unstructured.NestedSlice(content, "status", "conditions")
+		conditions = slices.DeleteFunc(conditions, func(existing any) bool {
+			existingMap, ok := existing.(map[string]any)
+
+			return ok && existingMap["type"] == condition.Type
+		})
+		conditions = append(conditions, conditionContent)
+
+		err = unstructured.SetNestedSlice(content, conditions, "status", "conditions")
+		if err != nil {
+			klog.V(100).Infof("Failed to set conditions of object %s: %v", obj.GetName(), err)
+
+			return
+		}
+
+		err = runtime.DefaultUnstructuredConverter.FromUnstructured(content, obj)
+		if err != nil {
+			klog.V(100).Infof("Failed to convert object %s from unstructured: %v", obj.GetName(), err)
+		}
+	}
+}
+
+// TestClock is a clock for TestClientParams.Clock that only moves when told to, allowing tests to travel forward in
+// time instead of sleeping until a StatusSimulator applies. It is safe for concurrent use.
+type TestClock struct {
+	mutex sync.RWMutex
+	now   time.Time
+}
+
+var _ clock.PassiveClock = (*TestClock)(nil)
+
+// NewTestClock returns a TestClock set to the provided time.
+func NewTestClock(now time.Time) *TestClock {
+	return &TestClock{now: now}
+}
+
+// Now returns the current time of the clock.
+func (testClock *TestClock) Now() time.Time {
+	testClock.mutex.RLock()
+	defer testClock.mutex.RUnlock()
+
+	return testClock.now
+}
+
+// Since returns the time elapsed since t according to the clock.
+func (testClock *TestClock) Since(t time.Time) time.Duration {
+	return testClock.Now().Sub(t)
+}
+
+// Step moves the clock forward by duration.
+func (testClock *TestClock) Step(duration time.Duration) {
+	testClock.mutex.Lock()
+	defer testClock.mutex.Unlock()
+
+	testClock.now = testClock.now.Add(duration)
+}
+
+// pendingSimulation is a StatusSimulator waiting to be applied to a created object.
+type pendingSimulation struct {
+	gvk       schema.GroupVersionKind
+	key       runtimeClient.ObjectKey
+	readyAt   time.Time
+	simulator StatusSimulator
+}
+
+// statusSimulation tracks the simulations pending for objects created through the fake runtime client.
+type statusSimulation struct {
+	mutex      sync.Mutex
+	clock      clock.PassiveClock
+	simulators []StatusSimulator
+	pending    []pendingSimulation
+}
+
+// withStatusSimulators returns funcs with the Create, Get, and List functions wrapped to apply simulators. Existing
+// functions in funcs are still called.
+func withStatusSimulators(
+	funcs interceptor.Funcs, simulators []StatusSimulator, passiveClock clock.PassiveClock) interceptor.Funcs {
+	if passiveClock == nil {
+		passiveClock = clock.RealClock{}
+	}
+
+	simulation := &statusSimulation{clock: passiveClock, simulators: simulators}
+
+	createFunc, getFunc, listFunc := funcs.Create, funcs.Get, funcs.List
+
+	funcs.Create = func(ctx context.Context, client runtimeClient.WithWatch,
+		obj runtimeClient.Object, opts ...runtimeClient.CreateOption) error {
+		var err error
+
+		if createFunc != nil {
+			err = createFunc(ctx, client, obj, opts...)
+		} else {
+			err = client.Create(ctx, obj, opts...)
+		}
+
+		if err == nil {
+			simulation.track(client.Scheme(), obj)
+		}
+
+		return err
+	}
+
+	funcs.Get = func(ctx context.Context, client runtimeClient.WithWatch,
+		key runtimeClient.ObjectKey, obj runtimeClient.Object, opts ...runtimeClient.GetOption) error {
+		simulation.apply(ctx, client, func(pending pendingSimulation) bool {
+			return pending.key == key
+		})
+
+		if getFunc != nil {
+			return getFunc(ctx, client, key, obj, opts...)
+		}
+
+		return client.Get(ctx, key, obj, opts...)
+	}
+
+	funcs.List = func(ctx context.Context, client runtimeClient.WithWatch,
+		list runtimeClient.ObjectList, opts ...runtimeClient.ListOption) error {
+		simulation.apply(ctx, client, func(pendingSimulation) bool { return true })
+
+		if listFunc != nil {
+			return listFunc(ctx, client, list, opts...)
+		}
+
+		return client.List(ctx, list, opts...)
+	}
+
+	return funcs
+}
+
+// track records the simulators matching obj as pending.
+func (simulation *statusSimulation) track(scheme *runtime.Scheme, obj runtimeClient.Object) {
+	gvk, err := apiutil.GVKForObject(obj, scheme)
+	if err != nil {
+		klog.V(100).Infof("Failed to get GVK of created object %s: %v", obj.GetName(), err)
+
+		return
+	}
+
+	simulation.mutex.Lock()
+	defer simulation.mutex.Unlock()
+
+	for _, simulator := range simulation.simulators {
+		if simulator.Mutate == nil || (simulator.Matches != nil && !simulator.Matches(obj)) {
+			continue
+		}
+
+		simulation.pending = append(simulation.pending, pendingSimulation{
+			gvk:       gvk,
+			key:       runtimeClient.ObjectKeyFromObject(obj),
+			readyAt:   simulation.clock.Now().Add(simulator.After),
+			simulator: simulator,
+		})
+	}
+}
+
+// apply applies and removes the pending simulations that are due and selected by filter.
+func (simulation *statusSimulation) apply(
+	ctx context.Context, client runtimeClient.WithWatch, filter func(pendingSimulation) bool) {
+	simulation.mutex.Lock()
+	defer simulation.mutex.Unlock()
+
+	now := simulation.clock.Now()
+
+	simulation.pending = slices.DeleteFunc(simulation.pending, func(pending pendingSimulation) bool {
+		if !filter(pending) || now.Before(pending.readyAt) {
+			return false
+		}
+
+		err := applySimulation(ctx, client, pending)
+		if err != nil {
+			klog.V(100).Infof("Failed to simulate status of %s %s: %v", pending.gvk.Kind, pending.key, err)
+		}
+
+		return true
+	})
+}
+
+// applySimulation mutates the stored object of pending and writes it back, using the status subresource if the fake
+// client has it enabled for the object.
+func applySimulation(ctx context.Context, client runtimeClient.WithWatch, pending pendingSimulation) error {
+	runtimeObj, err := client.Scheme().New(pending.gvk)
+	if err != nil {
+		return err
+	}
+
+	obj, ok := runtimeObj.(runtimeClient.Object)
+	if !ok {
+		return fmt.Errorf("type %T is not a client object", runtimeObj)
+	}
+
+	err = client.Get(ctx, pending.key, obj)
+	if err != nil {
+		return err
+	}
+
+	pending.simulator.Mutate(obj)
+
+	err = client.Status().Update(ctx, obj)
+	if k8serrors.IsNotFound(err) {
+		// Without the status subresource enabled, the fake client stores the status with the rest of the object.
+		return client.Update(ctx, obj)
+	}
+
+	return err
+}
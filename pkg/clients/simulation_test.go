@@ -0,0 +1,166 @@
+package clients
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	runtimeClient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+)
+
+const defaultSimulatedName = "test-simulated"
+
+func TestStatusSimulatorGet(t *testing.T) {
+	testCases := []struct {
+		matches       func(obj runtimeClient.Object) bool
+		step          time.Duration
+		expectApplied bool
+	}{
+		{
+			matches:       MatchName(defaultSimulatedName, ""),
+			step:          5 * time.Second,
+			expectApplied: true,
+		},
+		{
+			matches:       MatchName(defaultSimulatedName, ""),
+			step:          time.Second,
+			expectApplied: false,
+		},
+		{
+			matches:       MatchName("other-name", ""),
+			step:          5 * time.Second,
+			expectApplied: false,
+		},
+		{
+			matches:       MatchType(&corev1.Namespace{}),
+			step:          5 * time.Second,
+			expectApplied: true,
+		},
+		{
+			matches:       MatchType(&corev1.ConfigMap{}),
+			step:          5 * time.Second,
+			expectApplied: false,
+		},
+		{
+			matches:       nil,
+			step:          5 * time.Second,
+			expectApplied: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testClock := NewTestClock(time.Now())
+		testSettings := GetTestClients(TestClientParams{
+			StatusSimulators: []StatusSimulator{{
+				Matches: testCase.matches,
+				After:   5 * time.Second,
+				Mutate:  SetStatusCondition(metav1.Condition{Type: "Ready", Status: metav1.ConditionTrue}),
+			}},
+			Clock: testClock,
+		})
+
+		err := testSettings.Create(context.TODO(), buildDummySimulatedNamespace())
+		assert.Nil(t, err)
+
+		namespace := &corev1.Namespace{}
+		err = testSettings.Get(context.TODO(), runtimeClient.ObjectKey{Name: defaultSimulatedName}, namespace)
+		assert.Nil(t, err)
+		assert.Empty(t, namespace.Status.Conditions)
+
+		testClock.Step(testCase.step)
+
+		err = testSettings.Get(context.TODO(), runtimeClient.ObjectKey{Name: defaultSimulatedName}, namespace)
+		assert.Nil(t, err)
+
+		if testCase.expectApplied {
+			assert.Len(t, namespace.Status.Conditions, 1)
+			assert.Equal(t, corev1.NamespaceConditionType("Ready"), namespace.Status.Conditions[0].Type)
+			assert.Equal(t, corev1.ConditionTrue, namespace.Status.Conditions[0].Status)
+		} else {
+			assert.Empty(t, namespace.Status.Conditions)
+		}
+	}
+}
+
+func TestStatusSimulatorList(t *testing.T) {
+	testSettings := GetTestClients(TestClientParams{
+		StatusSimulators: []StatusSimulator{{
+			Mutate: SetStatusCondition(metav1.Condition{Type: "Ready", Status: metav1.ConditionTrue}),
+		}},
+	})
+
+	err := testSettings.Create(context.TODO(), buildDummySimulatedNamespace())
+	assert.Nil(t, err)
+
+	namespaceList := &corev1.NamespaceList{}
+	err = testSettings.List(context.TODO(), namespaceList)
+	assert.Nil(t, err)
+	assert.Len(t, namespaceList.Items, 1)
+	assert.Len(t, namespaceList.Items[0].Status.Conditions, 1)
+}
+
+func TestStatusSimulatorKeepsInterceptors(t *testing.T) {
+	var createCalls int
+
+	testSettings := GetTestClients(TestClientParams{
+		InterceptorFuncs: interceptor.Funcs{
+			Create: func(ctx context.Context, client runtimeClient.WithWatch, obj runtimeClient.Object,
+				opts ...runtimeClient.CreateOption) error {
+				createCalls++
+
+				return client.Create(ctx, obj, opts...)
+			},
+		},
+		StatusSimulators: []StatusSimulator{{
+			Mutate: SetStatusCondition(metav1.Condition{Type: "Ready", Status: metav1.ConditionTrue}),
+		}},
+	})
+
+	err := testSettings.Create(context.TODO(), buildDummySimulatedNamespace())
+	assert.Nil(t, err)
+	assert.Equal(t, 1, createCalls)
+
+	namespace := &corev1.Namespace{}
+	err = testSettings.Get(context.TODO(), runtimeClient.ObjectKey{Name: defaultSimulatedName}, namespace)
+	assert.Nil(t, err)
+	assert.Len(t, namespace.Status.Conditions, 1)
+}
+
+func TestSetStatusCondition(t *testing.T) {
+	namespace := buildDummySimulatedNamespace()
+	namespace.Status.Conditions = []corev1.NamespaceCondition{
+		{Type: "Ready", Status: corev1.ConditionFalse},
+		{Type: "Other", Status: corev1.ConditionTrue},
+	}
+
+	SetStatusCondition(metav1.Condition{Type: "Ready", Status: metav1.ConditionTrue, Reason: "Simulated"})(namespace)
+
+	assert.Len(t, namespace.Status.Conditions, 2)
+	assert.Equal(t, corev1.NamespaceConditionType("Other"), namespace.Status.Conditions[0].Type)
+	assert.Equal(t, corev1.ConditionTrue, namespace.Status.Conditions[1].Status)
+	assert.Equal(t, "Simulated", namespace.Status.Conditions[1].Reason)
+	assert.False(t, namespace.Status.Conditions[1].LastTransitionTime.IsZero())
+}
+
+func TestTestClock(t *testing.T) {
+	start := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+	testClock := NewTestClock(start)
+
+	assert.Equal(t, start, testClock.Now())
+
+	testClock.Step(time.Minute)
+	assert.Equal(t, start.Add(time.Minute), testClock.Now())
+	assert.Equal(t, time.Minute, testClock.Since(start))
+}
+
+func buildDummySimulatedNamespace() *corev1.Namespace {
+	return &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: defaultSimulatedName,
+		},
+	}
+}
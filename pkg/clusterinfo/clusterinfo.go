@@ -0,0 +1,297 @@
+package clusterinfo
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	configv1 "github.com/openshift/api/config/v1"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clusterversion"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/infrastructure"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/network"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/nodes"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/olm"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/klog/v2"
+)
+
+const (
+	// nodeRoleLabelPrefix is the prefix of the labels used to assign roles to nodes.
+	nodeRoleLabelPrefix = "node-role.kubernetes.io/"
+	// RoleControlPlane is the role of control plane nodes.
+	RoleControlPlane = "control-plane"
+	// RoleMaster is the legacy role of control plane nodes.
+	RoleMaster = "master"
+	// RoleWorker is the role of worker nodes.
+	RoleWorker = "worker"
+)
+
+// Snapshot is a structured summary of a cluster at the time it was collected. It can be serialized into test reports
+// or used to decide which tests apply to the cluster.
+type Snapshot struct {
+	// Version is the desired OpenShift version of the cluster, such as 4.17.1.
+	Version string `json:"version"`
+	// Channel is the update channel of the cluster. It is empty if no channel is set.
+	Channel string `json:"channel,omitempty"`
+	// Platform is the infrastructure platform the cluster runs on, such as BareMetal or None.
+	Platform configv1.PlatformType `json:"platform"`
+	// ControlPlaneTopology is the expected topology of the control plane nodes.
+	ControlPlaneTopology configv1.TopologyMode `json:"controlPlaneTopology"`
+	// InfrastructureTopology is the expected topology of the infrastructure workloads.
+	InfrastructureTopology configv1.TopologyMode `json:"infrastructureTopology"`
+	// NetworkType is the cluster network plugin, such as OVNKubernetes.
+	NetworkType string `json:"networkType"`
+	// Nodes are the nodes of the cluster, sorted by name.
+	Nodes []NodeInfo `json:"nodes"`
+	// Operators are the operators installed through OLM, sorted by namespace and name.
+	Operators []OperatorInfo `json:"operators"`
+}
+
+// NodeInfo is the summary of a single node in a Snapshot.
+type NodeInfo struct {
+	// Name is the name of the node.
+	Name string `json:"name"`
+	// Roles are the roles assigned to the node through node-role.kubernetes.io labels, sorted alphabetically.
+	Roles []string `json:"roles"`
+	// Ready is whether the node has the Ready condition set to True.
+	Ready bool `json:"ready"`
+	// KubeletVersion is the version of the kubelet running on the node.
+	KubeletVersion string `json:"kubeletVersion"`
+	// OSImage is the operating system image reported by the node.
+	OSImage string `json:"osImage"`
+}
+
+// OperatorInfo is the summary of a single OLM operator in a Snapshot.
+type OperatorInfo struct {
+	// Name is the name of the ClusterServiceVersion of the operator.
+	Name string `json:"name"`
+	// Namespace is the namespace the operator is installed in.
+	Namespace string `json:"namespace"`
+	// Version is the version of the operator from its ClusterServiceVersion.
+	Version string `json:"version"`
+	// Phase is the phase of the ClusterServiceVersion, such as Succeeded.
+	Phase string `json:"phase"`
+}
+
+// Collect gathers a Snapshot from the cluster. ClusterServiceVersions copied into every namespace by OLM are skipped
+// so each operator is only reported once, in the namespace it was installed in. If the apiClient is in reduced mode,
+// the fields that come from the OpenShift config APIs are left empty and the topology is inferred from the number of
+// nodes.
+func Collect(apiClient *clients.Settings) (*Snapshot, error) {
+	if apiClient == nil {
+		klog.V(100).Info("The apiClient of the cluster info is nil")
+
+		return nil, fmt.Errorf("clusterinfo 'apiClient' cannot be nil")
+	}
+
+	klog.V(100).Info("Collecting cluster info snapshot")
+
+	snapshot := &Snapshot{}
+
+	var err error
+
+	snapshot.Nodes, err = collectNodes(apiClient)
+	if err != nil {
+		return nil, err
+	}
+
+	if apiClient.IsReduced() {
+		klog.V(100).Info("The apiClient is in reduced mode, skipping the OpenShift config APIs")
+
+		// Without the Infrastructure object, the topology can only be inferred from the nodes.
+		if len(snapshot.Nodes) == 1 {
+			snapshot.ControlPlaneTopology = configv1.SingleReplicaTopologyMode
+			snapshot.InfrastructureTopology = configv1.SingleReplicaTopologyMode
+		}
+	} else if err = collectConfig(apiClient, snapshot); err != nil {
+		return nil, err
+	}
+
+	snapshot.Operators, err = collectOperators(apiClient)
+	if err != nil {
+		return nil, err
+	}
+
+	return snapshot, nil
+}
+
+// IsSNO returns whether the snapshot is of a single node cluster.
+func (snapshot *Snapshot) IsSNO() bool {
+	if snapshot == nil {
+		return false
+	}
+
+	return snapshot.ControlPlaneTopology == configv1.SingleReplicaTopologyMode
+}
+
+// NodesWithRole returns the nodes in the snapshot that have the provided role. Since control plane nodes may be labeled
+// with either the control-plane or the legacy master role, both roles match nodes with either label.
+func (snapshot *Snapshot) NodesWithRole(role string) []NodeInfo {
+	if snapshot == nil {
+		return nil
+	}
+
+	roles := []string{role}
+	if role == RoleControlPlane || role == RoleMaster {
+		roles = []string{RoleControlPlane, RoleMaster}
+	}
+
+	var matching []NodeInfo
+
+	for _, node := range snapshot.Nodes {
+		if slices.ContainsFunc(node.Roles, func(nodeRole string) bool { return slices.Contains(roles, nodeRole) }) {
+			matching = append(matching, node)
+		}
+	}
+
+	return matching
+}
+
+// GetOperator returns the first operator whose ClusterServiceVersion name starts with the provided prefix, such as
+// sriov-network-operator, and whether one was found. Matching on the prefix allows looking up operators without knowing
+// their exact version.
+func (snapshot *Snapshot) GetOperator(namePrefix string) (OperatorInfo, bool) {
+	if snapshot == nil {
+		return OperatorInfo{}, false
+	}
+
+	for _, operator := range snapshot.Operators {
+		if strings.HasPrefix(operator.Name, namePrefix) {
+			return operator, true
+		}
+	}
+
+	return OperatorInfo{}, false
+}
+
+// collectConfig fills in the fields of the snapshot that come from the OpenShift config APIs.
+func collectConfig(apiClient *clients.Settings, snapshot *Snapshot) error {
+	clusterVersion, err := clusterversion.Pull(apiClient)
+	if err != nil {
+		return fmt.Errorf("failed to collect cluster version: %w", err)
+	}
+
+	snapshot.Version = clusterVersion.Object.Status.Desired.Version
+	snapshot.Channel = clusterVersion.Object.Spec.Channel
+
+	infra, err := infrastructure.Pull(apiClient)
+	if err != nil {
+		return fmt.Errorf("failed to collect infrastructure: %w", err)
+	}
+
+	snapshot.Platform = getPlatform(infra.Object)
+	snapshot.ControlPlaneTopology = infra.Object.Status.ControlPlaneTopology
+	snapshot.InfrastructureTopology = infra.Object.Status.InfrastructureTopology
+
+	networkConfig, err := network.PullConfig(apiClient)
+	if err != nil {
+		return fmt.Errorf("failed to collect network config: %w", err)
+	}
+
+	snapshot.NetworkType = networkConfig.Object.Status.NetworkType
+	if snapshot.NetworkType == "" {
+		snapshot.NetworkType = networkConfig.Object.Spec.NetworkType
+	}
+
+	return nil
+}
+
+// collectNodes summarizes every node on the cluster.
+func collectNodes(apiClient *clients.Settings) ([]NodeInfo, error) {
+	nodeBuilders, err := nodes.List(apiClient)
+	if err != nil {
+		return nil, fmt.Errorf("failed to collect nodes: %w", err)
+	}
+
+	nodeInfos := make([]NodeInfo, 0, len(nodeBuilders))
+
+	for _, nodeBuilder := range nodeBuilders {
+		node := nodeBuilder.Object
+
+		nodeInfos = append(nodeInfos, NodeInfo{
+			Name:           node.Name,
+			Roles:          getNodeRoles(node),
+			Ready:          isNodeReady(node),
+			KubeletVersion: node.Status.NodeInfo.KubeletVersion,
+			OSImage:        node.Status.NodeInfo.OSImage,
+		})
+	}
+
+	slices.SortFunc(nodeInfos, func(a, b NodeInfo) int { return strings.Compare(a.Name, b.Name) })
+
+	return nodeInfos, nil
+}
+
+// collectOperators summarizes every ClusterServiceVersion on the cluster, skipping the copies OLM places in other
+// namespaces.
+func collectOperators(apiClient *clients.Settings) ([]OperatorInfo, error) {
+	csvBuilders, err := olm.ListClusterServiceVersionInAllNamespaces(apiClient)
+	if err != nil {
+		return nil, fmt.Errorf("failed to collect operators: %w", err)
+	}
+
+	operatorInfos := make([]OperatorInfo, 0, len(csvBuilders))
+
+	for _, csvBuilder := range csvBuilders {
+		csv := csvBuilder.Object
+
+		if csv.IsCopied() {
+			continue
+		}
+
+		operatorInfos = append(operatorInfos, OperatorInfo{
+			Name:      csv.Name,
+			Namespace: csv.Namespace,
+			Version:   csv.Spec.Version.String(),
+			Phase:     string(csv.Status.Phase),
+		})
+	}
+
+	slices.SortFunc(operatorInfos, func(a, b OperatorInfo) int {
+		if namespaceOrder := strings.Compare(a.Namespace, b.Namespace); namespaceOrder != 0 {
+			return namespaceOrder
+		}
+
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	return operatorInfos, nil
+}
+
+// getPlatform returns the platform type of the infrastructure, preferring the platform status over the deprecated
+// platform field.
+func getPlatform(infra *configv1.Infrastructure) configv1.PlatformType {
+	if infra.Status.PlatformStatus != nil && infra.Status.PlatformStatus.Type != "" {
+		return infra.Status.PlatformStatus.Type
+	}
+
+	//nolint:staticcheck // The deprecated field is still set on clusters installed before the platform status existed.
+	return infra.Status.Platform
+}
+
+// getNodeRoles returns the sorted roles of the node from its node-role.kubernetes.io labels.
+func getNodeRoles(node *corev1.Node) []string {
+	var roles []string
+
+	for label := range node.Labels {
+		if role, found := strings.CutPrefix(label, nodeRoleLabelPrefix); found && role != "" {
+			roles = append(roles, role)
+		}
+	}
+
+	slices.Sort(roles)
+
+	return roles
+}
+
+// isNodeReady returns whether the node has the Ready condition set to True.
+func isNodeReady(node *corev1.Node) bool {
+	for _, condition := range node.Status.Conditions {
+		if condition.Type == corev1.NodeReady {
+			return condition.Status == corev1.ConditionTrue
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,209 @@
+package clusterinfo
+
+import (
+	"testing"
+
+	"github.com/blang/semver/v4"
+	configv1 "github.com/openshift/api/config/v1"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	oplmV1alpha1 "github.com/rh-ecosystem-edge/eco-goinfra/pkg/schemes/olm/operators/v1alpha1"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/schemes/olm/version"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var testSchemes = []clients.SchemeAttacher{
+	configv1.Install,
+	oplmV1alpha1.AddToScheme,
+}
+
+func TestCollect(t *testing.T) {
+	testCases := []struct {
+		runtimeObjects []runtime.Object
+		client         bool
+		expectedError  string
+	}{
+		{
+			runtimeObjects: buildDummyClusterObjects(),
+			client:         true,
+		},
+		{
+			runtimeObjects: buildDummyClusterObjects()[1:],
+			client:         true,
+			expectedError:  "failed to collect cluster version: clusterversion object version does not exist",
+		},
+		{
+			runtimeObjects: buildDummyClusterObjects()[:2],
+			client:         true,
+			expectedError:  "failed to collect network config: network.config object cluster does not exist",
+		},
+		{
+			client:        false,
+			expectedError: "clusterinfo 'apiClient' cannot be nil",
+		},
+	}
+
+	for _, testCase := range testCases {
+		var testSettings *clients.Settings
+
+		if testCase.client {
+			testSettings = clients.GetTestClients(clients.TestClientParams{
+				K8sMockObjects:  testCase.runtimeObjects,
+				SchemeAttachers: testSchemes,
+			})
+		}
+
+		snapshot, err := Collect(testSettings)
+
+		if testCase.expectedError != "" {
+			assert.EqualError(t, err, testCase.expectedError)
+			assert.Nil(t, snapshot)
+
+			continue
+		}
+
+		assert.NoError(t, err)
+		assert.Equal(t, &Snapshot{
+			Version:                "4.17.1",
+			Channel:                "stable-4.17",
+			Platform:               configv1.BareMetalPlatformType,
+			ControlPlaneTopology:   configv1.HighlyAvailableTopologyMode,
+			InfrastructureTopology: configv1.HighlyAvailableTopologyMode,
+			NetworkType:            "OVNKubernetes",
+			Nodes: []NodeInfo{
+				{Name: "master-0", Roles: []string{RoleControlPlane, RoleMaster}, Ready: true, KubeletVersion: "v1.30.4"},
+				{Name: "worker-0", Roles: []string{RoleWorker}, Ready: false, KubeletVersion: "v1.30.4"},
+			},
+			Operators: []OperatorInfo{
+				{Name: "sriov-network-operator.v4.17.0", Namespace: "openshift-sriov-network-operator",
+					Version: "4.17.0", Phase: "Succeeded"},
+			},
+		}, snapshot)
+	}
+}
+
+func TestCollectReduced(t *testing.T) {
+	testSettings := clients.GetTestClients(clients.TestClientParams{
+		K8sMockObjects: []runtime.Object{
+			buildDummyNode("microshift-0", corev1.ConditionTrue, RoleControlPlane, RoleMaster, RoleWorker),
+		},
+		SchemeAttachers: testSchemes,
+		Reduced:         true,
+	})
+
+	snapshot, err := Collect(testSettings)
+	assert.NoError(t, err)
+	assert.Equal(t, &Snapshot{
+		ControlPlaneTopology:   configv1.SingleReplicaTopologyMode,
+		InfrastructureTopology: configv1.SingleReplicaTopologyMode,
+		Nodes: []NodeInfo{{
+			Name:           "microshift-0",
+			Roles:          []string{RoleControlPlane, RoleMaster, RoleWorker},
+			Ready:          true,
+			KubeletVersion: "v1.30.4",
+		}},
+		Operators: []OperatorInfo{},
+	}, snapshot)
+	assert.True(t, snapshot.IsSNO())
+}
+
+func TestSnapshotIsSNO(t *testing.T) {
+	var nilSnapshot *Snapshot
+
+	assert.False(t, nilSnapshot.IsSNO())
+	assert.False(t, (&Snapshot{ControlPlaneTopology: configv1.HighlyAvailableTopologyMode}).IsSNO())
+	assert.True(t, (&Snapshot{ControlPlaneTopology: configv1.SingleReplicaTopologyMode}).IsSNO())
+}
+
+func TestSnapshotNodesWithRole(t *testing.T) {
+	snapshot := &Snapshot{Nodes: []NodeInfo{
+		{Name: "legacy-master", Roles: []string{RoleMaster}},
+		{Name: "control-plane", Roles: []string{RoleControlPlane}},
+		{Name: "worker", Roles: []string{RoleWorker}},
+	}}
+
+	assert.Equal(t, snapshot.Nodes[:2], snapshot.NodesWithRole(RoleControlPlane))
+	assert.Equal(t, snapshot.Nodes[:2], snapshot.NodesWithRole(RoleMaster))
+	assert.Equal(t, snapshot.Nodes[2:], snapshot.NodesWithRole(RoleWorker))
+	assert.Empty(t, snapshot.NodesWithRole("infra"))
+
+	var nilSnapshot *Snapshot
+
+	assert.Nil(t, nilSnapshot.NodesWithRole(RoleWorker))
+}
+
+func TestSnapshotGetOperator(t *testing.T) {
+	operator := OperatorInfo{Name: "sriov-network-operator.v4.17.0", Namespace: "openshift-sriov-network-operator"}
+	snapshot := &Snapshot{Operators: []OperatorInfo{operator}}
+
+	foundOperator, found := snapshot.GetOperator("sriov-network-operator")
+	assert.True(t, found)
+	assert.Equal(t, operator, foundOperator)
+
+	_, found = snapshot.GetOperator("ptp-operator")
+	assert.False(t, found)
+
+	var nilSnapshot *Snapshot
+
+	_, found = nilSnapshot.GetOperator("sriov-network-operator")
+	assert.False(t, found)
+}
+
+// buildDummyClusterObjects returns the objects of a dummy cluster. The cluster version, infrastructure and network
+// config are the first three objects so tests can remove them by slicing.
+func buildDummyClusterObjects() []runtime.Object {
+	return []runtime.Object{
+		&configv1.ClusterVersion{
+			ObjectMeta: metav1.ObjectMeta{Name: "version"},
+			Spec:       configv1.ClusterVersionSpec{Channel: "stable-4.17"},
+			Status:     configv1.ClusterVersionStatus{Desired: configv1.Release{Version: "4.17.1"}},
+		},
+		&configv1.Infrastructure{
+			ObjectMeta: metav1.ObjectMeta{Name: "cluster"},
+			Status: configv1.InfrastructureStatus{
+				PlatformStatus:         &configv1.PlatformStatus{Type: configv1.BareMetalPlatformType},
+				ControlPlaneTopology:   configv1.HighlyAvailableTopologyMode,
+				InfrastructureTopology: configv1.HighlyAvailableTopologyMode,
+			},
+		},
+		&configv1.Network{
+			ObjectMeta: metav1.ObjectMeta{Name: "cluster"},
+			Spec:       configv1.NetworkSpec{NetworkType: "OVNKubernetes"},
+		},
+		buildDummyNode("worker-0", corev1.ConditionFalse, RoleWorker),
+		buildDummyNode("master-0", corev1.ConditionTrue, RoleMaster, RoleControlPlane),
+		buildDummyCSV("openshift-sriov-network-operator", ""),
+		buildDummyCSV("default", oplmV1alpha1.CSVReasonCopied),
+	}
+}
+
+func buildDummyNode(name string, ready corev1.ConditionStatus, roles ...string) *corev1.Node {
+	node := &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Labels: map[string]string{"kubernetes.io/hostname": name}},
+		Status: corev1.NodeStatus{
+			Conditions: []corev1.NodeCondition{{Type: corev1.NodeReady, Status: ready}},
+			NodeInfo:   corev1.NodeSystemInfo{KubeletVersion: "v1.30.4"},
+		},
+	}
+
+	for _, role := range roles {
+		node.Labels[nodeRoleLabelPrefix+role] = ""
+	}
+
+	return node
+}
+
+func buildDummyCSV(namespace string, reason oplmV1alpha1.ConditionReason) *oplmV1alpha1.ClusterServiceVersion {
+	return &oplmV1alpha1.ClusterServiceVersion{
+		ObjectMeta: metav1.ObjectMeta{Name: "sriov-network-operator.v4.17.0", Namespace: namespace},
+		Spec: oplmV1alpha1.ClusterServiceVersionSpec{
+			Version: version.OperatorVersion{Version: semver.MustParse("4.17.0")},
+		},
+		Status: oplmV1alpha1.ClusterServiceVersionStatus{
+			Phase:  oplmV1alpha1.CSVPhaseSucceeded,
+			Reason: reason,
+		},
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/msg"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/klog/v2"
 	goclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -33,6 +34,8 @@ type ClusterLogForwarderBuilder struct {
 // NewClusterLogForwarderBuilder method creates new instance of builder.
 func NewClusterLogForwarderBuilder(
 	apiClient *clients.Settings, name, nsname string) *ClusterLogForwarderBuilder {
+	nsname = apiClient.ResolveNamespace(nsname)
+
 	klog.V(100).Infof("Initializing new clusterlogforwarder structure with the following params: "+
 		"name: %s, namespace: %s", name, nsname)
 
@@ -178,6 +181,8 @@ func (builder *ClusterLogForwarderBuilder) WithPipeline(
 
 // PullClusterLogForwarder retrieves an existing clusterlogforwarder object from the cluster.
 func PullClusterLogForwarder(apiClient *clients.Settings, name, nsname string) (*ClusterLogForwarderBuilder, error) {
+	nsname = apiClient.ResolveNamespace(nsname)
+
 	klog.V(100).Infof("Pulling existing clusterlogforwarder %s in nsname %s", name, nsname)
 
 	if apiClient == nil {
@@ -341,6 +346,13 @@ func (builder *ClusterLogForwarderBuilder) Update(force bool) (*ClusterLogForwar
 	return builder, err
 }
 
+// GetClusterLogForwarderGVR returns ClusterLogForwarder's GroupVersionResource which could be used for Clean function.
+func GetClusterLogForwarderGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group: "observability.openshift.io", Version: "v1", Resource: "clusterlogforwarders",
+	}
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *ClusterLogForwarderBuilder) validate() (bool, error) {
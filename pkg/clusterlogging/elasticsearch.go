@@ -9,6 +9,7 @@ import (
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/msg"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/klog/v2"
 	goclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -28,6 +29,8 @@ type ElasticsearchBuilder struct {
 // NewElasticsearchBuilder method creates new instance of builder.
 func NewElasticsearchBuilder(
 	apiClient *clients.Settings, name, nsname string) *ElasticsearchBuilder {
+	nsname = apiClient.ResolveNamespace(nsname)
+
 	klog.V(100).Infof("Initializing new elasticsearch structure with the following params: name: %s, namespace: %s",
 		name, nsname)
 
@@ -75,6 +78,8 @@ func NewElasticsearchBuilder(
 
 // PullElasticsearch retrieves an existing elasticsearch object from the cluster.
 func PullElasticsearch(apiClient *clients.Settings, name, nsname string) (*ElasticsearchBuilder, error) {
+	nsname = apiClient.ResolveNamespace(nsname)
+
 	klog.V(100).Infof(
 		"Pulling elasticsearch object name:%s in namespace: %s", name, nsname)
 
@@ -257,6 +262,13 @@ func (builder *ElasticsearchBuilder) GetManagementState() (*eskv1.ManagementStat
 	return &builder.Object.Spec.ManagementState, nil
 }
 
+// GetElasticsearchGVR returns Elasticsearch's GroupVersionResource which could be used for Clean function.
+func GetElasticsearchGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group: "logging.openshift.io", Version: "v1", Resource: "elasticsearches",
+	}
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *ElasticsearchBuilder) validate() (bool, error) {
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"time"
 
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/wait"
 
 	lokiv1 "github.com/grafana/loki/operator/apis/loki/v1"
@@ -37,6 +38,8 @@ type LokiStackBuilder struct {
 // NewLokiStackBuilder creates new instance of builder.
 func NewLokiStackBuilder(
 	apiClient *clients.Settings, name, nsname string) *LokiStackBuilder {
+	nsname = apiClient.ResolveNamespace(nsname)
+
 	klog.V(100).Infof("Initializing new lokiStack structure with the following params: name: %s, namespace: %s",
 		name, nsname)
 
@@ -84,6 +87,8 @@ func NewLokiStackBuilder(
 
 // PullLokiStack retrieves an existing lokiStack object from the cluster.
 func PullLokiStack(apiClient *clients.Settings, name, nsname string) (*LokiStackBuilder, error) {
+	nsname = apiClient.ResolveNamespace(nsname)
+
 	klog.V(100).Infof(
 		"Pulling lokiStack object name: %s in namespace: %s", name, nsname)
 
@@ -411,6 +416,13 @@ func (builder *LokiStackBuilder) IsReady(timeout time.Duration) bool {
 	return err == nil
 }
 
+// GetLokiStackGVR returns LokiStack's GroupVersionResource which could be used for Clean function.
+func GetLokiStackGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group: "loki.grafana.com", Version: "v1", Resource: "lokistacks",
+	}
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *LokiStackBuilder) validate() (bool, error) {
@@ -0,0 +1,120 @@
+package compliance
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common"
+	compliancev1alpha1 "github.com/rh-ecosystem-edge/eco-goinfra/pkg/schemes/compliance/v1alpha1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/klog/v2"
+	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// CheckResultBuilder provides a struct for the ComplianceCheckResult resource containing a connection to the cluster
+// and the ComplianceCheckResult definition. Check results are created by the operator and are read-only.
+type CheckResultBuilder struct {
+	common.EmbeddableBuilder[compliancev1alpha1.ComplianceCheckResult, *compliancev1alpha1.ComplianceCheckResult]
+}
+
+// GetGVK returns the ComplianceCheckResult GVK for this builder.
+func (builder *CheckResultBuilder) GetGVK() schema.GroupVersionKind {
+	return compliancev1alpha1.GroupVersion.WithKind("ComplianceCheckResult")
+}
+
+// PullCheckResult pulls an existing ComplianceCheckResult from the cluster.
+func PullCheckResult(apiClient *clients.Settings, name, nsname string) (*CheckResultBuilder, error) {
+	klog.V(100).Infof("Pulling existing ComplianceCheckResult %s in namespace %s from cluster", name, nsname)
+
+	return common.PullNamespacedBuilder[compliancev1alpha1.ComplianceCheckResult, CheckResultBuilder](
+		context.TODO(), apiClient, compliancev1alpha1.AddToScheme, name, nsname)
+}
+
+// ListCheckResults returns a list of ComplianceCheckResult builders matching the provided options.
+func ListCheckResults(
+	apiClient *clients.Settings, options ...runtimeclient.ListOption) ([]*CheckResultBuilder, error) {
+	return common.List[compliancev1alpha1.ComplianceCheckResult, compliancev1alpha1.ComplianceCheckResultList,
+		CheckResultBuilder](context.TODO(), apiClient, compliancev1alpha1.AddToScheme, options...)
+}
+
+// CheckResultSummary holds the number of ComplianceCheckResults in each status, along with the names of the checks
+// that failed or errored so they can be reported.
+type CheckResultSummary struct {
+	Pass          int
+	Fail          int
+	Error         int
+	Info          int
+	Manual        int
+	NotApplicable int
+	Inconsistent  int
+	FailedChecks  []string
+	ErroredChecks []string
+}
+
+// Total returns the total number of check results in the summary.
+func (summary *CheckResultSummary) Total() int {
+	return summary.Pass + summary.Fail + summary.Error + summary.Info + summary.Manual + summary.NotApplicable +
+		summary.Inconsistent
+}
+
+// String returns a short human-readable representation of the summary.
+func (summary *CheckResultSummary) String() string {
+	return fmt.Sprintf("PASS: %d, FAIL: %d, ERROR: %d, INFO: %d, MANUAL: %d, NOT-APPLICABLE: %d, INCONSISTENT: %d",
+		summary.Pass, summary.Fail, summary.Error, summary.Info, summary.Manual, summary.NotApplicable,
+		summary.Inconsistent)
+}
+
+// GetCheckResultSummary lists the ComplianceCheckResults matching the provided options and aggregates them by status.
+func GetCheckResultSummary(
+	apiClient *clients.Settings, options ...runtimeclient.ListOption) (*CheckResultSummary, error) {
+	return getCheckResultSummary(apiClient, options...)
+}
+
+// getCheckResultSummary is the implementation of GetCheckResultSummary that accepts any client so it may be used by
+// builders, which only have access to a runtimeclient.Client.
+func getCheckResultSummary(
+	apiClient runtimeclient.Client, options ...runtimeclient.ListOption) (*CheckResultSummary, error) {
+	checkResults, err := common.List[compliancev1alpha1.ComplianceCheckResult,
+		compliancev1alpha1.ComplianceCheckResultList, CheckResultBuilder](
+		context.TODO(), apiClient, compliancev1alpha1.AddToScheme, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	summary := &CheckResultSummary{}
+
+	for _, checkResult := range checkResults {
+		switch checkResult.Object.Status {
+		case compliancev1alpha1.CheckResultPass:
+			summary.Pass++
+		case compliancev1alpha1.CheckResultFail:
+			summary.Fail++
+			summary.FailedChecks = append(summary.FailedChecks, checkResult.Object.Name)
+		case compliancev1alpha1.CheckResultError:
+			summary.Error++
+			summary.ErroredChecks = append(summary.ErroredChecks, checkResult.Object.Name)
+		case compliancev1alpha1.CheckResultInfo:
+			summary.Info++
+		case compliancev1alpha1.CheckResultManual:
+			summary.Manual++
+		case compliancev1alpha1.CheckResultNotApplicable:
+			summary.NotApplicable++
+		case compliancev1alpha1.CheckResultInconsistent:
+			summary.Inconsistent++
+		default:
+			klog.V(100).Infof("ComplianceCheckResult %s has unknown status %s",
+				checkResult.Object.Name, checkResult.Object.Status)
+		}
+	}
+
+	return summary, nil
+}
+
+// GetComplianceCheckResultGVR returns ComplianceCheckResult's GroupVersionResource
+// which could be used for Clean function.
+func GetComplianceCheckResultGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group: "compliance.openshift.io", Version: "v1alpha1", Resource: "compliancecheckresults",
+	}
+}
@@ -0,0 +1,107 @@
+package compliance
+
+import (
+	"testing"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common/testhelper"
+	compliancev1alpha1 "github.com/rh-ecosystem-edge/eco-goinfra/pkg/schemes/compliance/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/runtime"
+	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var checkResultGVK = compliancev1alpha1.GroupVersion.WithKind("ComplianceCheckResult")
+
+func TestPullCheckResult(t *testing.T) {
+	t.Parallel()
+
+	testhelper.NewNamespacedPullTestConfig(
+		PullCheckResult, compliancev1alpha1.AddToScheme, checkResultGVK).ExecuteTests(t)
+}
+
+func TestListCheckResults(t *testing.T) {
+	t.Parallel()
+
+	testhelper.NewListTestConfig(ListCheckResults, compliancev1alpha1.AddToScheme, checkResultGVK).ExecuteTests(t)
+}
+
+func TestGetCheckResultSummary(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name            string
+		client          bool
+		objects         []runtime.Object
+		expectedSummary *CheckResultSummary
+		expectedError   bool
+	}{
+		{
+			name:   "every status is counted",
+			client: true,
+			objects: []runtime.Object{
+				buildDummyCheckResult("pass", compliancev1alpha1.CheckResultPass),
+				buildDummyCheckResult("fail", compliancev1alpha1.CheckResultFail),
+				buildDummyCheckResult("error", compliancev1alpha1.CheckResultError),
+				buildDummyCheckResult("info", compliancev1alpha1.CheckResultInfo),
+				buildDummyCheckResult("manual", compliancev1alpha1.CheckResultManual),
+				buildDummyCheckResult("not-applicable", compliancev1alpha1.CheckResultNotApplicable),
+				buildDummyCheckResult("inconsistent", compliancev1alpha1.CheckResultInconsistent),
+			},
+			expectedSummary: &CheckResultSummary{
+				Pass:          1,
+				Fail:          1,
+				Error:         1,
+				Info:          1,
+				Manual:        1,
+				NotApplicable: 1,
+				Inconsistent:  1,
+				FailedChecks:  []string{"fail"},
+				ErroredChecks: []string{"error"},
+			},
+		},
+		{
+			name:            "no check results",
+			client:          true,
+			expectedSummary: &CheckResultSummary{},
+		},
+		{
+			name:          "nil client",
+			client:        false,
+			expectedError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			var testSettings *clients.Settings
+
+			if testCase.client {
+				testSettings = buildTestClientWithComplianceObjects(testCase.objects)
+			}
+
+			summary, err := GetCheckResultSummary(testSettings, runtimeclient.InNamespace(defaultComplianceNamespace))
+
+			if testCase.expectedError {
+				assert.Error(t, err)
+
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.expectedSummary, summary)
+		})
+	}
+}
+
+func TestCheckResultSummaryString(t *testing.T) {
+	t.Parallel()
+
+	summary := &CheckResultSummary{Pass: 3, Fail: 2, Error: 1}
+
+	assert.Equal(t, 6, summary.Total())
+	assert.Equal(t,
+		"PASS: 3, FAIL: 2, ERROR: 1, INFO: 0, MANUAL: 0, NOT-APPLICABLE: 0, INCONSISTENT: 0", summary.String())
+}
@@ -0,0 +1,148 @@
+package compliance
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common"
+	compliancev1alpha1 "github.com/rh-ecosystem-edge/eco-goinfra/pkg/schemes/compliance/v1alpha1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/klog/v2"
+	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// ComplianceScanBuilder provides a struct for the ComplianceScan resource containing a connection to the cluster and
+// the ComplianceScan definition. ComplianceScans are normally generated by the operator from a ScanSettingBinding, so
+// this builder can only pull existing scans.
+type ComplianceScanBuilder struct {
+	common.EmbeddableBuilder[compliancev1alpha1.ComplianceScan, *compliancev1alpha1.ComplianceScan]
+	common.EmbeddableDeleter[compliancev1alpha1.ComplianceScan, *compliancev1alpha1.ComplianceScan]
+	common.EmbeddableUpdater[compliancev1alpha1.ComplianceScan, ComplianceScanBuilder,
+		*compliancev1alpha1.ComplianceScan, *ComplianceScanBuilder]
+}
+
+// AttachMixins wires the embedded CRUD mixins to this builder instance.
+func (builder *ComplianceScanBuilder) AttachMixins() {
+	builder.EmbeddableDeleter.SetBase(builder)
+	builder.EmbeddableUpdater.SetBase(builder)
+}
+
+// GetGVK returns the ComplianceScan GVK for this builder.
+func (builder *ComplianceScanBuilder) GetGVK() schema.GroupVersionKind {
+	return compliancev1alpha1.GroupVersion.WithKind("ComplianceScan")
+}
+
+// PullComplianceScan pulls an existing ComplianceScan from the cluster.
+func PullComplianceScan(apiClient *clients.Settings, name, nsname string) (*ComplianceScanBuilder, error) {
+	klog.V(100).Infof("Pulling existing ComplianceScan %s in namespace %s from cluster", name, nsname)
+
+	return common.PullNamespacedBuilder[compliancev1alpha1.ComplianceScan, ComplianceScanBuilder](
+		context.TODO(), apiClient, compliancev1alpha1.AddToScheme, name, nsname)
+}
+
+// ListComplianceScans returns a list of ComplianceScan builders matching the provided options.
+func ListComplianceScans(
+	apiClient *clients.Settings, options ...runtimeclient.ListOption) ([]*ComplianceScanBuilder, error) {
+	return common.List[compliancev1alpha1.ComplianceScan, compliancev1alpha1.ComplianceScanList,
+		ComplianceScanBuilder](context.TODO(), apiClient, compliancev1alpha1.AddToScheme, options...)
+}
+
+// WaitForScanDone waits up to timeout for the ComplianceScan to reach the DONE phase. The result of the scan is
+// available in the Object status once this returns without error.
+func (builder *ComplianceScanBuilder) WaitForScanDone(timeout time.Duration) (*ComplianceScanBuilder, error) {
+	if err := common.Validate(builder); err != nil {
+		return builder, err
+	}
+
+	klog.V(100).Infof("Waiting up to %s for ComplianceScan %s in namespace %s to be done",
+		timeout, builder.Definition.Name, builder.Definition.Namespace)
+
+	err := wait.PollUntilContextTimeout(
+		context.TODO(), time.Second, timeout, true, func(ctx context.Context) (bool, error) {
+			object, err := builder.Get()
+			if err != nil {
+				klog.V(100).Infof("Failed to get ComplianceScan %s in namespace %s: %v",
+					builder.Definition.Name, builder.Definition.Namespace, err)
+
+				return false, nil
+			}
+
+			builder.Object = object
+
+			return object.Status.Phase == compliancev1alpha1.PhaseDone, nil
+		})
+
+	return builder, err
+}
+
+// GetResult returns the result of the ComplianceScan. An error is returned if the scan is not done yet.
+func (builder *ComplianceScanBuilder) GetResult() (compliancev1alpha1.ComplianceScanStatusResult, error) {
+	if err := common.Validate(builder); err != nil {
+		return compliancev1alpha1.ResultNotAvailable, err
+	}
+
+	klog.V(100).Infof("Getting result of ComplianceScan %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	object, err := builder.Get()
+	if err != nil {
+		return compliancev1alpha1.ResultNotAvailable, err
+	}
+
+	if object.Status.Phase != compliancev1alpha1.PhaseDone {
+		return compliancev1alpha1.ResultNotAvailable, fmt.Errorf(
+			"compliancescan %s in namespace %s is in phase %s, not %s",
+			builder.Definition.Name, builder.Definition.Namespace, object.Status.Phase, compliancev1alpha1.PhaseDone)
+	}
+
+	return object.Status.Result, nil
+}
+
+// Rescan annotates the ComplianceScan so the operator runs it again.
+func (builder *ComplianceScanBuilder) Rescan() (*ComplianceScanBuilder, error) {
+	if err := common.Validate(builder); err != nil {
+		return builder, err
+	}
+
+	klog.V(100).Infof("Requesting rescan of ComplianceScan %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	object, err := builder.Get()
+	if err != nil {
+		return builder, err
+	}
+
+	builder.Definition = object
+
+	if builder.Definition.Annotations == nil {
+		builder.Definition.Annotations = make(map[string]string)
+	}
+
+	builder.Definition.Annotations[compliancev1alpha1.ComplianceScanRerunAnnotation] = ""
+
+	return builder.Update()
+}
+
+// GetResultSummary aggregates the ComplianceCheckResults produced by this ComplianceScan.
+func (builder *ComplianceScanBuilder) GetResultSummary() (*CheckResultSummary, error) {
+	if err := common.Validate(builder); err != nil {
+		return nil, err
+	}
+
+	klog.V(100).Infof("Getting result summary of ComplianceScan %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	return getCheckResultSummary(builder.GetClient(),
+		runtimeclient.InNamespace(builder.Definition.Namespace),
+		runtimeclient.MatchingLabels{compliancev1alpha1.ComplianceScanLabel: builder.Definition.Name})
+}
+
+// GetComplianceScanGVR returns ComplianceScan's GroupVersionResource which could be used for Clean function.
+func GetComplianceScanGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group: "compliance.openshift.io", Version: "v1alpha1", Resource: "compliancescans",
+	}
+}
@@ -0,0 +1,169 @@
+package compliance
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common/testhelper"
+	compliancev1alpha1 "github.com/rh-ecosystem-edge/eco-goinfra/pkg/schemes/compliance/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var complianceScanGVK = compliancev1alpha1.GroupVersion.WithKind("ComplianceScan")
+
+func TestPullComplianceScan(t *testing.T) {
+	t.Parallel()
+
+	testhelper.NewNamespacedPullTestConfig(
+		PullComplianceScan, compliancev1alpha1.AddToScheme, complianceScanGVK).ExecuteTests(t)
+}
+
+func TestListComplianceScans(t *testing.T) {
+	t.Parallel()
+
+	testhelper.NewListTestConfig(
+		ListComplianceScans, compliancev1alpha1.AddToScheme, complianceScanGVK).ExecuteTests(t)
+}
+
+func TestComplianceScanMethods(t *testing.T) {
+	t.Parallel()
+
+	commonTestConfig := testhelper.NewCommonTestConfig[compliancev1alpha1.ComplianceScan, ComplianceScanBuilder](
+		compliancev1alpha1.AddToScheme,
+		complianceScanGVK,
+		testhelper.ResourceScopeNamespaced,
+	)
+
+	testhelper.NewTestSuite().
+		With(testhelper.NewGetTestConfig(commonTestConfig)).
+		With(testhelper.NewExistsTestConfig(commonTestConfig)).
+		With(testhelper.NewDeleterTestConfig(commonTestConfig)).
+		With(testhelper.NewUpdateTestConfig(commonTestConfig)).
+		Run(t)
+}
+
+func TestComplianceScanWaitForScanDone(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		phase         compliancev1alpha1.ComplianceScanStatusPhase
+		expectedError error
+	}{
+		{
+			name:  "scan done",
+			phase: compliancev1alpha1.PhaseDone,
+		},
+		{
+			name:          "scan aggregating times out",
+			phase:         compliancev1alpha1.PhaseAggregating,
+			expectedError: context.DeadlineExceeded,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			scan := buildDummyComplianceScan(defaultProfileName)
+			scan.Status.Phase = testCase.phase
+
+			testBuilder := buildValidComplianceScanTestBuilder(t, []runtime.Object{scan})
+
+			_, err := testBuilder.WaitForScanDone(time.Second)
+			if testCase.expectedError == nil {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, testCase.expectedError)
+			}
+		})
+	}
+}
+
+func TestComplianceScanGetResult(t *testing.T) {
+	t.Parallel()
+
+	scan := buildDummyComplianceScan(defaultProfileName)
+	scan.Status.Phase = compliancev1alpha1.PhaseDone
+	scan.Status.Result = compliancev1alpha1.ResultNonCompliant
+
+	testBuilder := buildValidComplianceScanTestBuilder(t, []runtime.Object{scan})
+
+	result, err := testBuilder.GetResult()
+	assert.NoError(t, err)
+	assert.Equal(t, compliancev1alpha1.ResultNonCompliant, result)
+
+	scan = buildDummyComplianceScan(defaultProfileName)
+	scan.Status.Phase = compliancev1alpha1.PhaseRunning
+
+	testBuilder = buildValidComplianceScanTestBuilder(t, []runtime.Object{scan})
+
+	result, err = testBuilder.GetResult()
+	assert.EqualError(t, err,
+		"compliancescan ocp4-cis in namespace openshift-compliance is in phase RUNNING, not DONE")
+	assert.Equal(t, compliancev1alpha1.ResultNotAvailable, result)
+}
+
+func TestComplianceScanRescan(t *testing.T) {
+	t.Parallel()
+
+	testBuilder := buildValidComplianceScanTestBuilder(
+		t, []runtime.Object{buildDummyComplianceScan(defaultProfileName)})
+
+	testBuilder, err := testBuilder.Rescan()
+	require.NoError(t, err)
+
+	scan, err := testBuilder.Get()
+	require.NoError(t, err)
+	assert.Contains(t, scan.Annotations, compliancev1alpha1.ComplianceScanRerunAnnotation)
+}
+
+func TestComplianceScanGetResultSummary(t *testing.T) {
+	t.Parallel()
+
+	otherScanResult := buildDummyCheckResult("other-scan-check", compliancev1alpha1.CheckResultPass)
+	otherScanResult.Labels = buildSuiteLabels("ocp4-cis-node-master")
+
+	testBuilder := buildValidComplianceScanTestBuilder(t, []runtime.Object{
+		buildDummyComplianceScan(defaultProfileName),
+		buildDummyCheckResult("check-pass", compliancev1alpha1.CheckResultPass),
+		buildDummyCheckResult("check-manual", compliancev1alpha1.CheckResultManual),
+		otherScanResult,
+	})
+
+	summary, err := testBuilder.GetResultSummary()
+	assert.NoError(t, err)
+	assert.Equal(t, 1, summary.Pass)
+	assert.Equal(t, 1, summary.Manual)
+	assert.Equal(t, 2, summary.Total())
+}
+
+func buildDummyComplianceScan(name string) *compliancev1alpha1.ComplianceScan {
+	return &compliancev1alpha1.ComplianceScan{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: defaultComplianceNamespace,
+			Labels:    map[string]string{compliancev1alpha1.SuiteLabel: defaultScanSettingBindingName},
+		},
+		Spec: compliancev1alpha1.ComplianceScanSpec{
+			ScanType: compliancev1alpha1.ScanTypePlatform,
+			Profile:  "xccdf_org.ssgproject.content_profile_cis",
+		},
+	}
+}
+
+// buildValidComplianceScanTestBuilder pulls the default ComplianceScan from a test client containing the provided
+// objects. The objects must include the default ComplianceScan.
+func buildValidComplianceScanTestBuilder(t *testing.T, objects []runtime.Object) *ComplianceScanBuilder {
+	t.Helper()
+
+	testBuilder, err := PullComplianceScan(
+		buildTestClientWithComplianceObjects(objects), defaultProfileName, defaultComplianceNamespace)
+	require.NoError(t, err)
+
+	return testBuilder
+}
@@ -0,0 +1,152 @@
+package compliance
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common"
+	compliancev1alpha1 "github.com/rh-ecosystem-edge/eco-goinfra/pkg/schemes/compliance/v1alpha1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/klog/v2"
+	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// RemediationBuilder provides a struct for the ComplianceRemediation resource containing a connection to the cluster
+// and the ComplianceRemediation definition. Remediations are generated by the operator for failed checks, so this
+// builder can only pull existing remediations.
+type RemediationBuilder struct {
+	common.EmbeddableBuilder[compliancev1alpha1.ComplianceRemediation, *compliancev1alpha1.ComplianceRemediation]
+	common.EmbeddableDeleter[compliancev1alpha1.ComplianceRemediation, *compliancev1alpha1.ComplianceRemediation]
+	common.EmbeddableUpdater[compliancev1alpha1.ComplianceRemediation, RemediationBuilder,
+		*compliancev1alpha1.ComplianceRemediation, *RemediationBuilder]
+}
+
+// AttachMixins wires the embedded CRUD mixins to this builder instance.
+func (builder *RemediationBuilder) AttachMixins() {
+	builder.EmbeddableDeleter.SetBase(builder)
+	builder.EmbeddableUpdater.SetBase(builder)
+}
+
+// GetGVK returns the ComplianceRemediation GVK for this builder.
+func (builder *RemediationBuilder) GetGVK() schema.GroupVersionKind {
+	return compliancev1alpha1.GroupVersion.WithKind("ComplianceRemediation")
+}
+
+// PullRemediation pulls an existing ComplianceRemediation from the cluster.
+func PullRemediation(apiClient *clients.Settings, name, nsname string) (*RemediationBuilder, error) {
+	klog.V(100).Infof("Pulling existing ComplianceRemediation %s in namespace %s from cluster", name, nsname)
+
+	return common.PullNamespacedBuilder[compliancev1alpha1.ComplianceRemediation, RemediationBuilder](
+		context.TODO(), apiClient, compliancev1alpha1.AddToScheme, name, nsname)
+}
+
+// ListRemediations returns a list of ComplianceRemediation builders matching the provided options.
+func ListRemediations(
+	apiClient *clients.Settings, options ...runtimeclient.ListOption) ([]*RemediationBuilder, error) {
+	return common.List[compliancev1alpha1.ComplianceRemediation, compliancev1alpha1.ComplianceRemediationList,
+		RemediationBuilder](context.TODO(), apiClient, compliancev1alpha1.AddToScheme, options...)
+}
+
+// Apply marks the ComplianceRemediation to be applied by the operator and updates it on the cluster.
+func (builder *RemediationBuilder) Apply() (*RemediationBuilder, error) {
+	return builder.setApply(true)
+}
+
+// Unapply marks the ComplianceRemediation to be reverted by the operator and updates it on the cluster.
+func (builder *RemediationBuilder) Unapply() (*RemediationBuilder, error) {
+	return builder.setApply(false)
+}
+
+// WaitForApplicationState waits up to timeout for the ComplianceRemediation to reach the provided application state.
+func (builder *RemediationBuilder) WaitForApplicationState(
+	state compliancev1alpha1.RemediationApplicationState, timeout time.Duration) (*RemediationBuilder, error) {
+	if err := common.Validate(builder); err != nil {
+		return builder, err
+	}
+
+	klog.V(100).Infof("Waiting up to %s for ComplianceRemediation %s in namespace %s to be in state %s",
+		timeout, builder.Definition.Name, builder.Definition.Namespace, state)
+
+	err := wait.PollUntilContextTimeout(
+		context.TODO(), time.Second, timeout, true, func(ctx context.Context) (bool, error) {
+			object, err := builder.Get()
+			if err != nil {
+				klog.V(100).Infof("Failed to get ComplianceRemediation %s in namespace %s: %v",
+					builder.Definition.Name, builder.Definition.Namespace, err)
+
+				return false, nil
+			}
+
+			builder.Object = object
+
+			if object.Status.ApplicationState == compliancev1alpha1.RemediationError {
+				klog.V(100).Infof("ComplianceRemediation %s in namespace %s failed to apply: %s",
+					builder.Definition.Name, builder.Definition.Namespace, object.Status.ErrorMessage)
+			}
+
+			return object.Status.ApplicationState == state, nil
+		})
+
+	return builder, err
+}
+
+// ApplyRemediations applies all the provided ComplianceRemediations and waits up to timeout for each of them to reach
+// the Applied state. All remediations are marked to be applied before waiting on any of them, so the operator may
+// apply them together. The names of the remediations that were not applied are included in the returned error.
+func ApplyRemediations(remediations []*RemediationBuilder, timeout time.Duration) error {
+	klog.V(100).Infof("Applying %d ComplianceRemediations", len(remediations))
+
+	for _, remediation := range remediations {
+		if _, err := remediation.Apply(); err != nil {
+			return err
+		}
+	}
+
+	var notApplied []string
+
+	for _, remediation := range remediations {
+		_, err := remediation.WaitForApplicationState(compliancev1alpha1.RemediationApplied, timeout)
+		if err != nil {
+			notApplied = append(notApplied, remediation.Definition.Name)
+		}
+	}
+
+	if len(notApplied) > 0 {
+		return fmt.Errorf("complianceremediations were not applied within %s: %s",
+			timeout, strings.Join(notApplied, ", "))
+	}
+
+	return nil
+}
+
+// setApply fetches the latest ComplianceRemediation and updates its apply field to the provided value.
+func (builder *RemediationBuilder) setApply(apply bool) (*RemediationBuilder, error) {
+	if err := common.Validate(builder); err != nil {
+		return builder, err
+	}
+
+	klog.V(100).Infof("Setting apply of ComplianceRemediation %s in namespace %s to %t",
+		builder.Definition.Name, builder.Definition.Namespace, apply)
+
+	object, err := builder.Get()
+	if err != nil {
+		return builder, err
+	}
+
+	builder.Definition = object
+	builder.Definition.Spec.Apply = apply
+
+	return builder.Update()
+}
+
+// GetComplianceRemediationGVR returns ComplianceRemediation's GroupVersionResource
+// which could be used for Clean function.
+func GetComplianceRemediationGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group: "compliance.openshift.io", Version: "v1alpha1", Resource: "complianceremediations",
+	}
+}
@@ -0,0 +1,158 @@
+package compliance
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common/testhelper"
+	compliancev1alpha1 "github.com/rh-ecosystem-edge/eco-goinfra/pkg/schemes/compliance/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const defaultRemediationName = "ocp4-cis-api-server-encryption-provider-cipher"
+
+var remediationGVK = compliancev1alpha1.GroupVersion.WithKind("ComplianceRemediation")
+
+func TestPullRemediation(t *testing.T) {
+	t.Parallel()
+
+	testhelper.NewNamespacedPullTestConfig(
+		PullRemediation, compliancev1alpha1.AddToScheme, remediationGVK).ExecuteTests(t)
+}
+
+func TestListRemediations(t *testing.T) {
+	t.Parallel()
+
+	testhelper.NewListTestConfig(ListRemediations, compliancev1alpha1.AddToScheme, remediationGVK).ExecuteTests(t)
+}
+
+func TestRemediationMethods(t *testing.T) {
+	t.Parallel()
+
+	commonTestConfig := testhelper.NewCommonTestConfig[compliancev1alpha1.ComplianceRemediation, RemediationBuilder](
+		compliancev1alpha1.AddToScheme,
+		remediationGVK,
+		testhelper.ResourceScopeNamespaced,
+	)
+
+	testhelper.NewTestSuite().
+		With(testhelper.NewGetTestConfig(commonTestConfig)).
+		With(testhelper.NewExistsTestConfig(commonTestConfig)).
+		With(testhelper.NewDeleterTestConfig(commonTestConfig)).
+		With(testhelper.NewUpdateTestConfig(commonTestConfig)).
+		Run(t)
+}
+
+func TestRemediationApplyAndUnapply(t *testing.T) {
+	t.Parallel()
+
+	testBuilder := buildValidRemediationTestBuilder(t, buildDummyRemediation(defaultRemediationName))
+
+	testBuilder, err := testBuilder.Apply()
+	require.NoError(t, err)
+
+	remediation, err := testBuilder.Get()
+	require.NoError(t, err)
+	assert.True(t, remediation.Spec.Apply)
+
+	testBuilder, err = testBuilder.Unapply()
+	require.NoError(t, err)
+
+	remediation, err = testBuilder.Get()
+	require.NoError(t, err)
+	assert.False(t, remediation.Spec.Apply)
+}
+
+func TestRemediationWaitForApplicationState(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		state         compliancev1alpha1.RemediationApplicationState
+		expectedError error
+	}{
+		{
+			name:  "remediation applied",
+			state: compliancev1alpha1.RemediationApplied,
+		},
+		{
+			name:          "remediation errored times out",
+			state:         compliancev1alpha1.RemediationError,
+			expectedError: context.DeadlineExceeded,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			remediation := buildDummyRemediation(defaultRemediationName)
+			remediation.Status.ApplicationState = testCase.state
+
+			testBuilder := buildValidRemediationTestBuilder(t, remediation)
+
+			_, err := testBuilder.WaitForApplicationState(compliancev1alpha1.RemediationApplied, time.Second)
+			if testCase.expectedError == nil {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, testCase.expectedError)
+			}
+		})
+	}
+}
+
+func TestApplyRemediations(t *testing.T) {
+	t.Parallel()
+
+	applied := buildDummyRemediation("applied")
+	applied.Status.ApplicationState = compliancev1alpha1.RemediationApplied
+
+	pending := buildDummyRemediation("pending")
+	pending.Status.ApplicationState = compliancev1alpha1.RemediationPending
+
+	remediations, err := ListRemediations(buildTestClientWithComplianceObjects([]runtime.Object{applied, pending}))
+	require.NoError(t, err)
+
+	err = ApplyRemediations(remediations, time.Second)
+	assert.EqualError(t, err, "complianceremediations were not applied within 1s: pending")
+
+	for _, remediation := range remediations {
+		object, err := remediation.Get()
+		require.NoError(t, err)
+		assert.True(t, object.Spec.Apply)
+	}
+
+	err = ApplyRemediations(remediations[:1], time.Second)
+	assert.NoError(t, err)
+}
+
+func buildDummyRemediation(name string) *compliancev1alpha1.ComplianceRemediation {
+	return &compliancev1alpha1.ComplianceRemediation{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: defaultComplianceNamespace,
+			Labels:    buildSuiteLabels(defaultProfileName),
+		},
+		Spec: compliancev1alpha1.ComplianceRemediationSpec{
+			ComplianceRemediationSpecMeta: compliancev1alpha1.ComplianceRemediationSpecMeta{
+				Type: compliancev1alpha1.ConfigurationRemediation,
+			},
+		},
+	}
+}
+
+// buildValidRemediationTestBuilder pulls the provided ComplianceRemediation from a test client containing it.
+func buildValidRemediationTestBuilder(
+	t *testing.T, remediation *compliancev1alpha1.ComplianceRemediation) *RemediationBuilder {
+	t.Helper()
+
+	testBuilder, err := PullRemediation(
+		buildTestClientWithComplianceObjects([]runtime.Object{remediation}), remediation.Name, remediation.Namespace)
+	require.NoError(t, err)
+
+	return testBuilder
+}
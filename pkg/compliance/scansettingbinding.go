@@ -0,0 +1,240 @@
+package compliance
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common"
+	compliancev1alpha1 "github.com/rh-ecosystem-edge/eco-goinfra/pkg/schemes/compliance/v1alpha1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/klog/v2"
+	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// ProfileKind is the kind of a Profile shipped in a ProfileBundle.
+	ProfileKind = "Profile"
+	// TailoredProfileKind is the kind of a user-defined TailoredProfile.
+	TailoredProfileKind = "TailoredProfile"
+	// ScanSettingKind is the kind of the ScanSetting referenced by a ScanSettingBinding.
+	ScanSettingKind = "ScanSetting"
+	// DefaultScanSettingName is the name of the ScanSetting created by the operator.
+	DefaultScanSettingName = "default"
+)
+
+// ScanSettingBindingBuilder provides a struct for the ScanSettingBinding resource containing a connection to the
+// cluster and the ScanSettingBinding definition.
+type ScanSettingBindingBuilder struct {
+	common.EmbeddableBuilder[compliancev1alpha1.ScanSettingBinding, *compliancev1alpha1.ScanSettingBinding]
+	common.EmbeddableCreator[compliancev1alpha1.ScanSettingBinding, ScanSettingBindingBuilder,
+		*compliancev1alpha1.ScanSettingBinding, *ScanSettingBindingBuilder]
+	common.EmbeddableDeleter[compliancev1alpha1.ScanSettingBinding, *compliancev1alpha1.ScanSettingBinding]
+	common.EmbeddableUpdater[compliancev1alpha1.ScanSettingBinding, ScanSettingBindingBuilder,
+		*compliancev1alpha1.ScanSettingBinding, *ScanSettingBindingBuilder]
+}
+
+// AttachMixins wires the embedded CRUD mixins to this builder instance.
+func (builder *ScanSettingBindingBuilder) AttachMixins() {
+	builder.EmbeddableCreator.SetBase(builder)
+	builder.EmbeddableDeleter.SetBase(builder)
+	builder.EmbeddableUpdater.SetBase(builder)
+}
+
+// GetGVK returns the ScanSettingBinding GVK for this builder.
+func (builder *ScanSettingBindingBuilder) GetGVK() schema.GroupVersionKind {
+	return compliancev1alpha1.GroupVersion.WithKind("ScanSettingBinding")
+}
+
+// NewScanSettingBindingBuilder creates a new instance of ScanSettingBindingBuilder. The binding references the
+// default ScanSetting until WithSettingsRef is used.
+func NewScanSettingBindingBuilder(apiClient *clients.Settings, name, nsname string) *ScanSettingBindingBuilder {
+	klog.V(100).Infof(
+		"Initializing new ScanSettingBinding structure with the following params: name: %s, nsname: %s", name, nsname)
+
+	builder := common.NewNamespacedBuilder[compliancev1alpha1.ScanSettingBinding, ScanSettingBindingBuilder](
+		apiClient, compliancev1alpha1.AddToScheme, name, nsname)
+	if builder.GetError() != nil {
+		return builder
+	}
+
+	builder.Definition.SettingsRef = &compliancev1alpha1.NamedObjectReference{
+		Name:     DefaultScanSettingName,
+		Kind:     ScanSettingKind,
+		APIGroup: compliancev1alpha1.GroupVersion.String(),
+	}
+
+	return builder
+}
+
+// PullScanSettingBinding pulls an existing ScanSettingBinding from the cluster.
+func PullScanSettingBinding(apiClient *clients.Settings, name, nsname string) (*ScanSettingBindingBuilder, error) {
+	klog.V(100).Infof("Pulling existing ScanSettingBinding %s in namespace %s from cluster", name, nsname)
+
+	return common.PullNamespacedBuilder[compliancev1alpha1.ScanSettingBinding, ScanSettingBindingBuilder](
+		context.TODO(), apiClient, compliancev1alpha1.AddToScheme, name, nsname)
+}
+
+// ListScanSettingBindings returns a list of ScanSettingBinding builders matching the provided options.
+func ListScanSettingBindings(
+	apiClient *clients.Settings, options ...runtimeclient.ListOption) ([]*ScanSettingBindingBuilder, error) {
+	return common.List[compliancev1alpha1.ScanSettingBinding, compliancev1alpha1.ScanSettingBindingList,
+		ScanSettingBindingBuilder](context.TODO(), apiClient, compliancev1alpha1.AddToScheme, options...)
+}
+
+// WithProfile adds a profile to the ScanSettingBinding. The kind must be either Profile or TailoredProfile.
+func (builder *ScanSettingBindingBuilder) WithProfile(name, kind string) *ScanSettingBindingBuilder {
+	if err := common.Validate(builder); err != nil {
+		return builder
+	}
+
+	klog.V(100).Infof("Adding %s %s to ScanSettingBinding %s in namespace %s",
+		kind, name, builder.Definition.Name, builder.Definition.Namespace)
+
+	if name == "" {
+		klog.V(100).Info("The profile name is empty")
+
+		builder.SetError(fmt.Errorf("scansettingbinding profile name cannot be empty"))
+
+		return builder
+	}
+
+	if kind != ProfileKind && kind != TailoredProfileKind {
+		klog.V(100).Infof("The profile kind %s is not supported", kind)
+
+		builder.SetError(fmt.Errorf("scansettingbinding profile kind must be %s or %s, got %q",
+			ProfileKind, TailoredProfileKind, kind))
+
+		return builder
+	}
+
+	builder.Definition.Profiles = append(builder.Definition.Profiles, compliancev1alpha1.NamedObjectReference{
+		Name:     name,
+		Kind:     kind,
+		APIGroup: compliancev1alpha1.GroupVersion.String(),
+	})
+
+	return builder
+}
+
+// WithSettingsRef sets the ScanSetting used by the ScanSettingBinding.
+func (builder *ScanSettingBindingBuilder) WithSettingsRef(scanSettingName string) *ScanSettingBindingBuilder {
+	if err := common.Validate(builder); err != nil {
+		return builder
+	}
+
+	klog.V(100).Infof("Setting ScanSetting of ScanSettingBinding %s in namespace %s to %s",
+		builder.Definition.Name, builder.Definition.Namespace, scanSettingName)
+
+	if scanSettingName == "" {
+		klog.V(100).Info("The ScanSetting name is empty")
+
+		builder.SetError(fmt.Errorf("scansettingbinding 'settingsRef' name cannot be empty"))
+
+		return builder
+	}
+
+	builder.Definition.SettingsRef = &compliancev1alpha1.NamedObjectReference{
+		Name:     scanSettingName,
+		Kind:     ScanSettingKind,
+		APIGroup: compliancev1alpha1.GroupVersion.String(),
+	}
+
+	return builder
+}
+
+// ListScans returns the ComplianceScans generated by this ScanSettingBinding. The operator creates a ComplianceSuite
+// with the same name as the binding and labels each scan with it.
+func (builder *ScanSettingBindingBuilder) ListScans() ([]*ComplianceScanBuilder, error) {
+	if err := common.Validate(builder); err != nil {
+		return nil, err
+	}
+
+	klog.V(100).Infof("Listing ComplianceScans of ScanSettingBinding %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	return common.List[compliancev1alpha1.ComplianceScan, compliancev1alpha1.ComplianceScanList,
+		ComplianceScanBuilder](context.TODO(), builder.GetClient(), compliancev1alpha1.AddToScheme,
+		builder.suiteListOptions()...)
+}
+
+// WaitForScanDone waits up to timeout for all the ComplianceScans generated by this ScanSettingBinding to reach the
+// DONE phase. At least one scan must exist for the wait to succeed.
+func (builder *ScanSettingBindingBuilder) WaitForScanDone(timeout time.Duration) (*ScanSettingBindingBuilder, error) {
+	if err := common.Validate(builder); err != nil {
+		return builder, err
+	}
+
+	klog.V(100).Infof("Waiting up to %s for scans of ScanSettingBinding %s in namespace %s to be done",
+		timeout, builder.Definition.Name, builder.Definition.Namespace)
+
+	err := wait.PollUntilContextTimeout(
+		context.TODO(), time.Second, timeout, true, func(ctx context.Context) (bool, error) {
+			scans, err := builder.ListScans()
+			if err != nil {
+				klog.V(100).Infof("Failed to list scans of ScanSettingBinding %s in namespace %s: %v",
+					builder.Definition.Name, builder.Definition.Namespace, err)
+
+				return false, nil
+			}
+
+			if len(scans) == 0 {
+				return false, nil
+			}
+
+			for _, scan := range scans {
+				if scan.Object.Status.Phase != compliancev1alpha1.PhaseDone {
+					klog.V(100).Infof("ComplianceScan %s is in phase %s", scan.Object.Name, scan.Object.Status.Phase)
+
+					return false, nil
+				}
+			}
+
+			return true, nil
+		})
+
+	return builder, err
+}
+
+// GetResultSummary aggregates the ComplianceCheckResults of all the scans generated by this ScanSettingBinding.
+func (builder *ScanSettingBindingBuilder) GetResultSummary() (*CheckResultSummary, error) {
+	if err := common.Validate(builder); err != nil {
+		return nil, err
+	}
+
+	klog.V(100).Infof("Getting result summary of ScanSettingBinding %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	return getCheckResultSummary(builder.GetClient(), builder.suiteListOptions()...)
+}
+
+// ListRemediations returns the ComplianceRemediations generated by the scans of this ScanSettingBinding.
+func (builder *ScanSettingBindingBuilder) ListRemediations() ([]*RemediationBuilder, error) {
+	if err := common.Validate(builder); err != nil {
+		return nil, err
+	}
+
+	klog.V(100).Infof("Listing ComplianceRemediations of ScanSettingBinding %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	return common.List[compliancev1alpha1.ComplianceRemediation, compliancev1alpha1.ComplianceRemediationList,
+		RemediationBuilder](context.TODO(), builder.GetClient(), compliancev1alpha1.AddToScheme,
+		builder.suiteListOptions()...)
+}
+
+// suiteListOptions returns the list options selecting objects that belong to the suite generated by this binding.
+func (builder *ScanSettingBindingBuilder) suiteListOptions() []runtimeclient.ListOption {
+	return []runtimeclient.ListOption{
+		runtimeclient.InNamespace(builder.Definition.Namespace),
+		runtimeclient.MatchingLabels{compliancev1alpha1.SuiteLabel: builder.Definition.Name},
+	}
+}
+
+// GetScanSettingBindingGVR returns ScanSettingBinding's GroupVersionResource which could be used for Clean function.
+func GetScanSettingBindingGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group: "compliance.openshift.io", Version: "v1alpha1", Resource: "scansettingbindings",
+	}
+}
@@ -0,0 +1,259 @@
+package compliance
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common/testhelper"
+	compliancev1alpha1 "github.com/rh-ecosystem-edge/eco-goinfra/pkg/schemes/compliance/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	defaultScanSettingBindingName = "cis-compliance"
+	defaultComplianceNamespace    = "openshift-compliance"
+	defaultProfileName            = "ocp4-cis"
+)
+
+var scanSettingBindingGVK = compliancev1alpha1.GroupVersion.WithKind("ScanSettingBinding")
+
+func TestNewScanSettingBindingBuilder(t *testing.T) {
+	t.Parallel()
+
+	t.Run("common namespaced builder behavior", func(t *testing.T) {
+		t.Parallel()
+
+		testhelper.NewNamespacedBuilderTestConfig(
+			NewScanSettingBindingBuilder, compliancev1alpha1.AddToScheme, scanSettingBindingGVK).ExecuteTests(t)
+	})
+
+	t.Run("default settingsRef is set", func(t *testing.T) {
+		t.Parallel()
+
+		testBuilder := buildValidScanSettingBindingTestBuilder(clients.GetTestClients(clients.TestClientParams{}))
+
+		require.NoError(t, testBuilder.GetError())
+		require.NotNil(t, testBuilder.Definition.SettingsRef)
+		assert.Equal(t, DefaultScanSettingName, testBuilder.Definition.SettingsRef.Name)
+		assert.Equal(t, ScanSettingKind, testBuilder.Definition.SettingsRef.Kind)
+	})
+}
+
+func TestPullScanSettingBinding(t *testing.T) {
+	t.Parallel()
+
+	testhelper.NewNamespacedPullTestConfig(
+		PullScanSettingBinding, compliancev1alpha1.AddToScheme, scanSettingBindingGVK).ExecuteTests(t)
+}
+
+func TestListScanSettingBindings(t *testing.T) {
+	t.Parallel()
+
+	testhelper.NewListTestConfig(
+		ListScanSettingBindings, compliancev1alpha1.AddToScheme, scanSettingBindingGVK).ExecuteTests(t)
+}
+
+func TestScanSettingBindingMethods(t *testing.T) {
+	t.Parallel()
+
+	commonTestConfig := testhelper.NewCommonTestConfig[compliancev1alpha1.ScanSettingBinding, ScanSettingBindingBuilder](
+		compliancev1alpha1.AddToScheme,
+		scanSettingBindingGVK,
+		testhelper.ResourceScopeNamespaced,
+	)
+
+	testhelper.NewTestSuite().
+		With(testhelper.NewGetTestConfig(commonTestConfig)).
+		With(testhelper.NewExistsTestConfig(commonTestConfig)).
+		With(testhelper.NewCreateTestConfig(commonTestConfig)).
+		With(testhelper.NewDeleterTestConfig(commonTestConfig)).
+		With(testhelper.NewUpdateTestConfig(commonTestConfig)).
+		Run(t)
+}
+
+func TestScanSettingBindingWithProfile(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		profileName   string
+		kind          string
+		expectedError string
+	}{
+		{
+			name:        "valid profile",
+			profileName: defaultProfileName,
+			kind:        ProfileKind,
+		},
+		{
+			name:        "valid tailored profile",
+			profileName: "ocp4-cis-tailored",
+			kind:        TailoredProfileKind,
+		},
+		{
+			name:          "empty profile name",
+			kind:          ProfileKind,
+			expectedError: "scansettingbinding profile name cannot be empty",
+		},
+		{
+			name:          "invalid kind",
+			profileName:   defaultProfileName,
+			kind:          "Rule",
+			expectedError: "scansettingbinding profile kind must be Profile or TailoredProfile, got \"Rule\"",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			testBuilder := buildValidScanSettingBindingTestBuilder(clients.GetTestClients(clients.TestClientParams{})).
+				WithProfile(testCase.profileName, testCase.kind)
+
+			if testCase.expectedError != "" {
+				assert.EqualError(t, testBuilder.GetError(), testCase.expectedError)
+
+				return
+			}
+
+			assert.NoError(t, testBuilder.GetError())
+			assert.Equal(t, []compliancev1alpha1.NamedObjectReference{{
+				Name:     testCase.profileName,
+				Kind:     testCase.kind,
+				APIGroup: compliancev1alpha1.GroupVersion.String(),
+			}}, testBuilder.Definition.Profiles)
+		})
+	}
+}
+
+func TestScanSettingBindingWithSettingsRef(t *testing.T) {
+	t.Parallel()
+
+	testBuilder := buildValidScanSettingBindingTestBuilder(clients.GetTestClients(clients.TestClientParams{})).
+		WithSettingsRef("periodic-setting")
+	assert.NoError(t, testBuilder.GetError())
+	assert.Equal(t, "periodic-setting", testBuilder.Definition.SettingsRef.Name)
+
+	testBuilder = testBuilder.WithSettingsRef("")
+	assert.EqualError(t, testBuilder.GetError(), "scansettingbinding 'settingsRef' name cannot be empty")
+}
+
+func TestScanSettingBindingWaitForScanDone(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		phases        []compliancev1alpha1.ComplianceScanStatusPhase
+		expectedError error
+	}{
+		{
+			name:   "all scans done",
+			phases: []compliancev1alpha1.ComplianceScanStatusPhase{compliancev1alpha1.PhaseDone, compliancev1alpha1.PhaseDone},
+		},
+		{
+			name: "one scan still running",
+			phases: []compliancev1alpha1.ComplianceScanStatusPhase{
+				compliancev1alpha1.PhaseDone, compliancev1alpha1.PhaseRunning},
+			expectedError: context.DeadlineExceeded,
+		},
+		{
+			name:          "no scans generated",
+			expectedError: context.DeadlineExceeded,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			var runtimeObjects []runtime.Object
+
+			for index, phase := range testCase.phases {
+				scan := buildDummyComplianceScan(defaultProfileName + "-" + string(rune('a'+index)))
+				scan.Status.Phase = phase
+
+				runtimeObjects = append(runtimeObjects, scan)
+			}
+
+			testBuilder := buildValidScanSettingBindingTestBuilder(buildTestClientWithComplianceObjects(runtimeObjects))
+
+			_, err := testBuilder.WaitForScanDone(time.Second)
+			if testCase.expectedError == nil {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, testCase.expectedError)
+			}
+		})
+	}
+}
+
+func TestScanSettingBindingGetResultSummary(t *testing.T) {
+	t.Parallel()
+
+	otherSuiteResult := buildDummyCheckResult("other-check", compliancev1alpha1.CheckResultFail)
+	otherSuiteResult.Labels[compliancev1alpha1.SuiteLabel] = "other-suite"
+
+	testBuilder := buildValidScanSettingBindingTestBuilder(buildTestClientWithComplianceObjects([]runtime.Object{
+		buildDummyCheckResult("check-pass", compliancev1alpha1.CheckResultPass),
+		buildDummyCheckResult("check-fail", compliancev1alpha1.CheckResultFail),
+		buildDummyCheckResult("check-error", compliancev1alpha1.CheckResultError),
+		otherSuiteResult,
+	}))
+
+	summary, err := testBuilder.GetResultSummary()
+	assert.NoError(t, err)
+	assert.Equal(t, 1, summary.Pass)
+	assert.Equal(t, 1, summary.Fail)
+	assert.Equal(t, 1, summary.Error)
+	assert.Equal(t, 3, summary.Total())
+	assert.Equal(t, []string{"check-fail"}, summary.FailedChecks)
+	assert.Equal(t, []string{"check-error"}, summary.ErroredChecks)
+}
+
+func TestScanSettingBindingListRemediations(t *testing.T) {
+	t.Parallel()
+
+	testBuilder := buildValidScanSettingBindingTestBuilder(buildTestClientWithComplianceObjects([]runtime.Object{
+		buildDummyRemediation(defaultRemediationName),
+	}))
+
+	remediations, err := testBuilder.ListRemediations()
+	assert.NoError(t, err)
+	assert.Len(t, remediations, 1)
+}
+
+func buildTestClientWithComplianceObjects(objects []runtime.Object) *clients.Settings {
+	return clients.GetTestClients(clients.TestClientParams{
+		K8sMockObjects:  objects,
+		SchemeAttachers: []clients.SchemeAttacher{compliancev1alpha1.AddToScheme},
+	})
+}
+
+func buildValidScanSettingBindingTestBuilder(apiClient *clients.Settings) *ScanSettingBindingBuilder {
+	return NewScanSettingBindingBuilder(apiClient, defaultScanSettingBindingName, defaultComplianceNamespace)
+}
+
+// buildSuiteLabels returns the labels the operator sets on objects generated for the default ScanSettingBinding.
+func buildSuiteLabels(scanName string) map[string]string {
+	return map[string]string{
+		compliancev1alpha1.SuiteLabel:          defaultScanSettingBindingName,
+		compliancev1alpha1.ComplianceScanLabel: scanName,
+	}
+}
+
+func buildDummyCheckResult(name string, status compliancev1alpha1.ComplianceCheckStatus) *compliancev1alpha1.ComplianceCheckResult {
+	return &compliancev1alpha1.ComplianceCheckResult{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: defaultComplianceNamespace,
+			Labels:    buildSuiteLabels(defaultProfileName),
+		},
+		ID:     name,
+		Status: status,
+	}
+}
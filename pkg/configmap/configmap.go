@@ -36,6 +36,16 @@ func (builder *Builder) GetGVK() schema.GroupVersionKind {
 	return corev1.SchemeGroupVersion.WithKind("ConfigMap")
 }
 
+// GetImmutableFields returns the data of the configmap as immutable once the configmap on the cluster is marked
+// immutable.
+func (builder *Builder) GetImmutableFields(latest *corev1.ConfigMap) []string {
+	if latest.Immutable == nil || !*latest.Immutable {
+		return nil
+	}
+
+	return []string{"data", "binaryData", "immutable"}
+}
+
 // Pull retrieves an existing configmap object from the cluster.
 func Pull(apiClient *clients.Settings, name, nsname string) (*Builder, error) {
 	return common.PullNamespacedBuilder[corev1.ConfigMap, Builder](
@@ -9,6 +9,9 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
 )
 
 var configMapGVK = corev1.SchemeGroupVersion.WithKind("ConfigMap")
@@ -47,6 +50,12 @@ func TestWithOptions(t *testing.T) {
 	testhelper.NewWithOptionsTestConfig(newConfigMapCommonTestConfig()).ExecuteTests(t)
 }
 
+func TestWithDefaults(t *testing.T) {
+	t.Parallel()
+
+	testhelper.NewWithDefaultsTestConfig(newConfigMapCommonTestConfig()).ExecuteTests(t)
+}
+
 func TestWithData(t *testing.T) {
 	t.Parallel()
 
@@ -109,6 +118,44 @@ func TestWithData(t *testing.T) {
 	}
 }
 
+func TestUpdateImmutable(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		immutable     *bool
+		expectedError bool
+	}{
+		{name: "mutable configmap", immutable: ptr.To(false)},
+		{name: "immutable configmap", immutable: ptr.To(true), expectedError: true},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			testSettings := clients.GetTestClients(clients.TestClientParams{
+				K8sMockObjects: []runtime.Object{&corev1.ConfigMap{
+					ObjectMeta: metav1.ObjectMeta{Name: "test-name", Namespace: "test-namespace"},
+					Data:       map[string]string{"key": "value"},
+					Immutable:  testCase.immutable,
+				}},
+				SchemeAttachers: []clients.SchemeAttacher{corev1.AddToScheme},
+			})
+
+			builder, err := Pull(testSettings, "test-name", "test-namespace")
+			require.NoError(t, err)
+
+			_, err = builder.WithData(map[string]string{"key": "other"}).Update()
+			assert.Equal(t, testCase.expectedError, commonerrors.IsImmutableFieldChange(err))
+
+			if !testCase.expectedError {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestGetGVR(t *testing.T) {
 	t.Parallel()
 
@@ -15,6 +15,8 @@ import (
 
 // List returns configmap inventory in the given namespace.
 func List(apiClient *clients.Settings, nsname string, options ...metav1.ListOptions) ([]*Builder, error) {
+	nsname = apiClient.ResolveNamespace(nsname)
+
 	if nsname == "" {
 		klog.V(100).Info("configmap 'nsname' parameter can not be empty")
 
@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 
 	configv1 "github.com/openshift/api/config/v1"
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
@@ -223,6 +224,13 @@ func (builder *Builder) Update() (*Builder, error) {
 	return builder, nil
 }
 
+// GetConsoleGVR returns Console's GroupVersionResource which could be used for Clean function.
+func GetConsoleGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group: "config.openshift.io", Version: "v1", Resource: "consoles",
+	}
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *Builder) validate() (bool, error) {
@@ -8,6 +8,7 @@ import (
 	goclient "sigs.k8s.io/controller-runtime/pkg/client"
 
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 
 	operatorv1 "github.com/openshift/api/operator/v1"
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
@@ -169,6 +170,13 @@ func (builder *ConsoleOperatorBuilder) WithPlugins(newPluginsList []string, rede
 	return builder
 }
 
+// GetConsoleOperatorGVR returns Console's GroupVersionResource which could be used for Clean function.
+func GetConsoleOperatorGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group: "operator.openshift.io", Version: "v1", Resource: "consoles",
+	}
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *ConsoleOperatorBuilder) validate() (bool, error) {
@@ -0,0 +1,73 @@
+package crd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common"
+	apiextv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/klog/v2"
+	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Builder provides a struct for the CustomResourceDefinition resource containing a connection to the cluster and the
+// CustomResourceDefinition definition. CRDs are usually installed by operators, so the builder only supports pulling,
+// listing and deleting them.
+type Builder struct {
+	common.EmbeddableBuilder[apiextv1.CustomResourceDefinition, *apiextv1.CustomResourceDefinition]
+	common.EmbeddableDeleter[apiextv1.CustomResourceDefinition, *apiextv1.CustomResourceDefinition]
+}
+
+// AttachMixins wires the embedded CRUD mixins to this builder instance.
+func (builder *Builder) AttachMixins() {
+	builder.EmbeddableDeleter.SetBase(builder)
+}
+
+// GetGVK returns the CustomResourceDefinition GVK for this builder.
+func (builder *Builder) GetGVK() schema.GroupVersionKind {
+	return apiextv1.SchemeGroupVersion.WithKind("CustomResourceDefinition")
+}
+
+// Pull pulls an existing CustomResourceDefinition, such as ptpconfigs.ptp.openshift.io, from the cluster.
+func Pull(apiClient *clients.Settings, name string) (*Builder, error) {
+	klog.V(100).Infof("Pulling existing CustomResourceDefinition %s from cluster", name)
+
+	return common.PullClusterScopedBuilder[apiextv1.CustomResourceDefinition, Builder](
+		context.TODO(), apiClient, apiextv1.AddToScheme, name)
+}
+
+// List returns the CustomResourceDefinitions on the cluster.
+func List(apiClient *clients.Settings, options ...runtimeclient.ListOption) ([]*Builder, error) {
+	return common.List[apiextv1.CustomResourceDefinition, apiextv1.CustomResourceDefinitionList, Builder](
+		context.TODO(), apiClient, apiextv1.AddToScheme, options...)
+}
+
+// GetServedVersions returns the versions of the CustomResourceDefinition that are served by the API server.
+func (builder *Builder) GetServedVersions() ([]string, error) {
+	if err := common.Validate(builder); err != nil {
+		return nil, err
+	}
+
+	if !builder.Exists() {
+		return nil, fmt.Errorf("customResourceDefinition object %s does not exist", builder.Definition.Name)
+	}
+
+	var versions []string
+
+	for _, version := range builder.Object.Spec.Versions {
+		if version.Served {
+			versions = append(versions, version.Name)
+		}
+	}
+
+	return versions, nil
+}
+
+// GetGVR returns CustomResourceDefinition's GroupVersionResource which could be used for Clean function.
+func GetGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group: "apiextensions.k8s.io", Version: "v1", Resource: "customresourcedefinitions",
+	}
+}
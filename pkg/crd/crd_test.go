@@ -0,0 +1,108 @@
+package crd
+
+import (
+	"testing"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common/testhelper"
+	"github.com/stretchr/testify/assert"
+	apiextv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const defaultCRDName = "ptpconfigs.ptp.openshift.io"
+
+var crdGVK = apiextv1.SchemeGroupVersion.WithKind("CustomResourceDefinition")
+
+func TestPull(t *testing.T) {
+	t.Parallel()
+
+	testhelper.NewClusterScopedPullTestConfig(Pull, apiextv1.AddToScheme, crdGVK).ExecuteTests(t)
+}
+
+func TestList(t *testing.T) {
+	t.Parallel()
+
+	testhelper.NewListTestConfig(List, apiextv1.AddToScheme, crdGVK).ExecuteTests(t)
+}
+
+func TestCRDMethods(t *testing.T) {
+	t.Parallel()
+
+	commonTestConfig := testhelper.NewCommonTestConfig[apiextv1.CustomResourceDefinition, Builder](
+		apiextv1.AddToScheme,
+		crdGVK,
+		testhelper.ResourceScopeClusterScoped,
+	)
+
+	testhelper.NewTestSuite().
+		With(testhelper.NewGetTestConfig(commonTestConfig)).
+		With(testhelper.NewExistsTestConfig(commonTestConfig)).
+		With(testhelper.NewDeleterTestConfig(commonTestConfig)).
+		Run(t)
+}
+
+func TestGetServedVersions(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name             string
+		exists           bool
+		expectedVersions []string
+		expectedError    string
+	}{
+		{
+			name:             "served versions are returned",
+			exists:           true,
+			expectedVersions: []string{"v1", "v2"},
+		},
+		{
+			name:          "crd does not exist",
+			exists:        false,
+			expectedError: "customResourceDefinition object ptpconfigs.ptp.openshift.io does not exist",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			testSettings := clients.GetTestClients(clients.TestClientParams{
+				K8sMockObjects:  []runtime.Object{buildDummyCRD()},
+				SchemeAttachers: []clients.SchemeAttacher{apiextv1.AddToScheme},
+			})
+
+			testBuilder, err := Pull(testSettings, defaultCRDName)
+			assert.NoError(t, err)
+
+			if !testCase.exists {
+				assert.NoError(t, testBuilder.Delete())
+			}
+
+			versions, err := testBuilder.GetServedVersions()
+
+			if testCase.expectedError != "" {
+				assert.EqualError(t, err, testCase.expectedError)
+
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.expectedVersions, versions)
+		})
+	}
+}
+
+func buildDummyCRD() *apiextv1.CustomResourceDefinition {
+	return &apiextv1.CustomResourceDefinition{
+		ObjectMeta: metav1.ObjectMeta{Name: defaultCRDName},
+		Spec: apiextv1.CustomResourceDefinitionSpec{
+			Versions: []apiextv1.CustomResourceDefinitionVersion{
+				{Name: "v1", Served: true},
+				{Name: "v1alpha1", Served: false},
+				{Name: "v2", Served: true},
+			},
+		},
+	}
+}
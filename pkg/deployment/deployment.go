@@ -11,8 +11,13 @@ import (
 	multus "gopkg.in/k8snetworkplumbingwg/multus-cni.v4/pkg/types"
 
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common"
+	commonkey "github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common/key"
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/logging"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/podspec"
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/msg"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/pod"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/progress"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
@@ -41,6 +46,8 @@ type AdditionalOptions func(builder *Builder) (*Builder, error)
 // NewBuilder creates a new instance of Builder.
 func NewBuilder(
 	apiClient *clients.Settings, name, nsname string, labels map[string]string, containerSpec corev1.Container) *Builder {
+	nsname = apiClient.ResolveNamespace(nsname)
+
 	klog.V(100).Infof(
 		"Initializing new deployment structure with the following params: "+
 			"name: %s, namespace: %s, labels: %s, containerSpec %v",
@@ -97,6 +104,8 @@ func NewBuilder(
 
 // Pull loads an existing deployment into Builder struct.
 func Pull(apiClient *clients.Settings, name, nsname string) (*Builder, error) {
+	nsname = apiClient.ResolveNamespace(nsname)
+
 	// Safeguard against nil apiClient interfaces.
 	if apiClient == nil {
 		klog.V(100).Info("The apiClient is nil")
@@ -187,6 +196,72 @@ func (builder *Builder) WithAdditionalContainerSpecs(specs []corev1.Container) *
 	return builder
 }
 
+// WithAdditionalInitContainerSpecs appends a list of init container specs to the deployment definition.
+func (builder *Builder) WithAdditionalInitContainerSpecs(specs []corev1.Container) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	klog.V(100).Infof("Appending a list of init container specs %v to deployment %s in namespace %s",
+		specs, builder.Definition.Name, builder.Definition.Namespace)
+
+	if len(specs) == 0 {
+		klog.V(100).Info("The init container specs are empty")
+
+		builder.errorMsg = "cannot accept empty list as init container specs"
+
+		return builder
+	}
+
+	builder.Definition.Spec.Template.Spec.InitContainers = append(
+		builder.Definition.Spec.Template.Spec.InitContainers, specs...)
+
+	return builder
+}
+
+// WithContainerBuilder appends the containers defined by the provided container builders to the deployment definition.
+func (builder *Builder) WithContainerBuilder(containerBuilders ...*pod.ContainerBuilder) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	klog.V(100).Infof("Appending %d containers from container builders to deployment %s in namespace %s",
+		len(containerBuilders), builder.Definition.Name, builder.Definition.Namespace)
+
+	specs, err := getContainerSpecs(containerBuilders)
+	if err != nil {
+		klog.V(100).Infof("Failed to get container specs from container builders: %v", err)
+
+		builder.errorMsg = err.Error()
+
+		return builder
+	}
+
+	return builder.WithAdditionalContainerSpecs(specs)
+}
+
+// WithInitContainerBuilder appends the containers defined by the provided container builders to the deployment
+// definition as init containers.
+func (builder *Builder) WithInitContainerBuilder(containerBuilders ...*pod.ContainerBuilder) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	klog.V(100).Infof("Appending %d init containers from container builders to deployment %s in namespace %s",
+		len(containerBuilders), builder.Definition.Name, builder.Definition.Namespace)
+
+	specs, err := getContainerSpecs(containerBuilders)
+	if err != nil {
+		klog.V(100).Infof("Failed to get init container specs from container builders: %v", err)
+
+		builder.errorMsg = err.Error()
+
+		return builder
+	}
+
+	return builder.WithAdditionalInitContainerSpecs(specs)
+}
+
 // WithSecondaryNetwork applies Multus secondary network configuration on deployment definition.
 func (builder *Builder) WithSecondaryNetwork(networks []*multus.NetworkSelectionElement) *Builder {
 	if valid, _ := builder.validate(); !valid {
@@ -276,6 +351,14 @@ func (builder *Builder) WithLabel(labelKey, labelValue string) *Builder {
 		return builder
 	}
 
+	if err := common.ValidateLabel(labelKey, labelValue); err != nil {
+		klog.V(100).Infof("Invalid label: %v", err)
+
+		builder.errorMsg = err.Error()
+
+		return builder
+	}
+
 	if builder.Definition.Spec.Template.Labels == nil {
 		builder.Definition.Spec.Template.Labels = map[string]string{}
 	}
@@ -389,6 +472,46 @@ func (builder *Builder) WithHostNetwork(enableHostnetwork bool) *Builder {
 	return builder
 }
 
+// WithTolerations appends the provided tolerations to the deployment pod template. None of the
+// tolerations can be empty.
+func (builder *Builder) WithTolerations(tolerations ...corev1.Toleration) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	klog.V(100).Infof("Adding tolerations %v to deployment %s in namespace %s",
+		tolerations, builder.Definition.Name, builder.Definition.Namespace)
+
+	if err := podspec.WithTolerations(&builder.Definition.Spec.Template.Spec, tolerations); err != nil {
+		klog.V(100).Infof("Failed to add tolerations to deployment %s in namespace %s: %v",
+			builder.Definition.Name, builder.Definition.Namespace, err)
+
+		builder.errorMsg = err.Error()
+	}
+
+	return builder
+}
+
+// WithRuntimeClass sets the runtimeClassName of the deployment pod template, such as the one
+// created by a performance profile.
+func (builder *Builder) WithRuntimeClass(runtimeClassName string) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	klog.V(100).Infof("Setting runtimeClassName %s on deployment %s in namespace %s",
+		runtimeClassName, builder.Definition.Name, builder.Definition.Namespace)
+
+	if err := podspec.WithRuntimeClass(&builder.Definition.Spec.Template.Spec, runtimeClassName); err != nil {
+		klog.V(100).Infof("Failed to set runtimeClassName on deployment %s in namespace %s: %v",
+			builder.Definition.Name, builder.Definition.Namespace, err)
+
+		builder.errorMsg = err.Error()
+	}
+
+	return builder
+}
+
 // WithOptions creates deployment with generic mutation options.
 func (builder *Builder) WithOptions(options ...AdditionalOptions) *Builder {
 	if valid, _ := builder.validate(); !valid {
@@ -438,7 +561,17 @@ func (builder *Builder) Update() (*Builder, error) {
 
 	klog.V(100).Infof("Updating deployment %s in namespace %s", builder.Definition.Name, builder.Definition.Namespace)
 
-	var err error
+	latestDeployment, err := builder.apiClient.Deployments(builder.Definition.Namespace).Get(
+		logging.DiscardContext(), builder.Definition.Name, metav1.GetOptions{})
+	if err == nil {
+		// The selector of a deployment cannot be changed once it is created.
+		err = common.ValidateImmutableFields(
+			commonkey.NewResourceKey("Deployment", builder.Definition.Name, builder.Definition.Namespace),
+			latestDeployment, builder.Definition, "spec.selector")
+		if err != nil {
+			return builder, err
+		}
+	}
 
 	builder.Object, err = builder.apiClient.Deployments(builder.Definition.Namespace).Update(
 		logging.DiscardContext(), builder.Definition, metav1.UpdateOptions{})
@@ -641,6 +774,14 @@ func (builder *Builder) Exists() bool {
 // WaitUntilCondition waits for the duration of the defined timeout or until the
 // deployment gets to a specific condition.
 func (builder *Builder) WaitUntilCondition(condition appsv1.DeploymentConditionType, timeout time.Duration) error {
+	return builder.WaitUntilConditionWithContext(context.TODO(), condition, timeout)
+}
+
+// WaitUntilConditionWithContext waits for the duration of the defined timeout or until the deployment gets to a
+// specific condition. The status of the condition and the ready replicas are reported after every attempt to the
+// progress.Reporter attached to ctx, if any.
+func (builder *Builder) WaitUntilConditionWithContext(
+	ctx context.Context, condition appsv1.DeploymentConditionType, timeout time.Duration) error {
 	if valid, err := builder.validate(); !valid {
 		return err
 	}
@@ -652,21 +793,31 @@ func (builder *Builder) WaitUntilCondition(condition appsv1.DeploymentConditionT
 		return fmt.Errorf("cannot wait for deployment condition because it does not exist")
 	}
 
-	return wait.PollUntilContextTimeout(
-		context.TODO(), time.Second, timeout, true, func(ctx context.Context) (bool, error) {
+	operation := fmt.Sprintf("deployment %s/%s to have condition %s",
+		builder.Definition.Namespace, builder.Definition.Name, condition)
+
+	return progress.PollUntilContextTimeout(
+		ctx, time.Second, timeout, true, operation, func(ctx context.Context) (bool, string, error) {
 			updateDeployment, err := builder.apiClient.Deployments(builder.Definition.Namespace).Get(
-				logging.DiscardContext(), builder.Definition.Name, metav1.GetOptions{})
+				logging.WithDiscardLogger(ctx), builder.Definition.Name, metav1.GetOptions{})
 			if err != nil {
-				return false, nil
+				return false, "", nil
 			}
 
+			state := fmt.Sprintf("%s not reported", condition)
+
 			for _, cond := range updateDeployment.Status.Conditions {
-				if cond.Type == condition && cond.Status == corev1.ConditionTrue {
-					return true, nil
+				if cond.Type == condition {
+					if cond.Status == corev1.ConditionTrue {
+						return true, "", nil
+					}
+
+					state = fmt.Sprintf("%s=%s", cond.Type, cond.Status)
 				}
 			}
 
-			return false, nil
+			return false, fmt.Sprintf("%s, ready replicas %d/%d",
+				state, updateDeployment.Status.ReadyReplicas, updateDeployment.Status.Replicas), nil
 		})
 }
 
@@ -767,3 +918,28 @@ func (builder *Builder) WithTerminationGracePeriodSeconds(terminationGracePeriod
 
 	return builder
 }
+
+// getContainerSpecs returns the container specs defined by containerBuilders, failing if any of them is nil or
+// invalid.
+func getContainerSpecs(containerBuilders []*pod.ContainerBuilder) ([]corev1.Container, error) {
+	if len(containerBuilders) == 0 {
+		return nil, fmt.Errorf("cannot accept empty list as container builders")
+	}
+
+	specs := make([]corev1.Container, 0, len(containerBuilders))
+
+	for index, containerBuilder := range containerBuilders {
+		if containerBuilder == nil {
+			return nil, fmt.Errorf("container builder at index %d is nil", index)
+		}
+
+		container, err := containerBuilder.GetContainerCfg()
+		if err != nil {
+			return nil, fmt.Errorf("container builder at index %d is invalid: %w", index, err)
+		}
+
+		specs = append(specs, *container)
+	}
+
+	return specs, nil
+}
@@ -7,6 +7,8 @@ import (
 	"time"
 
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	commonerrors "github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common/errors"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/pod"
 	"github.com/stretchr/testify/assert"
 	multus "gopkg.in/k8snetworkplumbingwg/multus-cni.v4/pkg/types"
 	appsv1 "k8s.io/api/apps/v1"
@@ -123,6 +125,152 @@ func TestPull(t *testing.T) {
 }
 
 // buildValidTestBuilder returns a valid Builder for testing purposes.
+func TestWithAdditionalInitContainerSpecs(t *testing.T) {
+	testCases := []struct {
+		specs          []corev1.Container
+		expectedErrMsg string
+	}{
+		{
+			specs:          []corev1.Container{{Name: "test-init-container"}},
+			expectedErrMsg: "",
+		},
+		{
+			specs:          []corev1.Container{},
+			expectedErrMsg: "cannot accept empty list as init container specs",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidTestBuilder().WithAdditionalInitContainerSpecs(testCase.specs)
+		assert.Equal(t, testCase.expectedErrMsg, testBuilder.errorMsg)
+
+		if testCase.expectedErrMsg == "" {
+			assert.Equal(t, testCase.specs, testBuilder.Definition.Spec.Template.Spec.InitContainers)
+		}
+	}
+}
+
+func TestWithContainerBuilder(t *testing.T) {
+	testCases := []struct {
+		containerBuilders []*pod.ContainerBuilder
+		expectedErrMsg    string
+	}{
+		{
+			containerBuilders: []*pod.ContainerBuilder{
+				pod.NewContainerBuilder("test-builder-container", "test-image", []string{"sleep", "INF"})},
+			expectedErrMsg: "",
+		},
+		{
+			containerBuilders: nil,
+			expectedErrMsg:    "cannot accept empty list as container builders",
+		},
+		{
+			containerBuilders: []*pod.ContainerBuilder{nil},
+			expectedErrMsg:    "container builder at index 0 is nil",
+		},
+		{
+			containerBuilders: []*pod.ContainerBuilder{pod.NewContainerBuilder("", "test-image", []string{"sleep", "INF"})},
+			expectedErrMsg:    "container builder at index 0 is invalid: container's name is empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidTestBuilder()
+		initialContainers := len(testBuilder.Definition.Spec.Template.Spec.Containers)
+
+		testBuilder = testBuilder.WithContainerBuilder(testCase.containerBuilders...)
+		assert.Equal(t, testCase.expectedErrMsg, testBuilder.errorMsg)
+
+		if testCase.expectedErrMsg == "" {
+			containers := testBuilder.Definition.Spec.Template.Spec.Containers
+			assert.Len(t, containers, initialContainers+1)
+			assert.Equal(t, "test-builder-container", containers[initialContainers].Name)
+		}
+	}
+}
+
+func TestWithInitContainerBuilder(t *testing.T) {
+	testCases := []struct {
+		containerBuilders []*pod.ContainerBuilder
+		expectedErrMsg    string
+	}{
+		{
+			containerBuilders: []*pod.ContainerBuilder{
+				pod.NewContainerBuilder("test-init-container", "test-image", []string{"true"})},
+			expectedErrMsg: "",
+		},
+		{
+			containerBuilders: []*pod.ContainerBuilder{nil},
+			expectedErrMsg:    "container builder at index 0 is nil",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidTestBuilder().WithInitContainerBuilder(testCase.containerBuilders...)
+		assert.Equal(t, testCase.expectedErrMsg, testBuilder.errorMsg)
+
+		if testCase.expectedErrMsg == "" {
+			initContainers := testBuilder.Definition.Spec.Template.Spec.InitContainers
+			assert.Len(t, initContainers, 1)
+			assert.Equal(t, "test-init-container", initContainers[0].Name)
+		}
+	}
+}
+
+func TestWithTolerations(t *testing.T) {
+	testCases := []struct {
+		tolerations    []corev1.Toleration
+		expectedErrMsg string
+	}{
+		{
+			tolerations:    []corev1.Toleration{{Key: "test-key", Operator: corev1.TolerationOpExists}},
+			expectedErrMsg: "",
+		},
+		{
+			tolerations:    nil,
+			expectedErrMsg: "tolerations cannot be empty",
+		},
+		{
+			tolerations:    []corev1.Toleration{{}},
+			expectedErrMsg: "toleration at index 0 cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidTestBuilder().WithTolerations(testCase.tolerations...)
+		assert.Equal(t, testCase.expectedErrMsg, testBuilder.errorMsg)
+
+		if testCase.expectedErrMsg == "" {
+			assert.Equal(t, testCase.tolerations, testBuilder.Definition.Spec.Template.Spec.Tolerations)
+		}
+	}
+}
+
+func TestWithRuntimeClass(t *testing.T) {
+	testCases := []struct {
+		runtimeClassName string
+		expectedErrMsg   string
+	}{
+		{
+			runtimeClassName: "test-runtime-class",
+			expectedErrMsg:   "",
+		},
+		{
+			runtimeClassName: "",
+			expectedErrMsg:   "runtimeClassName cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidTestBuilder().WithRuntimeClass(testCase.runtimeClassName)
+		assert.Equal(t, testCase.expectedErrMsg, testBuilder.errorMsg)
+
+		if testCase.expectedErrMsg == "" {
+			assert.Equal(t, testCase.runtimeClassName, *testBuilder.Definition.Spec.Template.Spec.RuntimeClassName)
+		}
+	}
+}
+
 func buildValidTestBuilder() *Builder {
 	return NewBuilder(&clients.Settings{
 		Client:          nil,
@@ -570,6 +718,9 @@ func TestUpdate(t *testing.T) {
 				Name:      "test-name",
 				Namespace: "test-namespace",
 			},
+			Spec: appsv1.DeploymentSpec{
+				Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"test-key": "test-value"}},
+			},
 		}
 	}
 
@@ -619,6 +770,28 @@ func TestUpdate(t *testing.T) {
 	}
 }
 
+func TestUpdateImmutableSelector(t *testing.T) {
+	testBuilder := buildTestBuilderWithFakeObjects([]runtime.Object{&appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-name",
+			Namespace: "test-namespace",
+		},
+		Spec: appsv1.DeploymentSpec{
+			Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"test-key": "test-value"}},
+		},
+	}})
+
+	testBuilder.Definition.Spec.Selector = &metav1.LabelSelector{MatchLabels: map[string]string{"test-key": "other"}}
+
+	_, err := testBuilder.Update()
+	assert.True(t, commonerrors.IsImmutableFieldChange(err))
+
+	testBuilder.Definition.Spec.Selector = &metav1.LabelSelector{MatchLabels: map[string]string{"test-key": "test-value"}}
+
+	_, err = testBuilder.Update()
+	assert.Nil(t, err)
+}
+
 func TestDelete(t *testing.T) {
 	generateTestDeployment := func() *appsv1.Deployment {
 		return &appsv1.Deployment{
@@ -11,6 +11,8 @@ import (
 
 // List returns deployment inventory in the given namespace.
 func List(apiClient *clients.Settings, nsname string, options ...metav1.ListOptions) ([]*Builder, error) {
+	nsname = apiClient.ResolveNamespace(nsname)
+
 	if nsname == "" {
 		klog.V(100).Info("deployment 'nsname' parameter can not be empty")
 
@@ -0,0 +1,123 @@
+package egressfirewall
+
+import (
+	"context"
+	"fmt"
+	"net"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/klog/v2"
+
+	egressfirewallv1 "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/crd/egressfirewall/v1"
+)
+
+// DefaultName is the only name OVN-Kubernetes accepts for an EgressFirewall, since a namespace can have at most one.
+const DefaultName = "default"
+
+// Builder provides a struct for the EgressFirewall resource containing a connection to the cluster and the
+// EgressFirewall definition. EgressFirewalls restrict the traffic leaving the pods of a namespace on OVN-Kubernetes
+// clusters, with rules evaluated in order and unmatched traffic allowed.
+type Builder struct {
+	common.EmbeddableBuilder[egressfirewallv1.EgressFirewall, *egressfirewallv1.EgressFirewall]
+	common.EmbeddableCreator[egressfirewallv1.EgressFirewall, Builder, *egressfirewallv1.EgressFirewall, *Builder]
+	common.EmbeddableDeleter[egressfirewallv1.EgressFirewall, *egressfirewallv1.EgressFirewall]
+	common.EmbeddableUpdater[egressfirewallv1.EgressFirewall, Builder, *egressfirewallv1.EgressFirewall, *Builder]
+}
+
+// AttachMixins wires the embedded CRUD mixins to this builder instance.
+func (builder *Builder) AttachMixins() {
+	builder.EmbeddableCreator.SetBase(builder)
+	builder.EmbeddableDeleter.SetBase(builder)
+	builder.EmbeddableUpdater.SetBase(builder)
+}
+
+// GetGVK returns the EgressFirewall GVK for this builder.
+func (builder *Builder) GetGVK() schema.GroupVersionKind {
+	return egressfirewallv1.SchemeGroupVersion.WithKind("EgressFirewall")
+}
+
+// NewBuilder creates a new instance of Builder without any rules. OVN-Kubernetes only accepts EgressFirewalls named
+// DefaultName.
+func NewBuilder(apiClient *clients.Settings, name, nsname string) *Builder {
+	return common.NewNamespacedBuilder[egressfirewallv1.EgressFirewall, Builder](
+		apiClient, egressfirewallv1.AddToScheme, name, nsname)
+}
+
+// Pull retrieves an existing EgressFirewall from the cluster.
+func Pull(apiClient *clients.Settings, name, nsname string) (*Builder, error) {
+	return common.PullNamespacedBuilder[egressfirewallv1.EgressFirewall, Builder](
+		context.TODO(), apiClient, egressfirewallv1.AddToScheme, name, nsname)
+}
+
+// WithRule appends the rule to the EgressFirewall. The rule must allow or deny traffic to exactly one of a CIDR, a DNS
+// name or the nodes matching a node selector.
+func (builder *Builder) WithRule(rule egressfirewallv1.EgressFirewallRule) *Builder {
+	if err := common.Validate(builder); err != nil {
+		return builder
+	}
+
+	klog.V(100).Infof("Adding rule to EgressFirewall %s in namespace %s: %v",
+		builder.Definition.Name, builder.Definition.Namespace, rule)
+
+	if rule.Type != egressfirewallv1.EgressFirewallRuleAllow && rule.Type != egressfirewallv1.EgressFirewallRuleDeny {
+		builder.SetError(fmt.Errorf("egressFirewall rule 'type' must be Allow or Deny, got %q", rule.Type))
+
+		return builder
+	}
+
+	destinations := 0
+
+	for _, set := range []bool{rule.To.CIDRSelector != "", rule.To.DNSName != "", rule.To.NodeSelector != nil} {
+		if set {
+			destinations++
+		}
+	}
+
+	if destinations != 1 {
+		builder.SetError(fmt.Errorf("egressFirewall rule must set exactly one of cidrSelector, dnsName and nodeSelector"))
+
+		return builder
+	}
+
+	if rule.To.CIDRSelector != "" {
+		if _, _, err := net.ParseCIDR(rule.To.CIDRSelector); err != nil {
+			builder.SetError(fmt.Errorf("egressFirewall rule 'cidrSelector' is invalid: %w", err))
+
+			return builder
+		}
+	}
+
+	builder.Definition.Spec.Egress = append(builder.Definition.Spec.Egress, rule)
+
+	return builder
+}
+
+// WithCIDRRule appends a rule allowing or denying traffic to the CIDR, optionally restricted to the ports.
+func (builder *Builder) WithCIDRRule(ruleType egressfirewallv1.EgressFirewallRuleType, cidr string,
+	ports ...egressfirewallv1.EgressFirewallPort) *Builder {
+	return builder.WithRule(egressfirewallv1.EgressFirewallRule{
+		Type:  ruleType,
+		Ports: ports,
+		To:    egressfirewallv1.EgressFirewallDestination{CIDRSelector: cidr},
+	})
+}
+
+// WithDNSNameRule appends a rule allowing or denying traffic to the DNS name, optionally restricted to the ports. A
+// leading '*.' matches a single label.
+func (builder *Builder) WithDNSNameRule(ruleType egressfirewallv1.EgressFirewallRuleType, dnsName string,
+	ports ...egressfirewallv1.EgressFirewallPort) *Builder {
+	return builder.WithRule(egressfirewallv1.EgressFirewallRule{
+		Type:  ruleType,
+		Ports: ports,
+		To:    egressfirewallv1.EgressFirewallDestination{DNSName: dnsName},
+	})
+}
+
+// GetEgressFirewallGVR returns EgressFirewall's GroupVersionResource which could be used for Clean function.
+func GetEgressFirewallGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group: "k8s.ovn.org", Version: "v1", Resource: "egressfirewalls",
+	}
+}
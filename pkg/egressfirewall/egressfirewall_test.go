@@ -0,0 +1,143 @@
+package egressfirewall
+
+import (
+	"testing"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common/testhelper"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	egressfirewallv1 "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/crd/egressfirewall/v1"
+)
+
+const defaultEgressFirewallNamespace = "test-namespace"
+
+var egressFirewallGVK = egressfirewallv1.SchemeGroupVersion.WithKind("EgressFirewall")
+
+func TestNewBuilder(t *testing.T) {
+	t.Parallel()
+
+	testhelper.NewNamespacedBuilderTestConfig[egressfirewallv1.EgressFirewall, Builder](
+		NewBuilder, egressfirewallv1.AddToScheme, egressFirewallGVK).ExecuteTests(t)
+}
+
+func TestPull(t *testing.T) {
+	t.Parallel()
+
+	testhelper.NewNamespacedPullTestConfig[egressfirewallv1.EgressFirewall, Builder](
+		Pull, egressfirewallv1.AddToScheme, egressFirewallGVK).ExecuteTests(t)
+}
+
+func TestBuilderMethods(t *testing.T) {
+	t.Parallel()
+
+	commonConfig := testhelper.NewCommonTestConfig[egressfirewallv1.EgressFirewall, Builder](
+		egressfirewallv1.AddToScheme, egressFirewallGVK, testhelper.ResourceScopeNamespaced)
+
+	testhelper.NewTestSuite().
+		With(testhelper.NewGetTestConfig(commonConfig)).
+		With(testhelper.NewExistsTestConfig(commonConfig)).
+		With(testhelper.NewCreateTestConfig(commonConfig)).
+		With(testhelper.NewDeleterTestConfig(commonConfig)).
+		With(testhelper.NewUpdateTestConfig(commonConfig)).
+		Run(t)
+}
+
+func TestWithRule(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		rule          egressfirewallv1.EgressFirewallRule
+		expectedError string
+	}{
+		{
+			name: "cidr rule",
+			rule: egressfirewallv1.EgressFirewallRule{
+				Type: egressfirewallv1.EgressFirewallRuleDeny,
+				To:   egressfirewallv1.EgressFirewallDestination{CIDRSelector: "fd00::/64"},
+			},
+		},
+		{
+			name: "node selector rule",
+			rule: egressfirewallv1.EgressFirewallRule{
+				Type: egressfirewallv1.EgressFirewallRuleAllow,
+				To: egressfirewallv1.EgressFirewallDestination{
+					NodeSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"node-role.kubernetes.io/worker": ""}},
+				},
+			},
+		},
+		{
+			name: "invalid type",
+			rule: egressfirewallv1.EgressFirewallRule{
+				Type: "Drop",
+				To:   egressfirewallv1.EgressFirewallDestination{DNSName: "example.com"},
+			},
+			expectedError: `egressFirewall rule 'type' must be Allow or Deny, got "Drop"`,
+		},
+		{
+			name:          "no destination",
+			rule:          egressfirewallv1.EgressFirewallRule{Type: egressfirewallv1.EgressFirewallRuleAllow},
+			expectedError: "egressFirewall rule must set exactly one of cidrSelector, dnsName and nodeSelector",
+		},
+		{
+			name: "two destinations",
+			rule: egressfirewallv1.EgressFirewallRule{
+				Type: egressfirewallv1.EgressFirewallRuleAllow,
+				To:   egressfirewallv1.EgressFirewallDestination{CIDRSelector: "10.0.0.0/8", DNSName: "example.com"},
+			},
+			expectedError: "egressFirewall rule must set exactly one of cidrSelector, dnsName and nodeSelector",
+		},
+		{
+			name: "invalid cidr",
+			rule: egressfirewallv1.EgressFirewallRule{
+				Type: egressfirewallv1.EgressFirewallRuleAllow,
+				To:   egressfirewallv1.EgressFirewallDestination{CIDRSelector: "10.0.0.0"},
+			},
+			expectedError: "egressFirewall rule 'cidrSelector' is invalid: invalid CIDR address: 10.0.0.0",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			testBuilder := NewBuilder(clients.GetTestClients(clients.TestClientParams{}),
+				DefaultName, defaultEgressFirewallNamespace).WithRule(testCase.rule)
+
+			if testCase.expectedError != "" {
+				assert.EqualError(t, testBuilder.GetError(), testCase.expectedError)
+
+				return
+			}
+
+			assert.NoError(t, testBuilder.GetError())
+			assert.Equal(t, []egressfirewallv1.EgressFirewallRule{testCase.rule}, testBuilder.Definition.Spec.Egress)
+		})
+	}
+}
+
+func TestWithCIDRAndDNSNameRules(t *testing.T) {
+	t.Parallel()
+
+	port := egressfirewallv1.EgressFirewallPort{Protocol: "TCP", Port: 443}
+
+	testBuilder := NewBuilder(clients.GetTestClients(clients.TestClientParams{}),
+		DefaultName, defaultEgressFirewallNamespace).
+		WithDNSNameRule(egressfirewallv1.EgressFirewallRuleAllow, "*.example.com", port).
+		WithCIDRRule(egressfirewallv1.EgressFirewallRuleDeny, "0.0.0.0/0")
+
+	assert.NoError(t, testBuilder.GetError())
+	assert.Equal(t, []egressfirewallv1.EgressFirewallRule{
+		{
+			Type:  egressfirewallv1.EgressFirewallRuleAllow,
+			Ports: []egressfirewallv1.EgressFirewallPort{port},
+			To:    egressfirewallv1.EgressFirewallDestination{DNSName: "*.example.com"},
+		},
+		{
+			Type: egressfirewallv1.EgressFirewallRuleDeny,
+			To:   egressfirewallv1.EgressFirewallDestination{CIDRSelector: "0.0.0.0/0"},
+		},
+	}, testBuilder.Definition.Spec.Egress)
+}
@@ -0,0 +1,117 @@
+package egressfirewall
+
+import (
+	"context"
+	"fmt"
+	"net"
+
+	networkv1 "github.com/openshift/api/network/v1"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/klog/v2"
+)
+
+// EgressNetworkPolicyBuilder provides a struct for the legacy EgressNetworkPolicy resource containing a connection to
+// the cluster and the EgressNetworkPolicy definition. EgressNetworkPolicies restrict the traffic leaving the pods of a
+// namespace on OpenShift SDN clusters, which only support IPv4 and allow a single policy per namespace.
+type EgressNetworkPolicyBuilder struct {
+	common.EmbeddableBuilder[networkv1.EgressNetworkPolicy, *networkv1.EgressNetworkPolicy]
+	common.EmbeddableCreator[networkv1.EgressNetworkPolicy, EgressNetworkPolicyBuilder,
+		*networkv1.EgressNetworkPolicy, *EgressNetworkPolicyBuilder]
+	common.EmbeddableDeleter[networkv1.EgressNetworkPolicy, *networkv1.EgressNetworkPolicy]
+	common.EmbeddableUpdater[networkv1.EgressNetworkPolicy, EgressNetworkPolicyBuilder,
+		*networkv1.EgressNetworkPolicy, *EgressNetworkPolicyBuilder]
+}
+
+// AttachMixins wires the embedded CRUD mixins to this builder instance.
+func (builder *EgressNetworkPolicyBuilder) AttachMixins() {
+	builder.EmbeddableCreator.SetBase(builder)
+	builder.EmbeddableDeleter.SetBase(builder)
+	builder.EmbeddableUpdater.SetBase(builder)
+}
+
+// GetGVK returns the EgressNetworkPolicy GVK for this builder.
+func (builder *EgressNetworkPolicyBuilder) GetGVK() schema.GroupVersionKind {
+	return networkv1.GroupVersion.WithKind("EgressNetworkPolicy")
+}
+
+// NewEgressNetworkPolicyBuilder creates a new instance of EgressNetworkPolicyBuilder without any rules.
+func NewEgressNetworkPolicyBuilder(apiClient *clients.Settings, name, nsname string) *EgressNetworkPolicyBuilder {
+	return common.NewNamespacedBuilder[networkv1.EgressNetworkPolicy, EgressNetworkPolicyBuilder](
+		apiClient, networkv1.Install, name, nsname)
+}
+
+// PullEgressNetworkPolicy retrieves an existing EgressNetworkPolicy from the cluster.
+func PullEgressNetworkPolicy(apiClient *clients.Settings, name, nsname string) (*EgressNetworkPolicyBuilder, error) {
+	return common.PullNamespacedBuilder[networkv1.EgressNetworkPolicy, EgressNetworkPolicyBuilder](
+		context.TODO(), apiClient, networkv1.Install, name, nsname)
+}
+
+// WithRule appends the rule to the EgressNetworkPolicy. The rule must allow or deny traffic to exactly one of an IPv4
+// CIDR and a DNS name.
+func (builder *EgressNetworkPolicyBuilder) WithRule(
+	rule networkv1.EgressNetworkPolicyRule) *EgressNetworkPolicyBuilder {
+	if err := common.Validate(builder); err != nil {
+		return builder
+	}
+
+	klog.V(100).Infof("Adding rule to EgressNetworkPolicy %s in namespace %s: %v",
+		builder.Definition.Name, builder.Definition.Namespace, rule)
+
+	if rule.Type != networkv1.EgressNetworkPolicyRuleAllow && rule.Type != networkv1.EgressNetworkPolicyRuleDeny {
+		builder.SetError(fmt.Errorf("egressNetworkPolicy rule 'type' must be Allow or Deny, got %q", rule.Type))
+
+		return builder
+	}
+
+	if (rule.To.CIDRSelector == "") == (rule.To.DNSName == "") {
+		builder.SetError(fmt.Errorf("egressNetworkPolicy rule must set exactly one of cidrSelector and dnsName"))
+
+		return builder
+	}
+
+	if rule.To.CIDRSelector != "" {
+		ip, _, err := net.ParseCIDR(rule.To.CIDRSelector)
+		if err != nil {
+			builder.SetError(fmt.Errorf("egressNetworkPolicy rule 'cidrSelector' is invalid: %w", err))
+
+			return builder
+		}
+
+		if ip.To4() == nil {
+			builder.SetError(fmt.Errorf("egressNetworkPolicy rule 'cidrSelector' %s is not IPv4", rule.To.CIDRSelector))
+
+			return builder
+		}
+	}
+
+	builder.Definition.Spec.Egress = append(builder.Definition.Spec.Egress, rule)
+
+	return builder
+}
+
+// WithCIDRRule appends a rule allowing or denying traffic to the IPv4 CIDR.
+func (builder *EgressNetworkPolicyBuilder) WithCIDRRule(
+	ruleType networkv1.EgressNetworkPolicyRuleType, cidr string) *EgressNetworkPolicyBuilder {
+	return builder.WithRule(networkv1.EgressNetworkPolicyRule{
+		Type: ruleType,
+		To:   networkv1.EgressNetworkPolicyPeer{CIDRSelector: cidr},
+	})
+}
+
+// WithDNSNameRule appends a rule allowing or denying traffic to the DNS name.
+func (builder *EgressNetworkPolicyBuilder) WithDNSNameRule(
+	ruleType networkv1.EgressNetworkPolicyRuleType, dnsName string) *EgressNetworkPolicyBuilder {
+	return builder.WithRule(networkv1.EgressNetworkPolicyRule{
+		Type: ruleType,
+		To:   networkv1.EgressNetworkPolicyPeer{DNSName: dnsName},
+	})
+}
+
+// GetEgressNetworkPolicyGVR returns EgressNetworkPolicy's GroupVersionResource which could be used for Clean function.
+func GetEgressNetworkPolicyGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group: "network.openshift.io", Version: "v1", Resource: "egressnetworkpolicies",
+	}
+}
@@ -0,0 +1,121 @@
+package egressfirewall
+
+import (
+	"testing"
+
+	networkv1 "github.com/openshift/api/network/v1"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common/testhelper"
+	"github.com/stretchr/testify/assert"
+)
+
+var egressNetworkPolicyGVK = networkv1.GroupVersion.WithKind("EgressNetworkPolicy")
+
+func TestNewEgressNetworkPolicyBuilder(t *testing.T) {
+	t.Parallel()
+
+	testhelper.NewNamespacedBuilderTestConfig[networkv1.EgressNetworkPolicy, EgressNetworkPolicyBuilder](
+		NewEgressNetworkPolicyBuilder, networkv1.Install, egressNetworkPolicyGVK).ExecuteTests(t)
+}
+
+func TestPullEgressNetworkPolicy(t *testing.T) {
+	t.Parallel()
+
+	testhelper.NewNamespacedPullTestConfig[networkv1.EgressNetworkPolicy, EgressNetworkPolicyBuilder](
+		PullEgressNetworkPolicy, networkv1.Install, egressNetworkPolicyGVK).ExecuteTests(t)
+}
+
+func TestEgressNetworkPolicyMethods(t *testing.T) {
+	t.Parallel()
+
+	commonConfig := testhelper.NewCommonTestConfig[networkv1.EgressNetworkPolicy, EgressNetworkPolicyBuilder](
+		networkv1.Install, egressNetworkPolicyGVK, testhelper.ResourceScopeNamespaced)
+
+	testhelper.NewTestSuite().
+		With(testhelper.NewGetTestConfig(commonConfig)).
+		With(testhelper.NewExistsTestConfig(commonConfig)).
+		With(testhelper.NewCreateTestConfig(commonConfig)).
+		With(testhelper.NewDeleterTestConfig(commonConfig)).
+		With(testhelper.NewUpdateTestConfig(commonConfig)).
+		Run(t)
+}
+
+func TestEgressNetworkPolicyWithRule(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		rule          networkv1.EgressNetworkPolicyRule
+		expectedError string
+	}{
+		{
+			name: "dns name rule",
+			rule: networkv1.EgressNetworkPolicyRule{
+				Type: networkv1.EgressNetworkPolicyRuleAllow,
+				To:   networkv1.EgressNetworkPolicyPeer{DNSName: "example.com"},
+			},
+		},
+		{
+			name: "invalid type",
+			rule: networkv1.EgressNetworkPolicyRule{
+				Type: "",
+				To:   networkv1.EgressNetworkPolicyPeer{DNSName: "example.com"},
+			},
+			expectedError: `egressNetworkPolicy rule 'type' must be Allow or Deny, got ""`,
+		},
+		{
+			name:          "no destination",
+			rule:          networkv1.EgressNetworkPolicyRule{Type: networkv1.EgressNetworkPolicyRuleDeny},
+			expectedError: "egressNetworkPolicy rule must set exactly one of cidrSelector and dnsName",
+		},
+		{
+			name: "invalid cidr",
+			rule: networkv1.EgressNetworkPolicyRule{
+				Type: networkv1.EgressNetworkPolicyRuleDeny,
+				To:   networkv1.EgressNetworkPolicyPeer{CIDRSelector: "10.0.0.0/33"},
+			},
+			expectedError: "egressNetworkPolicy rule 'cidrSelector' is invalid: invalid CIDR address: 10.0.0.0/33",
+		},
+		{
+			name: "ipv6 cidr",
+			rule: networkv1.EgressNetworkPolicyRule{
+				Type: networkv1.EgressNetworkPolicyRuleDeny,
+				To:   networkv1.EgressNetworkPolicyPeer{CIDRSelector: "fd00::/64"},
+			},
+			expectedError: "egressNetworkPolicy rule 'cidrSelector' fd00::/64 is not IPv4",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			testBuilder := NewEgressNetworkPolicyBuilder(clients.GetTestClients(clients.TestClientParams{}),
+				DefaultName, defaultEgressFirewallNamespace).WithRule(testCase.rule)
+
+			if testCase.expectedError != "" {
+				assert.EqualError(t, testBuilder.GetError(), testCase.expectedError)
+
+				return
+			}
+
+			assert.NoError(t, testBuilder.GetError())
+			assert.Equal(t, []networkv1.EgressNetworkPolicyRule{testCase.rule}, testBuilder.Definition.Spec.Egress)
+		})
+	}
+}
+
+func TestEgressNetworkPolicyWithCIDRAndDNSNameRules(t *testing.T) {
+	t.Parallel()
+
+	testBuilder := NewEgressNetworkPolicyBuilder(clients.GetTestClients(clients.TestClientParams{}),
+		DefaultName, defaultEgressFirewallNamespace).
+		WithDNSNameRule(networkv1.EgressNetworkPolicyRuleAllow, "example.com").
+		WithCIDRRule(networkv1.EgressNetworkPolicyRuleDeny, "0.0.0.0/0")
+
+	assert.NoError(t, testBuilder.GetError())
+	assert.Equal(t, []networkv1.EgressNetworkPolicyRule{
+		{Type: networkv1.EgressNetworkPolicyRuleAllow, To: networkv1.EgressNetworkPolicyPeer{DNSName: "example.com"}},
+		{Type: networkv1.EgressNetworkPolicyRuleDeny, To: networkv1.EgressNetworkPolicyPeer{CIDRSelector: "0.0.0.0/0"}},
+	}, testBuilder.Definition.Spec.Egress)
+}
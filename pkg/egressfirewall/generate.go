@@ -0,0 +1,4 @@
+package egressfirewall
+
+//go:generate go run ../../internal/listgen -builder Builder
+//go:generate go run ../../internal/listgen -builder EgressNetworkPolicyBuilder
@@ -0,0 +1,199 @@
+package egressfirewall
+
+import (
+	"fmt"
+
+	networkv1 "github.com/openshift/api/network/v1"
+	operatorv1 "github.com/openshift/api/operator/v1"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/network"
+	"k8s.io/klog/v2"
+
+	egressfirewallv1 "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/crd/egressfirewall/v1"
+)
+
+// Kind is the kind of the resource restricting egress traffic on a cluster, which depends on its network type.
+type Kind string
+
+const (
+	// KindEgressFirewall is used on OVN-Kubernetes clusters.
+	KindEgressFirewall Kind = "EgressFirewall"
+	// KindEgressNetworkPolicy is used on OpenShift SDN clusters.
+	KindEgressNetworkPolicy Kind = "EgressNetworkPolicy"
+)
+
+// RuleType indicates whether a Rule allows or denies traffic.
+type RuleType string
+
+const (
+	// RuleTypeAllow allows the traffic matching the rule.
+	RuleTypeAllow RuleType = "Allow"
+	// RuleTypeDeny denies the traffic matching the rule.
+	RuleTypeDeny RuleType = "Deny"
+)
+
+// Rule is an egress rule supported by both EgressFirewalls and EgressNetworkPolicies. Exactly one of CIDRSelector and
+// DNSName must be set.
+type Rule struct {
+	Type         RuleType
+	CIDRSelector string
+	DNSName      string
+}
+
+// GetKind returns the kind of the resource restricting egress traffic on the cluster, based on the network type in
+// the cluster network config.
+func GetKind(apiClient *clients.Settings) (Kind, error) {
+	networkConfig, err := network.PullConfig(apiClient)
+	if err != nil {
+		return "", err
+	}
+
+	networkType := networkConfig.Object.Status.NetworkType
+	if networkType == "" {
+		networkType = networkConfig.Object.Spec.NetworkType
+	}
+
+	klog.V(100).Infof("Selecting egress firewall kind for network type %s", networkType)
+
+	switch operatorv1.NetworkType(networkType) {
+	case operatorv1.NetworkTypeOVNKubernetes:
+		return KindEgressFirewall, nil
+	case operatorv1.NetworkTypeOpenShiftSDN:
+		return KindEgressNetworkPolicy, nil
+	default:
+		return "", fmt.Errorf("network type %q does not support egress firewalls", networkType)
+	}
+}
+
+// NetworkTypeBuilder restricts the egress traffic of a namespace using whichever resource the network type of the
+// cluster supports, so suites can run against both OVN-Kubernetes and OpenShift SDN clusters. Exactly one of the
+// builders is set, and it may be used directly for settings specific to its kind.
+type NetworkTypeBuilder struct {
+	// EgressFirewall is set on OVN-Kubernetes clusters.
+	EgressFirewall *Builder
+	// EgressNetworkPolicy is set on OpenShift SDN clusters.
+	EgressNetworkPolicy *EgressNetworkPolicyBuilder
+}
+
+// NewNetworkTypeBuilder detects the network type of the cluster and returns a NetworkTypeBuilder for the namespace
+// with the rules, in order. The resource is named DefaultName, which both kinds accept.
+func NewNetworkTypeBuilder(apiClient *clients.Settings, nsname string, rules ...Rule) (*NetworkTypeBuilder, error) {
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	kind, err := GetKind(apiClient)
+	if err != nil {
+		return nil, err
+	}
+
+	klog.V(100).Infof("Building %s in namespace %s with rules %v", kind, nsname, rules)
+
+	builder := &NetworkTypeBuilder{}
+
+	switch kind {
+	case KindEgressFirewall:
+		builder.EgressFirewall = NewBuilder(apiClient, DefaultName, nsname)
+
+		for _, rule := range rules {
+			builder.EgressFirewall.WithRule(egressfirewallv1.EgressFirewallRule{
+				Type: egressfirewallv1.EgressFirewallRuleType(rule.Type),
+				To: egressfirewallv1.EgressFirewallDestination{
+					CIDRSelector: rule.CIDRSelector,
+					DNSName:      rule.DNSName,
+				},
+			})
+		}
+
+		err = builder.EgressFirewall.GetError()
+	case KindEgressNetworkPolicy:
+		builder.EgressNetworkPolicy = NewEgressNetworkPolicyBuilder(apiClient, DefaultName, nsname)
+
+		for _, rule := range rules {
+			builder.EgressNetworkPolicy.WithRule(networkv1.EgressNetworkPolicyRule{
+				Type: networkv1.EgressNetworkPolicyRuleType(rule.Type),
+				To: networkv1.EgressNetworkPolicyPeer{
+					CIDRSelector: rule.CIDRSelector,
+					DNSName:      rule.DNSName,
+				},
+			})
+		}
+
+		err = builder.EgressNetworkPolicy.GetError()
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return builder, nil
+}
+
+// Kind returns the kind of the resource built.
+func (builder *NetworkTypeBuilder) Kind() Kind {
+	if builder == nil {
+		return ""
+	}
+
+	if builder.EgressNetworkPolicy != nil {
+		return KindEgressNetworkPolicy
+	}
+
+	return KindEgressFirewall
+}
+
+// Create makes the resource on the cluster if it does not already exist.
+func (builder *NetworkTypeBuilder) Create() error {
+	if err := builder.validate(); err != nil {
+		return err
+	}
+
+	var err error
+
+	if builder.EgressFirewall != nil {
+		_, err = builder.EgressFirewall.Create()
+	} else {
+		_, err = builder.EgressNetworkPolicy.Create()
+	}
+
+	return err
+}
+
+// Delete removes the resource from the cluster. It is not an error if the resource does not exist.
+func (builder *NetworkTypeBuilder) Delete() error {
+	if err := builder.validate(); err != nil {
+		return err
+	}
+
+	if builder.EgressFirewall != nil {
+		return builder.EgressFirewall.Delete()
+	}
+
+	return builder.EgressNetworkPolicy.Delete()
+}
+
+// Exists checks whether the resource exists on the cluster.
+func (builder *NetworkTypeBuilder) Exists() bool {
+	if err := builder.validate(); err != nil {
+		return false
+	}
+
+	if builder.EgressFirewall != nil {
+		return builder.EgressFirewall.Exists()
+	}
+
+	return builder.EgressNetworkPolicy.Exists()
+}
+
+// validate checks that exactly one of the builders is set.
+func (builder *NetworkTypeBuilder) validate() error {
+	if builder == nil {
+		klog.V(100).Info("The NetworkTypeBuilder is uninitialized")
+
+		return fmt.Errorf("error: received nil NetworkTypeBuilder")
+	}
+
+	if (builder.EgressFirewall == nil) == (builder.EgressNetworkPolicy == nil) {
+		return fmt.Errorf("networkTypeBuilder must have exactly one of EgressFirewall and EgressNetworkPolicy set")
+	}
+
+	return nil
+}
@@ -0,0 +1,143 @@
+package egressfirewall
+
+import (
+	"testing"
+
+	configv1 "github.com/openshift/api/config/v1"
+	networkv1 "github.com/openshift/api/network/v1"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	egressfirewallv1 "github.com/ovn-kubernetes/ovn-kubernetes/go-controller/pkg/crd/egressfirewall/v1"
+)
+
+var testRules = []Rule{
+	{Type: RuleTypeAllow, DNSName: "example.com"},
+	{Type: RuleTypeDeny, CIDRSelector: "0.0.0.0/0"},
+}
+
+func TestGetKind(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		statusType    string
+		specType      string
+		expectedKind  Kind
+		expectedError string
+	}{
+		{
+			name:         "ovn-kubernetes",
+			statusType:   "OVNKubernetes",
+			expectedKind: KindEgressFirewall,
+		},
+		{
+			name:         "openshift sdn from spec",
+			specType:     "OpenShiftSDN",
+			expectedKind: KindEgressNetworkPolicy,
+		},
+		{
+			name:          "unsupported network type",
+			statusType:    "Calico",
+			expectedError: `network type "Calico" does not support egress firewalls`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			kind, err := GetKind(buildTestClientWithNetworkType(testCase.statusType, testCase.specType))
+
+			if testCase.expectedError != "" {
+				assert.EqualError(t, err, testCase.expectedError)
+
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.expectedKind, kind)
+		})
+	}
+
+	_, err := GetKind(nil)
+	assert.EqualError(t, err, "network.config 'apiClient' cannot be nil")
+}
+
+func TestNewNetworkTypeBuilder(t *testing.T) {
+	t.Parallel()
+
+	testBuilder, err := NewNetworkTypeBuilder(
+		buildTestClientWithNetworkType("OVNKubernetes", ""), defaultEgressFirewallNamespace, testRules...)
+	require.NoError(t, err)
+	assert.Equal(t, KindEgressFirewall, testBuilder.Kind())
+	assert.Nil(t, testBuilder.EgressNetworkPolicy)
+	assert.Equal(t, DefaultName, testBuilder.EgressFirewall.Definition.Name)
+	assert.Equal(t, []egressfirewallv1.EgressFirewallRule{
+		{
+			Type: egressfirewallv1.EgressFirewallRuleAllow,
+			To:   egressfirewallv1.EgressFirewallDestination{DNSName: "example.com"},
+		},
+		{
+			Type: egressfirewallv1.EgressFirewallRuleDeny,
+			To:   egressfirewallv1.EgressFirewallDestination{CIDRSelector: "0.0.0.0/0"},
+		},
+	}, testBuilder.EgressFirewall.Definition.Spec.Egress)
+
+	testBuilder, err = NewNetworkTypeBuilder(
+		buildTestClientWithNetworkType("OpenShiftSDN", ""), defaultEgressFirewallNamespace, testRules...)
+	require.NoError(t, err)
+	assert.Equal(t, KindEgressNetworkPolicy, testBuilder.Kind())
+	assert.Nil(t, testBuilder.EgressFirewall)
+	assert.Equal(t, []networkv1.EgressNetworkPolicyRule{
+		{Type: networkv1.EgressNetworkPolicyRuleAllow, To: networkv1.EgressNetworkPolicyPeer{DNSName: "example.com"}},
+		{Type: networkv1.EgressNetworkPolicyRuleDeny, To: networkv1.EgressNetworkPolicyPeer{CIDRSelector: "0.0.0.0/0"}},
+	}, testBuilder.EgressNetworkPolicy.Definition.Spec.Egress)
+
+	_, err = NewNetworkTypeBuilder(buildTestClientWithNetworkType("OpenShiftSDN", ""), defaultEgressFirewallNamespace,
+		Rule{Type: RuleTypeDeny, CIDRSelector: "fd00::/64"})
+	assert.EqualError(t, err, "egressNetworkPolicy rule 'cidrSelector' fd00::/64 is not IPv4")
+
+	_, err = NewNetworkTypeBuilder(buildTestClientWithNetworkType("Calico", ""), defaultEgressFirewallNamespace)
+	assert.EqualError(t, err, `network type "Calico" does not support egress firewalls`)
+}
+
+func TestNetworkTypeBuilderCreateDelete(t *testing.T) {
+	t.Parallel()
+
+	for _, networkType := range []string{"OVNKubernetes", "OpenShiftSDN"} {
+		testBuilder, err := NewNetworkTypeBuilder(
+			buildTestClientWithNetworkType(networkType, ""), defaultEgressFirewallNamespace, testRules...)
+		require.NoError(t, err)
+
+		assert.False(t, testBuilder.Exists())
+		assert.NoError(t, testBuilder.Create())
+		assert.True(t, testBuilder.Exists())
+		assert.NoError(t, testBuilder.Delete())
+		assert.False(t, testBuilder.Exists())
+		assert.NoError(t, testBuilder.Delete())
+	}
+
+	var nilBuilder *NetworkTypeBuilder
+
+	assert.Equal(t, Kind(""), nilBuilder.Kind())
+	assert.False(t, nilBuilder.Exists())
+	assert.EqualError(t, nilBuilder.Create(), "error: received nil NetworkTypeBuilder")
+	assert.EqualError(t, (&NetworkTypeBuilder{}).Delete(),
+		"networkTypeBuilder must have exactly one of EgressFirewall and EgressNetworkPolicy set")
+}
+
+// buildTestClientWithNetworkType returns a test client with the cluster network config reporting the network types.
+func buildTestClientWithNetworkType(statusType, specType string) *clients.Settings {
+	return clients.GetTestClients(clients.TestClientParams{
+		K8sMockObjects: []runtime.Object{&configv1.Network{
+			ObjectMeta: metav1.ObjectMeta{Name: "cluster"},
+			Spec:       configv1.NetworkSpec{NetworkType: specType},
+			Status:     configv1.NetworkStatus{NetworkType: statusType},
+		}},
+		SchemeAttachers: []clients.SchemeAttacher{configv1.Install, egressfirewallv1.AddToScheme, networkv1.Install},
+	})
+}
@@ -12,6 +12,7 @@ import (
 
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	goclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -305,6 +306,13 @@ func (builder *EgressIPBuilder) GetAssignedEgressIPMap() (map[string]string, err
 	return egressIPMap, nil
 }
 
+// GetGVR returns EgressIP's GroupVersionResource which could be used for Clean function.
+func GetGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group: "k8s.ovn.org", Version: "v1", Resource: "egressips",
+	}
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *EgressIPBuilder) validate() (bool, error) {
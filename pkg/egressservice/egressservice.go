@@ -13,6 +13,7 @@ import (
 
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	goclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -35,6 +36,8 @@ type EgressServiceBuilder struct {
 // NewEgressServiceBuilder creates a new instance of EgressService builder.
 func NewEgressServiceBuilder(
 	apiClient *clients.Settings, name, nsname, sourceIPBy string) *EgressServiceBuilder {
+	nsname = apiClient.ResolveNamespace(nsname)
+
 	klog.V(100).Infof(
 		"Initializing new EgressService structure with the following params: "+
 			"name: %s; namespace: %s; sourceIPBy: %s",
@@ -140,6 +143,8 @@ func (builder *EgressServiceBuilder) WithVRFNetwork(vrfnet string) *EgressServic
 
 // Pull fetches existing EgressService from the cluster.
 func Pull(apiClient *clients.Settings, name, nsname string) (*EgressServiceBuilder, error) {
+	nsname = apiClient.ResolveNamespace(nsname)
+
 	klog.V(100).Infof("Pulling existing EgressService %q in namespace %q from cluster",
 		name, nsname)
 
@@ -308,6 +313,13 @@ func (builder *EgressServiceBuilder) Update() (*EgressServiceBuilder, error) {
 	return builder, err
 }
 
+// GetGVR returns EgressService's GroupVersionResource which could be used for Clean function.
+func GetGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group: "k8s.ovn.org", Version: "v1", Resource: "egressservices",
+	}
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *EgressServiceBuilder) validate() (bool, error) {
@@ -0,0 +1,348 @@
+package endpointslice
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common"
+	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
+)
+
+// Builder provides an endpointslice builder backed by the shared common builder framework. EndpointSlices are usually
+// managed by the cluster for each service, so the builder is mostly used to pull and inspect them.
+type Builder struct {
+	common.EmbeddableBuilder[discoveryv1.EndpointSlice, *discoveryv1.EndpointSlice]
+	common.EmbeddableCreator[discoveryv1.EndpointSlice, Builder, *discoveryv1.EndpointSlice, *Builder]
+	common.EmbeddableDeleter[discoveryv1.EndpointSlice, *discoveryv1.EndpointSlice]
+	common.EmbeddableUpdater[discoveryv1.EndpointSlice, Builder, *discoveryv1.EndpointSlice, *Builder]
+}
+
+// AttachMixins wires the embedded CRUD mixins to this builder instance.
+func (builder *Builder) AttachMixins() {
+	builder.EmbeddableCreator.SetBase(builder)
+	builder.EmbeddableDeleter.SetBase(builder)
+	builder.EmbeddableUpdater.SetBase(builder)
+}
+
+// GetGVK returns the EndpointSlice GVK for this builder.
+func (builder *Builder) GetGVK() schema.GroupVersionKind {
+	return discoveryv1.SchemeGroupVersion.WithKind("EndpointSlice")
+}
+
+// GetImmutableFields returns the address type, which cannot be changed once the endpointslice is created.
+func (builder *Builder) GetImmutableFields(latest *discoveryv1.EndpointSlice) []string {
+	return []string{"addressType"}
+}
+
+// NewBuilder creates a new instance of Builder. The address type defaults to IPv4 and can be changed using
+// WithAddressType.
+func NewBuilder(apiClient *clients.Settings, name, nsname string) *Builder {
+	builder := common.NewNamespacedBuilder[discoveryv1.EndpointSlice, Builder](
+		apiClient, discoveryv1.AddToScheme, name, nsname)
+
+	if builder.GetError() == nil {
+		builder.Definition.AddressType = discoveryv1.AddressTypeIPv4
+	}
+
+	return builder
+}
+
+// Pull retrieves an existing endpointslice object from the cluster.
+func Pull(apiClient *clients.Settings, name, nsname string) (*Builder, error) {
+	return common.PullNamespacedBuilder[discoveryv1.EndpointSlice, Builder](
+		context.TODO(), apiClient, discoveryv1.AddToScheme, name, nsname)
+}
+
+// WithAddressType sets the type of the addresses in the endpointslice. Each endpointslice only contains addresses of a
+// single type, so dual-stack services have separate IPv4 and IPv6 endpointslices.
+func (builder *Builder) WithAddressType(addressType discoveryv1.AddressType) *Builder {
+	if err := common.Validate(builder); err != nil {
+		return builder
+	}
+
+	klog.V(100).Infof("Setting address type of endpointslice %s in namespace %s to %s",
+		builder.Definition.Name, builder.Definition.Namespace, addressType)
+
+	if !slices.Contains(
+		[]discoveryv1.AddressType{discoveryv1.AddressTypeIPv4, discoveryv1.AddressTypeIPv6, discoveryv1.AddressTypeFQDN},
+		addressType) {
+		builder.SetError(fmt.Errorf("endpointslice 'addressType' %s is not supported", addressType))
+
+		return builder
+	}
+
+	builder.Definition.AddressType = addressType
+
+	return builder
+}
+
+// WithServiceName sets the label that associates the endpointslice with the service of the provided name.
+func (builder *Builder) WithServiceName(serviceName string) *Builder {
+	if err := common.Validate(builder); err != nil {
+		return builder
+	}
+
+	klog.V(100).Infof("Associating endpointslice %s in namespace %s with service %s",
+		builder.Definition.Name, builder.Definition.Namespace, serviceName)
+
+	if serviceName == "" {
+		builder.SetError(fmt.Errorf("endpointslice 'serviceName' cannot be empty"))
+
+		return builder
+	}
+
+	if builder.Definition.Labels == nil {
+		builder.Definition.Labels = make(map[string]string)
+	}
+
+	builder.Definition.Labels[discoveryv1.LabelServiceName] = serviceName
+
+	return builder
+}
+
+// WithEndpoint appends an endpoint with the provided addresses and readiness to the endpointslice.
+func (builder *Builder) WithEndpoint(addresses []string, ready bool) *Builder {
+	if err := common.Validate(builder); err != nil {
+		return builder
+	}
+
+	klog.V(100).Infof("Adding endpoint with addresses %v and readiness %t to endpointslice %s in namespace %s",
+		addresses, ready, builder.Definition.Name, builder.Definition.Namespace)
+
+	if len(addresses) == 0 {
+		builder.SetError(fmt.Errorf("endpointslice endpoint 'addresses' cannot be empty"))
+
+		return builder
+	}
+
+	builder.Definition.Endpoints = append(builder.Definition.Endpoints, discoveryv1.Endpoint{
+		Addresses:  addresses,
+		Conditions: discoveryv1.EndpointConditions{Ready: ptr.To(ready)},
+	})
+
+	return builder
+}
+
+// WithPorts sets the ports exposed by each endpoint in the endpointslice.
+func (builder *Builder) WithPorts(ports []discoveryv1.EndpointPort) *Builder {
+	if err := common.Validate(builder); err != nil {
+		return builder
+	}
+
+	klog.V(100).Infof("Setting ports of endpointslice %s in namespace %s to %v",
+		builder.Definition.Name, builder.Definition.Namespace, ports)
+
+	if len(ports) == 0 {
+		builder.SetError(fmt.Errorf("endpointslice 'ports' cannot be empty"))
+
+		return builder
+	}
+
+	builder.Definition.Ports = ports
+
+	return builder
+}
+
+// GetServiceName returns the name of the service the endpointslice belongs to, or an empty string if it is not
+// associated with a service.
+func (builder *Builder) GetServiceName() (string, error) {
+	if err := common.Validate(builder); err != nil {
+		return "", err
+	}
+
+	return builder.Definition.Labels[discoveryv1.LabelServiceName], nil
+}
+
+// GetReadyAddresses returns the addresses of the endpoints that are ready to receive traffic, as stored on the cluster.
+// Following the EndpointSlice API, endpoints without a ready condition are treated as ready.
+func (builder *Builder) GetReadyAddresses() ([]string, error) {
+	return builder.getAddresses(isEndpointReady)
+}
+
+// GetNotReadyAddresses returns the addresses of the endpoints that are not ready to receive traffic, as stored on the
+// cluster.
+func (builder *Builder) GetNotReadyAddresses() ([]string, error) {
+	return builder.getAddresses(func(endpoint discoveryv1.Endpoint) bool { return !isEndpointReady(endpoint) })
+}
+
+// GetServingAddresses returns the addresses of the endpoints that are serving, including those that are terminating
+// and therefore not ready, as stored on the cluster.
+func (builder *Builder) GetServingAddresses() ([]string, error) {
+	return builder.getAddresses(func(endpoint discoveryv1.Endpoint) bool {
+		return ptr.Deref(endpoint.Conditions.Serving, isEndpointReady(endpoint))
+	})
+}
+
+// GetPodNames returns the names of the pods backing the endpoints in the endpointslice, as stored on the cluster.
+// Endpoints that do not reference a pod are skipped.
+func (builder *Builder) GetPodNames() ([]string, error) {
+	endpointSlice, err := builder.refresh()
+	if err != nil {
+		return nil, err
+	}
+
+	var podNames []string
+
+	for _, endpoint := range endpointSlice.Endpoints {
+		if endpoint.TargetRef != nil && endpoint.TargetRef.Kind == "Pod" {
+			podNames = append(podNames, endpoint.TargetRef.Name)
+		}
+	}
+
+	return podNames, nil
+}
+
+// GetZoneHints returns the zones each endpoint address is hinted to be consumed from by topology aware routing, as
+// stored on the cluster. Addresses of endpoints without zone hints are omitted.
+func (builder *Builder) GetZoneHints() (map[string][]string, error) {
+	return builder.getHints(func(hints *discoveryv1.EndpointHints) []string {
+		var zones []string
+
+		for _, zone := range hints.ForZones {
+			zones = append(zones, zone.Name)
+		}
+
+		return zones
+	})
+}
+
+// GetNodeHints returns the nodes each endpoint address is hinted to be consumed from by topology aware routing, as
+// stored on the cluster. Addresses of endpoints without node hints are omitted.
+func (builder *Builder) GetNodeHints() (map[string][]string, error) {
+	return builder.getHints(func(hints *discoveryv1.EndpointHints) []string {
+		var nodes []string
+
+		for _, node := range hints.ForNodes {
+			nodes = append(nodes, node.Name)
+		}
+
+		return nodes
+	})
+}
+
+// AssertTrafficDistribution checks that the hints on the ready endpoints of the endpointslice match the provided
+// service traffic distribution. For PreferClose and PreferSameZone every ready endpoint must be hinted to its own zone
+// and for PreferSameNode it must also be hinted to its own node. An error describing the first mismatch is returned.
+func (builder *Builder) AssertTrafficDistribution(trafficDistribution string) error {
+	endpointSlice, err := builder.refresh()
+	if err != nil {
+		return err
+	}
+
+	klog.V(100).Infof("Asserting endpointslice %s in namespace %s has hints for traffic distribution %s",
+		builder.Definition.Name, builder.Definition.Namespace, trafficDistribution)
+
+	checkNodes := false
+
+	switch trafficDistribution {
+	case corev1.ServiceTrafficDistributionPreferClose, corev1.ServiceTrafficDistributionPreferSameZone:
+	case corev1.ServiceTrafficDistributionPreferSameNode:
+		checkNodes = true
+	default:
+		return fmt.Errorf("traffic distribution %s is not supported", trafficDistribution)
+	}
+
+	for _, endpoint := range endpointSlice.Endpoints {
+		if !isEndpointReady(endpoint) {
+			continue
+		}
+
+		if endpoint.Hints == nil {
+			return fmt.Errorf("endpoint %v of endpointslice %s has no hints", endpoint.Addresses, endpointSlice.Name)
+		}
+
+		zone := ptr.Deref(endpoint.Zone, "")
+		if !slices.Contains(endpoint.Hints.ForZones, discoveryv1.ForZone{Name: zone}) {
+			return fmt.Errorf("endpoint %v of endpointslice %s is not hinted to its zone %q",
+				endpoint.Addresses, endpointSlice.Name, zone)
+		}
+
+		nodeName := ptr.Deref(endpoint.NodeName, "")
+		if checkNodes && !slices.Contains(endpoint.Hints.ForNodes, discoveryv1.ForNode{Name: nodeName}) {
+			return fmt.Errorf("endpoint %v of endpointslice %s is not hinted to its node %q",
+				endpoint.Addresses, endpointSlice.Name, nodeName)
+		}
+	}
+
+	return nil
+}
+
+// getAddresses returns the addresses of the endpoints on the cluster that match the filter.
+func (builder *Builder) getAddresses(filter func(discoveryv1.Endpoint) bool) ([]string, error) {
+	endpointSlice, err := builder.refresh()
+	if err != nil {
+		return nil, err
+	}
+
+	var addresses []string
+
+	for _, endpoint := range endpointSlice.Endpoints {
+		if filter(endpoint) {
+			addresses = append(addresses, endpoint.Addresses...)
+		}
+	}
+
+	return addresses, nil
+}
+
+// getHints returns the hint names extracted by getNames for each address of the endpoints on the cluster that have
+// any.
+func (builder *Builder) getHints(getNames func(*discoveryv1.EndpointHints) []string) (map[string][]string, error) {
+	endpointSlice, err := builder.refresh()
+	if err != nil {
+		return nil, err
+	}
+
+	hints := make(map[string][]string)
+
+	for _, endpoint := range endpointSlice.Endpoints {
+		if endpoint.Hints == nil {
+			continue
+		}
+
+		names := getNames(endpoint.Hints)
+		if len(names) == 0 {
+			continue
+		}
+
+		for _, address := range endpoint.Addresses {
+			hints[address] = names
+		}
+	}
+
+	return hints, nil
+}
+
+// refresh gets the endpointslice from the cluster and stores it as the builder Object.
+func (builder *Builder) refresh() (*discoveryv1.EndpointSlice, error) {
+	if err := common.Validate(builder); err != nil {
+		return nil, err
+	}
+
+	endpointSlice, err := builder.Get()
+	if err != nil {
+		return nil, err
+	}
+
+	builder.Object = endpointSlice
+
+	return endpointSlice, nil
+}
+
+// isEndpointReady returns whether the endpoint is ready. A nil ready condition is interpreted as ready.
+func isEndpointReady(endpoint discoveryv1.Endpoint) bool {
+	return ptr.Deref(endpoint.Conditions.Ready, true)
+}
+
+// GetGVR returns EndpointSlice's GroupVersionResource which could be used for Clean function.
+func GetGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group: "discovery.k8s.io", Version: "v1", Resource: "endpointslices",
+	}
+}
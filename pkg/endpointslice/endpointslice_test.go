@@ -0,0 +1,293 @@
+package endpointslice
+
+import (
+	"testing"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common/testhelper"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+)
+
+const (
+	defaultEndpointSliceName      = "test-service-abcde"
+	defaultEndpointSliceNamespace = "test-namespace"
+	defaultServiceName            = "test-service"
+)
+
+var endpointSliceGVK = discoveryv1.SchemeGroupVersion.WithKind("EndpointSlice")
+
+func TestNewBuilder(t *testing.T) {
+	t.Parallel()
+
+	testhelper.NewNamespacedBuilderTestConfig[discoveryv1.EndpointSlice, Builder](
+		NewBuilder, discoveryv1.AddToScheme, endpointSliceGVK).ExecuteTests(t)
+
+	testBuilder := NewBuilder(buildTestClientWithEndpointSlices(), defaultEndpointSliceName, defaultEndpointSliceNamespace)
+	assert.Equal(t, discoveryv1.AddressTypeIPv4, testBuilder.Definition.AddressType)
+}
+
+func TestPull(t *testing.T) {
+	t.Parallel()
+
+	testhelper.NewNamespacedPullTestConfig[discoveryv1.EndpointSlice, Builder](
+		Pull, discoveryv1.AddToScheme, endpointSliceGVK).ExecuteTests(t)
+}
+
+func TestBuilderMethods(t *testing.T) {
+	t.Parallel()
+
+	commonConfig := testhelper.NewCommonTestConfig[discoveryv1.EndpointSlice, Builder](
+		discoveryv1.AddToScheme, endpointSliceGVK, testhelper.ResourceScopeNamespaced)
+
+	testhelper.NewTestSuite().
+		With(testhelper.NewGetTestConfig(commonConfig)).
+		With(testhelper.NewExistsTestConfig(commonConfig)).
+		With(testhelper.NewCreateTestConfig(commonConfig)).
+		With(testhelper.NewDeleterTestConfig(commonConfig)).
+		With(testhelper.NewUpdateTestConfig(commonConfig)).
+		Run(t)
+}
+
+func TestWithAddressType(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		addressType   discoveryv1.AddressType
+		expectedError string
+	}{
+		{
+			addressType: discoveryv1.AddressTypeIPv6,
+		},
+		{
+			addressType:   "IPv5",
+			expectedError: "endpointslice 'addressType' IPv5 is not supported",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidTestBuilder().WithAddressType(testCase.addressType)
+
+		if testCase.expectedError != "" {
+			assert.EqualError(t, testBuilder.GetError(), testCase.expectedError)
+
+			continue
+		}
+
+		assert.NoError(t, testBuilder.GetError())
+		assert.Equal(t, testCase.addressType, testBuilder.Definition.AddressType)
+	}
+}
+
+func TestWithServiceName(t *testing.T) {
+	t.Parallel()
+
+	testBuilder := buildValidTestBuilder().WithServiceName(defaultServiceName)
+	assert.NoError(t, testBuilder.GetError())
+
+	serviceName, err := testBuilder.GetServiceName()
+	assert.NoError(t, err)
+	assert.Equal(t, defaultServiceName, serviceName)
+
+	testBuilder = buildValidTestBuilder().WithServiceName("")
+	assert.EqualError(t, testBuilder.GetError(), "endpointslice 'serviceName' cannot be empty")
+}
+
+func TestWithEndpoint(t *testing.T) {
+	t.Parallel()
+
+	testBuilder := buildValidTestBuilder().WithEndpoint([]string{"10.0.0.1"}, false)
+	assert.NoError(t, testBuilder.GetError())
+	assert.Equal(t, []discoveryv1.Endpoint{{
+		Addresses:  []string{"10.0.0.1"},
+		Conditions: discoveryv1.EndpointConditions{Ready: ptr.To(false)},
+	}}, testBuilder.Definition.Endpoints)
+
+	testBuilder = buildValidTestBuilder().WithEndpoint(nil, true)
+	assert.EqualError(t, testBuilder.GetError(), "endpointslice endpoint 'addresses' cannot be empty")
+}
+
+func TestWithPorts(t *testing.T) {
+	t.Parallel()
+
+	ports := []discoveryv1.EndpointPort{{Name: ptr.To("http"), Port: ptr.To(int32(8080))}}
+
+	testBuilder := buildValidTestBuilder().WithPorts(ports)
+	assert.NoError(t, testBuilder.GetError())
+	assert.Equal(t, ports, testBuilder.Definition.Ports)
+
+	testBuilder = buildValidTestBuilder().WithPorts(nil)
+	assert.EqualError(t, testBuilder.GetError(), "endpointslice 'ports' cannot be empty")
+}
+
+func TestGetAddresses(t *testing.T) {
+	t.Parallel()
+
+	testBuilder := NewBuilder(
+		buildTestClientWithEndpointSlices(buildDummyEndpointSlice(defaultEndpointSliceName)),
+		defaultEndpointSliceName, defaultEndpointSliceNamespace)
+
+	readyAddresses, err := testBuilder.GetReadyAddresses()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"10.0.0.1", "10.0.0.2"}, readyAddresses)
+
+	notReadyAddresses, err := testBuilder.GetNotReadyAddresses()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"10.0.0.3", "10.0.0.4"}, notReadyAddresses)
+
+	servingAddresses, err := testBuilder.GetServingAddresses()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"10.0.0.1", "10.0.0.2", "10.0.0.3"}, servingAddresses)
+
+	podNames, err := testBuilder.GetPodNames()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"pod-0", "pod-2", "pod-3"}, podNames)
+
+	_, err = NewBuilder(buildTestClientWithEndpointSlices(), defaultEndpointSliceName, defaultEndpointSliceNamespace).
+		GetReadyAddresses()
+	assert.Error(t, err)
+}
+
+func TestGetHints(t *testing.T) {
+	t.Parallel()
+
+	testBuilder := NewBuilder(
+		buildTestClientWithEndpointSlices(buildDummyEndpointSlice(defaultEndpointSliceName)),
+		defaultEndpointSliceName, defaultEndpointSliceNamespace)
+
+	zoneHints, err := testBuilder.GetZoneHints()
+	assert.NoError(t, err)
+	assert.Equal(t, map[string][]string{"10.0.0.1": {"zone-a"}, "10.0.0.2": {"zone-b"}}, zoneHints)
+
+	nodeHints, err := testBuilder.GetNodeHints()
+	assert.NoError(t, err)
+	assert.Equal(t, map[string][]string{"10.0.0.1": {"node-0"}}, nodeHints)
+}
+
+func TestAssertTrafficDistribution(t *testing.T) {
+	t.Parallel()
+
+	missingHints := buildDummyEndpointSlice(defaultEndpointSliceName)
+	missingHints.Endpoints[1].Hints = nil
+
+	wrongZone := buildDummyEndpointSlice(defaultEndpointSliceName)
+	wrongZone.Endpoints[1].Hints.ForZones = []discoveryv1.ForZone{{Name: "zone-a"}}
+
+	testCases := []struct {
+		endpointSlice       *discoveryv1.EndpointSlice
+		trafficDistribution string
+		expectedError       string
+	}{
+		{
+			endpointSlice:       buildDummyEndpointSlice(defaultEndpointSliceName),
+			trafficDistribution: corev1.ServiceTrafficDistributionPreferClose,
+		},
+		{
+			endpointSlice:       buildDummyEndpointSlice(defaultEndpointSliceName),
+			trafficDistribution: corev1.ServiceTrafficDistributionPreferSameZone,
+		},
+		{
+			endpointSlice:       buildDummyEndpointSlice(defaultEndpointSliceName),
+			trafficDistribution: corev1.ServiceTrafficDistributionPreferSameNode,
+			expectedError: "endpoint [10.0.0.2] of endpointslice test-service-abcde is not hinted to its node " +
+				"\"node-1\"",
+		},
+		{
+			endpointSlice:       missingHints,
+			trafficDistribution: corev1.ServiceTrafficDistributionPreferSameZone,
+			expectedError:       "endpoint [10.0.0.2] of endpointslice test-service-abcde has no hints",
+		},
+		{
+			endpointSlice:       wrongZone,
+			trafficDistribution: corev1.ServiceTrafficDistributionPreferSameZone,
+			expectedError: "endpoint [10.0.0.2] of endpointslice test-service-abcde is not hinted to its zone " +
+				"\"zone-b\"",
+		},
+		{
+			endpointSlice:       buildDummyEndpointSlice(defaultEndpointSliceName),
+			trafficDistribution: "PreferFarAway",
+			expectedError:       "traffic distribution PreferFarAway is not supported",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := NewBuilder(
+			buildTestClientWithEndpointSlices(testCase.endpointSlice), defaultEndpointSliceName, defaultEndpointSliceNamespace)
+
+		err := testBuilder.AssertTrafficDistribution(testCase.trafficDistribution)
+
+		if testCase.expectedError != "" {
+			assert.EqualError(t, err, testCase.expectedError)
+		} else {
+			assert.NoError(t, err)
+		}
+	}
+}
+
+// buildValidTestBuilder returns a valid Builder for testing.
+func buildValidTestBuilder() *Builder {
+	return NewBuilder(buildTestClientWithEndpointSlices(), defaultEndpointSliceName, defaultEndpointSliceNamespace)
+}
+
+// buildTestClientWithEndpointSlices returns a test client with the EndpointSlice scheme and the provided
+// endpointslices.
+func buildTestClientWithEndpointSlices(endpointSlices ...*discoveryv1.EndpointSlice) *clients.Settings {
+	var runtimeObjects []runtime.Object
+
+	for _, endpointSlice := range endpointSlices {
+		runtimeObjects = append(runtimeObjects, endpointSlice)
+	}
+
+	return clients.GetTestClients(clients.TestClientParams{
+		K8sMockObjects:  runtimeObjects,
+		SchemeAttachers: []clients.SchemeAttacher{discoveryv1.AddToScheme},
+	})
+}
+
+// buildDummyEndpointSlice returns an endpointslice for the default service with two ready endpoints in different
+// zones, a terminating endpoint that is still serving, and an endpoint that is not ready.
+func buildDummyEndpointSlice(name string) *discoveryv1.EndpointSlice {
+	return &discoveryv1.EndpointSlice{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: defaultEndpointSliceNamespace,
+			Labels:    map[string]string{discoveryv1.LabelServiceName: defaultServiceName},
+		},
+		AddressType: discoveryv1.AddressTypeIPv4,
+		Endpoints: []discoveryv1.Endpoint{
+			{
+				Addresses: []string{"10.0.0.1"},
+				TargetRef: &corev1.ObjectReference{Kind: "Pod", Name: "pod-0"},
+				NodeName:  ptr.To("node-0"),
+				Zone:      ptr.To("zone-a"),
+				Hints: &discoveryv1.EndpointHints{
+					ForZones: []discoveryv1.ForZone{{Name: "zone-a"}},
+					ForNodes: []discoveryv1.ForNode{{Name: "node-0"}},
+				},
+			},
+			{
+				Addresses:  []string{"10.0.0.2"},
+				Conditions: discoveryv1.EndpointConditions{Ready: ptr.To(true)},
+				NodeName:   ptr.To("node-1"),
+				Zone:       ptr.To("zone-b"),
+				Hints:      &discoveryv1.EndpointHints{ForZones: []discoveryv1.ForZone{{Name: "zone-b"}}},
+			},
+			{
+				Addresses: []string{"10.0.0.3"},
+				TargetRef: &corev1.ObjectReference{Kind: "Pod", Name: "pod-2"},
+				Conditions: discoveryv1.EndpointConditions{
+					Ready: ptr.To(false), Serving: ptr.To(true), Terminating: ptr.To(true),
+				},
+			},
+			{
+				Addresses:  []string{"10.0.0.4"},
+				TargetRef:  &corev1.ObjectReference{Kind: "Pod", Name: "pod-3"},
+				Conditions: discoveryv1.EndpointConditions{Ready: ptr.To(false)},
+			},
+		},
+	}
+}
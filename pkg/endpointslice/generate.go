@@ -0,0 +1,3 @@
+package endpointslice
+
+//go:generate go run ../../internal/listgen -builder Builder
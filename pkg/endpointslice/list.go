@@ -0,0 +1,115 @@
+package endpointslice
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/klog/v2"
+	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// ListByService returns the endpointslice builders for the service of the provided name in the provided namespace.
+// Dual-stack services have one endpointslice per address family and large services may be split across several.
+func ListByService(
+	apiClient *clients.Settings, serviceName, nsname string, options ...runtimeclient.ListOption) ([]*Builder, error) {
+	if serviceName == "" {
+		klog.V(100).Info("EndpointSlice 'serviceName' parameter can not be empty")
+
+		return nil, fmt.Errorf("failed to list endpointslices, 'serviceName' parameter is empty")
+	}
+
+	klog.V(100).Infof("Listing endpointslices for service %s in namespace %s", serviceName, nsname)
+
+	allOptions := append(
+		[]runtimeclient.ListOption{runtimeclient.MatchingLabels{discoveryv1.LabelServiceName: serviceName}}, options...)
+
+	return List(apiClient, nsname, allOptions...)
+}
+
+// GetReadyAddressesForService returns the ready addresses across all the endpointslices of the service of the provided
+// name in the provided namespace, mapping the service to the pods currently able to receive its traffic.
+func GetReadyAddressesForService(apiClient *clients.Settings, serviceName, nsname string) ([]string, error) {
+	endpointSlices, err := ListByService(apiClient, serviceName, nsname)
+	if err != nil {
+		return nil, err
+	}
+
+	var addresses []string
+
+	for _, endpointSlice := range endpointSlices {
+		addresses = append(addresses, readyAddresses(endpointSlice.Object)...)
+	}
+
+	return addresses, nil
+}
+
+// AssertTrafficDistributionForService checks that the hints on the ready endpoints of every endpointslice of the
+// service of the provided name in the provided namespace match the provided traffic distribution, as described in
+// Builder.AssertTrafficDistribution. It fails if the service has no endpointslices.
+func AssertTrafficDistributionForService(
+	apiClient *clients.Settings, serviceName, nsname, trafficDistribution string) error {
+	endpointSlices, err := ListByService(apiClient, serviceName, nsname)
+	if err != nil {
+		return err
+	}
+
+	if len(endpointSlices) == 0 {
+		return fmt.Errorf("service %s in namespace %s has no endpointslices", serviceName, nsname)
+	}
+
+	for _, endpointSlice := range endpointSlices {
+		err = endpointSlice.AssertTrafficDistribution(trafficDistribution)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// WaitForTrafficDistributionForService waits up to timeout for the endpointslices of the service of the provided name
+// in the provided namespace to be hinted for the provided traffic distribution. Hints are populated asynchronously by
+// the endpointslice controller, so this should be used after creating the service or changing its endpoints. The last
+// mismatch is returned if the timeout is reached.
+func WaitForTrafficDistributionForService(
+	apiClient *clients.Settings, serviceName, nsname, trafficDistribution string, timeout time.Duration) error {
+	klog.V(100).Infof("Waiting up to %s for endpointslices of service %s in namespace %s to have hints for %s",
+		timeout, serviceName, nsname, trafficDistribution)
+
+	var lastErr error
+
+	err := wait.PollUntilContextTimeout(
+		context.TODO(), time.Second, timeout, true, func(ctx context.Context) (bool, error) {
+			lastErr = AssertTrafficDistributionForService(apiClient, serviceName, nsname, trafficDistribution)
+			if lastErr != nil {
+				klog.V(100).Infof("Endpointslices of service %s are not hinted yet: %v", serviceName, lastErr)
+
+				return false, nil
+			}
+
+			return true, nil
+		})
+	if err != nil && lastErr != nil {
+		return fmt.Errorf("failed waiting for traffic distribution %s of service %s: %w",
+			trafficDistribution, serviceName, lastErr)
+	}
+
+	return err
+}
+
+// readyAddresses returns the addresses of the ready endpoints in the endpointslice.
+func readyAddresses(endpointSlice *discoveryv1.EndpointSlice) []string {
+	var addresses []string
+
+	for _, endpoint := range endpointSlice.Endpoints {
+		if isEndpointReady(endpoint) {
+			addresses = append(addresses, endpoint.Addresses...)
+		}
+	}
+
+	return addresses
+}
@@ -0,0 +1,171 @@
+package endpointslice
+
+import (
+	"testing"
+	"time"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common/testhelper"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func TestList(t *testing.T) {
+	t.Parallel()
+
+	testhelper.NewNamespacedListTestConfig(
+		func(apiClient *clients.Settings, nsname string, _ ...runtimeclient.ListOptions) ([]*Builder, error) {
+			return List(apiClient, nsname)
+		},
+		discoveryv1.AddToScheme,
+		endpointSliceGVK,
+	).ExecuteTests(t)
+}
+
+func TestListInAllNamespaces(t *testing.T) {
+	t.Parallel()
+
+	testhelper.NewListTestConfig(ListInAllNamespaces, discoveryv1.AddToScheme, endpointSliceGVK).ExecuteTests(t)
+}
+
+func TestListByService(t *testing.T) {
+	t.Parallel()
+
+	otherService := buildDummyEndpointSlice("other-service-abcde")
+	otherService.Labels[discoveryv1.LabelServiceName] = "other-service"
+
+	testSettings := buildTestClientWithEndpointSlices(
+		buildDummyEndpointSlice(defaultEndpointSliceName), buildDummyEndpointSlice("test-service-fghij"), otherService)
+
+	testCases := []struct {
+		serviceName   string
+		nsname        string
+		expectedNames []string
+		expectedError string
+	}{
+		{
+			serviceName:   defaultServiceName,
+			nsname:        defaultEndpointSliceNamespace,
+			expectedNames: []string{defaultEndpointSliceName, "test-service-fghij"},
+		},
+		{
+			serviceName:   "missing-service",
+			nsname:        defaultEndpointSliceNamespace,
+			expectedNames: nil,
+		},
+		{
+			serviceName:   "",
+			nsname:        defaultEndpointSliceNamespace,
+			expectedError: "failed to list endpointslices, 'serviceName' parameter is empty",
+		},
+		{
+			serviceName:   defaultServiceName,
+			nsname:        "",
+			expectedError: "namespace of the builder for EndpointSlice is empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		builders, err := ListByService(testSettings, testCase.serviceName, testCase.nsname)
+
+		if testCase.expectedError != "" {
+			assert.ErrorContains(t, err, testCase.expectedError)
+
+			continue
+		}
+
+		assert.NoError(t, err)
+
+		var names []string
+
+		for _, builder := range builders {
+			names = append(names, builder.Definition.Name)
+		}
+
+		assert.Equal(t, testCase.expectedNames, names)
+	}
+}
+
+func TestGetReadyAddressesForService(t *testing.T) {
+	t.Parallel()
+
+	ipv6Slice := buildDummyEndpointSlice("test-service-ipv6")
+	ipv6Slice.AddressType = discoveryv1.AddressTypeIPv6
+	ipv6Slice.Endpoints = ipv6Slice.Endpoints[:1]
+	ipv6Slice.Endpoints[0].Addresses = []string{"fd00::1"}
+
+	testSettings := buildTestClientWithEndpointSlices(buildDummyEndpointSlice(defaultEndpointSliceName), ipv6Slice)
+
+	addresses, err := GetReadyAddressesForService(testSettings, defaultServiceName, defaultEndpointSliceNamespace)
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []string{"10.0.0.1", "10.0.0.2", "fd00::1"}, addresses)
+
+	_, err = GetReadyAddressesForService(testSettings, "", defaultEndpointSliceNamespace)
+	assert.Error(t, err)
+}
+
+func TestAssertTrafficDistributionForService(t *testing.T) {
+	t.Parallel()
+
+	missingHints := buildDummyEndpointSlice("test-service-fghij")
+	missingHints.Endpoints[0].Hints = nil
+
+	testCases := []struct {
+		endpointSlices      []*discoveryv1.EndpointSlice
+		serviceName         string
+		trafficDistribution string
+		expectedError       string
+	}{
+		{
+			endpointSlices:      []*discoveryv1.EndpointSlice{buildDummyEndpointSlice(defaultEndpointSliceName)},
+			serviceName:         defaultServiceName,
+			trafficDistribution: corev1.ServiceTrafficDistributionPreferSameZone,
+		},
+		{
+			endpointSlices:      []*discoveryv1.EndpointSlice{buildDummyEndpointSlice(defaultEndpointSliceName), missingHints},
+			serviceName:         defaultServiceName,
+			trafficDistribution: corev1.ServiceTrafficDistributionPreferSameZone,
+			expectedError:       "endpoint [10.0.0.1] of endpointslice test-service-fghij has no hints",
+		},
+		{
+			serviceName:         defaultServiceName,
+			trafficDistribution: corev1.ServiceTrafficDistributionPreferSameZone,
+			expectedError:       "service test-service in namespace test-namespace has no endpointslices",
+		},
+		{
+			serviceName:         "",
+			trafficDistribution: corev1.ServiceTrafficDistributionPreferSameZone,
+			expectedError:       "failed to list endpointslices, 'serviceName' parameter is empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		err := AssertTrafficDistributionForService(buildTestClientWithEndpointSlices(testCase.endpointSlices...),
+			testCase.serviceName, defaultEndpointSliceNamespace, testCase.trafficDistribution)
+
+		if testCase.expectedError != "" {
+			assert.EqualError(t, err, testCase.expectedError)
+
+			continue
+		}
+
+		assert.NoError(t, err)
+	}
+}
+
+func TestWaitForTrafficDistributionForService(t *testing.T) {
+	t.Parallel()
+
+	testSettings := buildTestClientWithEndpointSlices(buildDummyEndpointSlice(defaultEndpointSliceName))
+
+	err := WaitForTrafficDistributionForService(testSettings, defaultServiceName, defaultEndpointSliceNamespace,
+		corev1.ServiceTrafficDistributionPreferClose, time.Second)
+	assert.NoError(t, err)
+
+	err = WaitForTrafficDistributionForService(testSettings, defaultServiceName, defaultEndpointSliceNamespace,
+		corev1.ServiceTrafficDistributionPreferSameNode, 100*time.Millisecond)
+	assert.EqualError(t, err, "failed waiting for traffic distribution PreferSameNode of service test-service: "+
+		"endpoint [10.0.0.2] of endpointslice test-service-abcde is not hinted to its node \"node-1\"")
+}
@@ -0,0 +1,98 @@
+package etcd
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/pod"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/klog/v2"
+)
+
+const (
+	// DefaultBackupDirectory is the directory on the node backups are written to if none is provided.
+	DefaultBackupDirectory = "/home/core/backup"
+	// clusterBackupScript is the path on control plane nodes of the script that backs up etcd and the static pod
+	// resources.
+	clusterBackupScript = "/usr/local/bin/cluster-backup.sh"
+	// backupPodPrefix is the name prefix of the debug pods backups are triggered from.
+	backupPodPrefix = "etcd-backup-"
+	// hostRootVolumeName is the name of the volume mounting the root of the node in the backup pod.
+	hostRootVolumeName = "host"
+	// hostRootMountPath is where the root of the node is mounted in the backup pod.
+	hostRootMountPath = "/host"
+)
+
+// TriggerBackup runs the cluster-backup script on the control plane node nodeName from a privileged debug pod running
+// image, writing the etcd snapshot and static pod resources to backupDir on the node. If backupDir is empty,
+// DefaultBackupDirectory is used. The output of the script is returned and the debug pod is always deleted. The pod
+// creation and the backup are each bounded by timeout.
+func TriggerBackup(apiClient *clients.Settings, nodeName, image, backupDir string, timeout time.Duration) (string, error) {
+	klog.V(100).Infof("Triggering etcd backup on node %s to %s", nodeName, backupDir)
+
+	if apiClient == nil {
+		klog.V(100).Info("The apiClient is empty")
+
+		return "", fmt.Errorf("etcd backup 'apiClient' cannot be empty")
+	}
+
+	if nodeName == "" {
+		klog.V(100).Info("The etcd backup node name is empty")
+
+		return "", fmt.Errorf("etcd backup 'nodeName' cannot be empty")
+	}
+
+	if image == "" {
+		klog.V(100).Info("The etcd backup image is empty")
+
+		return "", fmt.Errorf("etcd backup 'image' cannot be empty")
+	}
+
+	if backupDir == "" {
+		backupDir = DefaultBackupDirectory
+	}
+
+	backupPod, err := pod.NewBuilder(apiClient, backupPodPrefix+nodeName, Namespace, image).
+		RedefineDefaultCMD([]string{"/bin/sh", "-c", "sleep infinity"}).
+		DefineOnNode(nodeName).
+		WithTolerationToMaster().
+		WithHostNetwork().
+		WithHostPid(true).
+		WithPrivilegedFlag().
+		WithVolume(corev1.Volume{
+			Name: hostRootVolumeName,
+			VolumeSource: corev1.VolumeSource{
+				HostPath: &corev1.HostPathVolumeSource{Path: "/"},
+			},
+		}).
+		WithLocalVolume(hostRootVolumeName, hostRootMountPath).
+		CreateAndWaitUntilRunning(timeout)
+	if err != nil {
+		if backupPod != nil {
+			_, _ = backupPod.DeleteAndWait(timeout)
+		}
+
+		return "", fmt.Errorf("failed to deploy etcd backup pod on node %s: %w", nodeName, err)
+	}
+
+	output, err := backupPod.ExecCommandWithTimeout(
+		[]string{"chroot", hostRootMountPath, clusterBackupScript, backupDir}, timeout)
+
+	_, deleteErr := backupPod.DeleteAndWait(timeout)
+	if deleteErr != nil {
+		klog.V(100).Infof("Failed to delete etcd backup pod on node %s: %v", nodeName, deleteErr)
+	}
+
+	if err != nil {
+		return output.String(), fmt.Errorf("etcd backup on node %s failed: %s: %w",
+			nodeName, strings.TrimSpace(output.String()), err)
+	}
+
+	if deleteErr != nil {
+		return output.String(), fmt.Errorf("failed to delete etcd backup pod on node %s: %w", nodeName, deleteErr)
+	}
+
+	return output.String(), nil
+}
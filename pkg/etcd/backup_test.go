@@ -0,0 +1,59 @@
+package etcd
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/stretchr/testify/assert"
+)
+
+const defaultBackupImage = "registry.example.com/tools:latest"
+
+func TestTriggerBackup(t *testing.T) {
+	testCases := []struct {
+		client        bool
+		nodeName      string
+		image         string
+		expectedError error
+	}{
+		{
+			client:        false,
+			nodeName:      defaultMemberNodes[0],
+			image:         defaultBackupImage,
+			expectedError: fmt.Errorf("etcd backup 'apiClient' cannot be empty"),
+		},
+		{
+			client:        true,
+			nodeName:      "",
+			image:         defaultBackupImage,
+			expectedError: fmt.Errorf("etcd backup 'nodeName' cannot be empty"),
+		},
+		{
+			client:        true,
+			nodeName:      defaultMemberNodes[0],
+			image:         "",
+			expectedError: fmt.Errorf("etcd backup 'image' cannot be empty"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		var testSettings *clients.Settings
+
+		if testCase.client {
+			testSettings = clients.GetTestClients(clients.TestClientParams{})
+		}
+
+		output, err := TriggerBackup(testSettings, testCase.nodeName, testCase.image, "", time.Second)
+		assert.Equal(t, testCase.expectedError, err)
+		assert.Empty(t, output)
+	}
+}
+
+func TestTriggerBackupPodNotRunning(t *testing.T) {
+	output, err := TriggerBackup(
+		clients.GetTestClients(clients.TestClientParams{}), defaultMemberNodes[0], defaultBackupImage, "", time.Second)
+	assert.ErrorContains(t, err, "failed to deploy etcd backup pod on node master-0")
+	assert.Empty(t, output)
+}
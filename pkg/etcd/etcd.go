@@ -0,0 +1,300 @@
+package etcd
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/logging"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/msg"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/klog/v2"
+	goclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// Namespace is the namespace the etcd static pods run in.
+	Namespace = "openshift-etcd"
+	// etcdObjName is the name of the etcd operator resource.
+	etcdObjName = "cluster"
+	// etcdPodPrefix is the name prefix of the etcd static pods, which are named etcd-<node>.
+	etcdPodPrefix = "etcd-"
+	// conditionTypeEtcdMembersAvailable is the etcd operator condition reporting whether the etcd members have quorum.
+	conditionTypeEtcdMembersAvailable = "EtcdMembersAvailable"
+	// quorumPollInterval is how often the members are checked while waiting for quorum.
+	quorumPollInterval = 5 * time.Second
+)
+
+// Builder provides a struct for the etcd operator resource.
+type Builder struct {
+	// Etcd definition. Used to pull the etcd object.
+	Definition *operatorv1.Etcd
+	// Pulled etcd object.
+	Object *operatorv1.Etcd
+	// apiClient opens api connection to the cluster.
+	apiClient goclient.Client
+}
+
+// MemberStatus describes the health of the etcd member on a control plane node.
+type MemberStatus struct {
+	// NodeName is the control plane node the member runs on.
+	NodeName string
+	// CurrentRevision is the revision of the static pod currently running on the node.
+	CurrentRevision int32
+	// TargetRevision is the revision of the static pod the operator is rolling out to the node.
+	TargetRevision int32
+	// Healthy is whether the etcd pod of the member is running and ready.
+	Healthy bool
+	// Message explains why the member is not healthy. It is empty for healthy members.
+	Message string
+}
+
+// Pull retrieves the etcd operator resource from the cluster.
+func Pull(apiClient *clients.Settings) (*Builder, error) {
+	klog.V(100).Infof("Pulling existing etcd %s from cluster", etcdObjName)
+
+	if apiClient == nil {
+		klog.V(100).Info("The apiClient is empty")
+
+		return nil, fmt.Errorf("etcd 'apiClient' cannot be empty")
+	}
+
+	builder := Builder{
+		apiClient: apiClient.Client,
+		Definition: &operatorv1.Etcd{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: etcdObjName,
+			},
+		},
+	}
+
+	if !builder.Exists() {
+		klog.V(100).Infof("The etcd %s does not exist", etcdObjName)
+
+		return nil, fmt.Errorf("etcd object %s does not exist", etcdObjName)
+	}
+
+	builder.Definition = builder.Object
+
+	return &builder, nil
+}
+
+// Get returns the etcd object if found.
+func (builder *Builder) Get() (*operatorv1.Etcd, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	klog.V(100).Infof("Getting etcd object %s", builder.Definition.Name)
+
+	etcd := &operatorv1.Etcd{}
+
+	err := builder.apiClient.Get(logging.DiscardContext(), goclient.ObjectKey{Name: builder.Definition.Name}, etcd)
+	if err != nil {
+		klog.V(100).Infof("Failed to get etcd object %s: %v", builder.Definition.Name, err)
+
+		return nil, err
+	}
+
+	return etcd, nil
+}
+
+// Exists checks whether the etcd object exists.
+func (builder *Builder) Exists() bool {
+	if valid, _ := builder.validate(); !valid {
+		return false
+	}
+
+	klog.V(100).Infof("Checking if etcd %s exists", builder.Definition.Name)
+
+	var err error
+
+	builder.Object, err = builder.Get()
+
+	return err == nil || !k8serrors.IsNotFound(err)
+}
+
+// ListMembers returns the status of the etcd member on each control plane node managed by the etcd operator. A member
+// is healthy if its etcd pod is running and ready.
+func (builder *Builder) ListMembers() ([]MemberStatus, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	klog.V(100).Infof("Listing members of etcd %s", builder.Definition.Name)
+
+	etcd, err := builder.Get()
+	if err != nil {
+		return nil, err
+	}
+
+	builder.Object = etcd
+
+	members := make([]MemberStatus, 0, len(etcd.Status.NodeStatuses))
+
+	for _, nodeStatus := range etcd.Status.NodeStatuses {
+		member := MemberStatus{
+			NodeName:        nodeStatus.NodeName,
+			CurrentRevision: nodeStatus.CurrentRevision,
+			TargetRevision:  nodeStatus.TargetRevision,
+		}
+
+		member.Healthy, member.Message = builder.getMemberPodHealth(nodeStatus.NodeName)
+
+		members = append(members, member)
+	}
+
+	return members, nil
+}
+
+// WaitForQuorumHealthy waits up to timeout for etcd to have quorum. This requires the EtcdMembersAvailable condition
+// of the etcd operator to be True and a majority of the members to be healthy.
+func (builder *Builder) WaitForQuorumHealthy(timeout time.Duration) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	klog.V(100).Infof("Waiting up to %s for etcd %s to have a healthy quorum", timeout, builder.Definition.Name)
+
+	var lastErr error
+
+	err := wait.PollUntilContextTimeout(
+		context.TODO(), quorumPollInterval, timeout, true, func(ctx context.Context) (bool, error) {
+			members, err := builder.ListMembers()
+			if err != nil {
+				lastErr = err
+
+				return false, nil
+			}
+
+			lastErr = checkQuorum(builder.Object, members)
+			if lastErr != nil {
+				klog.V(100).Infof("The etcd %s does not have a healthy quorum: %v", builder.Definition.Name, lastErr)
+
+				return false, nil
+			}
+
+			return true, nil
+		})
+	if err != nil {
+		if lastErr != nil {
+			return fmt.Errorf("etcd %s quorum is not healthy: %w", builder.Definition.Name, lastErr)
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+// getMemberPodHealth returns whether the etcd pod on nodeName is running and ready and, if not, why.
+func (builder *Builder) getMemberPodHealth(nodeName string) (bool, string) {
+	etcdPod := &corev1.Pod{}
+
+	err := builder.apiClient.Get(logging.DiscardContext(), goclient.ObjectKey{
+		Name:      etcdPodPrefix + nodeName,
+		Namespace: Namespace,
+	}, etcdPod)
+	if err != nil {
+		klog.V(100).Infof("Failed to get etcd pod on node %s: %v", nodeName, err)
+
+		return false, fmt.Sprintf("failed to get etcd pod: %v", err)
+	}
+
+	if etcdPod.Status.Phase != corev1.PodRunning {
+		return false, fmt.Sprintf("etcd pod is in phase %s", etcdPod.Status.Phase)
+	}
+
+	for _, condition := range etcdPod.Status.Conditions {
+		if condition.Type != corev1.PodReady {
+			continue
+		}
+
+		if condition.Status == corev1.ConditionTrue {
+			return true, ""
+		}
+
+		return false, fmt.Sprintf("etcd pod is not ready: %s", condition.Message)
+	}
+
+	return false, "etcd pod has no Ready condition"
+}
+
+// checkQuorum returns an error if the EtcdMembersAvailable condition of etcd is not True or fewer than a majority of
+// the members are healthy.
+func checkQuorum(etcd *operatorv1.Etcd, members []MemberStatus) error {
+	if len(members) == 0 {
+		return fmt.Errorf("no etcd members found")
+	}
+
+	available := false
+
+	for _, condition := range etcd.Status.Conditions {
+		if condition.Type != conditionTypeEtcdMembersAvailable {
+			continue
+		}
+
+		if condition.Status != operatorv1.ConditionTrue {
+			return fmt.Errorf("condition %s is %s: %s", condition.Type, condition.Status, condition.Message)
+		}
+
+		available = true
+	}
+
+	if !available {
+		return fmt.Errorf("condition %s not found", conditionTypeEtcdMembersAvailable)
+	}
+
+	healthy := 0
+
+	for _, member := range members {
+		if member.Healthy {
+			healthy++
+		}
+	}
+
+	if healthy <= len(members)/2 {
+		return fmt.Errorf("only %d of %d members are healthy", healthy, len(members))
+	}
+
+	return nil
+}
+
+// GetGVR returns Etcd's GroupVersionResource which could be used for Clean function.
+func GetGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group: "operator.openshift.io", Version: "v1", Resource: "etcds",
+	}
+}
+
+// validate will check that the builder and builder definition are properly initialized before
+// accessing any member fields.
+func (builder *Builder) validate() (bool, error) {
+	resourceCRD := "Etcd"
+
+	if builder == nil {
+		klog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+
+		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
+	}
+
+	if builder.Definition == nil {
+		klog.V(100).Infof("The %s is undefined", resourceCRD)
+
+		return false, fmt.Errorf("%s", msg.UndefinedCrdObjectErrString(resourceCRD))
+	}
+
+	if builder.apiClient == nil {
+		klog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+
+		return false, fmt.Errorf("%s builder cannot have nil apiClient", resourceCRD)
+	}
+
+	return true, nil
+}
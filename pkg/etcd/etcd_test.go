@@ -0,0 +1,267 @@
+package etcd
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var (
+	testSchemes = []clients.SchemeAttacher{
+		operatorv1.Install,
+	}
+	defaultMemberNodes = []string{"master-0", "master-1", "master-2"}
+)
+
+func TestPullEtcd(t *testing.T) {
+	testCases := []struct {
+		addToRuntimeObjects bool
+		client              bool
+		expectedError       error
+	}{
+		{
+			addToRuntimeObjects: true,
+			client:              true,
+			expectedError:       nil,
+		},
+		{
+			addToRuntimeObjects: false,
+			client:              true,
+			expectedError:       fmt.Errorf("etcd object %s does not exist", etcdObjName),
+		},
+		{
+			addToRuntimeObjects: true,
+			client:              false,
+			expectedError:       fmt.Errorf("etcd 'apiClient' cannot be empty"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		var (
+			runtimeObjects []runtime.Object
+			testSettings   *clients.Settings
+		)
+
+		if testCase.addToRuntimeObjects {
+			runtimeObjects = append(runtimeObjects, buildDummyEtcd(operatorv1.ConditionTrue))
+		}
+
+		if testCase.client {
+			testSettings = clients.GetTestClients(clients.TestClientParams{
+				K8sMockObjects:  runtimeObjects,
+				SchemeAttachers: testSchemes,
+			})
+		}
+
+		testBuilder, err := Pull(testSettings)
+		assert.Equal(t, testCase.expectedError, err)
+
+		if testCase.expectedError == nil {
+			assert.Equal(t, etcdObjName, testBuilder.Definition.Name)
+		}
+	}
+}
+
+func TestEtcdExists(t *testing.T) {
+	testCases := []struct {
+		testBuilder *Builder
+		exists      bool
+	}{
+		{
+			testBuilder: newEtcdBuilder(buildTestClientWithEtcd(operatorv1.ConditionTrue)),
+			exists:      true,
+		},
+		{
+			testBuilder: newEtcdBuilder(clients.GetTestClients(clients.TestClientParams{SchemeAttachers: testSchemes})),
+			exists:      false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		assert.Equal(t, testCase.exists, testCase.testBuilder.Exists())
+	}
+}
+
+func TestEtcdListMembers(t *testing.T) {
+	testCases := []struct {
+		testBuilder     *Builder
+		expectedHealthy []bool
+		expectedError   error
+	}{
+		{
+			testBuilder:     newEtcdBuilder(buildTestClientWithEtcd(operatorv1.ConditionTrue)),
+			expectedHealthy: []bool{true, false, false},
+			expectedError:   nil,
+		},
+		{
+			testBuilder:   nil,
+			expectedError: fmt.Errorf("error: received nil Etcd builder"),
+		},
+		{
+			testBuilder: &Builder{
+				apiClient: buildTestClientWithEtcd(operatorv1.ConditionTrue).Client,
+			},
+			expectedError: fmt.Errorf("can not redefine the undefined Etcd"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		members, err := testCase.testBuilder.ListMembers()
+		assert.Equal(t, testCase.expectedError, err)
+
+		if testCase.expectedError != nil {
+			continue
+		}
+
+		assert.Len(t, members, len(defaultMemberNodes))
+
+		for index, member := range members {
+			assert.Equal(t, defaultMemberNodes[index], member.NodeName)
+			assert.Equal(t, testCase.expectedHealthy[index], member.Healthy)
+		}
+
+		assert.Empty(t, members[0].Message)
+		assert.Equal(t, "etcd pod is not ready: containers not ready", members[1].Message)
+		assert.Contains(t, members[2].Message, "failed to get etcd pod")
+	}
+}
+
+func TestEtcdWaitForQuorumHealthy(t *testing.T) {
+	testCases := []struct {
+		testBuilder   *Builder
+		expectedError string
+	}{
+		{
+			testBuilder:   newEtcdBuilder(buildTestClientWithEtcd(operatorv1.ConditionFalse)),
+			expectedError: "etcd cluster quorum is not healthy: condition EtcdMembersAvailable is False: members unavailable",
+		},
+		{
+			testBuilder:   nil,
+			expectedError: "error: received nil Etcd builder",
+		},
+	}
+
+	for _, testCase := range testCases {
+		err := testCase.testBuilder.WaitForQuorumHealthy(time.Second)
+		assert.EqualError(t, err, testCase.expectedError)
+	}
+}
+
+func TestCheckQuorum(t *testing.T) {
+	healthyMember := MemberStatus{Healthy: true}
+	unhealthyMember := MemberStatus{Healthy: false}
+
+	testCases := []struct {
+		etcd          *operatorv1.Etcd
+		members       []MemberStatus
+		expectedError error
+	}{
+		{
+			etcd:          buildDummyEtcd(operatorv1.ConditionTrue),
+			members:       []MemberStatus{healthyMember, healthyMember, unhealthyMember},
+			expectedError: nil,
+		},
+		{
+			etcd:          buildDummyEtcd(operatorv1.ConditionTrue),
+			members:       []MemberStatus{healthyMember, unhealthyMember, unhealthyMember},
+			expectedError: fmt.Errorf("only 1 of 3 members are healthy"),
+		},
+		{
+			etcd:          buildDummyEtcd(operatorv1.ConditionFalse),
+			members:       []MemberStatus{healthyMember, healthyMember, healthyMember},
+			expectedError: fmt.Errorf("condition EtcdMembersAvailable is False: members unavailable"),
+		},
+		{
+			etcd:          &operatorv1.Etcd{},
+			members:       []MemberStatus{healthyMember},
+			expectedError: fmt.Errorf("condition EtcdMembersAvailable not found"),
+		},
+		{
+			etcd:          buildDummyEtcd(operatorv1.ConditionTrue),
+			members:       nil,
+			expectedError: fmt.Errorf("no etcd members found"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		err := checkQuorum(testCase.etcd, testCase.members)
+		assert.Equal(t, testCase.expectedError, err)
+	}
+}
+
+// buildDummyEtcd returns an Etcd with the default member nodes and the EtcdMembersAvailable condition set to
+// availableStatus.
+func buildDummyEtcd(availableStatus operatorv1.ConditionStatus) *operatorv1.Etcd {
+	etcd := &operatorv1.Etcd{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: etcdObjName,
+		},
+	}
+
+	for _, nodeName := range defaultMemberNodes {
+		etcd.Status.NodeStatuses = append(etcd.Status.NodeStatuses, operatorv1.NodeStatus{
+			NodeName:        nodeName,
+			CurrentRevision: 3,
+			TargetRevision:  3,
+		})
+	}
+
+	condition := operatorv1.OperatorCondition{
+		Type:   conditionTypeEtcdMembersAvailable,
+		Status: availableStatus,
+	}
+
+	if availableStatus != operatorv1.ConditionTrue {
+		condition.Message = "members unavailable"
+	}
+
+	etcd.Status.Conditions = append(etcd.Status.Conditions, condition)
+
+	return etcd
+}
+
+// buildDummyEtcdPod returns a running etcd pod for nodeName with the provided Ready condition status.
+func buildDummyEtcdPod(nodeName string, ready corev1.ConditionStatus) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      etcdPodPrefix + nodeName,
+			Namespace: Namespace,
+		},
+		Status: corev1.PodStatus{
+			Phase: corev1.PodRunning,
+			Conditions: []corev1.PodCondition{{
+				Type:    corev1.PodReady,
+				Status:  ready,
+				Message: "containers not ready",
+			}},
+		},
+	}
+}
+
+// buildTestClientWithEtcd returns a client with an Etcd for the default member nodes, a ready etcd pod on the first
+// node, and an unready etcd pod on the second node. The third node has no etcd pod.
+func buildTestClientWithEtcd(availableStatus operatorv1.ConditionStatus) *clients.Settings {
+	return clients.GetTestClients(clients.TestClientParams{
+		K8sMockObjects: []runtime.Object{
+			buildDummyEtcd(availableStatus),
+			buildDummyEtcdPod(defaultMemberNodes[0], corev1.ConditionTrue),
+			buildDummyEtcdPod(defaultMemberNodes[1], corev1.ConditionFalse),
+		},
+		SchemeAttachers: testSchemes,
+	})
+}
+
+// newEtcdBuilder returns a Builder for the default Etcd with the provided client.
+func newEtcdBuilder(apiClient *clients.Settings) *Builder {
+	return &Builder{
+		apiClient:  apiClient.Client,
+		Definition: buildDummyEtcd(operatorv1.ConditionTrue),
+	}
+}
@@ -12,6 +12,8 @@ import (
 // List returns Events inventory in the given namespace.
 func List(
 	apiClient *clients.Settings, nsname string, options ...metaV1.ListOptions) ([]*Builder, error) {
+	nsname = apiClient.ResolveNamespace(nsname)
+
 	if nsname == "" {
 		klog.V(100).Info("Events 'nsname' parameter can not be empty")
 
@@ -0,0 +1,210 @@
+package externalsecrets
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common"
+	esv1 "github.com/rh-ecosystem-edge/eco-goinfra/pkg/schemes/externalsecrets/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/klog/v2"
+	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// ClusterSecretStoreBuilder provides a struct for the ClusterSecretStore resource containing a connection to the
+// cluster and the ClusterSecretStore definition. ClusterSecretStores configure a provider that ExternalSecrets in any
+// namespace may be synced from, unless restricted using WithNamespaces or WithNamespaceSelector.
+type ClusterSecretStoreBuilder struct {
+	common.EmbeddableBuilder[esv1.ClusterSecretStore, *esv1.ClusterSecretStore]
+	common.EmbeddableCreator[esv1.ClusterSecretStore, ClusterSecretStoreBuilder,
+		*esv1.ClusterSecretStore, *ClusterSecretStoreBuilder]
+	common.EmbeddableDeleter[esv1.ClusterSecretStore, *esv1.ClusterSecretStore]
+	common.EmbeddableUpdater[esv1.ClusterSecretStore, ClusterSecretStoreBuilder,
+		*esv1.ClusterSecretStore, *ClusterSecretStoreBuilder]
+}
+
+// AttachMixins wires the embedded CRUD mixins to this builder instance.
+func (builder *ClusterSecretStoreBuilder) AttachMixins() {
+	builder.EmbeddableCreator.SetBase(builder)
+	builder.EmbeddableDeleter.SetBase(builder)
+	builder.EmbeddableUpdater.SetBase(builder)
+}
+
+// GetGVK returns the ClusterSecretStore GVK for this builder.
+func (builder *ClusterSecretStoreBuilder) GetGVK() schema.GroupVersionKind {
+	return esv1.GroupVersion.WithKind(ClusterSecretStoreKind)
+}
+
+// NewClusterSecretStoreBuilder creates a new instance of ClusterSecretStoreBuilder. A provider must be set using one of
+// the With*Provider methods before creating the ClusterSecretStore.
+func NewClusterSecretStoreBuilder(apiClient *clients.Settings, name string) *ClusterSecretStoreBuilder {
+	return common.NewClusterScopedBuilder[esv1.ClusterSecretStore, ClusterSecretStoreBuilder](
+		apiClient, esv1.AddToScheme, name)
+}
+
+// PullClusterSecretStore pulls an existing ClusterSecretStore from the cluster.
+func PullClusterSecretStore(apiClient *clients.Settings, name string) (*ClusterSecretStoreBuilder, error) {
+	return common.PullClusterScopedBuilder[esv1.ClusterSecretStore, ClusterSecretStoreBuilder](
+		context.TODO(), apiClient, esv1.AddToScheme, name)
+}
+
+// ListClusterSecretStores returns the ClusterSecretStores on the cluster matching the provided options.
+func ListClusterSecretStores(
+	apiClient *clients.Settings, options ...runtimeclient.ListOption) ([]*ClusterSecretStoreBuilder, error) {
+	return common.List[esv1.ClusterSecretStore, esv1.ClusterSecretStoreList, ClusterSecretStoreBuilder](
+		context.TODO(), apiClient, esv1.AddToScheme, options...)
+}
+
+// WithKubernetesProvider configures the ClusterSecretStore to read secrets from remoteNamespace on the same cluster,
+// authenticating as the provided service account in the namespace of each ExternalSecret. The caBundle is used to
+// verify the API server, such as the service CA of the cluster.
+func (builder *ClusterSecretStoreBuilder) WithKubernetesProvider(
+	remoteNamespace, serviceAccountName string, caBundle []byte) *ClusterSecretStoreBuilder {
+	if err := common.Validate(builder); err != nil {
+		return builder
+	}
+
+	klog.V(100).Infof("Setting kubernetes provider of clusterSecretStore %s to namespace %s",
+		builder.Definition.Name, remoteNamespace)
+
+	if err := setKubernetesProvider(&builder.Definition.Spec, remoteNamespace, serviceAccountName, caBundle); err != nil {
+		builder.SetError(err)
+	}
+
+	return builder
+}
+
+// WithFakeProvider configures the ClusterSecretStore to serve the provided static key/value pairs. It allows testing
+// ExternalSecrets without an external secret manager.
+func (builder *ClusterSecretStoreBuilder) WithFakeProvider(data map[string]string) *ClusterSecretStoreBuilder {
+	if err := common.Validate(builder); err != nil {
+		return builder
+	}
+
+	klog.V(100).Infof("Setting fake provider of clusterSecretStore %s with %d keys", builder.Definition.Name, len(data))
+
+	if err := setFakeProvider(&builder.Definition.Spec, data); err != nil {
+		builder.SetError(err)
+	}
+
+	return builder
+}
+
+// WithRefreshInterval sets how often the operator revalidates the ClusterSecretStore. It is rounded down to whole
+// seconds and zero uses the default of the operator.
+func (builder *ClusterSecretStoreBuilder) WithRefreshInterval(interval time.Duration) *ClusterSecretStoreBuilder {
+	if err := common.Validate(builder); err != nil {
+		return builder
+	}
+
+	klog.V(100).Infof("Setting refresh interval of clusterSecretStore %s to %s", builder.Definition.Name, interval)
+
+	if err := setRefreshInterval(&builder.Definition.Spec, interval); err != nil {
+		builder.SetError(err)
+	}
+
+	return builder
+}
+
+// WithNamespaces restricts the ClusterSecretStore to ExternalSecrets in the provided namespaces. It may be combined
+// with WithNamespaceSelector, in which case ExternalSecrets matching either are allowed.
+func (builder *ClusterSecretStoreBuilder) WithNamespaces(namespaces ...string) *ClusterSecretStoreBuilder {
+	if err := common.Validate(builder); err != nil {
+		return builder
+	}
+
+	klog.V(100).Infof("Restricting clusterSecretStore %s to namespaces %v", builder.Definition.Name, namespaces)
+
+	if len(namespaces) == 0 {
+		builder.SetError(fmt.Errorf("clusterSecretStore 'namespaces' cannot be empty"))
+
+		return builder
+	}
+
+	for _, namespace := range namespaces {
+		if namespace == "" {
+			builder.SetError(fmt.Errorf("clusterSecretStore 'namespaces' cannot contain an empty namespace"))
+
+			return builder
+		}
+	}
+
+	builder.Definition.Spec.Conditions = append(builder.Definition.Spec.Conditions,
+		esv1.ClusterSecretStoreCondition{Namespaces: namespaces})
+
+	return builder
+}
+
+// WithNamespaceSelector restricts the ClusterSecretStore to ExternalSecrets in namespaces with the provided labels.
+func (builder *ClusterSecretStoreBuilder) WithNamespaceSelector(
+	matchLabels map[string]string) *ClusterSecretStoreBuilder {
+	if err := common.Validate(builder); err != nil {
+		return builder
+	}
+
+	klog.V(100).Infof("Restricting clusterSecretStore %s to namespaces with labels %v",
+		builder.Definition.Name, matchLabels)
+
+	if len(matchLabels) == 0 {
+		builder.SetError(fmt.Errorf("clusterSecretStore 'matchLabels' cannot be empty"))
+
+		return builder
+	}
+
+	builder.Definition.Spec.Conditions = append(builder.Definition.Spec.Conditions,
+		esv1.ClusterSecretStoreCondition{NamespaceSelector: &metav1.LabelSelector{MatchLabels: matchLabels}})
+
+	return builder
+}
+
+// IsReady returns whether the ClusterSecretStore currently has the Ready condition set to True, meaning the operator
+// validated its provider. It returns false if the ClusterSecretStore cannot be retrieved.
+func (builder *ClusterSecretStoreBuilder) IsReady() bool {
+	state, err := builder.getReadyState()
+	if err != nil {
+		klog.V(100).Infof("Failed to get Ready condition of clusterSecretStore: %v", err)
+
+		return false
+	}
+
+	return isReadyStateTrue(state)
+}
+
+// WaitUntilReady waits up to timeout for the ClusterSecretStore to become Ready. On timeout, the error includes the
+// reason and message of the last Ready condition, which usually explains why the provider could not be validated.
+func (builder *ClusterSecretStoreBuilder) WaitUntilReady(timeout time.Duration) error {
+	if err := common.Validate(builder); err != nil {
+		return err
+	}
+
+	klog.V(100).Infof("Waiting up to %s for clusterSecretStore %s to become ready", timeout, builder.Definition.Name)
+
+	return waitForReadyCondition(fmt.Sprintf("clusterSecretStore %s", builder.Definition.Name),
+		timeout, builder.getReadyState, isReadyStateTrue)
+}
+
+// getReadyState refreshes the ClusterSecretStore and returns the state of its Ready condition.
+func (builder *ClusterSecretStoreBuilder) getReadyState() (readyState, error) {
+	if err := common.Validate(builder); err != nil {
+		return readyState{}, err
+	}
+
+	clusterSecretStore, err := builder.Get()
+	if err != nil {
+		return readyState{}, err
+	}
+
+	builder.Object = clusterSecretStore
+
+	return getSecretStoreReadyState(clusterSecretStore.Status), nil
+}
+
+// GetClusterSecretStoreGVR returns ClusterSecretStore's GroupVersionResource which could be used for Clean function.
+func GetClusterSecretStoreGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group: "external-secrets.io", Version: "v1", Resource: "clustersecretstores",
+	}
+}
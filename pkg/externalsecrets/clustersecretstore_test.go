@@ -0,0 +1,179 @@
+package externalsecrets
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common/testhelper"
+	esv1 "github.com/rh-ecosystem-edge/eco-goinfra/pkg/schemes/externalsecrets/v1"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var clusterSecretStoreGVK = esv1.GroupVersion.WithKind(ClusterSecretStoreKind)
+
+func TestNewClusterSecretStoreBuilder(t *testing.T) {
+	t.Parallel()
+
+	testhelper.NewClusterScopedBuilderTestConfig(
+		NewClusterSecretStoreBuilder, esv1.AddToScheme, clusterSecretStoreGVK).ExecuteTests(t)
+}
+
+func TestPullClusterSecretStore(t *testing.T) {
+	t.Parallel()
+
+	testhelper.NewClusterScopedPullTestConfig(
+		PullClusterSecretStore, esv1.AddToScheme, clusterSecretStoreGVK).ExecuteTests(t)
+}
+
+func TestClusterSecretStoreBuilderMethods(t *testing.T) {
+	t.Parallel()
+
+	commonConfig := testhelper.NewCommonTestConfig[esv1.ClusterSecretStore, ClusterSecretStoreBuilder](
+		esv1.AddToScheme, clusterSecretStoreGVK, testhelper.ResourceScopeClusterScoped)
+
+	testhelper.NewTestSuite().
+		With(testhelper.NewGetTestConfig(commonConfig)).
+		With(testhelper.NewExistsTestConfig(commonConfig)).
+		With(testhelper.NewCreateTestConfig(commonConfig)).
+		With(testhelper.NewDeleterTestConfig(commonConfig)).
+		With(testhelper.NewUpdateTestConfig(commonConfig)).
+		Run(t)
+}
+
+func TestListClusterSecretStores(t *testing.T) {
+	t.Parallel()
+
+	testhelper.NewListTestConfig(ListClusterSecretStores, esv1.AddToScheme, clusterSecretStoreGVK).ExecuteTests(t)
+}
+
+func TestClusterSecretStoreWithNamespaces(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		namespaces    []string
+		expectedError string
+	}{
+		{
+			namespaces: []string{"ns1", "ns2"},
+		},
+		{
+			namespaces:    nil,
+			expectedError: "clusterSecretStore 'namespaces' cannot be empty",
+		},
+		{
+			namespaces:    []string{"ns1", ""},
+			expectedError: "clusterSecretStore 'namespaces' cannot contain an empty namespace",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := NewClusterSecretStoreBuilder(buildTestClientWithSecretStores(), defaultSecretStoreName).
+			WithNamespaces(testCase.namespaces...)
+
+		if testCase.expectedError != "" {
+			assert.EqualError(t, testBuilder.GetError(), testCase.expectedError)
+
+			continue
+		}
+
+		assert.NoError(t, testBuilder.GetError())
+		assert.Equal(t, []esv1.ClusterSecretStoreCondition{{Namespaces: testCase.namespaces}},
+			testBuilder.Definition.Spec.Conditions)
+	}
+}
+
+func TestClusterSecretStoreWithNamespaceSelector(t *testing.T) {
+	t.Parallel()
+
+	matchLabels := map[string]string{"team": "edge"}
+
+	testBuilder := NewClusterSecretStoreBuilder(buildTestClientWithSecretStores(), defaultSecretStoreName).
+		WithNamespaces("ns1").
+		WithNamespaceSelector(matchLabels)
+	assert.NoError(t, testBuilder.GetError())
+	assert.Equal(t, []esv1.ClusterSecretStoreCondition{
+		{Namespaces: []string{"ns1"}},
+		{NamespaceSelector: &metav1.LabelSelector{MatchLabels: matchLabels}},
+	}, testBuilder.Definition.Spec.Conditions)
+
+	testBuilder = NewClusterSecretStoreBuilder(buildTestClientWithSecretStores(), defaultSecretStoreName).
+		WithNamespaceSelector(nil)
+	assert.EqualError(t, testBuilder.GetError(), "clusterSecretStore 'matchLabels' cannot be empty")
+}
+
+func TestClusterSecretStoreWithProviders(t *testing.T) {
+	t.Parallel()
+
+	testBuilder := NewClusterSecretStoreBuilder(buildTestClientWithSecretStores(), defaultSecretStoreName).
+		WithKubernetesProvider("remote", "reader", testCABundle).
+		WithRefreshInterval(time.Minute)
+	assert.NoError(t, testBuilder.GetError())
+	assert.Equal(t, "remote", testBuilder.Definition.Spec.Provider.Kubernetes.RemoteNamespace)
+	assert.Equal(t, 60, testBuilder.Definition.Spec.RefreshInterval)
+
+	testBuilder = testBuilder.WithFakeProvider(map[string]string{"key": "value"})
+	assert.NoError(t, testBuilder.GetError())
+	assert.Nil(t, testBuilder.Definition.Spec.Provider.Kubernetes)
+	assert.Equal(t, []esv1.FakeProviderData{{Key: "key", Value: "value"}}, testBuilder.Definition.Spec.Provider.Fake.Data)
+}
+
+func TestClusterSecretStoreWaitUntilReady(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		clusterSecretStore *esv1.ClusterSecretStore
+		expectedReady      bool
+		expectedError      string
+	}{
+		{
+			clusterSecretStore: buildDummyClusterSecretStore(corev1.ConditionTrue),
+			expectedReady:      true,
+		},
+		{
+			clusterSecretStore: buildDummyClusterSecretStore(corev1.ConditionFalse),
+			expectedReady:      false,
+			expectedError: "clusterSecretStore test-secret-store is not ready: " +
+				"InvalidProviderConfig: unable to validate store: context deadline exceeded",
+		},
+		{
+			clusterSecretStore: nil,
+			expectedReady:      false,
+			expectedError:      context.DeadlineExceeded.Error(),
+		},
+	}
+
+	for _, testCase := range testCases {
+		var objects []runtime.Object
+
+		if testCase.clusterSecretStore != nil {
+			objects = append(objects, testCase.clusterSecretStore)
+		}
+
+		testBuilder := NewClusterSecretStoreBuilder(buildTestClientWithSecretStores(objects...), defaultSecretStoreName)
+
+		assert.Equal(t, testCase.expectedReady, testBuilder.IsReady())
+
+		err := testBuilder.WaitUntilReady(time.Second)
+		if testCase.expectedError != "" {
+			assert.EqualError(t, err, testCase.expectedError)
+
+			continue
+		}
+
+		assert.NoError(t, err)
+	}
+}
+
+// buildDummyClusterSecretStore returns a ClusterSecretStore with the provided Ready status.
+func buildDummyClusterSecretStore(status corev1.ConditionStatus) *esv1.ClusterSecretStore {
+	return &esv1.ClusterSecretStore{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: defaultSecretStoreName,
+		},
+		Status: buildDummySecretStoreStatus(status),
+	}
+}
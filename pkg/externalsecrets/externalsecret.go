@@ -0,0 +1,305 @@
+package externalsecrets
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common"
+	esv1 "github.com/rh-ecosystem-edge/eco-goinfra/pkg/schemes/externalsecrets/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/klog/v2"
+)
+
+const (
+	// SecretStoreKind is the kind of namespaced secret stores referenced by ExternalSecrets.
+	SecretStoreKind = "SecretStore"
+	// ClusterSecretStoreKind is the kind of cluster-scoped secret stores referenced by ExternalSecrets.
+	ClusterSecretStoreKind = "ClusterSecretStore"
+)
+
+// ExternalSecretBuilder provides a struct for the ExternalSecret resource containing a connection to the cluster and
+// the ExternalSecret definition. ExternalSecrets are synced by the External Secrets Operator from a secret store into
+// a Kubernetes Secret.
+type ExternalSecretBuilder struct {
+	common.EmbeddableBuilder[esv1.ExternalSecret, *esv1.ExternalSecret]
+	common.EmbeddableCreator[esv1.ExternalSecret, ExternalSecretBuilder, *esv1.ExternalSecret, *ExternalSecretBuilder]
+	common.EmbeddableDeleter[esv1.ExternalSecret, *esv1.ExternalSecret]
+	common.EmbeddableUpdater[esv1.ExternalSecret, ExternalSecretBuilder, *esv1.ExternalSecret, *ExternalSecretBuilder]
+}
+
+// AttachMixins wires the embedded CRUD mixins to this builder instance.
+func (builder *ExternalSecretBuilder) AttachMixins() {
+	builder.EmbeddableCreator.SetBase(builder)
+	builder.EmbeddableDeleter.SetBase(builder)
+	builder.EmbeddableUpdater.SetBase(builder)
+}
+
+// GetGVK returns the ExternalSecret GVK for this builder.
+func (builder *ExternalSecretBuilder) GetGVK() schema.GroupVersionKind {
+	return esv1.GroupVersion.WithKind("ExternalSecret")
+}
+
+// NewExternalSecretBuilder creates a new instance of ExternalSecretBuilder. The secret store to sync from must be set
+// using WithSecretStoreRef before creating the ExternalSecret.
+func NewExternalSecretBuilder(apiClient *clients.Settings, name, nsname string) *ExternalSecretBuilder {
+	return common.NewNamespacedBuilder[esv1.ExternalSecret, ExternalSecretBuilder](
+		apiClient, esv1.AddToScheme, name, nsname)
+}
+
+// PullExternalSecret pulls an existing ExternalSecret from the cluster.
+func PullExternalSecret(apiClient *clients.Settings, name, nsname string) (*ExternalSecretBuilder, error) {
+	return common.PullNamespacedBuilder[esv1.ExternalSecret, ExternalSecretBuilder](
+		context.TODO(), apiClient, esv1.AddToScheme, name, nsname)
+}
+
+// WithSecretStoreRef sets the secret store the ExternalSecret is synced from. The kind must be either SecretStoreKind
+// or ClusterSecretStoreKind.
+func (builder *ExternalSecretBuilder) WithSecretStoreRef(storeName, storeKind string) *ExternalSecretBuilder {
+	if err := common.Validate(builder); err != nil {
+		return builder
+	}
+
+	klog.V(100).Infof("Setting secret store of externalSecret %s in namespace %s to %s %s",
+		builder.Definition.Name, builder.Definition.Namespace, storeKind, storeName)
+
+	if storeName == "" {
+		builder.SetError(fmt.Errorf("externalSecret 'storeName' cannot be empty"))
+
+		return builder
+	}
+
+	if storeKind != SecretStoreKind && storeKind != ClusterSecretStoreKind {
+		builder.SetError(fmt.Errorf("externalSecret 'storeKind' must be %s or %s, got %q",
+			SecretStoreKind, ClusterSecretStoreKind, storeKind))
+
+		return builder
+	}
+
+	builder.Definition.Spec.SecretStoreRef = esv1.SecretStoreRef{Name: storeName, Kind: storeKind}
+
+	return builder
+}
+
+// WithTarget sets the name of the Secret created by the ExternalSecret and how it is created. By default, the Secret
+// has the same name as the ExternalSecret and is owned by it.
+func (builder *ExternalSecretBuilder) WithTarget(
+	secretName string, creationPolicy esv1.ExternalSecretCreationPolicy) *ExternalSecretBuilder {
+	if err := common.Validate(builder); err != nil {
+		return builder
+	}
+
+	klog.V(100).Infof("Setting target of externalSecret %s in namespace %s to secret %s with creation policy %s",
+		builder.Definition.Name, builder.Definition.Namespace, secretName, creationPolicy)
+
+	if secretName == "" {
+		builder.SetError(fmt.Errorf("externalSecret target 'secretName' cannot be empty"))
+
+		return builder
+	}
+
+	switch creationPolicy {
+	case esv1.CreatePolicyOwner, esv1.CreatePolicyOrphan, esv1.CreatePolicyMerge, esv1.CreatePolicyNone:
+	default:
+		builder.SetError(fmt.Errorf("externalSecret target 'creationPolicy' %q is not supported", creationPolicy))
+
+		return builder
+	}
+
+	builder.Definition.Spec.Target.Name = secretName
+	builder.Definition.Spec.Target.CreationPolicy = creationPolicy
+
+	return builder
+}
+
+// WithRefreshInterval sets how often the ExternalSecret is synced from the secret store. An interval of zero syncs it
+// only once.
+func (builder *ExternalSecretBuilder) WithRefreshInterval(interval time.Duration) *ExternalSecretBuilder {
+	if err := common.Validate(builder); err != nil {
+		return builder
+	}
+
+	klog.V(100).Infof("Setting refresh interval of externalSecret %s in namespace %s to %s",
+		builder.Definition.Name, builder.Definition.Namespace, interval)
+
+	if interval < 0 {
+		builder.SetError(fmt.Errorf("externalSecret 'refreshInterval' cannot be negative"))
+
+		return builder
+	}
+
+	builder.Definition.Spec.RefreshInterval = &metav1.Duration{Duration: interval}
+
+	return builder
+}
+
+// WithData adds a key to the target Secret whose value is read from the provided key of the secret store. If property
+// is not empty, only that property of the remote value is used.
+func (builder *ExternalSecretBuilder) WithData(secretKey, remoteKey, property string) *ExternalSecretBuilder {
+	if err := common.Validate(builder); err != nil {
+		return builder
+	}
+
+	klog.V(100).Infof("Adding key %s from remote key %s to externalSecret %s in namespace %s",
+		secretKey, remoteKey, builder.Definition.Name, builder.Definition.Namespace)
+
+	if secretKey == "" {
+		builder.SetError(fmt.Errorf("externalSecret data 'secretKey' cannot be empty"))
+
+		return builder
+	}
+
+	if remoteKey == "" {
+		builder.SetError(fmt.Errorf("externalSecret data 'remoteKey' cannot be empty"))
+
+		return builder
+	}
+
+	builder.Definition.Spec.Data = append(builder.Definition.Spec.Data, esv1.ExternalSecretData{
+		SecretKey: secretKey,
+		RemoteRef: esv1.ExternalSecretDataRemoteRef{Key: remoteKey, Property: property},
+	})
+
+	return builder
+}
+
+// WithDataFromExtract adds every property of the provided key of the secret store as a key of the target Secret.
+func (builder *ExternalSecretBuilder) WithDataFromExtract(remoteKey string) *ExternalSecretBuilder {
+	if err := common.Validate(builder); err != nil {
+		return builder
+	}
+
+	klog.V(100).Infof("Extracting remote key %s into externalSecret %s in namespace %s",
+		remoteKey, builder.Definition.Name, builder.Definition.Namespace)
+
+	if remoteKey == "" {
+		builder.SetError(fmt.Errorf("externalSecret dataFrom 'remoteKey' cannot be empty"))
+
+		return builder
+	}
+
+	builder.Definition.Spec.DataFrom = append(builder.Definition.Spec.DataFrom, esv1.ExternalSecretDataFromRemoteRef{
+		Extract: &esv1.ExternalSecretDataRemoteRef{Key: remoteKey},
+	})
+
+	return builder
+}
+
+// GetTargetSecretName returns the name of the Secret the ExternalSecret is synced to, which defaults to the name of
+// the ExternalSecret.
+func (builder *ExternalSecretBuilder) GetTargetSecretName() string {
+	if err := common.Validate(builder); err != nil {
+		return ""
+	}
+
+	if builder.Definition.Spec.Target.Name != "" {
+		return builder.Definition.Spec.Target.Name
+	}
+
+	return builder.Definition.Name
+}
+
+// GetReadyCondition refreshes the ExternalSecret and returns its Ready condition. The condition is nil if the operator
+// has not reported it yet.
+func (builder *ExternalSecretBuilder) GetReadyCondition() (*esv1.ExternalSecretStatusCondition, error) {
+	externalSecret, err := builder.refresh()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, condition := range externalSecret.Status.Conditions {
+		if condition.Type == esv1.ExternalSecretReady {
+			return &condition, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// IsSynced returns whether the ExternalSecret currently has the Ready condition set to True, meaning its target Secret
+// was synced from the secret store. It returns false if the ExternalSecret cannot be retrieved.
+func (builder *ExternalSecretBuilder) IsSynced() bool {
+	condition, err := builder.GetReadyCondition()
+	if err != nil {
+		klog.V(100).Infof("Failed to get Ready condition of externalSecret: %v", err)
+
+		return false
+	}
+
+	return condition != nil && condition.Status == corev1.ConditionTrue
+}
+
+// WaitUntilSynced waits up to timeout for the ExternalSecret to be synced. On timeout, the error includes the reason
+// and message of the last Ready condition, which usually explains why the secret store could not be read.
+func (builder *ExternalSecretBuilder) WaitUntilSynced(timeout time.Duration) error {
+	if err := common.Validate(builder); err != nil {
+		return err
+	}
+
+	klog.V(100).Infof("Waiting up to %s for externalSecret %s in namespace %s to be synced",
+		timeout, builder.Definition.Name, builder.Definition.Namespace)
+
+	return waitForReadyCondition(builder.describe(), timeout, builder.getReadyState, isReadyStateTrue)
+}
+
+// WaitUntilRefreshed waits up to timeout for the ExternalSecret to be synced again after since. This allows waiting
+// for changes in the secret store to be propagated to the target Secret.
+func (builder *ExternalSecretBuilder) WaitUntilRefreshed(since time.Time, timeout time.Duration) error {
+	if err := common.Validate(builder); err != nil {
+		return err
+	}
+
+	klog.V(100).Infof("Waiting up to %s for externalSecret %s in namespace %s to be refreshed after %s",
+		timeout, builder.Definition.Name, builder.Definition.Namespace, since)
+
+	return waitForReadyCondition(builder.describe(), timeout, builder.getReadyState, func(state readyState) bool {
+		return isReadyStateTrue(state) &&
+			builder.Object != nil && builder.Object.Status.RefreshTime.After(since)
+	})
+}
+
+// getReadyState returns the state of the Ready condition for waitForReadyCondition.
+func (builder *ExternalSecretBuilder) getReadyState() (readyState, error) {
+	condition, err := builder.GetReadyCondition()
+	if err != nil || condition == nil {
+		return readyState{}, err
+	}
+
+	return readyState{
+		found:   true,
+		status:  condition.Status,
+		reason:  condition.Reason,
+		message: condition.Message,
+	}, nil
+}
+
+// describe returns a description of the ExternalSecret for use in error messages.
+func (builder *ExternalSecretBuilder) describe() string {
+	return fmt.Sprintf("externalSecret %s in namespace %s", builder.Definition.Name, builder.Definition.Namespace)
+}
+
+// refresh updates the Object of the builder from the cluster and returns it.
+func (builder *ExternalSecretBuilder) refresh() (*esv1.ExternalSecret, error) {
+	if err := common.Validate(builder); err != nil {
+		return nil, err
+	}
+
+	externalSecret, err := builder.Get()
+	if err != nil {
+		return nil, err
+	}
+
+	builder.Object = externalSecret
+
+	return externalSecret, nil
+}
+
+// GetExternalSecretGVR returns ExternalSecret's GroupVersionResource which could be used for Clean function.
+func GetExternalSecretGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group: "external-secrets.io", Version: "v1", Resource: "externalsecrets",
+	}
+}
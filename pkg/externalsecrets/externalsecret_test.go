@@ -0,0 +1,354 @@
+package externalsecrets
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common/testhelper"
+	esv1 "github.com/rh-ecosystem-edge/eco-goinfra/pkg/schemes/externalsecrets/v1"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	defaultExternalSecretName = "test-external-secret"
+	defaultSecretStoreName    = "test-secret-store"
+	defaultNamespace          = "test-namespace"
+)
+
+var externalSecretGVK = esv1.GroupVersion.WithKind("ExternalSecret")
+
+func TestNewExternalSecretBuilder(t *testing.T) {
+	t.Parallel()
+
+	testhelper.NewNamespacedBuilderTestConfig(
+		NewExternalSecretBuilder, esv1.AddToScheme, externalSecretGVK).ExecuteTests(t)
+}
+
+func TestPullExternalSecret(t *testing.T) {
+	t.Parallel()
+
+	testhelper.NewNamespacedPullTestConfig(
+		PullExternalSecret, esv1.AddToScheme, externalSecretGVK).ExecuteTests(t)
+}
+
+func TestExternalSecretBuilderMethods(t *testing.T) {
+	t.Parallel()
+
+	commonConfig := testhelper.NewCommonTestConfig[esv1.ExternalSecret, ExternalSecretBuilder](
+		esv1.AddToScheme, externalSecretGVK, testhelper.ResourceScopeNamespaced)
+
+	testhelper.NewTestSuite().
+		With(testhelper.NewGetTestConfig(commonConfig)).
+		With(testhelper.NewExistsTestConfig(commonConfig)).
+		With(testhelper.NewCreateTestConfig(commonConfig)).
+		With(testhelper.NewDeleterTestConfig(commonConfig)).
+		With(testhelper.NewUpdateTestConfig(commonConfig)).
+		Run(t)
+}
+
+func TestListExternalSecrets(t *testing.T) {
+	t.Parallel()
+
+	testhelper.NewNamespacedListTestConfig(
+		func(
+			apiClient *clients.Settings, nsname string, _ ...runtimeclient.ListOptions) ([]*ExternalSecretBuilder, error) {
+			return ListExternalSecrets(apiClient, nsname)
+		},
+		esv1.AddToScheme,
+		externalSecretGVK,
+	).ExecuteTests(t)
+}
+
+func TestExternalSecretWithSecretStoreRef(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		storeName     string
+		storeKind     string
+		expectedError string
+	}{
+		{
+			storeName: defaultSecretStoreName,
+			storeKind: SecretStoreKind,
+		},
+		{
+			storeName: defaultSecretStoreName,
+			storeKind: ClusterSecretStoreKind,
+		},
+		{
+			storeName:     "",
+			storeKind:     SecretStoreKind,
+			expectedError: "externalSecret 'storeName' cannot be empty",
+		},
+		{
+			storeName:     defaultSecretStoreName,
+			storeKind:     "Vault",
+			expectedError: "externalSecret 'storeKind' must be SecretStore or ClusterSecretStore, got \"Vault\"",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidExternalSecretBuilder(buildTestClientWithExternalSecrets()).
+			WithSecretStoreRef(testCase.storeName, testCase.storeKind)
+
+		if testCase.expectedError != "" {
+			assert.EqualError(t, testBuilder.GetError(), testCase.expectedError)
+
+			continue
+		}
+
+		assert.NoError(t, testBuilder.GetError())
+		assert.Equal(t, esv1.SecretStoreRef{Name: testCase.storeName, Kind: testCase.storeKind},
+			testBuilder.Definition.Spec.SecretStoreRef)
+	}
+}
+
+func TestExternalSecretWithTarget(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		secretName     string
+		creationPolicy esv1.ExternalSecretCreationPolicy
+		expectedError  string
+	}{
+		{
+			secretName:     "target",
+			creationPolicy: esv1.CreatePolicyMerge,
+		},
+		{
+			secretName:     "",
+			creationPolicy: esv1.CreatePolicyOwner,
+			expectedError:  "externalSecret target 'secretName' cannot be empty",
+		},
+		{
+			secretName:     "target",
+			creationPolicy: "Adopt",
+			expectedError:  "externalSecret target 'creationPolicy' \"Adopt\" is not supported",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidExternalSecretBuilder(buildTestClientWithExternalSecrets())
+		assert.Equal(t, defaultExternalSecretName, testBuilder.GetTargetSecretName())
+
+		testBuilder = testBuilder.WithTarget(testCase.secretName, testCase.creationPolicy)
+
+		if testCase.expectedError != "" {
+			assert.EqualError(t, testBuilder.GetError(), testCase.expectedError)
+
+			continue
+		}
+
+		assert.NoError(t, testBuilder.GetError())
+		assert.Equal(t, testCase.creationPolicy, testBuilder.Definition.Spec.Target.CreationPolicy)
+		assert.Equal(t, testCase.secretName, testBuilder.GetTargetSecretName())
+	}
+}
+
+func TestExternalSecretWithRefreshInterval(t *testing.T) {
+	t.Parallel()
+
+	testBuilder := buildValidExternalSecretBuilder(buildTestClientWithExternalSecrets()).WithRefreshInterval(time.Minute)
+	assert.NoError(t, testBuilder.GetError())
+	assert.Equal(t, time.Minute, testBuilder.Definition.Spec.RefreshInterval.Duration)
+
+	testBuilder = buildValidExternalSecretBuilder(buildTestClientWithExternalSecrets()).WithRefreshInterval(-time.Minute)
+	assert.EqualError(t, testBuilder.GetError(), "externalSecret 'refreshInterval' cannot be negative")
+}
+
+func TestExternalSecretWithData(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		secretKey     string
+		remoteKey     string
+		expectedError string
+	}{
+		{
+			secretKey: "password",
+			remoteKey: "db-credentials",
+		},
+		{
+			secretKey:     "",
+			remoteKey:     "db-credentials",
+			expectedError: "externalSecret data 'secretKey' cannot be empty",
+		},
+		{
+			secretKey:     "password",
+			remoteKey:     "",
+			expectedError: "externalSecret data 'remoteKey' cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidExternalSecretBuilder(buildTestClientWithExternalSecrets()).
+			WithData(testCase.secretKey, testCase.remoteKey, "password")
+
+		if testCase.expectedError != "" {
+			assert.EqualError(t, testBuilder.GetError(), testCase.expectedError)
+
+			continue
+		}
+
+		assert.NoError(t, testBuilder.GetError())
+		assert.Equal(t, []esv1.ExternalSecretData{{
+			SecretKey: testCase.secretKey,
+			RemoteRef: esv1.ExternalSecretDataRemoteRef{Key: testCase.remoteKey, Property: "password"},
+		}}, testBuilder.Definition.Spec.Data)
+	}
+}
+
+func TestExternalSecretWithDataFromExtract(t *testing.T) {
+	t.Parallel()
+
+	testBuilder := buildValidExternalSecretBuilder(buildTestClientWithExternalSecrets()).
+		WithDataFromExtract("db-credentials")
+	assert.NoError(t, testBuilder.GetError())
+	assert.Len(t, testBuilder.Definition.Spec.DataFrom, 1)
+	assert.Equal(t, "db-credentials", testBuilder.Definition.Spec.DataFrom[0].Extract.Key)
+
+	testBuilder = buildValidExternalSecretBuilder(buildTestClientWithExternalSecrets()).WithDataFromExtract("")
+	assert.EqualError(t, testBuilder.GetError(), "externalSecret dataFrom 'remoteKey' cannot be empty")
+}
+
+func TestExternalSecretIsSynced(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		externalSecret *esv1.ExternalSecret
+		expectedSynced bool
+	}{
+		{
+			externalSecret: buildDummyExternalSecret(corev1.ConditionTrue, time.Now()),
+			expectedSynced: true,
+		},
+		{
+			externalSecret: buildDummyExternalSecret(corev1.ConditionFalse, time.Now()),
+			expectedSynced: false,
+		},
+		{
+			externalSecret: buildDummyExternalSecret("", time.Now()),
+			expectedSynced: false,
+		},
+		{
+			externalSecret: nil,
+			expectedSynced: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidExternalSecretBuilder(buildTestClientWithExternalSecrets(testCase.externalSecret))
+
+		assert.Equal(t, testCase.expectedSynced, testBuilder.IsSynced())
+	}
+}
+
+func TestExternalSecretWaitUntilSynced(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		externalSecret *esv1.ExternalSecret
+		expectedError  string
+	}{
+		{
+			externalSecret: buildDummyExternalSecret(corev1.ConditionTrue, time.Now()),
+		},
+		{
+			externalSecret: buildDummyExternalSecret(corev1.ConditionFalse, time.Now()),
+			expectedError: "externalSecret test-external-secret in namespace test-namespace is not ready: " +
+				"SecretSyncedError: could not get secret data from provider: context deadline exceeded",
+		},
+		{
+			externalSecret: nil,
+			expectedError:  context.DeadlineExceeded.Error(),
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidExternalSecretBuilder(buildTestClientWithExternalSecrets(testCase.externalSecret))
+
+		err := testBuilder.WaitUntilSynced(time.Second)
+		if testCase.expectedError != "" {
+			assert.EqualError(t, err, testCase.expectedError)
+
+			continue
+		}
+
+		assert.NoError(t, err)
+	}
+}
+
+func TestExternalSecretWaitUntilRefreshed(t *testing.T) {
+	t.Parallel()
+
+	refreshTime := time.Now().Truncate(time.Second)
+
+	testBuilder := buildValidExternalSecretBuilder(buildTestClientWithExternalSecrets(
+		buildDummyExternalSecret(corev1.ConditionTrue, refreshTime)))
+
+	assert.NoError(t, testBuilder.WaitUntilRefreshed(refreshTime.Add(-time.Minute), time.Second))
+	assert.ErrorIs(t, testBuilder.WaitUntilRefreshed(refreshTime, time.Second), context.DeadlineExceeded)
+}
+
+// buildValidExternalSecretBuilder returns a valid ExternalSecretBuilder for testing.
+func buildValidExternalSecretBuilder(apiClient *clients.Settings) *ExternalSecretBuilder {
+	return NewExternalSecretBuilder(apiClient, defaultExternalSecretName, defaultNamespace).
+		WithSecretStoreRef(defaultSecretStoreName, SecretStoreKind)
+}
+
+// buildTestClientWithExternalSecrets returns a client with the provided ExternalSecrets. Nil ExternalSecrets are
+// skipped.
+func buildTestClientWithExternalSecrets(externalSecrets ...*esv1.ExternalSecret) *clients.Settings {
+	var objects []runtime.Object
+
+	for _, externalSecret := range externalSecrets {
+		if externalSecret != nil {
+			objects = append(objects, externalSecret)
+		}
+	}
+
+	return clients.GetTestClients(clients.TestClientParams{
+		K8sMockObjects:  objects,
+		SchemeAttachers: []clients.SchemeAttacher{esv1.AddToScheme},
+	})
+}
+
+// buildDummyExternalSecret returns an ExternalSecret with the provided Ready status, which is omitted if empty, and
+// refresh time.
+func buildDummyExternalSecret(status corev1.ConditionStatus, refreshTime time.Time) *esv1.ExternalSecret {
+	externalSecret := &esv1.ExternalSecret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      defaultExternalSecretName,
+			Namespace: defaultNamespace,
+		},
+		Status: esv1.ExternalSecretStatus{
+			RefreshTime: metav1.NewTime(refreshTime),
+		},
+	}
+
+	if status == "" {
+		return externalSecret
+	}
+
+	condition := esv1.ExternalSecretStatusCondition{
+		Type:    esv1.ExternalSecretReady,
+		Status:  status,
+		Reason:  esv1.ConditionReasonSecretSynced,
+		Message: "secret synced",
+	}
+
+	if status != corev1.ConditionTrue {
+		condition.Reason = esv1.ConditionReasonSecretSyncedError
+		condition.Message = "could not get secret data from provider"
+	}
+
+	externalSecret.Status.Conditions = []esv1.ExternalSecretStatusCondition{condition}
+
+	return externalSecret
+}
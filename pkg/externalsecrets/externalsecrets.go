@@ -0,0 +1,142 @@
+// Package externalsecrets provides builders for the resources of the External Secrets Operator: ExternalSecrets, which
+// are synced into Kubernetes Secrets, and the SecretStores and ClusterSecretStores they are synced from.
+package externalsecrets
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	esv1 "github.com/rh-ecosystem-edge/eco-goinfra/pkg/schemes/externalsecrets/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/klog/v2"
+)
+
+// defaultKubernetesServerURL is the URL of the API server used by the Kubernetes provider when reading secrets from
+// the cluster the operator runs on.
+const defaultKubernetesServerURL = "kubernetes.default"
+
+// readyState is the state of the Ready condition of a resource, independent of the resource kind.
+type readyState struct {
+	found   bool
+	status  corev1.ConditionStatus
+	reason  string
+	message string
+}
+
+// isReadyStateTrue returns whether the Ready condition was found and is True.
+func isReadyStateTrue(state readyState) bool {
+	return state.found && state.status == corev1.ConditionTrue
+}
+
+// waitForReadyCondition polls getState every second until isDone returns true or timeout elapses. On timeout, the error
+// includes the reason and message of the last Ready condition, if any.
+func waitForReadyCondition(
+	description string,
+	timeout time.Duration,
+	getState func() (readyState, error),
+	isDone func(readyState) bool) error {
+	var lastState readyState
+
+	err := wait.PollUntilContextTimeout(
+		context.TODO(), time.Second, timeout, true, func(ctx context.Context) (bool, error) {
+			state, err := getState()
+			if err != nil {
+				klog.V(100).Infof("Failed to get Ready condition of %s: %v", description, err)
+
+				return false, nil
+			}
+
+			lastState = state
+
+			return isDone(state), nil
+		})
+	if err != nil && lastState.found {
+		return fmt.Errorf("%s is not ready: %s: %s: %w", description, lastState.reason, lastState.message, err)
+	}
+
+	return err
+}
+
+// getSecretStoreReadyState returns the state of the Ready condition in the status of a SecretStore or
+// ClusterSecretStore.
+func getSecretStoreReadyState(status esv1.SecretStoreStatus) readyState {
+	for _, condition := range status.Conditions {
+		if condition.Type == esv1.SecretStoreReady {
+			return readyState{
+				found:   true,
+				status:  condition.Status,
+				reason:  condition.Reason,
+				message: condition.Message,
+			}
+		}
+	}
+
+	return readyState{}
+}
+
+// setKubernetesProvider configures spec to read secrets from remoteNamespace on the cluster the operator runs on,
+// authenticating as serviceAccountName. The provider replaces any other provider since only one may be set.
+func setKubernetesProvider(
+	spec *esv1.SecretStoreSpec, remoteNamespace, serviceAccountName string, caBundle []byte) error {
+	if remoteNamespace == "" {
+		return fmt.Errorf("kubernetes provider 'remoteNamespace' cannot be empty")
+	}
+
+	if serviceAccountName == "" {
+		return fmt.Errorf("kubernetes provider 'serviceAccountName' cannot be empty")
+	}
+
+	if len(caBundle) == 0 {
+		return fmt.Errorf("kubernetes provider 'caBundle' cannot be empty")
+	}
+
+	spec.Provider = &esv1.SecretStoreProvider{
+		Kubernetes: &esv1.KubernetesProvider{
+			Server: esv1.KubernetesServer{URL: defaultKubernetesServerURL, CABundle: caBundle},
+			Auth: &esv1.KubernetesAuth{
+				ServiceAccount: &esv1.ServiceAccountSelector{Name: serviceAccountName},
+			},
+			RemoteNamespace: remoteNamespace,
+		},
+	}
+
+	return nil
+}
+
+// setFakeProvider configures spec to serve the provided static key/value pairs, which is useful for testing the
+// operator without an external secret manager. The provider replaces any other provider since only one may be set.
+func setFakeProvider(spec *esv1.SecretStoreSpec, data map[string]string) error {
+	if len(data) == 0 {
+		return fmt.Errorf("fake provider 'data' cannot be empty")
+	}
+
+	keys := make([]string, 0, len(data))
+	for key := range data {
+		keys = append(keys, key)
+	}
+
+	slices.Sort(keys)
+
+	fakeProvider := &esv1.FakeProvider{}
+	for _, key := range keys {
+		fakeProvider.Data = append(fakeProvider.Data, esv1.FakeProviderData{Key: key, Value: data[key]})
+	}
+
+	spec.Provider = &esv1.SecretStoreProvider{Fake: fakeProvider}
+
+	return nil
+}
+
+// setRefreshInterval sets how often the operator revalidates the store in spec.
+func setRefreshInterval(spec *esv1.SecretStoreSpec, interval time.Duration) error {
+	if interval < 0 {
+		return fmt.Errorf("secretStore 'refreshInterval' cannot be negative")
+	}
+
+	spec.RefreshInterval = int(interval / time.Second)
+
+	return nil
+}
@@ -0,0 +1,4 @@
+package externalsecrets
+
+//go:generate go run ../../internal/listgen -builder ExternalSecretBuilder
+//go:generate go run ../../internal/listgen -builder SecretStoreBuilder
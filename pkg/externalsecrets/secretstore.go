@@ -0,0 +1,150 @@
+package externalsecrets
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common"
+	esv1 "github.com/rh-ecosystem-edge/eco-goinfra/pkg/schemes/externalsecrets/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/klog/v2"
+)
+
+// SecretStoreBuilder provides a struct for the SecretStore resource containing a connection to the cluster and the
+// SecretStore definition. SecretStores configure the provider ExternalSecrets in the same namespace are synced from.
+type SecretStoreBuilder struct {
+	common.EmbeddableBuilder[esv1.SecretStore, *esv1.SecretStore]
+	common.EmbeddableCreator[esv1.SecretStore, SecretStoreBuilder, *esv1.SecretStore, *SecretStoreBuilder]
+	common.EmbeddableDeleter[esv1.SecretStore, *esv1.SecretStore]
+	common.EmbeddableUpdater[esv1.SecretStore, SecretStoreBuilder, *esv1.SecretStore, *SecretStoreBuilder]
+}
+
+// AttachMixins wires the embedded CRUD mixins to this builder instance.
+func (builder *SecretStoreBuilder) AttachMixins() {
+	builder.EmbeddableCreator.SetBase(builder)
+	builder.EmbeddableDeleter.SetBase(builder)
+	builder.EmbeddableUpdater.SetBase(builder)
+}
+
+// GetGVK returns the SecretStore GVK for this builder.
+func (builder *SecretStoreBuilder) GetGVK() schema.GroupVersionKind {
+	return esv1.GroupVersion.WithKind(SecretStoreKind)
+}
+
+// NewSecretStoreBuilder creates a new instance of SecretStoreBuilder. A provider must be set using one of the
+// With*Provider methods before creating the SecretStore.
+func NewSecretStoreBuilder(apiClient *clients.Settings, name, nsname string) *SecretStoreBuilder {
+	return common.NewNamespacedBuilder[esv1.SecretStore, SecretStoreBuilder](apiClient, esv1.AddToScheme, name, nsname)
+}
+
+// PullSecretStore pulls an existing SecretStore from the cluster.
+func PullSecretStore(apiClient *clients.Settings, name, nsname string) (*SecretStoreBuilder, error) {
+	return common.PullNamespacedBuilder[esv1.SecretStore, SecretStoreBuilder](
+		context.TODO(), apiClient, esv1.AddToScheme, name, nsname)
+}
+
+// WithKubernetesProvider configures the SecretStore to read secrets from remoteNamespace on the same cluster,
+// authenticating as the provided service account in the namespace of the SecretStore. The caBundle is used to verify
+// the API server, such as the service CA of the cluster.
+func (builder *SecretStoreBuilder) WithKubernetesProvider(
+	remoteNamespace, serviceAccountName string, caBundle []byte) *SecretStoreBuilder {
+	if err := common.Validate(builder); err != nil {
+		return builder
+	}
+
+	klog.V(100).Infof("Setting kubernetes provider of secretStore %s in namespace %s to namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace, remoteNamespace)
+
+	if err := setKubernetesProvider(&builder.Definition.Spec, remoteNamespace, serviceAccountName, caBundle); err != nil {
+		builder.SetError(err)
+	}
+
+	return builder
+}
+
+// WithFakeProvider configures the SecretStore to serve the provided static key/value pairs. It allows testing
+// ExternalSecrets without an external secret manager.
+func (builder *SecretStoreBuilder) WithFakeProvider(data map[string]string) *SecretStoreBuilder {
+	if err := common.Validate(builder); err != nil {
+		return builder
+	}
+
+	klog.V(100).Infof("Setting fake provider of secretStore %s in namespace %s with %d keys",
+		builder.Definition.Name, builder.Definition.Namespace, len(data))
+
+	if err := setFakeProvider(&builder.Definition.Spec, data); err != nil {
+		builder.SetError(err)
+	}
+
+	return builder
+}
+
+// WithRefreshInterval sets how often the operator revalidates the SecretStore. It is rounded down to whole seconds
+// and zero uses the default of the operator.
+func (builder *SecretStoreBuilder) WithRefreshInterval(interval time.Duration) *SecretStoreBuilder {
+	if err := common.Validate(builder); err != nil {
+		return builder
+	}
+
+	klog.V(100).Infof("Setting refresh interval of secretStore %s in namespace %s to %s",
+		builder.Definition.Name, builder.Definition.Namespace, interval)
+
+	if err := setRefreshInterval(&builder.Definition.Spec, interval); err != nil {
+		builder.SetError(err)
+	}
+
+	return builder
+}
+
+// IsReady returns whether the SecretStore currently has the Ready condition set to True, meaning the operator validated
+// its provider. It returns false if the SecretStore cannot be retrieved.
+func (builder *SecretStoreBuilder) IsReady() bool {
+	state, err := builder.getReadyState()
+	if err != nil {
+		klog.V(100).Infof("Failed to get Ready condition of secretStore: %v", err)
+
+		return false
+	}
+
+	return isReadyStateTrue(state)
+}
+
+// WaitUntilReady waits up to timeout for the SecretStore to become Ready. On timeout, the error includes the reason
+// and message of the last Ready condition, which usually explains why the provider could not be validated.
+func (builder *SecretStoreBuilder) WaitUntilReady(timeout time.Duration) error {
+	if err := common.Validate(builder); err != nil {
+		return err
+	}
+
+	klog.V(100).Infof("Waiting up to %s for secretStore %s in namespace %s to become ready",
+		timeout, builder.Definition.Name, builder.Definition.Namespace)
+
+	return waitForReadyCondition(
+		fmt.Sprintf("secretStore %s in namespace %s", builder.Definition.Name, builder.Definition.Namespace),
+		timeout, builder.getReadyState, isReadyStateTrue)
+}
+
+// getReadyState refreshes the SecretStore and returns the state of its Ready condition.
+func (builder *SecretStoreBuilder) getReadyState() (readyState, error) {
+	if err := common.Validate(builder); err != nil {
+		return readyState{}, err
+	}
+
+	secretStore, err := builder.Get()
+	if err != nil {
+		return readyState{}, err
+	}
+
+	builder.Object = secretStore
+
+	return getSecretStoreReadyState(secretStore.Status), nil
+}
+
+// GetSecretStoreGVR returns SecretStore's GroupVersionResource which could be used for Clean function.
+func GetSecretStoreGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group: "external-secrets.io", Version: "v1", Resource: "secretstores",
+	}
+}
@@ -0,0 +1,227 @@
+package externalsecrets
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common/testhelper"
+	esv1 "github.com/rh-ecosystem-edge/eco-goinfra/pkg/schemes/externalsecrets/v1"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var (
+	secretStoreGVK = esv1.GroupVersion.WithKind(SecretStoreKind)
+	testCABundle   = []byte("-----BEGIN CERTIFICATE-----")
+)
+
+func TestNewSecretStoreBuilder(t *testing.T) {
+	t.Parallel()
+
+	testhelper.NewNamespacedBuilderTestConfig(NewSecretStoreBuilder, esv1.AddToScheme, secretStoreGVK).ExecuteTests(t)
+}
+
+func TestPullSecretStore(t *testing.T) {
+	t.Parallel()
+
+	testhelper.NewNamespacedPullTestConfig(PullSecretStore, esv1.AddToScheme, secretStoreGVK).ExecuteTests(t)
+}
+
+func TestSecretStoreBuilderMethods(t *testing.T) {
+	t.Parallel()
+
+	commonConfig := testhelper.NewCommonTestConfig[esv1.SecretStore, SecretStoreBuilder](
+		esv1.AddToScheme, secretStoreGVK, testhelper.ResourceScopeNamespaced)
+
+	testhelper.NewTestSuite().
+		With(testhelper.NewGetTestConfig(commonConfig)).
+		With(testhelper.NewExistsTestConfig(commonConfig)).
+		With(testhelper.NewCreateTestConfig(commonConfig)).
+		With(testhelper.NewDeleterTestConfig(commonConfig)).
+		With(testhelper.NewUpdateTestConfig(commonConfig)).
+		Run(t)
+}
+
+func TestListSecretStores(t *testing.T) {
+	t.Parallel()
+
+	testhelper.NewNamespacedListTestConfig(
+		func(apiClient *clients.Settings, nsname string, _ ...runtimeclient.ListOptions) ([]*SecretStoreBuilder, error) {
+			return ListSecretStores(apiClient, nsname)
+		},
+		esv1.AddToScheme,
+		secretStoreGVK,
+	).ExecuteTests(t)
+}
+
+func TestSecretStoreWithKubernetesProvider(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		remoteNamespace    string
+		serviceAccountName string
+		caBundle           []byte
+		expectedError      string
+	}{
+		{
+			remoteNamespace:    "remote",
+			serviceAccountName: "reader",
+			caBundle:           testCABundle,
+		},
+		{
+			remoteNamespace:    "",
+			serviceAccountName: "reader",
+			caBundle:           testCABundle,
+			expectedError:      "kubernetes provider 'remoteNamespace' cannot be empty",
+		},
+		{
+			remoteNamespace:    "remote",
+			serviceAccountName: "",
+			caBundle:           testCABundle,
+			expectedError:      "kubernetes provider 'serviceAccountName' cannot be empty",
+		},
+		{
+			remoteNamespace:    "remote",
+			serviceAccountName: "reader",
+			caBundle:           nil,
+			expectedError:      "kubernetes provider 'caBundle' cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := NewSecretStoreBuilder(buildTestClientWithSecretStores(), defaultSecretStoreName, defaultNamespace).
+			WithKubernetesProvider(testCase.remoteNamespace, testCase.serviceAccountName, testCase.caBundle)
+
+		if testCase.expectedError != "" {
+			assert.EqualError(t, testBuilder.GetError(), testCase.expectedError)
+
+			continue
+		}
+
+		assert.NoError(t, testBuilder.GetError())
+
+		kubernetesProvider := testBuilder.Definition.Spec.Provider.Kubernetes
+		assert.Equal(t, testCase.remoteNamespace, kubernetesProvider.RemoteNamespace)
+		assert.Equal(t, testCase.serviceAccountName, kubernetesProvider.Auth.ServiceAccount.Name)
+		assert.Equal(t, defaultKubernetesServerURL, kubernetesProvider.Server.URL)
+		assert.Equal(t, testCase.caBundle, kubernetesProvider.Server.CABundle)
+	}
+}
+
+func TestSecretStoreWithFakeProvider(t *testing.T) {
+	t.Parallel()
+
+	testBuilder := NewSecretStoreBuilder(buildTestClientWithSecretStores(), defaultSecretStoreName, defaultNamespace).
+		WithKubernetesProvider("remote", "reader", testCABundle).
+		WithFakeProvider(map[string]string{"b": "2", "a": "1"})
+	assert.NoError(t, testBuilder.GetError())
+	assert.Nil(t, testBuilder.Definition.Spec.Provider.Kubernetes)
+	assert.Equal(t, []esv1.FakeProviderData{{Key: "a", Value: "1"}, {Key: "b", Value: "2"}},
+		testBuilder.Definition.Spec.Provider.Fake.Data)
+
+	testBuilder = NewSecretStoreBuilder(buildTestClientWithSecretStores(), defaultSecretStoreName, defaultNamespace).
+		WithFakeProvider(nil)
+	assert.EqualError(t, testBuilder.GetError(), "fake provider 'data' cannot be empty")
+}
+
+func TestSecretStoreWithRefreshInterval(t *testing.T) {
+	t.Parallel()
+
+	testBuilder := NewSecretStoreBuilder(buildTestClientWithSecretStores(), defaultSecretStoreName, defaultNamespace).
+		WithRefreshInterval(90 * time.Second)
+	assert.NoError(t, testBuilder.GetError())
+	assert.Equal(t, 90, testBuilder.Definition.Spec.RefreshInterval)
+
+	testBuilder = NewSecretStoreBuilder(buildTestClientWithSecretStores(), defaultSecretStoreName, defaultNamespace).
+		WithRefreshInterval(-time.Second)
+	assert.EqualError(t, testBuilder.GetError(), "secretStore 'refreshInterval' cannot be negative")
+}
+
+func TestSecretStoreWaitUntilReady(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		secretStore   *esv1.SecretStore
+		expectedReady bool
+		expectedError string
+	}{
+		{
+			secretStore:   buildDummySecretStore(corev1.ConditionTrue),
+			expectedReady: true,
+		},
+		{
+			secretStore:   buildDummySecretStore(corev1.ConditionFalse),
+			expectedReady: false,
+			expectedError: "secretStore test-secret-store in namespace test-namespace is not ready: " +
+				"InvalidProviderConfig: unable to validate store: context deadline exceeded",
+		},
+		{
+			secretStore:   nil,
+			expectedReady: false,
+			expectedError: context.DeadlineExceeded.Error(),
+		},
+	}
+
+	for _, testCase := range testCases {
+		var objects []runtime.Object
+
+		if testCase.secretStore != nil {
+			objects = append(objects, testCase.secretStore)
+		}
+
+		testBuilder := NewSecretStoreBuilder(
+			buildTestClientWithSecretStores(objects...), defaultSecretStoreName, defaultNamespace)
+
+		assert.Equal(t, testCase.expectedReady, testBuilder.IsReady())
+
+		err := testBuilder.WaitUntilReady(time.Second)
+		if testCase.expectedError != "" {
+			assert.EqualError(t, err, testCase.expectedError)
+
+			continue
+		}
+
+		assert.NoError(t, err)
+	}
+}
+
+// buildTestClientWithSecretStores returns a client with the provided SecretStores and ClusterSecretStores.
+func buildTestClientWithSecretStores(objects ...runtime.Object) *clients.Settings {
+	return clients.GetTestClients(clients.TestClientParams{
+		K8sMockObjects:  objects,
+		SchemeAttachers: []clients.SchemeAttacher{esv1.AddToScheme},
+	})
+}
+
+// buildDummySecretStore returns a SecretStore with the provided Ready status.
+func buildDummySecretStore(status corev1.ConditionStatus) *esv1.SecretStore {
+	return &esv1.SecretStore{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      defaultSecretStoreName,
+			Namespace: defaultNamespace,
+		},
+		Status: buildDummySecretStoreStatus(status),
+	}
+}
+
+// buildDummySecretStoreStatus returns a SecretStore status with a Ready condition of the provided status.
+func buildDummySecretStoreStatus(status corev1.ConditionStatus) esv1.SecretStoreStatus {
+	condition := esv1.SecretStoreStatusCondition{
+		Type:    esv1.SecretStoreReady,
+		Status:  status,
+		Reason:  esv1.ReasonStoreValid,
+		Message: "store validated",
+	}
+
+	if status != corev1.ConditionTrue {
+		condition.Reason = esv1.ReasonInvalidProviderConfig
+		condition.Message = "unable to validate store"
+	}
+
+	return esv1.SecretStoreStatus{Conditions: []esv1.SecretStoreStatusCondition{condition}}
+}
@@ -0,0 +1,192 @@
+// Package fixtures provides one-call creation of the cluster objects most tests need during setup, such as a namespace
+// that allows privileged workloads and a service account with namespaced RBAC. Each fixture returns the builders of the
+// objects it created along with a cleanup function that removes them.
+package fixtures
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"time"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/namespace"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/resourcequotas"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/secret"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/klog/v2"
+)
+
+const (
+	// DefaultPullSecretName is the name of the image pull secret created by NewTestNamespace.
+	DefaultPullSecretName = "test-pull-secret"
+	// DefaultQuotaName is the name of the resource quota created by NewTestNamespace.
+	DefaultQuotaName = "test-quota"
+	// DefaultCleanupTimeout is the default time the cleanup function waits for the namespace to be removed.
+	DefaultCleanupTimeout = 5 * time.Minute
+)
+
+// PrivilegedNamespaceLabels are the labels applied to every test namespace. They set the pod security admission level
+// to privileged and stop OpenShift from synchronizing the labels back to the restricted defaults.
+var PrivilegedNamespaceLabels = map[string]string{
+	"pod-security.kubernetes.io/enforce":             "privileged",
+	"pod-security.kubernetes.io/audit":               "privileged",
+	"pod-security.kubernetes.io/warn":                "privileged",
+	"security.openshift.io/scc.podSecurityLabelSync": "false",
+}
+
+// CleanupFunc removes the objects created by a fixture. It is safe to call more than once.
+type CleanupFunc func() error
+
+// TestNamespace contains the builders of the objects created by NewTestNamespace. PullSecret and Quota are nil unless
+// requested using WithPullSecret and WithQuota respectively.
+type TestNamespace struct {
+	Namespace  *namespace.Builder
+	PullSecret *secret.Builder
+	Quota      *resourcequotas.Builder
+}
+
+// NamespaceOption configures the standard test namespace created by NewTestNamespace.
+type NamespaceOption func(config *namespaceConfig)
+
+type namespaceConfig struct {
+	labels           map[string]string
+	pullSecretName   string
+	dockerConfigJSON []byte
+	quota            *corev1.ResourceQuotaSpec
+	cleanupTimeout   time.Duration
+}
+
+// WithLabels adds labels to the test namespace in addition to PrivilegedNamespaceLabels. The provided labels take
+// precedence over the defaults.
+func WithLabels(labels map[string]string) NamespaceOption {
+	return func(config *namespaceConfig) {
+		maps.Copy(config.labels, labels)
+	}
+}
+
+// WithPullSecret creates an image pull secret named DefaultPullSecretName in the test namespace with the provided
+// .dockerconfigjson content.
+func WithPullSecret(dockerConfigJSON []byte) NamespaceOption {
+	return func(config *namespaceConfig) {
+		config.dockerConfigJSON = dockerConfigJSON
+	}
+}
+
+// WithPullSecretName overrides the name of the image pull secret created by WithPullSecret.
+func WithPullSecretName(name string) NamespaceOption {
+	return func(config *namespaceConfig) {
+		config.pullSecretName = name
+	}
+}
+
+// WithQuota creates a resource quota named DefaultQuotaName with the provided spec in the test namespace.
+func WithQuota(quota corev1.ResourceQuotaSpec) NamespaceOption {
+	return func(config *namespaceConfig) {
+		config.quota = &quota
+	}
+}
+
+// WithCleanupTimeout overrides the time the cleanup function waits for the namespace to be removed.
+func WithCleanupTimeout(timeout time.Duration) NamespaceOption {
+	return func(config *namespaceConfig) {
+		config.cleanupTimeout = timeout
+	}
+}
+
+// NewTestNamespace creates the standard test namespace with the provided name. The namespace is labeled with
+// PrivilegedNamespaceLabels and, depending on the options, contains an image pull secret and a resource quota. An error
+// is returned if the namespace already exists, since the cleanup function would otherwise delete a namespace the
+// fixture does not own. If any object fails to be created, the objects already created are removed before the error is
+// returned. Otherwise, the returned cleanup function deletes the namespace, along with its contents, and waits for it
+// to be removed.
+func NewTestNamespace(
+	apiClient *clients.Settings, name string, options ...NamespaceOption) (*TestNamespace, CleanupFunc, error) {
+	klog.V(100).Infof("Creating standard test namespace %s", name)
+
+	if apiClient == nil {
+		klog.V(100).Info("The apiClient of the test namespace is nil")
+
+		return nil, nil, fmt.Errorf("test namespace 'apiClient' cannot be nil")
+	}
+
+	if name == "" {
+		klog.V(100).Info("The name of the test namespace is empty")
+
+		return nil, nil, fmt.Errorf("test namespace 'name' cannot be empty")
+	}
+
+	config := &namespaceConfig{
+		labels:         maps.Clone(PrivilegedNamespaceLabels),
+		pullSecretName: DefaultPullSecretName,
+		cleanupTimeout: DefaultCleanupTimeout,
+	}
+
+	for _, option := range options {
+		if option != nil {
+			option(config)
+		}
+	}
+
+	nsBuilder := namespace.NewBuilder(apiClient, name)
+	if nsBuilder.Exists() {
+		klog.V(100).Infof("The test namespace %s already exists", name)
+
+		return nil, nil, fmt.Errorf("test namespace %s already exists", name)
+	}
+
+	nsBuilder, err := nsBuilder.WithMultipleLabels(config.labels).Create()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create test namespace %s: %w", name, err)
+	}
+
+	testNamespace := &TestNamespace{Namespace: nsBuilder}
+	cleanup := newNamespaceCleanup(nsBuilder, config.cleanupTimeout)
+
+	if len(config.dockerConfigJSON) > 0 {
+		testNamespace.PullSecret, err = secret.NewBuilder(
+			apiClient, config.pullSecretName, name, corev1.SecretTypeDockerConfigJson).
+			WithData(map[string][]byte{corev1.DockerConfigJsonKey: config.dockerConfigJSON}).
+			Create()
+		if err != nil {
+			return nil, nil, cleanupAfterFailure(
+				fmt.Errorf("failed to create pull secret in test namespace %s: %w", name, err), cleanup)
+		}
+	}
+
+	if config.quota != nil {
+		testNamespace.Quota, err = resourcequotas.NewBuilder(apiClient, DefaultQuotaName, name).
+			WithQuotaSpec(*config.quota).
+			Create()
+		if err != nil {
+			return nil, nil, cleanupAfterFailure(
+				fmt.Errorf("failed to create resource quota in test namespace %s: %w", name, err), cleanup)
+		}
+	}
+
+	return testNamespace, cleanup, nil
+}
+
+// newNamespaceCleanup returns a CleanupFunc that deletes the namespace and waits up to timeout for it to be removed.
+func newNamespaceCleanup(nsBuilder *namespace.Builder, timeout time.Duration) CleanupFunc {
+	return func() error {
+		klog.V(100).Infof("Cleaning up test namespace %s", nsBuilder.Definition.Name)
+
+		if !nsBuilder.Exists() {
+			return nil
+		}
+
+		err := nsBuilder.DeleteAndWait(timeout)
+		if err != nil {
+			return fmt.Errorf("failed to clean up test namespace %s: %w", nsBuilder.Definition.Name, err)
+		}
+
+		return nil
+	}
+}
+
+// cleanupAfterFailure runs cleanup after a fixture failed to be created and returns the original error, joined with the
+// cleanup error if there is one.
+func cleanupAfterFailure(err error, cleanup CleanupFunc) error {
+	return errors.Join(err, cleanup())
+}
@@ -0,0 +1,153 @@
+package fixtures
+
+import (
+	"testing"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/namespace"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/resourcequotas"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/secret"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	defaultTestNamespace = "test-fixtures"
+	defaultDockerConfig  = `{"auths":{"registry.example.com":{"auth":"dGVzdDp0ZXN0"}}}`
+)
+
+func TestNewTestNamespace(t *testing.T) {
+	testQuota := corev1.ResourceQuotaSpec{
+		Hard: corev1.ResourceList{corev1.ResourcePods: resource.MustParse("10")},
+	}
+
+	testCases := []struct {
+		name               string
+		options            []NamespaceOption
+		client             bool
+		exists             bool
+		expectedPullSecret bool
+		expectedQuota      bool
+		expectedError      string
+	}{
+		{
+			name:    defaultTestNamespace,
+			options: nil,
+			client:  true,
+		},
+		{
+			name:               defaultTestNamespace,
+			options:            []NamespaceOption{WithPullSecret([]byte(defaultDockerConfig)), WithQuota(testQuota)},
+			client:             true,
+			expectedPullSecret: true,
+			expectedQuota:      true,
+		},
+		{
+			name:          "",
+			client:        true,
+			expectedError: "test namespace 'name' cannot be empty",
+		},
+		{
+			name:          defaultTestNamespace,
+			client:        false,
+			expectedError: "test namespace 'apiClient' cannot be nil",
+		},
+		{
+			name:    defaultTestNamespace,
+			options: []NamespaceOption{WithPullSecret([]byte(defaultDockerConfig)), WithPullSecretName("")},
+			client:  true,
+			expectedError: "failed to create pull secret in test namespace test-fixtures: " +
+				"secret 'name' cannot be empty",
+		},
+		{
+			name:          defaultTestNamespace,
+			client:        true,
+			exists:        true,
+			expectedError: "test namespace test-fixtures already exists",
+		},
+	}
+
+	for _, testCase := range testCases {
+		var testSettings *clients.Settings
+
+		if testCase.client {
+			var runtimeObjects []runtime.Object
+
+			if testCase.exists {
+				runtimeObjects = append(runtimeObjects, &corev1.Namespace{
+					ObjectMeta: metav1.ObjectMeta{Name: testCase.name},
+				})
+			}
+
+			testSettings = clients.GetTestClients(clients.TestClientParams{
+				K8sMockObjects:  runtimeObjects,
+				SchemeAttachers: []clients.SchemeAttacher{corev1.AddToScheme},
+			})
+		}
+
+		testNamespace, cleanup, err := NewTestNamespace(testSettings, testCase.name, testCase.options...)
+
+		if testCase.expectedError != "" {
+			assert.EqualError(t, err, testCase.expectedError)
+			assert.Nil(t, testNamespace)
+			assert.Nil(t, cleanup)
+
+			if testCase.client && testCase.name != "" {
+				// Objects created before the failure must have been removed, but a namespace which already existed
+				// must be left alone.
+				assert.Equal(t, testCase.exists, namespace.NewBuilder(testSettings, testCase.name).Exists())
+			}
+
+			continue
+		}
+
+		assert.Nil(t, err)
+		assert.True(t, testNamespace.Namespace.Exists())
+
+		for key, value := range PrivilegedNamespaceLabels {
+			assert.Equal(t, value, testNamespace.Namespace.Definition.Labels[key])
+		}
+
+		assert.Equal(t, testCase.expectedPullSecret, testNamespace.PullSecret != nil)
+		assert.Equal(t, testCase.expectedQuota, testNamespace.Quota != nil)
+
+		if testCase.expectedPullSecret {
+			pullSecret, err := secret.Pull(testSettings, DefaultPullSecretName, testCase.name)
+			assert.Nil(t, err)
+			assert.Equal(t, corev1.SecretTypeDockerConfigJson, pullSecret.Object.Type)
+			assert.Equal(t, []byte(defaultDockerConfig), pullSecret.Object.Data[corev1.DockerConfigJsonKey])
+		}
+
+		if testCase.expectedQuota {
+			quota, err := resourcequotas.Pull(testSettings, DefaultQuotaName, testCase.name)
+			assert.Nil(t, err)
+			assert.Equal(t, testQuota, quota.Object.Spec)
+		}
+
+		assert.Nil(t, cleanup())
+		assert.False(t, testNamespace.Namespace.Exists())
+
+		// Calling the cleanup function again must not fail.
+		assert.Nil(t, cleanup())
+	}
+}
+
+func TestWithLabels(t *testing.T) {
+	testNamespace, cleanup, err := NewTestNamespace(
+		clients.GetTestClients(clients.TestClientParams{}), defaultTestNamespace,
+		WithLabels(map[string]string{"pod-security.kubernetes.io/warn": "baseline", "test": "label"}))
+	assert.Nil(t, err)
+
+	labels := testNamespace.Namespace.Definition.Labels
+	assert.Equal(t, "baseline", labels["pod-security.kubernetes.io/warn"])
+	assert.Equal(t, "privileged", labels["pod-security.kubernetes.io/enforce"])
+	assert.Equal(t, "label", labels["test"])
+
+	// The defaults must not be modified by the options.
+	assert.Equal(t, "privileged", PrivilegedNamespaceLabels["pod-security.kubernetes.io/warn"])
+
+	assert.Nil(t, cleanup())
+}
@@ -0,0 +1,136 @@
+package fixtures
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/rbac"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/serviceaccount"
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/klog/v2"
+)
+
+// DefaultRBACRules are the rules granted by NewTestRBAC when no rules are provided. They allow full control over the
+// namespaced workload resources tests commonly create.
+var DefaultRBACRules = []rbacv1.PolicyRule{
+	{
+		APIGroups: []string{""},
+		Resources: []string{
+			"pods", "pods/log", "pods/exec", "configmaps", "secrets", "services", "endpoints",
+			"persistentvolumeclaims", "serviceaccounts",
+		},
+		Verbs: []string{"*"},
+	},
+	{
+		APIGroups: []string{"apps"},
+		Resources: []string{"deployments", "daemonsets", "statefulsets", "replicasets"},
+		Verbs:     []string{"*"},
+	},
+	{
+		APIGroups: []string{"batch"},
+		Resources: []string{"jobs", "cronjobs"},
+		Verbs:     []string{"*"},
+	},
+}
+
+// TestRBAC contains the builders of the objects created by NewTestRBAC.
+type TestRBAC struct {
+	ServiceAccount *serviceaccount.Builder
+	Role           *rbac.RoleBuilder
+	RoleBinding    *rbac.RoleBindingBuilder
+}
+
+// NewTestRBAC creates a service account, a role, and a role binding granting the role to the service account, all with
+// the provided name in the provided namespace. If no rules are provided, DefaultRBACRules are used. If any object fails
+// to be created, the objects already created are removed before the error is returned. Otherwise, the returned cleanup
+// function deletes all three objects.
+func NewTestRBAC(
+	apiClient *clients.Settings, name, nsname string, rules ...rbacv1.PolicyRule) (*TestRBAC, CleanupFunc, error) {
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	klog.V(100).Infof("Creating standard test RBAC %s in namespace %s", name, nsname)
+
+	if apiClient == nil {
+		klog.V(100).Info("The apiClient of the test RBAC is nil")
+
+		return nil, nil, fmt.Errorf("test RBAC 'apiClient' cannot be nil")
+	}
+
+	if name == "" {
+		klog.V(100).Info("The name of the test RBAC is empty")
+
+		return nil, nil, fmt.Errorf("test RBAC 'name' cannot be empty")
+	}
+
+	if nsname == "" {
+		klog.V(100).Info("The namespace of the test RBAC is empty")
+
+		return nil, nil, fmt.Errorf("test RBAC 'nsname' cannot be empty")
+	}
+
+	if len(rules) == 0 {
+		rules = DefaultRBACRules
+	}
+
+	testRBAC := &TestRBAC{}
+	cleanup := newRBACCleanup(testRBAC)
+
+	// The builders are only stored once created so the cleanup function does not try to delete invalid objects.
+	serviceAccount, err := serviceaccount.NewBuilder(apiClient, name, nsname).Create()
+	if err != nil {
+		return nil, nil, cleanupAfterFailure(
+			fmt.Errorf("failed to create test serviceaccount %s in namespace %s: %w", name, nsname, err), cleanup)
+	}
+
+	testRBAC.ServiceAccount = serviceAccount
+
+	roleBuilder := rbac.NewRoleBuilder(apiClient, name, nsname, rules[0])
+	if len(rules) > 1 {
+		roleBuilder.WithRules(rules[1:])
+	}
+
+	role, err := roleBuilder.Create()
+	if err != nil {
+		return nil, nil, cleanupAfterFailure(
+			fmt.Errorf("failed to create test role %s in namespace %s: %w", name, nsname, err), cleanup)
+	}
+
+	testRBAC.Role = role
+
+	roleBinding, err := rbac.NewRoleBindingBuilder(apiClient, name, nsname, name, rbacv1.Subject{
+		Kind:      rbacv1.ServiceAccountKind,
+		Name:      name,
+		Namespace: nsname,
+	}).Create()
+	if err != nil {
+		return nil, nil, cleanupAfterFailure(
+			fmt.Errorf("failed to create test rolebinding %s in namespace %s: %w", name, nsname, err), cleanup)
+	}
+
+	testRBAC.RoleBinding = roleBinding
+
+	return testRBAC, cleanup, nil
+}
+
+// newRBACCleanup returns a CleanupFunc that deletes the objects of testRBAC which have been created, in the reverse
+// order of creation.
+func newRBACCleanup(testRBAC *TestRBAC) CleanupFunc {
+	return func() error {
+		var errs []error
+
+		if testRBAC.RoleBinding != nil {
+			errs = append(errs, testRBAC.RoleBinding.Delete())
+		}
+
+		if testRBAC.Role != nil {
+			errs = append(errs, testRBAC.Role.Delete())
+		}
+
+		if testRBAC.ServiceAccount != nil {
+			errs = append(errs, testRBAC.ServiceAccount.Delete())
+		}
+
+		return errors.Join(errs...)
+	}
+}
@@ -0,0 +1,126 @@
+package fixtures
+
+import (
+	"testing"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/serviceaccount"
+	"github.com/stretchr/testify/assert"
+	rbacv1 "k8s.io/api/rbac/v1"
+)
+
+const defaultTestRBACName = "test-rbac"
+
+func TestNewTestRBAC(t *testing.T) {
+	testRule := rbacv1.PolicyRule{APIGroups: []string{""}, Resources: []string{"pods"}, Verbs: []string{"get"}}
+
+	testCases := []struct {
+		name             string
+		nsname           string
+		defaultNamespace string
+		rules            []rbacv1.PolicyRule
+		client           bool
+		expectedRules    []rbacv1.PolicyRule
+		expectedError    string
+	}{
+		{
+			name:          defaultTestRBACName,
+			nsname:        defaultTestNamespace,
+			client:        true,
+			expectedRules: DefaultRBACRules,
+		},
+		{
+			name:          defaultTestRBACName,
+			nsname:        defaultTestNamespace,
+			rules:         []rbacv1.PolicyRule{testRule},
+			client:        true,
+			expectedRules: []rbacv1.PolicyRule{testRule},
+		},
+		{
+			name:          "",
+			nsname:        defaultTestNamespace,
+			client:        true,
+			expectedError: "test RBAC 'name' cannot be empty",
+		},
+		{
+			name:          defaultTestRBACName,
+			nsname:        "",
+			client:        true,
+			expectedError: "test RBAC 'nsname' cannot be empty",
+		},
+		{
+			name:             defaultTestRBACName,
+			nsname:           "",
+			defaultNamespace: defaultTestNamespace,
+			client:           true,
+			expectedRules:    DefaultRBACRules,
+		},
+		{
+			name:          defaultTestRBACName,
+			nsname:        defaultTestNamespace,
+			client:        false,
+			expectedError: "test RBAC 'apiClient' cannot be nil",
+		},
+		{
+			name:   defaultTestRBACName,
+			nsname: defaultTestNamespace,
+			rules:  []rbacv1.PolicyRule{testRule, {APIGroups: []string{""}, Resources: []string{"pods"}}},
+			client: true,
+			expectedError: "failed to create test role test-rbac in namespace test-fixtures: " +
+				"role must contain at least one Verb",
+		},
+	}
+
+	for _, testCase := range testCases {
+		var testSettings *clients.Settings
+
+		if testCase.client {
+			testSettings = clients.GetTestClients(clients.TestClientParams{})
+		}
+
+		if testCase.defaultNamespace != "" {
+			var err error
+
+			testSettings, err = testSettings.NamespacedClient(testCase.defaultNamespace)
+			assert.Nil(t, err)
+		}
+
+		testRBAC, cleanup, err := NewTestRBAC(testSettings, testCase.name, testCase.nsname, testCase.rules...)
+
+		if testCase.expectedError != "" {
+			assert.EqualError(t, err, testCase.expectedError)
+			assert.Nil(t, testRBAC)
+			assert.Nil(t, cleanup)
+
+			if testCase.client && testCase.name != "" && testCase.nsname != "" {
+				// The service account created before the failure must have been removed.
+				assert.False(t, serviceaccount.NewBuilder(testSettings, testCase.name, testCase.nsname).Exists())
+			}
+
+			continue
+		}
+
+		expectedNamespace := testCase.nsname
+		if expectedNamespace == "" {
+			expectedNamespace = testCase.defaultNamespace
+		}
+
+		assert.Nil(t, err)
+		assert.True(t, testRBAC.ServiceAccount.Exists())
+		assert.Equal(t, expectedNamespace, testRBAC.ServiceAccount.Definition.Namespace)
+		assert.True(t, testRBAC.Role.Exists())
+		assert.True(t, testRBAC.RoleBinding.Exists())
+		assert.Equal(t, testCase.expectedRules, testRBAC.Role.Object.Rules)
+		assert.Equal(t, testCase.name, testRBAC.RoleBinding.Object.RoleRef.Name)
+		assert.Equal(t, []rbacv1.Subject{{
+			Kind:      rbacv1.ServiceAccountKind,
+			Name:      testCase.name,
+			Namespace: expectedNamespace,
+		}}, testRBAC.RoleBinding.Object.Subjects)
+
+		assert.Nil(t, cleanup())
+		assert.False(t, testRBAC.ServiceAccount.Exists())
+		assert.False(t, testRBAC.Role.Exists())
+		assert.False(t, testRBAC.RoleBinding.Exists())
+	}
+}
@@ -0,0 +1,78 @@
+package gatewayapi
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/klog/v2"
+)
+
+// conditionsGetter returns the generation of a resource along with the sets of conditions that must all have a
+// condition set to True. Gateways and GatewayClasses have a single set, while routes have one set per parent.
+type conditionsGetter func() (int64, [][]metav1.Condition, error)
+
+// waitForCondition waits up to timeout for every set of conditions returned by getConditions to have the condition of
+// type condType set to True for the current generation of the resource. Conditions observed for an older generation
+// are ignored so that waiting right after an update does not return on the status of the previous spec. On timeout,
+// the error includes why the last condition was not satisfied.
+func waitForCondition(
+	resourceDescription, condType string, timeout time.Duration, getConditions conditionsGetter) error {
+	klog.V(100).Infof("Waiting up to %s for %s to be %s", timeout, resourceDescription, condType)
+
+	var lastErr error
+
+	err := wait.PollUntilContextTimeout(
+		context.TODO(), time.Second, timeout, true, func(ctx context.Context) (bool, error) {
+			generation, conditionSets, err := getConditions()
+			if err != nil {
+				klog.V(100).Infof("Failed to get %s: %v", resourceDescription, err)
+
+				lastErr = err
+
+				return false, nil
+			}
+
+			lastErr = checkConditionSets(conditionSets, condType, generation)
+
+			return lastErr == nil, nil
+		})
+	if err != nil {
+		if lastErr != nil {
+			err = fmt.Errorf("%w: %w", err, lastErr)
+		}
+
+		return fmt.Errorf("%s is not %s: %w", resourceDescription, condType, err)
+	}
+
+	return nil
+}
+
+// checkConditionSets returns an error describing the first set of conditions that does not have the condition of type
+// condType set to True for the generation, or nil if all of them do.
+func checkConditionSets(conditionSets [][]metav1.Condition, condType string, generation int64) error {
+	if len(conditionSets) == 0 {
+		return fmt.Errorf("condition %s not reported", condType)
+	}
+
+	for _, conditions := range conditionSets {
+		condition := meta.FindStatusCondition(conditions, condType)
+		if condition == nil {
+			return fmt.Errorf("condition %s not reported", condType)
+		}
+
+		if condition.ObservedGeneration < generation {
+			return fmt.Errorf("condition %s observed generation %d is older than generation %d",
+				condType, condition.ObservedGeneration, generation)
+		}
+
+		if condition.Status != metav1.ConditionTrue {
+			return fmt.Errorf("condition %s is %s: %s: %s", condType, condition.Status, condition.Reason, condition.Message)
+		}
+	}
+
+	return nil
+}
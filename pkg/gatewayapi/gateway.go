@@ -0,0 +1,281 @@
+package gatewayapi
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common"
+	gatewayv1 "github.com/rh-ecosystem-edge/eco-goinfra/pkg/schemes/gatewayapi/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
+)
+
+// GatewayBuilder provides a struct for the Gateway resource containing a connection to the cluster and the Gateway
+// definition. Gateways bind listeners to the network addresses provisioned by the controller of their GatewayClass.
+type GatewayBuilder struct {
+	common.EmbeddableBuilder[gatewayv1.Gateway, *gatewayv1.Gateway]
+	common.EmbeddableCreator[gatewayv1.Gateway, GatewayBuilder, *gatewayv1.Gateway, *GatewayBuilder]
+	common.EmbeddableDeleter[gatewayv1.Gateway, *gatewayv1.Gateway]
+	common.EmbeddableUpdater[gatewayv1.Gateway, GatewayBuilder, *gatewayv1.Gateway, *GatewayBuilder]
+	common.EmbeddableConditionGetter[metav1.Condition, gatewayv1.Gateway, *gatewayv1.Gateway]
+}
+
+// AttachMixins wires the embedded CRUD mixins to this builder instance.
+func (builder *GatewayBuilder) AttachMixins() {
+	builder.EmbeddableCreator.SetBase(builder)
+	builder.EmbeddableDeleter.SetBase(builder)
+	builder.EmbeddableUpdater.SetBase(builder)
+	builder.EmbeddableConditionGetter.SetBase(builder)
+}
+
+// GetGVK returns the Gateway GVK for this builder.
+func (builder *GatewayBuilder) GetGVK() schema.GroupVersionKind {
+	return gatewayv1.GroupVersion.WithKind(gatewayv1.GatewayKind)
+}
+
+// NewGatewayBuilder creates a new instance of GatewayBuilder for a Gateway of the GatewayClass gatewayClassName. At
+// least one listener must be added, such as with WithHTTPListener, before creating the Gateway.
+func NewGatewayBuilder(apiClient *clients.Settings, name, nsname, gatewayClassName string) *GatewayBuilder {
+	klog.V(100).Infof("Initializing new Gateway structure with the following params: name: %s, namespace: %s, "+
+		"gatewayClassName: %s", name, nsname, gatewayClassName)
+
+	builder := common.NewNamespacedBuilder[gatewayv1.Gateway, GatewayBuilder](
+		apiClient, gatewayv1.AddToScheme, name, nsname)
+	if builder.GetError() != nil {
+		return builder
+	}
+
+	if gatewayClassName == "" {
+		klog.V(100).Info("The gatewayClassName of the Gateway is empty")
+
+		builder.SetError(fmt.Errorf("gateway 'gatewayClassName' cannot be empty"))
+
+		return builder
+	}
+
+	builder.Definition.Spec.GatewayClassName = gatewayv1.ObjectName(gatewayClassName)
+
+	return builder
+}
+
+// PullGateway pulls an existing Gateway from the cluster.
+func PullGateway(apiClient *clients.Settings, name, nsname string) (*GatewayBuilder, error) {
+	klog.V(100).Infof("Pulling existing Gateway %s in namespace %s from cluster", name, nsname)
+
+	return common.PullNamespacedBuilder[gatewayv1.Gateway, GatewayBuilder](
+		context.TODO(), apiClient, gatewayv1.AddToScheme, name, nsname)
+}
+
+// WithListener adds the listener to the Gateway. Listener names must be unique within the Gateway.
+func (builder *GatewayBuilder) WithListener(listener gatewayv1.Listener) *GatewayBuilder {
+	if err := common.Validate(builder); err != nil {
+		return builder
+	}
+
+	klog.V(100).Infof("Adding listener %s on port %d to Gateway %s in namespace %s",
+		listener.Name, listener.Port, builder.Definition.Name, builder.Definition.Namespace)
+
+	if listener.Name == "" {
+		klog.V(100).Info("The name of the Gateway listener is empty")
+
+		builder.SetError(fmt.Errorf("gateway listener 'name' cannot be empty"))
+
+		return builder
+	}
+
+	if listener.Port < 1 || listener.Port > 65535 {
+		klog.V(100).Infof("The port %d of the Gateway listener is invalid", listener.Port)
+
+		builder.SetError(fmt.Errorf("gateway listener 'port' must be between 1 and 65535, got %d", listener.Port))
+
+		return builder
+	}
+
+	if builder.findListener(string(listener.Name)) != nil {
+		klog.V(100).Infof("The Gateway already has a listener named %s", listener.Name)
+
+		builder.SetError(fmt.Errorf("gateway listener %s already exists", listener.Name))
+
+		return builder
+	}
+
+	builder.Definition.Spec.Listeners = append(builder.Definition.Spec.Listeners, listener)
+
+	return builder
+}
+
+// WithHTTPListener adds a cleartext HTTP listener on port. If hostname is empty, the listener matches all hostnames.
+func (builder *GatewayBuilder) WithHTTPListener(name string, port int32, hostname string) *GatewayBuilder {
+	return builder.WithListener(newListener(name, gatewayv1.HTTPProtocolType, port, hostname))
+}
+
+// WithHTTPSListener adds an HTTPS listener on port which terminates TLS using the certificate and key in the
+// kubernetes.io/tls secret certificateSecretName from the namespace of the Gateway. If hostname is empty, the listener
+// matches all hostnames.
+func (builder *GatewayBuilder) WithHTTPSListener(
+	name string, port int32, hostname, certificateSecretName string) *GatewayBuilder {
+	if err := common.Validate(builder); err != nil {
+		return builder
+	}
+
+	if certificateSecretName == "" {
+		klog.V(100).Info("The certificate secret name of the HTTPS listener is empty")
+
+		builder.SetError(fmt.Errorf("gateway listener 'certificateSecretName' cannot be empty"))
+
+		return builder
+	}
+
+	listener := newListener(name, gatewayv1.HTTPSProtocolType, port, hostname)
+	listener.TLS = &gatewayv1.GatewayTLSConfig{
+		Mode:            ptr.To(gatewayv1.TLSModeTerminate),
+		CertificateRefs: []gatewayv1.SecretObjectReference{{Name: gatewayv1.ObjectName(certificateSecretName)}},
+	}
+
+	return builder.WithListener(listener)
+}
+
+// WithListenerAllowedNamespaces sets the namespaces routes may attach to the listener from. By default, only routes
+// in the namespace of the Gateway may attach. The selector is required when from is gatewayv1.NamespacesFromSelector
+// and ignored otherwise.
+func (builder *GatewayBuilder) WithListenerAllowedNamespaces(
+	listenerName string, from gatewayv1.FromNamespaces, selector *metav1.LabelSelector) *GatewayBuilder {
+	if err := common.Validate(builder); err != nil {
+		return builder
+	}
+
+	klog.V(100).Infof("Setting allowed namespaces of listener %s of Gateway %s in namespace %s to %s",
+		listenerName, builder.Definition.Name, builder.Definition.Namespace, from)
+
+	listener := builder.findListener(listenerName)
+	if listener == nil {
+		klog.V(100).Infof("The Gateway has no listener named %s", listenerName)
+
+		builder.SetError(fmt.Errorf("gateway listener %s does not exist", listenerName))
+
+		return builder
+	}
+
+	namespaces := &gatewayv1.RouteNamespaces{From: ptr.To(from)}
+
+	switch from {
+	case gatewayv1.NamespacesFromAll, gatewayv1.NamespacesFromSame:
+	case gatewayv1.NamespacesFromSelector:
+		if selector == nil {
+			klog.V(100).Info("The namespace selector of the Gateway listener is nil")
+
+			builder.SetError(fmt.Errorf("gateway listener 'selector' cannot be nil when allowing routes from %s", from))
+
+			return builder
+		}
+
+		namespaces.Selector = selector
+	default:
+		klog.V(100).Infof("The allowed namespaces %s of the Gateway listener are invalid", from)
+
+		builder.SetError(fmt.Errorf("gateway listener allowed namespaces %q is invalid", from))
+
+		return builder
+	}
+
+	if listener.AllowedRoutes == nil {
+		listener.AllowedRoutes = &gatewayv1.AllowedRoutes{}
+	}
+
+	listener.AllowedRoutes.Namespaces = namespaces
+
+	return builder
+}
+
+// WaitForAccepted waits up to timeout for the controller of the GatewayClass to accept the Gateway. On timeout, the
+// error includes the reason and message of the last Accepted condition.
+func (builder *GatewayBuilder) WaitForAccepted(timeout time.Duration) error {
+	return builder.waitForCondition(gatewayv1.GatewayConditionAccepted, timeout)
+}
+
+// WaitForProgrammed waits up to timeout for the Gateway to be programmed in the data plane, which means its addresses
+// are assigned and traffic may be sent to its listeners. On timeout, the error includes the reason and message of the
+// last Programmed condition.
+func (builder *GatewayBuilder) WaitForProgrammed(timeout time.Duration) error {
+	return builder.waitForCondition(gatewayv1.GatewayConditionProgrammed, timeout)
+}
+
+// GetAddresses refreshes the Gateway and returns the values of the addresses bound to it, which are IP addresses or
+// hostnames depending on the controller. The list is empty until the Gateway is programmed.
+func (builder *GatewayBuilder) GetAddresses() ([]string, error) {
+	if err := common.Validate(builder); err != nil {
+		return nil, err
+	}
+
+	gateway, err := builder.Get()
+	if err != nil {
+		return nil, err
+	}
+
+	builder.Object = gateway
+
+	var addresses []string
+
+	for _, address := range gateway.Status.Addresses {
+		addresses = append(addresses, address.Value)
+	}
+
+	return addresses, nil
+}
+
+// waitForCondition waits up to timeout for the condition of type condType of the Gateway to be True.
+func (builder *GatewayBuilder) waitForCondition(condType gatewayv1.GatewayConditionType, timeout time.Duration) error {
+	if err := common.Validate(builder); err != nil {
+		return err
+	}
+
+	return waitForCondition(
+		fmt.Sprintf("gateway %s in namespace %s", builder.Definition.Name, builder.Definition.Namespace),
+		string(condType),
+		timeout,
+		func() (int64, [][]metav1.Condition, error) {
+			gateway, err := builder.Get()
+			if err != nil {
+				return 0, nil, err
+			}
+
+			builder.Object = gateway
+
+			return gateway.Generation, [][]metav1.Condition{gateway.Status.Conditions}, nil
+		})
+}
+
+// findListener returns a pointer to the listener named name in the definition, or nil if there is none.
+func (builder *GatewayBuilder) findListener(name string) *gatewayv1.Listener {
+	for index := range builder.Definition.Spec.Listeners {
+		if builder.Definition.Spec.Listeners[index].Name == gatewayv1.SectionName(name) {
+			return &builder.Definition.Spec.Listeners[index]
+		}
+	}
+
+	return nil
+}
+
+// newListener returns a listener for protocol on port, matching hostname if it is not empty.
+func newListener(name string, protocol gatewayv1.ProtocolType, port int32, hostname string) gatewayv1.Listener {
+	listener := gatewayv1.Listener{
+		Name:     gatewayv1.SectionName(name),
+		Port:     gatewayv1.PortNumber(port),
+		Protocol: protocol,
+	}
+
+	if hostname != "" {
+		listener.Hostname = ptr.To(gatewayv1.Hostname(hostname))
+	}
+
+	return listener
+}
+
+// GetGatewayGVR returns Gateway's GroupVersionResource which could be used for Clean function.
+func GetGatewayGVR() schema.GroupVersionResource {
+	return gatewayv1.GroupVersion.WithResource(gatewayv1.GatewayName)
+}
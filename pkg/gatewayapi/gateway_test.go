@@ -0,0 +1,261 @@
+package gatewayapi
+
+import (
+	"testing"
+	"time"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common/testhelper"
+	gatewayv1 "github.com/rh-ecosystem-edge/eco-goinfra/pkg/schemes/gatewayapi/v1"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	defaultGatewayName      = "test-gateway"
+	defaultGatewayNamespace = "test-namespace"
+)
+
+var gatewayGVK = gatewayv1.GroupVersion.WithKind(gatewayv1.GatewayKind)
+
+func TestNewGatewayBuilder(t *testing.T) {
+	t.Parallel()
+
+	testhelper.NewNamespacedBuilderTestConfig(
+		func(apiClient *clients.Settings, name, nsname string) *GatewayBuilder {
+			return NewGatewayBuilder(apiClient, name, nsname, defaultGatewayClassName)
+		},
+		gatewayv1.AddToScheme,
+		gatewayGVK,
+	).ExecuteTests(t)
+
+	builder := NewGatewayBuilder(buildTestClientWithGatewayObjects(), defaultGatewayName, defaultGatewayNamespace, "")
+	assert.EqualError(t, builder.GetError(), "gateway 'gatewayClassName' cannot be empty")
+
+	builder = buildValidGatewayTestBuilder(buildTestClientWithGatewayObjects())
+	assert.Equal(t, gatewayv1.ObjectName(defaultGatewayClassName), builder.Definition.Spec.GatewayClassName)
+}
+
+func TestPullGateway(t *testing.T) {
+	t.Parallel()
+
+	testhelper.NewNamespacedPullTestConfig(PullGateway, gatewayv1.AddToScheme, gatewayGVK).ExecuteTests(t)
+}
+
+func TestListGateways(t *testing.T) {
+	t.Parallel()
+
+	testhelper.NewNamespacedListTestConfig(
+		func(apiClient *clients.Settings, nsname string, _ ...runtimeclient.ListOptions) ([]*GatewayBuilder, error) {
+			return ListGateways(apiClient, nsname)
+		},
+		gatewayv1.AddToScheme,
+		gatewayGVK,
+	).ExecuteTests(t)
+}
+
+func TestGatewayMethods(t *testing.T) {
+	t.Parallel()
+
+	commonConfig := testhelper.NewCommonTestConfig[gatewayv1.Gateway, GatewayBuilder](
+		gatewayv1.AddToScheme, gatewayGVK, testhelper.ResourceScopeNamespaced)
+
+	testhelper.NewTestSuite().
+		With(testhelper.NewGetTestConfig(commonConfig)).
+		With(testhelper.NewExistsTestConfig(commonConfig)).
+		With(testhelper.NewCreateTestConfig(commonConfig)).
+		With(testhelper.NewDeleterTestConfig(commonConfig)).
+		With(testhelper.NewUpdateTestConfig(commonConfig)).
+		Run(t)
+}
+
+func TestGatewayWithListeners(t *testing.T) {
+	t.Parallel()
+
+	builder := buildValidGatewayTestBuilder(buildTestClientWithGatewayObjects()).
+		WithHTTPListener("http", 80, "").
+		WithHTTPSListener("https", 443, "*.example.com", "test-cert")
+	assert.NoError(t, builder.GetError())
+	assert.Equal(t, []gatewayv1.Listener{
+		{Name: "http", Port: 80, Protocol: gatewayv1.HTTPProtocolType},
+		{
+			Name:     "https",
+			Hostname: ptr.To(gatewayv1.Hostname("*.example.com")),
+			Port:     443,
+			Protocol: gatewayv1.HTTPSProtocolType,
+			TLS: &gatewayv1.GatewayTLSConfig{
+				Mode:            ptr.To(gatewayv1.TLSModeTerminate),
+				CertificateRefs: []gatewayv1.SecretObjectReference{{Name: "test-cert"}},
+			},
+		},
+	}, builder.Definition.Spec.Listeners)
+
+	testCases := []struct {
+		builder       *GatewayBuilder
+		expectedError string
+	}{
+		{
+			builder:       buildValidGatewayTestBuilder(buildTestClientWithGatewayObjects()).WithHTTPListener("", 80, ""),
+			expectedError: "gateway listener 'name' cannot be empty",
+		},
+		{
+			builder:       buildValidGatewayTestBuilder(buildTestClientWithGatewayObjects()).WithHTTPListener("http", 0, ""),
+			expectedError: "gateway listener 'port' must be between 1 and 65535, got 0",
+		},
+		{
+			builder: buildValidGatewayTestBuilder(buildTestClientWithGatewayObjects()).
+				WithHTTPListener("http", 80, "").
+				WithHTTPListener("http", 8080, ""),
+			expectedError: "gateway listener http already exists",
+		},
+		{
+			builder: buildValidGatewayTestBuilder(buildTestClientWithGatewayObjects()).
+				WithHTTPSListener("https", 443, "", ""),
+			expectedError: "gateway listener 'certificateSecretName' cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		assert.EqualError(t, testCase.builder.GetError(), testCase.expectedError)
+	}
+}
+
+func TestGatewayWithListenerAllowedNamespaces(t *testing.T) {
+	t.Parallel()
+
+	selector := &metav1.LabelSelector{MatchLabels: map[string]string{"gateway-access": "true"}}
+
+	testCases := []struct {
+		listenerName  string
+		from          gatewayv1.FromNamespaces
+		selector      *metav1.LabelSelector
+		expected      *gatewayv1.RouteNamespaces
+		expectedError string
+	}{
+		{
+			listenerName: "http",
+			from:         gatewayv1.NamespacesFromAll,
+			selector:     selector,
+			expected:     &gatewayv1.RouteNamespaces{From: ptr.To(gatewayv1.NamespacesFromAll)},
+		},
+		{
+			listenerName: "http",
+			from:         gatewayv1.NamespacesFromSelector,
+			selector:     selector,
+			expected:     &gatewayv1.RouteNamespaces{From: ptr.To(gatewayv1.NamespacesFromSelector), Selector: selector},
+		},
+		{
+			listenerName:  "http",
+			from:          gatewayv1.NamespacesFromSelector,
+			expectedError: "gateway listener 'selector' cannot be nil when allowing routes from Selector",
+		},
+		{
+			listenerName:  "http",
+			from:          "Other",
+			expectedError: "gateway listener allowed namespaces \"Other\" is invalid",
+		},
+		{
+			listenerName:  "https",
+			from:          gatewayv1.NamespacesFromAll,
+			expectedError: "gateway listener https does not exist",
+		},
+	}
+
+	for _, testCase := range testCases {
+		builder := buildValidGatewayTestBuilder(buildTestClientWithGatewayObjects()).
+			WithHTTPListener("http", 80, "").
+			WithListenerAllowedNamespaces(testCase.listenerName, testCase.from, testCase.selector)
+
+		if testCase.expectedError != "" {
+			assert.EqualError(t, builder.GetError(), testCase.expectedError)
+
+			continue
+		}
+
+		assert.NoError(t, builder.GetError())
+		assert.Equal(t, testCase.expected, builder.Definition.Spec.Listeners[0].AllowedRoutes.Namespaces)
+	}
+}
+
+func TestGatewayWaitForConditions(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		generation    int64
+		conditions    []metav1.Condition
+		waitFunc      func(builder *GatewayBuilder) error
+		expectedError string
+	}{
+		{
+			conditions: []metav1.Condition{buildTestCondition("Accepted", metav1.ConditionTrue, "Accepted")},
+			waitFunc:   func(builder *GatewayBuilder) error { return builder.WaitForAccepted(time.Second) },
+		},
+		{
+			conditions: []metav1.Condition{
+				buildTestCondition("Accepted", metav1.ConditionTrue, "Accepted"),
+				buildTestCondition("Programmed", metav1.ConditionTrue, "Programmed"),
+			},
+			waitFunc: func(builder *GatewayBuilder) error { return builder.WaitForProgrammed(time.Second) },
+		},
+		{
+			conditions: []metav1.Condition{buildTestCondition("Accepted", metav1.ConditionTrue, "Accepted")},
+			waitFunc:   func(builder *GatewayBuilder) error { return builder.WaitForProgrammed(time.Second) },
+			expectedError: "gateway test-gateway in namespace test-namespace is not Programmed: " +
+				"context deadline exceeded: condition Programmed not reported",
+		},
+		{
+			generation: 2,
+			conditions: []metav1.Condition{buildTestCondition("Programmed", metav1.ConditionTrue, "Programmed")},
+			waitFunc:   func(builder *GatewayBuilder) error { return builder.WaitForProgrammed(time.Second) },
+			expectedError: "gateway test-gateway in namespace test-namespace is not Programmed: " +
+				"context deadline exceeded: condition Programmed observed generation 0 is older than generation 2",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testSettings := buildTestClientWithGatewayObjects(buildDummyGateway(testCase.generation, testCase.conditions))
+
+		err := testCase.waitFunc(buildValidGatewayTestBuilder(testSettings))
+		if testCase.expectedError != "" {
+			assert.EqualError(t, err, testCase.expectedError)
+
+			continue
+		}
+
+		assert.NoError(t, err)
+	}
+}
+
+func TestGatewayGetAddresses(t *testing.T) {
+	t.Parallel()
+
+	gateway := buildDummyGateway(0, nil)
+	gateway.Status.Addresses = []gatewayv1.GatewayStatusAddress{
+		{Type: ptr.To(gatewayv1.IPAddressType), Value: "192.0.2.10"},
+		{Type: ptr.To(gatewayv1.HostnameAddressType), Value: "gateway.example.com"},
+	}
+
+	addresses, err := buildValidGatewayTestBuilder(buildTestClientWithGatewayObjects(gateway)).GetAddresses()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"192.0.2.10", "gateway.example.com"}, addresses)
+
+	_, err = buildValidGatewayTestBuilder(buildTestClientWithGatewayObjects()).GetAddresses()
+	assert.Error(t, err)
+}
+
+func buildValidGatewayTestBuilder(apiClient *clients.Settings) *GatewayBuilder {
+	return NewGatewayBuilder(apiClient, defaultGatewayName, defaultGatewayNamespace, defaultGatewayClassName)
+}
+
+func buildDummyGateway(generation int64, conditions []metav1.Condition) *gatewayv1.Gateway {
+	return &gatewayv1.Gateway{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       defaultGatewayName,
+			Namespace:  defaultGatewayNamespace,
+			Generation: generation,
+		},
+		Status: gatewayv1.GatewayStatus{Conditions: conditions},
+	}
+}
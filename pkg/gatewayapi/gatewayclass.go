@@ -0,0 +1,168 @@
+package gatewayapi
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common"
+	gatewayv1 "github.com/rh-ecosystem-edge/eco-goinfra/pkg/schemes/gatewayapi/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
+	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// maxGatewayClassDescriptionLength is the longest description accepted by the GatewayClass CRD.
+const maxGatewayClassDescriptionLength = 64
+
+// GatewayClassBuilder provides a struct for the GatewayClass resource containing a connection to the cluster and the
+// GatewayClass definition. GatewayClasses select the controller, such as the OpenShift ingress operator or a service
+// mesh, that implements the Gateways referencing them.
+type GatewayClassBuilder struct {
+	common.EmbeddableBuilder[gatewayv1.GatewayClass, *gatewayv1.GatewayClass]
+	common.EmbeddableCreator[gatewayv1.GatewayClass, GatewayClassBuilder, *gatewayv1.GatewayClass, *GatewayClassBuilder]
+	common.EmbeddableDeleter[gatewayv1.GatewayClass, *gatewayv1.GatewayClass]
+	common.EmbeddableUpdater[gatewayv1.GatewayClass, GatewayClassBuilder, *gatewayv1.GatewayClass, *GatewayClassBuilder]
+	common.EmbeddableConditionGetter[metav1.Condition, gatewayv1.GatewayClass, *gatewayv1.GatewayClass]
+}
+
+// AttachMixins wires the embedded CRUD mixins to this builder instance.
+func (builder *GatewayClassBuilder) AttachMixins() {
+	builder.EmbeddableCreator.SetBase(builder)
+	builder.EmbeddableDeleter.SetBase(builder)
+	builder.EmbeddableUpdater.SetBase(builder)
+	builder.EmbeddableConditionGetter.SetBase(builder)
+}
+
+// GetGVK returns the GatewayClass GVK for this builder.
+func (builder *GatewayClassBuilder) GetGVK() schema.GroupVersionKind {
+	return gatewayv1.GroupVersion.WithKind(gatewayv1.GatewayClassKind)
+}
+
+// NewGatewayClassBuilder creates a new instance of GatewayClassBuilder for a GatewayClass implemented by the
+// controller named controllerName, such as openshift.io/gateway-controller/v1. The controllerName cannot be changed
+// once the GatewayClass is created.
+func NewGatewayClassBuilder(apiClient *clients.Settings, name, controllerName string) *GatewayClassBuilder {
+	klog.V(100).Infof("Initializing new GatewayClass structure with the following params: name: %s, controllerName: %s",
+		name, controllerName)
+
+	builder := common.NewClusterScopedBuilder[gatewayv1.GatewayClass, GatewayClassBuilder](
+		apiClient, gatewayv1.AddToScheme, name)
+	if builder.GetError() != nil {
+		return builder
+	}
+
+	if controllerName == "" {
+		klog.V(100).Info("The controllerName of the GatewayClass is empty")
+
+		builder.SetError(fmt.Errorf("gatewayClass 'controllerName' cannot be empty"))
+
+		return builder
+	}
+
+	builder.Definition.Spec.ControllerName = gatewayv1.GatewayController(controllerName)
+
+	return builder
+}
+
+// PullGatewayClass pulls an existing GatewayClass from the cluster.
+func PullGatewayClass(apiClient *clients.Settings, name string) (*GatewayClassBuilder, error) {
+	klog.V(100).Infof("Pulling existing GatewayClass %s from cluster", name)
+
+	return common.PullClusterScopedBuilder[gatewayv1.GatewayClass, GatewayClassBuilder](
+		context.TODO(), apiClient, gatewayv1.AddToScheme, name)
+}
+
+// ListGatewayClasses returns the GatewayClasses on the cluster.
+func ListGatewayClasses(
+	apiClient *clients.Settings, options ...runtimeclient.ListOption) ([]*GatewayClassBuilder, error) {
+	return common.List[gatewayv1.GatewayClass, gatewayv1.GatewayClassList, GatewayClassBuilder](
+		context.TODO(), apiClient, gatewayv1.AddToScheme, options...)
+}
+
+// WithDescription sets the description of the GatewayClass, which is limited to 64 characters.
+func (builder *GatewayClassBuilder) WithDescription(description string) *GatewayClassBuilder {
+	if err := common.Validate(builder); err != nil {
+		return builder
+	}
+
+	klog.V(100).Infof("Setting description of GatewayClass %s to %s", builder.Definition.Name, description)
+
+	if len(description) > maxGatewayClassDescriptionLength {
+		klog.V(100).Infof("The description of the GatewayClass is longer than %d characters",
+			maxGatewayClassDescriptionLength)
+
+		builder.SetError(fmt.Errorf("gatewayClass 'description' cannot be longer than %d characters",
+			maxGatewayClassDescriptionLength))
+
+		return builder
+	}
+
+	builder.Definition.Spec.Description = ptr.To(description)
+
+	return builder
+}
+
+// WithParametersRef sets the resource holding the controller specific configuration of the GatewayClass. The nsname
+// must be empty when the resource is cluster-scoped.
+func (builder *GatewayClassBuilder) WithParametersRef(group, kind, name, nsname string) *GatewayClassBuilder {
+	if err := common.Validate(builder); err != nil {
+		return builder
+	}
+
+	klog.V(100).Infof("Setting parametersRef of GatewayClass %s to %s %s/%s in group %s",
+		builder.Definition.Name, kind, nsname, name, group)
+
+	if kind == "" || name == "" {
+		klog.V(100).Info("The kind or name of the GatewayClass parametersRef is empty")
+
+		builder.SetError(fmt.Errorf("gatewayClass parametersRef 'kind' and 'name' cannot be empty"))
+
+		return builder
+	}
+
+	parametersRef := &gatewayv1.ParametersReference{
+		Group: gatewayv1.Group(group),
+		Kind:  gatewayv1.Kind(kind),
+		Name:  name,
+	}
+
+	if nsname != "" {
+		parametersRef.Namespace = ptr.To(gatewayv1.Namespace(nsname))
+	}
+
+	builder.Definition.Spec.ParametersRef = parametersRef
+
+	return builder
+}
+
+// WaitForAccepted waits up to timeout for the controller of the GatewayClass to accept it. On timeout, the error
+// includes the reason and message of the last Accepted condition.
+func (builder *GatewayClassBuilder) WaitForAccepted(timeout time.Duration) error {
+	if err := common.Validate(builder); err != nil {
+		return err
+	}
+
+	return waitForCondition(
+		fmt.Sprintf("gatewayClass %s", builder.Definition.Name),
+		string(gatewayv1.GatewayClassConditionStatusAccepted),
+		timeout,
+		func() (int64, [][]metav1.Condition, error) {
+			gatewayClass, err := builder.Get()
+			if err != nil {
+				return 0, nil, err
+			}
+
+			builder.Object = gatewayClass
+
+			return gatewayClass.Generation, [][]metav1.Condition{gatewayClass.Status.Conditions}, nil
+		})
+}
+
+// GetGatewayClassGVR returns GatewayClass's GroupVersionResource which could be used for Clean function.
+func GetGatewayClassGVR() schema.GroupVersionResource {
+	return gatewayv1.GroupVersion.WithResource(gatewayv1.GatewayClassName)
+}
@@ -0,0 +1,190 @@
+package gatewayapi
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common/testhelper"
+	gatewayv1 "github.com/rh-ecosystem-edge/eco-goinfra/pkg/schemes/gatewayapi/v1"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+)
+
+const (
+	defaultGatewayClassName       = "test-gatewayclass"
+	defaultGatewayControllerName  = "openshift.io/gateway-controller/v1"
+	defaultGatewayClassParamsKind = "ConfigMap"
+)
+
+var gatewayClassGVK = gatewayv1.GroupVersion.WithKind(gatewayv1.GatewayClassKind)
+
+func TestNewGatewayClassBuilder(t *testing.T) {
+	t.Parallel()
+
+	testhelper.NewClusterScopedBuilderTestConfig(
+		func(apiClient *clients.Settings, name string) *GatewayClassBuilder {
+			return NewGatewayClassBuilder(apiClient, name, defaultGatewayControllerName)
+		},
+		gatewayv1.AddToScheme,
+		gatewayClassGVK,
+	).ExecuteTests(t)
+
+	builder := NewGatewayClassBuilder(buildTestClientWithGatewayObjects(), defaultGatewayClassName, "")
+	assert.EqualError(t, builder.GetError(), "gatewayClass 'controllerName' cannot be empty")
+
+	builder = buildValidGatewayClassTestBuilder(buildTestClientWithGatewayObjects())
+	assert.Equal(t, gatewayv1.GatewayController(defaultGatewayControllerName), builder.Definition.Spec.ControllerName)
+}
+
+func TestPullGatewayClass(t *testing.T) {
+	t.Parallel()
+
+	testhelper.NewClusterScopedPullTestConfig(PullGatewayClass, gatewayv1.AddToScheme, gatewayClassGVK).ExecuteTests(t)
+}
+
+func TestListGatewayClasses(t *testing.T) {
+	t.Parallel()
+
+	testhelper.NewListTestConfig(ListGatewayClasses, gatewayv1.AddToScheme, gatewayClassGVK).ExecuteTests(t)
+}
+
+func TestGatewayClassMethods(t *testing.T) {
+	t.Parallel()
+
+	commonConfig := testhelper.NewCommonTestConfig[gatewayv1.GatewayClass, GatewayClassBuilder](
+		gatewayv1.AddToScheme, gatewayClassGVK, testhelper.ResourceScopeClusterScoped)
+
+	testhelper.NewTestSuite().
+		With(testhelper.NewGetTestConfig(commonConfig)).
+		With(testhelper.NewExistsTestConfig(commonConfig)).
+		With(testhelper.NewCreateTestConfig(commonConfig)).
+		With(testhelper.NewDeleterTestConfig(commonConfig)).
+		With(testhelper.NewUpdateTestConfig(commonConfig)).
+		Run(t)
+}
+
+func TestGatewayClassWithDescription(t *testing.T) {
+	t.Parallel()
+
+	builder := buildValidGatewayClassTestBuilder(buildTestClientWithGatewayObjects()).WithDescription("test class")
+	assert.NoError(t, builder.GetError())
+	assert.Equal(t, ptr.To("test class"), builder.Definition.Spec.Description)
+
+	builder = buildValidGatewayClassTestBuilder(buildTestClientWithGatewayObjects()).
+		WithDescription(strings.Repeat("a", 65))
+	assert.EqualError(t, builder.GetError(), "gatewayClass 'description' cannot be longer than 64 characters")
+}
+
+func TestGatewayClassWithParametersRef(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		kind          string
+		name          string
+		nsname        string
+		expected      *gatewayv1.ParametersReference
+		expectedError string
+	}{
+		{
+			kind:   defaultGatewayClassParamsKind,
+			name:   "params",
+			nsname: "test-namespace",
+			expected: &gatewayv1.ParametersReference{
+				Kind:      defaultGatewayClassParamsKind,
+				Name:      "params",
+				Namespace: ptr.To(gatewayv1.Namespace("test-namespace")),
+			},
+		},
+		{
+			kind:     defaultGatewayClassParamsKind,
+			name:     "params",
+			expected: &gatewayv1.ParametersReference{Kind: defaultGatewayClassParamsKind, Name: "params"},
+		},
+		{
+			name:          "params",
+			expectedError: "gatewayClass parametersRef 'kind' and 'name' cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		builder := buildValidGatewayClassTestBuilder(buildTestClientWithGatewayObjects()).
+			WithParametersRef("", testCase.kind, testCase.name, testCase.nsname)
+
+		if testCase.expectedError != "" {
+			assert.EqualError(t, builder.GetError(), testCase.expectedError)
+
+			continue
+		}
+
+		assert.NoError(t, builder.GetError())
+		assert.Equal(t, testCase.expected, builder.Definition.Spec.ParametersRef)
+	}
+}
+
+func TestGatewayClassWaitForAccepted(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		conditions    []metav1.Condition
+		exists        bool
+		expectedError string
+	}{
+		{
+			conditions: []metav1.Condition{buildTestCondition("Accepted", metav1.ConditionTrue, "Accepted")},
+			exists:     true,
+		},
+		{
+			conditions: []metav1.Condition{buildTestCondition("Accepted", metav1.ConditionUnknown, "Pending")},
+			exists:     true,
+			expectedError: "gatewayClass test-gatewayclass is not Accepted: context deadline exceeded: " +
+				"condition Accepted is Unknown: Pending: test message",
+		},
+		{
+			exists: false,
+			expectedError: "gatewayClass test-gatewayclass is not Accepted: context deadline exceeded: " +
+				"failed to get GatewayClass test-gatewayclass: " +
+				"gatewayclasses.gateway.networking.k8s.io \"test-gatewayclass\" not found",
+		},
+	}
+
+	for _, testCase := range testCases {
+		var objects []runtime.Object
+
+		if testCase.exists {
+			objects = append(objects, &gatewayv1.GatewayClass{
+				ObjectMeta: metav1.ObjectMeta{Name: defaultGatewayClassName},
+				Status:     gatewayv1.GatewayClassStatus{Conditions: testCase.conditions},
+			})
+		}
+
+		err := buildValidGatewayClassTestBuilder(buildTestClientWithGatewayObjects(objects...)).
+			WaitForAccepted(time.Second)
+
+		if testCase.expectedError != "" {
+			assert.EqualError(t, err, testCase.expectedError)
+
+			continue
+		}
+
+		assert.NoError(t, err)
+	}
+}
+
+func buildValidGatewayClassTestBuilder(apiClient *clients.Settings) *GatewayClassBuilder {
+	return NewGatewayClassBuilder(apiClient, defaultGatewayClassName, defaultGatewayControllerName)
+}
+
+func buildTestClientWithGatewayObjects(objects ...runtime.Object) *clients.Settings {
+	return clients.GetTestClients(clients.TestClientParams{
+		K8sMockObjects:  objects,
+		SchemeAttachers: []clients.SchemeAttacher{gatewayv1.AddToScheme},
+	})
+}
+
+func buildTestCondition(condType string, status metav1.ConditionStatus, reason string) metav1.Condition {
+	return metav1.Condition{Type: condType, Status: status, Reason: reason, Message: "test message"}
+}
@@ -0,0 +1,4 @@
+package gatewayapi
+
+//go:generate go run ../../internal/listgen -builder GatewayBuilder
+//go:generate go run ../../internal/listgen -builder HTTPRouteBuilder
@@ -0,0 +1,229 @@
+package gatewayapi
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common"
+	gatewayv1 "github.com/rh-ecosystem-edge/eco-goinfra/pkg/schemes/gatewayapi/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
+)
+
+// HTTPRouteBuilder provides a struct for the HTTPRoute resource containing a connection to the cluster and the
+// HTTPRoute definition. HTTPRoutes attach to the listeners of Gateways and route the matching HTTP requests to
+// backends, usually services.
+type HTTPRouteBuilder struct {
+	common.EmbeddableBuilder[gatewayv1.HTTPRoute, *gatewayv1.HTTPRoute]
+	common.EmbeddableCreator[gatewayv1.HTTPRoute, HTTPRouteBuilder, *gatewayv1.HTTPRoute, *HTTPRouteBuilder]
+	common.EmbeddableDeleter[gatewayv1.HTTPRoute, *gatewayv1.HTTPRoute]
+	common.EmbeddableUpdater[gatewayv1.HTTPRoute, HTTPRouteBuilder, *gatewayv1.HTTPRoute, *HTTPRouteBuilder]
+}
+
+// AttachMixins wires the embedded CRUD mixins to this builder instance.
+func (builder *HTTPRouteBuilder) AttachMixins() {
+	builder.EmbeddableCreator.SetBase(builder)
+	builder.EmbeddableDeleter.SetBase(builder)
+	builder.EmbeddableUpdater.SetBase(builder)
+}
+
+// GetGVK returns the HTTPRoute GVK for this builder.
+func (builder *HTTPRouteBuilder) GetGVK() schema.GroupVersionKind {
+	return gatewayv1.GroupVersion.WithKind(gatewayv1.HTTPRouteKind)
+}
+
+// NewHTTPRouteBuilder creates a new instance of HTTPRouteBuilder. The HTTPRoute is only served once it is attached to
+// a Gateway using WithParentGateway.
+func NewHTTPRouteBuilder(apiClient *clients.Settings, name, nsname string) *HTTPRouteBuilder {
+	klog.V(100).Infof("Initializing new HTTPRoute structure with the following params: name: %s, namespace: %s",
+		name, nsname)
+
+	return common.NewNamespacedBuilder[gatewayv1.HTTPRoute, HTTPRouteBuilder](
+		apiClient, gatewayv1.AddToScheme, name, nsname)
+}
+
+// PullHTTPRoute pulls an existing HTTPRoute from the cluster.
+func PullHTTPRoute(apiClient *clients.Settings, name, nsname string) (*HTTPRouteBuilder, error) {
+	klog.V(100).Infof("Pulling existing HTTPRoute %s in namespace %s from cluster", name, nsname)
+
+	return common.PullNamespacedBuilder[gatewayv1.HTTPRoute, HTTPRouteBuilder](
+		context.TODO(), apiClient, gatewayv1.AddToScheme, name, nsname)
+}
+
+// WithParentGateway attaches the HTTPRoute to the Gateway gatewayName in namespace gatewayNamespace, or in the
+// namespace of the HTTPRoute if gatewayNamespace is empty. If listenerName is not empty, the HTTPRoute only attaches
+// to that listener of the Gateway. Attaching across namespaces also requires the listener to allow routes from the
+// namespace of the HTTPRoute, see GatewayBuilder.WithListenerAllowedNamespaces.
+func (builder *HTTPRouteBuilder) WithParentGateway(
+	gatewayName, gatewayNamespace, listenerName string) *HTTPRouteBuilder {
+	if err := common.Validate(builder); err != nil {
+		return builder
+	}
+
+	klog.V(100).Infof("Attaching HTTPRoute %s in namespace %s to listener %q of Gateway %s in namespace %q",
+		builder.Definition.Name, builder.Definition.Namespace, listenerName, gatewayName, gatewayNamespace)
+
+	if gatewayName == "" {
+		klog.V(100).Info("The parent Gateway name of the HTTPRoute is empty")
+
+		builder.SetError(fmt.Errorf("httpRoute parent 'gatewayName' cannot be empty"))
+
+		return builder
+	}
+
+	parentRef := gatewayv1.ParentReference{Name: gatewayv1.ObjectName(gatewayName)}
+
+	if gatewayNamespace != "" {
+		parentRef.Namespace = ptr.To(gatewayv1.Namespace(gatewayNamespace))
+	}
+
+	if listenerName != "" {
+		parentRef.SectionName = ptr.To(gatewayv1.SectionName(listenerName))
+	}
+
+	builder.Definition.Spec.ParentRefs = append(builder.Definition.Spec.ParentRefs, parentRef)
+
+	return builder
+}
+
+// WithHostnames adds hostnames matched against the Host header of the requests. When no hostnames are set, the
+// HTTPRoute matches the hostnames of the listeners it attaches to.
+func (builder *HTTPRouteBuilder) WithHostnames(hostnames ...string) *HTTPRouteBuilder {
+	if err := common.Validate(builder); err != nil {
+		return builder
+	}
+
+	klog.V(100).Infof("Adding hostnames %v to HTTPRoute %s in namespace %s",
+		hostnames, builder.Definition.Name, builder.Definition.Namespace)
+
+	if len(hostnames) == 0 {
+		klog.V(100).Info("The hostnames of the HTTPRoute are empty")
+
+		builder.SetError(fmt.Errorf("httpRoute 'hostnames' cannot be empty"))
+
+		return builder
+	}
+
+	for _, hostname := range hostnames {
+		if hostname == "" {
+			klog.V(100).Info("One of the hostnames of the HTTPRoute is empty")
+
+			builder.SetError(fmt.Errorf("httpRoute hostname cannot be empty"))
+
+			return builder
+		}
+
+		builder.Definition.Spec.Hostnames = append(builder.Definition.Spec.Hostnames, gatewayv1.Hostname(hostname))
+	}
+
+	return builder
+}
+
+// WithRule adds the rule to the HTTPRoute. Rules are evaluated by the controller according to the precedence defined
+// by the Gateway API rather than in the order they are added.
+func (builder *HTTPRouteBuilder) WithRule(rule gatewayv1.HTTPRouteRule) *HTTPRouteBuilder {
+	if err := common.Validate(builder); err != nil {
+		return builder
+	}
+
+	klog.V(100).Infof("Adding rule to HTTPRoute %s in namespace %s", builder.Definition.Name, builder.Definition.Namespace)
+
+	builder.Definition.Spec.Rules = append(builder.Definition.Spec.Rules, rule)
+
+	return builder
+}
+
+// WithPathPrefixBackend adds a rule routing the requests whose path starts with pathPrefix to port of the service
+// serviceName in the namespace of the HTTPRoute.
+func (builder *HTTPRouteBuilder) WithPathPrefixBackend(
+	pathPrefix, serviceName string, port int32) *HTTPRouteBuilder {
+	if err := common.Validate(builder); err != nil {
+		return builder
+	}
+
+	klog.V(100).Infof("Routing path prefix %s of HTTPRoute %s in namespace %s to service %s on port %d",
+		pathPrefix, builder.Definition.Name, builder.Definition.Namespace, serviceName, port)
+
+	if pathPrefix == "" || serviceName == "" {
+		klog.V(100).Info("The path prefix or service name of the HTTPRoute rule is empty")
+
+		builder.SetError(fmt.Errorf("httpRoute rule 'pathPrefix' and 'serviceName' cannot be empty"))
+
+		return builder
+	}
+
+	if port < 1 || port > 65535 {
+		klog.V(100).Infof("The port %d of the HTTPRoute backend is invalid", port)
+
+		builder.SetError(fmt.Errorf("httpRoute backend 'port' must be between 1 and 65535, got %d", port))
+
+		return builder
+	}
+
+	return builder.WithRule(gatewayv1.HTTPRouteRule{
+		Matches: []gatewayv1.HTTPRouteMatch{{
+			Path: &gatewayv1.HTTPPathMatch{
+				Type:  ptr.To(gatewayv1.PathMatchPathPrefix),
+				Value: ptr.To(pathPrefix),
+			},
+		}},
+		BackendRefs: []gatewayv1.HTTPBackendRef{{
+			BackendRef: gatewayv1.BackendRef{
+				BackendObjectReference: gatewayv1.BackendObjectReference{
+					Name: gatewayv1.ObjectName(serviceName),
+					Port: ptr.To(gatewayv1.PortNumber(port)),
+				},
+			},
+		}},
+	})
+}
+
+// WaitForAccepted waits up to timeout for every parent the HTTPRoute reports status for to accept it. On timeout, the
+// error includes the reason and message of the last Accepted condition, such as NotAllowedByListeners.
+func (builder *HTTPRouteBuilder) WaitForAccepted(timeout time.Duration) error {
+	return builder.waitForCondition(gatewayv1.RouteConditionAccepted, timeout)
+}
+
+// WaitForResolvedRefs waits up to timeout for every parent the HTTPRoute reports status for to resolve its backends.
+// On timeout, the error includes the reason and message of the last ResolvedRefs condition, such as BackendNotFound.
+func (builder *HTTPRouteBuilder) WaitForResolvedRefs(timeout time.Duration) error {
+	return builder.waitForCondition(gatewayv1.RouteConditionResolvedRefs, timeout)
+}
+
+// waitForCondition waits up to timeout for the condition of type condType to be True for every parent of the
+// HTTPRoute.
+func (builder *HTTPRouteBuilder) waitForCondition(condType gatewayv1.RouteConditionType, timeout time.Duration) error {
+	if err := common.Validate(builder); err != nil {
+		return err
+	}
+
+	return waitForCondition(
+		fmt.Sprintf("httpRoute %s in namespace %s", builder.Definition.Name, builder.Definition.Namespace),
+		string(condType),
+		timeout,
+		func() (int64, [][]metav1.Condition, error) {
+			httpRoute, err := builder.Get()
+			if err != nil {
+				return 0, nil, err
+			}
+
+			builder.Object = httpRoute
+
+			var conditionSets [][]metav1.Condition
+
+			for _, parent := range httpRoute.Status.Parents {
+				conditionSets = append(conditionSets, parent.Conditions)
+			}
+
+			return httpRoute.Generation, conditionSets, nil
+		})
+}
+
+// GetHTTPRouteGVR returns HTTPRoute's GroupVersionResource which could be used for Clean function.
+func GetHTTPRouteGVR() schema.GroupVersionResource {
+	return gatewayv1.GroupVersion.WithResource(gatewayv1.HTTPRouteName)
+}
@@ -0,0 +1,219 @@
+package gatewayapi
+
+import (
+	"testing"
+	"time"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common/testhelper"
+	gatewayv1 "github.com/rh-ecosystem-edge/eco-goinfra/pkg/schemes/gatewayapi/v1"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	defaultHTTPRouteName      = "test-httproute"
+	defaultHTTPRouteNamespace = "test-namespace"
+)
+
+var httpRouteGVK = gatewayv1.GroupVersion.WithKind(gatewayv1.HTTPRouteKind)
+
+func TestNewHTTPRouteBuilder(t *testing.T) {
+	t.Parallel()
+
+	testhelper.NewNamespacedBuilderTestConfig(NewHTTPRouteBuilder, gatewayv1.AddToScheme, httpRouteGVK).ExecuteTests(t)
+}
+
+func TestPullHTTPRoute(t *testing.T) {
+	t.Parallel()
+
+	testhelper.NewNamespacedPullTestConfig(PullHTTPRoute, gatewayv1.AddToScheme, httpRouteGVK).ExecuteTests(t)
+}
+
+func TestListHTTPRoutes(t *testing.T) {
+	t.Parallel()
+
+	testhelper.NewNamespacedListTestConfig(
+		func(apiClient *clients.Settings, nsname string, _ ...runtimeclient.ListOptions) ([]*HTTPRouteBuilder, error) {
+			return ListHTTPRoutes(apiClient, nsname)
+		},
+		gatewayv1.AddToScheme,
+		httpRouteGVK,
+	).ExecuteTests(t)
+}
+
+func TestHTTPRouteMethods(t *testing.T) {
+	t.Parallel()
+
+	commonConfig := testhelper.NewCommonTestConfig[gatewayv1.HTTPRoute, HTTPRouteBuilder](
+		gatewayv1.AddToScheme, httpRouteGVK, testhelper.ResourceScopeNamespaced)
+
+	testhelper.NewTestSuite().
+		With(testhelper.NewGetTestConfig(commonConfig)).
+		With(testhelper.NewExistsTestConfig(commonConfig)).
+		With(testhelper.NewCreateTestConfig(commonConfig)).
+		With(testhelper.NewDeleterTestConfig(commonConfig)).
+		With(testhelper.NewUpdateTestConfig(commonConfig)).
+		Run(t)
+}
+
+func TestHTTPRouteWithParentGateway(t *testing.T) {
+	t.Parallel()
+
+	builder := buildValidHTTPRouteTestBuilder(buildTestClientWithGatewayObjects()).
+		WithParentGateway(defaultGatewayName, "", "").
+		WithParentGateway(defaultGatewayName, "other-namespace", "http")
+	assert.NoError(t, builder.GetError())
+	assert.Equal(t, []gatewayv1.ParentReference{
+		{Name: defaultGatewayName},
+		{
+			Name:        defaultGatewayName,
+			Namespace:   ptr.To(gatewayv1.Namespace("other-namespace")),
+			SectionName: ptr.To(gatewayv1.SectionName("http")),
+		},
+	}, builder.Definition.Spec.ParentRefs)
+
+	builder = buildValidHTTPRouteTestBuilder(buildTestClientWithGatewayObjects()).WithParentGateway("", "", "")
+	assert.EqualError(t, builder.GetError(), "httpRoute parent 'gatewayName' cannot be empty")
+}
+
+func TestHTTPRouteWithHostnames(t *testing.T) {
+	t.Parallel()
+
+	builder := buildValidHTTPRouteTestBuilder(buildTestClientWithGatewayObjects()).
+		WithHostnames("app.example.com", "*.apps.example.com")
+	assert.NoError(t, builder.GetError())
+	assert.Equal(t, []gatewayv1.Hostname{"app.example.com", "*.apps.example.com"}, builder.Definition.Spec.Hostnames)
+
+	builder = buildValidHTTPRouteTestBuilder(buildTestClientWithGatewayObjects()).WithHostnames()
+	assert.EqualError(t, builder.GetError(), "httpRoute 'hostnames' cannot be empty")
+
+	builder = buildValidHTTPRouteTestBuilder(buildTestClientWithGatewayObjects()).WithHostnames("app.example.com", "")
+	assert.EqualError(t, builder.GetError(), "httpRoute hostname cannot be empty")
+}
+
+func TestHTTPRouteWithPathPrefixBackend(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		pathPrefix    string
+		serviceName   string
+		port          int32
+		expectedError string
+	}{
+		{pathPrefix: "/", serviceName: "test-service", port: 8080},
+		{
+			serviceName:   "test-service",
+			port:          8080,
+			expectedError: "httpRoute rule 'pathPrefix' and 'serviceName' cannot be empty",
+		},
+		{pathPrefix: "/", port: 8080, expectedError: "httpRoute rule 'pathPrefix' and 'serviceName' cannot be empty"},
+		{
+			pathPrefix:    "/",
+			serviceName:   "test-service",
+			port:          70000,
+			expectedError: "httpRoute backend 'port' must be between 1 and 65535, got 70000",
+		},
+	}
+
+	for _, testCase := range testCases {
+		builder := buildValidHTTPRouteTestBuilder(buildTestClientWithGatewayObjects()).
+			WithPathPrefixBackend(testCase.pathPrefix, testCase.serviceName, testCase.port)
+
+		if testCase.expectedError != "" {
+			assert.EqualError(t, builder.GetError(), testCase.expectedError)
+
+			continue
+		}
+
+		assert.NoError(t, builder.GetError())
+		assert.Equal(t, []gatewayv1.HTTPRouteRule{{
+			Matches: []gatewayv1.HTTPRouteMatch{{
+				Path: &gatewayv1.HTTPPathMatch{
+					Type:  ptr.To(gatewayv1.PathMatchPathPrefix),
+					Value: ptr.To(testCase.pathPrefix),
+				},
+			}},
+			BackendRefs: []gatewayv1.HTTPBackendRef{{
+				BackendRef: gatewayv1.BackendRef{
+					BackendObjectReference: gatewayv1.BackendObjectReference{
+						Name: gatewayv1.ObjectName(testCase.serviceName),
+						Port: ptr.To(gatewayv1.PortNumber(testCase.port)),
+					},
+				},
+			}},
+		}}, builder.Definition.Spec.Rules)
+	}
+}
+
+func TestHTTPRouteWaitForConditions(t *testing.T) {
+	t.Parallel()
+
+	acceptedParent := gatewayv1.RouteParentStatus{
+		ParentRef:      gatewayv1.ParentReference{Name: defaultGatewayName},
+		ControllerName: defaultGatewayControllerName,
+		Conditions: []metav1.Condition{
+			buildTestCondition("Accepted", metav1.ConditionTrue, "Accepted"),
+			buildTestCondition("ResolvedRefs", metav1.ConditionFalse, "BackendNotFound"),
+		},
+	}
+	rejectedParent := gatewayv1.RouteParentStatus{
+		ParentRef:      gatewayv1.ParentReference{Name: "other-gateway"},
+		ControllerName: defaultGatewayControllerName,
+		Conditions: []metav1.Condition{
+			buildTestCondition("Accepted", metav1.ConditionFalse, "NotAllowedByListeners"),
+		},
+	}
+
+	testCases := []struct {
+		parents       []gatewayv1.RouteParentStatus
+		waitFunc      func(builder *HTTPRouteBuilder) error
+		expectedError string
+	}{
+		{
+			parents:  []gatewayv1.RouteParentStatus{acceptedParent},
+			waitFunc: func(builder *HTTPRouteBuilder) error { return builder.WaitForAccepted(time.Second) },
+		},
+		{
+			parents:  []gatewayv1.RouteParentStatus{acceptedParent, rejectedParent},
+			waitFunc: func(builder *HTTPRouteBuilder) error { return builder.WaitForAccepted(time.Second) },
+			expectedError: "httpRoute test-httproute in namespace test-namespace is not Accepted: " +
+				"context deadline exceeded: condition Accepted is False: NotAllowedByListeners: test message",
+		},
+		{
+			parents:  []gatewayv1.RouteParentStatus{acceptedParent},
+			waitFunc: func(builder *HTTPRouteBuilder) error { return builder.WaitForResolvedRefs(time.Second) },
+			expectedError: "httpRoute test-httproute in namespace test-namespace is not ResolvedRefs: " +
+				"context deadline exceeded: condition ResolvedRefs is False: BackendNotFound: test message",
+		},
+		{
+			waitFunc: func(builder *HTTPRouteBuilder) error { return builder.WaitForAccepted(time.Second) },
+			expectedError: "httpRoute test-httproute in namespace test-namespace is not Accepted: " +
+				"context deadline exceeded: condition Accepted not reported",
+		},
+	}
+
+	for _, testCase := range testCases {
+		httpRoute := &gatewayv1.HTTPRoute{
+			ObjectMeta: metav1.ObjectMeta{Name: defaultHTTPRouteName, Namespace: defaultHTTPRouteNamespace},
+			Status: gatewayv1.HTTPRouteStatus{
+				RouteStatus: gatewayv1.RouteStatus{Parents: testCase.parents},
+			},
+		}
+
+		err := testCase.waitFunc(buildValidHTTPRouteTestBuilder(buildTestClientWithGatewayObjects(httpRoute)))
+		if testCase.expectedError != "" {
+			assert.EqualError(t, err, testCase.expectedError)
+
+			continue
+		}
+
+		assert.NoError(t, err)
+	}
+}
+
+func buildValidHTTPRouteTestBuilder(apiClient *clients.Settings) *HTTPRouteBuilder {
+	return NewHTTPRouteBuilder(apiClient, defaultHTTPRouteName, defaultHTTPRouteNamespace)
+}
@@ -0,0 +1,77 @@
+package gvkregistry
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"maps"
+	"reflect"
+	"slices"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/klog/v2"
+)
+
+// registeredType records which attacher first registered a Go type for a GVK.
+type registeredType struct {
+	attacher string
+	goType   reflect.Type
+}
+
+// DetectSchemeConflicts installs each of the named attachers into its own scheme and returns an error listing every
+// GVK that two attachers map to different Go types. Adding such attachers to the same scheme would either panic or
+// silently decode objects into the wrong type, depending on the order they are attached in, which commonly happens
+// when the same upstream API is vendored twice. Attachers are processed in order of their names so the result is
+// deterministic.
+func DetectSchemeConflicts(attachers map[string]clients.SchemeAttacher) error {
+	registered := make(map[schema.GroupVersionKind]registeredType)
+
+	var errs []error
+
+	for _, name := range slices.Sorted(maps.Keys(attachers)) {
+		attacher := attachers[name]
+		if attacher == nil {
+			errs = append(errs, fmt.Errorf("scheme attacher %s is nil", name))
+
+			continue
+		}
+
+		scheme := runtime.NewScheme()
+
+		err := attacher(scheme)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to attach scheme %s: %w", name, err))
+
+			continue
+		}
+
+		knownTypes := scheme.AllKnownTypes()
+
+		for _, gvk := range slices.SortedFunc(maps.Keys(knownTypes), compareGVK) {
+			goType := knownTypes[gvk]
+
+			existing, found := registered[gvk]
+			if !found {
+				registered[gvk] = registeredType{attacher: name, goType: goType}
+
+				continue
+			}
+
+			if existing.goType != goType {
+				klog.V(100).Infof("Scheme conflict for %s between %s and %s", gvk, existing.attacher, name)
+
+				errs = append(errs, fmt.Errorf("%s is registered as %s by %s and as %s by %s",
+					gvk, existing.goType, existing.attacher, goType, name))
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// compareGVK orders GVKs by their string representation.
+func compareGVK(first, second schema.GroupVersionKind) int {
+	return cmp.Compare(first.String(), second.String())
+}
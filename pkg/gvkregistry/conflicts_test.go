@@ -0,0 +1,74 @@
+package gvkregistry
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+var testGVK = schema.GroupVersionKind{Group: "test.io", Version: "v1", Kind: "Test"}
+
+func TestDetectSchemeConflicts(t *testing.T) {
+	testCases := []struct {
+		attachers     map[string]clients.SchemeAttacher
+		expectedError string
+	}{
+		{
+			attachers: map[string]clients.SchemeAttacher{
+				"first":  buildDummyAttacher(&corev1.ConfigMap{}),
+				"second": buildDummyAttacher(&corev1.ConfigMap{}),
+			},
+			expectedError: "",
+		},
+		{
+			attachers: map[string]clients.SchemeAttacher{
+				"first":  buildDummyAttacher(&corev1.ConfigMap{}),
+				"second": buildDummyAttacher(&corev1.Secret{}),
+			},
+			expectedError: "test.io/v1, Kind=Test is registered as v1.ConfigMap by first and as v1.Secret by second",
+		},
+		{
+			attachers: map[string]clients.SchemeAttacher{
+				"nil": nil,
+			},
+			expectedError: "scheme attacher nil is nil",
+		},
+		{
+			attachers: map[string]clients.SchemeAttacher{
+				"failing": func(*runtime.Scheme) error { return errors.New("attach error") },
+			},
+			expectedError: "failed to attach scheme failing: attach error",
+		},
+	}
+
+	for _, testCase := range testCases {
+		err := DetectSchemeConflicts(testCase.attachers)
+
+		if testCase.expectedError == "" {
+			assert.Nil(t, err)
+		} else {
+			assert.EqualError(t, err, testCase.expectedError)
+		}
+	}
+}
+
+func TestNoSchemeConflicts(t *testing.T) {
+	attachers := SchemeAttachers()
+	attachers["clients.SetScheme"] = clients.SetScheme
+
+	err := DetectSchemeConflicts(attachers)
+	assert.Nil(t, err)
+}
+
+func buildDummyAttacher(obj runtime.Object) clients.SchemeAttacher {
+	return func(scheme *runtime.Scheme) error {
+		scheme.AddKnownTypeWithName(testGVK, obj)
+
+		return nil
+	}
+}
@@ -0,0 +1,177 @@
+// Package gvkregistry provides a central registry mapping the builders in this module to the GVK and GVR of the
+// resources they manage, along with the scheme attacher needed to use them. It allows tooling to look up a builder by
+// resource and lets tests verify that builders and schemes stay consistent as APIs are vendored and updated.
+package gvkregistry
+
+import (
+	"slices"
+
+	multinetpolicyv1beta1 "github.com/k8snetworkplumbingwg/multi-networkpolicy/pkg/apis/k8s.cni.cncf.io/v1beta1"
+	nadv1 "github.com/k8snetworkplumbingwg/network-attachment-definition-client/pkg/apis/k8s.cni.cncf.io/v1"
+	sriovv1 "github.com/k8snetworkplumbingwg/sriov-network-operator/api/v1"
+	cguv1alpha1 "github.com/openshift-kni/cluster-group-upgrades-operator/pkg/api/clustergroupupgrades/v1alpha1"
+	hardwaremanagementv1alpha1 "github.com/openshift-kni/oran-o2ims/api/hardwaremanagement/v1alpha1"
+	configv1 "github.com/openshift/api/config/v1"
+	imageregistryv1 "github.com/openshift/api/imageregistry/v1"
+	routev1 "github.com/openshift/api/route/v1"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	compliancev1alpha1 "github.com/rh-ecosystem-edge/eco-goinfra/pkg/schemes/compliance/v1alpha1"
+	sriovfectypes "github.com/rh-ecosystem-edge/eco-goinfra/pkg/schemes/fec/fectypes"
+	sriovvrbtypes "github.com/rh-ecosystem-edge/eco-goinfra/pkg/schemes/fec/vrbtypes"
+	farv1alpha1 "github.com/rh-ecosystem-edge/eco-goinfra/pkg/schemes/medik8s/fenceagentsremediation/v1alpha1"
+	nhcv1alpha1 "github.com/rh-ecosystem-edge/eco-goinfra/pkg/schemes/medik8s/nodehealthcheck/v1alpha1"
+	nmv1beta1 "github.com/rh-ecosystem-edge/eco-goinfra/pkg/schemes/medik8s/nodemaintenance/v1beta1"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/schemes/metallb/frrtypes"
+	mlboperator "github.com/rh-ecosystem-edge/eco-goinfra/pkg/schemes/metallb/mlboperator"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/schemes/metallb/mlbtypes"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/schemes/metallb/mlbtypesv1beta2"
+	ovnv1 "github.com/rh-ecosystem-edge/eco-goinfra/pkg/schemes/ovn/routeadvertisement/v1"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/schemes/pfstatus/pfstatustypes"
+	ptpv2alpha1 "github.com/rh-ecosystem-edge/eco-goinfra/pkg/schemes/ptp/v2alpha1"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	netv1 "k8s.io/api/networking/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// Entry describes the resource managed by a single builder.
+type Entry struct {
+	// Builder is the package qualified name of the builder type, for example deployment.Builder.
+	Builder string
+	// GVK is the GroupVersionKind of the resource managed by the builder.
+	GVK schema.GroupVersionKind
+	// GVR is the GroupVersionResource of the resource managed by the builder.
+	GVR schema.GroupVersionResource
+	// SchemeAttacher adds the resource type to a scheme. It is nil for types already added by clients.SetScheme.
+	SchemeAttacher clients.SchemeAttacher
+}
+
+var entries = []Entry{
+	newEntry("cgu.CguBuilder", cguv1alpha1.SchemeGroupVersion, "ClusterGroupUpgrade", "clustergroupupgrades",
+		cguv1alpha1.AddToScheme),
+	newEntry("cgu.PreCachingConfigBuilder", cguv1alpha1.SchemeGroupVersion, "PreCachingConfig", "precachingconfigs",
+		cguv1alpha1.AddToScheme),
+	newEntry("compliance.CheckResultBuilder", compliancev1alpha1.GroupVersion, "ComplianceCheckResult",
+		"compliancecheckresults", compliancev1alpha1.AddToScheme),
+	newEntry("compliance.ComplianceScanBuilder", compliancev1alpha1.GroupVersion, "ComplianceScan", "compliancescans",
+		compliancev1alpha1.AddToScheme),
+	newEntry("compliance.RemediationBuilder", compliancev1alpha1.GroupVersion, "ComplianceRemediation",
+		"complianceremediations", compliancev1alpha1.AddToScheme),
+	newEntry("compliance.ScanSettingBindingBuilder", compliancev1alpha1.GroupVersion, "ScanSettingBinding",
+		"scansettingbindings", compliancev1alpha1.AddToScheme),
+	newEntry("configmap.Builder", corev1.SchemeGroupVersion, "ConfigMap", "configmaps", nil),
+	newEntry("daemonset.Builder", appsv1.SchemeGroupVersion, "DaemonSet", "daemonsets", nil),
+	newEntry("deployment.Builder", appsv1.SchemeGroupVersion, "Deployment", "deployments", nil),
+	newEntry("imageregistry.ImagePrunerBuilder", imageregistryv1.GroupVersion, "ImagePruner", "imagepruners", nil),
+	newEntry("medik8s.FenceAgentsRemediationBuilder", farv1alpha1.GroupVersion, "FenceAgentsRemediation",
+		"fenceagentsremediations", farv1alpha1.AddToScheme),
+	newEntry("medik8s.NodeHealthCheckBuilder", nhcv1alpha1.GroupVersion, "NodeHealthCheck", "nodehealthchecks",
+		nhcv1alpha1.AddToScheme),
+	newEntry("medik8s.NodeMaintenanceBuilder", nmv1beta1.GroupVersion, "NodeMaintenance", "nodemaintenances",
+		nmv1beta1.AddToScheme),
+	newEntry("metallb.BFDBuilder", mlbtypes.GroupVersion, "BFDProfile", "bfdprofiles", mlbtypes.AddToScheme),
+	newEntry("metallb.BGPAdvertisementBuilder", mlbtypes.GroupVersion, "BGPAdvertisement", "bgpadvertisements",
+		mlbtypes.AddToScheme),
+	newEntry("metallb.BGPPeerBuilder", mlbtypesv1beta2.GroupVersion, "BGPPeer", "bgppeers",
+		mlbtypesv1beta2.AddToScheme),
+	newEntry("metallb.Builder", mlboperator.GroupVersion, "MetalLB", "metallbs", mlboperator.AddToScheme),
+	newEntry("metallb.FrrConfigurationBuilder", frrtypes.GroupVersion, "FRRConfiguration", "frrconfigurations",
+		frrtypes.AddToScheme),
+	newEntry("metallb.IPAddressPoolBuilder", mlbtypes.GroupVersion, "IPAddressPool", "ipaddresspools",
+		mlbtypes.AddToScheme),
+	newEntry("metallb.L2AdvertisementBuilder", mlbtypes.GroupVersion, "L2Advertisement", "l2advertisements",
+		mlbtypes.AddToScheme),
+	newEntry("nad.Builder", nadv1.SchemeGroupVersion, "NetworkAttachmentDefinition", "network-attachment-definitions",
+		nadv1.AddToScheme),
+	newEntry("namespace.Builder", corev1.SchemeGroupVersion, "Namespace", "namespaces", nil),
+	newEntry("networkpolicy.MultiNetworkPolicyBuilder", multinetpolicyv1beta1.SchemeGroupVersion,
+		"MultiNetworkPolicy", "multi-networkpolicies", multinetpolicyv1beta1.AddToScheme),
+	newEntry("networkpolicy.NetworkPolicyBuilder", netv1.SchemeGroupVersion, "NetworkPolicy", "networkpolicies", nil),
+	newEntry("nodesconfig.Builder", configv1.GroupVersion, "Node", "nodes", nil),
+	newEntry("oran.HardwareProfileBuilder", hardwaremanagementv1alpha1.GroupVersion, "HardwareProfile",
+		"hardwareprofiles", hardwaremanagementv1alpha1.AddToScheme),
+	newEntry("ovn.RouteAdvertisementBuilder", ovnv1.SchemeGroupVersion, "RouteAdvertisements", "routeadvertisements",
+		ovnv1.AddToScheme),
+	newEntry("pfstatus.PfStatusConfigurationBuilder", pfstatustypes.GroupVersion, "PFLACPMonitor", "pflacpmonitors",
+		pfstatustypes.AddToScheme),
+	newEntry("pod.Builder", corev1.SchemeGroupVersion, "Pod", "pods", nil),
+	newEntry("poddisruptionbudget.Builder", policyv1.SchemeGroupVersion, "PodDisruptionBudget",
+		"poddisruptionbudgets", nil),
+	newEntry("ptp.HardwareConfigBuilder", ptpv2alpha1.GroupVersion, "HardwareConfig", "hardwareconfigs",
+		ptpv2alpha1.AddToScheme),
+	newEntry("replicaset.Builder", appsv1.SchemeGroupVersion, "ReplicaSet", "replicasets", nil),
+	newEntry("resourcequotas.Builder", corev1.SchemeGroupVersion, "ResourceQuota", "resourcequotas", nil),
+	newEntry("route.Builder", routev1.GroupVersion, "Route", "routes", nil),
+	newEntry("service.Builder", corev1.SchemeGroupVersion, "Service", "services", nil),
+	newEntry("serviceaccount.Builder", corev1.SchemeGroupVersion, "ServiceAccount", "serviceaccounts", nil),
+	newEntry("sriov.NetworkBuilder", sriovv1.GroupVersion, "SriovNetwork", "sriovnetworks", sriovv1.AddToScheme),
+	newEntry("sriovfec.ClusterConfigBuilder", sriovfectypes.GroupVersion, "SriovFecClusterConfig",
+		"sriovfecclusterconfigs", sriovfectypes.AddToScheme),
+	newEntry("sriovfec.NodeConfigBuilder", sriovfectypes.GroupVersion, "SriovFecNodeConfig", "sriovfecnodeconfigs",
+		sriovfectypes.AddToScheme),
+	newEntry("sriovvrb.ClusterConfigBuilder", sriovvrbtypes.GroupVersion, "SriovVrbClusterConfig",
+		"sriovvrbclusterconfigs", sriovvrbtypes.AddToScheme),
+	newEntry("sriovvrb.NodeConfigBuilder", sriovvrbtypes.GroupVersion, "SriovVrbNodeConfig", "sriovvrbnodeconfigs",
+		sriovvrbtypes.AddToScheme),
+	newEntry("statefulset.Builder", appsv1.SchemeGroupVersion, "StatefulSet", "statefulsets", nil),
+	newEntry("storage.PVCBuilder", corev1.SchemeGroupVersion, "PersistentVolumeClaim", "persistentvolumeclaims", nil),
+}
+
+// Entries returns a copy of all registered entries, sorted by builder name.
+func Entries() []Entry {
+	return slices.Clone(entries)
+}
+
+// LookupBuilder returns the entry for the builder with the provided package qualified name, such as pod.Builder.
+func LookupBuilder(builder string) (Entry, bool) {
+	return lookup(func(entry Entry) bool { return entry.Builder == builder })
+}
+
+// LookupGVK returns the entry for the builder managing resources with the provided GVK.
+func LookupGVK(gvk schema.GroupVersionKind) (Entry, bool) {
+	return lookup(func(entry Entry) bool { return entry.GVK == gvk })
+}
+
+// LookupGVR returns the entry for the builder managing resources with the provided GVR.
+func LookupGVR(gvr schema.GroupVersionResource) (Entry, bool) {
+	return lookup(func(entry Entry) bool { return entry.GVR == gvr })
+}
+
+// SchemeAttachers returns the scheme attachers of all registered entries keyed by builder name. Entries whose types
+// are added by clients.SetScheme are omitted.
+func SchemeAttachers() map[string]clients.SchemeAttacher {
+	attachers := make(map[string]clients.SchemeAttacher)
+
+	for _, entry := range entries {
+		if entry.SchemeAttacher != nil {
+			attachers[entry.Builder] = entry.SchemeAttacher
+		}
+	}
+
+	return attachers
+}
+
+// lookup returns the first entry matching the provided function.
+func lookup(matches func(Entry) bool) (Entry, bool) {
+	index := slices.IndexFunc(entries, matches)
+	if index < 0 {
+		return Entry{}, false
+	}
+
+	return entries[index], true
+}
+
+// newEntry creates an Entry for a resource of the provided kind and plural resource name in groupVersion.
+func newEntry(
+	builder string,
+	groupVersion schema.GroupVersion,
+	kind, resource string,
+	attacher clients.SchemeAttacher) Entry {
+	return Entry{
+		Builder:        builder,
+		GVK:            groupVersion.WithKind(kind),
+		GVR:            groupVersion.WithResource(resource),
+		SchemeAttacher: attacher,
+	}
+}
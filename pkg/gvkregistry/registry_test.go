@@ -0,0 +1,176 @@
+package gvkregistry
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/cgu"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/compliance"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/configmap"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/daemonset"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/deployment"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/imageregistry"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/medik8s"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/metallb"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/nad"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/namespace"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/networkpolicy"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/nodesconfig"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/oran"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/ovn"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/pfstatus"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/pod"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/poddisruptionbudget"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/ptp"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/replicaset"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/resourcequotas"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/route"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/service"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/serviceaccount"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/sriov"
+	sriovfec "github.com/rh-ecosystem-edge/eco-goinfra/pkg/sriov-fec"
+	sriovvrb "github.com/rh-ecosystem-edge/eco-goinfra/pkg/sriov-vrb"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/statefulset"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/storage"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func TestEntriesUnique(t *testing.T) {
+	builders := map[string]struct{}{}
+	gvks := map[schema.GroupVersionKind]struct{}{}
+	gvrs := map[schema.GroupVersionResource]struct{}{}
+
+	for _, entry := range Entries() {
+		assert.NotContains(t, builders, entry.Builder)
+		assert.NotContains(t, gvks, entry.GVK, entry.Builder)
+		assert.NotContains(t, gvrs, entry.GVR, entry.Builder)
+
+		builders[entry.Builder] = struct{}{}
+		gvks[entry.GVK] = struct{}{}
+		gvrs[entry.GVR] = struct{}{}
+	}
+
+	assert.True(t, slices.IsSortedFunc(Entries(), func(first, second Entry) int {
+		return strings.Compare(first.Builder, second.Builder)
+	}))
+}
+
+func TestEntriesRecognizedByScheme(t *testing.T) {
+	for _, entry := range Entries() {
+		scheme := runtime.NewScheme()
+
+		err := clients.SetScheme(scheme)
+		assert.Nil(t, err)
+
+		if entry.SchemeAttacher != nil {
+			err = entry.SchemeAttacher(scheme)
+			assert.Nil(t, err, entry.Builder)
+		}
+
+		assert.True(t, scheme.Recognizes(entry.GVK), entry.Builder)
+	}
+}
+
+func TestEntriesMatchBuilders(t *testing.T) {
+	expectedGVRs := map[string]schema.GroupVersionResource{
+		"configmap.Builder":                       configmap.GetGVR(),
+		"daemonset.Builder":                       daemonset.GetGVR(),
+		"deployment.Builder":                      deployment.GetGVR(),
+		"metallb.BFDBuilder":                      metallb.GetBFDProfileGVR(),
+		"metallb.BGPAdvertisementBuilder":         metallb.GetBGPAdvertisementGVR(),
+		"metallb.Builder":                         metallb.GetMetalLbIoGVR(),
+		"metallb.FrrConfigurationBuilder":         metallb.GetFrrConfigurationGVR(),
+		"metallb.IPAddressPoolBuilder":            metallb.GetIPAddressPoolGVR(),
+		"nad.Builder":                             nad.GetGVR(),
+		"networkpolicy.MultiNetworkPolicyBuilder": networkpolicy.GetMultiNetworkGVR(),
+		"networkpolicy.NetworkPolicyBuilder":      networkpolicy.GetGVR(),
+		"ovn.RouteAdvertisementBuilder":           ovn.GetRouteAdvertisementGVR(),
+		"pfstatus.PfStatusConfigurationBuilder":   pfstatus.GetPfStatusConfigurationGVR(),
+		"pod.Builder":                             pod.GetGVR(),
+		"poddisruptionbudget.Builder":             poddisruptionbudget.GetGVR(),
+		"replicaset.Builder":                      replicaset.GetGVR(),
+		"resourcequotas.Builder":                  resourcequotas.GetGVR(),
+		"service.Builder":                         service.GetGVR(),
+		"serviceaccount.Builder":                  serviceaccount.GetGVR(),
+		"sriov.NetworkBuilder":                    sriov.GetSriovNetworksGVR(),
+		"sriovfec.ClusterConfigBuilder":           sriovfec.GetSriovFecClusterConfigIoGVR(),
+		"sriovfec.NodeConfigBuilder":              sriovfec.GetSriovFecNodeConfigIoGVR(),
+		"sriovvrb.ClusterConfigBuilder":           sriovvrb.GetSriovVrbClusterConfigIoGVR(),
+		"sriovvrb.NodeConfigBuilder":              sriovvrb.GetSriovVrbNodeConfigIoGVR(),
+		"statefulset.Builder":                     statefulset.GetGVR(),
+		"storage.PVCBuilder":                      storage.GetPersistentVolumeClaimGVR(),
+	}
+
+	for builder, expectedGVR := range expectedGVRs {
+		entry, found := LookupBuilder(builder)
+		assert.True(t, found, builder)
+		assert.Equal(t, expectedGVR, entry.GVR, builder)
+	}
+
+	expectedGVKs := map[string]schema.GroupVersionKind{
+		"cgu.CguBuilder":                        (*cgu.CguBuilder)(nil).GetGVK(),
+		"cgu.PreCachingConfigBuilder":           (*cgu.PreCachingConfigBuilder)(nil).GetGVK(),
+		"compliance.CheckResultBuilder":         (*compliance.CheckResultBuilder)(nil).GetGVK(),
+		"compliance.ComplianceScanBuilder":      (*compliance.ComplianceScanBuilder)(nil).GetGVK(),
+		"compliance.RemediationBuilder":         (*compliance.RemediationBuilder)(nil).GetGVK(),
+		"compliance.ScanSettingBindingBuilder":  (*compliance.ScanSettingBindingBuilder)(nil).GetGVK(),
+		"configmap.Builder":                     (*configmap.Builder)(nil).GetGVK(),
+		"imageregistry.ImagePrunerBuilder":      (*imageregistry.ImagePrunerBuilder)(nil).GetGVK(),
+		"medik8s.FenceAgentsRemediationBuilder": (*medik8s.FenceAgentsRemediationBuilder)(nil).GetGVK(),
+		"medik8s.NodeHealthCheckBuilder":        (*medik8s.NodeHealthCheckBuilder)(nil).GetGVK(),
+		"medik8s.NodeMaintenanceBuilder":        (*medik8s.NodeMaintenanceBuilder)(nil).GetGVK(),
+		"namespace.Builder":                     (*namespace.Builder)(nil).GetGVK(),
+		"oran.HardwareProfileBuilder":           (*oran.HardwareProfileBuilder)(nil).GetGVK(),
+		"ptp.HardwareConfigBuilder":             (*ptp.HardwareConfigBuilder)(nil).GetGVK(),
+		"route.Builder":                         (*route.Builder)(nil).GetGVK(),
+	}
+
+	for builder, expectedGVK := range expectedGVKs {
+		entry, found := LookupBuilder(builder)
+		assert.True(t, found, builder)
+		assert.Equal(t, expectedGVK, entry.GVK, builder)
+	}
+
+	entry, found := LookupBuilder("nodesconfig.Builder")
+	assert.True(t, found)
+	assert.Equal(t, nodesconfig.GetNodesConfigIoGVR().GroupVersion(), entry.GVR.GroupVersion())
+}
+
+func TestLookup(t *testing.T) {
+	entry, found := LookupGVK(schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Deployment"})
+	assert.True(t, found)
+	assert.Equal(t, "deployment.Builder", entry.Builder)
+
+	entry, found = LookupGVR(schema.GroupVersionResource{Version: "v1", Resource: "pods"})
+	assert.True(t, found)
+	assert.Equal(t, "pod.Builder", entry.Builder)
+
+	_, found = LookupGVK(schema.GroupVersionKind{Group: "unknown", Version: "v1", Kind: "Unknown"})
+	assert.False(t, found)
+
+	_, found = LookupGVR(schema.GroupVersionResource{Group: "unknown", Version: "v1", Resource: "unknowns"})
+	assert.False(t, found)
+
+	_, found = LookupBuilder("unknown.Builder")
+	assert.False(t, found)
+}
+
+func TestEntriesReturnsCopy(t *testing.T) {
+	copied := Entries()
+	copied[0].Builder = "modified"
+
+	assert.NotEqual(t, "modified", Entries()[0].Builder)
+}
+
+func TestSchemeAttachers(t *testing.T) {
+	attachers := SchemeAttachers()
+
+	for _, entry := range Entries() {
+		_, found := attachers[entry.Builder]
+		assert.Equal(t, entry.SchemeAttacher != nil, found, entry.Builder)
+	}
+}
@@ -12,6 +12,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/klog/v2"
 	goclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -47,6 +48,8 @@ func NewABMClusterDeploymentBuilder(
 	baseDomain string,
 	clusterInstallRef string,
 	agentSelector metav1.LabelSelector) *ClusterDeploymentBuilder {
+	nsname = apiClient.ResolveNamespace(nsname)
+
 	klog.V(100).Infof(
 		`Initializing new agentbaremetal clusterdeployment structure with the following params: name: %s, namespace: %s,
 		  clusterName: %s, baseDomain: %s, clusterInstallRef: %s, agentSelector: %s`,
@@ -75,6 +78,8 @@ func NewClusterDeploymentByInstallRefBuilder(
 	baseDomain string,
 	clusterInstallRef hiveV1.ClusterInstallLocalReference,
 	platform hiveV1.Platform) *ClusterDeploymentBuilder {
+	nsname = apiClient.ResolveNamespace(nsname)
+
 	klog.V(100).Infof(
 		`Initializing new agentbaremetal clusterdeployment structure with the following params: name: %s, namespace: %s,
 		  clusterName: %s, baseDomain: %s, clusterInstallRef: %v, platform: %v`,
@@ -230,6 +235,8 @@ func (builder *ClusterDeploymentBuilder) Get() (*hiveV1.ClusterDeployment, error
 
 // PullClusterDeployment pulls existing clusterdeployment from cluster.
 func PullClusterDeployment(apiClient *clients.Settings, name, nsname string) (*ClusterDeploymentBuilder, error) {
+	nsname = apiClient.ResolveNamespace(nsname)
+
 	klog.V(100).Infof("Pulling existing clusterdeployment name %s under namespace %s from cluster", name, nsname)
 
 	if apiClient == nil {
@@ -397,6 +404,13 @@ func (builder *ClusterDeploymentBuilder) Exists() bool {
 	return err == nil || !k8serrors.IsNotFound(err)
 }
 
+// GetClusterDeploymentGVR returns ClusterDeployment's GroupVersionResource which could be used for Clean function.
+func GetClusterDeploymentGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group: "hive.openshift.io", Version: "v1", Resource: "clusterdeployments",
+	}
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *ClusterDeploymentBuilder) validate() (bool, error) {
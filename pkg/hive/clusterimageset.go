@@ -9,6 +9,7 @@ import (
 	hiveV1 "github.com/rh-ecosystem-edge/eco-goinfra/pkg/schemes/hive/api/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/klog/v2"
 	goclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -276,6 +277,13 @@ func (builder *ClusterImageSetBuilder) WithOptions(
 	return builder
 }
 
+// GetClusterImageSetGVR returns ClusterImageSet's GroupVersionResource which could be used for Clean function.
+func GetClusterImageSetGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group: "hive.openshift.io", Version: "v1", Resource: "clusterimagesets",
+	}
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *ClusterImageSetBuilder) validate() (bool, error) {
@@ -9,6 +9,7 @@ import (
 	hiveV1 "github.com/rh-ecosystem-edge/eco-goinfra/pkg/schemes/hive/api/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/klog/v2"
 	runtimeClient "sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -206,6 +207,13 @@ func (builder *ConfigBuilder) WithOptions(options ...ConfigAdditionalOptions) *C
 	return builder
 }
 
+// GetHiveConfigGVR returns HiveConfig's GroupVersionResource which could be used for Clean function.
+func GetHiveConfigGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group: "hive.openshift.io", Version: "v1", Resource: "hiveconfigs",
+	}
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *ConfigBuilder) validate() (bool, error) {
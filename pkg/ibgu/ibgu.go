@@ -13,6 +13,7 @@ import (
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/schemes/imagebasedgroupupgrades/v1alpha1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/klog/v2"
 	goclient "sigs.k8s.io/controller-runtime/pkg/client"
@@ -38,6 +39,8 @@ func NewIbguBuilder(
 	apiClient *clients.Settings,
 	name string,
 	nsname string) *IbguBuilder {
+	nsname = apiClient.ResolveNamespace(nsname)
+
 	klog.V(100).Infof(
 		"Initializing new ibgu structure with the following params: name: %s, nsname: %s", name, nsname)
 
@@ -348,6 +351,8 @@ func (builder *IbguBuilder) Delete() error {
 
 // PullIbgu pulls existing ibgu into IbguBuilder struct.
 func PullIbgu(apiClient *clients.Settings, name, nsname string) (*IbguBuilder, error) {
+	nsname = apiClient.ResolveNamespace(nsname)
+
 	klog.V(100).Infof("Pulling existing ibgu name %s under namespace %s from cluster", name, nsname)
 
 	if apiClient == nil {
@@ -503,6 +508,13 @@ func (builder *IbguBuilder) WaitUntilComplete(timeout time.Duration) (*IbguBuild
 	return builder.WaitForCondition(conditionComplete, timeout)
 }
 
+// GetGVR returns ImageBasedGroupUpgrade's GroupVersionResource which could be used for Clean function.
+func GetGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group: "lcm.openshift.io", Version: "v1alpha1", Resource: "imagebasedgroupupgrades",
+	}
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *IbguBuilder) validate() (bool, error) {
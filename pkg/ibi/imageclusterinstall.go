@@ -12,6 +12,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/klog/v2"
 	goclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -32,6 +33,8 @@ type ImageClusterInstallBuilder struct {
 // NewImageClusterInstallBuilder creates a new instance of ImageClusterInstallBuilder.
 func NewImageClusterInstallBuilder(
 	apiClient *clients.Settings, name, nsname, imageset string) *ImageClusterInstallBuilder {
+	nsname = apiClient.ResolveNamespace(nsname)
+
 	klog.V(100).Infof(
 		"Initializing new imageclusterinstall structure with the following params: "+
 			"name: %s, namespace: %s, imageset: %s",
@@ -93,6 +96,8 @@ func NewImageClusterInstallBuilder(
 
 // PullImageClusterInstall retrieves an existing imageclusterinstall from the cluster.
 func PullImageClusterInstall(apiClient *clients.Settings, name, nsname string) (*ImageClusterInstallBuilder, error) {
+	nsname = apiClient.ResolveNamespace(nsname)
+
 	klog.V(100).Infof(
 		"Pulling existing imageclusterinstall with name %s from namespace %s", name, nsname)
 
@@ -446,6 +451,13 @@ func (builder *ImageClusterInstallBuilder) getCondition(
 	return nil, fmt.Errorf("cannot find %s condition in imageclusterinstall status", conditionType)
 }
 
+// GetGVR returns ImageClusterInstall's GroupVersionResource which could be used for Clean function.
+func GetGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group: "extensions.hive.openshift.io", Version: "v1alpha1", Resource: "imageclusterinstalls",
+	}
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *ImageClusterInstallBuilder) validate() (bool, error) {
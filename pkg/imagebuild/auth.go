@@ -0,0 +1,41 @@
+package imagebuild
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/pullsecret"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/secret"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/klog/v2"
+)
+
+// NewRegistryAuthSecret returns a secret.Builder for a dockerconfigjson secret holding the credentials of the
+// registries. Once created, its name can be passed to Builder.WithOutputImage, Builder.WithPullSecret or
+// BuildahJobBuilder.WithAuthSecret. The keys of auths are registries, optionally followed by a repository path, and
+// their values can be created using pullsecret.NewRegistryAuth.
+func NewRegistryAuthSecret(
+	apiClient *clients.Settings, name, nsname string, auths map[string]pullsecret.RegistryAuth) (*secret.Builder, error) {
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	klog.V(100).Infof("Initializing registry auth secret %s in namespace %s", name, nsname)
+
+	if apiClient == nil {
+		return nil, fmt.Errorf("registry auth secret 'apiClient' cannot be nil")
+	}
+
+	if len(auths) == 0 {
+		return nil, fmt.Errorf("registry auth secret 'auths' cannot be empty")
+	}
+
+	data, err := json.Marshal(pullsecret.DockerConfig{Auths: auths})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal registry auths: %w", err)
+	}
+
+	builder := secret.NewBuilder(apiClient, name, nsname, corev1.SecretTypeDockerConfigJson).
+		WithData(map[string][]byte{corev1.DockerConfigJsonKey: data})
+
+	return builder, nil
+}
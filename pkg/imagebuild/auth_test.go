@@ -0,0 +1,55 @@
+package imagebuild
+
+import (
+	"testing"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/pullsecret"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestNewRegistryAuthSecret(t *testing.T) {
+	t.Parallel()
+
+	auths := map[string]pullsecret.RegistryAuth{"quay.io/org": pullsecret.NewRegistryAuth("user", "pass")}
+
+	testCases := []struct {
+		client        *clients.Settings
+		auths         map[string]pullsecret.RegistryAuth
+		expectedError string
+	}{
+		{
+			client: clients.GetTestClients(clients.TestClientParams{}),
+			auths:  auths,
+		},
+		{
+			client:        nil,
+			auths:         auths,
+			expectedError: "registry auth secret 'apiClient' cannot be nil",
+		},
+		{
+			client:        clients.GetTestClients(clients.TestClientParams{}),
+			auths:         nil,
+			expectedError: "registry auth secret 'auths' cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		secretBuilder, err := NewRegistryAuthSecret(testCase.client, "registry-secret", defaultBuildNamespace,
+			testCase.auths)
+
+		if testCase.expectedError != "" {
+			assert.EqualError(t, err, testCase.expectedError)
+
+			continue
+		}
+
+		assert.NoError(t, err)
+		assert.Equal(t, corev1.SecretTypeDockerConfigJson, secretBuilder.Definition.Type)
+
+		config, err := pullsecret.ParseDockerConfig(secretBuilder.Definition.Data[corev1.DockerConfigJsonKey])
+		assert.NoError(t, err)
+		assert.Equal(t, testCase.auths, config.Auths)
+	}
+}
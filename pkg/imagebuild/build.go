@@ -0,0 +1,250 @@
+// Package imagebuild builds container images inside the cluster from a Dockerfile, such as the kmod images loaded by
+// KMM, and returns the pullspecs of the built images. Builder uses OpenShift Builds, pushing to an ImageStream of the
+// internal registry by default, while BuildahJobBuilder runs buildah in a Job for clusters without the Build
+// capability. Credentials for pulling base images and pushing the result are read from dockerconfigjson secrets, which
+// can be created using NewRegistryAuthSecret.
+package imagebuild
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	buildv1 "github.com/openshift/api/build/v1"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/klog/v2"
+)
+
+// buildPollInterval is how often the status of builds is checked while waiting for them to complete.
+const buildPollInterval = 5 * time.Second
+
+// Builder provides a struct for the OpenShift Build resource containing a connection to the cluster and the Build
+// definition. The Build runs once it is created, building the image from the Dockerfile using the Docker strategy.
+type Builder struct {
+	common.EmbeddableBuilder[buildv1.Build, *buildv1.Build]
+	common.EmbeddableCreator[buildv1.Build, Builder, *buildv1.Build, *Builder]
+	common.EmbeddableDeleter[buildv1.Build, *buildv1.Build]
+	common.EmbeddableConditionGetter[buildv1.BuildCondition, buildv1.Build, *buildv1.Build]
+	common.EmbeddablePhaseGetter[buildv1.Build, *buildv1.Build]
+}
+
+// AttachMixins wires the embedded CRUD mixins to this builder instance.
+func (builder *Builder) AttachMixins() {
+	builder.EmbeddableCreator.SetBase(builder)
+	builder.EmbeddableDeleter.SetBase(builder)
+	builder.EmbeddableConditionGetter.SetBase(builder)
+	builder.EmbeddablePhaseGetter.SetBase(builder)
+}
+
+// GetGVK returns the Build GVK for this builder.
+func (builder *Builder) GetGVK() schema.GroupVersionKind {
+	return buildv1.GroupVersion.WithKind("Build")
+}
+
+// NewBuilder creates a new instance of Builder building the image from the inline Dockerfile. The image is pushed to
+// the ImageStreamTag name:latest in the namespace unless WithOutputImage is used.
+func NewBuilder(apiClient *clients.Settings, name, nsname, dockerfile string) *Builder {
+	klog.V(100).Infof("Initializing new Build structure with the following params: name: %s, namespace: %s",
+		name, nsname)
+
+	builder := common.NewNamespacedBuilder[buildv1.Build, Builder](apiClient, buildv1.Install, name, nsname)
+	if builder.GetError() != nil {
+		return builder
+	}
+
+	if dockerfile == "" {
+		builder.SetError(fmt.Errorf("build 'dockerfile' cannot be empty"))
+
+		return builder
+	}
+
+	builder.Definition.Spec.Source = buildv1.BuildSource{
+		Type:       buildv1.BuildSourceDockerfile,
+		Dockerfile: &dockerfile,
+	}
+	builder.Definition.Spec.Strategy = buildv1.BuildStrategy{
+		Type:           buildv1.DockerBuildStrategyType,
+		DockerStrategy: &buildv1.DockerBuildStrategy{},
+	}
+	builder.Definition.Spec.Output = buildv1.BuildOutput{
+		To: &corev1.ObjectReference{Kind: "ImageStreamTag", Name: name + ":latest"},
+	}
+
+	return builder
+}
+
+// Pull retrieves an existing Build from the cluster.
+func Pull(apiClient *clients.Settings, name, nsname string) (*Builder, error) {
+	return common.PullNamespacedBuilder[buildv1.Build, Builder](
+		context.TODO(), apiClient, buildv1.Install, name, nsname)
+}
+
+// WithBuildArgs sets the ARG values of the Dockerfile, such as the KERNEL_VERSION of kmod images.
+func (builder *Builder) WithBuildArgs(buildArgs map[string]string) *Builder {
+	if err := common.Validate(builder); err != nil {
+		return builder
+	}
+
+	klog.V(100).Infof("Setting build args of Build %s in namespace %s to %v",
+		builder.Definition.Name, builder.Definition.Namespace, buildArgs)
+
+	if len(buildArgs) == 0 {
+		builder.SetError(fmt.Errorf("build 'buildArgs' cannot be empty"))
+
+		return builder
+	}
+
+	builder.Definition.Spec.Strategy.DockerStrategy.BuildArgs = toEnvVars(buildArgs)
+
+	return builder
+}
+
+// WithOutputImage pushes the image to the pullspec, such as quay.io/org/kmod:tag, rather than to an ImageStream. If
+// pushSecret is not empty, the dockerconfigjson secret of that name is used to authenticate to the registry.
+func (builder *Builder) WithOutputImage(pullspec, pushSecret string) *Builder {
+	if err := common.Validate(builder); err != nil {
+		return builder
+	}
+
+	klog.V(100).Infof("Setting output image of Build %s in namespace %s to %s",
+		builder.Definition.Name, builder.Definition.Namespace, pullspec)
+
+	if pullspec == "" {
+		builder.SetError(fmt.Errorf("build output 'pullspec' cannot be empty"))
+
+		return builder
+	}
+
+	builder.Definition.Spec.Output.To = &corev1.ObjectReference{Kind: "DockerImage", Name: pullspec}
+	builder.Definition.Spec.Output.PushSecret = nil
+
+	if pushSecret != "" {
+		builder.Definition.Spec.Output.PushSecret = &corev1.LocalObjectReference{Name: pushSecret}
+	}
+
+	return builder
+}
+
+// WithPullSecret sets the dockerconfigjson secret used to pull the base images of the Dockerfile.
+func (builder *Builder) WithPullSecret(pullSecret string) *Builder {
+	if err := common.Validate(builder); err != nil {
+		return builder
+	}
+
+	klog.V(100).Infof("Setting pull secret of Build %s in namespace %s to %s",
+		builder.Definition.Name, builder.Definition.Namespace, pullSecret)
+
+	if pullSecret == "" {
+		builder.SetError(fmt.Errorf("build 'pullSecret' cannot be empty"))
+
+		return builder
+	}
+
+	builder.Definition.Spec.Strategy.DockerStrategy.PullSecret = &corev1.LocalObjectReference{Name: pullSecret}
+
+	return builder
+}
+
+// WaitUntilComplete waits up to timeout for the Build to complete and returns the pullspec of the built image, pinned
+// to its digest when the registry reported one. It fails as soon as the Build fails, is cancelled or errors.
+func (builder *Builder) WaitUntilComplete(timeout time.Duration) (string, error) {
+	if err := common.Validate(builder); err != nil {
+		return "", err
+	}
+
+	klog.V(100).Infof("Waiting up to %s for Build %s in namespace %s to complete",
+		timeout, builder.Definition.Name, builder.Definition.Namespace)
+
+	err := wait.PollUntilContextTimeout(
+		context.TODO(), buildPollInterval, timeout, true, func(ctx context.Context) (bool, error) {
+			build, err := builder.Get()
+			if err != nil {
+				klog.V(100).Infof("Failed to get Build %s: %v", builder.Definition.Name, err)
+
+				return false, nil
+			}
+
+			builder.Object = build
+
+			switch build.Status.Phase {
+			case buildv1.BuildPhaseComplete:
+				return true, nil
+			case buildv1.BuildPhaseFailed, buildv1.BuildPhaseError, buildv1.BuildPhaseCancelled:
+				return false, fmt.Errorf("build %s in namespace %s is %s: %s %s", build.Name, build.Namespace,
+					build.Status.Phase, build.Status.Message, build.Status.LogSnippet)
+			default:
+				return false, nil
+			}
+		})
+	if err != nil {
+		return "", err
+	}
+
+	return builder.GetPullSpec()
+}
+
+// GetPullSpec returns the pullspec of the image pushed by the completed Build, pinned to its digest when the registry
+// reported one.
+func (builder *Builder) GetPullSpec() (string, error) {
+	if err := common.Validate(builder); err != nil {
+		return "", err
+	}
+
+	build, err := builder.Get()
+	if err != nil {
+		return "", err
+	}
+
+	builder.Object = build
+
+	if build.Status.Phase != buildv1.BuildPhaseComplete {
+		return "", fmt.Errorf("build %s in namespace %s is %s, not Complete",
+			build.Name, build.Namespace, build.Status.Phase)
+	}
+
+	if build.Status.OutputDockerImageReference == "" {
+		return "", fmt.Errorf("build %s in namespace %s has no output image", build.Name, build.Namespace)
+	}
+
+	digest := ""
+	if build.Status.Output.To != nil {
+		digest = build.Status.Output.To.ImageDigest
+	}
+
+	return pullSpecWithDigest(build.Status.OutputDockerImageReference, digest), nil
+}
+
+// toEnvVars returns the map as environment variables sorted by name.
+func toEnvVars(values map[string]string) []corev1.EnvVar {
+	envVars := make([]corev1.EnvVar, 0, len(values))
+
+	for name, value := range values {
+		envVars = append(envVars, corev1.EnvVar{Name: name, Value: value})
+	}
+
+	slices.SortFunc(envVars, func(a, b corev1.EnvVar) int {
+		if a.Name < b.Name {
+			return -1
+		}
+
+		if a.Name > b.Name {
+			return 1
+		}
+
+		return 0
+	})
+
+	return envVars
+}
+
+// GetBuildGVR returns Build's GroupVersionResource which could be used for Clean function.
+func GetBuildGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group: "build.openshift.io", Version: "v1", Resource: "builds",
+	}
+}
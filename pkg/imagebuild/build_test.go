@@ -0,0 +1,257 @@
+package imagebuild
+
+import (
+	"testing"
+	"time"
+
+	buildv1 "github.com/openshift/api/build/v1"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common/testhelper"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	defaultBuildName      = "kmod-build"
+	defaultBuildNamespace = "test-namespace"
+	defaultDockerfile     = "FROM registry.example.com/driver-toolkit:latest\nRUN make"
+	defaultPullSpec       = "registry.example.com:5000/kmm/kmod:1.0"
+	defaultDigest         = "sha256:0123456789abcdef"
+)
+
+var buildGVK = buildv1.GroupVersion.WithKind("Build")
+
+func TestNewBuilder(t *testing.T) {
+	t.Parallel()
+
+	testhelper.NewNamespacedBuilderTestConfig[buildv1.Build, Builder](
+		func(apiClient *clients.Settings, name, nsname string) *Builder {
+			return NewBuilder(apiClient, name, nsname, defaultDockerfile)
+		}, buildv1.Install, buildGVK).ExecuteTests(t)
+}
+
+func TestNewBuilderDockerfile(t *testing.T) {
+	t.Parallel()
+
+	testBuilder := NewBuilder(buildTestClientWithBuild(nil), defaultBuildName, defaultBuildNamespace, "")
+	assert.EqualError(t, testBuilder.GetError(), "build 'dockerfile' cannot be empty")
+
+	testBuilder = NewBuilder(buildTestClientWithBuild(nil), defaultBuildName, defaultBuildNamespace, defaultDockerfile)
+	assert.NoError(t, testBuilder.GetError())
+	assert.Equal(t, buildv1.BuildSourceDockerfile, testBuilder.Definition.Spec.Source.Type)
+	assert.Equal(t, defaultDockerfile, *testBuilder.Definition.Spec.Source.Dockerfile)
+	assert.Equal(t, buildv1.DockerBuildStrategyType, testBuilder.Definition.Spec.Strategy.Type)
+	assert.Equal(t, &corev1.ObjectReference{Kind: "ImageStreamTag", Name: defaultBuildName + ":latest"},
+		testBuilder.Definition.Spec.Output.To)
+}
+
+func TestPull(t *testing.T) {
+	t.Parallel()
+
+	testhelper.NewNamespacedPullTestConfig[buildv1.Build, Builder](
+		Pull, buildv1.Install, buildGVK).ExecuteTests(t)
+}
+
+func TestBuilderMethods(t *testing.T) {
+	t.Parallel()
+
+	commonConfig := testhelper.NewCommonTestConfig[buildv1.Build, Builder](
+		buildv1.Install, buildGVK, testhelper.ResourceScopeNamespaced)
+
+	testhelper.NewTestSuite().
+		With(testhelper.NewGetTestConfig(commonConfig)).
+		With(testhelper.NewExistsTestConfig(commonConfig)).
+		With(testhelper.NewCreateTestConfig(commonConfig)).
+		With(testhelper.NewDeleterTestConfig(commonConfig)).
+		Run(t)
+}
+
+func TestBuilderWithBuildArgs(t *testing.T) {
+	t.Parallel()
+
+	testBuilder := newTestBuilder().WithBuildArgs(nil)
+	assert.EqualError(t, testBuilder.GetError(), "build 'buildArgs' cannot be empty")
+
+	testBuilder = newTestBuilder().WithBuildArgs(map[string]string{"MOD_NAME": "kmm-ci", "KERNEL_VERSION": "5.14"})
+	assert.NoError(t, testBuilder.GetError())
+	assert.Equal(t, []corev1.EnvVar{{Name: "KERNEL_VERSION", Value: "5.14"}, {Name: "MOD_NAME", Value: "kmm-ci"}},
+		testBuilder.Definition.Spec.Strategy.DockerStrategy.BuildArgs)
+}
+
+func TestBuilderWithOutputImage(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		pullspec      string
+		pushSecret    string
+		expectedError string
+	}{
+		{
+			pullspec:   defaultPullSpec,
+			pushSecret: "push-secret",
+		},
+		{
+			pullspec: defaultPullSpec,
+		},
+		{
+			pullspec:      "",
+			expectedError: "build output 'pullspec' cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := newTestBuilder().WithOutputImage(testCase.pullspec, testCase.pushSecret)
+
+		if testCase.expectedError != "" {
+			assert.EqualError(t, testBuilder.GetError(), testCase.expectedError)
+
+			continue
+		}
+
+		assert.NoError(t, testBuilder.GetError())
+		assert.Equal(t, &corev1.ObjectReference{Kind: "DockerImage", Name: testCase.pullspec},
+			testBuilder.Definition.Spec.Output.To)
+
+		if testCase.pushSecret == "" {
+			assert.Nil(t, testBuilder.Definition.Spec.Output.PushSecret)
+		} else {
+			assert.Equal(t, testCase.pushSecret, testBuilder.Definition.Spec.Output.PushSecret.Name)
+		}
+	}
+}
+
+func TestBuilderWithPullSecret(t *testing.T) {
+	t.Parallel()
+
+	testBuilder := newTestBuilder().WithPullSecret("")
+	assert.EqualError(t, testBuilder.GetError(), "build 'pullSecret' cannot be empty")
+
+	testBuilder = newTestBuilder().WithPullSecret("pull-secret")
+	assert.NoError(t, testBuilder.GetError())
+	assert.Equal(t, "pull-secret", testBuilder.Definition.Spec.Strategy.DockerStrategy.PullSecret.Name)
+}
+
+func TestBuilderWaitUntilComplete(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		status           *buildv1.BuildStatus
+		expectedPullSpec string
+		expectedError    string
+	}{
+		{
+			status: &buildv1.BuildStatus{
+				Phase:                      buildv1.BuildPhaseComplete,
+				OutputDockerImageReference: defaultPullSpec,
+				Output:                     buildv1.BuildStatusOutput{To: &buildv1.BuildStatusOutputTo{ImageDigest: defaultDigest}},
+			},
+			expectedPullSpec: "registry.example.com:5000/kmm/kmod@" + defaultDigest,
+		},
+		{
+			status: &buildv1.BuildStatus{
+				Phase:                      buildv1.BuildPhaseComplete,
+				OutputDockerImageReference: defaultPullSpec,
+			},
+			expectedPullSpec: defaultPullSpec,
+		},
+		{
+			status: &buildv1.BuildStatus{Phase: buildv1.BuildPhaseComplete},
+			expectedError: "build " + defaultBuildName + " in namespace " + defaultBuildNamespace +
+				" has no output image",
+		},
+		{
+			status: &buildv1.BuildStatus{Phase: buildv1.BuildPhaseFailed, Message: "Docker build strategy has failed."},
+			expectedError: "build " + defaultBuildName + " in namespace " + defaultBuildNamespace +
+				" is Failed: Docker build strategy has failed. ",
+		},
+		{
+			status:        &buildv1.BuildStatus{Phase: buildv1.BuildPhaseRunning},
+			expectedError: "context deadline exceeded",
+		},
+		{
+			status:        nil,
+			expectedError: "context deadline exceeded",
+		},
+	}
+
+	for _, testCase := range testCases {
+		var objects []runtime.Object
+
+		if testCase.status != nil {
+			build := buildDummyBuild()
+			build.Status = *testCase.status
+			objects = append(objects, build)
+		}
+
+		testBuilder := NewBuilder(
+			buildTestClientWithBuild(objects), defaultBuildName, defaultBuildNamespace, defaultDockerfile)
+
+		pullSpec, err := testBuilder.WaitUntilComplete(time.Second)
+
+		if testCase.expectedError != "" {
+			assert.ErrorContains(t, err, testCase.expectedError)
+
+			continue
+		}
+
+		assert.NoError(t, err)
+		assert.Equal(t, testCase.expectedPullSpec, pullSpec)
+	}
+}
+
+func TestPullSpecWithDigest(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		pullspec string
+		digest   string
+		expected string
+	}{
+		{
+			pullspec: "quay.io/org/kmod:1.0",
+			digest:   defaultDigest,
+			expected: "quay.io/org/kmod@" + defaultDigest,
+		},
+		{
+			pullspec: "registry.example.com:5000/kmod",
+			digest:   defaultDigest,
+			expected: "registry.example.com:5000/kmod@" + defaultDigest,
+		},
+		{
+			pullspec: "quay.io/org/kmod@sha256:fedcba",
+			digest:   defaultDigest,
+			expected: "quay.io/org/kmod@" + defaultDigest,
+		},
+		{
+			pullspec: "quay.io/org/kmod:1.0",
+			digest:   "",
+			expected: "quay.io/org/kmod:1.0",
+		},
+	}
+
+	for _, testCase := range testCases {
+		assert.Equal(t, testCase.expected, pullSpecWithDigest(testCase.pullspec, testCase.digest))
+	}
+}
+
+func newTestBuilder() *Builder {
+	return NewBuilder(buildTestClientWithBuild(nil), defaultBuildName, defaultBuildNamespace, defaultDockerfile)
+}
+
+func buildDummyBuild() *buildv1.Build {
+	return &buildv1.Build{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      defaultBuildName,
+			Namespace: defaultBuildNamespace,
+		},
+	}
+}
+
+func buildTestClientWithBuild(objects []runtime.Object) *clients.Settings {
+	return clients.GetTestClients(clients.TestClientParams{
+		K8sMockObjects:  objects,
+		SchemeAttachers: []clients.SchemeAttacher{buildv1.Install},
+	})
+}
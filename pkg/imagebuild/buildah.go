@@ -0,0 +1,338 @@
+package imagebuild
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
+	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// DefaultBuildahImage is the image running buildah in the Jobs of BuildahJobBuilder.
+	DefaultBuildahImage = "quay.io/buildah/stable:latest"
+	// buildahContainerName is the name of the container running buildah in the Job.
+	buildahContainerName = "buildah"
+	// buildahAuthVolumeName is the name of the volume holding the registry auth secret.
+	buildahAuthVolumeName = "registry-auth"
+	// buildahAuthMountPath is where the registry auth secret is mounted in the buildah container.
+	buildahAuthMountPath = "/run/containers/registry-auth"
+	// buildahScript writes the Dockerfile from the environment, builds it with the build args passed as arguments and
+	// pushes the image, writing its digest to the termination log so it can be read from the status of the pod.
+	buildahScript = `set -e
+mkdir -p /tmp/build
+printf '%s' "$DOCKERFILE" > /tmp/build/Dockerfile
+buildah bud --storage-driver vfs --tls-verify="$TLS_VERIFY" "$@" -t "$IMAGE" /tmp/build
+buildah push --storage-driver vfs --tls-verify="$TLS_VERIFY" --digestfile /dev/termination-log "$IMAGE"`
+)
+
+// BuildahJobBuilder provides a struct for a Job running buildah to build an image from a Dockerfile and push it to a
+// registry. It is an alternative to Builder on clusters without the Build capability and requires the service account
+// of the namespace to be allowed to run privileged pods.
+type BuildahJobBuilder struct {
+	common.EmbeddableBuilder[batchv1.Job, *batchv1.Job]
+	common.EmbeddableCreator[batchv1.Job, BuildahJobBuilder, *batchv1.Job, *BuildahJobBuilder]
+	common.EmbeddableDeleter[batchv1.Job, *batchv1.Job]
+}
+
+// AttachMixins wires the embedded CRUD mixins to this builder instance.
+func (builder *BuildahJobBuilder) AttachMixins() {
+	builder.EmbeddableCreator.SetBase(builder)
+	builder.EmbeddableDeleter.SetBase(builder)
+}
+
+// GetGVK returns the Job GVK for this builder.
+func (builder *BuildahJobBuilder) GetGVK() schema.GroupVersionKind {
+	return batchv1.SchemeGroupVersion.WithKind("Job")
+}
+
+// NewBuildahJobBuilder creates a new instance of BuildahJobBuilder building the image from the inline Dockerfile and
+// pushing it to the pullspec. The Job is not retried, so a failed build fails the Job.
+func NewBuildahJobBuilder(
+	apiClient *clients.Settings, name, nsname, dockerfile, pullspec string) *BuildahJobBuilder {
+	klog.V(100).Infof("Initializing new buildah Job structure with the following params: name: %s, namespace: %s, "+
+		"pullspec: %s", name, nsname, pullspec)
+
+	builder := common.NewNamespacedBuilder[batchv1.Job, BuildahJobBuilder](apiClient, batchv1.AddToScheme, name, nsname)
+	if builder.GetError() != nil {
+		return builder
+	}
+
+	if dockerfile == "" {
+		builder.SetError(fmt.Errorf("buildah job 'dockerfile' cannot be empty"))
+
+		return builder
+	}
+
+	if pullspec == "" {
+		builder.SetError(fmt.Errorf("buildah job 'pullspec' cannot be empty"))
+
+		return builder
+	}
+
+	builder.Definition.Spec = batchv1.JobSpec{
+		BackoffLimit: ptr.To[int32](0),
+		Template: corev1.PodTemplateSpec{
+			Spec: corev1.PodSpec{
+				RestartPolicy: corev1.RestartPolicyNever,
+				Containers: []corev1.Container{{
+					Name:    buildahContainerName,
+					Image:   DefaultBuildahImage,
+					Command: []string{"/bin/sh", "-c", buildahScript, buildahContainerName},
+					Env: []corev1.EnvVar{
+						{Name: "DOCKERFILE", Value: dockerfile},
+						{Name: "IMAGE", Value: pullspec},
+						{Name: "TLS_VERIFY", Value: "true"},
+					},
+					SecurityContext: &corev1.SecurityContext{Privileged: ptr.To(true)},
+				}},
+			},
+		},
+	}
+
+	return builder
+}
+
+// PullBuildahJob retrieves an existing buildah Job from the cluster.
+func PullBuildahJob(apiClient *clients.Settings, name, nsname string) (*BuildahJobBuilder, error) {
+	return common.PullNamespacedBuilder[batchv1.Job, BuildahJobBuilder](
+		context.TODO(), apiClient, batchv1.AddToScheme, name, nsname)
+}
+
+// WithImage sets the image running buildah, such as a mirrored copy of DefaultBuildahImage on disconnected clusters.
+func (builder *BuildahJobBuilder) WithImage(image string) *BuildahJobBuilder {
+	if err := common.Validate(builder); err != nil {
+		return builder
+	}
+
+	klog.V(100).Infof("Setting image of buildah Job %s in namespace %s to %s",
+		builder.Definition.Name, builder.Definition.Namespace, image)
+
+	if image == "" {
+		builder.SetError(fmt.Errorf("buildah job 'image' cannot be empty"))
+
+		return builder
+	}
+
+	builder.container().Image = image
+
+	return builder
+}
+
+// WithBuildArgs sets the ARG values of the Dockerfile, such as the KERNEL_VERSION of kmod images.
+func (builder *BuildahJobBuilder) WithBuildArgs(buildArgs map[string]string) *BuildahJobBuilder {
+	if err := common.Validate(builder); err != nil {
+		return builder
+	}
+
+	klog.V(100).Infof("Setting build args of buildah Job %s in namespace %s to %v",
+		builder.Definition.Name, builder.Definition.Namespace, buildArgs)
+
+	if len(buildArgs) == 0 {
+		builder.SetError(fmt.Errorf("buildah job 'buildArgs' cannot be empty"))
+
+		return builder
+	}
+
+	container := builder.container()
+	container.Command = container.Command[:4]
+
+	for _, envVar := range toEnvVars(buildArgs) {
+		container.Command = append(container.Command, "--build-arg", envVar.Name+"="+envVar.Value)
+	}
+
+	return builder
+}
+
+// WithAuthSecret sets the dockerconfigjson secret used by buildah both to pull the base images of the Dockerfile and
+// to push the built image.
+func (builder *BuildahJobBuilder) WithAuthSecret(authSecret string) *BuildahJobBuilder {
+	if err := common.Validate(builder); err != nil {
+		return builder
+	}
+
+	klog.V(100).Infof("Setting auth secret of buildah Job %s in namespace %s to %s",
+		builder.Definition.Name, builder.Definition.Namespace, authSecret)
+
+	if authSecret == "" {
+		builder.SetError(fmt.Errorf("buildah job 'authSecret' cannot be empty"))
+
+		return builder
+	}
+
+	podSpec := &builder.Definition.Spec.Template.Spec
+	podSpec.Volumes = []corev1.Volume{{
+		Name: buildahAuthVolumeName,
+		VolumeSource: corev1.VolumeSource{
+			Secret: &corev1.SecretVolumeSource{SecretName: authSecret},
+		},
+	}}
+
+	container := builder.container()
+	container.VolumeMounts = []corev1.VolumeMount{{
+		Name:      buildahAuthVolumeName,
+		MountPath: buildahAuthMountPath,
+		ReadOnly:  true,
+	}}
+	setEnvVar(container, "REGISTRY_AUTH_FILE", buildahAuthMountPath+"/"+corev1.DockerConfigJsonKey)
+
+	return builder
+}
+
+// WithTLSVerify sets whether buildah verifies the certificates of the registries, which is enabled by default.
+// Disabling it allows pushing to registries using self-signed certificates.
+func (builder *BuildahJobBuilder) WithTLSVerify(tlsVerify bool) *BuildahJobBuilder {
+	if err := common.Validate(builder); err != nil {
+		return builder
+	}
+
+	klog.V(100).Infof("Setting TLS verification of buildah Job %s in namespace %s to %t",
+		builder.Definition.Name, builder.Definition.Namespace, tlsVerify)
+
+	setEnvVar(builder.container(), "TLS_VERIFY", strconv.FormatBool(tlsVerify))
+
+	return builder
+}
+
+// WaitUntilComplete waits up to timeout for the Job to complete and returns the pullspec of the pushed image, pinned
+// to its digest. It fails as soon as the Job fails.
+func (builder *BuildahJobBuilder) WaitUntilComplete(timeout time.Duration) (string, error) {
+	if err := common.Validate(builder); err != nil {
+		return "", err
+	}
+
+	klog.V(100).Infof("Waiting up to %s for buildah Job %s in namespace %s to complete",
+		timeout, builder.Definition.Name, builder.Definition.Namespace)
+
+	err := wait.PollUntilContextTimeout(
+		context.TODO(), buildPollInterval, timeout, true, func(ctx context.Context) (bool, error) {
+			job, err := builder.Get()
+			if err != nil {
+				klog.V(100).Infof("Failed to get buildah Job %s: %v", builder.Definition.Name, err)
+
+				return false, nil
+			}
+
+			builder.Object = job
+
+			for _, condition := range job.Status.Conditions {
+				if condition.Status != corev1.ConditionTrue {
+					continue
+				}
+
+				switch condition.Type {
+				case batchv1.JobComplete:
+					return true, nil
+				case batchv1.JobFailed:
+					return false, fmt.Errorf("buildah job %s in namespace %s failed: %s",
+						job.Name, job.Namespace, condition.Message)
+				}
+			}
+
+			return false, nil
+		})
+	if err != nil {
+		return "", err
+	}
+
+	return builder.GetPullSpec()
+}
+
+// GetPullSpec returns the pullspec of the image pushed by the completed Job, pinned to the digest written by buildah
+// to the termination message of its pod.
+func (builder *BuildahJobBuilder) GetPullSpec() (string, error) {
+	if err := common.Validate(builder); err != nil {
+		return "", err
+	}
+
+	podList := &corev1.PodList{}
+
+	err := builder.GetClient().List(context.TODO(), podList, runtimeclient.InNamespace(builder.Definition.Namespace),
+		runtimeclient.MatchingLabels{batchv1.JobNameLabel: builder.Definition.Name})
+	if err != nil {
+		return "", fmt.Errorf("failed to list pods of buildah job %s in namespace %s: %w",
+			builder.Definition.Name, builder.Definition.Namespace, err)
+	}
+
+	for _, jobPod := range podList.Items {
+		for _, status := range jobPod.Status.ContainerStatuses {
+			terminated := status.State.Terminated
+			if status.Name != buildahContainerName || terminated == nil || terminated.ExitCode != 0 {
+				continue
+			}
+
+			digest := strings.TrimSpace(terminated.Message)
+			if digest == "" {
+				continue
+			}
+
+			return pullSpecWithDigest(getEnvVar(builder.container(), "IMAGE"), digest), nil
+		}
+	}
+
+	return "", fmt.Errorf("buildah job %s in namespace %s has no successful pod reporting an image digest",
+		builder.Definition.Name, builder.Definition.Namespace)
+}
+
+// container returns the buildah container of the Job.
+func (builder *BuildahJobBuilder) container() *corev1.Container {
+	return &builder.Definition.Spec.Template.Spec.Containers[0]
+}
+
+// getEnvVar returns the value of the environment variable of the container, or an empty string if it is not set.
+func getEnvVar(container *corev1.Container, name string) string {
+	for _, envVar := range container.Env {
+		if envVar.Name == name {
+			return envVar.Value
+		}
+	}
+
+	return ""
+}
+
+// setEnvVar sets the environment variable of the container, replacing any existing value.
+func setEnvVar(container *corev1.Container, name, value string) {
+	for index := range container.Env {
+		if container.Env[index].Name == name {
+			container.Env[index].Value = value
+
+			return
+		}
+	}
+
+	container.Env = append(container.Env, corev1.EnvVar{Name: name, Value: value})
+}
+
+// pullSpecWithDigest returns the pullspec pinned to the digest, replacing its tag. The pullspec is returned unchanged
+// if digest is empty.
+func pullSpecWithDigest(pullspec, digest string) string {
+	if digest == "" {
+		return pullspec
+	}
+
+	repository, _, _ := strings.Cut(pullspec, "@")
+
+	// A colon after the last slash separates the tag, while one before it separates the port of the registry.
+	if lastColon := strings.LastIndex(repository, ":"); lastColon > strings.LastIndex(repository, "/") {
+		repository = repository[:lastColon]
+	}
+
+	return repository + "@" + digest
+}
+
+// GetJobGVR returns Job's GroupVersionResource which could be used for Clean function.
+func GetJobGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group: "batch", Version: "v1", Resource: "jobs",
+	}
+}
@@ -0,0 +1,233 @@
+package imagebuild
+
+import (
+	"testing"
+	"time"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common/testhelper"
+	"github.com/stretchr/testify/assert"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var jobGVK = batchv1.SchemeGroupVersion.WithKind("Job")
+
+func TestNewBuildahJobBuilder(t *testing.T) {
+	t.Parallel()
+
+	testhelper.NewNamespacedBuilderTestConfig[batchv1.Job, BuildahJobBuilder](
+		func(apiClient *clients.Settings, name, nsname string) *BuildahJobBuilder {
+			return NewBuildahJobBuilder(apiClient, name, nsname, defaultDockerfile, defaultPullSpec)
+		}, batchv1.AddToScheme, jobGVK).ExecuteTests(t)
+}
+
+func TestNewBuildahJobBuilderParams(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		dockerfile    string
+		pullspec      string
+		expectedError string
+	}{
+		{
+			dockerfile: defaultDockerfile,
+			pullspec:   defaultPullSpec,
+		},
+		{
+			dockerfile:    "",
+			pullspec:      defaultPullSpec,
+			expectedError: "buildah job 'dockerfile' cannot be empty",
+		},
+		{
+			dockerfile:    defaultDockerfile,
+			pullspec:      "",
+			expectedError: "buildah job 'pullspec' cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := NewBuildahJobBuilder(buildTestClientWithJob(nil), defaultBuildName, defaultBuildNamespace,
+			testCase.dockerfile, testCase.pullspec)
+
+		if testCase.expectedError != "" {
+			assert.EqualError(t, testBuilder.GetError(), testCase.expectedError)
+
+			continue
+		}
+
+		assert.NoError(t, testBuilder.GetError())
+		assert.Equal(t, int32(0), *testBuilder.Definition.Spec.BackoffLimit)
+
+		container := testBuilder.container()
+		assert.Equal(t, DefaultBuildahImage, container.Image)
+		assert.True(t, *container.SecurityContext.Privileged)
+		assert.Equal(t, testCase.dockerfile, getEnvVar(container, "DOCKERFILE"))
+		assert.Equal(t, testCase.pullspec, getEnvVar(container, "IMAGE"))
+		assert.Equal(t, "true", getEnvVar(container, "TLS_VERIFY"))
+	}
+}
+
+func TestPullBuildahJob(t *testing.T) {
+	t.Parallel()
+
+	testhelper.NewNamespacedPullTestConfig[batchv1.Job, BuildahJobBuilder](
+		PullBuildahJob, batchv1.AddToScheme, jobGVK).ExecuteTests(t)
+}
+
+func TestBuildahJobBuilderMethods(t *testing.T) {
+	t.Parallel()
+
+	commonConfig := testhelper.NewCommonTestConfig[batchv1.Job, BuildahJobBuilder](
+		batchv1.AddToScheme, jobGVK, testhelper.ResourceScopeNamespaced)
+
+	testhelper.NewTestSuite().
+		With(testhelper.NewGetTestConfig(commonConfig)).
+		With(testhelper.NewExistsTestConfig(commonConfig)).
+		With(testhelper.NewCreateTestConfig(commonConfig)).
+		With(testhelper.NewDeleterTestConfig(commonConfig)).
+		Run(t)
+}
+
+func TestBuildahJobBuilderWithImage(t *testing.T) {
+	t.Parallel()
+
+	testBuilder := newTestBuildahJobBuilder().WithImage("")
+	assert.EqualError(t, testBuilder.GetError(), "buildah job 'image' cannot be empty")
+
+	testBuilder = newTestBuildahJobBuilder().WithImage("mirror.example.com/buildah/stable:latest")
+	assert.NoError(t, testBuilder.GetError())
+	assert.Equal(t, "mirror.example.com/buildah/stable:latest", testBuilder.container().Image)
+}
+
+func TestBuildahJobBuilderWithBuildArgs(t *testing.T) {
+	t.Parallel()
+
+	testBuilder := newTestBuildahJobBuilder().WithBuildArgs(nil)
+	assert.EqualError(t, testBuilder.GetError(), "buildah job 'buildArgs' cannot be empty")
+
+	testBuilder = newTestBuildahJobBuilder().
+		WithBuildArgs(map[string]string{"OLD": "value"}).
+		WithBuildArgs(map[string]string{"MOD_NAME": "kmm-ci", "KERNEL_VERSION": "5.14"})
+	assert.NoError(t, testBuilder.GetError())
+	assert.Equal(t, []string{"--build-arg", "KERNEL_VERSION=5.14", "--build-arg", "MOD_NAME=kmm-ci"},
+		testBuilder.container().Command[4:])
+}
+
+func TestBuildahJobBuilderWithAuthSecret(t *testing.T) {
+	t.Parallel()
+
+	testBuilder := newTestBuildahJobBuilder().WithAuthSecret("")
+	assert.EqualError(t, testBuilder.GetError(), "buildah job 'authSecret' cannot be empty")
+
+	testBuilder = newTestBuildahJobBuilder().WithAuthSecret("registry-secret")
+	assert.NoError(t, testBuilder.GetError())
+	assert.Equal(t, "registry-secret", testBuilder.Definition.Spec.Template.Spec.Volumes[0].Secret.SecretName)
+	assert.Equal(t, buildahAuthMountPath, testBuilder.container().VolumeMounts[0].MountPath)
+	assert.Equal(t, buildahAuthMountPath+"/.dockerconfigjson", getEnvVar(testBuilder.container(), "REGISTRY_AUTH_FILE"))
+}
+
+func TestBuildahJobBuilderWithTLSVerify(t *testing.T) {
+	t.Parallel()
+
+	testBuilder := newTestBuildahJobBuilder().WithTLSVerify(false)
+	assert.NoError(t, testBuilder.GetError())
+	assert.Equal(t, "false", getEnvVar(testBuilder.container(), "TLS_VERIFY"))
+}
+
+func TestBuildahJobBuilderWaitUntilComplete(t *testing.T) {
+	t.Parallel()
+
+	completed := batchv1.JobCondition{Type: batchv1.JobComplete, Status: corev1.ConditionTrue}
+
+	testCases := []struct {
+		conditions       []batchv1.JobCondition
+		podMessage       string
+		expectedPullSpec string
+		expectedError    string
+	}{
+		{
+			conditions:       []batchv1.JobCondition{completed},
+			podMessage:       defaultDigest + "\n",
+			expectedPullSpec: "registry.example.com:5000/kmm/kmod@" + defaultDigest,
+		},
+		{
+			conditions: []batchv1.JobCondition{completed},
+			podMessage: "",
+			expectedError: "buildah job " + defaultBuildName + " in namespace " + defaultBuildNamespace +
+				" has no successful pod reporting an image digest",
+		},
+		{
+			conditions: []batchv1.JobCondition{{
+				Type: batchv1.JobFailed, Status: corev1.ConditionTrue, Message: "Job has reached the specified backoff limit",
+			}},
+			expectedError: "buildah job " + defaultBuildName + " in namespace " + defaultBuildNamespace +
+				" failed: Job has reached the specified backoff limit",
+		},
+		{
+			conditions:    nil,
+			expectedError: "context deadline exceeded",
+		},
+	}
+
+	for _, testCase := range testCases {
+		job := buildDummyJob()
+		job.Status.Conditions = testCase.conditions
+
+		testClient := buildTestClientWithJob([]runtime.Object{job, buildDummyJobPod(testCase.podMessage)})
+		testBuilder := NewBuildahJobBuilder(
+			testClient, defaultBuildName, defaultBuildNamespace, defaultDockerfile, defaultPullSpec)
+
+		pullSpec, err := testBuilder.WaitUntilComplete(time.Second)
+
+		if testCase.expectedError != "" {
+			assert.ErrorContains(t, err, testCase.expectedError)
+
+			continue
+		}
+
+		assert.NoError(t, err)
+		assert.Equal(t, testCase.expectedPullSpec, pullSpec)
+	}
+}
+
+func newTestBuildahJobBuilder() *BuildahJobBuilder {
+	return NewBuildahJobBuilder(
+		buildTestClientWithJob(nil), defaultBuildName, defaultBuildNamespace, defaultDockerfile, defaultPullSpec)
+}
+
+func buildDummyJob() *batchv1.Job {
+	return &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      defaultBuildName,
+			Namespace: defaultBuildNamespace,
+		},
+	}
+}
+
+func buildDummyJobPod(message string) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      defaultBuildName + "-abcde",
+			Namespace: defaultBuildNamespace,
+			Labels:    map[string]string{batchv1.JobNameLabel: defaultBuildName},
+		},
+		Status: corev1.PodStatus{
+			ContainerStatuses: []corev1.ContainerStatus{{
+				Name: buildahContainerName,
+				State: corev1.ContainerState{
+					Terminated: &corev1.ContainerStateTerminated{ExitCode: 0, Message: message},
+				},
+			}},
+		},
+	}
+}
+
+func buildTestClientWithJob(objects []runtime.Object) *clients.Settings {
+	return clients.GetTestClients(clients.TestClientParams{
+		K8sMockObjects:  objects,
+		SchemeAttachers: []clients.SchemeAttacher{batchv1.AddToScheme},
+	})
+}
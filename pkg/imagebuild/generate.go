@@ -0,0 +1,3 @@
+package imagebuild
+
+//go:generate go run ../../internal/listgen -builder Builder
@@ -0,0 +1,216 @@
+package imageregistry
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	imageregistryv1 "github.com/openshift/api/imageregistry/v1"
+	operatorv1 "github.com/openshift/api/operator/v1"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
+)
+
+const (
+	// ImagePrunerName is the name of the singleton ImagePruner managed by the imageRegistry operator.
+	ImagePrunerName = "cluster"
+	// ImagePrunerConditionScheduled is the ImagePruner condition reporting whether the pruning job is scheduled.
+	ImagePrunerConditionScheduled = "Scheduled"
+	// ImagePrunerConditionFailed is the ImagePruner condition reporting whether the last pruning job failed.
+	ImagePrunerConditionFailed = "Failed"
+)
+
+// ImagePrunerBuilder provides a struct for the ImagePruner resource containing a connection to the cluster and the
+// ImagePruner definition.
+type ImagePrunerBuilder struct {
+	common.EmbeddableBuilder[imageregistryv1.ImagePruner, *imageregistryv1.ImagePruner]
+	common.EmbeddableCreator[imageregistryv1.ImagePruner, ImagePrunerBuilder,
+		*imageregistryv1.ImagePruner, *ImagePrunerBuilder]
+	common.EmbeddableDeleter[imageregistryv1.ImagePruner, *imageregistryv1.ImagePruner]
+	common.EmbeddableUpdater[imageregistryv1.ImagePruner, ImagePrunerBuilder,
+		*imageregistryv1.ImagePruner, *ImagePrunerBuilder]
+}
+
+// AttachMixins wires the embedded CRUD mixins to this builder instance.
+func (builder *ImagePrunerBuilder) AttachMixins() {
+	builder.EmbeddableCreator.SetBase(builder)
+	builder.EmbeddableDeleter.SetBase(builder)
+	builder.EmbeddableUpdater.SetBase(builder)
+}
+
+// GetGVK returns the ImagePruner GVK for this builder.
+func (builder *ImagePrunerBuilder) GetGVK() schema.GroupVersionKind {
+	return imageregistryv1.GroupVersion.WithKind("ImagePruner")
+}
+
+// NewImagePrunerBuilder creates a new instance of ImagePrunerBuilder. The operator only reconciles the ImagePruner
+// named cluster, see ImagePrunerName. The schedule uses cron syntax; an empty schedule runs the pruner daily.
+func NewImagePrunerBuilder(apiClient *clients.Settings, name, schedule string) *ImagePrunerBuilder {
+	klog.V(100).Infof(
+		"Initializing new ImagePruner structure with the following params: name: %s, schedule: %s", name, schedule)
+
+	builder := common.NewClusterScopedBuilder[imageregistryv1.ImagePruner, ImagePrunerBuilder](
+		apiClient, imageregistryv1.Install, name)
+	if builder.GetError() != nil {
+		return builder
+	}
+
+	builder.Definition.Spec.Schedule = schedule
+
+	return builder
+}
+
+// PullImagePruner pulls an existing ImagePruner from the cluster.
+func PullImagePruner(apiClient *clients.Settings, name string) (*ImagePrunerBuilder, error) {
+	klog.V(100).Infof("Pulling existing ImagePruner %s from cluster", name)
+
+	return common.PullClusterScopedBuilder[imageregistryv1.ImagePruner, ImagePrunerBuilder](
+		context.TODO(), apiClient, imageregistryv1.Install, name)
+}
+
+// WithSchedule sets the cron schedule on which the pruning job runs.
+func (builder *ImagePrunerBuilder) WithSchedule(schedule string) *ImagePrunerBuilder {
+	if err := common.Validate(builder); err != nil {
+		return builder
+	}
+
+	klog.V(100).Infof("Setting schedule of ImagePruner %s to %q", builder.Definition.Name, schedule)
+
+	builder.Definition.Spec.Schedule = schedule
+
+	return builder
+}
+
+// WithSuspend sets whether the pruning job is suspended.
+func (builder *ImagePrunerBuilder) WithSuspend(suspend bool) *ImagePrunerBuilder {
+	if err := common.Validate(builder); err != nil {
+		return builder
+	}
+
+	klog.V(100).Infof("Setting suspend of ImagePruner %s to %t", builder.Definition.Name, suspend)
+
+	builder.Definition.Spec.Suspend = ptr.To(suspend)
+
+	return builder
+}
+
+// WithKeepTagRevisions sets the number of revisions per tag to keep.
+func (builder *ImagePrunerBuilder) WithKeepTagRevisions(revisions int) *ImagePrunerBuilder {
+	if err := common.Validate(builder); err != nil {
+		return builder
+	}
+
+	klog.V(100).Infof("Setting keepTagRevisions of ImagePruner %s to %d", builder.Definition.Name, revisions)
+
+	if revisions < 0 {
+		klog.V(100).Infof("The keepTagRevisions %d is negative", revisions)
+
+		builder.SetError(fmt.Errorf("imagepruner 'keepTagRevisions' cannot be negative"))
+
+		return builder
+	}
+
+	builder.Definition.Spec.KeepTagRevisions = ptr.To(revisions)
+
+	return builder
+}
+
+// WithKeepYoungerThan sets the minimum age of images and image streams for them to be pruned.
+func (builder *ImagePrunerBuilder) WithKeepYoungerThan(duration time.Duration) *ImagePrunerBuilder {
+	if err := common.Validate(builder); err != nil {
+		return builder
+	}
+
+	klog.V(100).Infof("Setting keepYoungerThanDuration of ImagePruner %s to %s", builder.Definition.Name, duration)
+
+	if duration < 0 {
+		klog.V(100).Infof("The keepYoungerThanDuration %s is negative", duration)
+
+		builder.SetError(fmt.Errorf("imagepruner 'keepYoungerThanDuration' cannot be negative"))
+
+		return builder
+	}
+
+	builder.Definition.Spec.KeepYoungerThanDuration = &metav1.Duration{Duration: duration}
+
+	return builder
+}
+
+// WithIgnoreInvalidImageReferences sets whether the pruner ignores errors while parsing image references.
+func (builder *ImagePrunerBuilder) WithIgnoreInvalidImageReferences(ignore bool) *ImagePrunerBuilder {
+	if err := common.Validate(builder); err != nil {
+		return builder
+	}
+
+	klog.V(100).Infof("Setting ignoreInvalidImageReferences of ImagePruner %s to %t", builder.Definition.Name, ignore)
+
+	builder.Definition.Spec.IgnoreInvalidImageReferences = ignore
+
+	return builder
+}
+
+// WaitForRollout waits up to timeout for the operator to observe the latest generation of the ImagePruner and report
+// it as Available. When the pruner is not suspended, the pruning job must also be Scheduled.
+func (builder *ImagePrunerBuilder) WaitForRollout(timeout time.Duration) (*ImagePrunerBuilder, error) {
+	if err := common.Validate(builder); err != nil {
+		return builder, err
+	}
+
+	klog.V(100).Infof("Waiting up to %s for ImagePruner %s to roll out", timeout, builder.Definition.Name)
+
+	err := wait.PollUntilContextTimeout(
+		context.TODO(), time.Second, timeout, true, func(ctx context.Context) (bool, error) {
+			object, err := builder.Get()
+			if err != nil {
+				klog.V(100).Infof("Failed to get ImagePruner %s: %v", builder.Definition.Name, err)
+
+				return false, nil
+			}
+
+			builder.Object = object
+
+			if object.Status.ObservedGeneration < object.Generation {
+				klog.V(100).Infof("ImagePruner %s has not observed generation %d yet",
+					builder.Definition.Name, object.Generation)
+
+				return false, nil
+			}
+
+			if !hasOperatorCondition(object.Status.Conditions,
+				operatorv1.OperatorStatusTypeAvailable, operatorv1.ConditionTrue) {
+				return false, nil
+			}
+
+			if ptr.Deref(object.Spec.Suspend, false) {
+				return true, nil
+			}
+
+			return hasOperatorCondition(object.Status.Conditions, ImagePrunerConditionScheduled, operatorv1.ConditionTrue), nil
+		})
+
+	return builder, err
+}
+
+// hasOperatorCondition returns true if conditions contains a condition of the provided type with the provided status.
+func hasOperatorCondition(
+	conditions []operatorv1.OperatorCondition, conditionType string, status operatorv1.ConditionStatus) bool {
+	for _, condition := range conditions {
+		if condition.Type == conditionType {
+			return condition.Status == status
+		}
+	}
+
+	return false
+}
+
+// GetImagePrunerGVR returns ImagePruner's GroupVersionResource which could be used for Clean function.
+func GetImagePrunerGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group: "imageregistry.operator.openshift.io", Version: "v1", Resource: "imagepruners",
+	}
+}
@@ -0,0 +1,244 @@
+package imageregistry
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	imageregistryv1 "github.com/openshift/api/imageregistry/v1"
+	operatorv1 "github.com/openshift/api/operator/v1"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common/testhelper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+)
+
+const defaultImagePrunerSchedule = "0 0 * * *"
+
+var imagePrunerGVK = imageregistryv1.GroupVersion.WithKind("ImagePruner")
+
+func TestNewImagePrunerBuilder(t *testing.T) {
+	t.Parallel()
+
+	t.Run("common cluster-scoped builder behavior", func(t *testing.T) {
+		t.Parallel()
+
+		testhelper.NewClusterScopedBuilderTestConfig(
+			func(apiClient *clients.Settings, name string) *ImagePrunerBuilder {
+				return NewImagePrunerBuilder(apiClient, name, defaultImagePrunerSchedule)
+			},
+			imageregistryv1.Install,
+			imagePrunerGVK,
+		).ExecuteTests(t)
+	})
+
+	t.Run("schedule is set", func(t *testing.T) {
+		t.Parallel()
+
+		testBuilder := buildValidImagePrunerTestBuilder(clients.GetTestClients(clients.TestClientParams{}))
+
+		require.NoError(t, testBuilder.GetError())
+		assert.Equal(t, defaultImagePrunerSchedule, testBuilder.Definition.Spec.Schedule)
+	})
+}
+
+func TestPullImagePruner(t *testing.T) {
+	t.Parallel()
+
+	testhelper.NewClusterScopedPullTestConfig(PullImagePruner, imageregistryv1.Install, imagePrunerGVK).ExecuteTests(t)
+}
+
+func TestImagePrunerMethods(t *testing.T) {
+	t.Parallel()
+
+	commonTestConfig := testhelper.NewCommonTestConfig[imageregistryv1.ImagePruner, ImagePrunerBuilder](
+		imageregistryv1.Install,
+		imagePrunerGVK,
+		testhelper.ResourceScopeClusterScoped,
+	)
+
+	testhelper.NewTestSuite().
+		With(testhelper.NewGetTestConfig(commonTestConfig)).
+		With(testhelper.NewExistsTestConfig(commonTestConfig)).
+		With(testhelper.NewCreateTestConfig(commonTestConfig)).
+		With(testhelper.NewDeleterTestConfig(commonTestConfig)).
+		With(testhelper.NewUpdateTestConfig(commonTestConfig)).
+		Run(t)
+}
+
+func TestImagePrunerWithOptions(t *testing.T) {
+	t.Parallel()
+
+	testBuilder := buildValidImagePrunerTestBuilder(clients.GetTestClients(clients.TestClientParams{})).
+		WithSchedule("*/5 * * * *").
+		WithSuspend(true).
+		WithKeepTagRevisions(3).
+		WithKeepYoungerThan(time.Hour).
+		WithIgnoreInvalidImageReferences(true)
+
+	assert.NoError(t, testBuilder.GetError())
+	assert.Equal(t, "*/5 * * * *", testBuilder.Definition.Spec.Schedule)
+	assert.Equal(t, ptr.To(true), testBuilder.Definition.Spec.Suspend)
+	assert.Equal(t, ptr.To(3), testBuilder.Definition.Spec.KeepTagRevisions)
+	assert.Equal(t, &metav1.Duration{Duration: time.Hour}, testBuilder.Definition.Spec.KeepYoungerThanDuration)
+	assert.True(t, testBuilder.Definition.Spec.IgnoreInvalidImageReferences)
+
+	testBuilder = buildValidImagePrunerTestBuilder(clients.GetTestClients(clients.TestClientParams{})).
+		WithKeepTagRevisions(-1)
+	assert.EqualError(t, testBuilder.GetError(), "imagepruner 'keepTagRevisions' cannot be negative")
+
+	testBuilder = buildValidImagePrunerTestBuilder(clients.GetTestClients(clients.TestClientParams{})).
+		WithKeepYoungerThan(-time.Hour)
+	assert.EqualError(t, testBuilder.GetError(), "imagepruner 'keepYoungerThanDuration' cannot be negative")
+}
+
+func TestImagePrunerWithMethods(t *testing.T) {
+	t.Parallel()
+
+	commonTestConfig := testhelper.NewCommonTestConfig[imageregistryv1.ImagePruner, ImagePrunerBuilder](
+		imageregistryv1.Install,
+		imagePrunerGVK,
+		testhelper.ResourceScopeClusterScoped,
+	)
+
+	type testCase = testhelper.WithMethodTestCase[*imageregistryv1.ImagePruner, *ImagePrunerBuilder]
+
+	testhelper.NewTestSuite().
+		With(testhelper.NewWithMethodTestConfig(commonTestConfig, "WithSchedule", testCase{
+			Name: "valid schedule",
+			Call: func(builder *ImagePrunerBuilder) *ImagePrunerBuilder { return builder.WithSchedule("*/5 * * * *") },
+			AssertDefinition: func(t *testing.T, definition *imageregistryv1.ImagePruner) {
+				assert.Equal(t, "*/5 * * * *", definition.Spec.Schedule)
+			},
+		})).
+		With(testhelper.NewWithMethodTestConfig(commonTestConfig, "WithSuspend", testCase{
+			Name: "suspend",
+			Call: func(builder *ImagePrunerBuilder) *ImagePrunerBuilder { return builder.WithSuspend(true) },
+			AssertDefinition: func(t *testing.T, definition *imageregistryv1.ImagePruner) {
+				assert.Equal(t, ptr.To(true), definition.Spec.Suspend)
+			},
+		})).
+		With(testhelper.NewWithMethodTestConfig(commonTestConfig, "WithKeepTagRevisions", testCase{
+			Name: "valid revisions",
+			Call: func(builder *ImagePrunerBuilder) *ImagePrunerBuilder { return builder.WithKeepTagRevisions(3) },
+			AssertDefinition: func(t *testing.T, definition *imageregistryv1.ImagePruner) {
+				assert.Equal(t, ptr.To(3), definition.Spec.KeepTagRevisions)
+			},
+		}, testCase{
+			Name:        "negative revisions",
+			Call:        func(builder *ImagePrunerBuilder) *ImagePrunerBuilder { return builder.WithKeepTagRevisions(-1) },
+			AssertError: testhelper.HasErrorMessage("imagepruner 'keepTagRevisions' cannot be negative"),
+		})).
+		With(testhelper.NewWithMethodTestConfig(commonTestConfig, "WithKeepYoungerThan", testCase{
+			Name: "valid duration",
+			Call: func(builder *ImagePrunerBuilder) *ImagePrunerBuilder { return builder.WithKeepYoungerThan(time.Hour) },
+			AssertDefinition: func(t *testing.T, definition *imageregistryv1.ImagePruner) {
+				assert.Equal(t, &metav1.Duration{Duration: time.Hour}, definition.Spec.KeepYoungerThanDuration)
+			},
+		}, testCase{
+			Name: "negative duration",
+			Call: func(builder *ImagePrunerBuilder) *ImagePrunerBuilder {
+				return builder.WithKeepYoungerThan(-time.Hour)
+			},
+			AssertError: testhelper.HasErrorMessage("imagepruner 'keepYoungerThanDuration' cannot be negative"),
+		})).
+		With(testhelper.NewWithMethodTestConfig(commonTestConfig, "WithIgnoreInvalidImageReferences", testCase{
+			Name: "ignore invalid references",
+			Call: func(builder *ImagePrunerBuilder) *ImagePrunerBuilder {
+				return builder.WithIgnoreInvalidImageReferences(true)
+			},
+			AssertDefinition: func(t *testing.T, definition *imageregistryv1.ImagePruner) {
+				assert.True(t, definition.Spec.IgnoreInvalidImageReferences)
+			},
+		})).
+		Run(t)
+}
+
+func TestImagePrunerWaitForRollout(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name               string
+		suspend            bool
+		observedGeneration int64
+		conditions         []operatorv1.OperatorCondition
+		expectedError      error
+	}{
+		{
+			name:               "available and scheduled",
+			observedGeneration: 1,
+			conditions: []operatorv1.OperatorCondition{
+				{Type: operatorv1.OperatorStatusTypeAvailable, Status: operatorv1.ConditionTrue},
+				{Type: ImagePrunerConditionScheduled, Status: operatorv1.ConditionTrue},
+			},
+		},
+		{
+			name:               "suspended and available",
+			suspend:            true,
+			observedGeneration: 1,
+			conditions: []operatorv1.OperatorCondition{
+				{Type: operatorv1.OperatorStatusTypeAvailable, Status: operatorv1.ConditionTrue},
+			},
+		},
+		{
+			name:               "available but not scheduled",
+			observedGeneration: 1,
+			conditions: []operatorv1.OperatorCondition{
+				{Type: operatorv1.OperatorStatusTypeAvailable, Status: operatorv1.ConditionTrue},
+				{Type: ImagePrunerConditionScheduled, Status: operatorv1.ConditionFalse},
+			},
+			expectedError: context.DeadlineExceeded,
+		},
+		{
+			name:               "generation not observed",
+			observedGeneration: 0,
+			conditions: []operatorv1.OperatorCondition{
+				{Type: operatorv1.OperatorStatusTypeAvailable, Status: operatorv1.ConditionTrue},
+				{Type: ImagePrunerConditionScheduled, Status: operatorv1.ConditionTrue},
+			},
+			expectedError: context.DeadlineExceeded,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			imagePruner := buildDummyImagePruner()
+			imagePruner.Generation = 1
+			imagePruner.Spec.Suspend = ptr.To(testCase.suspend)
+			imagePruner.Status.ObservedGeneration = testCase.observedGeneration
+			imagePruner.Status.Conditions = testCase.conditions
+
+			testBuilder := buildValidImagePrunerTestBuilder(clients.GetTestClients(clients.TestClientParams{
+				K8sMockObjects:  []runtime.Object{imagePruner},
+				SchemeAttachers: testSchemes,
+			}))
+
+			_, err := testBuilder.WaitForRollout(time.Second)
+			if testCase.expectedError == nil {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, testCase.expectedError)
+			}
+		})
+	}
+}
+
+func buildDummyImagePruner() *imageregistryv1.ImagePruner {
+	return &imageregistryv1.ImagePruner{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: ImagePrunerName,
+		},
+		Spec: imageregistryv1.ImagePrunerSpec{
+			Schedule: defaultImagePrunerSchedule,
+		},
+	}
+}
+
+func buildValidImagePrunerTestBuilder(apiClient *clients.Settings) *ImagePrunerBuilder {
+	return NewImagePrunerBuilder(apiClient, ImagePrunerName, defaultImagePrunerSchedule)
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/logging"
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/msg"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/klog/v2"
 	goclient "sigs.k8s.io/controller-runtime/pkg/client"
@@ -193,6 +194,169 @@ func (builder *Builder) WithStorage(expectedStorage imageregistryv1.ImageRegistr
 	return builder
 }
 
+// WithEmptyDirStorage sets the imageRegistry operator's storage to an emptyDir volume. Images are lost when the
+// registry pod restarts, so this is only suitable for test clusters.
+func (builder *Builder) WithEmptyDirStorage() *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	klog.V(100).Infof("Setting imageRegistry %s with emptyDir storage", builder.Definition.Name)
+
+	builder.Definition.Spec.Storage = imageregistryv1.ImageRegistryConfigStorage{
+		EmptyDir: &imageregistryv1.ImageRegistryConfigStorageEmptyDir{},
+	}
+
+	return builder
+}
+
+// WithPVCStorage sets the imageRegistry operator's storage to a PersistentVolumeClaim. If claimName is empty, the
+// operator creates the image-registry-storage claim.
+func (builder *Builder) WithPVCStorage(claimName string) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	klog.V(100).Infof("Setting imageRegistry %s with PVC storage using claim %q", builder.Definition.Name, claimName)
+
+	builder.Definition.Spec.Storage = imageregistryv1.ImageRegistryConfigStorage{
+		PVC: &imageregistryv1.ImageRegistryConfigStoragePVC{
+			Claim: claimName,
+		},
+	}
+
+	return builder
+}
+
+// WithS3Storage sets the imageRegistry operator's storage to an S3 bucket in the provided region. If bucket is empty,
+// the operator generates a bucket name.
+func (builder *Builder) WithS3Storage(bucket, region string) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	klog.V(100).Infof(
+		"Setting imageRegistry %s with S3 storage: bucket: %s, region: %s", builder.Definition.Name, bucket, region)
+
+	if region == "" {
+		klog.V(100).Info("The S3 storage region is empty")
+
+		builder.errorMsg = "imageRegistry S3 storage 'region' cannot be empty"
+
+		return builder
+	}
+
+	builder.Definition.Spec.Storage = imageregistryv1.ImageRegistryConfigStorage{
+		S3: &imageregistryv1.ImageRegistryConfigStorageS3{
+			Bucket: bucket,
+			Region: region,
+		},
+	}
+
+	return builder
+}
+
+// WithDefaultRoute sets whether the imageRegistry operator exposes the registry using a route with the default
+// generated hostname.
+func (builder *Builder) WithDefaultRoute(enabled bool) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	klog.V(100).Infof("Setting imageRegistry %s with defaultRoute: %t", builder.Definition.Name, enabled)
+
+	builder.Definition.Spec.DefaultRoute = enabled
+
+	return builder
+}
+
+// WithRoute adds an additional route exposing the registry. The hostname and secretName are optional; when they are
+// empty the default generated hostname and the default certificate are used.
+func (builder *Builder) WithRoute(name, hostname, secretName string) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	klog.V(100).Infof(
+		"Adding route to imageRegistry %s: name: %s, hostname: %s, secretName: %s",
+		builder.Definition.Name, name, hostname, secretName)
+
+	if name == "" {
+		klog.V(100).Info("The route name is empty")
+
+		builder.errorMsg = "imageRegistry route 'name' cannot be empty"
+
+		return builder
+	}
+
+	builder.Definition.Spec.Routes = append(builder.Definition.Spec.Routes, imageregistryv1.ImageRegistryConfigRoute{
+		Name:       name,
+		Hostname:   hostname,
+		SecretName: secretName,
+	})
+
+	return builder
+}
+
+// WithReplicas sets the number of registry instances to run.
+func (builder *Builder) WithReplicas(replicas int32) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	klog.V(100).Infof("Setting imageRegistry %s with replicas: %d", builder.Definition.Name, replicas)
+
+	if replicas < 1 {
+		klog.V(100).Infof("The replicas %d is less than 1", replicas)
+
+		builder.errorMsg = "imageRegistry 'replicas' cannot be less than 1"
+
+		return builder
+	}
+
+	builder.Definition.Spec.Replicas = replicas
+
+	return builder
+}
+
+// WaitForRollout waits until the imageRegistry operator has observed the latest generation of the Config and reports
+// it as Available, not Progressing, and not Degraded.
+func (builder *Builder) WaitForRollout(timeout time.Duration) (*Builder, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	klog.V(100).Infof("Waiting until imageRegistry %s has rolled out", builder.Definition.Name)
+
+	if !builder.Exists() {
+		return nil, fmt.Errorf("imageRegistry object %s does not exist", builder.Definition.Name)
+	}
+
+	var err error
+
+	err = wait.PollUntilContextTimeout(
+		context.TODO(), time.Second, timeout, true, func(ctx context.Context) (bool, error) {
+			builder.Object, err = builder.Get()
+			if err != nil {
+				return false, nil
+			}
+
+			if builder.Object.Status.ObservedGeneration < builder.Object.Generation {
+				klog.V(100).Infof("imageRegistry %s has not observed generation %d yet",
+					builder.Definition.Name, builder.Object.Generation)
+
+				return false, nil
+			}
+
+			return isOperatorRolledOut(builder.Object.Status.Conditions), nil
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	return builder, nil
+}
+
 // WaitForCondition waits until the imageRegistry has a condition that matches the expected, checking only the Type,
 // Status, Reason, and Message fields. For the messages field, it matches if the message contains the expected. Zero
 // value fields in the expected condition are ignored.
@@ -246,6 +410,42 @@ func (builder *Builder) WaitForCondition(
 	return builder, nil
 }
 
+// isOperatorRolledOut returns true if the conditions report the operand as Available, not Progressing, and not
+// Degraded. Conditions that are missing are treated as not matching.
+func isOperatorRolledOut(conditions []operatorv1.OperatorCondition) bool {
+	expectedStatuses := map[string]operatorv1.ConditionStatus{
+		operatorv1.OperatorStatusTypeAvailable:   operatorv1.ConditionTrue,
+		operatorv1.OperatorStatusTypeProgressing: operatorv1.ConditionFalse,
+		operatorv1.OperatorStatusTypeDegraded:    operatorv1.ConditionFalse,
+	}
+
+	matched := 0
+
+	for _, condition := range conditions {
+		expectedStatus, ok := expectedStatuses[condition.Type]
+		if !ok {
+			continue
+		}
+
+		if condition.Status != expectedStatus {
+			klog.V(100).Infof("Condition %s has status %s, expected %s", condition.Type, condition.Status, expectedStatus)
+
+			return false
+		}
+
+		matched++
+	}
+
+	return matched == len(expectedStatuses)
+}
+
+// GetConfigGVR returns Config's GroupVersionResource which could be used for Clean function.
+func GetConfigGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group: "imageregistry.operator.openshift.io", Version: "v1", Resource: "configs",
+	}
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *Builder) validate() (bool, error) {
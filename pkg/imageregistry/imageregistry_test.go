@@ -445,3 +445,192 @@ func newBuilder(apiClient *clients.Settings, name string, managementState operat
 
 	return builder
 }
+
+func TestImageRegistryWithStorageBackends(t *testing.T) {
+	testCases := []struct {
+		configure         func(builder *Builder) *Builder
+		expectedStorage   imageregistryV1.ImageRegistryConfigStorage
+		expectedErrorText string
+	}{
+		{
+			configure: func(builder *Builder) *Builder { return builder.WithEmptyDirStorage() },
+			expectedStorage: imageregistryV1.ImageRegistryConfigStorage{
+				EmptyDir: &imageregistryV1.ImageRegistryConfigStorageEmptyDir{},
+			},
+		},
+		{
+			configure: func(builder *Builder) *Builder { return builder.WithPVCStorage("registry-claim") },
+			expectedStorage: imageregistryV1.ImageRegistryConfigStorage{
+				PVC: &imageregistryV1.ImageRegistryConfigStoragePVC{Claim: "registry-claim"},
+			},
+		},
+		{
+			configure: func(builder *Builder) *Builder { return builder.WithPVCStorage("") },
+			expectedStorage: imageregistryV1.ImageRegistryConfigStorage{
+				PVC: &imageregistryV1.ImageRegistryConfigStoragePVC{},
+			},
+		},
+		{
+			configure: func(builder *Builder) *Builder { return builder.WithS3Storage("registry-bucket", "us-east-1") },
+			expectedStorage: imageregistryV1.ImageRegistryConfigStorage{
+				S3: &imageregistryV1.ImageRegistryConfigStorageS3{Bucket: "registry-bucket", Region: "us-east-1"},
+			},
+		},
+		{
+			configure:         func(builder *Builder) *Builder { return builder.WithS3Storage("registry-bucket", "") },
+			expectedErrorText: "imageRegistry S3 storage 'region' cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidImageRegistryBuilder(buildImageRegistryClientWithDummyObject())
+
+		result := testCase.configure(testBuilder)
+
+		if testCase.expectedErrorText != "" {
+			assert.Equal(t, testCase.expectedErrorText, result.errorMsg)
+		} else {
+			assert.Empty(t, result.errorMsg)
+			assert.Equal(t, testCase.expectedStorage, result.Definition.Spec.Storage)
+		}
+	}
+}
+
+func TestImageRegistryWithRoutes(t *testing.T) {
+	testCases := []struct {
+		routeName         string
+		hostname          string
+		secretName        string
+		expectedErrorText string
+	}{
+		{
+			routeName: "public-route",
+			hostname:  "registry.example.com",
+		},
+		{
+			routeName:  "secure-route",
+			hostname:   "registry.example.com",
+			secretName: "registry-tls",
+		},
+		{
+			routeName:         "",
+			hostname:          "registry.example.com",
+			expectedErrorText: "imageRegistry route 'name' cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidImageRegistryBuilder(buildImageRegistryClientWithDummyObject())
+
+		result := testBuilder.WithDefaultRoute(true).WithRoute(testCase.routeName, testCase.hostname, testCase.secretName)
+
+		if testCase.expectedErrorText != "" {
+			assert.Equal(t, testCase.expectedErrorText, result.errorMsg)
+		} else {
+			assert.Empty(t, result.errorMsg)
+			assert.True(t, result.Definition.Spec.DefaultRoute)
+			assert.Equal(t, []imageregistryV1.ImageRegistryConfigRoute{{
+				Name:       testCase.routeName,
+				Hostname:   testCase.hostname,
+				SecretName: testCase.secretName,
+			}}, result.Definition.Spec.Routes)
+		}
+	}
+}
+
+func TestImageRegistryWithReplicas(t *testing.T) {
+	testCases := []struct {
+		replicas          int32
+		expectedErrorText string
+	}{
+		{
+			replicas: 2,
+		},
+		{
+			replicas:          0,
+			expectedErrorText: "imageRegistry 'replicas' cannot be less than 1",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidImageRegistryBuilder(buildImageRegistryClientWithDummyObject())
+
+		result := testBuilder.WithReplicas(testCase.replicas)
+
+		if testCase.expectedErrorText != "" {
+			assert.Equal(t, testCase.expectedErrorText, result.errorMsg)
+		} else {
+			assert.Empty(t, result.errorMsg)
+			assert.Equal(t, testCase.replicas, result.Definition.Spec.Replicas)
+		}
+	}
+}
+
+func TestImageRegistryWaitForRollout(t *testing.T) {
+	rolledOutConditions := []operatorV1.OperatorCondition{
+		{Type: operatorV1.OperatorStatusTypeAvailable, Status: operatorV1.ConditionTrue},
+		{Type: operatorV1.OperatorStatusTypeProgressing, Status: operatorV1.ConditionFalse},
+		{Type: operatorV1.OperatorStatusTypeDegraded, Status: operatorV1.ConditionFalse},
+	}
+
+	testCases := []struct {
+		exists             bool
+		observedGeneration int64
+		conditions         []operatorV1.OperatorCondition
+		expectedError      error
+	}{
+		{
+			exists:             true,
+			observedGeneration: 1,
+			conditions:         rolledOutConditions,
+			expectedError:      nil,
+		},
+		{
+			exists:             true,
+			observedGeneration: 0,
+			conditions:         rolledOutConditions,
+			expectedError:      context.DeadlineExceeded,
+		},
+		{
+			exists:             true,
+			observedGeneration: 1,
+			conditions: []operatorV1.OperatorCondition{
+				{Type: operatorV1.OperatorStatusTypeAvailable, Status: operatorV1.ConditionTrue},
+				{Type: operatorV1.OperatorStatusTypeProgressing, Status: operatorV1.ConditionTrue},
+				{Type: operatorV1.OperatorStatusTypeDegraded, Status: operatorV1.ConditionFalse},
+			},
+			expectedError: context.DeadlineExceeded,
+		},
+		{
+			exists:             true,
+			observedGeneration: 1,
+			conditions:         rolledOutConditions[:1],
+			expectedError:      context.DeadlineExceeded,
+		},
+		{
+			exists:        false,
+			expectedError: fmt.Errorf("imageRegistry object %s does not exist", defaultImageRegistryName),
+		},
+	}
+
+	for _, testCase := range testCases {
+		var runtimeObjects []runtime.Object
+
+		if testCase.exists {
+			imageRegistry := buildDummyImageRegistry(defaultImageRegistryName, defaultManagementState)
+			imageRegistry.Generation = 1
+			imageRegistry.Status.ObservedGeneration = testCase.observedGeneration
+			imageRegistry.Status.Conditions = testCase.conditions
+
+			runtimeObjects = append(runtimeObjects, imageRegistry)
+		}
+
+		testBuilder := buildValidImageRegistryBuilder(clients.GetTestClients(clients.TestClientParams{
+			K8sMockObjects:  runtimeObjects,
+			SchemeAttachers: testSchemes,
+		}))
+
+		_, err := testBuilder.WaitForRollout(time.Second)
+		assert.Equal(t, testCase.expectedError, err)
+	}
+}
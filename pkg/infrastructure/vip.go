@@ -0,0 +1,337 @@
+package infrastructure
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"net/netip"
+	"strings"
+	"time"
+
+	configv1 "github.com/openshift/api/config/v1"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/pod"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/klog/v2"
+)
+
+const (
+	// keepalivedPodPrefix is the name prefix of the keepalived static pods, which are named keepalived-<node>.
+	keepalivedPodPrefix = "keepalived-"
+	// keepalivedContainerName is the name of the container running keepalived in the keepalived pods.
+	keepalivedContainerName = "keepalived"
+	// vipPollInterval is how often the VIP holder is inspected while waiting for the VIP to fail over.
+	vipPollInterval = 5 * time.Second
+)
+
+// VIPType is the type of a VIP managed by keepalived on on-prem platforms.
+type VIPType string
+
+const (
+	// VIPTypeAPI is the VIP the internal API load balancer listens on.
+	VIPTypeAPI VIPType = "api"
+	// VIPTypeIngress is the VIP the default ingress controller is reached on.
+	VIPTypeIngress VIPType = "ingress"
+)
+
+// keepalivedNamespaces maps the on-prem platforms using keepalived for their VIPs to the namespace the keepalived pods
+// run in.
+var keepalivedNamespaces = map[configv1.PlatformType]string{
+	configv1.BareMetalPlatformType: "openshift-kni-infra",
+	configv1.OpenStackPlatformType: "openshift-openstack-infra",
+	configv1.VSpherePlatformType:   "openshift-vsphere-infra",
+	configv1.OvirtPlatformType:     "openshift-ovirt-infra",
+	configv1.NutanixPlatformType:   "openshift-nutanix-infra",
+}
+
+// GetVIPs returns the API or Ingress VIPs of the cluster from the platform status. On dual-stack clusters, the primary
+// IP family VIP is listed first. Only on-prem platforms using keepalived have VIPs.
+func (builder *Builder) GetVIPs(vipType VIPType) ([]string, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	klog.V(100).Infof("Getting %s VIPs of infrastructure %s", vipType, builder.Definition.Name)
+
+	infrastructure, err := builder.Get()
+	if err != nil {
+		return nil, err
+	}
+
+	platformStatus := infrastructure.Status.PlatformStatus
+	if platformStatus == nil {
+		klog.V(100).Infof("The infrastructure %s has no platform status", builder.Definition.Name)
+
+		return nil, fmt.Errorf("infrastructure %s has no platform status", builder.Definition.Name)
+	}
+
+	var apiVIPs, ingressVIPs []string
+
+	switch {
+	case platformStatus.BareMetal != nil:
+		apiVIPs, ingressVIPs = platformStatus.BareMetal.APIServerInternalIPs, platformStatus.BareMetal.IngressIPs
+	case platformStatus.OpenStack != nil:
+		apiVIPs, ingressVIPs = platformStatus.OpenStack.APIServerInternalIPs, platformStatus.OpenStack.IngressIPs
+	case platformStatus.VSphere != nil:
+		apiVIPs, ingressVIPs = platformStatus.VSphere.APIServerInternalIPs, platformStatus.VSphere.IngressIPs
+	case platformStatus.Ovirt != nil:
+		apiVIPs, ingressVIPs = platformStatus.Ovirt.APIServerInternalIPs, platformStatus.Ovirt.IngressIPs
+	case platformStatus.Nutanix != nil:
+		apiVIPs, ingressVIPs = platformStatus.Nutanix.APIServerInternalIPs, platformStatus.Nutanix.IngressIPs
+	}
+
+	var vips []string
+
+	switch vipType {
+	case VIPTypeAPI:
+		vips = apiVIPs
+	case VIPTypeIngress:
+		vips = ingressVIPs
+	default:
+		klog.V(100).Infof("The VIP type %s is invalid", vipType)
+
+		return nil, fmt.Errorf("invalid VIP type %q, must be %q or %q", vipType, VIPTypeAPI, VIPTypeIngress)
+	}
+
+	if len(vips) == 0 {
+		klog.V(100).Infof("The infrastructure %s has no %s VIPs on platform %s",
+			builder.Definition.Name, vipType, platformStatus.Type)
+
+		return nil, fmt.Errorf("infrastructure %s has no %s VIPs on platform %s",
+			builder.Definition.Name, vipType, platformStatus.Type)
+	}
+
+	return vips, nil
+}
+
+// GetKeepalivedNamespace returns the namespace the keepalived pods managing the VIPs of the cluster run in.
+func (builder *Builder) GetKeepalivedNamespace() (string, error) {
+	if valid, err := builder.validate(); !valid {
+		return "", err
+	}
+
+	klog.V(100).Infof("Getting keepalived namespace of infrastructure %s", builder.Definition.Name)
+
+	infrastructure, err := builder.Get()
+	if err != nil {
+		return "", err
+	}
+
+	if infrastructure.Status.PlatformStatus == nil {
+		klog.V(100).Infof("The infrastructure %s has no platform status", builder.Definition.Name)
+
+		return "", fmt.Errorf("infrastructure %s has no platform status", builder.Definition.Name)
+	}
+
+	platformType := infrastructure.Status.PlatformStatus.Type
+
+	namespace, ok := keepalivedNamespaces[platformType]
+	if !ok {
+		klog.V(100).Infof("The platform %s does not use keepalived", platformType)
+
+		return "", fmt.Errorf("platform %s does not use keepalived for its VIPs", platformType)
+	}
+
+	return namespace, nil
+}
+
+// KeepalivedVIP provides a struct to inspect which node holds a VIP managed by keepalived.
+type KeepalivedVIP struct {
+	// Address is the VIP address.
+	Address string
+	// Type is whether the VIP is the API or Ingress VIP.
+	Type VIPType
+	// Holder is the node that held the VIP when it was last inspected.
+	Holder string
+	// namespace is the namespace the keepalived pods run in.
+	namespace string
+	// api client to interact with the cluster.
+	apiClient *clients.Settings
+}
+
+// PullKeepalivedVIP loads the first API or Ingress VIP of the cluster and the node currently holding it into a
+// KeepalivedVIP struct. On dual-stack clusters, this is the VIP of the primary IP family.
+func PullKeepalivedVIP(apiClient *clients.Settings, vipType VIPType) (*KeepalivedVIP, error) {
+	klog.V(100).Infof("Pulling keepalived %s VIP", vipType)
+
+	infraBuilder, err := Pull(apiClient)
+	if err != nil {
+		return nil, err
+	}
+
+	vips, err := infraBuilder.GetVIPs(vipType)
+	if err != nil {
+		return nil, err
+	}
+
+	namespace, err := infraBuilder.GetKeepalivedNamespace()
+	if err != nil {
+		return nil, err
+	}
+
+	vip := &KeepalivedVIP{
+		Address:   vips[0],
+		Type:      vipType,
+		namespace: namespace,
+		apiClient: apiClient,
+	}
+
+	_, err = vip.GetHolder()
+	if err != nil {
+		return nil, err
+	}
+
+	return vip, nil
+}
+
+// GetHolder inspects the keepalived pods and returns the node currently holding the VIP. Holder is updated with the
+// result. An error is returned if no node or more than one node holds the VIP.
+func (vip *KeepalivedVIP) GetHolder() (string, error) {
+	if valid, err := vip.validate(); !valid {
+		return "", err
+	}
+
+	klog.V(100).Infof("Getting holder of %s VIP %s", vip.Type, vip.Address)
+
+	holders, err := vip.getHolders()
+	if err != nil {
+		return "", err
+	}
+
+	switch len(holders) {
+	case 0:
+		klog.V(100).Infof("The %s VIP %s is not held by any node", vip.Type, vip.Address)
+
+		return "", fmt.Errorf("%s VIP %s is not held by any node", vip.Type, vip.Address)
+	case 1:
+		vip.Holder = holders[0]
+
+		return vip.Holder, nil
+	default:
+		klog.V(100).Infof("The %s VIP %s is held by more than one node: %v", vip.Type, vip.Address, holders)
+
+		return "", fmt.Errorf("%s VIP %s is held by more than one node: %v", vip.Type, vip.Address, holders)
+	}
+}
+
+// WaitForVIPFailover waits up to timeout for the VIP to move from Holder to a single other node, for example after
+// the holder has been shut down or keepalived has been stopped on it. Holder is updated with the new node.
+func (vip *KeepalivedVIP) WaitForVIPFailover(timeout time.Duration) error {
+	if valid, err := vip.validate(); !valid {
+		return err
+	}
+
+	klog.V(100).Infof("Waiting up to %s for %s VIP %s to fail over from node %s", timeout, vip.Type, vip.Address, vip.Holder)
+
+	if vip.Holder == "" {
+		klog.V(100).Infof("The %s VIP %s has no known holder", vip.Type, vip.Address)
+
+		return fmt.Errorf("cannot wait for %s VIP %s to fail over without a known holder", vip.Type, vip.Address)
+	}
+
+	previousHolder := vip.Holder
+
+	err := wait.PollUntilContextTimeout(
+		context.TODO(), vipPollInterval, timeout, true, func(ctx context.Context) (bool, error) {
+			holder, err := vip.GetHolder()
+			if err != nil {
+				klog.V(100).Infof("Failed to get holder of %s VIP %s: %v", vip.Type, vip.Address, err)
+
+				return false, nil
+			}
+
+			return holder != previousHolder, nil
+		})
+	if err != nil {
+		vip.Holder = previousHolder
+
+		return fmt.Errorf("%s VIP %s did not fail over from node %s: %w", vip.Type, vip.Address, previousHolder, err)
+	}
+
+	klog.V(100).Infof("The %s VIP %s failed over from node %s to node %s", vip.Type, vip.Address, previousHolder, vip.Holder)
+
+	return nil
+}
+
+// getHolders returns the nodes of the running keepalived pods that have the VIP assigned to one of their interfaces.
+// Pods that cannot be inspected, such as those on a node that is shutting down, are skipped.
+func (vip *KeepalivedVIP) getHolders() ([]string, error) {
+	keepalivedPods, err := pod.ListByNamePattern(vip.apiClient, keepalivedPodPrefix, vip.namespace)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list keepalived pods in namespace %s: %w", vip.namespace, err)
+	}
+
+	var holders []string
+
+	for _, keepalivedPod := range keepalivedPods {
+		if !strings.HasPrefix(keepalivedPod.Object.Name, keepalivedPodPrefix) ||
+			keepalivedPod.Object.Status.Phase != corev1.PodRunning {
+			continue
+		}
+
+		output, err := keepalivedPod.ExecCommand([]string{"ip", "-o", "addr", "show"}, keepalivedContainerName)
+		if err != nil {
+			klog.V(100).Infof("Failed to inspect addresses of keepalived pod %s: %v", keepalivedPod.Object.Name, err)
+
+			continue
+		}
+
+		if hasIPAddress(output.String(), vip.Address) {
+			holders = append(holders, keepalivedPod.Object.Spec.NodeName)
+		}
+	}
+
+	return holders, nil
+}
+
+// validate will check that the KeepalivedVIP is properly initialized before accessing any member fields.
+func (vip *KeepalivedVIP) validate() (bool, error) {
+	if vip == nil {
+		klog.V(100).Info("The KeepalivedVIP is uninitialized")
+
+		return false, fmt.Errorf("error: received nil KeepalivedVIP")
+	}
+
+	if vip.apiClient == nil {
+		klog.V(100).Info("The KeepalivedVIP apiclient is nil")
+
+		return false, fmt.Errorf("KeepalivedVIP cannot have nil apiClient")
+	}
+
+	if vip.Address == "" {
+		klog.V(100).Info("The KeepalivedVIP address is empty")
+
+		return false, fmt.Errorf("KeepalivedVIP 'Address' cannot be empty")
+	}
+
+	return true, nil
+}
+
+// hasIPAddress returns whether the output of ip -o addr show contains address. Addresses are compared after parsing so
+// different notations of the same IPv6 address match.
+func hasIPAddress(output, address string) bool {
+	target, err := netip.ParseAddr(address)
+	if err != nil {
+		return false
+	}
+
+	scanner := bufio.NewScanner(strings.NewReader(output))
+
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+
+		for index := 0; index < len(fields)-1; index++ {
+			if fields[index] != "inet" && fields[index] != "inet6" {
+				continue
+			}
+
+			prefix, err := netip.ParsePrefix(fields[index+1])
+			if err == nil && prefix.Addr() == target {
+				return true
+			}
+		}
+	}
+
+	return false
+}
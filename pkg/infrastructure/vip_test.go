@@ -0,0 +1,277 @@
+package infrastructure
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	configv1 "github.com/openshift/api/config/v1"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	defaultAPIVIP     = "192.168.111.5"
+	defaultIngressVIP = "192.168.111.4"
+	defaultAPIVIPv6   = "fd2e:6f44:5dd8:c956::5"
+)
+
+func TestInfrastructureGetVIPs(t *testing.T) {
+	testCases := []struct {
+		platformStatus *configv1.PlatformStatus
+		vipType        VIPType
+		expectedVIPs   []string
+		expectedError  error
+	}{
+		{
+			platformStatus: buildDummyBareMetalPlatformStatus(),
+			vipType:        VIPTypeAPI,
+			expectedVIPs:   []string{defaultAPIVIP, defaultAPIVIPv6},
+			expectedError:  nil,
+		},
+		{
+			platformStatus: buildDummyBareMetalPlatformStatus(),
+			vipType:        VIPTypeIngress,
+			expectedVIPs:   []string{defaultIngressVIP},
+			expectedError:  nil,
+		},
+		{
+			platformStatus: buildDummyBareMetalPlatformStatus(),
+			vipType:        "invalid",
+			expectedError:  fmt.Errorf("invalid VIP type \"invalid\", must be \"api\" or \"ingress\""),
+		},
+		{
+			platformStatus: &configv1.PlatformStatus{Type: configv1.NonePlatformType},
+			vipType:        VIPTypeAPI,
+			expectedError:  fmt.Errorf("infrastructure cluster has no api VIPs on platform None"),
+		},
+		{
+			platformStatus: nil,
+			vipType:        VIPTypeAPI,
+			expectedError:  fmt.Errorf("infrastructure cluster has no platform status"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := newInfrastructureBuilder(buildTestClientWithPlatformStatus(testCase.platformStatus))
+
+		vips, err := testBuilder.GetVIPs(testCase.vipType)
+		assert.Equal(t, testCase.expectedError, err)
+		assert.Equal(t, testCase.expectedVIPs, vips)
+	}
+}
+
+func TestInfrastructureGetKeepalivedNamespace(t *testing.T) {
+	testCases := []struct {
+		platformStatus    *configv1.PlatformStatus
+		expectedNamespace string
+		expectedError     error
+	}{
+		{
+			platformStatus:    buildDummyBareMetalPlatformStatus(),
+			expectedNamespace: "openshift-kni-infra",
+			expectedError:     nil,
+		},
+		{
+			platformStatus:    &configv1.PlatformStatus{Type: configv1.VSpherePlatformType},
+			expectedNamespace: "openshift-vsphere-infra",
+			expectedError:     nil,
+		},
+		{
+			platformStatus: &configv1.PlatformStatus{Type: configv1.AWSPlatformType},
+			expectedError:  fmt.Errorf("platform AWS does not use keepalived for its VIPs"),
+		},
+		{
+			platformStatus: nil,
+			expectedError:  fmt.Errorf("infrastructure cluster has no platform status"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := newInfrastructureBuilder(buildTestClientWithPlatformStatus(testCase.platformStatus))
+
+		namespace, err := testBuilder.GetKeepalivedNamespace()
+		assert.Equal(t, testCase.expectedError, err)
+		assert.Equal(t, testCase.expectedNamespace, namespace)
+	}
+}
+
+func TestPullKeepalivedVIP(t *testing.T) {
+	testCases := []struct {
+		platformStatus *configv1.PlatformStatus
+		client         bool
+		expectedError  error
+	}{
+		{
+			platformStatus: buildDummyBareMetalPlatformStatus(),
+			client:         true,
+			expectedError:  fmt.Errorf("api VIP %s is not held by any node", defaultAPIVIP),
+		},
+		{
+			platformStatus: &configv1.PlatformStatus{Type: configv1.AWSPlatformType},
+			client:         true,
+			expectedError:  fmt.Errorf("infrastructure cluster has no api VIPs on platform AWS"),
+		},
+		{
+			platformStatus: buildDummyBareMetalPlatformStatus(),
+			client:         false,
+			expectedError:  fmt.Errorf("infrastructure 'apiClient' cannot be nil"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		var testSettings *clients.Settings
+
+		if testCase.client {
+			testSettings = buildTestClientWithPlatformStatus(testCase.platformStatus, buildDummyKeepalivedPod())
+		}
+
+		vip, err := PullKeepalivedVIP(testSettings, VIPTypeAPI)
+		assert.Equal(t, testCase.expectedError, err)
+		assert.Nil(t, vip)
+	}
+}
+
+func TestKeepalivedVIPGetHolder(t *testing.T) {
+	testCases := []struct {
+		testVIP       *KeepalivedVIP
+		expectedError error
+	}{
+		{
+			testVIP:       buildValidKeepalivedVIP(),
+			expectedError: fmt.Errorf("api VIP %s is not held by any node", defaultAPIVIP),
+		},
+		{
+			testVIP:       nil,
+			expectedError: fmt.Errorf("error: received nil KeepalivedVIP"),
+		},
+		{
+			testVIP:       &KeepalivedVIP{Address: defaultAPIVIP},
+			expectedError: fmt.Errorf("KeepalivedVIP cannot have nil apiClient"),
+		},
+		{
+			testVIP:       &KeepalivedVIP{apiClient: clients.GetTestClients(clients.TestClientParams{})},
+			expectedError: fmt.Errorf("KeepalivedVIP 'Address' cannot be empty"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		holder, err := testCase.testVIP.GetHolder()
+		assert.Equal(t, testCase.expectedError, err)
+		assert.Empty(t, holder)
+	}
+}
+
+func TestKeepalivedVIPWaitForVIPFailover(t *testing.T) {
+	testCases := []struct {
+		holder        string
+		expectedError string
+	}{
+		{
+			holder:        "",
+			expectedError: fmt.Sprintf("cannot wait for api VIP %s to fail over without a known holder", defaultAPIVIP),
+		},
+		{
+			holder: "master-0",
+			expectedError: fmt.Sprintf(
+				"api VIP %s did not fail over from node master-0: context deadline exceeded", defaultAPIVIP),
+		},
+	}
+
+	for _, testCase := range testCases {
+		testVIP := buildValidKeepalivedVIP()
+		testVIP.Holder = testCase.holder
+
+		err := testVIP.WaitForVIPFailover(time.Second)
+		assert.EqualError(t, err, testCase.expectedError)
+		assert.Equal(t, testCase.holder, testVIP.Holder)
+	}
+}
+
+func TestHasIPAddress(t *testing.T) {
+	output := "1: lo    inet 127.0.0.1/8 scope host lo\\       valid_lft forever preferred_lft forever\n" +
+		"2: br-ex    inet 192.168.111.20/24 brd 192.168.111.255 scope global dynamic noprefixroute br-ex\n" +
+		"2: br-ex    inet 192.168.111.5/32 scope global br-ex\n" +
+		"2: br-ex    inet6 fd2e:6f44:5dd8:c956:0:0:0:5/128 scope global nodad deprecated\n"
+
+	testCases := []struct {
+		address  string
+		expected bool
+	}{
+		{
+			address:  defaultAPIVIP,
+			expected: true,
+		},
+		{
+			address:  defaultAPIVIPv6,
+			expected: true,
+		},
+		{
+			address:  defaultIngressVIP,
+			expected: false,
+		},
+		{
+			address:  "invalid",
+			expected: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		assert.Equal(t, testCase.expected, hasIPAddress(output, testCase.address))
+	}
+}
+
+// buildDummyBareMetalPlatformStatus returns a BareMetal PlatformStatus with dual-stack API VIPs and an Ingress VIP.
+func buildDummyBareMetalPlatformStatus() *configv1.PlatformStatus {
+	return &configv1.PlatformStatus{
+		Type: configv1.BareMetalPlatformType,
+		BareMetal: &configv1.BareMetalPlatformStatus{
+			APIServerInternalIPs: []string{defaultAPIVIP, defaultAPIVIPv6},
+			IngressIPs:           []string{defaultIngressVIP},
+		},
+	}
+}
+
+// buildDummyKeepalivedPod returns a pending keepalived pod in the BareMetal keepalived namespace. It is not running
+// since commands cannot be executed in pods of the test clients.
+func buildDummyKeepalivedPod() *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "keepalived-master-0",
+			Namespace: "openshift-kni-infra",
+		},
+		Spec: corev1.PodSpec{
+			NodeName:   "master-0",
+			Containers: []corev1.Container{{Name: keepalivedContainerName}},
+		},
+		Status: corev1.PodStatus{Phase: corev1.PodPending},
+	}
+}
+
+// buildTestClientWithPlatformStatus returns a new client with a mock Infrastructure object with the provided
+// platformStatus and any additional objects.
+func buildTestClientWithPlatformStatus(
+	platformStatus *configv1.PlatformStatus, objects ...runtime.Object) *clients.Settings {
+	infrastructure := buildDummyInfrastructure()
+	infrastructure.Status.PlatformStatus = platformStatus
+
+	return clients.GetTestClients(clients.TestClientParams{
+		K8sMockObjects:  append([]runtime.Object{infrastructure}, objects...),
+		SchemeAttachers: testSchemes,
+	})
+}
+
+// buildValidKeepalivedVIP returns a KeepalivedVIP for the API VIP with a client containing a keepalived pod.
+func buildValidKeepalivedVIP() *KeepalivedVIP {
+	return &KeepalivedVIP{
+		Address:   defaultAPIVIP,
+		Type:      VIPTypeAPI,
+		namespace: "openshift-kni-infra",
+		apiClient: clients.GetTestClients(clients.TestClientParams{
+			K8sMockObjects: []runtime.Object{buildDummyKeepalivedPod()},
+		}),
+	}
+}
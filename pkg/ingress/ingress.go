@@ -8,6 +8,7 @@ import (
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/msg"
 	networkingv1 "k8s.io/api/networking/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/klog/v2"
 	"k8s.io/utils/ptr"
 	goclient "sigs.k8s.io/controller-runtime/pkg/client"
@@ -32,6 +33,8 @@ type IngressBuilder struct {
 
 // NewIngressBuilder creates a new instance of IngressBuilder.
 func NewIngressBuilder(apiClient *clients.Settings, name, nsname string) *IngressBuilder {
+	nsname = apiClient.ResolveNamespace(nsname)
+
 	klog.V(100).Infof(
 		"Initializing new ingress structure with the following params: name=%s, namespace=%s",
 		name, nsname)
@@ -83,6 +86,8 @@ func NewIngressBuilder(apiClient *clients.Settings, name, nsname string) *Ingres
 
 // PullIngress loads an existing ingress into IngressBuilder struct.
 func PullIngress(apiClient *clients.Settings, name, nsname string) (*IngressBuilder, error) {
+	nsname = apiClient.ResolveNamespace(nsname)
+
 	klog.V(100).Infof("Pulling existing ingress %s in namespace %s", name, nsname)
 
 	if apiClient == nil {
@@ -268,6 +273,13 @@ func (builder *IngressBuilder) Delete() error {
 	return nil
 }
 
+// GetIngressGVR returns Ingress' GroupVersionResource which could be used for Clean function.
+func GetIngressGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group: "networking.k8s.io", Version: "v1", Resource: "ingresses",
+	}
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *IngressBuilder) validate() (bool, error) {
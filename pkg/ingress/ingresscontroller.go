@@ -9,6 +9,7 @@ import (
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/msg"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/klog/v2"
 	goclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -25,6 +26,8 @@ type Builder struct {
 
 // Pull loads an existing ingresscontroller into Builder struct.
 func Pull(apiClient *clients.Settings, name, nsname string) (*Builder, error) {
+	nsname = apiClient.ResolveNamespace(nsname)
+
 	klog.V(100).Infof("Pulling existing ingresscontroller %s in namespace %s", name, nsname)
 
 	builder := &Builder{
@@ -166,6 +169,13 @@ func (builder *Builder) Delete() error {
 	return nil
 }
 
+// GetIngressControllerGVR returns IngressController's GroupVersionResource which could be used for Clean function.
+func GetIngressControllerGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group: "operator.openshift.io", Version: "v1", Resource: "ingresscontrollers",
+	}
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *Builder) validate() (bool, error) {
@@ -0,0 +1,174 @@
+// Package insights configures the Insights operator, which periodically gathers data about the cluster and uploads it
+// to Red Hat. Together with pullsecret.DisableTelemetry, DisableGathering allows privacy-sensitive test environments
+// to stop the cluster from reporting data and to restore the previous configuration afterwards.
+package insights
+
+import (
+	"context"
+	"fmt"
+
+	configv1 "github.com/openshift/api/config/v1"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/klog/v2"
+)
+
+// DataGatherName is the name of the singleton InsightsDataGather read by the Insights operator.
+const DataGatherName = "cluster"
+
+// DataGatherBuilder provides a struct for the InsightsDataGather resource containing a connection to the cluster and
+// the InsightsDataGather definition.
+type DataGatherBuilder struct {
+	common.EmbeddableBuilder[configv1.InsightsDataGather, *configv1.InsightsDataGather]
+	common.EmbeddableCreator[configv1.InsightsDataGather, DataGatherBuilder,
+		*configv1.InsightsDataGather, *DataGatherBuilder]
+	common.EmbeddableDeleter[configv1.InsightsDataGather, *configv1.InsightsDataGather]
+	common.EmbeddableUpdater[configv1.InsightsDataGather, DataGatherBuilder,
+		*configv1.InsightsDataGather, *DataGatherBuilder]
+}
+
+// AttachMixins wires the embedded CRUD mixins to this builder instance.
+func (builder *DataGatherBuilder) AttachMixins() {
+	builder.EmbeddableCreator.SetBase(builder)
+	builder.EmbeddableDeleter.SetBase(builder)
+	builder.EmbeddableUpdater.SetBase(builder)
+}
+
+// GetGVK returns the InsightsDataGather GVK for this builder.
+func (builder *DataGatherBuilder) GetGVK() schema.GroupVersionKind {
+	return configv1.GroupVersion.WithKind("InsightsDataGather")
+}
+
+// NewDataGatherBuilder creates a new instance of DataGatherBuilder with all gatherers enabled. The operator only reads
+// the InsightsDataGather named cluster, see DataGatherName.
+func NewDataGatherBuilder(apiClient *clients.Settings, name string) *DataGatherBuilder {
+	klog.V(100).Infof("Initializing new InsightsDataGather structure with the following params: name: %s", name)
+
+	builder := common.NewClusterScopedBuilder[configv1.InsightsDataGather, DataGatherBuilder](
+		apiClient, configv1.Install, name)
+	if builder.GetError() != nil {
+		return builder
+	}
+
+	builder.Definition.Spec.GatherConfig.Gatherers.Mode = configv1.GatheringModeAll
+
+	return builder
+}
+
+// PullDataGather pulls an existing InsightsDataGather from the cluster.
+func PullDataGather(apiClient *clients.Settings, name string) (*DataGatherBuilder, error) {
+	klog.V(100).Infof("Pulling existing InsightsDataGather %s from cluster", name)
+
+	return common.PullClusterScopedBuilder[configv1.InsightsDataGather, DataGatherBuilder](
+		context.TODO(), apiClient, configv1.Install, name)
+}
+
+// WithGatheringMode sets the mode of the gatherers to either GatheringModeAll or GatheringModeNone, clearing any
+// custom gatherer configuration.
+func (builder *DataGatherBuilder) WithGatheringMode(mode configv1.GatheringMode) *DataGatherBuilder {
+	if err := common.Validate(builder); err != nil {
+		return builder
+	}
+
+	klog.V(100).Infof("Setting gathering mode of InsightsDataGather %s to %s", builder.Definition.Name, mode)
+
+	if mode != configv1.GatheringModeAll && mode != configv1.GatheringModeNone {
+		klog.V(100).Infof("The gathering mode %s of the InsightsDataGather is invalid", mode)
+
+		builder.SetError(fmt.Errorf("insightsdatagather 'mode' must be %s or %s, got %q",
+			configv1.GatheringModeAll, configv1.GatheringModeNone, mode))
+
+		return builder
+	}
+
+	builder.Definition.Spec.GatherConfig.Gatherers = configv1.Gatherers{Mode: mode}
+
+	return builder
+}
+
+// WithDataPolicy sets the obfuscation applied to the gathered data, replacing any previously set options.
+func (builder *DataGatherBuilder) WithDataPolicy(options ...configv1.DataPolicyOption) *DataGatherBuilder {
+	if err := common.Validate(builder); err != nil {
+		return builder
+	}
+
+	klog.V(100).Infof("Setting data policy of InsightsDataGather %s to %v", builder.Definition.Name, options)
+
+	for _, option := range options {
+		if option != configv1.DataPolicyOptionObfuscateNetworking &&
+			option != configv1.DataPolicyOptionObfuscateWorkloadNames {
+			klog.V(100).Infof("The data policy option %s of the InsightsDataGather is invalid", option)
+
+			builder.SetError(fmt.Errorf("insightsdatagather data policy option %q is invalid", option))
+
+			return builder
+		}
+	}
+
+	builder.Definition.Spec.GatherConfig.DataPolicy = options
+
+	return builder
+}
+
+// GatheringBackup holds the gatherers configuration of the InsightsDataGather before gathering was disabled.
+type GatheringBackup struct {
+	apiClient *clients.Settings
+	gatherers configv1.Gatherers
+}
+
+// DisableGathering sets the gathering mode of the cluster InsightsDataGather to GatheringModeNone so that the Insights
+// operator stops gathering data. The returned GatheringBackup restores the previous gatherers configuration.
+func DisableGathering(apiClient *clients.Settings) (*GatheringBackup, error) {
+	klog.V(100).Info("Disabling Insights data gathering")
+
+	builder, err := PullDataGather(apiClient, DataGatherName)
+	if err != nil {
+		return nil, err
+	}
+
+	backup := &GatheringBackup{
+		apiClient: apiClient,
+		gatherers: *builder.Object.Spec.GatherConfig.Gatherers.DeepCopy(),
+	}
+
+	_, err = builder.WithGatheringMode(configv1.GatheringModeNone).Update()
+	if err != nil {
+		return nil, fmt.Errorf("failed to disable Insights data gathering: %w", err)
+	}
+
+	return backup, nil
+}
+
+// Restore sets the gatherers configuration of the cluster InsightsDataGather back to the one it had before gathering
+// was disabled.
+func (backup *GatheringBackup) Restore() error {
+	if backup == nil {
+		klog.V(100).Info("The Insights gathering backup is nil")
+
+		return fmt.Errorf("insights gathering backup cannot be nil")
+	}
+
+	klog.V(100).Info("Restoring Insights data gathering from backup")
+
+	builder, err := PullDataGather(backup.apiClient, DataGatherName)
+	if err != nil {
+		return err
+	}
+
+	builder.Definition.Spec.GatherConfig.Gatherers = *backup.gatherers.DeepCopy()
+
+	_, err = builder.Update()
+	if err != nil {
+		return fmt.Errorf("failed to restore Insights data gathering: %w", err)
+	}
+
+	return nil
+}
+
+// GetGVR returns InsightsDataGather's GroupVersionResource which could be used for Clean function.
+func GetGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group: "config.openshift.io", Version: "v1", Resource: "insightsdatagathers",
+	}
+}
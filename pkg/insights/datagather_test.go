@@ -0,0 +1,156 @@
+package insights
+
+import (
+	"testing"
+
+	configv1 "github.com/openshift/api/config/v1"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common/testhelper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var (
+	dataGatherGVK = configv1.GroupVersion.WithKind("InsightsDataGather")
+	testSchemes   = []clients.SchemeAttacher{configv1.Install}
+)
+
+func TestNewDataGatherBuilder(t *testing.T) {
+	t.Parallel()
+
+	t.Run("common cluster-scoped builder behavior", func(t *testing.T) {
+		t.Parallel()
+
+		testhelper.NewClusterScopedBuilderTestConfig(NewDataGatherBuilder, configv1.Install, dataGatherGVK).
+			ExecuteTests(t)
+	})
+
+	t.Run("gathering is enabled", func(t *testing.T) {
+		t.Parallel()
+
+		testBuilder := NewDataGatherBuilder(clients.GetTestClients(clients.TestClientParams{}), DataGatherName)
+
+		require.NoError(t, testBuilder.GetError())
+		assert.Equal(t, configv1.GatheringModeAll, testBuilder.Definition.Spec.GatherConfig.Gatherers.Mode)
+	})
+}
+
+func TestPullDataGather(t *testing.T) {
+	t.Parallel()
+
+	testhelper.NewClusterScopedPullTestConfig(PullDataGather, configv1.Install, dataGatherGVK).ExecuteTests(t)
+}
+
+func TestDataGatherMethods(t *testing.T) {
+	t.Parallel()
+
+	commonTestConfig := testhelper.NewCommonTestConfig[configv1.InsightsDataGather, DataGatherBuilder](
+		configv1.Install,
+		dataGatherGVK,
+		testhelper.ResourceScopeClusterScoped,
+	)
+
+	testhelper.NewTestSuite().
+		With(testhelper.NewGetTestConfig(commonTestConfig)).
+		With(testhelper.NewExistsTestConfig(commonTestConfig)).
+		With(testhelper.NewCreateTestConfig(commonTestConfig)).
+		With(testhelper.NewDeleterTestConfig(commonTestConfig)).
+		With(testhelper.NewUpdateTestConfig(commonTestConfig)).
+		Run(t)
+}
+
+func TestDataGatherWithMethods(t *testing.T) {
+	t.Parallel()
+
+	commonTestConfig := testhelper.NewCommonTestConfig[configv1.InsightsDataGather, DataGatherBuilder](
+		configv1.Install,
+		dataGatherGVK,
+		testhelper.ResourceScopeClusterScoped,
+	)
+
+	type testCase = testhelper.WithMethodTestCase[*configv1.InsightsDataGather, *DataGatherBuilder]
+
+	testhelper.NewTestSuite().
+		With(testhelper.NewWithMethodTestConfig(commonTestConfig, "WithGatheringMode", testCase{
+			Name: "none",
+			Call: func(builder *DataGatherBuilder) *DataGatherBuilder {
+				return builder.WithGatheringMode(configv1.GatheringModeNone)
+			},
+			AssertDefinition: func(t *testing.T, definition *configv1.InsightsDataGather) {
+				assert.Equal(t, configv1.Gatherers{Mode: configv1.GatheringModeNone},
+					definition.Spec.GatherConfig.Gatherers)
+			},
+		}, testCase{
+			Name: "custom",
+			Call: func(builder *DataGatherBuilder) *DataGatherBuilder {
+				return builder.WithGatheringMode(configv1.GatheringModeCustom)
+			},
+			AssertError: testhelper.HasErrorMessage("insightsdatagather 'mode' must be All or None, got \"Custom\""),
+		})).
+		With(testhelper.NewWithMethodTestConfig(commonTestConfig, "WithDataPolicy", testCase{
+			Name: "obfuscate networking",
+			Call: func(builder *DataGatherBuilder) *DataGatherBuilder {
+				return builder.WithDataPolicy(configv1.DataPolicyOptionObfuscateNetworking)
+			},
+			AssertDefinition: func(t *testing.T, definition *configv1.InsightsDataGather) {
+				assert.Equal(t, []configv1.DataPolicyOption{configv1.DataPolicyOptionObfuscateNetworking},
+					definition.Spec.GatherConfig.DataPolicy)
+			},
+		}, testCase{
+			Name: "invalid option",
+			Call: func(builder *DataGatherBuilder) *DataGatherBuilder {
+				return builder.WithDataPolicy("Everything")
+			},
+			AssertError: testhelper.HasErrorMessage("insightsdatagather data policy option \"Everything\" is invalid"),
+		})).
+		Run(t)
+}
+
+func TestDisableGatheringAndRestore(t *testing.T) {
+	t.Parallel()
+
+	dataGather := buildDummyDataGather()
+	dataGather.Spec.GatherConfig.Gatherers = configv1.Gatherers{
+		Mode: configv1.GatheringModeCustom,
+		Custom: configv1.Custom{Configs: []configv1.GathererConfig{
+			{Name: "workloads", State: configv1.GathererStateDisabled},
+		}},
+	}
+
+	testSettings := clients.GetTestClients(clients.TestClientParams{
+		K8sMockObjects:  []runtime.Object{dataGather},
+		SchemeAttachers: testSchemes,
+	})
+
+	backup, err := DisableGathering(testSettings)
+	require.NoError(t, err)
+
+	testBuilder, err := PullDataGather(testSettings, DataGatherName)
+	require.NoError(t, err)
+	assert.Equal(t, configv1.Gatherers{Mode: configv1.GatheringModeNone},
+		testBuilder.Object.Spec.GatherConfig.Gatherers)
+
+	err = backup.Restore()
+	require.NoError(t, err)
+
+	testBuilder, err = PullDataGather(testSettings, DataGatherName)
+	require.NoError(t, err)
+	assert.Equal(t, dataGather.Spec.GatherConfig.Gatherers, testBuilder.Object.Spec.GatherConfig.Gatherers)
+
+	var nilBackup *GatheringBackup
+
+	assert.EqualError(t, nilBackup.Restore(), "insights gathering backup cannot be nil")
+
+	_, err = DisableGathering(clients.GetTestClients(clients.TestClientParams{SchemeAttachers: testSchemes}))
+	assert.Error(t, err)
+}
+
+func buildDummyDataGather() *configv1.InsightsDataGather {
+	return &configv1.InsightsDataGather{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: DataGatherName,
+		},
+	}
+}
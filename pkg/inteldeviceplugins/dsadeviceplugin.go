@@ -0,0 +1,245 @@
+package inteldeviceplugins
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common"
+	idpv1 "github.com/rh-ecosystem-edge/eco-goinfra/pkg/schemes/inteldeviceplugins/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/klog/v2"
+	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// DsaDevicePluginBuilder provides a struct for the DsaDevicePlugin resource containing a connection to the cluster and
+// the DsaDevicePlugin definition. It advertises DSA work queues used to offload data movement.
+type DsaDevicePluginBuilder struct {
+	common.EmbeddableBuilder[idpv1.DsaDevicePlugin, *idpv1.DsaDevicePlugin]
+	common.EmbeddableCreator[idpv1.DsaDevicePlugin, DsaDevicePluginBuilder,
+		*idpv1.DsaDevicePlugin, *DsaDevicePluginBuilder]
+	common.EmbeddableDeleter[idpv1.DsaDevicePlugin, *idpv1.DsaDevicePlugin]
+	common.EmbeddableUpdater[idpv1.DsaDevicePlugin, DsaDevicePluginBuilder,
+		*idpv1.DsaDevicePlugin, *DsaDevicePluginBuilder]
+}
+
+// AttachMixins wires the embedded CRUD mixins to this builder instance.
+func (builder *DsaDevicePluginBuilder) AttachMixins() {
+	builder.EmbeddableCreator.SetBase(builder)
+	builder.EmbeddableDeleter.SetBase(builder)
+	builder.EmbeddableUpdater.SetBase(builder)
+}
+
+// GetGVK returns the DsaDevicePlugin GVK for this builder.
+func (builder *DsaDevicePluginBuilder) GetGVK() schema.GroupVersionKind {
+	return idpv1.GroupVersion.WithKind("DsaDevicePlugin")
+}
+
+// NewDsaDevicePluginBuilder creates a new instance of DsaDevicePluginBuilder using the provided device plugin image.
+func NewDsaDevicePluginBuilder(apiClient *clients.Settings, name, image string) *DsaDevicePluginBuilder {
+	builder := common.NewClusterScopedBuilder[idpv1.DsaDevicePlugin, DsaDevicePluginBuilder](
+		apiClient, idpv1.AddToScheme, name)
+	if err := common.Validate(builder); err != nil {
+		return builder
+	}
+
+	if image == "" {
+		klog.V(100).Info("The image of the dsaDevicePlugin is empty")
+
+		builder.SetError(fmt.Errorf("dsaDevicePlugin 'image' cannot be empty"))
+
+		return builder
+	}
+
+	builder.Definition.Spec.Image = image
+
+	return builder
+}
+
+// PullDsaDevicePlugin pulls an existing DsaDevicePlugin from the cluster.
+func PullDsaDevicePlugin(apiClient *clients.Settings, name string) (*DsaDevicePluginBuilder, error) {
+	return common.PullClusterScopedBuilder[idpv1.DsaDevicePlugin, DsaDevicePluginBuilder](
+		context.TODO(), apiClient, idpv1.AddToScheme, name)
+}
+
+// ListDsaDevicePlugins returns the DsaDevicePlugins on the cluster matching the provided options.
+func ListDsaDevicePlugins(
+	apiClient *clients.Settings, options ...runtimeclient.ListOption) ([]*DsaDevicePluginBuilder, error) {
+	return common.List[idpv1.DsaDevicePlugin, idpv1.DsaDevicePluginList, DsaDevicePluginBuilder](
+		context.TODO(), apiClient, idpv1.AddToScheme, options...)
+}
+
+// WithInitImage sets the image of the init container that configures the DSA devices and work queues.
+func (builder *DsaDevicePluginBuilder) WithInitImage(initImage string) *DsaDevicePluginBuilder {
+	if err := common.Validate(builder); err != nil {
+		return builder
+	}
+
+	klog.V(100).Infof("Setting init image of dsaDevicePlugin %s to %s", builder.Definition.Name, initImage)
+
+	if initImage == "" {
+		builder.SetError(fmt.Errorf("dsaDevicePlugin 'initImage' cannot be empty"))
+
+		return builder
+	}
+
+	builder.Definition.Spec.InitImage = initImage
+
+	return builder
+}
+
+// WithNodeSelector restricts the device plugin DaemonSet to nodes with the provided labels.
+func (builder *DsaDevicePluginBuilder) WithNodeSelector(nodeSelector map[string]string) *DsaDevicePluginBuilder {
+	if err := common.Validate(builder); err != nil {
+		return builder
+	}
+
+	klog.V(100).Infof("Setting node selector of dsaDevicePlugin %s to %v", builder.Definition.Name, nodeSelector)
+
+	if len(nodeSelector) == 0 {
+		builder.SetError(fmt.Errorf("dsaDevicePlugin 'nodeSelector' cannot be empty"))
+
+		return builder
+	}
+
+	builder.Definition.Spec.NodeSelector = nodeSelector
+
+	return builder
+}
+
+// WithToleration adds a toleration to the device plugin DaemonSet, allowing it to run on tainted accelerator nodes.
+func (builder *DsaDevicePluginBuilder) WithToleration(toleration corev1.Toleration) *DsaDevicePluginBuilder {
+	if err := common.Validate(builder); err != nil {
+		return builder
+	}
+
+	klog.V(100).Infof("Adding toleration %v to dsaDevicePlugin %s", toleration, builder.Definition.Name)
+
+	builder.Definition.Spec.Tolerations = append(builder.Definition.Spec.Tolerations, toleration)
+
+	return builder
+}
+
+// WithLogLevel sets the log level of the device plugin.
+func (builder *DsaDevicePluginBuilder) WithLogLevel(logLevel int) *DsaDevicePluginBuilder {
+	if err := common.Validate(builder); err != nil {
+		return builder
+	}
+
+	klog.V(100).Infof("Setting log level of dsaDevicePlugin %s to %d", builder.Definition.Name, logLevel)
+
+	if logLevel < 0 {
+		builder.SetError(fmt.Errorf("dsaDevicePlugin 'logLevel' cannot be negative"))
+
+		return builder
+	}
+
+	builder.Definition.Spec.LogLevel = logLevel
+
+	return builder
+}
+
+// WithSharedDevNum sets the number of containers per node that may share the same DSA work queue.
+func (builder *DsaDevicePluginBuilder) WithSharedDevNum(sharedDevNum int) *DsaDevicePluginBuilder {
+	if err := common.Validate(builder); err != nil {
+		return builder
+	}
+
+	klog.V(100).Infof("Setting sharedDevNum of dsaDevicePlugin %s to %d", builder.Definition.Name, sharedDevNum)
+
+	if sharedDevNum < 1 {
+		builder.SetError(fmt.Errorf("dsaDevicePlugin 'sharedDevNum' must be greater than 0"))
+
+		return builder
+	}
+
+	builder.Definition.Spec.SharedDevNum = sharedDevNum
+
+	return builder
+}
+
+// WithProvisioningConfig sets the name of the ConfigMap used by the init container to configure the DSA devices and
+// work queues.
+func (builder *DsaDevicePluginBuilder) WithProvisioningConfig(configMapName string) *DsaDevicePluginBuilder {
+	if err := common.Validate(builder); err != nil {
+		return builder
+	}
+
+	klog.V(100).Infof("Setting provisioningConfig of dsaDevicePlugin %s to %s", builder.Definition.Name, configMapName)
+
+	if configMapName == "" {
+		builder.SetError(fmt.Errorf("dsaDevicePlugin 'provisioningConfig' cannot be empty"))
+
+		return builder
+	}
+
+	builder.Definition.Spec.ProvisioningConfig = configMapName
+
+	return builder
+}
+
+// IsReady returns whether the device plugin DaemonSet is scheduled on at least one node and ready on all of them. It
+// returns false if the DsaDevicePlugin cannot be retrieved.
+func (builder *DsaDevicePluginBuilder) IsReady() bool {
+	status, err := builder.getStatus()
+	if err != nil {
+		klog.V(100).Infof("Failed to get status of dsaDevicePlugin: %v", err)
+
+		return false
+	}
+
+	return isDevicePluginReady(status)
+}
+
+// WaitUntilReady waits up to timeout for the device plugin DaemonSet to be scheduled on at least one node and ready
+// on all of them.
+func (builder *DsaDevicePluginBuilder) WaitUntilReady(timeout time.Duration) error {
+	if err := common.Validate(builder); err != nil {
+		return err
+	}
+
+	klog.V(100).Infof("Waiting up to %s for dsaDevicePlugin %s to become ready", timeout, builder.Definition.Name)
+
+	return waitForDevicePluginReady(fmt.Sprintf("dsaDevicePlugin %s", builder.Definition.Name), timeout, builder.getStatus)
+}
+
+// WaitUntilAllocatable waits up to timeout for every node running the device plugin to advertise at least minimum
+// of resourceName as allocatable. The nodes are taken from the status of the DsaDevicePlugin, so it should be
+// ready first.
+func (builder *DsaDevicePluginBuilder) WaitUntilAllocatable(
+	resourceName corev1.ResourceName, minimum int64, timeout time.Duration) error {
+	status, err := builder.getStatus()
+	if err != nil {
+		return err
+	}
+
+	klog.V(100).Infof("Waiting up to %s for nodes of dsaDevicePlugin %s to have at least %d allocatable %s",
+		timeout, builder.Definition.Name, minimum, resourceName)
+
+	return waitForNodesAllocatable(builder.GetClient(), status.NodeNames, resourceName, minimum, timeout)
+}
+
+// getStatus refreshes the DsaDevicePlugin and returns its status.
+func (builder *DsaDevicePluginBuilder) getStatus() (idpv1.DevicePluginStatus, error) {
+	if err := common.Validate(builder); err != nil {
+		return idpv1.DevicePluginStatus{}, err
+	}
+
+	devicePlugin, err := builder.Get()
+	if err != nil {
+		return idpv1.DevicePluginStatus{}, err
+	}
+
+	builder.Object = devicePlugin
+
+	return idpv1.DevicePluginStatus(devicePlugin.Status), nil
+}
+
+// GetDsaDevicePluginGVR returns DsaDevicePlugin's GroupVersionResource which could be used for Clean function.
+func GetDsaDevicePluginGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group: "deviceplugin.intel.com", Version: "v1", Resource: "dsadeviceplugins",
+	}
+}
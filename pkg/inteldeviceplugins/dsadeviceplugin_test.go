@@ -0,0 +1,120 @@
+package inteldeviceplugins
+
+import (
+	"testing"
+	"time"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common/testhelper"
+	idpv1 "github.com/rh-ecosystem-edge/eco-goinfra/pkg/schemes/inteldeviceplugins/v1"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var dsaDevicePluginGVK = idpv1.GroupVersion.WithKind("DsaDevicePlugin")
+
+func TestNewDsaDevicePluginBuilder(t *testing.T) {
+	t.Parallel()
+
+	testhelper.NewClusterScopedBuilderTestConfig(
+		func(apiClient *clients.Settings, name string) *DsaDevicePluginBuilder {
+			return NewDsaDevicePluginBuilder(apiClient, name, defaultDevicePluginImage)
+		}, idpv1.AddToScheme, dsaDevicePluginGVK).ExecuteTests(t)
+
+	testBuilder := NewDsaDevicePluginBuilder(buildTestClientWithNodes(), defaultDevicePluginName, "")
+	assert.EqualError(t, testBuilder.GetError(), "dsaDevicePlugin 'image' cannot be empty")
+}
+
+func TestPullDsaDevicePlugin(t *testing.T) {
+	t.Parallel()
+
+	testhelper.NewClusterScopedPullTestConfig(PullDsaDevicePlugin, idpv1.AddToScheme, dsaDevicePluginGVK).ExecuteTests(t)
+}
+
+func TestDsaDevicePluginBuilderMethods(t *testing.T) {
+	t.Parallel()
+
+	commonConfig := testhelper.NewCommonTestConfig[idpv1.DsaDevicePlugin, DsaDevicePluginBuilder](
+		idpv1.AddToScheme, dsaDevicePluginGVK, testhelper.ResourceScopeClusterScoped)
+
+	testhelper.NewTestSuite().
+		With(testhelper.NewGetTestConfig(commonConfig)).
+		With(testhelper.NewExistsTestConfig(commonConfig)).
+		With(testhelper.NewCreateTestConfig(commonConfig)).
+		With(testhelper.NewDeleterTestConfig(commonConfig)).
+		With(testhelper.NewUpdateTestConfig(commonConfig)).
+		Run(t)
+}
+
+func TestListDsaDevicePlugins(t *testing.T) {
+	t.Parallel()
+
+	testhelper.NewListTestConfig(ListDsaDevicePlugins, idpv1.AddToScheme, dsaDevicePluginGVK).ExecuteTests(t)
+}
+
+func TestDsaDevicePluginWithOptions(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		sharedDevNum       int
+		provisioningConfig string
+		expectedError      string
+	}{
+		{
+			sharedDevNum:       10,
+			provisioningConfig: "dsa-config",
+		},
+		{
+			sharedDevNum:       0,
+			provisioningConfig: "dsa-config",
+			expectedError:      "dsaDevicePlugin 'sharedDevNum' must be greater than 0",
+		},
+		{
+			sharedDevNum:       10,
+			provisioningConfig: "",
+			expectedError:      "dsaDevicePlugin 'provisioningConfig' cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := NewDsaDevicePluginBuilder(
+			buildTestClientWithNodes(), defaultDevicePluginName, defaultDevicePluginImage).
+			WithSharedDevNum(testCase.sharedDevNum).
+			WithProvisioningConfig(testCase.provisioningConfig)
+
+		if testCase.expectedError != "" {
+			assert.EqualError(t, testBuilder.GetError(), testCase.expectedError)
+
+			continue
+		}
+
+		assert.NoError(t, testBuilder.GetError())
+		assert.Equal(t, testCase.sharedDevNum, testBuilder.Definition.Spec.SharedDevNum)
+		assert.Equal(t, testCase.provisioningConfig, testBuilder.Definition.Spec.ProvisioningConfig)
+	}
+}
+
+func TestDsaDevicePluginWaitUntilReady(t *testing.T) {
+	t.Parallel()
+
+	testBuilder := NewDsaDevicePluginBuilder(buildTestClientWithNodes(
+		buildDummyDsaDevicePlugin(true), buildDummyNode(defaultNodeName, DSASharedWorkQueueResource, 110)),
+		defaultDevicePluginName, defaultDevicePluginImage)
+
+	assert.True(t, testBuilder.IsReady())
+	assert.NoError(t, testBuilder.WaitUntilReady(time.Second))
+	assert.NoError(t, testBuilder.WaitUntilAllocatable(DSASharedWorkQueueResource, 1, time.Second))
+}
+
+// buildDummyDsaDevicePlugin returns a DsaDevicePlugin scheduled on the default node.
+func buildDummyDsaDevicePlugin(ready bool) *idpv1.DsaDevicePlugin {
+	return &idpv1.DsaDevicePlugin{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: defaultDevicePluginName,
+		},
+		Spec: idpv1.DsaDevicePluginSpec{
+			Image: defaultDevicePluginImage,
+		},
+		Status: idpv1.DsaDevicePluginStatus(buildDummyDevicePluginStatus(ready)),
+	}
+}
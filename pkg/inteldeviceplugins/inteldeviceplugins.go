@@ -0,0 +1,181 @@
+// Package inteldeviceplugins provides builders for the resources of the Intel Device Plugins Operator. Each device
+// plugin resource deploys a DaemonSet that advertises a kind of Intel accelerator, such as QAT, SGX or DSA, as
+// extended resources on the nodes. Helpers are also provided to assert these resources become allocatable.
+package inteldeviceplugins
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	idpv1 "github.com/rh-ecosystem-edge/eco-goinfra/pkg/schemes/inteldeviceplugins/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/klog/v2"
+	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// QATGenericResource is the resource advertised for QAT virtual functions that are not limited to a service.
+	QATGenericResource corev1.ResourceName = "qat.intel.com/generic"
+	// QATCryptoResource is the resource advertised for QAT virtual functions configured for crypto services.
+	QATCryptoResource corev1.ResourceName = "qat.intel.com/cy"
+	// QATCompressionResource is the resource advertised for QAT virtual functions configured for compression services.
+	QATCompressionResource corev1.ResourceName = "qat.intel.com/dc"
+	// SGXEPCResource is the resource advertised for SGX enclave page cache memory, in bytes.
+	SGXEPCResource corev1.ResourceName = "sgx.intel.com/epc"
+	// SGXEnclaveResource is the resource advertised for access to the SGX enclave device.
+	SGXEnclaveResource corev1.ResourceName = "sgx.intel.com/enclave"
+	// SGXProvisionResource is the resource advertised for access to the SGX provision device.
+	SGXProvisionResource corev1.ResourceName = "sgx.intel.com/provision"
+	// DSASharedWorkQueueResource is the resource advertised for shared DSA work queues.
+	DSASharedWorkQueueResource corev1.ResourceName = "dsa.intel.com/wq-user-shared"
+	// DSADedicatedWorkQueueResource is the resource advertised for dedicated DSA work queues.
+	DSADedicatedWorkQueueResource corev1.ResourceName = "dsa.intel.com/wq-user-dedicated"
+)
+
+// GetNodeAllocatable returns the allocatable amount of resourceName on the node. It returns zero if the node does not
+// advertise the resource.
+func GetNodeAllocatable(
+	apiClient *clients.Settings, nodeName string, resourceName corev1.ResourceName) (int64, error) {
+	if apiClient == nil {
+		klog.V(100).Info("The apiClient is nil")
+
+		return 0, fmt.Errorf("node allocatable 'apiClient' cannot be nil")
+	}
+
+	return getNodeAllocatable(apiClient.Client, nodeName, resourceName)
+}
+
+// WaitForNodeAllocatable waits up to timeout for the node to advertise at least minimum of resourceName as allocatable.
+func WaitForNodeAllocatable(
+	apiClient *clients.Settings,
+	nodeName string,
+	resourceName corev1.ResourceName,
+	minimum int64,
+	timeout time.Duration) error {
+	if apiClient == nil {
+		klog.V(100).Info("The apiClient is nil")
+
+		return fmt.Errorf("node allocatable 'apiClient' cannot be nil")
+	}
+
+	return waitForNodesAllocatable(apiClient.Client, []string{nodeName}, resourceName, minimum, timeout)
+}
+
+// getNodeAllocatable returns the allocatable amount of resourceName on the node using the provided client.
+func getNodeAllocatable(
+	apiClient runtimeclient.Client, nodeName string, resourceName corev1.ResourceName) (int64, error) {
+	if nodeName == "" {
+		klog.V(100).Info("The nodeName is empty")
+
+		return 0, fmt.Errorf("node allocatable 'nodeName' cannot be empty")
+	}
+
+	if resourceName == "" {
+		klog.V(100).Info("The resourceName is empty")
+
+		return 0, fmt.Errorf("node allocatable 'resourceName' cannot be empty")
+	}
+
+	klog.V(100).Infof("Getting allocatable %s of node %s", resourceName, nodeName)
+
+	node := &corev1.Node{}
+
+	err := apiClient.Get(context.TODO(), runtimeclient.ObjectKey{Name: nodeName}, node)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get node %s: %w", nodeName, err)
+	}
+
+	quantity, ok := node.Status.Allocatable[resourceName]
+	if !ok {
+		return 0, nil
+	}
+
+	return quantity.Value(), nil
+}
+
+// waitForNodesAllocatable waits up to timeout for all of the nodes to advertise at least minimum of resourceName as
+// allocatable. On timeout, the error includes the first node that did not have enough of the resource.
+func waitForNodesAllocatable(
+	apiClient runtimeclient.Client,
+	nodeNames []string,
+	resourceName corev1.ResourceName,
+	minimum int64,
+	timeout time.Duration) error {
+	if len(nodeNames) == 0 {
+		klog.V(100).Info("The nodeNames are empty")
+
+		return fmt.Errorf("node allocatable 'nodeNames' cannot be empty")
+	}
+
+	if minimum < 1 {
+		klog.V(100).Infof("The minimum %d is not positive", minimum)
+
+		return fmt.Errorf("node allocatable 'minimum' must be greater than 0")
+	}
+
+	klog.V(100).Infof("Waiting up to %s for nodes %v to have at least %d allocatable %s",
+		timeout, nodeNames, minimum, resourceName)
+
+	var lastErr error
+
+	err := wait.PollUntilContextTimeout(
+		context.TODO(), time.Second, timeout, true, func(ctx context.Context) (bool, error) {
+			for _, nodeName := range nodeNames {
+				allocatable, err := getNodeAllocatable(apiClient, nodeName, resourceName)
+				if err != nil {
+					lastErr = err
+
+					return false, nil
+				}
+
+				if allocatable < minimum {
+					lastErr = fmt.Errorf("node %s has %d allocatable %s, expected at least %d",
+						nodeName, allocatable, resourceName, minimum)
+
+					return false, nil
+				}
+			}
+
+			return true, nil
+		})
+	if err != nil && lastErr != nil {
+		return fmt.Errorf("%w: %w", lastErr, err)
+	}
+
+	return err
+}
+
+// isDevicePluginReady returns whether the DaemonSet of a device plugin is scheduled on at least one node and ready on
+// all of them.
+func isDevicePluginReady(status idpv1.DevicePluginStatus) bool {
+	return status.DesiredNumberScheduled > 0 && status.NumberReady == status.DesiredNumberScheduled
+}
+
+// waitForDevicePluginReady waits up to timeout for the device plugin status returned by getStatus to be ready.
+func waitForDevicePluginReady(
+	description string, timeout time.Duration, getStatus func() (idpv1.DevicePluginStatus, error)) error {
+	var lastStatus idpv1.DevicePluginStatus
+
+	err := wait.PollUntilContextTimeout(
+		context.TODO(), time.Second, timeout, true, func(ctx context.Context) (bool, error) {
+			status, err := getStatus()
+			if err != nil {
+				klog.V(100).Infof("Failed to get status of %s: %v", description, err)
+
+				return false, nil
+			}
+
+			lastStatus = status
+
+			return isDevicePluginReady(status), nil
+		})
+	if err != nil {
+		return fmt.Errorf("%s is not ready: %d of %d nodes ready: %w",
+			description, lastStatus.NumberReady, lastStatus.DesiredNumberScheduled, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,205 @@
+package inteldeviceplugins
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	idpv1 "github.com/rh-ecosystem-edge/eco-goinfra/pkg/schemes/inteldeviceplugins/v1"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	defaultDevicePluginName  = "test-device-plugin"
+	defaultDevicePluginImage = "intel/intel-deviceplugin:0.32.0"
+	defaultNodeName          = "worker-0"
+)
+
+var testSchemes = []clients.SchemeAttacher{idpv1.AddToScheme}
+
+func TestGetNodeAllocatable(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		nodeName      string
+		resourceName  corev1.ResourceName
+		client        bool
+		expected      int64
+		expectedError string
+	}{
+		{
+			nodeName:     defaultNodeName,
+			resourceName: QATCryptoResource,
+			client:       true,
+			expected:     16,
+		},
+		{
+			nodeName:     defaultNodeName,
+			resourceName: SGXEnclaveResource,
+			client:       true,
+			expected:     0,
+		},
+		{
+			nodeName:      "",
+			resourceName:  QATCryptoResource,
+			client:        true,
+			expectedError: "node allocatable 'nodeName' cannot be empty",
+		},
+		{
+			nodeName:      defaultNodeName,
+			resourceName:  "",
+			client:        true,
+			expectedError: "node allocatable 'resourceName' cannot be empty",
+		},
+		{
+			nodeName:      defaultNodeName,
+			resourceName:  QATCryptoResource,
+			client:        false,
+			expectedError: "node allocatable 'apiClient' cannot be nil",
+		},
+		{
+			nodeName:      "worker-1",
+			resourceName:  QATCryptoResource,
+			client:        true,
+			expectedError: "failed to get node worker-1: nodes \"worker-1\" not found",
+		},
+	}
+
+	for _, testCase := range testCases {
+		var testSettings *clients.Settings
+
+		if testCase.client {
+			testSettings = buildTestClientWithNodes(buildDummyNode(defaultNodeName, QATCryptoResource, 16))
+		}
+
+		allocatable, err := GetNodeAllocatable(testSettings, testCase.nodeName, testCase.resourceName)
+		if testCase.expectedError != "" {
+			assert.EqualError(t, err, testCase.expectedError)
+
+			continue
+		}
+
+		assert.NoError(t, err)
+		assert.Equal(t, testCase.expected, allocatable)
+	}
+}
+
+func TestWaitForNodeAllocatable(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		minimum       int64
+		client        bool
+		expectedError string
+	}{
+		{
+			minimum: 16,
+			client:  true,
+		},
+		{
+			minimum: 17,
+			client:  true,
+			expectedError: "node worker-0 has 16 allocatable qat.intel.com/cy, expected at least 17: " +
+				context.DeadlineExceeded.Error(),
+		},
+		{
+			minimum:       0,
+			client:        true,
+			expectedError: "node allocatable 'minimum' must be greater than 0",
+		},
+		{
+			minimum:       16,
+			client:        false,
+			expectedError: "node allocatable 'apiClient' cannot be nil",
+		},
+	}
+
+	for _, testCase := range testCases {
+		var testSettings *clients.Settings
+
+		if testCase.client {
+			testSettings = buildTestClientWithNodes(buildDummyNode(defaultNodeName, QATCryptoResource, 16))
+		}
+
+		err := WaitForNodeAllocatable(testSettings, defaultNodeName, QATCryptoResource, testCase.minimum, time.Second)
+		if testCase.expectedError != "" {
+			assert.EqualError(t, err, testCase.expectedError)
+
+			if testCase.minimum > 16 {
+				assert.True(t, errors.Is(err, context.DeadlineExceeded))
+			}
+
+			continue
+		}
+
+		assert.NoError(t, err)
+	}
+}
+
+func TestIsDevicePluginReady(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		status   idpv1.DevicePluginStatus
+		expected bool
+	}{
+		{
+			status:   idpv1.DevicePluginStatus{DesiredNumberScheduled: 2, NumberReady: 2},
+			expected: true,
+		},
+		{
+			status:   idpv1.DevicePluginStatus{DesiredNumberScheduled: 2, NumberReady: 1},
+			expected: false,
+		},
+		{
+			status:   idpv1.DevicePluginStatus{},
+			expected: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		assert.Equal(t, testCase.expected, isDevicePluginReady(testCase.status))
+	}
+}
+
+// buildTestClientWithNodes returns a client with the device plugin schemes and the provided objects.
+func buildTestClientWithNodes(objects ...runtime.Object) *clients.Settings {
+	return clients.GetTestClients(clients.TestClientParams{
+		K8sMockObjects:  objects,
+		SchemeAttachers: testSchemes,
+	})
+}
+
+// buildDummyNode returns a Node with the provided amount of resourceName allocatable.
+func buildDummyNode(name string, resourceName corev1.ResourceName, allocatable int64) *corev1.Node {
+	return &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+		},
+		Status: corev1.NodeStatus{
+			Allocatable: corev1.ResourceList{
+				resourceName: *resource.NewQuantity(allocatable, resource.DecimalSI),
+			},
+		},
+	}
+}
+
+// buildDummyDevicePluginStatus returns a device plugin status scheduled and ready on the default node.
+func buildDummyDevicePluginStatus(ready bool) idpv1.DevicePluginStatus {
+	status := idpv1.DevicePluginStatus{
+		NodeNames:              []string{defaultNodeName},
+		DesiredNumberScheduled: 1,
+	}
+
+	if ready {
+		status.NumberReady = 1
+	}
+
+	return status
+}
@@ -0,0 +1,283 @@
+package inteldeviceplugins
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common"
+	idpv1 "github.com/rh-ecosystem-edge/eco-goinfra/pkg/schemes/inteldeviceplugins/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/klog/v2"
+	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// QatDevicePluginBuilder provides a struct for the QatDevicePlugin resource containing a connection to the cluster and
+// the QatDevicePlugin definition. It advertises QAT crypto and compression accelerators.
+type QatDevicePluginBuilder struct {
+	common.EmbeddableBuilder[idpv1.QatDevicePlugin, *idpv1.QatDevicePlugin]
+	common.EmbeddableCreator[idpv1.QatDevicePlugin, QatDevicePluginBuilder,
+		*idpv1.QatDevicePlugin, *QatDevicePluginBuilder]
+	common.EmbeddableDeleter[idpv1.QatDevicePlugin, *idpv1.QatDevicePlugin]
+	common.EmbeddableUpdater[idpv1.QatDevicePlugin, QatDevicePluginBuilder,
+		*idpv1.QatDevicePlugin, *QatDevicePluginBuilder]
+}
+
+// AttachMixins wires the embedded CRUD mixins to this builder instance.
+func (builder *QatDevicePluginBuilder) AttachMixins() {
+	builder.EmbeddableCreator.SetBase(builder)
+	builder.EmbeddableDeleter.SetBase(builder)
+	builder.EmbeddableUpdater.SetBase(builder)
+}
+
+// GetGVK returns the QatDevicePlugin GVK for this builder.
+func (builder *QatDevicePluginBuilder) GetGVK() schema.GroupVersionKind {
+	return idpv1.GroupVersion.WithKind("QatDevicePlugin")
+}
+
+// NewQatDevicePluginBuilder creates a new instance of QatDevicePluginBuilder using the provided device plugin image.
+func NewQatDevicePluginBuilder(apiClient *clients.Settings, name, image string) *QatDevicePluginBuilder {
+	builder := common.NewClusterScopedBuilder[idpv1.QatDevicePlugin, QatDevicePluginBuilder](
+		apiClient, idpv1.AddToScheme, name)
+	if err := common.Validate(builder); err != nil {
+		return builder
+	}
+
+	if image == "" {
+		klog.V(100).Info("The image of the qatDevicePlugin is empty")
+
+		builder.SetError(fmt.Errorf("qatDevicePlugin 'image' cannot be empty"))
+
+		return builder
+	}
+
+	builder.Definition.Spec.Image = image
+
+	return builder
+}
+
+// PullQatDevicePlugin pulls an existing QatDevicePlugin from the cluster.
+func PullQatDevicePlugin(apiClient *clients.Settings, name string) (*QatDevicePluginBuilder, error) {
+	return common.PullClusterScopedBuilder[idpv1.QatDevicePlugin, QatDevicePluginBuilder](
+		context.TODO(), apiClient, idpv1.AddToScheme, name)
+}
+
+// ListQatDevicePlugins returns the QatDevicePlugins on the cluster matching the provided options.
+func ListQatDevicePlugins(
+	apiClient *clients.Settings, options ...runtimeclient.ListOption) ([]*QatDevicePluginBuilder, error) {
+	return common.List[idpv1.QatDevicePlugin, idpv1.QatDevicePluginList, QatDevicePluginBuilder](
+		context.TODO(), apiClient, idpv1.AddToScheme, options...)
+}
+
+// WithInitImage sets the image of the init container that configures the QAT devices.
+func (builder *QatDevicePluginBuilder) WithInitImage(initImage string) *QatDevicePluginBuilder {
+	if err := common.Validate(builder); err != nil {
+		return builder
+	}
+
+	klog.V(100).Infof("Setting init image of qatDevicePlugin %s to %s", builder.Definition.Name, initImage)
+
+	if initImage == "" {
+		builder.SetError(fmt.Errorf("qatDevicePlugin 'initImage' cannot be empty"))
+
+		return builder
+	}
+
+	builder.Definition.Spec.InitImage = initImage
+
+	return builder
+}
+
+// WithNodeSelector restricts the device plugin DaemonSet to nodes with the provided labels.
+func (builder *QatDevicePluginBuilder) WithNodeSelector(nodeSelector map[string]string) *QatDevicePluginBuilder {
+	if err := common.Validate(builder); err != nil {
+		return builder
+	}
+
+	klog.V(100).Infof("Setting node selector of qatDevicePlugin %s to %v", builder.Definition.Name, nodeSelector)
+
+	if len(nodeSelector) == 0 {
+		builder.SetError(fmt.Errorf("qatDevicePlugin 'nodeSelector' cannot be empty"))
+
+		return builder
+	}
+
+	builder.Definition.Spec.NodeSelector = nodeSelector
+
+	return builder
+}
+
+// WithToleration adds a toleration to the device plugin DaemonSet, allowing it to run on tainted accelerator nodes.
+func (builder *QatDevicePluginBuilder) WithToleration(toleration corev1.Toleration) *QatDevicePluginBuilder {
+	if err := common.Validate(builder); err != nil {
+		return builder
+	}
+
+	klog.V(100).Infof("Adding toleration %v to qatDevicePlugin %s", toleration, builder.Definition.Name)
+
+	builder.Definition.Spec.Tolerations = append(builder.Definition.Spec.Tolerations, toleration)
+
+	return builder
+}
+
+// WithLogLevel sets the log level of the device plugin.
+func (builder *QatDevicePluginBuilder) WithLogLevel(logLevel int) *QatDevicePluginBuilder {
+	if err := common.Validate(builder); err != nil {
+		return builder
+	}
+
+	klog.V(100).Infof("Setting log level of qatDevicePlugin %s to %d", builder.Definition.Name, logLevel)
+
+	if logLevel < 0 {
+		builder.SetError(fmt.Errorf("qatDevicePlugin 'logLevel' cannot be negative"))
+
+		return builder
+	}
+
+	builder.Definition.Spec.LogLevel = logLevel
+
+	return builder
+}
+
+// WithKernelVfDrivers sets the VF drivers of the QAT devices exposed by the device plugin.
+func (builder *QatDevicePluginBuilder) WithKernelVfDrivers(
+	kernelVfDrivers ...idpv1.KernelVfDriver) *QatDevicePluginBuilder {
+	if err := common.Validate(builder); err != nil {
+		return builder
+	}
+
+	klog.V(100).Infof("Setting kernelVfDrivers of qatDevicePlugin %s to %v", builder.Definition.Name, kernelVfDrivers)
+
+	if len(kernelVfDrivers) == 0 {
+		builder.SetError(fmt.Errorf("qatDevicePlugin 'kernelVfDrivers' cannot be empty"))
+
+		return builder
+	}
+
+	builder.Definition.Spec.KernelVfDrivers = kernelVfDrivers
+
+	return builder
+}
+
+// WithMaxNumDevices sets the maximum number of QAT virtual functions advertised per node.
+func (builder *QatDevicePluginBuilder) WithMaxNumDevices(maxNumDevices int) *QatDevicePluginBuilder {
+	if err := common.Validate(builder); err != nil {
+		return builder
+	}
+
+	klog.V(100).Infof("Setting maxNumDevices of qatDevicePlugin %s to %d", builder.Definition.Name, maxNumDevices)
+
+	if maxNumDevices < 1 {
+		builder.SetError(fmt.Errorf("qatDevicePlugin 'maxNumDevices' must be greater than 0"))
+
+		return builder
+	}
+
+	builder.Definition.Spec.MaxNumDevices = maxNumDevices
+
+	return builder
+}
+
+// WithPreferredAllocationPolicy sets how QAT devices are allocated to containers, either balanced or packed.
+func (builder *QatDevicePluginBuilder) WithPreferredAllocationPolicy(policy string) *QatDevicePluginBuilder {
+	if err := common.Validate(builder); err != nil {
+		return builder
+	}
+
+	klog.V(100).Infof("Setting preferredAllocationPolicy of qatDevicePlugin %s to %s", builder.Definition.Name, policy)
+
+	if policy != "balanced" && policy != "packed" {
+		builder.SetError(fmt.Errorf("qatDevicePlugin 'preferredAllocationPolicy' must be balanced or packed"))
+
+		return builder
+	}
+
+	builder.Definition.Spec.PreferredAllocationPolicy = policy
+
+	return builder
+}
+
+// WithProvisioningConfig sets the name of the ConfigMap used by the init container to configure the QAT devices.
+func (builder *QatDevicePluginBuilder) WithProvisioningConfig(configMapName string) *QatDevicePluginBuilder {
+	if err := common.Validate(builder); err != nil {
+		return builder
+	}
+
+	klog.V(100).Infof("Setting provisioningConfig of qatDevicePlugin %s to %s", builder.Definition.Name, configMapName)
+
+	if configMapName == "" {
+		builder.SetError(fmt.Errorf("qatDevicePlugin 'provisioningConfig' cannot be empty"))
+
+		return builder
+	}
+
+	builder.Definition.Spec.ProvisioningConfig = configMapName
+
+	return builder
+}
+
+// IsReady returns whether the device plugin DaemonSet is scheduled on at least one node and ready on all of them. It
+// returns false if the QatDevicePlugin cannot be retrieved.
+func (builder *QatDevicePluginBuilder) IsReady() bool {
+	status, err := builder.getStatus()
+	if err != nil {
+		klog.V(100).Infof("Failed to get status of qatDevicePlugin: %v", err)
+
+		return false
+	}
+
+	return isDevicePluginReady(status)
+}
+
+// WaitUntilReady waits up to timeout for the device plugin DaemonSet to be scheduled on at least one node and ready
+// on all of them.
+func (builder *QatDevicePluginBuilder) WaitUntilReady(timeout time.Duration) error {
+	if err := common.Validate(builder); err != nil {
+		return err
+	}
+
+	klog.V(100).Infof("Waiting up to %s for qatDevicePlugin %s to become ready", timeout, builder.Definition.Name)
+
+	return waitForDevicePluginReady(fmt.Sprintf("qatDevicePlugin %s", builder.Definition.Name), timeout, builder.getStatus)
+}
+
+// WaitUntilAllocatable waits up to timeout for every node running the device plugin to advertise at least minimum
+// of resourceName as allocatable. The nodes are taken from the status of the QatDevicePlugin, so it should be
+// ready first.
+func (builder *QatDevicePluginBuilder) WaitUntilAllocatable(
+	resourceName corev1.ResourceName, minimum int64, timeout time.Duration) error {
+	status, err := builder.getStatus()
+	if err != nil {
+		return err
+	}
+
+	klog.V(100).Infof("Waiting up to %s for nodes of qatDevicePlugin %s to have at least %d allocatable %s",
+		timeout, builder.Definition.Name, minimum, resourceName)
+
+	return waitForNodesAllocatable(builder.GetClient(), status.NodeNames, resourceName, minimum, timeout)
+}
+
+// getStatus refreshes the QatDevicePlugin and returns its status.
+func (builder *QatDevicePluginBuilder) getStatus() (idpv1.DevicePluginStatus, error) {
+	if err := common.Validate(builder); err != nil {
+		return idpv1.DevicePluginStatus{}, err
+	}
+
+	devicePlugin, err := builder.Get()
+	if err != nil {
+		return idpv1.DevicePluginStatus{}, err
+	}
+
+	builder.Object = devicePlugin
+
+	return idpv1.DevicePluginStatus(devicePlugin.Status), nil
+}
+
+// GetQatDevicePluginGVR returns QatDevicePlugin's GroupVersionResource which could be used for Clean function.
+func GetQatDevicePluginGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group: "deviceplugin.intel.com", Version: "v1", Resource: "qatdeviceplugins",
+	}
+}
@@ -0,0 +1,215 @@
+package inteldeviceplugins
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common/testhelper"
+	idpv1 "github.com/rh-ecosystem-edge/eco-goinfra/pkg/schemes/inteldeviceplugins/v1"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var qatDevicePluginGVK = idpv1.GroupVersion.WithKind("QatDevicePlugin")
+
+func TestNewQatDevicePluginBuilder(t *testing.T) {
+	t.Parallel()
+
+	testhelper.NewClusterScopedBuilderTestConfig(
+		func(apiClient *clients.Settings, name string) *QatDevicePluginBuilder {
+			return NewQatDevicePluginBuilder(apiClient, name, defaultDevicePluginImage)
+		}, idpv1.AddToScheme, qatDevicePluginGVK).ExecuteTests(t)
+
+	testBuilder := NewQatDevicePluginBuilder(buildTestClientWithNodes(), defaultDevicePluginName, "")
+	assert.EqualError(t, testBuilder.GetError(), "qatDevicePlugin 'image' cannot be empty")
+}
+
+func TestPullQatDevicePlugin(t *testing.T) {
+	t.Parallel()
+
+	testhelper.NewClusterScopedPullTestConfig(PullQatDevicePlugin, idpv1.AddToScheme, qatDevicePluginGVK).ExecuteTests(t)
+}
+
+func TestQatDevicePluginBuilderMethods(t *testing.T) {
+	t.Parallel()
+
+	commonConfig := testhelper.NewCommonTestConfig[idpv1.QatDevicePlugin, QatDevicePluginBuilder](
+		idpv1.AddToScheme, qatDevicePluginGVK, testhelper.ResourceScopeClusterScoped)
+
+	testhelper.NewTestSuite().
+		With(testhelper.NewGetTestConfig(commonConfig)).
+		With(testhelper.NewExistsTestConfig(commonConfig)).
+		With(testhelper.NewCreateTestConfig(commonConfig)).
+		With(testhelper.NewDeleterTestConfig(commonConfig)).
+		With(testhelper.NewUpdateTestConfig(commonConfig)).
+		Run(t)
+}
+
+func TestListQatDevicePlugins(t *testing.T) {
+	t.Parallel()
+
+	testhelper.NewListTestConfig(ListQatDevicePlugins, idpv1.AddToScheme, qatDevicePluginGVK).ExecuteTests(t)
+}
+
+func TestQatDevicePluginWithOptions(t *testing.T) {
+	t.Parallel()
+
+	toleration := corev1.Toleration{Key: "accelerator", Operator: corev1.TolerationOpExists}
+
+	testBuilder := NewQatDevicePluginBuilder(
+		buildTestClientWithNodes(), defaultDevicePluginName, defaultDevicePluginImage).
+		WithInitImage("intel/intel-qat-initcontainer:0.32.0").
+		WithNodeSelector(map[string]string{"intel.feature.node.kubernetes.io/qat": "true"}).
+		WithToleration(toleration).
+		WithLogLevel(4).
+		WithKernelVfDrivers("4xxxvf").
+		WithMaxNumDevices(16).
+		WithPreferredAllocationPolicy("balanced").
+		WithProvisioningConfig("qat-config")
+	assert.NoError(t, testBuilder.GetError())
+	assert.Equal(t, idpv1.QatDevicePluginSpec{
+		Image:                     defaultDevicePluginImage,
+		InitImage:                 "intel/intel-qat-initcontainer:0.32.0",
+		ProvisioningConfig:        "qat-config",
+		PreferredAllocationPolicy: "balanced",
+		NodeSelector:              map[string]string{"intel.feature.node.kubernetes.io/qat": "true"},
+		KernelVfDrivers:           []idpv1.KernelVfDriver{"4xxxvf"},
+		Tolerations:               []corev1.Toleration{toleration},
+		MaxNumDevices:             16,
+		LogLevel:                  4,
+	}, testBuilder.Definition.Spec)
+}
+
+func TestQatDevicePluginWithOptionsErrors(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		option        func(*QatDevicePluginBuilder) *QatDevicePluginBuilder
+		expectedError string
+	}{
+		{
+			option: func(builder *QatDevicePluginBuilder) *QatDevicePluginBuilder {
+				return builder.WithInitImage("")
+			},
+			expectedError: "qatDevicePlugin 'initImage' cannot be empty",
+		},
+		{
+			option: func(builder *QatDevicePluginBuilder) *QatDevicePluginBuilder {
+				return builder.WithNodeSelector(nil)
+			},
+			expectedError: "qatDevicePlugin 'nodeSelector' cannot be empty",
+		},
+		{
+			option: func(builder *QatDevicePluginBuilder) *QatDevicePluginBuilder {
+				return builder.WithLogLevel(-1)
+			},
+			expectedError: "qatDevicePlugin 'logLevel' cannot be negative",
+		},
+		{
+			option: func(builder *QatDevicePluginBuilder) *QatDevicePluginBuilder {
+				return builder.WithKernelVfDrivers()
+			},
+			expectedError: "qatDevicePlugin 'kernelVfDrivers' cannot be empty",
+		},
+		{
+			option: func(builder *QatDevicePluginBuilder) *QatDevicePluginBuilder {
+				return builder.WithMaxNumDevices(0)
+			},
+			expectedError: "qatDevicePlugin 'maxNumDevices' must be greater than 0",
+		},
+		{
+			option: func(builder *QatDevicePluginBuilder) *QatDevicePluginBuilder {
+				return builder.WithPreferredAllocationPolicy("random")
+			},
+			expectedError: "qatDevicePlugin 'preferredAllocationPolicy' must be balanced or packed",
+		},
+		{
+			option: func(builder *QatDevicePluginBuilder) *QatDevicePluginBuilder {
+				return builder.WithProvisioningConfig("")
+			},
+			expectedError: "qatDevicePlugin 'provisioningConfig' cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := testCase.option(
+			NewQatDevicePluginBuilder(buildTestClientWithNodes(), defaultDevicePluginName, defaultDevicePluginImage))
+		assert.EqualError(t, testBuilder.GetError(), testCase.expectedError)
+	}
+}
+
+func TestQatDevicePluginWaitUntilReady(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		exists        bool
+		ready         bool
+		expectedError string
+	}{
+		{
+			exists: true,
+			ready:  true,
+		},
+		{
+			exists: true,
+			ready:  false,
+			expectedError: "qatDevicePlugin test-device-plugin is not ready: 0 of 1 nodes ready: " +
+				context.DeadlineExceeded.Error(),
+		},
+		{
+			exists: false,
+			expectedError: "qatDevicePlugin test-device-plugin is not ready: 0 of 0 nodes ready: " +
+				context.DeadlineExceeded.Error(),
+		},
+	}
+
+	for _, testCase := range testCases {
+		var objects []runtime.Object
+
+		if testCase.exists {
+			objects = append(objects, buildDummyQatDevicePlugin(testCase.ready))
+		}
+
+		testBuilder := NewQatDevicePluginBuilder(
+			buildTestClientWithNodes(objects...), defaultDevicePluginName, defaultDevicePluginImage)
+
+		assert.Equal(t, testCase.ready, testBuilder.IsReady())
+
+		err := testBuilder.WaitUntilReady(time.Second)
+		if testCase.expectedError != "" {
+			assert.EqualError(t, err, testCase.expectedError)
+
+			continue
+		}
+
+		assert.NoError(t, err)
+	}
+}
+
+func TestQatDevicePluginWaitUntilAllocatable(t *testing.T) {
+	t.Parallel()
+
+	testBuilder := NewQatDevicePluginBuilder(buildTestClientWithNodes(
+		buildDummyQatDevicePlugin(true), buildDummyNode(defaultNodeName, QATCryptoResource, 16)),
+		defaultDevicePluginName, defaultDevicePluginImage)
+
+	assert.NoError(t, testBuilder.WaitUntilAllocatable(QATCryptoResource, 16, time.Second))
+	assert.ErrorIs(t, testBuilder.WaitUntilAllocatable(QATCompressionResource, 1, time.Second), context.DeadlineExceeded)
+}
+
+// buildDummyQatDevicePlugin returns a QatDevicePlugin scheduled on the default node.
+func buildDummyQatDevicePlugin(ready bool) *idpv1.QatDevicePlugin {
+	return &idpv1.QatDevicePlugin{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: defaultDevicePluginName,
+		},
+		Spec: idpv1.QatDevicePluginSpec{
+			Image: defaultDevicePluginImage,
+		},
+		Status: idpv1.QatDevicePluginStatus(buildDummyDevicePluginStatus(ready)),
+	}
+}
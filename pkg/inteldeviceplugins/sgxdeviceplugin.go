@@ -0,0 +1,244 @@
+package inteldeviceplugins
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common"
+	idpv1 "github.com/rh-ecosystem-edge/eco-goinfra/pkg/schemes/inteldeviceplugins/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/klog/v2"
+	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// SgxDevicePluginBuilder provides a struct for the SgxDevicePlugin resource containing a connection to the cluster and
+// the SgxDevicePlugin definition. It advertises SGX enclave page cache memory and SGX device access.
+type SgxDevicePluginBuilder struct {
+	common.EmbeddableBuilder[idpv1.SgxDevicePlugin, *idpv1.SgxDevicePlugin]
+	common.EmbeddableCreator[idpv1.SgxDevicePlugin, SgxDevicePluginBuilder,
+		*idpv1.SgxDevicePlugin, *SgxDevicePluginBuilder]
+	common.EmbeddableDeleter[idpv1.SgxDevicePlugin, *idpv1.SgxDevicePlugin]
+	common.EmbeddableUpdater[idpv1.SgxDevicePlugin, SgxDevicePluginBuilder,
+		*idpv1.SgxDevicePlugin, *SgxDevicePluginBuilder]
+}
+
+// AttachMixins wires the embedded CRUD mixins to this builder instance.
+func (builder *SgxDevicePluginBuilder) AttachMixins() {
+	builder.EmbeddableCreator.SetBase(builder)
+	builder.EmbeddableDeleter.SetBase(builder)
+	builder.EmbeddableUpdater.SetBase(builder)
+}
+
+// GetGVK returns the SgxDevicePlugin GVK for this builder.
+func (builder *SgxDevicePluginBuilder) GetGVK() schema.GroupVersionKind {
+	return idpv1.GroupVersion.WithKind("SgxDevicePlugin")
+}
+
+// NewSgxDevicePluginBuilder creates a new instance of SgxDevicePluginBuilder using the provided device plugin image.
+func NewSgxDevicePluginBuilder(apiClient *clients.Settings, name, image string) *SgxDevicePluginBuilder {
+	builder := common.NewClusterScopedBuilder[idpv1.SgxDevicePlugin, SgxDevicePluginBuilder](
+		apiClient, idpv1.AddToScheme, name)
+	if err := common.Validate(builder); err != nil {
+		return builder
+	}
+
+	if image == "" {
+		klog.V(100).Info("The image of the sgxDevicePlugin is empty")
+
+		builder.SetError(fmt.Errorf("sgxDevicePlugin 'image' cannot be empty"))
+
+		return builder
+	}
+
+	builder.Definition.Spec.Image = image
+
+	return builder
+}
+
+// PullSgxDevicePlugin pulls an existing SgxDevicePlugin from the cluster.
+func PullSgxDevicePlugin(apiClient *clients.Settings, name string) (*SgxDevicePluginBuilder, error) {
+	return common.PullClusterScopedBuilder[idpv1.SgxDevicePlugin, SgxDevicePluginBuilder](
+		context.TODO(), apiClient, idpv1.AddToScheme, name)
+}
+
+// ListSgxDevicePlugins returns the SgxDevicePlugins on the cluster matching the provided options.
+func ListSgxDevicePlugins(
+	apiClient *clients.Settings, options ...runtimeclient.ListOption) ([]*SgxDevicePluginBuilder, error) {
+	return common.List[idpv1.SgxDevicePlugin, idpv1.SgxDevicePluginList, SgxDevicePluginBuilder](
+		context.TODO(), apiClient, idpv1.AddToScheme, options...)
+}
+
+// WithInitImage sets the image of the init container that labels SGX capable nodes.
+func (builder *SgxDevicePluginBuilder) WithInitImage(initImage string) *SgxDevicePluginBuilder {
+	if err := common.Validate(builder); err != nil {
+		return builder
+	}
+
+	klog.V(100).Infof("Setting init image of sgxDevicePlugin %s to %s", builder.Definition.Name, initImage)
+
+	if initImage == "" {
+		builder.SetError(fmt.Errorf("sgxDevicePlugin 'initImage' cannot be empty"))
+
+		return builder
+	}
+
+	builder.Definition.Spec.InitImage = initImage
+
+	return builder
+}
+
+// WithNodeSelector restricts the device plugin DaemonSet to nodes with the provided labels.
+func (builder *SgxDevicePluginBuilder) WithNodeSelector(nodeSelector map[string]string) *SgxDevicePluginBuilder {
+	if err := common.Validate(builder); err != nil {
+		return builder
+	}
+
+	klog.V(100).Infof("Setting node selector of sgxDevicePlugin %s to %v", builder.Definition.Name, nodeSelector)
+
+	if len(nodeSelector) == 0 {
+		builder.SetError(fmt.Errorf("sgxDevicePlugin 'nodeSelector' cannot be empty"))
+
+		return builder
+	}
+
+	builder.Definition.Spec.NodeSelector = nodeSelector
+
+	return builder
+}
+
+// WithToleration adds a toleration to the device plugin DaemonSet, allowing it to run on tainted accelerator nodes.
+func (builder *SgxDevicePluginBuilder) WithToleration(toleration corev1.Toleration) *SgxDevicePluginBuilder {
+	if err := common.Validate(builder); err != nil {
+		return builder
+	}
+
+	klog.V(100).Infof("Adding toleration %v to sgxDevicePlugin %s", toleration, builder.Definition.Name)
+
+	builder.Definition.Spec.Tolerations = append(builder.Definition.Spec.Tolerations, toleration)
+
+	return builder
+}
+
+// WithLogLevel sets the log level of the device plugin.
+func (builder *SgxDevicePluginBuilder) WithLogLevel(logLevel int) *SgxDevicePluginBuilder {
+	if err := common.Validate(builder); err != nil {
+		return builder
+	}
+
+	klog.V(100).Infof("Setting log level of sgxDevicePlugin %s to %d", builder.Definition.Name, logLevel)
+
+	if logLevel < 0 {
+		builder.SetError(fmt.Errorf("sgxDevicePlugin 'logLevel' cannot be negative"))
+
+		return builder
+	}
+
+	builder.Definition.Spec.LogLevel = logLevel
+
+	return builder
+}
+
+// WithEnclaveLimit sets the number of containers per node that may share the SGX enclave device.
+func (builder *SgxDevicePluginBuilder) WithEnclaveLimit(enclaveLimit int) *SgxDevicePluginBuilder {
+	if err := common.Validate(builder); err != nil {
+		return builder
+	}
+
+	klog.V(100).Infof("Setting enclaveLimit of sgxDevicePlugin %s to %d", builder.Definition.Name, enclaveLimit)
+
+	if enclaveLimit < 1 {
+		builder.SetError(fmt.Errorf("sgxDevicePlugin 'enclaveLimit' must be greater than 0"))
+
+		return builder
+	}
+
+	builder.Definition.Spec.EnclaveLimit = enclaveLimit
+
+	return builder
+}
+
+// WithProvisionLimit sets the number of containers per node that may share the SGX provision device.
+func (builder *SgxDevicePluginBuilder) WithProvisionLimit(provisionLimit int) *SgxDevicePluginBuilder {
+	if err := common.Validate(builder); err != nil {
+		return builder
+	}
+
+	klog.V(100).Infof("Setting provisionLimit of sgxDevicePlugin %s to %d", builder.Definition.Name, provisionLimit)
+
+	if provisionLimit < 1 {
+		builder.SetError(fmt.Errorf("sgxDevicePlugin 'provisionLimit' must be greater than 0"))
+
+		return builder
+	}
+
+	builder.Definition.Spec.ProvisionLimit = provisionLimit
+
+	return builder
+}
+
+// IsReady returns whether the device plugin DaemonSet is scheduled on at least one node and ready on all of them. It
+// returns false if the SgxDevicePlugin cannot be retrieved.
+func (builder *SgxDevicePluginBuilder) IsReady() bool {
+	status, err := builder.getStatus()
+	if err != nil {
+		klog.V(100).Infof("Failed to get status of sgxDevicePlugin: %v", err)
+
+		return false
+	}
+
+	return isDevicePluginReady(status)
+}
+
+// WaitUntilReady waits up to timeout for the device plugin DaemonSet to be scheduled on at least one node and ready
+// on all of them.
+func (builder *SgxDevicePluginBuilder) WaitUntilReady(timeout time.Duration) error {
+	if err := common.Validate(builder); err != nil {
+		return err
+	}
+
+	klog.V(100).Infof("Waiting up to %s for sgxDevicePlugin %s to become ready", timeout, builder.Definition.Name)
+
+	return waitForDevicePluginReady(fmt.Sprintf("sgxDevicePlugin %s", builder.Definition.Name), timeout, builder.getStatus)
+}
+
+// WaitUntilAllocatable waits up to timeout for every node running the device plugin to advertise at least minimum
+// of resourceName as allocatable. The nodes are taken from the status of the SgxDevicePlugin, so it should be
+// ready first.
+func (builder *SgxDevicePluginBuilder) WaitUntilAllocatable(
+	resourceName corev1.ResourceName, minimum int64, timeout time.Duration) error {
+	status, err := builder.getStatus()
+	if err != nil {
+		return err
+	}
+
+	klog.V(100).Infof("Waiting up to %s for nodes of sgxDevicePlugin %s to have at least %d allocatable %s",
+		timeout, builder.Definition.Name, minimum, resourceName)
+
+	return waitForNodesAllocatable(builder.GetClient(), status.NodeNames, resourceName, minimum, timeout)
+}
+
+// getStatus refreshes the SgxDevicePlugin and returns its status.
+func (builder *SgxDevicePluginBuilder) getStatus() (idpv1.DevicePluginStatus, error) {
+	if err := common.Validate(builder); err != nil {
+		return idpv1.DevicePluginStatus{}, err
+	}
+
+	devicePlugin, err := builder.Get()
+	if err != nil {
+		return idpv1.DevicePluginStatus{}, err
+	}
+
+	builder.Object = devicePlugin
+
+	return idpv1.DevicePluginStatus(devicePlugin.Status), nil
+}
+
+// GetSgxDevicePluginGVR returns SgxDevicePlugin's GroupVersionResource which could be used for Clean function.
+func GetSgxDevicePluginGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group: "deviceplugin.intel.com", Version: "v1", Resource: "sgxdeviceplugins",
+	}
+}
@@ -0,0 +1,120 @@
+package inteldeviceplugins
+
+import (
+	"testing"
+	"time"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common/testhelper"
+	idpv1 "github.com/rh-ecosystem-edge/eco-goinfra/pkg/schemes/inteldeviceplugins/v1"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var sgxDevicePluginGVK = idpv1.GroupVersion.WithKind("SgxDevicePlugin")
+
+func TestNewSgxDevicePluginBuilder(t *testing.T) {
+	t.Parallel()
+
+	testhelper.NewClusterScopedBuilderTestConfig(
+		func(apiClient *clients.Settings, name string) *SgxDevicePluginBuilder {
+			return NewSgxDevicePluginBuilder(apiClient, name, defaultDevicePluginImage)
+		}, idpv1.AddToScheme, sgxDevicePluginGVK).ExecuteTests(t)
+
+	testBuilder := NewSgxDevicePluginBuilder(buildTestClientWithNodes(), defaultDevicePluginName, "")
+	assert.EqualError(t, testBuilder.GetError(), "sgxDevicePlugin 'image' cannot be empty")
+}
+
+func TestPullSgxDevicePlugin(t *testing.T) {
+	t.Parallel()
+
+	testhelper.NewClusterScopedPullTestConfig(PullSgxDevicePlugin, idpv1.AddToScheme, sgxDevicePluginGVK).ExecuteTests(t)
+}
+
+func TestSgxDevicePluginBuilderMethods(t *testing.T) {
+	t.Parallel()
+
+	commonConfig := testhelper.NewCommonTestConfig[idpv1.SgxDevicePlugin, SgxDevicePluginBuilder](
+		idpv1.AddToScheme, sgxDevicePluginGVK, testhelper.ResourceScopeClusterScoped)
+
+	testhelper.NewTestSuite().
+		With(testhelper.NewGetTestConfig(commonConfig)).
+		With(testhelper.NewExistsTestConfig(commonConfig)).
+		With(testhelper.NewCreateTestConfig(commonConfig)).
+		With(testhelper.NewDeleterTestConfig(commonConfig)).
+		With(testhelper.NewUpdateTestConfig(commonConfig)).
+		Run(t)
+}
+
+func TestListSgxDevicePlugins(t *testing.T) {
+	t.Parallel()
+
+	testhelper.NewListTestConfig(ListSgxDevicePlugins, idpv1.AddToScheme, sgxDevicePluginGVK).ExecuteTests(t)
+}
+
+func TestSgxDevicePluginWithLimits(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		enclaveLimit   int
+		provisionLimit int
+		expectedError  string
+	}{
+		{
+			enclaveLimit:   110,
+			provisionLimit: 110,
+		},
+		{
+			enclaveLimit:   0,
+			provisionLimit: 110,
+			expectedError:  "sgxDevicePlugin 'enclaveLimit' must be greater than 0",
+		},
+		{
+			enclaveLimit:   110,
+			provisionLimit: 0,
+			expectedError:  "sgxDevicePlugin 'provisionLimit' must be greater than 0",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := NewSgxDevicePluginBuilder(
+			buildTestClientWithNodes(), defaultDevicePluginName, defaultDevicePluginImage).
+			WithEnclaveLimit(testCase.enclaveLimit).
+			WithProvisionLimit(testCase.provisionLimit)
+
+		if testCase.expectedError != "" {
+			assert.EqualError(t, testBuilder.GetError(), testCase.expectedError)
+
+			continue
+		}
+
+		assert.NoError(t, testBuilder.GetError())
+		assert.Equal(t, testCase.enclaveLimit, testBuilder.Definition.Spec.EnclaveLimit)
+		assert.Equal(t, testCase.provisionLimit, testBuilder.Definition.Spec.ProvisionLimit)
+	}
+}
+
+func TestSgxDevicePluginWaitUntilReady(t *testing.T) {
+	t.Parallel()
+
+	testBuilder := NewSgxDevicePluginBuilder(buildTestClientWithNodes(
+		buildDummySgxDevicePlugin(true), buildDummyNode(defaultNodeName, SGXEnclaveResource, 110)),
+		defaultDevicePluginName, defaultDevicePluginImage)
+
+	assert.True(t, testBuilder.IsReady())
+	assert.NoError(t, testBuilder.WaitUntilReady(time.Second))
+	assert.NoError(t, testBuilder.WaitUntilAllocatable(SGXEnclaveResource, 1, time.Second))
+}
+
+// buildDummySgxDevicePlugin returns a SgxDevicePlugin scheduled on the default node.
+func buildDummySgxDevicePlugin(ready bool) *idpv1.SgxDevicePlugin {
+	return &idpv1.SgxDevicePlugin{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: defaultDevicePluginName,
+		},
+		Spec: idpv1.SgxDevicePluginSpec{
+			Image: defaultDevicePluginImage,
+		},
+		Status: idpv1.SgxDevicePluginStatus(buildDummyDevicePluginStatus(ready)),
+	}
+}
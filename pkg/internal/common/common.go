@@ -133,7 +133,7 @@ func NewClusterScopedBuilder[O, B any, SO ObjectPointer[O], SB BuilderPointer[B,
 		return builder
 	}
 
-	err := schemeAttacher(apiClient.Scheme())
+	err := attachScheme(apiClient, schemeAttacher)
 	if err != nil {
 		klog.V(100).Infof("Failed to attach scheme for %s: %v", resourceKey.String(), err)
 
@@ -155,9 +155,12 @@ func NewClusterScopedBuilder[O, B any, SO ObjectPointer[O], SB BuilderPointer[B,
 
 // NewNamespacedBuilder creates a new builder for a namespaced resource. It is generic over the actual builder type and
 // uses the methods from the Builder interface to create the actual builder. Generic parameters are ordered so that SO
-// and SB can be elided and only O and B must be provided.
+// and SB can be elided and only O and B must be provided. If nsname is empty, the default namespace of the client is
+// used, if it has one.
 func NewNamespacedBuilder[O, B any, SO ObjectPointer[O], SB BuilderPointer[B, O, SO]](
 	apiClient runtimeclient.Client, schemeAttacher clients.SchemeAttacher, name, nsname string) SB {
+	nsname = resolveNamespace(apiClient, nsname)
+
 	var builder SB = new(B)
 
 	if mixinAttacher, ok := any(builder).(MixinAttacher); ok {
@@ -182,7 +185,7 @@ func NewNamespacedBuilder[O, B any, SO ObjectPointer[O], SB BuilderPointer[B, O,
 		return builder
 	}
 
-	err := schemeAttacher(apiClient.Scheme())
+	err := attachScheme(apiClient, schemeAttacher)
 	if err != nil {
 		klog.V(100).Infof("Failed to attach scheme for %s: %v", resourceKey.String(), err)
 
@@ -236,7 +239,7 @@ func PullClusterScopedBuilder[O, B any, SO ObjectPointer[O], SB BuilderPointer[B
 		return nil, errors.NewAPIClientNil(resourceKey)
 	}
 
-	err := schemeAttacher(apiClient.Scheme())
+	err := attachScheme(apiClient, schemeAttacher)
 	if err != nil {
 		klog.V(100).Infof("Failed to attach scheme for %s: %v", resourceKey.String(), err)
 
@@ -264,9 +267,12 @@ func PullClusterScopedBuilder[O, B any, SO ObjectPointer[O], SB BuilderPointer[B
 
 // PullNamespacedBuilder creates a new Builder for a namespaced resource, pulling the resource from the cluster.
 // It is generic over the actual builder type and uses the methods from the Builder interface to create the actual
-// builder. Generic parameters are ordered so that SO and SB can be elided and only O and B must be provided.
+// builder. Generic parameters are ordered so that SO and SB can be elided and only O and B must be provided. If nsname
+// is empty, the default namespace of the client is used, if it has one.
 func PullNamespacedBuilder[O, B any, SO ObjectPointer[O], SB BuilderPointer[B, O, SO]](
 	ctx context.Context, apiClient runtimeclient.Client, schemeAttacher clients.SchemeAttacher, name, nsname string) (SB, error) {
+	nsname = resolveNamespace(apiClient, nsname)
+
 	var builder SB = new(B)
 
 	if mixinAttacher, ok := any(builder).(MixinAttacher); ok {
@@ -289,7 +295,7 @@ func PullNamespacedBuilder[O, B any, SO ObjectPointer[O], SB BuilderPointer[B, O
 		return nil, errors.NewAPIClientNil(resourceKey)
 	}
 
-	err := schemeAttacher(apiClient.Scheme())
+	err := attachScheme(apiClient, schemeAttacher)
 	if err != nil {
 		klog.V(100).Infof("Failed to attach scheme for %s: %v", resourceKey.String(), err)
 
@@ -394,6 +400,9 @@ func Delete[O any, SO ObjectPointer[O]](ctx context.Context, builder Builder[O,
 // error is because the resource did not exist, returning with an error if so. If the error is for any other reason, the
 // behavior depends on the force flag.
 //
+// Without force, builders implementing ImmutableFieldsProvider have their immutable fields compared against the latest
+// object first, returning an error for which errors.IsImmutableFieldChange is true rather than sending the update.
+//
 // If force is true, the resource will be deleted and recreated. Otherwise, the error is wrapped and returned without
 // modifying the builder. It is generally discouraged to use the force flag since finalizers may cause unexpected side
 // effects and most update errors can be resolved by retrying on conflict.
@@ -413,6 +422,12 @@ func Update[O any, SO ObjectPointer[O]](ctx context.Context, builder Builder[O,
 		return fmt.Errorf("failed get latest object for update: %w", err)
 	}
 
+	if !force {
+		if err := validateBuilderImmutableFields(builder, latestObject); err != nil {
+			return err
+		}
+	}
+
 	builder.GetDefinition().SetResourceVersion(latestObject.GetResourceVersion())
 
 	err = builder.GetClient().Update(logging.WithDiscardLogger(ctx), builder.GetDefinition())
@@ -447,6 +462,15 @@ func Update[O any, SO ObjectPointer[O]](ctx context.Context, builder Builder[O,
 
 // Create creates the definition on the cluster. If the resource already exists, this is a no-op.
 func Create[O any, SO ObjectPointer[O]](ctx context.Context, builder Builder[O, SO]) error {
+	return CreateWithOptions(ctx, builder)
+}
+
+// CreateWithOptions creates the definition on the cluster like Create, with the options changing how failed requests
+// are handled, such as WithQuotaRetry. If the resource already exists, this is a no-op. Requests rejected by quota
+// admission are also retried if the client was created using clients.Settings.QuotaRetryClient, unless the options
+// set otherwise.
+func CreateWithOptions[O any, SO ObjectPointer[O]](
+	ctx context.Context, builder Builder[O, SO], options ...CreateOption) error {
 	if err := Validate(builder); err != nil {
 		return err
 	}
@@ -458,7 +482,7 @@ func Create[O any, SO ObjectPointer[O]](ctx context.Context, builder Builder[O,
 	// Create requests will be rejected if the resource version is set, so we clear it.
 	builder.GetDefinition().SetResourceVersion("")
 
-	err := builder.GetClient().Create(logging.WithDiscardLogger(ctx), builder.GetDefinition())
+	err := createWithRetry(ctx, builder, newCreateOptions(builder.GetClient(), options))
 	if err == nil {
 		builder.SetObject(builder.GetDefinition())
 
@@ -545,7 +569,7 @@ func List[O, L, B any, SO ObjectPointer[O], SL ListPointer[L], SB BuilderPointer
 		return nil, errors.NewAPIClientNil(resourceKey)
 	}
 
-	err := schemeAttacher(apiClient.Scheme())
+	err := attachScheme(apiClient, schemeAttacher)
 	if err != nil {
 		klog.V(100).Infof("Failed to attach scheme for listing %s: %v", resourceKey.String(), err)
 
@@ -686,8 +710,76 @@ func WithOptions[O, B any, SO ObjectPointer[O], SB BuilderPointer[B, O, SO], AO
 	return builder
 }
 
+// WithDefaults returns a new builder with the provided functional options applied, leaving the original builder
+// untouched. The new builder has a deep copy of the definition and object of the original, so a single builder may
+// serve as the base for many configurations, such as the entries of a table-driven test, even when they run in
+// parallel. If the original builder is invalid, it is returned as is. If any option returns an error, the error is set
+// on the new builder only and subsequent options are not applied. Nil options are skipped.
+func WithDefaults[O, B any, SO ObjectPointer[O], SB BuilderPointer[B, O, SO], AO AdditionalOption[SB]](
+	builder SB, options ...AO) SB {
+	if err := Validate(builder); err != nil {
+		return builder
+	}
+
+	klog.V(100).Infof("Copying %s to apply %d options", NewResourceKeyFromBuilder(builder).String(), len(options))
+
+	return WithOptions[O, B, SO, SB, AO](copyBuilder[O, B, SO, SB](builder), options...)
+}
+
+// copyBuilder returns a new builder with the same client and GVK as builder and deep copies of its definition and
+// object. Mixins of the new builder are attached to it rather than to the original.
+func copyBuilder[O, B any, SO ObjectPointer[O], SB BuilderPointer[B, O, SO]](builder SB) SB {
+	var builderCopy SB = new(B)
+
+	if mixinAttacher, ok := any(builderCopy).(MixinAttacher); ok {
+		mixinAttacher.AttachMixins()
+	}
+
+	builderCopy.SetGVK(builder.GetGVK())
+	builderCopy.SetClient(builder.GetClient())
+	builderCopy.SetError(builder.GetError())
+	builderCopy.SetDefinition(deepCopyObject(builder.GetDefinition()))
+	builderCopy.SetObject(deepCopyObject(builder.GetObject()))
+
+	return builderCopy
+}
+
+// deepCopyObject returns a deep copy of object, or nil if object is nil.
+func deepCopyObject[O any, SO ObjectPointer[O]](object SO) SO {
+	if object == nil {
+		return nil
+	}
+
+	objectCopy, ok := object.DeepCopyObject().(SO)
+	if !ok {
+		return nil
+	}
+
+	return objectCopy
+}
+
 // isInterfaceNil checks if the interface is nil. It checks both equality against nil and the reflect.Value.IsNil
 // method. This ensures that neither the interface nor its concrete value are nil.
 func isInterfaceNil(v any) bool {
 	return v == nil || reflect.ValueOf(v).IsNil()
 }
+
+// attachScheme attaches the scheme to the client. When the client is a clients.Settings, attachers already applied to
+// it are skipped, otherwise the attacher is applied to the scheme of the client directly.
+func attachScheme(apiClient runtimeclient.Client, schemeAttacher clients.SchemeAttacher) error {
+	if settings, ok := apiClient.(*clients.Settings); ok {
+		return settings.AttachScheme(schemeAttacher)
+	}
+
+	return schemeAttacher(apiClient.Scheme())
+}
+
+// resolveNamespace returns nsname, or the default namespace of apiClient if it is empty and apiClient is a
+// *clients.Settings created using NamespacedClient.
+func resolveNamespace(apiClient runtimeclient.Client, nsname string) string {
+	if settings, ok := apiClient.(*clients.Settings); ok {
+		return settings.ResolveNamespace(nsname)
+	}
+
+	return nsname
+}
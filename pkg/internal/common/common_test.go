@@ -10,6 +10,7 @@ import (
 	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -42,6 +43,33 @@ func TestNewNamespacedBuilder(t *testing.T) {
 	testhelper.NewGenericNamespacedBuilderTestConfig(commonConfig, common.NewNamespacedBuilder).ExecuteTests(t)
 }
 
+func TestNewNamespacedBuilderWithDefaultNamespace(t *testing.T) {
+	t.Parallel()
+
+	testSettings, err := clients.GetTestClients(clients.TestClientParams{
+		K8sMockObjects: []runtime.Object{&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-name", Namespace: "default-ns"},
+		}},
+		SchemeAttachers: []clients.SchemeAttacher{testSchemeAttacher},
+	}).NamespacedClient("default-ns")
+	require.NoError(t, err)
+
+	builder := common.NewNamespacedBuilder[corev1.ConfigMap, mockNamespacedBuilder](
+		testSettings, testSchemeAttacher, "test-name", "")
+	assert.NoError(t, builder.GetError())
+	assert.Equal(t, "default-ns", builder.GetDefinition().Namespace)
+
+	builder = common.NewNamespacedBuilder[corev1.ConfigMap, mockNamespacedBuilder](
+		testSettings, testSchemeAttacher, "test-name", "explicit-ns")
+	assert.NoError(t, builder.GetError())
+	assert.Equal(t, "explicit-ns", builder.GetDefinition().Namespace)
+
+	pulledBuilder, err := common.PullNamespacedBuilder[corev1.ConfigMap, mockNamespacedBuilder](
+		t.Context(), testSettings, testSchemeAttacher, "test-name", "")
+	require.NoError(t, err)
+	assert.Equal(t, "default-ns", pulledBuilder.GetDefinition().Namespace)
+}
+
 func TestPullClusterScopedBuilder(t *testing.T) {
 	t.Parallel()
 
@@ -114,6 +142,15 @@ func TestWithOptions(t *testing.T) {
 	testhelper.NewWithOptionsTestConfig(commonConfig).ExecuteTests(t)
 }
 
+func TestWithDefaults(t *testing.T) {
+	t.Parallel()
+
+	commonConfig := testhelper.NewCommonTestConfig[corev1.Namespace, mockClusterScopedBuilder](
+		testSchemeAttacher, clusterScopedGVK, testhelper.ResourceScopeClusterScoped)
+
+	testhelper.NewWithDefaultsTestConfig(commonConfig).ExecuteTests(t)
+}
+
 func TestList(t *testing.T) {
 	t.Parallel()
 
@@ -275,6 +312,13 @@ func (builder *mockClusterScopedBuilder) WithOptions(
 	return common.WithOptions(builder, options...)
 }
 
+// WithDefaults delegates to the common package implementation for tests that exercise the generic helper.
+func (builder *mockClusterScopedBuilder) WithDefaults(
+	options ...func(*mockClusterScopedBuilder) (*mockClusterScopedBuilder, error),
+) *mockClusterScopedBuilder {
+	return common.WithDefaults(builder, options...)
+}
+
 // mockNamespacedBuilder implements the Builder interface for testing using a namespaced resource.
 type mockNamespacedBuilder struct {
 	common.EmbeddableBuilder[corev1.ConfigMap, *corev1.ConfigMap]
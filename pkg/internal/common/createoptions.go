@@ -0,0 +1,121 @@
+package common
+
+import (
+	"context"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/logging"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/klog/v2"
+	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// quotaErrorMessages are the messages of the errors returned by the ResourceQuota admission plugin when a request is
+// rejected because the quota, including quotas scoped to priority classes, is exhausted or not yet computed. Once
+// other resources are deleted or the quota controller catches up, the same request succeeds.
+var quotaErrorMessages = []string{"exceeded quota", "status unknown for quota", "insufficient quota"}
+
+// CreateOption changes how CreateWithOptions handles failed create requests.
+type CreateOption func(*createOptions)
+
+// createOptions holds the settings applied by the create options.
+type createOptions struct {
+	// quotaRetryTimeout is how long to retry requests rejected by quota admission. Zero disables retrying.
+	quotaRetryTimeout time.Duration
+	// quotaRetryBackoff is the backoff between retries of requests rejected by quota admission.
+	quotaRetryBackoff wait.Backoff
+}
+
+// WithQuotaRetry retries creating the resource with exponential backoff, starting at one second and capped at thirty
+// seconds, for up to timeout while the request is rejected because a ResourceQuota is exhausted or not yet computed, or
+// because the API server is throttling requests. This suits scale tests that intentionally run close to quota limits. A
+// timeout of zero disables retrying, even if the client was created using clients.Settings.QuotaRetryClient.
+// Other errors, such as validation errors or missing permissions, are permanent and returned immediately. If the
+// request is still rejected when timeout elapses, the last error is returned.
+func WithQuotaRetry(timeout time.Duration) CreateOption {
+	return func(options *createOptions) {
+		options.quotaRetryTimeout = timeout
+	}
+}
+
+// newCreateOptions returns the settings applied by the options, skipping any nil option. The quota retry timeout
+// defaults to the one of the client, if it is a *clients.Settings.
+func newCreateOptions(apiClient runtimeclient.Client, options []CreateOption) *createOptions {
+	createOptions := &createOptions{
+		quotaRetryBackoff: wait.Backoff{
+			Duration: time.Second,
+			Factor:   2,
+			Jitter:   0.1,
+			Steps:    math.MaxInt32,
+			Cap:      30 * time.Second,
+		},
+	}
+
+	if settings, ok := apiClient.(*clients.Settings); ok {
+		createOptions.quotaRetryTimeout = settings.QuotaRetryTimeout()
+	}
+
+	for _, option := range options {
+		if option != nil {
+			option(createOptions)
+		}
+	}
+
+	return createOptions
+}
+
+// IsQuotaError returns whether the error is a transient rejection of a request by quota admission or API priority and
+// fairness, which may succeed if retried later, rather than a permanent error.
+func IsQuotaError(err error) bool {
+	if k8serrors.IsTooManyRequests(err) {
+		return true
+	}
+
+	if !k8serrors.IsForbidden(err) {
+		return false
+	}
+
+	message := err.Error()
+
+	for _, quotaMessage := range quotaErrorMessages {
+		if strings.Contains(message, quotaMessage) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// createWithRetry sends the create request for the definition of the builder, retrying quota errors as configured by
+// the options. It returns the error of the last request.
+func createWithRetry[O any, SO ObjectPointer[O]](
+	ctx context.Context, builder Builder[O, SO], options *createOptions) error {
+	if options.quotaRetryTimeout <= 0 {
+		return builder.GetClient().Create(logging.WithDiscardLogger(ctx), builder.GetDefinition())
+	}
+
+	key := NewResourceKeyFromBuilder(builder)
+
+	retryCtx, cancel := context.WithTimeout(ctx, options.quotaRetryTimeout)
+	defer cancel()
+
+	var err error
+
+	// Each request uses the parent context so the last one is not cut short by the retry timeout.
+	_ = wait.ExponentialBackoffWithContext(retryCtx, options.quotaRetryBackoff, func(context.Context) (bool, error) {
+		err = builder.GetClient().Create(logging.WithDiscardLogger(ctx), builder.GetDefinition())
+		if err != nil && IsQuotaError(err) {
+			klog.V(100).Infof("Creating %s was rejected by quota admission, retrying: %v", key.String(), err)
+
+			return false, nil
+		}
+
+		return true, nil
+	})
+
+	return err
+}
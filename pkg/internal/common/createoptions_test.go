@@ -0,0 +1,168 @@
+package common_test
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+)
+
+var (
+	errTestQuotaExceeded = k8serrors.NewForbidden(schema.GroupResource{Resource: "namespaces"}, "test-name",
+		errors.New("exceeded quota: scale-quota, requested: count/namespaces=1, used: count/namespaces=10, "+
+			"limited: count/namespaces=10"))
+	errTestInvalid = k8serrors.NewInvalid(
+		schema.GroupKind{Kind: "Namespace"}, "test-name", nil)
+)
+
+func TestIsQuotaError(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		err      error
+		expected bool
+	}{
+		{name: "exceeded quota", err: errTestQuotaExceeded, expected: true},
+		{
+			name: "quota not computed",
+			err: k8serrors.NewForbidden(schema.GroupResource{Resource: "pods"}, "test-pod",
+				errors.New("status unknown for quota: scale-quota, resource: pods")),
+			expected: true,
+		},
+		{
+			name: "insufficient scoped quota",
+			err: k8serrors.NewForbidden(schema.GroupResource{Resource: "pods"}, "test-pod",
+				errors.New("insufficient quota to match these scopes: [{PriorityClass In [high]}]")),
+			expected: true,
+		},
+		{name: "throttled", err: k8serrors.NewTooManyRequests("too many requests", 1), expected: true},
+		{
+			name: "missing permissions",
+			err: k8serrors.NewForbidden(schema.GroupResource{Resource: "pods"}, "test-pod",
+				errors.New("User \"test\" cannot create resource \"pods\"")),
+		},
+		{name: "invalid", err: errTestInvalid},
+		{name: "nil"},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, testCase.expected, common.IsQuotaError(testCase.err))
+		})
+	}
+}
+
+func TestCreateWithOptionsQuotaRetry(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name             string
+		createErrors     []error
+		quotaRetryClient time.Duration
+		options          []common.CreateOption
+		expectedCreates  int32
+		expectedError    error
+	}{
+		{
+			name:            "no retry by default",
+			createErrors:    []error{errTestQuotaExceeded},
+			expectedCreates: 1,
+			expectedError:   errTestQuotaExceeded,
+		},
+		{
+			name:            "retry option",
+			createErrors:    []error{errTestQuotaExceeded},
+			options:         []common.CreateOption{nil, common.WithQuotaRetry(time.Minute)},
+			expectedCreates: 2,
+		},
+		{
+			name:             "retry client",
+			createErrors:     []error{errTestQuotaExceeded},
+			quotaRetryClient: time.Minute,
+			expectedCreates:  2,
+		},
+		{
+			name:             "retry disabled by option",
+			createErrors:     []error{errTestQuotaExceeded},
+			quotaRetryClient: time.Minute,
+			options:          []common.CreateOption{common.WithQuotaRetry(0)},
+			expectedCreates:  1,
+			expectedError:    errTestQuotaExceeded,
+		},
+		{
+			name:            "permanent error",
+			createErrors:    []error{errTestInvalid},
+			options:         []common.CreateOption{common.WithQuotaRetry(time.Minute)},
+			expectedCreates: 1,
+			expectedError:   errTestInvalid,
+		},
+		{
+			name: "retry timeout",
+			createErrors: []error{
+				errTestQuotaExceeded, errTestQuotaExceeded, errTestQuotaExceeded, errTestQuotaExceeded,
+			},
+			options:         []common.CreateOption{common.WithQuotaRetry(1500 * time.Millisecond)},
+			expectedCreates: 2,
+			expectedError:   errTestQuotaExceeded,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			var creates atomic.Int32
+
+			testSettings := clients.GetTestClients(clients.TestClientParams{
+				SchemeAttachers: []clients.SchemeAttacher{testSchemeAttacher},
+				InterceptorFuncs: interceptor.Funcs{
+					Create: func(ctx context.Context, client runtimeclient.WithWatch, obj runtimeclient.Object,
+						opts ...runtimeclient.CreateOption) error {
+						attempt := int(creates.Add(1)) - 1
+						if attempt < len(testCase.createErrors) {
+							return testCase.createErrors[attempt]
+						}
+
+						return client.Create(ctx, obj, opts...)
+					},
+				},
+			})
+
+			if testCase.quotaRetryClient > 0 {
+				var err error
+
+				testSettings, err = testSettings.QuotaRetryClient(testCase.quotaRetryClient)
+				assert.NoError(t, err)
+			}
+
+			builder := common.NewClusterScopedBuilder[corev1.Namespace, mockClusterScopedBuilder](
+				testSettings, testSchemeAttacher, "test-name")
+
+			err := common.CreateWithOptions(t.Context(), builder, testCase.options...)
+			assert.Equal(t, testCase.expectedCreates, creates.Load())
+
+			if testCase.expectedError != nil {
+				assert.ErrorIs(t, err, testCase.expectedError)
+				assert.Nil(t, builder.GetObject())
+
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.NotNil(t, builder.GetObject())
+		})
+	}
+}
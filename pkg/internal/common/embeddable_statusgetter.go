@@ -0,0 +1,39 @@
+package common
+
+import "context"
+
+// EmbeddableConditionGetter is a mixin which provides the GetCondition method to the embedding builder. The type
+// parameter C is the type of the conditions in the Status of the resource, such as metav1.Condition.
+type EmbeddableConditionGetter[C, O any, SO ObjectPointer[O]] struct {
+	base Builder[O, SO]
+}
+
+// SetBase sets the base builder for the mixin. When the GetCondition method is called, the common GetCondition method
+// will be called on the base builder.
+func (getter *EmbeddableConditionGetter[C, O, SO]) SetBase(base Builder[O, SO]) {
+	getter.base = base
+}
+
+// GetCondition pulls the resource from the cluster and returns its condition of type condType. An error is returned
+// if the resource cannot be pulled or does not have a condition of type condType. It does not modify the builder.
+func (getter *EmbeddableConditionGetter[C, O, SO]) GetCondition(condType string) (*C, error) {
+	return GetCondition[C](context.TODO(), getter.base, condType)
+}
+
+// EmbeddablePhaseGetter is a mixin which provides the GetPhase method to the embedding builder. It should only be
+// embedded by builders whose resource has a string Status.Phase.
+type EmbeddablePhaseGetter[O any, SO ObjectPointer[O]] struct {
+	base Builder[O, SO]
+}
+
+// SetBase sets the base builder for the mixin. When the GetPhase method is called, the common GetPhase method will be
+// called on the base builder.
+func (getter *EmbeddablePhaseGetter[O, SO]) SetBase(base Builder[O, SO]) {
+	getter.base = base
+}
+
+// GetPhase pulls the resource from the cluster and returns its Status.Phase. An error is returned if the resource
+// cannot be pulled. It does not modify the builder.
+func (getter *EmbeddablePhaseGetter[O, SO]) GetPhase() (string, error) {
+	return GetPhase(context.TODO(), getter.base)
+}
@@ -20,3 +20,10 @@ func (mixin *EmbeddableWithOptions[O, B, SO, SB, AO]) SetBase(base SB) {
 func (mixin *EmbeddableWithOptions[O, B, SO, SB, AO]) WithOptions(options ...AO) SB {
 	return WithOptions[O, B, SO, SB, AO](mixin.base, options...)
 }
+
+// WithDefaults returns a new builder with the provided functional options applied, leaving the builder it is called on
+// untouched. This allows a single builder to be the base for many independent configurations, such as the entries of a
+// table-driven test.
+func (mixin *EmbeddableWithOptions[O, B, SO, SB, AO]) WithDefaults(options ...AO) SB {
+	return WithDefaults[O, B, SO, SB, AO](mixin.base, options...)
+}
@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"reflect"
+	"strings"
 
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common/key"
 )
@@ -207,3 +208,84 @@ func IsItemTypeMismatch(err error) bool {
 
 	return errors.As(err, &itemTypeMismatch)
 }
+
+type invalidMetadataError struct {
+	field   MetadataField
+	key     string
+	hasKey  bool
+	reasons []string
+}
+
+var _ error = (*invalidMetadataError)(nil)
+
+// MetadataField is a type that represents a metadata map of a K8s resource whose entries are validated.
+type MetadataField string
+
+const (
+	// MetadataFieldLabel corresponds to the Labels field of the ObjectMeta.
+	MetadataFieldLabel MetadataField = "label"
+	// MetadataFieldAnnotation corresponds to the Annotations field of the ObjectMeta.
+	MetadataFieldAnnotation MetadataField = "annotation"
+)
+
+// NewInvalidMetadata creates a new error that indicates that the entry with the given key of a metadata map does not
+// follow the Kubernetes syntax rules.
+func NewInvalidMetadata(field MetadataField, key string, reasons []string) *invalidMetadataError {
+	return &invalidMetadataError{field: field, key: key, hasKey: true, reasons: reasons}
+}
+
+// NewInvalidMetadataMap creates a new error that indicates that a metadata map as a whole does not follow the
+// Kubernetes syntax rules, such as by exceeding its total size limit.
+func NewInvalidMetadataMap(field MetadataField, reasons []string) *invalidMetadataError {
+	return &invalidMetadataError{field: field, reasons: reasons}
+}
+
+func (e *invalidMetadataError) Error() string {
+	if !e.hasKey {
+		return fmt.Sprintf("invalid %ss: %s", e.field, strings.Join(e.reasons, "; "))
+	}
+
+	return fmt.Sprintf("invalid %s %q: %s", e.field, e.key, strings.Join(e.reasons, "; "))
+}
+
+// IsInvalidLabel returns true if an error, or any error in the error's tree, is due to a label not following the
+// Kubernetes syntax rules.
+func IsInvalidLabel(err error) bool {
+	var invalidMetadata *invalidMetadataError
+
+	return errors.As(err, &invalidMetadata) && invalidMetadata.field == MetadataFieldLabel
+}
+
+// IsInvalidAnnotation returns true if an error, or any error in the error's tree, is due to an annotation not
+// following the Kubernetes syntax rules.
+func IsInvalidAnnotation(err error) bool {
+	var invalidMetadata *invalidMetadataError
+
+	return errors.As(err, &invalidMetadata) && invalidMetadata.field == MetadataFieldAnnotation
+}
+
+type immutableFieldChangeError struct {
+	resourceKey key.ResourceKey
+	fields      []string
+}
+
+var _ error = (*immutableFieldChangeError)(nil)
+
+// NewImmutableFieldChange creates a new error that indicates that an update would change fields of a resource which
+// cannot be changed once it is created. The fields are the paths of the changed fields, such as spec.selector.
+func NewImmutableFieldChange(resourceKey key.ResourceKey, fields []string) *immutableFieldChangeError {
+	return &immutableFieldChangeError{resourceKey: resourceKey, fields: fields}
+}
+
+func (e *immutableFieldChangeError) Error() string {
+	return fmt.Sprintf("cannot update immutable fields of %s: %s; the resource must be deleted and recreated instead",
+		e.resourceKey.String(), strings.Join(e.fields, ", "))
+}
+
+// IsImmutableFieldChange returns true if an error, or any error in the error's tree, is due to an update changing
+// immutable fields of a resource.
+func IsImmutableFieldChange(err error) bool {
+	var immutableFieldChange *immutableFieldChangeError
+
+	return errors.As(err, &immutableFieldChange)
+}
@@ -0,0 +1,103 @@
+package common
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common/errors"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common/key"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/klog/v2"
+)
+
+// ImmutableFieldsProvider is an optional interface for builders whose resources have fields which cannot be changed
+// once the resource is created. When the builder implements it, Update compares these fields between the definition
+// and the resource on the cluster before sending the request, so changing them fails with an error for which
+// errors.IsImmutableFieldChange is true rather than with a rejection from the API server. Force updates are not
+// checked since they delete and recreate the resource when the update is rejected.
+type ImmutableFieldsProvider[O any, SO ObjectPointer[O]] interface {
+	// GetImmutableFields returns the paths of the immutable fields, given the latest version of the resource on the
+	// cluster. Paths are the JSON field names separated by dots, such as spec.selector.
+	GetImmutableFields(latest SO) []string
+}
+
+// ValidateImmutableFields compares the fields at the provided paths between latest, the resource on the cluster, and
+// definition, the resource about to be sent in an update, returning an error listing the fields which differ. Fields
+// not set in the definition are skipped since the API server keeps or defaults them, but setting a field which is
+// empty on the cluster is reported. This allows builders which do not use the common Update to check immutable fields
+// before updating.
+func ValidateImmutableFields(resourceKey key.ResourceKey, latest, definition runtime.Object, fields ...string) error {
+	if len(fields) == 0 {
+		return nil
+	}
+
+	latestContent, err := runtime.DefaultUnstructuredConverter.ToUnstructured(latest)
+	if err != nil {
+		return fmt.Errorf("failed to convert latest %s to compare immutable fields: %w", resourceKey.String(), err)
+	}
+
+	definitionContent, err := runtime.DefaultUnstructuredConverter.ToUnstructured(definition)
+	if err != nil {
+		return fmt.Errorf("failed to convert definition of %s to compare immutable fields: %w", resourceKey.String(), err)
+	}
+
+	var changedFields []string
+
+	for _, field := range fields {
+		path := strings.Split(field, ".")
+
+		definitionValue, _, _ := unstructured.NestedFieldNoCopy(definitionContent, path...)
+		latestValue, _, _ := unstructured.NestedFieldNoCopy(latestContent, path...)
+
+		if definitionValue == nil {
+			continue
+		}
+
+		if isEmptyFieldValue(definitionValue) && isEmptyFieldValue(latestValue) {
+			continue
+		}
+
+		if !reflect.DeepEqual(definitionValue, latestValue) {
+			changedFields = append(changedFields, field)
+		}
+	}
+
+	if len(changedFields) > 0 {
+		klog.V(100).Infof("Update of %s changes immutable fields %v", resourceKey.String(), changedFields)
+
+		return errors.NewImmutableFieldChange(resourceKey, changedFields)
+	}
+
+	return nil
+}
+
+// validateBuilderImmutableFields checks the immutable fields of the builder against latest if the builder implements
+// ImmutableFieldsProvider. Otherwise, it does nothing.
+func validateBuilderImmutableFields[O any, SO ObjectPointer[O]](builder Builder[O, SO], latest SO) error {
+	provider, ok := builder.(ImmutableFieldsProvider[O, SO])
+	if !ok {
+		return nil
+	}
+
+	return ValidateImmutableFields(
+		NewResourceKeyFromBuilder(builder), latest, builder.GetDefinition(), provider.GetImmutableFields(latest)...)
+}
+
+// isEmptyFieldValue returns true if the unstructured value is unset or empty, matching how empty fields are omitted
+// from resources returned by the API server.
+func isEmptyFieldValue(value any) bool {
+	switch typedValue := value.(type) {
+	case nil:
+		return true
+	case string:
+		return typedValue == ""
+	case map[string]any:
+		return len(typedValue) == 0
+	case []any:
+		return len(typedValue) == 0
+	default:
+		return false
+	}
+}
@@ -0,0 +1,155 @@
+package common_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common"
+	commonerrors "github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common/errors"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common/key"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/ptr"
+)
+
+const (
+	defaultImmutableName      = "test-name"
+	defaultImmutableNamespace = "test-namespace"
+)
+
+func TestValidateImmutableFields(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name           string
+		latestData     map[string]string
+		definitionData map[string]string
+		fields         []string
+		expectedError  bool
+	}{
+		{
+			name:           "unchanged",
+			latestData:     map[string]string{"key": "value"},
+			definitionData: map[string]string{"key": "value"},
+			fields:         []string{"data", "immutable"},
+		},
+		{
+			name:           "changed",
+			latestData:     map[string]string{"key": "value"},
+			definitionData: map[string]string{"key": "other"},
+			fields:         []string{"data", "immutable"},
+			expectedError:  true,
+		},
+		{
+			name:       "unset in definition",
+			latestData: map[string]string{"key": "value"},
+			fields:     []string{"data"},
+		},
+		{
+			name:           "unset in latest",
+			definitionData: map[string]string{"key": "value"},
+			fields:         []string{"data"},
+			expectedError:  true,
+		},
+		{
+			name:           "changed but not immutable",
+			latestData:     map[string]string{"key": "value"},
+			definitionData: map[string]string{"key": "other"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			latest := buildDummyImmutableConfigMap(testCase.latestData)
+			definition := buildDummyImmutableConfigMap(testCase.definitionData)
+			resourceKey := key.NewResourceKey("ConfigMap", defaultImmutableName, defaultImmutableNamespace)
+
+			err := common.ValidateImmutableFields(resourceKey, latest, definition, testCase.fields...)
+			if !testCase.expectedError {
+				assert.NoError(t, err)
+
+				return
+			}
+
+			assert.True(t, commonerrors.IsImmutableFieldChange(err))
+			assert.EqualError(t, err, "cannot update immutable fields of ConfigMap test-namespace/test-name: data; "+
+				"the resource must be deleted and recreated instead")
+		})
+	}
+}
+
+func TestUpdateImmutableFields(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		data          map[string]string
+		force         bool
+		expectedError bool
+	}{
+		{name: "unchanged", data: map[string]string{"key": "value"}},
+		{name: "changed", data: map[string]string{"key": "other"}, expectedError: true},
+		// Force updates delete and recreate the resource when the update is rejected, so they are not checked.
+		{name: "changed with force", data: map[string]string{"key": "other"}, force: true},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			testSettings := clients.GetTestClients(clients.TestClientParams{
+				K8sMockObjects:  []runtime.Object{buildDummyImmutableConfigMap(map[string]string{"key": "value"})},
+				SchemeAttachers: []clients.SchemeAttacher{testSchemeAttacher},
+			})
+
+			builder := common.NewNamespacedBuilder[corev1.ConfigMap, mockImmutableBuilder](
+				testSettings, testSchemeAttacher, defaultImmutableName, defaultImmutableNamespace)
+			require.NoError(t, builder.GetError())
+
+			builder.Definition.Data = testCase.data
+
+			err := common.Update(context.TODO(), builder, testCase.force)
+			assert.Equal(t, testCase.expectedError, commonerrors.IsImmutableFieldChange(err))
+
+			if !testCase.expectedError {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+// mockImmutableBuilder implements the ImmutableFieldsProvider interface for testing, treating the data of configmaps
+// as immutable.
+type mockImmutableBuilder struct {
+	common.EmbeddableBuilder[corev1.ConfigMap, *corev1.ConfigMap]
+}
+
+var _ common.ImmutableFieldsProvider[corev1.ConfigMap, *corev1.ConfigMap] = (*mockImmutableBuilder)(nil)
+
+// GetGVK returns the GVK for the mock immutable builder.
+func (builder *mockImmutableBuilder) GetGVK() schema.GroupVersionKind {
+	return namespacedGVK
+}
+
+// GetImmutableFields returns the data of the configmap.
+func (builder *mockImmutableBuilder) GetImmutableFields(latest *corev1.ConfigMap) []string {
+	return []string{"data"}
+}
+
+func buildDummyImmutableConfigMap(data map[string]string) *corev1.ConfigMap {
+	return &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      defaultImmutableName,
+			Namespace: defaultImmutableNamespace,
+		},
+		Data:      data,
+		Immutable: ptr.To(true),
+	}
+}
@@ -0,0 +1,58 @@
+package common
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common/errors"
+	"k8s.io/apimachinery/pkg/api/validation"
+	k8svalidation "k8s.io/apimachinery/pkg/util/validation"
+)
+
+// ValidateLabel checks that key is a valid label key, a name of at most 63 characters with an optional DNS subdomain
+// prefix, and that value is a valid label value, at most 63 alphanumeric characters, '-', '_' or '.', beginning and
+// ending with an alphanumeric character. This allows builders to report invalid labels when they are set rather than
+// when the API server rejects the resource.
+func ValidateLabel(key, value string) error {
+	reasons := k8svalidation.IsQualifiedName(key)
+
+	for _, reason := range k8svalidation.IsValidLabelValue(value) {
+		reasons = append(reasons, fmt.Sprintf("value %q: %s", value, reason))
+	}
+
+	if len(reasons) > 0 {
+		return errors.NewInvalidMetadata(errors.MetadataFieldLabel, key, reasons)
+	}
+
+	return nil
+}
+
+// ValidateLabels checks every label using ValidateLabel, returning the error for the first invalid key in sorted order
+// so the error is deterministic.
+func ValidateLabels(labels map[string]string) error {
+	for _, key := range slices.Sorted(maps.Keys(labels)) {
+		if err := ValidateLabel(key, labels[key]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ValidateAnnotations checks that every annotation key is a valid qualified name, ignoring case as the API server does,
+// and that the annotations do not exceed the total size limit of 256 kB. Annotation values may be any string.
+func ValidateAnnotations(annotations map[string]string) error {
+	for _, key := range slices.Sorted(maps.Keys(annotations)) {
+		if reasons := k8svalidation.IsQualifiedName(strings.ToLower(key)); len(reasons) > 0 {
+			return errors.NewInvalidMetadata(errors.MetadataFieldAnnotation, key, reasons)
+		}
+	}
+
+	if err := validation.ValidateAnnotationsSize(annotations); err != nil {
+		return errors.NewInvalidMetadataMap(errors.MetadataFieldAnnotation, []string{err.Error()})
+	}
+
+	return nil
+}
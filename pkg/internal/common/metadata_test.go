@@ -0,0 +1,80 @@
+package common_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common"
+	commonerrors "github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateLabels(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name    string
+		labels  map[string]string
+		invalid bool
+	}{
+		{name: "nil labels", labels: nil},
+		{name: "valid labels", labels: map[string]string{"app": "test", "node-role.kubernetes.io/worker": ""}},
+		{name: "key with space", labels: map[string]string{"test key": "value"}, invalid: true},
+		{name: "key too long", labels: map[string]string{strings.Repeat("a", 64): "value"}, invalid: true},
+		{name: "invalid prefix", labels: map[string]string{"Example.com/app": "value"}, invalid: true},
+		{name: "value too long", labels: map[string]string{"app": strings.Repeat("a", 64)}, invalid: true},
+		{name: "value with slash", labels: map[string]string{"app": "test/value"}, invalid: true},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := common.ValidateLabels(testCase.labels)
+			assert.Equal(t, testCase.invalid, commonerrors.IsInvalidLabel(err))
+			assert.Equal(t, testCase.invalid, err != nil)
+			assert.False(t, commonerrors.IsInvalidAnnotation(err))
+		})
+	}
+}
+
+func TestValidateAnnotations(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		annotations   map[string]string
+		expectedError string
+	}{
+		{
+			name:        "valid annotations",
+			annotations: map[string]string{"Example.com/Note": "any value / with spaces", "note": strings.Repeat("a", 64)},
+		},
+		{
+			name:          "empty key",
+			annotations:   map[string]string{"": "value"},
+			expectedError: "invalid annotation \"\": name part must be non-empty",
+		},
+		{
+			name:          "too large",
+			annotations:   map[string]string{"note": strings.Repeat("a", 256*1024)},
+			expectedError: "invalid annotations: annotations size 262148 is larger than limit 262144",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := common.ValidateAnnotations(testCase.annotations)
+			if testCase.expectedError == "" {
+				assert.NoError(t, err)
+
+				return
+			}
+
+			assert.True(t, commonerrors.IsInvalidAnnotation(err))
+			assert.ErrorContains(t, err, testCase.expectedError)
+		})
+	}
+}
@@ -0,0 +1,109 @@
+package common
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"k8s.io/klog/v2"
+)
+
+// GetCondition pulls the resource from the cluster and returns the condition of type condType from its
+// Status.Conditions, so simple assertions on conditions do not need a resource-specific helper. The type parameter T is
+// the type of the conditions, such as metav1.Condition or corev1.NamespaceCondition, and must be given explicitly:
+//
+//	condition, err := common.GetCondition[metav1.Condition](ctx, builder, "Available")
+//
+// Conditions are matched by their Type field. An error is returned if the resource cannot be pulled, does not have
+// Status.Conditions of type []T, or does not have a condition of type condType. It does not modify the builder.
+func GetCondition[T, O any, SO ObjectPointer[O]](
+	ctx context.Context, builder Builder[O, SO], condType string) (*T, error) {
+	object, err := Get(ctx, builder)
+	if err != nil {
+		return nil, err
+	}
+
+	key := NewResourceKeyFromBuilder(builder)
+
+	klog.V(100).Infof("Getting condition %s of %s", condType, key.String())
+
+	conditions, err := getStatusField(object, "Conditions", reflect.Slice)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get conditions of %s: %w", key.String(), err)
+	}
+
+	conditionType := reflect.TypeFor[T]()
+	if conditions.Type().Elem() != conditionType {
+		return nil, fmt.Errorf("conditions of %s are of type %s, not %s",
+			key.String(), conditions.Type().Elem(), conditionType)
+	}
+
+	for index := range conditions.Len() {
+		typeField := conditions.Index(index).FieldByName("Type")
+		if !typeField.IsValid() || typeField.Kind() != reflect.String {
+			return nil, fmt.Errorf("conditions of %s do not have a string Type field", key.String())
+		}
+
+		if typeField.String() == condType {
+			condition, _ := conditions.Index(index).Interface().(T)
+
+			return &condition, nil
+		}
+	}
+
+	return nil, fmt.Errorf("condition %s not found for %s", condType, key.String())
+}
+
+// GetPhase pulls the resource from the cluster and returns the value of its Status.Phase, which is expected to be a
+// string type such as corev1.PodPhase. An error is returned if the resource cannot be pulled or does not have a string
+// Status.Phase. It does not modify the builder.
+func GetPhase[O any, SO ObjectPointer[O]](ctx context.Context, builder Builder[O, SO]) (string, error) {
+	object, err := Get(ctx, builder)
+	if err != nil {
+		return "", err
+	}
+
+	key := NewResourceKeyFromBuilder(builder)
+
+	klog.V(100).Infof("Getting phase of %s", key.String())
+
+	phase, err := getStatusField(object, "Phase", reflect.String)
+	if err != nil {
+		return "", fmt.Errorf("failed to get phase of %s: %w", key.String(), err)
+	}
+
+	return phase.String(), nil
+}
+
+// getStatusField returns the field with the given name and kind from the Status field of object, which may be a
+// struct or a pointer to one.
+func getStatusField(object any, name string, kind reflect.Kind) (reflect.Value, error) {
+	objectValue := reflect.Indirect(reflect.ValueOf(object))
+	if objectValue.Kind() != reflect.Struct {
+		return reflect.Value{}, fmt.Errorf("object of type %T is not a struct", object)
+	}
+
+	status := objectValue.FieldByName("Status")
+	if status.Kind() == reflect.Pointer {
+		if status.IsNil() {
+			return reflect.Value{}, fmt.Errorf("status is nil")
+		}
+
+		status = status.Elem()
+	}
+
+	if status.Kind() != reflect.Struct {
+		return reflect.Value{}, fmt.Errorf("object of type %T does not have a status", object)
+	}
+
+	field := status.FieldByName(name)
+	if !field.IsValid() {
+		return reflect.Value{}, fmt.Errorf("status of type %s does not have a %s field", status.Type(), name)
+	}
+
+	if field.Kind() != kind {
+		return reflect.Value{}, fmt.Errorf("status field %s is of kind %s, not %s", name, field.Kind(), kind)
+	}
+
+	return field, nil
+}
@@ -0,0 +1,124 @@
+package common_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common"
+	commonerrors "github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const defaultStatusTestName = "test-resource"
+
+func TestGetCondition(t *testing.T) {
+	t.Parallel()
+
+	namespace := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{Name: defaultStatusTestName},
+		Status: corev1.NamespaceStatus{
+			Phase: corev1.NamespaceTerminating,
+			Conditions: []corev1.NamespaceCondition{{
+				Type:   corev1.NamespaceDeletionContentFailure,
+				Status: corev1.ConditionTrue,
+				Reason: "ContentDeletionFailed",
+			}},
+		},
+	}
+
+	testBuilder := buildStatusTestBuilder(namespace)
+
+	condition, err := common.GetCondition[corev1.NamespaceCondition](
+		context.TODO(), testBuilder, string(corev1.NamespaceDeletionContentFailure))
+	require.NoError(t, err)
+	assert.Equal(t, corev1.ConditionTrue, condition.Status)
+	assert.Equal(t, "ContentDeletionFailed", condition.Reason)
+
+	_, err = common.GetCondition[corev1.NamespaceCondition](
+		context.TODO(), testBuilder, string(corev1.NamespaceDeletionDiscoveryFailure))
+	assert.EqualError(t, err, "condition NamespaceDeletionDiscoveryFailure not found for Namespace test-resource")
+
+	_, err = common.GetCondition[metav1.Condition](
+		context.TODO(), testBuilder, string(corev1.NamespaceDeletionContentFailure))
+	assert.ErrorContains(t, err, "are of type v1.NamespaceCondition, not v1.Condition")
+
+	_, err = common.GetCondition[corev1.NamespaceCondition](
+		context.TODO(), buildStatusTestBuilder(), string(corev1.NamespaceDeletionContentFailure))
+	assert.True(t, commonerrors.IsAPICallFailedWithVerb(err, "get"))
+}
+
+func TestGetPhase(t *testing.T) {
+	t.Parallel()
+
+	namespace := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{Name: defaultStatusTestName},
+		Status:     corev1.NamespaceStatus{Phase: corev1.NamespaceActive},
+	}
+
+	phase, err := common.GetPhase(context.TODO(), buildStatusTestBuilder(namespace))
+	assert.NoError(t, err)
+	assert.Equal(t, string(corev1.NamespaceActive), phase)
+
+	_, err = common.GetPhase(context.TODO(), buildStatusTestBuilder())
+	assert.True(t, commonerrors.IsAPICallFailedWithVerb(err, "get"))
+
+	// ConfigMaps do not have a status, so there is no phase to get.
+	configMap := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: defaultStatusTestName, Namespace: "test-ns"}}
+	configMapBuilder := common.NewNamespacedBuilder[corev1.ConfigMap, mockNamespacedBuilder](
+		clients.GetTestClients(clients.TestClientParams{
+			K8sMockObjects:  []runtime.Object{configMap},
+			SchemeAttachers: []clients.SchemeAttacher{testSchemeAttacher},
+		}), testSchemeAttacher, defaultStatusTestName, "test-ns")
+
+	_, err = common.GetPhase(context.TODO(), configMapBuilder)
+	assert.EqualError(t, err,
+		"failed to get phase of ConfigMap test-ns/test-resource: object of type *v1.ConfigMap does not have a status")
+}
+
+func TestEmbeddableStatusGetters(t *testing.T) {
+	t.Parallel()
+
+	namespace := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{Name: defaultStatusTestName},
+		Status: corev1.NamespaceStatus{
+			Phase: corev1.NamespaceTerminating,
+			Conditions: []corev1.NamespaceCondition{{
+				Type:   corev1.NamespaceDeletionContentFailure,
+				Status: corev1.ConditionTrue,
+			}},
+		},
+	}
+
+	testBuilder := buildStatusTestBuilder(namespace)
+
+	var conditionGetter common.EmbeddableConditionGetter[corev1.NamespaceCondition, corev1.Namespace, *corev1.Namespace]
+
+	conditionGetter.SetBase(testBuilder)
+
+	condition, err := conditionGetter.GetCondition(string(corev1.NamespaceDeletionContentFailure))
+	require.NoError(t, err)
+	assert.Equal(t, corev1.ConditionTrue, condition.Status)
+
+	var phaseGetter common.EmbeddablePhaseGetter[corev1.Namespace, *corev1.Namespace]
+
+	phaseGetter.SetBase(testBuilder)
+
+	phase, err := phaseGetter.GetPhase()
+	assert.NoError(t, err)
+	assert.Equal(t, string(corev1.NamespaceTerminating), phase)
+}
+
+// buildStatusTestBuilder returns a Namespace builder for defaultStatusTestName using a client with the provided
+// objects.
+func buildStatusTestBuilder(objects ...runtime.Object) *mockClusterScopedBuilder {
+	return common.NewClusterScopedBuilder[corev1.Namespace, mockClusterScopedBuilder](
+		clients.GetTestClients(clients.TestClientParams{
+			K8sMockObjects:  objects,
+			SchemeAttachers: []clients.SchemeAttacher{testSchemeAttacher},
+		}), testSchemeAttacher, defaultStatusTestName)
+}
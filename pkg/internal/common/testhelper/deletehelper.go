@@ -9,6 +9,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"k8s.io/apimachinery/pkg/runtime"
+	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
 )
 
@@ -25,13 +26,14 @@ type DeleteReturner[O, B any, SO common.ObjectPointer[O], SB common.BuilderPoint
 }
 
 // internalDeleteFunc is the internal function signature used by DeleteTestConfig. All of the other delete functions
-// must be able to be wrapped in this signature.
+// must be able to be wrapped in this signature. Functions that do not return a builder return the builder they were
+// called with.
 //
 // This type is different than the [GenericDeleteFunc] because it makes stricter assumptions about the builder type that
 // the common package does not. The constructor for the generic version enforces these constraints so they can be made
 // equivalent with a thin wrapper.
 type internalDeleteFunc[
-	O, B any, SO common.ObjectPointer[O], SB common.BuilderPointer[B, O, SO]] func(ctx context.Context, builder SB) error
+	O, B any, SO common.ObjectPointer[O], SB common.BuilderPointer[B, O, SO]] func(ctx context.Context, builder SB) (SB, error)
 
 // GenericDeleteFunc is the signature for the common.Delete function that takes context and builder.
 type GenericDeleteFunc[O any, SO common.ObjectPointer[O]] func(ctx context.Context, builder common.Builder[O, SO]) error
@@ -51,8 +53,8 @@ func NewDeleterTestConfig[O, B any, SO common.ObjectPointer[O], SB Deleter[O, B,
 ) DeleteTestConfig[O, B, SO, SB] {
 	return DeleteTestConfig[O, B, SO, SB]{
 		CommonTestConfig: commonTestConfig,
-		deleteFunc: func(_ context.Context, builder SB) error {
-			return builder.Delete()
+		deleteFunc: func(_ context.Context, builder SB) (SB, error) {
+			return builder, builder.Delete()
 		},
 	}
 }
@@ -63,10 +65,8 @@ func NewDeleteReturnerTestConfig[O, B any, SO common.ObjectPointer[O], SB Delete
 ) DeleteTestConfig[O, B, SO, SB] {
 	return DeleteTestConfig[O, B, SO, SB]{
 		CommonTestConfig: commonTestConfig,
-		deleteFunc: func(_ context.Context, builder SB) error {
-			_, err := builder.Delete()
-
-			return err
+		deleteFunc: func(_ context.Context, builder SB) (SB, error) {
+			return builder.Delete()
 		},
 	}
 }
@@ -79,8 +79,8 @@ func NewGenericDeleteTestConfig[O, B any, SO common.ObjectPointer[O], SB common.
 ) DeleteTestConfig[O, B, SO, SB] {
 	return DeleteTestConfig[O, B, SO, SB]{
 		CommonTestConfig: commonTestConfig,
-		deleteFunc: func(ctx context.Context, builder SB) error {
-			return deleteFunc(ctx, builder)
+		deleteFunc: func(ctx context.Context, builder SB) (SB, error) {
+			return builder, deleteFunc(ctx, builder)
 		},
 	}
 }
@@ -102,6 +102,7 @@ func (config DeleteTestConfig[O, B, SO, SB]) ExecuteTests(t *testing.T) {
 		builderError     error
 		interceptorFuncs interceptor.Funcs
 		assertError      func(error) bool
+		expectRemaining  bool
 	}{
 		{
 			name:         "valid delete existing resource",
@@ -109,21 +110,30 @@ func (config DeleteTestConfig[O, B, SO, SB]) ExecuteTests(t *testing.T) {
 			assertError:  isErrorNil,
 		},
 		{
-			name:         testNameInvalidBuilder,
-			objectExists: true,
-			builderError: errInvalidBuilder,
-			assertError:  isInvalidBuilder,
+			name:            testNameInvalidBuilder,
+			objectExists:    true,
+			builderError:    errInvalidBuilder,
+			assertError:     isInvalidBuilder,
+			expectRemaining: true,
 		},
 		{
 			name:         "resource does not exist succeeds",
 			objectExists: false,
 			assertError:  isErrorNil,
 		},
+		{
+			name:             "resource removed before deletion succeeds",
+			objectExists:     true,
+			interceptorFuncs: interceptor.Funcs{Delete: testNotFoundDelete},
+			assertError:      isErrorNil,
+			expectRemaining:  true,
+		},
 		{
 			name:             "failed deletion returns error",
 			objectExists:     true,
 			interceptorFuncs: interceptor.Funcs{Delete: testFailingDelete},
 			assertError:      isAPICallFailedWithDelete,
+			expectRemaining:  true,
 		},
 	}
 
@@ -131,14 +141,16 @@ func (config DeleteTestConfig[O, B, SO, SB]) ExecuteTests(t *testing.T) {
 		t.Run(testCase.name, func(t *testing.T) {
 			t.Parallel()
 
-			var objects []runtime.Object
+			var (
+				objects   []runtime.Object
+				namespace string
+			)
 
-			if testCase.objectExists {
-				var namespace string
-				if config.ResourceScope.IsNamespaced() {
-					namespace = testResourceNamespace
-				}
+			if config.ResourceScope.IsNamespaced() {
+				namespace = testResourceNamespace
+			}
 
+			if testCase.objectExists {
 				objects = append(objects, buildDummyObject[O, SO](testResourceName, namespace))
 			}
 
@@ -156,13 +168,24 @@ func (config DeleteTestConfig[O, B, SO, SB]) ExecuteTests(t *testing.T) {
 			}
 
 			builder.SetError(testCase.builderError)
+			builder.SetObject(buildDummyObject[O, SO](testResourceName, namespace))
 
-			err := config.deleteFunc(t.Context(), builder)
+			result, err := config.deleteFunc(t.Context(), builder)
 
 			require.Truef(t, testCase.assertError(err), "unexpected error, got: %v", err)
 
 			if err == nil {
-				assert.Nil(t, builder.GetObject())
+				require.NotNil(t, result)
+				assert.Nil(t, result.GetObject())
+			} else {
+				assert.NotNil(t, builder.GetObject())
+			}
+
+			if testCase.objectExists {
+				var remaining SO = new(O)
+
+				err = client.Get(t.Context(), runtimeclient.ObjectKey{Name: testResourceName, Namespace: namespace}, remaining)
+				assert.Equalf(t, testCase.expectRemaining, err == nil, "unexpected get error, got: %v", err)
 			}
 		})
 	}
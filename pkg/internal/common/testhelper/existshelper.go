@@ -9,6 +9,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
 )
 
 // Exister is an interface for builders that have an Exists method.
@@ -71,10 +72,11 @@ func (config ExistsTestConfig[O, B, SO, SB]) ExecuteTests(t *testing.T) {
 	t.Run("scheme attacher adds GVK", createSchemeAttacherGVKTest[O, SO](config.SchemeAttacher, config.ExpectedGVK))
 
 	testCases := []struct {
-		name           string
-		objectExists   bool
-		builderError   error
-		expectedResult bool
+		name             string
+		objectExists     bool
+		builderError     error
+		interceptorFuncs interceptor.Funcs
+		expectedResult   bool
 	}{
 		{
 			name:           "valid exists returns true when resource exists",
@@ -92,6 +94,12 @@ func (config ExistsTestConfig[O, B, SO, SB]) ExecuteTests(t *testing.T) {
 			objectExists:   false,
 			expectedResult: false,
 		},
+		{
+			name:             "get failure returns false",
+			objectExists:     true,
+			interceptorFuncs: interceptor.Funcs{Get: testFailingGet},
+			expectedResult:   false,
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -110,8 +118,9 @@ func (config ExistsTestConfig[O, B, SO, SB]) ExecuteTests(t *testing.T) {
 			}
 
 			client := clients.GetTestClients(clients.TestClientParams{
-				K8sMockObjects:  objects,
-				SchemeAttachers: []clients.SchemeAttacher{config.SchemeAttacher},
+				K8sMockObjects:   objects,
+				SchemeAttachers:  []clients.SchemeAttacher{config.SchemeAttacher},
+				InterceptorFuncs: testCase.interceptorFuncs,
 			})
 
 			var builder SB
@@ -134,6 +143,8 @@ func (config ExistsTestConfig[O, B, SO, SB]) ExecuteTests(t *testing.T) {
 				if config.ResourceScope.IsNamespaced() {
 					assert.Equal(t, testResourceNamespace, builder.GetObject().GetNamespace())
 				}
+			} else {
+				assert.Nil(t, builder.GetObject())
 			}
 		})
 	}
@@ -141,6 +141,7 @@ func (config GetTestConfig[O, B, SO, SB]) ExecuteTests(t *testing.T) {
 			result, err := config.getFunc(t.Context(), builder)
 
 			require.Truef(t, testCase.assertError(err), "unexpected error, got: %v", err)
+			assert.Nil(t, builder.GetObject(), "get should not modify the builder")
 
 			if err == nil {
 				require.NotNil(t, result)
@@ -0,0 +1,123 @@
+// Package interceptors provides ready-made interceptor functions for the fake runtime client so builder unit tests can
+// inject API failures without defining their own closures. Each preset keeps its own call count, so a new set must be
+// created for every test client.
+package interceptors
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"sync/atomic"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+)
+
+// ErrSimulatedUpdate is returned by the update failing presets.
+var ErrSimulatedUpdate = errors.New("simulated update failure")
+
+// FailNthUpdate returns interceptor functions that make the nth call to Update, counting from 1, fail with
+// ErrSimulatedUpdate. All other calls are passed to the fake client. If n is less than 1, no call fails.
+func FailNthUpdate(n int) interceptor.Funcs {
+	var calls atomic.Int64
+
+	return interceptor.Funcs{
+		Update: func(
+			ctx context.Context,
+			client runtimeclient.WithWatch,
+			obj runtimeclient.Object,
+			opts ...runtimeclient.UpdateOption,
+		) error {
+			if calls.Add(1) == int64(n) {
+				return ErrSimulatedUpdate
+			}
+
+			return client.Update(ctx, obj, opts...)
+		},
+	}
+}
+
+// ConflictOnceThenSucceed returns interceptor functions that make the first call to Update fail with a conflict error,
+// as if the object had been modified since it was read. All later calls are passed to the fake client. It is used to
+// test that callers retry on conflicts.
+func ConflictOnceThenSucceed() interceptor.Funcs {
+	var conflicted atomic.Bool
+
+	return interceptor.Funcs{
+		Update: func(
+			ctx context.Context,
+			client runtimeclient.WithWatch,
+			obj runtimeclient.Object,
+			opts ...runtimeclient.UpdateOption,
+		) error {
+			if conflicted.CompareAndSwap(false, true) {
+				return k8serrors.NewConflict(getGroupResource(client, obj), obj.GetName(),
+					errors.New("the object has been modified; please apply your changes to the latest version"))
+			}
+
+			return client.Update(ctx, obj, opts...)
+		},
+	}
+}
+
+// NotFoundOnFirstGet returns interceptor functions that make the first call to Get fail with a not found error, as if
+// the object had not been created yet. All later calls are passed to the fake client. It is used to test that callers
+// wait for objects to appear.
+func NotFoundOnFirstGet() interceptor.Funcs {
+	var missed atomic.Bool
+
+	return interceptor.Funcs{
+		Get: func(
+			ctx context.Context,
+			client runtimeclient.WithWatch,
+			key runtimeclient.ObjectKey,
+			obj runtimeclient.Object,
+			opts ...runtimeclient.GetOption,
+		) error {
+			if missed.CompareAndSwap(false, true) {
+				return k8serrors.NewNotFound(getGroupResource(client, obj), key.Name)
+			}
+
+			return client.Get(ctx, key, obj, opts...)
+		},
+	}
+}
+
+// Merge combines several sets of interceptor functions into one. If more than one set defines the same function, the
+// one from the last set is used.
+func Merge(funcsList ...interceptor.Funcs) interceptor.Funcs {
+	var merged interceptor.Funcs
+
+	mergedValue := reflect.ValueOf(&merged).Elem()
+
+	for _, funcs := range funcsList {
+		funcsValue := reflect.ValueOf(funcs)
+
+		for index := range funcsValue.NumField() {
+			if field := funcsValue.Field(index); !field.IsNil() {
+				mergedValue.Field(index).Set(field)
+			}
+		}
+	}
+
+	return merged
+}
+
+// getGroupResource returns the GroupResource of obj for use in API errors. If it cannot be determined, an empty
+// GroupResource is returned.
+func getGroupResource(client runtimeclient.WithWatch, obj runtimeclient.Object) schema.GroupResource {
+	gvk, err := apiutil.GVKForObject(obj, client.Scheme())
+	if err != nil {
+		return schema.GroupResource{}
+	}
+
+	mapping, err := client.RESTMapper().RESTMapping(gvk.GroupKind(), gvk.Version)
+	if err != nil {
+		return schema.GroupResource{Group: gvk.Group, Resource: gvk.Kind}
+	}
+
+	return mapping.Resource.GroupResource()
+}
@@ -0,0 +1,125 @@
+package interceptors
+
+import (
+	"context"
+	"testing"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+)
+
+const (
+	testConfigMapName      = "test-configmap"
+	testConfigMapNamespace = "test-namespace"
+)
+
+func TestFailNthUpdate(t *testing.T) {
+	testCases := []struct {
+		n              int
+		expectedFailed []bool
+	}{
+		{
+			n:              1,
+			expectedFailed: []bool{true, false, false},
+		},
+		{
+			n:              2,
+			expectedFailed: []bool{false, true, false},
+		},
+		{
+			n:              0,
+			expectedFailed: []bool{false, false, false},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testSettings := buildTestClient(FailNthUpdate(testCase.n))
+		configMap := getTestConfigMap(t, testSettings)
+
+		for _, expectedFailed := range testCase.expectedFailed {
+			err := testSettings.Update(context.TODO(), configMap)
+
+			if expectedFailed {
+				assert.ErrorIs(t, err, ErrSimulatedUpdate)
+			} else {
+				assert.NoError(t, err)
+			}
+		}
+	}
+}
+
+func TestConflictOnceThenSucceed(t *testing.T) {
+	testSettings := buildTestClient(ConflictOnceThenSucceed())
+	configMap := getTestConfigMap(t, testSettings)
+
+	err := testSettings.Update(context.TODO(), configMap)
+	assert.True(t, k8serrors.IsConflict(err))
+
+	err = testSettings.Update(context.TODO(), configMap)
+	assert.NoError(t, err)
+}
+
+func TestNotFoundOnFirstGet(t *testing.T) {
+	testSettings := buildTestClient(NotFoundOnFirstGet())
+	key := runtimeclient.ObjectKey{Name: testConfigMapName, Namespace: testConfigMapNamespace}
+
+	err := testSettings.Get(context.TODO(), key, &corev1.ConfigMap{})
+	assert.True(t, k8serrors.IsNotFound(err))
+
+	err = testSettings.Get(context.TODO(), key, &corev1.ConfigMap{})
+	assert.NoError(t, err)
+}
+
+func TestMerge(t *testing.T) {
+	merged := Merge(NotFoundOnFirstGet(), FailNthUpdate(1), interceptor.Funcs{})
+	assert.NotNil(t, merged.Get)
+	assert.NotNil(t, merged.Update)
+	assert.Nil(t, merged.Create)
+
+	testSettings := buildTestClient(merged)
+	key := runtimeclient.ObjectKey{Name: testConfigMapName, Namespace: testConfigMapNamespace}
+
+	err := testSettings.Get(context.TODO(), key, &corev1.ConfigMap{})
+	assert.True(t, k8serrors.IsNotFound(err))
+
+	configMap := getTestConfigMap(t, testSettings)
+	err = testSettings.Update(context.TODO(), configMap)
+	assert.ErrorIs(t, err, ErrSimulatedUpdate)
+}
+
+func buildTestClient(funcs interceptor.Funcs) *clients.Settings {
+	return clients.GetTestClients(clients.TestClientParams{
+		K8sMockObjects: []runtime.Object{&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: testConfigMapName, Namespace: testConfigMapNamespace},
+		}},
+		SchemeAttachers:  []clients.SchemeAttacher{corev1.AddToScheme},
+		InterceptorFuncs: funcs,
+	})
+}
+
+func getTestConfigMap(t *testing.T, testSettings *clients.Settings) *corev1.ConfigMap {
+	t.Helper()
+
+	configMap := &corev1.ConfigMap{}
+
+	var err error
+
+	// The first Get may be intercepted, so retry once to read the stored object.
+	for range 2 {
+		err = testSettings.Get(context.TODO(), runtimeclient.ObjectKey{
+			Name: testConfigMapName, Namespace: testConfigMapNamespace}, configMap)
+		if err == nil {
+			break
+		}
+	}
+
+	assert.NoError(t, err)
+
+	return configMap
+}
@@ -19,6 +19,7 @@ const (
 	nilClientReturnsError               = "nil client returns error"
 	schemeAttachmentFailureReturnsError = "scheme attachment failure returns error"
 	emptyNamespaceReturnsError          = "empty namespace returns error"
+	emptyNamespaceUsesClientDefault     = "empty namespace uses client default"
 )
 
 // ListInAllNamespacesFunc is a List function signature for listing resources in all namespaces (e.g.,
@@ -149,6 +150,7 @@ func (config ListTestConfig[O, B, SO, SB]) ExecuteTests(t *testing.T) {
 		clientNil        bool
 		schemeAttacher   clients.SchemeAttacher
 		nsname           string
+		defaultNamespace string
 		objectsExist     bool
 		interceptorFuncs interceptor.Funcs
 		assertError      func(error) bool
@@ -216,6 +218,15 @@ func (config ListTestConfig[O, B, SO, SB]) ExecuteTests(t *testing.T) {
 			objectsExist:   false,
 			assertError:    commonerrors.IsBuilderNamespaceEmpty,
 			expectedCount:  0,
+		}, testCase{
+			name:             emptyNamespaceUsesClientDefault,
+			clientNil:        false,
+			schemeAttacher:   config.SchemeAttacher,
+			nsname:           "",
+			defaultNamespace: testResourceNamespace,
+			objectsExist:     true,
+			assertError:      isErrorNil,
+			expectedCount:    2,
 		})
 	}
 
@@ -244,6 +255,13 @@ func (config ListTestConfig[O, B, SO, SB]) ExecuteTests(t *testing.T) {
 					SchemeAttachers:  []clients.SchemeAttacher{config.SchemeAttacher},
 					InterceptorFuncs: testCase.interceptorFuncs,
 				})
+
+				if testCase.defaultNamespace != "" {
+					var err error
+
+					client, err = client.NamespacedClient(testCase.defaultNamespace)
+					require.NoError(t, err)
+				}
 			}
 
 			builders, err := config.listFunc(t.Context(), client, testCase.schemeAttacher, testCase.nsname)
@@ -50,6 +50,20 @@ func NewCommonTestConfig[O, B any, SO common.ObjectPointer[O], SB common.Builder
 	}
 }
 
+// buildTestBuilder creates a valid builder for testing, scoped appropriately for the resource type. Its client has the
+// scheme attacher of the config but no objects, so it suits tests which only inspect the builder.
+func (config CommonTestConfig[O, B, SO, SB]) buildTestBuilder() SB {
+	client := clients.GetTestClients(clients.TestClientParams{
+		SchemeAttachers: []clients.SchemeAttacher{config.SchemeAttacher},
+	})
+
+	if config.ResourceScope.IsNamespaced() {
+		return common.NewNamespacedBuilder[O, B, SO, SB](client, config.SchemeAttacher, testResourceName, testResourceNamespace)
+	}
+
+	return common.NewClusterScopedBuilder[O, B, SO, SB](client, config.SchemeAttacher, testResourceName)
+}
+
 // TestExecutor is implemented by test helper configs that can execute their test suite.
 type TestExecutor interface {
 	ExecuteTests(t *testing.T)
@@ -13,6 +13,7 @@ import (
 	commonerrors "github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
@@ -70,6 +71,17 @@ func testFailingDelete(
 	return errDeleteFailure
 }
 
+// testNotFoundDelete is an interceptor function that always returns a not found error. Used with fake client
+// interceptors to simulate the resource being removed by another client between being read and deleted.
+func testNotFoundDelete(
+	ctx context.Context,
+	client runtimeclient.WithWatch,
+	obj runtimeclient.Object,
+	opts ...runtimeclient.DeleteOption,
+) error {
+	return k8serrors.NewNotFound(schema.GroupResource{}, obj.GetName())
+}
+
 // testFailingUpdate is an interceptor function that always returns errUpdateFailure. Used with fake client interceptors
 // to simulate Kubernetes API update failures.
 func testFailingUpdate(
@@ -0,0 +1,124 @@
+package testhelper
+
+import (
+	"testing"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// WithDefaultsUser is an interface for builders that have a WithDefaults method. AO is the option type, as in
+// WithOptionsUser.
+type WithDefaultsUser[
+	O, B any,
+	SO common.ObjectPointer[O],
+	SB common.BuilderPointer[B, O, SO],
+	AO common.AdditionalOption[SB],
+] interface {
+	common.BuilderPointer[B, O, SO]
+	WithDefaults(options ...AO) SB
+}
+
+// WithDefaultsTestConfig provides the configuration needed to test a WithDefaults method.
+type WithDefaultsTestConfig[
+	O, B any,
+	SO common.ObjectPointer[O],
+	SB common.BuilderPointer[B, O, SO],
+	AO common.AdditionalOption[SB],
+] struct {
+	CommonTestConfig[O, B, SO, SB]
+
+	withDefaultsFunc func(builder SB, options ...AO) SB
+}
+
+// NewWithDefaultsTestConfig creates a new WithDefaultsTestConfig with the given parameters for builders that implement
+// the WithDefaultsUser interface.
+func NewWithDefaultsTestConfig[
+	O, B any,
+	SO common.ObjectPointer[O],
+	SB WithDefaultsUser[O, B, SO, SB, AO],
+	AO common.AdditionalOption[SB],
+](commonTestConfig CommonTestConfig[O, B, SO, SB]) WithDefaultsTestConfig[O, B, SO, SB, AO] {
+	return WithDefaultsTestConfig[O, B, SO, SB, AO]{
+		CommonTestConfig: commonTestConfig,
+		withDefaultsFunc: func(builder SB, options ...AO) SB {
+			return builder.WithDefaults(options...)
+		},
+	}
+}
+
+// Name returns the name to use for running these tests.
+func (config WithDefaultsTestConfig[O, B, SO, SB, AO]) Name() string {
+	return "WithDefaults"
+}
+
+// ExecuteTests runs the standard set of WithDefaults tests for the configured resource. Besides the behavior shared
+// with WithOptions, it verifies that the original builder is never modified.
+func (config WithDefaultsTestConfig[O, B, SO, SB, AO]) ExecuteTests(t *testing.T) {
+	t.Helper()
+
+	testCases := []struct {
+		name         string
+		builderError error
+		options      []AO
+		assertError  func(error) bool
+	}{
+		{
+			name:        "options are applied to a copy",
+			options:     []AO{testAnnotationOption[O, B, SO, SB, AO]()},
+			assertError: isErrorNil,
+		},
+		{
+			name:         "invalid builder is returned as is",
+			builderError: errInvalidBuilder,
+			options:      []AO{testAnnotationOption[O, B, SO, SB, AO]()},
+			assertError:  isInvalidBuilder,
+		},
+		{
+			name:        "error in option is captured in copy",
+			options:     []AO{testFailingOption[O, B, SO, SB, AO]()},
+			assertError: isOptionFailure,
+		},
+		{
+			name:        "nil options are skipped",
+			options:     []AO{nil, testAnnotationOption[O, B, SO, SB, AO](), nil},
+			assertError: isErrorNil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			builder := config.buildTestBuilder()
+			builder.SetError(testCase.builderError)
+			builder.SetObject(builder.GetDefinition().DeepCopyObject().(SO))
+
+			result := config.withDefaultsFunc(builder, testCase.options...)
+
+			require.NotNil(t, result)
+			require.Truef(t, testCase.assertError(result.GetError()), "unexpected error, got: %v", result.GetError())
+			assert.Equal(t, testResourceName, result.GetDefinition().GetName())
+
+			if testCase.builderError != nil {
+				assert.Same(t, builder, result, "invalid builder should be returned as is")
+
+				return
+			}
+
+			assert.NotSame(t, builder, result, "a new builder should be returned")
+			assert.NotSame(t, builder.GetDefinition(), result.GetDefinition(), "definition should be copied")
+			assert.NotSame(t, builder.GetObject(), result.GetObject(), "object should be copied")
+			assert.Equal(t, builder.GetClient(), result.GetClient())
+			assert.Equal(t, builder.GetGVK(), result.GetGVK())
+			assert.NoError(t, builder.GetError(), "original builder should not have an error")
+			assert.NotContains(t, builder.GetDefinition().GetAnnotations(), testAnnotationKey,
+				"original builder should not be modified")
+
+			if result.GetError() == nil {
+				assert.Equal(t, testAnnotationValue, result.GetDefinition().GetAnnotations()[testAnnotationKey])
+			}
+		})
+	}
+}
@@ -0,0 +1,115 @@
+package testhelper
+
+import (
+	"testing"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// WithMethodTestCase describes a single call to a With* method of a builder, such as WithLabel("key", "value").
+type WithMethodTestCase[SO, SB any] struct {
+	// Name is the name of the subtest for this case.
+	Name string
+	// Call invokes the method under test on builder with the inputs of this case and returns the result, for example
+	// func(builder *Builder) *Builder { return builder.WithLabel("key", "value") }.
+	Call func(builder SB) SB
+	// AssertError checks the error set on the builder by an invalid input. If nil, the call is expected to succeed.
+	AssertError func(err error) bool
+	// AssertDefinition checks that a successful call mutated the definition as expected. It is not called when the
+	// call is expected to fail.
+	AssertDefinition func(t *testing.T, definition SO)
+}
+
+// WithMethodTestConfig provides the configuration needed to test a With* method. For each test case it verifies that
+// the method returns the builder it was called on, that invalid input sets the expected error, that valid input
+// mutates the definition, and that calling it on a builder that already has an error changes nothing.
+type WithMethodTestConfig[O, B any, SO common.ObjectPointer[O], SB common.BuilderPointer[B, O, SO]] struct {
+	CommonTestConfig[O, B, SO, SB]
+
+	methodName string
+	testCases  []WithMethodTestCase[SO, SB]
+}
+
+// NewWithMethodTestConfig creates a new WithMethodTestConfig for the method with the provided name. The name is only
+// used to name the subtests.
+func NewWithMethodTestConfig[O, B any, SO common.ObjectPointer[O], SB common.BuilderPointer[B, O, SO]](
+	commonTestConfig CommonTestConfig[O, B, SO, SB],
+	methodName string,
+	testCases ...WithMethodTestCase[SO, SB],
+) WithMethodTestConfig[O, B, SO, SB] {
+	return WithMethodTestConfig[O, B, SO, SB]{
+		CommonTestConfig: commonTestConfig,
+		methodName:       methodName,
+		testCases:        testCases,
+	}
+}
+
+// Name returns the name to use for running these tests.
+func (config WithMethodTestConfig[O, B, SO, SB]) Name() string {
+	return config.methodName
+}
+
+// ExecuteTests runs the provided test cases against a valid builder, a builder that already has an error, and a nil
+// builder.
+func (config WithMethodTestConfig[O, B, SO, SB]) ExecuteTests(t *testing.T) {
+	t.Helper()
+
+	require.NotEmpty(t, config.testCases, "at least one test case must be provided for %s", config.methodName)
+
+	for _, testCase := range config.testCases {
+		require.NotNil(t, testCase.Call, "test case %q must provide a Call function", testCase.Name)
+
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			builder := config.buildTestBuilder()
+			result := testCase.Call(builder)
+
+			require.Same(t, builder, result, "%s should return the builder it was called on", config.methodName)
+
+			if testCase.AssertError == nil {
+				require.NoError(t, result.GetError())
+
+				if testCase.AssertDefinition != nil {
+					testCase.AssertDefinition(t, result.GetDefinition())
+				}
+
+				return
+			}
+
+			require.Truef(t, testCase.AssertError(result.GetError()), "unexpected error, got: %v", result.GetError())
+		})
+
+		t.Run(testCase.Name+" on invalid builder is a no-op", func(t *testing.T) {
+			t.Parallel()
+
+			builder := config.buildTestBuilder()
+			builder.SetError(errInvalidBuilder)
+
+			original := builder.GetDefinition().DeepCopyObject()
+			result := testCase.Call(builder)
+
+			require.Same(t, builder, result, "%s should return the builder it was called on", config.methodName)
+			assert.Truef(t, isInvalidBuilder(result.GetError()), "unexpected error, got: %v", result.GetError())
+			assert.Equal(t, original, result.GetDefinition(), "definition should not be modified")
+		})
+
+		t.Run(testCase.Name+" on nil builder does not panic", func(t *testing.T) {
+			t.Parallel()
+
+			assert.NotPanics(t, func() {
+				_ = testCase.Call(nil)
+			})
+		})
+	}
+}
+
+// HasErrorMessage returns a WithMethodTestCase.AssertError function that matches errors with exactly the provided
+// message. It is meant for builders whose With* methods set plain errors rather than typed ones.
+func HasErrorMessage(message string) func(err error) bool {
+	return func(err error) bool {
+		return err != nil && err.Error() == message
+	}
+}
@@ -3,7 +3,6 @@ package testhelper
 import (
 	"testing"
 
-	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -111,7 +110,7 @@ func (config WithOptionsTestConfig[O, B, SO, SB, AO]) ExecuteTests(t *testing.T)
 		t.Run(testCase.name, func(t *testing.T) {
 			t.Parallel()
 
-			builder := config.buildTestBuilder(t)
+			builder := config.buildTestBuilder()
 			builder.SetError(testCase.builderError)
 
 			result := config.withOptionsFunc(builder, testCase.options...)
@@ -121,21 +120,6 @@ func (config WithOptionsTestConfig[O, B, SO, SB, AO]) ExecuteTests(t *testing.T)
 	}
 }
 
-// buildTestBuilder creates a valid builder for testing, scoped appropriately for the resource type.
-func (config WithOptionsTestConfig[O, B, SO, SB, AO]) buildTestBuilder(t *testing.T) SB {
-	t.Helper()
-
-	client := clients.GetTestClients(clients.TestClientParams{
-		SchemeAttachers: []clients.SchemeAttacher{config.SchemeAttacher},
-	})
-
-	if config.ResourceScope.IsNamespaced() {
-		return common.NewNamespacedBuilder[O, B, SO, SB](client, config.SchemeAttacher, testResourceName, testResourceNamespace)
-	}
-
-	return common.NewClusterScopedBuilder[O, B, SO, SB](client, config.SchemeAttacher, testResourceName)
-}
-
 // assertResult verifies the result of a WithOptions call: correct error, expected resource name, and annotation state
 // consistent with whether the option succeeded or failed.
 func (config WithOptionsTestConfig[O, B, SO, SB, AO]) assertResult(
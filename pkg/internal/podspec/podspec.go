@@ -0,0 +1,76 @@
+// Package podspec provides the scheduling and networking modifiers shared by the builders of resources that contain a
+// pod spec, such as pods, deployments, daemonsets, and statefulsets. Each builder exposes these as its own
+// WithNodeSelector, WithTolerations, WithRuntimeClass, and WithHostNetwork methods, applying them to the pod spec it
+// manages so the behavior and validation are identical across builders.
+package podspec
+
+import (
+	"fmt"
+	"maps"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
+)
+
+// WithNodeSelector replaces the nodeSelector of the spec with a copy of the provided selector, which cannot be empty.
+func WithNodeSelector(spec *corev1.PodSpec, selector map[string]string) error {
+	if spec == nil {
+		return fmt.Errorf("pod spec cannot be nil")
+	}
+
+	if len(selector) == 0 {
+		return fmt.Errorf("nodeSelector cannot be empty")
+	}
+
+	spec.NodeSelector = maps.Clone(selector)
+
+	return nil
+}
+
+// WithTolerations appends the provided tolerations to the spec. At least one toleration must be provided and none of
+// them can be empty.
+func WithTolerations(spec *corev1.PodSpec, tolerations []corev1.Toleration) error {
+	if spec == nil {
+		return fmt.Errorf("pod spec cannot be nil")
+	}
+
+	if len(tolerations) == 0 {
+		return fmt.Errorf("tolerations cannot be empty")
+	}
+
+	for index, toleration := range tolerations {
+		if toleration == (corev1.Toleration{}) {
+			return fmt.Errorf("toleration at index %d cannot be empty", index)
+		}
+	}
+
+	spec.Tolerations = append(spec.Tolerations, tolerations...)
+
+	return nil
+}
+
+// WithRuntimeClass sets the runtimeClassName of the spec. The name cannot be empty.
+func WithRuntimeClass(spec *corev1.PodSpec, runtimeClassName string) error {
+	if spec == nil {
+		return fmt.Errorf("pod spec cannot be nil")
+	}
+
+	if runtimeClassName == "" {
+		return fmt.Errorf("runtimeClassName cannot be empty")
+	}
+
+	spec.RuntimeClassName = ptr.To(runtimeClassName)
+
+	return nil
+}
+
+// WithHostNetwork sets whether the spec uses the host network namespace.
+func WithHostNetwork(spec *corev1.PodSpec, enabled bool) error {
+	if spec == nil {
+		return fmt.Errorf("pod spec cannot be nil")
+	}
+
+	spec.HostNetwork = enabled
+
+	return nil
+}
@@ -0,0 +1,129 @@
+package podspec
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestWithNodeSelector(t *testing.T) {
+	testCases := []struct {
+		spec          *corev1.PodSpec
+		selector      map[string]string
+		expectedError string
+	}{
+		{
+			spec:          &corev1.PodSpec{},
+			selector:      map[string]string{"node-role.kubernetes.io/worker": ""},
+			expectedError: "",
+		},
+		{
+			spec:          &corev1.PodSpec{},
+			selector:      map[string]string{},
+			expectedError: "nodeSelector cannot be empty",
+		},
+		{
+			spec:          nil,
+			selector:      map[string]string{"node-role.kubernetes.io/worker": ""},
+			expectedError: "pod spec cannot be nil",
+		},
+	}
+
+	for _, testCase := range testCases {
+		err := WithNodeSelector(testCase.spec, testCase.selector)
+
+		if testCase.expectedError != "" {
+			assert.EqualError(t, err, testCase.expectedError)
+
+			continue
+		}
+
+		assert.Nil(t, err)
+		assert.Equal(t, testCase.selector, testCase.spec.NodeSelector)
+
+		// The selector is copied so later changes by the caller do not leak into the spec.
+		testCase.selector["test"] = "test"
+		assert.NotContains(t, testCase.spec.NodeSelector, "test")
+	}
+}
+
+func TestWithTolerations(t *testing.T) {
+	testToleration := corev1.Toleration{Key: "test", Operator: corev1.TolerationOpExists}
+
+	testCases := []struct {
+		tolerations   []corev1.Toleration
+		expectedError string
+	}{
+		{
+			tolerations:   []corev1.Toleration{testToleration},
+			expectedError: "",
+		},
+		{
+			tolerations:   nil,
+			expectedError: "tolerations cannot be empty",
+		},
+		{
+			tolerations:   []corev1.Toleration{testToleration, {}},
+			expectedError: "toleration at index 1 cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		spec := &corev1.PodSpec{Tolerations: []corev1.Toleration{{Key: "existing"}}}
+		err := WithTolerations(spec, testCase.tolerations)
+
+		if testCase.expectedError != "" {
+			assert.EqualError(t, err, testCase.expectedError)
+			assert.Len(t, spec.Tolerations, 1)
+
+			continue
+		}
+
+		assert.Nil(t, err)
+		assert.Equal(t, append([]corev1.Toleration{{Key: "existing"}}, testCase.tolerations...), spec.Tolerations)
+	}
+}
+
+func TestWithRuntimeClass(t *testing.T) {
+	testCases := []struct {
+		runtimeClassName string
+		expectedError    string
+	}{
+		{
+			runtimeClassName: "performance-openshift-node-performance-profile",
+			expectedError:    "",
+		},
+		{
+			runtimeClassName: "",
+			expectedError:    "runtimeClassName cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		spec := &corev1.PodSpec{}
+		err := WithRuntimeClass(spec, testCase.runtimeClassName)
+
+		if testCase.expectedError != "" {
+			assert.EqualError(t, err, testCase.expectedError)
+			assert.Nil(t, spec.RuntimeClassName)
+
+			continue
+		}
+
+		assert.Nil(t, err)
+		assert.Equal(t, testCase.runtimeClassName, *spec.RuntimeClassName)
+	}
+}
+
+func TestWithHostNetwork(t *testing.T) {
+	spec := &corev1.PodSpec{}
+
+	assert.Nil(t, WithHostNetwork(spec, true))
+	assert.True(t, spec.HostNetwork)
+
+	assert.Nil(t, WithHostNetwork(spec, false))
+	assert.False(t, spec.HostNetwork)
+
+	assert.EqualError(t, WithHostNetwork(nil, true), "pod spec cannot be nil")
+}
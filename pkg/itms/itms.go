@@ -0,0 +1,80 @@
+package itms
+
+import (
+	"context"
+	"fmt"
+
+	configv1 "github.com/openshift/api/config/v1"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/klog/v2"
+)
+
+// Builder provides a struct for the ImageTagMirrorSet resource containing a connection to the cluster and the
+// ImageTagMirrorSet definition. ImageTagMirrorSets configure the registries that images pulled by tag are mirrored to,
+// as required in disconnected clusters.
+type Builder struct {
+	common.EmbeddableBuilder[configv1.ImageTagMirrorSet, *configv1.ImageTagMirrorSet]
+	common.EmbeddableCreator[configv1.ImageTagMirrorSet, Builder, *configv1.ImageTagMirrorSet, *Builder]
+	common.EmbeddableDeleter[configv1.ImageTagMirrorSet, *configv1.ImageTagMirrorSet]
+	common.EmbeddableUpdater[configv1.ImageTagMirrorSet, Builder, *configv1.ImageTagMirrorSet, *Builder]
+}
+
+// AttachMixins wires the embedded CRUD mixins to this builder instance.
+func (builder *Builder) AttachMixins() {
+	builder.EmbeddableCreator.SetBase(builder)
+	builder.EmbeddableDeleter.SetBase(builder)
+	builder.EmbeddableUpdater.SetBase(builder)
+}
+
+// GetGVK returns the ImageTagMirrorSet GVK for this builder.
+func (builder *Builder) GetGVK() schema.GroupVersionKind {
+	return configv1.GroupVersion.WithKind("ImageTagMirrorSet")
+}
+
+// NewBuilder creates a new instance of Builder with the provided mirror.
+func NewBuilder(apiClient *clients.Settings, name string, mirror configv1.ImageTagMirrors) *Builder {
+	klog.V(100).Infof(
+		"Initializing new ImageTagMirrorSet structure with the following params: name: %s, mirror: %v", name, mirror)
+
+	builder := common.NewClusterScopedBuilder[configv1.ImageTagMirrorSet, Builder](
+		apiClient, configv1.Install, name)
+	if builder.GetError() != nil {
+		return builder
+	}
+
+	return builder.WithMirror(mirror)
+}
+
+// Pull retrieves an existing ImageTagMirrorSet from the cluster.
+func Pull(apiClient *clients.Settings, name string) (*Builder, error) {
+	return common.PullClusterScopedBuilder[configv1.ImageTagMirrorSet, Builder](
+		context.TODO(), apiClient, configv1.Install, name)
+}
+
+// WithMirror adds an ImageTagMirrors entry mirroring the images of its source.
+func (builder *Builder) WithMirror(mirror configv1.ImageTagMirrors) *Builder {
+	if err := common.Validate(builder); err != nil {
+		return builder
+	}
+
+	klog.V(100).Infof("Adding imagetagmirror to ImageTagMirrorSet %s: %v", builder.Definition.Name, mirror)
+
+	if mirror.Source == "" {
+		builder.SetError(fmt.Errorf("imageTagMirrorSet mirror 'source' cannot be empty"))
+
+		return builder
+	}
+
+	builder.Definition.Spec.ImageTagMirrors = append(builder.Definition.Spec.ImageTagMirrors, mirror)
+
+	return builder
+}
+
+// GetGVR returns ImageTagMirrorSet's GroupVersionResource which could be used for Clean function.
+func GetGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group: "config.openshift.io", Version: "v1", Resource: "imagetagmirrorsets",
+	}
+}
@@ -0,0 +1,122 @@
+package itms
+
+import (
+	"testing"
+
+	configv1 "github.com/openshift/api/config/v1"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common/testhelper"
+	"github.com/stretchr/testify/assert"
+)
+
+const defaultITMSName = "test-image-tag-mirror-set"
+
+var (
+	itmsGVK = configv1.GroupVersion.WithKind("ImageTagMirrorSet")
+
+	defaultMirror = configv1.ImageTagMirrors{
+		Source:  "registry.example.com/source",
+		Mirrors: []configv1.ImageMirror{"mirror.example.com/source"},
+	}
+)
+
+func TestNewBuilder(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		itmsName      string
+		mirror        configv1.ImageTagMirrors
+		client        bool
+		expectedError string
+	}{
+		{
+			name:     "valid builder",
+			itmsName: defaultITMSName,
+			mirror:   defaultMirror,
+			client:   true,
+		},
+		{
+			name:          "empty name",
+			mirror:        defaultMirror,
+			client:        true,
+			expectedError: "name of the builder for ImageTagMirrorSet is empty",
+		},
+		{
+			name:          "empty mirror source",
+			itmsName:      defaultITMSName,
+			client:        true,
+			expectedError: "imageTagMirrorSet mirror 'source' cannot be empty",
+		},
+		{
+			name:          "nil client",
+			itmsName:      defaultITMSName,
+			mirror:        defaultMirror,
+			expectedError: "apiClient for ImageTagMirrorSet",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			var testSettings *clients.Settings
+
+			if testCase.client {
+				testSettings = clients.GetTestClients(clients.TestClientParams{})
+			}
+
+			testBuilder := NewBuilder(testSettings, testCase.itmsName, testCase.mirror)
+
+			if testCase.expectedError != "" {
+				assert.ErrorContains(t, testBuilder.GetError(), testCase.expectedError)
+
+				return
+			}
+
+			assert.NoError(t, testBuilder.GetError())
+			assert.Equal(t, defaultITMSName, testBuilder.Definition.Name)
+			assert.Equal(t, []configv1.ImageTagMirrors{defaultMirror}, testBuilder.Definition.Spec.ImageTagMirrors)
+			assert.Equal(t, itmsGVK, testBuilder.GetGVK())
+		})
+	}
+}
+
+func TestPull(t *testing.T) {
+	t.Parallel()
+
+	testhelper.NewClusterScopedPullTestConfig(Pull, configv1.Install, itmsGVK).ExecuteTests(t)
+}
+
+func TestITMSMethods(t *testing.T) {
+	t.Parallel()
+
+	commonTestConfig := testhelper.NewCommonTestConfig[configv1.ImageTagMirrorSet, Builder](
+		configv1.Install,
+		itmsGVK,
+		testhelper.ResourceScopeClusterScoped,
+	)
+
+	testhelper.NewTestSuite().
+		With(testhelper.NewGetTestConfig(commonTestConfig)).
+		With(testhelper.NewExistsTestConfig(commonTestConfig)).
+		With(testhelper.NewCreateTestConfig(commonTestConfig)).
+		With(testhelper.NewDeleterTestConfig(commonTestConfig)).
+		With(testhelper.NewUpdateTestConfig(commonTestConfig)).
+		Run(t)
+}
+
+func TestITMSWithMirror(t *testing.T) {
+	t.Parallel()
+
+	testBuilder := NewBuilder(clients.GetTestClients(clients.TestClientParams{}), defaultITMSName, defaultMirror)
+
+	otherMirror := configv1.ImageTagMirrors{
+		Source:  "quay.io/other",
+		Mirrors: []configv1.ImageMirror{"mirror.example.com/other"},
+	}
+
+	testBuilder = testBuilder.WithMirror(otherMirror)
+	assert.NoError(t, testBuilder.GetError())
+	assert.Equal(t, []configv1.ImageTagMirrors{defaultMirror, otherMirror}, testBuilder.Definition.Spec.ImageTagMirrors)
+}
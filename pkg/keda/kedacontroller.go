@@ -9,6 +9,7 @@ import (
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/msg"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/klog/v2"
 	goclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -28,6 +29,8 @@ type ControllerBuilder struct {
 // NewControllerBuilder creates a new instance of ControllerBuilder.
 func NewControllerBuilder(
 	apiClient *clients.Settings, name, nsname string) *ControllerBuilder {
+	nsname = apiClient.ResolveNamespace(nsname)
+
 	klog.V(100).Infof(
 		"Initializing new kedaController structure with the following params: "+
 			"name: %s, namespace: %s", name, nsname)
@@ -76,6 +79,8 @@ func NewControllerBuilder(
 
 // PullController pulls existing kedaController from cluster.
 func PullController(apiClient *clients.Settings, name, nsname string) (*ControllerBuilder, error) {
+	nsname = apiClient.ResolveNamespace(nsname)
+
 	klog.V(100).Infof("Pulling existing kedaController name %s in namespace %s from cluster", name, nsname)
 
 	if apiClient == nil {
@@ -301,6 +306,13 @@ func (builder *ControllerBuilder) WithWatchNamespace(
 	return builder
 }
 
+// GetKedaControllerGVR returns KedaController's GroupVersionResource which could be used for Clean function.
+func GetKedaControllerGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group: "keda.sh", Version: "v1alpha1", Resource: "kedacontrollers",
+	}
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *ControllerBuilder) validate() (bool, error) {
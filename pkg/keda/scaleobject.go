@@ -10,6 +10,7 @@ import (
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/msg"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/klog/v2"
 	goclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -34,6 +35,8 @@ type ScaledObjectBuilder struct {
 // NewScaledObjectBuilder creates a new instance of ScaledObjectBuilder.
 func NewScaledObjectBuilder(
 	apiClient *clients.Settings, name, nsname string) *ScaledObjectBuilder {
+	nsname = apiClient.ResolveNamespace(nsname)
+
 	klog.V(100).Infof(
 		"Initializing new scaledObject structure with the following params: "+
 			"name: %s, namespace: %s", name, nsname)
@@ -82,6 +85,8 @@ func NewScaledObjectBuilder(
 
 // PullScaledObject pulls existing scaledObject from cluster.
 func PullScaledObject(apiClient *clients.Settings, name, nsname string) (*ScaledObjectBuilder, error) {
+	nsname = apiClient.ResolveNamespace(nsname)
+
 	klog.V(100).Infof("Pulling existing scaledObject name %s in namespace %s from cluster",
 		name, nsname)
 
@@ -341,6 +346,13 @@ func (builder *ScaledObjectBuilder) WithScaleTargetRef(
 	return builder
 }
 
+// GetScaledObjectGVR returns ScaledObject's GroupVersionResource which could be used for Clean function.
+func GetScaledObjectGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group: "keda.sh", Version: "v1alpha1", Resource: "scaledobjects",
+	}
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *ScaledObjectBuilder) validate() (bool, error) {
@@ -10,6 +10,7 @@ import (
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/msg"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/klog/v2"
 	goclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -34,6 +35,8 @@ type TriggerAuthenticationBuilder struct {
 // NewTriggerAuthenticationBuilder creates a new instance of TriggerAuthenticationBuilder.
 func NewTriggerAuthenticationBuilder(
 	apiClient *clients.Settings, name, nsname string) *TriggerAuthenticationBuilder {
+	nsname = apiClient.ResolveNamespace(nsname)
+
 	klog.V(100).Infof(
 		"Initializing new triggerAuthentication structure with the following params: "+
 			"name: %s, namespace: %s", name, nsname)
@@ -83,6 +86,8 @@ func NewTriggerAuthenticationBuilder(
 // PullTriggerAuthentication pulls existing triggerAuthentication from cluster.
 func PullTriggerAuthentication(apiClient *clients.Settings,
 	name, nsname string) (*TriggerAuthenticationBuilder, error) {
+	nsname = apiClient.ResolveNamespace(nsname)
+
 	klog.V(100).Infof("Pulling existing triggerAuthentication name %s in namespace %s from cluster",
 		name, nsname)
 
@@ -262,6 +267,14 @@ func (builder *TriggerAuthenticationBuilder) WithSecretTargetRef(
 	return builder
 }
 
+// GetTriggerAuthenticationGVR returns TriggerAuthentication's GroupVersionResource
+// which could be used for Clean function.
+func GetTriggerAuthenticationGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group: "keda.sh", Version: "v1alpha1", Resource: "triggerauthentications",
+	}
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *TriggerAuthenticationBuilder) validate() (bool, error) {
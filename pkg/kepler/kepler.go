@@ -0,0 +1,182 @@
+// Package kepler provides a builder for the Kepler resource of the power monitoring operator, which deploys an
+// exporter on each node publishing energy metrics, and helpers to query those metrics from Prometheus.
+package kepler
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common"
+	keplerv1alpha1 "github.com/rh-ecosystem-edge/eco-goinfra/pkg/schemes/kepler/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/klog/v2"
+	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Builder provides a struct for the Kepler resource containing a connection to the cluster and the Kepler definition.
+// The operator only reconciles a Kepler resource named kepler.
+type Builder struct {
+	common.EmbeddableBuilder[keplerv1alpha1.Kepler, *keplerv1alpha1.Kepler]
+	common.EmbeddableCreator[keplerv1alpha1.Kepler, Builder, *keplerv1alpha1.Kepler, *Builder]
+	common.EmbeddableDeleter[keplerv1alpha1.Kepler, *keplerv1alpha1.Kepler]
+	common.EmbeddableUpdater[keplerv1alpha1.Kepler, Builder, *keplerv1alpha1.Kepler, *Builder]
+}
+
+// AttachMixins wires the embedded CRUD mixins to this builder instance.
+func (builder *Builder) AttachMixins() {
+	builder.EmbeddableCreator.SetBase(builder)
+	builder.EmbeddableDeleter.SetBase(builder)
+	builder.EmbeddableUpdater.SetBase(builder)
+}
+
+// GetGVK returns the Kepler GVK for this builder.
+func (builder *Builder) GetGVK() schema.GroupVersionKind {
+	return keplerv1alpha1.GroupVersion.WithKind("Kepler")
+}
+
+// NewBuilder creates a new instance of Builder.
+func NewBuilder(apiClient *clients.Settings, name string) *Builder {
+	return common.NewClusterScopedBuilder[keplerv1alpha1.Kepler, Builder](apiClient, keplerv1alpha1.AddToScheme, name)
+}
+
+// Pull retrieves an existing Kepler from the cluster.
+func Pull(apiClient *clients.Settings, name string) (*Builder, error) {
+	return common.PullClusterScopedBuilder[keplerv1alpha1.Kepler, Builder](
+		context.TODO(), apiClient, keplerv1alpha1.AddToScheme, name)
+}
+
+// List returns the Keplers on the cluster matching the provided options.
+func List(apiClient *clients.Settings, options ...runtimeclient.ListOption) ([]*Builder, error) {
+	return common.List[keplerv1alpha1.Kepler, keplerv1alpha1.KeplerList, Builder](
+		context.TODO(), apiClient, keplerv1alpha1.AddToScheme, options...)
+}
+
+// WithExporterPort sets the port the exporter serves metrics on.
+func (builder *Builder) WithExporterPort(port int32) *Builder {
+	if err := common.Validate(builder); err != nil {
+		return builder
+	}
+
+	klog.V(100).Infof("Setting exporter port of kepler %s to %d", builder.Definition.Name, port)
+
+	if port < 1 || port > 65535 {
+		builder.SetError(fmt.Errorf("kepler 'port' must be between 1 and 65535, got %d", port))
+
+		return builder
+	}
+
+	builder.Definition.Spec.Exporter.Deployment.Port = port
+
+	return builder
+}
+
+// WithNodeSelector restricts the exporter to nodes with the provided labels.
+func (builder *Builder) WithNodeSelector(nodeSelector map[string]string) *Builder {
+	if err := common.Validate(builder); err != nil {
+		return builder
+	}
+
+	klog.V(100).Infof("Setting exporter node selector of kepler %s to %v", builder.Definition.Name, nodeSelector)
+
+	if len(nodeSelector) == 0 {
+		builder.SetError(fmt.Errorf("kepler 'nodeSelector' cannot be empty"))
+
+		return builder
+	}
+
+	builder.Definition.Spec.Exporter.Deployment.NodeSelector = nodeSelector
+
+	return builder
+}
+
+// WithToleration adds a toleration to the exporter, allowing it to run on tainted nodes.
+func (builder *Builder) WithToleration(toleration corev1.Toleration) *Builder {
+	if err := common.Validate(builder); err != nil {
+		return builder
+	}
+
+	klog.V(100).Infof("Adding exporter toleration %v to kepler %s", toleration, builder.Definition.Name)
+
+	builder.Definition.Spec.Exporter.Deployment.Tolerations = append(
+		builder.Definition.Spec.Exporter.Deployment.Tolerations, toleration)
+
+	return builder
+}
+
+// IsAvailable returns whether the Available condition of the exporter is True, meaning it is running on all of the
+// selected nodes. It returns false if the Kepler cannot be retrieved.
+func (builder *Builder) IsAvailable() bool {
+	condition, err := builder.getAvailableCondition()
+	if err != nil {
+		klog.V(100).Infof("Failed to get Available condition of kepler: %v", err)
+
+		return false
+	}
+
+	return condition != nil && condition.Status == keplerv1alpha1.ConditionTrue
+}
+
+// WaitUntilAvailable waits up to timeout for the Available condition of the exporter to be True. On timeout, the error
+// includes the reason and message of the last Available condition.
+func (builder *Builder) WaitUntilAvailable(timeout time.Duration) error {
+	if err := common.Validate(builder); err != nil {
+		return err
+	}
+
+	klog.V(100).Infof("Waiting up to %s for kepler %s to become available", timeout, builder.Definition.Name)
+
+	var lastCondition *keplerv1alpha1.Condition
+
+	err := wait.PollUntilContextTimeout(
+		context.TODO(), time.Second, timeout, true, func(ctx context.Context) (bool, error) {
+			condition, err := builder.getAvailableCondition()
+			if err != nil {
+				klog.V(100).Infof("Failed to get Available condition of kepler %s: %v", builder.Definition.Name, err)
+
+				return false, nil
+			}
+
+			lastCondition = condition
+
+			return condition != nil && condition.Status == keplerv1alpha1.ConditionTrue, nil
+		})
+	if err != nil && lastCondition != nil {
+		return fmt.Errorf("kepler %s is not available: %s: %s: %w",
+			builder.Definition.Name, lastCondition.Reason, lastCondition.Message, err)
+	}
+
+	return err
+}
+
+// getAvailableCondition refreshes the Kepler and returns its Available condition, or nil if it is not reported.
+func (builder *Builder) getAvailableCondition() (*keplerv1alpha1.Condition, error) {
+	if err := common.Validate(builder); err != nil {
+		return nil, err
+	}
+
+	kepler, err := builder.Get()
+	if err != nil {
+		return nil, err
+	}
+
+	builder.Object = kepler
+
+	for _, condition := range kepler.Status.Exporter.Conditions {
+		if condition.Type == keplerv1alpha1.Available {
+			return &condition, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// GetGVR returns Kepler's GroupVersionResource which could be used for Clean function.
+func GetGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group: "kepler.system.sustainable.computing.io", Version: "v1alpha1", Resource: "keplers",
+	}
+}
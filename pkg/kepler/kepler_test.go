@@ -0,0 +1,156 @@
+package kepler
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common/testhelper"
+	keplerv1alpha1 "github.com/rh-ecosystem-edge/eco-goinfra/pkg/schemes/kepler/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const defaultKeplerName = "kepler"
+
+var keplerGVK = keplerv1alpha1.GroupVersion.WithKind("Kepler")
+
+func TestNewBuilder(t *testing.T) {
+	t.Parallel()
+
+	testhelper.NewClusterScopedBuilderTestConfig(NewBuilder, keplerv1alpha1.AddToScheme, keplerGVK).ExecuteTests(t)
+}
+
+func TestPull(t *testing.T) {
+	t.Parallel()
+
+	testhelper.NewClusterScopedPullTestConfig(Pull, keplerv1alpha1.AddToScheme, keplerGVK).ExecuteTests(t)
+}
+
+func TestBuilderMethods(t *testing.T) {
+	t.Parallel()
+
+	commonConfig := testhelper.NewCommonTestConfig[keplerv1alpha1.Kepler, Builder](
+		keplerv1alpha1.AddToScheme, keplerGVK, testhelper.ResourceScopeClusterScoped)
+
+	testhelper.NewTestSuite().
+		With(testhelper.NewGetTestConfig(commonConfig)).
+		With(testhelper.NewExistsTestConfig(commonConfig)).
+		With(testhelper.NewCreateTestConfig(commonConfig)).
+		With(testhelper.NewDeleterTestConfig(commonConfig)).
+		With(testhelper.NewUpdateTestConfig(commonConfig)).
+		Run(t)
+}
+
+func TestList(t *testing.T) {
+	t.Parallel()
+
+	testhelper.NewListTestConfig(List, keplerv1alpha1.AddToScheme, keplerGVK).ExecuteTests(t)
+}
+
+func TestKeplerWithExporterOptions(t *testing.T) {
+	t.Parallel()
+
+	toleration := corev1.Toleration{Operator: corev1.TolerationOpExists}
+
+	testBuilder := NewBuilder(buildTestClientWithKepler(), defaultKeplerName).
+		WithExporterPort(9103).
+		WithNodeSelector(map[string]string{"node-role.kubernetes.io/worker": ""}).
+		WithToleration(toleration)
+	assert.NoError(t, testBuilder.GetError())
+	assert.Equal(t, keplerv1alpha1.ExporterDeploymentSpec{
+		Port:         9103,
+		NodeSelector: map[string]string{"node-role.kubernetes.io/worker": ""},
+		Tolerations:  []corev1.Toleration{toleration},
+	}, testBuilder.Definition.Spec.Exporter.Deployment)
+
+	testBuilder = NewBuilder(buildTestClientWithKepler(), defaultKeplerName).WithExporterPort(0)
+	assert.EqualError(t, testBuilder.GetError(), "kepler 'port' must be between 1 and 65535, got 0")
+
+	testBuilder = NewBuilder(buildTestClientWithKepler(), defaultKeplerName).WithNodeSelector(nil)
+	assert.EqualError(t, testBuilder.GetError(), "kepler 'nodeSelector' cannot be empty")
+}
+
+func TestKeplerWaitUntilAvailable(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		kepler            *keplerv1alpha1.Kepler
+		expectedAvailable bool
+		expectedError     string
+	}{
+		{
+			kepler:            buildDummyKepler(keplerv1alpha1.ConditionTrue),
+			expectedAvailable: true,
+		},
+		{
+			kepler:            buildDummyKepler(keplerv1alpha1.ConditionDegraded),
+			expectedAvailable: false,
+			expectedError: "kepler kepler is not available: DaemonSetPodsNotRunning: " +
+				"1 of 2 pods are running: context deadline exceeded",
+		},
+		{
+			kepler:            nil,
+			expectedAvailable: false,
+			expectedError:     context.DeadlineExceeded.Error(),
+		},
+	}
+
+	for _, testCase := range testCases {
+		var objects []runtime.Object
+
+		if testCase.kepler != nil {
+			objects = append(objects, testCase.kepler)
+		}
+
+		testBuilder := NewBuilder(buildTestClientWithKepler(objects...), defaultKeplerName)
+
+		assert.Equal(t, testCase.expectedAvailable, testBuilder.IsAvailable())
+
+		err := testBuilder.WaitUntilAvailable(time.Second)
+		if testCase.expectedError != "" {
+			assert.EqualError(t, err, testCase.expectedError)
+
+			continue
+		}
+
+		assert.NoError(t, err)
+	}
+}
+
+// buildTestClientWithKepler returns a client with the Kepler scheme and the provided objects.
+func buildTestClientWithKepler(objects ...runtime.Object) *clients.Settings {
+	return clients.GetTestClients(clients.TestClientParams{
+		K8sMockObjects:  objects,
+		SchemeAttachers: []clients.SchemeAttacher{keplerv1alpha1.AddToScheme},
+	})
+}
+
+// buildDummyKepler returns a Kepler whose Available condition has the provided status.
+func buildDummyKepler(status keplerv1alpha1.ConditionStatus) *keplerv1alpha1.Kepler {
+	condition := keplerv1alpha1.Condition{
+		Type:    keplerv1alpha1.Available,
+		Status:  status,
+		Reason:  "DaemonSetReady",
+		Message: "all pods are running",
+	}
+
+	if status != keplerv1alpha1.ConditionTrue {
+		condition.Reason = "DaemonSetPodsNotRunning"
+		condition.Message = "1 of 2 pods are running"
+	}
+
+	return &keplerv1alpha1.Kepler{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: defaultKeplerName,
+		},
+		Status: keplerv1alpha1.KeplerStatus{
+			Exporter: keplerv1alpha1.ExporterStatus{
+				Conditions: []keplerv1alpha1.Condition{condition},
+			},
+		},
+	}
+}
@@ -0,0 +1,249 @@
+package kepler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/pod"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/klog/v2"
+)
+
+const (
+	// DefaultPrometheusPodName is the name of a Prometheus pod of the cluster monitoring stack.
+	DefaultPrometheusPodName = "prometheus-k8s-0"
+	// DefaultPrometheusNamespace is the namespace of the cluster monitoring stack.
+	DefaultPrometheusNamespace = "openshift-monitoring"
+	// DefaultPrometheusContainer is the name of the Prometheus container in DefaultPrometheusPodName.
+	DefaultPrometheusContainer = "prometheus"
+
+	// NodePackageJoulesMetric is the counter of energy consumed by the CPU packages of a node.
+	NodePackageJoulesMetric = "kepler_node_package_joules_total"
+	// NodePlatformJoulesMetric is the counter of energy consumed by a node as a whole.
+	NodePlatformJoulesMetric = "kepler_node_platform_joules_total"
+	// ContainerJoulesMetric is the counter of energy attributed to a container.
+	ContainerJoulesMetric = "kepler_container_joules_total"
+
+	prometheusQueryURL = "http://localhost:9090/api/v1/query"
+)
+
+// Sample is a single series returned by an instant Prometheus query.
+type Sample struct {
+	Labels map[string]string
+	Value  float64
+}
+
+// Querier runs instant PromQL queries. It allows the energy helpers to be used with any way of reaching Prometheus.
+type Querier interface {
+	Query(query string) ([]Sample, error)
+}
+
+// PrometheusPodQuerier runs queries by executing curl in a Prometheus pod, avoiding the need for a route or token.
+type PrometheusPodQuerier struct {
+	apiClient     *clients.Settings
+	podName       string
+	nsname        string
+	containerName string
+}
+
+// NewPrometheusPodQuerier returns a PrometheusPodQuerier using the Prometheus pod of the cluster monitoring stack.
+func NewPrometheusPodQuerier(apiClient *clients.Settings) *PrometheusPodQuerier {
+	return &PrometheusPodQuerier{
+		apiClient:     apiClient,
+		podName:       DefaultPrometheusPodName,
+		nsname:        DefaultPrometheusNamespace,
+		containerName: DefaultPrometheusContainer,
+	}
+}
+
+// WithPod sets the pod and container queries are executed in, such as one from user workload monitoring.
+func (querier *PrometheusPodQuerier) WithPod(podName, nsname, containerName string) *PrometheusPodQuerier {
+	querier.podName = podName
+	querier.nsname = nsname
+	querier.containerName = containerName
+
+	return querier
+}
+
+// Query runs the instant query in the Prometheus pod and returns the resulting samples.
+func (querier *PrometheusPodQuerier) Query(query string) ([]Sample, error) {
+	if querier == nil {
+		return nil, fmt.Errorf("prometheus querier cannot be nil")
+	}
+
+	if query == "" {
+		return nil, fmt.Errorf("prometheus 'query' cannot be empty")
+	}
+
+	klog.V(100).Infof("Querying prometheus pod %s in namespace %s: %s", querier.podName, querier.nsname, query)
+
+	prometheusPod, err := pod.Pull(querier.apiClient, querier.podName, querier.nsname)
+	if err != nil {
+		return nil, fmt.Errorf("failed to pull prometheus pod %s in namespace %s: %w", querier.podName, querier.nsname, err)
+	}
+
+	output, err := prometheusPod.ExecCommand(
+		[]string{"curl", "-s", "-G", prometheusQueryURL, "--data-urlencode", "query=" + query}, querier.containerName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query prometheus: %w", err)
+	}
+
+	return parseQueryResponse(output.Bytes())
+}
+
+// GetNodeEnergyJoules returns the total energy in joules consumed by the CPU packages of the node since its exporter
+// started.
+func GetNodeEnergyJoules(querier Querier, nodeName string) (float64, error) {
+	if nodeName == "" {
+		return 0, fmt.Errorf("kepler 'nodeName' cannot be empty")
+	}
+
+	return queryScalar(querier, fmt.Sprintf(`sum(%s{instance=%q})`, NodePackageJoulesMetric, nodeName))
+}
+
+// GetNodePowerWatts returns the average power in watts drawn by the CPU packages of the node over window.
+func GetNodePowerWatts(querier Querier, nodeName string, window time.Duration) (float64, error) {
+	if nodeName == "" {
+		return 0, fmt.Errorf("kepler 'nodeName' cannot be empty")
+	}
+
+	if err := validateWindow(window); err != nil {
+		return 0, err
+	}
+
+	return queryScalar(querier, fmt.Sprintf(`sum(rate(%s{instance=%q}[%s]))`,
+		NodePackageJoulesMetric, nodeName, formatWindow(window)))
+}
+
+// GetPodPowerWatts returns the average power in watts attributed to the containers of the pod over window.
+func GetPodPowerWatts(querier Querier, podName, nsname string, window time.Duration) (float64, error) {
+	if podName == "" {
+		return 0, fmt.Errorf("kepler 'podName' cannot be empty")
+	}
+
+	if nsname == "" {
+		return 0, fmt.Errorf("kepler 'nsname' cannot be empty")
+	}
+
+	if err := validateWindow(window); err != nil {
+		return 0, err
+	}
+
+	return queryScalar(querier, fmt.Sprintf(`sum(rate(%s{pod_name=%q,container_namespace=%q}[%s]))`,
+		ContainerJoulesMetric, podName, nsname, formatWindow(window)))
+}
+
+// WaitForNodeMetrics waits up to timeout for the exporter on the node to publish energy metrics to Prometheus. This is
+// useful after the Kepler becomes available, since metrics only appear after the first scrape.
+func WaitForNodeMetrics(querier Querier, nodeName string, timeout time.Duration) error {
+	if querier == nil {
+		return fmt.Errorf("kepler 'querier' cannot be nil")
+	}
+
+	if nodeName == "" {
+		return fmt.Errorf("kepler 'nodeName' cannot be empty")
+	}
+
+	query := fmt.Sprintf(`%s{instance=%q}`, NodePackageJoulesMetric, nodeName)
+
+	return wait.PollUntilContextTimeout(
+		context.TODO(), 5*time.Second, timeout, true, func(ctx context.Context) (bool, error) {
+			samples, err := querier.Query(query)
+			if err != nil {
+				klog.V(100).Infof("Failed to query energy metrics of node %s: %v", nodeName, err)
+
+				return false, nil
+			}
+
+			return len(samples) > 0, nil
+		})
+}
+
+// queryScalar runs a query expected to return a single sample and returns its value.
+func queryScalar(querier Querier, query string) (float64, error) {
+	if querier == nil {
+		return 0, fmt.Errorf("kepler 'querier' cannot be nil")
+	}
+
+	samples, err := querier.Query(query)
+	if err != nil {
+		return 0, err
+	}
+
+	if len(samples) != 1 {
+		return 0, fmt.Errorf("expected 1 sample for query %s, got %d", query, len(samples))
+	}
+
+	return samples[0].Value, nil
+}
+
+// validateWindow checks that the rate window covers at least one scrape of the exporter.
+func validateWindow(window time.Duration) error {
+	if window < time.Minute {
+		return fmt.Errorf("kepler 'window' must be at least one minute, got %s", window)
+	}
+
+	return nil
+}
+
+// formatWindow formats the window as a PromQL duration in seconds.
+func formatWindow(window time.Duration) string {
+	return fmt.Sprintf("%ds", int64(window/time.Second))
+}
+
+// queryResponse is the subset of the Prometheus HTTP API response used for instant vector queries.
+type queryResponse struct {
+	Status    string `json:"status"`
+	ErrorType string `json:"errorType"`
+	Error     string `json:"error"`
+	Data      struct {
+		ResultType string `json:"resultType"`
+		Result     []struct {
+			Metric map[string]string `json:"metric"`
+			Value  []any             `json:"value"`
+		} `json:"result"`
+	} `json:"data"`
+}
+
+// parseQueryResponse parses the body of a Prometheus instant query response into samples.
+func parseQueryResponse(body []byte) ([]Sample, error) {
+	var response queryResponse
+
+	if err := json.Unmarshal(body, &response); err != nil {
+		return nil, fmt.Errorf("failed to parse prometheus response: %w", err)
+	}
+
+	if response.Status != "success" {
+		return nil, fmt.Errorf("prometheus query failed: %s: %s", response.ErrorType, response.Error)
+	}
+
+	if response.Data.ResultType != "vector" {
+		return nil, fmt.Errorf("unsupported prometheus result type %q", response.Data.ResultType)
+	}
+
+	samples := make([]Sample, 0, len(response.Data.Result))
+
+	for _, result := range response.Data.Result {
+		if len(result.Value) != 2 {
+			return nil, fmt.Errorf("malformed prometheus sample value %v", result.Value)
+		}
+
+		rawValue, ok := result.Value[1].(string)
+		if !ok {
+			return nil, fmt.Errorf("malformed prometheus sample value %v", result.Value)
+		}
+
+		value, err := strconv.ParseFloat(rawValue, 64)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse prometheus sample value %q: %w", rawValue, err)
+		}
+
+		samples = append(samples, Sample{Labels: result.Metric, Value: value})
+	}
+
+	return samples, nil
+}
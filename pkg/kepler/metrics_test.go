@@ -0,0 +1,171 @@
+package kepler
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeQuerier records the queries it receives and returns the configured samples or error.
+type fakeQuerier struct {
+	queries []string
+	samples []Sample
+	err     error
+}
+
+// Query records the query and returns the configured samples or error.
+func (querier *fakeQuerier) Query(query string) ([]Sample, error) {
+	querier.queries = append(querier.queries, query)
+
+	return querier.samples, querier.err
+}
+
+func TestParseQueryResponse(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		body            string
+		expectedSamples []Sample
+		expectedError   string
+	}{
+		{
+			body: `{"status":"success","data":{"resultType":"vector","result":[` +
+				`{"metric":{"instance":"worker-0"},"value":[1700000000.123,"42.5"]}]}}`,
+			expectedSamples: []Sample{{Labels: map[string]string{"instance": "worker-0"}, Value: 42.5}},
+		},
+		{
+			body:            `{"status":"success","data":{"resultType":"vector","result":[]}}`,
+			expectedSamples: []Sample{},
+		},
+		{
+			body:          `{"status":"error","errorType":"bad_data","error":"parse error"}`,
+			expectedError: "prometheus query failed: bad_data: parse error",
+		},
+		{
+			body:          `{"status":"success","data":{"resultType":"matrix","result":[]}}`,
+			expectedError: "unsupported prometheus result type \"matrix\"",
+		},
+		{
+			body: `{"status":"success","data":{"resultType":"vector","result":[` +
+				`{"metric":{},"value":[1700000000.123,"NaN-ish"]}]}}`,
+			expectedError: "failed to parse prometheus sample value \"NaN-ish\": " +
+				"strconv.ParseFloat: parsing \"NaN-ish\": invalid syntax",
+		},
+		{
+			body:          `not json`,
+			expectedError: "failed to parse prometheus response: invalid character 'o' in literal null (expecting 'u')",
+		},
+	}
+
+	for _, testCase := range testCases {
+		samples, err := parseQueryResponse([]byte(testCase.body))
+		if testCase.expectedError != "" {
+			assert.EqualError(t, err, testCase.expectedError)
+
+			continue
+		}
+
+		assert.NoError(t, err)
+		assert.Equal(t, testCase.expectedSamples, samples)
+	}
+}
+
+func TestGetNodeEnergyJoules(t *testing.T) {
+	t.Parallel()
+
+	querier := &fakeQuerier{samples: []Sample{{Value: 1234.5}}}
+
+	joules, err := GetNodeEnergyJoules(querier, "worker-0")
+	assert.NoError(t, err)
+	assert.Equal(t, 1234.5, joules)
+	assert.Equal(t, []string{`sum(kepler_node_package_joules_total{instance="worker-0"})`}, querier.queries)
+
+	_, err = GetNodeEnergyJoules(querier, "")
+	assert.EqualError(t, err, "kepler 'nodeName' cannot be empty")
+
+	_, err = GetNodeEnergyJoules(nil, "worker-0")
+	assert.EqualError(t, err, "kepler 'querier' cannot be nil")
+
+	_, err = GetNodeEnergyJoules(&fakeQuerier{}, "worker-0")
+	assert.EqualError(t, err,
+		`expected 1 sample for query sum(kepler_node_package_joules_total{instance="worker-0"}), got 0`)
+}
+
+func TestGetNodePowerWatts(t *testing.T) {
+	t.Parallel()
+
+	querier := &fakeQuerier{samples: []Sample{{Value: 85}}}
+
+	watts, err := GetNodePowerWatts(querier, "worker-0", 5*time.Minute)
+	assert.NoError(t, err)
+	assert.Equal(t, 85.0, watts)
+	assert.Equal(t, []string{`sum(rate(kepler_node_package_joules_total{instance="worker-0"}[300s]))`}, querier.queries)
+
+	_, err = GetNodePowerWatts(querier, "worker-0", time.Second)
+	assert.EqualError(t, err, "kepler 'window' must be at least one minute, got 1s")
+}
+
+func TestGetPodPowerWatts(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		podName       string
+		nsname        string
+		querierErr    error
+		expectedError string
+	}{
+		{
+			podName: "app",
+			nsname:  "test-ns",
+		},
+		{
+			podName:       "",
+			nsname:        "test-ns",
+			expectedError: "kepler 'podName' cannot be empty",
+		},
+		{
+			podName:       "app",
+			nsname:        "",
+			expectedError: "kepler 'nsname' cannot be empty",
+		},
+		{
+			podName:       "app",
+			nsname:        "test-ns",
+			querierErr:    fmt.Errorf("connection refused"),
+			expectedError: "connection refused",
+		},
+	}
+
+	for _, testCase := range testCases {
+		querier := &fakeQuerier{samples: []Sample{{Value: 3.5}}, err: testCase.querierErr}
+
+		watts, err := GetPodPowerWatts(querier, testCase.podName, testCase.nsname, time.Minute)
+		if testCase.expectedError != "" {
+			assert.EqualError(t, err, testCase.expectedError)
+
+			continue
+		}
+
+		assert.NoError(t, err)
+		assert.Equal(t, 3.5, watts)
+		assert.Equal(t, []string{
+			`sum(rate(kepler_container_joules_total{pod_name="app",container_namespace="test-ns"}[60s]))`,
+		}, querier.queries)
+	}
+}
+
+func TestWaitForNodeMetrics(t *testing.T) {
+	t.Parallel()
+
+	err := WaitForNodeMetrics(&fakeQuerier{samples: []Sample{{Value: 1}}}, "worker-0", time.Second)
+	assert.NoError(t, err)
+
+	err = WaitForNodeMetrics(&fakeQuerier{}, "worker-0", time.Second)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+	err = WaitForNodeMetrics(nil, "worker-0", time.Second)
+	assert.EqualError(t, err, "kepler 'querier' cannot be nil")
+}
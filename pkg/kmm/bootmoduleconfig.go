@@ -9,6 +9,7 @@ import (
 	bmcV1Beta1 "github.com/rh-ecosystem-edge/eco-goinfra/pkg/schemes/kmm/v1beta1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/klog/v2"
 	goclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -36,6 +37,8 @@ type BootModuleConfigAdditionalOptions func(builder *BootModuleConfigBuilder) (*
 // NewBootModuleConfigBuilder creates a new instance of BootModuleConfigBuilder.
 func NewBootModuleConfigBuilder(
 	apiClient *clients.Settings, name, nsname string) *BootModuleConfigBuilder {
+	nsname = apiClient.ResolveNamespace(nsname)
+
 	klog.V(100).Infof(
 		"Initializing new BootModuleConfig structure with following params: %s, %s", name, nsname)
 
@@ -223,6 +226,8 @@ func (builder *BootModuleConfigBuilder) WithWorkerImage(image string) *BootModul
 
 // PullBootModuleConfig pulls existing bootmoduleconfig from cluster.
 func PullBootModuleConfig(apiClient *clients.Settings, name, nsname string) (*BootModuleConfigBuilder, error) {
+	nsname = apiClient.ResolveNamespace(nsname)
+
 	klog.V(100).Infof("Pulling existing bootmoduleconfig name %s under namespace %s from cluster", name, nsname)
 
 	if apiClient == nil {
@@ -374,6 +379,13 @@ func (builder *BootModuleConfigBuilder) Get() (*bmcV1Beta1.BootModuleConfig, err
 	return bootmoduleconfig, nil
 }
 
+// GetBootModuleConfigGVR returns BootModuleConfig's GroupVersionResource which could be used for Clean function.
+func GetBootModuleConfigGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group: "kmm.sigs.x-k8s.io", Version: "v1beta1", Resource: "bootmoduleconfigs",
+	}
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *BootModuleConfigBuilder) validate() (bool, error) {
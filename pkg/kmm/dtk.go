@@ -0,0 +1,82 @@
+package kmm
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/imagestream"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/nodes"
+	"k8s.io/klog/v2"
+)
+
+const (
+	// DTKImageStreamName is the name of the ImageStream the cluster version operator creates from the driver-toolkit
+	// image reference of the release payload.
+	DTKImageStreamName = "driver-toolkit"
+	// DTKImageStreamNamespace is the namespace of the driver-toolkit ImageStream.
+	DTKImageStreamNamespace = "openshift"
+	// dtkLatestTag is the tag of the driver-toolkit ImageStream pointing to the image of the running release.
+	dtkLatestTag = "latest"
+)
+
+// rhcosVersionRegex matches the RHCOS version in the OS image reported by nodes, such as 417.94.202410090854-0 in
+// Red Hat Enterprise Linux CoreOS 417.94.202410090854-0 (Plow). The driver-toolkit ImageStream has a tag for each
+// RHCOS version of the release.
+var rhcosVersionRegex = regexp.MustCompile(`\b\d+\.\d+\.\d{12}-\d+\b`)
+
+// GetDTKImage returns the pullspec of the Driver Toolkit image of the running cluster version, as referenced by the
+// release payload. It can be used as the base image of KMM build Dockerfiles or with
+// PreflightValidationOCPBuilder.WithDtkImage, instead of running oc adm release info.
+func GetDTKImage(apiClient *clients.Settings) (string, error) {
+	klog.V(100).Info("Getting driver-toolkit image of the running release")
+
+	return getDTKImageForTag(apiClient, dtkLatestTag)
+}
+
+// GetDTKImageForNode returns the pullspec of the Driver Toolkit image matching the RHCOS version of the node, and
+// therefore its kernel. Unlike GetDTKImage, it returns the right image while an upgrade is rolling out to the nodes.
+func GetDTKImageForNode(apiClient *clients.Settings, nodeName string) (string, error) {
+	if nodeName == "" {
+		return "", fmt.Errorf("kmm 'nodeName' cannot be empty")
+	}
+
+	klog.V(100).Infof("Getting driver-toolkit image for node %s", nodeName)
+
+	node, err := nodes.Pull(apiClient, nodeName)
+	if err != nil {
+		return "", fmt.Errorf("failed to pull node %s: %w", nodeName, err)
+	}
+
+	rhcosVersion, err := parseRHCOSVersion(node.Object.Status.NodeInfo.OSImage)
+	if err != nil {
+		return "", fmt.Errorf("failed to get RHCOS version of node %s: %w", nodeName, err)
+	}
+
+	return getDTKImageForTag(apiClient, rhcosVersion)
+}
+
+// getDTKImageForTag returns the pullspec of the tag of the driver-toolkit ImageStream.
+func getDTKImageForTag(apiClient *clients.Settings, tag string) (string, error) {
+	imageStream, err := imagestream.Pull(apiClient, DTKImageStreamName, DTKImageStreamNamespace)
+	if err != nil {
+		return "", fmt.Errorf("failed to pull driver-toolkit imageStream: %w", err)
+	}
+
+	image, err := imageStream.GetDockerImage(tag)
+	if err != nil {
+		return "", fmt.Errorf("failed to get driver-toolkit image for tag %s: %w", tag, err)
+	}
+
+	return image, nil
+}
+
+// parseRHCOSVersion returns the RHCOS version in the OS image reported by a node.
+func parseRHCOSVersion(osImage string) (string, error) {
+	version := rhcosVersionRegex.FindString(osImage)
+	if version == "" {
+		return "", fmt.Errorf("os image %q is not an RHCOS image", osImage)
+	}
+
+	return version, nil
+}
@@ -0,0 +1,132 @@
+package kmm
+
+import (
+	"testing"
+
+	imagev1 "github.com/openshift/api/image/v1"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	defaultDTKNodeName     = "worker-0"
+	defaultRHCOSVersion    = "417.94.202410090854-0"
+	defaultDTKLatestImage  = "quay.io/openshift-release-dev/ocp-v4.0-art-dev@sha256:1111"
+	defaultDTKRHCOSImage   = "quay.io/openshift-release-dev/ocp-v4.0-art-dev@sha256:2222"
+	defaultRHCOSOSImage    = "Red Hat Enterprise Linux CoreOS " + defaultRHCOSVersion + " (Plow)"
+	defaultNonRHCOSOSImage = "Red Hat Enterprise Linux 9.4 (Plow)"
+)
+
+func TestGetDTKImage(t *testing.T) {
+	testCases := []struct {
+		objects       []runtime.Object
+		expectedImage string
+		expectedError string
+	}{
+		{
+			objects:       []runtime.Object{buildDummyDTKImageStream()},
+			expectedImage: defaultDTKLatestImage,
+		},
+		{
+			objects: nil,
+			expectedError: "failed to pull driver-toolkit imageStream: imageStream object driver-toolkit does not " +
+				"exist in namespace openshift",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testSettings := buildTestClientWithDTK(testCase.objects)
+
+		image, err := GetDTKImage(testSettings)
+		if testCase.expectedError != "" {
+			assert.EqualError(t, err, testCase.expectedError)
+
+			continue
+		}
+
+		assert.NoError(t, err)
+		assert.Equal(t, testCase.expectedImage, image)
+	}
+}
+
+func TestGetDTKImageForNode(t *testing.T) {
+	testCases := []struct {
+		nodeName      string
+		osImage       string
+		expectedImage string
+		expectedError string
+	}{
+		{
+			nodeName:      defaultDTKNodeName,
+			osImage:       defaultRHCOSOSImage,
+			expectedImage: defaultDTKRHCOSImage,
+		},
+		{
+			nodeName: defaultDTKNodeName,
+			osImage:  "Red Hat Enterprise Linux CoreOS 418.94.202501010000-0 (Plow)",
+			expectedError: "failed to get driver-toolkit image for tag 418.94.202501010000-0: image tag " +
+				"418.94.202501010000-0 not found for imageStream object driver-toolkit in namespace openshift",
+		},
+		{
+			nodeName: defaultDTKNodeName,
+			osImage:  defaultNonRHCOSOSImage,
+			expectedError: "failed to get RHCOS version of node worker-0: os image " +
+				"\"Red Hat Enterprise Linux 9.4 (Plow)\" is not an RHCOS image",
+		},
+		{
+			nodeName:      "",
+			expectedError: "kmm 'nodeName' cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testSettings := buildTestClientWithDTK([]runtime.Object{
+			buildDummyDTKImageStream(),
+			&corev1.Node{
+				ObjectMeta: metav1.ObjectMeta{Name: defaultDTKNodeName},
+				Status:     corev1.NodeStatus{NodeInfo: corev1.NodeSystemInfo{OSImage: testCase.osImage}},
+			},
+		})
+
+		image, err := GetDTKImageForNode(testSettings, testCase.nodeName)
+		if testCase.expectedError != "" {
+			assert.EqualError(t, err, testCase.expectedError)
+
+			continue
+		}
+
+		assert.NoError(t, err)
+		assert.Equal(t, testCase.expectedImage, image)
+	}
+}
+
+func buildDummyDTKImageStream() *imagev1.ImageStream {
+	return &imagev1.ImageStream{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      DTKImageStreamName,
+			Namespace: DTKImageStreamNamespace,
+		},
+		Spec: imagev1.ImageStreamSpec{
+			Tags: []imagev1.TagReference{
+				{
+					Name: defaultRHCOSVersion,
+					From: &corev1.ObjectReference{Kind: "DockerImage", Name: defaultDTKRHCOSImage},
+				},
+				{
+					Name: dtkLatestTag,
+					From: &corev1.ObjectReference{Kind: "DockerImage", Name: defaultDTKLatestImage},
+				},
+			},
+		},
+	}
+}
+
+func buildTestClientWithDTK(objects []runtime.Object) *clients.Settings {
+	return clients.GetTestClients(clients.TestClientParams{
+		K8sMockObjects:  objects,
+		SchemeAttachers: []clients.SchemeAttacher{imagev1.AddToScheme},
+	})
+}
@@ -10,6 +10,7 @@ import (
 	moduleV1Beta1 "github.com/rh-ecosystem-edge/eco-goinfra/pkg/schemes/kmm/v1beta1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/klog/v2"
 	goclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -33,6 +34,8 @@ type ManagedClusterModuleAdditionalOptions func(builder *ManagedClusterModuleBui
 
 // NewManagedClusterModuleBuilder creates a new instance of ManagedClusterModuleBuilder.
 func NewManagedClusterModuleBuilder(apiClient *clients.Settings, name, nsname string) *ManagedClusterModuleBuilder {
+	nsname = apiClient.ResolveNamespace(nsname)
+
 	klog.V(100).Infof(
 		"Initializing new ManagedClusterModule structure with following params: %s, %s", name, nsname)
 
@@ -171,6 +174,8 @@ func (builder *ManagedClusterModuleBuilder) WithOptions(
 
 // PullManagedClusterModule pulls existing module from cluster.
 func PullManagedClusterModule(apiClient *clients.Settings, name, nsname string) (*ManagedClusterModuleBuilder, error) {
+	nsname = apiClient.ResolveNamespace(nsname)
+
 	klog.V(100).Infof("Pulling existing module name %s under namespace %s from cluster", name, nsname)
 
 	if apiClient == nil {
@@ -321,6 +326,14 @@ func (builder *ManagedClusterModuleBuilder) Get() (*mcmV1Beta1.ManagedClusterMod
 	return mcm, nil
 }
 
+// GetManagedClusterModuleGVR returns ManagedClusterModule's GroupVersionResource
+// which could be used for Clean function.
+func GetManagedClusterModuleGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group: "hub.kmm.sigs.x-k8s.io", Version: "v1beta1", Resource: "managedclustermodules",
+	}
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *ManagedClusterModuleBuilder) validate() (bool, error) {
@@ -10,6 +10,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/klog/v2"
 	goclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -39,6 +40,8 @@ type ModuleAdditionalOptions func(builder *ModuleBuilder) (*ModuleBuilder, error
 // NewModuleBuilder creates a new instance of ModuleBuilder.
 func NewModuleBuilder(
 	apiClient *clients.Settings, name, nsname string) *ModuleBuilder {
+	nsname = apiClient.ResolveNamespace(nsname)
+
 	klog.V(100).Infof(
 		"Initializing new Module structure with following params: %s, %s", name, nsname)
 
@@ -346,6 +349,8 @@ func (builder *ModuleBuilder) WithOptions(options ...ModuleAdditionalOptions) *M
 
 // Pull pulls existing module from cluster.
 func Pull(apiClient *clients.Settings, name, nsname string) (*ModuleBuilder, error) {
+	nsname = apiClient.ResolveNamespace(nsname)
+
 	klog.V(100).Infof("Pulling existing module name %s under namespace %s from cluster", name, nsname)
 
 	if apiClient == nil {
@@ -529,6 +534,13 @@ func (builder *ModuleBuilder) withServiceAccount(srvAccountName string, accountT
 	return builder
 }
 
+// GetModuleGVR returns Module's GroupVersionResource which could be used for Clean function.
+func GetModuleGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group: "kmm.sigs.x-k8s.io", Version: "v1beta1", Resource: "modules",
+	}
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *ModuleBuilder) validate() (bool, error) {
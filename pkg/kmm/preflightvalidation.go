@@ -9,6 +9,7 @@ import (
 	kmmv1beta2 "github.com/rh-ecosystem-edge/eco-goinfra/pkg/schemes/kmm/v1beta2"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/klog/v2"
 	goclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -38,6 +39,8 @@ type PreflightValidationAdditionalOptions func(
 // NewPreflightValidationBuilder creates a new instance of PreflightValidationBuilder.
 func NewPreflightValidationBuilder(
 	apiClient *clients.Settings, name, nsname string) *PreflightValidationBuilder {
+	nsname = apiClient.ResolveNamespace(nsname)
+
 	klog.V(100).Infof("Initializing new PreflightValidation structure with following params: %s, %s",
 		name, nsname)
 
@@ -144,6 +147,8 @@ func (builder *PreflightValidationBuilder) WithOptions(
 // PullPreflightValidation fetches existing PreflightValidation from the cluster.
 func PullPreflightValidation(apiClient *clients.Settings,
 	name, nsname string) (*PreflightValidationBuilder, error) {
+	nsname = apiClient.ResolveNamespace(nsname)
+
 	klog.V(100).Infof("Pulling existing preflightvalidation name %s under namespace %s",
 		name, nsname)
 
@@ -296,6 +301,13 @@ func (builder *PreflightValidationBuilder) Get() (*kmmv1beta2.PreflightValidatio
 	return preflightvalidation, nil
 }
 
+// GetPreflightValidationGVR returns PreflightValidation's GroupVersionResource which could be used for Clean function.
+func GetPreflightValidationGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group: "kmm.sigs.x-k8s.io", Version: "v1beta2", Resource: "preflightvalidations",
+	}
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *PreflightValidationBuilder) validate() (bool, error) {
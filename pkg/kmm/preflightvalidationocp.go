@@ -9,6 +9,7 @@ import (
 	kmmv1beta2 "github.com/rh-ecosystem-edge/eco-goinfra/pkg/schemes/kmm/v1beta2"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/klog/v2"
 	goclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -37,6 +38,8 @@ type PreflightValidationOCPAdditionalOptions func(
 // NewPreflightValidationOCPBuilder creates a new instance of PreflightValidationOCPBuilder.
 func NewPreflightValidationOCPBuilder(
 	apiClient *clients.Settings, name, nsname string) *PreflightValidationOCPBuilder {
+	nsname = apiClient.ResolveNamespace(nsname)
+
 	klog.V(100).Infof("Initializing new PreflightValidationOCP structure with following params: %s, %s",
 		name, nsname)
 
@@ -162,6 +165,8 @@ func (builder *PreflightValidationOCPBuilder) WithOptions(
 // PullPreflightValidationOCP fetches existing PreflightValidationOCP from the cluster.
 func PullPreflightValidationOCP(apiClient *clients.Settings,
 	name, nsname string) (*PreflightValidationOCPBuilder, error) {
+	nsname = apiClient.ResolveNamespace(nsname)
+
 	klog.V(100).Infof("Pulling existing preflightvalidationocp name %s under namespace %s",
 		name, nsname)
 
@@ -317,6 +322,14 @@ func (builder *PreflightValidationOCPBuilder) Get() (*kmmv1beta2.PreflightValida
 	return preflightvalidationocp, nil
 }
 
+// GetPreflightValidationOCPGVR returns PreflightValidationOCP's GroupVersionResource
+// which could be used for Clean function.
+func GetPreflightValidationOCPGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group: "kmm.sigs.x-k8s.io", Version: "v1beta2", Resource: "preflightvalidationsocp",
+	}
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *PreflightValidationOCPBuilder) validate() (bool, error) {
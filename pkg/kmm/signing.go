@@ -0,0 +1,248 @@
+package kmm
+
+import (
+	"crypto/sha1"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/kubelet"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/secret"
+	"k8s.io/klog/v2"
+)
+
+const (
+	// SigningCertKey is the key of the certificate in the secret passed as certSecret to KernelMappingBuilder.WithSign.
+	SigningCertKey = "cert"
+	// unsignedModuleTaint is the taint flag set by the kernel when a module without a valid signature is loaded.
+	unsignedModuleTaint = "E"
+)
+
+// moduleNameRegex matches the kernel module names accepted by the signing helpers, since the name is used in paths on
+// the node.
+var moduleNameRegex = regexp.MustCompile(`^[A-Za-z0-9_-]+$`)
+
+// ModuleSignature is the signature information of a kernel module, as reported by modinfo. All the fields are empty
+// for unsigned modules.
+type ModuleSignature struct {
+	// Signer is the common name of the certificate the module was signed with.
+	Signer string
+	// SigKey is the serial number of the certificate the module was signed with, as colon separated hex bytes.
+	SigKey string
+	// SigHashAlgo is the hash algorithm of the signature, such as sha256.
+	SigHashAlgo string
+}
+
+// GetModuleSignature returns the signature information of the kernel module on the node, using modinfo. The module may
+// be given either by name, in which case it is looked up in the modules of the running kernel, or by the path of its
+// file.
+func GetModuleSignature(executor kubelet.NodeExecutor, nodeName, module string) (*ModuleSignature, error) {
+	if executor == nil {
+		return nil, fmt.Errorf("kmm 'executor' cannot be nil")
+	}
+
+	if module == "" {
+		return nil, fmt.Errorf("kmm 'module' cannot be empty")
+	}
+
+	klog.V(100).Infof("Getting signature of kernel module %s on node %s", module, nodeName)
+
+	output, err := executor.ExecOnNode(nodeName, "modinfo", module)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get modinfo of kernel module %s on node %s: %w", module, nodeName, err)
+	}
+
+	fields := parseModinfo(output)
+
+	return &ModuleSignature{
+		Signer:      fields["signer"],
+		SigKey:      fields["sig_key"],
+		SigHashAlgo: fields["sig_hashalgo"],
+	}, nil
+}
+
+// AssertModuleSigned checks that the kernel module is loaded on the node, that the kernel did not taint itself as
+// having loaded it without a valid signature, and that it was signed by signer. An empty signer accepts any signer, so
+// signer should be the common name of the certificate passed to KernelMappingBuilder.WithSign to check that the module
+// built by KMM was signed with it.
+func AssertModuleSigned(executor kubelet.NodeExecutor, nodeName, moduleName, signer string) error {
+	if executor == nil {
+		return fmt.Errorf("kmm 'executor' cannot be nil")
+	}
+
+	if !moduleNameRegex.MatchString(moduleName) {
+		return fmt.Errorf("kmm 'moduleName' %q is invalid", moduleName)
+	}
+
+	klog.V(100).Infof("Asserting kernel module %s on node %s is signed by %q", moduleName, nodeName, signer)
+
+	// The kernel exposes loaded modules with dashes in their name replaced by underscores.
+	sysfsPath := "/sys/module/" + strings.ReplaceAll(moduleName, "-", "_")
+
+	initState, err := executor.ExecOnNode(nodeName, "cat", sysfsPath+"/initstate")
+	if err != nil || strings.TrimSpace(initState) != "live" {
+		return fmt.Errorf("kernel module %s is not loaded on node %s", moduleName, nodeName)
+	}
+
+	taint, err := executor.ExecOnNode(nodeName, "cat", sysfsPath+"/taint")
+	if err != nil {
+		return fmt.Errorf("failed to get taint of kernel module %s on node %s: %w", moduleName, nodeName, err)
+	}
+
+	if strings.Contains(taint, unsignedModuleTaint) {
+		return fmt.Errorf("kernel module %s on node %s was loaded without a valid signature", moduleName, nodeName)
+	}
+
+	signature, err := GetModuleSignature(executor, nodeName, moduleName)
+	if err != nil {
+		return err
+	}
+
+	if signature.Signer == "" {
+		return fmt.Errorf("kernel module %s on node %s is not signed", moduleName, nodeName)
+	}
+
+	if signer != "" && signature.Signer != signer {
+		return fmt.Errorf("kernel module %s on node %s is signed by %q, expected %q",
+			moduleName, nodeName, signature.Signer, signer)
+	}
+
+	return nil
+}
+
+// IsSecureBootEnabled returns whether secure boot is enabled on the node, using mokutil. It fails on nodes that do not
+// boot using UEFI.
+func IsSecureBootEnabled(executor kubelet.NodeExecutor, nodeName string) (bool, error) {
+	if executor == nil {
+		return false, fmt.Errorf("kmm 'executor' cannot be nil")
+	}
+
+	klog.V(100).Infof("Getting secure boot state of node %s", nodeName)
+
+	output, err := executor.ExecOnNode(nodeName, "mokutil", "--sb-state")
+	if err != nil {
+		return false, fmt.Errorf("failed to get secure boot state of node %s: %w", nodeName, err)
+	}
+
+	switch {
+	case strings.Contains(output, "SecureBoot enabled"):
+		return true, nil
+	case strings.Contains(output, "SecureBoot disabled"):
+		return false, nil
+	default:
+		return false, fmt.Errorf("failed to parse secure boot state of node %s: %q", nodeName, strings.TrimSpace(output))
+	}
+}
+
+// AssertMOKEnrolled checks that secure boot is enabled on the node and that the PEM encoded certificate is enrolled as
+// a Machine Owner Key, so modules signed with it can be loaded. The certificate is usually the one in the secret passed
+// as certSecret to KernelMappingBuilder.WithSign, which can be retrieved using GetSigningCertificate.
+func AssertMOKEnrolled(executor kubelet.NodeExecutor, nodeName string, certPEM []byte) error {
+	fingerprint, err := getCertificateFingerprint(certPEM)
+	if err != nil {
+		return err
+	}
+
+	enabled, err := IsSecureBootEnabled(executor, nodeName)
+	if err != nil {
+		return err
+	}
+
+	if !enabled {
+		return fmt.Errorf("secure boot is not enabled on node %s", nodeName)
+	}
+
+	klog.V(100).Infof("Asserting certificate with SHA1 fingerprint %s is enrolled on node %s", fingerprint, nodeName)
+
+	output, err := executor.ExecOnNode(nodeName, "mokutil", "--list-enrolled")
+	if err != nil {
+		return fmt.Errorf("failed to list enrolled keys of node %s: %w", nodeName, err)
+	}
+
+	if !strings.Contains(strings.ToLower(output), fingerprint) {
+		return fmt.Errorf("certificate with SHA1 fingerprint %s is not enrolled on node %s", fingerprint, nodeName)
+	}
+
+	return nil
+}
+
+// GetSigningCertificate returns the PEM encoded certificate stored in the signing certificate secret of the provided
+// name in the provided namespace, as used by KernelMappingBuilder.WithSign.
+func GetSigningCertificate(apiClient *clients.Settings, certSecret, nsname string) ([]byte, error) {
+	secretBuilder, err := secret.Pull(apiClient, certSecret, nsname)
+	if err != nil {
+		return nil, err
+	}
+
+	cert, ok := secretBuilder.Object.Data[SigningCertKey]
+	if !ok || len(cert) == 0 {
+		return nil, fmt.Errorf("secret %s in namespace %s has no %s key", certSecret, nsname, SigningCertKey)
+	}
+
+	return cert, nil
+}
+
+// getCertificateFingerprint returns the SHA1 fingerprint of the PEM encoded certificate as lowercase colon separated
+// hex bytes, the format used by mokutil.
+func getCertificateFingerprint(certPEM []byte) (string, error) {
+	block, _ := pem.Decode(certPEM)
+	if block == nil {
+		return "", fmt.Errorf("failed to decode PEM certificate")
+	}
+
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse certificate: %w", err)
+	}
+
+	sum := sha1.Sum(cert.Raw)
+	hexBytes := make([]string, 0, len(sum))
+
+	for _, b := range sum {
+		hexBytes = append(hexBytes, fmt.Sprintf("%02x", b))
+	}
+
+	return strings.Join(hexBytes, ":"), nil
+}
+
+// parseModinfo returns the first value of each field in the modinfo output. Values continued on indented lines, such
+// as the signature, are joined to the value of their field.
+func parseModinfo(output string) map[string]string {
+	fields := make(map[string]string)
+	lastField := ""
+
+	for line := range strings.SplitSeq(output, "\n") {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+
+		if line[0] == ' ' || line[0] == '\t' {
+			if lastField != "" {
+				fields[lastField] += strings.TrimSpace(line)
+			}
+
+			continue
+		}
+
+		field, value, found := strings.Cut(line, ":")
+		if !found {
+			lastField = ""
+
+			continue
+		}
+
+		if _, exists := fields[field]; exists {
+			lastField = ""
+
+			continue
+		}
+
+		fields[field] = strings.TrimSpace(value)
+		lastField = field
+	}
+
+	return fields
+}
@@ -0,0 +1,323 @@
+package kmm
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const testModinfoOutput = `filename:       /lib/modules/5.14.0-427.el9.x86_64/extra/kmm-ci.ko
+license:        GPL
+depends:
+name:           kmm_ci
+vermagic:       5.14.0-427.el9.x86_64 SMP preempt mod_unload modversions
+sig_id:         PKCS#7
+signer:         kmm-ci-signing
+sig_key:        61:EE:55:46:D3:0D:C6:38
+sig_hashalgo:   sha256
+signature:      30:45:02:21:00:E5:1C:8A:16:DA:2B:9A:5F:11:47:0C:DB:44:0C:
+		E8:25:8F:4A:65:2C
+`
+
+type fakeNodeExecutor struct {
+	commands [][]string
+	outputs  map[string]string
+	errs     map[string]error
+}
+
+// ExecOnNode records the command and returns the output or error configured for the command joined by spaces.
+func (executor *fakeNodeExecutor) ExecOnNode(nodeName string, command ...string) (string, error) {
+	executor.commands = append(executor.commands, command)
+	joined := strings.Join(command, " ")
+
+	return executor.outputs[joined], executor.errs[joined]
+}
+
+func TestGetModuleSignature(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		module            string
+		output            string
+		err               error
+		expectedSignature *ModuleSignature
+		expectedError     string
+	}{
+		{
+			module: "kmm-ci",
+			output: testModinfoOutput,
+			expectedSignature: &ModuleSignature{
+				Signer: "kmm-ci-signing", SigKey: "61:EE:55:46:D3:0D:C6:38", SigHashAlgo: "sha256",
+			},
+		},
+		{
+			module:            "/tmp/unsigned.ko",
+			output:            "filename:       /tmp/unsigned.ko\nlicense:        GPL\n",
+			expectedSignature: &ModuleSignature{},
+		},
+		{
+			module:        "",
+			expectedError: "kmm 'module' cannot be empty",
+		},
+		{
+			module:        "missing",
+			err:           fmt.Errorf("exit status 1"),
+			expectedError: "failed to get modinfo of kernel module missing on node worker-0: exit status 1",
+		},
+	}
+
+	for _, testCase := range testCases {
+		executor := &fakeNodeExecutor{
+			outputs: map[string]string{"modinfo " + testCase.module: testCase.output},
+			errs:    map[string]error{"modinfo " + testCase.module: testCase.err},
+		}
+
+		signature, err := GetModuleSignature(executor, "worker-0", testCase.module)
+		if testCase.expectedError != "" {
+			assert.EqualError(t, err, testCase.expectedError)
+
+			continue
+		}
+
+		assert.NoError(t, err)
+		assert.Equal(t, testCase.expectedSignature, signature)
+	}
+
+	_, err := GetModuleSignature(nil, "worker-0", "kmm-ci")
+	assert.EqualError(t, err, "kmm 'executor' cannot be nil")
+}
+
+func TestAssertModuleSigned(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		moduleName    string
+		signer        string
+		initState     string
+		taint         string
+		modinfo       string
+		expectedError string
+	}{
+		{
+			moduleName: "kmm-ci",
+			signer:     "kmm-ci-signing",
+			initState:  "live\n",
+			taint:      "O\n",
+			modinfo:    testModinfoOutput,
+		},
+		{
+			moduleName: "kmm-ci",
+			initState:  "live\n",
+			modinfo:    testModinfoOutput,
+		},
+		{
+			moduleName:    "kmm-ci",
+			initState:     "coming\n",
+			expectedError: "kernel module kmm-ci is not loaded on node worker-0",
+		},
+		{
+			moduleName:    "kmm-ci",
+			initState:     "live\n",
+			taint:         "OE\n",
+			expectedError: "kernel module kmm-ci on node worker-0 was loaded without a valid signature",
+		},
+		{
+			moduleName:    "kmm-ci",
+			initState:     "live\n",
+			modinfo:       "filename:       /lib/modules/kmm-ci.ko\n",
+			expectedError: "kernel module kmm-ci on node worker-0 is not signed",
+		},
+		{
+			moduleName:    "kmm-ci",
+			signer:        "other-signer",
+			initState:     "live\n",
+			modinfo:       testModinfoOutput,
+			expectedError: `kernel module kmm-ci on node worker-0 is signed by "kmm-ci-signing", expected "other-signer"`,
+		},
+		{
+			moduleName:    "../kmm-ci",
+			expectedError: `kmm 'moduleName' "../kmm-ci" is invalid`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		executor := &fakeNodeExecutor{outputs: map[string]string{
+			"cat /sys/module/kmm_ci/initstate": testCase.initState,
+			"cat /sys/module/kmm_ci/taint":     testCase.taint,
+			"modinfo kmm-ci":                   testCase.modinfo,
+		}}
+
+		err := AssertModuleSigned(executor, "worker-0", testCase.moduleName, testCase.signer)
+		if testCase.expectedError != "" {
+			assert.EqualError(t, err, testCase.expectedError)
+
+			continue
+		}
+
+		assert.NoError(t, err)
+	}
+}
+
+func TestIsSecureBootEnabled(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		output          string
+		err             error
+		expectedEnabled bool
+		expectedError   string
+	}{
+		{
+			output:          "SecureBoot enabled\n",
+			expectedEnabled: true,
+		},
+		{
+			output:          "SecureBoot disabled\nPlatform is in Setup Mode\n",
+			expectedEnabled: false,
+		},
+		{
+			output:        "unexpected\n",
+			expectedError: `failed to parse secure boot state of node worker-0: "unexpected"`,
+		},
+		{
+			err:           fmt.Errorf("EFI variables are not supported on this system"),
+			expectedError: "failed to get secure boot state of node worker-0: EFI variables are not supported on this system",
+		},
+	}
+
+	for _, testCase := range testCases {
+		executor := &fakeNodeExecutor{
+			outputs: map[string]string{"mokutil --sb-state": testCase.output},
+			errs:    map[string]error{"mokutil --sb-state": testCase.err},
+		}
+
+		enabled, err := IsSecureBootEnabled(executor, "worker-0")
+		if testCase.expectedError != "" {
+			assert.EqualError(t, err, testCase.expectedError)
+
+			continue
+		}
+
+		assert.NoError(t, err)
+		assert.Equal(t, testCase.expectedEnabled, enabled)
+	}
+}
+
+func TestAssertMOKEnrolled(t *testing.T) {
+	t.Parallel()
+
+	certPEM, fingerprint := buildTestSigningCertificate(t)
+
+	testCases := []struct {
+		certPEM       []byte
+		sbState       string
+		enrolled      string
+		expectedError string
+	}{
+		{
+			certPEM:  certPEM,
+			sbState:  "SecureBoot enabled\n",
+			enrolled: fmt.Sprintf("[key 1]\nSHA1 Fingerprint: %s\n", fingerprint),
+		},
+		{
+			certPEM:       certPEM,
+			sbState:       "SecureBoot enabled\n",
+			enrolled:      "[key 1]\nSHA1 Fingerprint: 00:11:22\n",
+			expectedError: fmt.Sprintf("certificate with SHA1 fingerprint %s is not enrolled on node worker-0", fingerprint),
+		},
+		{
+			certPEM:       certPEM,
+			sbState:       "SecureBoot disabled\n",
+			expectedError: "secure boot is not enabled on node worker-0",
+		},
+		{
+			certPEM:       []byte("not a certificate"),
+			expectedError: "failed to decode PEM certificate",
+		},
+	}
+
+	for _, testCase := range testCases {
+		executor := &fakeNodeExecutor{outputs: map[string]string{
+			"mokutil --sb-state":      testCase.sbState,
+			"mokutil --list-enrolled": testCase.enrolled,
+		}}
+
+		err := AssertMOKEnrolled(executor, "worker-0", testCase.certPEM)
+		if testCase.expectedError != "" {
+			assert.EqualError(t, err, testCase.expectedError)
+
+			continue
+		}
+
+		assert.NoError(t, err)
+	}
+}
+
+func TestGetSigningCertificate(t *testing.T) {
+	t.Parallel()
+
+	testSettings := clients.GetTestClients(clients.TestClientParams{K8sMockObjects: []runtime.Object{
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "signing-cert", Namespace: "kmm-test"},
+			Data:       map[string][]byte{SigningCertKey: []byte("certificate")},
+		},
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "signing-key", Namespace: "kmm-test"},
+			Data:       map[string][]byte{"key": []byte("key")},
+		},
+	}})
+
+	cert, err := GetSigningCertificate(testSettings, "signing-cert", "kmm-test")
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("certificate"), cert)
+
+	_, err = GetSigningCertificate(testSettings, "signing-key", "kmm-test")
+	assert.EqualError(t, err, "secret signing-key in namespace kmm-test has no cert key")
+
+	_, err = GetSigningCertificate(testSettings, "missing", "kmm-test")
+	assert.Error(t, err)
+}
+
+// buildTestSigningCertificate returns a self-signed PEM encoded certificate and its SHA1 fingerprint as printed by
+// mokutil.
+func buildTestSigningCertificate(t *testing.T) ([]byte, string) {
+	t.Helper()
+
+	privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "kmm-ci-signing"},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+
+	certDER, err := x509.CreateCertificate(rand.Reader, template, template, &privateKey.PublicKey, privateKey)
+	require.NoError(t, err)
+
+	sum := sha1.Sum(certDER)
+	hexBytes := make([]string, 0, len(sum))
+
+	for _, b := range sum {
+		hexBytes = append(hexBytes, fmt.Sprintf("%02x", b))
+	}
+
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certDER}), strings.Join(hexBytes, ":")
+}
@@ -6,12 +6,14 @@ import (
 	"time"
 
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common"
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/logging"
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/msg"
 	kservev1beta1 "github.com/rh-ecosystem-edge/eco-goinfra/pkg/schemes/kserve/v1beta1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/klog/v2"
 	goclient "sigs.k8s.io/controller-runtime/pkg/client"
@@ -30,6 +32,8 @@ type InferenceServiceBuilder struct {
 func NewInferenceServiceBuilder(
 	apiClient *clients.Settings,
 	name, namespace string) *InferenceServiceBuilder {
+	namespace = apiClient.ResolveNamespace(namespace)
+
 	klog.V(100).Infof(
 		"Initializing new InferenceService structure with name: %s, namespace: %s",
 		name, namespace)
@@ -223,6 +227,14 @@ func (builder *InferenceServiceBuilder) WithAnnotation(
 	klog.V(100).Infof("Setting InferenceService %s annotation %s=%s",
 		builder.Definition.Name, key, value)
 
+	if err := common.ValidateAnnotations(map[string]string{key: value}); err != nil {
+		klog.V(100).Infof("Invalid annotation: %v", err)
+
+		builder.errorMsg = err.Error()
+
+		return builder
+	}
+
 	if builder.Definition.Annotations == nil {
 		builder.Definition.Annotations = make(map[string]string)
 	}
@@ -251,6 +263,8 @@ func (builder *InferenceServiceBuilder) WithEnv(
 // PullInferenceService retrieves an existing InferenceService from the cluster.
 func PullInferenceService(
 	apiClient *clients.Settings, name, namespace string) (*InferenceServiceBuilder, error) {
+	namespace = apiClient.ResolveNamespace(namespace)
+
 	klog.V(100).Infof("Pulling InferenceService %s from namespace %s", name, namespace)
 
 	if apiClient == nil {
@@ -437,6 +451,13 @@ func (builder *InferenceServiceBuilder) WaitUntilReady(
 		})
 }
 
+// GetInferenceServiceGVR returns InferenceService's GroupVersionResource which could be used for Clean function.
+func GetInferenceServiceGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group: "serving.kserve.io", Version: "v1beta1", Resource: "inferenceservices",
+	}
+}
+
 // validate checks that the builder is properly configured.
 func (builder *InferenceServiceBuilder) validate() (bool, error) {
 	resourceCRD := "InferenceService"
@@ -4,11 +4,13 @@ import (
 	"fmt"
 
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common"
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/logging"
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/msg"
 	kservev1alpha1 "github.com/rh-ecosystem-edge/eco-goinfra/pkg/schemes/kserve/v1alpha1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/klog/v2"
 	goclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -26,6 +28,8 @@ type ServingRuntimeBuilder struct {
 func NewServingRuntimeBuilder(
 	apiClient *clients.Settings,
 	name, namespace string) *ServingRuntimeBuilder {
+	namespace = apiClient.ResolveNamespace(namespace)
+
 	klog.V(100).Infof(
 		"Initializing new ServingRuntime structure with name: %s, namespace: %s",
 		name, namespace)
@@ -142,6 +146,14 @@ func (builder *ServingRuntimeBuilder) WithAnnotation(
 		return builder
 	}
 
+	if err := common.ValidateAnnotations(map[string]string{key: value}); err != nil {
+		klog.V(100).Infof("Invalid annotation: %v", err)
+
+		builder.errorMsg = err.Error()
+
+		return builder
+	}
+
 	if builder.Definition.Annotations == nil {
 		builder.Definition.Annotations = make(map[string]string)
 	}
@@ -154,6 +166,8 @@ func (builder *ServingRuntimeBuilder) WithAnnotation(
 // PullServingRuntime retrieves an existing ServingRuntime from the cluster.
 func PullServingRuntime(
 	apiClient *clients.Settings, name, namespace string) (*ServingRuntimeBuilder, error) {
+	namespace = apiClient.ResolveNamespace(namespace)
+
 	klog.V(100).Infof("Pulling ServingRuntime %s from namespace %s", name, namespace)
 
 	if apiClient == nil {
@@ -274,6 +288,13 @@ func (builder *ServingRuntimeBuilder) Delete() (*ServingRuntimeBuilder, error) {
 	return builder, nil
 }
 
+// GetServingRuntimeGVR returns ServingRuntime's GroupVersionResource which could be used for Clean function.
+func GetServingRuntimeGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group: "serving.kserve.io", Version: "v1alpha1", Resource: "servingruntimes",
+	}
+}
+
 // validate checks that the builder is properly configured.
 func (builder *ServingRuntimeBuilder) validate() (bool, error) {
 	resourceCRD := "ServingRuntime"
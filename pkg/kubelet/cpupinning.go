@@ -0,0 +1,267 @@
+package kubelet
+
+import (
+	"fmt"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/cpuset"
+)
+
+// CPUPinning is the CPU assignment of a container as seen on its node.
+type CPUPinning struct {
+	// CPUSet is the set of CPUs the container may run on, read from the cgroup of the container.
+	CPUSet cpuset.CPUSet
+	// NUMANodes are the IDs of the NUMA nodes of the CPUs in CPUSet, in ascending order.
+	NUMANodes []int
+}
+
+// GetContainerCPUSet returns the effective cpuset of the container with the provided ID, such as the ID in the status
+// of a pod, read from the cgroup of the container on the node. The ID may include the runtime prefix, such as
+// cri-o://. Both the cgroup v1 and v2 hierarchies are supported.
+func GetContainerCPUSet(executor NodeExecutor, nodeName, containerID string) (cpuset.CPUSet, error) {
+	if executor == nil {
+		return cpuset.New(), fmt.Errorf("kubelet 'executor' cannot be nil")
+	}
+
+	if _, trimmedID, found := strings.Cut(containerID, "://"); found {
+		containerID = trimmedID
+	}
+
+	if containerID == "" {
+		return cpuset.New(), fmt.Errorf("kubelet 'containerID' cannot be empty")
+	}
+
+	mode, err := GetCgroupMode(executor, nodeName)
+	if err != nil {
+		return cpuset.New(), err
+	}
+
+	cgroupRoot, cpusetFile := "/sys/fs/cgroup", "cpuset.cpus.effective"
+	if mode == CgroupV1 {
+		cgroupRoot, cpusetFile = "/sys/fs/cgroup/cpuset", "cpuset.effective_cpus"
+	}
+
+	klog.V(100).Infof("Reading cpuset of container %s from %s cgroup on node %s", containerID, mode, nodeName)
+
+	// The cgroup of the container is named after its ID, such as crio-<id>.scope with the systemd cgroup driver, but
+	// its parents depend on the QoS class of the pod, so it is searched for.
+	output, err := executor.ExecOnNode(nodeName, "sh", "-c", fmt.Sprintf(
+		`dir=$(find %s -type d -name '*%s*' -print -quit) && test -n "$dir" && cat "$dir/%s"`,
+		cgroupRoot, containerID, cpusetFile))
+	if err != nil {
+		return cpuset.New(), fmt.Errorf("failed to read cpuset of container %s on node %s: %w", containerID, nodeName, err)
+	}
+
+	cpuSet, err := cpuset.Parse(strings.TrimSpace(output))
+	if err != nil {
+		return cpuset.New(), fmt.Errorf("failed to parse cpuset of container %s on node %s: %w", containerID, nodeName, err)
+	}
+
+	return cpuSet, nil
+}
+
+// GetNUMANodeCPUs returns the CPUs of each NUMA node of the node, keyed by NUMA node ID. They are read from sysfs on
+// the node.
+func GetNUMANodeCPUs(executor NodeExecutor, nodeName string) (map[int]cpuset.CPUSet, error) {
+	if executor == nil {
+		return nil, fmt.Errorf("kubelet 'executor' cannot be nil")
+	}
+
+	output, err := executor.ExecOnNode(nodeName, "sh", "-c", "grep -H . /sys/devices/system/node/node*/cpulist")
+	if err != nil {
+		return nil, fmt.Errorf("failed to read NUMA nodes of node %s: %w", nodeName, err)
+	}
+
+	return parseNUMANodeCPUs(output)
+}
+
+// GetContainerCPUPinning returns the cpuset of the container of the running pod, read from its cgroup, along with the
+// NUMA nodes of the CPUs in it.
+func GetContainerCPUPinning(executor NodeExecutor, pod *corev1.Pod, containerName string) (*CPUPinning, error) {
+	if pod == nil {
+		return nil, fmt.Errorf("kubelet 'pod' cannot be nil")
+	}
+
+	containerID, err := getRunningContainerID(pod, containerName)
+	if err != nil {
+		return nil, err
+	}
+
+	cpuSet, err := GetContainerCPUSet(executor, pod.Spec.NodeName, containerID)
+	if err != nil {
+		return nil, err
+	}
+
+	numaNodeCPUs, err := GetNUMANodeCPUs(executor, pod.Spec.NodeName)
+	if err != nil {
+		return nil, err
+	}
+
+	pinning := &CPUPinning{CPUSet: cpuSet}
+
+	for numaNode, numaCPUs := range numaNodeCPUs {
+		if !cpuSet.Intersection(numaCPUs).IsEmpty() {
+			pinning.NUMANodes = append(pinning.NUMANodes, numaNode)
+		}
+	}
+
+	slices.Sort(pinning.NUMANodes)
+
+	return pinning, nil
+}
+
+// VerifyContainerCPUPinning verifies that the container of the running pod has exclusive CPUs as assigned by the static
+// CPU manager policy, such as when a PerformanceProfile is applied to its node. The pod must have the Guaranteed QoS
+// class and the container an integer CPU request. The cpuset of the container cgroup must have as many CPUs as
+// requested, be the cpuset assigned in the CPU manager checkpoint and not overlap the shared pool. If singleNUMANode
+// is true, all of the CPUs must also be on the same NUMA node, as expected with the single-numa-node topology policy.
+// The pinning read from the node is returned even if it does not match.
+func VerifyContainerCPUPinning(
+	executor NodeExecutor, pod *corev1.Pod, containerName string, singleNUMANode bool) (*CPUPinning, error) {
+	if pod == nil {
+		return nil, fmt.Errorf("kubelet 'pod' cannot be nil")
+	}
+
+	exclusiveCPUs, err := getExclusiveCPURequest(pod, containerName)
+	if err != nil {
+		return nil, err
+	}
+
+	klog.V(100).Infof("Verifying %d exclusive CPUs of container %s of pod %s in namespace %s",
+		exclusiveCPUs, containerName, pod.Name, pod.Namespace)
+
+	pinning, err := GetContainerCPUPinning(executor, pod, containerName)
+	if err != nil {
+		return nil, err
+	}
+
+	if pinning.CPUSet.Size() != exclusiveCPUs {
+		return pinning, fmt.Errorf("container %s of pod %s has cpuset %s of %d CPUs, expected %d exclusive CPUs",
+			containerName, pod.Name, pinning.CPUSet, pinning.CPUSet.Size(), exclusiveCPUs)
+	}
+
+	state, err := GetCPUManagerState(executor, pod.Spec.NodeName)
+	if err != nil {
+		return pinning, err
+	}
+
+	if state.PolicyName != "static" {
+		return pinning, fmt.Errorf("cpu manager of node %s has policy %q, expected static",
+			pod.Spec.NodeName, state.PolicyName)
+	}
+
+	assignedCPUs, ok := state.GetContainerCPUSet(string(pod.UID), containerName)
+	if !ok {
+		return pinning, fmt.Errorf("cpu manager of node %s has no cpuset assigned to container %s of pod %s",
+			pod.Spec.NodeName, containerName, pod.Name)
+	}
+
+	assignedCPUSet, err := cpuset.Parse(assignedCPUs)
+	if err != nil {
+		return pinning, fmt.Errorf("failed to parse cpuset assigned to container %s of pod %s: %w",
+			containerName, pod.Name, err)
+	}
+
+	if !assignedCPUSet.Equals(pinning.CPUSet) {
+		return pinning, fmt.Errorf("container %s of pod %s has cpuset %s, but the cpu manager assigned %s",
+			containerName, pod.Name, pinning.CPUSet, assignedCPUSet)
+	}
+
+	sharedCPUs, err := cpuset.Parse(state.DefaultCPUSet)
+	if err != nil {
+		return pinning, fmt.Errorf("failed to parse default cpuset of node %s: %w", pod.Spec.NodeName, err)
+	}
+
+	if overlap := pinning.CPUSet.Intersection(sharedCPUs); !overlap.IsEmpty() {
+		return pinning, fmt.Errorf("container %s of pod %s has CPUs %s which are also in the shared pool",
+			containerName, pod.Name, overlap)
+	}
+
+	if singleNUMANode && len(pinning.NUMANodes) != 1 {
+		return pinning, fmt.Errorf("container %s of pod %s has CPUs on NUMA nodes %v, expected a single NUMA node",
+			containerName, pod.Name, pinning.NUMANodes)
+	}
+
+	return pinning, nil
+}
+
+// getRunningContainerID returns the ID of the container of pod, which must be running.
+func getRunningContainerID(pod *corev1.Pod, containerName string) (string, error) {
+	for _, status := range pod.Status.ContainerStatuses {
+		if status.Name != containerName {
+			continue
+		}
+
+		if status.State.Running == nil || status.ContainerID == "" {
+			return "", fmt.Errorf("container %s of pod %s is not running", containerName, pod.Name)
+		}
+
+		return status.ContainerID, nil
+	}
+
+	return "", fmt.Errorf("container %s of pod %s has no status", containerName, pod.Name)
+}
+
+// getExclusiveCPURequest returns the number of CPUs the static CPU manager policy assigns exclusively to the container
+// of pod, returning an error if the container is not eligible for exclusive CPUs.
+func getExclusiveCPURequest(pod *corev1.Pod, containerName string) (int, error) {
+	if pod.Status.QOSClass != corev1.PodQOSGuaranteed {
+		return 0, fmt.Errorf("pod %s has QoS class %q, exclusive CPUs require %q",
+			pod.Name, pod.Status.QOSClass, corev1.PodQOSGuaranteed)
+	}
+
+	for _, container := range pod.Spec.Containers {
+		if container.Name != containerName {
+			continue
+		}
+
+		request := container.Resources.Requests.Cpu()
+		if request.IsZero() || request.MilliValue()%1000 != 0 {
+			return 0, fmt.Errorf("container %s of pod %s has CPU request %s, exclusive CPUs require an integer request",
+				containerName, pod.Name, request)
+		}
+
+		return int(request.Value()), nil
+	}
+
+	return 0, fmt.Errorf("container %s not found in pod %s", containerName, pod.Name)
+}
+
+// parseNUMANodeCPUs parses the output of grep -H over the cpulist files of each NUMA node. Each line has the form
+// /sys/devices/system/node/node0/cpulist:0-3,8-11. Lines may end in \r\n since commands run in a pod with a TTY.
+func parseNUMANodeCPUs(output string) (map[int]cpuset.CPUSet, error) {
+	numaNodeCPUs := make(map[int]cpuset.CPUSet)
+
+	for _, line := range strings.Split(strings.TrimSpace(output), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+
+		path, cpuList, found := strings.Cut(line, ":")
+		if !found {
+			return nil, fmt.Errorf("malformed NUMA node cpulist line %q", line)
+		}
+
+		numaDir := filepath.Base(filepath.Dir(path))
+
+		numaNode, err := strconv.Atoi(strings.TrimPrefix(numaDir, "node"))
+		if err != nil || !strings.HasPrefix(numaDir, "node") {
+			return nil, fmt.Errorf("malformed NUMA node in line %q", line)
+		}
+
+		cpus, err := cpuset.Parse(cpuList)
+		if err != nil {
+			return nil, fmt.Errorf("malformed cpulist in line %q: %w", line, err)
+		}
+
+		numaNodeCPUs[numaNode] = cpus
+	}
+
+	return numaNodeCPUs, nil
+}
@@ -0,0 +1,267 @@
+package kubelet
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/cpuset"
+)
+
+const (
+	defaultPinnedPodUID      = "pod-uid"
+	defaultPinnedContainerID = "0123456789abcdef"
+	defaultNUMACPUList       = "/sys/devices/system/node/node0/cpulist:0-3,8-11\n" +
+		"/sys/devices/system/node/node1/cpulist:4-7,12-15\n"
+)
+
+// fakeScriptedExecutor returns the output whose key is contained in the command, so a single executor can answer the
+// different commands run by a helper. Keys must not be contained in more than one command. It records the commands it
+// receives.
+type fakeScriptedExecutor struct {
+	commands [][]string
+	outputs  map[string]string
+}
+
+// ExecOnNode records the command and returns the output matching it, or an error if none matches.
+func (executor *fakeScriptedExecutor) ExecOnNode(nodeName string, command ...string) (string, error) {
+	executor.commands = append(executor.commands, command)
+	joinedCommand := strings.Join(command, " ")
+
+	for key, output := range executor.outputs {
+		if strings.Contains(joinedCommand, key) {
+			return output, nil
+		}
+	}
+
+	return "", fmt.Errorf("unexpected command %q", joinedCommand)
+}
+
+func TestGetContainerCPUSet(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		cgroupMode      string
+		cpuSet          string
+		expectedCommand string
+		expectedCPUSet  cpuset.CPUSet
+		expectedError   string
+	}{
+		{
+			cgroupMode: "cgroup2fs",
+			cpuSet:     "2-3\n",
+			expectedCommand: "dir=$(find /sys/fs/cgroup -type d -name '*0123456789abcdef*' -print -quit) && " +
+				"test -n \"$dir\" && cat \"$dir/cpuset.cpus.effective\"",
+			expectedCPUSet: cpuset.New(2, 3),
+		},
+		{
+			cgroupMode: "tmpfs",
+			cpuSet:     "2,10\n",
+			expectedCommand: "dir=$(find /sys/fs/cgroup/cpuset -type d -name '*0123456789abcdef*' -print -quit) && " +
+				"test -n \"$dir\" && cat \"$dir/cpuset.effective_cpus\"",
+			expectedCPUSet: cpuset.New(2, 10),
+		},
+		{
+			cgroupMode: "cgroup2fs",
+			cpuSet:     "2-\n",
+			expectedError: "failed to parse cpuset of container 0123456789abcdef on node worker-0: " +
+				"strconv.Atoi: parsing \"\": invalid syntax",
+		},
+	}
+
+	for _, testCase := range testCases {
+		executor := &fakeScriptedExecutor{outputs: map[string]string{
+			"stat -f": testCase.cgroupMode,
+			"find ":   testCase.cpuSet,
+		}}
+
+		cpuSet, err := GetContainerCPUSet(executor, "worker-0", "cri-o://"+defaultPinnedContainerID)
+		if testCase.expectedError != "" {
+			assert.EqualError(t, err, testCase.expectedError)
+
+			continue
+		}
+
+		assert.NoError(t, err)
+		assert.True(t, testCase.expectedCPUSet.Equals(cpuSet), "unexpected cpuset %s", cpuSet)
+		assert.Equal(t, []string{"sh", "-c", testCase.expectedCommand}, executor.commands[1])
+	}
+
+	_, err := GetContainerCPUSet(nil, "worker-0", defaultPinnedContainerID)
+	assert.EqualError(t, err, "kubelet 'executor' cannot be nil")
+
+	_, err = GetContainerCPUSet(&fakeExecutor{}, "worker-0", "cri-o://")
+	assert.EqualError(t, err, "kubelet 'containerID' cannot be empty")
+}
+
+func TestGetNUMANodeCPUs(t *testing.T) {
+	t.Parallel()
+
+	executor := &fakeExecutor{output: defaultNUMACPUList}
+
+	numaNodeCPUs, err := GetNUMANodeCPUs(executor, "worker-0")
+	assert.NoError(t, err)
+	assert.Equal(t, [][]string{{"sh", "-c", "grep -H . /sys/devices/system/node/node*/cpulist"}}, executor.commands)
+	assert.Len(t, numaNodeCPUs, 2)
+	assert.True(t, cpuset.New(0, 1, 2, 3, 8, 9, 10, 11).Equals(numaNodeCPUs[0]))
+	assert.True(t, cpuset.New(4, 5, 6, 7, 12, 13, 14, 15).Equals(numaNodeCPUs[1]))
+
+	numaNodeCPUs, err = GetNUMANodeCPUs(
+		&fakeExecutor{output: strings.ReplaceAll(defaultNUMACPUList, "\n", "\r\n")}, "worker-0")
+	assert.NoError(t, err)
+	assert.True(t, cpuset.New(0, 1, 2, 3, 8, 9, 10, 11).Equals(numaNodeCPUs[0]))
+	assert.True(t, cpuset.New(4, 5, 6, 7, 12, 13, 14, 15).Equals(numaNodeCPUs[1]))
+
+	_, err = GetNUMANodeCPUs(&fakeExecutor{output: "/sys/devices/system/node/nodeX/cpulist:0-3\n"}, "worker-0")
+	assert.EqualError(t, err, "malformed NUMA node in line \"/sys/devices/system/node/nodeX/cpulist:0-3\"")
+
+	_, err = GetNUMANodeCPUs(&fakeExecutor{err: fmt.Errorf("exec failed")}, "worker-0")
+	assert.EqualError(t, err, "failed to read NUMA nodes of node worker-0: exec failed")
+}
+
+func TestVerifyContainerCPUPinning(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name              string
+		pod               *corev1.Pod
+		cpuSet            string
+		cpuManagerState   string
+		singleNUMANode    bool
+		expectedNUMANodes []int
+		expectedError     string
+	}{
+		{
+			name:              "pinned on single NUMA node",
+			pod:               buildDummyPinnedPod("2", corev1.PodQOSGuaranteed),
+			cpuSet:            "2-3",
+			cpuManagerState:   buildDummyCPUManagerState("2-3", "0-1,4-15"),
+			singleNUMANode:    true,
+			expectedNUMANodes: []int{0},
+		},
+		{
+			name:              "pinned across NUMA nodes",
+			pod:               buildDummyPinnedPod("2", corev1.PodQOSGuaranteed),
+			cpuSet:            "3-4",
+			cpuManagerState:   buildDummyCPUManagerState("3-4", "0-2,5-15"),
+			singleNUMANode:    true,
+			expectedNUMANodes: []int{0, 1},
+			expectedError: "container app of pod pinned has CPUs on NUMA nodes [0 1], expected a single NUMA " +
+				"node",
+		},
+		{
+			name:              "shared CPUs",
+			pod:               buildDummyPinnedPod("2", corev1.PodQOSGuaranteed),
+			cpuSet:            "2-3",
+			cpuManagerState:   buildDummyCPUManagerState("2-3", "0-15"),
+			expectedNUMANodes: []int{0},
+			expectedError:     "container app of pod pinned has CPUs 2-3 which are also in the shared pool",
+		},
+		{
+			name:              "different assignment",
+			pod:               buildDummyPinnedPod("2", corev1.PodQOSGuaranteed),
+			cpuSet:            "2-3",
+			cpuManagerState:   buildDummyCPUManagerState("8-9", "0-7,10-15"),
+			expectedNUMANodes: []int{0},
+			expectedError:     "container app of pod pinned has cpuset 2-3, but the cpu manager assigned 8-9",
+		},
+		{
+			name:              "wrong number of CPUs",
+			pod:               buildDummyPinnedPod("2", corev1.PodQOSGuaranteed),
+			cpuSet:            "0-15",
+			expectedNUMANodes: []int{0, 1},
+			expectedError:     "container app of pod pinned has cpuset 0-15 of 16 CPUs, expected 2 exclusive CPUs",
+		},
+		{
+			name:          "fractional CPUs",
+			pod:           buildDummyPinnedPod("1500m", corev1.PodQOSGuaranteed),
+			expectedError: "container app of pod pinned has CPU request 1500m, exclusive CPUs require an integer request",
+		},
+		{
+			name:          "burstable pod",
+			pod:           buildDummyPinnedPod("2", corev1.PodQOSBurstable),
+			expectedError: "pod pinned has QoS class \"Burstable\", exclusive CPUs require \"Guaranteed\"",
+		},
+		{
+			name:          "nil pod",
+			expectedError: "kubelet 'pod' cannot be nil",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			executor := &fakeScriptedExecutor{outputs: map[string]string{
+				"stat -f":           "cgroup2fs",
+				"find ":             testCase.cpuSet,
+				"cpulist":           defaultNUMACPUList,
+				CPUManagerStateFile: testCase.cpuManagerState,
+			}}
+
+			pinning, err := VerifyContainerCPUPinning(executor, testCase.pod, "app", testCase.singleNUMANode)
+			if testCase.expectedError != "" {
+				assert.EqualError(t, err, testCase.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			if testCase.expectedNUMANodes == nil {
+				assert.Nil(t, pinning)
+
+				return
+			}
+
+			assert.Equal(t, testCase.cpuSet, pinning.CPUSet.String())
+			assert.Equal(t, testCase.expectedNUMANodes, pinning.NUMANodes)
+		})
+	}
+}
+
+func TestGetContainerCPUPinningNotRunning(t *testing.T) {
+	t.Parallel()
+
+	pod := buildDummyPinnedPod("2", corev1.PodQOSGuaranteed)
+	pod.Status.ContainerStatuses[0].State = corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{}}
+
+	_, err := GetContainerCPUPinning(&fakeExecutor{}, pod, "app")
+	assert.EqualError(t, err, "container app of pod pinned is not running")
+
+	_, err = GetContainerCPUPinning(&fakeExecutor{}, pod, "sidecar")
+	assert.EqualError(t, err, "container sidecar of pod pinned has no status")
+}
+
+func buildDummyPinnedPod(cpus string, qosClass corev1.PodQOSClass) *corev1.Pod {
+	resources := corev1.ResourceList{
+		corev1.ResourceCPU:    resource.MustParse(cpus),
+		corev1.ResourceMemory: resource.MustParse("1Gi"),
+	}
+
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "pinned", Namespace: "test-namespace", UID: defaultPinnedPodUID},
+		Spec: corev1.PodSpec{
+			NodeName: "worker-0",
+			Containers: []corev1.Container{{
+				Name:      "app",
+				Resources: corev1.ResourceRequirements{Requests: resources, Limits: resources},
+			}},
+		},
+		Status: corev1.PodStatus{
+			QOSClass: qosClass,
+			ContainerStatuses: []corev1.ContainerStatus{{
+				Name:        "app",
+				ContainerID: "cri-o://" + defaultPinnedContainerID,
+				State:       corev1.ContainerState{Running: &corev1.ContainerStateRunning{}},
+			}},
+		},
+	}
+}
+
+func buildDummyCPUManagerState(assigned, shared string) string {
+	return fmt.Sprintf(`{"policyName":"static","defaultCpuSet":%q,"entries":{%q:{"app":%q}},"checksum":1}`,
+		shared, defaultPinnedPodUID, assigned)
+}
@@ -0,0 +1,37 @@
+package kubelet
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// GetJournalUnitLogs returns the journal entries of the systemd unit on the node, such as kubelet.service or
+// crio.service, logged during the last since. If since is zero, all entries of the unit are returned.
+func GetJournalUnitLogs(executor NodeExecutor, nodeName, unit string, since time.Duration) (string, error) {
+	if executor == nil {
+		return "", fmt.Errorf("kubelet 'executor' cannot be nil")
+	}
+
+	if unit == "" {
+		return "", fmt.Errorf("journal 'unit' cannot be empty")
+	}
+
+	if since < 0 {
+		return "", fmt.Errorf("journal 'since' cannot be negative, got %s", since)
+	}
+
+	command := []string{"journalctl", "--no-pager", "--unit", unit}
+
+	if since > 0 {
+		// Relative times are understood by journalctl as offsets from now, see systemd.time(7).
+		command = append(command, "--since", "-"+strconv.FormatInt(int64(since.Seconds()), 10)+"s")
+	}
+
+	output, err := executor.ExecOnNode(nodeName, command...)
+	if err != nil {
+		return "", fmt.Errorf("failed to get journal of unit %s on node %s: %w", unit, nodeName, err)
+	}
+
+	return output, nil
+}
@@ -0,0 +1,63 @@
+package kubelet
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetJournalUnitLogs(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		unit            string
+		since           time.Duration
+		err             error
+		expectedCommand []string
+		expectedError   string
+	}{
+		{
+			unit:            "kubelet.service",
+			since:           5 * time.Minute,
+			expectedCommand: []string{"journalctl", "--no-pager", "--unit", "kubelet.service", "--since", "-300s"},
+		},
+		{
+			unit:            "crio.service",
+			expectedCommand: []string{"journalctl", "--no-pager", "--unit", "crio.service"},
+		},
+		{
+			unit:          "",
+			expectedError: "journal 'unit' cannot be empty",
+		},
+		{
+			unit:          "kubelet.service",
+			since:         -time.Minute,
+			expectedError: "journal 'since' cannot be negative, got -1m0s",
+		},
+		{
+			unit:          "kubelet.service",
+			err:           fmt.Errorf("exec failed"),
+			expectedError: "failed to get journal of unit kubelet.service on node worker-0: exec failed",
+		},
+	}
+
+	for _, testCase := range testCases {
+		executor := &fakeExecutor{output: "journal", err: testCase.err}
+
+		logs, err := GetJournalUnitLogs(executor, "worker-0", testCase.unit, testCase.since)
+		if testCase.expectedError != "" {
+			assert.EqualError(t, err, testCase.expectedError)
+
+			continue
+		}
+
+		assert.NoError(t, err)
+		assert.Equal(t, "journal", logs)
+		assert.Equal(t, [][]string{testCase.expectedCommand}, executor.commands)
+	}
+
+	_, err := GetJournalUnitLogs(nil, "worker-0", "kubelet.service", 0)
+	assert.EqualError(t, err, "kubelet 'executor' cannot be nil")
+}
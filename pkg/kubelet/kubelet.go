@@ -0,0 +1,65 @@
+// Package kubelet provides helpers to inspect the runtime state of the kubelet on a node, such as its effective
+// configuration, the cgroup mode of the node and the CPU manager state. These reflect what the kubelet actually runs
+// with, which may differ from the KubeletConfig or PerformanceProfile requesting it while changes roll out.
+package kubelet
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"k8s.io/klog/v2"
+	kubeletconfigv1beta1 "k8s.io/kubelet/config/v1beta1"
+)
+
+// configzResponse is the body returned by the configz endpoint of the kubelet.
+type configzResponse struct {
+	KubeletConfig *kubeletconfigv1beta1.KubeletConfiguration `json:"kubeletconfig"`
+}
+
+// GetConfigz returns the effective configuration of the kubelet on the node, read from its configz endpoint through
+// the node proxy of the API server.
+func GetConfigz(apiClient *clients.Settings, nodeName string) (*kubeletconfigv1beta1.KubeletConfiguration, error) {
+	if apiClient == nil {
+		klog.V(100).Info("The apiClient is nil")
+
+		return nil, fmt.Errorf("kubelet 'apiClient' cannot be nil")
+	}
+
+	if nodeName == "" {
+		klog.V(100).Info("The nodeName is empty")
+
+		return nil, fmt.Errorf("kubelet 'nodeName' cannot be empty")
+	}
+
+	klog.V(100).Infof("Getting configz of kubelet on node %s", nodeName)
+
+	body, err := apiClient.CoreV1Interface.RESTClient().
+		Get().
+		Resource("nodes").
+		Name(nodeName).
+		SubResource("proxy").
+		Suffix("configz").
+		DoRaw(context.TODO())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get configz of kubelet on node %s: %w", nodeName, err)
+	}
+
+	return parseConfigz(body)
+}
+
+// parseConfigz parses the body of a configz response into the kubelet configuration.
+func parseConfigz(body []byte) (*kubeletconfigv1beta1.KubeletConfiguration, error) {
+	response := &configzResponse{}
+
+	if err := json.Unmarshal(body, response); err != nil {
+		return nil, fmt.Errorf("failed to parse kubelet configz: %w", err)
+	}
+
+	if response.KubeletConfig == nil {
+		return nil, fmt.Errorf("kubelet configz does not contain kubeletconfig")
+	}
+
+	return response.KubeletConfig, nil
+}
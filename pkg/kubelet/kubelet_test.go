@@ -0,0 +1,80 @@
+package kubelet
+
+import (
+	"testing"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetConfigz(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		client        bool
+		nodeName      string
+		expectedError string
+	}{
+		{
+			client:        false,
+			nodeName:      "worker-0",
+			expectedError: "kubelet 'apiClient' cannot be nil",
+		},
+		{
+			client:        true,
+			nodeName:      "",
+			expectedError: "kubelet 'nodeName' cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		var testSettings *clients.Settings
+
+		if testCase.client {
+			testSettings = clients.GetTestClients(clients.TestClientParams{})
+		}
+
+		_, err := GetConfigz(testSettings, testCase.nodeName)
+		assert.EqualError(t, err, testCase.expectedError)
+	}
+}
+
+func TestParseConfigz(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		body             string
+		expectedPolicy   string
+		expectedReserved string
+		expectedError    string
+	}{
+		{
+			body: `{"kubeletconfig":{"cpuManagerPolicy":"static","reservedSystemCPUs":"0-1",` +
+				`"topologyManagerPolicy":"single-numa-node"}}`,
+			expectedPolicy:   "static",
+			expectedReserved: "0-1",
+		},
+		{
+			body:          `{}`,
+			expectedError: "kubelet configz does not contain kubeletconfig",
+		},
+		{
+			body:          `{`,
+			expectedError: "failed to parse kubelet configz: unexpected end of JSON input",
+		},
+	}
+
+	for _, testCase := range testCases {
+		kubeletConfig, err := parseConfigz([]byte(testCase.body))
+		if testCase.expectedError != "" {
+			assert.EqualError(t, err, testCase.expectedError)
+
+			continue
+		}
+
+		assert.NoError(t, err)
+		assert.Equal(t, testCase.expectedPolicy, kubeletConfig.CPUManagerPolicy)
+		assert.Equal(t, testCase.expectedReserved, kubeletConfig.ReservedSystemCPUs)
+		assert.Equal(t, "single-numa-node", kubeletConfig.TopologyManagerPolicy)
+	}
+}
@@ -0,0 +1,145 @@
+package kubelet
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/pod"
+	"k8s.io/klog/v2"
+)
+
+const (
+	debugPodPrefix     = "kubelet-debug-"
+	debugPodRunTimeout = 2 * time.Minute
+	debugPodDelTimeout = time.Minute
+)
+
+// NodeExecutor runs commands in the host namespaces of a node and returns their output. It allows the inspection
+// helpers to be used with any way of reaching the node.
+type NodeExecutor interface {
+	ExecOnNode(nodeName string, command ...string) (string, error)
+}
+
+// DebugPodExecutor runs commands on nodes using a privileged pod per node that enters the host namespaces of PID 1.
+// Pods are created on first use and reused for later commands until Cleanup is called. The namespace must allow
+// privileged pods with host PID.
+type DebugPodExecutor struct {
+	apiClient *clients.Settings
+	nsname    string
+	image     string
+	pods      map[string]*pod.Builder
+}
+
+// NewDebugPodExecutor creates a new DebugPodExecutor running pods in nsname with the provided image, which must
+// include nsenter.
+func NewDebugPodExecutor(apiClient *clients.Settings, nsname, image string) (*DebugPodExecutor, error) {
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	if apiClient == nil {
+		klog.V(100).Info("The apiClient is nil")
+
+		return nil, fmt.Errorf("debugPodExecutor 'apiClient' cannot be nil")
+	}
+
+	if nsname == "" {
+		klog.V(100).Info("The nsname is empty")
+
+		return nil, fmt.Errorf("debugPodExecutor 'nsname' cannot be empty")
+	}
+
+	if image == "" {
+		klog.V(100).Info("The image is empty")
+
+		return nil, fmt.Errorf("debugPodExecutor 'image' cannot be empty")
+	}
+
+	return &DebugPodExecutor{
+		apiClient: apiClient,
+		nsname:    nsname,
+		image:     image,
+		pods:      make(map[string]*pod.Builder),
+	}, nil
+}
+
+// ExecOnNode runs the command in the host namespaces of the node, creating the debug pod for the node if needed.
+func (executor *DebugPodExecutor) ExecOnNode(nodeName string, command ...string) (string, error) {
+	if executor == nil {
+		return "", fmt.Errorf("debugPodExecutor cannot be nil")
+	}
+
+	if nodeName == "" {
+		return "", fmt.Errorf("debugPodExecutor 'nodeName' cannot be empty")
+	}
+
+	if len(command) == 0 {
+		return "", fmt.Errorf("debugPodExecutor 'command' cannot be empty")
+	}
+
+	debugPod, err := executor.getDebugPod(nodeName)
+	if err != nil {
+		return "", err
+	}
+
+	klog.V(100).Infof("Executing %v on node %s", command, nodeName)
+
+	nsenterCommand := append([]string{"nsenter", "--target", "1", "--mount", "--uts", "--ipc", "--net", "--pid", "--"},
+		command...)
+
+	output, err := debugPod.ExecCommand(nsenterCommand)
+	if err != nil {
+		return "", fmt.Errorf("failed to execute %v on node %s: %w", command, nodeName, err)
+	}
+
+	return output.String(), nil
+}
+
+// Cleanup deletes the debug pods created by the executor. It attempts to delete all pods and returns the first error.
+func (executor *DebugPodExecutor) Cleanup() error {
+	if executor == nil {
+		return fmt.Errorf("debugPodExecutor cannot be nil")
+	}
+
+	var firstErr error
+
+	for nodeName, debugPod := range executor.pods {
+		klog.V(100).Infof("Deleting debug pod %s for node %s", debugPod.Definition.Name, nodeName)
+
+		_, err := debugPod.DeleteAndWait(debugPodDelTimeout)
+		if err != nil && firstErr == nil {
+			firstErr = fmt.Errorf("failed to delete debug pod for node %s: %w", nodeName, err)
+		}
+
+		if err == nil {
+			delete(executor.pods, nodeName)
+		}
+	}
+
+	return firstErr
+}
+
+// getDebugPod returns the running debug pod for the node, creating it if it does not exist yet.
+func (executor *DebugPodExecutor) getDebugPod(nodeName string) (*pod.Builder, error) {
+	if debugPod, ok := executor.pods[nodeName]; ok {
+		return debugPod, nil
+	}
+
+	podName := debugPodPrefix + strings.ReplaceAll(nodeName, ".", "-")
+
+	klog.V(100).Infof("Creating debug pod %s in namespace %s for node %s", podName, executor.nsname, nodeName)
+
+	debugPod, err := pod.NewBuilder(executor.apiClient, podName, executor.nsname, executor.image).
+		DefineOnNode(nodeName).
+		WithTolerationToControlPlane().
+		WithPrivilegedFlag().
+		WithHostPid(true).
+		CreateAndWaitUntilRunning(debugPodRunTimeout)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create debug pod for node %s: %w", nodeName, err)
+	}
+
+	executor.pods[nodeName] = debugPod
+
+	return debugPod, nil
+}
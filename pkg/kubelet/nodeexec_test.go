@@ -0,0 +1,102 @@
+package kubelet
+
+import (
+	"testing"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewDebugPodExecutor(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		client           bool
+		nsname           string
+		defaultNamespace string
+		image            string
+		expectedError    string
+	}{
+		{
+			client: true,
+			nsname: "test-ns",
+			image:  "quay.io/test/tools:latest",
+		},
+		{
+			client:        false,
+			nsname:        "test-ns",
+			image:         "quay.io/test/tools:latest",
+			expectedError: "debugPodExecutor 'apiClient' cannot be nil",
+		},
+		{
+			client:        true,
+			nsname:        "",
+			image:         "quay.io/test/tools:latest",
+			expectedError: "debugPodExecutor 'nsname' cannot be empty",
+		},
+		{
+			client:           true,
+			nsname:           "",
+			defaultNamespace: "test-ns",
+			image:            "quay.io/test/tools:latest",
+		},
+		{
+			client:        true,
+			nsname:        "test-ns",
+			image:         "",
+			expectedError: "debugPodExecutor 'image' cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		var testSettings *clients.Settings
+
+		if testCase.client {
+			testSettings = clients.GetTestClients(clients.TestClientParams{})
+		}
+
+		if testCase.defaultNamespace != "" {
+			var err error
+
+			testSettings, err = testSettings.NamespacedClient(testCase.defaultNamespace)
+			assert.NoError(t, err)
+		}
+
+		executor, err := NewDebugPodExecutor(testSettings, testCase.nsname, testCase.image)
+		if testCase.expectedError != "" {
+			assert.EqualError(t, err, testCase.expectedError)
+			assert.Nil(t, executor)
+
+			continue
+		}
+
+		expectedNamespace := testCase.nsname
+		if expectedNamespace == "" {
+			expectedNamespace = testCase.defaultNamespace
+		}
+
+		assert.NoError(t, err)
+		assert.Equal(t, expectedNamespace, executor.nsname)
+		assert.Equal(t, testCase.image, executor.image)
+	}
+}
+
+func TestDebugPodExecutorExecOnNode(t *testing.T) {
+	t.Parallel()
+
+	executor, err := NewDebugPodExecutor(clients.GetTestClients(clients.TestClientParams{}), "test-ns", "tools")
+	assert.NoError(t, err)
+
+	_, err = executor.ExecOnNode("", "true")
+	assert.EqualError(t, err, "debugPodExecutor 'nodeName' cannot be empty")
+
+	_, err = executor.ExecOnNode("worker-0")
+	assert.EqualError(t, err, "debugPodExecutor 'command' cannot be empty")
+
+	var nilExecutor *DebugPodExecutor
+
+	_, err = nilExecutor.ExecOnNode("worker-0", "true")
+	assert.EqualError(t, err, "debugPodExecutor cannot be nil")
+	assert.EqualError(t, nilExecutor.Cleanup(), "debugPodExecutor cannot be nil")
+	assert.NoError(t, executor.Cleanup())
+}
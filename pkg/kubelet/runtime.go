@@ -0,0 +1,81 @@
+package kubelet
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// CgroupMode is the cgroup hierarchy mounted on a node.
+type CgroupMode string
+
+const (
+	// CgroupV1 is the legacy cgroup hierarchy, with a separate hierarchy per controller.
+	CgroupV1 CgroupMode = "v1"
+	// CgroupV2 is the unified cgroup hierarchy.
+	CgroupV2 CgroupMode = "v2"
+
+	// CPUManagerStateFile is the path of the checkpoint in which the CPU manager stores its assignments.
+	CPUManagerStateFile = "/var/lib/kubelet/cpu_manager_state"
+)
+
+// CPUManagerState is the checkpoint of the kubelet CPU manager. Entries maps pod UIDs to container names to the
+// cpusets exclusively assigned to them, while all other containers use DefaultCPUSet.
+type CPUManagerState struct {
+	PolicyName    string                       `json:"policyName"`
+	DefaultCPUSet string                       `json:"defaultCpuSet"`
+	Entries       map[string]map[string]string `json:"entries,omitempty"`
+	Checksum      uint64                       `json:"checksum"`
+}
+
+// GetContainerCPUSet returns the cpuset exclusively assigned to the container of the pod and whether one is assigned.
+func (state *CPUManagerState) GetContainerCPUSet(podUID, containerName string) (string, bool) {
+	if state == nil {
+		return "", false
+	}
+
+	cpuSet, ok := state.Entries[podUID][containerName]
+
+	return cpuSet, ok
+}
+
+// GetCgroupMode returns whether the node runs with cgroup v1 or v2, based on the filesystem mounted at /sys/fs/cgroup.
+func GetCgroupMode(executor NodeExecutor, nodeName string) (CgroupMode, error) {
+	if executor == nil {
+		return "", fmt.Errorf("kubelet 'executor' cannot be nil")
+	}
+
+	output, err := executor.ExecOnNode(nodeName, "stat", "-f", "-c", "%T", "/sys/fs/cgroup")
+	if err != nil {
+		return "", err
+	}
+
+	switch fsType := strings.TrimSpace(output); fsType {
+	case "cgroup2fs":
+		return CgroupV2, nil
+	case "tmpfs":
+		return CgroupV1, nil
+	default:
+		return "", fmt.Errorf("unknown filesystem type %q mounted at /sys/fs/cgroup on node %s", fsType, nodeName)
+	}
+}
+
+// GetCPUManagerState returns the contents of the CPU manager checkpoint on the node.
+func GetCPUManagerState(executor NodeExecutor, nodeName string) (*CPUManagerState, error) {
+	if executor == nil {
+		return nil, fmt.Errorf("kubelet 'executor' cannot be nil")
+	}
+
+	output, err := executor.ExecOnNode(nodeName, "cat", CPUManagerStateFile)
+	if err != nil {
+		return nil, err
+	}
+
+	state := &CPUManagerState{}
+
+	if err := json.Unmarshal([]byte(output), state); err != nil {
+		return nil, fmt.Errorf("failed to parse cpu manager state of node %s: %w", nodeName, err)
+	}
+
+	return state, nil
+}
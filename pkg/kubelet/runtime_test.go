@@ -0,0 +1,99 @@
+package kubelet
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeExecutor returns the configured output or error and records the commands it receives.
+type fakeExecutor struct {
+	commands [][]string
+	output   string
+	err      error
+}
+
+// ExecOnNode records the command and returns the configured output or error.
+func (executor *fakeExecutor) ExecOnNode(nodeName string, command ...string) (string, error) {
+	executor.commands = append(executor.commands, command)
+
+	return executor.output, executor.err
+}
+
+func TestGetCgroupMode(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		output        string
+		err           error
+		expectedMode  CgroupMode
+		expectedError string
+	}{
+		{
+			output:       "cgroup2fs\n",
+			expectedMode: CgroupV2,
+		},
+		{
+			output:       "tmpfs\n",
+			expectedMode: CgroupV1,
+		},
+		{
+			output:        "ext4\n",
+			expectedError: "unknown filesystem type \"ext4\" mounted at /sys/fs/cgroup on node worker-0",
+		},
+		{
+			err:           fmt.Errorf("exec failed"),
+			expectedError: "exec failed",
+		},
+	}
+
+	for _, testCase := range testCases {
+		executor := &fakeExecutor{output: testCase.output, err: testCase.err}
+
+		mode, err := GetCgroupMode(executor, "worker-0")
+		if testCase.expectedError != "" {
+			assert.EqualError(t, err, testCase.expectedError)
+
+			continue
+		}
+
+		assert.NoError(t, err)
+		assert.Equal(t, testCase.expectedMode, mode)
+		assert.Equal(t, [][]string{{"stat", "-f", "-c", "%T", "/sys/fs/cgroup"}}, executor.commands)
+	}
+
+	_, err := GetCgroupMode(nil, "worker-0")
+	assert.EqualError(t, err, "kubelet 'executor' cannot be nil")
+}
+
+func TestGetCPUManagerState(t *testing.T) {
+	t.Parallel()
+
+	executor := &fakeExecutor{output: `{"policyName":"static","defaultCpuSet":"0-1,4-7",` +
+		`"entries":{"pod-uid":{"app":"2-3"}},"checksum":1234}`}
+
+	state, err := GetCPUManagerState(executor, "worker-0")
+	assert.NoError(t, err)
+	assert.Equal(t, &CPUManagerState{
+		PolicyName:    "static",
+		DefaultCPUSet: "0-1,4-7",
+		Entries:       map[string]map[string]string{"pod-uid": {"app": "2-3"}},
+		Checksum:      1234,
+	}, state)
+	assert.Equal(t, [][]string{{"cat", CPUManagerStateFile}}, executor.commands)
+
+	cpuSet, ok := state.GetContainerCPUSet("pod-uid", "app")
+	assert.True(t, ok)
+	assert.Equal(t, "2-3", cpuSet)
+
+	_, ok = state.GetContainerCPUSet("pod-uid", "sidecar")
+	assert.False(t, ok)
+
+	_, err = GetCPUManagerState(&fakeExecutor{output: "not json"}, "worker-0")
+	assert.EqualError(t, err,
+		"failed to parse cpu manager state of node worker-0: invalid character 'o' in literal null (expecting 'u')")
+
+	_, err = GetCPUManagerState(nil, "worker-0")
+	assert.EqualError(t, err, "kubelet 'executor' cannot be nil")
+}
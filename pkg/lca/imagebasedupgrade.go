@@ -8,6 +8,7 @@ import (
 
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/klog/v2"
 	goclient "sigs.k8s.io/controller-runtime/pkg/client"
@@ -449,6 +450,13 @@ func (builder *ImageBasedUpgradeBuilder) WithStage(
 	return builder
 }
 
+// GetImageBasedUpgradeGVR returns ImageBasedUpgrade's GroupVersionResource which could be used for Clean function.
+func GetImageBasedUpgradeGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group: "lca.openshift.io", Version: "v1", Resource: "imagebasedupgrades",
+	}
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *ImageBasedUpgradeBuilder) validate() (bool, error) {
@@ -3,7 +3,6 @@ package lca
 import (
 	"context"
 	"fmt"
-	"net"
 	"strings"
 	"time"
 
@@ -12,8 +11,10 @@ import (
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/logging"
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/msg"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/netparam"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/klog/v2"
 	goclient "sigs.k8s.io/controller-runtime/pkg/client"
@@ -318,9 +319,7 @@ func (builder *IPConfigBuilder) WithIPv4Address(
 		return builder
 	}
 
-	ipAddress := net.ParseIP(ipv4Address)
-
-	if ipAddress.To4() == nil {
+	if netparam.ValidateIPv4(ipv4Address) != nil {
 		klog.V(100).Infof("Invalid IPv4 address %s", ipv4Address)
 
 		builder.errorMsg = fmt.Sprintf("invalid IPv4 address argument %s", ipv4Address)
@@ -334,7 +333,7 @@ func (builder *IPConfigBuilder) WithIPv4Address(
 		builder.Definition.Spec.IPv4 = &lcaipcv1.IPv4Config{}
 	}
 
-	builder.Definition.Spec.IPv4.Address = ipv4Address
+	builder.Definition.Spec.IPv4.Address, _ = netparam.Normalize(ipv4Address)
 
 	return builder
 }
@@ -346,9 +345,7 @@ func (builder *IPConfigBuilder) WithIPv6Address(
 		return builder
 	}
 
-	ipAddress := net.ParseIP(ipv6Address)
-
-	if ipAddress == nil || ipAddress.To4() != nil {
+	if netparam.ValidateIPv6(ipv6Address) != nil {
 		klog.V(100).Infof("Invalid IPv6 address %s", ipv6Address)
 
 		builder.errorMsg = fmt.Sprintf("invalid IPv6 argument %s", ipv6Address)
@@ -362,7 +359,7 @@ func (builder *IPConfigBuilder) WithIPv6Address(
 		builder.Definition.Spec.IPv6 = &lcaipcv1.IPv6Config{}
 	}
 
-	builder.Definition.Spec.IPv6.Address = ipv6Address
+	builder.Definition.Spec.IPv6.Address, _ = netparam.Normalize(ipv6Address)
 
 	return builder
 }
@@ -374,9 +371,7 @@ func (builder *IPConfigBuilder) WithIPv6Gateway(
 		return builder
 	}
 
-	gwIPAddress := net.ParseIP(ipv6Address)
-
-	if gwIPAddress == nil || gwIPAddress.To4() != nil {
+	if netparam.ValidateIPv6(ipv6Address) != nil {
 		klog.V(100).Infof("Invalid IPv6 address %s", ipv6Address)
 
 		builder.errorMsg = fmt.Sprintf("invalid IPv6 argument %s", ipv6Address)
@@ -390,7 +385,7 @@ func (builder *IPConfigBuilder) WithIPv6Gateway(
 		builder.Definition.Spec.IPv6 = &lcaipcv1.IPv6Config{}
 	}
 
-	builder.Definition.Spec.IPv6.Gateway = ipv6Address
+	builder.Definition.Spec.IPv6.Gateway, _ = netparam.Normalize(ipv6Address)
 
 	return builder
 }
@@ -402,9 +397,7 @@ func (builder *IPConfigBuilder) WithIPv4Gateway(
 		return builder
 	}
 
-	gwIPAddress := net.ParseIP(ipv4Address)
-
-	if gwIPAddress.To4() == nil {
+	if netparam.ValidateIPv4(ipv4Address) != nil {
 		klog.V(100).Infof("Invalid IPv4 address %s", ipv4Address)
 
 		builder.errorMsg = fmt.Sprintf("invalid IPv4 address argument %s", ipv4Address)
@@ -418,7 +411,7 @@ func (builder *IPConfigBuilder) WithIPv4Gateway(
 		builder.Definition.Spec.IPv4 = &lcaipcv1.IPv4Config{}
 	}
 
-	builder.Definition.Spec.IPv4.Gateway = ipv4Address
+	builder.Definition.Spec.IPv4.Gateway, _ = netparam.Normalize(ipv4Address)
 
 	return builder
 }
@@ -430,8 +423,7 @@ func (builder *IPConfigBuilder) WithIPv4MachineNetwork(
 		return builder
 	}
 
-	ipv4, _, err := net.ParseCIDR(ipv4MachineNetwork)
-	if err != nil || ipv4.To4() == nil {
+	if netparam.ValidateIPv4CIDR(ipv4MachineNetwork) != nil {
 		klog.V(100).Infof("Invalid CIDR %s", ipv4MachineNetwork)
 
 		builder.errorMsg = fmt.Sprintf("invalid CIDR argument %s", ipv4MachineNetwork)
@@ -457,8 +449,7 @@ func (builder *IPConfigBuilder) WithIPv6MachineNetwork(
 		return builder
 	}
 
-	ipv6, _, err := net.ParseCIDR(ipv6MachineNetwork)
-	if err != nil || ipv6.To4() != nil {
+	if netparam.ValidateIPv6CIDR(ipv6MachineNetwork) != nil {
 		klog.V(100).Infof("Invalid CIDR %s", ipv6MachineNetwork)
 
 		builder.errorMsg = fmt.Sprintf("invalid CIDR argument %s", ipv6MachineNetwork)
@@ -553,6 +544,72 @@ func (builder *IPConfigBuilder) WithDNS(
 	return builder
 }
 
+// ValidateIPStacks checks that the IPv4 and IPv6 stacks of the ipconfig are each complete and consistent: every
+// configured stack must have an address and a machine network containing it, and a gateway, if set, must also be in
+// the machine network. Configuring both stacks makes the ipconfig dual-stack. It should be called before Update when
+// the stacks were set field by field.
+func (builder *IPConfigBuilder) ValidateIPStacks() error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	klog.V(100).Infof("Validating IP stacks of ipconfig %s", builder.Definition.Name)
+
+	ipv4, ipv6 := builder.Definition.Spec.IPv4, builder.Definition.Spec.IPv6
+
+	if ipv4 == nil && ipv6 == nil {
+		return fmt.Errorf("ipconfig must configure at least one of the IPv4 and IPv6 stacks")
+	}
+
+	if ipv4 != nil {
+		err := validateIPStack("IPv4", ipv4.Address, ipv4.MachineNetwork, ipv4.Gateway)
+		if err != nil {
+			return err
+		}
+	}
+
+	if ipv6 != nil {
+		err := validateIPStack("IPv6", ipv6.Address, ipv6.MachineNetwork, ipv6.Gateway)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validateIPStack checks that the address and optional gateway of a single ipconfig stack are in its machine network.
+func validateIPStack(stack, address, machineNetwork, gateway string) error {
+	if address == "" || machineNetwork == "" {
+		return fmt.Errorf("ipconfig %s stack must set both an address and a machine network", stack)
+	}
+
+	if netparam.ValidateCIDR(machineNetwork) != nil {
+		return fmt.Errorf("ipconfig %s machine network %s is not a valid CIDR", stack, machineNetwork)
+	}
+
+	if contains, _ := netparam.CIDRContains(machineNetwork, address); !contains {
+		return fmt.Errorf("ipconfig %s address %s is not in machine network %s", stack, address, machineNetwork)
+	}
+
+	if gateway == "" {
+		return nil
+	}
+
+	if contains, _ := netparam.CIDRContains(machineNetwork, gateway); !contains {
+		return fmt.Errorf("ipconfig %s gateway %s is not in machine network %s", stack, gateway, machineNetwork)
+	}
+
+	return nil
+}
+
+// GetIPConfigGVR returns IPConfig's GroupVersionResource which could be used for Clean function.
+func GetIPConfigGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group: "lca.openshift.io", Version: "v1", Resource: "ipconfigs",
+	}
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *IPConfigBuilder) validate() (bool, error) {
@@ -546,6 +546,59 @@ func TestIPConfigWaitUntilIdle(t *testing.T) {
 	}
 }
 
+func TestIPConfigValidateIPStacks(t *testing.T) {
+	testCases := []struct {
+		ipv4        *lcaipcv1.IPv4Config
+		ipv6        *lcaipcv1.IPv6Config
+		expectedErr string
+	}{
+		{
+			ipv4: &lcaipcv1.IPv4Config{Address: "192.0.2.10", MachineNetwork: "192.0.2.0/24", Gateway: "192.0.2.1"},
+		},
+		{
+			ipv6: &lcaipcv1.IPv6Config{Address: "2001:db8::10", MachineNetwork: "2001:db8::/64"},
+		},
+		{
+			ipv4: &lcaipcv1.IPv4Config{Address: "192.0.2.10", MachineNetwork: "192.0.2.0/24"},
+			ipv6: &lcaipcv1.IPv6Config{Address: "2001:db8::10", MachineNetwork: "2001:db8::/64", Gateway: "2001:db8::1"},
+		},
+		{
+			expectedErr: "ipconfig must configure at least one of the IPv4 and IPv6 stacks",
+		},
+		{
+			ipv4:        &lcaipcv1.IPv4Config{Address: "192.0.2.10"},
+			expectedErr: "ipconfig IPv4 stack must set both an address and a machine network",
+		},
+		{
+			ipv4:        &lcaipcv1.IPv4Config{Address: "192.0.3.10", MachineNetwork: "192.0.2.0/24"},
+			expectedErr: "ipconfig IPv4 address 192.0.3.10 is not in machine network 192.0.2.0/24",
+		},
+		{
+			ipv4:        &lcaipcv1.IPv4Config{Address: "192.0.2.10", MachineNetwork: "192.0.2.0/24"},
+			ipv6:        &lcaipcv1.IPv6Config{Address: "2001:db8::10", MachineNetwork: "2001:db8::/64", Gateway: "2001:db9::1"},
+			expectedErr: "ipconfig IPv6 gateway 2001:db9::1 is not in machine network 2001:db8::/64",
+		},
+		{
+			ipv6:        &lcaipcv1.IPv6Config{Address: "2001:db8::10", MachineNetwork: "invalid"},
+			expectedErr: "ipconfig IPv6 machine network invalid is not a valid CIDR",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildTestIPConfigBuilderWithFakeObjects()
+		testBuilder.Definition.Spec.IPv4 = testCase.ipv4
+		testBuilder.Definition.Spec.IPv6 = testCase.ipv6
+
+		err := testBuilder.ValidateIPStacks()
+
+		if testCase.expectedErr != "" {
+			assert.EqualError(t, err, testCase.expectedErr)
+		} else {
+			assert.NoError(t, err)
+		}
+	}
+}
+
 func buildTestIPConfigBuilderWithFakeObjects() *IPConfigBuilder {
 	apiClient := buildIPConfigTestClientWithDummyObject(buildDummyIPConfigRuntime())
 
@@ -11,6 +11,7 @@ import (
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/msg"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/klog/v2"
 	goclient "sigs.k8s.io/controller-runtime/pkg/client"
@@ -308,6 +309,13 @@ func (builder *SeedGeneratorBuilder) WaitUntilComplete(timeout time.Duration) (*
 	return nil, err
 }
 
+// GetSeedGeneratorGVR returns SeedGenerator's GroupVersionResource which could be used for Clean function.
+func GetSeedGeneratorGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group: "lca.openshift.io", Version: "v1", Resource: "seedgenerators",
+	}
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *SeedGeneratorBuilder) validate() (bool, error) {
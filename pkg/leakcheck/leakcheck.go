@@ -0,0 +1,268 @@
+// Package leakcheck detects cluster-scoped resources leaked by a test suite. Take a Snapshot before the suite runs and
+// call Snapshot.Diff after it finishes to get builders for every resource that was created in between and not
+// deleted, so they can be reported and cleaned up before they affect other suites sharing the cluster.
+package leakcheck
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/crd"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/logging"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/rbac"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/storage"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/webhook"
+	admregv1 "k8s.io/api/admissionregistration/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+)
+
+// Snapshot records the names of the cluster-scoped resources present on the cluster at the time it was taken.
+type Snapshot struct {
+	// CRDs are the names of the CustomResourceDefinitions.
+	CRDs []string
+	// ClusterRoles are the names of the ClusterRoles.
+	ClusterRoles []string
+	// PersistentVolumes are the names of the PersistentVolumes.
+	PersistentVolumes []string
+	// ValidatingWebhookConfigurations are the names of the ValidatingWebhookConfigurations.
+	ValidatingWebhookConfigurations []string
+	// MutatingWebhookConfigurations are the names of the MutatingWebhookConfigurations.
+	MutatingWebhookConfigurations []string
+}
+
+// Leaks are the cluster-scoped resources created after a Snapshot was taken that still exist.
+type Leaks struct {
+	CRDs                            []*crd.Builder
+	ClusterRoles                    []*rbac.ClusterRoleBuilder
+	PersistentVolumes               []*storage.PVBuilder
+	ValidatingWebhookConfigurations []*webhook.ValidatingConfigurationBuilder
+	MutatingWebhookConfigurations   []*webhook.MutatingConfigurationBuilder
+}
+
+// TakeSnapshot records the cluster-scoped resources currently present on the cluster.
+func TakeSnapshot(apiClient *clients.Settings) (*Snapshot, error) {
+	if apiClient == nil {
+		klog.V(100).Info("The apiClient of the leak check is nil")
+
+		return nil, fmt.Errorf("leakcheck 'apiClient' cannot be nil")
+	}
+
+	klog.V(100).Info("Taking snapshot of cluster-scoped resources")
+
+	snapshot := &Snapshot{}
+
+	crdBuilders, err := crd.List(apiClient)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list CustomResourceDefinitions: %w", err)
+	}
+
+	for _, crdBuilder := range crdBuilders {
+		snapshot.CRDs = append(snapshot.CRDs, crdBuilder.Definition.Name)
+	}
+
+	clusterRoles, err := apiClient.ClusterRoles().List(logging.DiscardContext(), metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list ClusterRoles: %w", err)
+	}
+
+	for _, clusterRole := range clusterRoles.Items {
+		snapshot.ClusterRoles = append(snapshot.ClusterRoles, clusterRole.Name)
+	}
+
+	pvBuilders, err := storage.ListPV(apiClient)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list PersistentVolumes: %w", err)
+	}
+
+	for _, pvBuilder := range pvBuilders {
+		snapshot.PersistentVolumes = append(snapshot.PersistentVolumes, pvBuilder.Definition.Name)
+	}
+
+	err = apiClient.AttachScheme(admregv1.AddToScheme)
+	if err != nil {
+		return nil, fmt.Errorf("failed to add admissionregistration v1 scheme to client schemes: %w", err)
+	}
+
+	validatingWebhooks := &admregv1.ValidatingWebhookConfigurationList{}
+
+	err = apiClient.Client.List(logging.DiscardContext(), validatingWebhooks)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list ValidatingWebhookConfigurations: %w", err)
+	}
+
+	for _, validatingWebhook := range validatingWebhooks.Items {
+		snapshot.ValidatingWebhookConfigurations = append(
+			snapshot.ValidatingWebhookConfigurations, validatingWebhook.Name)
+	}
+
+	mutatingWebhooks := &admregv1.MutatingWebhookConfigurationList{}
+
+	err = apiClient.Client.List(logging.DiscardContext(), mutatingWebhooks)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list MutatingWebhookConfigurations: %w", err)
+	}
+
+	for _, mutatingWebhook := range mutatingWebhooks.Items {
+		snapshot.MutatingWebhookConfigurations = append(snapshot.MutatingWebhookConfigurations, mutatingWebhook.Name)
+	}
+
+	return snapshot, nil
+}
+
+// Diff takes a new snapshot and returns builders for the resources in it that were not in the original snapshot.
+// Resources that were deleted in between are ignored.
+func (snapshot *Snapshot) Diff(apiClient *clients.Settings) (*Leaks, error) {
+	if snapshot == nil {
+		klog.V(100).Info("The leak check snapshot is nil")
+
+		return nil, fmt.Errorf("leakcheck snapshot cannot be nil")
+	}
+
+	current, err := TakeSnapshot(apiClient)
+	if err != nil {
+		return nil, err
+	}
+
+	leaks := &Leaks{}
+
+	for _, name := range newNames(snapshot.CRDs, current.CRDs) {
+		crdBuilder, err := crd.Pull(apiClient, name)
+		if err != nil {
+			return nil, err
+		}
+
+		leaks.CRDs = append(leaks.CRDs, crdBuilder)
+	}
+
+	for _, name := range newNames(snapshot.ClusterRoles, current.ClusterRoles) {
+		clusterRoleBuilder, err := rbac.PullClusterRole(apiClient, name)
+		if err != nil {
+			return nil, err
+		}
+
+		leaks.ClusterRoles = append(leaks.ClusterRoles, clusterRoleBuilder)
+	}
+
+	for _, name := range newNames(snapshot.PersistentVolumes, current.PersistentVolumes) {
+		pvBuilder, err := storage.PullPersistentVolume(apiClient, name)
+		if err != nil {
+			return nil, err
+		}
+
+		leaks.PersistentVolumes = append(leaks.PersistentVolumes, pvBuilder)
+	}
+
+	for _, name := range newNames(snapshot.ValidatingWebhookConfigurations, current.ValidatingWebhookConfigurations) {
+		validatingBuilder, err := webhook.PullValidatingConfiguration(apiClient, name)
+		if err != nil {
+			return nil, err
+		}
+
+		leaks.ValidatingWebhookConfigurations = append(leaks.ValidatingWebhookConfigurations, validatingBuilder)
+	}
+
+	for _, name := range newNames(snapshot.MutatingWebhookConfigurations, current.MutatingWebhookConfigurations) {
+		mutatingBuilder, err := webhook.PullMutatingConfiguration(apiClient, name)
+		if err != nil {
+			return nil, err
+		}
+
+		leaks.MutatingWebhookConfigurations = append(leaks.MutatingWebhookConfigurations, mutatingBuilder)
+	}
+
+	return leaks, nil
+}
+
+// IsEmpty returns whether no resources were leaked.
+func (leaks *Leaks) IsEmpty() bool {
+	return len(leaks.Names()) == 0
+}
+
+// Names returns the leaked resources as kind/name strings, such as ClusterRole/test-role, for reporting.
+func (leaks *Leaks) Names() []string {
+	if leaks == nil {
+		return nil
+	}
+
+	var names []string
+
+	for _, crdBuilder := range leaks.CRDs {
+		names = append(names, "CustomResourceDefinition/"+crdBuilder.Definition.Name)
+	}
+
+	for _, clusterRoleBuilder := range leaks.ClusterRoles {
+		names = append(names, "ClusterRole/"+clusterRoleBuilder.Definition.Name)
+	}
+
+	for _, pvBuilder := range leaks.PersistentVolumes {
+		names = append(names, "PersistentVolume/"+pvBuilder.Definition.Name)
+	}
+
+	for _, validatingBuilder := range leaks.ValidatingWebhookConfigurations {
+		names = append(names, "ValidatingWebhookConfiguration/"+validatingBuilder.Definition.Name)
+	}
+
+	for _, mutatingBuilder := range leaks.MutatingWebhookConfigurations {
+		names = append(names, "MutatingWebhookConfiguration/"+mutatingBuilder.Definition.Name)
+	}
+
+	return names
+}
+
+// String returns a comma separated list of the leaked resources.
+func (leaks *Leaks) String() string {
+	return strings.Join(leaks.Names(), ", ")
+}
+
+// Cleanup deletes every leaked resource. It attempts to delete all of them even if some deletions fail and returns
+// the joined errors.
+func (leaks *Leaks) Cleanup() error {
+	if leaks == nil {
+		return nil
+	}
+
+	klog.V(100).Infof("Cleaning up leaked resources: %s", leaks)
+
+	var errs []error
+
+	for _, crdBuilder := range leaks.CRDs {
+		errs = append(errs, crdBuilder.Delete())
+	}
+
+	for _, clusterRoleBuilder := range leaks.ClusterRoles {
+		errs = append(errs, clusterRoleBuilder.Delete())
+	}
+
+	for _, pvBuilder := range leaks.PersistentVolumes {
+		errs = append(errs, pvBuilder.Delete())
+	}
+
+	for _, validatingBuilder := range leaks.ValidatingWebhookConfigurations {
+		_, err := validatingBuilder.Delete()
+		errs = append(errs, err)
+	}
+
+	for _, mutatingBuilder := range leaks.MutatingWebhookConfigurations {
+		_, err := mutatingBuilder.Delete()
+		errs = append(errs, err)
+	}
+
+	return errors.Join(errs...)
+}
+
+// newNames returns the names in current that are not in previous.
+func newNames(previous, current []string) []string {
+	var names []string
+
+	for _, name := range current {
+		if !slices.Contains(previous, name) {
+			names = append(names, name)
+		}
+	}
+
+	return names
+}
@@ -0,0 +1,147 @@
+package leakcheck
+
+import (
+	"testing"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/stretchr/testify/assert"
+	admregv1 "k8s.io/api/admissionregistration/v1"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	apiextv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var testSchemes = []clients.SchemeAttacher{
+	apiextv1.AddToScheme,
+	rbacv1.AddToScheme,
+	corev1.AddToScheme,
+	admregv1.AddToScheme,
+}
+
+func TestTakeSnapshot(t *testing.T) {
+	snapshot, err := TakeSnapshot(buildTestClient(buildDummyObjects("existing")))
+	assert.NoError(t, err)
+	assert.Equal(t, &Snapshot{
+		CRDs:                            []string{"existing.example.com"},
+		ClusterRoles:                    []string{"existing"},
+		PersistentVolumes:               []string{"existing"},
+		ValidatingWebhookConfigurations: []string{"existing"},
+		MutatingWebhookConfigurations:   []string{"existing"},
+	}, snapshot)
+
+	snapshot, err = TakeSnapshot(nil)
+	assert.EqualError(t, err, "leakcheck 'apiClient' cannot be nil")
+	assert.Nil(t, snapshot)
+}
+
+func TestSnapshotDiff(t *testing.T) {
+	testCases := []struct {
+		snapshot      *Snapshot
+		expectedNames []string
+		expectedError string
+	}{
+		{
+			snapshot: &Snapshot{
+				CRDs:                            []string{"existing.example.com", "deleted.example.com"},
+				ClusterRoles:                    []string{"existing"},
+				PersistentVolumes:               []string{"existing"},
+				ValidatingWebhookConfigurations: []string{"existing"},
+				MutatingWebhookConfigurations:   []string{"existing"},
+			},
+			expectedNames: []string{
+				"CustomResourceDefinition/leaked.example.com",
+				"ClusterRole/leaked",
+				"PersistentVolume/leaked",
+				"ValidatingWebhookConfiguration/leaked",
+				"MutatingWebhookConfiguration/leaked",
+			},
+		},
+		{
+			snapshot: &Snapshot{
+				CRDs:                            []string{"existing.example.com", "leaked.example.com"},
+				ClusterRoles:                    []string{"existing", "leaked"},
+				PersistentVolumes:               []string{"existing", "leaked"},
+				ValidatingWebhookConfigurations: []string{"existing", "leaked"},
+				MutatingWebhookConfigurations:   []string{"existing", "leaked"},
+			},
+		},
+		{
+			snapshot:      nil,
+			expectedError: "leakcheck snapshot cannot be nil",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testSettings := buildTestClient(append(buildDummyObjects("existing"), buildDummyObjects("leaked")...))
+
+		leaks, err := testCase.snapshot.Diff(testSettings)
+
+		if testCase.expectedError != "" {
+			assert.EqualError(t, err, testCase.expectedError)
+			assert.Nil(t, leaks)
+
+			continue
+		}
+
+		assert.NoError(t, err)
+		assert.Equal(t, testCase.expectedNames, leaks.Names())
+		assert.Equal(t, len(testCase.expectedNames) == 0, leaks.IsEmpty())
+	}
+}
+
+func TestLeaksCleanup(t *testing.T) {
+	testSettings := buildTestClient(buildDummyObjects("existing"))
+
+	snapshot, err := TakeSnapshot(testSettings)
+	assert.NoError(t, err)
+
+	for _, object := range buildDummyObjects("leaked") {
+		switch typedObject := object.(type) {
+		case *corev1.PersistentVolume:
+			_, err = testSettings.PersistentVolumes().Create(t.Context(), typedObject, metav1.CreateOptions{})
+		case *rbacv1.ClusterRole:
+			_, err = testSettings.ClusterRoles().Create(t.Context(), typedObject, metav1.CreateOptions{})
+		default:
+			err = testSettings.Client.Create(t.Context(), typedObject.(runtimeclient.Object))
+		}
+
+		assert.NoError(t, err)
+	}
+
+	leaks, err := snapshot.Diff(testSettings)
+	assert.NoError(t, err)
+	assert.Len(t, leaks.Names(), 5)
+	assert.Equal(t, "CustomResourceDefinition/leaked.example.com, ClusterRole/leaked, PersistentVolume/leaked, "+
+		"ValidatingWebhookConfiguration/leaked, MutatingWebhookConfiguration/leaked", leaks.String())
+
+	assert.NoError(t, leaks.Cleanup())
+
+	leaks, err = snapshot.Diff(testSettings)
+	assert.NoError(t, err)
+	assert.True(t, leaks.IsEmpty())
+
+	var nilLeaks *Leaks
+
+	assert.NoError(t, nilLeaks.Cleanup())
+	assert.True(t, nilLeaks.IsEmpty())
+}
+
+func buildTestClient(objects []runtime.Object) *clients.Settings {
+	return clients.GetTestClients(clients.TestClientParams{
+		K8sMockObjects:  objects,
+		SchemeAttachers: testSchemes,
+	})
+}
+
+func buildDummyObjects(name string) []runtime.Object {
+	return []runtime.Object{
+		&apiextv1.CustomResourceDefinition{ObjectMeta: metav1.ObjectMeta{Name: name + ".example.com"}},
+		&rbacv1.ClusterRole{ObjectMeta: metav1.ObjectMeta{Name: name}},
+		&corev1.PersistentVolume{ObjectMeta: metav1.ObjectMeta{Name: name}},
+		&admregv1.ValidatingWebhookConfiguration{ObjectMeta: metav1.ObjectMeta{Name: name}},
+		&admregv1.MutatingWebhookConfiguration{ObjectMeta: metav1.ObjectMeta{Name: name}},
+	}
+}
@@ -0,0 +1,3 @@
+package lease
+
+//go:generate go run ../../internal/listgen -builder Builder
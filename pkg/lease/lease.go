@@ -0,0 +1,186 @@
+package lease
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
+)
+
+// Builder provides a lease builder backed by the shared common builder framework. Leases are used by operators for
+// leader election, with the current leader recorded as the holder identity.
+type Builder struct {
+	common.EmbeddableBuilder[coordinationv1.Lease, *coordinationv1.Lease]
+	common.EmbeddableCreator[coordinationv1.Lease, Builder, *coordinationv1.Lease, *Builder]
+	common.EmbeddableDeleter[coordinationv1.Lease, *coordinationv1.Lease]
+	common.EmbeddableUpdater[coordinationv1.Lease, Builder, *coordinationv1.Lease, *Builder]
+}
+
+// AttachMixins wires the embedded CRUD mixins to this builder instance.
+func (builder *Builder) AttachMixins() {
+	builder.EmbeddableCreator.SetBase(builder)
+	builder.EmbeddableDeleter.SetBase(builder)
+	builder.EmbeddableUpdater.SetBase(builder)
+}
+
+// GetGVK returns the Lease GVK for this builder.
+func (builder *Builder) GetGVK() schema.GroupVersionKind {
+	return coordinationv1.SchemeGroupVersion.WithKind("Lease")
+}
+
+// NewBuilder creates a new instance of Builder.
+func NewBuilder(apiClient *clients.Settings, name, nsname string) *Builder {
+	return common.NewNamespacedBuilder[coordinationv1.Lease, Builder](apiClient, coordinationv1.AddToScheme, name, nsname)
+}
+
+// Pull retrieves an existing lease object from the cluster.
+func Pull(apiClient *clients.Settings, name, nsname string) (*Builder, error) {
+	return common.PullNamespacedBuilder[coordinationv1.Lease, Builder](
+		context.TODO(), apiClient, coordinationv1.AddToScheme, name, nsname)
+}
+
+// WithHolderIdentity sets the identity of the holder of the lease.
+func (builder *Builder) WithHolderIdentity(holderIdentity string) *Builder {
+	if err := common.Validate(builder); err != nil {
+		return builder
+	}
+
+	klog.V(100).Infof("Setting holder identity of lease %s in namespace %s to %s",
+		builder.Definition.Name, builder.Definition.Namespace, holderIdentity)
+
+	if holderIdentity == "" {
+		builder.SetError(fmt.Errorf("lease 'holderIdentity' cannot be empty"))
+
+		return builder
+	}
+
+	builder.Definition.Spec.HolderIdentity = ptr.To(holderIdentity)
+
+	return builder
+}
+
+// WithLeaseDuration sets the duration candidates wait before they may take over the lease once it stops being renewed.
+func (builder *Builder) WithLeaseDuration(duration time.Duration) *Builder {
+	if err := common.Validate(builder); err != nil {
+		return builder
+	}
+
+	klog.V(100).Infof("Setting duration of lease %s in namespace %s to %s",
+		builder.Definition.Name, builder.Definition.Namespace, duration)
+
+	if duration < time.Second {
+		builder.SetError(fmt.Errorf("lease 'duration' must be at least one second"))
+
+		return builder
+	}
+
+	builder.Definition.Spec.LeaseDurationSeconds = ptr.To(int32(duration / time.Second))
+
+	return builder
+}
+
+// GetHolderIdentity returns the identity of the current holder of the lease from the cluster. It returns an empty
+// string if the lease is not held.
+func (builder *Builder) GetHolderIdentity() (string, error) {
+	lease, err := builder.refresh()
+	if err != nil {
+		return "", err
+	}
+
+	return ptr.Deref(lease.Spec.HolderIdentity, ""), nil
+}
+
+// GetRenewTime returns the time the holder of the lease last renewed it, as stored on the cluster. It returns the zero
+// time if the lease has never been renewed.
+func (builder *Builder) GetRenewTime() (time.Time, error) {
+	lease, err := builder.refresh()
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	if lease.Spec.RenewTime == nil {
+		return time.Time{}, nil
+	}
+
+	return lease.Spec.RenewTime.Time, nil
+}
+
+// IsExpired returns whether the lease on the cluster has not been renewed within its duration, meaning another
+// candidate may take it over. Leases without a holder, renew time or duration are considered expired.
+func (builder *Builder) IsExpired() (bool, error) {
+	lease, err := builder.refresh()
+	if err != nil {
+		return false, err
+	}
+
+	if ptr.Deref(lease.Spec.HolderIdentity, "") == "" ||
+		lease.Spec.RenewTime == nil || lease.Spec.LeaseDurationSeconds == nil {
+		return true, nil
+	}
+
+	expiry := lease.Spec.RenewTime.Add(time.Duration(*lease.Spec.LeaseDurationSeconds) * time.Second)
+
+	return time.Now().After(expiry), nil
+}
+
+// WaitForLeaderChange waits up to timeout for the lease to be acquired by a holder other than the current one and
+// returns the identity of the new holder. The current holder is read from the cluster when waiting starts, so it should
+// be called before disrupting the leader.
+func (builder *Builder) WaitForLeaderChange(timeout time.Duration) (string, error) {
+	previousHolder, err := builder.GetHolderIdentity()
+	if err != nil {
+		return "", err
+	}
+
+	klog.V(100).Infof("Waiting up to %s for the holder of lease %s in namespace %s to change from %s",
+		timeout, builder.Definition.Name, builder.Definition.Namespace, previousHolder)
+
+	var newHolder string
+
+	err = wait.PollUntilContextTimeout(
+		context.TODO(), time.Second, timeout, true, func(ctx context.Context) (bool, error) {
+			newHolder, err = builder.GetHolderIdentity()
+			if err != nil {
+				klog.V(100).Infof("Failed to get holder of lease %s: %v", builder.Definition.Name, err)
+
+				return false, nil
+			}
+
+			return newHolder != "" && newHolder != previousHolder, nil
+		})
+	if err != nil {
+		return "", err
+	}
+
+	return newHolder, nil
+}
+
+// refresh gets the lease from the cluster and stores it as the builder Object.
+func (builder *Builder) refresh() (*coordinationv1.Lease, error) {
+	if err := common.Validate(builder); err != nil {
+		return nil, err
+	}
+
+	lease, err := builder.Get()
+	if err != nil {
+		return nil, err
+	}
+
+	builder.Object = lease
+
+	return lease, nil
+}
+
+// GetGVR returns Lease's GroupVersionResource which could be used for Clean function.
+func GetGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group: "coordination.k8s.io", Version: "v1", Resource: "leases",
+	}
+}
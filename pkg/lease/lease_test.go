@@ -0,0 +1,322 @@
+package lease
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common/testhelper"
+	"github.com/stretchr/testify/assert"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+)
+
+const (
+	defaultLeaseName      = "test-lease"
+	defaultLeaseNamespace = "test-namespace"
+	defaultLeaseHolder    = "controller-0_0123"
+)
+
+var leaseGVK = coordinationv1.SchemeGroupVersion.WithKind("Lease")
+
+func TestNewBuilder(t *testing.T) {
+	t.Parallel()
+
+	testhelper.NewNamespacedBuilderTestConfig[coordinationv1.Lease, Builder](
+		NewBuilder, coordinationv1.AddToScheme, leaseGVK).ExecuteTests(t)
+}
+
+func TestPull(t *testing.T) {
+	t.Parallel()
+
+	testhelper.NewNamespacedPullTestConfig[coordinationv1.Lease, Builder](
+		Pull, coordinationv1.AddToScheme, leaseGVK).ExecuteTests(t)
+}
+
+func TestBuilderMethods(t *testing.T) {
+	t.Parallel()
+
+	commonConfig := testhelper.NewCommonTestConfig[coordinationv1.Lease, Builder](
+		coordinationv1.AddToScheme, leaseGVK, testhelper.ResourceScopeNamespaced)
+
+	testhelper.NewTestSuite().
+		With(testhelper.NewGetTestConfig(commonConfig)).
+		With(testhelper.NewExistsTestConfig(commonConfig)).
+		With(testhelper.NewCreateTestConfig(commonConfig)).
+		With(testhelper.NewDeleterTestConfig(commonConfig)).
+		With(testhelper.NewUpdateTestConfig(commonConfig)).
+		Run(t)
+}
+
+func TestWithHolderIdentity(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		holderIdentity string
+		expectedError  string
+	}{
+		{
+			holderIdentity: defaultLeaseHolder,
+		},
+		{
+			holderIdentity: "",
+			expectedError:  "lease 'holderIdentity' cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := NewBuilder(buildTestClientWithDummyLease(nil), defaultLeaseName, defaultLeaseNamespace).
+			WithHolderIdentity(testCase.holderIdentity)
+
+		if testCase.expectedError != "" {
+			assert.EqualError(t, testBuilder.GetError(), testCase.expectedError)
+
+			continue
+		}
+
+		assert.NoError(t, testBuilder.GetError())
+		assert.Equal(t, testCase.holderIdentity, *testBuilder.Definition.Spec.HolderIdentity)
+	}
+}
+
+func TestWithLeaseDuration(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		duration      time.Duration
+		expectedError string
+	}{
+		{
+			duration: 137 * time.Second,
+		},
+		{
+			duration:      time.Millisecond,
+			expectedError: "lease 'duration' must be at least one second",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := NewBuilder(buildTestClientWithDummyLease(nil), defaultLeaseName, defaultLeaseNamespace).
+			WithLeaseDuration(testCase.duration)
+
+		if testCase.expectedError != "" {
+			assert.EqualError(t, testBuilder.GetError(), testCase.expectedError)
+
+			continue
+		}
+
+		assert.NoError(t, testBuilder.GetError())
+		assert.Equal(t, int32(137), *testBuilder.Definition.Spec.LeaseDurationSeconds)
+	}
+}
+
+func TestGetHolderIdentity(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		lease          *coordinationv1.Lease
+		expectedHolder string
+		expectedError  bool
+	}{
+		{
+			lease:          buildDummyLease(defaultLeaseHolder, time.Now(), 15),
+			expectedHolder: defaultLeaseHolder,
+		},
+		{
+			lease:          &coordinationv1.Lease{ObjectMeta: buildDummyLease("", time.Now(), 0).ObjectMeta},
+			expectedHolder: "",
+		},
+		{
+			lease:         nil,
+			expectedError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := NewBuilder(buildTestClientWithDummyLease(testCase.lease), defaultLeaseName, defaultLeaseNamespace)
+
+		holder, err := testBuilder.GetHolderIdentity()
+
+		if testCase.expectedError {
+			assert.Error(t, err)
+
+			continue
+		}
+
+		assert.NoError(t, err)
+		assert.Equal(t, testCase.expectedHolder, holder)
+		assert.NotNil(t, testBuilder.Object)
+	}
+}
+
+func TestGetRenewTime(t *testing.T) {
+	t.Parallel()
+
+	renewTime := time.Now().Add(-time.Minute).Truncate(time.Microsecond)
+
+	testBuilder := NewBuilder(
+		buildTestClientWithDummyLease(buildDummyLease(defaultLeaseHolder, renewTime, 15)),
+		defaultLeaseName, defaultLeaseNamespace)
+
+	actualRenewTime, err := testBuilder.GetRenewTime()
+	assert.NoError(t, err)
+	assert.True(t, renewTime.Equal(actualRenewTime))
+
+	testBuilder = NewBuilder(buildTestClientWithDummyLease(
+		&coordinationv1.Lease{ObjectMeta: buildDummyLease("", time.Now(), 0).ObjectMeta}),
+		defaultLeaseName, defaultLeaseNamespace)
+
+	actualRenewTime, err = testBuilder.GetRenewTime()
+	assert.NoError(t, err)
+	assert.True(t, actualRenewTime.IsZero())
+
+	_, err = NewBuilder(buildTestClientWithDummyLease(nil), defaultLeaseName, defaultLeaseNamespace).GetRenewTime()
+	assert.Error(t, err)
+}
+
+func TestIsExpired(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		lease           *coordinationv1.Lease
+		expectedExpired bool
+		expectedError   bool
+	}{
+		{
+			lease:           buildDummyLease(defaultLeaseHolder, time.Now(), 15),
+			expectedExpired: false,
+		},
+		{
+			lease:           buildDummyLease(defaultLeaseHolder, time.Now().Add(-time.Minute), 15),
+			expectedExpired: true,
+		},
+		{
+			lease:           buildDummyLease("", time.Now(), 15),
+			expectedExpired: true,
+		},
+		{
+			lease:         nil,
+			expectedError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := NewBuilder(buildTestClientWithDummyLease(testCase.lease), defaultLeaseName, defaultLeaseNamespace)
+
+		expired, err := testBuilder.IsExpired()
+
+		if testCase.expectedError {
+			assert.Error(t, err)
+
+			continue
+		}
+
+		assert.NoError(t, err)
+		assert.Equal(t, testCase.expectedExpired, expired)
+	}
+}
+
+func TestWaitForLeaderChange(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		newHolder      string
+		expectedHolder string
+		expectedError  bool
+	}{
+		{
+			newHolder:      "controller-1_4567",
+			expectedHolder: "controller-1_4567",
+		},
+		{
+			newHolder:     defaultLeaseHolder,
+			expectedError: true,
+		},
+		{
+			// The lease being released is not a leader change until a new holder acquires it.
+			newHolder:     "",
+			expectedError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		var getCalls int
+
+		testSettings := clients.GetTestClients(clients.TestClientParams{
+			K8sMockObjects:  []runtime.Object{buildDummyLease(defaultLeaseHolder, time.Now(), 15)},
+			SchemeAttachers: []clients.SchemeAttacher{coordinationv1.AddToScheme},
+			InterceptorFuncs: interceptor.Funcs{
+				Get: func(ctx context.Context, client runtimeclient.WithWatch, key runtimeclient.ObjectKey,
+					obj runtimeclient.Object, opts ...runtimeclient.GetOption) error {
+					err := client.Get(ctx, key, obj, opts...)
+					if err != nil {
+						return err
+					}
+
+					getCalls++
+
+					// The first get records the previous holder, after which the leader changes.
+					if lease, ok := obj.(*coordinationv1.Lease); ok && getCalls > 1 {
+						lease.Spec.HolderIdentity = ptr.To(testCase.newHolder)
+					}
+
+					return nil
+				},
+			},
+		})
+
+		holder, err := NewBuilder(testSettings, defaultLeaseName, defaultLeaseNamespace).
+			WaitForLeaderChange(100 * time.Millisecond)
+
+		if testCase.expectedError {
+			assert.Error(t, err)
+
+			continue
+		}
+
+		assert.NoError(t, err)
+		assert.Equal(t, testCase.expectedHolder, holder)
+	}
+
+	_, err := NewBuilder(buildTestClientWithDummyLease(nil), defaultLeaseName, defaultLeaseNamespace).
+		WaitForLeaderChange(100 * time.Millisecond)
+	assert.Error(t, err)
+}
+
+// buildTestClientWithDummyLease returns a test client with the Lease scheme and the provided lease, if not nil.
+func buildTestClientWithDummyLease(lease *coordinationv1.Lease) *clients.Settings {
+	var runtimeObjects []runtime.Object
+
+	if lease != nil {
+		runtimeObjects = append(runtimeObjects, lease)
+	}
+
+	return clients.GetTestClients(clients.TestClientParams{
+		K8sMockObjects:  runtimeObjects,
+		SchemeAttachers: []clients.SchemeAttacher{coordinationv1.AddToScheme},
+	})
+}
+
+func buildDummyLease(holderIdentity string, renewTime time.Time, durationSeconds int32) *coordinationv1.Lease {
+	lease := &coordinationv1.Lease{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      defaultLeaseName,
+			Namespace: defaultLeaseNamespace,
+		},
+		Spec: coordinationv1.LeaseSpec{
+			RenewTime:            &metav1.MicroTime{Time: renewTime},
+			LeaseDurationSeconds: ptr.To(durationSeconds),
+		},
+	}
+
+	if holderIdentity != "" {
+		lease.Spec.HolderIdentity = ptr.To(holderIdentity)
+	}
+
+	return lease
+}
@@ -0,0 +1,28 @@
+package lease
+
+import (
+	"testing"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common/testhelper"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func TestList(t *testing.T) {
+	t.Parallel()
+
+	testhelper.NewNamespacedListTestConfig(
+		func(apiClient *clients.Settings, nsname string, _ ...runtimeclient.ListOptions) ([]*Builder, error) {
+			return List(apiClient, nsname)
+		},
+		coordinationv1.AddToScheme,
+		leaseGVK,
+	).ExecuteTests(t)
+}
+
+func TestListInAllNamespaces(t *testing.T) {
+	t.Parallel()
+
+	testhelper.NewListTestConfig(ListInAllNamespaces, coordinationv1.AddToScheme, leaseGVK).ExecuteTests(t)
+}
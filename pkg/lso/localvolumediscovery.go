@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"time"
 
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/wait"
 
 	corev1 "k8s.io/api/core/v1"
@@ -40,6 +41,8 @@ type LocalVolumeDiscoveryBuilder struct {
 
 // NewLocalVolumeDiscoveryBuilder creates new instance of LocalVolumeDiscoveryBuilder.
 func NewLocalVolumeDiscoveryBuilder(apiClient *clients.Settings, name, nsname string) *LocalVolumeDiscoveryBuilder {
+	nsname = apiClient.ResolveNamespace(nsname)
+
 	klog.V(100).Infof("Initializing new localVolumeDiscovery structure with the following params: name: "+
 		"%s, namespace: %s", name, nsname)
 
@@ -87,6 +90,8 @@ func NewLocalVolumeDiscoveryBuilder(apiClient *clients.Settings, name, nsname st
 
 // PullLocalVolumeDiscovery retrieves an existing localVolumeDiscovery object from the cluster.
 func PullLocalVolumeDiscovery(apiClient *clients.Settings, name, nsname string) (*LocalVolumeDiscoveryBuilder, error) {
+	nsname = apiClient.ResolveNamespace(nsname)
+
 	klog.V(100).Infof(
 		"Pulling localVolumeDiscovery object name: %s in namespace: %s", name, nsname)
 
@@ -309,6 +314,14 @@ func (builder *LocalVolumeDiscoveryBuilder) WithTolerations(
 	return builder
 }
 
+// GetLocalVolumeDiscoveryGVR returns LocalVolumeDiscovery's GroupVersionResource
+// which could be used for Clean function.
+func GetLocalVolumeDiscoveryGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group: "local.storage.openshift.io", Version: "v1alpha1", Resource: "localvolumediscoveries",
+	}
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *LocalVolumeDiscoveryBuilder) validate() (bool, error) {
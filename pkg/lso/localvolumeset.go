@@ -6,6 +6,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 
 	lsov1 "github.com/openshift/local-storage-operator/api/v1"
 	lsov1alpha1 "github.com/openshift/local-storage-operator/api/v1alpha1"
@@ -36,6 +37,8 @@ type LocalVolumeSetBuilder struct {
 
 // NewLocalVolumeSetBuilder creates new instance of LocalVolumeSetBuilder.
 func NewLocalVolumeSetBuilder(apiClient *clients.Settings, name, nsname string) *LocalVolumeSetBuilder {
+	nsname = apiClient.ResolveNamespace(nsname)
+
 	klog.V(100).Infof("Initializing new localVolumeSet %s structure in namespace %s",
 		name, nsname)
 
@@ -83,6 +86,8 @@ func NewLocalVolumeSetBuilder(apiClient *clients.Settings, name, nsname string)
 
 // PullLocalVolumeSet retrieves an existing localVolumeSet object from the cluster.
 func PullLocalVolumeSet(apiClient *clients.Settings, name, nsname string) (*LocalVolumeSetBuilder, error) {
+	nsname = apiClient.ResolveNamespace(nsname)
+
 	klog.V(100).Infof(
 		"Pulling localVolumeSet object name: %s in namespace: %s", name, nsname)
 
@@ -396,6 +401,13 @@ func (builder *LocalVolumeSetBuilder) WithDeviceInclusionSpec(
 	return builder
 }
 
+// GetLocalVolumeSetGVR returns LocalVolumeSet's GroupVersionResource which could be used for Clean function.
+func GetLocalVolumeSetGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group: "local.storage.openshift.io", Version: "v1alpha1", Resource: "localvolumesets",
+	}
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *LocalVolumeSetBuilder) validate() (bool, error) {
@@ -14,6 +14,7 @@ import (
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/msg"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/klog/v2"
 )
@@ -49,6 +50,8 @@ func NewSetBuilderFromCopy(
 	instanceType string,
 	workerLabel string,
 	replicas int32) *SetBuilder {
+	nsName = apiClient.ResolveNamespace(nsName)
+
 	klog.V(100).Infof("Initializing new SetBuilder structure from copied MachineSet with the following"+
 		" params: namespace: %s, instanceType: %s, workerLabel: %s, and replicas: %v", nsName, instanceType,
 		workerLabel, replicas)
@@ -129,6 +132,8 @@ func NewSetBuilderFromCopy(
 
 // PullSet loads an existing MachineSet into Builder struct.
 func PullSet(apiClient *clients.Settings, name, namespace string) (*SetBuilder, error) {
+	namespace = apiClient.ResolveNamespace(namespace)
+
 	klog.V(100).Infof("Pulling existing machineSet name %s in namespace %s", name, namespace)
 
 	builder := &SetBuilder{
@@ -554,6 +559,13 @@ func (builder *SetBuilder) getPublicCloudKind() error {
 	return nil
 }
 
+// GetGVR returns MachineSet's GroupVersionResource which could be used for Clean function.
+func GetGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group: "machine.openshift.io", Version: "v1beta1", Resource: "machinesets",
+	}
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *SetBuilder) validate() (bool, error) {
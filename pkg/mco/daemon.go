@@ -0,0 +1,69 @@
+package mco
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/pod"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+)
+
+const (
+	// MCDNamespace is the namespace of the machine config daemon pods.
+	MCDNamespace = "openshift-machine-config-operator"
+	// MCDContainer is the name of the container running the machine config daemon in its pods.
+	MCDContainer = "machine-config-daemon"
+
+	mcdLabelSelector = "k8s-app=machine-config-daemon"
+)
+
+// GetMCDLogsForNode returns the logs of the machine config daemon running on the node, written during the last since,
+// to diagnose MachineConfig rollouts. If since is zero, the full logs of the current daemon container are returned.
+func GetMCDLogsForNode(apiClient *clients.Settings, nodeName string, since time.Duration) (string, error) {
+	if apiClient == nil {
+		klog.V(100).Info("The apiClient is nil")
+
+		return "", fmt.Errorf("machine config daemon 'apiClient' cannot be nil")
+	}
+
+	if nodeName == "" {
+		klog.V(100).Info("The nodeName is empty")
+
+		return "", fmt.Errorf("machine config daemon 'nodeName' cannot be empty")
+	}
+
+	if since < 0 {
+		return "", fmt.Errorf("machine config daemon logs 'since' cannot be negative, got %s", since)
+	}
+
+	klog.V(100).Infof("Getting machine config daemon logs for node %s since %s", nodeName, since)
+
+	daemonPod, err := getMCDPodForNode(apiClient, nodeName)
+	if err != nil {
+		return "", err
+	}
+
+	if since == 0 {
+		return daemonPod.GetFullLog(MCDContainer)
+	}
+
+	return daemonPod.GetLog(since, MCDContainer)
+}
+
+// getMCDPodForNode returns the machine config daemon pod scheduled on the node.
+func getMCDPodForNode(apiClient *clients.Settings, nodeName string) (*pod.Builder, error) {
+	daemonPods, err := pod.List(apiClient, MCDNamespace, metav1.ListOptions{LabelSelector: mcdLabelSelector})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list machine config daemon pods: %w", err)
+	}
+
+	for _, daemonPod := range daemonPods {
+		if daemonPod.Definition.Spec.NodeName == nodeName {
+			return daemonPod, nil
+		}
+	}
+
+	return nil, fmt.Errorf("no machine config daemon pod found on node %s", nodeName)
+}
@@ -0,0 +1,90 @@
+package mco
+
+import (
+	"testing"
+	"time"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestGetMCDLogsForNode(t *testing.T) {
+	testCases := []struct {
+		nodeName      string
+		since         time.Duration
+		client        bool
+		expectedError string
+	}{
+		{
+			nodeName: "worker-0",
+			since:    time.Minute,
+			client:   true,
+		},
+		{
+			nodeName: "worker-0",
+			client:   true,
+		},
+		{
+			nodeName:      "worker-1",
+			client:        true,
+			expectedError: "no machine config daemon pod found on node worker-1",
+		},
+		{
+			nodeName:      "",
+			client:        true,
+			expectedError: "machine config daemon 'nodeName' cannot be empty",
+		},
+		{
+			nodeName:      "worker-0",
+			since:         -time.Minute,
+			client:        true,
+			expectedError: "machine config daemon logs 'since' cannot be negative, got -1m0s",
+		},
+		{
+			nodeName:      "worker-0",
+			client:        false,
+			expectedError: "machine config daemon 'apiClient' cannot be nil",
+		},
+	}
+
+	for _, testCase := range testCases {
+		var testSettings *clients.Settings
+
+		if testCase.client {
+			testSettings = clients.GetTestClients(clients.TestClientParams{
+				K8sMockObjects: []runtime.Object{
+					buildDummyMCDPod("machine-config-daemon-a", "worker-0", "machine-config-daemon"),
+					buildDummyMCDPod("other-pod", "worker-1", "other"),
+				},
+				SchemeAttachers: []clients.SchemeAttacher{corev1.AddToScheme},
+			})
+		}
+
+		logs, err := GetMCDLogsForNode(testSettings, testCase.nodeName, testCase.since)
+		if testCase.expectedError != "" {
+			assert.EqualError(t, err, testCase.expectedError)
+
+			continue
+		}
+
+		assert.NoError(t, err)
+		assert.Equal(t, "fake logs", logs)
+	}
+}
+
+func buildDummyMCDPod(name, nodeName, app string) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: MCDNamespace,
+			Labels:    map[string]string{"k8s-app": app},
+		},
+		Spec: corev1.PodSpec{
+			NodeName:   nodeName,
+			Containers: []corev1.Container{{Name: MCDContainer, Image: "test"}},
+		},
+	}
+}
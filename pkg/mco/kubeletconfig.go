@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 
 	mcv1 "github.com/openshift/api/machineconfiguration/v1"
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
@@ -290,6 +291,13 @@ func (builder *KubeletConfigBuilder) WithOptions(options ...AdditionalOptions) *
 	return builder
 }
 
+// GetKubeletConfigGVR returns KubeletConfig's GroupVersionResource which could be used for Clean function.
+func GetKubeletConfigGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group: "machineconfiguration.openshift.io", Version: "v1", Resource: "kubeletconfigs",
+	}
+}
+
 func (builder *KubeletConfigBuilder) validate() (bool, error) {
 	resourceCRD := "KubeletConfig"
 
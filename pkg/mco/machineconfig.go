@@ -5,10 +5,12 @@ import (
 
 	mcv1 "github.com/openshift/api/machineconfiguration/v1"
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common"
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/logging"
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/msg"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/klog/v2"
 	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -224,6 +226,14 @@ func (builder *MCBuilder) WithLabel(key, value string) *MCBuilder {
 		return builder
 	}
 
+	if err := common.ValidateLabel(key, value); err != nil {
+		klog.V(100).Infof("Invalid label: %v", err)
+
+		builder.errorMsg = err.Error()
+
+		return builder
+	}
+
 	if builder.Definition.Labels == nil {
 		builder.Definition.Labels = map[string]string{}
 	}
@@ -354,6 +364,13 @@ func (builder *MCBuilder) WithRawConfig(config []byte) *MCBuilder {
 	return builder
 }
 
+// GetMachineConfigGVR returns MachineConfig's GroupVersionResource which could be used for Clean function.
+func GetMachineConfigGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group: "machineconfiguration.openshift.io", Version: "v1", Resource: "machineconfigs",
+	}
+}
+
 func (builder *MCBuilder) validate() (bool, error) {
 	resourceCRD := "MachineConfig"
 
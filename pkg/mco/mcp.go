@@ -9,6 +9,7 @@ import (
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/logging"
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/msg"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/klog/v2"
 	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -407,6 +408,13 @@ func (builder *MCPBuilder) IsInCondition(mcpConditionType mcv1.MachineConfigPool
 	return false
 }
 
+// GetMachineConfigPoolGVR returns MachineConfigPool's GroupVersionResource which could be used for Clean function.
+func GetMachineConfigPoolGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group: "machineconfiguration.openshift.io", Version: "v1", Resource: "machineconfigpools",
+	}
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *MCPBuilder) validate() (bool, error) {
@@ -0,0 +1,278 @@
+package medik8s
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common"
+	farv1alpha1 "github.com/rh-ecosystem-edge/eco-goinfra/pkg/schemes/medik8s/fenceagentsremediation/v1alpha1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/klog/v2"
+	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// FenceAgentsRemediationBuilder provides a struct for the FenceAgentsRemediation resource containing a connection to
+// the cluster and the FenceAgentsRemediation definition.
+type FenceAgentsRemediationBuilder struct {
+	common.EmbeddableBuilder[farv1alpha1.FenceAgentsRemediation, *farv1alpha1.FenceAgentsRemediation]
+	common.EmbeddableCreator[farv1alpha1.FenceAgentsRemediation, FenceAgentsRemediationBuilder,
+		*farv1alpha1.FenceAgentsRemediation, *FenceAgentsRemediationBuilder]
+	common.EmbeddableDeleter[farv1alpha1.FenceAgentsRemediation, *farv1alpha1.FenceAgentsRemediation]
+	common.EmbeddableUpdater[farv1alpha1.FenceAgentsRemediation, FenceAgentsRemediationBuilder,
+		*farv1alpha1.FenceAgentsRemediation, *FenceAgentsRemediationBuilder]
+	common.EmbeddableConditionGetter[metav1.Condition, farv1alpha1.FenceAgentsRemediation,
+		*farv1alpha1.FenceAgentsRemediation]
+}
+
+// AttachMixins wires the embedded CRUD mixins to this builder instance.
+func (builder *FenceAgentsRemediationBuilder) AttachMixins() {
+	builder.EmbeddableCreator.SetBase(builder)
+	builder.EmbeddableDeleter.SetBase(builder)
+	builder.EmbeddableUpdater.SetBase(builder)
+	builder.EmbeddableConditionGetter.SetBase(builder)
+}
+
+// GetGVK returns the FenceAgentsRemediation GVK for this builder.
+func (builder *FenceAgentsRemediationBuilder) GetGVK() schema.GroupVersionKind {
+	return farv1alpha1.GroupVersion.WithKind("FenceAgentsRemediation")
+}
+
+// NewFenceAgentsRemediationBuilder creates a new instance of FenceAgentsRemediationBuilder. The operator fences the
+// node with the same name as the FenceAgentsRemediation, so nodeName is used as the resource name. The agent must be
+// the name of a fence agent, such as fence_ipmilan.
+func NewFenceAgentsRemediationBuilder(
+	apiClient *clients.Settings, nodeName, nsname, agent string) *FenceAgentsRemediationBuilder {
+	klog.V(100).Infof(
+		"Initializing new FenceAgentsRemediation structure with the following params: nodeName: %s, nsname: %s, agent: %s",
+		nodeName, nsname, agent)
+
+	builder := common.NewNamespacedBuilder[farv1alpha1.FenceAgentsRemediation, FenceAgentsRemediationBuilder](
+		apiClient, farv1alpha1.AddToScheme, nodeName, nsname)
+	if builder.GetError() != nil {
+		return builder
+	}
+
+	if !strings.HasPrefix(agent, "fence_") {
+		klog.V(100).Infof("The agent %s of the FenceAgentsRemediation does not have the fence_ prefix", agent)
+
+		builder.SetError(fmt.Errorf("fenceagentsremediation 'agent' must have the fence_ prefix, got %q", agent))
+
+		return builder
+	}
+
+	builder.Definition.Spec.Agent = agent
+
+	return builder
+}
+
+// PullFenceAgentsRemediation pulls an existing FenceAgentsRemediation from the cluster.
+func PullFenceAgentsRemediation(
+	apiClient *clients.Settings, nodeName, nsname string) (*FenceAgentsRemediationBuilder, error) {
+	klog.V(100).Infof("Pulling existing FenceAgentsRemediation %s in namespace %s from cluster", nodeName, nsname)
+
+	return common.PullNamespacedBuilder[farv1alpha1.FenceAgentsRemediation, FenceAgentsRemediationBuilder](
+		context.TODO(), apiClient, farv1alpha1.AddToScheme, nodeName, nsname)
+}
+
+// ListFenceAgentsRemediations returns a list of FenceAgentsRemediation builders matching the provided options.
+func ListFenceAgentsRemediations(
+	apiClient *clients.Settings, options ...runtimeclient.ListOption) ([]*FenceAgentsRemediationBuilder, error) {
+	return common.List[farv1alpha1.FenceAgentsRemediation, farv1alpha1.FenceAgentsRemediationList,
+		FenceAgentsRemediationBuilder](context.TODO(), apiClient, farv1alpha1.AddToScheme, options...)
+}
+
+// WithSharedParameter sets a fence agent parameter that is passed regardless of the node being fenced.
+func (builder *FenceAgentsRemediationBuilder) WithSharedParameter(name, value string) *FenceAgentsRemediationBuilder {
+	if err := common.Validate(builder); err != nil {
+		return builder
+	}
+
+	klog.V(100).Infof("Setting shared parameter %s on FenceAgentsRemediation %s in namespace %s",
+		name, builder.Definition.Name, builder.Definition.Namespace)
+
+	if name == "" {
+		klog.V(100).Info("The shared parameter name is empty")
+
+		builder.SetError(fmt.Errorf("fenceagentsremediation parameter name cannot be empty"))
+
+		return builder
+	}
+
+	if builder.Definition.Spec.SharedParameters == nil {
+		builder.Definition.Spec.SharedParameters = make(map[farv1alpha1.ParameterName]string)
+	}
+
+	builder.Definition.Spec.SharedParameters[farv1alpha1.ParameterName(name)] = value
+
+	return builder
+}
+
+// WithNodeParameter sets a fence agent parameter that is only passed when fencing nodeName.
+func (builder *FenceAgentsRemediationBuilder) WithNodeParameter(
+	name, nodeName, value string) *FenceAgentsRemediationBuilder {
+	if err := common.Validate(builder); err != nil {
+		return builder
+	}
+
+	klog.V(100).Infof("Setting node parameter %s for node %s on FenceAgentsRemediation %s in namespace %s",
+		name, nodeName, builder.Definition.Name, builder.Definition.Namespace)
+
+	if name == "" {
+		klog.V(100).Info("The node parameter name is empty")
+
+		builder.SetError(fmt.Errorf("fenceagentsremediation parameter name cannot be empty"))
+
+		return builder
+	}
+
+	if nodeName == "" {
+		klog.V(100).Info("The node parameter nodeName is empty")
+
+		builder.SetError(fmt.Errorf("fenceagentsremediation node parameter 'nodeName' cannot be empty"))
+
+		return builder
+	}
+
+	if builder.Definition.Spec.NodeParameters == nil {
+		builder.Definition.Spec.NodeParameters = make(map[farv1alpha1.ParameterName]map[farv1alpha1.NodeName]string)
+	}
+
+	parameterName := farv1alpha1.ParameterName(name)
+
+	if builder.Definition.Spec.NodeParameters[parameterName] == nil {
+		builder.Definition.Spec.NodeParameters[parameterName] = make(map[farv1alpha1.NodeName]string)
+	}
+
+	builder.Definition.Spec.NodeParameters[parameterName][farv1alpha1.NodeName(nodeName)] = value
+
+	return builder
+}
+
+// WithSharedSecretName sets the name of the secret containing parameters shared by all nodes.
+func (builder *FenceAgentsRemediationBuilder) WithSharedSecretName(secretName string) *FenceAgentsRemediationBuilder {
+	if err := common.Validate(builder); err != nil {
+		return builder
+	}
+
+	klog.V(100).Infof("Setting shared secret name of FenceAgentsRemediation %s in namespace %s to %s",
+		builder.Definition.Name, builder.Definition.Namespace, secretName)
+
+	if secretName == "" {
+		klog.V(100).Info("The shared secret name is empty")
+
+		builder.SetError(fmt.Errorf("fenceagentsremediation 'sharedSecretName' cannot be empty"))
+
+		return builder
+	}
+
+	builder.Definition.Spec.SharedSecretName = &secretName
+
+	return builder
+}
+
+// WithRetry sets how many times the fence agent is executed, how long to wait between executions, and the timeout of
+// each execution.
+func (builder *FenceAgentsRemediationBuilder) WithRetry(
+	retryCount int, retryInterval, timeout time.Duration) *FenceAgentsRemediationBuilder {
+	if err := common.Validate(builder); err != nil {
+		return builder
+	}
+
+	klog.V(100).Infof(
+		"Setting retry on FenceAgentsRemediation %s in namespace %s: count: %d, interval: %s, timeout: %s",
+		builder.Definition.Name, builder.Definition.Namespace, retryCount, retryInterval, timeout)
+
+	if retryCount < 1 {
+		klog.V(100).Info("The retry count is less than 1")
+
+		builder.SetError(fmt.Errorf("fenceagentsremediation 'retryCount' cannot be less than 1"))
+
+		return builder
+	}
+
+	builder.Definition.Spec.RetryCount = retryCount
+	builder.Definition.Spec.RetryInterval = metav1.Duration{Duration: retryInterval}
+	builder.Definition.Spec.Timeout = metav1.Duration{Duration: timeout}
+
+	return builder
+}
+
+// WithRemediationStrategy sets the strategy used by the operator to remediate the fenced node.
+func (builder *FenceAgentsRemediationBuilder) WithRemediationStrategy(
+	strategy farv1alpha1.RemediationStrategyType) *FenceAgentsRemediationBuilder {
+	if err := common.Validate(builder); err != nil {
+		return builder
+	}
+
+	klog.V(100).Infof("Setting remediation strategy of FenceAgentsRemediation %s in namespace %s to %s",
+		builder.Definition.Name, builder.Definition.Namespace, strategy)
+
+	if strategy != farv1alpha1.ResourceDeletionRemediationStrategy &&
+		strategy != farv1alpha1.OutOfServiceTaintRemediationStrategy {
+		klog.V(100).Infof("The remediation strategy %s is not supported", strategy)
+
+		builder.SetError(fmt.Errorf("fenceagentsremediation 'remediationStrategy' %q is not supported", strategy))
+
+		return builder
+	}
+
+	builder.Definition.Spec.RemediationStrategy = strategy
+
+	return builder
+}
+
+// WaitForCondition waits up to timeout for the FenceAgentsRemediation to have a condition of the provided type with
+// the provided status.
+func (builder *FenceAgentsRemediationBuilder) WaitForCondition(
+	conditionType string, status metav1.ConditionStatus, timeout time.Duration) (*FenceAgentsRemediationBuilder, error) {
+	if err := common.Validate(builder); err != nil {
+		return builder, err
+	}
+
+	klog.V(100).Infof("Waiting up to %s for FenceAgentsRemediation %s in namespace %s to have condition %s=%s",
+		timeout, builder.Definition.Name, builder.Definition.Namespace, conditionType, status)
+
+	err := wait.PollUntilContextTimeout(
+		context.TODO(), time.Second, timeout, true, func(ctx context.Context) (bool, error) {
+			object, err := builder.Get()
+			if err != nil {
+				klog.V(100).Infof("Failed to get FenceAgentsRemediation %s in namespace %s: %v",
+					builder.Definition.Name, builder.Definition.Namespace, err)
+
+				return false, nil
+			}
+
+			builder.Object = object
+
+			return meta.IsStatusConditionPresentAndEqual(object.Status.Conditions, conditionType, status), nil
+		})
+
+	return builder, err
+}
+
+// WaitUntilFenced waits up to timeout for the fence agent action to succeed on the node.
+func (builder *FenceAgentsRemediationBuilder) WaitUntilFenced(
+	timeout time.Duration) (*FenceAgentsRemediationBuilder, error) {
+	return builder.WaitForCondition(farv1alpha1.FenceAgentActionSucceededType, metav1.ConditionTrue, timeout)
+}
+
+// WaitUntilSucceeded waits up to timeout for the remediation to finish successfully, meaning the node has been fenced
+// and its workloads have been remediated.
+func (builder *FenceAgentsRemediationBuilder) WaitUntilSucceeded(
+	timeout time.Duration) (*FenceAgentsRemediationBuilder, error) {
+	return builder.WaitForCondition(farv1alpha1.SucceededType, metav1.ConditionTrue, timeout)
+}
+
+// GetFenceAgentsRemediationGVR returns FenceAgentsRemediation's GroupVersionResource
+// which could be used for Clean function.
+func GetFenceAgentsRemediationGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group: "fence-agents-remediation.medik8s.io", Version: "v1alpha1", Resource: "fenceagentsremediations",
+	}
+}
@@ -0,0 +1,213 @@
+package medik8s
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common/testhelper"
+	farv1alpha1 "github.com/rh-ecosystem-edge/eco-goinfra/pkg/schemes/medik8s/fenceagentsremediation/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	defaultFARNamespace = "openshift-workload-availability"
+	defaultFenceAgent   = "fence_ipmilan"
+)
+
+var fenceAgentsRemediationGVK = farv1alpha1.GroupVersion.WithKind("FenceAgentsRemediation")
+
+func TestNewFenceAgentsRemediationBuilder(t *testing.T) {
+	t.Parallel()
+
+	t.Run("common namespaced builder behavior", func(t *testing.T) {
+		t.Parallel()
+
+		testhelper.NewNamespacedBuilderTestConfig(
+			func(apiClient *clients.Settings, name, nsname string) *FenceAgentsRemediationBuilder {
+				return NewFenceAgentsRemediationBuilder(apiClient, name, nsname, defaultFenceAgent)
+			},
+			farv1alpha1.AddToScheme,
+			fenceAgentsRemediationGVK,
+		).ExecuteTests(t)
+	})
+
+	t.Run("agent without fence_ prefix returns error", func(t *testing.T) {
+		t.Parallel()
+
+		testBuilder := NewFenceAgentsRemediationBuilder(
+			clients.GetTestClients(clients.TestClientParams{}), defaultNodeName, defaultFARNamespace, "ipmilan")
+
+		assert.EqualError(t, testBuilder.GetError(),
+			"fenceagentsremediation 'agent' must have the fence_ prefix, got \"ipmilan\"")
+	})
+
+	t.Run("valid agent sets spec", func(t *testing.T) {
+		t.Parallel()
+
+		testBuilder := buildValidFARTestBuilder(clients.GetTestClients(clients.TestClientParams{}))
+
+		require.NoError(t, testBuilder.GetError())
+		assert.Equal(t, defaultFenceAgent, testBuilder.Definition.Spec.Agent)
+	})
+}
+
+func TestPullFenceAgentsRemediation(t *testing.T) {
+	t.Parallel()
+
+	testhelper.NewNamespacedPullTestConfig(
+		PullFenceAgentsRemediation, farv1alpha1.AddToScheme, fenceAgentsRemediationGVK).ExecuteTests(t)
+}
+
+func TestListFenceAgentsRemediations(t *testing.T) {
+	t.Parallel()
+
+	testhelper.NewListTestConfig(
+		ListFenceAgentsRemediations, farv1alpha1.AddToScheme, fenceAgentsRemediationGVK).ExecuteTests(t)
+}
+
+func TestFenceAgentsRemediationMethods(t *testing.T) {
+	t.Parallel()
+
+	commonTestConfig := testhelper.NewCommonTestConfig[farv1alpha1.FenceAgentsRemediation, FenceAgentsRemediationBuilder](
+		farv1alpha1.AddToScheme,
+		fenceAgentsRemediationGVK,
+		testhelper.ResourceScopeNamespaced,
+	)
+
+	testhelper.NewTestSuite().
+		With(testhelper.NewGetTestConfig(commonTestConfig)).
+		With(testhelper.NewExistsTestConfig(commonTestConfig)).
+		With(testhelper.NewCreateTestConfig(commonTestConfig)).
+		With(testhelper.NewDeleterTestConfig(commonTestConfig)).
+		With(testhelper.NewUpdateTestConfig(commonTestConfig)).
+		Run(t)
+}
+
+func TestFenceAgentsRemediationWithParameters(t *testing.T) {
+	t.Parallel()
+
+	testBuilder := buildValidFARTestBuilder(clients.GetTestClients(clients.TestClientParams{})).
+		WithSharedParameter("--lanplus", "").
+		WithNodeParameter("--ipport", defaultNodeName, "6233")
+
+	assert.NoError(t, testBuilder.GetError())
+	assert.Equal(t, map[farv1alpha1.ParameterName]string{"--lanplus": ""}, testBuilder.Definition.Spec.SharedParameters)
+	assert.Equal(t, "6233", testBuilder.Definition.Spec.NodeParameters["--ipport"][defaultNodeName])
+
+	testBuilder = buildValidFARTestBuilder(clients.GetTestClients(clients.TestClientParams{})).
+		WithSharedParameter("", "value")
+	assert.EqualError(t, testBuilder.GetError(), "fenceagentsremediation parameter name cannot be empty")
+
+	testBuilder = buildValidFARTestBuilder(clients.GetTestClients(clients.TestClientParams{})).
+		WithNodeParameter("--ipport", "", "6233")
+	assert.EqualError(t, testBuilder.GetError(), "fenceagentsremediation node parameter 'nodeName' cannot be empty")
+}
+
+func TestFenceAgentsRemediationWithSharedSecretName(t *testing.T) {
+	t.Parallel()
+
+	testBuilder := buildValidFARTestBuilder(clients.GetTestClients(clients.TestClientParams{})).
+		WithSharedSecretName("fence-secret")
+	assert.NoError(t, testBuilder.GetError())
+	require.NotNil(t, testBuilder.Definition.Spec.SharedSecretName)
+	assert.Equal(t, "fence-secret", *testBuilder.Definition.Spec.SharedSecretName)
+
+	testBuilder = testBuilder.WithSharedSecretName("")
+	assert.EqualError(t, testBuilder.GetError(), "fenceagentsremediation 'sharedSecretName' cannot be empty")
+}
+
+func TestFenceAgentsRemediationWithRetry(t *testing.T) {
+	t.Parallel()
+
+	testBuilder := buildValidFARTestBuilder(clients.GetTestClients(clients.TestClientParams{})).
+		WithRetry(3, 5*time.Second, time.Minute)
+	assert.NoError(t, testBuilder.GetError())
+	assert.Equal(t, 3, testBuilder.Definition.Spec.RetryCount)
+	assert.Equal(t, 5*time.Second, testBuilder.Definition.Spec.RetryInterval.Duration)
+	assert.Equal(t, time.Minute, testBuilder.Definition.Spec.Timeout.Duration)
+
+	testBuilder = testBuilder.WithRetry(0, time.Second, time.Second)
+	assert.EqualError(t, testBuilder.GetError(), "fenceagentsremediation 'retryCount' cannot be less than 1")
+}
+
+func TestFenceAgentsRemediationWithRemediationStrategy(t *testing.T) {
+	t.Parallel()
+
+	testBuilder := buildValidFARTestBuilder(clients.GetTestClients(clients.TestClientParams{})).
+		WithRemediationStrategy(farv1alpha1.OutOfServiceTaintRemediationStrategy)
+	assert.NoError(t, testBuilder.GetError())
+	assert.Equal(t, farv1alpha1.OutOfServiceTaintRemediationStrategy, testBuilder.Definition.Spec.RemediationStrategy)
+
+	testBuilder = testBuilder.WithRemediationStrategy("Reboot")
+	assert.EqualError(t, testBuilder.GetError(),
+		"fenceagentsremediation 'remediationStrategy' \"Reboot\" is not supported")
+}
+
+func TestFenceAgentsRemediationWaitUntilSucceeded(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		conditions    []metav1.Condition
+		expectedError error
+	}{
+		{
+			name: "remediation succeeded",
+			conditions: []metav1.Condition{
+				{Type: farv1alpha1.FenceAgentActionSucceededType, Status: metav1.ConditionTrue},
+				{Type: farv1alpha1.SucceededType, Status: metav1.ConditionTrue},
+			},
+		},
+		{
+			name: "remediation only fenced times out",
+			conditions: []metav1.Condition{
+				{Type: farv1alpha1.FenceAgentActionSucceededType, Status: metav1.ConditionTrue},
+				{Type: farv1alpha1.SucceededType, Status: metav1.ConditionFalse},
+			},
+			expectedError: context.DeadlineExceeded,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			fenceAgentsRemediation := buildDummyFAR()
+			fenceAgentsRemediation.Status.Conditions = testCase.conditions
+
+			testBuilder := buildValidFARTestBuilder(
+				buildTestClientWithMedik8sObjects([]runtime.Object{fenceAgentsRemediation}))
+
+			_, err := testBuilder.WaitUntilFenced(time.Second)
+			assert.NoError(t, err)
+
+			_, err = testBuilder.WaitUntilSucceeded(time.Second)
+			if testCase.expectedError == nil {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, testCase.expectedError)
+			}
+		})
+	}
+}
+
+func buildDummyFAR() *farv1alpha1.FenceAgentsRemediation {
+	return &farv1alpha1.FenceAgentsRemediation{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      defaultNodeName,
+			Namespace: defaultFARNamespace,
+		},
+		Spec: farv1alpha1.FenceAgentsRemediationSpec{
+			Agent: defaultFenceAgent,
+		},
+	}
+}
+
+func buildValidFARTestBuilder(apiClient *clients.Settings) *FenceAgentsRemediationBuilder {
+	return NewFenceAgentsRemediationBuilder(apiClient, defaultNodeName, defaultFARNamespace, defaultFenceAgent)
+}
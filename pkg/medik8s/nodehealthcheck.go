@@ -0,0 +1,367 @@
+package medik8s
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common"
+	nhcv1alpha1 "github.com/rh-ecosystem-edge/eco-goinfra/pkg/schemes/medik8s/nodehealthcheck/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/klog/v2"
+	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// NodeHealthCheckBuilder provides a struct for the NodeHealthCheck resource containing a connection to the cluster and
+// the NodeHealthCheck definition.
+type NodeHealthCheckBuilder struct {
+	common.EmbeddableBuilder[nhcv1alpha1.NodeHealthCheck, *nhcv1alpha1.NodeHealthCheck]
+	common.EmbeddableCreator[nhcv1alpha1.NodeHealthCheck, NodeHealthCheckBuilder,
+		*nhcv1alpha1.NodeHealthCheck, *NodeHealthCheckBuilder]
+	common.EmbeddableDeleter[nhcv1alpha1.NodeHealthCheck, *nhcv1alpha1.NodeHealthCheck]
+	common.EmbeddableUpdater[nhcv1alpha1.NodeHealthCheck, NodeHealthCheckBuilder,
+		*nhcv1alpha1.NodeHealthCheck, *NodeHealthCheckBuilder]
+	common.EmbeddableConditionGetter[metav1.Condition, nhcv1alpha1.NodeHealthCheck, *nhcv1alpha1.NodeHealthCheck]
+	common.EmbeddablePhaseGetter[nhcv1alpha1.NodeHealthCheck, *nhcv1alpha1.NodeHealthCheck]
+}
+
+// AttachMixins wires the embedded CRUD mixins to this builder instance.
+func (builder *NodeHealthCheckBuilder) AttachMixins() {
+	builder.EmbeddableCreator.SetBase(builder)
+	builder.EmbeddableDeleter.SetBase(builder)
+	builder.EmbeddableUpdater.SetBase(builder)
+	builder.EmbeddableConditionGetter.SetBase(builder)
+	builder.EmbeddablePhaseGetter.SetBase(builder)
+}
+
+// GetGVK returns the NodeHealthCheck GVK for this builder.
+func (builder *NodeHealthCheckBuilder) GetGVK() schema.GroupVersionKind {
+	return nhcv1alpha1.GroupVersion.WithKind("NodeHealthCheck")
+}
+
+// NewNodeHealthCheckBuilder creates a new instance of NodeHealthCheckBuilder. The nodeSelector determines which nodes
+// have their health observed and may not be empty.
+func NewNodeHealthCheckBuilder(
+	apiClient *clients.Settings, name string, nodeSelector map[string]string) *NodeHealthCheckBuilder {
+	klog.V(100).Infof("Initializing new NodeHealthCheck structure with the following params: name: %s, nodeSelector: %v",
+		name, nodeSelector)
+
+	builder := common.NewClusterScopedBuilder[nhcv1alpha1.NodeHealthCheck, NodeHealthCheckBuilder](
+		apiClient, nhcv1alpha1.AddToScheme, name)
+	if builder.GetError() != nil {
+		return builder
+	}
+
+	if len(nodeSelector) == 0 {
+		klog.V(100).Info("The nodeSelector of the NodeHealthCheck is empty")
+
+		builder.SetError(fmt.Errorf("nodehealthcheck 'nodeSelector' cannot be empty"))
+
+		return builder
+	}
+
+	builder.Definition.Spec.Selector = metav1.LabelSelector{MatchLabels: nodeSelector}
+
+	return builder
+}
+
+// PullNodeHealthCheck pulls an existing NodeHealthCheck from the cluster.
+func PullNodeHealthCheck(apiClient *clients.Settings, name string) (*NodeHealthCheckBuilder, error) {
+	klog.V(100).Infof("Pulling existing NodeHealthCheck %s from cluster", name)
+
+	return common.PullClusterScopedBuilder[nhcv1alpha1.NodeHealthCheck, NodeHealthCheckBuilder](
+		context.TODO(), apiClient, nhcv1alpha1.AddToScheme, name)
+}
+
+// ListNodeHealthChecks returns a list of NodeHealthCheck builders for all NodeHealthChecks on the cluster.
+func ListNodeHealthChecks(
+	apiClient *clients.Settings, options ...runtimeclient.ListOption) ([]*NodeHealthCheckBuilder, error) {
+	return common.List[nhcv1alpha1.NodeHealthCheck, nhcv1alpha1.NodeHealthCheckList, NodeHealthCheckBuilder](
+		context.TODO(), apiClient, nhcv1alpha1.AddToScheme, options...)
+}
+
+// WithUnhealthyCondition appends a node condition which marks a node unhealthy once it has had the provided status for
+// at least duration.
+func (builder *NodeHealthCheckBuilder) WithUnhealthyCondition(
+	conditionType corev1.NodeConditionType, status corev1.ConditionStatus, duration time.Duration) *NodeHealthCheckBuilder {
+	if err := common.Validate(builder); err != nil {
+		return builder
+	}
+
+	klog.V(100).Infof("Adding unhealthy condition %s=%s for %s to NodeHealthCheck %s",
+		conditionType, status, duration, builder.Definition.Name)
+
+	if conditionType == "" {
+		klog.V(100).Info("The unhealthy condition type is empty")
+
+		builder.SetError(fmt.Errorf("nodehealthcheck unhealthy condition 'type' cannot be empty"))
+
+		return builder
+	}
+
+	if status == "" {
+		klog.V(100).Info("The unhealthy condition status is empty")
+
+		builder.SetError(fmt.Errorf("nodehealthcheck unhealthy condition 'status' cannot be empty"))
+
+		return builder
+	}
+
+	builder.Definition.Spec.UnhealthyConditions = append(builder.Definition.Spec.UnhealthyConditions,
+		nhcv1alpha1.UnhealthyCondition{
+			Type:     conditionType,
+			Status:   status,
+			Duration: metav1.Duration{Duration: duration},
+		})
+
+	return builder
+}
+
+// WithMinHealthy sets the minimum number or percentage of healthy nodes required before remediation is allowed.
+func (builder *NodeHealthCheckBuilder) WithMinHealthy(minHealthy intstr.IntOrString) *NodeHealthCheckBuilder {
+	if err := common.Validate(builder); err != nil {
+		return builder
+	}
+
+	klog.V(100).Infof("Setting minHealthy of NodeHealthCheck %s to %s", builder.Definition.Name, minHealthy.String())
+
+	if builder.Definition.Spec.MaxUnhealthy != nil {
+		klog.V(100).Info("The NodeHealthCheck already has maxUnhealthy set")
+
+		builder.SetError(fmt.Errorf("nodehealthcheck 'minHealthy' and 'maxUnhealthy' are mutually exclusive"))
+
+		return builder
+	}
+
+	builder.Definition.Spec.MinHealthy = &minHealthy
+
+	return builder
+}
+
+// WithMaxUnhealthy sets the maximum number or percentage of unhealthy nodes for which remediation is allowed.
+func (builder *NodeHealthCheckBuilder) WithMaxUnhealthy(maxUnhealthy intstr.IntOrString) *NodeHealthCheckBuilder {
+	if err := common.Validate(builder); err != nil {
+		return builder
+	}
+
+	klog.V(100).Infof("Setting maxUnhealthy of NodeHealthCheck %s to %s", builder.Definition.Name, maxUnhealthy.String())
+
+	if builder.Definition.Spec.MinHealthy != nil {
+		klog.V(100).Info("The NodeHealthCheck already has minHealthy set")
+
+		builder.SetError(fmt.Errorf("nodehealthcheck 'minHealthy' and 'maxUnhealthy' are mutually exclusive"))
+
+		return builder
+	}
+
+	builder.Definition.Spec.MaxUnhealthy = &maxUnhealthy
+
+	return builder
+}
+
+// WithRemediationTemplate sets the remediation template used for unhealthy nodes. It cannot be combined with
+// escalating remediations.
+func (builder *NodeHealthCheckBuilder) WithRemediationTemplate(template corev1.ObjectReference) *NodeHealthCheckBuilder {
+	if err := common.Validate(builder); err != nil {
+		return builder
+	}
+
+	klog.V(100).Infof("Setting remediation template of NodeHealthCheck %s to %s/%s %s",
+		builder.Definition.Name, template.Namespace, template.Name, template.Kind)
+
+	if template.Name == "" || template.Kind == "" {
+		klog.V(100).Info("The remediation template is missing its name or kind")
+
+		builder.SetError(fmt.Errorf("nodehealthcheck remediation template must have a name and kind"))
+
+		return builder
+	}
+
+	if len(builder.Definition.Spec.EscalatingRemediations) > 0 {
+		klog.V(100).Info("The NodeHealthCheck already has escalating remediations")
+
+		builder.SetError(
+			fmt.Errorf("nodehealthcheck 'remediationTemplate' and 'escalatingRemediations' are mutually exclusive"))
+
+		return builder
+	}
+
+	builder.Definition.Spec.RemediationTemplate = &template
+
+	return builder
+}
+
+// WithEscalatingRemediation appends a remediation template to the escalating remediations with the provided order and
+// timeout. It cannot be combined with a single remediation template.
+func (builder *NodeHealthCheckBuilder) WithEscalatingRemediation(
+	template corev1.ObjectReference, order int, timeout time.Duration) *NodeHealthCheckBuilder {
+	if err := common.Validate(builder); err != nil {
+		return builder
+	}
+
+	klog.V(100).Infof("Adding escalating remediation %s %s with order %d and timeout %s to NodeHealthCheck %s",
+		template.Kind, template.Name, order, timeout, builder.Definition.Name)
+
+	if template.Name == "" || template.Kind == "" {
+		klog.V(100).Info("The escalating remediation template is missing its name or kind")
+
+		builder.SetError(fmt.Errorf("nodehealthcheck remediation template must have a name and kind"))
+
+		return builder
+	}
+
+	if builder.Definition.Spec.RemediationTemplate != nil {
+		klog.V(100).Info("The NodeHealthCheck already has a remediation template")
+
+		builder.SetError(
+			fmt.Errorf("nodehealthcheck 'remediationTemplate' and 'escalatingRemediations' are mutually exclusive"))
+
+		return builder
+	}
+
+	builder.Definition.Spec.EscalatingRemediations = append(builder.Definition.Spec.EscalatingRemediations,
+		nhcv1alpha1.EscalatingRemediation{
+			RemediationTemplate: template,
+			Order:               order,
+			Timeout:             metav1.Duration{Duration: timeout},
+		})
+
+	return builder
+}
+
+// WithPauseRequest appends a pause request to the NodeHealthCheck, preventing new remediations from starting.
+func (builder *NodeHealthCheckBuilder) WithPauseRequest(reason string) *NodeHealthCheckBuilder {
+	if err := common.Validate(builder); err != nil {
+		return builder
+	}
+
+	klog.V(100).Infof("Adding pause request %s to NodeHealthCheck %s", reason, builder.Definition.Name)
+
+	if reason == "" {
+		klog.V(100).Info("The pause request is empty")
+
+		builder.SetError(fmt.Errorf("nodehealthcheck pause request cannot be empty"))
+
+		return builder
+	}
+
+	builder.Definition.Spec.PauseRequests = append(builder.Definition.Spec.PauseRequests, reason)
+
+	return builder
+}
+
+// GetUnhealthyNodes returns the unhealthy nodes and their remediations as currently reported by the NodeHealthCheck
+// status.
+func (builder *NodeHealthCheckBuilder) GetUnhealthyNodes() ([]*nhcv1alpha1.UnhealthyNode, error) {
+	if err := common.Validate(builder); err != nil {
+		return nil, err
+	}
+
+	klog.V(100).Infof("Getting unhealthy nodes of NodeHealthCheck %s", builder.Definition.Name)
+
+	object, err := builder.Get()
+	if err != nil {
+		return nil, err
+	}
+
+	builder.Object = object
+
+	return object.Status.UnhealthyNodes, nil
+}
+
+// WaitForPhase waits up to timeout for the NodeHealthCheck to reach the provided phase.
+func (builder *NodeHealthCheckBuilder) WaitForPhase(
+	phase nhcv1alpha1.NHCPhase, timeout time.Duration) (*NodeHealthCheckBuilder, error) {
+	if err := common.Validate(builder); err != nil {
+		return builder, err
+	}
+
+	klog.V(100).Infof("Waiting up to %s for NodeHealthCheck %s to reach phase %s",
+		timeout, builder.Definition.Name, phase)
+
+	err := wait.PollUntilContextTimeout(
+		context.TODO(), time.Second, timeout, true, func(ctx context.Context) (bool, error) {
+			object, err := builder.Get()
+			if err != nil {
+				klog.V(100).Infof("Failed to get NodeHealthCheck %s: %v", builder.Definition.Name, err)
+
+				return false, nil
+			}
+
+			builder.Object = object
+
+			return object.Status.Phase == phase, nil
+		})
+
+	return builder, err
+}
+
+// WaitForNodeRemediationStarted waits up to timeout for the NodeHealthCheck to report that at least one remediation
+// has been created for nodeName.
+func (builder *NodeHealthCheckBuilder) WaitForNodeRemediationStarted(
+	nodeName string, timeout time.Duration) (*NodeHealthCheckBuilder, error) {
+	return builder.waitForNodeRemediation(nodeName, timeout, func(node *nhcv1alpha1.UnhealthyNode) bool {
+		return node != nil && len(node.Remediations) > 0
+	})
+}
+
+// WaitForNodeRemediationCompleted waits up to timeout for nodeName to no longer be reported as unhealthy by the
+// NodeHealthCheck, meaning the node has recovered and its remediations have been cleaned up.
+func (builder *NodeHealthCheckBuilder) WaitForNodeRemediationCompleted(
+	nodeName string, timeout time.Duration) (*NodeHealthCheckBuilder, error) {
+	return builder.waitForNodeRemediation(nodeName, timeout, func(node *nhcv1alpha1.UnhealthyNode) bool {
+		return node == nil
+	})
+}
+
+// waitForNodeRemediation waits until condition returns true for the unhealthy node entry matching nodeName. If no such
+// entry exists, condition is called with nil.
+func (builder *NodeHealthCheckBuilder) waitForNodeRemediation(
+	nodeName string,
+	timeout time.Duration,
+	condition func(node *nhcv1alpha1.UnhealthyNode) bool) (*NodeHealthCheckBuilder, error) {
+	if err := common.Validate(builder); err != nil {
+		return builder, err
+	}
+
+	if nodeName == "" {
+		klog.V(100).Info("The nodeName to wait for is empty")
+
+		return builder, errNodeNameEmpty
+	}
+
+	klog.V(100).Infof("Waiting up to %s for remediation of node %s by NodeHealthCheck %s",
+		timeout, nodeName, builder.Definition.Name)
+
+	err := wait.PollUntilContextTimeout(
+		context.TODO(), time.Second, timeout, true, func(ctx context.Context) (bool, error) {
+			unhealthyNodes, err := builder.GetUnhealthyNodes()
+			if err != nil {
+				klog.V(100).Infof("Failed to get unhealthy nodes of NodeHealthCheck %s: %v", builder.Definition.Name, err)
+
+				return false, nil
+			}
+
+			for _, unhealthyNode := range unhealthyNodes {
+				if unhealthyNode != nil && unhealthyNode.Name == nodeName {
+					return condition(unhealthyNode), nil
+				}
+			}
+
+			return condition(nil), nil
+		})
+
+	return builder, err
+}
+
+// GetNodeHealthCheckGVR returns NodeHealthCheck's GroupVersionResource which could be used for Clean function.
+func GetNodeHealthCheckGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group: "remediation.medik8s.io", Version: "v1alpha1", Resource: "nodehealthchecks",
+	}
+}
@@ -0,0 +1,305 @@
+package medik8s
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common/testhelper"
+	nhcv1alpha1 "github.com/rh-ecosystem-edge/eco-goinfra/pkg/schemes/medik8s/nodehealthcheck/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+const defaultNodeHealthCheckName = "nhc-test"
+
+var (
+	nodeHealthCheckGVK         = nhcv1alpha1.GroupVersion.WithKind("NodeHealthCheck")
+	defaultNodeHealthSelector  = map[string]string{"node-role.kubernetes.io/worker": ""}
+	defaultRemediationTemplate = corev1.ObjectReference{
+		Kind:      "SelfNodeRemediationTemplate",
+		Name:      "self-node-remediation-automatic-strategy-template",
+		Namespace: "openshift-workload-availability",
+	}
+)
+
+func TestNewNodeHealthCheckBuilder(t *testing.T) {
+	t.Parallel()
+
+	t.Run("common cluster-scoped builder behavior", func(t *testing.T) {
+		t.Parallel()
+
+		testhelper.NewClusterScopedBuilderTestConfig(
+			func(apiClient *clients.Settings, name string) *NodeHealthCheckBuilder {
+				return NewNodeHealthCheckBuilder(apiClient, name, defaultNodeHealthSelector)
+			},
+			nhcv1alpha1.AddToScheme,
+			nodeHealthCheckGVK,
+		).ExecuteTests(t)
+	})
+
+	t.Run("empty nodeSelector returns error", func(t *testing.T) {
+		t.Parallel()
+
+		testBuilder := NewNodeHealthCheckBuilder(
+			clients.GetTestClients(clients.TestClientParams{}), defaultNodeHealthCheckName, nil)
+
+		assert.EqualError(t, testBuilder.GetError(), "nodehealthcheck 'nodeSelector' cannot be empty")
+	})
+
+	t.Run("valid nodeSelector sets selector", func(t *testing.T) {
+		t.Parallel()
+
+		testBuilder := buildValidNodeHealthCheckTestBuilder(clients.GetTestClients(clients.TestClientParams{}))
+
+		require.NoError(t, testBuilder.GetError())
+		assert.Equal(t, defaultNodeHealthSelector, testBuilder.Definition.Spec.Selector.MatchLabels)
+	})
+}
+
+func TestPullNodeHealthCheck(t *testing.T) {
+	t.Parallel()
+
+	testhelper.NewClusterScopedPullTestConfig(
+		PullNodeHealthCheck, nhcv1alpha1.AddToScheme, nodeHealthCheckGVK).ExecuteTests(t)
+}
+
+func TestListNodeHealthChecks(t *testing.T) {
+	t.Parallel()
+
+	testhelper.NewListTestConfig(ListNodeHealthChecks, nhcv1alpha1.AddToScheme, nodeHealthCheckGVK).ExecuteTests(t)
+}
+
+func TestNodeHealthCheckMethods(t *testing.T) {
+	t.Parallel()
+
+	commonTestConfig := testhelper.NewCommonTestConfig[nhcv1alpha1.NodeHealthCheck, NodeHealthCheckBuilder](
+		nhcv1alpha1.AddToScheme,
+		nodeHealthCheckGVK,
+		testhelper.ResourceScopeClusterScoped,
+	)
+
+	testhelper.NewTestSuite().
+		With(testhelper.NewGetTestConfig(commonTestConfig)).
+		With(testhelper.NewExistsTestConfig(commonTestConfig)).
+		With(testhelper.NewCreateTestConfig(commonTestConfig)).
+		With(testhelper.NewDeleterTestConfig(commonTestConfig)).
+		With(testhelper.NewUpdateTestConfig(commonTestConfig)).
+		Run(t)
+}
+
+func TestNodeHealthCheckWithUnhealthyCondition(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		conditionType corev1.NodeConditionType
+		status        corev1.ConditionStatus
+		expectedError string
+	}{
+		{
+			name:          "valid condition",
+			conditionType: corev1.NodeReady,
+			status:        corev1.ConditionFalse,
+		},
+		{
+			name:          "empty condition type",
+			status:        corev1.ConditionFalse,
+			expectedError: "nodehealthcheck unhealthy condition 'type' cannot be empty",
+		},
+		{
+			name:          "empty condition status",
+			conditionType: corev1.NodeReady,
+			expectedError: "nodehealthcheck unhealthy condition 'status' cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			testBuilder := buildValidNodeHealthCheckTestBuilder(clients.GetTestClients(clients.TestClientParams{})).
+				WithUnhealthyCondition(testCase.conditionType, testCase.status, time.Minute)
+
+			if testCase.expectedError != "" {
+				assert.EqualError(t, testBuilder.GetError(), testCase.expectedError)
+
+				return
+			}
+
+			assert.NoError(t, testBuilder.GetError())
+			assert.Equal(t, []nhcv1alpha1.UnhealthyCondition{{
+				Type:     testCase.conditionType,
+				Status:   testCase.status,
+				Duration: metav1.Duration{Duration: time.Minute},
+			}}, testBuilder.Definition.Spec.UnhealthyConditions)
+		})
+	}
+}
+
+func TestNodeHealthCheckWithMinAndMaxHealthy(t *testing.T) {
+	t.Parallel()
+
+	testBuilder := buildValidNodeHealthCheckTestBuilder(clients.GetTestClients(clients.TestClientParams{})).
+		WithMinHealthy(intstr.FromString("51%"))
+	assert.NoError(t, testBuilder.GetError())
+	assert.Equal(t, "51%", testBuilder.Definition.Spec.MinHealthy.String())
+
+	testBuilder = testBuilder.WithMaxUnhealthy(intstr.FromInt32(1))
+	assert.EqualError(t, testBuilder.GetError(), "nodehealthcheck 'minHealthy' and 'maxUnhealthy' are mutually exclusive")
+
+	testBuilder = buildValidNodeHealthCheckTestBuilder(clients.GetTestClients(clients.TestClientParams{})).
+		WithMaxUnhealthy(intstr.FromInt32(1))
+	assert.NoError(t, testBuilder.GetError())
+	assert.Equal(t, int32(1), testBuilder.Definition.Spec.MaxUnhealthy.IntVal)
+
+	testBuilder = testBuilder.WithMinHealthy(intstr.FromString("51%"))
+	assert.EqualError(t, testBuilder.GetError(), "nodehealthcheck 'minHealthy' and 'maxUnhealthy' are mutually exclusive")
+}
+
+func TestNodeHealthCheckWithRemediations(t *testing.T) {
+	t.Parallel()
+
+	testBuilder := buildValidNodeHealthCheckTestBuilder(clients.GetTestClients(clients.TestClientParams{})).
+		WithRemediationTemplate(defaultRemediationTemplate)
+	assert.NoError(t, testBuilder.GetError())
+	assert.Equal(t, &defaultRemediationTemplate, testBuilder.Definition.Spec.RemediationTemplate)
+
+	testBuilder = testBuilder.WithEscalatingRemediation(defaultRemediationTemplate, 1, time.Minute)
+	assert.EqualError(t, testBuilder.GetError(),
+		"nodehealthcheck 'remediationTemplate' and 'escalatingRemediations' are mutually exclusive")
+
+	testBuilder = buildValidNodeHealthCheckTestBuilder(clients.GetTestClients(clients.TestClientParams{})).
+		WithEscalatingRemediation(defaultRemediationTemplate, 1, time.Minute)
+	assert.NoError(t, testBuilder.GetError())
+	assert.Len(t, testBuilder.Definition.Spec.EscalatingRemediations, 1)
+
+	testBuilder = testBuilder.WithRemediationTemplate(defaultRemediationTemplate)
+	assert.EqualError(t, testBuilder.GetError(),
+		"nodehealthcheck 'remediationTemplate' and 'escalatingRemediations' are mutually exclusive")
+
+	testBuilder = buildValidNodeHealthCheckTestBuilder(clients.GetTestClients(clients.TestClientParams{})).
+		WithRemediationTemplate(corev1.ObjectReference{})
+	assert.EqualError(t, testBuilder.GetError(), "nodehealthcheck remediation template must have a name and kind")
+}
+
+func TestNodeHealthCheckWithPauseRequest(t *testing.T) {
+	t.Parallel()
+
+	testBuilder := buildValidNodeHealthCheckTestBuilder(clients.GetTestClients(clients.TestClientParams{})).
+		WithPauseRequest("cluster-upgrade")
+	assert.NoError(t, testBuilder.GetError())
+	assert.Equal(t, []string{"cluster-upgrade"}, testBuilder.Definition.Spec.PauseRequests)
+
+	testBuilder = testBuilder.WithPauseRequest("")
+	assert.EqualError(t, testBuilder.GetError(), "nodehealthcheck pause request cannot be empty")
+}
+
+func TestNodeHealthCheckWaitForPhase(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		phase         nhcv1alpha1.NHCPhase
+		expectedError error
+	}{
+		{
+			name:  "phase reached",
+			phase: nhcv1alpha1.PhaseEnabled,
+		},
+		{
+			name:          "phase not reached times out",
+			phase:         nhcv1alpha1.PhasePaused,
+			expectedError: context.DeadlineExceeded,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			nodeHealthCheck := buildDummyNodeHealthCheck()
+			nodeHealthCheck.Status.Phase = testCase.phase
+
+			testBuilder := buildValidNodeHealthCheckTestBuilder(
+				buildTestClientWithMedik8sObjects([]runtime.Object{nodeHealthCheck}))
+
+			_, err := testBuilder.WaitForPhase(nhcv1alpha1.PhaseEnabled, time.Second)
+			if testCase.expectedError == nil {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, testCase.expectedError)
+			}
+		})
+	}
+}
+
+func TestNodeHealthCheckStatusGetters(t *testing.T) {
+	t.Parallel()
+
+	nodeHealthCheck := buildDummyNodeHealthCheck()
+	nodeHealthCheck.Status.Phase = nhcv1alpha1.PhaseEnabled
+	nodeHealthCheck.Status.Conditions = []metav1.Condition{{Type: "Disabled", Status: metav1.ConditionFalse}}
+
+	testBuilder := buildValidNodeHealthCheckTestBuilder(
+		buildTestClientWithMedik8sObjects([]runtime.Object{nodeHealthCheck}))
+
+	condition, err := testBuilder.GetCondition("Disabled")
+	assert.NoError(t, err)
+	assert.Equal(t, metav1.ConditionFalse, condition.Status)
+
+	phase, err := testBuilder.GetPhase()
+	assert.NoError(t, err)
+	assert.Equal(t, string(nhcv1alpha1.PhaseEnabled), phase)
+}
+
+func TestNodeHealthCheckWaitForNodeRemediation(t *testing.T) {
+	t.Parallel()
+
+	remediating := buildDummyNodeHealthCheck()
+	remediating.Status.UnhealthyNodes = []*nhcv1alpha1.UnhealthyNode{{
+		Name:         defaultNodeName,
+		Remediations: []*nhcv1alpha1.Remediation{{Resource: corev1.ObjectReference{Name: defaultNodeName}}},
+	}}
+
+	testBuilder := buildValidNodeHealthCheckTestBuilder(
+		buildTestClientWithMedik8sObjects([]runtime.Object{remediating}))
+
+	_, err := testBuilder.WaitForNodeRemediationStarted(defaultNodeName, time.Second)
+	assert.NoError(t, err)
+
+	_, err = testBuilder.WaitForNodeRemediationCompleted(defaultNodeName, time.Second)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+	_, err = testBuilder.WaitForNodeRemediationCompleted("", time.Second)
+	assert.Equal(t, errNodeNameEmpty, err)
+
+	testBuilder = buildValidNodeHealthCheckTestBuilder(
+		buildTestClientWithMedik8sObjects([]runtime.Object{buildDummyNodeHealthCheck()}))
+
+	_, err = testBuilder.WaitForNodeRemediationCompleted(defaultNodeName, time.Second)
+	assert.NoError(t, err)
+
+	_, err = testBuilder.WaitForNodeRemediationStarted(defaultNodeName, time.Second)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func buildDummyNodeHealthCheck() *nhcv1alpha1.NodeHealthCheck {
+	return &nhcv1alpha1.NodeHealthCheck{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: defaultNodeHealthCheckName,
+		},
+		Spec: nhcv1alpha1.NodeHealthCheckSpec{
+			Selector: metav1.LabelSelector{MatchLabels: defaultNodeHealthSelector},
+		},
+	}
+}
+
+func buildValidNodeHealthCheckTestBuilder(apiClient *clients.Settings) *NodeHealthCheckBuilder {
+	return NewNodeHealthCheckBuilder(apiClient, defaultNodeHealthCheckName, defaultNodeHealthSelector)
+}
@@ -0,0 +1,213 @@
+package medik8s
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common"
+	nmv1beta1 "github.com/rh-ecosystem-edge/eco-goinfra/pkg/schemes/medik8s/nodemaintenance/v1beta1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/klog/v2"
+	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var errNodeNameEmpty = errors.New("nodemaintenance 'nodeName' cannot be empty")
+
+// NodeMaintenanceBuilder provides a struct for the NodeMaintenance resource containing a connection to the cluster and
+// the NodeMaintenance definition.
+type NodeMaintenanceBuilder struct {
+	common.EmbeddableBuilder[nmv1beta1.NodeMaintenance, *nmv1beta1.NodeMaintenance]
+	common.EmbeddableCreator[nmv1beta1.NodeMaintenance, NodeMaintenanceBuilder,
+		*nmv1beta1.NodeMaintenance, *NodeMaintenanceBuilder]
+	common.EmbeddableDeleter[nmv1beta1.NodeMaintenance, *nmv1beta1.NodeMaintenance]
+	common.EmbeddableUpdater[nmv1beta1.NodeMaintenance, NodeMaintenanceBuilder,
+		*nmv1beta1.NodeMaintenance, *NodeMaintenanceBuilder]
+	common.EmbeddablePhaseGetter[nmv1beta1.NodeMaintenance, *nmv1beta1.NodeMaintenance]
+}
+
+// AttachMixins wires the embedded CRUD mixins to this builder instance.
+func (builder *NodeMaintenanceBuilder) AttachMixins() {
+	builder.EmbeddableCreator.SetBase(builder)
+	builder.EmbeddableDeleter.SetBase(builder)
+	builder.EmbeddableUpdater.SetBase(builder)
+	builder.EmbeddablePhaseGetter.SetBase(builder)
+}
+
+// GetGVK returns the NodeMaintenance GVK for this builder.
+func (builder *NodeMaintenanceBuilder) GetGVK() schema.GroupVersionKind {
+	return nmv1beta1.GroupVersion.WithKind("NodeMaintenance")
+}
+
+// GetImmutableFields returns the node name, which cannot be changed once the NodeMaintenance is created.
+func (builder *NodeMaintenanceBuilder) GetImmutableFields(latest *nmv1beta1.NodeMaintenance) []string {
+	return []string{"spec.nodeName"}
+}
+
+// NewNodeMaintenanceBuilder creates a new instance of NodeMaintenanceBuilder which puts nodeName into maintenance once
+// created.
+func NewNodeMaintenanceBuilder(apiClient *clients.Settings, name, nodeName string) *NodeMaintenanceBuilder {
+	klog.V(100).Infof("Initializing new NodeMaintenance structure with the following params: name: %s, nodeName: %s",
+		name, nodeName)
+
+	builder := common.NewClusterScopedBuilder[nmv1beta1.NodeMaintenance, NodeMaintenanceBuilder](
+		apiClient, nmv1beta1.AddToScheme, name)
+	if builder.GetError() != nil {
+		return builder
+	}
+
+	if nodeName == "" {
+		klog.V(100).Info("The nodeName of the NodeMaintenance is empty")
+
+		builder.SetError(errNodeNameEmpty)
+
+		return builder
+	}
+
+	builder.Definition.Spec.NodeName = nodeName
+
+	return builder
+}
+
+// PullNodeMaintenance pulls an existing NodeMaintenance from the cluster.
+func PullNodeMaintenance(apiClient *clients.Settings, name string) (*NodeMaintenanceBuilder, error) {
+	klog.V(100).Infof("Pulling existing NodeMaintenance %s from cluster", name)
+
+	return common.PullClusterScopedBuilder[nmv1beta1.NodeMaintenance, NodeMaintenanceBuilder](
+		context.TODO(), apiClient, nmv1beta1.AddToScheme, name)
+}
+
+// ListNodeMaintenances returns a list of NodeMaintenance builders for all NodeMaintenances on the cluster.
+func ListNodeMaintenances(
+	apiClient *clients.Settings, options ...runtimeclient.ListOption) ([]*NodeMaintenanceBuilder, error) {
+	return common.List[nmv1beta1.NodeMaintenance, nmv1beta1.NodeMaintenanceList, NodeMaintenanceBuilder](
+		context.TODO(), apiClient, nmv1beta1.AddToScheme, options...)
+}
+
+// WithReason sets the reason for the maintenance in the NodeMaintenance definition.
+func (builder *NodeMaintenanceBuilder) WithReason(reason string) *NodeMaintenanceBuilder {
+	if err := common.Validate(builder); err != nil {
+		return builder
+	}
+
+	klog.V(100).Infof("Setting reason of NodeMaintenance %s to %s", builder.Definition.Name, reason)
+
+	if reason == "" {
+		klog.V(100).Info("The reason of the NodeMaintenance is empty")
+
+		builder.SetError(fmt.Errorf("nodemaintenance 'reason' cannot be empty"))
+
+		return builder
+	}
+
+	builder.Definition.Spec.Reason = reason
+
+	return builder
+}
+
+// WaitForMaintenanceActive waits up to timeout for the NodeMaintenance to reach the Succeeded phase, meaning the node
+// has been cordoned and all evictable pods have been drained.
+func (builder *NodeMaintenanceBuilder) WaitForMaintenanceActive(timeout time.Duration) (*NodeMaintenanceBuilder, error) {
+	return builder.WaitForPhase(nmv1beta1.MaintenanceSucceeded, timeout)
+}
+
+// WaitForPhase waits up to timeout for the NodeMaintenance to reach the provided phase. If the maintenance reaches the
+// Failed phase, the last error reported by the operator is logged but waiting continues since the operator retries
+// failed drains.
+func (builder *NodeMaintenanceBuilder) WaitForPhase(
+	phase nmv1beta1.MaintenancePhase, timeout time.Duration) (*NodeMaintenanceBuilder, error) {
+	if err := common.Validate(builder); err != nil {
+		return builder, err
+	}
+
+	klog.V(100).Infof("Waiting up to %s for NodeMaintenance %s to reach phase %s",
+		timeout, builder.Definition.Name, phase)
+
+	if !builder.Exists() {
+		klog.V(100).Infof("The NodeMaintenance %s does not exist", builder.Definition.Name)
+
+		return builder, fmt.Errorf("nodemaintenance object %s does not exist", builder.Definition.Name)
+	}
+
+	err := wait.PollUntilContextTimeout(
+		context.TODO(), time.Second, timeout, true, func(ctx context.Context) (bool, error) {
+			object, err := builder.Get()
+			if err != nil {
+				klog.V(100).Infof("Failed to get NodeMaintenance %s: %v", builder.Definition.Name, err)
+
+				return false, nil
+			}
+
+			builder.Object = object
+
+			if object.Status.Phase == nmv1beta1.MaintenanceFailed && phase != nmv1beta1.MaintenanceFailed {
+				klog.V(100).Infof("NodeMaintenance %s is in phase Failed: %s", builder.Definition.Name,
+					object.Status.LastError)
+			}
+
+			return object.Status.Phase == phase, nil
+		})
+
+	return builder, err
+}
+
+// GetDrainProgress returns the percentage of the drain that has completed along with the number of pods still pending
+// eviction, as last reported by the operator.
+func (builder *NodeMaintenanceBuilder) GetDrainProgress() (int, int, error) {
+	if err := common.Validate(builder); err != nil {
+		return 0, 0, err
+	}
+
+	klog.V(100).Infof("Getting drain progress of NodeMaintenance %s", builder.Definition.Name)
+
+	object, err := builder.Get()
+	if err != nil {
+		return 0, 0, err
+	}
+
+	builder.Object = object
+
+	return object.Status.DrainProgress, len(object.Status.PendingPods), nil
+}
+
+// DeleteAndWait deletes the NodeMaintenance, ending the maintenance, and waits up to timeout for it to be removed.
+func (builder *NodeMaintenanceBuilder) DeleteAndWait(timeout time.Duration) error {
+	if err := common.Validate(builder); err != nil {
+		return err
+	}
+
+	klog.V(100).Infof("Deleting NodeMaintenance %s and waiting up to %s for it to be removed",
+		builder.Definition.Name, timeout)
+
+	err := builder.Delete()
+	if err != nil {
+		return err
+	}
+
+	return wait.PollUntilContextTimeout(
+		context.TODO(), time.Second, timeout, true, func(ctx context.Context) (bool, error) {
+			_, err := builder.Get()
+			if err == nil {
+				return false, nil
+			}
+
+			if k8serrors.IsNotFound(err) {
+				return true, nil
+			}
+
+			klog.V(100).Infof("Failed to get NodeMaintenance %s: %v", builder.Definition.Name, err)
+
+			return false, nil
+		})
+}
+
+// GetNodeMaintenanceGVR returns NodeMaintenance's GroupVersionResource which could be used for Clean function.
+func GetNodeMaintenanceGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group: "nodemaintenance.medik8s.io", Version: "v1beta1", Resource: "nodemaintenances",
+	}
+}
@@ -0,0 +1,239 @@
+package medik8s
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common/testhelper"
+	farv1alpha1 "github.com/rh-ecosystem-edge/eco-goinfra/pkg/schemes/medik8s/fenceagentsremediation/v1alpha1"
+	nhcv1alpha1 "github.com/rh-ecosystem-edge/eco-goinfra/pkg/schemes/medik8s/nodehealthcheck/v1alpha1"
+	nmv1beta1 "github.com/rh-ecosystem-edge/eco-goinfra/pkg/schemes/medik8s/nodemaintenance/v1beta1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	defaultNodeMaintenanceName = "nm-test"
+	defaultNodeName            = "worker-0"
+)
+
+var nodeMaintenanceGVK = nmv1beta1.GroupVersion.WithKind("NodeMaintenance")
+
+func TestNewNodeMaintenanceBuilder(t *testing.T) {
+	t.Parallel()
+
+	t.Run("common cluster-scoped builder behavior", func(t *testing.T) {
+		t.Parallel()
+
+		testhelper.NewClusterScopedBuilderTestConfig(
+			func(apiClient *clients.Settings, name string) *NodeMaintenanceBuilder {
+				return NewNodeMaintenanceBuilder(apiClient, name, defaultNodeName)
+			},
+			nmv1beta1.AddToScheme,
+			nodeMaintenanceGVK,
+		).ExecuteTests(t)
+	})
+
+	t.Run("empty nodeName returns error", func(t *testing.T) {
+		t.Parallel()
+
+		testBuilder := NewNodeMaintenanceBuilder(
+			clients.GetTestClients(clients.TestClientParams{}), defaultNodeMaintenanceName, "")
+
+		assert.Equal(t, errNodeNameEmpty, testBuilder.GetError())
+	})
+
+	t.Run("valid nodeName sets spec", func(t *testing.T) {
+		t.Parallel()
+
+		testBuilder := NewNodeMaintenanceBuilder(
+			clients.GetTestClients(clients.TestClientParams{}), defaultNodeMaintenanceName, defaultNodeName)
+
+		require.NoError(t, testBuilder.GetError())
+		assert.Equal(t, defaultNodeName, testBuilder.Definition.Spec.NodeName)
+	})
+}
+
+func TestPullNodeMaintenance(t *testing.T) {
+	t.Parallel()
+
+	testhelper.NewClusterScopedPullTestConfig(
+		PullNodeMaintenance, nmv1beta1.AddToScheme, nodeMaintenanceGVK).ExecuteTests(t)
+}
+
+func TestListNodeMaintenances(t *testing.T) {
+	t.Parallel()
+
+	testhelper.NewListTestConfig(ListNodeMaintenances, nmv1beta1.AddToScheme, nodeMaintenanceGVK).ExecuteTests(t)
+}
+
+func TestNodeMaintenanceMethods(t *testing.T) {
+	t.Parallel()
+
+	commonTestConfig := testhelper.NewCommonTestConfig[nmv1beta1.NodeMaintenance, NodeMaintenanceBuilder](
+		nmv1beta1.AddToScheme,
+		nodeMaintenanceGVK,
+		testhelper.ResourceScopeClusterScoped,
+	)
+
+	testhelper.NewTestSuite().
+		With(testhelper.NewGetTestConfig(commonTestConfig)).
+		With(testhelper.NewExistsTestConfig(commonTestConfig)).
+		With(testhelper.NewCreateTestConfig(commonTestConfig)).
+		With(testhelper.NewDeleterTestConfig(commonTestConfig)).
+		With(testhelper.NewUpdateTestConfig(commonTestConfig)).
+		Run(t)
+}
+
+func TestNodeMaintenanceWithReason(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		reason        string
+		expectedError string
+	}{
+		{
+			name:   "valid reason",
+			reason: "kernel upgrade",
+		},
+		{
+			name:          "empty reason",
+			reason:        "",
+			expectedError: "nodemaintenance 'reason' cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			testBuilder := buildValidNodeMaintenanceTestBuilder(clients.GetTestClients(clients.TestClientParams{}))
+			testBuilder = testBuilder.WithReason(testCase.reason)
+
+			if testCase.expectedError == "" {
+				assert.NoError(t, testBuilder.GetError())
+				assert.Equal(t, testCase.reason, testBuilder.Definition.Spec.Reason)
+			} else {
+				assert.EqualError(t, testBuilder.GetError(), testCase.expectedError)
+			}
+		})
+	}
+}
+
+func TestNodeMaintenanceWaitForMaintenanceActive(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		exists        bool
+		phase         nmv1beta1.MaintenancePhase
+		expectedError error
+	}{
+		{
+			name:   "maintenance succeeded",
+			exists: true,
+			phase:  nmv1beta1.MaintenanceSucceeded,
+		},
+		{
+			name:          "maintenance running times out",
+			exists:        true,
+			phase:         nmv1beta1.MaintenanceRunning,
+			expectedError: context.DeadlineExceeded,
+		},
+		{
+			name:   "maintenance does not exist",
+			exists: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			var runtimeObjects []runtime.Object
+
+			if testCase.exists {
+				nodeMaintenance := buildDummyNodeMaintenance()
+				nodeMaintenance.Status.Phase = testCase.phase
+
+				runtimeObjects = append(runtimeObjects, nodeMaintenance)
+			}
+
+			testBuilder := buildValidNodeMaintenanceTestBuilder(buildTestClientWithMedik8sObjects(runtimeObjects))
+			_, err := testBuilder.WaitForMaintenanceActive(time.Second)
+
+			switch {
+			case !testCase.exists:
+				assert.EqualError(t, err,
+					"nodemaintenance object "+defaultNodeMaintenanceName+" does not exist")
+			case testCase.expectedError == nil:
+				assert.NoError(t, err)
+			default:
+				assert.ErrorIs(t, err, testCase.expectedError)
+			}
+		})
+	}
+}
+
+func TestNodeMaintenanceGetDrainProgress(t *testing.T) {
+	t.Parallel()
+
+	nodeMaintenance := buildDummyNodeMaintenance()
+	nodeMaintenance.Status.DrainProgress = 50
+	nodeMaintenance.Status.PendingPods = []string{"pod-a", "pod-b"}
+
+	testBuilder := buildValidNodeMaintenanceTestBuilder(
+		buildTestClientWithMedik8sObjects([]runtime.Object{nodeMaintenance}))
+
+	progress, pending, err := testBuilder.GetDrainProgress()
+	assert.NoError(t, err)
+	assert.Equal(t, 50, progress)
+	assert.Equal(t, 2, pending)
+
+	testBuilder = buildValidNodeMaintenanceTestBuilder(buildTestClientWithMedik8sObjects(nil))
+
+	_, _, err = testBuilder.GetDrainProgress()
+	assert.Error(t, err)
+}
+
+func TestNodeMaintenanceDeleteAndWait(t *testing.T) {
+	t.Parallel()
+
+	testBuilder := buildValidNodeMaintenanceTestBuilder(
+		buildTestClientWithMedik8sObjects([]runtime.Object{buildDummyNodeMaintenance()}))
+
+	err := testBuilder.DeleteAndWait(time.Second)
+	assert.NoError(t, err)
+	assert.False(t, testBuilder.Exists())
+}
+
+func buildTestClientWithMedik8sObjects(objects []runtime.Object) *clients.Settings {
+	return clients.GetTestClients(clients.TestClientParams{
+		K8sMockObjects: objects,
+		SchemeAttachers: []clients.SchemeAttacher{
+			nmv1beta1.AddToScheme,
+			nhcv1alpha1.AddToScheme,
+			farv1alpha1.AddToScheme,
+		},
+	})
+}
+
+func buildDummyNodeMaintenance() *nmv1beta1.NodeMaintenance {
+	return &nmv1beta1.NodeMaintenance{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: defaultNodeMaintenanceName,
+		},
+		Spec: nmv1beta1.NodeMaintenanceSpec{
+			NodeName: defaultNodeName,
+		},
+	}
+}
+
+func buildValidNodeMaintenanceTestBuilder(apiClient *clients.Settings) *NodeMaintenanceBuilder {
+	return NewNodeMaintenanceBuilder(apiClient, defaultNodeMaintenanceName, defaultNodeName)
+}
@@ -2,11 +2,14 @@ package metallb
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/logging"
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/msg"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/network"
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/schemes/metallb/mlbtypes"
+	corev1 "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -33,6 +36,8 @@ type IPAddressPoolAdditionalOptions func(builder *IPAddressPoolBuilder) (*IPAddr
 // NewIPAddressPoolBuilder creates a new instance of IPAddressPoolBuilder.
 func NewIPAddressPoolBuilder(
 	apiClient *clients.Settings, name, nsname string, addrPool []string) *IPAddressPoolBuilder {
+	nsname = apiClient.ResolveNamespace(nsname)
+
 	klog.V(100).Infof(
 		"Initializing new IPAddressPool structure with the following params: %s, %s %s",
 		name, nsname, addrPool)
@@ -128,6 +133,8 @@ func (builder *IPAddressPoolBuilder) Exists() bool {
 
 // PullAddressPool pulls existing addresspool from cluster.
 func PullAddressPool(apiClient *clients.Settings, name, nsname string) (*IPAddressPoolBuilder, error) {
+	nsname = apiClient.ResolveNamespace(nsname)
+
 	klog.V(100).Infof("Pulling existing addresspool name %s under namespace %s from cluster", name, nsname)
 
 	if apiClient == nil {
@@ -322,6 +329,56 @@ func (builder *IPAddressPoolBuilder) WithOptions(options ...IPAddressPoolAdditio
 	return builder
 }
 
+// GetIPFamilies returns the IP families of the addresses in the IPAddressPool definition in the order they first
+// appear. Addresses may be CIDRs or ranges of the form start-end, and both ends of a range must be of the same family.
+func (builder *IPAddressPoolBuilder) GetIPFamilies() ([]corev1.IPFamily, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	klog.V(100).Infof("Getting IP families of IPAddressPool %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	var addresses []string
+
+	for _, address := range builder.Definition.Spec.Addresses {
+		start, end, isRange := strings.Cut(address, "-")
+		if !isRange {
+			addresses = append(addresses, strings.TrimSpace(address))
+
+			continue
+		}
+
+		rangeFamilies, err := network.GetIPFamilies([]string{strings.TrimSpace(start), strings.TrimSpace(end)})
+		if err != nil {
+			return nil, fmt.Errorf("invalid IPAddressPool range %s: %w", address, err)
+		}
+
+		if len(rangeFamilies) != 1 {
+			return nil, fmt.Errorf("invalid IPAddressPool range %s: start and end must be of the same IP family", address)
+		}
+
+		addresses = append(addresses, strings.TrimSpace(start))
+	}
+
+	families, err := network.GetIPFamilies(addresses)
+	if err != nil {
+		return nil, fmt.Errorf("invalid IPAddressPool address: %w", err)
+	}
+
+	return families, nil
+}
+
+// IsDualStack returns whether the IPAddressPool definition contains both IPv4 and IPv6 addresses.
+func (builder *IPAddressPoolBuilder) IsDualStack() (bool, error) {
+	families, err := builder.GetIPFamilies()
+	if err != nil {
+		return false, err
+	}
+
+	return len(families) == 2, nil
+}
+
 // GetIPAddressPoolGVR returns ipaddresspool's GroupVersionResource, which could be used for Clean function.
 func GetIPAddressPoolGVR() schema.GroupVersionResource {
 	return schema.GroupVersionResource{
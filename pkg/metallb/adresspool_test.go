@@ -7,6 +7,7 @@ import (
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/schemes/metallb/mlbtypes"
 	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -339,6 +340,58 @@ func TestGetIPAddressPoolGVR(t *testing.T) {
 		})
 }
 
+func TestIPAddressPoolGetIPFamilies(t *testing.T) {
+	testCases := []struct {
+		addresses         []string
+		expectedFamilies  []corev1.IPFamily
+		expectedDualStack bool
+		expectedError     string
+	}{
+		{
+			addresses:        defaultIPPoolRange,
+			expectedFamilies: []corev1.IPFamily{corev1.IPv4Protocol},
+		},
+		{
+			addresses:         []string{"192.0.2.0/28", "2001:db8::10 - 2001:db8::20"},
+			expectedFamilies:  []corev1.IPFamily{corev1.IPv4Protocol, corev1.IPv6Protocol},
+			expectedDualStack: true,
+		},
+		{
+			addresses:     []string{"192.0.2.10-2001:db8::20"},
+			expectedError: "invalid IPAddressPool range 192.0.2.10-2001:db8::20: start and end must be of the same IP family",
+		},
+		{
+			addresses: []string{"192.0.2.10-invalid"},
+			expectedError: "invalid IPAddressPool range 192.0.2.10-invalid: " +
+				"\"invalid\" is neither a valid IP address nor a valid CIDR",
+		},
+		{
+			addresses:     []string{"invalid"},
+			expectedError: "invalid IPAddressPool address: \"invalid\" is neither a valid IP address nor a valid CIDR",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := NewIPAddressPoolBuilder(
+			buildTestClientWithDummyObject(), defaultIPAddressPoolName, defaultNsName, testCase.addresses)
+
+		families, err := testBuilder.GetIPFamilies()
+		dualStack, dualStackErr := testBuilder.IsDualStack()
+
+		if testCase.expectedError != "" {
+			assert.EqualError(t, err, testCase.expectedError)
+			assert.EqualError(t, dualStackErr, testCase.expectedError)
+
+			continue
+		}
+
+		assert.NoError(t, err)
+		assert.NoError(t, dualStackErr)
+		assert.Equal(t, testCase.expectedFamilies, families)
+		assert.Equal(t, testCase.expectedDualStack, dualStack)
+	}
+}
+
 func buildValidIPAddressPoolBuilder(apiClient *clients.Settings) *IPAddressPoolBuilder {
 	return NewIPAddressPoolBuilder(
 		apiClient, defaultIPAddressPoolName, defaultNsName, defaultIPPoolRange)
@@ -28,6 +28,8 @@ type BFDAdditionalOptions func(builder *BFDBuilder) (*BFDBuilder, error)
 
 // NewBFDBuilder creates a new instance of BFDBuilder.
 func NewBFDBuilder(apiClient *clients.Settings, name, nsname string) *BFDBuilder {
+	nsname = apiClient.ResolveNamespace(nsname)
+
 	klog.V(100).Infof(
 		"Initializing new BFDBuilder structure with the following params: %s, %s",
 		name, nsname)
@@ -113,6 +115,8 @@ func (builder *BFDBuilder) Exists() bool {
 
 // PullBFDProfile pulls existing bfdprofile from cluster.
 func PullBFDProfile(apiClient *clients.Settings, name, nsname string) (*BFDBuilder, error) {
+	nsname = apiClient.ResolveNamespace(nsname)
+
 	klog.V(100).Infof("Pulling existing bfdprofile name %s under namespace %s from cluster", name, nsname)
 
 	if apiClient == nil {
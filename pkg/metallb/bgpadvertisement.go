@@ -34,6 +34,8 @@ type BGPAdvertisementAdditionalOptions func(builder *BGPAdvertisementBuilder) (*
 
 // NewBGPAdvertisementBuilder creates a new instance of BGPAdvertisementBuilder.
 func NewBGPAdvertisementBuilder(apiClient *clients.Settings, name, nsname string) *BGPAdvertisementBuilder {
+	nsname = apiClient.ResolveNamespace(nsname)
+
 	klog.V(100).Infof(
 		"Initializing new BGPAdvertisement structure with the following params: %s, %s",
 		name, nsname)
@@ -119,6 +121,8 @@ func (builder *BGPAdvertisementBuilder) Get() (*mlbtypes.BGPAdvertisement, error
 
 // PullBGPAdvertisement pulls existing bgpadvertisement from cluster.
 func PullBGPAdvertisement(apiClient *clients.Settings, name, nsname string) (*BGPAdvertisementBuilder, error) {
+	nsname = apiClient.ResolveNamespace(nsname)
+
 	klog.V(100).Infof("Pulling existing bgpadvertisement name %s under namespace %s from cluster", name, nsname)
 
 	if apiClient == nil {
@@ -2,12 +2,12 @@ package metallb
 
 import (
 	"fmt"
-	"net"
 	"time"
 
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/logging"
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/msg"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/netparam"
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/schemes/metallb/mlbtypesv1beta2"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/klog/v2"
@@ -40,6 +40,8 @@ type BGPPeerAdditionalOptions func(builder *BGPPeerBuilder) (*BGPPeerBuilder, er
 // NewBPGPeerBuilder creates a new instance of BGPPeer.
 func NewBPGPeerBuilder(
 	apiClient *clients.Settings, name, nsname, peerIP string, asn, remoteASN uint32) *BGPPeerBuilder {
+	nsname = apiClient.ResolveNamespace(nsname)
+
 	klog.V(100).Infof(
 		"Initializing new BGPPeer structure with the following params: %s, %s %s %d %d",
 		name, nsname, peerIP, asn, remoteASN)
@@ -81,7 +83,7 @@ func NewBPGPeerBuilder(
 		return builder
 	}
 
-	if net.ParseIP(peerIP) == nil {
+	if netparam.ValidateIP(peerIP) != nil {
 		klog.V(100).Infof("The peerIP of the BGPPeer contains invalid ip address %s", peerIP)
 
 		builder.errorMsg = bgppeerPeeripOfTheBgppeerContains
@@ -95,6 +97,8 @@ func NewBPGPeerBuilder(
 // NewBGPPeerBuilder creates a new instance of BGPPeer.
 func NewBGPPeerBuilder(
 	apiClient *clients.Settings, name, nsname string, asn, remoteASN uint32) *BGPPeerBuilder {
+	nsname = apiClient.ResolveNamespace(nsname)
+
 	klog.V(100).Infof(
 		"Initializing new BGPPeer structure with the following params: %s, %s %d %d",
 		name, nsname, asn, remoteASN)
@@ -189,6 +193,8 @@ func (builder *BGPPeerBuilder) Exists() bool {
 
 // PullBGPPeer pulls existing bgppeer from cluster.
 func PullBGPPeer(apiClient *clients.Settings, name, nsname string) (*BGPPeerBuilder, error) {
+	nsname = apiClient.ResolveNamespace(nsname)
+
 	klog.V(100).Infof("Pulling existing bgppeer name %s under namespace %s from cluster", name, nsname)
 
 	if apiClient == nil {
@@ -323,7 +329,7 @@ func (builder *BGPPeerBuilder) WithBGPPeerIP(bgpPeerIP string) *BGPPeerBuilder {
 		"Creating an BGPPeer %s in namespace %s with IP address: %s",
 		builder.Definition.Name, builder.Definition.Namespace, bgpPeerIP)
 
-	if net.ParseIP(bgpPeerIP) == nil {
+	if netparam.ValidateIP(bgpPeerIP) != nil {
 		klog.V(100).Infof("The peerIP of the BGPPeer contains invalid ip address %s", bgpPeerIP)
 
 		builder.errorMsg = bgppeerBgppeeripOfTheBgppeerContains
@@ -394,7 +400,7 @@ func (builder *BGPPeerBuilder) WithRouterID(routerID string) *BGPPeerBuilder {
 		"Creating BGPPeer %s in namespace %s with this routerID: %s",
 		builder.Definition.Name, builder.Definition.Namespace, routerID)
 
-	if net.ParseIP(routerID) == nil {
+	if netparam.ValidateIP(routerID) != nil {
 		klog.V(100).Infof("The routerID of the BGPPeer contains invalid ip address %s, "+
 			"routerID should be present in ip address format", routerID)
 
@@ -441,7 +447,7 @@ func (builder *BGPPeerBuilder) WithSRCAddress(srcAddress string) *BGPPeerBuilder
 		"Creating BGPPeer %s in namespace %s with this srcAddress: %s",
 		builder.Definition.Name, builder.Definition.Namespace, srcAddress)
 
-	if net.ParseIP(srcAddress) == nil {
+	if netparam.ValidateIP(srcAddress) != nil {
 		klog.V(100).Infof("The srcAddress of the BGPPeer contains invalid ip address %s, "+
 			"srcAddress should be present in ip address format", srcAddress)
 
@@ -657,7 +663,7 @@ func (builder *BGPPeerBuilder) WithOptions(options ...BGPPeerAdditionalOptions)
 // GetBGPPeerGVR returns bgppeer's GroupVersionResource which could be used for Clean function.
 func GetBGPPeerGVR() schema.GroupVersionResource {
 	return schema.GroupVersionResource{
-		Group: APIGroup, Version: APIVersion, Resource: "bgppeers",
+		Group: APIGroup, Version: "v1beta2", Resource: "bgppeers",
 	}
 }
 
@@ -601,7 +601,7 @@ func TestBGPPeerWithOptions(t *testing.T) {
 func TestBGPPeerGVR(t *testing.T) {
 	assert.Equal(t, GetBGPPeerGVR(),
 		schema.GroupVersionResource{
-			Group: APIGroup, Version: APIVersion, Resource: "bgppeers",
+			Group: APIGroup, Version: "v1beta2", Resource: "bgppeers",
 		})
 }
 
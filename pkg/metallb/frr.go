@@ -2,7 +2,6 @@ package metallb
 
 import (
 	"fmt"
-	"net"
 	"time"
 
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
@@ -11,6 +10,7 @@ import (
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/logging"
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/msg"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/netparam"
 	frrtypes "github.com/rh-ecosystem-edge/eco-goinfra/pkg/schemes/metallb/frrtypes"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/klog/v2"
@@ -34,6 +34,8 @@ type FrrConfigurationBuilder struct {
 // NewFrrConfigurationBuilder creates a new instance of FRRConfiguration.
 func NewFrrConfigurationBuilder(
 	apiClient *clients.Settings, name, nsname string) *FrrConfigurationBuilder {
+	nsname = apiClient.ResolveNamespace(nsname)
+
 	klog.V(100).Infof(
 		"Initializing new Frrconfiguration structure with the following params: %s, %s",
 		name, nsname)
@@ -265,7 +267,7 @@ func (builder *FrrConfigurationBuilder) WithToReceiveModeFiltered(prefixes []str
 
 	// Validate CIDR prefixes
 	for _, prefix := range prefixes {
-		if _, _, err := net.ParseCIDR(prefix); err != nil {
+		if err := netparam.ValidateCIDR(prefix); err != nil {
 			klog.V(100).Infof("the frrConfiguration prefix %s is not a valid CIDR", prefix)
 			builder.errorMsg = fmt.Sprintf("the prefix %s is not a valid CIDR", prefix)
 
@@ -298,7 +300,7 @@ func (builder *FrrConfigurationBuilder) WithBGPNeighbor(bgpPeerIP string,
 		"Define BGP Neighbor %s with peer IP %s and ASN %v",
 		builder.Definition.Name, bgpPeerIP, remoteAS)
 
-	if net.ParseIP(bgpPeerIP) == nil {
+	if netparam.ValidateIP(bgpPeerIP) != nil {
 		klog.V(100).Infof("The peerIP for the frrConfiguration bgp peer contains invalid ip address %s",
 			bgpPeerIP)
 
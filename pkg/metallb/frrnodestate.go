@@ -8,6 +8,7 @@ import (
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/schemes/metallb/frrtypes"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/klog/v2"
 	runtimeClient "sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -99,6 +100,13 @@ func (builder *FrrNodeStateBuilder) Get() (*frrtypes.FRRNodeState, error) {
 	return frrNodeState, nil
 }
 
+// GetFrrNodeStateGVR returns FRRNodeState's GroupVersionResource which could be used for Clean function.
+func GetFrrNodeStateGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group: "frrk8s.metallb.io", Version: "v1beta1", Resource: "frrnodestates",
+	}
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *FrrNodeStateBuilder) validate() (bool, error) {
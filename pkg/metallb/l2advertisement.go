@@ -32,6 +32,8 @@ type L2AdvertisementAdditionalOptions func(builder *L2AdvertisementBuilder) (*L2
 
 // NewL2AdvertisementBuilder creates a new instance of L2AdvertisementBuilder.
 func NewL2AdvertisementBuilder(apiClient *clients.Settings, name, nsname string) *L2AdvertisementBuilder {
+	nsname = apiClient.ResolveNamespace(nsname)
+
 	klog.V(100).Infof(
 		"Initializing new L2Advertisement structure with the following params: %s, %s",
 		name, nsname)
@@ -80,6 +82,8 @@ func NewL2AdvertisementBuilder(apiClient *clients.Settings, name, nsname string)
 
 // PullL2Advertisement pulls existing L2Advertisement from cluster.
 func PullL2Advertisement(apiClient *clients.Settings, name, nsname string) (*L2AdvertisementBuilder, error) {
+	nsname = apiClient.ResolveNamespace(nsname)
+
 	klog.V(100).Infof("Pulling existing L2Advertisement name %s under namespace %s from cluster", name, nsname)
 
 	if apiClient == nil {
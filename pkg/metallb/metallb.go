@@ -2,11 +2,11 @@ package metallb
 
 import (
 	"fmt"
-	"net"
 
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/logging"
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/msg"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/netparam"
 	mlbtypes "github.com/rh-ecosystem-edge/eco-goinfra/pkg/schemes/metallb/mlboperator"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -33,6 +33,8 @@ type AdditionalOptions func(builder *Builder) (*Builder, error)
 
 // NewBuilder creates a new instance of Builder.
 func NewBuilder(apiClient *clients.Settings, name, nsname string, nodeSelector map[string]string) *Builder {
+	nsname = apiClient.ResolveNamespace(nsname)
+
 	klog.V(100).Infof(
 		"Initializing new metallb structure with the following params: %s, %s, %v",
 		name, nsname, nodeSelector)
@@ -86,6 +88,8 @@ func NewBuilder(apiClient *clients.Settings, name, nsname string, nodeSelector m
 
 // Pull retrieves an existing metallb.io object from the cluster.
 func Pull(apiClient *clients.Settings, name, nsname string) (*Builder, error) {
+	nsname = apiClient.ResolveNamespace(nsname)
+
 	klog.V(100).Infof(
 		"Pulling metallb.io object name:%s in namespace: %s", name, nsname)
 
@@ -346,7 +350,7 @@ func (builder *Builder) WithFRRConfigAlwaysBlock(prefixes []string) *Builder {
 
 	// Validate CIDR prefixes
 	for _, prefix := range prefixes {
-		if _, _, err := net.ParseCIDR(prefix); err != nil {
+		if err := netparam.ValidateCIDR(prefix); err != nil {
 			klog.V(100).Infof("the frrConfiguration prefix %s is not a valid CIDR", prefix)
 			builder.errorMsg = fmt.Sprintf("the prefix %s is not a valid CIDR", prefix)
 
@@ -8,6 +8,7 @@ import (
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/schemes/metallb/mlbtypes"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/klog/v2"
 	runtimeClient "sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -86,6 +87,13 @@ func (builder *ServiceBGPStatusBuilder) Get() (*mlbtypes.ServiceBGPStatus, error
 	return serviceBGPStatus, nil
 }
 
+// GetServiceBGPStatusGVR returns ServiceBGPStatus' GroupVersionResource which could be used for Clean function.
+func GetServiceBGPStatusGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group: "metallb.io", Version: "v1beta1", Resource: "servicebgpstatuses",
+	}
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *ServiceBGPStatusBuilder) validate() (bool, error) {
@@ -0,0 +1,95 @@
+package microshift
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+const (
+	// BootStatusGreen is the greenboot boot status after all required health checks passed.
+	BootStatusGreen = "GREEN"
+	// BootStatusRed is the greenboot boot status after a required health check failed.
+	BootStatusRed = "RED"
+)
+
+var (
+	// bootStatusRegex matches the boot status greenboot writes to /run/motd.d/boot-status, such as
+	// "Boot Status is GREEN - Health Check SUCCESS".
+	bootStatusRegex = regexp.MustCompile(`Boot Status is (\w+)`)
+	// scriptResultRegex matches the result greenboot logs for each health check script, such as
+	// "Script '40_microshift_running_check.sh' SUCCESS".
+	scriptResultRegex = regexp.MustCompile(`Script '([^']+)' (SUCCESS|FAILURE)`)
+)
+
+// GreenbootStatus is the result of the greenboot health checks on a device.
+type GreenbootStatus struct {
+	// BootStatus is the last boot status reported by greenboot, either BootStatusGreen or BootStatusRed. It is empty if
+	// the output did not contain a boot status.
+	BootStatus string
+	// PassedScripts are the health check scripts that succeeded, in the order they ran.
+	PassedScripts []string
+	// FailedScripts are the health check scripts that failed, in the order they ran.
+	FailedScripts []string
+}
+
+// ParseGreenbootStatus parses the output of greenboot, such as the contents of /run/motd.d/boot-status or the journal
+// of the greenboot-healthcheck service. An error is returned if the output contains neither a boot status nor any
+// script results.
+func ParseGreenbootStatus(output string) (*GreenbootStatus, error) {
+	status := &GreenbootStatus{}
+
+	for _, line := range strings.Split(output, "\n") {
+		if match := bootStatusRegex.FindStringSubmatch(line); match != nil {
+			status.BootStatus = match[1]
+
+			continue
+		}
+
+		if match := scriptResultRegex.FindStringSubmatch(line); match != nil {
+			if match[2] == "SUCCESS" {
+				status.PassedScripts = append(status.PassedScripts, match[1])
+			} else {
+				status.FailedScripts = append(status.FailedScripts, match[1])
+			}
+		}
+	}
+
+	if status.BootStatus == "" && len(status.PassedScripts) == 0 && len(status.FailedScripts) == 0 {
+		return nil, fmt.Errorf("greenboot output contains neither a boot status nor health check results")
+	}
+
+	return status, nil
+}
+
+// IsHealthy returns whether no health check failed and, if a boot status was reported, it is green.
+func (status *GreenbootStatus) IsHealthy() bool {
+	if status == nil {
+		return false
+	}
+
+	if status.BootStatus != "" && status.BootStatus != BootStatusGreen {
+		return false
+	}
+
+	return len(status.FailedScripts) == 0
+}
+
+// AssertGreenbootHealthy parses the greenboot output and returns an error describing the failure if the health checks
+// did not pass.
+func AssertGreenbootHealthy(output string) error {
+	status, err := ParseGreenbootStatus(output)
+	if err != nil {
+		return err
+	}
+
+	if len(status.FailedScripts) > 0 {
+		return fmt.Errorf("greenboot health check scripts failed: %s", strings.Join(status.FailedScripts, ", "))
+	}
+
+	if !status.IsHealthy() {
+		return fmt.Errorf("greenboot boot status is %s", status.BootStatus)
+	}
+
+	return nil
+}
@@ -0,0 +1,109 @@
+package microshift
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	greenbootHealthyOutput = `Running Required Health Check Scripts...
+Script '00_required_scripts_start.sh' SUCCESS
+Script '40_microshift_running_check.sh' SUCCESS
+Running Wanted Health Check Scripts...
+Boot Status is GREEN - Health Check SUCCESS`
+	greenbootFailedOutput = `Running Required Health Check Scripts...
+Script '00_required_scripts_start.sh' SUCCESS
+Script '40_microshift_running_check.sh' FAILURE (exit code '1'). Continuing...
+Boot Status is RED - Health Check FAILURE!`
+)
+
+func TestParseGreenbootStatus(t *testing.T) {
+	testCases := []struct {
+		output         string
+		expectedStatus *GreenbootStatus
+		expectedError  string
+	}{
+		{
+			output: greenbootHealthyOutput,
+			expectedStatus: &GreenbootStatus{
+				BootStatus:    BootStatusGreen,
+				PassedScripts: []string{"00_required_scripts_start.sh", "40_microshift_running_check.sh"},
+			},
+		},
+		{
+			output: greenbootFailedOutput,
+			expectedStatus: &GreenbootStatus{
+				BootStatus:    BootStatusRed,
+				PassedScripts: []string{"00_required_scripts_start.sh"},
+				FailedScripts: []string{"40_microshift_running_check.sh"},
+			},
+		},
+		{
+			output:         "Boot Status is GREEN - Health Check SUCCESS\n",
+			expectedStatus: &GreenbootStatus{BootStatus: BootStatusGreen},
+		},
+		{
+			output:        "greenboot is not installed",
+			expectedError: "greenboot output contains neither a boot status nor health check results",
+		},
+	}
+
+	for _, testCase := range testCases {
+		status, err := ParseGreenbootStatus(testCase.output)
+
+		if testCase.expectedError != "" {
+			assert.EqualError(t, err, testCase.expectedError)
+
+			continue
+		}
+
+		assert.NoError(t, err)
+		assert.Equal(t, testCase.expectedStatus, status)
+	}
+}
+
+func TestGreenbootStatusIsHealthy(t *testing.T) {
+	var nilStatus *GreenbootStatus
+
+	assert.False(t, nilStatus.IsHealthy())
+	assert.True(t, (&GreenbootStatus{BootStatus: BootStatusGreen}).IsHealthy())
+	assert.True(t, (&GreenbootStatus{PassedScripts: []string{"check.sh"}}).IsHealthy())
+	assert.False(t, (&GreenbootStatus{BootStatus: BootStatusRed}).IsHealthy())
+	assert.False(t, (&GreenbootStatus{BootStatus: BootStatusGreen, FailedScripts: []string{"check.sh"}}).IsHealthy())
+}
+
+func TestAssertGreenbootHealthy(t *testing.T) {
+	testCases := []struct {
+		output        string
+		expectedError string
+	}{
+		{
+			output: greenbootHealthyOutput,
+		},
+		{
+			output:        greenbootFailedOutput,
+			expectedError: "greenboot health check scripts failed: 40_microshift_running_check.sh",
+		},
+		{
+			output:        "Boot Status is RED - Health Check FAILURE!",
+			expectedError: "greenboot boot status is RED",
+		},
+		{
+			output:        "",
+			expectedError: "greenboot output contains neither a boot status nor health check results",
+		},
+	}
+
+	for _, testCase := range testCases {
+		err := AssertGreenbootHealthy(testCase.output)
+
+		if testCase.expectedError != "" {
+			assert.EqualError(t, err, testCase.expectedError)
+
+			continue
+		}
+
+		assert.NoError(t, err)
+	}
+}
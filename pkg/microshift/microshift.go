@@ -0,0 +1,134 @@
+// Package microshift provides helpers for MicroShift based devices, which serve the core Kubernetes APIs but not the
+// OpenShift config APIs. Use clients.NewReduced to create the apiClient for these devices.
+package microshift
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/configmap"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// VersionConfigMapName is the name of the ConfigMap MicroShift publishes its version in.
+	VersionConfigMapName = "microshift-version"
+	// VersionConfigMapNamespace is the namespace of the MicroShift version ConfigMap.
+	VersionConfigMapNamespace = "kube-public"
+	// ConfigKey is the key of the ConfigMap data holding the MicroShift config, using the format of
+	// /etc/microshift/config.yaml.
+	ConfigKey = "config.yaml"
+)
+
+// Version is the version of MicroShift running on a device.
+type Version struct {
+	// Major is the major version, such as 4 for 4.17.1.
+	Major int
+	// Minor is the minor version, such as 17 for 4.17.1.
+	Minor int
+	// Patch is the patch version, such as 1 for 4.17.1.
+	Patch int
+	// Version is the full version string, such as 4.17.1.
+	Version string
+}
+
+// Config is the subset of the MicroShift config used by tests. Fields that are not set in the config are left empty,
+// meaning MicroShift uses its defaults.
+type Config struct {
+	DNS       DNSConfig       `json:"dns,omitempty"`
+	Network   NetworkConfig   `json:"network,omitempty"`
+	Node      NodeConfig      `json:"node,omitempty"`
+	APIServer APIServerConfig `json:"apiServer,omitempty"`
+	Debugging DebuggingConfig `json:"debugging,omitempty"`
+}
+
+// DNSConfig is the dns section of the MicroShift config.
+type DNSConfig struct {
+	BaseDomain string `json:"baseDomain,omitempty"`
+}
+
+// NetworkConfig is the network section of the MicroShift config.
+type NetworkConfig struct {
+	ClusterNetwork       []string `json:"clusterNetwork,omitempty"`
+	ServiceNetwork       []string `json:"serviceNetwork,omitempty"`
+	ServiceNodePortRange string   `json:"serviceNodePortRange,omitempty"`
+}
+
+// NodeConfig is the node section of the MicroShift config.
+type NodeConfig struct {
+	HostnameOverride string `json:"hostnameOverride,omitempty"`
+	NodeIP           string `json:"nodeIP,omitempty"`
+	NodeIPv6         string `json:"nodeIPv6,omitempty"`
+}
+
+// APIServerConfig is the apiServer section of the MicroShift config.
+type APIServerConfig struct {
+	AdvertiseAddress string   `json:"advertiseAddress,omitempty"`
+	SubjectAltNames  []string `json:"subjectAltNames,omitempty"`
+}
+
+// DebuggingConfig is the debugging section of the MicroShift config.
+type DebuggingConfig struct {
+	LogLevel string `json:"logLevel,omitempty"`
+}
+
+// GetVersion returns the MicroShift version from the microshift-version ConfigMap.
+func GetVersion(apiClient *clients.Settings) (*Version, error) {
+	klog.V(100).Info("Getting the MicroShift version")
+
+	versionConfigMap, err := configmap.Pull(apiClient, VersionConfigMapName, VersionConfigMapNamespace)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get MicroShift version: %w", err)
+	}
+
+	data := versionConfigMap.Object.Data
+	version := &Version{Version: data["version"]}
+
+	if version.Version == "" {
+		return nil, fmt.Errorf("configmap %s in namespace %s has no version",
+			VersionConfigMapName, VersionConfigMapNamespace)
+	}
+
+	for _, part := range []struct {
+		key   string
+		field *int
+	}{{"major", &version.Major}, {"minor", &version.Minor}, {"patch", &version.Patch}} {
+		*part.field, err = strconv.Atoi(data[part.key])
+		if err != nil {
+			return nil, fmt.Errorf("configmap %s in namespace %s has invalid %s version %q",
+				VersionConfigMapName, VersionConfigMapNamespace, part.key, data[part.key])
+		}
+	}
+
+	return version, nil
+}
+
+// PullConfig reads the MicroShift config from the ConfigKey of the provided ConfigMap. The ConfigMap is usually
+// created by the test suite from /etc/microshift/config.yaml on the device.
+func PullConfig(apiClient *clients.Settings, name, nsname string) (*Config, error) {
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	klog.V(100).Infof("Pulling MicroShift config from configmap %s in namespace %s", name, nsname)
+
+	configMap, err := configmap.Pull(apiClient, name, nsname)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get MicroShift config: %w", err)
+	}
+
+	rawConfig, ok := configMap.Object.Data[ConfigKey]
+	if !ok {
+		return nil, fmt.Errorf("configmap %s in namespace %s has no %s key", name, nsname, ConfigKey)
+	}
+
+	config := &Config{}
+
+	err = yaml.Unmarshal([]byte(rawConfig), config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse MicroShift config from configmap %s in namespace %s: %w",
+			name, nsname, err)
+	}
+
+	return config, nil
+}
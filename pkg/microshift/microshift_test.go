@@ -0,0 +1,159 @@
+package microshift
+
+import (
+	"testing"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	defaultConfigMapName      = "microshift-config"
+	defaultConfigMapNamespace = "microshift-test"
+)
+
+func TestGetVersion(t *testing.T) {
+	testCases := []struct {
+		data            map[string]string
+		addConfigMap    bool
+		expectedVersion *Version
+		expectedError   string
+	}{
+		{
+			data:            map[string]string{"major": "4", "minor": "17", "patch": "1", "version": "4.17.1"},
+			addConfigMap:    true,
+			expectedVersion: &Version{Major: 4, Minor: 17, Patch: 1, Version: "4.17.1"},
+		},
+		{
+			data:          map[string]string{"major": "4", "minor": "17", "patch": "1"},
+			addConfigMap:  true,
+			expectedError: "configmap microshift-version in namespace kube-public has no version",
+		},
+		{
+			data:          map[string]string{"major": "4", "minor": "x", "patch": "1", "version": "4.x.1"},
+			addConfigMap:  true,
+			expectedError: "configmap microshift-version in namespace kube-public has invalid minor version \"x\"",
+		},
+		{
+			addConfigMap: false,
+			expectedError: "failed to get MicroShift version: failed to pull builder: " +
+				"failed to get ConfigMap kube-public/microshift-version: configmaps \"microshift-version\" not found",
+		},
+	}
+
+	for _, testCase := range testCases {
+		var runtimeObjects []runtime.Object
+
+		if testCase.addConfigMap {
+			runtimeObjects = append(runtimeObjects,
+				buildDummyConfigMap(VersionConfigMapName, VersionConfigMapNamespace, testCase.data))
+		}
+
+		version, err := GetVersion(buildTestClient(runtimeObjects))
+
+		if testCase.expectedError != "" {
+			assert.EqualError(t, err, testCase.expectedError)
+
+			continue
+		}
+
+		assert.NoError(t, err)
+		assert.Equal(t, testCase.expectedVersion, version)
+	}
+}
+
+func TestPullConfig(t *testing.T) {
+	testCases := []struct {
+		data           map[string]string
+		addConfigMap   bool
+		expectedConfig *Config
+		expectedError  string
+	}{
+		{
+			data: map[string]string{ConfigKey: `
+dns:
+  baseDomain: example.com
+network:
+  clusterNetwork:
+  - 10.42.0.0/16
+  - fd01::/48
+  serviceNetwork:
+  - 10.43.0.0/16
+  - fd02::/112
+node:
+  nodeIP: 192.168.122.10
+  nodeIPv6: 2001:db8::10
+debugging:
+  logLevel: Debug
+`},
+			addConfigMap: true,
+			expectedConfig: &Config{
+				DNS: DNSConfig{BaseDomain: "example.com"},
+				Network: NetworkConfig{
+					ClusterNetwork: []string{"10.42.0.0/16", "fd01::/48"},
+					ServiceNetwork: []string{"10.43.0.0/16", "fd02::/112"},
+				},
+				Node:      NodeConfig{NodeIP: "192.168.122.10", NodeIPv6: "2001:db8::10"},
+				Debugging: DebuggingConfig{LogLevel: "Debug"},
+			},
+		},
+		{
+			data:           map[string]string{ConfigKey: ""},
+			addConfigMap:   true,
+			expectedConfig: &Config{},
+		},
+		{
+			data:          map[string]string{"other": ""},
+			addConfigMap:  true,
+			expectedError: "configmap microshift-config in namespace microshift-test has no config.yaml key",
+		},
+		{
+			data:          map[string]string{ConfigKey: "dns: [invalid"},
+			addConfigMap:  true,
+			expectedError: "failed to parse MicroShift config from configmap microshift-config in namespace microshift-test",
+		},
+		{
+			addConfigMap: false,
+			expectedError: "failed to get MicroShift config: failed to pull builder: " +
+				"failed to get ConfigMap microshift-test/microshift-config: configmaps \"microshift-config\" not found",
+		},
+	}
+
+	for _, testCase := range testCases {
+		var runtimeObjects []runtime.Object
+
+		if testCase.addConfigMap {
+			runtimeObjects = append(runtimeObjects,
+				buildDummyConfigMap(defaultConfigMapName, defaultConfigMapNamespace, testCase.data))
+		}
+
+		config, err := PullConfig(buildTestClient(runtimeObjects), defaultConfigMapName, defaultConfigMapNamespace)
+
+		if testCase.expectedError != "" {
+			assert.ErrorContains(t, err, testCase.expectedError)
+
+			continue
+		}
+
+		assert.NoError(t, err)
+		assert.Equal(t, testCase.expectedConfig, config)
+	}
+}
+
+func buildTestClient(runtimeObjects []runtime.Object) *clients.Settings {
+	return clients.GetTestClients(clients.TestClientParams{
+		K8sMockObjects:  runtimeObjects,
+		SchemeAttachers: []clients.SchemeAttacher{corev1.AddToScheme},
+		Reduced:         true,
+	})
+}
+
+func buildDummyConfigMap(name, nsname string, data map[string]string) *corev1.ConfigMap {
+	return &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: nsname},
+		Data:       data,
+	}
+}
@@ -0,0 +1,302 @@
+package monitoring
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common"
+	monv1alpha1 "github.com/rh-ecosystem-edge/eco-goinfra/pkg/schemes/monitoring/v1alpha1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
+)
+
+// AlertmanagerConfigBuilder provides a struct for the AlertmanagerConfig resource containing a connection to the
+// cluster and the AlertmanagerConfig definition. AlertmanagerConfigs route the alerts of their namespace to receivers
+// and are only reconciled by the user workload Alertmanager when it is enabled with enableAlertmanagerConfig, see
+// UserWorkloadConfigBuilder.WithAlertmanager.
+type AlertmanagerConfigBuilder struct {
+	common.EmbeddableBuilder[monv1alpha1.AlertmanagerConfig, *monv1alpha1.AlertmanagerConfig]
+	common.EmbeddableCreator[monv1alpha1.AlertmanagerConfig, AlertmanagerConfigBuilder,
+		*monv1alpha1.AlertmanagerConfig, *AlertmanagerConfigBuilder]
+	common.EmbeddableDeleter[monv1alpha1.AlertmanagerConfig, *monv1alpha1.AlertmanagerConfig]
+	common.EmbeddableUpdater[monv1alpha1.AlertmanagerConfig, AlertmanagerConfigBuilder,
+		*monv1alpha1.AlertmanagerConfig, *AlertmanagerConfigBuilder]
+}
+
+// AttachMixins wires the embedded CRUD mixins to this builder instance.
+func (builder *AlertmanagerConfigBuilder) AttachMixins() {
+	builder.EmbeddableCreator.SetBase(builder)
+	builder.EmbeddableDeleter.SetBase(builder)
+	builder.EmbeddableUpdater.SetBase(builder)
+}
+
+// GetGVK returns the AlertmanagerConfig GVK for this builder.
+func (builder *AlertmanagerConfigBuilder) GetGVK() schema.GroupVersionKind {
+	return monv1alpha1.GroupVersion.WithKind(monv1alpha1.AlertmanagerConfigKind)
+}
+
+// NewAlertmanagerConfigBuilder creates a new instance of AlertmanagerConfigBuilder. A route must be set using
+// WithRoute and the receiver it names must be added before creating the AlertmanagerConfig.
+func NewAlertmanagerConfigBuilder(apiClient *clients.Settings, name, nsname string) *AlertmanagerConfigBuilder {
+	klog.V(100).Infof("Initializing new AlertmanagerConfig structure with the following params: name: %s, namespace: %s",
+		name, nsname)
+
+	return common.NewNamespacedBuilder[monv1alpha1.AlertmanagerConfig, AlertmanagerConfigBuilder](
+		apiClient, monv1alpha1.AddToScheme, name, nsname)
+}
+
+// PullAlertmanagerConfig pulls an existing AlertmanagerConfig from the cluster.
+func PullAlertmanagerConfig(apiClient *clients.Settings, name, nsname string) (*AlertmanagerConfigBuilder, error) {
+	klog.V(100).Infof("Pulling existing AlertmanagerConfig %s in namespace %s from cluster", name, nsname)
+
+	return common.PullNamespacedBuilder[monv1alpha1.AlertmanagerConfig, AlertmanagerConfigBuilder](
+		context.TODO(), apiClient, monv1alpha1.AddToScheme, name, nsname)
+}
+
+// WithRoute sets the route of the AlertmanagerConfig, sending the alerts of its namespace that match all of the
+// matchers to receiver, grouped by the groupBy labels. The operator always restricts the route to alerts from the
+// namespace of the AlertmanagerConfig.
+func (builder *AlertmanagerConfigBuilder) WithRoute(
+	receiver string, groupBy []string, matchers ...monv1alpha1.Matcher) *AlertmanagerConfigBuilder {
+	if err := common.Validate(builder); err != nil {
+		return builder
+	}
+
+	klog.V(100).Infof("Setting route of AlertmanagerConfig %s in namespace %s to receiver %s with matchers %v",
+		builder.Definition.Name, builder.Definition.Namespace, receiver, matchers)
+
+	if receiver == "" {
+		klog.V(100).Info("The route receiver of the AlertmanagerConfig is empty")
+
+		builder.SetError(fmt.Errorf("alertmanagerconfig route 'receiver' cannot be empty"))
+
+		return builder
+	}
+
+	if err := validateMatchers(matchers); err != nil {
+		builder.SetError(err)
+
+		return builder
+	}
+
+	route := &monv1alpha1.Route{Receiver: receiver, GroupBy: groupBy, Matchers: matchers}
+
+	// Keep the intervals if they were set before the route was replaced.
+	if builder.Definition.Spec.Route != nil {
+		route.GroupWait = builder.Definition.Spec.Route.GroupWait
+		route.GroupInterval = builder.Definition.Spec.Route.GroupInterval
+		route.RepeatInterval = builder.Definition.Spec.Route.RepeatInterval
+	}
+
+	builder.Definition.Spec.Route = route
+
+	return builder
+}
+
+// WithRouteIntervals sets how long the route waits before sending the first notification of a group, before
+// notifying about new alerts in a group and before repeating a notification. Zero durations leave the default of
+// Alertmanager. WithRoute must be called first.
+func (builder *AlertmanagerConfigBuilder) WithRouteIntervals(
+	groupWait, groupInterval, repeatInterval time.Duration) *AlertmanagerConfigBuilder {
+	if err := common.Validate(builder); err != nil {
+		return builder
+	}
+
+	klog.V(100).Infof("Setting route intervals of AlertmanagerConfig %s in namespace %s to %s, %s and %s",
+		builder.Definition.Name, builder.Definition.Namespace, groupWait, groupInterval, repeatInterval)
+
+	if builder.Definition.Spec.Route == nil {
+		klog.V(100).Info("The AlertmanagerConfig has no route")
+
+		builder.SetError(fmt.Errorf("alertmanagerconfig route must be set before its intervals"))
+
+		return builder
+	}
+
+	if groupWait < 0 || groupInterval < 0 || repeatInterval < 0 {
+		klog.V(100).Info("The route intervals of the AlertmanagerConfig are negative")
+
+		builder.SetError(fmt.Errorf("alertmanagerconfig route intervals cannot be negative"))
+
+		return builder
+	}
+
+	builder.Definition.Spec.Route.GroupWait = formatAlertmanagerDuration(groupWait)
+	builder.Definition.Spec.Route.GroupInterval = formatAlertmanagerDuration(groupInterval)
+	builder.Definition.Spec.Route.RepeatInterval = formatAlertmanagerDuration(repeatInterval)
+
+	return builder
+}
+
+// WithWebhookReceiver adds a receiver named name which posts the alerts to url. If sendResolved is true, the webhook
+// is also notified when the alerts are resolved.
+func (builder *AlertmanagerConfigBuilder) WithWebhookReceiver(
+	name, url string, sendResolved bool) *AlertmanagerConfigBuilder {
+	if err := common.Validate(builder); err != nil {
+		return builder
+	}
+
+	klog.V(100).Infof("Adding webhook receiver %s with url %s to AlertmanagerConfig %s in namespace %s",
+		name, url, builder.Definition.Name, builder.Definition.Namespace)
+
+	if url == "" {
+		klog.V(100).Info("The webhook receiver url of the AlertmanagerConfig is empty")
+
+		builder.SetError(fmt.Errorf("alertmanagerconfig webhook receiver 'url' cannot be empty"))
+
+		return builder
+	}
+
+	return builder.withReceiver(monv1alpha1.Receiver{
+		Name: name,
+		WebhookConfigs: []monv1alpha1.WebhookConfig{{
+			URL:          ptr.To(url),
+			SendResolved: ptr.To(sendResolved),
+		}},
+	})
+}
+
+// WithEmailReceiver adds a receiver named name which emails the alerts to the to address from the from address
+// through smarthost, which has the form host:port.
+func (builder *AlertmanagerConfigBuilder) WithEmailReceiver(
+	name, to, from, smarthost string) *AlertmanagerConfigBuilder {
+	if err := common.Validate(builder); err != nil {
+		return builder
+	}
+
+	klog.V(100).Infof("Adding email receiver %s to %s through %s to AlertmanagerConfig %s in namespace %s",
+		name, to, smarthost, builder.Definition.Name, builder.Definition.Namespace)
+
+	if to == "" || from == "" || smarthost == "" {
+		klog.V(100).Info("The email receiver of the AlertmanagerConfig is missing an address")
+
+		builder.SetError(fmt.Errorf("alertmanagerconfig email receiver 'to', 'from' and 'smarthost' cannot be empty"))
+
+		return builder
+	}
+
+	return builder.withReceiver(monv1alpha1.Receiver{
+		Name:         name,
+		EmailConfigs: []monv1alpha1.EmailConfig{{To: to, From: from, Smarthost: smarthost}},
+	})
+}
+
+// WithReceiver adds the provided receiver, allowing integrations not covered by the other With*Receiver methods.
+func (builder *AlertmanagerConfigBuilder) WithReceiver(receiver monv1alpha1.Receiver) *AlertmanagerConfigBuilder {
+	if err := common.Validate(builder); err != nil {
+		return builder
+	}
+
+	klog.V(100).Infof("Adding receiver %s to AlertmanagerConfig %s in namespace %s",
+		receiver.Name, builder.Definition.Name, builder.Definition.Namespace)
+
+	return builder.withReceiver(receiver)
+}
+
+// WithInhibitRule adds a rule muting the alerts matching targetMatch while an alert matching sourceMatch is firing.
+// If labels to compare are provided, the source and target alerts must have equal values for them.
+func (builder *AlertmanagerConfigBuilder) WithInhibitRule(
+	sourceMatch, targetMatch []monv1alpha1.Matcher, equal ...string) *AlertmanagerConfigBuilder {
+	if err := common.Validate(builder); err != nil {
+		return builder
+	}
+
+	klog.V(100).Infof("Adding inhibit rule from %v to %v to AlertmanagerConfig %s in namespace %s",
+		sourceMatch, targetMatch, builder.Definition.Name, builder.Definition.Namespace)
+
+	if len(sourceMatch) == 0 || len(targetMatch) == 0 {
+		klog.V(100).Info("The inhibit rule of the AlertmanagerConfig has no source or target matchers")
+
+		builder.SetError(fmt.Errorf("alertmanagerconfig inhibit rule 'sourceMatch' and 'targetMatch' cannot be empty"))
+
+		return builder
+	}
+
+	if err := validateMatchers(slices.Concat(sourceMatch, targetMatch)); err != nil {
+		builder.SetError(err)
+
+		return builder
+	}
+
+	builder.Definition.Spec.InhibitRules = append(builder.Definition.Spec.InhibitRules, monv1alpha1.InhibitRule{
+		SourceMatch: sourceMatch,
+		TargetMatch: targetMatch,
+		Equal:       equal,
+	})
+
+	return builder
+}
+
+// withReceiver validates and appends the receiver to the definition. The builder must already be valid.
+func (builder *AlertmanagerConfigBuilder) withReceiver(receiver monv1alpha1.Receiver) *AlertmanagerConfigBuilder {
+	if receiver.Name == "" {
+		klog.V(100).Info("The receiver name of the AlertmanagerConfig is empty")
+
+		builder.SetError(fmt.Errorf("alertmanagerconfig receiver 'name' cannot be empty"))
+
+		return builder
+	}
+
+	for _, existing := range builder.Definition.Spec.Receivers {
+		if existing.Name == receiver.Name {
+			klog.V(100).Infof("The AlertmanagerConfig already has a receiver named %s", receiver.Name)
+
+			builder.SetError(fmt.Errorf("alertmanagerconfig receiver %s already exists", receiver.Name))
+
+			return builder
+		}
+	}
+
+	builder.Definition.Spec.Receivers = append(builder.Definition.Spec.Receivers, receiver)
+
+	return builder
+}
+
+// GetAlertmanagerConfigGVR returns AlertmanagerConfig's GroupVersionResource which could be used for Clean function.
+func GetAlertmanagerConfigGVR() schema.GroupVersionResource {
+	return monv1alpha1.GroupVersion.WithResource(monv1alpha1.AlertmanagerConfigName)
+}
+
+// NewMatcher returns a matcher comparing the label name to value using matchType, such as monv1alpha1.MatchEqual.
+func NewMatcher(name string, matchType monv1alpha1.MatchType, value string) monv1alpha1.Matcher {
+	return monv1alpha1.Matcher{Name: name, MatchType: matchType, Value: value}
+}
+
+// validateMatchers returns an error if any of the matchers has no label name or an unknown match type.
+func validateMatchers(matchers []monv1alpha1.Matcher) error {
+	for _, matcher := range matchers {
+		if matcher.Name == "" {
+			return fmt.Errorf("alertmanagerconfig matcher 'name' cannot be empty")
+		}
+
+		switch matcher.MatchType {
+		case "", monv1alpha1.MatchEqual, monv1alpha1.MatchNotEqual, monv1alpha1.MatchRegexp, monv1alpha1.MatchNotRegexp:
+		default:
+			return fmt.Errorf("alertmanagerconfig matcher %s has invalid matchType %q", matcher.Name, matcher.MatchType)
+		}
+	}
+
+	return nil
+}
+
+// formatAlertmanagerDuration formats duration in the largest unit Alertmanager accepts which represents it exactly,
+// since Alertmanager does not accept the output of time.Duration.String for fractional values. Zero returns an empty
+// string so the default is used.
+func formatAlertmanagerDuration(duration time.Duration) string {
+	switch {
+	case duration == 0:
+		return ""
+	case duration%time.Hour == 0:
+		return fmt.Sprintf("%dh", duration/time.Hour)
+	case duration%time.Minute == 0:
+		return fmt.Sprintf("%dm", duration/time.Minute)
+	case duration%time.Second == 0:
+		return fmt.Sprintf("%ds", duration/time.Second)
+	default:
+		return fmt.Sprintf("%dms", duration/time.Millisecond)
+	}
+}
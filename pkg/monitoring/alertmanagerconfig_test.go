@@ -0,0 +1,235 @@
+package monitoring
+
+import (
+	"testing"
+	"time"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common/testhelper"
+	monv1alpha1 "github.com/rh-ecosystem-edge/eco-goinfra/pkg/schemes/monitoring/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/utils/ptr"
+	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	defaultAlertmanagerConfigName      = "test-alertmanagerconfig"
+	defaultAlertmanagerConfigNamespace = "test-namespace"
+)
+
+var alertmanagerConfigGVK = monv1alpha1.GroupVersion.WithKind(monv1alpha1.AlertmanagerConfigKind)
+
+func TestNewAlertmanagerConfigBuilder(t *testing.T) {
+	t.Parallel()
+
+	testhelper.NewNamespacedBuilderTestConfig(
+		NewAlertmanagerConfigBuilder, monv1alpha1.AddToScheme, alertmanagerConfigGVK).ExecuteTests(t)
+}
+
+func TestPullAlertmanagerConfig(t *testing.T) {
+	t.Parallel()
+
+	testhelper.NewNamespacedPullTestConfig(
+		PullAlertmanagerConfig, monv1alpha1.AddToScheme, alertmanagerConfigGVK).ExecuteTests(t)
+}
+
+func TestAlertmanagerConfigBuilderMethods(t *testing.T) {
+	t.Parallel()
+
+	commonConfig := testhelper.NewCommonTestConfig[monv1alpha1.AlertmanagerConfig, AlertmanagerConfigBuilder](
+		monv1alpha1.AddToScheme, alertmanagerConfigGVK, testhelper.ResourceScopeNamespaced)
+
+	testhelper.NewTestSuite().
+		With(testhelper.NewGetTestConfig(commonConfig)).
+		With(testhelper.NewExistsTestConfig(commonConfig)).
+		With(testhelper.NewCreateTestConfig(commonConfig)).
+		With(testhelper.NewDeleterTestConfig(commonConfig)).
+		With(testhelper.NewUpdateTestConfig(commonConfig)).
+		Run(t)
+}
+
+func TestListAlertmanagerConfigs(t *testing.T) {
+	t.Parallel()
+
+	testhelper.NewNamespacedListTestConfig(
+		func(apiClient *clients.Settings, nsname string,
+			_ ...runtimeclient.ListOptions) ([]*AlertmanagerConfigBuilder, error) {
+			return ListAlertmanagerConfigs(apiClient, nsname)
+		},
+		monv1alpha1.AddToScheme,
+		alertmanagerConfigGVK,
+	).ExecuteTests(t)
+}
+
+func TestAlertmanagerConfigWithRoute(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		receiver      string
+		matchers      []monv1alpha1.Matcher
+		expectedError string
+	}{
+		{
+			receiver: "webhook",
+			matchers: []monv1alpha1.Matcher{NewMatcher("severity", monv1alpha1.MatchEqual, "critical")},
+		},
+		{
+			receiver:      "",
+			expectedError: "alertmanagerconfig route 'receiver' cannot be empty",
+		},
+		{
+			receiver:      "webhook",
+			matchers:      []monv1alpha1.Matcher{NewMatcher("", monv1alpha1.MatchEqual, "critical")},
+			expectedError: "alertmanagerconfig matcher 'name' cannot be empty",
+		},
+		{
+			receiver:      "webhook",
+			matchers:      []monv1alpha1.Matcher{NewMatcher("severity", "==", "critical")},
+			expectedError: "alertmanagerconfig matcher severity has invalid matchType \"==\"",
+		},
+	}
+
+	for _, testCase := range testCases {
+		builder := buildValidAlertmanagerConfigTestBuilder().WithRoute(
+			testCase.receiver, []string{"alertname"}, testCase.matchers...)
+
+		if testCase.expectedError != "" {
+			assert.EqualError(t, builder.GetError(), testCase.expectedError)
+
+			continue
+		}
+
+		assert.NoError(t, builder.GetError())
+		assert.Equal(t, &monv1alpha1.Route{
+			Receiver: testCase.receiver,
+			GroupBy:  []string{"alertname"},
+			Matchers: testCase.matchers,
+		}, builder.Definition.Spec.Route)
+	}
+}
+
+func TestAlertmanagerConfigWithRouteIntervals(t *testing.T) {
+	t.Parallel()
+
+	builder := buildValidAlertmanagerConfigTestBuilder().
+		WithRoute("webhook", nil).
+		WithRouteIntervals(30*time.Second, 5*time.Minute, 90*time.Minute)
+	assert.NoError(t, builder.GetError())
+	assert.Equal(t, "30s", builder.Definition.Spec.Route.GroupWait)
+	assert.Equal(t, "5m", builder.Definition.Spec.Route.GroupInterval)
+	assert.Equal(t, "90m", builder.Definition.Spec.Route.RepeatInterval)
+
+	// Replacing the route keeps the intervals.
+	builder = builder.WithRoute("email", nil)
+	assert.Equal(t, "email", builder.Definition.Spec.Route.Receiver)
+	assert.Equal(t, "30s", builder.Definition.Spec.Route.GroupWait)
+
+	builder = buildValidAlertmanagerConfigTestBuilder().WithRouteIntervals(time.Second, 0, 0)
+	assert.EqualError(t, builder.GetError(), "alertmanagerconfig route must be set before its intervals")
+
+	builder = buildValidAlertmanagerConfigTestBuilder().WithRoute("webhook", nil).WithRouteIntervals(-time.Second, 0, 0)
+	assert.EqualError(t, builder.GetError(), "alertmanagerconfig route intervals cannot be negative")
+}
+
+func TestAlertmanagerConfigWithReceivers(t *testing.T) {
+	t.Parallel()
+
+	builder := buildValidAlertmanagerConfigTestBuilder().
+		WithWebhookReceiver("webhook", "http://receiver.test-namespace.svc:8080", true).
+		WithEmailReceiver("email", "oncall@example.com", "alertmanager@example.com", "smtp.example.com:25").
+		WithReceiver(monv1alpha1.Receiver{Name: "null"})
+	assert.NoError(t, builder.GetError())
+	assert.Equal(t, []monv1alpha1.Receiver{
+		{
+			Name: "webhook",
+			WebhookConfigs: []monv1alpha1.WebhookConfig{{
+				URL:          ptr.To("http://receiver.test-namespace.svc:8080"),
+				SendResolved: ptr.To(true),
+			}},
+		},
+		{
+			Name: "email",
+			EmailConfigs: []monv1alpha1.EmailConfig{{
+				To:        "oncall@example.com",
+				From:      "alertmanager@example.com",
+				Smarthost: "smtp.example.com:25",
+			}},
+		},
+		{Name: "null"},
+	}, builder.Definition.Spec.Receivers)
+
+	testCases := []struct {
+		builder       *AlertmanagerConfigBuilder
+		expectedError string
+	}{
+		{
+			builder:       buildValidAlertmanagerConfigTestBuilder().WithWebhookReceiver("", "http://receiver", false),
+			expectedError: "alertmanagerconfig receiver 'name' cannot be empty",
+		},
+		{
+			builder:       buildValidAlertmanagerConfigTestBuilder().WithWebhookReceiver("webhook", "", false),
+			expectedError: "alertmanagerconfig webhook receiver 'url' cannot be empty",
+		},
+		{
+			builder:       buildValidAlertmanagerConfigTestBuilder().WithEmailReceiver("email", "", "from", "smtp:25"),
+			expectedError: "alertmanagerconfig email receiver 'to', 'from' and 'smarthost' cannot be empty",
+		},
+		{
+			builder: buildValidAlertmanagerConfigTestBuilder().
+				WithReceiver(monv1alpha1.Receiver{Name: "null"}).
+				WithReceiver(monv1alpha1.Receiver{Name: "null"}),
+			expectedError: "alertmanagerconfig receiver null already exists",
+		},
+	}
+
+	for _, testCase := range testCases {
+		assert.EqualError(t, testCase.builder.GetError(), testCase.expectedError)
+	}
+}
+
+func TestAlertmanagerConfigWithInhibitRule(t *testing.T) {
+	t.Parallel()
+
+	sourceMatch := []monv1alpha1.Matcher{NewMatcher("severity", monv1alpha1.MatchEqual, "critical")}
+	targetMatch := []monv1alpha1.Matcher{NewMatcher("severity", monv1alpha1.MatchRegexp, "warning|info")}
+
+	builder := buildValidAlertmanagerConfigTestBuilder().WithInhibitRule(sourceMatch, targetMatch, "alertname")
+	assert.NoError(t, builder.GetError())
+	assert.Equal(t, []monv1alpha1.InhibitRule{{
+		SourceMatch: sourceMatch,
+		TargetMatch: targetMatch,
+		Equal:       []string{"alertname"},
+	}}, builder.Definition.Spec.InhibitRules)
+
+	builder = buildValidAlertmanagerConfigTestBuilder().WithInhibitRule(sourceMatch, nil)
+	assert.EqualError(t, builder.GetError(),
+		"alertmanagerconfig inhibit rule 'sourceMatch' and 'targetMatch' cannot be empty")
+
+	builder = buildValidAlertmanagerConfigTestBuilder().WithInhibitRule(
+		sourceMatch, []monv1alpha1.Matcher{NewMatcher("", monv1alpha1.MatchEqual, "warning")})
+	assert.EqualError(t, builder.GetError(), "alertmanagerconfig matcher 'name' cannot be empty")
+}
+
+func TestFormatAlertmanagerDuration(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		duration time.Duration
+		expected string
+	}{
+		{duration: 0, expected: ""},
+		{duration: 4 * time.Hour, expected: "4h"},
+		{duration: 90 * time.Second, expected: "90s"},
+		{duration: 1500 * time.Millisecond, expected: "1500ms"},
+	}
+
+	for _, testCase := range testCases {
+		assert.Equal(t, testCase.expected, formatAlertmanagerDuration(testCase.duration))
+	}
+}
+
+func buildValidAlertmanagerConfigTestBuilder() *AlertmanagerConfigBuilder {
+	return NewAlertmanagerConfigBuilder(clients.GetTestClients(clients.TestClientParams{
+		SchemeAttachers: []clients.SchemeAttacher{monv1alpha1.AddToScheme},
+	}), defaultAlertmanagerConfigName, defaultAlertmanagerConfigNamespace)
+}
@@ -0,0 +1,3 @@
+package monitoring
+
+//go:generate go run ../../internal/listgen -builder AlertmanagerConfigBuilder
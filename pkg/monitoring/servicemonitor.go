@@ -6,10 +6,12 @@ import (
 	monv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
 
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common"
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/logging"
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/msg"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/klog/v2"
 	goclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -35,6 +37,8 @@ type Builder struct {
 // NewBuilder creates a new instance of Builder.
 func NewBuilder(
 	apiClient *clients.Settings, name, nsname string) *Builder {
+	nsname = apiClient.ResolveNamespace(nsname)
+
 	klog.V(100).Infof(
 		"Initializing new serviceMonitor structure with the following params: "+
 			"name: %s, namespace: %s", name, nsname)
@@ -83,6 +87,8 @@ func NewBuilder(
 
 // Pull pulls existing serviceMonitor from cluster.
 func Pull(apiClient *clients.Settings, name, nsname string) (*Builder, error) {
+	nsname = apiClient.ResolveNamespace(nsname)
+
 	klog.V(100).Infof("Pulling existing serviceMonitor name %s in namespace %s from cluster",
 		name, nsname)
 
@@ -288,6 +294,14 @@ func (builder *Builder) WithLabels(labels map[string]string) *Builder {
 		}
 	}
 
+	if err := common.ValidateLabels(labels); err != nil {
+		klog.V(100).Infof("Invalid labels: %v", err)
+
+		builder.errorMsg = err.Error()
+
+		return builder
+	}
+
 	builder.Definition.Labels = labels
 
 	return builder
@@ -347,6 +361,13 @@ func (builder *Builder) WithNamespaceSelector(namespaceSelector []string) *Build
 	return builder
 }
 
+// GetServiceMonitorGVR returns ServiceMonitor's GroupVersionResource which could be used for Clean function.
+func GetServiceMonitorGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group: "monitoring.coreos.com", Version: "v1", Resource: "servicemonitors",
+	}
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *Builder) validate() (bool, error) {
@@ -0,0 +1,364 @@
+package monitoring
+
+import (
+	"fmt"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/configmap"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// ClusterMonitoringConfigName is the name of the configmap configuring the platform monitoring stack.
+	ClusterMonitoringConfigName = "cluster-monitoring-config"
+	// ClusterMonitoringNamespace is the namespace of the platform monitoring stack.
+	ClusterMonitoringNamespace = "openshift-monitoring"
+	// UserWorkloadMonitoringConfigName is the name of the configmap configuring the user workload monitoring stack.
+	UserWorkloadMonitoringConfigName = "user-workload-monitoring-config"
+	// UserWorkloadMonitoringNamespace is the namespace of the user workload monitoring stack.
+	UserWorkloadMonitoringNamespace = "openshift-user-workload-monitoring"
+	// monitoringConfigKey is the key of the monitoring configmaps holding the YAML configuration.
+	monitoringConfigKey = "config.yaml"
+)
+
+// UserWorkloadConfig is the subset of the user workload monitoring configuration managed by the
+// UserWorkloadConfigBuilder. Settings of the configmap not represented here are preserved when it is applied.
+type UserWorkloadConfig struct {
+	Alertmanager                      *UserWorkloadAlertmanagerConfig `json:"alertmanager,omitempty"`
+	Prometheus                        *UserWorkloadPrometheusConfig   `json:"prometheus,omitempty"`
+	NamespacesWithoutLabelEnforcement []string                        `json:"namespacesWithoutLabelEnforcement,omitempty"`
+}
+
+// UserWorkloadAlertmanagerConfig configures the Alertmanager dedicated to user workloads.
+type UserWorkloadAlertmanagerConfig struct {
+	// Enabled deploys the user workload Alertmanager rather than sending user alerts to the platform one.
+	Enabled bool `json:"enabled"`
+	// EnableAlertmanagerConfig makes the user workload Alertmanager reconcile AlertmanagerConfig resources.
+	EnableAlertmanagerConfig bool `json:"enableAlertmanagerConfig"`
+}
+
+// UserWorkloadPrometheusConfig configures the Prometheus dedicated to user workloads.
+type UserWorkloadPrometheusConfig struct {
+	// Retention is how long metrics are kept, such as 24h.
+	Retention string `json:"retention,omitempty"`
+	// ExternalLabels are added to every series and alert sent by Prometheus.
+	ExternalLabels map[string]string `json:"externalLabels,omitempty"`
+}
+
+// UserWorkloadConfigBuilder provides a struct to manage the user-workload-monitoring-config configmap through typed
+// settings rather than by editing its YAML. The settings of the configmap are loaded when the builder is created and
+// Apply merges the Definition back into them.
+type UserWorkloadConfigBuilder struct {
+	// Definition holds the typed settings applied to the configmap.
+	Definition *UserWorkloadConfig
+	// configMapBuilder is the builder of the user-workload-monitoring-config configmap.
+	configMapBuilder *configmap.Builder
+	// errorMsg is set when defining or mutating the settings fails.
+	errorMsg string
+}
+
+// NewUserWorkloadConfigBuilder creates a new instance of UserWorkloadConfigBuilder, loading the current settings if
+// the user-workload-monitoring-config configmap already exists.
+func NewUserWorkloadConfigBuilder(apiClient *clients.Settings) *UserWorkloadConfigBuilder {
+	klog.V(100).Info("Initializing new user workload monitoring config structure")
+
+	if apiClient == nil {
+		klog.V(100).Info("The apiClient of the user workload monitoring config is nil")
+
+		return nil
+	}
+
+	builder := &UserWorkloadConfigBuilder{
+		Definition: &UserWorkloadConfig{},
+		configMapBuilder: configmap.NewBuilder(
+			apiClient, UserWorkloadMonitoringConfigName, UserWorkloadMonitoringNamespace),
+	}
+
+	if err := builder.configMapBuilder.GetError(); err != nil {
+		builder.errorMsg = err.Error()
+
+		return builder
+	}
+
+	if !builder.configMapBuilder.Exists() {
+		return builder
+	}
+
+	builder.configMapBuilder.Definition = builder.configMapBuilder.Object
+
+	err := yaml.Unmarshal([]byte(builder.configMapBuilder.Definition.Data[monitoringConfigKey]), builder.Definition)
+	if err != nil {
+		klog.V(100).Infof("Failed to parse the user workload monitoring config: %v", err)
+
+		builder.errorMsg = fmt.Sprintf("failed to parse %s: %v", monitoringConfigKey, err)
+	}
+
+	return builder
+}
+
+// WithAlertmanager sets whether the user workload Alertmanager is deployed and whether it reconciles
+// AlertmanagerConfig resources, which is required for AlertmanagerConfigBuilder resources to route user alerts.
+func (builder *UserWorkloadConfigBuilder) WithAlertmanager(
+	enabled, enableAlertmanagerConfig bool) *UserWorkloadConfigBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	klog.V(100).Infof("Setting user workload alertmanager enabled to %t and enableAlertmanagerConfig to %t",
+		enabled, enableAlertmanagerConfig)
+
+	builder.Definition.Alertmanager = &UserWorkloadAlertmanagerConfig{
+		Enabled:                  enabled,
+		EnableAlertmanagerConfig: enableAlertmanagerConfig,
+	}
+
+	return builder
+}
+
+// WithPrometheusRetention sets how long the user workload Prometheus keeps metrics, such as 24h.
+func (builder *UserWorkloadConfigBuilder) WithPrometheusRetention(retention string) *UserWorkloadConfigBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	klog.V(100).Infof("Setting user workload prometheus retention to %s", retention)
+
+	if retention == "" {
+		klog.V(100).Info("The user workload prometheus retention is empty")
+
+		builder.errorMsg = "user workload monitoring config 'retention' cannot be empty"
+
+		return builder
+	}
+
+	builder.getPrometheusConfig().Retention = retention
+
+	return builder
+}
+
+// WithPrometheusExternalLabels sets the labels the user workload Prometheus adds to every series and alert.
+func (builder *UserWorkloadConfigBuilder) WithPrometheusExternalLabels(
+	externalLabels map[string]string) *UserWorkloadConfigBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	klog.V(100).Infof("Setting user workload prometheus external labels to %v", externalLabels)
+
+	if len(externalLabels) == 0 {
+		klog.V(100).Info("The user workload prometheus external labels are empty")
+
+		builder.errorMsg = "user workload monitoring config 'externalLabels' cannot be empty"
+
+		return builder
+	}
+
+	builder.getPrometheusConfig().ExternalLabels = externalLabels
+
+	return builder
+}
+
+// WithNamespacesWithoutLabelEnforcement sets the namespaces whose alerting and recording rules may query metrics of
+// other namespaces, since the namespace label is not enforced on them.
+func (builder *UserWorkloadConfigBuilder) WithNamespacesWithoutLabelEnforcement(
+	nsnames ...string) *UserWorkloadConfigBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	klog.V(100).Infof("Setting user workload namespaces without label enforcement to %v", nsnames)
+
+	if len(nsnames) == 0 {
+		klog.V(100).Info("The user workload namespaces without label enforcement are empty")
+
+		builder.errorMsg = "user workload monitoring config 'nsnames' cannot be empty"
+
+		return builder
+	}
+
+	builder.Definition.NamespacesWithoutLabelEnforcement = nsnames
+
+	return builder
+}
+
+// Apply merges the Definition into the settings of the user-workload-monitoring-config configmap, creating it if it
+// does not exist. Settings not represented in the Definition are kept.
+func (builder *UserWorkloadConfigBuilder) Apply() (*UserWorkloadConfigBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	klog.V(100).Infof("Applying user workload monitoring config %s in namespace %s",
+		UserWorkloadMonitoringConfigName, UserWorkloadMonitoringNamespace)
+
+	err := mergeMonitoringConfig(builder.configMapBuilder, builder.Definition)
+	if err != nil {
+		return builder, err
+	}
+
+	if builder.configMapBuilder.Exists() {
+		_, err = builder.configMapBuilder.Update()
+	} else {
+		_, err = builder.configMapBuilder.Create()
+	}
+
+	return builder, err
+}
+
+// SetUserWorkloadMonitoringEnabled sets enableUserWorkload in the cluster-monitoring-config configmap, creating it if
+// it does not exist, which deploys or removes the user workload monitoring stack. Other settings of the configmap are
+// kept.
+func SetUserWorkloadMonitoringEnabled(apiClient *clients.Settings, enabled bool) error {
+	if apiClient == nil {
+		klog.V(100).Info("The apiClient of the cluster monitoring config is nil")
+
+		return fmt.Errorf("cluster monitoring config 'apiClient' cannot be nil")
+	}
+
+	klog.V(100).Infof("Setting enableUserWorkload in cluster monitoring config to %t", enabled)
+
+	configMapBuilder := configmap.NewBuilder(apiClient, ClusterMonitoringConfigName, ClusterMonitoringNamespace)
+	if err := configMapBuilder.GetError(); err != nil {
+		return err
+	}
+
+	exists := configMapBuilder.Exists()
+	if exists {
+		configMapBuilder.Definition = configMapBuilder.Object
+	}
+
+	err := mergeMonitoringConfig(configMapBuilder, map[string]any{"enableUserWorkload": enabled})
+	if err != nil {
+		return err
+	}
+
+	if exists {
+		_, err = configMapBuilder.Update()
+	} else {
+		_, err = configMapBuilder.Create()
+	}
+
+	return err
+}
+
+// IsUserWorkloadMonitoringEnabled returns whether enableUserWorkload is set in the cluster-monitoring-config
+// configmap. It returns false without an error if the configmap does not exist.
+func IsUserWorkloadMonitoringEnabled(apiClient *clients.Settings) (bool, error) {
+	if apiClient == nil {
+		klog.V(100).Info("The apiClient of the cluster monitoring config is nil")
+
+		return false, fmt.Errorf("cluster monitoring config 'apiClient' cannot be nil")
+	}
+
+	configMapBuilder := configmap.NewBuilder(apiClient, ClusterMonitoringConfigName, ClusterMonitoringNamespace)
+	if err := configMapBuilder.GetError(); err != nil {
+		return false, err
+	}
+
+	if !configMapBuilder.Exists() {
+		return false, nil
+	}
+
+	var config struct {
+		EnableUserWorkload bool `json:"enableUserWorkload"`
+	}
+
+	err := yaml.Unmarshal([]byte(configMapBuilder.Object.Data[monitoringConfigKey]), &config)
+	if err != nil {
+		return false, fmt.Errorf("failed to parse %s of %s: %w", monitoringConfigKey, ClusterMonitoringConfigName, err)
+	}
+
+	return config.EnableUserWorkload, nil
+}
+
+// getPrometheusConfig returns the Prometheus settings of the Definition, initializing them if needed.
+func (builder *UserWorkloadConfigBuilder) getPrometheusConfig() *UserWorkloadPrometheusConfig {
+	if builder.Definition.Prometheus == nil {
+		builder.Definition.Prometheus = &UserWorkloadPrometheusConfig{}
+	}
+
+	return builder.Definition.Prometheus
+}
+
+// validate will check that the builder and builder definition are properly initialized before
+// accessing any member fields.
+func (builder *UserWorkloadConfigBuilder) validate() (bool, error) {
+	if builder == nil {
+		klog.V(100).Info("The user workload monitoring config builder is uninitialized")
+
+		return false, fmt.Errorf("error: received nil user workload monitoring config builder")
+	}
+
+	if builder.Definition == nil {
+		klog.V(100).Info("The user workload monitoring config is undefined")
+
+		return false, fmt.Errorf("user workload monitoring config 'Definition' cannot be nil")
+	}
+
+	if builder.errorMsg != "" {
+		klog.V(100).Infof("The user workload monitoring config builder has error message: %s", builder.errorMsg)
+
+		return false, fmt.Errorf("%s", builder.errorMsg)
+	}
+
+	return true, nil
+}
+
+// mergeMonitoringConfig merges settings into the config.yaml of the monitoring configmap definition. Maps are merged
+// recursively so that nested settings which are not part of settings are kept, while other values are replaced.
+func mergeMonitoringConfig(configMapBuilder *configmap.Builder, settings any) error {
+	current := make(map[string]any)
+
+	err := yaml.Unmarshal([]byte(configMapBuilder.Definition.Data[monitoringConfigKey]), &current)
+	if err != nil {
+		return fmt.Errorf("failed to parse %s of %s: %w", monitoringConfigKey, configMapBuilder.Definition.Name, err)
+	}
+
+	rawSettings, err := yaml.Marshal(settings)
+	if err != nil {
+		return fmt.Errorf("failed to marshal settings of %s: %w", configMapBuilder.Definition.Name, err)
+	}
+
+	updates := make(map[string]any)
+
+	err = yaml.Unmarshal(rawSettings, &updates)
+	if err != nil {
+		return fmt.Errorf("failed to parse settings of %s: %w", configMapBuilder.Definition.Name, err)
+	}
+
+	merged, err := yaml.Marshal(mergeMaps(current, updates))
+	if err != nil {
+		return fmt.Errorf("failed to marshal %s of %s: %w", monitoringConfigKey, configMapBuilder.Definition.Name, err)
+	}
+
+	if configMapBuilder.Definition.Data == nil {
+		configMapBuilder.Definition.Data = make(map[string]string)
+	}
+
+	configMapBuilder.Definition.Data[monitoringConfigKey] = string(merged)
+
+	return nil
+}
+
+// mergeMaps recursively sets the values of updates in current and returns it.
+func mergeMaps(current, updates map[string]any) map[string]any {
+	if current == nil {
+		current = make(map[string]any)
+	}
+
+	for key, value := range updates {
+		currentMap, currentIsMap := current[key].(map[string]any)
+		updateMap, updateIsMap := value.(map[string]any)
+
+		if currentIsMap && updateIsMap {
+			current[key] = mergeMaps(currentMap, updateMap)
+
+			continue
+		}
+
+		current[key] = value
+	}
+
+	return current
+}
@@ -0,0 +1,210 @@
+package monitoring
+
+import (
+	"context"
+	"testing"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const defaultUserWorkloadConfig = `alertmanager:
+  enabled: false
+  resources:
+    limits:
+      memory: 1Gi
+prometheus:
+  logLevel: debug
+`
+
+func TestNewUserWorkloadConfigBuilder(t *testing.T) {
+	testCases := []struct {
+		objects       []runtime.Object
+		expected      *UserWorkloadConfig
+		expectedError string
+	}{
+		{
+			expected: &UserWorkloadConfig{},
+		},
+		{
+			objects: []runtime.Object{buildDummyMonitoringConfigMap(
+				UserWorkloadMonitoringConfigName, UserWorkloadMonitoringNamespace, defaultUserWorkloadConfig)},
+			expected: &UserWorkloadConfig{
+				Alertmanager: &UserWorkloadAlertmanagerConfig{},
+				Prometheus:   &UserWorkloadPrometheusConfig{},
+			},
+		},
+		{
+			objects: []runtime.Object{buildDummyMonitoringConfigMap(
+				UserWorkloadMonitoringConfigName, UserWorkloadMonitoringNamespace, "alertmanager: [")},
+			expectedError: "failed to parse config.yaml: error converting YAML to JSON: " +
+				"yaml: line 1: did not find expected node content",
+		},
+	}
+
+	for _, testCase := range testCases {
+		builder := NewUserWorkloadConfigBuilder(buildTestClientWithMonitoringConfigMaps(testCase.objects...))
+
+		_, err := builder.validate()
+		if testCase.expectedError != "" {
+			assert.EqualError(t, err, testCase.expectedError)
+
+			continue
+		}
+
+		assert.NoError(t, err)
+		assert.Equal(t, testCase.expected, builder.Definition)
+	}
+
+	assert.Nil(t, NewUserWorkloadConfigBuilder(nil))
+}
+
+func TestUserWorkloadConfigApply(t *testing.T) {
+	testCases := []struct {
+		objects  []runtime.Object
+		expected string
+	}{
+		{
+			expected: `alertmanager:
+  enableAlertmanagerConfig: true
+  enabled: true
+namespacesWithoutLabelEnforcement:
+- test-namespace
+prometheus:
+  externalLabels:
+    cluster: test
+  retention: 24h
+`,
+		},
+		{
+			objects: []runtime.Object{buildDummyMonitoringConfigMap(
+				UserWorkloadMonitoringConfigName, UserWorkloadMonitoringNamespace, defaultUserWorkloadConfig)},
+			expected: `alertmanager:
+  enableAlertmanagerConfig: true
+  enabled: true
+  resources:
+    limits:
+      memory: 1Gi
+namespacesWithoutLabelEnforcement:
+- test-namespace
+prometheus:
+  externalLabels:
+    cluster: test
+  logLevel: debug
+  retention: 24h
+`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testSettings := buildTestClientWithMonitoringConfigMaps(testCase.objects...)
+
+		_, err := NewUserWorkloadConfigBuilder(testSettings).
+			WithAlertmanager(true, true).
+			WithPrometheusRetention("24h").
+			WithPrometheusExternalLabels(map[string]string{"cluster": "test"}).
+			WithNamespacesWithoutLabelEnforcement("test-namespace").
+			Apply()
+		assert.NoError(t, err)
+
+		assert.Equal(t, testCase.expected, getMonitoringConfig(
+			t, testSettings, UserWorkloadMonitoringConfigName, UserWorkloadMonitoringNamespace))
+	}
+}
+
+func TestUserWorkloadConfigWithInvalidSettings(t *testing.T) {
+	testSettings := buildTestClientWithMonitoringConfigMaps()
+
+	testCases := []struct {
+		builder       *UserWorkloadConfigBuilder
+		expectedError string
+	}{
+		{
+			builder:       NewUserWorkloadConfigBuilder(testSettings).WithPrometheusRetention(""),
+			expectedError: "user workload monitoring config 'retention' cannot be empty",
+		},
+		{
+			builder:       NewUserWorkloadConfigBuilder(testSettings).WithPrometheusExternalLabels(nil),
+			expectedError: "user workload monitoring config 'externalLabels' cannot be empty",
+		},
+		{
+			builder:       NewUserWorkloadConfigBuilder(testSettings).WithNamespacesWithoutLabelEnforcement(),
+			expectedError: "user workload monitoring config 'nsnames' cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		_, err := testCase.builder.WithAlertmanager(true, true).Apply()
+		assert.EqualError(t, err, testCase.expectedError)
+		assert.Nil(t, testCase.builder.Definition.Alertmanager)
+	}
+}
+
+func TestSetUserWorkloadMonitoringEnabled(t *testing.T) {
+	testCases := []struct {
+		objects  []runtime.Object
+		enabled  bool
+		expected string
+	}{
+		{
+			enabled:  true,
+			expected: "enableUserWorkload: true\n",
+		},
+		{
+			objects: []runtime.Object{buildDummyMonitoringConfigMap(
+				ClusterMonitoringConfigName, ClusterMonitoringNamespace, "enableUserWorkload: true\ntelemeterClient:\n"+
+					"  enabled: false\n")},
+			enabled:  false,
+			expected: "enableUserWorkload: false\ntelemeterClient:\n  enabled: false\n",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testSettings := buildTestClientWithMonitoringConfigMaps(testCase.objects...)
+
+		err := SetUserWorkloadMonitoringEnabled(testSettings, testCase.enabled)
+		assert.NoError(t, err)
+		assert.Equal(t, testCase.expected, getMonitoringConfig(
+			t, testSettings, ClusterMonitoringConfigName, ClusterMonitoringNamespace))
+
+		enabled, err := IsUserWorkloadMonitoringEnabled(testSettings)
+		assert.NoError(t, err)
+		assert.Equal(t, testCase.enabled, enabled)
+	}
+
+	enabled, err := IsUserWorkloadMonitoringEnabled(buildTestClientWithMonitoringConfigMaps())
+	assert.NoError(t, err)
+	assert.False(t, enabled)
+
+	err = SetUserWorkloadMonitoringEnabled(nil, true)
+	assert.EqualError(t, err, "cluster monitoring config 'apiClient' cannot be nil")
+}
+
+func buildTestClientWithMonitoringConfigMaps(objects ...runtime.Object) *clients.Settings {
+	return clients.GetTestClients(clients.TestClientParams{
+		K8sMockObjects:  objects,
+		SchemeAttachers: []clients.SchemeAttacher{corev1.AddToScheme},
+	})
+}
+
+func buildDummyMonitoringConfigMap(name, nsname, config string) *corev1.ConfigMap {
+	return &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: nsname},
+		Data:       map[string]string{monitoringConfigKey: config},
+	}
+}
+
+func getMonitoringConfig(t *testing.T, apiClient *clients.Settings, name, nsname string) string {
+	t.Helper()
+
+	configMap := &corev1.ConfigMap{}
+
+	err := apiClient.Get(context.TODO(), runtimeclient.ObjectKey{Name: name, Namespace: nsname}, configMap)
+	assert.NoError(t, err)
+
+	return configMap.Data[monitoringConfigKey]
+}
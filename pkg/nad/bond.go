@@ -0,0 +1,261 @@
+package nad
+
+import (
+	"fmt"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	multus "gopkg.in/k8snetworkplumbingwg/multus-cni.v4/pkg/types"
+	"k8s.io/klog/v2"
+)
+
+const (
+	// DefaultBondInterface is the name of the bond interface created in the pod by a SriovBondBuilder NAD.
+	DefaultBondInterface = "bond0"
+	// minBondSlaves is the minimum number of SR-IOV networks a bond is created over.
+	minBondSlaves = 2
+)
+
+// SriovBondBuilder provides a struct to define a bond NAD over SR-IOV VFs. Each slave of the bond is a VF attached to
+// the pod by an SR-IOV network, so the bond is created in the pod from the slave interfaces.
+type SriovBondBuilder struct {
+	// nadBuilder is the NAD builder the bond configuration is applied to on Create.
+	nadBuilder *Builder
+	// bondPlugin is the bond plugin configuration of the NAD.
+	bondPlugin *MasterBondPlugin
+	// slaves are the SR-IOV networks the VFs enslaved to the bond are attached from.
+	slaves []*multus.NetworkSelectionElement
+	// bondInterface is the name of the bond interface in the pod.
+	bondInterface string
+	// Used to store the latest error message upon defining the bond. errorMsg is processed before the NAD is created.
+	errorMsg string
+}
+
+// NewSriovBondBuilder creates a new instance of SriovBondBuilder for a bond NAD with the provided name, namespace, and
+// bond mode. Slaves must be added using WithSlave before the NAD is created.
+func NewSriovBondBuilder(apiClient *clients.Settings, name, nsname, mode string) *SriovBondBuilder {
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	klog.V(100).Infof("Initializing new SriovBondBuilder structure with the following params: "+
+		"name: %s, namespace: %s, mode: %s", name, nsname, mode)
+
+	builder := &SriovBondBuilder{
+		nadBuilder:    NewBuilder(apiClient, name, nsname),
+		bondPlugin:    NewMasterBondPlugin(name, mode).WithLinksInContainer(true),
+		bondInterface: DefaultBondInterface,
+	}
+
+	if builder.nadBuilder == nil {
+		klog.V(100).Info("The NAD builder of the SriovBondBuilder is nil")
+
+		builder.errorMsg = "SriovBondBuilder cannot have nil apiClient"
+
+		return builder
+	}
+
+	if valid, err := builder.nadBuilder.validate(); !valid {
+		builder.errorMsg = err.Error()
+
+		return builder
+	}
+
+	if builder.bondPlugin.errorMsg != "" {
+		builder.errorMsg = builder.bondPlugin.errorMsg
+	}
+
+	return builder
+}
+
+// WithMiimon sets the MII link monitoring interval of the bond in milliseconds.
+func (builder *SriovBondBuilder) WithMiimon(miimon int) *SriovBondBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.bondPlugin.WithMiimon(miimon)
+	builder.errorMsg = builder.bondPlugin.errorMsg
+
+	return builder
+}
+
+// WithFailOverMac sets the fail_over_mac policy of the bond. Allowed values are 0 (none), 1 (active), and 2 (follow).
+func (builder *SriovBondBuilder) WithFailOverMac(failOverMac int) *SriovBondBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.bondPlugin.WithFailOverMac(failOverMac)
+	builder.errorMsg = builder.bondPlugin.errorMsg
+
+	return builder
+}
+
+// WithIPAM sets the IPAM configuration of the bond interface.
+func (builder *SriovBondBuilder) WithIPAM(ipam *IPAM) *SriovBondBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	builder.bondPlugin.WithIPAM(ipam)
+	builder.errorMsg = builder.bondPlugin.errorMsg
+
+	return builder
+}
+
+// WithMTU sets the MTU of the bond interface.
+func (builder *SriovBondBuilder) WithMTU(mtu int) *SriovBondBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	klog.V(100).Infof("Setting MTU of SriovBondBuilder %s to %d", builder.nadBuilder.Definition.Name, mtu)
+
+	if mtu < 1 || mtu > 9192 {
+		klog.V(100).Infof("The SriovBondBuilder MTU %d is invalid", mtu)
+
+		builder.errorMsg = fmt.Sprintf("invalid mtu size %d allowed mtu should be in range 1...9192", mtu)
+
+		return builder
+	}
+
+	builder.bondPlugin.masterPlugin.Mtu = mtu
+
+	return builder
+}
+
+// WithBondInterface sets the name of the bond interface in the pod. Defaults to bond0.
+func (builder *SriovBondBuilder) WithBondInterface(interfaceName string) *SriovBondBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	klog.V(100).Infof("Setting bond interface of SriovBondBuilder %s to %s", builder.nadBuilder.Definition.Name,
+		interfaceName)
+
+	if interfaceName == "" {
+		klog.V(100).Info("The SriovBondBuilder bond interface is empty")
+
+		builder.errorMsg = "SriovBondBuilder 'interfaceName' cannot be empty"
+
+		return builder
+	}
+
+	builder.bondInterface = interfaceName
+
+	return builder
+}
+
+// WithSlave adds a slave to the bond, which is a VF attached to the pod by the SR-IOV network with the provided name.
+// If nsname is empty, the namespace of the bond NAD is used. Slaves are named net1, net2, and so on in the pod, in the
+// order they are added. Slaves should use VF resources from different PFs so the bond survives a PF failure.
+func (builder *SriovBondBuilder) WithSlave(sriovNetworkName, nsname string) *SriovBondBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	klog.V(100).Infof("Adding slave network %s/%s to SriovBondBuilder %s", nsname, sriovNetworkName,
+		builder.nadBuilder.Definition.Name)
+
+	if sriovNetworkName == "" {
+		klog.V(100).Info("The SriovBondBuilder slave network name is empty")
+
+		builder.errorMsg = "SriovBondBuilder slave 'sriovNetworkName' cannot be empty"
+
+		return builder
+	}
+
+	if nsname == "" {
+		nsname = builder.nadBuilder.Definition.Namespace
+	}
+
+	builder.slaves = append(builder.slaves, &multus.NetworkSelectionElement{
+		Name:             sriovNetworkName,
+		Namespace:        nsname,
+		InterfaceRequest: fmt.Sprintf("net%d", len(builder.slaves)+1),
+	})
+
+	return builder
+}
+
+// GetSlaveInterfaces returns the names of the slave interfaces in the pod, in the order the slaves were added.
+func (builder *SriovBondBuilder) GetSlaveInterfaces() []string {
+	if valid, _ := builder.validate(); !valid {
+		return nil
+	}
+
+	var interfaces []string
+
+	for _, slave := range builder.slaves {
+		interfaces = append(interfaces, slave.InterfaceRequest)
+	}
+
+	return interfaces
+}
+
+// GetPodNetworks returns the network selection elements a pod must request to be attached to the bond. The slave
+// networks are listed before the bond so their interfaces exist when the bond is created.
+func (builder *SriovBondBuilder) GetPodNetworks() ([]*multus.NetworkSelectionElement, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	networks := make([]*multus.NetworkSelectionElement, 0, len(builder.slaves)+1)
+
+	for _, slave := range builder.slaves {
+		slaveCopy := *slave
+		networks = append(networks, &slaveCopy)
+	}
+
+	networks = append(networks, &multus.NetworkSelectionElement{
+		Name:             builder.nadBuilder.Definition.Name,
+		Namespace:        builder.nadBuilder.Definition.Namespace,
+		InterfaceRequest: builder.bondInterface,
+	})
+
+	return networks, nil
+}
+
+// Create creates the bond NAD on the cluster and returns the NAD builder. At least two slaves are required.
+func (builder *SriovBondBuilder) Create() (*Builder, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	klog.V(100).Infof("Creating bond NAD %s in namespace %s",
+		builder.nadBuilder.Definition.Name, builder.nadBuilder.Definition.Namespace)
+
+	if len(builder.slaves) < minBondSlaves {
+		klog.V(100).Infof("The SriovBondBuilder has %d slaves", len(builder.slaves))
+
+		return nil, fmt.Errorf("SriovBondBuilder requires at least %d slaves, got %d", minBondSlaves, len(builder.slaves))
+	}
+
+	var links []Link
+
+	for _, slave := range builder.slaves {
+		links = append(links, Link{Name: slave.InterfaceRequest})
+	}
+
+	masterPlugin, err := builder.bondPlugin.WithLinks(links).GetMasterPluginConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	return builder.nadBuilder.WithMasterPlugin(masterPlugin).Create()
+}
+
+// validate will check that the builder is properly initialized before accessing any member fields.
+func (builder *SriovBondBuilder) validate() (bool, error) {
+	if builder == nil {
+		klog.V(100).Info("The SriovBondBuilder is uninitialized")
+
+		return false, fmt.Errorf("error: received nil SriovBondBuilder")
+	}
+
+	if builder.errorMsg != "" {
+		klog.V(100).Infof("The SriovBondBuilder has error message: %s", builder.errorMsg)
+
+		return false, fmt.Errorf("%s", builder.errorMsg)
+	}
+
+	return true, nil
+}
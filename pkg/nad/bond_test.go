@@ -0,0 +1,273 @@
+package nad
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/stretchr/testify/assert"
+)
+
+const defaultBondName = "bondtest"
+
+func TestNewSriovBondBuilder(t *testing.T) {
+	testCases := []struct {
+		name          string
+		nsname        string
+		mode          string
+		client        bool
+		expectedError string
+	}{
+		{
+			name:          defaultBondName,
+			nsname:        defaultNetNsName,
+			mode:          "active-backup",
+			client:        true,
+			expectedError: "",
+		},
+		{
+			name:          defaultBondName,
+			nsname:        "",
+			mode:          "active-backup",
+			client:        true,
+			expectedError: nadNamespaceIsEmpty,
+		},
+		{
+			name:          defaultBondName,
+			nsname:        defaultNetNsName,
+			mode:          "invalid",
+			client:        true,
+			expectedError: "Bond mode type is not valid",
+		},
+		{
+			name:          defaultBondName,
+			nsname:        defaultNetNsName,
+			mode:          "active-backup",
+			client:        false,
+			expectedError: "SriovBondBuilder cannot have nil apiClient",
+		},
+	}
+
+	for _, testCase := range testCases {
+		var testSettings *clients.Settings
+
+		if testCase.client {
+			testSettings = buildTestClientWithDummyObject()
+		}
+
+		testBuilder := NewSriovBondBuilder(testSettings, testCase.name, testCase.nsname, testCase.mode)
+		assert.NotNil(t, testBuilder)
+		assert.Equal(t, testCase.expectedError, testBuilder.errorMsg)
+
+		if testCase.expectedError == "" {
+			assert.Equal(t, DefaultBondInterface, testBuilder.bondInterface)
+			assert.True(t, testBuilder.bondPlugin.masterPlugin.LinksInContainer)
+		}
+	}
+}
+
+func TestSriovBondWithMiimon(t *testing.T) {
+	testCases := []struct {
+		miimon        int
+		expectedError string
+	}{
+		{
+			miimon:        100,
+			expectedError: "",
+		},
+		{
+			miimon:        -1,
+			expectedError: "error adding incorrect miimon value to MasterBondPlugin",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidSriovBondTestBuilder().WithMiimon(testCase.miimon)
+		assert.Equal(t, testCase.expectedError, testBuilder.errorMsg)
+
+		if testCase.expectedError == "" {
+			assert.Equal(t, strconv.Itoa(testCase.miimon), testBuilder.bondPlugin.masterPlugin.Miimon)
+		}
+	}
+}
+
+func TestSriovBondWithMTU(t *testing.T) {
+	testCases := []struct {
+		mtu           int
+		expectedError string
+	}{
+		{
+			mtu:           9000,
+			expectedError: "",
+		},
+		{
+			mtu:           0,
+			expectedError: "invalid mtu size 0 allowed mtu should be in range 1...9192",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidSriovBondTestBuilder().WithMTU(testCase.mtu)
+		assert.Equal(t, testCase.expectedError, testBuilder.errorMsg)
+
+		if testCase.expectedError == "" {
+			assert.Equal(t, testCase.mtu, testBuilder.bondPlugin.masterPlugin.Mtu)
+		}
+	}
+}
+
+func TestSriovBondWithBondInterface(t *testing.T) {
+	testCases := []struct {
+		interfaceName string
+		expectedError string
+	}{
+		{
+			interfaceName: "bond1",
+			expectedError: "",
+		},
+		{
+			interfaceName: "",
+			expectedError: "SriovBondBuilder 'interfaceName' cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidSriovBondTestBuilder().WithBondInterface(testCase.interfaceName)
+		assert.Equal(t, testCase.expectedError, testBuilder.errorMsg)
+
+		if testCase.expectedError == "" {
+			assert.Equal(t, testCase.interfaceName, testBuilder.bondInterface)
+		}
+	}
+}
+
+func TestSriovBondWithSlave(t *testing.T) {
+	testCases := []struct {
+		networkName       string
+		nsname            string
+		expectedNamespace string
+		expectedError     string
+	}{
+		{
+			networkName:       "sriov-net-pf1",
+			nsname:            "sriov-ns",
+			expectedNamespace: "sriov-ns",
+			expectedError:     "",
+		},
+		{
+			networkName:       "sriov-net-pf1",
+			nsname:            "",
+			expectedNamespace: defaultNetNsName,
+			expectedError:     "",
+		},
+		{
+			networkName:   "",
+			nsname:        "sriov-ns",
+			expectedError: "SriovBondBuilder slave 'sriovNetworkName' cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidSriovBondTestBuilder().WithSlave(testCase.networkName, testCase.nsname)
+		assert.Equal(t, testCase.expectedError, testBuilder.errorMsg)
+
+		if testCase.expectedError == "" {
+			assert.Len(t, testBuilder.slaves, 1)
+			assert.Equal(t, testCase.networkName, testBuilder.slaves[0].Name)
+			assert.Equal(t, testCase.expectedNamespace, testBuilder.slaves[0].Namespace)
+			assert.Equal(t, "net1", testBuilder.slaves[0].InterfaceRequest)
+		}
+	}
+}
+
+func TestSriovBondGetSlaveInterfaces(t *testing.T) {
+	testBuilder := buildValidSriovBondTestBuilder().WithSlave("sriov-net-pf1", "").WithSlave("sriov-net-pf2", "")
+	assert.Equal(t, []string{"net1", "net2"}, testBuilder.GetSlaveInterfaces())
+
+	testBuilder = buildValidSriovBondTestBuilder().WithSlave("", "")
+	assert.Nil(t, testBuilder.GetSlaveInterfaces())
+}
+
+func TestSriovBondGetPodNetworks(t *testing.T) {
+	testCases := []struct {
+		testBuilder   *SriovBondBuilder
+		expectedError error
+	}{
+		{
+			testBuilder:   buildValidSriovBondTestBuilder().WithSlave("sriov-net-pf1", "").WithSlave("sriov-net-pf2", ""),
+			expectedError: nil,
+		},
+		{
+			testBuilder:   buildValidSriovBondTestBuilder().WithBondInterface(""),
+			expectedError: fmt.Errorf("SriovBondBuilder 'interfaceName' cannot be empty"),
+		},
+		{
+			testBuilder:   nil,
+			expectedError: fmt.Errorf("error: received nil SriovBondBuilder"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		networks, err := testCase.testBuilder.GetPodNetworks()
+		assert.Equal(t, testCase.expectedError, err)
+
+		if testCase.expectedError == nil {
+			assert.Len(t, networks, 3)
+			assert.Equal(t, "sriov-net-pf1", networks[0].Name)
+			assert.Equal(t, "net1", networks[0].InterfaceRequest)
+			assert.Equal(t, "sriov-net-pf2", networks[1].Name)
+			assert.Equal(t, "net2", networks[1].InterfaceRequest)
+			assert.Equal(t, defaultBondName, networks[2].Name)
+			assert.Equal(t, defaultNetNsName, networks[2].Namespace)
+			assert.Equal(t, DefaultBondInterface, networks[2].InterfaceRequest)
+		}
+	}
+}
+
+func TestSriovBondCreate(t *testing.T) {
+	testCases := []struct {
+		testBuilder   *SriovBondBuilder
+		expectedError error
+	}{
+		{
+			testBuilder: buildValidSriovBondTestBuilder().
+				WithMiimon(100).WithSlave("sriov-net-pf1", "").WithSlave("sriov-net-pf2", ""),
+			expectedError: nil,
+		},
+		{
+			testBuilder:   buildValidSriovBondTestBuilder().WithSlave("sriov-net-pf1", ""),
+			expectedError: fmt.Errorf("SriovBondBuilder requires at least 2 slaves, got 1"),
+		},
+		{
+			testBuilder:   buildValidSriovBondTestBuilder().WithMiimon(-1),
+			expectedError: fmt.Errorf("error adding incorrect miimon value to MasterBondPlugin"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		nadBuilder, err := testCase.testBuilder.Create()
+		assert.Equal(t, testCase.expectedError, err)
+
+		if testCase.expectedError == nil {
+			assert.True(t, nadBuilder.Exists())
+
+			var config MasterPlugin
+
+			err = json.Unmarshal([]byte(nadBuilder.Object.Spec.Config), &config)
+			assert.Nil(t, err)
+			assert.Equal(t, "bond", config.Type)
+			assert.Equal(t, "active-backup", config.Mode)
+			assert.Equal(t, "100", config.Miimon)
+			assert.True(t, config.LinksInContainer)
+			assert.Equal(t, []Link{{Name: "net1"}, {Name: "net2"}}, config.Links)
+		}
+	}
+}
+
+func buildValidSriovBondTestBuilder() *SriovBondBuilder {
+	return NewSriovBondBuilder(
+		clients.GetTestClients(clients.TestClientParams{SchemeAttachers: testSchemes}),
+		defaultBondName, defaultNetNsName, "active-backup")
+}
@@ -37,6 +37,8 @@ type Builder struct {
 //
 // return value:    the created Builder.
 func NewBuilder(apiClient *clients.Settings, name, nsname string) *Builder {
+	nsname = apiClient.ResolveNamespace(nsname)
+
 	klog.V(100).Infof(
 		"Initializing new NetworkAttachmentDefinition structure with the following params: "+
 			"name: %s, namespace: %s",
@@ -86,6 +88,8 @@ func NewBuilder(apiClient *clients.Settings, name, nsname string) *Builder {
 
 // Pull pulls existing networkattachmentdefinition from cluster.
 func Pull(apiClient *clients.Settings, name, nsname string) (*Builder, error) {
+	nsname = apiClient.ResolveNamespace(nsname)
+
 	klog.V(100).Infof(
 		"Pulling existing networkattachmentdefinition name %s under namespace %s from cluster", name, nsname)
 
@@ -164,6 +164,13 @@ func TestNADNewNetworkBuilder(t *testing.T) {
 			assert.Equal(t, testCase.expectedErrorText, testNetworkStructure.errorMsg)
 		}
 	}
+
+	namespacedSettings, err := clients.GetTestClients(clients.TestClientParams{}).NamespacedClient(defaultNetNsName)
+	assert.Nil(t, err)
+
+	testNetworkStructure := NewBuilder(namespacedSettings, defaultNetName, "")
+	assert.Empty(t, testNetworkStructure.errorMsg)
+	assert.Equal(t, defaultNetNsName, testNetworkStructure.Definition.Namespace)
 }
 
 func TestNADGet(t *testing.T) {
@@ -12,6 +12,8 @@ import (
 
 // List returns NADs inventory in the given namespace.
 func List(apiClient *clients.Settings, nsname string) ([]*Builder, error) {
+	nsname = apiClient.ResolveNamespace(nsname)
+
 	if apiClient == nil {
 		klog.V(100).Info("The apiClient is empty")
 
@@ -0,0 +1,144 @@
+// Package names provides helpers for generating resource names that are valid DNS-1123 labels and do not collide
+// between tests, whether they run in parallel in the same process or in separate runs against the same cluster.
+package names
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"sync"
+
+	utilrand "k8s.io/apimachinery/pkg/util/rand"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/klog/v2"
+)
+
+const (
+	// MaxLength is the maximum length of a DNS-1123 label, which most resource names must be.
+	MaxLength = validation.DNS1123LabelMaxLength
+	// RandomSuffixLength is the length of the random suffix appended by GenerateName.
+	RandomSuffixLength = 5
+	// hashLength is the number of hex characters of the hash appended by TruncateWithHash.
+	hashLength = 8
+	// defaultName is used when a prefix contains no valid characters.
+	defaultName = "test"
+)
+
+var (
+	// runSuffix is shared by all names generated using GenerateRunName in this process.
+	runSuffix = utilrand.String(RandomSuffixLength)
+
+	generatedNamesMutex sync.Mutex
+	generatedNames      = map[string]struct{}{}
+)
+
+// RunSuffix returns the random suffix generated once per process. It may be used to tag all resources created by a
+// single test run so they can be identified and cleaned up together.
+func RunSuffix() string {
+	return runSuffix
+}
+
+// GenerateName returns a name made of the sanitized prefix followed by a random suffix, for example test-ns-x7k2p. The
+// prefix is truncated so the name is a valid DNS-1123 label. The returned name is never returned again by GenerateName
+// in the same process.
+func GenerateName(prefix string) string {
+	base := withSuffixSpace(prefix, RandomSuffixLength)
+
+	generatedNamesMutex.Lock()
+	defer generatedNamesMutex.Unlock()
+
+	for {
+		name := base + "-" + utilrand.String(RandomSuffixLength)
+
+		if _, found := generatedNames[name]; found {
+			klog.V(100).Infof("Generated name %s was already used, generating a new one", name)
+
+			continue
+		}
+
+		generatedNames[name] = struct{}{}
+
+		return name
+	}
+}
+
+// GenerateRunName returns a name made of the sanitized prefix followed by the per-process RunSuffix. Unlike
+// GenerateName, the same prefix always yields the same name within a run, so it may be used to refer to a resource from
+// different places in a suite.
+func GenerateRunName(prefix string) string {
+	return withSuffixSpace(prefix, len(runSuffix)) + "-" + runSuffix
+}
+
+// Sanitize converts name into a valid DNS-1123 label. Uppercase letters are lowercased, any other invalid character is
+// replaced with a dash, leading and trailing dashes are removed, and the result is truncated to MaxLength. If no valid
+// characters remain, "test" is returned.
+func Sanitize(name string) string {
+	var builder strings.Builder
+
+	for _, char := range strings.ToLower(name) {
+		if (char >= 'a' && char <= 'z') || (char >= '0' && char <= '9') {
+			builder.WriteRune(char)
+		} else {
+			builder.WriteRune('-')
+		}
+	}
+
+	sanitized := Truncate(builder.String(), MaxLength)
+	if sanitized == "" {
+		return defaultName
+	}
+
+	return sanitized
+}
+
+// Truncate shortens name to at most maxLength characters and removes any leading or trailing dashes or dots so the
+// result remains a valid DNS-1123 label or subdomain.
+func Truncate(name string, maxLength int) string {
+	if maxLength < 0 {
+		maxLength = 0
+	}
+
+	if len(name) > maxLength {
+		name = name[:maxLength]
+	}
+
+	return strings.Trim(name, "-.")
+}
+
+// TruncateWithHash shortens name to at most maxLength characters. Unlike Truncate, names sharing a long common prefix
+// remain distinct since the end of the truncated name is replaced by a hash of the full name. Names that already fit
+// are returned unchanged.
+func TruncateWithHash(name string, maxLength int) string {
+	if len(name) <= maxLength {
+		return name
+	}
+
+	sum := sha256.Sum256([]byte(name))
+	hash := hex.EncodeToString(sum[:])[:hashLength]
+
+	if maxLength <= hashLength {
+		return hash[:max(maxLength, 0)]
+	}
+
+	truncated := Truncate(name, maxLength-hashLength-1)
+	if truncated == "" {
+		return hash
+	}
+
+	return truncated + "-" + hash
+}
+
+// IsValid returns true if name is a valid DNS-1123 label.
+func IsValid(name string) bool {
+	return len(validation.IsDNS1123Label(name)) == 0
+}
+
+// withSuffixSpace sanitizes prefix and truncates it so that a dash and a suffix of suffixLength still fit in MaxLength.
+func withSuffixSpace(prefix string, suffixLength int) string {
+	base := Truncate(Sanitize(prefix), MaxLength-suffixLength-1)
+	if base == "" {
+		return defaultName
+	}
+
+	return base
+}
@@ -0,0 +1,165 @@
+package names
+
+import (
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGenerateName(t *testing.T) {
+	testCases := []struct {
+		prefix         string
+		expectedPrefix string
+	}{
+		{
+			prefix:         "test-namespace",
+			expectedPrefix: "test-namespace-",
+		},
+		{
+			prefix:         "Test_Namespace",
+			expectedPrefix: "test-namespace-",
+		},
+		{
+			prefix:         "",
+			expectedPrefix: "test-",
+		},
+		{
+			prefix:         strings.Repeat("a", 100),
+			expectedPrefix: strings.Repeat("a", MaxLength-RandomSuffixLength-1) + "-",
+		},
+	}
+
+	for _, testCase := range testCases {
+		name := GenerateName(testCase.prefix)
+		assert.True(t, strings.HasPrefix(name, testCase.expectedPrefix), name)
+		assert.Len(t, name, len(testCase.expectedPrefix)+RandomSuffixLength)
+		assert.True(t, IsValid(name), name)
+	}
+}
+
+func TestGenerateNameUnique(t *testing.T) {
+	const count = 1000
+
+	var (
+		waitGroup sync.WaitGroup
+		mutex     sync.Mutex
+	)
+
+	generated := map[string]struct{}{}
+
+	for range count {
+		waitGroup.Add(1)
+
+		go func() {
+			defer waitGroup.Done()
+
+			name := GenerateName("parallel")
+
+			mutex.Lock()
+			defer mutex.Unlock()
+
+			generated[name] = struct{}{}
+		}()
+	}
+
+	waitGroup.Wait()
+
+	assert.Len(t, generated, count)
+}
+
+func TestGenerateRunName(t *testing.T) {
+	name := GenerateRunName("test-run")
+	assert.Equal(t, "test-run-"+RunSuffix(), name)
+	assert.Equal(t, name, GenerateRunName("test-run"))
+	assert.Len(t, RunSuffix(), RandomSuffixLength)
+
+	longName := GenerateRunName(strings.Repeat("b", 100))
+	assert.Len(t, longName, MaxLength)
+	assert.True(t, IsValid(longName))
+}
+
+func TestSanitize(t *testing.T) {
+	testCases := []struct {
+		name     string
+		expected string
+	}{
+		{
+			name:     "valid-name",
+			expected: "valid-name",
+		},
+		{
+			name:     "Invalid_Name.With Spaces",
+			expected: "invalid-name-with-spaces",
+		},
+		{
+			name:     "--leading-and-trailing--",
+			expected: "leading-and-trailing",
+		},
+		{
+			name:     "___",
+			expected: "test",
+		},
+		{
+			name:     strings.Repeat("c", 70),
+			expected: strings.Repeat("c", MaxLength),
+		},
+	}
+
+	for _, testCase := range testCases {
+		assert.Equal(t, testCase.expected, Sanitize(testCase.name))
+	}
+}
+
+func TestTruncate(t *testing.T) {
+	testCases := []struct {
+		name      string
+		maxLength int
+		expected  string
+	}{
+		{
+			name:      "short",
+			maxLength: 10,
+			expected:  "short",
+		},
+		{
+			name:      "truncated-name",
+			maxLength: 10,
+			expected:  "truncated",
+		},
+		{
+			name:      "name.with.dots",
+			maxLength: 5,
+			expected:  "name",
+		},
+		{
+			name:      "name",
+			maxLength: -1,
+			expected:  "",
+		},
+	}
+
+	for _, testCase := range testCases {
+		assert.Equal(t, testCase.expected, Truncate(testCase.name, testCase.maxLength))
+	}
+}
+
+func TestTruncateWithHash(t *testing.T) {
+	shortName := "short-name"
+	assert.Equal(t, shortName, TruncateWithHash(shortName, MaxLength))
+
+	firstName := strings.Repeat("d", 70) + "-first"
+	secondName := strings.Repeat("d", 70) + "-second"
+
+	firstTruncated := TruncateWithHash(firstName, MaxLength)
+	secondTruncated := TruncateWithHash(secondName, MaxLength)
+
+	assert.LessOrEqual(t, len(firstTruncated), MaxLength)
+	assert.LessOrEqual(t, len(secondTruncated), MaxLength)
+	assert.NotEqual(t, firstTruncated, secondTruncated)
+	assert.True(t, IsValid(firstTruncated))
+	assert.Equal(t, firstTruncated, TruncateWithHash(firstName, MaxLength))
+
+	assert.Len(t, TruncateWithHash(firstName, 4), 4)
+}
@@ -28,6 +28,8 @@ type Builder struct {
 	common.EmbeddableDeleter[corev1.Namespace, *corev1.Namespace]
 	common.EmbeddableUpdater[corev1.Namespace, Builder, *corev1.Namespace, *Builder]
 	common.EmbeddableWithOptions[corev1.Namespace, Builder, *corev1.Namespace, *Builder, AdditionalOptions]
+	common.EmbeddableConditionGetter[corev1.NamespaceCondition, corev1.Namespace, *corev1.Namespace]
+	common.EmbeddablePhaseGetter[corev1.Namespace, *corev1.Namespace]
 }
 
 // AdditionalOptions additional options for namespace object.
@@ -39,6 +41,8 @@ func (builder *Builder) AttachMixins() {
 	builder.EmbeddableDeleter.SetBase(builder)
 	builder.EmbeddableUpdater.SetBase(builder)
 	builder.EmbeddableWithOptions.SetBase(builder)
+	builder.EmbeddableConditionGetter.SetBase(builder)
+	builder.EmbeddablePhaseGetter.SetBase(builder)
 }
 
 // GetGVK returns the Namespace GVK for this builder.
@@ -73,6 +77,12 @@ func (builder *Builder) WithLabel(key string, value string) *Builder {
 		return builder
 	}
 
+	if err := common.ValidateLabel(key, value); err != nil {
+		builder.SetError(err)
+
+		return builder
+	}
+
 	if builder.Definition.Labels == nil {
 		builder.Definition.Labels = map[string]string{}
 	}
@@ -237,3 +247,10 @@ func (builder *Builder) hasOnlyDefaultConfigMaps(objList *unstructured.Unstructu
 
 	return true, nil
 }
+
+// GetGVR returns Namespace's GroupVersionResource which could be used for Clean function.
+func GetGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group: "", Version: "v1", Resource: "namespaces",
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	commonerrors "github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common/errors"
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common/testhelper"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -56,6 +57,12 @@ func TestWithOptions(t *testing.T) {
 	testhelper.NewWithOptionsTestConfig(newNamespaceCommonTestConfig()).ExecuteTests(t)
 }
 
+func TestWithDefaults(t *testing.T) {
+	t.Parallel()
+
+	testhelper.NewWithDefaultsTestConfig(newNamespaceCommonTestConfig()).ExecuteTests(t)
+}
+
 func TestWithLabel(t *testing.T) {
 	t.Parallel()
 
@@ -64,6 +71,7 @@ func TestWithLabel(t *testing.T) {
 		key           string
 		value         string
 		expectedError string
+		invalidLabel  bool
 		builder       func() *Builder
 	}{
 		{
@@ -79,6 +87,20 @@ func TestWithLabel(t *testing.T) {
 			expectedError: "'key' cannot be empty",
 			builder:       func() *Builder { return buildValidNamespaceTestBuilder(newNamespaceTestClient()) },
 		},
+		{
+			name:         "invalid key",
+			key:          "test key",
+			value:        "test-value",
+			invalidLabel: true,
+			builder:      func() *Builder { return buildValidNamespaceTestBuilder(newNamespaceTestClient()) },
+		},
+		{
+			name:         "invalid value",
+			key:          "test-key",
+			value:        "-test-value",
+			invalidLabel: true,
+			builder:      func() *Builder { return buildValidNamespaceTestBuilder(newNamespaceTestClient()) },
+		},
 		{
 			name:    "invalid builder short circuits",
 			key:     "test-key",
@@ -97,7 +119,10 @@ func TestWithLabel(t *testing.T) {
 			result := testBuilder.WithLabel(testCase.key, testCase.value)
 			require.Same(t, testBuilder, result)
 
-			if testCase.expectedError != "" {
+			if testCase.invalidLabel {
+				assert.True(t, commonerrors.IsInvalidLabel(result.GetError()))
+				assert.NotContains(t, result.Definition.Labels, testCase.key)
+			} else if testCase.expectedError != "" {
 				require.EqualError(t, result.GetError(), testCase.expectedError)
 			} else if result.GetError() == nil {
 				assert.Equal(t, testCase.value, result.Definition.Labels[testCase.key])
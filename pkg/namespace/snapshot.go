@@ -0,0 +1,353 @@
+package namespace
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"slices"
+	"time"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/logging"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/klog/v2"
+)
+
+// snapshotPollInterval is how often deleted objects are checked while waiting for them to be removed during a restore.
+const snapshotPollInterval = time.Second
+
+var (
+	// snapshotVolatileMetadataFields are the metadata fields set by the API server, which are dropped from snapshotted
+	// objects so they can be recreated.
+	snapshotVolatileMetadataFields = []string{
+		"uid", "resourceVersion", "generation", "creationTimestamp", "deletionTimestamp", "deletionGracePeriodSeconds",
+		"managedFields", "selfLink",
+	}
+	// snapshotDefaultObjects are the objects created in every namespace by the cluster, which are neither snapshotted
+	// nor deleted by a restore.
+	snapshotDefaultObjects = map[string][]string{
+		"configmaps":      {"kube-root-ca.crt", "openshift-service-ca.crt"},
+		"serviceaccounts": {"builder", "default", "deployer"},
+	}
+)
+
+// Snapshot holds the objects of selected resources in a namespace at a point in time, so the namespace can later be
+// reset to that point using Restore without deleting it. Objects owned by a controller, such as the pods of a
+// deployment, and the objects created in every namespace by the cluster are not part of the snapshot, since they are
+// recreated by their owners.
+type Snapshot struct {
+	// Namespace is the namespace the objects were snapshotted from.
+	Namespace string `json:"namespace"`
+	// Resources are the snapshotted resources, in the order their objects are recreated.
+	Resources []SnapshotResource `json:"resources"`
+	// apiClient is used to restore the snapshot.
+	apiClient dynamic.Interface
+}
+
+// SnapshotResource holds the snapshotted objects of a single resource.
+type SnapshotResource struct {
+	// GVR is the group, version, and resource of the objects.
+	GVR schema.GroupVersionResource `json:"gvr"`
+	// Objects are the snapshotted objects, sorted by name, without their status and the metadata set by the API
+	// server.
+	Objects []*unstructured.Unstructured `json:"objects"`
+}
+
+// TakeSnapshot returns a snapshot of the objects of the resources in the namespace. Resources are restored in the
+// order they are provided, so resources referenced by others, such as configmaps, should come first.
+func (builder *Builder) TakeSnapshot(resources ...schema.GroupVersionResource) (*Snapshot, error) {
+	if err := common.Validate(builder); err != nil {
+		return nil, err
+	}
+
+	klog.V(100).Infof("Taking snapshot of resources %v in namespace %s", resources, builder.Definition.Name)
+
+	if len(resources) == 0 {
+		return nil, fmt.Errorf("failed to snapshot empty list of resources in namespace %s", builder.Definition.Name)
+	}
+
+	if !builder.Exists() {
+		return nil, fmt.Errorf("failed to snapshot resources of non-existent namespace %s", builder.Definition.Name)
+	}
+
+	dynamicClient, ok := builder.GetClient().(dynamic.Interface)
+	if !ok {
+		return nil, fmt.Errorf("client does not support dynamic resource operations")
+	}
+
+	snapshot := &Snapshot{
+		Namespace: builder.Definition.Name,
+		apiClient: dynamicClient,
+	}
+
+	for _, resource := range resources {
+		objects, err := snapshot.listObjects(resource)
+		if err != nil {
+			return nil, err
+		}
+
+		snapshotResource := SnapshotResource{GVR: resource}
+
+		for _, object := range objects {
+			snapshotResource.Objects = append(snapshotResource.Objects, normalizeSnapshotObject(object))
+		}
+
+		snapshot.Resources = append(snapshot.Resources, snapshotResource)
+	}
+
+	return snapshot, nil
+}
+
+// LoadSnapshot reads a snapshot saved using Snapshot.SaveToFile, so it can be restored using the client.
+func LoadSnapshot(apiClient *clients.Settings, path string) (*Snapshot, error) {
+	if apiClient == nil {
+		return nil, fmt.Errorf("snapshot 'apiClient' cannot be nil")
+	}
+
+	klog.V(100).Infof("Loading namespace snapshot from %s", path)
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read snapshot file %s: %w", path, err)
+	}
+
+	snapshot := &Snapshot{}
+
+	err = json.Unmarshal(data, snapshot)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse snapshot file %s: %w", path, err)
+	}
+
+	if snapshot.Namespace == "" || len(snapshot.Resources) == 0 {
+		return nil, fmt.Errorf("snapshot file %s has no namespace or resources", path)
+	}
+
+	snapshot.apiClient = apiClient
+
+	return snapshot, nil
+}
+
+// SaveToFile writes the snapshot to the file as JSON, so it can be restored by a later run using LoadSnapshot.
+func (snapshot *Snapshot) SaveToFile(path string) error {
+	if err := snapshot.validate(); err != nil {
+		return err
+	}
+
+	klog.V(100).Infof("Saving snapshot of namespace %s to %s", snapshot.Namespace, path)
+
+	data, err := json.Marshal(snapshot)
+	if err != nil {
+		return fmt.Errorf("failed to marshal snapshot of namespace %s: %w", snapshot.Namespace, err)
+	}
+
+	err = os.WriteFile(path, data, 0o600)
+	if err != nil {
+		return fmt.Errorf("failed to write snapshot file %s: %w", path, err)
+	}
+
+	return nil
+}
+
+// Restore resets the snapshotted resources of the namespace to the snapshot. Objects created since the snapshot are
+// deleted, objects changed since the snapshot are deleted and recreated, and objects deleted since the snapshot are
+// recreated. Unchanged objects are left as is, so they keep their IPs, volumes and other allocated resources. Timeout
+// applies to waiting for the deleted objects to be removed.
+func (snapshot *Snapshot) Restore(timeout time.Duration) error {
+	if err := snapshot.validate(); err != nil {
+		return err
+	}
+
+	klog.V(100).Infof("Restoring snapshot of namespace %s", snapshot.Namespace)
+
+	// Objects are removed in the reverse order they are recreated in, so objects are removed before the objects they
+	// reference.
+	toCreate := make([][]*unstructured.Unstructured, len(snapshot.Resources))
+
+	for idx, snapshotResource := range slices.Backward(snapshot.Resources) {
+		created, err := snapshot.removeChangedObjects(snapshotResource, timeout)
+		if err != nil {
+			return err
+		}
+
+		toCreate[idx] = created
+	}
+
+	for idx, snapshotResource := range snapshot.Resources {
+		resource := snapshotResource.GVR
+
+		for _, object := range toCreate[idx] {
+			klog.V(100).Infof("Recreating %s %s in namespace %s", resource.Resource, object.GetName(), snapshot.Namespace)
+
+			_, err := snapshot.apiClient.Resource(resource).Namespace(snapshot.Namespace).Create(
+				context.TODO(), object.DeepCopy(), metav1.CreateOptions{})
+			if err != nil {
+				return fmt.Errorf("failed to recreate %s %s in namespace %s: %w",
+					resource.Resource, object.GetName(), snapshot.Namespace, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// removeChangedObjects deletes the objects of the resource that were created or changed since the snapshot and waits
+// up to timeout for them to be removed. It returns the snapshotted objects of the resource that must be recreated.
+func (snapshot *Snapshot) removeChangedObjects(
+	snapshotResource SnapshotResource, timeout time.Duration) ([]*unstructured.Unstructured, error) {
+	resource := snapshotResource.GVR
+
+	current, err := snapshot.listObjects(resource)
+	if err != nil {
+		return nil, err
+	}
+
+	currentByName := make(map[string]*unstructured.Unstructured, len(current))
+	for _, object := range current {
+		currentByName[object.GetName()] = object
+	}
+
+	var (
+		toCreate []*unstructured.Unstructured
+		toDelete []string
+	)
+
+	for _, object := range snapshotResource.Objects {
+		currentObject, exists := currentByName[object.GetName()]
+		delete(currentByName, object.GetName())
+
+		if exists && equality.Semantic.DeepEqual(normalizeSnapshotObject(currentObject), object) {
+			continue
+		}
+
+		if exists {
+			toDelete = append(toDelete, object.GetName())
+		}
+
+		toCreate = append(toCreate, object)
+	}
+
+	for name := range currentByName {
+		toDelete = append(toDelete, name)
+	}
+
+	slices.Sort(toDelete)
+
+	resourceClient := snapshot.apiClient.Resource(resource).Namespace(snapshot.Namespace)
+
+	for _, name := range toDelete {
+		klog.V(100).Infof("Deleting %s %s in namespace %s", resource.Resource, name, snapshot.Namespace)
+
+		err := resourceClient.Delete(context.TODO(), name, metav1.DeleteOptions{})
+		if err != nil && !k8serrors.IsNotFound(err) {
+			return nil, fmt.Errorf("failed to delete %s %s in namespace %s: %w",
+				resource.Resource, name, snapshot.Namespace, err)
+		}
+	}
+
+	for _, name := range toDelete {
+		err := wait.PollUntilContextTimeout(
+			context.TODO(), snapshotPollInterval, timeout, true, func(ctx context.Context) (bool, error) {
+				_, err := resourceClient.Get(logging.DiscardContext(), name, metav1.GetOptions{})
+				if k8serrors.IsNotFound(err) {
+					return true, nil
+				}
+
+				return false, nil
+			})
+		if err != nil {
+			return nil, fmt.Errorf("failed waiting for %s %s in namespace %s to be deleted: %w",
+				resource.Resource, name, snapshot.Namespace, err)
+		}
+	}
+
+	return toCreate, nil
+}
+
+// listObjects returns the objects of the resource in the namespace that are part of snapshots, sorted by name.
+func (snapshot *Snapshot) listObjects(resource schema.GroupVersionResource) ([]*unstructured.Unstructured, error) {
+	objectList, err := snapshot.apiClient.Resource(resource).Namespace(snapshot.Namespace).List(
+		logging.DiscardContext(), metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list %s in namespace %s: %w", resource.Resource, snapshot.Namespace, err)
+	}
+
+	var objects []*unstructured.Unstructured
+
+	for idx := range objectList.Items {
+		object := &objectList.Items[idx]
+
+		if isSnapshotIgnored(resource, object) {
+			continue
+		}
+
+		objects = append(objects, object)
+	}
+
+	slices.SortFunc(objects, func(a, b *unstructured.Unstructured) int {
+		return cmp.Compare(a.GetName(), b.GetName())
+	})
+
+	return objects, nil
+}
+
+// validate checks that the snapshot can be used to restore the namespace.
+func (snapshot *Snapshot) validate() error {
+	if snapshot == nil {
+		klog.V(100).Info("The namespace snapshot is nil")
+
+		return fmt.Errorf("error: received nil namespace snapshot")
+	}
+
+	if snapshot.apiClient == nil {
+		klog.V(100).Info("The namespace snapshot apiClient is nil")
+
+		return fmt.Errorf("namespace snapshot cannot have nil apiClient")
+	}
+
+	return nil
+}
+
+// isSnapshotIgnored returns whether the object is left out of snapshots because it is recreated by its owner or by
+// the cluster.
+func isSnapshotIgnored(resource schema.GroupVersionResource, object *unstructured.Unstructured) bool {
+	if metav1.GetControllerOf(object) != nil {
+		return true
+	}
+
+	if resource.Group == "" && slices.Contains(snapshotDefaultObjects[resource.Resource], object.GetName()) {
+		return true
+	}
+
+	// The tokens and pull secrets of service accounts are managed by the cluster.
+	if resource.Group == "" && resource.Resource == "secrets" {
+		secretType, _, _ := unstructured.NestedString(object.Object, "type")
+
+		return secretType == string(corev1.SecretTypeServiceAccountToken) ||
+			object.GetAnnotations()[corev1.ServiceAccountNameKey] != ""
+	}
+
+	return false
+}
+
+// normalizeSnapshotObject returns a copy of the object without its status and the metadata set by the API server.
+func normalizeSnapshotObject(object *unstructured.Unstructured) *unstructured.Unstructured {
+	normalized := object.DeepCopy()
+
+	for _, field := range snapshotVolatileMetadataFields {
+		unstructured.RemoveNestedField(normalized.Object, "metadata", field)
+	}
+
+	unstructured.RemoveNestedField(normalized.Object, "status")
+
+	return normalized
+}
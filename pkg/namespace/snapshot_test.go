@@ -0,0 +1,241 @@
+package namespace
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	"k8s.io/utils/ptr"
+)
+
+const defaultSnapshotNamespace = "test-namespace"
+
+var (
+	configMapGVR = corev1.SchemeGroupVersion.WithResource("configmaps")
+	secretGVR    = corev1.SchemeGroupVersion.WithResource("secrets")
+)
+
+func TestTakeSnapshot(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		resources     []schema.GroupVersionResource
+		exists        bool
+		expectedError string
+	}{
+		{
+			name:      "valid snapshot",
+			resources: []schema.GroupVersionResource{configMapGVR, secretGVR},
+			exists:    true,
+		},
+		{
+			name:          "empty resources",
+			exists:        true,
+			expectedError: "failed to snapshot empty list of resources in namespace test-namespace",
+		},
+		{
+			name:          "non-existent namespace",
+			resources:     []schema.GroupVersionResource{configMapGVR},
+			expectedError: "failed to snapshot resources of non-existent namespace test-namespace",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			testSettings := buildSnapshotTestClient(testCase.exists,
+				buildSnapshotTestConfigMap("app-config", "value"),
+				buildSnapshotTestConfigMap("kube-root-ca.crt", "ca"),
+				buildSnapshotTestSecret("app-secret", nil),
+				buildSnapshotTestSecret("default-token", map[string]string{corev1.ServiceAccountNameKey: "default"}))
+
+			snapshot, err := buildValidNamespaceTestBuilder(testSettings).TakeSnapshot(testCase.resources...)
+			if testCase.expectedError != "" {
+				assert.EqualError(t, err, testCase.expectedError)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, defaultSnapshotNamespace, snapshot.Namespace)
+			require.Len(t, snapshot.Resources, 2)
+
+			assert.Equal(t, configMapGVR, snapshot.Resources[0].GVR)
+			require.Len(t, snapshot.Resources[0].Objects, 1)
+			assert.Equal(t, "app-config", snapshot.Resources[0].Objects[0].GetName())
+			assert.Empty(t, snapshot.Resources[0].Objects[0].GetResourceVersion())
+			assert.Empty(t, snapshot.Resources[0].Objects[0].GetUID())
+
+			assert.Equal(t, secretGVR, snapshot.Resources[1].GVR)
+			require.Len(t, snapshot.Resources[1].Objects, 1)
+			assert.Equal(t, "app-secret", snapshot.Resources[1].Objects[0].GetName())
+		})
+	}
+}
+
+func TestSnapshotRestore(t *testing.T) {
+	t.Parallel()
+
+	testSettings := buildSnapshotTestClient(true,
+		buildSnapshotTestConfigMap("unchanged", "value"),
+		buildSnapshotTestConfigMap("changed", "value"),
+		buildSnapshotTestConfigMap("deleted", "value"))
+
+	snapshot, err := buildValidNamespaceTestBuilder(testSettings).TakeSnapshot(configMapGVR)
+	require.NoError(t, err)
+
+	configMapClient := testSettings.Resource(configMapGVR).Namespace(defaultSnapshotNamespace)
+
+	unchanged, err := configMapClient.Get(context.TODO(), "unchanged", metav1.GetOptions{})
+	require.NoError(t, err)
+
+	changed, err := configMapClient.Get(context.TODO(), "changed", metav1.GetOptions{})
+	require.NoError(t, err)
+
+	err = unstructured.SetNestedField(changed.Object, "other", "data", "key")
+	require.NoError(t, err)
+
+	_, err = configMapClient.Update(context.TODO(), changed, metav1.UpdateOptions{})
+	require.NoError(t, err)
+
+	err = configMapClient.Delete(context.TODO(), "deleted", metav1.DeleteOptions{})
+	require.NoError(t, err)
+
+	_, err = configMapClient.Create(context.TODO(), buildSnapshotTestConfigMap("created", "value"), metav1.CreateOptions{})
+	require.NoError(t, err)
+
+	err = snapshot.Restore(time.Second)
+	require.NoError(t, err)
+
+	configMaps, err := configMapClient.List(context.TODO(), metav1.ListOptions{})
+	require.NoError(t, err)
+
+	var names []string
+
+	for _, configMap := range configMaps.Items {
+		names = append(names, configMap.GetName())
+
+		value, _, _ := unstructured.NestedString(configMap.Object, "data", "key")
+		assert.Equal(t, "value", value)
+
+		if configMap.GetName() == "unchanged" {
+			assert.Equal(t, unchanged.GetUID(), configMap.GetUID())
+		}
+	}
+
+	assert.ElementsMatch(t, []string{"unchanged", "changed", "deleted"}, names)
+}
+
+func TestSnapshotSaveAndLoad(t *testing.T) {
+	t.Parallel()
+
+	testSettings := buildSnapshotTestClient(true, buildSnapshotTestConfigMap("app-config", "value"))
+
+	snapshot, err := buildValidNamespaceTestBuilder(testSettings).TakeSnapshot(configMapGVR)
+	require.NoError(t, err)
+
+	path := filepath.Join(t.TempDir(), "snapshot.json")
+
+	err = snapshot.SaveToFile(path)
+	require.NoError(t, err)
+
+	loaded, err := LoadSnapshot(testSettings, path)
+	require.NoError(t, err)
+	assert.Equal(t, snapshot.Namespace, loaded.Namespace)
+	assert.Equal(t, snapshot.Resources, loaded.Resources)
+
+	err = loaded.Restore(time.Second)
+	assert.NoError(t, err)
+
+	_, err = LoadSnapshot(nil, path)
+	assert.EqualError(t, err, "snapshot 'apiClient' cannot be nil")
+
+	_, err = LoadSnapshot(testSettings, filepath.Join(t.TempDir(), "missing.json"))
+	assert.ErrorContains(t, err, "failed to read snapshot file")
+
+	var nilSnapshot *Snapshot
+
+	assert.EqualError(t, nilSnapshot.SaveToFile(path), "error: received nil namespace snapshot")
+	assert.EqualError(t, nilSnapshot.Restore(time.Second), "error: received nil namespace snapshot")
+}
+
+func TestIsSnapshotIgnored(t *testing.T) {
+	t.Parallel()
+
+	owned := buildSnapshotTestConfigMap("owned", "value")
+	owned.SetOwnerReferences([]metav1.OwnerReference{{
+		APIVersion: "apps/v1", Kind: "Deployment", Name: "app", UID: "uid", Controller: ptr.To(true),
+	}})
+
+	tokenSecret := buildSnapshotTestSecret("token", nil)
+	tokenSecret.Object["type"] = string(corev1.SecretTypeServiceAccountToken)
+
+	assert.True(t, isSnapshotIgnored(configMapGVR, owned))
+	assert.True(t, isSnapshotIgnored(configMapGVR, buildSnapshotTestConfigMap("openshift-service-ca.crt", "ca")))
+	assert.True(t, isSnapshotIgnored(secretGVR, tokenSecret))
+	assert.False(t, isSnapshotIgnored(configMapGVR, buildSnapshotTestConfigMap("app-config", "value")))
+	assert.False(t, isSnapshotIgnored(secretGVR, buildSnapshotTestSecret("app-secret", nil)))
+}
+
+func buildSnapshotTestClient(exists bool, objects ...runtime.Object) *clients.Settings {
+	var k8sMockObjects []runtime.Object
+
+	if exists {
+		k8sMockObjects = append(k8sMockObjects, &corev1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{Name: defaultSnapshotNamespace},
+		})
+	}
+
+	testSettings := clients.GetTestClients(clients.TestClientParams{
+		K8sMockObjects:  k8sMockObjects,
+		SchemeAttachers: []clients.SchemeAttacher{corev1.AddToScheme},
+	})
+
+	testSettings.Interface = dynamicfake.NewSimpleDynamicClientWithCustomListKinds(
+		runtime.NewScheme(), map[schema.GroupVersionResource]string{
+			configMapGVR: "ConfigMapList",
+			secretGVR:    "SecretList",
+		}, objects...)
+
+	return testSettings
+}
+
+func buildSnapshotTestConfigMap(name, value string) *unstructured.Unstructured {
+	configMap := &unstructured.Unstructured{Object: map[string]any{
+		"data": map[string]any{"key": value},
+	}}
+	configMap.SetAPIVersion("v1")
+	configMap.SetKind("ConfigMap")
+	configMap.SetName(name)
+	configMap.SetNamespace(defaultSnapshotNamespace)
+	configMap.SetUID(types.UID("uid-" + name))
+	configMap.SetResourceVersion("1")
+
+	return configMap
+}
+
+func buildSnapshotTestSecret(name string, annotations map[string]string) *unstructured.Unstructured {
+	secret := &unstructured.Unstructured{Object: map[string]any{
+		"type": string(corev1.SecretTypeOpaque),
+	}}
+	secret.SetAPIVersion("v1")
+	secret.SetKind("Secret")
+	secret.SetName(name)
+	secret.SetNamespace(defaultSnapshotNamespace)
+	secret.SetAnnotations(annotations)
+
+	return secret
+}
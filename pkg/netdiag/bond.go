@@ -0,0 +1,175 @@
+package netdiag
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/pod"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/klog/v2"
+)
+
+const (
+	// bondProbeCount is the number of ICMP echo requests sent each time connectivity over the bond is probed.
+	bondProbeCount = 3
+	// bondPollInterval is how often connectivity over the bond is probed while waiting for it to fail over.
+	bondPollInterval = 2 * time.Second
+)
+
+// LinkFlapFunc sets a link the bond depends on down or up. It is used by ValidateBondFailover to simulate the failure
+// of a bond slave, for example a VF in the workload pod or the PF it belongs to on the node.
+type LinkFlapFunc func(down bool) error
+
+// NewPodLinkFlapper returns a LinkFlapFunc that sets iface down or up in the first container of the provided pod. The
+// container must have the NET_ADMIN capability.
+func NewPodLinkFlapper(podBuilder *pod.Builder, iface string) LinkFlapFunc {
+	return func(down bool) error {
+		if podBuilder == nil || podBuilder.Definition == nil {
+			klog.V(100).Info("The link flapper pod is nil")
+
+			return fmt.Errorf("link flapper pod cannot be nil")
+		}
+
+		if iface == "" {
+			klog.V(100).Info("The link flapper interface is empty")
+
+			return fmt.Errorf("link flapper 'iface' cannot be empty")
+		}
+
+		state := "up"
+		if down {
+			state = "down"
+		}
+
+		klog.V(100).Infof("Setting interface %s of pod %s/%s %s",
+			iface, podBuilder.Definition.Namespace, podBuilder.Definition.Name, state)
+
+		output, err := podBuilder.ExecCommand([]string{"ip", "link", "set", "dev", iface, state})
+		if err != nil {
+			return fmt.Errorf("failed to set interface %s %s: %s: %w", iface, state, strings.TrimSpace(output.String()), err)
+		}
+
+		return nil
+	}
+}
+
+// ValidateBondFailover checks that traffic over bondInterface in the workload pod survives the failure of a slave. It
+// probes targetIP over the bond, calls flap to set the slave down, and waits up to timeout for the bond to fail over
+// and targetIP to be reachable again. For active-backup bonds, the active slave must also change. The slave is then
+// set up again and connectivity is checked once more. The slave is always set up again before returning.
+func ValidateBondFailover(
+	podBuilder *pod.Builder, bondInterface, targetIP string, flap LinkFlapFunc, timeout time.Duration) error {
+	if podBuilder == nil || podBuilder.Definition == nil {
+		klog.V(100).Info("The bond failover pod is nil")
+
+		return fmt.Errorf("bond failover pod cannot be nil")
+	}
+
+	if bondInterface == "" {
+		klog.V(100).Info("The bond failover interface is empty")
+
+		return fmt.Errorf("bond failover 'bondInterface' cannot be empty")
+	}
+
+	if targetIP == "" {
+		klog.V(100).Info("The bond failover target IP is empty")
+
+		return fmt.Errorf("bond failover 'targetIP' cannot be empty")
+	}
+
+	if flap == nil {
+		klog.V(100).Info("The bond failover flap function is nil")
+
+		return fmt.Errorf("bond failover flap function cannot be nil")
+	}
+
+	klog.V(100).Infof("Validating failover of bond %s in pod %s/%s to %s",
+		bondInterface, podBuilder.Definition.Namespace, podBuilder.Definition.Name, targetIP)
+
+	err := probeBond(podBuilder, bondInterface, targetIP)
+	if err != nil {
+		return fmt.Errorf("no connectivity over bond %s before failover: %w", bondInterface, err)
+	}
+
+	activeSlave := getBondActiveSlave(podBuilder, bondInterface)
+
+	err = flap(true)
+	if err != nil {
+		return fmt.Errorf("failed to set bond %s slave down: %w", bondInterface, err)
+	}
+
+	failoverErr := waitForBondConnectivity(podBuilder, bondInterface, targetIP, activeSlave, timeout)
+
+	err = flap(false)
+	if err != nil {
+		return fmt.Errorf("failed to set bond %s slave up: %w", bondInterface, err)
+	}
+
+	if failoverErr != nil {
+		return fmt.Errorf("bond %s did not fail over: %w", bondInterface, failoverErr)
+	}
+
+	err = waitForBondConnectivity(podBuilder, bondInterface, targetIP, "", timeout)
+	if err != nil {
+		return fmt.Errorf("no connectivity over bond %s after slave recovery: %w", bondInterface, err)
+	}
+
+	return nil
+}
+
+// waitForBondConnectivity waits up to timeout for targetIP to be reachable over bondInterface. If previousSlave is not
+// empty, the active slave of the bond must also have changed from it.
+func waitForBondConnectivity(
+	podBuilder *pod.Builder, bondInterface, targetIP, previousSlave string, timeout time.Duration) error {
+	var lastErr error
+
+	err := wait.PollUntilContextTimeout(
+		context.TODO(), bondPollInterval, timeout, true, func(ctx context.Context) (bool, error) {
+			if previousSlave != "" {
+				activeSlave := getBondActiveSlave(podBuilder, bondInterface)
+				if activeSlave == previousSlave {
+					lastErr = fmt.Errorf("active slave is still %s", previousSlave)
+
+					return false, nil
+				}
+			}
+
+			lastErr = probeBond(podBuilder, bondInterface, targetIP)
+
+			return lastErr == nil, nil
+		})
+	if err != nil && lastErr != nil {
+		return lastErr
+	}
+
+	return err
+}
+
+// probeBond pings targetIP over bondInterface and returns an error if no replies are received.
+func probeBond(podBuilder *pod.Builder, bondInterface, targetIP string) error {
+	output, err := podBuilder.ExecCommand([]string{
+		"ping", "-q", "-c", fmt.Sprintf("%d", bondProbeCount), "-W", "1", "-I", bondInterface, targetIP})
+	if err != nil {
+		klog.V(100).Infof("Ping over bond %s failed: %v", bondInterface, err)
+	}
+
+	_, err = parsePingOutput(output.String())
+
+	return err
+}
+
+// getBondActiveSlave returns the active slave of bondInterface. It is empty for bond modes without an active slave or
+// if the bonding sysfs attributes cannot be read.
+func getBondActiveSlave(podBuilder *pod.Builder, bondInterface string) string {
+	output, err := podBuilder.ExecCommand(
+		[]string{"cat", fmt.Sprintf("/sys/class/net/%s/bonding/active_slave", bondInterface)})
+	if err != nil {
+		klog.V(100).Infof("Failed to read active slave of bond %s: %v", bondInterface, err)
+
+		return ""
+	}
+
+	return strings.TrimSpace(output.String())
+}
@@ -0,0 +1,114 @@
+package netdiag
+
+import (
+	"testing"
+	"time"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/pod"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	defaultBondInterface = "bond0"
+	defaultBondTargetIP  = "192.168.10.1"
+)
+
+func TestNewPodLinkFlapper(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		podBuilder    *pod.Builder
+		iface         string
+		expectedError string
+	}{
+		{
+			name:          "nil pod",
+			iface:         "net1",
+			expectedError: "link flapper pod cannot be nil",
+		},
+		{
+			name:          "empty interface",
+			podBuilder:    buildCapturePodBuilder(),
+			expectedError: "link flapper 'iface' cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := NewPodLinkFlapper(testCase.podBuilder, testCase.iface)(true)
+			assert.EqualError(t, err, testCase.expectedError)
+		})
+	}
+}
+
+func TestValidateBondFailover(t *testing.T) {
+	t.Parallel()
+
+	noopFlap := func(bool) error { return nil }
+
+	testCases := []struct {
+		name          string
+		podBuilder    *pod.Builder
+		bondInterface string
+		targetIP      string
+		flap          LinkFlapFunc
+		expectedError string
+	}{
+		{
+			name:          "nil pod",
+			bondInterface: defaultBondInterface,
+			targetIP:      defaultBondTargetIP,
+			flap:          noopFlap,
+			expectedError: "bond failover pod cannot be nil",
+		},
+		{
+			name:          "empty bond interface",
+			podBuilder:    buildCapturePodBuilder(),
+			targetIP:      defaultBondTargetIP,
+			flap:          noopFlap,
+			expectedError: "bond failover 'bondInterface' cannot be empty",
+		},
+		{
+			name:          "empty target IP",
+			podBuilder:    buildCapturePodBuilder(),
+			bondInterface: defaultBondInterface,
+			flap:          noopFlap,
+			expectedError: "bond failover 'targetIP' cannot be empty",
+		},
+		{
+			name:          "nil flap function",
+			podBuilder:    buildCapturePodBuilder(),
+			bondInterface: defaultBondInterface,
+			targetIP:      defaultBondTargetIP,
+			expectedError: "bond failover flap function cannot be nil",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := ValidateBondFailover(
+				testCase.podBuilder, testCase.bondInterface, testCase.targetIP, testCase.flap, time.Second)
+			assert.EqualError(t, err, testCase.expectedError)
+		})
+	}
+}
+
+func TestValidateBondFailoverNoConnectivity(t *testing.T) {
+	t.Parallel()
+
+	flapped := false
+	flap := func(bool) error {
+		flapped = true
+
+		return nil
+	}
+
+	err := ValidateBondFailover(buildCapturePodBuilder(), defaultBondInterface, defaultBondTargetIP, flap, time.Second)
+	assert.ErrorContains(t, err, "no connectivity over bond bond0 before failover")
+	assert.False(t, flapped)
+}
@@ -0,0 +1,376 @@
+package netdiag
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/pod"
+	"k8s.io/klog/v2"
+)
+
+const (
+	// captureDirectory is the directory in the capture container the pcap file is written to.
+	captureDirectory = "/tmp"
+	// captureAllInterfaces is the tcpdump interface used when no interface is provided.
+	captureAllInterfaces = "any"
+	// defaultCapturePodTimeout is how long to wait for a node capture pod to be running if the context passed to
+	// StartCapture has no deadline.
+	defaultCapturePodTimeout = 2 * time.Minute
+	// captureStopTimeout is how long to wait for tcpdump to flush the pcap file and exit after being interrupted.
+	captureStopTimeout = 30 * time.Second
+	// captureStartDelay is how long to wait after starting tcpdump before checking that it is still running.
+	captureStartDelay = time.Second
+)
+
+// CaptureTarget provides a struct describing where a packet capture runs. Use NewPodCaptureTarget to capture in an
+// existing pod or NewNodeCaptureTarget to capture in the host network namespace of a node.
+type CaptureTarget struct {
+	// podBuilder is the pod tcpdump runs in. For node targets, it is nil until StartCapture creates the pod.
+	podBuilder *pod.Builder
+	// containerName is the container tcpdump runs in. If empty, the first container of the pod is used.
+	containerName string
+	// nodeName is the node a capture pod is created on. It is empty for pod targets.
+	nodeName string
+	// nsname is the namespace a node capture pod is created in.
+	nsname string
+	// image is the image of a node capture pod.
+	image string
+	// apiClient is used to create node capture pods.
+	apiClient *clients.Settings
+	// Used to store the latest error message upon defining the target. errorMsg is processed by StartCapture.
+	errorMsg string
+}
+
+// NewPodCaptureTarget returns a CaptureTarget that runs tcpdump in the provided container of an existing pod. If
+// containerName is empty, the first container is used. The container must provide tcpdump and have the privileges
+// required to capture packets.
+func NewPodCaptureTarget(podBuilder *pod.Builder, containerName string) *CaptureTarget {
+	klog.V(100).Infof("Initializing new pod capture target with container %q", containerName)
+
+	target := &CaptureTarget{
+		podBuilder:    podBuilder,
+		containerName: containerName,
+	}
+
+	if podBuilder == nil || podBuilder.Definition == nil {
+		klog.V(100).Info("The capture target pod is nil")
+
+		target.errorMsg = "capture target pod cannot be nil"
+	}
+
+	return target
+}
+
+// NewNodeCaptureTarget returns a CaptureTarget that runs tcpdump on the provided node. StartCapture creates a
+// privileged host network pod using image in nsname and the pod is deleted when the capture is cleaned up. The image
+// must provide tcpdump.
+func NewNodeCaptureTarget(apiClient *clients.Settings, nodeName, nsname, image string) *CaptureTarget {
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	klog.V(100).Infof("Initializing new node capture target with the following params: node: %s, namespace: %s, "+
+		"image: %s", nodeName, nsname, image)
+
+	target := &CaptureTarget{
+		nodeName:  nodeName,
+		nsname:    nsname,
+		image:     image,
+		apiClient: apiClient,
+	}
+
+	if apiClient == nil {
+		klog.V(100).Info("The capture target apiClient is nil")
+
+		target.errorMsg = "capture target cannot have nil apiClient"
+
+		return target
+	}
+
+	if nodeName == "" {
+		klog.V(100).Info("The capture target node name is empty")
+
+		target.errorMsg = "capture target 'nodeName' cannot be empty"
+
+		return target
+	}
+
+	if nsname == "" {
+		klog.V(100).Info("The capture target namespace is empty")
+
+		target.errorMsg = "capture target 'nsname' cannot be empty"
+
+		return target
+	}
+
+	if image == "" {
+		klog.V(100).Info("The capture target image is empty")
+
+		target.errorMsg = "capture target 'image' cannot be empty"
+
+		return target
+	}
+
+	return target
+}
+
+// Capture is a handle to a running packet capture returned by StartCapture. It must be stopped and cleaned up by the
+// caller.
+type Capture struct {
+	// target is where the capture is running.
+	target *CaptureTarget
+	// path is the path of the pcap file in the capture container.
+	path string
+	// pid is the process ID of tcpdump in the capture container.
+	pid int
+	// stopped is closed once the capture has been stopped.
+	stopped chan struct{}
+	// stopErr is the error returned by the first call to stop.
+	stopErr error
+	// stopOnce ensures tcpdump is only interrupted once, whether by Stop or by the context being done.
+	stopOnce sync.Once
+}
+
+// StartCapture starts tcpdump on iface of the target with the provided filter, using the tcpdump filter syntax. If
+// iface is empty, all interfaces are captured and if filter is empty, all packets are captured. The capture runs
+// until Stop is called or ctx is done, after which the pcap can be retrieved using Download.
+func StartCapture(ctx context.Context, target *CaptureTarget, iface, filter string) (*Capture, error) {
+	if target == nil {
+		klog.V(100).Info("The capture target is nil")
+
+		return nil, fmt.Errorf("capture target cannot be nil")
+	}
+
+	if target.errorMsg != "" {
+		klog.V(100).Infof("The capture target has error message: %s", target.errorMsg)
+
+		return nil, fmt.Errorf("%s", target.errorMsg)
+	}
+
+	if err := ctx.Err(); err != nil {
+		klog.V(100).Infof("The capture context is already done: %v", err)
+
+		return nil, fmt.Errorf("cannot start packet capture: %w", err)
+	}
+
+	if iface == "" {
+		iface = captureAllInterfaces
+	}
+
+	klog.V(100).Infof("Starting packet capture on interface %s with filter %q", iface, filter)
+
+	if target.podBuilder == nil {
+		err := target.deployNodePod(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	capture := &Capture{
+		target:  target,
+		path:    fmt.Sprintf("%s/capture-%d.pcap", captureDirectory, time.Now().UnixNano()),
+		stopped: make(chan struct{}),
+	}
+
+	tcpdumpCommand := fmt.Sprintf("tcpdump -i %s -U -w %s", shellQuote(iface), capture.path)
+	if filter != "" {
+		tcpdumpCommand += " " + shellQuote(filter)
+	}
+
+	startCommand := fmt.Sprintf("nohup %s >%s.log 2>&1 & echo $!", tcpdumpCommand, capture.path)
+
+	output, err := target.exec([]string{"/bin/sh", "-c", startCommand})
+	if err != nil {
+		_ = target.cleanupNodePod()
+
+		return nil, fmt.Errorf("failed to start tcpdump on interface %s: %w", iface, err)
+	}
+
+	capture.pid, err = parsePID(output)
+	if err != nil {
+		_ = target.cleanupNodePod()
+
+		return nil, err
+	}
+
+	time.Sleep(captureStartDelay)
+
+	_, err = target.exec([]string{"kill", "-0", strconv.Itoa(capture.pid)})
+	if err != nil {
+		tcpdumpLog, _ := target.exec([]string{"cat", capture.path + ".log"})
+		_ = target.cleanupNodePod()
+
+		return nil, fmt.Errorf("tcpdump exited after starting on interface %s: %s", iface, strings.TrimSpace(tcpdumpLog))
+	}
+
+	go func() {
+		select {
+		case <-ctx.Done():
+			klog.V(100).Infof("Context done, stopping packet capture %s", capture.path)
+
+			_ = capture.Stop()
+		case <-capture.stopped:
+		}
+	}()
+
+	return capture, nil
+}
+
+// Stop interrupts tcpdump and waits for it to flush the pcap file and exit. It is safe to call Stop more than once.
+func (capture *Capture) Stop() error {
+	if capture == nil {
+		klog.V(100).Info("The capture is nil")
+
+		return fmt.Errorf("error: received nil capture")
+	}
+
+	capture.stopOnce.Do(func() {
+		defer close(capture.stopped)
+
+		klog.V(100).Infof("Stopping packet capture %s with pid %d", capture.path, capture.pid)
+
+		pid := strconv.Itoa(capture.pid)
+		stopCommand := fmt.Sprintf("kill -INT %s; while kill -0 %s 2>/dev/null; do sleep 0.1; done", pid, pid)
+
+		_, err := capture.target.podBuilder.ExecCommandWithTimeout(
+			[]string{"/bin/sh", "-c", stopCommand}, captureStopTimeout, capture.target.getContainerName())
+		if err != nil {
+			capture.stopErr = fmt.Errorf("failed to stop packet capture %s: %w", capture.path, err)
+		}
+	})
+
+	return capture.stopErr
+}
+
+// Download stops the capture if it is still running and returns the contents of the pcap file.
+func (capture *Capture) Download() ([]byte, error) {
+	err := capture.Stop()
+	if err != nil {
+		return nil, err
+	}
+
+	klog.V(100).Infof("Downloading packet capture %s", capture.path)
+
+	buffer, err := capture.target.podBuilder.Copy(capture.path, capture.target.getContainerName(), false)
+	if err != nil {
+		return nil, fmt.Errorf("failed to download packet capture %s: %w", capture.path, err)
+	}
+
+	return buffer.Bytes(), nil
+}
+
+// DownloadToFile stops the capture if it is still running and writes the pcap file to localPath.
+func (capture *Capture) DownloadToFile(localPath string) error {
+	if localPath == "" {
+		klog.V(100).Info("The capture local path is empty")
+
+		return fmt.Errorf("capture 'localPath' cannot be empty")
+	}
+
+	contents, err := capture.Download()
+	if err != nil {
+		return err
+	}
+
+	klog.V(100).Infof("Writing packet capture %s to %s", capture.path, localPath)
+
+	return os.WriteFile(localPath, contents, 0o600)
+}
+
+// Cleanup stops the capture if it is still running and removes the pcap file. For node targets, the capture pod is
+// deleted instead.
+func (capture *Capture) Cleanup() error {
+	err := capture.Stop()
+	if err != nil {
+		return err
+	}
+
+	klog.V(100).Infof("Cleaning up packet capture %s", capture.path)
+
+	if capture.target.nodeName != "" {
+		return capture.target.cleanupNodePod()
+	}
+
+	_, err = capture.target.exec([]string{"rm", "-f", capture.path, capture.path + ".log"})
+	if err != nil {
+		return fmt.Errorf("failed to remove packet capture %s: %w", capture.path, err)
+	}
+
+	return nil
+}
+
+// deployNodePod creates the privileged host network pod used to capture packets on the target node and waits for it
+// to be running. The wait is bounded by the deadline of ctx, if it has one.
+func (target *CaptureTarget) deployNodePod(ctx context.Context) error {
+	timeout := defaultCapturePodTimeout
+	if deadline, ok := ctx.Deadline(); ok {
+		timeout = time.Until(deadline)
+	}
+
+	podBuilder, err := pod.NewBuilder(
+		target.apiClient, fmt.Sprintf("capture-%s", target.nodeName), target.nsname, target.image).
+		RedefineDefaultCMD([]string{"/bin/sh", "-c", "sleep infinity"}).
+		DefineOnNode(target.nodeName).
+		WithHostNetwork().
+		WithPrivilegedFlag().
+		WithTolerationToMaster().
+		CreateAndWaitUntilRunning(timeout)
+	if err != nil {
+		return fmt.Errorf("failed to deploy capture pod on node %s: %w", target.nodeName, err)
+	}
+
+	target.podBuilder = podBuilder
+
+	return nil
+}
+
+// cleanupNodePod deletes the capture pod of a node target, if one was created. It is a no-op for pod targets.
+func (target *CaptureTarget) cleanupNodePod() error {
+	if target.nodeName == "" || target.podBuilder == nil {
+		return nil
+	}
+
+	_, err := target.podBuilder.DeleteAndWait(captureStopTimeout)
+	if err != nil {
+		return fmt.Errorf("failed to delete capture pod on node %s: %w", target.nodeName, err)
+	}
+
+	target.podBuilder = nil
+
+	return nil
+}
+
+// exec runs command in the capture container and returns its output.
+func (target *CaptureTarget) exec(command []string) (string, error) {
+	output, err := target.podBuilder.ExecCommand(command, target.getContainerName())
+
+	return output.String(), err
+}
+
+// getContainerName returns the name of the container tcpdump runs in.
+func (target *CaptureTarget) getContainerName() string {
+	if target.containerName != "" {
+		return target.containerName
+	}
+
+	return target.podBuilder.Definition.Spec.Containers[0].Name
+}
+
+// parsePID parses the process ID printed when tcpdump is started in the background.
+func parsePID(output string) (int, error) {
+	pid, err := strconv.Atoi(strings.TrimSpace(output))
+	if err != nil || pid < 1 {
+		return 0, fmt.Errorf("failed to parse tcpdump pid from output %q", output)
+	}
+
+	return pid, nil
+}
+
+// shellQuote quotes value so it is passed to the shell as a single word.
+func shellQuote(value string) string {
+	return "'" + strings.ReplaceAll(value, "'", `'\''`) + "'"
+}
@@ -0,0 +1,210 @@
+package netdiag
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/pod"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+)
+
+const defaultCaptureNode = "worker-0"
+
+func TestNewPodCaptureTarget(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name              string
+		podBuilder        *pod.Builder
+		containerName     string
+		expectedContainer string
+		expectedError     string
+	}{
+		{
+			name:              "default container",
+			podBuilder:        buildCapturePodBuilder(),
+			expectedContainer: "test",
+		},
+		{
+			name:              "named container",
+			podBuilder:        buildCapturePodBuilder(),
+			containerName:     "sidecar",
+			expectedContainer: "sidecar",
+		},
+		{
+			name:          "nil pod",
+			expectedError: "capture target pod cannot be nil",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			target := NewPodCaptureTarget(testCase.podBuilder, testCase.containerName)
+			assert.Equal(t, testCase.expectedError, target.errorMsg)
+
+			if testCase.expectedError == "" {
+				assert.Equal(t, testCase.expectedContainer, target.getContainerName())
+			}
+		})
+	}
+}
+
+func TestNewNodeCaptureTarget(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		client        bool
+		nodeName      string
+		nsname        string
+		image         string
+		expectedError string
+	}{
+		{
+			name:     "valid target",
+			client:   true,
+			nodeName: defaultCaptureNode,
+			nsname:   defaultNetdiagNamespace,
+			image:    defaultNetdiagImage,
+		},
+		{
+			name:          "nil client",
+			nodeName:      defaultCaptureNode,
+			nsname:        defaultNetdiagNamespace,
+			image:         defaultNetdiagImage,
+			expectedError: "capture target cannot have nil apiClient",
+		},
+		{
+			name:          "empty node",
+			client:        true,
+			nsname:        defaultNetdiagNamespace,
+			image:         defaultNetdiagImage,
+			expectedError: "capture target 'nodeName' cannot be empty",
+		},
+		{
+			name:          "empty namespace",
+			client:        true,
+			nodeName:      defaultCaptureNode,
+			image:         defaultNetdiagImage,
+			expectedError: "capture target 'nsname' cannot be empty",
+		},
+		{
+			name:          "empty image",
+			client:        true,
+			nodeName:      defaultCaptureNode,
+			nsname:        defaultNetdiagNamespace,
+			expectedError: "capture target 'image' cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			var testSettings *clients.Settings
+
+			if testCase.client {
+				testSettings = clients.GetTestClients(clients.TestClientParams{})
+			}
+
+			target := NewNodeCaptureTarget(testSettings, testCase.nodeName, testCase.nsname, testCase.image)
+			assert.Equal(t, testCase.expectedError, target.errorMsg)
+			assert.Nil(t, target.podBuilder)
+		})
+	}
+}
+
+func TestStartCapture(t *testing.T) {
+	t.Parallel()
+
+	_, err := StartCapture(context.TODO(), nil, "", "")
+	assert.EqualError(t, err, "capture target cannot be nil")
+
+	_, err = StartCapture(context.TODO(), NewPodCaptureTarget(nil, ""), "", "")
+	assert.EqualError(t, err, "capture target pod cannot be nil")
+
+	_, err = StartCapture(context.TODO(), NewPodCaptureTarget(buildCapturePodBuilder(), ""), "eth0", "udp port 319")
+	assert.ErrorContains(t, err, "failed to start tcpdump on interface eth0")
+
+	target := NewNodeCaptureTarget(
+		clients.GetTestClients(clients.TestClientParams{}), defaultCaptureNode, defaultNetdiagNamespace, defaultNetdiagImage)
+
+	cancelledCtx, cancel := context.WithCancel(context.TODO())
+	cancel()
+
+	_, err = StartCapture(cancelledCtx, target, "", "")
+	assert.EqualError(t, err, "cannot start packet capture: context canceled")
+
+	timeoutCtx, cancel := context.WithTimeout(context.TODO(), time.Second)
+	defer cancel()
+
+	_, err = StartCapture(timeoutCtx, target, "", "")
+	assert.ErrorContains(t, err, "failed to deploy capture pod on node worker-0")
+}
+
+func TestCaptureNil(t *testing.T) {
+	t.Parallel()
+
+	var capture *Capture
+
+	assert.EqualError(t, capture.Stop(), "error: received nil capture")
+	assert.EqualError(t, capture.Cleanup(), "error: received nil capture")
+
+	_, err := capture.Download()
+	assert.EqualError(t, err, "error: received nil capture")
+
+	err = capture.DownloadToFile("")
+	assert.EqualError(t, err, "capture 'localPath' cannot be empty")
+
+	err = capture.DownloadToFile(filepath.Join(t.TempDir(), "capture.pcap"))
+	assert.EqualError(t, err, "error: received nil capture")
+}
+
+func TestParsePID(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		output        string
+		expectedPID   int
+		expectedError string
+	}{
+		{output: "1234\r\n", expectedPID: 1234},
+		{output: "42", expectedPID: 42},
+		{output: "", expectedError: "failed to parse tcpdump pid from output \"\""},
+		{output: "0", expectedError: "failed to parse tcpdump pid from output \"0\""},
+		{output: "sh: tcpdump: not found", expectedError: "failed to parse tcpdump pid from output \"sh: tcpdump: not found\""},
+	}
+
+	for _, testCase := range testCases {
+		pid, err := parsePID(testCase.output)
+		if testCase.expectedError != "" {
+			assert.EqualError(t, err, testCase.expectedError)
+		} else {
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.expectedPID, pid)
+		}
+	}
+}
+
+func TestShellQuote(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "''", shellQuote(""))
+	assert.Equal(t, "'udp port 319 or udp port 320'", shellQuote("udp port 319 or udp port 320"))
+	assert.Equal(t, `'it'\''s'`, shellQuote("it's"))
+}
+
+func buildCapturePodBuilder() *pod.Builder {
+	podBuilder := pod.NewBuilder(
+		clients.GetTestClients(clients.TestClientParams{}), "capture", defaultNetdiagNamespace, defaultNetdiagImage)
+	podBuilder.Definition.Spec.Containers[0].Name = "test"
+	podBuilder.Definition.Spec.Containers = append(podBuilder.Definition.Spec.Containers, corev1.Container{Name: "sidecar"})
+
+	return podBuilder
+}
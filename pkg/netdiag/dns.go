@@ -0,0 +1,367 @@
+package netdiag
+
+import (
+	"fmt"
+	"net/netip"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/pod"
+	"k8s.io/klog/v2"
+)
+
+// dnsQueryTimeoutSeconds is how long dig waits for each nameserver to answer a query, without retries.
+const dnsQueryTimeoutSeconds = 2
+
+// DNSProbeBuilder provides a struct for a short-lived pod used to check DNS resolution from a namespace, such as the
+// namespace of a CNF before its tests run. The image must provide dig.
+type DNSProbeBuilder struct {
+	// name is the name of the probe pod.
+	name string
+	// nsname is the namespace the probe pod is created in and resolves names from.
+	nsname string
+	// image is the image of the probe pod.
+	image string
+	// nodeName is the node the probe pod is scheduled on. If empty, the scheduler chooses a node.
+	nodeName string
+	// api client to interact with the cluster.
+	apiClient *clients.Settings
+	// Used in functions that define or mutate the probe pod. errorMsg is processed before the pod is created.
+	errorMsg string
+}
+
+// DNSNameserverResult is the result of resolving a name using a single nameserver of the probe pod.
+type DNSNameserverResult struct {
+	// Nameserver is the address of the nameserver, as listed in the resolv.conf of the probe pod.
+	Nameserver string
+	// Addresses are the IPv4 and IPv6 addresses the name resolved to, after following any CNAME records.
+	Addresses []netip.Addr
+	// Error describes why the name could not be resolved. It is empty if Addresses is not empty.
+	Error string
+}
+
+// DNSResult is the result of resolving a name using each nameserver of the probe pod.
+type DNSResult struct {
+	// Name is the resolved name. Names without a trailing dot are expanded using the search domains of the pod.
+	Name string
+	// Nameservers are the results of each nameserver, in the order of the resolv.conf of the probe pod.
+	Nameservers []DNSNameserverResult
+}
+
+// DNSReport is the result of a DNS probe.
+type DNSReport struct {
+	// Nameservers are the nameservers listed in the resolv.conf of the probe pod.
+	Nameservers []string
+	// SearchDomains are the search domains listed in the resolv.conf of the probe pod.
+	SearchDomains []string
+	// Results are the results of each name, in the order they were provided.
+	Results []DNSResult
+}
+
+// ServiceDNSName returns the name of the service in the namespace, relative to the cluster domain, so it can be
+// resolved from any namespace.
+func ServiceDNSName(serviceName, nsname string) string {
+	return fmt.Sprintf("%s.%s.svc", serviceName, nsname)
+}
+
+// NewDNSProbeBuilder creates a new instance of DNSProbeBuilder.
+func NewDNSProbeBuilder(apiClient *clients.Settings, name, nsname, image string) *DNSProbeBuilder {
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	klog.V(100).Infof(
+		"Initializing new DNS probe structure with the following params: name: %s, namespace: %s, image: %s",
+		name, nsname, image)
+
+	builder := &DNSProbeBuilder{
+		name:      name,
+		nsname:    nsname,
+		image:     image,
+		apiClient: apiClient,
+	}
+
+	if name == "" {
+		klog.V(100).Info("The name of the DNS probe is empty")
+
+		builder.errorMsg = "dns probe 'name' cannot be empty"
+
+		return builder
+	}
+
+	if nsname == "" {
+		klog.V(100).Info("The namespace of the DNS probe is empty")
+
+		builder.errorMsg = "dns probe 'nsname' cannot be empty"
+
+		return builder
+	}
+
+	if image == "" {
+		klog.V(100).Info("The image of the DNS probe is empty")
+
+		builder.errorMsg = "dns probe 'image' cannot be empty"
+
+		return builder
+	}
+
+	return builder
+}
+
+// WithNode schedules the probe pod on the provided node, so resolution is checked from that node.
+func (builder *DNSProbeBuilder) WithNode(nodeName string) *DNSProbeBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	klog.V(100).Infof("Setting node of DNS probe %s to %s", builder.name, nodeName)
+
+	if nodeName == "" {
+		klog.V(100).Info("The DNS probe node name is empty")
+
+		builder.errorMsg = "dns probe 'nodeName' cannot be empty"
+
+		return builder
+	}
+
+	builder.nodeName = nodeName
+
+	return builder
+}
+
+// Run creates the probe pod, resolves each name using every nameserver of the pod, and deletes the pod. The timeout
+// applies separately to the pod becoming running and to its deletion. Names that fail to resolve do not cause an
+// error, use DNSReport.Error to check them.
+func (builder *DNSProbeBuilder) Run(timeout time.Duration, names ...string) (*DNSReport, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	klog.V(100).Infof("Running DNS probe %s in namespace %s for names %v", builder.name, builder.nsname, names)
+
+	if len(names) == 0 {
+		klog.V(100).Info("The DNS probe names are empty")
+
+		return nil, fmt.Errorf("dns probe names cannot be empty")
+	}
+
+	podBuilder := pod.NewBuilder(builder.apiClient, builder.name, builder.nsname, builder.image).
+		RedefineDefaultCMD([]string{"/bin/sh", "-c", "sleep infinity"}).
+		WithLabel("app", builder.name)
+
+	if builder.nodeName != "" {
+		podBuilder = podBuilder.DefineOnNode(builder.nodeName)
+	}
+
+	podBuilder, err := podBuilder.Create()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create dns probe pod %s: %w", builder.name, err)
+	}
+
+	// The probe pod is short-lived, so it is deleted even if it never becomes running.
+	defer func() {
+		_, err := podBuilder.DeleteAndWait(timeout)
+		if err != nil {
+			klog.V(100).Infof("Failed to delete dns probe pod %s: %v", builder.name, err)
+		}
+	}()
+
+	err = podBuilder.WaitUntilRunning(timeout)
+	if err != nil {
+		return nil, fmt.Errorf("dns probe pod %s is not running: %w", builder.name, err)
+	}
+
+	return runDNSProbe(func(command []string) (string, error) {
+		output, err := podBuilder.ExecCommand(command)
+
+		return output.String(), err
+	}, names)
+}
+
+// AssertResolution runs the probe and returns an error with per nameserver diagnostics unless every name resolved
+// using every nameserver. It is meant to be used as a network health gate.
+func (builder *DNSProbeBuilder) AssertResolution(timeout time.Duration, names ...string) error {
+	report, err := builder.Run(timeout, names...)
+	if err != nil {
+		return err
+	}
+
+	return report.Error()
+}
+
+// Resolved returns whether the name resolved using every nameserver.
+func (result DNSResult) Resolved() bool {
+	if len(result.Nameservers) == 0 {
+		return false
+	}
+
+	for _, nameserverResult := range result.Nameservers {
+		if len(nameserverResult.Addresses) == 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Failed returns the results of the names that did not resolve using every nameserver.
+func (report *DNSReport) Failed() []DNSResult {
+	if report == nil {
+		return nil
+	}
+
+	var failed []DNSResult
+
+	for _, result := range report.Results {
+		if !result.Resolved() {
+			failed = append(failed, result)
+		}
+	}
+
+	return failed
+}
+
+// Error returns nil if every name resolved using every nameserver. Otherwise, it returns an error listing the failure
+// of each nameserver for each name that did not resolve.
+func (report *DNSReport) Error() error {
+	if report == nil {
+		return fmt.Errorf("dns report cannot be nil")
+	}
+
+	failed := report.Failed()
+	if len(failed) == 0 {
+		return nil
+	}
+
+	var failures []string
+
+	for _, result := range failed {
+		if len(result.Nameservers) == 0 {
+			failures = append(failures, fmt.Sprintf("%s: no nameservers", result.Name))
+
+			continue
+		}
+
+		for _, nameserverResult := range result.Nameservers {
+			if nameserverResult.Error != "" {
+				failures = append(failures,
+					fmt.Sprintf("%s via %s: %s", result.Name, nameserverResult.Nameserver, nameserverResult.Error))
+			}
+		}
+	}
+
+	return fmt.Errorf("failed to resolve %d of %d names: %s",
+		len(failed), len(report.Results), strings.Join(failures, "; "))
+}
+
+// runDNSProbe reads the resolv.conf of the probe pod using exec and resolves each name using each of its nameservers.
+func runDNSProbe(exec func(command []string) (string, error), names []string) (*DNSReport, error) {
+	resolvConf, err := exec([]string{"cat", "/etc/resolv.conf"})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read resolv.conf of dns probe pod: %w", err)
+	}
+
+	report := &DNSReport{}
+	report.Nameservers, report.SearchDomains = parseResolvConf(resolvConf)
+
+	for _, name := range names {
+		result := DNSResult{Name: name}
+
+		for _, nameserver := range report.Nameservers {
+			output, err := exec([]string{
+				"dig", "+short", "+search", "+time=" + strconv.Itoa(dnsQueryTimeoutSeconds), "+tries=1",
+				"@" + nameserver, name, "A", name, "AAAA",
+			})
+
+			nameserverResult := DNSNameserverResult{Nameserver: nameserver}
+			nameserverResult.Addresses, nameserverResult.Error = parseDigOutput(output, err)
+
+			result.Nameservers = append(result.Nameservers, nameserverResult)
+		}
+
+		report.Results = append(report.Results, result)
+	}
+
+	return report, nil
+}
+
+// parseResolvConf returns the nameservers and search domains listed in the resolv.conf content.
+func parseResolvConf(content string) ([]string, []string) {
+	var nameservers, searchDomains []string
+
+	for line := range strings.SplitSeq(content, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) < 2 {
+			continue
+		}
+
+		switch fields[0] {
+		case "nameserver":
+			nameservers = append(nameservers, fields[1])
+		case "search":
+			searchDomains = fields[1:]
+		}
+	}
+
+	return nameservers, searchDomains
+}
+
+// parseDigOutput returns the addresses in the output of dig +short, ignoring CNAME targets, along with a description of
+// the failure if there are none.
+func parseDigOutput(output string, execErr error) ([]netip.Addr, string) {
+	var (
+		addresses []netip.Addr
+		comments  []string
+	)
+
+	for line := range strings.SplitSeq(output, "\n") {
+		line = strings.TrimSpace(line)
+
+		if comment, found := strings.CutPrefix(line, ";;"); found {
+			comments = append(comments, strings.TrimSpace(comment))
+
+			continue
+		}
+
+		if address, err := netip.ParseAddr(line); err == nil && !slices.Contains(addresses, address) {
+			addresses = append(addresses, address)
+		}
+	}
+
+	if len(addresses) > 0 {
+		return addresses, ""
+	}
+
+	switch {
+	case len(comments) > 0:
+		return nil, strings.Join(comments, ", ")
+	case execErr != nil:
+		return nil, execErr.Error()
+	default:
+		return nil, "no addresses"
+	}
+}
+
+// validate will check that the builder is properly initialized before accessing any member fields.
+func (builder *DNSProbeBuilder) validate() (bool, error) {
+	if builder == nil {
+		klog.V(100).Info("The DNS probe builder is uninitialized")
+
+		return false, fmt.Errorf("error: received nil dns probe builder")
+	}
+
+	if builder.apiClient == nil {
+		klog.V(100).Info("The DNS probe builder apiclient is nil")
+
+		return false, fmt.Errorf("dns probe builder cannot have nil apiClient")
+	}
+
+	if builder.errorMsg != "" {
+		klog.V(100).Infof("The DNS probe builder has error message: %s", builder.errorMsg)
+
+		return false, fmt.Errorf("%s", builder.errorMsg)
+	}
+
+	return true, nil
+}
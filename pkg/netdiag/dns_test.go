@@ -0,0 +1,242 @@
+package netdiag
+
+import (
+	"errors"
+	"net/netip"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	defaultDNSProbeName = "dns-probe"
+	defaultResolvConf   = "search netdiag-ns.svc.cluster.local svc.cluster.local cluster.local\nnameserver 172.30.0.10\n" +
+		"nameserver fd02::a\noptions ndots:5\n"
+	digTimeoutOutput = ";; communications error to 172.30.0.10#53: timed out\n" +
+		";; no servers could be reached\n"
+)
+
+func TestNewDNSProbeBuilder(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		probeName     string
+		nsname        string
+		image         string
+		client        bool
+		expectedError string
+	}{
+		{
+			name:      "valid builder",
+			probeName: defaultDNSProbeName,
+			nsname:    defaultNetdiagNamespace,
+			image:     defaultNetdiagImage,
+			client:    true,
+		},
+		{
+			name:          "empty name",
+			nsname:        defaultNetdiagNamespace,
+			image:         defaultNetdiagImage,
+			client:        true,
+			expectedError: "dns probe 'name' cannot be empty",
+		},
+		{
+			name:          "empty namespace",
+			probeName:     defaultDNSProbeName,
+			image:         defaultNetdiagImage,
+			client:        true,
+			expectedError: "dns probe 'nsname' cannot be empty",
+		},
+		{
+			name:          "empty image",
+			probeName:     defaultDNSProbeName,
+			nsname:        defaultNetdiagNamespace,
+			client:        true,
+			expectedError: "dns probe 'image' cannot be empty",
+		},
+		{
+			name:          "nil client",
+			probeName:     defaultDNSProbeName,
+			nsname:        defaultNetdiagNamespace,
+			image:         defaultNetdiagImage,
+			expectedError: "dns probe builder cannot have nil apiClient",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			var testSettings *clients.Settings
+
+			if testCase.client {
+				testSettings = clients.GetTestClients(clients.TestClientParams{})
+			}
+
+			testBuilder := NewDNSProbeBuilder(testSettings, testCase.probeName, testCase.nsname, testCase.image)
+			require.NotNil(t, testBuilder)
+
+			_, err := testBuilder.validate()
+			if testCase.expectedError == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, testCase.expectedError)
+			}
+		})
+	}
+}
+
+func TestDNSProbeBuilderWithNode(t *testing.T) {
+	t.Parallel()
+
+	testBuilder := buildValidDNSProbeBuilder().WithNode("worker-0")
+	assert.Equal(t, "worker-0", testBuilder.nodeName)
+	assert.Empty(t, testBuilder.errorMsg)
+
+	testBuilder = buildValidDNSProbeBuilder().WithNode("")
+	assert.Equal(t, "dns probe 'nodeName' cannot be empty", testBuilder.errorMsg)
+}
+
+func TestDNSProbeBuilderRunValidation(t *testing.T) {
+	t.Parallel()
+
+	_, err := buildValidDNSProbeBuilder().Run(time.Second)
+	assert.EqualError(t, err, "dns probe names cannot be empty")
+
+	err = buildValidDNSProbeBuilder().WithNode("").AssertResolution(time.Second, "kubernetes.default.svc")
+	assert.EqualError(t, err, "dns probe 'nodeName' cannot be empty")
+
+	var nilBuilder *DNSProbeBuilder
+
+	_, err = nilBuilder.Run(time.Second, "kubernetes.default.svc")
+	assert.EqualError(t, err, "error: received nil dns probe builder")
+}
+
+func TestRunDNSProbe(t *testing.T) {
+	t.Parallel()
+
+	serviceName := ServiceDNSName("kubernetes", "default")
+	assert.Equal(t, "kubernetes.default.svc", serviceName)
+
+	outputs := map[string]string{
+		"cat /etc/resolv.conf":        defaultResolvConf,
+		"@172.30.0.10 " + serviceName: "172.30.0.1\n",
+		"@fd02::a " + serviceName:     "172.30.0.1\n",
+		"@172.30.0.10 quay.io":        "quay.io.\n3.224.48.34\n2600:1f18::1\n",
+		"@fd02::a quay.io":            digTimeoutOutput,
+	}
+
+	exec := func(command []string) (string, error) {
+		if command[0] == "cat" {
+			return outputs[strings.Join(command, " ")], nil
+		}
+
+		require.Equal(t, []string{"dig", "+short", "+search", "+time=2", "+tries=1"}, command[:5])
+
+		key := strings.Join(command[5:7], " ")
+		if strings.HasPrefix(outputs[key], ";;") {
+			return outputs[key], errors.New("command terminated with exit code 9")
+		}
+
+		return outputs[key], nil
+	}
+
+	report, err := runDNSProbe(exec, []string{serviceName, "quay.io"})
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"172.30.0.10", "fd02::a"}, report.Nameservers)
+	assert.Equal(t, []string{"netdiag-ns.svc.cluster.local", "svc.cluster.local", "cluster.local"},
+		report.SearchDomains)
+	require.Len(t, report.Results, 2)
+
+	assert.True(t, report.Results[0].Resolved())
+	assert.Equal(t, []netip.Addr{netip.MustParseAddr("172.30.0.1")}, report.Results[0].Nameservers[0].Addresses)
+
+	assert.False(t, report.Results[1].Resolved())
+	assert.Equal(t, []netip.Addr{netip.MustParseAddr("3.224.48.34"), netip.MustParseAddr("2600:1f18::1")},
+		report.Results[1].Nameservers[0].Addresses)
+	assert.Equal(t, "communications error to 172.30.0.10#53: timed out, no servers could be reached",
+		report.Results[1].Nameservers[1].Error)
+
+	assert.Equal(t, []DNSResult{report.Results[1]}, report.Failed())
+	assert.EqualError(t, report.Error(), "failed to resolve 1 of 2 names: quay.io via fd02::a: "+
+		"communications error to 172.30.0.10#53: timed out, no servers could be reached")
+
+	_, err = runDNSProbe(func([]string) (string, error) { return "", errors.New("exec failed") }, []string{"quay.io"})
+	assert.EqualError(t, err, "failed to read resolv.conf of dns probe pod: exec failed")
+}
+
+func TestDNSReportError(t *testing.T) {
+	t.Parallel()
+
+	var nilReport *DNSReport
+
+	assert.EqualError(t, nilReport.Error(), "dns report cannot be nil")
+	assert.Nil(t, nilReport.Failed())
+
+	report := &DNSReport{Results: []DNSResult{{Name: "quay.io"}}}
+	assert.EqualError(t, report.Error(), "failed to resolve 1 of 1 names: quay.io: no nameservers")
+
+	report = &DNSReport{Results: []DNSResult{{
+		Name: "quay.io",
+		Nameservers: []DNSNameserverResult{
+			{Nameserver: "172.30.0.10", Addresses: []netip.Addr{netip.MustParseAddr("3.224.48.34")}},
+		},
+	}}}
+	assert.NoError(t, report.Error())
+}
+
+func TestParseDigOutput(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name              string
+		output            string
+		execErr           error
+		expectedAddresses []netip.Addr
+		expectedError     string
+	}{
+		{
+			name:              "addresses with CNAME and duplicates",
+			output:            "registry.example.com.\n10.0.0.1\n10.0.0.1\nfd00::1\n",
+			expectedAddresses: []netip.Addr{netip.MustParseAddr("10.0.0.1"), netip.MustParseAddr("fd00::1")},
+		},
+		{
+			name:          "NXDOMAIN",
+			output:        "",
+			expectedError: "no addresses",
+		},
+		{
+			name:          "timeout",
+			output:        digTimeoutOutput,
+			execErr:       errors.New("command terminated with exit code 9"),
+			expectedError: "communications error to 172.30.0.10#53: timed out, no servers could be reached",
+		},
+		{
+			name:          "exec failure",
+			execErr:       errors.New("executable file not found"),
+			expectedError: "executable file not found",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			addresses, errorMsg := parseDigOutput(testCase.output, testCase.execErr)
+			assert.Equal(t, testCase.expectedAddresses, addresses)
+			assert.Equal(t, testCase.expectedError, errorMsg)
+		})
+	}
+}
+
+func buildValidDNSProbeBuilder() *DNSProbeBuilder {
+	return NewDNSProbeBuilder(
+		clients.GetTestClients(clients.TestClientParams{}), defaultDNSProbeName, defaultNetdiagNamespace,
+		defaultNetdiagImage)
+}
@@ -0,0 +1,296 @@
+package netdiag
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/klog/v2"
+)
+
+const (
+	// connectivityProbe is the payload sent by CheckConnectivity and echoed back by the server.
+	connectivityProbe = "netdiag-probe"
+	// connectivityTimeoutSeconds is how long ncat waits for the server to respond in CheckConnectivity.
+	connectivityTimeoutSeconds = 5
+	// serverStartDelay is how long to wait after starting a server in the background before connecting to it.
+	serverStartDelay = time.Second
+)
+
+var (
+	pingPacketsRegex = regexp.MustCompile(`(\d+) packets transmitted, (\d+) (?:packets )?received`)
+	pingRTTRegex     = regexp.MustCompile(`(?:rtt|round-trip) min/avg/max(?:/mdev)? = ([\d.]+)/([\d.]+)/([\d.]+)`)
+)
+
+// LatencyResult contains the results of a ping run between the client and server pods.
+type LatencyResult struct {
+	// Transmitted is the number of ICMP echo requests sent.
+	Transmitted int
+	// Received is the number of ICMP echo replies received.
+	Received int
+	// PacketLoss is the percentage of requests that did not receive a reply.
+	PacketLoss float64
+	// Min is the minimum round-trip time.
+	Min time.Duration
+	// Avg is the average round-trip time.
+	Avg time.Duration
+	// Max is the maximum round-trip time.
+	Max time.Duration
+}
+
+// ThroughputResult contains the results of an iperf3 run between the client and server pods.
+type ThroughputResult struct {
+	// Protocol is the protocol the throughput was measured over.
+	Protocol corev1.Protocol
+	// BitsPerSecond is the throughput measured by the receiver.
+	BitsPerSecond float64
+	// Retransmits is the number of TCP retransmits. It is always zero for UDP.
+	Retransmits int
+	// LostPercent is the percentage of lost UDP datagrams. It is always zero for TCP.
+	LostPercent float64
+}
+
+// iperfOutput is the subset of the iperf3 JSON output used to build a ThroughputResult.
+type iperfOutput struct {
+	End struct {
+		SumSent struct {
+			Retransmits int `json:"retransmits"`
+		} `json:"sum_sent"`
+		SumReceived struct {
+			BitsPerSecond float64 `json:"bits_per_second"`
+		} `json:"sum_received"`
+		Sum struct {
+			BitsPerSecond float64 `json:"bits_per_second"`
+			LostPercent   float64 `json:"lost_percent"`
+		} `json:"sum"`
+	} `json:"end"`
+	Error string `json:"error"`
+}
+
+// MeasureLatency sends count ICMP echo requests from the client pod to the target IP and returns the measured
+// round-trip times. An error is returned if no replies are received.
+func (builder *Builder) MeasureLatency(count int) (*LatencyResult, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	klog.V(100).Infof("Measuring latency of netdiag %s with %d packets", builder.name, count)
+
+	if count < 1 {
+		klog.V(100).Infof("The ping count %d is less than 1", count)
+
+		return nil, fmt.Errorf("netdiag ping count cannot be less than 1")
+	}
+
+	targetIP, err := builder.getTargetIP()
+	if err != nil {
+		return nil, err
+	}
+
+	output, err := builder.ClientPod.ExecCommand([]string{"ping", "-q", "-c", strconv.Itoa(count), targetIP})
+	if err != nil {
+		klog.V(100).Infof("Ping from netdiag %s client failed: %v", builder.name, err)
+	}
+
+	result, parseErr := parsePingOutput(output.String())
+	if parseErr != nil {
+		return nil, fmt.Errorf("failed to measure latency to %s: %w", targetIP, parseErr)
+	}
+
+	return result, nil
+}
+
+// MeasureThroughput runs iperf3 from the client pod to the server pod for the provided duration and returns the
+// measured throughput. Only TCP and UDP are supported.
+func (builder *Builder) MeasureThroughput(duration time.Duration, protocol corev1.Protocol) (*ThroughputResult, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	klog.V(100).Infof("Measuring %s throughput of netdiag %s for %s", protocol, builder.name, duration)
+
+	if protocol != corev1.ProtocolTCP && protocol != corev1.ProtocolUDP {
+		klog.V(100).Infof("The protocol %s is not supported by iperf3", protocol)
+
+		return nil, fmt.Errorf("netdiag throughput protocol must be TCP or UDP, got %q", protocol)
+	}
+
+	seconds := int(math.Ceil(duration.Seconds()))
+	if seconds < 1 {
+		klog.V(100).Infof("The duration %s is less than one second", duration)
+
+		return nil, fmt.Errorf("netdiag throughput duration cannot be less than 1s")
+	}
+
+	targetIP, err := builder.getTargetIP()
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = builder.ServerPod.ExecCommand([]string{"iperf3", "--server", "--one-off", "--daemon"})
+	if err != nil {
+		return nil, fmt.Errorf("failed to start iperf3 server: %w", err)
+	}
+
+	time.Sleep(serverStartDelay)
+
+	command := []string{"iperf3", "--client", targetIP, "--time", strconv.Itoa(seconds), "--json"}
+	if protocol == corev1.ProtocolUDP {
+		command = append(command, "--udp", "--bitrate", "0")
+	}
+
+	output, err := builder.ClientPod.ExecCommand(command)
+	if err != nil {
+		klog.V(100).Infof("iperf3 from netdiag %s client failed: %v", builder.name, err)
+	}
+
+	result, parseErr := parseIperfOutput(output.String(), protocol)
+	if parseErr != nil {
+		return nil, fmt.Errorf("failed to measure throughput to %s: %w", targetIP, parseErr)
+	}
+
+	return result, nil
+}
+
+// CheckConnectivity starts an echo server on the provided port and protocol in the server pod and checks that the
+// client pod can reach it. TCP, UDP, and SCTP are supported.
+func (builder *Builder) CheckConnectivity(protocol corev1.Protocol, port int32) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	klog.V(100).Infof("Checking %s connectivity of netdiag %s on port %d", protocol, builder.name, port)
+
+	protocolFlag, err := getNcatProtocolFlag(protocol)
+	if err != nil {
+		return err
+	}
+
+	if port < 1 || port > math.MaxUint16 {
+		klog.V(100).Infof("The port %d is invalid", port)
+
+		return fmt.Errorf("netdiag port must be between 1 and %d, got %d", math.MaxUint16, port)
+	}
+
+	targetIP, err := builder.getTargetIP()
+	if err != nil {
+		return err
+	}
+
+	serverCommand := fmt.Sprintf("nohup timeout %d ncat --listen %s %d --exec /bin/cat >/dev/null 2>&1 &",
+		connectivityTimeoutSeconds*2, protocolFlag, port)
+
+	_, err = builder.ServerPod.ExecCommand([]string{"/bin/sh", "-c", serverCommand})
+	if err != nil {
+		return fmt.Errorf("failed to start %s server on port %d: %w", protocol, port, err)
+	}
+
+	time.Sleep(serverStartDelay)
+
+	clientCommand := fmt.Sprintf("echo %s | ncat --wait %d --idle-timeout %d %s %s %d",
+		connectivityProbe, connectivityTimeoutSeconds, connectivityTimeoutSeconds, protocolFlag, targetIP, port)
+
+	output, err := builder.ClientPod.ExecCommand([]string{"/bin/sh", "-c", clientCommand})
+	if !strings.Contains(output.String(), connectivityProbe) {
+		klog.V(100).Infof("The netdiag %s client did not receive the probe back: %q, %v",
+			builder.name, output.String(), err)
+
+		return fmt.Errorf("no %s connectivity to %s on port %d", protocol, targetIP, port)
+	}
+
+	return nil
+}
+
+// parsePingOutput parses the summary of the iputils or busybox ping output into a LatencyResult.
+func parsePingOutput(output string) (*LatencyResult, error) {
+	packets := pingPacketsRegex.FindStringSubmatch(output)
+	if packets == nil {
+		return nil, fmt.Errorf("ping output does not contain a packet summary: %q", output)
+	}
+
+	transmitted, _ := strconv.Atoi(packets[1])
+	received, _ := strconv.Atoi(packets[2])
+
+	if received == 0 {
+		return nil, fmt.Errorf("no ping replies received out of %d requests", transmitted)
+	}
+
+	result := &LatencyResult{
+		Transmitted: transmitted,
+		Received:    received,
+		PacketLoss:  100 * float64(transmitted-received) / float64(transmitted),
+	}
+
+	rtt := pingRTTRegex.FindStringSubmatch(output)
+	if rtt == nil {
+		return nil, fmt.Errorf("ping output does not contain a round-trip summary: %q", output)
+	}
+
+	durations := make([]time.Duration, 0, 3)
+
+	for _, millis := range rtt[1:] {
+		value, err := strconv.ParseFloat(millis, 64)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse round-trip time %q: %w", millis, err)
+		}
+
+		durations = append(durations, time.Duration(value*float64(time.Millisecond)))
+	}
+
+	result.Min, result.Avg, result.Max = durations[0], durations[1], durations[2]
+
+	return result, nil
+}
+
+// parseIperfOutput parses the JSON output of an iperf3 client run into a ThroughputResult.
+func parseIperfOutput(output string, protocol corev1.Protocol) (*ThroughputResult, error) {
+	// The exec TTY may prefix the JSON document with other output, so start from the first brace.
+	start := strings.Index(output, "{")
+	if start < 0 {
+		return nil, fmt.Errorf("iperf3 output is not JSON: %q", output)
+	}
+
+	var parsed iperfOutput
+
+	err := json.Unmarshal([]byte(output[start:]), &parsed)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse iperf3 output: %w", err)
+	}
+
+	if parsed.Error != "" {
+		return nil, fmt.Errorf("iperf3 failed: %s", parsed.Error)
+	}
+
+	result := &ThroughputResult{Protocol: protocol}
+
+	if protocol == corev1.ProtocolUDP {
+		result.BitsPerSecond = parsed.End.Sum.BitsPerSecond
+		result.LostPercent = parsed.End.Sum.LostPercent
+	} else {
+		result.BitsPerSecond = parsed.End.SumReceived.BitsPerSecond
+		result.Retransmits = parsed.End.SumSent.Retransmits
+	}
+
+	return result, nil
+}
+
+// getNcatProtocolFlag returns the ncat flag selecting the provided protocol.
+func getNcatProtocolFlag(protocol corev1.Protocol) (string, error) {
+	switch protocol {
+	case corev1.ProtocolTCP:
+		return "", nil
+	case corev1.ProtocolUDP:
+		return "--udp", nil
+	case corev1.ProtocolSCTP:
+		return "--sctp", nil
+	default:
+		klog.V(100).Infof("The protocol %s is not supported by ncat", protocol)
+
+		return "", fmt.Errorf("netdiag connectivity protocol must be TCP, UDP, or SCTP, got %q", protocol)
+	}
+}
@@ -0,0 +1,204 @@
+package netdiag
+
+import (
+	"testing"
+	"time"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+)
+
+const (
+	iputilsPingOutput = `PING 10.128.0.11 (10.128.0.11) 56(84) bytes of data.
+
+--- 10.128.0.11 ping statistics ---
+4 packets transmitted, 3 received, 25% packet loss, time 3004ms
+rtt min/avg/max/mdev = 0.041/0.052/0.067/0.010 ms
+`
+	busyboxPingOutput = "PING 10.128.0.11 (10.128.0.11): 56 data bytes\r\n\r\n" +
+		"--- 10.128.0.11 ping statistics ---\r\n" +
+		"2 packets transmitted, 2 packets received, 0% packet loss\r\n" +
+		"round-trip min/avg/max = 1.500/2.000/2.500 ms\r\n"
+	tcpIperfOutput = `{"start":{},"end":{"sum_sent":{"bits_per_second":9.5e9,"retransmits":12},` +
+		`"sum_received":{"bits_per_second":9.4e9}}}`
+	udpIperfOutput = `{"end":{"sum":{"bits_per_second":1.2e9,"lost_percent":0.5}}}`
+)
+
+func TestParsePingOutput(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name           string
+		output         string
+		expectedResult *LatencyResult
+		expectedError  string
+	}{
+		{
+			name:   "iputils output with loss",
+			output: iputilsPingOutput,
+			expectedResult: &LatencyResult{
+				Transmitted: 4,
+				Received:    3,
+				PacketLoss:  25,
+				Min:         41 * time.Microsecond,
+				Avg:         52 * time.Microsecond,
+				Max:         67 * time.Microsecond,
+			},
+		},
+		{
+			name:   "busybox output over tty",
+			output: busyboxPingOutput,
+			expectedResult: &LatencyResult{
+				Transmitted: 2,
+				Received:    2,
+				Min:         1500 * time.Microsecond,
+				Avg:         2 * time.Millisecond,
+				Max:         2500 * time.Microsecond,
+			},
+		},
+		{
+			name:          "no replies",
+			output:        "3 packets transmitted, 0 received, 100% packet loss, time 2040ms",
+			expectedError: "no ping replies received out of 3 requests",
+		},
+		{
+			name:          "no summary",
+			output:        "ping: connect: Network is unreachable",
+			expectedError: "ping output does not contain a packet summary: \"ping: connect: Network is unreachable\"",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			result, err := parsePingOutput(testCase.output)
+			if testCase.expectedError != "" {
+				assert.EqualError(t, err, testCase.expectedError)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expectedResult, result)
+		})
+	}
+}
+
+func TestParseIperfOutput(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name           string
+		output         string
+		protocol       corev1.Protocol
+		expectedResult *ThroughputResult
+		expectedError  string
+	}{
+		{
+			name:     "tcp",
+			output:   tcpIperfOutput,
+			protocol: corev1.ProtocolTCP,
+			expectedResult: &ThroughputResult{
+				Protocol:      corev1.ProtocolTCP,
+				BitsPerSecond: 9.4e9,
+				Retransmits:   12,
+			},
+		},
+		{
+			name:     "udp with tty prefix",
+			output:   "\r\n" + udpIperfOutput,
+			protocol: corev1.ProtocolUDP,
+			expectedResult: &ThroughputResult{
+				Protocol:      corev1.ProtocolUDP,
+				BitsPerSecond: 1.2e9,
+				LostPercent:   0.5,
+			},
+		},
+		{
+			name:          "iperf error",
+			output:        `{"error":"unable to connect to server: Connection refused"}`,
+			protocol:      corev1.ProtocolTCP,
+			expectedError: "iperf3 failed: unable to connect to server: Connection refused",
+		},
+		{
+			name:          "not json",
+			output:        "command not found",
+			protocol:      corev1.ProtocolTCP,
+			expectedError: "iperf3 output is not JSON: \"command not found\"",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			result, err := parseIperfOutput(testCase.output, testCase.protocol)
+			if testCase.expectedError != "" {
+				assert.EqualError(t, err, testCase.expectedError)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expectedResult, result)
+		})
+	}
+}
+
+func TestGetNcatProtocolFlag(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		protocol      corev1.Protocol
+		expectedFlag  string
+		expectedError string
+	}{
+		{protocol: corev1.ProtocolTCP, expectedFlag: ""},
+		{protocol: corev1.ProtocolUDP, expectedFlag: "--udp"},
+		{protocol: corev1.ProtocolSCTP, expectedFlag: "--sctp"},
+		{protocol: "ICMP", expectedError: "netdiag connectivity protocol must be TCP, UDP, or SCTP, got \"ICMP\""},
+	}
+
+	for _, testCase := range testCases {
+		flag, err := getNcatProtocolFlag(testCase.protocol)
+		if testCase.expectedError != "" {
+			assert.EqualError(t, err, testCase.expectedError)
+		} else {
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.expectedFlag, flag)
+		}
+	}
+}
+
+func TestMeasureValidation(t *testing.T) {
+	t.Parallel()
+
+	testBuilder := buildValidTestBuilder(clients.GetTestClients(clients.TestClientParams{}))
+
+	_, err := testBuilder.MeasureLatency(0)
+	assert.EqualError(t, err, "netdiag ping count cannot be less than 1")
+
+	_, err = testBuilder.MeasureLatency(3)
+	assert.EqualError(t, err, "netdiag netdiag pods have not been deployed")
+
+	_, err = testBuilder.MeasureThroughput(time.Second, corev1.ProtocolSCTP)
+	assert.EqualError(t, err, "netdiag throughput protocol must be TCP or UDP, got \"SCTP\"")
+
+	_, err = testBuilder.MeasureThroughput(0, corev1.ProtocolTCP)
+	assert.EqualError(t, err, "netdiag throughput duration cannot be less than 1s")
+
+	_, err = testBuilder.MeasureThroughput(time.Second, corev1.ProtocolTCP)
+	assert.EqualError(t, err, "netdiag netdiag pods have not been deployed")
+
+	err = testBuilder.CheckConnectivity(corev1.ProtocolTCP, 0)
+	assert.EqualError(t, err, "netdiag port must be between 1 and 65535, got 0")
+
+	err = testBuilder.CheckConnectivity("ICMP", 8080)
+	assert.EqualError(t, err, "netdiag connectivity protocol must be TCP, UDP, or SCTP, got \"ICMP\"")
+
+	err = testBuilder.CheckConnectivity(corev1.ProtocolSCTP, 8080)
+	assert.EqualError(t, err, "netdiag netdiag pods have not been deployed")
+}
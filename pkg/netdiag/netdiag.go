@@ -0,0 +1,296 @@
+package netdiag
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/pod"
+	multus "gopkg.in/k8snetworkplumbingwg/multus-cni.v4/pkg/types"
+	"k8s.io/klog/v2"
+)
+
+// Builder provides a struct for a pair of client and server pods used to diagnose the pod network between two nodes.
+// The image must provide ping, iperf3, and ncat.
+type Builder struct {
+	// ClientPod is the pod diagnostics are run from. It is nil until Deploy is called.
+	ClientPod *pod.Builder
+	// ServerPod is the pod diagnostics target. It is nil until Deploy is called.
+	ServerPod *pod.Builder
+	// name is used as the prefix of the client and server pod names.
+	name string
+	// nsname is the namespace the pods are created in.
+	nsname string
+	// image is the image used by both pods.
+	image string
+	// clientNode is the node the client pod is scheduled on. If empty, the scheduler chooses a node.
+	clientNode string
+	// serverNode is the node the server pod is scheduled on. If empty, the scheduler chooses a node.
+	serverNode string
+	// networks are the secondary networks attached to both pods.
+	networks []*multus.NetworkSelectionElement
+	// hostNetwork is whether both pods use the host network namespace.
+	hostNetwork bool
+	// targetIP overrides the server pod IP diagnostics target, such as an IP on a secondary network.
+	targetIP string
+	// api client to interact with the cluster.
+	apiClient *clients.Settings
+	// Used in functions that define or mutate the diagnostics pods. errorMsg is processed before the pods are created.
+	errorMsg string
+}
+
+// NewBuilder creates a new instance of Builder. The client and server pods are named <name>-client and <name>-server.
+func NewBuilder(apiClient *clients.Settings, name, nsname, image string) *Builder {
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	klog.V(100).Infof(
+		"Initializing new netdiag structure with the following params: name: %s, namespace: %s, image: %s",
+		name, nsname, image)
+
+	builder := &Builder{
+		name:      name,
+		nsname:    nsname,
+		image:     image,
+		apiClient: apiClient,
+	}
+
+	if name == "" {
+		klog.V(100).Info("The name of the netdiag is empty")
+
+		builder.errorMsg = "netdiag 'name' cannot be empty"
+
+		return builder
+	}
+
+	if nsname == "" {
+		klog.V(100).Info("The namespace of the netdiag is empty")
+
+		builder.errorMsg = "netdiag 'nsname' cannot be empty"
+
+		return builder
+	}
+
+	if image == "" {
+		klog.V(100).Info("The image of the netdiag is empty")
+
+		builder.errorMsg = "netdiag 'image' cannot be empty"
+
+		return builder
+	}
+
+	return builder
+}
+
+// WithClientNode schedules the client pod on the provided node.
+func (builder *Builder) WithClientNode(nodeName string) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	klog.V(100).Infof("Setting client node of netdiag %s to %s", builder.name, nodeName)
+
+	if nodeName == "" {
+		klog.V(100).Info("The client node name is empty")
+
+		builder.errorMsg = "netdiag client 'nodeName' cannot be empty"
+
+		return builder
+	}
+
+	builder.clientNode = nodeName
+
+	return builder
+}
+
+// WithServerNode schedules the server pod on the provided node.
+func (builder *Builder) WithServerNode(nodeName string) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	klog.V(100).Infof("Setting server node of netdiag %s to %s", builder.name, nodeName)
+
+	if nodeName == "" {
+		klog.V(100).Info("The server node name is empty")
+
+		builder.errorMsg = "netdiag server 'nodeName' cannot be empty"
+
+		return builder
+	}
+
+	builder.serverNode = nodeName
+
+	return builder
+}
+
+// WithSecondaryNetwork attaches the provided secondary networks to both pods. Use WithTargetIP to run diagnostics
+// against an IP on one of these networks.
+func (builder *Builder) WithSecondaryNetwork(networks []*multus.NetworkSelectionElement) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	klog.V(100).Infof("Setting secondary networks of netdiag %s to %v", builder.name, networks)
+
+	if len(networks) == 0 {
+		klog.V(100).Info("The secondary networks are empty")
+
+		builder.errorMsg = "netdiag secondary networks cannot be empty"
+
+		return builder
+	}
+
+	builder.networks = networks
+
+	return builder
+}
+
+// WithHostNetwork runs both pods in the host network namespace so the node network is diagnosed instead of the pod
+// network.
+func (builder *Builder) WithHostNetwork() *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	klog.V(100).Infof("Setting netdiag %s to use the host network", builder.name)
+
+	builder.hostNetwork = true
+
+	return builder
+}
+
+// WithTargetIP sets the IP diagnostics are run against. By default, the primary IP of the server pod is used.
+func (builder *Builder) WithTargetIP(targetIP string) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	klog.V(100).Infof("Setting target IP of netdiag %s to %s", builder.name, targetIP)
+
+	if targetIP == "" {
+		klog.V(100).Info("The target IP is empty")
+
+		builder.errorMsg = "netdiag 'targetIP' cannot be empty"
+
+		return builder
+	}
+
+	builder.targetIP = targetIP
+
+	return builder
+}
+
+// Deploy creates the client and server pods and waits up to timeout for each of them to be running.
+func (builder *Builder) Deploy(timeout time.Duration) (*Builder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	klog.V(100).Infof("Deploying netdiag %s pods in namespace %s", builder.name, builder.nsname)
+
+	var err error
+
+	builder.ServerPod, err = builder.newPodBuilder("server", builder.serverNode).CreateAndWaitUntilRunning(timeout)
+	if err != nil {
+		return builder, fmt.Errorf("failed to deploy netdiag server pod: %w", err)
+	}
+
+	builder.ClientPod, err = builder.newPodBuilder("client", builder.clientNode).CreateAndWaitUntilRunning(timeout)
+	if err != nil {
+		return builder, fmt.Errorf("failed to deploy netdiag client pod: %w", err)
+	}
+
+	return builder, nil
+}
+
+// Cleanup deletes the client and server pods and waits up to timeout for each of them to be removed.
+func (builder *Builder) Cleanup(timeout time.Duration) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	klog.V(100).Infof("Cleaning up netdiag %s pods in namespace %s", builder.name, builder.nsname)
+
+	for _, podBuilder := range []*pod.Builder{builder.ClientPod, builder.ServerPod} {
+		if podBuilder == nil {
+			continue
+		}
+
+		_, err := podBuilder.DeleteAndWait(timeout)
+		if err != nil {
+			return fmt.Errorf("failed to delete netdiag pod %s: %w", podBuilder.Definition.Name, err)
+		}
+	}
+
+	builder.ClientPod = nil
+	builder.ServerPod = nil
+
+	return nil
+}
+
+// newPodBuilder returns a pod builder for the netdiag pod with the provided role, optionally scheduled on nodeName.
+// The pod sleeps until diagnostics are run in it.
+func (builder *Builder) newPodBuilder(role, nodeName string) *pod.Builder {
+	podBuilder := pod.NewBuilder(builder.apiClient, fmt.Sprintf("%s-%s", builder.name, role), builder.nsname, builder.image).
+		RedefineDefaultCMD([]string{"/bin/sh", "-c", "sleep infinity"}).
+		WithLabel("app", builder.name).
+		WithLabel("netdiag-role", role)
+
+	if nodeName != "" {
+		podBuilder = podBuilder.DefineOnNode(nodeName)
+	}
+
+	if len(builder.networks) > 0 {
+		podBuilder = podBuilder.WithSecondaryNetwork(builder.networks)
+	}
+
+	if builder.hostNetwork {
+		podBuilder = podBuilder.WithHostNetwork().WithPrivilegedFlag()
+	}
+
+	return podBuilder
+}
+
+// getTargetIP returns the IP diagnostics are run against and ensures the pods have been deployed.
+func (builder *Builder) getTargetIP() (string, error) {
+	if builder.ClientPod == nil || builder.ServerPod == nil {
+		klog.V(100).Infof("The netdiag %s pods have not been deployed", builder.name)
+
+		return "", fmt.Errorf("netdiag %s pods have not been deployed", builder.name)
+	}
+
+	if builder.targetIP != "" {
+		return builder.targetIP, nil
+	}
+
+	if builder.ServerPod.Object == nil || builder.ServerPod.Object.Status.PodIP == "" {
+		klog.V(100).Infof("The netdiag %s server pod has no IP", builder.name)
+
+		return "", fmt.Errorf("netdiag %s server pod has no IP", builder.name)
+	}
+
+	return builder.ServerPod.Object.Status.PodIP, nil
+}
+
+// validate will check that the builder is properly initialized before accessing any member fields.
+func (builder *Builder) validate() (bool, error) {
+	if builder == nil {
+		klog.V(100).Info("The netdiag builder is uninitialized")
+
+		return false, fmt.Errorf("error: received nil netdiag builder")
+	}
+
+	if builder.apiClient == nil {
+		klog.V(100).Info("The netdiag builder apiclient is nil")
+
+		return false, fmt.Errorf("netdiag builder cannot have nil apiClient")
+	}
+
+	if builder.errorMsg != "" {
+		klog.V(100).Infof("The netdiag builder has error message: %s", builder.errorMsg)
+
+		return false, fmt.Errorf("%s", builder.errorMsg)
+	}
+
+	return true, nil
+}
@@ -0,0 +1,233 @@
+package netdiag
+
+import (
+	"testing"
+	"time"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/pod"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	multus "gopkg.in/k8snetworkplumbingwg/multus-cni.v4/pkg/types"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	defaultNetdiagName      = "netdiag"
+	defaultNetdiagNamespace = "netdiag-ns"
+	defaultNetdiagImage     = "quay.io/example/network-tools:latest"
+)
+
+func TestNewBuilder(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		netdiagName   string
+		nsname        string
+		image         string
+		client        bool
+		expectedError string
+	}{
+		{
+			name:        "valid builder",
+			netdiagName: defaultNetdiagName,
+			nsname:      defaultNetdiagNamespace,
+			image:       defaultNetdiagImage,
+			client:      true,
+		},
+		{
+			name:          "empty name",
+			nsname:        defaultNetdiagNamespace,
+			image:         defaultNetdiagImage,
+			client:        true,
+			expectedError: "netdiag 'name' cannot be empty",
+		},
+		{
+			name:          "empty namespace",
+			netdiagName:   defaultNetdiagName,
+			image:         defaultNetdiagImage,
+			client:        true,
+			expectedError: "netdiag 'nsname' cannot be empty",
+		},
+		{
+			name:          "empty image",
+			netdiagName:   defaultNetdiagName,
+			nsname:        defaultNetdiagNamespace,
+			client:        true,
+			expectedError: "netdiag 'image' cannot be empty",
+		},
+		{
+			name:          "nil client",
+			netdiagName:   defaultNetdiagName,
+			nsname:        defaultNetdiagNamespace,
+			image:         defaultNetdiagImage,
+			expectedError: "netdiag builder cannot have nil apiClient",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			var testSettings *clients.Settings
+
+			if testCase.client {
+				testSettings = clients.GetTestClients(clients.TestClientParams{})
+			}
+
+			testBuilder := NewBuilder(testSettings, testCase.netdiagName, testCase.nsname, testCase.image)
+			require.NotNil(t, testBuilder)
+
+			_, err := testBuilder.validate()
+			if testCase.expectedError == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, testCase.expectedError)
+			}
+		})
+	}
+}
+
+func TestBuilderWithOptions(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		configure     func(builder *Builder) *Builder
+		expectedError string
+	}{
+		{
+			name: "valid nodes and target",
+			configure: func(builder *Builder) *Builder {
+				return builder.WithClientNode("worker-0").WithServerNode("worker-1").WithTargetIP("192.168.1.10")
+			},
+		},
+		{
+			name:          "empty client node",
+			configure:     func(builder *Builder) *Builder { return builder.WithClientNode("") },
+			expectedError: "netdiag client 'nodeName' cannot be empty",
+		},
+		{
+			name:          "empty server node",
+			configure:     func(builder *Builder) *Builder { return builder.WithServerNode("") },
+			expectedError: "netdiag server 'nodeName' cannot be empty",
+		},
+		{
+			name:          "empty target IP",
+			configure:     func(builder *Builder) *Builder { return builder.WithTargetIP("") },
+			expectedError: "netdiag 'targetIP' cannot be empty",
+		},
+		{
+			name: "valid secondary network",
+			configure: func(builder *Builder) *Builder {
+				return builder.WithSecondaryNetwork([]*multus.NetworkSelectionElement{{Name: "sriov-net"}})
+			},
+		},
+		{
+			name:          "empty secondary network",
+			configure:     func(builder *Builder) *Builder { return builder.WithSecondaryNetwork(nil) },
+			expectedError: "netdiag secondary networks cannot be empty",
+		},
+		{
+			name:      "host network",
+			configure: func(builder *Builder) *Builder { return builder.WithHostNetwork() },
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			testBuilder := testCase.configure(buildValidTestBuilder(clients.GetTestClients(clients.TestClientParams{})))
+
+			if testCase.expectedError == "" {
+				assert.Empty(t, testBuilder.errorMsg)
+			} else {
+				assert.Equal(t, testCase.expectedError, testBuilder.errorMsg)
+			}
+		})
+	}
+}
+
+func TestBuilderNewPodBuilder(t *testing.T) {
+	t.Parallel()
+
+	testBuilder := buildValidTestBuilder(clients.GetTestClients(clients.TestClientParams{})).
+		WithServerNode("worker-1").
+		WithSecondaryNetwork([]*multus.NetworkSelectionElement{{Name: "sriov-net"}}).
+		WithHostNetwork()
+
+	podBuilder := testBuilder.newPodBuilder("server", testBuilder.serverNode)
+
+	assert.Equal(t, "netdiag-server", podBuilder.Definition.Name)
+	assert.Equal(t, defaultNetdiagNamespace, podBuilder.Definition.Namespace)
+	assert.Equal(t, "worker-1", podBuilder.Definition.Spec.NodeName)
+	assert.True(t, podBuilder.Definition.Spec.HostNetwork)
+	assert.Equal(t, "server", podBuilder.Definition.Labels["netdiag-role"])
+	assert.Contains(t, podBuilder.Definition.Annotations, "k8s.v1.cni.cncf.io/networks")
+}
+
+func TestBuilderGetTargetIP(t *testing.T) {
+	t.Parallel()
+
+	testBuilder := buildValidTestBuilder(clients.GetTestClients(clients.TestClientParams{}))
+
+	_, err := testBuilder.getTargetIP()
+	assert.EqualError(t, err, "netdiag netdiag pods have not been deployed")
+
+	testBuilder.ClientPod = buildRunningPodBuilder(t, "netdiag-client", "10.128.0.10")
+	testBuilder.ServerPod = buildRunningPodBuilder(t, "netdiag-server", "10.128.0.11")
+
+	targetIP, err := testBuilder.getTargetIP()
+	assert.NoError(t, err)
+	assert.Equal(t, "10.128.0.11", targetIP)
+
+	targetIP, err = testBuilder.WithTargetIP("192.168.1.10").getTargetIP()
+	assert.NoError(t, err)
+	assert.Equal(t, "192.168.1.10", targetIP)
+}
+
+func TestBuilderDeployAndCleanup(t *testing.T) {
+	t.Parallel()
+
+	testBuilder := buildValidTestBuilder(clients.GetTestClients(clients.TestClientParams{}))
+
+	_, err := testBuilder.Deploy(time.Second)
+	assert.ErrorContains(t, err, "failed to deploy netdiag server pod")
+
+	err = testBuilder.Cleanup(time.Second)
+	assert.NoError(t, err)
+	assert.Nil(t, testBuilder.ClientPod)
+	assert.Nil(t, testBuilder.ServerPod)
+
+	_, err = NewBuilder(clients.GetTestClients(clients.TestClientParams{}), "", defaultNetdiagNamespace,
+		defaultNetdiagImage).Deploy(time.Second)
+	assert.EqualError(t, err, "netdiag 'name' cannot be empty")
+}
+
+func buildValidTestBuilder(apiClient *clients.Settings) *Builder {
+	return NewBuilder(apiClient, defaultNetdiagName, defaultNetdiagNamespace, defaultNetdiagImage)
+}
+
+// buildRunningPodBuilder pulls a running pod with the provided name and IP from a test client containing it.
+func buildRunningPodBuilder(t *testing.T, name, podIP string) *pod.Builder {
+	t.Helper()
+
+	testPod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: defaultNetdiagNamespace},
+		Status:     corev1.PodStatus{Phase: corev1.PodRunning, PodIP: podIP},
+	}
+
+	podBuilder, err := pod.Pull(
+		clients.GetTestClients(clients.TestClientParams{
+			K8sMockObjects:  []runtime.Object{testPod},
+			SchemeAttachers: []clients.SchemeAttacher{corev1.AddToScheme},
+		}),
+		name, defaultNetdiagNamespace)
+	require.NoError(t, err)
+
+	return podBuilder
+}
@@ -0,0 +1,191 @@
+// Package netparam provides the IP address and CIDR validation shared by the builders that accept network parameters,
+// so that IPv4, IPv6 and dual-stack inputs are handled the same way everywhere.
+//
+// Addresses are accepted with or without surrounding whitespace and with or without the square brackets used for IPv6
+// addresses in URLs and host:port strings. IPv6 zone identifiers, such as the %eth0 in fe80::1%eth0, are accepted for
+// addresses but not for CIDRs. IPv4-mapped IPv6 addresses, such as ::ffff:192.0.2.1, are treated as IPv4 addresses.
+package netparam
+
+import (
+	"fmt"
+	"net"
+	"net/netip"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+// ParseIP parses an IPv4 or IPv6 address, stripping surrounding whitespace and brackets. Zone identifiers are kept.
+func ParseIP(address string) (netip.Addr, error) {
+	trimmed := strings.TrimSpace(address)
+
+	if strings.HasPrefix(trimmed, "[") && strings.HasSuffix(trimmed, "]") {
+		trimmed = trimmed[1 : len(trimmed)-1]
+	}
+
+	ipAddress, err := netip.ParseAddr(trimmed)
+	if err != nil {
+		return netip.Addr{}, fmt.Errorf("%q is not a valid IP address", address)
+	}
+
+	return ipAddress, nil
+}
+
+// Normalize returns the canonical form of an IPv4 or IPv6 address without brackets or surrounding whitespace, such as
+// 2001:db8::1 for [2001:DB8:0::1], so it can be stored in API fields that expect a bare address.
+func Normalize(address string) (string, error) {
+	ipAddress, err := ParseIP(address)
+	if err != nil {
+		return "", err
+	}
+
+	return ipAddress.String(), nil
+}
+
+// ParseCIDR parses an IPv4 or IPv6 CIDR, stripping surrounding whitespace. The address part of the CIDR does not need
+// to be the network address, so 192.0.2.10/24 is accepted.
+func ParseCIDR(cidr string) (netip.Prefix, error) {
+	prefix, err := netip.ParsePrefix(strings.TrimSpace(cidr))
+	if err != nil {
+		return netip.Prefix{}, fmt.Errorf("%q is not a valid CIDR", cidr)
+	}
+
+	return prefix, nil
+}
+
+// ValidateIP checks that address is a valid IPv4 or IPv6 address.
+func ValidateIP(address string) error {
+	_, err := ParseIP(address)
+
+	return err
+}
+
+// ValidateIPv4 checks that address is a valid IPv4 address.
+func ValidateIPv4(address string) error {
+	ipAddress, err := ParseIP(address)
+	if err != nil {
+		return err
+	}
+
+	if !isIPv4(ipAddress) {
+		return fmt.Errorf("%q is not a valid IPv4 address", address)
+	}
+
+	return nil
+}
+
+// ValidateIPv6 checks that address is a valid IPv6 address. IPv4-mapped IPv6 addresses are rejected.
+func ValidateIPv6(address string) error {
+	ipAddress, err := ParseIP(address)
+	if err != nil {
+		return err
+	}
+
+	if isIPv4(ipAddress) {
+		return fmt.Errorf("%q is not a valid IPv6 address", address)
+	}
+
+	return nil
+}
+
+// ValidateCIDR checks that cidr is a valid IPv4 or IPv6 CIDR.
+func ValidateCIDR(cidr string) error {
+	_, err := ParseCIDR(cidr)
+
+	return err
+}
+
+// ValidateIPv4CIDR checks that cidr is a valid IPv4 CIDR.
+func ValidateIPv4CIDR(cidr string) error {
+	prefix, err := ParseCIDR(cidr)
+	if err != nil {
+		return err
+	}
+
+	if !isIPv4(prefix.Addr()) {
+		return fmt.Errorf("%q is not a valid IPv4 CIDR", cidr)
+	}
+
+	return nil
+}
+
+// ValidateIPv6CIDR checks that cidr is a valid IPv6 CIDR. IPv4-mapped IPv6 CIDRs are rejected.
+func ValidateIPv6CIDR(cidr string) error {
+	prefix, err := ParseCIDR(cidr)
+	if err != nil {
+		return err
+	}
+
+	if isIPv4(prefix.Addr()) {
+		return fmt.Errorf("%q is not a valid IPv6 CIDR", cidr)
+	}
+
+	return nil
+}
+
+// CIDRContains checks whether the address is within cidr. Zone identifiers on the address are ignored.
+func CIDRContains(cidr, address string) (bool, error) {
+	prefix, err := ParseCIDR(cidr)
+	if err != nil {
+		return false, err
+	}
+
+	ipAddress, err := ParseIP(address)
+	if err != nil {
+		return false, err
+	}
+
+	ipAddress = ipAddress.WithZone("")
+
+	// Compare IPv4-mapped addresses with IPv4 CIDRs, and the other way round, as IPv4 addresses.
+	if isIPv4(ipAddress) && isIPv4(prefix.Addr()) {
+		ipAddress = ipAddress.Unmap()
+		prefix = netip.PrefixFrom(prefix.Addr().Unmap(), unmappedBits(prefix))
+	}
+
+	return prefix.Contains(ipAddress), nil
+}
+
+// GetIPFamily returns the IP family of the provided IP address or CIDR.
+func GetIPFamily(addressOrCIDR string) (corev1.IPFamily, error) {
+	ipAddress, err := ParseIP(addressOrCIDR)
+	if err != nil {
+		prefix, cidrErr := ParseCIDR(addressOrCIDR)
+		if cidrErr != nil {
+			return "", fmt.Errorf("%q is neither a valid IP address nor a valid CIDR", addressOrCIDR)
+		}
+
+		ipAddress = prefix.Addr()
+	}
+
+	if isIPv4(ipAddress) {
+		return corev1.IPv4Protocol, nil
+	}
+
+	return corev1.IPv6Protocol, nil
+}
+
+// ToNetIP converts an address into a net.IP for APIs that still use it. Brackets and zone identifiers are removed since
+// net.IP cannot represent them, and IPv4-mapped IPv6 addresses become IPv4 addresses.
+func ToNetIP(address string) (net.IP, error) {
+	ipAddress, err := ParseIP(address)
+	if err != nil {
+		return nil, err
+	}
+
+	return net.IP(ipAddress.WithZone("").Unmap().AsSlice()), nil
+}
+
+// isIPv4 returns whether the address is an IPv4 address or an IPv4-mapped IPv6 address.
+func isIPv4(ipAddress netip.Addr) bool {
+	return ipAddress.Unmap().Is4()
+}
+
+// unmappedBits returns the prefix length of an IPv4 CIDR, converting the length of IPv4-mapped IPv6 CIDRs.
+func unmappedBits(prefix netip.Prefix) int {
+	if prefix.Addr().Is4In6() {
+		return max(prefix.Bits()-96, 0)
+	}
+
+	return prefix.Bits()
+}
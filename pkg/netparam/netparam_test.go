@@ -0,0 +1,246 @@
+package netparam
+
+import (
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestParseIP(t *testing.T) {
+	testCases := []struct {
+		address       string
+		expectedIP    string
+		expectedError string
+	}{
+		{address: "192.0.2.10", expectedIP: "192.0.2.10"},
+		{address: " 192.0.2.10 ", expectedIP: "192.0.2.10"},
+		{address: "2001:db8::1", expectedIP: "2001:db8::1"},
+		{address: "[2001:db8::1]", expectedIP: "2001:db8::1"},
+		{address: "fe80::1%eth0", expectedIP: "fe80::1%eth0"},
+		{address: "[fe80::1%eth0]", expectedIP: "fe80::1%eth0"},
+		{address: "::ffff:192.0.2.10", expectedIP: "::ffff:192.0.2.10"},
+		{address: "[2001:db8::1", expectedError: "\"[2001:db8::1\" is not a valid IP address"},
+		{address: "192.0.2.0/24", expectedError: "\"192.0.2.0/24\" is not a valid IP address"},
+		{address: "", expectedError: "\"\" is not a valid IP address"},
+	}
+
+	for _, testCase := range testCases {
+		ipAddress, err := ParseIP(testCase.address)
+
+		if testCase.expectedError != "" {
+			assert.EqualError(t, err, testCase.expectedError)
+
+			continue
+		}
+
+		assert.NoError(t, err)
+		assert.Equal(t, testCase.expectedIP, ipAddress.String())
+	}
+}
+
+func TestNormalize(t *testing.T) {
+	testCases := []struct {
+		address         string
+		expectedAddress string
+		expectedError   string
+	}{
+		{address: "192.0.2.10", expectedAddress: "192.0.2.10"},
+		{address: "[2001:DB8:0::1]", expectedAddress: "2001:db8::1"},
+		{address: " fe80::1%eth0 ", expectedAddress: "fe80::1%eth0"},
+		{address: "invalid", expectedError: "\"invalid\" is not a valid IP address"},
+	}
+
+	for _, testCase := range testCases {
+		address, err := Normalize(testCase.address)
+
+		if testCase.expectedError != "" {
+			assert.EqualError(t, err, testCase.expectedError)
+
+			continue
+		}
+
+		assert.NoError(t, err)
+		assert.Equal(t, testCase.expectedAddress, address)
+	}
+}
+
+func TestValidateIPFamilies(t *testing.T) {
+	testCases := []struct {
+		address      string
+		expectedIPv4 string
+		expectedIPv6 string
+	}{
+		{
+			address:      "192.0.2.10",
+			expectedIPv6: "\"192.0.2.10\" is not a valid IPv6 address",
+		},
+		{
+			address:      "::ffff:192.0.2.10",
+			expectedIPv6: "\"::ffff:192.0.2.10\" is not a valid IPv6 address",
+		},
+		{
+			address:      "[2001:db8::1]",
+			expectedIPv4: "\"[2001:db8::1]\" is not a valid IPv4 address",
+		},
+		{
+			address:      "fe80::1%eth0",
+			expectedIPv4: "\"fe80::1%eth0\" is not a valid IPv4 address",
+		},
+		{
+			address:      "invalid",
+			expectedIPv4: "\"invalid\" is not a valid IP address",
+			expectedIPv6: "\"invalid\" is not a valid IP address",
+		},
+	}
+
+	for _, testCase := range testCases {
+		assertError(t, testCase.expectedIPv4, ValidateIPv4(testCase.address))
+		assertError(t, testCase.expectedIPv6, ValidateIPv6(testCase.address))
+
+		if testCase.expectedIPv4 == "" || testCase.expectedIPv6 == "" {
+			assert.NoError(t, ValidateIP(testCase.address))
+		}
+	}
+}
+
+func TestValidateCIDRFamilies(t *testing.T) {
+	testCases := []struct {
+		cidr         string
+		expectedIPv4 string
+		expectedIPv6 string
+	}{
+		{
+			cidr:         "192.0.2.10/24",
+			expectedIPv6: "\"192.0.2.10/24\" is not a valid IPv6 CIDR",
+		},
+		{
+			cidr:         "::ffff:192.0.2.0/120",
+			expectedIPv6: "\"::ffff:192.0.2.0/120\" is not a valid IPv6 CIDR",
+		},
+		{
+			cidr:         " 2001:db8::/64 ",
+			expectedIPv4: "\" 2001:db8::/64 \" is not a valid IPv4 CIDR",
+		},
+		{
+			cidr:         "fe80::/64%eth0",
+			expectedIPv4: "\"fe80::/64%eth0\" is not a valid CIDR",
+			expectedIPv6: "\"fe80::/64%eth0\" is not a valid CIDR",
+		},
+		{
+			cidr:         "192.0.2.10",
+			expectedIPv4: "\"192.0.2.10\" is not a valid CIDR",
+			expectedIPv6: "\"192.0.2.10\" is not a valid CIDR",
+		},
+	}
+
+	for _, testCase := range testCases {
+		assertError(t, testCase.expectedIPv4, ValidateIPv4CIDR(testCase.cidr))
+		assertError(t, testCase.expectedIPv6, ValidateIPv6CIDR(testCase.cidr))
+
+		if testCase.expectedIPv4 == "" || testCase.expectedIPv6 == "" {
+			assert.NoError(t, ValidateCIDR(testCase.cidr))
+		}
+	}
+}
+
+func TestCIDRContains(t *testing.T) {
+	testCases := []struct {
+		cidr          string
+		address       string
+		expected      bool
+		expectedError string
+	}{
+		{cidr: "192.0.2.0/24", address: "192.0.2.10", expected: true},
+		{cidr: "192.0.2.0/24", address: "198.51.100.10", expected: false},
+		{cidr: "192.0.2.0/24", address: "::ffff:192.0.2.10", expected: true},
+		{cidr: "::ffff:192.0.2.0/120", address: "192.0.2.10", expected: true},
+		{cidr: "2001:db8::/64", address: "[2001:db8::10]", expected: true},
+		{cidr: "fe80::/64", address: "fe80::1%eth0", expected: true},
+		{cidr: "2001:db8::/64", address: "192.0.2.10", expected: false},
+		{cidr: "invalid", address: "192.0.2.10", expectedError: "\"invalid\" is not a valid CIDR"},
+		{cidr: "192.0.2.0/24", address: "invalid", expectedError: "\"invalid\" is not a valid IP address"},
+	}
+
+	for _, testCase := range testCases {
+		contains, err := CIDRContains(testCase.cidr, testCase.address)
+
+		if testCase.expectedError != "" {
+			assert.EqualError(t, err, testCase.expectedError)
+
+			continue
+		}
+
+		assert.NoError(t, err)
+		assert.Equal(t, testCase.expected, contains)
+	}
+}
+
+func TestGetIPFamily(t *testing.T) {
+	testCases := []struct {
+		address        string
+		expectedFamily corev1.IPFamily
+		expectedError  string
+	}{
+		{address: "192.0.2.10", expectedFamily: corev1.IPv4Protocol},
+		{address: "192.0.2.0/24", expectedFamily: corev1.IPv4Protocol},
+		{address: "::ffff:192.0.2.10", expectedFamily: corev1.IPv4Protocol},
+		{address: "[2001:db8::1]", expectedFamily: corev1.IPv6Protocol},
+		{address: "fe80::1%eth0", expectedFamily: corev1.IPv6Protocol},
+		{address: "2001:db8::/64", expectedFamily: corev1.IPv6Protocol},
+		{address: "invalid", expectedError: "\"invalid\" is neither a valid IP address nor a valid CIDR"},
+	}
+
+	for _, testCase := range testCases {
+		family, err := GetIPFamily(testCase.address)
+
+		if testCase.expectedError != "" {
+			assert.EqualError(t, err, testCase.expectedError)
+
+			continue
+		}
+
+		assert.NoError(t, err)
+		assert.Equal(t, testCase.expectedFamily, family)
+	}
+}
+
+func TestToNetIP(t *testing.T) {
+	testCases := []struct {
+		address       string
+		expectedIP    net.IP
+		expectedError string
+	}{
+		{address: "192.0.2.10", expectedIP: net.ParseIP("192.0.2.10").To4()},
+		{address: "::ffff:192.0.2.10", expectedIP: net.ParseIP("192.0.2.10").To4()},
+		{address: "[2001:db8::1]", expectedIP: net.ParseIP("2001:db8::1")},
+		{address: "fe80::1%eth0", expectedIP: net.ParseIP("fe80::1")},
+		{address: "invalid", expectedError: "\"invalid\" is not a valid IP address"},
+	}
+
+	for _, testCase := range testCases {
+		ipAddress, err := ToNetIP(testCase.address)
+
+		if testCase.expectedError != "" {
+			assert.EqualError(t, err, testCase.expectedError)
+
+			continue
+		}
+
+		assert.NoError(t, err)
+		assert.Equal(t, testCase.expectedIP, ipAddress)
+	}
+}
+
+func assertError(t *testing.T, expectedError string, err error) {
+	t.Helper()
+
+	if expectedError == "" {
+		assert.NoError(t, err)
+
+		return
+	}
+
+	assert.EqualError(t, err, expectedError)
+}
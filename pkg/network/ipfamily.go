@@ -0,0 +1,98 @@
+package network
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/netparam"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/klog/v2"
+)
+
+// GetIPFamily returns the IP family of the provided IP address or CIDR.
+func GetIPFamily(address string) (corev1.IPFamily, error) {
+	return netparam.GetIPFamily(address)
+}
+
+// GetIPFamilies returns the IP families of the provided IP addresses or CIDRs in the order they first appear.
+func GetIPFamilies(addresses []string) ([]corev1.IPFamily, error) {
+	var families []corev1.IPFamily
+
+	for _, address := range addresses {
+		family, err := GetIPFamily(address)
+		if err != nil {
+			return nil, err
+		}
+
+		if !slices.Contains(families, family) {
+			families = append(families, family)
+		}
+	}
+
+	return families, nil
+}
+
+// GetIPFamilyPolicy returns the service IP family policy matching the provided IP families: SingleStack for one family
+// and RequireDualStack for two.
+func GetIPFamilyPolicy(families []corev1.IPFamily) (corev1.IPFamilyPolicy, error) {
+	if err := ValidateIPFamilies(families); err != nil {
+		return "", err
+	}
+
+	if len(families) == 2 {
+		return corev1.IPFamilyPolicyRequireDualStack, nil
+	}
+
+	return corev1.IPFamilyPolicySingleStack, nil
+}
+
+// ValidateIPFamilies checks that families contains one or two distinct IP families, which is what Kubernetes accepts
+// for single-stack and dual-stack resources.
+func ValidateIPFamilies(families []corev1.IPFamily) error {
+	if len(families) == 0 || len(families) > 2 {
+		return fmt.Errorf("ipFamilies must contain one or two families, got %d", len(families))
+	}
+
+	for _, family := range families {
+		if family != corev1.IPv4Protocol && family != corev1.IPv6Protocol {
+			return fmt.Errorf("ipFamily %s is not supported", family)
+		}
+	}
+
+	if len(families) == 2 && families[0] == families[1] {
+		return fmt.Errorf("ipFamilies cannot contain %s twice", families[0])
+	}
+
+	return nil
+}
+
+// DetectClusterIPFamilies returns the IP families of the cluster service network, with the primary family first. A
+// single family means the cluster is single-stack and two families mean it is dual-stack, so suites can use the result
+// to parametrize tests for IPv4, IPv6 and dual-stack clusters.
+func DetectClusterIPFamilies(apiClient *clients.Settings) ([]corev1.IPFamily, error) {
+	networkConfig, err := PullConfig(apiClient)
+	if err != nil {
+		return nil, err
+	}
+
+	serviceNetwork := networkConfig.Object.Status.ServiceNetwork
+	if len(serviceNetwork) == 0 {
+		klog.V(100).Info("The network.config status has no service network, falling back to the spec")
+
+		serviceNetwork = networkConfig.Object.Spec.ServiceNetwork
+	}
+
+	if len(serviceNetwork) == 0 {
+		return nil, fmt.Errorf("network.config %s has no service network", clusterNetworkName)
+	}
+
+	families, err := GetIPFamilies(serviceNetwork)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get IP families of the service network: %w", err)
+	}
+
+	klog.V(100).Infof("Detected cluster IP families %v", families)
+
+	return families, nil
+}
@@ -0,0 +1,164 @@
+package network
+
+import (
+	"testing"
+
+	configv1 "github.com/openshift/api/config/v1"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestGetIPFamily(t *testing.T) {
+	testCases := []struct {
+		address        string
+		expectedFamily corev1.IPFamily
+		expectedError  string
+	}{
+		{address: "192.0.2.10", expectedFamily: corev1.IPv4Protocol},
+		{address: "192.0.2.0/24", expectedFamily: corev1.IPv4Protocol},
+		{address: "2001:db8::1", expectedFamily: corev1.IPv6Protocol},
+		{address: "2001:db8::/64", expectedFamily: corev1.IPv6Protocol},
+		{address: "::ffff:192.0.2.10", expectedFamily: corev1.IPv4Protocol},
+		{address: "not-an-ip", expectedError: "\"not-an-ip\" is neither a valid IP address nor a valid CIDR"},
+		{address: "", expectedError: "\"\" is neither a valid IP address nor a valid CIDR"},
+	}
+
+	for _, testCase := range testCases {
+		family, err := GetIPFamily(testCase.address)
+
+		if testCase.expectedError != "" {
+			assert.EqualError(t, err, testCase.expectedError)
+
+			continue
+		}
+
+		assert.NoError(t, err)
+		assert.Equal(t, testCase.expectedFamily, family)
+	}
+}
+
+func TestGetIPFamilies(t *testing.T) {
+	families, err := GetIPFamilies([]string{"2001:db8::/64", "192.0.2.0/24", "2001:db8:1::/64"})
+	assert.NoError(t, err)
+	assert.Equal(t, []corev1.IPFamily{corev1.IPv6Protocol, corev1.IPv4Protocol}, families)
+
+	_, err = GetIPFamilies([]string{"192.0.2.0/24", "invalid"})
+	assert.Error(t, err)
+}
+
+func TestGetIPFamilyPolicy(t *testing.T) {
+	testCases := []struct {
+		families       []corev1.IPFamily
+		expectedPolicy corev1.IPFamilyPolicy
+		expectedError  string
+	}{
+		{
+			families:       []corev1.IPFamily{corev1.IPv4Protocol},
+			expectedPolicy: corev1.IPFamilyPolicySingleStack,
+		},
+		{
+			families:       []corev1.IPFamily{corev1.IPv6Protocol, corev1.IPv4Protocol},
+			expectedPolicy: corev1.IPFamilyPolicyRequireDualStack,
+		},
+		{
+			families:      nil,
+			expectedError: "ipFamilies must contain one or two families, got 0",
+		},
+		{
+			families:      []corev1.IPFamily{corev1.IPv4Protocol, corev1.IPv4Protocol},
+			expectedError: "ipFamilies cannot contain IPv4 twice",
+		},
+		{
+			families:      []corev1.IPFamily{"IPv5"},
+			expectedError: "ipFamily IPv5 is not supported",
+		},
+		{
+			families:      []corev1.IPFamily{corev1.IPv4Protocol, corev1.IPv6Protocol, corev1.IPv4Protocol},
+			expectedError: "ipFamilies must contain one or two families, got 3",
+		},
+	}
+
+	for _, testCase := range testCases {
+		policy, err := GetIPFamilyPolicy(testCase.families)
+
+		if testCase.expectedError != "" {
+			assert.EqualError(t, err, testCase.expectedError)
+
+			continue
+		}
+
+		assert.NoError(t, err)
+		assert.Equal(t, testCase.expectedPolicy, policy)
+	}
+}
+
+func TestDetectClusterIPFamilies(t *testing.T) {
+	testCases := []struct {
+		statusNetwork    []string
+		specNetwork      []string
+		addNetwork       bool
+		expectedFamilies []corev1.IPFamily
+		expectedError    string
+	}{
+		{
+			statusNetwork:    []string{"172.30.0.0/16"},
+			addNetwork:       true,
+			expectedFamilies: []corev1.IPFamily{corev1.IPv4Protocol},
+		},
+		{
+			statusNetwork:    []string{"fd02::/112", "172.30.0.0/16"},
+			specNetwork:      []string{"172.30.0.0/16"},
+			addNetwork:       true,
+			expectedFamilies: []corev1.IPFamily{corev1.IPv6Protocol, corev1.IPv4Protocol},
+		},
+		{
+			specNetwork:      []string{"fd02::/112"},
+			addNetwork:       true,
+			expectedFamilies: []corev1.IPFamily{corev1.IPv6Protocol},
+		},
+		{
+			addNetwork:    true,
+			expectedError: "network.config cluster has no service network",
+		},
+		{
+			statusNetwork: []string{"invalid"},
+			addNetwork:    true,
+			expectedError: "failed to get IP families of the service network: " +
+				"\"invalid\" is neither a valid IP address nor a valid CIDR",
+		},
+		{
+			addNetwork:    false,
+			expectedError: "network.config object cluster does not exist",
+		},
+	}
+
+	for _, testCase := range testCases {
+		var runtimeObjects []runtime.Object
+
+		if testCase.addNetwork {
+			network := buildDummyNetwork()
+			network.Spec.ServiceNetwork = testCase.specNetwork
+			network.Status.ServiceNetwork = testCase.statusNetwork
+
+			runtimeObjects = append(runtimeObjects, network)
+		}
+
+		testSettings := clients.GetTestClients(clients.TestClientParams{
+			K8sMockObjects:  runtimeObjects,
+			SchemeAttachers: []clients.SchemeAttacher{configv1.Install},
+		})
+
+		families, err := DetectClusterIPFamilies(testSettings)
+
+		if testCase.expectedError != "" {
+			assert.EqualError(t, err, testCase.expectedError)
+
+			continue
+		}
+
+		assert.NoError(t, err)
+		assert.Equal(t, testCase.expectedFamilies, families)
+	}
+}
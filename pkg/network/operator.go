@@ -10,13 +10,24 @@ import (
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/logging"
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/msg"
+	appsv1 "k8s.io/api/apps/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/klog/v2"
 	goclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
+const (
+	// OVNKubernetesNamespace is the namespace the OVN-Kubernetes components run in.
+	OVNKubernetesNamespace = "openshift-ovn-kubernetes"
+	// ovnKubeNodeDaemonSetName is the name of the daemonset running OVN-Kubernetes on every node.
+	ovnKubeNodeDaemonSetName = "ovnkube-node"
+	// ovnKubeControlPlaneDeploymentName is the name of the deployment running the OVN-Kubernetes control plane.
+	ovnKubeControlPlaneDeploymentName = "ovnkube-control-plane"
+)
+
 // OperatorBuilder provides a struct for network.operator object from the cluster and a network.operator definition.
 type OperatorBuilder struct {
 	// network.operator definition, used to create the network.operator object.
@@ -122,12 +133,145 @@ func (builder *OperatorBuilder) Update() (*OperatorBuilder, error) {
 	return builder, nil
 }
 
+// WithLocalGatewayMode sets whether OVN-Kubernetes routes egress traffic via the host, also known as local gateway
+// mode. The change is applied to the cluster by Update and can be waited on using WaitForOVNRollout.
+func (builder *OperatorBuilder) WithLocalGatewayMode(routingViaHost bool) *OperatorBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	klog.V(100).Infof("Setting routingViaHost to %t on network.operator %s", routingViaHost, builder.Definition.Name)
+
+	builder.ensureGatewayConfig()
+	builder.Definition.Spec.DefaultNetwork.OVNKubernetesConfig.GatewayConfig.RoutingViaHost = routingViaHost
+
+	return builder
+}
+
+// WithIPForwarding sets the IPForwarding mode on the OVN-Kubernetes gateway configuration. The change is applied to
+// the cluster by Update and can be waited on using WaitForOVNRollout.
+func (builder *OperatorBuilder) WithIPForwarding(mode operatorv1.IPForwardingMode) *OperatorBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	klog.V(100).Infof("Setting IPForwarding mode %q on network.operator %s", mode, builder.Definition.Name)
+
+	if mode != operatorv1.IPForwardingRestricted && mode != operatorv1.IPForwardingGlobal {
+		klog.V(100).Infof("The IPForwarding mode %q is invalid", mode)
+
+		builder.errorMsg = fmt.Sprintf("network.operator IPForwarding mode must be %q or %q, got %q",
+			operatorv1.IPForwardingRestricted, operatorv1.IPForwardingGlobal, mode)
+
+		return builder
+	}
+
+	builder.ensureGatewayConfig()
+	builder.Definition.Spec.DefaultNetwork.OVNKubernetesConfig.GatewayConfig.IPForwarding = mode
+
+	return builder
+}
+
+// WithAdditionalNetwork adds an additional network to the network.operator. If an additional network with the same
+// name and namespace already exists, it is replaced. The change is applied to the cluster by Update.
+func (builder *OperatorBuilder) WithAdditionalNetwork(network operatorv1.AdditionalNetworkDefinition) *OperatorBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	klog.V(100).Infof("Adding additional network %s/%s to network.operator %s",
+		network.Namespace, network.Name, builder.Definition.Name)
+
+	if network.Name == "" {
+		klog.V(100).Info("The additional network name is empty")
+
+		builder.errorMsg = "network.operator additional network 'name' cannot be empty"
+
+		return builder
+	}
+
+	if network.Type == "" {
+		klog.V(100).Info("The additional network type is empty")
+
+		builder.errorMsg = "network.operator additional network 'type' cannot be empty"
+
+		return builder
+	}
+
+	for index, existing := range builder.Definition.Spec.AdditionalNetworks {
+		if existing.Name == network.Name && existing.Namespace == network.Namespace {
+			builder.Definition.Spec.AdditionalNetworks[index] = network
+
+			return builder
+		}
+	}
+
+	builder.Definition.Spec.AdditionalNetworks = append(builder.Definition.Spec.AdditionalNetworks, network)
+
+	return builder
+}
+
+// WithoutAdditionalNetwork removes the additional network with the provided name and namespace from the
+// network.operator, if it exists. The change is applied to the cluster by Update.
+func (builder *OperatorBuilder) WithoutAdditionalNetwork(name, nsname string) *OperatorBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	klog.V(100).Infof("Removing additional network %s/%s from network.operator %s", nsname, name, builder.Definition.Name)
+
+	if name == "" {
+		klog.V(100).Info("The additional network name is empty")
+
+		builder.errorMsg = "network.operator additional network 'name' cannot be empty"
+
+		return builder
+	}
+
+	var networks []operatorv1.AdditionalNetworkDefinition
+
+	for _, existing := range builder.Definition.Spec.AdditionalNetworks {
+		if existing.Name != name || existing.Namespace != nsname {
+			networks = append(networks, existing)
+		}
+	}
+
+	builder.Definition.Spec.AdditionalNetworks = networks
+
+	return builder
+}
+
+// WithExportNetworkFlows sets the NetFlow, sFlow, and IPFIX collectors OVN-Kubernetes exports network flows to. A nil
+// exportNetworkFlows disables flow export. The change is applied to the cluster by Update.
+func (builder *OperatorBuilder) WithExportNetworkFlows(exportNetworkFlows *operatorv1.ExportNetworkFlows) *OperatorBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	klog.V(100).Infof("Setting exportNetworkFlows to %v on network.operator %s", exportNetworkFlows, builder.Definition.Name)
+
+	if exportNetworkFlows != nil && exportNetworkFlows.NetFlow == nil &&
+		exportNetworkFlows.SFlow == nil && exportNetworkFlows.IPFIX == nil {
+		klog.V(100).Info("The exportNetworkFlows has no collectors")
+
+		builder.errorMsg = "network.operator exportNetworkFlows must have at least one collector"
+
+		return builder
+	}
+
+	builder.Definition.Spec.ExportNetworkFlows = exportNetworkFlows
+
+	return builder
+}
+
 // SetLocalGWMode switches network.operator OVN mode from/to local mode.
 func (builder *OperatorBuilder) SetLocalGWMode(state bool, timeout time.Duration) (*OperatorBuilder, error) {
 	if valid, err := builder.validate(); !valid {
 		return builder, err
 	}
 
+	builder.ensureGatewayConfig()
+
 	var err error
 
 	if builder.Definition.Spec.DefaultNetwork.OVNKubernetesConfig.GatewayConfig.RoutingViaHost != state {
@@ -167,13 +311,7 @@ func (builder *OperatorBuilder) SetIPForwarding(
 
 	klog.V(100).Infof("Setting IPForwarding mode %q on network.operator %s", mode, builder.Definition.Name)
 
-	if builder.Definition.Spec.DefaultNetwork.OVNKubernetesConfig == nil {
-		builder.Definition.Spec.DefaultNetwork.OVNKubernetesConfig = &operatorv1.OVNKubernetesConfig{}
-	}
-
-	if builder.Definition.Spec.DefaultNetwork.OVNKubernetesConfig.GatewayConfig == nil {
-		builder.Definition.Spec.DefaultNetwork.OVNKubernetesConfig.GatewayConfig = &operatorv1.GatewayConfig{}
-	}
+	builder.ensureGatewayConfig()
 
 	var err error
 
@@ -311,6 +449,128 @@ func (builder *OperatorBuilder) WaitUntilInCondition(
 	return err
 }
 
+// WaitForOVNRollout waits up to timeout for the network.operator to finish rolling out its configuration. The
+// network.operator must be Available and neither Progressing nor Degraded, and the ovnkube-node daemonset and
+// ovnkube-control-plane deployment must have all of their pods updated and available.
+func (builder *OperatorBuilder) WaitForOVNRollout(timeout time.Duration) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	klog.V(100).Infof("Waiting up to %s for OVN-Kubernetes rollout of network.operator %s", timeout, builder.Definition.Name)
+
+	return wait.PollUntilContextTimeout(
+		context.TODO(), 3*time.Second, timeout, true, func(ctx context.Context) (bool, error) {
+			obj, err := builder.Get()
+			if err != nil {
+				if k8serrors.IsNotFound(err) {
+					return false, fmt.Errorf("network.operator object %s does not exist", builder.Definition.Name)
+				}
+
+				return false, nil
+			}
+
+			builder.Object = obj
+
+			if !hasOperatorConditionStatus(obj.Status.Conditions,
+				operatorv1.OperatorStatusTypeAvailable, operatorv1.ConditionTrue) ||
+				hasOperatorConditionStatus(obj.Status.Conditions,
+					operatorv1.OperatorStatusTypeProgressing, operatorv1.ConditionTrue) ||
+				hasOperatorConditionStatus(obj.Status.Conditions,
+					operatorv1.OperatorStatusTypeDegraded, operatorv1.ConditionTrue) {
+				klog.V(100).Infof("network.operator %s has not finished rolling out", builder.Definition.Name)
+
+				return false, nil
+			}
+
+			daemonSet := &appsv1.DaemonSet{}
+
+			err = builder.apiClient.Get(ctx, goclient.ObjectKey{
+				Name: ovnKubeNodeDaemonSetName, Namespace: OVNKubernetesNamespace}, daemonSet)
+			if err != nil {
+				klog.V(100).Infof("Failed to get daemonset %s: %v", ovnKubeNodeDaemonSetName, err)
+
+				return false, nil
+			}
+
+			if !isDaemonSetRolledOut(daemonSet) {
+				klog.V(100).Infof("Daemonset %s has not finished rolling out", ovnKubeNodeDaemonSetName)
+
+				return false, nil
+			}
+
+			deployment := &appsv1.Deployment{}
+
+			err = builder.apiClient.Get(ctx, goclient.ObjectKey{
+				Name: ovnKubeControlPlaneDeploymentName, Namespace: OVNKubernetesNamespace}, deployment)
+			if err != nil {
+				klog.V(100).Infof("Failed to get deployment %s: %v", ovnKubeControlPlaneDeploymentName, err)
+
+				return false, nil
+			}
+
+			if !isDeploymentRolledOut(deployment) {
+				klog.V(100).Infof("Deployment %s has not finished rolling out", ovnKubeControlPlaneDeploymentName)
+
+				return false, nil
+			}
+
+			return true, nil
+		})
+}
+
+// ensureGatewayConfig initializes the OVN-Kubernetes gateway configuration of the definition if it is nil.
+func (builder *OperatorBuilder) ensureGatewayConfig() {
+	if builder.Definition.Spec.DefaultNetwork.OVNKubernetesConfig == nil {
+		builder.Definition.Spec.DefaultNetwork.OVNKubernetesConfig = &operatorv1.OVNKubernetesConfig{}
+	}
+
+	if builder.Definition.Spec.DefaultNetwork.OVNKubernetesConfig.GatewayConfig == nil {
+		builder.Definition.Spec.DefaultNetwork.OVNKubernetesConfig.GatewayConfig = &operatorv1.GatewayConfig{}
+	}
+}
+
+// hasOperatorConditionStatus returns true if conditions contains a condition of the provided type and status.
+func hasOperatorConditionStatus(
+	conditions []operatorv1.OperatorCondition, conditionType string, status operatorv1.ConditionStatus) bool {
+	for _, condition := range conditions {
+		if condition.Type == conditionType {
+			return condition.Status == status
+		}
+	}
+
+	return false
+}
+
+// isDaemonSetRolledOut returns true if the daemonset controller has observed the latest generation and every
+// scheduled pod is updated and available.
+func isDaemonSetRolledOut(daemonSet *appsv1.DaemonSet) bool {
+	return daemonSet.Status.ObservedGeneration >= daemonSet.Generation &&
+		daemonSet.Status.UpdatedNumberScheduled == daemonSet.Status.DesiredNumberScheduled &&
+		daemonSet.Status.NumberAvailable == daemonSet.Status.DesiredNumberScheduled &&
+		daemonSet.Status.NumberUnavailable == 0
+}
+
+// isDeploymentRolledOut returns true if the deployment controller has observed the latest generation and every
+// replica is updated and available.
+func isDeploymentRolledOut(deployment *appsv1.Deployment) bool {
+	replicas := int32(1)
+	if deployment.Spec.Replicas != nil {
+		replicas = *deployment.Spec.Replicas
+	}
+
+	return deployment.Status.ObservedGeneration >= deployment.Generation &&
+		deployment.Status.UpdatedReplicas == replicas &&
+		deployment.Status.AvailableReplicas == replicas
+}
+
+// GetOperatorGVR returns Network's GroupVersionResource which could be used for Clean function.
+func GetOperatorGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group: "operator.openshift.io", Version: "v1", Resource: "networks",
+	}
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *OperatorBuilder) validate() (bool, error) {
@@ -9,6 +9,7 @@ import (
 	operatorv1 "github.com/openshift/api/operator/v1"
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
 	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/utils/ptr"
@@ -394,6 +395,194 @@ func TestOperatorSetIPForwarding(t *testing.T) {
 	}
 }
 
+func TestOperatorWithLocalGatewayMode(t *testing.T) {
+	testBuilder := newOperatorBuilder(buildTestClientWithDummyNetworkOperator()).WithLocalGatewayMode(true)
+	assert.Empty(t, testBuilder.errorMsg)
+	assert.True(t, testBuilder.Definition.Spec.DefaultNetwork.OVNKubernetesConfig.GatewayConfig.RoutingViaHost)
+
+	testBuilder = testBuilder.WithLocalGatewayMode(false)
+	assert.Empty(t, testBuilder.errorMsg)
+	assert.False(t, testBuilder.Definition.Spec.DefaultNetwork.OVNKubernetesConfig.GatewayConfig.RoutingViaHost)
+}
+
+func TestOperatorWithIPForwarding(t *testing.T) {
+	testCases := []struct {
+		mode          operatorv1.IPForwardingMode
+		expectedError string
+	}{
+		{
+			mode:          operatorv1.IPForwardingGlobal,
+			expectedError: "",
+		},
+		{
+			mode:          operatorv1.IPForwardingRestricted,
+			expectedError: "",
+		},
+		{
+			mode:          "Invalid",
+			expectedError: "network.operator IPForwarding mode must be \"Restricted\" or \"Global\", got \"Invalid\"",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := newOperatorBuilder(buildTestClientWithDummyNetworkOperator()).WithIPForwarding(testCase.mode)
+		assert.Equal(t, testCase.expectedError, testBuilder.errorMsg)
+
+		if testCase.expectedError == "" {
+			assert.Equal(t, testCase.mode,
+				testBuilder.Definition.Spec.DefaultNetwork.OVNKubernetesConfig.GatewayConfig.IPForwarding)
+		}
+	}
+}
+
+func TestOperatorWithAdditionalNetwork(t *testing.T) {
+	testCases := []struct {
+		network       operatorv1.AdditionalNetworkDefinition
+		expectedError string
+	}{
+		{
+			network:       buildDummyAdditionalNetwork("test-net", "{}"),
+			expectedError: "",
+		},
+		{
+			network:       buildDummyAdditionalNetwork("", "{}"),
+			expectedError: "network.operator additional network 'name' cannot be empty",
+		},
+		{
+			network:       operatorv1.AdditionalNetworkDefinition{Name: "test-net"},
+			expectedError: "network.operator additional network 'type' cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := newOperatorBuilder(buildTestClientWithDummyNetworkOperator()).WithAdditionalNetwork(testCase.network)
+		assert.Equal(t, testCase.expectedError, testBuilder.errorMsg)
+
+		if testCase.expectedError == "" {
+			assert.Equal(t, []operatorv1.AdditionalNetworkDefinition{testCase.network},
+				testBuilder.Definition.Spec.AdditionalNetworks)
+		}
+	}
+
+	testBuilder := newOperatorBuilder(buildTestClientWithDummyNetworkOperator()).
+		WithAdditionalNetwork(buildDummyAdditionalNetwork("test-net", "{}")).
+		WithAdditionalNetwork(buildDummyAdditionalNetwork("other-net", "{}")).
+		WithAdditionalNetwork(buildDummyAdditionalNetwork("test-net", `{"cniVersion":"0.4.0"}`))
+	assert.Empty(t, testBuilder.errorMsg)
+	assert.Equal(t, []operatorv1.AdditionalNetworkDefinition{
+		buildDummyAdditionalNetwork("test-net", `{"cniVersion":"0.4.0"}`),
+		buildDummyAdditionalNetwork("other-net", "{}"),
+	}, testBuilder.Definition.Spec.AdditionalNetworks)
+}
+
+func TestOperatorWithoutAdditionalNetwork(t *testing.T) {
+	testBuilder := newOperatorBuilder(buildTestClientWithDummyNetworkOperator()).
+		WithAdditionalNetwork(buildDummyAdditionalNetwork("test-net", "{}")).
+		WithAdditionalNetwork(buildDummyAdditionalNetwork("other-net", "{}")).
+		WithoutAdditionalNetwork("test-net", "test-ns")
+	assert.Empty(t, testBuilder.errorMsg)
+	assert.Equal(t, []operatorv1.AdditionalNetworkDefinition{buildDummyAdditionalNetwork("other-net", "{}")},
+		testBuilder.Definition.Spec.AdditionalNetworks)
+
+	testBuilder = testBuilder.WithoutAdditionalNetwork("", "test-ns")
+	assert.Equal(t, "network.operator additional network 'name' cannot be empty", testBuilder.errorMsg)
+}
+
+func TestOperatorWithExportNetworkFlows(t *testing.T) {
+	testCases := []struct {
+		exportNetworkFlows *operatorv1.ExportNetworkFlows
+		expectedError      string
+	}{
+		{
+			exportNetworkFlows: &operatorv1.ExportNetworkFlows{
+				IPFIX: &operatorv1.IPFIXConfig{Collectors: []operatorv1.IPPort{"192.168.1.10:2055"}},
+			},
+			expectedError: "",
+		},
+		{
+			exportNetworkFlows: nil,
+			expectedError:      "",
+		},
+		{
+			exportNetworkFlows: &operatorv1.ExportNetworkFlows{},
+			expectedError:      "network.operator exportNetworkFlows must have at least one collector",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := newOperatorBuilder(buildTestClientWithDummyNetworkOperator()).
+			WithExportNetworkFlows(testCase.exportNetworkFlows)
+		assert.Equal(t, testCase.expectedError, testBuilder.errorMsg)
+
+		if testCase.expectedError == "" {
+			assert.Equal(t, testCase.exportNetworkFlows, testBuilder.Definition.Spec.ExportNetworkFlows)
+		}
+	}
+}
+
+func TestOperatorWaitForOVNRollout(t *testing.T) {
+	testCases := []struct {
+		operatorExists bool
+		operatorReady  bool
+		ovnRolledOut   bool
+		expectedError  error
+	}{
+		{
+			operatorExists: true,
+			operatorReady:  true,
+			ovnRolledOut:   true,
+			expectedError:  nil,
+		},
+		{
+			operatorExists: true,
+			operatorReady:  false,
+			ovnRolledOut:   true,
+			expectedError:  context.DeadlineExceeded,
+		},
+		{
+			operatorExists: true,
+			operatorReady:  true,
+			ovnRolledOut:   false,
+			expectedError:  context.DeadlineExceeded,
+		},
+		{
+			operatorExists: false,
+			operatorReady:  true,
+			ovnRolledOut:   true,
+			expectedError:  fmt.Errorf("network.operator object %s does not exist", clusterNetworkName),
+		},
+	}
+
+	for _, testCase := range testCases {
+		var runtimeObjects []runtime.Object
+
+		if testCase.operatorExists {
+			networkOperator := buildDummyNetworkOperator()
+			networkOperator.Status.Conditions = []operatorv1.OperatorCondition{
+				{Type: operatorv1.OperatorStatusTypeAvailable, Status: operatorv1.ConditionTrue},
+				{Type: operatorv1.OperatorStatusTypeProgressing, Status: operatorv1.ConditionFalse},
+				{Type: operatorv1.OperatorStatusTypeDegraded, Status: operatorv1.ConditionFalse},
+			}
+
+			if !testCase.operatorReady {
+				networkOperator.Status.Conditions[1].Status = operatorv1.ConditionTrue
+			}
+
+			runtimeObjects = append(runtimeObjects, networkOperator)
+		}
+
+		runtimeObjects = append(runtimeObjects, buildDummyOVNKubeNode(testCase.ovnRolledOut), buildDummyOVNKubeControlPlane())
+
+		testSettings := clients.GetTestClients(clients.TestClientParams{
+			K8sMockObjects:  runtimeObjects,
+			SchemeAttachers: operatorTestSchemes,
+		})
+
+		err := newOperatorBuilder(testSettings).WaitForOVNRollout(time.Second)
+		assert.Equal(t, testCase.expectedError, err)
+	}
+}
+
 // buildDummyNetworkOperator builds a dummy network.operator object. It uses the clusterNetworkName.
 func buildDummyNetworkOperator() *operatorv1.Network {
 	return &operatorv1.Network{
@@ -420,3 +609,52 @@ func newOperatorBuilder(apiClient *clients.Settings) *OperatorBuilder {
 		Definition: buildDummyNetworkOperator(),
 	}
 }
+
+// buildDummyAdditionalNetwork returns an additional network with the provided name and raw CNI config.
+func buildDummyAdditionalNetwork(name, rawConfig string) operatorv1.AdditionalNetworkDefinition {
+	return operatorv1.AdditionalNetworkDefinition{
+		Type:         operatorv1.NetworkTypeRaw,
+		Name:         name,
+		Namespace:    "test-ns",
+		RawCNIConfig: rawConfig,
+	}
+}
+
+// buildDummyOVNKubeNode returns an ovnkube-node daemonset that has three pods scheduled and is either fully rolled out
+// or has one pod left to update.
+func buildDummyOVNKubeNode(rolledOut bool) *appsv1.DaemonSet {
+	daemonSet := &appsv1.DaemonSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      ovnKubeNodeDaemonSetName,
+			Namespace: OVNKubernetesNamespace,
+		},
+		Status: appsv1.DaemonSetStatus{
+			DesiredNumberScheduled: 3,
+			UpdatedNumberScheduled: 3,
+			NumberAvailable:        3,
+		},
+	}
+
+	if !rolledOut {
+		daemonSet.Status.UpdatedNumberScheduled = 2
+	}
+
+	return daemonSet
+}
+
+// buildDummyOVNKubeControlPlane returns an ovnkube-control-plane deployment with all replicas updated and available.
+func buildDummyOVNKubeControlPlane() *appsv1.Deployment {
+	return &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      ovnKubeControlPlaneDeploymentName,
+			Namespace: OVNKubernetesNamespace,
+		},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: ptr.To[int32](2),
+		},
+		Status: appsv1.DeploymentStatus{
+			UpdatedReplicas:   2,
+			AvailableReplicas: 2,
+		},
+	}
+}
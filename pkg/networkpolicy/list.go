@@ -11,6 +11,8 @@ import (
 
 // List returns networkpolicy inventory in the given namespace.
 func List(apiClient *clients.Settings, nsname string, options ...metav1.ListOptions) ([]*NetworkPolicyBuilder, error) {
+	nsname = apiClient.ResolveNamespace(nsname)
+
 	if nsname == "" {
 		klog.V(100).Info("networkpolicy 'nsname' parameter can not be empty")
 
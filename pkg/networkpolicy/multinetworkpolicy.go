@@ -28,6 +28,8 @@ type MultiNetworkPolicyBuilder struct {
 
 // NewMultiNetworkPolicyBuilder method creates new instance of builder.
 func NewMultiNetworkPolicyBuilder(apiClient *clients.Settings, name, nsname string) *MultiNetworkPolicyBuilder {
+	nsname = apiClient.ResolveNamespace(nsname)
+
 	klog.V(100).Infof(
 		"Initializing new MultiNetworkPolicyBuilder structure with the following params: name: %s, namespace: %s",
 		name, nsname)
@@ -189,6 +191,8 @@ func (builder *MultiNetworkPolicyBuilder) WithPolicyType(
 
 // PullMultiNetworkPolicy loads an existing MultiNetworkPolicy into the Builder struct.
 func PullMultiNetworkPolicy(apiClient *clients.Settings, name, nsname string) (*MultiNetworkPolicyBuilder, error) {
+	nsname = apiClient.ResolveNamespace(nsname)
+
 	klog.V(100).Infof("Pulling existing MultiNetworkPolicy name: %s, namespace: %s", name, nsname)
 
 	if apiClient == nil {
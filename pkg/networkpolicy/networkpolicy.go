@@ -34,6 +34,8 @@ type NetworkPolicyBuilder struct {
 
 // NewNetworkPolicyBuilder method creates new instance of builder.
 func NewNetworkPolicyBuilder(apiClient *clients.Settings, name, nsname string) *NetworkPolicyBuilder {
+	nsname = apiClient.ResolveNamespace(nsname)
+
 	klog.V(100).Infof("Initializing new NetworkPolicyBuilder structure with the following params: name: %s, namespace: %s",
 		name, nsname)
 
@@ -176,6 +178,8 @@ func (builder *NetworkPolicyBuilder) WithPodSelector(podSelectorMatchLabels map[
 
 // Pull loads an existing networkPolicy into the Builder struct.
 func Pull(apiClient *clients.Settings, name, nsname string) (*NetworkPolicyBuilder, error) {
+	nsname = apiClient.ResolveNamespace(nsname)
+
 	klog.V(100).Infof("Pulling existing networkPolicy name: %s namespace:%s", name, nsname)
 
 	if apiClient == nil {
@@ -9,6 +9,7 @@ import (
 	neuronv1beta1 "github.com/rh-ecosystem-edge/eco-goinfra/pkg/schemes/neuron/v1beta1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/klog/v2"
 	goclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -39,6 +40,8 @@ func NewBuilder(
 	apiClient *clients.Settings,
 	name, namespace string,
 	driversImage, driverVersion, devicePluginImage string) *Builder {
+	namespace = apiClient.ResolveNamespace(namespace)
+
 	klog.V(100).Infof(
 		"Initializing new DeviceConfig structure with name: %s, namespace: %s",
 		name, namespace)
@@ -121,6 +124,8 @@ func NewBuilderWithInClusterBuild(
 	apiClient *clients.Settings,
 	name, namespace string,
 	driverVersion, devicePluginImage string) *Builder {
+	namespace = apiClient.ResolveNamespace(namespace)
+
 	klog.V(100).Infof(
 		"Initializing new DeviceConfig (in-cluster build) with name: %s, namespace: %s",
 		name, namespace)
@@ -316,6 +321,8 @@ func (builder *Builder) WithNodeMetricsImage(image string) *Builder {
 
 // Pull retrieves an existing DeviceConfig from the cluster.
 func Pull(apiClient *clients.Settings, name, namespace string) (*Builder, error) {
+	namespace = apiClient.ResolveNamespace(namespace)
+
 	klog.V(100).Infof("Pulling DeviceConfig %s from namespace %s", name, namespace)
 
 	if apiClient == nil {
@@ -535,6 +542,13 @@ func (builder *Builder) WithOptions(
 	return builder
 }
 
+// GetGVR returns DeviceConfig's GroupVersionResource which could be used for Clean function.
+func GetGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group: "k8s.aws", Version: "v1beta1", Resource: "deviceconfigs",
+	}
+}
+
 // validate checks that the builder is properly configured.
 func (builder *Builder) validate() (bool, error) {
 	resourceCRD := "DeviceConfig"
@@ -0,0 +1,24 @@
+package nfd
+
+import (
+	nfdv1 "github.com/openshift/cluster-nfd-operator/api/v1"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/schemes/convert"
+	nfdschemev1 "github.com/rh-ecosystem-edge/eco-goinfra/pkg/schemes/nfd/v1"
+	"k8s.io/klog/v2"
+)
+
+// FromSchemeType converts a NodeFeatureDiscovery from the copy synced under pkg/schemes to the upstream operator type
+// used by this package. It returns an error if the object has fields set that the upstream type does not have.
+func FromSchemeType(nodeFeatureDiscovery *nfdschemev1.NodeFeatureDiscovery) (*nfdv1.NodeFeatureDiscovery, error) {
+	klog.V(100).Info("Converting NodeFeatureDiscovery from the synced scheme type to the upstream type")
+
+	return convert.Convert[nfdv1.NodeFeatureDiscovery](nodeFeatureDiscovery)
+}
+
+// ToSchemeType converts a NodeFeatureDiscovery from the upstream operator type used by this package to the copy synced
+// under pkg/schemes. It returns an error if the object has fields set that the synced type does not have.
+func ToSchemeType(nodeFeatureDiscovery *nfdv1.NodeFeatureDiscovery) (*nfdschemev1.NodeFeatureDiscovery, error) {
+	klog.V(100).Info("Converting NodeFeatureDiscovery from the upstream type to the synced scheme type")
+
+	return convert.Convert[nfdschemev1.NodeFeatureDiscovery](nodeFeatureDiscovery)
+}
@@ -0,0 +1,85 @@
+package nfd
+
+import (
+	"testing"
+
+	nfdv1 "github.com/openshift/cluster-nfd-operator/api/v1"
+	nfdschemev1 "github.com/rh-ecosystem-edge/eco-goinfra/pkg/schemes/nfd/v1"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestFromSchemeType(t *testing.T) {
+	testCases := []struct {
+		nodeFeatureDiscovery *nfdschemev1.NodeFeatureDiscovery
+		expectedError        bool
+	}{
+		{
+			nodeFeatureDiscovery: &nfdschemev1.NodeFeatureDiscovery{
+				ObjectMeta: metav1.ObjectMeta{Name: "nfd-instance", Namespace: "openshift-nfd"},
+				Spec:       nfdschemev1.NodeFeatureDiscoverySpec{Instance: "nfd-master"},
+			},
+			expectedError: false,
+		},
+		{
+			nodeFeatureDiscovery: nil,
+			expectedError:        true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		nodeFeatureDiscovery, err := FromSchemeType(testCase.nodeFeatureDiscovery)
+
+		if testCase.expectedError {
+			assert.Error(t, err)
+			assert.Nil(t, nodeFeatureDiscovery)
+
+			continue
+		}
+
+		assert.NoError(t, err)
+		assert.Equal(t, testCase.nodeFeatureDiscovery.Name, nodeFeatureDiscovery.Name)
+		assert.Equal(t, testCase.nodeFeatureDiscovery.Namespace, nodeFeatureDiscovery.Namespace)
+		assert.Equal(t, testCase.nodeFeatureDiscovery.Spec.Instance, nodeFeatureDiscovery.Spec.Instance)
+	}
+}
+
+func TestToSchemeType(t *testing.T) {
+	testCases := []struct {
+		nodeFeatureDiscovery *nfdv1.NodeFeatureDiscovery
+		expectedError        bool
+	}{
+		{
+			nodeFeatureDiscovery: &nfdv1.NodeFeatureDiscovery{
+				ObjectMeta: metav1.ObjectMeta{Name: "nfd-instance", Namespace: "openshift-nfd"},
+				Spec:       nfdv1.NodeFeatureDiscoverySpec{Instance: "nfd-master"},
+			},
+			expectedError: false,
+		},
+		{
+			// WorkerPriorityClassName only exists in the upstream type, so converting would lose it.
+			nodeFeatureDiscovery: &nfdv1.NodeFeatureDiscovery{
+				ObjectMeta: metav1.ObjectMeta{Name: "nfd-instance", Namespace: "openshift-nfd"},
+				Spec: nfdv1.NodeFeatureDiscoverySpec{
+					Operand: nfdv1.OperandSpec{WorkerPriorityClassName: "system-node-critical"},
+				},
+			},
+			expectedError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		nodeFeatureDiscovery, err := ToSchemeType(testCase.nodeFeatureDiscovery)
+
+		if testCase.expectedError {
+			assert.Error(t, err)
+			assert.Nil(t, nodeFeatureDiscovery)
+
+			continue
+		}
+
+		assert.NoError(t, err)
+		assert.Equal(t, testCase.nodeFeatureDiscovery.Name, nodeFeatureDiscovery.Name)
+		assert.Equal(t, testCase.nodeFeatureDiscovery.Spec.Instance, nodeFeatureDiscovery.Spec.Instance)
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/msg"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/json"
 	"k8s.io/klog/v2"
 	goclient "sigs.k8s.io/controller-runtime/pkg/client"
@@ -79,6 +80,8 @@ func NewBuilderFromObjectString(apiClient *clients.Settings, almExample string)
 
 // Pull loads an existing NodeFeatureDiscovery into Builder struct.
 func Pull(apiClient *clients.Settings, name, namespace string) (*Builder, error) {
+	namespace = apiClient.ResolveNamespace(namespace)
+
 	klog.V(100).Infof("Pulling existing nodeFeatureDiscovery name: %s in namespace: %s", name, namespace)
 
 	if apiClient == nil {
@@ -266,6 +269,14 @@ func getNodeFeatureDiscoveryFromAlmExample(almExample string) (*nfdv1.NodeFeatur
 	return &nodeFeatureDiscoveryList.Items[0], nil
 }
 
+// GetNodeFeatureDiscoveryGVR returns NodeFeatureDiscovery's GroupVersionResource
+// which could be used for Clean function.
+func GetNodeFeatureDiscoveryGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group: "nfd.openshift.io", Version: "v1", Resource: "nodefeaturediscoveries",
+	}
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *Builder) validate() (bool, error) {
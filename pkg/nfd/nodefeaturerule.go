@@ -8,6 +8,7 @@ import (
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/msg"
 	nfdv1 "github.com/rh-ecosystem-edge/eco-goinfra/pkg/schemes/nfd/v1alpha1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/json"
 	"k8s.io/klog/v2"
 	goclient "sigs.k8s.io/controller-runtime/pkg/client"
@@ -36,6 +37,8 @@ type NodeFeatureRuleBuilder struct {
 
 // NewNodeFeatureRuleBuilder creates a new instance of NodeFeatureRuleBuilder.
 func NewNodeFeatureRuleBuilder(apiClient *clients.Settings, name, namespace string) *NodeFeatureRuleBuilder {
+	namespace = apiClient.ResolveNamespace(namespace)
+
 	klog.V(100).Infof(
 		"Initializing new NodeFeatureRule structure with name: %s, namespace: %s",
 		name, namespace)
@@ -136,6 +139,8 @@ func NewNodeFeatureRuleBuilderFromObjectString(apiClient *clients.Settings, almE
 
 // PullFeatureRule loads an existing NodeFeatureRule into Builder struct.
 func PullFeatureRule(apiClient *clients.Settings, name, namespace string) (*NodeFeatureRuleBuilder, error) {
+	namespace = apiClient.ResolveNamespace(namespace)
+
 	klog.V(100).Infof("Pulling existing NodeFeatureRule name: %s in namespace: %s", name, namespace)
 
 	if apiClient == nil {
@@ -480,6 +485,13 @@ func (builder *NodeFeatureRuleBuilder) Get() (*nfdv1.NodeFeatureRule, error) {
 	return NodeFeatureRule, err
 }
 
+// GetNodeFeatureRuleGVR returns NodeFeatureRule's GroupVersionResource which could be used for Clean function.
+func GetNodeFeatureRuleGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group: "nfd.openshift.io", Version: "v1alpha1", Resource: "nodefeaturerules",
+	}
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *NodeFeatureRuleBuilder) validate() (bool, error) {
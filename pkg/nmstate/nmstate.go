@@ -11,6 +11,7 @@ import (
 	nmstateV1 "github.com/nmstate/kubernetes-nmstate/api/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	goclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -219,6 +220,13 @@ func PullNMstate(apiClient *clients.Settings, name string) (*Builder, error) {
 	return builder, nil
 }
 
+// GetNMStateGVR returns NMState's GroupVersionResource which could be used for Clean function.
+func GetNMStateGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group: "nmstate.io", Version: "v1", Resource: "nmstates",
+	}
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *Builder) validate() (bool, error) {
@@ -15,6 +15,7 @@ import (
 	nmstateV1beta1 "github.com/nmstate/kubernetes-nmstate/api/v1beta1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	goclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -331,6 +332,13 @@ func PullNodeNetworkState(apiClient *clients.Settings, name string) (*StateBuild
 	return stateBuilder, nil
 }
 
+// GetNodeNetworkStateGVR returns NodeNetworkState's GroupVersionResource which could be used for Clean function.
+func GetNodeNetworkStateGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group: "nmstate.io", Version: "v1beta1", Resource: "nodenetworkstates",
+	}
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *StateBuilder) validate() (bool, error) {
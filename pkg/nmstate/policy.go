@@ -17,12 +17,14 @@ import (
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/logging"
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/msg"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/netparam"
 
 	"slices"
 
 	corev1 "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/wait"
 	goclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -369,7 +371,7 @@ func (builder *PolicyBuilder) WithVlanInterfaceIP(baseInterface, ipv4Addresses,
 		return builder
 	}
 
-	if net.ParseIP(ipv4Addresses) == nil {
+	if netparam.ValidateIPv4(ipv4Addresses) != nil {
 		klog.V(100).Info("the vlanInterface contains an invalid ipv4 address")
 
 		builder.errorMsg = "vlanInterfaceIP 'ipv4Addresses' is an invalid ipv4 address"
@@ -377,7 +379,7 @@ func (builder *PolicyBuilder) WithVlanInterfaceIP(baseInterface, ipv4Addresses,
 		return builder
 	}
 
-	if net.ParseIP(ipv6Addresses) == nil {
+	if netparam.ValidateIPv6(ipv6Addresses) != nil {
 		klog.V(100).Info("the vlanInterface contains an invalid ipv6 address")
 
 		builder.errorMsg = "vlanInterfaceIP 'ipv6Addresses' is an invalid ipv6 address"
@@ -385,6 +387,10 @@ func (builder *PolicyBuilder) WithVlanInterfaceIP(baseInterface, ipv4Addresses,
 		return builder
 	}
 
+	// Both addresses were validated above, so the conversions cannot fail.
+	ipv4IP, _ := netparam.ToNetIP(ipv4Addresses)
+	ipv6IP, _ := netparam.ToNetIP(ipv6Addresses)
+
 	newInterface := NetworkInterface{
 		Name:  fmt.Sprintf("%s.%d", baseInterface, vlanID),
 		Type:  interfaceTypeVlan,
@@ -398,7 +404,7 @@ func (builder *PolicyBuilder) WithVlanInterfaceIP(baseInterface, ipv4Addresses,
 			Dhcp:    false,
 			Address: []InterfaceIPAddress{{
 				PrefixLen: 24,
-				IP:        net.ParseIP(ipv4Addresses),
+				IP:        ipv4IP,
 			}},
 		},
 		Ipv6: InterfaceIpv6{
@@ -407,7 +413,7 @@ func (builder *PolicyBuilder) WithVlanInterfaceIP(baseInterface, ipv4Addresses,
 			Autoconf: false,
 			Address: []InterfaceIPAddress{{
 				PrefixLen: 64,
-				IP:        net.ParseIP(ipv6Addresses),
+				IP:        ipv6IP,
 			}},
 		},
 	}
@@ -594,7 +600,7 @@ func (builder *PolicyBuilder) WithEthernetInterface(interfaceName, ipv4Address,
 		return builder
 	}
 
-	if net.ParseIP(ipv4Address) == nil {
+	if netparam.ValidateIPv4(ipv4Address) != nil {
 		klog.V(100).Info("the ethernet interface contains an invalid ipv4 address")
 
 		builder.errorMsg = "ethernet interface 'ipv4Addresses' is an invalid ipv4 address"
@@ -602,7 +608,7 @@ func (builder *PolicyBuilder) WithEthernetInterface(interfaceName, ipv4Address,
 		return builder
 	}
 
-	if net.ParseIP(ipv6Address) == nil {
+	if netparam.ValidateIPv6(ipv6Address) != nil {
 		klog.V(100).Info("the ethernet interface contains an invalid ipv6 address")
 
 		builder.errorMsg = "ethernet interface 'ipv6Addresses' is an invalid ipv6 address"
@@ -610,6 +616,10 @@ func (builder *PolicyBuilder) WithEthernetInterface(interfaceName, ipv4Address,
 		return builder
 	}
 
+	// Both addresses were validated above, so the conversions cannot fail.
+	ipv4IP, _ := netparam.ToNetIP(ipv4Address)
+	ipv6IP, _ := netparam.ToNetIP(ipv6Address)
+
 	newInterface := NetworkInterface{
 		Name:  interfaceName,
 		Type:  interfaceTypeEthernet,
@@ -619,7 +629,7 @@ func (builder *PolicyBuilder) WithEthernetInterface(interfaceName, ipv4Address,
 			Dhcp:    false,
 			Address: []InterfaceIPAddress{{
 				PrefixLen: 24,
-				IP:        net.ParseIP(ipv4Address),
+				IP:        ipv4IP,
 			}},
 		},
 		Ipv6: InterfaceIpv6{
@@ -628,7 +638,7 @@ func (builder *PolicyBuilder) WithEthernetInterface(interfaceName, ipv4Address,
 			Autoconf: false,
 			Address: []InterfaceIPAddress{{
 				PrefixLen: 64,
-				IP:        net.ParseIP(ipv6Address),
+				IP:        ipv6IP,
 			}},
 		},
 	}
@@ -869,6 +879,14 @@ func (builder *PolicyBuilder) WaitUntilCondition(condition nmstateShared.Conditi
 		})
 }
 
+// GetNodeNetworkConfigurationPolicyGVR returns NodeNetworkConfigurationPolicy's GroupVersionResource
+// which could be used for Clean function.
+func GetNodeNetworkConfigurationPolicyGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group: "nmstate.io", Version: "v1", Resource: "nodenetworkconfigurationpolicies",
+	}
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *PolicyBuilder) validate() (bool, error) {
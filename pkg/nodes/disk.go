@@ -0,0 +1,371 @@
+package nodes
+
+import (
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/kubelet"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
+)
+
+// lsblkColumns are the columns requested from lsblk. MOUNTPOINT is used rather than MOUNTPOINTS since the latter is not
+// available in older versions of util-linux.
+const lsblkColumns = "NAME,PATH,TYPE,SIZE,FSTYPE,MOUNTPOINT,PARTLABEL,MODEL,SERIAL,WWN,ROTA"
+
+// BlockDevice is a block device of a node, such as a disk, a partition or a logical volume, as reported by lsblk.
+type BlockDevice struct {
+	// Name is the kernel name of the device, such as sda or nvme0n1p4.
+	Name string
+	// Path is the path of the device node, such as /dev/sda.
+	Path string
+	// Type is the type of the device, such as disk, part, lvm or rom.
+	Type string
+	// Size is the size of the device in bytes.
+	Size int64
+	// FSType is the type of the filesystem or signature on the device, such as xfs or LVM2_member, if any.
+	FSType string
+	// MountPoint is where the device is mounted, if it is mounted.
+	MountPoint string
+	// PartLabel is the partition label of partitions on GPT disks, such as var-lib-containers.
+	PartLabel string
+	// Model, Serial and WWN identify the hardware of disks.
+	Model  string
+	Serial string
+	WWN    string
+	// Rotational is true for spinning disks.
+	Rotational bool
+	// Children are the devices built on top of this one, such as the partitions of a disk.
+	Children []BlockDevice
+}
+
+// FilesystemUsage is the space used by the filesystem holding a path on a node, as reported by df.
+type FilesystemUsage struct {
+	// Source is the device holding the filesystem, such as /dev/sda4.
+	Source string
+	// FSType is the type of the filesystem, such as xfs.
+	FSType string
+	// MountPoint is where the filesystem is mounted, such as /var.
+	MountPoint string
+	// Size, Used and Available are in bytes.
+	Size      int64
+	Used      int64
+	Available int64
+}
+
+// UsedPercent returns the percentage of the size of the filesystem which is used. Filesystems without a size, such as
+// some pseudo filesystems, are reported as 0% used.
+func (usage *FilesystemUsage) UsedPercent() float64 {
+	if usage == nil || usage.Size == 0 {
+		return 0
+	}
+
+	return float64(usage.Used) * 100 / float64(usage.Size)
+}
+
+// ExpectedPartition is a partition ValidateDiskPartitioning expects on the node. The partition is found by its
+// PartLabel, or by its MountPoint if PartLabel is empty. Other fields are only checked when set.
+type ExpectedPartition struct {
+	// Disk is the name or path of the disk holding the partition, such as sda or /dev/sda.
+	Disk string
+	// PartLabel is the partition label, such as var-lib-containers.
+	PartLabel string
+	// MountPoint is where the partition is mounted, such as /var/lib/containers.
+	MountPoint string
+	// FSType is the type of the filesystem on the partition, such as xfs.
+	FSType string
+	// MinSize is the minimum size of the partition in bytes.
+	MinSize int64
+}
+
+// GetBlockDevices returns the block devices of the node, with disks at the top level and the partitions and other
+// devices built on them as their children. The devices are listed using lsblk on the node through the executor.
+func (builder *Builder) GetBlockDevices(executor kubelet.NodeExecutor) ([]BlockDevice, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	if executor == nil {
+		klog.V(100).Info("The executor is nil")
+
+		return nil, fmt.Errorf("node disk 'executor' cannot be nil")
+	}
+
+	klog.V(100).Infof("Getting block devices of node %s", builder.Definition.Name)
+
+	output, err := executor.ExecOnNode(builder.Definition.Name, "lsblk", "--json", "--bytes", "--output", lsblkColumns)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list block devices of node %s: %w", builder.Definition.Name, err)
+	}
+
+	return parseBlockDevices(output)
+}
+
+// GetFilesystemUsage returns the usage of the filesystem holding path on the node, such as /var/lib/containers. The
+// usage is read using df on the node through the executor.
+func (builder *Builder) GetFilesystemUsage(executor kubelet.NodeExecutor, path string) (*FilesystemUsage, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	if executor == nil {
+		klog.V(100).Info("The executor is nil")
+
+		return nil, fmt.Errorf("node disk 'executor' cannot be nil")
+	}
+
+	if path == "" {
+		klog.V(100).Info("The filesystem path is empty")
+
+		return nil, fmt.Errorf("node filesystem 'path' cannot be empty")
+	}
+
+	klog.V(100).Infof("Getting usage of filesystem holding %s on node %s", path, builder.Definition.Name)
+
+	output, err := executor.ExecOnNode(builder.Definition.Name,
+		"df", "--block-size=1", "--output=source,fstype,size,used,avail,target", path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get usage of filesystem holding %s on node %s: %w",
+			path, builder.Definition.Name, err)
+	}
+
+	return parseFilesystemUsage(output)
+}
+
+// ValidateDiskPartitioning checks that every expected partition exists on the node and matches the expectation, such
+// as the partitions created by an image based install or the disks used by LVMS. All mismatches are reported in the
+// returned error rather than only the first one.
+func (builder *Builder) ValidateDiskPartitioning(
+	executor kubelet.NodeExecutor, expected []ExpectedPartition) error {
+	if len(expected) == 0 {
+		klog.V(100).Info("The expected partitions are empty")
+
+		return fmt.Errorf("node disk 'expected' partitions cannot be empty")
+	}
+
+	devices, err := builder.GetBlockDevices(executor)
+	if err != nil {
+		return err
+	}
+
+	klog.V(100).Infof("Validating %d expected partitions on node %s", len(expected), builder.Definition.Name)
+
+	var mismatches []string
+
+	for _, partition := range expected {
+		if mismatch := validatePartition(devices, partition); mismatch != "" {
+			mismatches = append(mismatches, mismatch)
+		}
+	}
+
+	if len(mismatches) > 0 {
+		return fmt.Errorf("disk partitioning of node %s does not match: %s",
+			builder.Definition.Name, strings.Join(mismatches, "; "))
+	}
+
+	return nil
+}
+
+// validatePartition returns a description of how the block devices do not match the expected partition, or an empty
+// string if they match.
+func validatePartition(devices []BlockDevice, expected ExpectedPartition) string {
+	description := expected.PartLabel
+	if description == "" {
+		description = expected.MountPoint
+	}
+
+	if description == "" {
+		return "expected partition must have a partLabel or mountPoint"
+	}
+
+	disk, partition, found := findPartition(devices, expected)
+	if !found {
+		if expected.Disk != "" {
+			return fmt.Sprintf("partition %s not found on disk %s", description, expected.Disk)
+		}
+
+		return fmt.Sprintf("partition %s not found", description)
+	}
+
+	var problems []string
+
+	if expected.Disk != "" && !matchesDevice(disk, expected.Disk) {
+		problems = append(problems, fmt.Sprintf("on disk %s rather than %s", disk.Path, expected.Disk))
+	}
+
+	if expected.MountPoint != "" && partition.MountPoint != expected.MountPoint {
+		problems = append(problems, fmt.Sprintf("mounted at %q rather than %q", partition.MountPoint, expected.MountPoint))
+	}
+
+	if expected.FSType != "" && partition.FSType != expected.FSType {
+		problems = append(problems, fmt.Sprintf("has filesystem %q rather than %q", partition.FSType, expected.FSType))
+	}
+
+	if partition.Size < expected.MinSize {
+		problems = append(problems, fmt.Sprintf("has size %d bytes, less than %d", partition.Size, expected.MinSize))
+	}
+
+	if len(problems) == 0 {
+		return ""
+	}
+
+	return fmt.Sprintf("partition %s %s", description, strings.Join(problems, ", "))
+}
+
+// findPartition returns the first partition, along with its disk, whose label or mount point matches the expected
+// partition. Partitions on the expected disk are preferred so a partition with the same label on another disk is only
+// returned if the expected disk has none.
+func findPartition(devices []BlockDevice, expected ExpectedPartition) (BlockDevice, BlockDevice, bool) {
+	var (
+		otherDisk, otherPartition BlockDevice
+		foundOther                bool
+	)
+
+	for _, disk := range devices {
+		for _, partition := range flattenBlockDevices(disk.Children) {
+			if partition.Type != "part" || !matchesPartition(partition, expected) {
+				continue
+			}
+
+			if expected.Disk == "" || matchesDevice(disk, expected.Disk) {
+				return disk, partition, true
+			}
+
+			if !foundOther {
+				otherDisk, otherPartition, foundOther = disk, partition, true
+			}
+		}
+	}
+
+	return otherDisk, otherPartition, foundOther
+}
+
+// matchesPartition returns true if the partition has the expected label, or the expected mount point when no label is
+// expected.
+func matchesPartition(partition BlockDevice, expected ExpectedPartition) bool {
+	if expected.PartLabel != "" {
+		return partition.PartLabel == expected.PartLabel
+	}
+
+	return partition.MountPoint == expected.MountPoint
+}
+
+// matchesDevice returns true if nameOrPath is the kernel name or the path of the device.
+func matchesDevice(device BlockDevice, nameOrPath string) bool {
+	return device.Name == nameOrPath || device.Path == nameOrPath || device.Name == filepath.Base(nameOrPath)
+}
+
+// flattenBlockDevices returns the devices and all of their descendants.
+func flattenBlockDevices(devices []BlockDevice) []BlockDevice {
+	var flattened []BlockDevice
+
+	for _, device := range devices {
+		flattened = append(flattened, device)
+		flattened = append(flattened, flattenBlockDevices(device.Children)...)
+	}
+
+	return flattened
+}
+
+// lsblkDevice is a device in the JSON output of lsblk. Depending on the version of util-linux, numbers and booleans
+// are either JSON values or strings, so they are decoded as raw values. Unset columns are null.
+type lsblkDevice struct {
+	Name       string          `json:"name"`
+	Path       string          `json:"path"`
+	Type       string          `json:"type"`
+	Size       json.RawMessage `json:"size"`
+	FSType     *string         `json:"fstype"`
+	MountPoint *string         `json:"mountpoint"`
+	PartLabel  *string         `json:"partlabel"`
+	Model      *string         `json:"model"`
+	Serial     *string         `json:"serial"`
+	WWN        *string         `json:"wwn"`
+	Rota       json.RawMessage `json:"rota"`
+	Children   []lsblkDevice   `json:"children"`
+}
+
+// parseBlockDevices parses the output of lsblk --json --bytes.
+func parseBlockDevices(output string) ([]BlockDevice, error) {
+	var lsblkOutput struct {
+		BlockDevices []lsblkDevice `json:"blockdevices"`
+	}
+
+	if err := json.Unmarshal([]byte(output), &lsblkOutput); err != nil {
+		return nil, fmt.Errorf("failed to parse block devices: %w", err)
+	}
+
+	return convertLsblkDevices(lsblkOutput.BlockDevices)
+}
+
+// convertLsblkDevices converts the devices decoded from lsblk, along with their children, to BlockDevices.
+func convertLsblkDevices(lsblkDevices []lsblkDevice) ([]BlockDevice, error) {
+	var devices []BlockDevice
+
+	for _, lsblkDevice := range lsblkDevices {
+		size, err := strconv.ParseInt(strings.Trim(string(lsblkDevice.Size), `"`), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("malformed size %s of block device %s: %w", lsblkDevice.Size, lsblkDevice.Name, err)
+		}
+
+		children, err := convertLsblkDevices(lsblkDevice.Children)
+		if err != nil {
+			return nil, err
+		}
+
+		rota := strings.Trim(string(lsblkDevice.Rota), `"`)
+
+		devices = append(devices, BlockDevice{
+			Name:       lsblkDevice.Name,
+			Path:       lsblkDevice.Path,
+			Type:       lsblkDevice.Type,
+			Size:       size,
+			FSType:     ptr.Deref(lsblkDevice.FSType, ""),
+			MountPoint: ptr.Deref(lsblkDevice.MountPoint, ""),
+			PartLabel:  ptr.Deref(lsblkDevice.PartLabel, ""),
+			Model:      strings.TrimSpace(ptr.Deref(lsblkDevice.Model, "")),
+			Serial:     ptr.Deref(lsblkDevice.Serial, ""),
+			WWN:        ptr.Deref(lsblkDevice.WWN, ""),
+			Rotational: rota == "true" || rota == "1",
+			Children:   children,
+		})
+	}
+
+	return devices, nil
+}
+
+// parseFilesystemUsage parses the output of df --block-size=1 --output=source,fstype,size,used,avail,target for a
+// single path. The first line is the header.
+func parseFilesystemUsage(output string) (*FilesystemUsage, error) {
+	lines := strings.Split(strings.TrimSpace(output), "\n")
+	if len(lines) != 2 {
+		return nil, fmt.Errorf("malformed filesystem usage %q", output)
+	}
+
+	fields := strings.Fields(lines[1])
+	if len(fields) != 6 {
+		return nil, fmt.Errorf("malformed filesystem usage line %q", lines[1])
+	}
+
+	var sizes [3]int64
+
+	for index, field := range fields[2:5] {
+		size, err := strconv.ParseInt(field, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("malformed size in filesystem usage line %q: %w", lines[1], err)
+		}
+
+		sizes[index] = size
+	}
+
+	return &FilesystemUsage{
+		Source:     fields[0],
+		FSType:     fields[1],
+		Size:       sizes[0],
+		Used:       sizes[1],
+		Available:  sizes[2],
+		MountPoint: fields[5],
+	}, nil
+}
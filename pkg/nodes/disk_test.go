@@ -0,0 +1,218 @@
+package nodes
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// defaultLsblkOutput is the lsblk output of a node installed with a separate /var/lib/containers partition. The sizes
+// and rota columns use the string form of older util-linux versions for sdb.
+const defaultLsblkOutput = `{
+   "blockdevices": [
+      {"name":"sda", "path":"/dev/sda", "type":"disk", "size":480103981056, "fstype":null, "mountpoint":null,
+       "partlabel":null, "model":"INTEL SSDSC2KB48 ", "serial":"BTYF0001", "wwn":"0x55cd2e415", "rota":false,
+         "children": [
+            {"name":"sda1", "path":"/dev/sda1", "type":"part", "size":1048576, "fstype":null, "mountpoint":null,
+             "partlabel":"BIOS-BOOT", "model":null, "serial":null, "wwn":"0x55cd2e415", "rota":false},
+            {"name":"sda4", "path":"/dev/sda4", "type":"part", "size":128849018880, "fstype":"xfs",
+             "mountpoint":"/sysroot", "partlabel":"root", "model":null, "serial":null, "wwn":"0x55cd2e415",
+             "rota":false},
+            {"name":"sda5", "path":"/dev/sda5", "type":"part", "size":214748364800, "fstype":"xfs",
+             "mountpoint":"/var/lib/containers", "partlabel":"var-lib-containers", "model":null, "serial":null,
+             "wwn":"0x55cd2e415", "rota":false}
+         ]
+      },
+      {"name":"sdb", "path":"/dev/sdb", "type":"disk", "size":"1000204886016", "fstype":"LVM2_member",
+       "mountpoint":null, "partlabel":null, "model":"ST1000NM0055", "serial":"ZBS0002", "wwn":null, "rota":"1",
+         "children": [
+            {"name":"vg1-thin--pool", "path":"/dev/mapper/vg1-thin--pool", "type":"lvm", "size":"900000000000",
+             "fstype":null, "mountpoint":null, "partlabel":null, "model":null, "serial":null, "wwn":null,
+             "rota":"1"}
+         ]
+      }
+   ]
+}`
+
+func TestNodeGetBlockDevices(t *testing.T) {
+	testCases := []struct {
+		output        string
+		err           error
+		expectedError string
+	}{
+		{
+			output: defaultLsblkOutput,
+		},
+		{
+			output:        "lsblk: unknown column: PATH",
+			expectedError: "failed to parse block devices: invalid character 'l' looking for beginning of value",
+		},
+		{
+			output: `{"blockdevices": [{"name":"sda", "size":"big"}]}`,
+			expectedError: "malformed size \"big\" of block device sda: strconv.ParseInt: parsing \"big\": " +
+				"invalid syntax",
+		},
+		{
+			err:           fmt.Errorf("exec failed"),
+			expectedError: fmt.Sprintf("failed to list block devices of node %s: exec failed", defaultNodeName),
+		},
+	}
+
+	for _, testCase := range testCases {
+		executor := &fakeCommandExecutor{output: testCase.output, err: testCase.err}
+
+		devices, err := buildValidNodeTestBuilder(buildTestClientWithDummyNode()).GetBlockDevices(executor)
+		if testCase.expectedError != "" {
+			assert.EqualError(t, err, testCase.expectedError)
+
+			continue
+		}
+
+		assert.NoError(t, err)
+		assert.Equal(t, [][]string{{"lsblk", "--json", "--bytes", "--output", lsblkColumns}}, executor.commands)
+		assert.Len(t, devices, 2)
+		assert.Equal(t, "INTEL SSDSC2KB48", devices[0].Model)
+		assert.False(t, devices[0].Rotational)
+		assert.Len(t, devices[0].Children, 3)
+		assert.Equal(t, BlockDevice{
+			Name:       "sda5",
+			Path:       "/dev/sda5",
+			Type:       "part",
+			Size:       214748364800,
+			FSType:     "xfs",
+			MountPoint: "/var/lib/containers",
+			PartLabel:  "var-lib-containers",
+			WWN:        "0x55cd2e415",
+		}, devices[0].Children[2])
+		assert.Equal(t, int64(1000204886016), devices[1].Size)
+		assert.Equal(t, "LVM2_member", devices[1].FSType)
+		assert.True(t, devices[1].Rotational)
+		assert.Equal(t, "lvm", devices[1].Children[0].Type)
+	}
+
+	_, err := buildValidNodeTestBuilder(buildTestClientWithDummyNode()).GetBlockDevices(nil)
+	assert.EqualError(t, err, "node disk 'executor' cannot be nil")
+}
+
+func TestNodeGetFilesystemUsage(t *testing.T) {
+	testCases := []struct {
+		path          string
+		output        string
+		err           error
+		expected      *FilesystemUsage
+		expectedError string
+	}{
+		{
+			path: "/var/lib/containers",
+			output: "Filesystem     Type   1B-blocks        Used       Avail Mounted on\n" +
+				"/dev/sda5      xfs 214681255936 53670313984 161010941952 /var/lib/containers\n",
+			expected: &FilesystemUsage{
+				Source:     "/dev/sda5",
+				FSType:     "xfs",
+				MountPoint: "/var/lib/containers",
+				Size:       214681255936,
+				Used:       53670313984,
+				Available:  161010941952,
+			},
+		},
+		{
+			path:   "/var/lib/containers",
+			output: "Filesystem     Type   1B-blocks        Used       Avail Mounted on\n",
+			expectedError: "malformed filesystem usage " +
+				"\"Filesystem     Type   1B-blocks        Used       Avail Mounted on\\n\"",
+		},
+		{
+			path: "/var/lib/containers",
+			output: "Filesystem     Type   1B-blocks        Used       Avail Mounted on\n" +
+				"/dev/sda5      xfs 214681255936 - 161010941952 /var/lib/containers\n",
+			expectedError: "malformed size in filesystem usage line " +
+				"\"/dev/sda5      xfs 214681255936 - 161010941952 /var/lib/containers\": strconv.ParseInt: parsing " +
+				"\"-\": invalid syntax",
+		},
+		{
+			path: "/var/lib/containers",
+			err:  fmt.Errorf("exec failed"),
+			expectedError: fmt.Sprintf(
+				"failed to get usage of filesystem holding /var/lib/containers on node %s: exec failed", defaultNodeName),
+		},
+		{
+			path:          "",
+			expectedError: "node filesystem 'path' cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		executor := &fakeCommandExecutor{output: testCase.output, err: testCase.err}
+
+		usage, err := buildValidNodeTestBuilder(buildTestClientWithDummyNode()).GetFilesystemUsage(
+			executor, testCase.path)
+		if testCase.expectedError != "" {
+			assert.EqualError(t, err, testCase.expectedError)
+
+			continue
+		}
+
+		assert.NoError(t, err)
+		assert.Equal(t, testCase.expected, usage)
+		assert.InDelta(t, 25.0, usage.UsedPercent(), 0.01)
+	}
+}
+
+func TestNodeValidateDiskPartitioning(t *testing.T) {
+	testCases := []struct {
+		expected      []ExpectedPartition
+		expectedError string
+	}{
+		{
+			expected: []ExpectedPartition{
+				{
+					Disk:       "/dev/sda",
+					PartLabel:  "var-lib-containers",
+					MountPoint: "/var/lib/containers",
+					FSType:     "xfs",
+					MinSize:    100 * 1024 * 1024 * 1024,
+				},
+				{MountPoint: "/sysroot"},
+			},
+		},
+		{
+			expected: []ExpectedPartition{
+				{
+					Disk:       "sdb",
+					PartLabel:  "var-lib-containers",
+					MountPoint: "/var/lib/containers/storage",
+					FSType:     "ext4",
+					MinSize:    300 * 1024 * 1024 * 1024,
+				},
+				{PartLabel: "var-lib-etcd"},
+			},
+			expectedError: fmt.Sprintf("disk partitioning of node %s does not match: partition var-lib-containers on "+
+				"disk /dev/sda rather than sdb, mounted at \"/var/lib/containers\" rather than "+
+				"\"/var/lib/containers/storage\", has filesystem \"xfs\" rather than \"ext4\", has size 214748364800 "+
+				"bytes, less than 322122547200; partition var-lib-etcd not found", defaultNodeName),
+		},
+		{
+			expected: []ExpectedPartition{{FSType: "xfs"}},
+			expectedError: fmt.Sprintf("disk partitioning of node %s does not match: expected partition must have "+
+				"a partLabel or mountPoint", defaultNodeName),
+		},
+		{
+			expectedError: "node disk 'expected' partitions cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		executor := &fakeCommandExecutor{output: defaultLsblkOutput}
+
+		err := buildValidNodeTestBuilder(buildTestClientWithDummyNode()).ValidateDiskPartitioning(
+			executor, testCase.expected)
+		if testCase.expectedError != "" {
+			assert.EqualError(t, err, testCase.expectedError)
+
+			continue
+		}
+
+		assert.NoError(t, err)
+	}
+}
@@ -0,0 +1,163 @@
+package nodes
+
+import (
+	"fmt"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/kubelet"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/klog/v2"
+)
+
+// HugePagesNUMAInfo is the number of hugepages of a single size reserved and free on a NUMA node.
+type HugePagesNUMAInfo struct {
+	Total int64
+	Free  int64
+}
+
+// GetHugePagesCapacity returns the number of hugepages of the provided size, such as 1Gi or 2Mi, in the capacity of
+// the node.
+func (builder *Builder) GetHugePagesCapacity(size string) (int64, error) {
+	if valid, err := builder.validate(); !valid {
+		return 0, err
+	}
+
+	klog.V(100).Infof("Getting capacity of %s hugepages on node %s", size, builder.Definition.Name)
+
+	if !builder.Exists() {
+		return 0, fmt.Errorf("node object %s does not exist", builder.Definition.Name)
+	}
+
+	return countHugePages(builder.Object.Status.Capacity, size)
+}
+
+// GetHugePagesAllocatable returns the number of hugepages of the provided size, such as 1Gi or 2Mi, that pods may
+// request on the node.
+func (builder *Builder) GetHugePagesAllocatable(size string) (int64, error) {
+	if valid, err := builder.validate(); !valid {
+		return 0, err
+	}
+
+	klog.V(100).Infof("Getting allocatable %s hugepages on node %s", size, builder.Definition.Name)
+
+	if !builder.Exists() {
+		return 0, fmt.Errorf("node object %s does not exist", builder.Definition.Name)
+	}
+
+	return countHugePages(builder.Object.Status.Allocatable, size)
+}
+
+// GetHugePagesPerNUMA returns the number of hugepages of the provided size reserved and free on each NUMA node, keyed
+// by NUMA node ID. The counts are read from sysfs on the node using the executor.
+func (builder *Builder) GetHugePagesPerNUMA(
+	executor kubelet.NodeExecutor, size string) (map[int]HugePagesNUMAInfo, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	if executor == nil {
+		klog.V(100).Info("The executor is nil")
+
+		return nil, fmt.Errorf("node hugepages 'executor' cannot be nil")
+	}
+
+	pageSize, err := parseHugePageSize(size)
+	if err != nil {
+		return nil, err
+	}
+
+	klog.V(100).Infof("Getting %s hugepages per NUMA node on node %s", size, builder.Definition.Name)
+
+	sysfsDir := fmt.Sprintf("/sys/devices/system/node/node*/hugepages/hugepages-%dkB", pageSize.Value()/1024)
+
+	output, err := executor.ExecOnNode(builder.Definition.Name, "sh", "-c",
+		fmt.Sprintf("grep -H . %s/nr_hugepages %s/free_hugepages", sysfsDir, sysfsDir))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read hugepages of node %s: %w", builder.Definition.Name, err)
+	}
+
+	return parseHugePagesPerNUMA(output)
+}
+
+// parseHugePageSize parses a hugepage size, such as 1Gi or 2Mi, and returns it as a quantity.
+func parseHugePageSize(size string) (resource.Quantity, error) {
+	if size == "" {
+		return resource.Quantity{}, fmt.Errorf("node hugepages 'size' cannot be empty")
+	}
+
+	pageSize, err := resource.ParseQuantity(size)
+	if err != nil {
+		return resource.Quantity{}, fmt.Errorf("invalid hugepages size %q: %w", size, err)
+	}
+
+	if pageSize.Value() < 1024 {
+		return resource.Quantity{}, fmt.Errorf("invalid hugepages size %q: must be at least 1Ki", size)
+	}
+
+	return pageSize, nil
+}
+
+// countHugePages returns the number of pages of size in the hugepages resource of resources. Resources without the
+// hugepages resource have zero pages.
+func countHugePages(resources corev1.ResourceList, size string) (int64, error) {
+	pageSize, err := parseHugePageSize(size)
+	if err != nil {
+		return 0, err
+	}
+
+	quantity, ok := resources[corev1.ResourceName(corev1.ResourceHugePagesPrefix+size)]
+	if !ok {
+		return 0, nil
+	}
+
+	return quantity.Value() / pageSize.Value(), nil
+}
+
+// parseHugePagesPerNUMA parses the output of grep -H over the nr_hugepages and free_hugepages files of each NUMA node.
+// Each line has the form /sys/devices/system/node/node0/hugepages/hugepages-1048576kB/nr_hugepages:4. Lines may end in
+// \r\n since commands run in a pod with a TTY.
+func parseHugePagesPerNUMA(output string) (map[int]HugePagesNUMAInfo, error) {
+	perNUMA := make(map[int]HugePagesNUMAInfo)
+
+	for _, line := range strings.Split(strings.TrimSpace(output), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+
+		path, rawValue, found := strings.Cut(line, ":")
+		if !found {
+			return nil, fmt.Errorf("malformed hugepages line %q", line)
+		}
+
+		value, err := strconv.ParseInt(rawValue, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("malformed hugepages count in line %q: %w", line, err)
+		}
+
+		numaDir := filepath.Base(filepath.Dir(filepath.Dir(filepath.Dir(path))))
+
+		numaNode, err := strconv.Atoi(strings.TrimPrefix(numaDir, "node"))
+		if err != nil || !strings.HasPrefix(numaDir, "node") {
+			return nil, fmt.Errorf("malformed NUMA node in line %q", line)
+		}
+
+		info := perNUMA[numaNode]
+
+		switch filepath.Base(path) {
+		case "nr_hugepages":
+			info.Total = value
+		case "free_hugepages":
+			info.Free = value
+		default:
+			return nil, fmt.Errorf("unexpected hugepages file in line %q", line)
+		}
+
+		perNUMA[numaNode] = info
+	}
+
+	return perNUMA, nil
+}
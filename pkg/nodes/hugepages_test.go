@@ -0,0 +1,170 @@
+package nodes
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// fakeCommandExecutor returns the configured output or error and records the commands it receives.
+type fakeCommandExecutor struct {
+	commands [][]string
+	output   string
+	err      error
+}
+
+// ExecOnNode records the command and returns the configured output or error.
+func (executor *fakeCommandExecutor) ExecOnNode(nodeName string, command ...string) (string, error) {
+	executor.commands = append(executor.commands, command)
+
+	return executor.output, executor.err
+}
+
+func TestNodeGetHugePagesCapacity(t *testing.T) {
+	testCases := []struct {
+		size          string
+		exists        bool
+		expectedPages int64
+		expectedError string
+	}{
+		{
+			size:          "1Gi",
+			exists:        true,
+			expectedPages: 4,
+		},
+		{
+			size:          "2Mi",
+			exists:        true,
+			expectedPages: 512,
+		},
+		{
+			size:          "16Gi",
+			exists:        true,
+			expectedPages: 0,
+		},
+		{
+			size:          "",
+			exists:        true,
+			expectedError: "node hugepages 'size' cannot be empty",
+		},
+		{
+			size:          "1Gi",
+			exists:        false,
+			expectedError: fmt.Sprintf("node object %s does not exist", defaultNodeName),
+		},
+	}
+
+	for _, testCase := range testCases {
+		var runtimeObjects []runtime.Object
+
+		if testCase.exists {
+			runtimeObjects = append(runtimeObjects, buildDummyNodeWithHugePages(defaultNodeName))
+		}
+
+		testBuilder := buildValidNodeTestBuilder(clients.GetTestClients(clients.TestClientParams{
+			K8sMockObjects: runtimeObjects,
+		}))
+
+		pages, err := testBuilder.GetHugePagesCapacity(testCase.size)
+		if testCase.expectedError != "" {
+			assert.EqualError(t, err, testCase.expectedError)
+
+			continue
+		}
+
+		assert.NoError(t, err)
+		assert.Equal(t, testCase.expectedPages, pages)
+	}
+}
+
+func TestNodeGetHugePagesAllocatable(t *testing.T) {
+	testBuilder := buildValidNodeTestBuilder(clients.GetTestClients(clients.TestClientParams{
+		K8sMockObjects: []runtime.Object{buildDummyNodeWithHugePages(defaultNodeName)},
+	}))
+
+	pages, err := testBuilder.GetHugePagesAllocatable("1Gi")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), pages)
+
+	_, err = testBuilder.GetHugePagesAllocatable("1x")
+	assert.ErrorContains(t, err, "invalid hugepages size \"1x\"")
+}
+
+func TestNodeGetHugePagesPerNUMA(t *testing.T) {
+	testCases := []struct {
+		output        string
+		err           error
+		expected      map[int]HugePagesNUMAInfo
+		expectedError string
+	}{
+		{
+			output: "/sys/devices/system/node/node0/hugepages/hugepages-1048576kB/nr_hugepages:4\n" +
+				"/sys/devices/system/node/node1/hugepages/hugepages-1048576kB/nr_hugepages:2\n" +
+				"/sys/devices/system/node/node0/hugepages/hugepages-1048576kB/free_hugepages:3\n" +
+				"/sys/devices/system/node/node1/hugepages/hugepages-1048576kB/free_hugepages:2\n",
+			expected: map[int]HugePagesNUMAInfo{0: {Total: 4, Free: 3}, 1: {Total: 2, Free: 2}},
+		},
+		{
+			output: "/sys/devices/system/node/node0/hugepages/hugepages-1048576kB/nr_hugepages:4\r\n" +
+				"/sys/devices/system/node/node1/hugepages/hugepages-1048576kB/nr_hugepages:2\r\n" +
+				"/sys/devices/system/node/node0/hugepages/hugepages-1048576kB/free_hugepages:3\r\n" +
+				"/sys/devices/system/node/node1/hugepages/hugepages-1048576kB/free_hugepages:2\r\n",
+			expected: map[int]HugePagesNUMAInfo{0: {Total: 4, Free: 3}, 1: {Total: 2, Free: 2}},
+		},
+		{
+			output: "/sys/devices/system/node/node0/hugepages/hugepages-1048576kB/nr_hugepages\n",
+			expectedError: "malformed hugepages line " +
+				"\"/sys/devices/system/node/node0/hugepages/hugepages-1048576kB/nr_hugepages\"",
+		},
+		{
+			output: "/sys/devices/system/node/nodeX/hugepages/hugepages-1048576kB/nr_hugepages:4\n",
+			expectedError: "malformed NUMA node in line " +
+				"\"/sys/devices/system/node/nodeX/hugepages/hugepages-1048576kB/nr_hugepages:4\"",
+		},
+		{
+			err:           fmt.Errorf("exec failed"),
+			expectedError: fmt.Sprintf("failed to read hugepages of node %s: exec failed", defaultNodeName),
+		},
+	}
+
+	for _, testCase := range testCases {
+		executor := &fakeCommandExecutor{output: testCase.output, err: testCase.err}
+
+		perNUMA, err := buildValidNodeTestBuilder(buildTestClientWithDummyNode()).GetHugePagesPerNUMA(executor, "1Gi")
+		if testCase.expectedError != "" {
+			assert.EqualError(t, err, testCase.expectedError)
+
+			continue
+		}
+
+		assert.NoError(t, err)
+		assert.Equal(t, testCase.expected, perNUMA)
+		assert.Equal(t, [][]string{{"sh", "-c",
+			"grep -H . /sys/devices/system/node/node*/hugepages/hugepages-1048576kB/nr_hugepages " +
+				"/sys/devices/system/node/node*/hugepages/hugepages-1048576kB/free_hugepages"}}, executor.commands)
+	}
+
+	_, err := buildValidNodeTestBuilder(buildTestClientWithDummyNode()).GetHugePagesPerNUMA(nil, "1Gi")
+	assert.EqualError(t, err, "node hugepages 'executor' cannot be nil")
+}
+
+// buildDummyNodeWithHugePages returns a Node with 4Gi of 1Gi hugepages and 1Gi of 2Mi hugepages in its capacity, of
+// which 3Gi of 1Gi hugepages are allocatable.
+func buildDummyNodeWithHugePages(name string) *corev1.Node {
+	node := buildDummyNode(name)
+
+	node.Status.Capacity = corev1.ResourceList{
+		"hugepages-1Gi": resource.MustParse("4Gi"),
+		"hugepages-2Mi": resource.MustParse("1Gi"),
+	}
+	node.Status.Allocatable = corev1.ResourceList{
+		"hugepages-1Gi": resource.MustParse("3Gi"),
+	}
+
+	return node
+}
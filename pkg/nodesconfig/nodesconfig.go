@@ -159,7 +159,7 @@ func (builder *Builder) WithCGroupMode(expectedCGroupMode configV1.CgroupMode) *
 // GetNodesConfigIoGVR returns nodesConfig's GroupVersionResource which could be used for Clean function.
 func GetNodesConfigIoGVR() schema.GroupVersionResource {
 	return schema.GroupVersionResource{
-		Group: APIGroup, Version: APIVersion, Resource: APIKind,
+		Group: APIGroup, Version: APIVersion, Resource: "nodes",
 	}
 }
 
@@ -187,7 +187,7 @@ func TestNodesConfigUpdate(t *testing.T) {
 func TestGetNodesConfigGVR(t *testing.T) {
 	assert.Equal(t, GetNodesConfigIoGVR(),
 		schema.GroupVersionResource{
-			Group: APIGroup, Version: APIVersion, Resource: APIKind,
+			Group: APIGroup, Version: APIVersion, Resource: "nodes",
 		})
 }
 
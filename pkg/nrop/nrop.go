@@ -9,6 +9,7 @@ import (
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/msg"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/klog/v2"
 	goclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -238,6 +239,14 @@ func (builder *Builder) WithMCPSelector(config nropv1.NodeGroupConfig, mcpSelect
 	return builder
 }
 
+// GetNUMAResourcesOperatorGVR returns NUMAResourcesOperator's GroupVersionResource
+// which could be used for Clean function.
+func GetNUMAResourcesOperatorGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group: "nodetopology.openshift.io", Version: "v1", Resource: "numaresourcesoperators",
+	}
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *Builder) validate() (bool, error) {
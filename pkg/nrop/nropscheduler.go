@@ -10,6 +10,7 @@ import (
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/msg"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/klog/v2"
 	goclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -34,6 +35,8 @@ type SchedulerBuilder struct {
 // NewSchedulerBuilder creates a new instance of NUMAResourcesScheduler.
 func NewSchedulerBuilder(
 	apiClient *clients.Settings, name, nsname string) *SchedulerBuilder {
+	nsname = apiClient.ResolveNamespace(nsname)
+
 	klog.V(100).Infof(
 		"Initializing new NUMAResourcesScheduler structure with the following name: %s in namespace %s",
 		name, nsname)
@@ -82,6 +85,8 @@ func NewSchedulerBuilder(
 
 // PullScheduler pulls existing NUMAResourcesScheduler from cluster.
 func PullScheduler(apiClient *clients.Settings, name, nsname string) (*SchedulerBuilder, error) {
+	nsname = apiClient.ResolveNamespace(nsname)
+
 	klog.V(100).Infof("Pulling existing NUMAResourcesScheduler %s in namespace %s from the cluster",
 		name, nsname)
 
@@ -286,6 +291,14 @@ func (builder *SchedulerBuilder) WithSchedulerName(schedulerName string) *Schedu
 	return builder
 }
 
+// GetNUMAResourcesSchedulerGVR returns NUMAResourcesScheduler's GroupVersionResource
+// which could be used for Clean function.
+func GetNUMAResourcesSchedulerGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group: "nodetopology.openshift.io", Version: "v1", Resource: "numaresourcesschedulers",
+	}
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *SchedulerBuilder) validate() (bool, error) {
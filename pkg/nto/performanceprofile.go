@@ -6,10 +6,12 @@ import (
 
 	performanceprofilev2 "github.com/openshift/cluster-node-tuning-operator/pkg/apis/performanceprofile/v2"
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common"
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/logging"
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/msg"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/klog/v2"
 	goclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -347,6 +349,14 @@ func (builder *Builder) WithAnnotations(annotations map[string]string) *Builder
 		return builder
 	}
 
+	if err := common.ValidateAnnotations(annotations); err != nil {
+		klog.V(100).Infof("Invalid annotations: %v", err)
+
+		builder.errorMsg = err.Error()
+
+		return builder
+	}
+
 	builder.Definition.Annotations = annotations
 
 	return builder
@@ -520,6 +530,13 @@ func (builder *Builder) Update(force bool) (*Builder, error) {
 	return builder, err
 }
 
+// GetPerformanceProfileGVR returns PerformanceProfile's GroupVersionResource which could be used for Clean function.
+func GetPerformanceProfileGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group: "performance.openshift.io", Version: "v2", Resource: "performanceprofiles",
+	}
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *Builder) validate() (bool, error) {
@@ -9,6 +9,7 @@ import (
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/msg"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/klog/v2"
 	goclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -28,6 +29,8 @@ type TunedBuilder struct {
 // NewTunedBuilder creates a new instance of TunedBuilder.
 func NewTunedBuilder(
 	apiClient *clients.Settings, name, nsname string) *TunedBuilder {
+	nsname = apiClient.ResolveNamespace(nsname)
+
 	klog.V(100).Infof(
 		"Initializing new Tuned structure with the following params: "+
 			"name: %s, namespace: %s", name, nsname)
@@ -76,6 +79,8 @@ func NewTunedBuilder(
 
 // PullTuned pulls existing Tuned from cluster.
 func PullTuned(apiClient *clients.Settings, name, nsname string) (*TunedBuilder, error) {
+	nsname = apiClient.ResolveNamespace(nsname)
+
 	klog.V(100).Infof("Pulling existing Tuned name %s in namespace %s from cluster", name, nsname)
 
 	if apiClient == nil {
@@ -261,6 +266,13 @@ func (builder *TunedBuilder) WithRecommend(
 	return builder
 }
 
+// GetTunedGVR returns Tuned's GroupVersionResource which could be used for Clean function.
+func GetTunedGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group: "tuned.openshift.io", Version: "v1", Resource: "tuneds",
+	}
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *TunedBuilder) validate() (bool, error) {
@@ -9,6 +9,7 @@ import (
 	oadpv1alpha1 "github.com/rh-ecosystem-edge/eco-goinfra/pkg/schemes/oadp/api/v1alpha1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/klog/v2"
 	runtimeClient "sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -28,6 +29,8 @@ type DPABuilder struct {
 // NewDPABuilder creates a new instance of DPABuilder.
 func NewDPABuilder(
 	apiClient *clients.Settings, name, namespace string, config oadpv1alpha1.ApplicationConfig) *DPABuilder {
+	namespace = apiClient.ResolveNamespace(namespace)
+
 	klog.V(100).Infof(
 		"Initializing new dataprotectionapplication: name: %s, namespace: %s, config: %v",
 		name, namespace, config)
@@ -81,6 +84,8 @@ func NewDPABuilder(
 
 // PullDPA pulls existing dataprotectionapplication from cluster.
 func PullDPA(apiClient *clients.Settings, name, nsname string) (*DPABuilder, error) {
+	nsname = apiClient.ResolveNamespace(nsname)
+
 	klog.V(100).Infof("Pulling existing dataprotectionapplication name %s under namespace %s from cluster", name, nsname)
 
 	if apiClient == nil {
@@ -273,6 +278,13 @@ func (builder *DPABuilder) Delete() error {
 	return nil
 }
 
+// GetGVR returns DataProtectionApplication's GroupVersionResource which could be used for Clean function.
+func GetGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group: "oadp.openshift.io", Version: "v1alpha1", Resource: "dataprotectionapplications",
+	}
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *DPABuilder) validate() (bool, error) {
@@ -13,6 +13,8 @@ import (
 // ListDataProtectionApplication returns dataprotectionapplication inventory in the given namespace.
 func ListDataProtectionApplication(
 	apiClient *clients.Settings, nsname string, options ...runtimeClient.ListOptions) ([]*DPABuilder, error) {
+	nsname = apiClient.ResolveNamespace(nsname)
+
 	klog.V(100).Infof("Listing dataprotectionapplications in namespace %s", nsname)
 
 	if apiClient == nil {
@@ -9,6 +9,7 @@ import (
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/msg"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/klog/v2"
 
 	goclient "sigs.k8s.io/controller-runtime/pkg/client"
@@ -167,6 +168,13 @@ func (builder *OAuthClientBuilder) Delete() error {
 	return nil
 }
 
+// GetGVR returns OAuthClient's GroupVersionResource which could be used for Clean function.
+func GetGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group: "oauth.openshift.io", Version: "v1", Resource: "oauthclients",
+	}
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *OAuthClientBuilder) validate() (bool, error) {
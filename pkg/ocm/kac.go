@@ -11,6 +11,7 @@ import (
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/schemes/ocm/kacv1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/klog/v2"
 	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
@@ -36,6 +37,8 @@ type KACBuilder struct {
 
 // NewKACBuilder creates a new instance of a KlusterletAddonConfig builder.
 func NewKACBuilder(apiClient *clients.Settings, name, nsname string) *KACBuilder {
+	nsname = apiClient.ResolveNamespace(nsname)
+
 	klog.V(100).Infof(
 		"Initializing new KlusterletAddonConfig structure with the following params: name: %s, nsname: %s", name, nsname)
 
@@ -83,6 +86,8 @@ func NewKACBuilder(apiClient *clients.Settings, name, nsname string) *KACBuilder
 
 // PullKAC pulls an existing KlusterletAddonConfig into a Builder struct.
 func PullKAC(apiClient *clients.Settings, name, nsname string) (*KACBuilder, error) {
+	nsname = apiClient.ResolveNamespace(nsname)
+
 	klog.V(100).Infof("Pulling existing KlusterletAddonConfig %s under namespace %s from cluster", name, nsname)
 
 	if apiClient == nil {
@@ -303,6 +308,14 @@ func (builder *KACBuilder) WaitUntilSearchCollectorEnabled(timeout time.Duration
 	return builder, nil
 }
 
+// GetKlusterletAddonConfigGVR returns KlusterletAddonConfig's GroupVersionResource
+// which could be used for Clean function.
+func GetKlusterletAddonConfigGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group: "agent.open-cluster-management.io", Version: "v1", Resource: "klusterletaddonconfigs",
+	}
+}
+
 // validate checks that the builder, definition, and apiClient are properly initialized and there is no errorMsg.
 func (builder *KACBuilder) validate() (bool, error) {
 	resourceCRD := "klusterletAddonConfig"
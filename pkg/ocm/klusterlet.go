@@ -8,6 +8,7 @@ import (
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/msg"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/klog/v2"
 	operatorv1 "open-cluster-management.io/api/operator/v1"
 	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
@@ -223,6 +224,13 @@ func (builder *KlusterletBuilder) Delete() error {
 	return nil
 }
 
+// GetKlusterletGVR returns Klusterlet's GroupVersionResource which could be used for Clean function.
+func GetKlusterletGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group: "operator.open-cluster-management.io", Version: "v1", Resource: "klusterlets",
+	}
+}
+
 // validate checks that the builder, definition, and apiClient are properly initialized and there is no errorMsg.
 func (builder *KlusterletBuilder) validate() (bool, error) {
 	resourceCRD := "klusterlet"
@@ -12,6 +12,7 @@ import (
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/schemes/ocm/clusterv1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/klog/v2"
 	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
@@ -384,6 +385,13 @@ func (builder *ManagedClusterBuilder) WaitForCondition(expected metav1.Condition
 	return builder, nil
 }
 
+// GetManagedClusterGVR returns ManagedCluster's GroupVersionResource which could be used for Clean function.
+func GetManagedClusterGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group: "cluster.open-cluster-management.io", Version: "v1", Resource: "managedclusters",
+	}
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *ManagedClusterBuilder) validate() (bool, error) {
@@ -8,6 +8,7 @@ import (
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/msg"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/klog/v2"
 	policiesv1 "open-cluster-management.io/governance-policy-propagator/api/v1"
 	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
@@ -40,6 +41,8 @@ func NewPlacementBindingBuilder(
 	nsname string,
 	placementRef policiesv1.PlacementSubject,
 	subject policiesv1.Subject) *PlacementBindingBuilder {
+	nsname = apiClient.ResolveNamespace(nsname)
+
 	klog.V(100).Infof(
 		"Initializing new placement binding structure with the following params: name: %s, nsname: %s",
 		name, nsname)
@@ -98,6 +101,8 @@ func NewPlacementBindingBuilder(
 
 // PullPlacementBinding pulls existing placementBinding into Builder struct.
 func PullPlacementBinding(apiClient *clients.Settings, name, nsname string) (*PlacementBindingBuilder, error) {
+	nsname = apiClient.ResolveNamespace(nsname)
+
 	klog.V(100).Infof("Pulling existing placementBinding name %s under namespace %s from cluster", name, nsname)
 
 	if apiClient == nil {
@@ -346,6 +351,13 @@ func validateSubject(subject policiesv1.Subject) string {
 	return ""
 }
 
+// GetPlacementBindingGVR returns PlacementBinding's GroupVersionResource which could be used for Clean function.
+func GetPlacementBindingGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group: "policy.open-cluster-management.io", Version: "v1", Resource: "placementbindings",
+	}
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *PlacementBindingBuilder) validate() (bool, error) {
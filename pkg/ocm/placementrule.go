@@ -8,6 +8,7 @@ import (
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/msg"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/klog/v2"
 	placementrulev1 "open-cluster-management.io/multicloud-operators-subscription/pkg/apis/apps/placementrule/v1"
 	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
@@ -28,6 +29,8 @@ type PlacementRuleBuilder struct {
 
 // NewPlacementRuleBuilder creates a new instance of PlacementRuleBuilder.
 func NewPlacementRuleBuilder(apiClient *clients.Settings, name, nsname string) *PlacementRuleBuilder {
+	nsname = apiClient.ResolveNamespace(nsname)
+
 	klog.V(100).Infof(
 		"Initializing new placement rule structure with the following params: name: %s, nsname: %s",
 		name, nsname)
@@ -76,6 +79,8 @@ func NewPlacementRuleBuilder(apiClient *clients.Settings, name, nsname string) *
 
 // PullPlacementRule pulls existing placementrule into Builder struct.
 func PullPlacementRule(apiClient *clients.Settings, name, nsname string) (*PlacementRuleBuilder, error) {
+	nsname = apiClient.ResolveNamespace(nsname)
+
 	klog.V(100).Infof("Pulling existing placementrule name %s under namespace %s from cluster", name, nsname)
 
 	if apiClient == nil {
@@ -255,6 +260,13 @@ func (builder *PlacementRuleBuilder) Update(force bool) (*PlacementRuleBuilder,
 	return builder, nil
 }
 
+// GetPlacementRuleGVR returns PlacementRule's GroupVersionResource which could be used for Clean function.
+func GetPlacementRuleGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group: "apps.open-cluster-management.io", Version: "v1", Resource: "placementrules",
+	}
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *PlacementRuleBuilder) validate() (bool, error) {
@@ -11,6 +11,7 @@ import (
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/msg"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/klog/v2"
 	policiesv1 "open-cluster-management.io/governance-policy-propagator/api/v1"
@@ -33,6 +34,8 @@ type PolicyBuilder struct {
 // NewPolicyBuilder creates a new instance of PolicyBuilder.
 func NewPolicyBuilder(
 	apiClient *clients.Settings, name, nsname string, template *policiesv1.PolicyTemplate) *PolicyBuilder {
+	nsname = apiClient.ResolveNamespace(nsname)
+
 	klog.V(100).Infof(
 		"Initializing new policy structure with the following params: name: %s, nsname: %s",
 		name, nsname)
@@ -92,6 +95,8 @@ func NewPolicyBuilder(
 
 // PullPolicy pulls existing policy into Builder struct.
 func PullPolicy(apiClient *clients.Settings, name, nsname string) (*PolicyBuilder, error) {
+	nsname = apiClient.ResolveNamespace(nsname)
+
 	klog.V(100).Infof("Pulling existing policy name %s under namespace %s from cluster", name, nsname)
 
 	if apiClient == nil {
@@ -419,6 +424,13 @@ func (builder *PolicyBuilder) WaitForStatusMessageToContain(
 	return builder, nil
 }
 
+// GetPolicyGVR returns Policy's GroupVersionResource which could be used for Clean function.
+func GetPolicyGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group: "policy.open-cluster-management.io", Version: "v1", Resource: "policies",
+	}
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *PolicyBuilder) validate() (bool, error) {
@@ -8,6 +8,7 @@ import (
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/msg"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/klog/v2"
 	policiesv1beta1 "open-cluster-management.io/governance-policy-propagator/api/v1beta1"
 	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
@@ -29,6 +30,8 @@ type PolicySetBuilder struct {
 // NewPolicySetBuilder creates a new instance of PolicySetBuilder.
 func NewPolicySetBuilder(
 	apiClient *clients.Settings, name, nsname string, policy policiesv1beta1.NonEmptyString) *PolicySetBuilder {
+	nsname = apiClient.ResolveNamespace(nsname)
+
 	klog.V(100).Infof(
 		"Initializing new policy set structure with the following params: name: %s, nsname: %s, policy: %v",
 		name, nsname, policy)
@@ -88,6 +91,8 @@ func NewPolicySetBuilder(
 
 // PullPolicySet pulls existing policySet into Builder struct.
 func PullPolicySet(apiClient *clients.Settings, name, nsname string) (*PolicySetBuilder, error) {
+	nsname = apiClient.ResolveNamespace(nsname)
+
 	klog.V(100).Infof("Pulling existing policySet name %s under namespace %s from cluster", name, nsname)
 
 	if apiClient == nil {
@@ -289,6 +294,13 @@ func (builder *PolicySetBuilder) WithAdditionalPolicy(policy policiesv1beta1.Non
 	return builder
 }
 
+// GetPolicySetGVR returns PolicySet's GroupVersionResource which could be used for Clean function.
+func GetPolicySetGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group: "policy.open-cluster-management.io", Version: "v1beta1", Resource: "policysets",
+	}
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *PolicySetBuilder) validate() (bool, error) {
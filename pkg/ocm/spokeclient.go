@@ -0,0 +1,125 @@
+package ocm
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	hivev1 "github.com/rh-ecosystem-edge/eco-goinfra/pkg/schemes/hive/api/v1"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/tools/clientcmd"
+	"k8s.io/klog/v2"
+	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// adminKubeconfigSecretSuffix is appended to the name of the cluster to get the name of the admin kubeconfig
+	// secret when there is no ClusterDeployment referencing it, as for clusters installed through the assisted
+	// installer or the siteconfig operator.
+	adminKubeconfigSecretSuffix = "-admin-kubeconfig"
+	// adminKubeconfigSecretKey is the key of the admin kubeconfig secret containing the kubeconfig.
+	adminKubeconfigSecretKey = "kubeconfig"
+)
+
+// GetSpokeClient returns a client for the ManagedCluster with the provided name, using the admin kubeconfig stored on
+// the hub. The kubeconfig secret is taken from the ClusterDeployment of the same name in the namespace of the cluster,
+// falling back to the <clusterName>-admin-kubeconfig secret in that namespace if there is no ClusterDeployment.
+//
+// The kubeconfig is written to a temporary file so that the KubeconfigPath of the returned client may be used by tools
+// that require a file. The file is not removed since it must remain valid for as long as the client is used.
+func GetSpokeClient(hubClient *clients.Settings, clusterName string) (*clients.Settings, error) {
+	if hubClient == nil {
+		klog.V(100).Info("The hubClient is nil")
+
+		return nil, fmt.Errorf("spoke client 'hubClient' cannot be nil")
+	}
+
+	if clusterName == "" {
+		klog.V(100).Info("The clusterName of the spoke is empty")
+
+		return nil, fmt.Errorf("spoke client 'clusterName' cannot be empty")
+	}
+
+	klog.V(100).Infof("Getting client for spoke cluster %s from hub", clusterName)
+
+	secretName, err := getAdminKubeconfigSecretName(hubClient, clusterName)
+	if err != nil {
+		return nil, err
+	}
+
+	secret := &corev1.Secret{}
+
+	err = hubClient.Client.Get(context.TODO(), runtimeclient.ObjectKey{Name: secretName, Namespace: clusterName}, secret)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get admin kubeconfig secret %s for spoke cluster %s: %w",
+			secretName, clusterName, err)
+	}
+
+	kubeconfig, ok := secret.Data[adminKubeconfigSecretKey]
+	if !ok || len(kubeconfig) == 0 {
+		return nil, fmt.Errorf("admin kubeconfig secret %s for spoke cluster %s has no %s key",
+			secretName, clusterName, adminKubeconfigSecretKey)
+	}
+
+	return newSpokeClient(clusterName, kubeconfig)
+}
+
+// getAdminKubeconfigSecretName returns the name of the admin kubeconfig secret of the cluster. The ClusterDeployment
+// of the cluster is preferred, since hive may add a random suffix to the secret name.
+func getAdminKubeconfigSecretName(hubClient *clients.Settings, clusterName string) (string, error) {
+	err := hubClient.AttachScheme(hivev1.AddToScheme)
+	if err != nil {
+		klog.V(100).Info("Failed to add hive v1 scheme to client schemes")
+
+		return "", err
+	}
+
+	clusterDeployment := &hivev1.ClusterDeployment{}
+
+	err = hubClient.Client.Get(context.TODO(),
+		runtimeclient.ObjectKey{Name: clusterName, Namespace: clusterName}, clusterDeployment)
+	if err != nil {
+		if !k8serrors.IsNotFound(err) {
+			return "", fmt.Errorf("failed to get clusterDeployment for spoke cluster %s: %w", clusterName, err)
+		}
+
+		klog.V(100).Infof("No clusterDeployment found for spoke cluster %s, using default secret name", clusterName)
+
+		return clusterName + adminKubeconfigSecretSuffix, nil
+	}
+
+	if clusterDeployment.Spec.ClusterMetadata == nil ||
+		clusterDeployment.Spec.ClusterMetadata.AdminKubeconfigSecretRef.Name == "" {
+		return "", fmt.Errorf("clusterDeployment for spoke cluster %s does not reference an admin kubeconfig yet",
+			clusterName)
+	}
+
+	return clusterDeployment.Spec.ClusterMetadata.AdminKubeconfigSecretRef.Name, nil
+}
+
+// newSpokeClient validates the kubeconfig and returns a client for it after writing it to a temporary file.
+func newSpokeClient(clusterName string, kubeconfig []byte) (*clients.Settings, error) {
+	if _, err := clientcmd.Load(kubeconfig); err != nil {
+		return nil, fmt.Errorf("failed to parse admin kubeconfig for spoke cluster %s: %w", clusterName, err)
+	}
+
+	kubeconfigFile, err := os.CreateTemp("", clusterName+"-kubeconfig-")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create kubeconfig file for spoke cluster %s: %w", clusterName, err)
+	}
+
+	defer kubeconfigFile.Close()
+
+	if _, err := kubeconfigFile.Write(kubeconfig); err != nil {
+		return nil, fmt.Errorf("failed to write kubeconfig file for spoke cluster %s: %w", clusterName, err)
+	}
+
+	spokeClient := clients.New(kubeconfigFile.Name())
+	if spokeClient == nil {
+		return nil, fmt.Errorf("failed to create client for spoke cluster %s", clusterName)
+	}
+
+	return spokeClient, nil
+}
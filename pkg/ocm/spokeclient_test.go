@@ -0,0 +1,159 @@
+package ocm
+
+import (
+	"testing"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	hivev1 "github.com/rh-ecosystem-edge/eco-goinfra/pkg/schemes/hive/api/v1"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	defaultSpokeName = "spoke-test"
+	dummyKubeconfig  = `apiVersion: v1
+kind: Config
+clusters:
+- name: spoke-test
+  cluster:
+    server: https://api.spoke-test.example.com:6443
+contexts:
+- name: admin
+  context:
+    cluster: spoke-test
+    user: admin
+current-context: admin
+users:
+- name: admin
+  user:
+    token: dummy-token
+`
+)
+
+func TestGetSpokeClient(t *testing.T) {
+	testCases := []struct {
+		clusterName       string
+		objects           []runtime.Object
+		client            bool
+		expectedErrorText string
+	}{
+		{
+			clusterName: defaultSpokeName,
+			objects: []runtime.Object{
+				buildDummyAdminKubeconfigSecret(defaultSpokeName+"-admin-kubeconfig", dummyKubeconfig)},
+			client: true,
+		},
+		{
+			clusterName: defaultSpokeName,
+			objects: []runtime.Object{
+				buildDummyClusterDeployment("spoke-test-x7k2p-admin-kubeconfig"),
+				buildDummyAdminKubeconfigSecret("spoke-test-x7k2p-admin-kubeconfig", dummyKubeconfig),
+			},
+			client: true,
+		},
+		{
+			clusterName: defaultSpokeName,
+			objects: []runtime.Object{
+				buildDummyClusterDeployment(""),
+				buildDummyAdminKubeconfigSecret(defaultSpokeName+"-admin-kubeconfig", dummyKubeconfig),
+			},
+			client:            true,
+			expectedErrorText: "clusterDeployment for spoke cluster spoke-test does not reference an admin kubeconfig yet",
+		},
+		{
+			clusterName: defaultSpokeName,
+			objects:     []runtime.Object{},
+			client:      true,
+			expectedErrorText: "failed to get admin kubeconfig secret spoke-test-admin-kubeconfig for spoke cluster " +
+				"spoke-test: secrets \"spoke-test-admin-kubeconfig\" not found",
+		},
+		{
+			clusterName: defaultSpokeName,
+			objects:     []runtime.Object{buildDummyAdminKubeconfigSecret(defaultSpokeName+"-admin-kubeconfig", "")},
+			client:      true,
+			expectedErrorText: "admin kubeconfig secret spoke-test-admin-kubeconfig for spoke cluster spoke-test " +
+				"has no kubeconfig key",
+		},
+		{
+			clusterName: defaultSpokeName,
+			objects: []runtime.Object{
+				buildDummyAdminKubeconfigSecret(defaultSpokeName+"-admin-kubeconfig", "clusters: {")},
+			client: true,
+			expectedErrorText: "failed to parse admin kubeconfig for spoke cluster spoke-test: " +
+				"yaml: line 1: did not find expected node content",
+		},
+		{
+			clusterName:       "",
+			client:            true,
+			expectedErrorText: "spoke client 'clusterName' cannot be empty",
+		},
+		{
+			clusterName:       defaultSpokeName,
+			client:            false,
+			expectedErrorText: "spoke client 'hubClient' cannot be nil",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Setenv("TMPDIR", t.TempDir())
+
+		var hubClient *clients.Settings
+
+		if testCase.client {
+			hubClient = clients.GetTestClients(clients.TestClientParams{
+				K8sMockObjects:  testCase.objects,
+				SchemeAttachers: []clients.SchemeAttacher{hivev1.AddToScheme},
+			})
+		}
+
+		spokeClient, err := GetSpokeClient(hubClient, testCase.clusterName)
+		if testCase.expectedErrorText != "" {
+			assert.EqualError(t, err, testCase.expectedErrorText)
+
+			continue
+		}
+
+		assert.Nil(t, err)
+		assert.NotNil(t, spokeClient)
+		assert.Equal(t, "https://api.spoke-test.example.com:6443", spokeClient.Config.Host)
+		assert.FileExists(t, spokeClient.KubeconfigPath)
+	}
+}
+
+// buildDummyAdminKubeconfigSecret returns an admin kubeconfig secret in the namespace of the spoke with the provided
+// name and kubeconfig. If the kubeconfig is empty, the secret has no data.
+func buildDummyAdminKubeconfigSecret(name, kubeconfig string) *corev1.Secret {
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: defaultSpokeName,
+		},
+	}
+
+	if kubeconfig != "" {
+		secret.Data = map[string][]byte{adminKubeconfigSecretKey: []byte(kubeconfig)}
+	}
+
+	return secret
+}
+
+// buildDummyClusterDeployment returns a ClusterDeployment for the spoke referencing the provided admin kubeconfig
+// secret. If the secret name is empty, the ClusterDeployment has no cluster metadata.
+func buildDummyClusterDeployment(secretName string) *hivev1.ClusterDeployment {
+	clusterDeployment := &hivev1.ClusterDeployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      defaultSpokeName,
+			Namespace: defaultSpokeName,
+		},
+	}
+
+	if secretName != "" {
+		clusterDeployment.Spec.ClusterMetadata = &hivev1.ClusterMetadata{
+			AdminKubeconfigSecretRef: corev1.LocalObjectReference{Name: secretName},
+		}
+	}
+
+	return clusterDeployment
+}
@@ -0,0 +1,247 @@
+package ocmirror
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/msg"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// ImageSetConfigurationAPIVersion is the API version of the ImageSetConfiguration read by oc-mirror v2.
+	ImageSetConfigurationAPIVersion = "mirror.openshift.io/v2alpha1"
+	// ImageSetConfigurationKind is the kind of the ImageSetConfiguration read by oc-mirror.
+	ImageSetConfigurationKind = "ImageSetConfiguration"
+)
+
+// ImageSetConfiguration is the configuration passed to oc-mirror describing the release, operator and additional
+// images to mirror. Only the commonly used fields are modeled.
+type ImageSetConfiguration struct {
+	APIVersion string       `json:"apiVersion"`
+	Kind       string       `json:"kind"`
+	Mirror     ImageSetSpec `json:"mirror"`
+}
+
+// ImageSetSpec lists the images mirrored by an ImageSetConfiguration.
+type ImageSetSpec struct {
+	Platform         *Platform         `json:"platform,omitempty"`
+	Operators        []Operator        `json:"operators,omitempty"`
+	AdditionalImages []AdditionalImage `json:"additionalImages,omitempty"`
+}
+
+// Platform selects the OpenShift release images to mirror.
+type Platform struct {
+	Channels []ReleaseChannel `json:"channels,omitempty"`
+	// Graph mirrors the update graph data so the cluster can be upgraded using the OpenShift Update Service.
+	Graph bool `json:"graph,omitempty"`
+}
+
+// ReleaseChannel selects the releases of a channel, such as stable-4.18, between two versions.
+type ReleaseChannel struct {
+	Name       string `json:"name"`
+	MinVersion string `json:"minVersion,omitempty"`
+	MaxVersion string `json:"maxVersion,omitempty"`
+}
+
+// Operator selects the operator packages of a catalog to mirror. If no packages are listed, the whole catalog is
+// mirrored.
+type Operator struct {
+	Catalog  string            `json:"catalog"`
+	Packages []OperatorPackage `json:"packages,omitempty"`
+}
+
+// OperatorPackage selects an operator package and optionally its channels.
+type OperatorPackage struct {
+	Name     string            `json:"name"`
+	Channels []OperatorChannel `json:"channels,omitempty"`
+}
+
+// OperatorChannel selects a channel of an operator package.
+type OperatorChannel struct {
+	Name string `json:"name"`
+}
+
+// AdditionalImage is an image mirrored in addition to the release and operator images.
+type AdditionalImage struct {
+	Name string `json:"name"`
+}
+
+// ImageSetConfigurationBuilder provides a struct to generate the ImageSetConfiguration passed to oc-mirror. It does
+// not connect to the cluster.
+type ImageSetConfigurationBuilder struct {
+	// Definition of the ImageSetConfiguration.
+	Definition *ImageSetConfiguration
+	// errorMsg is processed before the ImageSetConfiguration is generated.
+	errorMsg string
+}
+
+// NewImageSetConfigurationBuilder creates a new instance of ImageSetConfigurationBuilder with nothing to mirror.
+func NewImageSetConfigurationBuilder() *ImageSetConfigurationBuilder {
+	klog.V(100).Info("Initializing new ImageSetConfiguration structure")
+
+	return &ImageSetConfigurationBuilder{
+		Definition: &ImageSetConfiguration{
+			APIVersion: ImageSetConfigurationAPIVersion,
+			Kind:       ImageSetConfigurationKind,
+		},
+	}
+}
+
+// WithPlatformChannel mirrors the releases of the channel between minVersion and maxVersion. Empty versions leave the
+// range open.
+func (builder *ImageSetConfigurationBuilder) WithPlatformChannel(
+	name, minVersion, maxVersion string) *ImageSetConfigurationBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	klog.V(100).Infof("Adding platform channel %s from %s to %s to ImageSetConfiguration", name, minVersion, maxVersion)
+
+	if name == "" {
+		builder.errorMsg = "imageSetConfiguration platform channel 'name' cannot be empty"
+
+		return builder
+	}
+
+	if builder.Definition.Mirror.Platform == nil {
+		builder.Definition.Mirror.Platform = &Platform{}
+	}
+
+	builder.Definition.Mirror.Platform.Channels = append(builder.Definition.Mirror.Platform.Channels,
+		ReleaseChannel{Name: name, MinVersion: minVersion, MaxVersion: maxVersion})
+
+	return builder
+}
+
+// WithGraph sets whether the update graph data is mirrored along with the release images.
+func (builder *ImageSetConfigurationBuilder) WithGraph(graph bool) *ImageSetConfigurationBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	klog.V(100).Infof("Setting graph of ImageSetConfiguration to %t", graph)
+
+	if builder.Definition.Mirror.Platform == nil {
+		builder.Definition.Mirror.Platform = &Platform{}
+	}
+
+	builder.Definition.Mirror.Platform.Graph = graph
+
+	return builder
+}
+
+// WithOperatorPackages mirrors the operator packages, with all their channels, of the catalog, such as
+// registry.redhat.io/redhat/redhat-operator-index:v4.18. If no packages are given, the whole catalog is mirrored.
+func (builder *ImageSetConfigurationBuilder) WithOperatorPackages(
+	catalog string, packageNames ...string) *ImageSetConfigurationBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	klog.V(100).Infof("Adding packages %v of catalog %s to ImageSetConfiguration", packageNames, catalog)
+
+	if catalog == "" {
+		builder.errorMsg = "imageSetConfiguration operator 'catalog' cannot be empty"
+
+		return builder
+	}
+
+	operator := Operator{Catalog: catalog}
+
+	for _, packageName := range packageNames {
+		if packageName == "" {
+			builder.errorMsg = "imageSetConfiguration operator package 'name' cannot be empty"
+
+			return builder
+		}
+
+		operator.Packages = append(operator.Packages, OperatorPackage{Name: packageName})
+	}
+
+	builder.Definition.Mirror.Operators = append(builder.Definition.Mirror.Operators, operator)
+
+	return builder
+}
+
+// WithAdditionalImages mirrors the images in addition to the release and operator images.
+func (builder *ImageSetConfigurationBuilder) WithAdditionalImages(images ...string) *ImageSetConfigurationBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	klog.V(100).Infof("Adding additional images %v to ImageSetConfiguration", images)
+
+	for _, image := range images {
+		if image == "" {
+			builder.errorMsg = "imageSetConfiguration additional image cannot be empty"
+
+			return builder
+		}
+
+		builder.Definition.Mirror.AdditionalImages = append(builder.Definition.Mirror.AdditionalImages,
+			AdditionalImage{Name: image})
+	}
+
+	return builder
+}
+
+// ToYAML returns the ImageSetConfiguration as the YAML document read by oc-mirror.
+func (builder *ImageSetConfigurationBuilder) ToYAML() ([]byte, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	klog.V(100).Info("Generating ImageSetConfiguration YAML")
+
+	mirror := builder.Definition.Mirror
+	if mirror.Platform == nil && len(mirror.Operators) == 0 && len(mirror.AdditionalImages) == 0 {
+		return nil, fmt.Errorf("imageSetConfiguration does not mirror any images")
+	}
+
+	return yaml.Marshal(builder.Definition)
+}
+
+// WriteToFile writes the ImageSetConfiguration YAML to the file at path, to be passed to oc-mirror using --config.
+func (builder *ImageSetConfigurationBuilder) WriteToFile(path string) error {
+	content, err := builder.ToYAML()
+	if err != nil {
+		return err
+	}
+
+	klog.V(100).Infof("Writing ImageSetConfiguration to %s", path)
+
+	err = os.WriteFile(path, content, 0o600)
+	if err != nil {
+		return fmt.Errorf("failed to write ImageSetConfiguration to %s: %w", path, err)
+	}
+
+	return nil
+}
+
+// validate will check that the builder and builder definition are properly initialized before
+// accessing any member fields.
+func (builder *ImageSetConfigurationBuilder) validate() (bool, error) {
+	resourceCRD := "ImageSetConfiguration"
+
+	if builder == nil {
+		klog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+
+		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
+	}
+
+	if builder.Definition == nil {
+		klog.V(100).Infof("The %s is undefined", resourceCRD)
+
+		return false, fmt.Errorf("%s", msg.UndefinedCrdObjectErrString(resourceCRD))
+	}
+
+	if builder.errorMsg != "" {
+		klog.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+
+		return false, fmt.Errorf("%s", builder.errorMsg)
+	}
+
+	return true, nil
+}
@@ -0,0 +1,95 @@
+package ocmirror
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const expectedImageSetConfiguration = `apiVersion: mirror.openshift.io/v2alpha1
+kind: ImageSetConfiguration
+mirror:
+  additionalImages:
+  - name: registry.redhat.io/ubi9/ubi:latest
+  operators:
+  - catalog: registry.redhat.io/redhat/redhat-operator-index:v4.18
+    packages:
+    - name: sriov-network-operator
+    - name: ptp-operator
+  platform:
+    channels:
+    - maxVersion: 4.18.2
+      minVersion: 4.18.0
+      name: stable-4.18
+    graph: true
+`
+
+func TestImageSetConfigurationToYAML(t *testing.T) {
+	testCases := []struct {
+		builder       *ImageSetConfigurationBuilder
+		expectedYAML  string
+		expectedError string
+	}{
+		{
+			builder: NewImageSetConfigurationBuilder().
+				WithPlatformChannel("stable-4.18", "4.18.0", "4.18.2").
+				WithGraph(true).
+				WithOperatorPackages("registry.redhat.io/redhat/redhat-operator-index:v4.18",
+					"sriov-network-operator", "ptp-operator").
+				WithAdditionalImages("registry.redhat.io/ubi9/ubi:latest"),
+			expectedYAML: expectedImageSetConfiguration,
+		},
+		{
+			builder:       NewImageSetConfigurationBuilder(),
+			expectedError: "imageSetConfiguration does not mirror any images",
+		},
+		{
+			builder:       NewImageSetConfigurationBuilder().WithPlatformChannel("", "", ""),
+			expectedError: "imageSetConfiguration platform channel 'name' cannot be empty",
+		},
+		{
+			builder:       NewImageSetConfigurationBuilder().WithOperatorPackages(""),
+			expectedError: "imageSetConfiguration operator 'catalog' cannot be empty",
+		},
+		{
+			builder:       NewImageSetConfigurationBuilder().WithOperatorPackages("catalog", ""),
+			expectedError: "imageSetConfiguration operator package 'name' cannot be empty",
+		},
+		{
+			builder:       NewImageSetConfigurationBuilder().WithAdditionalImages(""),
+			expectedError: "imageSetConfiguration additional image cannot be empty",
+		},
+		{
+			builder:       nil,
+			expectedError: "error: received nil ImageSetConfiguration builder",
+		},
+	}
+
+	for _, testCase := range testCases {
+		content, err := testCase.builder.ToYAML()
+		if testCase.expectedError != "" {
+			assert.EqualError(t, err, testCase.expectedError)
+
+			continue
+		}
+
+		assert.NoError(t, err)
+		assert.Equal(t, testCase.expectedYAML, string(content))
+	}
+}
+
+func TestImageSetConfigurationWriteToFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "imageset-config.yaml")
+
+	err := NewImageSetConfigurationBuilder().WithAdditionalImages("quay.io/example/image:v1").WriteToFile(path)
+	assert.NoError(t, err)
+
+	content, err := os.ReadFile(path)
+	assert.NoError(t, err)
+	assert.Contains(t, string(content), "- name: quay.io/example/image:v1")
+
+	err = NewImageSetConfigurationBuilder().WriteToFile(path)
+	assert.EqualError(t, err, "imageSetConfiguration does not mirror any images")
+}
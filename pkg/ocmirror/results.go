@@ -0,0 +1,173 @@
+package ocmirror
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+
+	configv1 "github.com/openshift/api/config/v1"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/idms"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/itms"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+	"k8s.io/klog/v2"
+)
+
+// ClusterResourcesDir is the directory, relative to the workspace of oc-mirror v2, holding the cluster resources
+// generated when mirroring to a registry.
+const ClusterResourcesDir = "working-dir/cluster-resources"
+
+// ClusterResources holds builders for the mirror sets generated by oc-mirror, ready to be created on the cluster so
+// it pulls the mirrored images.
+type ClusterResources struct {
+	ImageDigestMirrorSets []*idms.Builder
+	ImageTagMirrorSets    []*itms.Builder
+}
+
+// ParseClusterResources reads the YAML files in dir, usually the ClusterResourcesDir of the oc-mirror workspace, and
+// returns builders for the ImageDigestMirrorSets and ImageTagMirrorSets they define. Other resources, such as
+// CatalogSources and signature ConfigMaps, are skipped. Files are read in lexical order.
+func ParseClusterResources(apiClient *clients.Settings, dir string) (*ClusterResources, error) {
+	if apiClient == nil {
+		klog.V(100).Info("The apiClient is nil")
+
+		return nil, fmt.Errorf("oc-mirror cluster resources 'apiClient' cannot be nil")
+	}
+
+	if dir == "" {
+		klog.V(100).Info("The dir is empty")
+
+		return nil, fmt.Errorf("oc-mirror cluster resources 'dir' cannot be empty")
+	}
+
+	klog.V(100).Infof("Parsing oc-mirror cluster resources in %s", dir)
+
+	var files []string
+
+	for _, pattern := range []string{"*.yaml", "*.yml"} {
+		matches, err := filepath.Glob(filepath.Join(dir, pattern))
+		if err != nil {
+			return nil, fmt.Errorf("failed to list oc-mirror cluster resources in %s: %w", dir, err)
+		}
+
+		files = append(files, matches...)
+	}
+
+	slices.Sort(files)
+
+	resources := &ClusterResources{}
+
+	for _, file := range files {
+		objects, err := decodeFile(file)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, object := range objects {
+			err = resources.add(apiClient, object)
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse %s %s in %s: %w", object.GetKind(), object.GetName(), file, err)
+			}
+		}
+	}
+
+	return resources, nil
+}
+
+// add appends a builder for the object if it is a mirror set, and ignores it otherwise.
+func (resources *ClusterResources) add(apiClient *clients.Settings, object *unstructured.Unstructured) error {
+	kind := object.GetKind()
+	if object.GroupVersionKind().Group != configv1.GroupName ||
+		(kind != "ImageDigestMirrorSet" && kind != "ImageTagMirrorSet") {
+		return nil
+	}
+
+	if object.GetName() == "" {
+		return fmt.Errorf("mirror set 'name' cannot be empty")
+	}
+
+	switch kind {
+	case "ImageDigestMirrorSet":
+		digestMirrorSet := &configv1.ImageDigestMirrorSet{}
+
+		err := runtime.DefaultUnstructuredConverter.FromUnstructured(object.Object, digestMirrorSet)
+		if err != nil {
+			return err
+		}
+
+		mirrors := digestMirrorSet.Spec.ImageDigestMirrors
+		if len(mirrors) == 0 {
+			return fmt.Errorf("imagedigestmirrorset does not have any mirrors")
+		}
+
+		builder := idms.NewBuilder(apiClient, digestMirrorSet.Name, mirrors[0])
+		for _, mirror := range mirrors[1:] {
+			builder = builder.WithMirror(mirror)
+		}
+
+		resources.ImageDigestMirrorSets = append(resources.ImageDigestMirrorSets, builder)
+	case "ImageTagMirrorSet":
+		tagMirrorSet := &configv1.ImageTagMirrorSet{}
+
+		err := runtime.DefaultUnstructuredConverter.FromUnstructured(object.Object, tagMirrorSet)
+		if err != nil {
+			return err
+		}
+
+		mirrors := tagMirrorSet.Spec.ImageTagMirrors
+		if len(mirrors) == 0 {
+			return fmt.Errorf("imagetagmirrorset does not have any mirrors")
+		}
+
+		builder := itms.NewBuilder(apiClient, tagMirrorSet.Name, mirrors[0])
+		for _, mirror := range mirrors[1:] {
+			builder = builder.WithMirror(mirror)
+		}
+
+		if err := builder.GetError(); err != nil {
+			return err
+		}
+
+		resources.ImageTagMirrorSets = append(resources.ImageTagMirrorSets, builder)
+	}
+
+	return nil
+}
+
+// decodeFile decodes every YAML document in the file, skipping empty documents.
+func decodeFile(file string) ([]*unstructured.Unstructured, error) {
+	reader, err := os.Open(file)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open oc-mirror cluster resource %s: %w", file, err)
+	}
+
+	defer reader.Close()
+
+	decoder := utilyaml.NewYAMLOrJSONDecoder(reader, 4096)
+
+	var objects []*unstructured.Unstructured
+
+	for {
+		object := &unstructured.Unstructured{}
+
+		err := decoder.Decode(&object.Object)
+		if errors.Is(err, io.EOF) {
+			return objects, nil
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode oc-mirror cluster resource %s: %w", file, err)
+		}
+
+		if len(object.Object) == 0 {
+			continue
+		}
+
+		objects = append(objects, object)
+	}
+}
@@ -0,0 +1,140 @@
+package ocmirror
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	configv1 "github.com/openshift/api/config/v1"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testIDMSResource = `apiVersion: config.openshift.io/v1
+kind: ImageDigestMirrorSet
+metadata:
+  name: idms-release-0
+spec:
+  imageDigestMirrors:
+  - mirrors:
+    - mirror.example.com/openshift/release
+    source: quay.io/openshift-release-dev/ocp-v4.0-art-dev
+  - mirrors:
+    - mirror.example.com/openshift/release-images
+    source: quay.io/openshift-release-dev/ocp-release
+`
+	testITMSResource = `---
+apiVersion: config.openshift.io/v1
+kind: ImageTagMirrorSet
+metadata:
+  name: itms-generic-0
+spec:
+  imageTagMirrors:
+  - mirrors:
+    - mirror.example.com/ubi9
+    source: registry.redhat.io/ubi9
+`
+	testCatalogSourceResource = `apiVersion: operators.coreos.com/v1alpha1
+kind: CatalogSource
+metadata:
+  name: cs-redhat-operator-index
+  namespace: openshift-marketplace
+spec:
+  image: mirror.example.com/redhat/redhat-operator-index:v4.18
+  sourceType: grpc
+`
+)
+
+func TestParseClusterResources(t *testing.T) {
+	testCases := []struct {
+		name          string
+		files         map[string]string
+		client        bool
+		expectedIDMS  int
+		expectedITMS  int
+		expectedError string
+	}{
+		{
+			name: "mirror sets and catalog source",
+			files: map[string]string{
+				"idms-oc-mirror.yaml":           testIDMSResource,
+				"itms-oc-mirror.yaml":           testITMSResource,
+				"cs-redhat-operator-index.yaml": testCatalogSourceResource,
+				"release-signatures.json":       "{}",
+				"signature-configmap.yaml":      "",
+			},
+			client:       true,
+			expectedIDMS: 1,
+			expectedITMS: 1,
+		},
+		{
+			name:   "empty directory",
+			files:  map[string]string{},
+			client: true,
+		},
+		{
+			name: "mirror set without mirrors",
+			files: map[string]string{
+				"itms-oc-mirror.yaml": "apiVersion: config.openshift.io/v1\nkind: ImageTagMirrorSet\nmetadata:\n  name: itms\n",
+			},
+			client:        true,
+			expectedError: "imagetagmirrorset does not have any mirrors",
+		},
+		{
+			name:          "invalid yaml",
+			files:         map[string]string{"idms-oc-mirror.yaml": "kind: [\n"},
+			client:        true,
+			expectedError: "failed to decode oc-mirror cluster resource",
+		},
+		{
+			name:          "nil client",
+			files:         map[string]string{},
+			expectedError: "oc-mirror cluster resources 'apiClient' cannot be nil",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			dir := t.TempDir()
+
+			for name, content := range testCase.files {
+				require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(content), 0o600))
+			}
+
+			var testSettings *clients.Settings
+
+			if testCase.client {
+				testSettings = clients.GetTestClients(clients.TestClientParams{})
+			}
+
+			resources, err := ParseClusterResources(testSettings, dir)
+			if testCase.expectedError != "" {
+				assert.ErrorContains(t, err, testCase.expectedError)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Len(t, resources.ImageDigestMirrorSets, testCase.expectedIDMS)
+			assert.Len(t, resources.ImageTagMirrorSets, testCase.expectedITMS)
+
+			if testCase.expectedIDMS > 0 {
+				idmsBuilder := resources.ImageDigestMirrorSets[0]
+				assert.Equal(t, "idms-release-0", idmsBuilder.Definition.Name)
+				assert.Len(t, idmsBuilder.Definition.Spec.ImageDigestMirrors, 2)
+			}
+
+			if testCase.expectedITMS > 0 {
+				itmsBuilder := resources.ImageTagMirrorSets[0]
+				assert.Equal(t, "itms-generic-0", itmsBuilder.Definition.Name)
+				assert.Equal(t, []configv1.ImageMirror{"mirror.example.com/ubi9"},
+					itmsBuilder.Definition.Spec.ImageTagMirrors[0].Mirrors)
+			}
+		})
+	}
+
+	_, err := ParseClusterResources(clients.GetTestClients(clients.TestClientParams{}), "")
+	assert.EqualError(t, err, "oc-mirror cluster resources 'dir' cannot be empty")
+}
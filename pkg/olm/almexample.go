@@ -0,0 +1,209 @@
+package olm
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/logging"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/msg"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/klog/v2"
+	runtimeClient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// ALMExampleBuilder provides a struct for a sample custom resource taken from the alm-examples of a
+// clusterserviceversion. Since the examples may be of any kind, the resource is kept as an unstructured object.
+type ALMExampleBuilder struct {
+	// ALMExampleBuilder definition. Used to create the sample resource, as decoded from the alm-examples.
+	Definition *unstructured.Unstructured
+	// Created sample resource on the cluster.
+	Object *unstructured.Unstructured
+	// api client to interact with the cluster.
+	apiClient runtimeClient.Client
+	// errorMsg is processed before the sample resource is created.
+	errorMsg string
+}
+
+// BuildersFromALMExamples decodes the alm-examples of the clusterserviceversion into a builder for each sample
+// resource, so the sample resources of any installed operator can be created and smoke-tested without
+// operator-specific builders. Namespaced samples that do not set a namespace are placed in the namespace of the
+// clusterserviceversion. The scope of the samples is resolved through the REST mapper of the client, so the CRDs of
+// the operator must be installed; builders of samples whose scope cannot be resolved hold the error.
+func BuildersFromALMExamples(csv *ClusterServiceVersionBuilder) ([]*ALMExampleBuilder, error) {
+	if valid, err := csv.validate(); !valid {
+		return nil, err
+	}
+
+	klog.V(100).Infof("Building sample resources from the alm-examples of clusterserviceversion %s in namespace %s",
+		csv.Definition.Name, csv.Definition.Namespace)
+
+	almExamples, err := csv.GetAlmExamples()
+	if err != nil {
+		return nil, err
+	}
+
+	var examples []map[string]any
+
+	err = json.Unmarshal([]byte(almExamples), &examples)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode alm-examples of clusterserviceversion %s: %w",
+			csv.Definition.Name, err)
+	}
+
+	builders := make([]*ALMExampleBuilder, 0, len(examples))
+
+	for index, example := range examples {
+		definition := &unstructured.Unstructured{Object: example}
+
+		if definition.GetAPIVersion() == "" || definition.GetKind() == "" || definition.GetName() == "" {
+			return nil, fmt.Errorf("alm-example %d of clusterserviceversion %s must set apiVersion, kind and name",
+				index, csv.Definition.Name)
+		}
+
+		builder := &ALMExampleBuilder{
+			apiClient:  csv.apiClient,
+			Definition: definition,
+		}
+
+		if definition.GetNamespace() == "" {
+			namespaced, err := csv.apiClient.IsObjectNamespaced(definition)
+			if err != nil {
+				klog.V(100).Infof("Failed to get the scope of alm-example %s %s: %v",
+					definition.GetKind(), definition.GetName(), err)
+
+				builder.errorMsg = fmt.Sprintf("failed to get the scope of alm-example %s %s: %v",
+					definition.GetKind(), definition.GetName(), err)
+			} else if namespaced {
+				definition.SetNamespace(csv.Definition.Namespace)
+			}
+		}
+
+		builders = append(builders, builder)
+	}
+
+	return builders, nil
+}
+
+// Get returns the sample resource if found.
+func (builder *ALMExampleBuilder) Get() (*unstructured.Unstructured, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	klog.V(100).Infof("Collecting %s %s in namespace %s",
+		builder.Definition.GetKind(), builder.Definition.GetName(), builder.Definition.GetNamespace())
+
+	object := &unstructured.Unstructured{}
+	object.SetGroupVersionKind(builder.Definition.GroupVersionKind())
+
+	err := builder.apiClient.Get(logging.DiscardContext(),
+		runtimeClient.ObjectKeyFromObject(builder.Definition), object)
+	if err != nil {
+		klog.V(100).Infof("%s %s does not exist in namespace %s",
+			builder.Definition.GetKind(), builder.Definition.GetName(), builder.Definition.GetNamespace())
+
+		return nil, err
+	}
+
+	return object, nil
+}
+
+// Exists checks whether the given sample resource exists.
+func (builder *ALMExampleBuilder) Exists() bool {
+	if valid, _ := builder.validate(); !valid {
+		return false
+	}
+
+	klog.V(100).Infof("Checking if %s %s exists in namespace %s",
+		builder.Definition.GetKind(), builder.Definition.GetName(), builder.Definition.GetNamespace())
+
+	var err error
+
+	builder.Object, err = builder.Get()
+
+	return err == nil || !k8serrors.IsNotFound(err)
+}
+
+// Create makes the sample resource in the cluster and stores the created object in struct.
+func (builder *ALMExampleBuilder) Create() (*ALMExampleBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	klog.V(100).Infof("Creating %s %s in namespace %s",
+		builder.Definition.GetKind(), builder.Definition.GetName(), builder.Definition.GetNamespace())
+
+	if builder.Exists() {
+		return builder, nil
+	}
+
+	err := builder.apiClient.Create(logging.DiscardContext(), builder.Definition)
+	if err != nil {
+		return builder, err
+	}
+
+	builder.Object = builder.Definition
+
+	return builder, nil
+}
+
+// Delete removes the sample resource. It is not an error if the resource does not exist.
+func (builder *ALMExampleBuilder) Delete() error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	klog.V(100).Infof("Deleting %s %s in namespace %s",
+		builder.Definition.GetKind(), builder.Definition.GetName(), builder.Definition.GetNamespace())
+
+	if !builder.Exists() {
+		klog.V(100).Infof("%s %s in namespace %s cannot be deleted because it does not exist",
+			builder.Definition.GetKind(), builder.Definition.GetName(), builder.Definition.GetNamespace())
+
+		builder.Object = nil
+
+		return nil
+	}
+
+	err := builder.apiClient.Delete(logging.DiscardContext(), builder.Definition)
+	if err != nil && !k8serrors.IsNotFound(err) {
+		return err
+	}
+
+	builder.Object = nil
+
+	return nil
+}
+
+// validate will check that the builder and builder definition are properly initialized before
+// accessing any member fields.
+func (builder *ALMExampleBuilder) validate() (bool, error) {
+	resourceCRD := "ALMExample"
+
+	if builder == nil {
+		klog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
+
+		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
+	}
+
+	if builder.Definition == nil {
+		klog.V(100).Infof("The %s is undefined", resourceCRD)
+
+		return false, fmt.Errorf("%s", msg.UndefinedCrdObjectErrString(resourceCRD))
+	}
+
+	if builder.apiClient == nil {
+		klog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
+
+		return false, fmt.Errorf("%s builder cannot have nil apiClient", resourceCRD)
+	}
+
+	if builder.errorMsg != "" {
+		klog.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+
+		return false, fmt.Errorf("%s", builder.errorMsg)
+	}
+
+	return true, nil
+}
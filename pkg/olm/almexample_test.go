@@ -0,0 +1,119 @@
+package olm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	runtimeClient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const testALMExamples = `[
+  {"apiVersion": "v1", "kind": "ConfigMap", "metadata": {"name": "sample"}, "data": {"key": "value"}},
+  {"apiVersion": "v1", "kind": "ConfigMap", "metadata": {"name": "sample", "namespace": "other-namespace"}},
+  {"apiVersion": "v1", "kind": "Namespace", "metadata": {"name": "sample"}},
+  {"apiVersion": "example.com/v1", "kind": "Unknown", "metadata": {"name": "sample"}}
+]`
+
+func TestBuildersFromALMExamples(t *testing.T) {
+	testCases := []struct {
+		almExamples   map[string]string
+		expectedError error
+	}{
+		{
+			almExamples:   map[string]string{annotationAlmExamples: testALMExamples},
+			expectedError: nil,
+		},
+		{
+			almExamples:   nil,
+			expectedError: fmt.Errorf("alm-examples not found in given clusterserviceversion named clusterservice"),
+		},
+		{
+			almExamples: map[string]string{annotationAlmExamples: "{"},
+			expectedError: fmt.Errorf("failed to decode alm-examples of clusterserviceversion clusterservice: " +
+				"unexpected end of JSON input"),
+		},
+		{
+			almExamples: map[string]string{annotationAlmExamples: `[{"apiVersion": "v1", "kind": "ConfigMap"}]`},
+			expectedError: fmt.Errorf(
+				"alm-example 0 of clusterserviceversion clusterservice must set apiVersion, kind and name"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		testSettings := buildTestClientWithALMExamplesMapper(testCase.almExamples)
+
+		builders, err := BuildersFromALMExamples(buildValidClusterServiceBuilder(testSettings))
+		if testCase.expectedError != nil {
+			assert.EqualError(t, err, testCase.expectedError.Error())
+
+			continue
+		}
+
+		assert.Nil(t, err)
+
+		assert.Len(t, builders, 4)
+		assert.Equal(t, "test-namespace", builders[0].Definition.GetNamespace())
+		assert.Equal(t, "other-namespace", builders[1].Definition.GetNamespace())
+		assert.Empty(t, builders[2].Definition.GetNamespace())
+		assert.Contains(t, builders[3].errorMsg, "failed to get the scope of alm-example Unknown sample")
+	}
+
+	_, err := BuildersFromALMExamples(buildInvalidClusterServiceBuilder())
+	assert.Equal(t, fmt.Errorf("ClusterServiceVersion builder cannot have nil apiClient"), err)
+}
+
+func TestALMExampleBuilderCreateAndDelete(t *testing.T) {
+	testSettings := buildTestClientWithALMExamplesMapper(map[string]string{annotationAlmExamples: testALMExamples})
+
+	builders, err := BuildersFromALMExamples(buildValidClusterServiceBuilder(testSettings))
+	assert.Nil(t, err)
+
+	sampleBuilder := builders[0]
+	assert.False(t, sampleBuilder.Exists())
+
+	sampleBuilder, err = sampleBuilder.Create()
+	assert.Nil(t, err)
+	assert.True(t, sampleBuilder.Exists())
+
+	configMap := &corev1.ConfigMap{}
+	err = testSettings.Get(t.Context(), runtimeClient.ObjectKey{Name: "sample", Namespace: "test-namespace"}, configMap)
+	assert.Nil(t, err)
+	assert.Equal(t, "value", configMap.Data["key"])
+
+	err = sampleBuilder.Delete()
+	assert.Nil(t, err)
+	assert.False(t, sampleBuilder.Exists())
+	assert.Nil(t, sampleBuilder.Object)
+
+	// Deleting a sample that does not exist is not an error.
+	assert.Nil(t, sampleBuilder.Delete())
+
+	_, err = builders[3].Create()
+	assert.ErrorContains(t, err, "failed to get the scope of alm-example Unknown sample")
+
+	var nilBuilder *ALMExampleBuilder
+
+	_, err = nilBuilder.Create()
+	assert.Equal(t, fmt.Errorf("error: received nil ALMExample builder"), err)
+}
+
+// buildTestClientWithALMExamplesMapper returns a client with a clusterserviceversion with the given annotations whose
+// REST mapper knows ConfigMaps are namespaced and Namespaces are cluster-scoped.
+func buildTestClientWithALMExamplesMapper(almExamples map[string]string) *clients.Settings {
+	restMapper := meta.NewDefaultRESTMapper([]schema.GroupVersion{corev1.SchemeGroupVersion})
+	restMapper.Add(corev1.SchemeGroupVersion.WithKind("ConfigMap"), meta.RESTScopeNamespace)
+	restMapper.Add(corev1.SchemeGroupVersion.WithKind("Namespace"), meta.RESTScopeRoot)
+
+	testSettings, clientBuilder := clients.GetModifiableTestClients(clients.TestClientParams{
+		K8sMockObjects:  buildDummyClusterServiceWithAlm(almExamples),
+		SchemeAttachers: testSchemes,
+	})
+	testSettings.Client = clientBuilder.WithRESTMapper(restMapper).Build()
+
+	return testSettings
+}
@@ -1,7 +1,9 @@
 package olm
 
 import (
+	"context"
 	"fmt"
+	"time"
 
 	oplmV1alpha1 "github.com/rh-ecosystem-edge/eco-goinfra/pkg/schemes/olm/operators/v1alpha1"
 	runtimeClient "sigs.k8s.io/controller-runtime/pkg/client"
@@ -9,14 +11,19 @@ import (
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/logging"
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/msg"
+	corev1 "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/klog/v2"
 )
 
 const (
 	errEmptyCatalogSourceNsname = "catalogsource 'nsname' cannot be empty"
 	resourceCatalogSource       = "catalogsource"
+	// infraNodeRoleLabel is the role label, and usually also the taint key, of infra nodes.
+	infraNodeRoleLabel = "node-role.kubernetes.io/infra"
 )
 
 // CatalogSourceBuilder provides a struct for catalogsource object
@@ -35,6 +42,8 @@ type CatalogSourceBuilder struct {
 
 // NewCatalogSourceBuilder creates new instance of CatalogSourceBuilder.
 func NewCatalogSourceBuilder(apiClient *clients.Settings, name, nsname string) *CatalogSourceBuilder {
+	nsname = apiClient.ResolveNamespace(nsname)
+
 	klog.V(100).Infof("Initializing new %s catalogsource structure", name)
 
 	if apiClient == nil {
@@ -82,6 +91,8 @@ func NewCatalogSourceBuilder(apiClient *clients.Settings, name, nsname string) *
 // PullCatalogSource loads an existing catalogsource into Builder struct.
 func PullCatalogSource(apiClient *clients.Settings, name, nsname string) (*CatalogSourceBuilder,
 	error) {
+	nsname = apiClient.ResolveNamespace(nsname)
+
 	klog.V(100).Infof("Pulling existing catalogsource name %s in namespace %s", name, nsname)
 
 	if apiClient == nil {
@@ -254,6 +265,137 @@ func (builder *CatalogSourceBuilder) Delete() error {
 	return nil
 }
 
+// WithRegistryPollInterval sets how often the catalog operator polls the registry for a new version of the catalog
+// image, replacing the catalog pod when the image digest changes. This is needed when a test catalog is rebuilt under
+// the same tag while a suite runs.
+func (builder *CatalogSourceBuilder) WithRegistryPollInterval(interval time.Duration) *CatalogSourceBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	klog.V(100).Infof("Setting registry poll interval of catalogsource %s in namespace %s to %s",
+		builder.Definition.Name, builder.Definition.Namespace, interval)
+
+	if interval <= 0 {
+		klog.V(100).Info("The registry poll interval of the catalogsource is not positive")
+
+		builder.errorMsg = "catalogsource registry poll 'interval' must be positive"
+
+		return builder
+	}
+
+	builder.Definition.Spec.UpdateStrategy = &oplmV1alpha1.UpdateStrategy{
+		RegistryPoll: &oplmV1alpha1.RegistryPoll{
+			RawInterval: interval.String(),
+			Interval:    &metav1.Duration{Duration: interval},
+		},
+	}
+
+	return builder
+}
+
+// WithGrpcPodNodeSelector sets the node selector of the catalog pod serving a grpc catalogsource.
+func (builder *CatalogSourceBuilder) WithGrpcPodNodeSelector(nodeSelector map[string]string) *CatalogSourceBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	klog.V(100).Infof("Setting grpc pod node selector of catalogsource %s in namespace %s to %v",
+		builder.Definition.Name, builder.Definition.Namespace, nodeSelector)
+
+	if len(nodeSelector) == 0 {
+		klog.V(100).Info("The grpc pod node selector of the catalogsource is empty")
+
+		builder.errorMsg = "catalogsource grpc pod 'nodeSelector' cannot be empty"
+
+		return builder
+	}
+
+	builder.getGrpcPodConfig().NodeSelector = nodeSelector
+
+	return builder
+}
+
+// WithGrpcPodTolerations appends the tolerations to the catalog pod serving a grpc catalogsource.
+func (builder *CatalogSourceBuilder) WithGrpcPodTolerations(tolerations ...corev1.Toleration) *CatalogSourceBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	klog.V(100).Infof("Adding grpc pod tolerations %v to catalogsource %s in namespace %s",
+		tolerations, builder.Definition.Name, builder.Definition.Namespace)
+
+	if len(tolerations) == 0 {
+		klog.V(100).Info("The grpc pod tolerations of the catalogsource are empty")
+
+		builder.errorMsg = "catalogsource grpc pod 'tolerations' cannot be empty"
+
+		return builder
+	}
+
+	grpcPodConfig := builder.getGrpcPodConfig()
+	grpcPodConfig.Tolerations = append(grpcPodConfig.Tolerations, tolerations...)
+
+	return builder
+}
+
+// WithGrpcPodOnInfraNodes schedules the catalog pod serving a grpc catalogsource on infra nodes, selecting them by
+// their role label and tolerating the taints commonly used to reserve them.
+func (builder *CatalogSourceBuilder) WithGrpcPodOnInfraNodes() *CatalogSourceBuilder {
+	return builder.
+		WithGrpcPodNodeSelector(map[string]string{infraNodeRoleLabel: ""}).
+		WithGrpcPodTolerations(
+			corev1.Toleration{
+				Key: infraNodeRoleLabel, Operator: corev1.TolerationOpExists, Effect: corev1.TaintEffectNoSchedule},
+			corev1.Toleration{
+				Key: infraNodeRoleLabel, Operator: corev1.TolerationOpExists, Effect: corev1.TaintEffectNoExecute})
+}
+
+// WaitForRegistryPoll waits up to timeout for the catalog operator to poll the registry of the catalogsource after
+// the provided time, such as the time a test catalog image was pushed. It requires a registry poll interval, see
+// WithRegistryPollInterval.
+func (builder *CatalogSourceBuilder) WaitForRegistryPoll(after time.Time, timeout time.Duration) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	klog.V(100).Infof("Waiting up to %s for catalogsource %s in namespace %s to poll its registry after %s",
+		timeout, builder.Definition.Name, builder.Definition.Namespace, after)
+
+	return wait.PollUntilContextTimeout(
+		context.TODO(), time.Second, timeout, true, func(ctx context.Context) (bool, error) {
+			var err error
+
+			builder.Object, err = builder.Get()
+			if err != nil {
+				klog.V(100).Infof("Failed to get catalogsource %s in namespace %s: %v",
+					builder.Definition.Name, builder.Definition.Namespace, err)
+
+				return false, nil
+			}
+
+			latestPoll := builder.Object.Status.LatestImageRegistryPoll
+
+			return latestPoll != nil && latestPoll.After(after), nil
+		})
+}
+
+// getGrpcPodConfig returns the grpc pod config of the definition, initializing it if needed.
+func (builder *CatalogSourceBuilder) getGrpcPodConfig() *oplmV1alpha1.GrpcPodConfig {
+	if builder.Definition.Spec.GrpcPodConfig == nil {
+		builder.Definition.Spec.GrpcPodConfig = &oplmV1alpha1.GrpcPodConfig{}
+	}
+
+	return builder.Definition.Spec.GrpcPodConfig
+}
+
+// GetCatalogSourceGVR returns CatalogSource's GroupVersionResource which could be used for Clean function.
+func GetCatalogSourceGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group: "operators.coreos.com", Version: "v1alpha1", Resource: "catalogsources",
+	}
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *CatalogSourceBuilder) validate() (bool, error) {
@@ -1,9 +1,12 @@
 package olm
 
 import (
+	"context"
 	"fmt"
 	"testing"
+	"time"
 
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 
@@ -316,6 +319,88 @@ func TestCatalogSourceUpdate(t *testing.T) {
 	}
 }
 
+func TestCatalogSourceWithRegistryPollInterval(t *testing.T) {
+	testCases := []struct {
+		interval      time.Duration
+		expectedError string
+	}{
+		{
+			interval: 10 * time.Minute,
+		},
+		{
+			interval:      0,
+			expectedError: "catalogsource registry poll 'interval' must be positive",
+		},
+	}
+
+	for _, testCase := range testCases {
+		builder := buildValidCatalogSourceBuilder(buildTestClientWithDummyObject()).
+			WithRegistryPollInterval(testCase.interval)
+		assert.Equal(t, testCase.expectedError, builder.errorMsg)
+
+		if testCase.expectedError == "" {
+			assert.Equal(t, "10m0s", builder.Definition.Spec.UpdateStrategy.RawInterval)
+			assert.Equal(t, testCase.interval, builder.Definition.Spec.UpdateStrategy.Interval.Duration)
+		}
+	}
+}
+
+func TestCatalogSourceWithGrpcPodConfig(t *testing.T) {
+	builder := buildValidCatalogSourceBuilder(buildTestClientWithDummyObject()).WithGrpcPodOnInfraNodes()
+	assert.Empty(t, builder.errorMsg)
+	assert.Equal(t, &oplmV1alpha1.GrpcPodConfig{
+		NodeSelector: map[string]string{infraNodeRoleLabel: ""},
+		Tolerations: []corev1.Toleration{
+			{Key: infraNodeRoleLabel, Operator: corev1.TolerationOpExists, Effect: corev1.TaintEffectNoSchedule},
+			{Key: infraNodeRoleLabel, Operator: corev1.TolerationOpExists, Effect: corev1.TaintEffectNoExecute},
+		},
+	}, builder.Definition.Spec.GrpcPodConfig)
+
+	builder = buildValidCatalogSourceBuilder(buildTestClientWithDummyObject()).WithGrpcPodNodeSelector(nil)
+	assert.Equal(t, "catalogsource grpc pod 'nodeSelector' cannot be empty", builder.errorMsg)
+
+	builder = buildValidCatalogSourceBuilder(buildTestClientWithDummyObject()).WithGrpcPodTolerations()
+	assert.Equal(t, "catalogsource grpc pod 'tolerations' cannot be empty", builder.errorMsg)
+	assert.Nil(t, builder.Definition.Spec.GrpcPodConfig)
+}
+
+func TestCatalogSourceWaitForRegistryPoll(t *testing.T) {
+	pushed := time.Now().Add(-time.Minute)
+
+	testCases := []struct {
+		latestPoll    *metav1.Time
+		expectedError error
+	}{
+		{
+			latestPoll:    &metav1.Time{Time: pushed.Add(30 * time.Second)},
+			expectedError: nil,
+		},
+		{
+			latestPoll:    &metav1.Time{Time: pushed.Add(-30 * time.Second)},
+			expectedError: context.DeadlineExceeded,
+		},
+		{
+			latestPoll:    nil,
+			expectedError: context.DeadlineExceeded,
+		},
+	}
+
+	for _, testCase := range testCases {
+		catalogSource := &oplmV1alpha1.CatalogSource{
+			ObjectMeta: metav1.ObjectMeta{Name: resourceCatalogSource, Namespace: "test-namespace"},
+			Status:     oplmV1alpha1.CatalogSourceStatus{LatestImageRegistryPoll: testCase.latestPoll},
+		}
+
+		builder := buildValidCatalogSourceBuilder(clients.GetTestClients(clients.TestClientParams{
+			K8sMockObjects:  []runtime.Object{catalogSource},
+			SchemeAttachers: testSchemes,
+		}))
+
+		err := builder.WaitForRegistryPoll(pushed, time.Second)
+		assert.Equal(t, testCase.expectedError, err)
+	}
+}
+
 func buildValidCatalogSourceBuilder(apiClient *clients.Settings) *CatalogSourceBuilder {
 	return NewCatalogSourceBuilder(apiClient, resourceCatalogSource, "test-namespace")
 }
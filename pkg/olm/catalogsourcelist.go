@@ -16,6 +16,8 @@ func ListCatalogSources(
 	apiClient *clients.Settings,
 	nsname string,
 	options ...client.ListOptions) ([]*CatalogSourceBuilder, error) {
+	nsname = apiClient.ResolveNamespace(nsname)
+
 	if apiClient == nil {
 		klog.V(100).Info("The apiClient cannot be nil")
 
@@ -2,6 +2,8 @@ package olm
 
 import (
 	"fmt"
+	"slices"
+	"strings"
 
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/logging"
@@ -9,6 +11,7 @@ import (
 	oplmV1alpha1 "github.com/rh-ecosystem-edge/eco-goinfra/pkg/schemes/olm/operators/v1alpha1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/klog/v2"
 	runtimeClient "sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -34,6 +37,8 @@ type ClusterServiceVersionBuilder struct {
 // PullClusterServiceVersion loads an existing clusterserviceversion into Builder struct.
 func PullClusterServiceVersion(apiClient *clients.Settings, name, namespace string) (*ClusterServiceVersionBuilder,
 	error) {
+	namespace = apiClient.ResolveNamespace(namespace)
+
 	klog.V(100).Infof("Pulling existing clusterserviceversion name %s in namespace %s", name, namespace)
 
 	if apiClient == nil {
@@ -235,6 +240,142 @@ func (builder *ClusterServiceVersionBuilder) GetPhase() (oplmV1alpha1.ClusterSer
 	return builder.Object.Status.Phase, nil
 }
 
+// GetRelatedImages returns the images used by the operator bundle: the related images of the clusterserviceversion,
+// followed by any container images of its install strategy deployments not listed as related images. Each image is
+// only returned once.
+func (builder *ClusterServiceVersionBuilder) GetRelatedImages() ([]string, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	klog.V(100).Infof("Getting related images of clusterserviceversion %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if !builder.Exists() {
+		return nil, fmt.Errorf("%s clusterserviceversion not found in %s namespace",
+			builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	var images []string
+
+	seen := make(map[string]bool)
+	addImage := func(image string) {
+		if image != "" && !seen[image] {
+			seen[image] = true
+			images = append(images, image)
+		}
+	}
+
+	for _, relatedImage := range builder.Object.Spec.RelatedImages {
+		addImage(relatedImage.Image)
+	}
+
+	for _, deploymentSpec := range builder.Object.Spec.InstallStrategy.StrategySpec.DeploymentSpecs {
+		podSpec := deploymentSpec.Spec.Template.Spec
+
+		for _, container := range slices.Concat(podSpec.InitContainers, podSpec.Containers) {
+			addImage(container.Image)
+		}
+	}
+
+	return images, nil
+}
+
+// GetRequiredCRDs returns the names of the CRDs the operator requires but does not own, which must be provided by
+// other operators before it can be installed.
+func (builder *ClusterServiceVersionBuilder) GetRequiredCRDs() ([]string, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	klog.V(100).Infof("Getting required CRDs of clusterserviceversion %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if !builder.Exists() {
+		return nil, fmt.Errorf("%s clusterserviceversion not found in %s namespace",
+			builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	var crdNames []string
+
+	for _, crd := range builder.Object.Spec.CustomResourceDefinitions.Required {
+		crdNames = append(crdNames, crd.Name)
+	}
+
+	return crdNames, nil
+}
+
+// GetSupportedInstallModes returns the install modes supported by the operator, such as OwnNamespace or AllNamespaces,
+// which decide the operatorgroups it can be installed with.
+func (builder *ClusterServiceVersionBuilder) GetSupportedInstallModes() ([]oplmV1alpha1.InstallModeType, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	klog.V(100).Infof("Getting supported install modes of clusterserviceversion %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if !builder.Exists() {
+		return nil, fmt.Errorf("%s clusterserviceversion not found in %s namespace",
+			builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	var installModes []oplmV1alpha1.InstallModeType
+
+	for _, installMode := range builder.Object.Spec.InstallModes {
+		if installMode.Supported {
+			installModes = append(installModes, installMode.Type)
+		}
+	}
+
+	return installModes, nil
+}
+
+// AssertAllRelatedImagesMirrored checks that every image returned by GetRelatedImages is pulled from registry, such as
+// mirror.example.com:5000 or mirror.example.com:5000/olm, as expected of operator bundles prepared for disconnected
+// clusters. It returns an error listing the images pulled from elsewhere.
+func (builder *ClusterServiceVersionBuilder) AssertAllRelatedImagesMirrored(registry string) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	klog.V(100).Infof("Checking related images of clusterserviceversion %s in namespace %s are mirrored to %s",
+		builder.Definition.Name, builder.Definition.Namespace, registry)
+
+	registry = strings.TrimSuffix(registry, "/")
+	if registry == "" {
+		return fmt.Errorf("clusterserviceversion mirror 'registry' cannot be empty")
+	}
+
+	images, err := builder.GetRelatedImages()
+	if err != nil {
+		return err
+	}
+
+	var unmirrored []string
+
+	for _, image := range images {
+		if !strings.HasPrefix(image, registry+"/") {
+			unmirrored = append(unmirrored, image)
+		}
+	}
+
+	if len(unmirrored) > 0 {
+		return fmt.Errorf("clusterserviceversion %s has %d images not mirrored to %s: %s",
+			builder.Definition.Name, len(unmirrored), registry, strings.Join(unmirrored, ", "))
+	}
+
+	return nil
+}
+
+// GetClusterServiceVersionGVR returns ClusterServiceVersion's GroupVersionResource
+// which could be used for Clean function.
+func GetClusterServiceVersionGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group: "operators.coreos.com", Version: "v1alpha1", Resource: "clusterserviceversions",
+	}
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *ClusterServiceVersionBuilder) validate() (bool, error) {
@@ -7,6 +7,8 @@ import (
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
 	oplmV1alpha1 "github.com/rh-ecosystem-edge/eco-goinfra/pkg/schemes/olm/operators/v1alpha1"
 	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 )
@@ -313,6 +315,101 @@ func TestClusterServiceIsSuccessful(t *testing.T) {
 	}
 }
 
+func TestClusterServiceGetRelatedImages(t *testing.T) {
+	testCases := []struct {
+		clusterService *ClusterServiceVersionBuilder
+		expectedImages []string
+		expectedError  error
+	}{
+		{
+			clusterService: buildValidClusterServiceBuilder(buildTestClientWithBundleClusterServiceObject()),
+			expectedImages: []string{
+				"registry.example.com/operator:v1",
+				"registry.example.com/operand:v1",
+				"quay.io/example/init:v1",
+			},
+			expectedError: nil,
+		},
+		{
+			clusterService: buildValidClusterServiceBuilder(
+				clients.GetTestClients(clients.TestClientParams{SchemeAttachers: testSchemes})),
+			expectedError: fmt.Errorf("clusterservice clusterserviceversion not found in test-namespace namespace"),
+		},
+		{
+			clusterService: buildInvalidClusterServiceBuilder(),
+			expectedError:  fmt.Errorf("ClusterServiceVersion builder cannot have nil apiClient"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		images, err := testCase.clusterService.GetRelatedImages()
+		assert.Equal(t, testCase.expectedError, err)
+		assert.Equal(t, testCase.expectedImages, images)
+	}
+}
+
+func TestClusterServiceGetRequiredCRDs(t *testing.T) {
+	crdNames, err := buildValidClusterServiceBuilder(buildTestClientWithBundleClusterServiceObject()).GetRequiredCRDs()
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"required.example.com"}, crdNames)
+
+	_, err = buildInvalidClusterServiceBuilder().GetRequiredCRDs()
+	assert.Equal(t, fmt.Errorf("ClusterServiceVersion builder cannot have nil apiClient"), err)
+}
+
+func TestClusterServiceGetSupportedInstallModes(t *testing.T) {
+	installModes, err := buildValidClusterServiceBuilder(
+		buildTestClientWithBundleClusterServiceObject()).GetSupportedInstallModes()
+	assert.Nil(t, err)
+	assert.Equal(t, []oplmV1alpha1.InstallModeType{
+		oplmV1alpha1.InstallModeTypeOwnNamespace, oplmV1alpha1.InstallModeTypeAllNamespaces}, installModes)
+
+	_, err = buildInvalidClusterServiceBuilder().GetSupportedInstallModes()
+	assert.Equal(t, fmt.Errorf("ClusterServiceVersion builder cannot have nil apiClient"), err)
+}
+
+func TestClusterServiceAssertAllRelatedImagesMirrored(t *testing.T) {
+	testCases := []struct {
+		registry      string
+		expectedError error
+	}{
+		{
+			registry: "registry.example.com",
+			expectedError: fmt.Errorf("clusterserviceversion clusterservice has 1 images not mirrored to " +
+				"registry.example.com: quay.io/example/init:v1"),
+		},
+		{
+			registry: "registry.example",
+			expectedError: fmt.Errorf("clusterserviceversion clusterservice has 3 images not mirrored to " +
+				"registry.example: registry.example.com/operator:v1, registry.example.com/operand:v1, " +
+				"quay.io/example/init:v1"),
+		},
+		{
+			registry:      "",
+			expectedError: fmt.Errorf("clusterserviceversion mirror 'registry' cannot be empty"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		err := buildValidClusterServiceBuilder(
+			buildTestClientWithBundleClusterServiceObject()).AssertAllRelatedImagesMirrored(testCase.registry)
+		assert.Equal(t, testCase.expectedError, err)
+	}
+
+	mirroredSettings := clients.GetTestClients(clients.TestClientParams{
+		K8sMockObjects: []runtime.Object{&oplmV1alpha1.ClusterServiceVersion{
+			ObjectMeta: metav1.ObjectMeta{Name: "clusterservice", Namespace: "test-namespace"},
+			Spec: oplmV1alpha1.ClusterServiceVersionSpec{
+				RelatedImages: []oplmV1alpha1.RelatedImage{{Name: "operator", Image: "mirror.example.com/olm/operator:v1"}},
+			},
+		}},
+		SchemeAttachers: testSchemes,
+	})
+
+	err := buildValidClusterServiceBuilder(mirroredSettings).AssertAllRelatedImagesMirrored("mirror.example.com/olm/")
+	assert.Nil(t, err)
+}
+
 func buildValidClusterServiceBuilder(apiClient *clients.Settings) *ClusterServiceVersionBuilder {
 	return newClusterServiceBuilder(apiClient, "clusterservice", "test-namespace")
 }
@@ -402,3 +499,32 @@ func invalidClusterServiceBuilder(name, namespace string) *ClusterServiceVersion
 				DisplayName: "test",
 			}}}
 }
+
+func buildTestClientWithBundleClusterServiceObject() *clients.Settings {
+	csv := buildDummyClusterService(nil, "")[0].(*oplmV1alpha1.ClusterServiceVersion)
+	csv.Spec.RelatedImages = []oplmV1alpha1.RelatedImage{
+		{Name: "operator", Image: "registry.example.com/operator:v1"},
+		{Name: "operand", Image: "registry.example.com/operand:v1"},
+	}
+	csv.Spec.InstallStrategy.StrategySpec.DeploymentSpecs = []oplmV1alpha1.StrategyDeploymentSpec{{
+		Name: "operator",
+		Spec: appsv1.DeploymentSpec{Template: corev1.PodTemplateSpec{Spec: corev1.PodSpec{
+			InitContainers: []corev1.Container{{Name: "init", Image: "quay.io/example/init:v1"}},
+			Containers:     []corev1.Container{{Name: "operator", Image: "registry.example.com/operator:v1"}},
+		}}},
+	}}
+	csv.Spec.CustomResourceDefinitions = oplmV1alpha1.CustomResourceDefinitions{
+		Owned:    []oplmV1alpha1.CRDDescription{{Name: "owned.example.com"}},
+		Required: []oplmV1alpha1.CRDDescription{{Name: "required.example.com"}},
+	}
+	csv.Spec.InstallModes = []oplmV1alpha1.InstallMode{
+		{Type: oplmV1alpha1.InstallModeTypeOwnNamespace, Supported: true},
+		{Type: oplmV1alpha1.InstallModeTypeSingleNamespace, Supported: false},
+		{Type: oplmV1alpha1.InstallModeTypeAllNamespaces, Supported: true},
+	}
+
+	return clients.GetTestClients(clients.TestClientParams{
+		K8sMockObjects:  []runtime.Object{csv},
+		SchemeAttachers: testSchemes,
+	})
+}
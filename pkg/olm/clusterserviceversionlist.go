@@ -17,6 +17,8 @@ func ListClusterServiceVersion(
 	apiClient *clients.Settings,
 	nsname string,
 	options ...client.ListOptions) ([]*ClusterServiceVersionBuilder, error) {
+	nsname = apiClient.ResolveNamespace(nsname)
+
 	if apiClient == nil {
 		klog.V(100).Info("The apiClient cannot be nil")
 
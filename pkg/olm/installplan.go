@@ -10,6 +10,7 @@ import (
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/logging"
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/msg"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/klog/v2"
@@ -35,6 +36,8 @@ type InstallPlanBuilder struct {
 
 // NewInstallPlanBuilder creates new instance of InstallPlanBuilder.
 func NewInstallPlanBuilder(apiClient *clients.Settings, name, nsname string) *InstallPlanBuilder {
+	nsname = apiClient.ResolveNamespace(nsname)
+
 	klog.V(100).Infof("Initializing new %s installplan structure", name)
 
 	if apiClient == nil {
@@ -81,6 +84,8 @@ func NewInstallPlanBuilder(apiClient *clients.Settings, name, nsname string) *In
 
 // PullInstallPlan loads existing InstallPlan from cluster into the InstallPlanBuilder struct.
 func PullInstallPlan(apiClient *clients.Settings, name, nsName string) (*InstallPlanBuilder, error) {
+	nsName = apiClient.ResolveNamespace(nsName)
+
 	klog.V(100).Infof("Pulling existing InstallPlan %s from cluster in namespace %s", name, nsName)
 
 	if apiClient == nil {
@@ -243,6 +248,13 @@ func (builder *InstallPlanBuilder) Update() (*InstallPlanBuilder, error) {
 	return builder, err
 }
 
+// GetInstallPlanGVR returns InstallPlan's GroupVersionResource which could be used for Clean function.
+func GetInstallPlanGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group: "operators.coreos.com", Version: "v1alpha1", Resource: "installplans",
+	}
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *InstallPlanBuilder) validate() (bool, error) {
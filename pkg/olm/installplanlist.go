@@ -14,6 +14,8 @@ import (
 // ListInstallPlan returns a list of installplans found for specific namespace.
 func ListInstallPlan(
 	apiClient *clients.Settings, nsname string, options ...client.ListOptions) ([]*InstallPlanBuilder, error) {
+	nsname = apiClient.ResolveNamespace(nsname)
+
 	if nsname == "" {
 		klog.V(100).Info("The nsname of the installplan is empty")
 
@@ -0,0 +1,237 @@
+package olm
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common"
+	operatorsv2 "github.com/rh-ecosystem-edge/eco-goinfra/pkg/schemes/olm/operators/v2"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/klog/v2"
+	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// OperatorConditionBuilder provides a struct for the OperatorCondition resource containing a connection to the
+// cluster and the OperatorCondition definition.
+type OperatorConditionBuilder struct {
+	common.EmbeddableBuilder[operatorsv2.OperatorCondition, *operatorsv2.OperatorCondition]
+	common.EmbeddableCreator[operatorsv2.OperatorCondition, OperatorConditionBuilder,
+		*operatorsv2.OperatorCondition, *OperatorConditionBuilder]
+	common.EmbeddableDeleter[operatorsv2.OperatorCondition, *operatorsv2.OperatorCondition]
+	common.EmbeddableUpdater[operatorsv2.OperatorCondition, OperatorConditionBuilder,
+		*operatorsv2.OperatorCondition, *OperatorConditionBuilder]
+	common.EmbeddableConditionGetter[metav1.Condition, operatorsv2.OperatorCondition, *operatorsv2.OperatorCondition]
+}
+
+// AttachMixins wires the embedded CRUD mixins to this builder instance.
+func (builder *OperatorConditionBuilder) AttachMixins() {
+	builder.EmbeddableCreator.SetBase(builder)
+	builder.EmbeddableDeleter.SetBase(builder)
+	builder.EmbeddableUpdater.SetBase(builder)
+	builder.EmbeddableConditionGetter.SetBase(builder)
+}
+
+// GetGVK returns the OperatorCondition GVK for this builder.
+func (builder *OperatorConditionBuilder) GetGVK() schema.GroupVersionKind {
+	return operatorsv2.GroupVersion.WithKind("OperatorCondition")
+}
+
+// NewOperatorConditionBuilder creates a new instance of OperatorConditionBuilder. OLM creates an OperatorCondition
+// named after each ClusterServiceVersion in its namespace, so it is usually pulled rather than created.
+func NewOperatorConditionBuilder(apiClient *clients.Settings, name, nsname string) *OperatorConditionBuilder {
+	klog.V(100).Infof(
+		"Initializing new OperatorCondition structure with the following params: name: %s, nsname: %s", name, nsname)
+
+	return common.NewNamespacedBuilder[operatorsv2.OperatorCondition, OperatorConditionBuilder](
+		apiClient, operatorsv2.AddToScheme, name, nsname)
+}
+
+// PullOperatorCondition pulls an existing OperatorCondition from the cluster.
+func PullOperatorCondition(apiClient *clients.Settings, name, nsname string) (*OperatorConditionBuilder, error) {
+	klog.V(100).Infof("Pulling existing OperatorCondition %s in namespace %s from cluster", name, nsname)
+
+	return common.PullNamespacedBuilder[operatorsv2.OperatorCondition, OperatorConditionBuilder](
+		context.TODO(), apiClient, operatorsv2.AddToScheme, name, nsname)
+}
+
+// ListOperatorConditions returns a list of OperatorCondition builders matching the provided options.
+func ListOperatorConditions(
+	apiClient *clients.Settings, options ...runtimeclient.ListOption) ([]*OperatorConditionBuilder, error) {
+	return common.List[operatorsv2.OperatorCondition, operatorsv2.OperatorConditionList, OperatorConditionBuilder](
+		context.TODO(), apiClient, operatorsv2.AddToScheme, options...)
+}
+
+// WithUpgradeableCondition sets the Upgradeable condition in the spec, the same way the operator reports whether it
+// can be upgraded. This simulates an operator blocking or allowing its own upgrade.
+func (builder *OperatorConditionBuilder) WithUpgradeableCondition(
+	status metav1.ConditionStatus, reason, message string) *OperatorConditionBuilder {
+	if err := common.Validate(builder); err != nil {
+		return builder
+	}
+
+	klog.V(100).Infof("Setting Upgradeable condition of OperatorCondition %s in namespace %s to %s",
+		builder.Definition.Name, builder.Definition.Namespace, status)
+
+	if err := validateUpgradeableCondition(status, reason); err != nil {
+		builder.SetError(err)
+
+		return builder
+	}
+
+	meta.SetStatusCondition(&builder.Definition.Spec.Conditions,
+		newUpgradeableCondition(status, reason, message, builder.Definition.Generation))
+
+	return builder
+}
+
+// WithoutUpgradeableCondition removes the Upgradeable condition from the spec.
+func (builder *OperatorConditionBuilder) WithoutUpgradeableCondition() *OperatorConditionBuilder {
+	if err := common.Validate(builder); err != nil {
+		return builder
+	}
+
+	klog.V(100).Infof("Removing Upgradeable condition of OperatorCondition %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	meta.RemoveStatusCondition(&builder.Definition.Spec.Conditions, operatorsv2.Upgradeable)
+
+	return builder
+}
+
+// WithUpgradeableOverride sets the Upgradeable override in the spec, the same way a cluster admin forces whether the
+// operator can be upgraded. OLM uses the override instead of the condition reported by the operator.
+func (builder *OperatorConditionBuilder) WithUpgradeableOverride(
+	status metav1.ConditionStatus, reason, message string) *OperatorConditionBuilder {
+	if err := common.Validate(builder); err != nil {
+		return builder
+	}
+
+	klog.V(100).Infof("Setting Upgradeable override of OperatorCondition %s in namespace %s to %s",
+		builder.Definition.Name, builder.Definition.Namespace, status)
+
+	if err := validateUpgradeableCondition(status, reason); err != nil {
+		builder.SetError(err)
+
+		return builder
+	}
+
+	meta.SetStatusCondition(&builder.Definition.Spec.Overrides,
+		newUpgradeableCondition(status, reason, message, builder.Definition.Generation))
+
+	return builder
+}
+
+// WithoutUpgradeableOverride removes the Upgradeable override from the spec, so that OLM goes back to using the
+// condition reported by the operator.
+func (builder *OperatorConditionBuilder) WithoutUpgradeableOverride() *OperatorConditionBuilder {
+	if err := common.Validate(builder); err != nil {
+		return builder
+	}
+
+	klog.V(100).Infof("Removing Upgradeable override of OperatorCondition %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	meta.RemoveStatusCondition(&builder.Definition.Spec.Overrides, operatorsv2.Upgradeable)
+
+	return builder
+}
+
+// GetUpgradeableCondition returns the Upgradeable condition OLM uses to gate upgrades of the operator, which is the
+// override if one is set and otherwise the condition in the status. It returns nil if neither is set.
+func (builder *OperatorConditionBuilder) GetUpgradeableCondition() (*metav1.Condition, error) {
+	if err := common.Validate(builder); err != nil {
+		return nil, err
+	}
+
+	klog.V(100).Infof("Getting Upgradeable condition of OperatorCondition %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	object, err := builder.Get()
+	if err != nil {
+		return nil, err
+	}
+
+	builder.Object = object
+
+	if override := meta.FindStatusCondition(object.Spec.Overrides, operatorsv2.Upgradeable); override != nil {
+		return override, nil
+	}
+
+	return meta.FindStatusCondition(object.Status.Conditions, operatorsv2.Upgradeable), nil
+}
+
+// IsUpgradeable returns whether OLM allows the operator to be upgraded. Only an Upgradeable condition with status
+// False blocks upgrades, so the operator is upgradeable if the condition is not set.
+func (builder *OperatorConditionBuilder) IsUpgradeable() (bool, error) {
+	condition, err := builder.GetUpgradeableCondition()
+	if err != nil {
+		return false, err
+	}
+
+	return condition == nil || condition.Status != metav1.ConditionFalse, nil
+}
+
+// WaitUntilUpgradeable waits up to timeout for the operator to be upgradeable, or blocked from upgrading if
+// upgradeable is false, as reported by IsUpgradeable.
+func (builder *OperatorConditionBuilder) WaitUntilUpgradeable(upgradeable bool, timeout time.Duration) error {
+	if err := common.Validate(builder); err != nil {
+		return err
+	}
+
+	klog.V(100).Infof("Waiting up to %s for OperatorCondition %s in namespace %s to have upgradeable %t",
+		timeout, builder.Definition.Name, builder.Definition.Namespace, upgradeable)
+
+	return wait.PollUntilContextTimeout(
+		context.TODO(), time.Second, timeout, true, func(ctx context.Context) (bool, error) {
+			isUpgradeable, err := builder.IsUpgradeable()
+			if err != nil {
+				klog.V(100).Infof("Failed to get Upgradeable condition of OperatorCondition %s in namespace %s: %v",
+					builder.Definition.Name, builder.Definition.Namespace, err)
+
+				return false, nil
+			}
+
+			return isUpgradeable == upgradeable, nil
+		})
+}
+
+// validateUpgradeableCondition checks the fields of an Upgradeable condition that the API server requires.
+func validateUpgradeableCondition(status metav1.ConditionStatus, reason string) error {
+	if status != metav1.ConditionTrue && status != metav1.ConditionFalse && status != metav1.ConditionUnknown {
+		klog.V(100).Infof("The Upgradeable condition status %s is invalid", status)
+
+		return fmt.Errorf("operatorcondition 'status' must be True, False or Unknown, got %q", status)
+	}
+
+	if reason == "" {
+		klog.V(100).Info("The Upgradeable condition reason is empty")
+
+		return fmt.Errorf("operatorcondition 'reason' cannot be empty")
+	}
+
+	return nil
+}
+
+// newUpgradeableCondition returns an Upgradeable condition with the provided fields.
+func newUpgradeableCondition(
+	status metav1.ConditionStatus, reason, message string, generation int64) metav1.Condition {
+	return metav1.Condition{
+		Type:               operatorsv2.Upgradeable,
+		Status:             status,
+		Reason:             reason,
+		Message:            message,
+		ObservedGeneration: generation,
+	}
+}
+
+// GetOperatorConditionGVR returns OperatorCondition's GroupVersionResource which could be used for Clean function.
+func GetOperatorConditionGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group: "operators.coreos.com", Version: "v2", Resource: "operatorconditions",
+	}
+}
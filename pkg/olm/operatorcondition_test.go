@@ -0,0 +1,167 @@
+package olm
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common/testhelper"
+	operatorsv2 "github.com/rh-ecosystem-edge/eco-goinfra/pkg/schemes/olm/operators/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	defaultOperatorConditionName      = "kmm-operator.v2.0.0"
+	defaultOperatorConditionNamespace = "openshift-kmm"
+)
+
+var operatorConditionGVK = operatorsv2.GroupVersion.WithKind("OperatorCondition")
+
+func TestNewOperatorConditionBuilder(t *testing.T) {
+	t.Parallel()
+
+	testhelper.NewNamespacedBuilderTestConfig(
+		NewOperatorConditionBuilder, operatorsv2.AddToScheme, operatorConditionGVK).ExecuteTests(t)
+}
+
+func TestPullOperatorCondition(t *testing.T) {
+	t.Parallel()
+
+	testhelper.NewNamespacedPullTestConfig(
+		PullOperatorCondition, operatorsv2.AddToScheme, operatorConditionGVK).ExecuteTests(t)
+}
+
+func TestListOperatorConditions(t *testing.T) {
+	t.Parallel()
+
+	testhelper.NewListTestConfig(ListOperatorConditions, operatorsv2.AddToScheme, operatorConditionGVK).ExecuteTests(t)
+}
+
+func TestOperatorConditionMethods(t *testing.T) {
+	t.Parallel()
+
+	commonTestConfig := testhelper.NewCommonTestConfig[operatorsv2.OperatorCondition, OperatorConditionBuilder](
+		operatorsv2.AddToScheme,
+		operatorConditionGVK,
+		testhelper.ResourceScopeNamespaced,
+	)
+
+	testhelper.NewTestSuite().
+		With(testhelper.NewGetTestConfig(commonTestConfig)).
+		With(testhelper.NewExistsTestConfig(commonTestConfig)).
+		With(testhelper.NewCreateTestConfig(commonTestConfig)).
+		With(testhelper.NewDeleterTestConfig(commonTestConfig)).
+		With(testhelper.NewUpdateTestConfig(commonTestConfig)).
+		Run(t)
+}
+
+func TestOperatorConditionWithUpgradeable(t *testing.T) {
+	t.Parallel()
+
+	testBuilder := buildValidOperatorConditionTestBuilder(clients.GetTestClients(clients.TestClientParams{})).
+		WithUpgradeableCondition(metav1.ConditionFalse, "Migrating", "migration in progress").
+		WithUpgradeableOverride(metav1.ConditionTrue, "Forced", "")
+
+	require.NoError(t, testBuilder.GetError())
+	require.Len(t, testBuilder.Definition.Spec.Conditions, 1)
+	assert.Equal(t, operatorsv2.Upgradeable, testBuilder.Definition.Spec.Conditions[0].Type)
+	assert.Equal(t, metav1.ConditionFalse, testBuilder.Definition.Spec.Conditions[0].Status)
+	assert.Equal(t, "migration in progress", testBuilder.Definition.Spec.Conditions[0].Message)
+	require.Len(t, testBuilder.Definition.Spec.Overrides, 1)
+	assert.Equal(t, metav1.ConditionTrue, testBuilder.Definition.Spec.Overrides[0].Status)
+	assert.False(t, testBuilder.Definition.Spec.Overrides[0].LastTransitionTime.IsZero())
+
+	testBuilder = testBuilder.WithoutUpgradeableCondition().WithoutUpgradeableOverride()
+
+	require.NoError(t, testBuilder.GetError())
+	assert.Empty(t, testBuilder.Definition.Spec.Conditions)
+	assert.Empty(t, testBuilder.Definition.Spec.Overrides)
+
+	testBuilder = buildValidOperatorConditionTestBuilder(clients.GetTestClients(clients.TestClientParams{})).
+		WithUpgradeableCondition("Maybe", "Migrating", "")
+	assert.EqualError(t, testBuilder.GetError(),
+		"operatorcondition 'status' must be True, False or Unknown, got \"Maybe\"")
+
+	testBuilder = buildValidOperatorConditionTestBuilder(clients.GetTestClients(clients.TestClientParams{})).
+		WithUpgradeableOverride(metav1.ConditionTrue, "", "")
+	assert.EqualError(t, testBuilder.GetError(), "operatorcondition 'reason' cannot be empty")
+}
+
+func TestOperatorConditionIsUpgradeable(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name        string
+		conditions  []metav1.Condition
+		overrides   []metav1.Condition
+		upgradeable bool
+	}{
+		{
+			name:        "no condition",
+			upgradeable: true,
+		},
+		{
+			name:        "condition false",
+			conditions:  []metav1.Condition{{Type: operatorsv2.Upgradeable, Status: metav1.ConditionFalse}},
+			upgradeable: false,
+		},
+		{
+			name:        "condition true",
+			conditions:  []metav1.Condition{{Type: operatorsv2.Upgradeable, Status: metav1.ConditionTrue}},
+			upgradeable: true,
+		},
+		{
+			name:        "override takes precedence",
+			conditions:  []metav1.Condition{{Type: operatorsv2.Upgradeable, Status: metav1.ConditionFalse}},
+			overrides:   []metav1.Condition{{Type: operatorsv2.Upgradeable, Status: metav1.ConditionTrue}},
+			upgradeable: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			operatorCondition := buildDummyOperatorCondition()
+			operatorCondition.Status.Conditions = testCase.conditions
+			operatorCondition.Spec.Overrides = testCase.overrides
+
+			testBuilder := buildValidOperatorConditionTestBuilder(clients.GetTestClients(clients.TestClientParams{
+				K8sMockObjects:  []runtime.Object{operatorCondition},
+				SchemeAttachers: []clients.SchemeAttacher{operatorsv2.AddToScheme},
+			}))
+
+			upgradeable, err := testBuilder.IsUpgradeable()
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.upgradeable, upgradeable)
+
+			err = testBuilder.WaitUntilUpgradeable(testCase.upgradeable, time.Second)
+			assert.NoError(t, err)
+
+			err = testBuilder.WaitUntilUpgradeable(!testCase.upgradeable, time.Second)
+			assert.ErrorIs(t, err, context.DeadlineExceeded)
+		})
+	}
+
+	_, err := buildValidOperatorConditionTestBuilder(clients.GetTestClients(clients.TestClientParams{
+		SchemeAttachers: []clients.SchemeAttacher{operatorsv2.AddToScheme},
+	})).IsUpgradeable()
+	assert.Error(t, err)
+}
+
+func buildDummyOperatorCondition() *operatorsv2.OperatorCondition {
+	return &operatorsv2.OperatorCondition{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      defaultOperatorConditionName,
+			Namespace: defaultOperatorConditionNamespace,
+		},
+	}
+}
+
+func buildValidOperatorConditionTestBuilder(apiClient *clients.Settings) *OperatorConditionBuilder {
+	return NewOperatorConditionBuilder(apiClient, defaultOperatorConditionName, defaultOperatorConditionNamespace)
+}
@@ -7,6 +7,7 @@ import (
 	runtimeClient "sigs.k8s.io/controller-runtime/pkg/client"
 
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/klog/v2"
 
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
@@ -34,6 +35,8 @@ type OperatorGroupBuilder struct {
 
 // NewOperatorGroupBuilder returns an OperatorGroupBuilder struct.
 func NewOperatorGroupBuilder(apiClient *clients.Settings, groupName, nsName string) *OperatorGroupBuilder {
+	nsName = apiClient.ResolveNamespace(nsName)
+
 	klog.V(100).Infof(
 		"Initializing new OperatorGroupBuilder structure with the following params: %s, %s", groupName, nsName)
 
@@ -198,6 +201,8 @@ func (builder *OperatorGroupBuilder) Update() (*OperatorGroupBuilder, error) {
 
 // PullOperatorGroup loads existing OperatorGroup from cluster into the OperatorGroupBuilder struct.
 func PullOperatorGroup(apiClient *clients.Settings, groupName, nsName string) (*OperatorGroupBuilder, error) {
+	nsName = apiClient.ResolveNamespace(nsName)
+
 	klog.V(100).Infof("Pulling existing OperatorGroup %s from cluster in namespace %s",
 		groupName, nsName)
 
@@ -246,6 +251,13 @@ func PullOperatorGroup(apiClient *clients.Settings, groupName, nsName string) (*
 	return builder, nil
 }
 
+// GetOperatorGroupGVR returns OperatorGroup's GroupVersionResource which could be used for Clean function.
+func GetOperatorGroupGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group: "operators.coreos.com", Version: "v1", Resource: "operatorgroups",
+	}
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *OperatorGroupBuilder) validate() (bool, error) {
@@ -5,6 +5,7 @@ import (
 
 	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/logging"
@@ -32,6 +33,8 @@ type PackageManifestBuilder struct {
 
 // PullPackageManifest loads an existing PackageManifest into Builder struct.
 func PullPackageManifest(apiClient *clients.Settings, name, nsname string) (*PackageManifestBuilder, error) {
+	nsname = apiClient.ResolveNamespace(nsname)
+
 	klog.V(100).Infof("Pulling existing PackageManifest name %s in namespace %s", name, nsname)
 
 	if apiClient == nil {
@@ -81,6 +84,8 @@ func PullPackageManifest(apiClient *clients.Settings, name, nsname string) (*Pac
 // PullPackageManifestByCatalog loads an existing PackageManifest from specified catalog into Builder struct.
 func PullPackageManifestByCatalog(apiClient *clients.Settings, name, nsname,
 	catalog string) (*PackageManifestBuilder, error) {
+	nsname = apiClient.ResolveNamespace(nsname)
+
 	klog.V(100).Infof("Pulling existing PackageManifest name %s in namespace %s and from catalog %s",
 		name, nsname, catalog)
 
@@ -206,6 +211,13 @@ func (builder *PackageManifestBuilder) Delete() error {
 	return nil
 }
 
+// GetPackageManifestGVR returns PackageManifest's GroupVersionResource which could be used for Clean function.
+func GetPackageManifestGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group: "packages.operators.coreos.com", Version: "v1", Resource: "packagemanifests",
+	}
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *PackageManifestBuilder) validate() (bool, error) {
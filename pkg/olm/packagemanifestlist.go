@@ -16,6 +16,8 @@ func ListPackageManifest(
 	apiClient *clients.Settings,
 	nsname string,
 	options ...client.ListOptions) ([]*PackageManifestBuilder, error) {
+	nsname = apiClient.ResolveNamespace(nsname)
+
 	if nsname == "" {
 		klog.V(100).Info("packagemanifest 'nsname' parameter can not be empty")
 
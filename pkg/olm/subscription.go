@@ -9,6 +9,7 @@ import (
 	operatorsV1alpha1 "github.com/rh-ecosystem-edge/eco-goinfra/pkg/schemes/olm/operators/v1alpha1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/klog/v2"
 	runtimeClient "sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -338,6 +339,13 @@ func PullSubscription(apiClient *clients.Settings, subName, subNamespace string)
 	return builder, nil
 }
 
+// GetSubscriptionGVR returns Subscription's GroupVersionResource which could be used for Clean function.
+func GetSubscriptionGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group: "operators.coreos.com", Version: "v1alpha1", Resource: "subscriptions",
+	}
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *SubscriptionBuilder) validate() (bool, error) {
@@ -8,6 +8,7 @@ import (
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/logging"
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/msg"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/klog/v2"
 	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -26,6 +27,8 @@ type AllocatedNodeBuilder struct {
 
 // PullAllocatedNode pulls an existing AllocatedNode into a AllocatedNodeBuilder struct.
 func PullAllocatedNode(apiClient *clients.Settings, name, nsname string) (*AllocatedNodeBuilder, error) {
+	nsname = apiClient.ResolveNamespace(nsname)
+
 	klog.V(100).Infof("Pulling existing AllocatedNode %s in namespace %s from cluster", name, nsname)
 
 	if apiClient == nil {
@@ -118,6 +121,13 @@ func (builder *AllocatedNodeBuilder) Exists() bool {
 	return true
 }
 
+// GetAllocatedNodeGVR returns AllocatedNode's GroupVersionResource which could be used for Clean function.
+func GetAllocatedNodeGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group: "clcm.openshift.io", Version: "v1alpha1", Resource: "allocatednodes",
+	}
+}
+
 // validate checks that the builder, definition, and apiClient are properly initialized and there is no errorMsg.
 func (builder *AllocatedNodeBuilder) validate() (bool, error) {
 	resourceCRD := "allocatedNode"
@@ -12,6 +12,7 @@ import (
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/msg"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/klog/v2"
 	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
@@ -31,6 +32,8 @@ type ClusterTemplateBuilder struct {
 
 // PullClusterTemplate pulls an existing ClusterTemplate into a ClusterTemplateBuilder struct.
 func PullClusterTemplate(apiClient *clients.Settings, name, nsname string) (*ClusterTemplateBuilder, error) {
+	nsname = apiClient.ResolveNamespace(nsname)
+
 	klog.V(100).Infof("Pulling existing ClusterTemplate %s in namespace %s from cluster", name, nsname)
 
 	if apiClient == nil {
@@ -182,6 +185,13 @@ func (builder *ClusterTemplateBuilder) WaitForCondition(
 	return builder, nil
 }
 
+// GetClusterTemplateGVR returns ClusterTemplate's GroupVersionResource which could be used for Clean function.
+func GetClusterTemplateGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group: "clcm.openshift.io", Version: "v1alpha1", Resource: "clustertemplates",
+	}
+}
+
 // validate checks that the builder, definition, and apiClient are properly initialized and there is no errorMsg.
 func (builder *ClusterTemplateBuilder) validate() (bool, error) {
 	resourceCRD := "clusterTemplate"
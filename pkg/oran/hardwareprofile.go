@@ -6,6 +6,7 @@ import (
 	hardwaremanagementv1alpha1 "github.com/openshift-kni/oran-o2ims/api/hardwaremanagement/v1alpha1"
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -14,6 +15,13 @@ import (
 // a connection to the cluster and the HardwareProfile definition.
 type HardwareProfileBuilder struct {
 	common.EmbeddableBuilder[hardwaremanagementv1alpha1.HardwareProfile, *hardwaremanagementv1alpha1.HardwareProfile]
+	common.EmbeddableConditionGetter[metav1.Condition, hardwaremanagementv1alpha1.HardwareProfile,
+		*hardwaremanagementv1alpha1.HardwareProfile]
+}
+
+// AttachMixins wires the embedded status mixins to this builder instance.
+func (builder *HardwareProfileBuilder) AttachMixins() {
+	builder.EmbeddableConditionGetter.SetBase(builder)
 }
 
 // GetGVK returns the HardwareProfile GVK for this builder.
@@ -32,3 +40,10 @@ func ListHardwareProfiles(apiClient *clients.Settings, options ...runtimeclient.
 	return common.List[hardwaremanagementv1alpha1.HardwareProfile, hardwaremanagementv1alpha1.HardwareProfileList, HardwareProfileBuilder](
 		context.TODO(), apiClient, hardwaremanagementv1alpha1.AddToScheme, options...)
 }
+
+// GetHardwareProfileGVR returns HardwareProfile's GroupVersionResource which could be used for Clean function.
+func GetHardwareProfileGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group: "clcm.openshift.io", Version: "v1alpha1", Resource: "hardwareprofiles",
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/logging"
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/msg"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/klog/v2"
 	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -26,6 +27,8 @@ type NARBuilder struct {
 
 // PullNodeAllocationRequest pulls an existing NodeAllocationRequest into a NARBuilder struct.
 func PullNodeAllocationRequest(apiClient *clients.Settings, name, nsname string) (*NARBuilder, error) {
+	nsname = apiClient.ResolveNamespace(nsname)
+
 	klog.V(100).Infof("Pulling existing NodeAllocationRequest %s in namespace %s from cluster", name, nsname)
 
 	if apiClient == nil {
@@ -121,6 +124,14 @@ func (builder *NARBuilder) Exists() bool {
 	return true
 }
 
+// GetNodeAllocationRequestGVR returns NodeAllocationRequest's GroupVersionResource
+// which could be used for Clean function.
+func GetNodeAllocationRequestGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group: "clcm.openshift.io", Version: "v1alpha1", Resource: "nodeallocationrequests",
+	}
+}
+
 // validate checks that the builder, definition, and apiClient are properly initialized and there is no errorMsg.
 func (builder *NARBuilder) validate() (bool, error) {
 	resourceCRD := "nodeAllocationRequest"
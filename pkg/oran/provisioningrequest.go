@@ -14,6 +14,7 @@ import (
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/msg"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/klog/v2"
 	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
@@ -484,6 +485,13 @@ func (builder *ProvisioningRequestBuilder) WaitForPhaseAfter(
 	return nil
 }
 
+// GetProvisioningRequestGVR returns ProvisioningRequest's GroupVersionResource which could be used for Clean function.
+func GetProvisioningRequestGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group: "clcm.openshift.io", Version: "v1alpha1", Resource: "provisioningrequests",
+	}
+}
+
 // validate checks that the builder, definition, and apiClient are properly initialized and there is no errorMsg.
 func (builder *ProvisioningRequestBuilder) validate() (bool, error) {
 	resourceCRD := "provisioningRequest"
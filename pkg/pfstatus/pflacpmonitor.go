@@ -31,6 +31,8 @@ type PfStatusConfigurationBuilder struct {
 // NewPfStatusConfigurationBuilder creates a new instance of PfStatusConfiguration.
 func NewPfStatusConfigurationBuilder(
 	apiClient *clients.Settings, name, nsname string) *PfStatusConfigurationBuilder {
+	nsname = apiClient.ResolveNamespace(nsname)
+
 	klog.V(100).Infof(
 		"Initializing new NewPfStatusConfiguration structure with the following params: %s, %s",
 		name, nsname)
@@ -179,6 +181,8 @@ func (builder *PfStatusConfigurationBuilder) Get() (*pfstatustypes.PFLACPMonitor
 // PullPfStatusConfiguration pulls existing pfStatusConfiguration from cluster.
 func PullPfStatusConfiguration(
 	apiClient *clients.Settings, name, nsname string) (*PfStatusConfigurationBuilder, error) {
+	nsname = apiClient.ResolveNamespace(nsname)
+
 	klog.V(100).Infof(
 		"Pulling existing pfStatusConfiguration name %s under namespace %s from cluster", name, nsname)
 
@@ -437,6 +437,115 @@ func (builder *ContainerBuilder) WithReadinessProbe(readinessProbe *corev1.Probe
 	return builder
 }
 
+// WithLivenessProbe adds a livenessProbe to the container.
+func (builder *ContainerBuilder) WithLivenessProbe(livenessProbe *corev1.Probe) *ContainerBuilder {
+	klog.V(100).Infof("Adding livenessProbe to the %s container's definition", builder.definition.Name)
+
+	if livenessProbe == nil {
+		klog.V(100).Info("Container's livenessProbe cannot be empty")
+
+		builder.errorMsg = "container's livenessProbe is empty"
+
+		return builder
+	}
+
+	builder.definition.LivenessProbe = livenessProbe
+
+	return builder
+}
+
+// WithStartupProbe adds a startupProbe to the container.
+func (builder *ContainerBuilder) WithStartupProbe(startupProbe *corev1.Probe) *ContainerBuilder {
+	klog.V(100).Infof("Adding startupProbe to the %s container's definition", builder.definition.Name)
+
+	if startupProbe == nil {
+		klog.V(100).Info("Container's startupProbe cannot be empty")
+
+		builder.errorMsg = "container's startupProbe is empty"
+
+		return builder
+	}
+
+	builder.definition.StartupProbe = startupProbe
+
+	return builder
+}
+
+// WithPostStart adds a postStart lifecycle hook to the container, which runs immediately after the container is
+// created.
+func (builder *ContainerBuilder) WithPostStart(handler *corev1.LifecycleHandler) *ContainerBuilder {
+	klog.V(100).Infof("Adding postStart hook to the %s container's definition", builder.definition.Name)
+
+	if handler == nil {
+		klog.V(100).Info("Container's postStart handler cannot be empty")
+
+		builder.errorMsg = "container's postStart handler is empty"
+
+		return builder
+	}
+
+	if builder.definition.Lifecycle == nil {
+		builder.definition.Lifecycle = &corev1.Lifecycle{}
+	}
+
+	builder.definition.Lifecycle.PostStart = handler
+
+	return builder
+}
+
+// WithPreStop adds a preStop lifecycle hook to the container, which runs before the container is terminated.
+func (builder *ContainerBuilder) WithPreStop(handler *corev1.LifecycleHandler) *ContainerBuilder {
+	klog.V(100).Infof("Adding preStop hook to the %s container's definition", builder.definition.Name)
+
+	if handler == nil {
+		klog.V(100).Info("Container's preStop handler cannot be empty")
+
+		builder.errorMsg = "container's preStop handler is empty"
+
+		return builder
+	}
+
+	if builder.definition.Lifecycle == nil {
+		builder.definition.Lifecycle = &corev1.Lifecycle{}
+	}
+
+	builder.definition.Lifecycle.PreStop = handler
+
+	return builder
+}
+
+// WithResources sets the resource requests and limits of the container, replacing any previously set. Either may be
+// empty, but not both, and no request may exceed the limit of the same resource.
+func (builder *ContainerBuilder) WithResources(requests, limits corev1.ResourceList) *ContainerBuilder {
+	klog.V(100).Infof("Applying resources to container %s: requests: %v, limits: %v",
+		builder.definition.Name, requests, limits)
+
+	if len(requests) == 0 && len(limits) == 0 {
+		klog.V(100).Info("Container's resource requests and limits are both empty")
+
+		builder.errorMsg = "container's resource 'requests' and 'limits' cannot both be empty"
+
+		return builder
+	}
+
+	for name, request := range requests {
+		limit, ok := limits[name]
+		if ok && request.Cmp(limit) > 0 {
+			klog.V(100).Infof("Container's resource request %s of %s exceeds limit %s", name, request.String(), limit.String())
+
+			builder.errorMsg = fmt.Sprintf("container's resource request %s of %s exceeds limit %s",
+				name, request.String(), limit.String())
+
+			return builder
+		}
+	}
+
+	builder.definition.Resources.Requests = requests
+	builder.definition.Resources.Limits = limits
+
+	return builder
+}
+
 // WithTTY applies TTY value on container.
 func (builder *ContainerBuilder) WithTTY(enableTTY bool) *ContainerBuilder {
 	klog.V(100).Infof("Applying TTY value to container: %v", enableTTY)
@@ -7,6 +7,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
 var testUser = int64(1000)
@@ -437,6 +438,157 @@ func TestPodContainerWithReadinessProbe(t *testing.T) {
 	}
 }
 
+func TestPodContainerWithLivenessProbe(t *testing.T) {
+	testCases := []struct {
+		livenessProbe *corev1.Probe
+		expectedError string
+	}{
+		{
+			livenessProbe: &corev1.Probe{
+				ProbeHandler: corev1.ProbeHandler{
+					Exec: &corev1.ExecAction{
+						Command: []string{
+							"echo",
+							"alive",
+						},
+					},
+				},
+			},
+			expectedError: "",
+		},
+		{
+			livenessProbe: nil,
+			expectedError: "container's livenessProbe is empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		container := NewContainerBuilder("container", "test", []string{defaultShellBinBash, "-c", "sleep"})
+		container = container.WithLivenessProbe(testCase.livenessProbe)
+		assert.Equal(t, testCase.expectedError, container.errorMsg)
+
+		if testCase.expectedError == "" {
+			assert.NotNil(t, container.definition)
+			assert.Equal(t, testCase.livenessProbe, container.definition.LivenessProbe)
+		}
+	}
+}
+
+func TestPodContainerWithStartupProbe(t *testing.T) {
+	testCases := []struct {
+		startupProbe  *corev1.Probe
+		expectedError string
+	}{
+		{
+			startupProbe: &corev1.Probe{
+				ProbeHandler: corev1.ProbeHandler{
+					TCPSocket: &corev1.TCPSocketAction{
+						Port: intstr.FromInt32(8080),
+					},
+				},
+				FailureThreshold: 30,
+			},
+			expectedError: "",
+		},
+		{
+			startupProbe:  nil,
+			expectedError: "container's startupProbe is empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		container := NewContainerBuilder("container", "test", []string{defaultShellBinBash, "-c", "sleep"})
+		container = container.WithStartupProbe(testCase.startupProbe)
+		assert.Equal(t, testCase.expectedError, container.errorMsg)
+
+		if testCase.expectedError == "" {
+			assert.NotNil(t, container.definition)
+			assert.Equal(t, testCase.startupProbe, container.definition.StartupProbe)
+		}
+	}
+}
+
+func TestPodContainerWithLifecycleHooks(t *testing.T) {
+	testHandler := &corev1.LifecycleHandler{
+		Exec: &corev1.ExecAction{
+			Command: []string{"sleep", "5"},
+		},
+	}
+
+	testCases := []struct {
+		postStart     *corev1.LifecycleHandler
+		preStop       *corev1.LifecycleHandler
+		expectedError string
+	}{
+		{
+			postStart:     testHandler,
+			preStop:       testHandler,
+			expectedError: "",
+		},
+		{
+			postStart:     nil,
+			preStop:       testHandler,
+			expectedError: "container's postStart handler is empty",
+		},
+		{
+			postStart:     testHandler,
+			preStop:       nil,
+			expectedError: "container's preStop handler is empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		container := NewContainerBuilder("container", "test", []string{defaultShellBinBash, "-c", "sleep"})
+		container = container.WithPostStart(testCase.postStart).WithPreStop(testCase.preStop)
+		assert.Equal(t, testCase.expectedError, container.errorMsg)
+
+		if testCase.expectedError == "" {
+			assert.Equal(t, testCase.postStart, container.definition.Lifecycle.PostStart)
+			assert.Equal(t, testCase.preStop, container.definition.Lifecycle.PreStop)
+		}
+	}
+}
+
+func TestPodContainerWithResources(t *testing.T) {
+	testCases := []struct {
+		requests      corev1.ResourceList
+		limits        corev1.ResourceList
+		expectedError string
+	}{
+		{
+			requests:      corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("500m")},
+			limits:        corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("1")},
+			expectedError: "",
+		},
+		{
+			requests:      corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("1Gi")},
+			limits:        nil,
+			expectedError: "",
+		},
+		{
+			requests:      corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("2")},
+			limits:        corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("1")},
+			expectedError: "container's resource request cpu of 2 exceeds limit 1",
+		},
+		{
+			requests:      nil,
+			limits:        corev1.ResourceList{},
+			expectedError: "container's resource 'requests' and 'limits' cannot both be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		container := NewContainerBuilder("container", "test", []string{defaultShellBinBash, "-c", "sleep"})
+		container = container.WithResources(testCase.requests, testCase.limits)
+		assert.Equal(t, testCase.expectedError, container.errorMsg)
+
+		if testCase.expectedError == "" {
+			assert.Equal(t, testCase.requests, container.definition.Resources.Requests)
+			assert.Equal(t, testCase.limits, container.definition.Resources.Limits)
+		}
+	}
+}
+
 func TestPodContainerWithTTY(t *testing.T) {
 	testCases := []struct {
 		enableTty     bool
@@ -0,0 +1,182 @@
+package pod
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/klog/v2"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common"
+)
+
+// hugeTLBFSType is the filesystem type of hugepage mounts in /proc/mounts.
+const hugeTLBFSType = "hugetlbfs"
+
+// VerifyHugePagesMount verifies that mountPath in the provided container is backed by hugepages of the provided size,
+// such as 1Gi or 2Mi. The spec of the pod must mount a HugePages emptyDir at mountPath and request hugepages of size in
+// the limits of the container, and the mount inside the running container must be a hugetlbfs with the same page size.
+func (builder *Builder) VerifyHugePagesMount(containerName, mountPath, size string) error {
+	if err := common.Validate(builder); err != nil {
+		return err
+	}
+
+	if containerName == "" {
+		return fmt.Errorf("pod hugepages 'containerName' cannot be empty")
+	}
+
+	if mountPath == "" {
+		return fmt.Errorf("pod hugepages 'mountPath' cannot be empty")
+	}
+
+	if size == "" {
+		return fmt.Errorf("pod hugepages 'size' cannot be empty")
+	}
+
+	pageSize, err := resource.ParseQuantity(size)
+	if err != nil {
+		return fmt.Errorf("invalid hugepages size %q: %w", size, err)
+	}
+
+	klog.V(100).Infof("Verifying %s hugepages mount %s in container %s of pod %s in namespace %s",
+		size, mountPath, containerName, builder.Definition.Name, builder.Definition.Namespace)
+
+	pod, err := builder.Get()
+	if err != nil {
+		return err
+	}
+
+	builder.Object = pod
+
+	if err := verifyHugePagesSpec(&pod.Spec, containerName, mountPath, size); err != nil {
+		return err
+	}
+
+	output, err := builder.ExecCommand([]string{"cat", "/proc/mounts"}, containerName)
+	if err != nil {
+		return fmt.Errorf("failed to read mounts of container %s: %w", containerName, err)
+	}
+
+	mountedPageSize, err := getHugeTLBPageSize(output.String(), mountPath)
+	if err != nil {
+		return err
+	}
+
+	if mountedPageSize != pageSize.Value() {
+		return fmt.Errorf("hugepages mount %s in container %s has page size of %d bytes, expected %s",
+			mountPath, containerName, mountedPageSize, size)
+	}
+
+	return nil
+}
+
+// verifyHugePagesSpec verifies that the container in podSpec mounts a HugePages emptyDir at mountPath and has a limit
+// for hugepages of the provided size.
+func verifyHugePagesSpec(podSpec *corev1.PodSpec, containerName, mountPath, size string) error {
+	var container *corev1.Container
+
+	for idx := range podSpec.Containers {
+		if podSpec.Containers[idx].Name == containerName {
+			container = &podSpec.Containers[idx]
+
+			break
+		}
+	}
+
+	if container == nil {
+		return fmt.Errorf("container %s not found in pod", containerName)
+	}
+
+	volumeName := ""
+
+	for _, volumeMount := range container.VolumeMounts {
+		if volumeMount.MountPath == mountPath {
+			volumeName = volumeMount.Name
+
+			break
+		}
+	}
+
+	if volumeName == "" {
+		return fmt.Errorf("container %s has no volume mounted at %s", containerName, mountPath)
+	}
+
+	var medium corev1.StorageMedium
+
+	for _, volume := range podSpec.Volumes {
+		if volume.Name == volumeName && volume.EmptyDir != nil {
+			medium = volume.EmptyDir.Medium
+
+			break
+		}
+	}
+
+	if medium != corev1.StorageMediumHugePages && medium != corev1.StorageMedium("HugePages-"+size) {
+		return fmt.Errorf("volume %s mounted at %s is not a hugepages emptyDir", volumeName, mountPath)
+	}
+
+	limit, ok := container.Resources.Limits[corev1.ResourceName(corev1.ResourceHugePagesPrefix+size)]
+	if !ok || limit.IsZero() {
+		return fmt.Errorf("container %s has no limit for %s hugepages", containerName, size)
+	}
+
+	return nil
+}
+
+// getHugeTLBPageSize returns the page size in bytes of the hugetlbfs mounted at mountPath, according to the provided
+// contents of /proc/mounts.
+func getHugeTLBPageSize(mounts, mountPath string) (int64, error) {
+	for _, line := range strings.Split(mounts, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) < 4 || fields[1] != mountPath {
+			continue
+		}
+
+		if fields[2] != hugeTLBFSType {
+			return 0, fmt.Errorf("mount %s has filesystem type %s, expected %s", mountPath, fields[2], hugeTLBFSType)
+		}
+
+		for _, option := range strings.Split(fields[3], ",") {
+			if pageSize, found := strings.CutPrefix(option, "pagesize="); found {
+				return parseKernelPageSize(pageSize)
+			}
+		}
+
+		// Without a pagesize option, the mount uses the default hugepage size of the kernel, which cannot be
+		// determined from the mount alone.
+		return 0, fmt.Errorf("mount %s has no pagesize option", mountPath)
+	}
+
+	return 0, fmt.Errorf("mount %s not found", mountPath)
+}
+
+// parseKernelPageSize parses a page size as shown by the kernel in mount options, such as 2M or 1024M, into bytes. The
+// kernel uses binary units for the K, M, and G suffixes.
+func parseKernelPageSize(pageSize string) (int64, error) {
+	multiplier := int64(1)
+	number := pageSize
+
+	if pageSize != "" {
+		switch pageSize[len(pageSize)-1] {
+		case 'K':
+			multiplier = 1 << 10
+		case 'M':
+			multiplier = 1 << 20
+		case 'G':
+			multiplier = 1 << 30
+		}
+
+		if multiplier != 1 {
+			number = pageSize[:len(pageSize)-1]
+		}
+	}
+
+	value, err := strconv.ParseInt(number, 10, 64)
+	if err != nil || value <= 0 {
+		return 0, fmt.Errorf("invalid hugetlbfs page size %q", pageSize)
+	}
+
+	return value * multiplier, nil
+}
@@ -0,0 +1,200 @@
+package pod
+
+import (
+	"testing"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const defaultHugePagesMountPath = "/mnt/huge"
+
+func TestPodVerifyHugePagesMount(t *testing.T) {
+	testCases := []struct {
+		containerName string
+		mountPath     string
+		size          string
+		podExists     bool
+		expectedError string
+	}{
+		{
+			containerName: "",
+			mountPath:     defaultHugePagesMountPath,
+			size:          "1Gi",
+			podExists:     true,
+			expectedError: "pod hugepages 'containerName' cannot be empty",
+		},
+		{
+			containerName: "test",
+			mountPath:     "",
+			size:          "1Gi",
+			podExists:     true,
+			expectedError: "pod hugepages 'mountPath' cannot be empty",
+		},
+		{
+			containerName: "test",
+			mountPath:     defaultHugePagesMountPath,
+			size:          "",
+			podExists:     true,
+			expectedError: "pod hugepages 'size' cannot be empty",
+		},
+		{
+			containerName: "test",
+			mountPath:     defaultHugePagesMountPath,
+			size:          "huge",
+			podExists:     true,
+			expectedError: "invalid hugepages size \"huge\": quantities must match the regular expression " +
+				"'^([+-]?[0-9.]+)([eEinumkKMGTP]*[-+]?[0-9]*)$'",
+		},
+		{
+			containerName: "test",
+			mountPath:     defaultHugePagesMountPath,
+			size:          "1Gi",
+			podExists:     false,
+			expectedError: "failed to get Pod test-ns/test-pod: pods \"test-pod\" not found",
+		},
+		{
+			containerName: "test",
+			mountPath:     defaultHugePagesMountPath,
+			size:          "1Gi",
+			podExists:     true,
+			expectedError: "container test has no volume mounted at /mnt/huge",
+		},
+	}
+
+	for _, testCase := range testCases {
+		var runtimeObjects []runtime.Object
+
+		if testCase.podExists {
+			runtimeObjects = append(runtimeObjects, buildDummyPod(defaultPodName, defaultPodNsName, defaultPodImage))
+		}
+
+		testSettings := clients.GetTestClients(clients.TestClientParams{
+			K8sMockObjects:  runtimeObjects,
+			SchemeAttachers: testSchemes,
+		})
+
+		err := buildValidPodTestBuilder(testSettings).
+			VerifyHugePagesMount(testCase.containerName, testCase.mountPath, testCase.size)
+		assert.EqualError(t, err, testCase.expectedError)
+	}
+}
+
+func TestVerifyHugePagesSpec(t *testing.T) {
+	testCases := []struct {
+		containerName string
+		medium        corev1.StorageMedium
+		limits        corev1.ResourceList
+		expectedError string
+	}{
+		{
+			containerName: "test",
+			medium:        corev1.StorageMediumHugePages,
+			limits:        corev1.ResourceList{"hugepages-1Gi": resource.MustParse("2Gi")},
+		},
+		{
+			containerName: "test",
+			medium:        "HugePages-1Gi",
+			limits:        corev1.ResourceList{"hugepages-1Gi": resource.MustParse("2Gi")},
+		},
+		{
+			containerName: "other",
+			medium:        corev1.StorageMediumHugePages,
+			limits:        corev1.ResourceList{"hugepages-1Gi": resource.MustParse("2Gi")},
+			expectedError: "container other not found in pod",
+		},
+		{
+			containerName: "test",
+			medium:        corev1.StorageMediumMemory,
+			limits:        corev1.ResourceList{"hugepages-1Gi": resource.MustParse("2Gi")},
+			expectedError: "volume hugepages mounted at /mnt/huge is not a hugepages emptyDir",
+		},
+		{
+			containerName: "test",
+			medium:        "HugePages-2Mi",
+			limits:        corev1.ResourceList{"hugepages-1Gi": resource.MustParse("2Gi")},
+			expectedError: "volume hugepages mounted at /mnt/huge is not a hugepages emptyDir",
+		},
+		{
+			containerName: "test",
+			medium:        corev1.StorageMediumHugePages,
+			limits:        corev1.ResourceList{"hugepages-2Mi": resource.MustParse("2Gi")},
+			expectedError: "container test has no limit for 1Gi hugepages",
+		},
+	}
+
+	for _, testCase := range testCases {
+		podSpec := buildDummyPodSpecWithHugePages(testCase.medium, testCase.limits)
+
+		err := verifyHugePagesSpec(podSpec, testCase.containerName, defaultHugePagesMountPath, "1Gi")
+		if testCase.expectedError == "" {
+			assert.NoError(t, err)
+		} else {
+			assert.EqualError(t, err, testCase.expectedError)
+		}
+	}
+}
+
+func TestGetHugeTLBPageSize(t *testing.T) {
+	testCases := []struct {
+		mounts           string
+		expectedPageSize int64
+		expectedError    string
+	}{
+		{
+			mounts: "overlay / overlay rw,relatime 0 0\n" +
+				"nodev /mnt/huge hugetlbfs rw,seclabel,relatime,pagesize=1024M 0 0\n",
+			expectedPageSize: 1 << 30,
+		},
+		{
+			mounts:           "nodev /mnt/huge hugetlbfs rw,relatime,pagesize=2M 0 0\n",
+			expectedPageSize: 2 << 20,
+		},
+		{
+			mounts:        "tmpfs /mnt/huge tmpfs rw,relatime 0 0\n",
+			expectedError: "mount /mnt/huge has filesystem type tmpfs, expected hugetlbfs",
+		},
+		{
+			mounts:        "nodev /mnt/huge hugetlbfs rw,relatime 0 0\n",
+			expectedError: "mount /mnt/huge has no pagesize option",
+		},
+		{
+			mounts:        "nodev /mnt/huge hugetlbfs rw,relatime,pagesize=large 0 0\n",
+			expectedError: "invalid hugetlbfs page size \"large\"",
+		},
+		{
+			mounts:        "overlay / overlay rw,relatime 0 0\n",
+			expectedError: "mount /mnt/huge not found",
+		},
+	}
+
+	for _, testCase := range testCases {
+		pageSize, err := getHugeTLBPageSize(testCase.mounts, defaultHugePagesMountPath)
+		if testCase.expectedError == "" {
+			assert.NoError(t, err)
+		} else {
+			assert.EqualError(t, err, testCase.expectedError)
+		}
+
+		assert.Equal(t, testCase.expectedPageSize, pageSize)
+	}
+}
+
+// buildDummyPodSpecWithHugePages returns a PodSpec whose test container mounts an emptyDir with the provided medium at
+// the default hugepages mount path and has the provided limits.
+func buildDummyPodSpecWithHugePages(medium corev1.StorageMedium, limits corev1.ResourceList) *corev1.PodSpec {
+	return &corev1.PodSpec{
+		Containers: []corev1.Container{{
+			Name:         "test",
+			Resources:    corev1.ResourceRequirements{Limits: limits},
+			VolumeMounts: []corev1.VolumeMount{{Name: volumeNameHugepages, MountPath: defaultHugePagesMountPath}},
+		}},
+		Volumes: []corev1.Volume{{
+			Name:         volumeNameHugepages,
+			VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{Medium: medium}},
+		}},
+	}
+}
@@ -7,146 +7,66 @@ import (
 	"time"
 
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
-	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/logging"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common"
+	commonerrors "github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common/errors"
+	commonkey "github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common/key"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/klog/v2"
+	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 // List returns pod inventory in the given namespace.
 func List(apiClient *clients.Settings, nsname string, options ...metav1.ListOptions) ([]*Builder, error) {
-	if apiClient == nil {
-		klog.V(100).Info("The apiClient is empty")
-
-		return nil, fmt.Errorf("podList 'apiClient' cannot be empty")
-	}
+	nsname = apiClient.ResolveNamespace(nsname)
 
 	if nsname == "" {
 		klog.V(100).Info("pod 'nsname' parameter can not be empty")
 
-		return nil, fmt.Errorf("failed to list pods, 'nsname' parameter is empty")
-	}
-
-	logMessage := fmt.Sprintf("Listing pods in the nsname %s", nsname)
-	passedOptions := metav1.ListOptions{}
-
-	if len(options) == 1 {
-		passedOptions = options[0]
-		logMessage += fmt.Sprintf(" with the options %v", passedOptions)
-	} else if len(options) > 1 {
-		klog.V(100).Info("'options' parameter must be empty or single-valued")
-
-		return nil, fmt.Errorf("error: more than one ListOptions was passed")
+		return nil, commonerrors.NewBuilderFieldEmpty(
+			commonkey.NewResourceKey("Pod", "", ""), commonerrors.BuilderFieldNamespace)
 	}
 
-	klog.V(100).Infof("%v", logMessage)
-
-	podList, err := apiClient.Pods(nsname).List(logging.DiscardContext(), passedOptions)
+	convertedOptions, err := common.ConvertMetaListOptionsToListOptions(options)
 	if err != nil {
-		klog.V(100).Infof("Failed to list pods in the nsname %s due to %s", nsname, err.Error())
-
 		return nil, err
 	}
 
-	var podObjects []*Builder
+	allOptions := append([]runtimeclient.ListOption{runtimeclient.InNamespace(nsname)}, convertedOptions...)
 
-	for _, runningPod := range podList.Items {
-		copiedPod := runningPod
-		podBuilder := &Builder{
-			apiClient:  apiClient,
-			Object:     &copiedPod,
-			Definition: &copiedPod,
-		}
-
-		podObjects = append(podObjects, podBuilder)
-	}
-
-	return podObjects, nil
+	return common.List[corev1.Pod, corev1.PodList, Builder](
+		context.TODO(), apiClient, corev1.AddToScheme, allOptions...)
 }
 
 // ListInAllNamespaces returns a cluster-wide pod inventory.
 func ListInAllNamespaces(apiClient *clients.Settings, options ...metav1.ListOptions) ([]*Builder, error) {
-	logMessage := "Listing all pods in all namespaces"
-	passedOptions := metav1.ListOptions{}
-
-	if apiClient == nil {
-		klog.V(100).Info("The apiClient is empty")
-
-		return nil, fmt.Errorf("podList 'apiClient' cannot be empty")
-	}
-
-	if len(options) > 1 {
-		klog.V(100).Info("'options' parameter must be empty or single-valued")
-
-		return nil, fmt.Errorf("error: more than one ListOptions was passed")
-	}
-
-	if len(options) == 1 {
-		passedOptions = options[0]
-		logMessage += fmt.Sprintf(" with the options %v", passedOptions)
-	}
-
-	klog.V(100).Infof("%v", logMessage)
-
-	podList, err := apiClient.Pods("").List(logging.DiscardContext(), passedOptions)
+	convertedOptions, err := common.ConvertMetaListOptionsToListOptions(options)
 	if err != nil {
-		klog.V(100).Infof("Failed to list all pods due to %s", err.Error())
-
 		return nil, err
 	}
 
-	var podObjects []*Builder
-
-	for _, runningPod := range podList.Items {
-		copiedPod := runningPod
-		podBuilder := &Builder{
-			apiClient:  apiClient,
-			Object:     &copiedPod,
-			Definition: &copiedPod,
-		}
-
-		podObjects = append(podObjects, podBuilder)
-	}
-
-	return podObjects, nil
+	return common.List[corev1.Pod, corev1.PodList, Builder](
+		context.TODO(), apiClient, corev1.AddToScheme, convertedOptions...)
 }
 
 // ListByNamePattern returns pod inventory in the given namespace filtered by name pattern.
 func ListByNamePattern(apiClient *clients.Settings, namePattern, nsname string) ([]*Builder, error) {
 	klog.V(100).Infof("Listing pods in the nsname %s filtered by the name pattern %s", nsname, namePattern)
 
-	if apiClient == nil {
-		klog.V(100).Info("The apiClient is empty")
-
-		return nil, fmt.Errorf("podList 'apiClient' cannot be empty")
-	}
-
-	if nsname == "" {
-		klog.V(100).Info("pod 'nsname' parameter can not be empty")
-
-		return nil, fmt.Errorf("failed to list pods, 'nsname' parameter is empty")
-	}
-
-	podList, err := apiClient.Pods(nsname).List(logging.DiscardContext(), metav1.ListOptions{})
+	podBuilders, err := List(apiClient, nsname)
 	if err != nil {
-		klog.V(100).Infof("Failed to list pods filtered by the name pattern %s in the nsname %s due to %s",
-			namePattern, nsname, err.Error())
+		klog.V(100).Infof("Failed to list pods filtered by the name pattern %s in the nsname %s due to %v",
+			namePattern, nsname, err)
 
 		return nil, err
 	}
 
 	var podObjects []*Builder
 
-	for _, runningPod := range podList.Items {
-		if strings.Contains(runningPod.Name, namePattern) {
-			copiedPod := runningPod
-			podBuilder := &Builder{
-				apiClient:  apiClient,
-				Object:     &copiedPod,
-				Definition: &copiedPod,
-			}
-
+	for _, podBuilder := range podBuilders {
+		if strings.Contains(podBuilder.Definition.Name, namePattern) {
 			podObjects = append(podObjects, podBuilder)
 		}
 	}
@@ -160,6 +80,8 @@ func WaitForAllPodsInNamespaceRunning(
 	nsname string,
 	timeout time.Duration,
 	options ...metav1.ListOptions) (bool, error) {
+	nsname = apiClient.ResolveNamespace(nsname)
+
 	if apiClient == nil {
 		klog.V(100).Info("The apiClient is empty")
 
@@ -256,6 +178,148 @@ func WaitForPodsInNamespacesHealthy(
 		})
 }
 
+// WaitForAllPodsRunning waits up to timeout until every pod in nsname matching the label selector is running. Unlike
+// WaitForAllPodsInNamespaceRunning, it lists the pods once and then watches them rather than polling each pod, so it
+// stays cheap for namespaces with hundreds of pods. It succeeds immediately if no pods match, so use WaitForPodCount
+// first when the pods may not have been created yet.
+func WaitForAllPodsRunning(apiClient *clients.Settings, nsname, selector string, timeout time.Duration) error {
+	klog.V(100).Infof("Waiting for all pods in namespace %s matching selector %q to be running", nsname, selector)
+
+	return waitForPods(apiClient, nsname, selector, timeout, func(pods map[string]*corev1.Pod) bool {
+		for _, pod := range pods {
+			if pod.Status.Phase != corev1.PodRunning {
+				return false
+			}
+		}
+
+		return true
+	})
+}
+
+// WaitForPodCount waits up to timeout until exactly count pods in nsname match the label selector, not counting pods
+// that are being deleted. Like WaitForAllPodsRunning, it lists the pods once and then watches them.
+func WaitForPodCount(apiClient *clients.Settings, nsname, selector string, count int, timeout time.Duration) error {
+	if count < 0 {
+		klog.V(100).Infof("The pod count %d is negative", count)
+
+		return fmt.Errorf("pod 'count' cannot be negative, got %d", count)
+	}
+
+	klog.V(100).Infof("Waiting for %d pods in namespace %s matching selector %q", count, nsname, selector)
+
+	return waitForPods(apiClient, nsname, selector, timeout, func(pods map[string]*corev1.Pod) bool {
+		current := 0
+
+		for _, pod := range pods {
+			if pod.DeletionTimestamp == nil {
+				current++
+			}
+		}
+
+		return current == count
+	})
+}
+
+// waitForPods lists the pods in nsname matching selector and then watches them, keeping the listed pods up to date
+// with each event, until condition returns true for them or timeout is reached. The pods are only listed again if the
+// watch ends before then.
+func waitForPods(
+	apiClient *clients.Settings,
+	nsname, selector string,
+	timeout time.Duration,
+	condition func(pods map[string]*corev1.Pod) bool) error {
+	if apiClient == nil {
+		klog.V(100).Info("The apiClient is empty")
+
+		return fmt.Errorf("podList 'apiClient' cannot be empty")
+	}
+
+	if nsname == "" {
+		klog.V(100).Info("'nsname' parameter can not be empty")
+
+		return fmt.Errorf("failed to list pods, 'nsname' parameter is empty")
+	}
+
+	ctx, cancel := context.WithTimeout(context.TODO(), timeout)
+	defer cancel()
+
+	for {
+		podList, err := apiClient.Pods(nsname).List(ctx, metav1.ListOptions{LabelSelector: selector})
+		if err != nil {
+			return fmt.Errorf("failed to list pods in namespace %s: %w", nsname, err)
+		}
+
+		pods := make(map[string]*corev1.Pod, len(podList.Items))
+
+		for index := range podList.Items {
+			pods[podList.Items[index].Name] = &podList.Items[index]
+		}
+
+		if condition(pods) {
+			return nil
+		}
+
+		watcher, err := apiClient.Pods(nsname).Watch(ctx, metav1.ListOptions{
+			LabelSelector:   selector,
+			ResourceVersion: podList.ResourceVersion,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to watch pods in namespace %s: %w", nsname, err)
+		}
+
+		done, err := watchPods(ctx, watcher, pods, condition)
+
+		watcher.Stop()
+
+		if err != nil {
+			return fmt.Errorf("failed to wait for pods in namespace %s: %w", nsname, err)
+		}
+
+		if done {
+			return nil
+		}
+
+		klog.V(100).Infof("Watch of pods in namespace %s ended, listing them again", nsname)
+	}
+}
+
+// watchPods applies the events of watcher to pods until condition returns true for them, the watch ends, or ctx is
+// done. It returns false with no error if the watch ended, including when it failed with an error event.
+func watchPods(
+	ctx context.Context,
+	watcher watch.Interface,
+	pods map[string]*corev1.Pod,
+	condition func(pods map[string]*corev1.Pod) bool) (bool, error) {
+	for {
+		select {
+		case <-ctx.Done():
+			return false, ctx.Err()
+		case event, ok := <-watcher.ResultChan():
+			if !ok || event.Type == watch.Error {
+				return false, nil
+			}
+
+			pod, isPod := event.Object.(*corev1.Pod)
+			if !isPod {
+				continue
+			}
+
+			switch event.Type {
+			case watch.Added, watch.Modified:
+				pods[pod.Name] = pod
+			case watch.Deleted:
+				delete(pods, pod.Name)
+			default:
+				continue
+			}
+
+			if condition(pods) {
+				return true, nil
+			}
+		}
+	}
+}
+
 // listPodsInNamespaces lists pods only in the provided namespaces or all namespaces if the provided slice is empty. It
 // will not perform validation, passing arguments directly to ListInAllNamespaces or List.
 func listPodsInNamespaces(
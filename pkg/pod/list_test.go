@@ -7,12 +7,56 @@ import (
 	"time"
 
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	commonerrors "github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common/errors"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common/testhelper"
 	"github.com/stretchr/testify/assert"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	clienttesting "k8s.io/client-go/testing"
+	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
+func TestList(t *testing.T) {
+	t.Parallel()
+
+	testhelper.NewNamespacedListTestConfig(
+		func(apiClient *clients.Settings, nsname string, _ ...runtimeclient.ListOptions) ([]*Builder, error) {
+			return List(apiClient, nsname)
+		},
+		corev1.AddToScheme,
+		podGVK,
+	).ExecuteTests(t)
+}
+
+func TestListInAllNamespaces(t *testing.T) {
+	t.Parallel()
+
+	testhelper.NewListTestConfig(ListInAllNamespaces, corev1.AddToScheme, podGVK).ExecuteTests(t)
+}
+
+func TestListByNamePattern(t *testing.T) {
+	t.Parallel()
+
+	testSettings := clients.GetTestClients(clients.TestClientParams{
+		K8sMockObjects: []runtime.Object{
+			buildDummyPod(defaultPodName, defaultPodNsName, defaultPodImage),
+			buildDummyPod("other", defaultPodNsName, defaultPodImage),
+		},
+		SchemeAttachers: testSchemes,
+	})
+
+	podBuilders, err := ListByNamePattern(testSettings, "test", defaultPodNsName)
+	assert.NoError(t, err)
+	assert.Len(t, podBuilders, 1)
+	assert.Equal(t, defaultPodName, podBuilders[0].Definition.Name)
+
+	_, err = ListByNamePattern(testSettings, "test", "")
+	assert.True(t, commonerrors.IsBuilderNamespaceEmpty(err))
+}
+
 func TestWaitForPodsInNamespacesHealthy(t *testing.T) {
 	testCases := []struct {
 		namespaces    []string
@@ -84,7 +128,8 @@ func TestWaitForPodsInNamespacesHealthy(t *testing.T) {
 			}
 
 			testSettings = clients.GetTestClients(clients.TestClientParams{
-				K8sMockObjects: []runtime.Object{testPod},
+				K8sMockObjects:  []runtime.Object{testPod},
+				SchemeAttachers: testSchemes,
 			})
 		}
 
@@ -92,3 +137,88 @@ func TestWaitForPodsInNamespacesHealthy(t *testing.T) {
 		assert.Equal(t, testCase.expectedError, err)
 	}
 }
+
+func TestWaitForAllPodsRunning(t *testing.T) {
+	t.Parallel()
+
+	pendingPod := buildDummyPod(defaultPodName, defaultPodNsName, defaultPodImage)
+	pendingPod.Labels = map[string]string{"app": "test"}
+	runningPod := pendingPod.DeepCopy()
+	runningPod.Status.Phase = corev1.PodRunning
+
+	testSettings := buildTestClientWithPodEvents(
+		[]*corev1.Pod{pendingPod}, watch.Event{Type: watch.Modified, Object: runningPod})
+	assert.NoError(t, WaitForAllPodsRunning(testSettings, defaultPodNsName, "app=test", 5*time.Second))
+
+	testSettings = buildTestClientWithPodEvents([]*corev1.Pod{pendingPod})
+
+	// Pods not matching the selector are ignored.
+	assert.NoError(t, WaitForAllPodsRunning(testSettings, defaultPodNsName, "app=other", time.Second))
+
+	err := WaitForAllPodsRunning(testSettings, defaultPodNsName, "app=test", 100*time.Millisecond)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+	err = WaitForAllPodsRunning(nil, defaultPodNsName, "", time.Second)
+	assert.EqualError(t, err, "podList 'apiClient' cannot be empty")
+
+	err = WaitForAllPodsRunning(testSettings, "", "", time.Second)
+	assert.EqualError(t, err, "failed to list pods, 'nsname' parameter is empty")
+}
+
+func TestWaitForPodCount(t *testing.T) {
+	t.Parallel()
+
+	firstPod := buildDummyPod(defaultPodName, defaultPodNsName, defaultPodImage)
+	secondPod := buildDummyPod("second", defaultPodNsName, defaultPodImage)
+	thirdPod := buildDummyPod("third", defaultPodNsName, defaultPodImage)
+	deletingPod := secondPod.DeepCopy()
+	deletingPod.DeletionTimestamp = &metav1.Time{Time: time.Now()}
+
+	testSettings := buildTestClientWithPodEvents([]*corev1.Pod{firstPod},
+		watch.Event{Type: watch.Added, Object: secondPod},
+		watch.Event{Type: watch.Added, Object: thirdPod},
+		watch.Event{Type: watch.Modified, Object: deletingPod},
+		watch.Event{Type: watch.Deleted, Object: thirdPod},
+	)
+
+	// Only reached once the third pod is deleted, since the second pod being deleted is not counted.
+	assert.NoError(t, WaitForPodCount(testSettings, defaultPodNsName, "", 1, 5*time.Second))
+
+	testSettings = buildTestClientWithPodEvents([]*corev1.Pod{firstPod},
+		watch.Event{Type: watch.Added, Object: secondPod},
+		watch.Event{Type: watch.Added, Object: thirdPod},
+	)
+	assert.NoError(t, WaitForPodCount(testSettings, defaultPodNsName, "", 3, 5*time.Second))
+
+	testSettings = buildTestClientWithPodEvents([]*corev1.Pod{firstPod})
+
+	err := WaitForPodCount(testSettings, defaultPodNsName, "", 2, 100*time.Millisecond)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+
+	err = WaitForPodCount(testSettings, defaultPodNsName, "", -1, time.Second)
+	assert.EqualError(t, err, "pod 'count' cannot be negative, got -1")
+}
+
+// buildTestClientWithPodEvents returns a client with the provided pods whose pod watches send the provided events.
+func buildTestClientWithPodEvents(pods []*corev1.Pod, events ...watch.Event) *clients.Settings {
+	var objects []runtime.Object
+
+	for _, pod := range pods {
+		objects = append(objects, pod)
+	}
+
+	testSettings := clients.GetTestClients(clients.TestClientParams{K8sMockObjects: objects})
+
+	fakeClientset, _ := testSettings.K8sClient.(*k8sfake.Clientset)
+	fakeClientset.PrependWatchReactor("pods", func(clienttesting.Action) (bool, watch.Interface, error) {
+		fakeWatcher := watch.NewRaceFreeFake()
+
+		for _, event := range events {
+			fakeWatcher.Action(event.Type, event.Object)
+		}
+
+		return true, fakeWatcher, nil
+	})
+
+	return testSettings
+}
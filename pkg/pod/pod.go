@@ -18,19 +18,22 @@ import (
 	multus "gopkg.in/k8snetworkplumbingwg/multus-cni.v4/pkg/types"
 	corev1 "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/httpstream"
 	"k8s.io/apimachinery/pkg/util/httpstream/spdy"
+	utilnet "k8s.io/apimachinery/pkg/util/net"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/remotecommand"
 	"k8s.io/klog/v2"
 	"k8s.io/utils/ptr"
+	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common"
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/logging"
-	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/msg"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/podspec"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/progress"
 )
 
 const (
@@ -42,7 +45,6 @@ const (
 	defaultResponseHeaderTimeout = 30 * time.Second
 	// defaultIdleConnTimeout is the maximum time an idle connection can remain in the pool.
 	defaultIdleConnTimeout           = 90 * time.Second
-	errEmptyNamespace                = "pod 'namespace' cannot be empty"
 	defaultShellBinBash              = "/bin/bash"
 	taintEffectNoSchedule            = "NoSchedule"
 	nodeRoleKubernetesIoControlPlane = "node-role.kubernetes.io/control-plane"
@@ -51,19 +53,31 @@ const (
 
 // Builder provides a struct for pod object from the cluster and a pod definition.
 type Builder struct {
-	// Pod definition, used to create the pod object.
-	Definition *corev1.Pod
-	// Created pod object.
-	Object *corev1.Pod
-	// Used to store latest error message upon defining or mutating pod definition.
-	errorMsg string
-	// api client to interact with the cluster.
-	apiClient *clients.Settings
+	common.EmbeddableBuilder[corev1.Pod, *corev1.Pod]
+	common.EmbeddableCreator[corev1.Pod, Builder, *corev1.Pod, *Builder]
+	common.EmbeddableDeleteReturner[corev1.Pod, Builder, *corev1.Pod, *Builder]
+	common.EmbeddableWithOptions[corev1.Pod, Builder, *corev1.Pod, *Builder, AdditionalOptions]
+	common.EmbeddableConditionGetter[corev1.PodCondition, corev1.Pod, *corev1.Pod]
+	common.EmbeddablePhaseGetter[corev1.Pod, *corev1.Pod]
 }
 
 // AdditionalOptions additional options for pod object.
 type AdditionalOptions func(builder *Builder) (*Builder, error)
 
+// AttachMixins wires the embedded CRUD mixins to this builder instance.
+func (builder *Builder) AttachMixins() {
+	builder.EmbeddableCreator.SetBase(builder)
+	builder.EmbeddableDeleteReturner.SetBase(builder)
+	builder.EmbeddableWithOptions.SetBase(builder)
+	builder.EmbeddableConditionGetter.SetBase(builder)
+	builder.EmbeddablePhaseGetter.SetBase(builder)
+}
+
+// GetGVK returns the Pod GVK for this builder.
+func (builder *Builder) GetGVK() schema.GroupVersionKind {
+	return corev1.SchemeGroupVersion.WithKind("Pod")
+}
+
 // NewBuilder creates a new instance of Builder.
 func NewBuilder(apiClient *clients.Settings, name, nsname, image string) *Builder {
 	klog.V(100).Infof(
@@ -71,37 +85,15 @@ func NewBuilder(apiClient *clients.Settings, name, nsname, image string) *Builde
 			"name: %s, namespace: %s, image: %s",
 		name, nsname, image)
 
-	if apiClient == nil {
-		klog.V(100).Info("The apiClient is empty, pod 'apiClient' cannot be empty")
-
-		return nil
-	}
-
-	builder := &Builder{
-		apiClient:  apiClient,
-		Definition: getDefinition(name, nsname),
-	}
-
-	if name == "" {
-		klog.V(100).Info("The name of the pod is empty")
-
-		builder.errorMsg = "pod 'name' cannot be empty"
-
-		return builder
-	}
-
-	if nsname == "" {
-		klog.V(100).Info("The namespace of the pod is empty")
-
-		builder.errorMsg = errEmptyNamespace
-
+	builder := common.NewNamespacedBuilder[corev1.Pod, Builder](apiClient, corev1.AddToScheme, name, nsname)
+	if builder.GetError() != nil {
 		return builder
 	}
 
 	if image == "" {
 		klog.V(100).Info("The image of the pod is empty")
 
-		builder.errorMsg = "pod 'image' cannot be empty"
+		builder.SetError(fmt.Errorf("pod 'image' cannot be empty"))
 
 		return builder
 	}
@@ -110,7 +102,7 @@ func NewBuilder(apiClient *clients.Settings, name, nsname, image string) *Builde
 	if err != nil {
 		klog.V(100).Info("Failed to define the default container settings")
 
-		builder.errorMsg = err.Error()
+		builder.SetError(err)
 
 		return builder
 	}
@@ -122,51 +114,12 @@ func NewBuilder(apiClient *clients.Settings, name, nsname, image string) *Builde
 
 // Pull loads an existing pod into the Builder struct.
 func Pull(apiClient *clients.Settings, name, nsname string) (*Builder, error) {
-	klog.V(100).Infof("Pulling existing pod name: %s namespace:%s", name, nsname)
-
-	if apiClient == nil {
-		klog.V(100).Info("The apiClient is empty")
-
-		return nil, fmt.Errorf("pod 'apiClient' cannot be empty")
-	}
-
-	builder := &Builder{
-		apiClient: apiClient,
-		Definition: &corev1.Pod{
-			ObjectMeta: metav1.ObjectMeta{
-				Name:      name,
-				Namespace: nsname,
-			},
-		},
-	}
-
-	if name == "" {
-		klog.V(100).Info("The name of the pod is empty")
-
-		return nil, fmt.Errorf("pod 'name' cannot be empty")
-	}
-
-	if nsname == "" {
-		klog.V(100).Info("The namespace of the pod is empty")
-
-		return nil, fmt.Errorf(errEmptyNamespace)
-	}
-
-	if !builder.Exists() {
-		klog.V(100).Infof("Failed to pull pod object %s from namespace %s. Object does not exist",
-			name, nsname)
-
-		return nil, fmt.Errorf("pod object %s does not exist in namespace %s", name, nsname)
-	}
-
-	builder.Definition = builder.Object
-
-	return builder, nil
+	return common.PullNamespacedBuilder[corev1.Pod, Builder](context.TODO(), apiClient, corev1.AddToScheme, name, nsname)
 }
 
 // DefineOnNode adds nodeName to the pod's definition.
 func (builder *Builder) DefineOnNode(nodeName string) *Builder {
-	if valid, _ := builder.validate(); !valid {
+	if err := common.Validate(builder); err != nil {
 		return builder
 	}
 
@@ -178,7 +131,7 @@ func (builder *Builder) DefineOnNode(nodeName string) *Builder {
 	if nodeName == "" {
 		klog.V(100).Info("The node name is empty")
 
-		builder.errorMsg = "can not define pod on empty node"
+		builder.SetError(fmt.Errorf("can not define pod on empty node"))
 
 		return builder
 	}
@@ -188,57 +141,9 @@ func (builder *Builder) DefineOnNode(nodeName string) *Builder {
 	return builder
 }
 
-// Create makes a pod according to the pod definition and stores the created object in the pod builder.
-func (builder *Builder) Create() (*Builder, error) {
-	if valid, err := builder.validate(); !valid {
-		return builder, err
-	}
-
-	klog.V(100).Infof("Creating pod %s in namespace %s",
-		builder.Definition.Name, builder.Definition.Namespace)
-
-	var err error
-	if !builder.Exists() {
-		builder.Object, err = builder.apiClient.Pods(builder.Definition.Namespace).Create(
-			logging.DiscardContext(), builder.Definition, metav1.CreateOptions{})
-	}
-
-	return builder, err
-}
-
-// Delete removes the pod object and resets the builder object.
-func (builder *Builder) Delete() (*Builder, error) {
-	if valid, err := builder.validate(); !valid {
-		return builder, err
-	}
-
-	klog.V(100).Infof("Deleting pod %s in namespace %s",
-		builder.Definition.Name, builder.Definition.Namespace)
-
-	if !builder.Exists() {
-		klog.V(100).Infof(
-			"Pod %s in namespace %s cannot be deleted because it does not exist",
-			builder.Definition.Name, builder.Definition.Namespace)
-
-		builder.Object = nil
-
-		return builder, nil
-	}
-
-	err := builder.apiClient.Pods(builder.Definition.Namespace).Delete(
-		logging.DiscardContext(), builder.Object.Name, metav1.DeleteOptions{})
-	if err != nil {
-		return builder, fmt.Errorf("can not delete pod: %w", err)
-	}
-
-	builder.Object = nil
-
-	return builder, nil
-}
-
 // DeleteAndWait deletes the pod object and waits until the pod is deleted.
 func (builder *Builder) DeleteAndWait(timeout time.Duration) (*Builder, error) {
-	if valid, err := builder.validate(); !valid {
+	if err := common.Validate(builder); err != nil {
 		return builder, err
 	}
 
@@ -260,26 +165,15 @@ func (builder *Builder) DeleteAndWait(timeout time.Duration) (*Builder, error) {
 
 // DeleteImmediate removes the pod immediately and resets the builder object.
 func (builder *Builder) DeleteImmediate() (*Builder, error) {
-	if valid, err := builder.validate(); !valid {
+	if err := common.Validate(builder); err != nil {
 		return builder, err
 	}
 
 	klog.V(100).Infof("Immediately deleting pod %s in namespace %s",
 		builder.Definition.Name, builder.Definition.Namespace)
 
-	if !builder.Exists() {
-		klog.V(100).Infof(
-			"Pod %s in namespace %s cannot be deleted because it does not exist",
-			builder.Definition.Name, builder.Definition.Namespace)
-
-		builder.Object = nil
-
-		return builder, nil
-	}
-
-	err := builder.apiClient.Pods(builder.Definition.Namespace).Delete(
-		logging.DiscardContext(), builder.Object.Name, metav1.DeleteOptions{GracePeriodSeconds: ptr.To(int64(0))})
-	if err != nil {
+	err := builder.GetClient().Delete(logging.DiscardContext(), builder.Definition, runtimeclient.GracePeriodSeconds(0))
+	if err != nil && !k8serrors.IsNotFound(err) {
 		return builder, fmt.Errorf("can not immediately delete pod: %w", err)
 	}
 
@@ -290,7 +184,7 @@ func (builder *Builder) DeleteImmediate() (*Builder, error) {
 
 // CreateAndWaitUntilRunning creates the pod object and waits until the pod is running.
 func (builder *Builder) CreateAndWaitUntilRunning(timeout time.Duration) (*Builder, error) {
-	if valid, err := builder.validate(); !valid {
+	if err := common.Validate(builder); err != nil {
 		return builder, err
 	}
 
@@ -312,7 +206,7 @@ func (builder *Builder) CreateAndWaitUntilRunning(timeout time.Duration) (*Build
 
 // WaitUntilRunning waits for the duration of the defined timeout or until the pod is running.
 func (builder *Builder) WaitUntilRunning(timeout time.Duration) error {
-	if valid, err := builder.validate(); !valid {
+	if err := common.Validate(builder); err != nil {
 		return err
 	}
 
@@ -325,7 +219,7 @@ func (builder *Builder) WaitUntilRunning(timeout time.Duration) error {
 // IsHealthy returns true if and only if the pod has succeeded or is running and ready. All other cases, such as when
 // the pod does not exist or the builder is invalid, will return false.
 func (builder *Builder) IsHealthy() bool {
-	if valid, _ := builder.validate(); !valid {
+	if err := common.Validate(builder); err != nil {
 		return false
 	}
 
@@ -344,31 +238,42 @@ func (builder *Builder) IsHealthy() bool {
 
 // WaitUntilInStatus waits for the duration of the defined timeout or until the pod gets to a specific status.
 func (builder *Builder) WaitUntilInStatus(status corev1.PodPhase, timeout time.Duration) error {
-	if valid, err := builder.validate(); !valid {
+	return builder.WaitUntilInStatusWithContext(context.TODO(), status, timeout)
+}
+
+// WaitUntilInStatusWithContext waits for the duration of the defined timeout or until the pod gets to a specific
+// status. The phase of the pod is reported after every attempt to the progress.Reporter attached to ctx, if any.
+func (builder *Builder) WaitUntilInStatusWithContext(
+	ctx context.Context, status corev1.PodPhase, timeout time.Duration) error {
+	if err := common.Validate(builder); err != nil {
 		return err
 	}
 
 	klog.V(100).Infof("Waiting for the defined period until pod %s in namespace %s has status %v",
 		builder.Definition.Name, builder.Definition.Namespace, status)
 
-	return wait.PollUntilContextTimeout(context.TODO(),
-		time.Second, timeout, true, func(ctx context.Context) (bool, error) {
-			updatePod, err := builder.apiClient.Pods(builder.Definition.Namespace).
-				Get(logging.DiscardContext(), builder.Definition.Name, metav1.GetOptions{})
+	operation := fmt.Sprintf("pod %s/%s to have status %s", builder.Definition.Namespace, builder.Definition.Name, status)
+
+	return progress.PollUntilContextTimeout(ctx,
+		time.Second, timeout, true, operation, func(ctx context.Context) (bool, string, error) {
+			updatePod := &corev1.Pod{}
+
+			err := builder.GetClient().Get(
+				logging.WithDiscardLogger(ctx), runtimeclient.ObjectKeyFromObject(builder.Definition), updatePod)
 			if err != nil {
 				klog.V(100).Infof("Failed to get pod %s in namespace %s: %v",
 					builder.Definition.Name, builder.Definition.Namespace, err)
 
-				return false, nil
+				return false, "", nil
 			}
 
-			return updatePod.Status.Phase == status, nil
+			return updatePod.Status.Phase == status, string(updatePod.Status.Phase), nil
 		})
 }
 
 // WaitUntilDeleted waits for the duration of the defined timeout or until the pod is deleted.
 func (builder *Builder) WaitUntilDeleted(timeout time.Duration) error {
-	if valid, err := builder.validate(); !valid {
+	if err := common.Validate(builder); err != nil {
 		return err
 	}
 
@@ -377,8 +282,8 @@ func (builder *Builder) WaitUntilDeleted(timeout time.Duration) error {
 
 	err := wait.PollUntilContextTimeout(
 		context.TODO(), time.Second, timeout, false, func(ctx context.Context) (bool, error) {
-			_, err := builder.apiClient.Pods(builder.Definition.Namespace).Get(
-				logging.DiscardContext(), builder.Definition.Name, metav1.GetOptions{})
+			err := builder.GetClient().Get(
+				logging.DiscardContext(), runtimeclient.ObjectKeyFromObject(builder.Definition), &corev1.Pod{})
 			if err == nil {
 				klog.V(100).Infof("pod %s/%s still present", builder.Definition.Namespace, builder.Definition.Name)
 
@@ -401,7 +306,7 @@ func (builder *Builder) WaitUntilDeleted(timeout time.Duration) error {
 
 // WaitUntilReady waits for the duration of the defined timeout or until the pod reaches the Ready condition.
 func (builder *Builder) WaitUntilReady(timeout time.Duration) error {
-	if valid, err := builder.validate(); !valid {
+	if err := common.Validate(builder); err != nil {
 		return err
 	}
 
@@ -413,15 +318,26 @@ func (builder *Builder) WaitUntilReady(timeout time.Duration) error {
 
 // WaitUntilCondition waits for the duration of the defined timeout or until the pod gets to a specific condition.
 func (builder *Builder) WaitUntilCondition(condition corev1.PodConditionType, timeout time.Duration) error {
-	if valid, err := builder.validate(); !valid {
+	return builder.WaitUntilConditionWithContext(context.TODO(), condition, timeout)
+}
+
+// WaitUntilConditionWithContext waits for the duration of the defined timeout or until the pod gets to a specific
+// condition. The status of the condition is reported after every attempt to the progress.Reporter attached to ctx, if
+// any.
+func (builder *Builder) WaitUntilConditionWithContext(
+	ctx context.Context, condition corev1.PodConditionType, timeout time.Duration) error {
+	if err := common.Validate(builder); err != nil {
 		return err
 	}
 
 	klog.V(100).Infof("Waiting for the defined period until pod %s in namespace %s has condition %v",
 		builder.Definition.Name, builder.Definition.Namespace, condition)
 
-	return wait.PollUntilContextTimeout(
-		context.TODO(), time.Second, timeout, true, func(ctx context.Context) (bool, error) {
+	operation := fmt.Sprintf("pod %s/%s to have condition %s",
+		builder.Definition.Namespace, builder.Definition.Name, condition)
+
+	return progress.PollUntilContextTimeout(
+		ctx, time.Second, timeout, true, operation, func(ctx context.Context) (bool, string, error) {
 			// Add Discard logger to suppress verbose Kubernetes client logging.
 			// This preserves the behavior from logging.DiscardContext() while allowing
 			// us to add timeout and respect parent cancellation.
@@ -433,8 +349,9 @@ func (builder *Builder) WaitUntilCondition(condition corev1.PodConditionType, ti
 			getCtx, cancel := context.WithTimeout(ctx, 120*time.Second)
 			defer cancel()
 
-			updatePod, err := builder.apiClient.Pods(builder.Definition.Namespace).Get(
-				getCtx, builder.Definition.Name, metav1.GetOptions{})
+			updatePod := &corev1.Pod{}
+
+			err := builder.GetClient().Get(getCtx, runtimeclient.ObjectKeyFromObject(builder.Definition), updatePod)
 			if err != nil {
 				klog.V(100).Infof("Failed to get pod from cluster. Error is: '%s'", err.Error())
 
@@ -445,22 +362,22 @@ func (builder *Builder) WaitUntilCondition(condition corev1.PodConditionType, ti
 						builder.Definition.Name, builder.Definition.Namespace)
 				}
 
-				return false, nil
+				return false, "", nil
 			}
 
 			for _, cond := range updatePod.Status.Conditions {
-				if cond.Type == condition && cond.Status == corev1.ConditionTrue {
-					return true, nil
+				if cond.Type == condition {
+					return cond.Status == corev1.ConditionTrue, fmt.Sprintf("%s=%s", cond.Type, cond.Status), nil
 				}
 			}
 
-			return false, nil
+			return false, fmt.Sprintf("%s not reported", condition), nil
 		})
 }
 
 // ExecCommand runs command in the pod and returns the buffer output.
 func (builder *Builder) ExecCommand(command []string, containerName ...string) (bytes.Buffer, error) {
-	if valid, err := builder.validate(); !valid {
+	if err := common.Validate(builder); err != nil {
 		return bytes.Buffer{}, err
 	}
 
@@ -486,7 +403,17 @@ func (builder *Builder) ExecCommand(command []string, containerName ...string) (
 	klog.V(100).Infof("Execute command %v in the pod %s container %s in namespace %s",
 		command, builder.Object.Name, cName, builder.Object.Namespace)
 
-	req := builder.apiClient.CoreV1Interface.RESTClient().
+	apiClient, err := builder.getSettings()
+	if err != nil {
+		return bytes.Buffer{}, err
+	}
+
+	restConfig, err := apiClient.RESTConfig()
+	if err != nil {
+		return bytes.Buffer{}, err
+	}
+
+	req := apiClient.CoreV1Interface.RESTClient().
 		Post().
 		Namespace(builder.Object.Namespace).
 		Resource("pods").
@@ -500,7 +427,8 @@ func (builder *Builder) ExecCommand(command []string, containerName ...string) (
 			TTY:       true,
 		}, scheme.ParameterCodec)
 
-	exec, err := builder.getExecutorFromRequest(
+	exec, err := getExecutorFromRequest(
+		restConfig,
 		req,
 		defaultDialTimeout,
 		defaultTLSHandshakeTimeout,
@@ -535,7 +463,7 @@ func (builder *Builder) ExecCommandWithTimeout(
 	command []string,
 	timeout time.Duration,
 	containerName ...string) (bytes.Buffer, error) {
-	if valid, err := builder.validate(); !valid {
+	if err := common.Validate(builder); err != nil {
 		return bytes.Buffer{}, err
 	}
 
@@ -573,7 +501,17 @@ func (builder *Builder) ExecCommandWithTimeout(
 	klog.V(100).Infof("Execute command %v in the pod %s container %s in namespace %s with %s timeout",
 		command, builder.Object.Name, cName, builder.Object.Namespace, timeout.String())
 
-	req := builder.apiClient.CoreV1Interface.RESTClient().
+	apiClient, err := builder.getSettings()
+	if err != nil {
+		return bytes.Buffer{}, err
+	}
+
+	restConfig, err := apiClient.RESTConfig()
+	if err != nil {
+		return bytes.Buffer{}, err
+	}
+
+	req := apiClient.CoreV1Interface.RESTClient().
 		Post().
 		Namespace(builder.Object.Namespace).
 		Resource("pods").
@@ -587,7 +525,8 @@ func (builder *Builder) ExecCommandWithTimeout(
 			TTY:       true,
 		}, scheme.ParameterCodec)
 
-	exec, err := builder.getExecutorFromRequest(
+	exec, err := getExecutorFromRequest(
+		restConfig,
 		req,
 		defaultDialTimeout,
 		defaultTLSHandshakeTimeout,
@@ -615,7 +554,7 @@ func (builder *Builder) ExecCommandWithTimeout(
 // Copy returns the contents of a file or path from a specified container into a buffer.
 // Setting the tar option returns a tar archive of the specified path.
 func (builder *Builder) Copy(path, containerName string, tar bool) (bytes.Buffer, error) {
-	if valid, err := builder.validate(); !valid {
+	if err := common.Validate(builder); err != nil {
 		return bytes.Buffer{}, err
 	}
 
@@ -639,7 +578,17 @@ func (builder *Builder) Copy(path, containerName string, tar bool) (bytes.Buffer
 
 	var buffer bytes.Buffer
 
-	req := builder.apiClient.CoreV1Interface.RESTClient().
+	apiClient, err := builder.getSettings()
+	if err != nil {
+		return bytes.Buffer{}, err
+	}
+
+	restConfig, err := apiClient.RESTConfig()
+	if err != nil {
+		return bytes.Buffer{}, err
+	}
+
+	req := apiClient.CoreV1Interface.RESTClient().
 		Post().
 		Namespace(builder.Object.Namespace).
 		Resource("pods").
@@ -654,7 +603,8 @@ func (builder *Builder) Copy(path, containerName string, tar bool) (bytes.Buffer
 			TTY:       false,
 		}, scheme.ParameterCodec)
 
-	exec, err := builder.getExecutorFromRequest(
+	exec, err := getExecutorFromRequest(
+		restConfig,
 		req,
 		defaultDialTimeout,
 		defaultTLSHandshakeTimeout,
@@ -680,26 +630,9 @@ func (builder *Builder) Copy(path, containerName string, tar bool) (bytes.Buffer
 	return buffer, nil
 }
 
-// Exists checks whether the given pod exists.
-func (builder *Builder) Exists() bool {
-	if valid, _ := builder.validate(); !valid {
-		return false
-	}
-
-	klog.V(100).Infof("Checking if pod %s exists in namespace %s",
-		builder.Definition.Name, builder.Definition.Namespace)
-
-	var err error
-
-	builder.Object, err = builder.apiClient.Pods(builder.Definition.Namespace).Get(
-		logging.DiscardContext(), builder.Definition.Name, metav1.GetOptions{})
-
-	return err == nil || !k8serrors.IsNotFound(err)
-}
-
 // RedefineDefaultCMD redefines default command in pod's definition.
 func (builder *Builder) RedefineDefaultCMD(command []string) *Builder {
-	if valid, _ := builder.validate(); !valid {
+	if err := common.Validate(builder); err != nil {
 		return builder
 	}
 
@@ -707,7 +640,7 @@ func (builder *Builder) RedefineDefaultCMD(command []string) *Builder {
 
 	builder.isMutationAllowed("cmd")
 
-	if builder.errorMsg != "" {
+	if builder.GetError() != nil {
 		return builder
 	}
 
@@ -718,7 +651,7 @@ func (builder *Builder) RedefineDefaultCMD(command []string) *Builder {
 
 // WithRestartPolicy applies restart policy to pod's definition.
 func (builder *Builder) WithRestartPolicy(restartPolicy corev1.RestartPolicy) *Builder {
-	if valid, _ := builder.validate(); !valid {
+	if err := common.Validate(builder); err != nil {
 		return builder
 	}
 
@@ -731,7 +664,7 @@ func (builder *Builder) WithRestartPolicy(restartPolicy corev1.RestartPolicy) *B
 			"Failed to set RestartPolicy on pod %s in namespace %s. RestartPolicy can not be empty",
 			builder.Definition.Name, builder.Definition.Namespace)
 
-		builder.errorMsg = "can not define pod with empty restart policy"
+		builder.SetError(fmt.Errorf("can not define pod with empty restart policy"))
 
 		return builder
 	}
@@ -743,7 +676,7 @@ func (builder *Builder) WithRestartPolicy(restartPolicy corev1.RestartPolicy) *B
 
 // WithTolerationToMaster sets toleration policy which allows pod to be running on master node.
 func (builder *Builder) WithTolerationToMaster() *Builder {
-	if valid, _ := builder.validate(); !valid {
+	if err := common.Validate(builder); err != nil {
 		return builder
 	}
 
@@ -751,7 +684,7 @@ func (builder *Builder) WithTolerationToMaster() *Builder {
 
 	builder.isMutationAllowed("toleration to master node")
 
-	if builder.errorMsg != "" {
+	if builder.GetError() != nil {
 		return builder
 	}
 
@@ -767,7 +700,7 @@ func (builder *Builder) WithTolerationToMaster() *Builder {
 
 // WithTolerationToControlPlane sets toleration policy which allows pod to be running on control plane node.
 func (builder *Builder) WithTolerationToControlPlane() *Builder {
-	if valid, _ := builder.validate(); !valid {
+	if err := common.Validate(builder); err != nil {
 		return builder
 	}
 
@@ -775,7 +708,7 @@ func (builder *Builder) WithTolerationToControlPlane() *Builder {
 
 	builder.isMutationAllowed("toleration to control plane node")
 
-	if builder.errorMsg != "" {
+	if builder.GetError() != nil {
 		return builder
 	}
 
@@ -791,7 +724,7 @@ func (builder *Builder) WithTolerationToControlPlane() *Builder {
 
 // WithToleration adds a toleration configuration inside the pod.
 func (builder *Builder) WithToleration(toleration corev1.Toleration) *Builder {
-	if valid, _ := builder.validate(); !valid {
+	if err := common.Validate(builder); err != nil {
 		return builder
 	}
 
@@ -799,7 +732,7 @@ func (builder *Builder) WithToleration(toleration corev1.Toleration) *Builder {
 
 	builder.isMutationAllowed("custom toleration")
 
-	if builder.errorMsg != "" {
+	if builder.GetError() != nil {
 		return builder
 	}
 
@@ -808,9 +741,34 @@ func (builder *Builder) WithToleration(toleration corev1.Toleration) *Builder {
 	return builder
 }
 
+// WithTolerations appends the provided tolerations to the pod spec. None of the tolerations can be empty.
+func (builder *Builder) WithTolerations(tolerations ...corev1.Toleration) *Builder {
+	if err := common.Validate(builder); err != nil {
+		return builder
+	}
+
+	klog.V(100).Infof("Adding tolerations %v to pod %s in namespace %s",
+		tolerations, builder.Definition.Name, builder.Definition.Namespace)
+
+	builder.isMutationAllowed("tolerations")
+
+	if builder.GetError() != nil {
+		return builder
+	}
+
+	if err := podspec.WithTolerations(&builder.Definition.Spec, tolerations); err != nil {
+		klog.V(100).Infof("Failed to add tolerations to pod %s in namespace %s: %v",
+			builder.Definition.Name, builder.Definition.Namespace, err)
+
+		builder.SetError(err)
+	}
+
+	return builder
+}
+
 // WithNodeSelector adds a nodeSelector configuration inside the pod.
 func (builder *Builder) WithNodeSelector(nodeSelector map[string]string) *Builder {
-	if valid, _ := builder.validate(); !valid {
+	if err := common.Validate(builder); err != nil {
 		return builder
 	}
 
@@ -824,7 +782,7 @@ func (builder *Builder) WithNodeSelector(nodeSelector map[string]string) *Builde
 			"Failed to set nodeSelector on pod %s in namespace %s. nodeSelector can not be empty",
 			builder.Definition.Name, builder.Definition.Namespace)
 
-		builder.errorMsg = "can not define pod with empty nodeSelector"
+		builder.SetError(fmt.Errorf("can not define pod with empty nodeSelector"))
 
 		return builder
 	}
@@ -836,7 +794,7 @@ func (builder *Builder) WithNodeSelector(nodeSelector map[string]string) *Builde
 
 // WithPrivilegedFlag sets privileged flag on all containers.
 func (builder *Builder) WithPrivilegedFlag() *Builder {
-	if valid, _ := builder.validate(); !valid {
+	if err := common.Validate(builder); err != nil {
 		return builder
 	}
 
@@ -844,7 +802,7 @@ func (builder *Builder) WithPrivilegedFlag() *Builder {
 
 	builder.isMutationAllowed("privileged container flag")
 
-	if builder.errorMsg != "" {
+	if builder.GetError() != nil {
 		return builder
 	}
 
@@ -859,14 +817,14 @@ func (builder *Builder) WithPrivilegedFlag() *Builder {
 
 // WithVolume attaches given volume to a pod.
 func (builder *Builder) WithVolume(volume corev1.Volume) *Builder {
-	if valid, _ := builder.validate(); !valid {
+	if err := common.Validate(builder); err != nil {
 		return builder
 	}
 
 	if volume.Name == "" {
 		klog.V(100).Info("The volume's Name cannot be empty")
 
-		builder.errorMsg = "the volume's name cannot be empty"
+		builder.SetError(fmt.Errorf("the volume's name cannot be empty"))
 
 		return builder
 	}
@@ -879,68 +837,29 @@ func (builder *Builder) WithVolume(volume corev1.Volume) *Builder {
 	return builder
 }
 
-// WithLocalVolume attaches given volume to all pod's containers.
+// WithLocalVolume attaches the configMap with the same name as the volume to all pod's containers. It is equivalent
+// to WithConfigMapVolume(volumeName, volumeName, mountPath).
 func (builder *Builder) WithLocalVolume(volumeName, mountPath string) *Builder {
-	if valid, _ := builder.validate(); !valid {
+	if err := common.Validate(builder); err != nil {
 		return builder
 	}
 
 	klog.V(100).Infof("Configuring volume %s for all pod's: %s containers. MountPath %s",
 		volumeName, builder.Definition.Name, mountPath)
 
-	builder.isMutationAllowed("LocalVolume")
-
-	if volumeName == "" {
-		klog.V(100).Info("The 'volumeName' of the pod is empty")
-
-		builder.errorMsg = "'volumeName' parameter is empty"
-
-		return builder
-	}
-
-	if mountPath == "" {
-		klog.V(100).Info("The 'mountPath' of the pod is empty")
-
-		builder.errorMsg = "'mountPath' parameter is empty"
-
-		return builder
-	}
-
-	mountConfig := corev1.VolumeMount{Name: volumeName, MountPath: mountPath, ReadOnly: false}
-
-	builder.isMountAlreadyInUseInPod(mountConfig)
-
-	if builder.errorMsg != "" {
-		return builder
-	}
-
-	for index := range builder.Definition.Spec.Containers {
-		builder.Definition.Spec.Containers[index].VolumeMounts = append(
-			builder.Definition.Spec.Containers[index].VolumeMounts, mountConfig)
-	}
-
-	if len(builder.Definition.Spec.InitContainers) > 0 {
-		for index := range builder.Definition.Spec.InitContainers {
-			builder.Definition.Spec.InitContainers[index].VolumeMounts = append(
-				builder.Definition.Spec.InitContainers[index].VolumeMounts, mountConfig)
-		}
-	}
-
-	builder.Definition.Spec.Volumes = append(builder.Definition.Spec.Volumes,
-		corev1.Volume{Name: mountConfig.Name, VolumeSource: corev1.VolumeSource{
+	return builder.withVolumeAndMount(corev1.Volume{
+		Name: volumeName,
+		VolumeSource: corev1.VolumeSource{
 			ConfigMap: &corev1.ConfigMapVolumeSource{
-				LocalObjectReference: corev1.LocalObjectReference{
-					Name: mountConfig.Name,
-				},
+				LocalObjectReference: corev1.LocalObjectReference{Name: volumeName},
 			},
-		}})
-
-	return builder
+		},
+	}, mountPath, false)
 }
 
 // WithAdditionalContainer appends additional container to pod.
 func (builder *Builder) WithAdditionalContainer(container *corev1.Container) *Builder {
-	if valid, _ := builder.validate(); !valid {
+	if err := common.Validate(builder); err != nil {
 		return builder
 	}
 
@@ -948,7 +867,7 @@ func (builder *Builder) WithAdditionalContainer(container *corev1.Container) *Bu
 	builder.isMutationAllowed("additional container")
 
 	if container == nil {
-		builder.errorMsg = "'container' parameter cannot be empty"
+		builder.SetError(fmt.Errorf("'container' parameter cannot be empty"))
 
 		return builder
 	}
@@ -960,7 +879,7 @@ func (builder *Builder) WithAdditionalContainer(container *corev1.Container) *Bu
 
 // WithAdditionalInitContainer appends additional init container to pod.
 func (builder *Builder) WithAdditionalInitContainer(container *corev1.Container) *Builder {
-	if valid, _ := builder.validate(); !valid {
+	if err := common.Validate(builder); err != nil {
 		return builder
 	}
 
@@ -971,7 +890,7 @@ func (builder *Builder) WithAdditionalInitContainer(container *corev1.Container)
 	if container == nil {
 		klog.V(100).Info("The 'container' parameter of the pod is empty")
 
-		builder.errorMsg = "'container' parameter cannot be empty"
+		builder.SetError(fmt.Errorf("'container' parameter cannot be empty"))
 
 		return builder
 	}
@@ -983,7 +902,7 @@ func (builder *Builder) WithAdditionalInitContainer(container *corev1.Container)
 
 // WithSecondaryNetwork applies Multus secondary network on pod definition.
 func (builder *Builder) WithSecondaryNetwork(network []*multus.NetworkSelectionElement) *Builder {
-	if valid, _ := builder.validate(); !valid {
+	if err := common.Validate(builder); err != nil {
 		return builder
 	}
 
@@ -991,13 +910,13 @@ func (builder *Builder) WithSecondaryNetwork(network []*multus.NetworkSelectionE
 
 	builder.isMutationAllowed("secondary network")
 
-	if builder.errorMsg != "" {
+	if builder.GetError() != nil {
 		return builder
 	}
 
 	netAnnotation, err := json.Marshal(network)
 	if err != nil {
-		builder.errorMsg = fmt.Sprintf("error to unmarshal network annotation due to: %s", err.Error())
+		builder.SetError(fmt.Errorf("error to unmarshal network annotation due to: %s", err.Error()))
 
 		return builder
 	}
@@ -1009,7 +928,7 @@ func (builder *Builder) WithSecondaryNetwork(network []*multus.NetworkSelectionE
 
 // WithHostNetwork applies HostNetwork to pod's definition.
 func (builder *Builder) WithHostNetwork() *Builder {
-	if valid, _ := builder.validate(); !valid {
+	if err := common.Validate(builder); err != nil {
 		return builder
 	}
 
@@ -1017,7 +936,7 @@ func (builder *Builder) WithHostNetwork() *Builder {
 
 	builder.isMutationAllowed("HostNetwork")
 
-	if builder.errorMsg != "" {
+	if builder.GetError() != nil {
 		return builder
 	}
 
@@ -1026,9 +945,34 @@ func (builder *Builder) WithHostNetwork() *Builder {
 	return builder
 }
 
+// WithRuntimeClass sets the runtimeClassName of the pod spec, such as the one created by a performance profile.
+func (builder *Builder) WithRuntimeClass(runtimeClassName string) *Builder {
+	if err := common.Validate(builder); err != nil {
+		return builder
+	}
+
+	klog.V(100).Infof("Setting runtimeClassName %s on pod %s in namespace %s",
+		runtimeClassName, builder.Definition.Name, builder.Definition.Namespace)
+
+	builder.isMutationAllowed("runtimeClassName")
+
+	if builder.GetError() != nil {
+		return builder
+	}
+
+	if err := podspec.WithRuntimeClass(&builder.Definition.Spec, runtimeClassName); err != nil {
+		klog.V(100).Infof("Failed to set runtimeClassName on pod %s in namespace %s: %v",
+			builder.Definition.Name, builder.Definition.Namespace, err)
+
+		builder.SetError(err)
+	}
+
+	return builder
+}
+
 // WithHostPid configures a pod's access to the host process ID namespace based on a boolean parameter.
 func (builder *Builder) WithHostPid(hostPid bool) *Builder {
-	if valid, _ := builder.validate(); !valid {
+	if err := common.Validate(builder); err != nil {
 		return builder
 	}
 
@@ -1037,7 +981,7 @@ func (builder *Builder) WithHostPid(hostPid bool) *Builder {
 
 	builder.isMutationAllowed("HostPID")
 
-	if builder.errorMsg != "" {
+	if builder.GetError() != nil {
 		return builder
 	}
 
@@ -1048,7 +992,7 @@ func (builder *Builder) WithHostPid(hostPid bool) *Builder {
 
 // RedefineDefaultContainer redefines default container with the new one.
 func (builder *Builder) RedefineDefaultContainer(container corev1.Container) *Builder {
-	if valid, _ := builder.validate(); !valid {
+	if err := common.Validate(builder); err != nil {
 		return builder
 	}
 
@@ -1064,7 +1008,7 @@ func (builder *Builder) RedefineDefaultContainer(container corev1.Container) *Bu
 
 // WithHugePages sets hugePages on all containers inside the pod.
 func (builder *Builder) WithHugePages() *Builder {
-	if valid, _ := builder.validate(); !valid {
+	if err := common.Validate(builder); err != nil {
 		return builder
 	}
 
@@ -1103,7 +1047,7 @@ func (builder *Builder) WithHugePages() *Builder {
 
 // WithSecurityContext sets SecurityContext on pod definition.
 func (builder *Builder) WithSecurityContext(securityContext *corev1.PodSecurityContext) *Builder {
-	if valid, _ := builder.validate(); !valid {
+	if err := common.Validate(builder); err != nil {
 		return builder
 	}
 
@@ -1113,7 +1057,7 @@ func (builder *Builder) WithSecurityContext(securityContext *corev1.PodSecurityC
 	if securityContext == nil {
 		klog.V(100).Info("The 'securityContext' of the pod is empty")
 
-		builder.errorMsg = "'securityContext' parameter is empty"
+		builder.SetError(fmt.Errorf("'securityContext' parameter is empty"))
 
 		return builder
 	}
@@ -1127,7 +1071,7 @@ func (builder *Builder) WithSecurityContext(securityContext *corev1.PodSecurityC
 
 // PullImage pulls image for given pod's container and removes it.
 func (builder *Builder) PullImage(timeout time.Duration, testCmd []string) error {
-	if valid, err := builder.validate(); !valid {
+	if err := common.Validate(builder); err != nil {
 		return err
 	}
 
@@ -1175,7 +1119,7 @@ func (builder *Builder) PullImage(timeout time.Duration, testCmd []string) error
 
 // WithLabel applies label to pod's definition.
 func (builder *Builder) WithLabel(labelKey, labelValue string) *Builder {
-	if valid, _ := builder.validate(); !valid {
+	if err := common.Validate(builder); err != nil {
 		return builder
 	}
 
@@ -1184,7 +1128,13 @@ func (builder *Builder) WithLabel(labelKey, labelValue string) *Builder {
 	builder.isMutationAllowed("Labels")
 
 	if labelKey == "" {
-		builder.errorMsg = "can not apply empty labelKey"
+		builder.SetError(fmt.Errorf("can not apply empty labelKey"))
+
+		return builder
+	}
+
+	if err := common.ValidateLabel(labelKey, labelValue); err != nil {
+		builder.SetError(err)
 
 		return builder
 	}
@@ -1196,56 +1146,38 @@ func (builder *Builder) WithLabel(labelKey, labelValue string) *Builder {
 
 // WithLabels applies a set of labels to a Pod's definition.
 func (builder *Builder) WithLabels(labels map[string]string) *Builder {
-	if valid, _ := builder.validate(); !valid {
+	if err := common.Validate(builder); err != nil {
 		return builder
 	}
 
 	if len(labels) == 0 {
-		builder.errorMsg = "can not apply empty set of labels to pod's definition"
+		builder.SetError(fmt.Errorf("can not apply empty set of labels to pod's definition"))
 
 		return builder
 	}
 
 	builder.isMutationAllowed("Labels")
 
-	if builder.errorMsg != "" {
+	if builder.GetError() != nil {
 		return builder
 	}
 
-	klog.V(100).Infof("%v", fmt.Sprintf("Defining pod labels: %q", labels))
-
-	builder.Definition.Labels = labels
-
-	return builder
-}
+	if err := common.ValidateLabels(labels); err != nil {
+		builder.SetError(err)
 
-// WithOptions creates pod with generic mutation options.
-func (builder *Builder) WithOptions(options ...AdditionalOptions) *Builder {
-	if valid, _ := builder.validate(); !valid {
 		return builder
 	}
 
-	klog.V(100).Info("Setting pod additional options")
-
-	for _, option := range options {
-		if option != nil {
-			builder, err := option(builder)
-			if err != nil {
-				klog.V(100).Info("Error occurred in mutation function")
-
-				builder.errorMsg = err.Error()
+	klog.V(100).Infof("%v", fmt.Sprintf("Defining pod labels: %q", labels))
 
-				return builder
-			}
-		}
-	}
+	builder.Definition.Labels = labels
 
 	return builder
 }
 
 // WithTerminationGracePeriodSeconds configures TerminationGracePeriodSeconds on the pod.
 func (builder *Builder) WithTerminationGracePeriodSeconds(terminationGracePeriodSeconds int64) *Builder {
-	if valid, _ := builder.validate(); !valid {
+	if err := common.Validate(builder); err != nil {
 		return builder
 	}
 
@@ -1254,7 +1186,7 @@ func (builder *Builder) WithTerminationGracePeriodSeconds(terminationGracePeriod
 
 	builder.isMutationAllowed("terminationGracePeriodSeconds")
 
-	if builder.errorMsg != "" {
+	if builder.GetError() != nil {
 		return builder
 	}
 
@@ -1291,11 +1223,16 @@ func (builder *Builder) GetFullLog(containerName string) (string, error) {
 // GetLogsWithOptions retrieves logs from a pod using the provided options. No validation is performed on the provided
 // options. The options may be nil.
 func (builder *Builder) GetLogsWithOptions(options *corev1.PodLogOptions) ([]byte, error) {
-	if valid, err := builder.validate(); !valid {
+	if err := common.Validate(builder); err != nil {
 		return nil, err
 	}
 
-	logReader, err := builder.apiClient.Pods(builder.Definition.Namespace).
+	apiClient, err := builder.getSettings()
+	if err != nil {
+		return nil, err
+	}
+
+	logReader, err := apiClient.Pods(builder.Definition.Namespace).
 		GetLogs(builder.Definition.Name, options).
 		Stream(logging.DiscardContext())
 	if err != nil {
@@ -1317,49 +1254,31 @@ func GetGVR() schema.GroupVersionResource {
 	return schema.GroupVersionResource{Group: "", Version: "v1", Resource: "pods"}
 }
 
-// getExecutorFromRequest returns a new Executor using the builder's apiClient and the request,
+// getExecutorFromRequest returns a new Executor using the provided REST config and the request,
 // with configurable timeout parameters for connection establishment, TLS handshake, and response headers.
 // It attempts to first use the websocket executor then falls back to using the SPDY executor with pings disabled.
 // This should maximize reliability by avoiding issues like kubernetes/kubernetes#60140 and kubernetes/kubernetes#124571.
 //
 // Parameters:
+//   - restConfig: the REST config of the cluster the request is sent to
 //   - req: the REST request to execute
 //   - dialTimeout: maximum time to wait for TCP connection establishment
 //   - tlsTimeout: maximum time to wait for TLS handshake completion
 //   - responseTimeout: maximum time to wait for server response headers
 //
 //nolint:ireturn,nolintlint // remotecommand only returns interfaces, so we must too.
-func (builder *Builder) getExecutorFromRequest(
+func getExecutorFromRequest(
+	restConfig *rest.Config,
 	req *rest.Request,
 	dialTimeout time.Duration,
 	tlsTimeout time.Duration,
 	responseTimeout time.Duration,
 ) (remotecommand.Executor, error) {
-	tlsConfig, err := rest.TLSConfigFor(builder.apiClient.Config)
+	httpTransport, err := newStreamingTransport(restConfig, dialTimeout, tlsTimeout, responseTimeout)
 	if err != nil {
 		return nil, err
 	}
 
-	proxy := http.ProxyFromEnvironment
-	if builder.apiClient.Config.Proxy != nil {
-		proxy = builder.apiClient.Config.Proxy
-	}
-
-	// Create HTTP transport with timeout configurations
-	// This ensures connection, TLS handshake, and response header timeouts are enforced
-	httpTransport := &http.Transport{
-		Proxy:                 proxy,
-		TLSClientConfig:       tlsConfig,
-		TLSHandshakeTimeout:   tlsTimeout,
-		ResponseHeaderTimeout: responseTimeout,
-		IdleConnTimeout:       defaultIdleConnTimeout,
-		ExpectContinueTimeout: 1 * time.Second,
-		DialContext: (&net.Dialer{
-			Timeout:   dialTimeout,
-			KeepAlive: 30 * time.Second,
-		}).DialContext,
-	}
-
 	// More verbose setup of remotecommand executor required in order to tweak PingPeriod and configure timeouts.
 	// By default many large files are not copied in their entirety without disabling PingPeriod during the copy.
 	// https://github.com/kubernetes/kubernetes/issues/60140#issuecomment-1411477275
@@ -1374,7 +1293,7 @@ func (builder *Builder) getExecutorFromRequest(
 		return nil, err
 	}
 
-	wrapper, err := rest.HTTPWrappersForConfig(builder.apiClient.Config, upgradeRoundTripper)
+	wrapper, err := rest.HTTPWrappersForConfig(restConfig, upgradeRoundTripper)
 	if err != nil {
 		return nil, err
 	}
@@ -1384,7 +1303,7 @@ func (builder *Builder) getExecutorFromRequest(
 		return nil, fmt.Errorf("failed to create new SPDY executor: %w", err)
 	}
 
-	webSocketExec, err := remotecommand.NewWebSocketExecutor(builder.apiClient.Config, "GET", req.URL().String())
+	webSocketExec, err := remotecommand.NewWebSocketExecutor(restConfig, "GET", req.URL().String())
 	if err != nil {
 		return nil, fmt.Errorf("failed to create new WebSocket executor: %w", err)
 	}
@@ -1399,34 +1318,61 @@ func (builder *Builder) getExecutorFromRequest(
 	return exec, nil
 }
 
-func getDefinition(name, nsName string) *corev1.Pod {
-	return &corev1.Pod{
-		ObjectMeta: metav1.ObjectMeta{
-			Name:      name,
-			Namespace: nsName},
+// newStreamingTransport returns the HTTP transport used to upgrade exec, copy and port-forward connections, with the
+// provided timeouts. Since it does not go through the client-go transport cache, it explicitly honors the settings of
+// the REST config that affect how the API server is reached: the proxy, falling back to the proxy environment
+// variables with CIDR support in NO_PROXY as client-go does, the custom dialer, and the TLS settings including any
+// custom CA bundle.
+func newStreamingTransport(
+	restConfig *rest.Config, dialTimeout, tlsTimeout, responseTimeout time.Duration) (*http.Transport, error) {
+	tlsConfig, err := rest.TLSConfigFor(restConfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get TLS config for %s: %w", restConfig.Host, err)
+	}
+
+	proxy := restConfig.Proxy
+	if proxy == nil {
+		proxy = utilnet.NewProxierWithNoProxyCIDR(http.ProxyFromEnvironment)
+	}
+
+	dial := restConfig.Dial
+	if dial == nil {
+		dial = (&net.Dialer{
+			Timeout:   dialTimeout,
+			KeepAlive: 30 * time.Second,
+		}).DialContext
 	}
+
+	// These timeouts ensure connection, TLS handshake, and response header timeouts are enforced.
+	return &http.Transport{
+		Proxy:                 proxy,
+		TLSClientConfig:       tlsConfig,
+		TLSHandshakeTimeout:   tlsTimeout,
+		ResponseHeaderTimeout: responseTimeout,
+		IdleConnTimeout:       defaultIdleConnTimeout,
+		ExpectContinueTimeout: 1 * time.Second,
+		DialContext:           dial,
+	}, nil
 }
 
 func (builder *Builder) isMutationAllowed(configToMutate string) {
-	_, _ = builder.validate()
-
 	if builder.Object != nil {
 		klog.V(100).Infof(
 			"Failed to redefine %s for running pod %s in namespace %s",
 			builder.Definition.Name, configToMutate, builder.Definition.Namespace)
 
-		builder.errorMsg = fmt.Sprintf(
-			"can not redefine running pod. pod already running on node %s", builder.Object.Spec.NodeName)
+		builder.SetError(fmt.Errorf(
+			"can not redefine running pod. pod already running on node %s", builder.Object.Spec.NodeName))
 	}
 }
 
 func (builder *Builder) isMountAlreadyInUseInPod(newMount corev1.VolumeMount) {
-	if valid, _ := builder.validate(); valid {
+	if err := common.Validate(builder); err == nil {
 		for index := range builder.Definition.Spec.Containers {
 			if builder.Definition.Spec.Containers[index].VolumeMounts != nil {
 				if isMountInUse(builder.Definition.Spec.Containers[index].VolumeMounts, newMount) {
-					builder.errorMsg = fmt.Sprintf("given mount %v already mounted to pod's container %s",
-						newMount.Name, builder.Definition.Spec.Containers[index].Name)
+					builder.SetError(fmt.Errorf("given mount %v already mounted to pod's container %s",
+						newMount.Name, builder.Definition.Spec.Containers[index].Name))
 				}
 			}
 		}
@@ -1469,34 +1415,16 @@ func isMountInUse(containerMounts []corev1.VolumeMount, newMount corev1.VolumeMo
 	return false
 }
 
-// validate will check that the builder and builder definition are properly initialized before
-// accessing any member fields.
-func (builder *Builder) validate() (bool, error) {
-	resourceCRD := "Pod"
-
-	if builder == nil {
-		klog.V(100).Infof("The %s builder is uninitialized", resourceCRD)
-
-		return false, fmt.Errorf("error: received nil %s builder", resourceCRD)
-	}
-
-	if builder.Definition == nil {
-		klog.V(100).Infof("The %s is undefined", resourceCRD)
-
-		return false, fmt.Errorf("%s", msg.UndefinedCrdObjectErrString(resourceCRD))
-	}
-
-	if builder.apiClient == nil {
-		klog.V(100).Infof("The %s builder apiclient is nil", resourceCRD)
-
-		return false, fmt.Errorf("%s builder cannot have nil apiClient", resourceCRD)
-	}
-
-	if builder.errorMsg != "" {
-		klog.V(100).Infof("The %s builder has error message: %s", resourceCRD, builder.errorMsg)
+// getSettings returns the clients.Settings the builder was created with. Exec, log streaming, and port forwarding are
+// not supported by the controller-runtime client so they require the typed clientset and REST config from the settings.
+func (builder *Builder) getSettings() (*clients.Settings, error) {
+	apiClient, ok := builder.GetClient().(*clients.Settings)
+	if !ok || apiClient == nil {
+		klog.V(100).Infof("The apiClient for pod %s in namespace %s is not a *clients.Settings",
+			builder.Definition.Name, builder.Definition.Namespace)
 
-		return false, fmt.Errorf("%s", builder.errorMsg)
+		return nil, fmt.Errorf("pod builder apiClient must be a *clients.Settings, got %T", builder.GetClient())
 	}
 
-	return true, nil
+	return apiClient, nil
 }
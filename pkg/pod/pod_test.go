@@ -3,15 +3,25 @@ package pod
 import (
 	"bytes"
 	"context"
+	"encoding/pem"
+	"errors"
 	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
 	"testing"
 	"time"
 
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common/testhelper"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/progress"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/rest"
 )
 
 const (
@@ -23,148 +33,84 @@ const (
 	defaultMountPath   = "/test"
 )
 
-var podRunningErrorMsg = fmt.Sprintf("can not redefine running pod. pod already running on node %s", defaultPodNodeName)
+// errInvalidPodBuilder is the error returned when validating a builder from buildInvalidPodTestBuilder.
+const errInvalidPodBuilder = "failed to validate: namespace of the builder for Pod test-pod is empty"
+
+var (
+	podGVK             = corev1.SchemeGroupVersion.WithKind("Pod")
+	testSchemes        = []clients.SchemeAttacher{corev1.AddToScheme}
+	podRunningErrorMsg = fmt.Sprintf("can not redefine running pod. pod already running on node %s", defaultPodNodeName)
+)
 
 func TestPodNewBuilder(t *testing.T) {
+	t.Parallel()
+
+	t.Run("common namespaced builder behavior", func(t *testing.T) {
+		t.Parallel()
+
+		testhelper.NewNamespacedBuilderTestConfig(
+			func(apiClient *clients.Settings, name, nsname string) *Builder {
+				return NewBuilder(apiClient, name, nsname, defaultPodImage)
+			},
+			corev1.AddToScheme,
+			podGVK,
+		).ExecuteTests(t)
+	})
+
 	testCases := []struct {
 		name          string
-		nsname        string
 		image         string
-		client        bool
 		expectedError string
 	}{
 		{
-			name:          defaultPodName,
-			nsname:        defaultPodNsName,
+			name:          "valid image sets default container",
 			image:         defaultPodImage,
-			client:        true,
 			expectedError: "",
 		},
 		{
-			name:          "",
-			nsname:        defaultPodNsName,
-			image:         defaultPodImage,
-			client:        true,
-			expectedError: "pod 'name' cannot be empty",
-		},
-		{
-			name:          defaultPodName,
-			nsname:        "",
-			image:         defaultPodImage,
-			client:        true,
-			expectedError: errEmptyNamespace,
-		},
-		{
-			name:          defaultPodName,
-			nsname:        defaultPodNsName,
+			name:          "empty image returns error",
 			image:         "",
-			client:        true,
 			expectedError: "pod 'image' cannot be empty",
 		},
-		{
-			name:          defaultPodName,
-			nsname:        defaultPodNsName,
-			image:         defaultPodImage,
-			client:        false,
-			expectedError: "",
-		},
 	}
 
 	for _, testCase := range testCases {
-		var testSettings *clients.Settings
-
-		if testCase.client {
-			testSettings = clients.GetTestClients(clients.TestClientParams{})
-		}
-
-		testBuilder := NewBuilder(testSettings, testCase.name, testCase.nsname, testCase.image)
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
 
-		if testCase.client {
-			assert.Equal(t, testCase.expectedError, testBuilder.errorMsg)
+			testBuilder := NewBuilder(
+				clients.GetTestClients(clients.TestClientParams{}), defaultPodName, defaultPodNsName, testCase.image)
+			assertBuilderError(t, testCase.expectedError, testBuilder)
 
 			if testCase.expectedError == "" {
-				assert.Equal(t, testCase.name, testBuilder.Definition.Name)
-				assert.Equal(t, testCase.nsname, testBuilder.Definition.Namespace)
-				assert.NotEmpty(t, testBuilder.Definition.Spec.Containers)
+				assert.Len(t, testBuilder.Definition.Spec.Containers, 1)
+				assert.Equal(t, testCase.image, testBuilder.Definition.Spec.Containers[0].Image)
 			}
-		} else {
-			assert.Nil(t, testBuilder)
-		}
+		})
 	}
 }
 
 func TestPodPull(t *testing.T) {
-	testCases := []struct {
-		name                string
-		nsname              string
-		addToRuntimeObjects bool
-		client              bool
-		expectedError       error
-	}{
-		{
-			name:                defaultPodName,
-			nsname:              defaultPodNsName,
-			addToRuntimeObjects: true,
-			client:              true,
-			expectedError:       nil,
-		},
-		{
-			name:                "",
-			nsname:              defaultPodNsName,
-			addToRuntimeObjects: true,
-			client:              true,
-			expectedError:       fmt.Errorf("pod 'name' cannot be empty"),
-		},
-		{
-			name:                defaultPodName,
-			nsname:              "",
-			addToRuntimeObjects: true,
-			client:              true,
-			expectedError:       fmt.Errorf(errEmptyNamespace),
-		},
-		{
-			name:                defaultPodName,
-			nsname:              defaultPodNsName,
-			addToRuntimeObjects: false,
-			client:              true,
-			expectedError:       fmt.Errorf("pod object %s does not exist in namespace %s", defaultPodName, defaultPodNsName),
-		},
-		{
-			name:                defaultPodName,
-			nsname:              defaultPodNsName,
-			addToRuntimeObjects: true,
-			client:              false,
-			expectedError:       fmt.Errorf("pod 'apiClient' cannot be empty"),
-		},
-	}
-
-	for _, testCase := range testCases {
-		var (
-			runtimeObjects []runtime.Object
-			testSettings   *clients.Settings
-		)
-
-		testPod := buildDummyPod(testCase.name, testCase.nsname, defaultPodImage)
-
-		if testCase.addToRuntimeObjects {
-			runtimeObjects = append(runtimeObjects, testPod)
-		}
-
-		if testCase.client {
-			testSettings = clients.GetTestClients(clients.TestClientParams{
-				K8sMockObjects: runtimeObjects,
-			})
-		}
+	t.Parallel()
 
-		testBuilder, err := Pull(testSettings, testCase.name, testCase.nsname)
-		assert.Equal(t, testCase.expectedError, err)
+	testhelper.NewNamespacedPullTestConfig(Pull, corev1.AddToScheme, podGVK).ExecuteTests(t)
+}
 
-		if testCase.expectedError == nil {
-			assert.Equal(t, testCase.name, testBuilder.Definition.Name)
-			assert.Equal(t, testCase.nsname, testBuilder.Definition.Namespace)
-			assert.NotEmpty(t, testBuilder.Definition.Spec.Containers)
-		}
-	}
+func TestPodMethods(t *testing.T) {
+	t.Parallel()
+
+	commonTestConfig := testhelper.NewCommonTestConfig[corev1.Pod, Builder](
+		corev1.AddToScheme,
+		podGVK,
+		testhelper.ResourceScopeNamespaced,
+	)
+
+	testhelper.NewTestSuite().
+		With(testhelper.NewGetTestConfig(commonTestConfig)).
+		With(testhelper.NewExistsTestConfig(commonTestConfig)).
+		With(testhelper.NewCreateTestConfig(commonTestConfig)).
+		With(testhelper.NewDeleteReturnerTestConfig(commonTestConfig)).
+		Run(t)
 }
 
 func TestPodDefineOnNode(t *testing.T) {
@@ -199,7 +145,7 @@ func TestPodDefineOnNode(t *testing.T) {
 		}
 
 		testBuilder = testBuilder.DefineOnNode(testCase.nodeName)
-		assert.Equal(t, testCase.expectedError, testBuilder.errorMsg)
+		assertBuilderError(t, testCase.expectedError, testBuilder)
 
 		if testCase.expectedError == "" {
 			assert.Equal(t, testCase.nodeName, testBuilder.Definition.Spec.NodeName)
@@ -207,43 +153,6 @@ func TestPodDefineOnNode(t *testing.T) {
 	}
 }
 
-func TestPodCreate(t *testing.T) {
-	testCases := []struct {
-		testBuilder   *Builder
-		expectedError error
-	}{
-		{
-			testBuilder:   buildValidPodTestBuilder(buildTestClientWithDummyPod()),
-			expectedError: nil,
-		},
-		{
-			testBuilder:   buildInvalidPodTestBuilder(buildTestClientWithDummyPod()),
-			expectedError: fmt.Errorf(errEmptyNamespace),
-		},
-		{
-			testBuilder:   buildValidPodTestBuilder(clients.GetTestClients(clients.TestClientParams{})),
-			expectedError: nil,
-		},
-	}
-
-	for _, testCase := range testCases {
-		testBuilder, err := testCase.testBuilder.Create()
-		assert.Equal(t, testCase.expectedError, err)
-
-		if testCase.expectedError == nil {
-			assert.Equal(t, testBuilder.Definition.Name, testBuilder.Object.Name)
-			assert.Equal(t, testBuilder.Definition.Namespace, testBuilder.Object.Namespace)
-			assert.NotEmpty(t, testBuilder.Object.Spec.Containers)
-		}
-	}
-}
-
-func TestPodDelete(t *testing.T) {
-	testPodDeleteHelper(t, func(builder *Builder) (*Builder, error) {
-		return builder.Delete()
-	})
-}
-
 func TestPodDeleteAndWait(t *testing.T) {
 	testPodDeleteHelper(t, func(builder *Builder) (*Builder, error) {
 		return builder.DeleteAndWait(5 * time.Second)
@@ -267,7 +176,7 @@ func TestPodWaitUntilDeleted(t *testing.T) {
 		},
 		{
 			testBuilder:   buildInvalidPodTestBuilder(clients.GetTestClients(clients.TestClientParams{})),
-			expectedError: fmt.Errorf(errEmptyNamespace),
+			expectedError: errors.New(errInvalidPodBuilder),
 		},
 		{
 			testBuilder:   buildValidPodTestBuilder(buildTestClientWithDummyPod()),
@@ -277,7 +186,7 @@ func TestPodWaitUntilDeleted(t *testing.T) {
 
 	for _, testCase := range testCases {
 		err := testCase.testBuilder.WaitUntilDeleted(2 * time.Second)
-		assert.Equal(t, testCase.expectedError, err)
+		assertErrorMessage(t, testCase.expectedError, err)
 	}
 }
 
@@ -293,29 +202,37 @@ func TestPodWaitUntilCondition(t *testing.T) {
 	})
 }
 
-func TestPodExists(t *testing.T) {
-	testCases := []struct {
-		testBuilder *Builder
-		exists      bool
-	}{
-		{
-			testBuilder: buildValidPodTestBuilder(buildTestClientWithDummyPod()),
-			exists:      true,
-		},
-		{
-			testBuilder: buildInvalidPodTestBuilder(buildTestClientWithDummyPod()),
-			exists:      false,
-		},
-		{
-			testBuilder: buildValidPodTestBuilder(clients.GetTestClients(clients.TestClientParams{})),
-			exists:      false,
-		},
-	}
-
-	for _, testCase := range testCases {
-		exists := testCase.testBuilder.Exists()
-		assert.Equal(t, testCase.exists, exists)
-	}
+func TestPodWaitUntilConditionWithContext(t *testing.T) {
+	pod := buildDummyPodWithPhaseAndCondition(corev1.PodPending, corev1.PodReady, false)
+	pod.Status.Conditions[0].Status = corev1.ConditionFalse
+
+	testBuilder := buildValidPodTestBuilder(clients.GetTestClients(clients.TestClientParams{
+		K8sMockObjects:  []runtime.Object{pod},
+		SchemeAttachers: testSchemes,
+	}))
+
+	var events []progress.Event
+
+	ctx := progress.WithReporter(context.TODO(), progress.ReporterFunc(func(event progress.Event) {
+		events = append(events, event)
+	}))
+
+	err := testBuilder.WaitUntilConditionWithContext(ctx, corev1.PodReady, time.Second)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.NotEmpty(t, events)
+
+	lastEvent := events[len(events)-1]
+	assert.True(t, lastEvent.Done)
+	assert.ErrorIs(t, lastEvent.Err, context.DeadlineExceeded)
+	assert.Equal(t, "Ready=False", lastEvent.State)
+	assert.Equal(t, "pod test-ns/test-pod to have condition Ready", lastEvent.Operation)
+
+	events = nil
+	err = testBuilder.WaitUntilInStatusWithContext(ctx, corev1.PodPending, time.Second)
+	assert.NoError(t, err)
+	assert.Len(t, events, 1)
+	assert.True(t, events[0].Done)
+	assert.Equal(t, string(corev1.PodPending), events[0].State)
 }
 
 func TestPodRedefineDefaultCMD(t *testing.T) {
@@ -350,7 +267,7 @@ func TestPodRedefineDefaultCMD(t *testing.T) {
 		}
 
 		testBuilder = testBuilder.RedefineDefaultCMD(testCase.command)
-		assert.Equal(t, testCase.expectedError, testBuilder.errorMsg)
+		assertBuilderError(t, testCase.expectedError, testBuilder)
 
 		if testCase.expectedError == "" {
 			assert.Equal(t, testCase.command, testBuilder.Definition.Spec.Containers[0].Command)
@@ -390,7 +307,7 @@ func TestPodWithRestartPolicy(t *testing.T) {
 		}
 
 		testBuilder = testBuilder.WithRestartPolicy(testCase.restartPolicy)
-		assert.Equal(t, testCase.expectedError, testBuilder.errorMsg)
+		assertBuilderError(t, testCase.expectedError, testBuilder)
 
 		if testCase.expectedError == "" {
 			assert.Equal(t, testCase.restartPolicy, testBuilder.Definition.Spec.RestartPolicy)
@@ -431,6 +348,86 @@ func TestPodWithToleration(t *testing.T) {
 	})
 }
 
+func TestPodWithTolerations(t *testing.T) {
+	testCases := []struct {
+		tolerations   []corev1.Toleration
+		hasObject     bool
+		expectedError string
+	}{
+		{
+			tolerations:   []corev1.Toleration{{Key: "test-key", Operator: corev1.TolerationOpExists}},
+			hasObject:     false,
+			expectedError: "",
+		},
+		{
+			tolerations:   nil,
+			hasObject:     false,
+			expectedError: "tolerations cannot be empty",
+		},
+		{
+			tolerations:   []corev1.Toleration{{Key: "test-key", Operator: corev1.TolerationOpExists}},
+			hasObject:     true,
+			expectedError: podRunningErrorMsg,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidPodTestBuilder(buildTestClientWithDummyPod())
+
+		if testCase.hasObject {
+			testBuilder.Object = testBuilder.Definition
+			testBuilder.Object.Spec.NodeName = defaultPodNodeName
+		}
+
+		testBuilder = testBuilder.WithTolerations(testCase.tolerations...)
+		assertBuilderError(t, testCase.expectedError, testBuilder)
+
+		if testCase.expectedError == "" {
+			assert.Equal(t, testCase.tolerations, testBuilder.Definition.Spec.Tolerations)
+		}
+	}
+}
+
+func TestPodWithRuntimeClass(t *testing.T) {
+	testCases := []struct {
+		runtimeClassName string
+		hasObject        bool
+		expectedError    string
+	}{
+		{
+			runtimeClassName: "test-runtime-class",
+			hasObject:        false,
+			expectedError:    "",
+		},
+		{
+			runtimeClassName: "",
+			hasObject:        false,
+			expectedError:    "runtimeClassName cannot be empty",
+		},
+		{
+			runtimeClassName: "test-runtime-class",
+			hasObject:        true,
+			expectedError:    podRunningErrorMsg,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidPodTestBuilder(buildTestClientWithDummyPod())
+
+		if testCase.hasObject {
+			testBuilder.Object = testBuilder.Definition
+			testBuilder.Object.Spec.NodeName = defaultPodNodeName
+		}
+
+		testBuilder = testBuilder.WithRuntimeClass(testCase.runtimeClassName)
+		assertBuilderError(t, testCase.expectedError, testBuilder)
+
+		if testCase.expectedError == "" {
+			assert.Equal(t, testCase.runtimeClassName, *testBuilder.Definition.Spec.RuntimeClassName)
+		}
+	}
+}
+
 func TestPodWithNodeSelector(t *testing.T) {
 	testCases := []struct {
 		nodeSelector  map[string]string
@@ -463,7 +460,7 @@ func TestPodWithNodeSelector(t *testing.T) {
 		}
 
 		testBuilder = testBuilder.WithNodeSelector(testCase.nodeSelector)
-		assert.Equal(t, testCase.expectedError, testBuilder.errorMsg)
+		assertBuilderError(t, testCase.expectedError, testBuilder)
 
 		if testCase.expectedError == "" {
 			assert.Equal(t, testCase.nodeSelector, testBuilder.Definition.Spec.NodeSelector)
@@ -495,7 +492,7 @@ func TestPodWithPrivilegedFlag(t *testing.T) {
 		}
 
 		testBuilder = testBuilder.WithPrivilegedFlag()
-		assert.Equal(t, testCase.expectedError, testBuilder.errorMsg)
+		assertBuilderError(t, testCase.expectedError, testBuilder)
 
 		if testCase.expectedError == "" {
 			assert.True(t, *testBuilder.Definition.Spec.Containers[0].SecurityContext.Privileged)
@@ -521,7 +518,7 @@ func TestPodWithVolume(t *testing.T) {
 	for _, testCase := range testCases {
 		testBuilder := buildValidPodTestBuilder(buildTestClientWithDummyPod())
 		testBuilder = testBuilder.WithVolume(testCase.volume)
-		assert.Equal(t, testCase.expectedError, testBuilder.errorMsg)
+		assertBuilderError(t, testCase.expectedError, testBuilder)
 
 		if testCase.expectedError == "" {
 			assert.Equal(t, []corev1.Volume{testCase.volume}, testBuilder.Definition.Spec.Volumes)
@@ -590,7 +587,7 @@ func TestPodWithLocalVolume(t *testing.T) {
 		}
 
 		testBuilder = testBuilder.WithLocalVolume(testCase.volumeName, testCase.mountPath)
-		assert.Equal(t, testCase.expectedError, testBuilder.errorMsg)
+		assertBuilderError(t, testCase.expectedError, testBuilder)
 
 		if testCase.expectedError == "" {
 			assert.Equal(t, testCase.volumeName, testBuilder.Definition.Spec.Containers[0].VolumeMounts[0].Name)
@@ -642,7 +639,8 @@ func TestPodIsHealthy(t *testing.T) {
 		}
 
 		testSettings := clients.GetTestClients(clients.TestClientParams{
-			K8sMockObjects: runtimeObjects,
+			K8sMockObjects:  runtimeObjects,
+			SchemeAttachers: testSchemes,
 		})
 		testBuilder := buildValidPodTestBuilder(testSettings)
 
@@ -690,7 +688,7 @@ func TestPodExecCommandWithTimeout(t *testing.T) {
 			timeout:       5 * time.Second,
 			containerName: []string{},
 			testBuilder:   buildInvalidPodTestBuilder(buildTestClientWithDummyPod()),
-			expectedError: errEmptyNamespace,
+			expectedError: errInvalidPodBuilder,
 		},
 		{
 			name:          "pod does not exist",
@@ -776,7 +774,7 @@ func testPodDeleteHelper(t *testing.T, deleteFunc func(builder *Builder) (*Build
 		},
 		{
 			testBuilder:   buildInvalidPodTestBuilder(buildTestClientWithDummyPod()),
-			expectedError: fmt.Errorf(errEmptyNamespace),
+			expectedError: errors.New(errInvalidPodBuilder),
 		},
 		{
 			testBuilder:   buildValidPodTestBuilder(clients.GetTestClients(clients.TestClientParams{})),
@@ -786,7 +784,7 @@ func testPodDeleteHelper(t *testing.T, deleteFunc func(builder *Builder) (*Build
 
 	for _, testCase := range testCases {
 		testBuilder, err := deleteFunc(testCase.testBuilder)
-		assert.Equal(t, testCase.expectedError, err)
+		assertErrorMessage(t, testCase.expectedError, err)
 
 		if testCase.expectedError == nil {
 			assert.Nil(t, testBuilder.Object)
@@ -812,7 +810,7 @@ func testPodWaitUntilConditionHelper(t *testing.T, waitFunc func(builder *Builde
 		{
 			valid:         false,
 			ready:         true,
-			expectedError: fmt.Errorf(errEmptyNamespace),
+			expectedError: errors.New(errInvalidPodBuilder),
 		},
 		{
 			valid:         true,
@@ -832,14 +830,15 @@ func testPodWaitUntilConditionHelper(t *testing.T, waitFunc func(builder *Builde
 			}
 
 			testBuilder = buildValidPodTestBuilder(clients.GetTestClients(clients.TestClientParams{
-				K8sMockObjects: []runtime.Object{pod},
+				K8sMockObjects:  []runtime.Object{pod},
+				SchemeAttachers: testSchemes,
 			}))
 		} else {
 			testBuilder = buildInvalidPodTestBuilder(clients.GetTestClients(clients.TestClientParams{}))
 		}
 
 		err := waitFunc(testBuilder)
-		assert.Equal(t, testCase.expectedError, err)
+		assertErrorMessage(t, testCase.expectedError, err)
 	}
 }
 
@@ -871,7 +870,7 @@ func testPodWithTolerationHelper(
 		}
 
 		testBuilder = testFunc(testBuilder, toleration)
-		assert.Equal(t, testCase.expectedError, testBuilder.errorMsg)
+		assertBuilderError(t, testCase.expectedError, testBuilder)
 
 		if testCase.expectedError == "" {
 			assert.Equal(t, []corev1.Toleration{toleration}, testBuilder.Definition.Spec.Tolerations)
@@ -879,6 +878,34 @@ func testPodWithTolerationHelper(
 	}
 }
 
+// assertBuilderError asserts that the error stored in the builder has the expected message, or that there is no error
+// if the expected message is empty.
+func assertBuilderError(t *testing.T, expectedError string, builder *Builder) {
+	t.Helper()
+
+	if expectedError == "" {
+		assert.NoError(t, builder.GetError())
+
+		return
+	}
+
+	assert.EqualError(t, builder.GetError(), expectedError)
+}
+
+// assertErrorMessage asserts that err has the same message as expectedError, or that err is nil if expectedError is
+// nil. Comparing messages allows the typed errors from the common package to be matched against plain errors.
+func assertErrorMessage(t *testing.T, expectedError, err error) {
+	t.Helper()
+
+	if expectedError == nil {
+		assert.NoError(t, err)
+
+		return
+	}
+
+	assert.EqualError(t, err, expectedError.Error())
+}
+
 // buildDummyPod returns a Pod with the provided name, nsname, and container image.
 //
 //nolint:unparam
@@ -906,6 +933,7 @@ func buildTestClientWithDummyPod() *clients.Settings {
 		K8sMockObjects: []runtime.Object{
 			buildDummyPod(defaultPodName, defaultPodNsName, defaultPodImage),
 		},
+		SchemeAttachers: testSchemes,
 	})
 }
 
@@ -935,3 +963,73 @@ func buildDummyPodWithPhaseAndCondition(
 
 	return pod
 }
+
+func TestNewStreamingTransport(t *testing.T) {
+	server := httptest.NewTLSServer(http.NotFoundHandler())
+	t.Cleanup(server.Close)
+
+	caData := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+	proxyURL, _ := url.Parse("http://proxy.example.com:3128")
+	dialed := false
+
+	transport, err := newStreamingTransport(&rest.Config{
+		Host:            server.URL,
+		TLSClientConfig: rest.TLSClientConfig{CAData: caData, ServerName: "api.example.com"},
+		Proxy:           http.ProxyURL(proxyURL),
+		Dial: func(ctx context.Context, network, address string) (net.Conn, error) {
+			dialed = true
+
+			return (&net.Dialer{}).DialContext(ctx, network, address)
+		},
+	}, time.Second, time.Second, time.Second)
+	require.NoError(t, err)
+
+	require.NotNil(t, transport.TLSClientConfig)
+	assert.NotNil(t, transport.TLSClientConfig.RootCAs)
+	assert.Equal(t, "api.example.com", transport.TLSClientConfig.ServerName)
+
+	request, err := http.NewRequest(http.MethodGet, server.URL, nil)
+	require.NoError(t, err)
+
+	requestProxy, err := transport.Proxy(request)
+	assert.NoError(t, err)
+	assert.Equal(t, proxyURL, requestProxy)
+
+	conn, err := transport.DialContext(context.TODO(), "tcp", server.Listener.Addr().String())
+	require.NoError(t, err)
+	assert.True(t, dialed)
+	assert.NoError(t, conn.Close())
+
+	// Without a proxy in the config, the environment is used, honoring CIDRs in NO_PROXY.
+	t.Setenv("HTTPS_PROXY", proxyURL.String())
+	t.Setenv("NO_PROXY", "127.0.0.0/8")
+
+	transport, err = newStreamingTransport(&rest.Config{Host: server.URL}, time.Second, time.Second, time.Second)
+	require.NoError(t, err)
+
+	requestProxy, err = transport.Proxy(request)
+	assert.NoError(t, err)
+	assert.Nil(t, requestProxy)
+
+	request, err = http.NewRequest(http.MethodGet, "https://api.example.com:6443", nil)
+	require.NoError(t, err)
+
+	requestProxy, err = transport.Proxy(request)
+	assert.NoError(t, err)
+	assert.Equal(t, proxyURL, requestProxy)
+
+	invalidConfig := &rest.Config{Host: server.URL, TLSClientConfig: rest.TLSClientConfig{CAData: []byte("invalid")}}
+
+	_, err = newStreamingTransport(invalidConfig, time.Second, time.Second, time.Second)
+	assert.ErrorContains(t, err, "failed to get TLS config for "+server.URL)
+}
+
+func TestPodExecCommandWithoutRESTConfig(t *testing.T) {
+	testBuilder := buildValidPodTestBuilder(buildTestClientWithDummyPod())
+
+	_, err := testBuilder.ExecCommand([]string{"echo", "test"})
+	assert.EqualError(t, err, "cannot get REST config of client without a REST config")
+
+	_, _, err = testBuilder.PortForward(8080, 8080)
+	assert.EqualError(t, err, "cannot get REST config of client without a REST config")
+}
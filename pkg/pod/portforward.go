@@ -6,18 +6,25 @@ import (
 	"sync"
 	"time"
 
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common"
 	"k8s.io/apimachinery/pkg/util/httpstream"
+	httpspdy "k8s.io/apimachinery/pkg/util/httpstream/spdy"
+	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/portforward"
 	"k8s.io/client-go/transport/spdy"
 	"k8s.io/klog/v2"
 )
 
-const defaultPortForwardReadyTimeout = 60 * time.Second
+const (
+	defaultPortForwardReadyTimeout = 60 * time.Second
+	// defaultPortForwardPingPeriod matches the ping period client-go uses for port-forward connections.
+	defaultPortForwardPingPeriod = 5 * time.Second
+)
 
 // PortForward establishes a port-forward to the pod and returns the local address (e.g. "localhost:8443")
 // and a stop function. Callers must invoke the stop function to close the port-forward when done.
 func (builder *Builder) PortForward(localPort, remotePort int) (string, func(), error) {
-	if valid, err := builder.validate(); !valid {
+	if err := common.Validate(builder); err != nil {
 		return "", nil, err
 	}
 
@@ -29,20 +36,42 @@ func (builder *Builder) PortForward(localPort, remotePort int) (string, func(),
 	klog.V(100).Infof("Setting up port-forward %d:%d to pod %s in namespace %s",
 		localPort, remotePort, builder.Object.Name, builder.Object.Namespace)
 
-	restConfig := builder.apiClient.Config
+	apiClient, err := builder.getSettings()
+	if err != nil {
+		return "", nil, err
+	}
+
+	restConfig, err := apiClient.RESTConfig()
+	if err != nil {
+		return "", nil, err
+	}
 
-	req := builder.apiClient.CoreV1Interface.RESTClient().
+	req := apiClient.CoreV1Interface.RESTClient().
 		Post().
 		Namespace(builder.Object.Namespace).
 		Resource("pods").
 		Name(builder.Object.Name).
 		SubResource("portforward")
 
-	transport, upgrader, err := spdy.RoundTripperFor(restConfig)
+	httpTransport, err := newStreamingTransport(
+		restConfig, defaultDialTimeout, defaultTLSHandshakeTimeout, defaultResponseHeaderTimeout)
+	if err != nil {
+		return "", nil, err
+	}
+
+	upgrader, err := httpspdy.NewRoundTripperWithConfig(httpspdy.RoundTripperConfig{
+		PingPeriod:       defaultPortForwardPingPeriod,
+		UpgradeTransport: httpTransport,
+	})
 	if err != nil {
 		return "", nil, fmt.Errorf("failed to create SPDY round-tripper: %w", err)
 	}
 
+	transport, err := rest.HTTPWrappersForConfig(restConfig, upgrader)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to wrap SPDY round-tripper: %w", err)
+	}
+
 	spdyDialer := spdy.NewDialer(upgrader, &http.Client{Transport: transport}, http.MethodPost, req.URL())
 
 	wsDialer, err := portforward.NewSPDYOverWebsocketDialer(req.URL(), restConfig)
@@ -0,0 +1,209 @@
+package pod
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
+)
+
+// dpdkCapabilities are the capabilities DPDK applications need to lock hugepages and drive VFs.
+var dpdkCapabilities = []corev1.Capability{"IPC_LOCK", "SYS_RESOURCE", "NET_RAW", capabilityNetAdmin}
+
+// WithRestrictedProfile sets a security context on the container that complies with the restricted pod security
+// standard and the restricted-v2 SCC. The user and group are left for the SCC to assign.
+func (builder *ContainerBuilder) WithRestrictedProfile() *ContainerBuilder {
+	klog.V(100).Infof("Applying restricted security profile to container %s", builder.definition.Name)
+
+	builder.definition.SecurityContext = restrictedSecurityContext()
+
+	return builder
+}
+
+// WithPrivilegedProfile sets a privileged security context running as root on the container.
+func (builder *ContainerBuilder) WithPrivilegedProfile() *ContainerBuilder {
+	klog.V(100).Infof("Applying privileged security profile to container %s", builder.definition.Name)
+
+	builder.definition.SecurityContext = privilegedSecurityContext()
+
+	return builder
+}
+
+// WithDPDKProfile sets the security context and resources a DPDK application needs on the container. It runs as root
+// with the capabilities required to lock memory and drive VFs, and requests one VF of vfResource along with hugePages
+// of 1Gi hugepages, memory, and cpus exclusive CPUs. Requests are set equal to limits so the pod has the Guaranteed
+// QoS class. If vfResource has no prefix, openshift.io is used. The pod must also mount hugepages, see WithHugePages.
+func (builder *ContainerBuilder) WithDPDKProfile(hugePages, memory string, cpus int64, vfResource string) *ContainerBuilder {
+	klog.V(100).Infof("Applying DPDK profile to container %s: hugePages: %s, memory: %s, cpus: %d, vfResource: %s",
+		builder.definition.Name, hugePages, memory, cpus, vfResource)
+
+	resources, err := dpdkResources(hugePages, memory, cpus, vfResource)
+	if err != nil {
+		klog.V(100).Infof("Failed to define DPDK resources of container %s: %v", builder.definition.Name, err)
+
+		builder.errorMsg = err.Error()
+
+		return builder
+	}
+
+	builder.definition.SecurityContext = dpdkSecurityContext()
+	builder.definition.Resources.Requests = resources
+	builder.definition.Resources.Limits = resources.DeepCopy()
+
+	return builder
+}
+
+// WithRestrictedProfile sets a pod security context and container security contexts on all containers that comply
+// with the restricted pod security standard and the restricted-v2 SCC.
+func (builder *Builder) WithRestrictedProfile() *Builder {
+	if err := common.Validate(builder); err != nil {
+		return builder
+	}
+
+	klog.V(100).Infof("Applying restricted security profile to pod %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	builder.isMutationAllowed("restricted security profile")
+
+	if builder.GetError() != nil {
+		return builder
+	}
+
+	builder.Definition.Spec.SecurityContext = &corev1.PodSecurityContext{
+		RunAsNonRoot:   ptr.To(true),
+		SeccompProfile: &corev1.SeccompProfile{Type: corev1.SeccompProfileTypeRuntimeDefault},
+	}
+
+	for idx := range builder.Definition.Spec.Containers {
+		builder.Definition.Spec.Containers[idx].SecurityContext = restrictedSecurityContext()
+	}
+
+	return builder
+}
+
+// WithPrivilegedProfile sets a privileged security context running as root on all containers. Unlike
+// WithPrivilegedFlag, privilege escalation is explicitly allowed and the containers run as root regardless of the
+// image user.
+func (builder *Builder) WithPrivilegedProfile() *Builder {
+	if err := common.Validate(builder); err != nil {
+		return builder
+	}
+
+	klog.V(100).Infof("Applying privileged security profile to pod %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	builder.isMutationAllowed("privileged security profile")
+
+	if builder.GetError() != nil {
+		return builder
+	}
+
+	for idx := range builder.Definition.Spec.Containers {
+		builder.Definition.Spec.Containers[idx].SecurityContext = privilegedSecurityContext()
+	}
+
+	return builder
+}
+
+// WithDPDKProfile applies the DPDK container profile to the first container of the pod and mounts hugepages in all
+// containers. See ContainerBuilder.WithDPDKProfile for the security context and resources that are set. The VF must
+// still be attached by requesting a network backed by vfResource, see WithSecondaryNetwork.
+func (builder *Builder) WithDPDKProfile(hugePages, memory string, cpus int64, vfResource string) *Builder {
+	if err := common.Validate(builder); err != nil {
+		return builder
+	}
+
+	klog.V(100).Infof("Applying DPDK profile to pod %s in namespace %s: hugePages: %s, memory: %s, cpus: %d, "+
+		"vfResource: %s", builder.Definition.Name, builder.Definition.Namespace, hugePages, memory, cpus, vfResource)
+
+	builder.isMutationAllowed("DPDK profile")
+
+	if builder.GetError() != nil {
+		return builder
+	}
+
+	resources, err := dpdkResources(hugePages, memory, cpus, vfResource)
+	if err != nil {
+		klog.V(100).Infof("Failed to define DPDK resources of pod %s: %v", builder.Definition.Name, err)
+
+		builder.SetError(err)
+
+		return builder
+	}
+
+	container := &builder.Definition.Spec.Containers[0]
+	container.SecurityContext = dpdkSecurityContext()
+	container.Resources.Requests = resources
+	container.Resources.Limits = resources.DeepCopy()
+
+	return builder.WithHugePages()
+}
+
+// restrictedSecurityContext returns a container security context complying with the restricted pod security
+// standard.
+func restrictedSecurityContext() *corev1.SecurityContext {
+	return &corev1.SecurityContext{
+		AllowPrivilegeEscalation: ptr.To(false),
+		RunAsNonRoot:             ptr.To(true),
+		SeccompProfile:           &corev1.SeccompProfile{Type: corev1.SeccompProfileTypeRuntimeDefault},
+		Capabilities: &corev1.Capabilities{
+			Drop: []corev1.Capability{"ALL"},
+		},
+	}
+}
+
+// privilegedSecurityContext returns a privileged container security context running as root.
+func privilegedSecurityContext() *corev1.SecurityContext {
+	return &corev1.SecurityContext{
+		Privileged:               ptr.To(true),
+		AllowPrivilegeEscalation: ptr.To(true),
+		RunAsUser:                ptr.To[int64](0),
+	}
+}
+
+// dpdkSecurityContext returns a container security context running as root with the capabilities DPDK applications
+// need.
+func dpdkSecurityContext() *corev1.SecurityContext {
+	return &corev1.SecurityContext{
+		RunAsUser: ptr.To[int64](0),
+		Capabilities: &corev1.Capabilities{
+			Add: append([]corev1.Capability{}, dpdkCapabilities...),
+		},
+	}
+}
+
+// dpdkResources validates the DPDK profile parameters and returns the resources of the DPDK container.
+func dpdkResources(hugePages, memory string, cpus int64, vfResource string) (corev1.ResourceList, error) {
+	hugePagesQuantity, err := resource.ParseQuantity(hugePages)
+	if err != nil {
+		return nil, fmt.Errorf("DPDK profile 'hugePages' is invalid: %w", err)
+	}
+
+	memoryQuantity, err := resource.ParseQuantity(memory)
+	if err != nil {
+		return nil, fmt.Errorf("DPDK profile 'memory' is invalid: %w", err)
+	}
+
+	if cpus < 1 {
+		return nil, fmt.Errorf("DPDK profile 'cpus' must be greater than 0, got %d", cpus)
+	}
+
+	if vfResource == "" {
+		return nil, fmt.Errorf("DPDK profile 'vfResource' cannot be empty")
+	}
+
+	if !strings.Contains(vfResource, "/") {
+		vfResource = fmt.Sprintf("%s/%s", defaultSRIOVResourcePrefix, vfResource)
+	}
+
+	return corev1.ResourceList{
+		corev1.ResourceCPU:              *resource.NewQuantity(cpus, resource.DecimalSI),
+		corev1.ResourceMemory:           memoryQuantity,
+		resourceHugePages1Gi:            hugePagesQuantity,
+		corev1.ResourceName(vfResource): *resource.NewQuantity(1, resource.DecimalSI),
+	}, nil
+}
@@ -0,0 +1,217 @@
+package pod
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+func TestContainerWithRestrictedProfile(t *testing.T) {
+	container, err := NewContainerBuilder("test", defaultPodImage, []string{"sleep", "INF"}).
+		WithPrivilegedProfile().
+		WithRestrictedProfile().
+		GetContainerCfg()
+	assert.Nil(t, err)
+	assert.Equal(t, restrictedSecurityContext(), container.SecurityContext)
+	assert.Nil(t, container.SecurityContext.RunAsUser)
+}
+
+func TestContainerWithPrivilegedProfile(t *testing.T) {
+	container, err := NewContainerBuilder("test", defaultPodImage, []string{"sleep", "INF"}).
+		WithPrivilegedProfile().
+		GetContainerCfg()
+	assert.Nil(t, err)
+	assert.True(t, *container.SecurityContext.Privileged)
+	assert.True(t, *container.SecurityContext.AllowPrivilegeEscalation)
+	assert.Equal(t, int64(0), *container.SecurityContext.RunAsUser)
+
+	// The default security context is shared, so the profile must not have modified it.
+	assert.Nil(t, defaultSecurityContext.Privileged)
+}
+
+func TestContainerWithDPDKProfile(t *testing.T) {
+	testCases := []struct {
+		hugePages        string
+		memory           string
+		cpus             int64
+		vfResource       string
+		expectedResource corev1.ResourceName
+		expectedError    string
+	}{
+		{
+			hugePages:        "2Gi",
+			memory:           "1Gi",
+			cpus:             4,
+			vfResource:       "dpdknic",
+			expectedResource: "openshift.io/dpdknic",
+			expectedError:    "",
+		},
+		{
+			hugePages:        "2Gi",
+			memory:           "1Gi",
+			cpus:             4,
+			vfResource:       "example.com/dpdknic",
+			expectedResource: "example.com/dpdknic",
+			expectedError:    "",
+		},
+		{
+			hugePages:  "invalid",
+			memory:     "1Gi",
+			cpus:       4,
+			vfResource: "dpdknic",
+			expectedError: "DPDK profile 'hugePages' is invalid: quantities must match the regular expression " +
+				"'^([+-]?[0-9.]+)([eEinumkKMGTP]*[-+]?[0-9]*)$'",
+		},
+		{
+			hugePages:  "2Gi",
+			memory:     "",
+			cpus:       4,
+			vfResource: "dpdknic",
+			expectedError: "DPDK profile 'memory' is invalid: quantities must match the regular expression " +
+				"'^([+-]?[0-9.]+)([eEinumkKMGTP]*[-+]?[0-9]*)$'",
+		},
+		{
+			hugePages:     "2Gi",
+			memory:        "1Gi",
+			cpus:          0,
+			vfResource:    "dpdknic",
+			expectedError: "DPDK profile 'cpus' must be greater than 0, got 0",
+		},
+		{
+			hugePages:     "2Gi",
+			memory:        "1Gi",
+			cpus:          4,
+			vfResource:    "",
+			expectedError: "DPDK profile 'vfResource' cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := NewContainerBuilder("test", defaultPodImage, []string{"sleep", "INF"}).
+			WithDPDKProfile(testCase.hugePages, testCase.memory, testCase.cpus, testCase.vfResource)
+		assert.Equal(t, testCase.expectedError, testBuilder.errorMsg)
+
+		if testCase.expectedError != "" {
+			continue
+		}
+
+		container, err := testBuilder.GetContainerCfg()
+		assert.Nil(t, err)
+		assert.Equal(t, dpdkCapabilities, container.SecurityContext.Capabilities.Add)
+		assert.Equal(t, container.Resources.Requests, container.Resources.Limits)
+		assert.Equal(t, resource.MustParse("2Gi"), container.Resources.Limits[resourceHugePages1Gi])
+		assert.Equal(t, int64(4), container.Resources.Limits.Cpu().Value())
+		assert.Equal(t, int64(1), container.Resources.Limits.Name(testCase.expectedResource, resource.DecimalSI).Value())
+	}
+}
+
+func TestPodWithRestrictedProfile(t *testing.T) {
+	testCases := []struct {
+		hasObject     bool
+		expectedError string
+	}{
+		{
+			hasObject:     false,
+			expectedError: "",
+		},
+		{
+			hasObject:     true,
+			expectedError: podRunningErrorMsg,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidPodTestBuilder(buildTestClientWithDummyPod())
+
+		if testCase.hasObject {
+			testBuilder.Object = testBuilder.Definition
+			testBuilder.Object.Spec.NodeName = defaultPodNodeName
+		}
+
+		testBuilder = testBuilder.WithRestrictedProfile()
+		assertBuilderError(t, testCase.expectedError, testBuilder)
+
+		if testCase.expectedError == "" {
+			assert.True(t, *testBuilder.Definition.Spec.SecurityContext.RunAsNonRoot)
+			assert.Equal(t, restrictedSecurityContext(), testBuilder.Definition.Spec.Containers[0].SecurityContext)
+		}
+	}
+}
+
+func TestPodWithPrivilegedProfile(t *testing.T) {
+	testCases := []struct {
+		hasObject     bool
+		expectedError string
+	}{
+		{
+			hasObject:     false,
+			expectedError: "",
+		},
+		{
+			hasObject:     true,
+			expectedError: podRunningErrorMsg,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidPodTestBuilder(buildTestClientWithDummyPod())
+
+		if testCase.hasObject {
+			testBuilder.Object = testBuilder.Definition
+			testBuilder.Object.Spec.NodeName = defaultPodNodeName
+		}
+
+		testBuilder = testBuilder.WithPrivilegedProfile()
+		assertBuilderError(t, testCase.expectedError, testBuilder)
+
+		if testCase.expectedError == "" {
+			assert.Equal(t, privilegedSecurityContext(), testBuilder.Definition.Spec.Containers[0].SecurityContext)
+		}
+	}
+}
+
+func TestPodWithDPDKProfile(t *testing.T) {
+	testCases := []struct {
+		hasObject     bool
+		cpus          int64
+		expectedError string
+	}{
+		{
+			hasObject:     false,
+			cpus:          2,
+			expectedError: "",
+		},
+		{
+			hasObject:     false,
+			cpus:          0,
+			expectedError: "DPDK profile 'cpus' must be greater than 0, got 0",
+		},
+		{
+			hasObject:     true,
+			cpus:          2,
+			expectedError: podRunningErrorMsg,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidPodTestBuilder(buildTestClientWithDummyPod())
+
+		if testCase.hasObject {
+			testBuilder.Object = testBuilder.Definition
+			testBuilder.Object.Spec.NodeName = defaultPodNodeName
+		}
+
+		testBuilder = testBuilder.WithDPDKProfile("1Gi", "512Mi", testCase.cpus, "dpdknic")
+		assertBuilderError(t, testCase.expectedError, testBuilder)
+
+		if testCase.expectedError == "" {
+			container := testBuilder.Definition.Spec.Containers[0]
+			assert.Equal(t, dpdkSecurityContext(), container.SecurityContext)
+			assert.Equal(t, container.Resources.Requests, container.Resources.Limits)
+			assert.Equal(t, volumeNameHugepages, testBuilder.Definition.Spec.Volumes[0].Name)
+			assert.Equal(t, volumeNameHugepages, container.VolumeMounts[0].Name)
+		}
+	}
+}
@@ -0,0 +1,287 @@
+package pod
+
+import (
+	"fmt"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
+)
+
+const (
+	// projectedTokenPath is the file name of the service account token in a projected token volume.
+	projectedTokenPath = "token"
+	// minProjectedTokenExpirationSeconds is the minimum validity of a projected service account token accepted by the
+	// API server.
+	minProjectedTokenExpirationSeconds = 600
+)
+
+// WithConfigMapVolume adds a volume with the contents of the configMap with the provided name to the pod and mounts it
+// at mountPath in all containers, including init containers.
+func (builder *Builder) WithConfigMapVolume(volumeName, configMapName, mountPath string) *Builder {
+	if err := common.Validate(builder); err != nil {
+		return builder
+	}
+
+	klog.V(100).Infof("Adding configMap %s volume %s to pod %s in namespace %s",
+		configMapName, volumeName, builder.Definition.Name, builder.Definition.Namespace)
+
+	if configMapName == "" {
+		klog.V(100).Info("The 'configMapName' of the volume is empty")
+
+		builder.SetError(fmt.Errorf("'configMapName' parameter is empty"))
+
+		return builder
+	}
+
+	return builder.withVolumeAndMount(corev1.Volume{
+		Name: volumeName,
+		VolumeSource: corev1.VolumeSource{
+			ConfigMap: &corev1.ConfigMapVolumeSource{
+				LocalObjectReference: corev1.LocalObjectReference{Name: configMapName},
+			},
+		},
+	}, mountPath, false)
+}
+
+// WithSecretVolume adds a volume with the contents of the secret with the provided name to the pod and mounts it read
+// only at mountPath in all containers, including init containers.
+func (builder *Builder) WithSecretVolume(volumeName, secretName, mountPath string) *Builder {
+	if err := common.Validate(builder); err != nil {
+		return builder
+	}
+
+	klog.V(100).Infof("Adding secret %s volume %s to pod %s in namespace %s",
+		secretName, volumeName, builder.Definition.Name, builder.Definition.Namespace)
+
+	if secretName == "" {
+		klog.V(100).Info("The 'secretName' of the volume is empty")
+
+		builder.SetError(fmt.Errorf("'secretName' parameter is empty"))
+
+		return builder
+	}
+
+	return builder.withVolumeAndMount(corev1.Volume{
+		Name: volumeName,
+		VolumeSource: corev1.VolumeSource{
+			Secret: &corev1.SecretVolumeSource{SecretName: secretName},
+		},
+	}, mountPath, true)
+}
+
+// WithEmptyDir adds an emptyDir volume to the pod and mounts it at mountPath in all containers, including init
+// containers. The medium may be empty for node storage or Memory for a tmpfs. If sizeLimit is empty, the volume is not
+// limited.
+func (builder *Builder) WithEmptyDir(
+	volumeName, mountPath string, medium corev1.StorageMedium, sizeLimit string) *Builder {
+	if err := common.Validate(builder); err != nil {
+		return builder
+	}
+
+	klog.V(100).Infof("Adding emptyDir volume %s with medium %q and size limit %q to pod %s in namespace %s",
+		volumeName, medium, sizeLimit, builder.Definition.Name, builder.Definition.Namespace)
+
+	emptyDir := &corev1.EmptyDirVolumeSource{Medium: medium}
+
+	if sizeLimit != "" {
+		quantity, err := resource.ParseQuantity(sizeLimit)
+		if err != nil {
+			klog.V(100).Infof("The 'sizeLimit' %s of the volume is invalid: %v", sizeLimit, err)
+
+			builder.SetError(fmt.Errorf("'sizeLimit' parameter is invalid: %w", err))
+
+			return builder
+		}
+
+		emptyDir.SizeLimit = &quantity
+	}
+
+	return builder.withVolumeAndMount(corev1.Volume{
+		Name:         volumeName,
+		VolumeSource: corev1.VolumeSource{EmptyDir: emptyDir},
+	}, mountPath, false)
+}
+
+// WithHostPath adds a volume with the path on the node to the pod and mounts it at mountPath in all containers,
+// including init containers. The hostPathType may be empty to skip checks on the path.
+func (builder *Builder) WithHostPath(
+	volumeName, hostPath, mountPath string, hostPathType corev1.HostPathType) *Builder {
+	if err := common.Validate(builder); err != nil {
+		return builder
+	}
+
+	klog.V(100).Infof("Adding hostPath %s volume %s to pod %s in namespace %s",
+		hostPath, volumeName, builder.Definition.Name, builder.Definition.Namespace)
+
+	if hostPath == "" {
+		klog.V(100).Info("The 'hostPath' of the volume is empty")
+
+		builder.SetError(fmt.Errorf("'hostPath' parameter is empty"))
+
+		return builder
+	}
+
+	hostPathSource := &corev1.HostPathVolumeSource{Path: hostPath}
+
+	if hostPathType != "" {
+		hostPathSource.Type = ptr.To(hostPathType)
+	}
+
+	return builder.withVolumeAndMount(corev1.Volume{
+		Name:         volumeName,
+		VolumeSource: corev1.VolumeSource{HostPath: hostPathSource},
+	}, mountPath, false)
+}
+
+// WithPVC adds a volume backed by the persistentVolumeClaim with the provided name to the pod and mounts it at
+// mountPath in all containers, including init containers.
+func (builder *Builder) WithPVC(volumeName, claimName, mountPath string, readOnly bool) *Builder {
+	if err := common.Validate(builder); err != nil {
+		return builder
+	}
+
+	klog.V(100).Infof("Adding persistentVolumeClaim %s volume %s to pod %s in namespace %s",
+		claimName, volumeName, builder.Definition.Name, builder.Definition.Namespace)
+
+	if claimName == "" {
+		klog.V(100).Info("The 'claimName' of the volume is empty")
+
+		builder.SetError(fmt.Errorf("'claimName' parameter is empty"))
+
+		return builder
+	}
+
+	return builder.withVolumeAndMount(corev1.Volume{
+		Name: volumeName,
+		VolumeSource: corev1.VolumeSource{
+			PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+				ClaimName: claimName,
+				ReadOnly:  readOnly,
+			},
+		},
+	}, mountPath, readOnly)
+}
+
+// WithDownwardAPI adds a volume exposing the provided pod fields as files to the pod and mounts it read only at
+// mountPath in all containers, including init containers.
+func (builder *Builder) WithDownwardAPI(
+	volumeName, mountPath string, items []corev1.DownwardAPIVolumeFile) *Builder {
+	if err := common.Validate(builder); err != nil {
+		return builder
+	}
+
+	klog.V(100).Infof("Adding downwardAPI volume %s to pod %s in namespace %s",
+		volumeName, builder.Definition.Name, builder.Definition.Namespace)
+
+	if len(items) == 0 {
+		klog.V(100).Info("The 'items' of the volume are empty")
+
+		builder.SetError(fmt.Errorf("'items' parameter is empty"))
+
+		return builder
+	}
+
+	return builder.withVolumeAndMount(corev1.Volume{
+		Name: volumeName,
+		VolumeSource: corev1.VolumeSource{
+			DownwardAPI: &corev1.DownwardAPIVolumeSource{Items: items},
+		},
+	}, mountPath, true)
+}
+
+// WithProjectedToken adds a volume with a service account token for the provided audience to the pod and mounts it
+// read only at mountPath in all containers, including init containers. The token is written to the token file and is
+// rotated by the kubelet before it expires after expirationSeconds, which must be at least 600.
+func (builder *Builder) WithProjectedToken(
+	volumeName, mountPath, audience string, expirationSeconds int64) *Builder {
+	if err := common.Validate(builder); err != nil {
+		return builder
+	}
+
+	klog.V(100).Infof("Adding projected token volume %s with audience %q to pod %s in namespace %s",
+		volumeName, audience, builder.Definition.Name, builder.Definition.Namespace)
+
+	if expirationSeconds < minProjectedTokenExpirationSeconds {
+		klog.V(100).Infof("The 'expirationSeconds' %d of the volume is too short", expirationSeconds)
+
+		builder.SetError(fmt.Errorf(
+			"'expirationSeconds' parameter must be at least %d, got %d", minProjectedTokenExpirationSeconds, expirationSeconds))
+
+		return builder
+	}
+
+	return builder.withVolumeAndMount(corev1.Volume{
+		Name: volumeName,
+		VolumeSource: corev1.VolumeSource{
+			Projected: &corev1.ProjectedVolumeSource{
+				Sources: []corev1.VolumeProjection{{
+					ServiceAccountToken: &corev1.ServiceAccountTokenProjection{
+						Audience:          audience,
+						ExpirationSeconds: ptr.To(expirationSeconds),
+						Path:              projectedTokenPath,
+					},
+				}},
+			},
+		},
+	}, mountPath, true)
+}
+
+// withVolumeAndMount adds volume to the pod and mounts it at mountPath in all containers, including init containers.
+func (builder *Builder) withVolumeAndMount(volume corev1.Volume, mountPath string, readOnly bool) *Builder {
+	builder.isMutationAllowed(fmt.Sprintf("volume %s", volume.Name))
+
+	if builder.GetError() != nil {
+		return builder
+	}
+
+	if volume.Name == "" {
+		klog.V(100).Info("The 'volumeName' of the pod is empty")
+
+		builder.SetError(fmt.Errorf("'volumeName' parameter is empty"))
+
+		return builder
+	}
+
+	if mountPath == "" {
+		klog.V(100).Info("The 'mountPath' of the pod is empty")
+
+		builder.SetError(fmt.Errorf("'mountPath' parameter is empty"))
+
+		return builder
+	}
+
+	for _, existingVolume := range builder.Definition.Spec.Volumes {
+		if existingVolume.Name == volume.Name {
+			klog.V(100).Infof("The volume %s is already defined in pod %s", volume.Name, builder.Definition.Name)
+
+			builder.SetError(fmt.Errorf("volume %s already defined in pod", volume.Name))
+
+			return builder
+		}
+	}
+
+	mountConfig := corev1.VolumeMount{Name: volume.Name, MountPath: mountPath, ReadOnly: readOnly}
+
+	builder.isMountAlreadyInUseInPod(mountConfig)
+
+	if builder.GetError() != nil {
+		return builder
+	}
+
+	for index := range builder.Definition.Spec.Containers {
+		builder.Definition.Spec.Containers[index].VolumeMounts = append(
+			builder.Definition.Spec.Containers[index].VolumeMounts, mountConfig)
+	}
+
+	for index := range builder.Definition.Spec.InitContainers {
+		builder.Definition.Spec.InitContainers[index].VolumeMounts = append(
+			builder.Definition.Spec.InitContainers[index].VolumeMounts, mountConfig)
+	}
+
+	builder.Definition.Spec.Volumes = append(builder.Definition.Spec.Volumes, volume)
+
+	return builder
+}
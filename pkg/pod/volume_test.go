@@ -0,0 +1,352 @@
+package pod
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+func TestPodWithConfigMapVolume(t *testing.T) {
+	testCases := []struct {
+		volumeName    string
+		configMapName string
+		mountPath     string
+		hasObject     bool
+		expectedError string
+	}{
+		{
+			volumeName:    defaultVolumeName,
+			configMapName: "test-configmap",
+			mountPath:     defaultMountPath,
+			hasObject:     false,
+			expectedError: "",
+		},
+		{
+			volumeName:    defaultVolumeName,
+			configMapName: "",
+			mountPath:     defaultMountPath,
+			hasObject:     false,
+			expectedError: "'configMapName' parameter is empty",
+		},
+		{
+			volumeName:    "",
+			configMapName: "test-configmap",
+			mountPath:     defaultMountPath,
+			hasObject:     false,
+			expectedError: "'volumeName' parameter is empty",
+		},
+		{
+			volumeName:    defaultVolumeName,
+			configMapName: "test-configmap",
+			mountPath:     "",
+			hasObject:     false,
+			expectedError: "'mountPath' parameter is empty",
+		},
+		{
+			volumeName:    defaultVolumeName,
+			configMapName: "test-configmap",
+			mountPath:     defaultMountPath,
+			hasObject:     true,
+			expectedError: podRunningErrorMsg,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidPodTestBuilder(buildTestClientWithDummyPod())
+
+		if testCase.hasObject {
+			testBuilder.Object = testBuilder.Definition
+			testBuilder.Object.Spec.NodeName = defaultPodNodeName
+		}
+
+		testBuilder = testBuilder.WithConfigMapVolume(testCase.volumeName, testCase.configMapName, testCase.mountPath)
+		assertBuilderError(t, testCase.expectedError, testBuilder)
+
+		if testCase.expectedError == "" {
+			assert.Equal(t, testCase.configMapName, testBuilder.Definition.Spec.Volumes[0].ConfigMap.Name)
+			assert.Equal(t, corev1.VolumeMount{Name: testCase.volumeName, MountPath: testCase.mountPath},
+				testBuilder.Definition.Spec.Containers[0].VolumeMounts[0])
+		}
+	}
+}
+
+func TestPodWithSecretVolume(t *testing.T) {
+	testCases := []struct {
+		secretName    string
+		expectedError string
+	}{
+		{
+			secretName:    "test-secret",
+			expectedError: "",
+		},
+		{
+			secretName:    "",
+			expectedError: "'secretName' parameter is empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidPodTestBuilder(buildTestClientWithDummyPod()).
+			WithSecretVolume(defaultVolumeName, testCase.secretName, defaultMountPath)
+		assertBuilderError(t, testCase.expectedError, testBuilder)
+
+		if testCase.expectedError == "" {
+			assert.Equal(t, testCase.secretName, testBuilder.Definition.Spec.Volumes[0].Secret.SecretName)
+			assert.True(t, testBuilder.Definition.Spec.Containers[0].VolumeMounts[0].ReadOnly)
+		}
+	}
+}
+
+func TestPodWithEmptyDir(t *testing.T) {
+	testCases := []struct {
+		medium        corev1.StorageMedium
+		sizeLimit     string
+		expectedError string
+	}{
+		{
+			medium:        corev1.StorageMediumDefault,
+			sizeLimit:     "",
+			expectedError: "",
+		},
+		{
+			medium:        corev1.StorageMediumMemory,
+			sizeLimit:     "64Mi",
+			expectedError: "",
+		},
+		{
+			medium:    corev1.StorageMediumMemory,
+			sizeLimit: "invalid",
+			expectedError: "'sizeLimit' parameter is invalid: quantities must match the regular expression " +
+				"'^([+-]?[0-9.]+)([eEinumkKMGTP]*[-+]?[0-9]*)$'",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidPodTestBuilder(buildTestClientWithDummyPod()).
+			WithEmptyDir(defaultVolumeName, defaultMountPath, testCase.medium, testCase.sizeLimit)
+		assertBuilderError(t, testCase.expectedError, testBuilder)
+
+		if testCase.expectedError != "" {
+			continue
+		}
+
+		emptyDir := testBuilder.Definition.Spec.Volumes[0].EmptyDir
+		assert.Equal(t, testCase.medium, emptyDir.Medium)
+
+		if testCase.sizeLimit == "" {
+			assert.Nil(t, emptyDir.SizeLimit)
+		} else {
+			assert.Equal(t, resource.MustParse(testCase.sizeLimit), *emptyDir.SizeLimit)
+		}
+	}
+}
+
+func TestPodWithHostPath(t *testing.T) {
+	testCases := []struct {
+		hostPath      string
+		hostPathType  corev1.HostPathType
+		expectedError string
+	}{
+		{
+			hostPath:      "/var/log",
+			hostPathType:  corev1.HostPathDirectory,
+			expectedError: "",
+		},
+		{
+			hostPath:      "/var/log",
+			hostPathType:  "",
+			expectedError: "",
+		},
+		{
+			hostPath:      "",
+			hostPathType:  corev1.HostPathDirectory,
+			expectedError: "'hostPath' parameter is empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidPodTestBuilder(buildTestClientWithDummyPod()).
+			WithHostPath(defaultVolumeName, testCase.hostPath, defaultMountPath, testCase.hostPathType)
+		assertBuilderError(t, testCase.expectedError, testBuilder)
+
+		if testCase.expectedError != "" {
+			continue
+		}
+
+		hostPath := testBuilder.Definition.Spec.Volumes[0].HostPath
+		assert.Equal(t, testCase.hostPath, hostPath.Path)
+
+		if testCase.hostPathType == "" {
+			assert.Nil(t, hostPath.Type)
+		} else {
+			assert.Equal(t, testCase.hostPathType, *hostPath.Type)
+		}
+	}
+}
+
+func TestPodWithPVC(t *testing.T) {
+	testCases := []struct {
+		claimName     string
+		readOnly      bool
+		expectedError string
+	}{
+		{
+			claimName:     "test-pvc",
+			readOnly:      false,
+			expectedError: "",
+		},
+		{
+			claimName:     "test-pvc",
+			readOnly:      true,
+			expectedError: "",
+		},
+		{
+			claimName:     "",
+			readOnly:      false,
+			expectedError: "'claimName' parameter is empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidPodTestBuilder(buildTestClientWithDummyPod()).
+			WithPVC(defaultVolumeName, testCase.claimName, defaultMountPath, testCase.readOnly)
+		assertBuilderError(t, testCase.expectedError, testBuilder)
+
+		if testCase.expectedError == "" {
+			claim := testBuilder.Definition.Spec.Volumes[0].PersistentVolumeClaim
+			assert.Equal(t, testCase.claimName, claim.ClaimName)
+			assert.Equal(t, testCase.readOnly, claim.ReadOnly)
+			assert.Equal(t, testCase.readOnly, testBuilder.Definition.Spec.Containers[0].VolumeMounts[0].ReadOnly)
+		}
+	}
+}
+
+func TestPodWithDownwardAPI(t *testing.T) {
+	testItems := []corev1.DownwardAPIVolumeFile{{
+		Path:     "labels",
+		FieldRef: &corev1.ObjectFieldSelector{FieldPath: "metadata.labels"},
+	}}
+
+	testCases := []struct {
+		items         []corev1.DownwardAPIVolumeFile
+		expectedError string
+	}{
+		{
+			items:         testItems,
+			expectedError: "",
+		},
+		{
+			items:         nil,
+			expectedError: "'items' parameter is empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidPodTestBuilder(buildTestClientWithDummyPod()).
+			WithDownwardAPI(defaultVolumeName, defaultMountPath, testCase.items)
+		assertBuilderError(t, testCase.expectedError, testBuilder)
+
+		if testCase.expectedError == "" {
+			assert.Equal(t, testCase.items, testBuilder.Definition.Spec.Volumes[0].DownwardAPI.Items)
+		}
+	}
+}
+
+func TestPodWithProjectedToken(t *testing.T) {
+	testCases := []struct {
+		audience          string
+		expirationSeconds int64
+		expectedError     string
+	}{
+		{
+			audience:          "vault",
+			expirationSeconds: 3600,
+			expectedError:     "",
+		},
+		{
+			audience:          "",
+			expirationSeconds: 600,
+			expectedError:     "",
+		},
+		{
+			audience:          "vault",
+			expirationSeconds: 60,
+			expectedError:     "'expirationSeconds' parameter must be at least 600, got 60",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidPodTestBuilder(buildTestClientWithDummyPod()).
+			WithProjectedToken(defaultVolumeName, defaultMountPath, testCase.audience, testCase.expirationSeconds)
+		assertBuilderError(t, testCase.expectedError, testBuilder)
+
+		if testCase.expectedError != "" {
+			continue
+		}
+
+		sources := testBuilder.Definition.Spec.Volumes[0].Projected.Sources
+		assert.Len(t, sources, 1)
+		assert.Equal(t, testCase.audience, sources[0].ServiceAccountToken.Audience)
+		assert.Equal(t, testCase.expirationSeconds, *sources[0].ServiceAccountToken.ExpirationSeconds)
+		assert.Equal(t, projectedTokenPath, sources[0].ServiceAccountToken.Path)
+	}
+}
+
+func TestPodWithVolumeAndMount(t *testing.T) {
+	testCases := []struct {
+		existingVolume bool
+		existingMount  bool
+		hasInit        bool
+		expectedError  string
+	}{
+		{
+			existingVolume: false,
+			existingMount:  false,
+			hasInit:        true,
+			expectedError:  "",
+		},
+		{
+			existingVolume: true,
+			existingMount:  false,
+			hasInit:        false,
+			expectedError:  fmt.Sprintf("volume %s already defined in pod", defaultVolumeName),
+		},
+		{
+			existingVolume: false,
+			existingMount:  true,
+			hasInit:        false,
+			expectedError:  fmt.Sprintf("given mount %s already mounted to pod's container test", defaultVolumeName),
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidPodTestBuilder(buildTestClientWithDummyPod())
+
+		if testCase.existingVolume {
+			testBuilder.Definition.Spec.Volumes = []corev1.Volume{{Name: defaultVolumeName}}
+		}
+
+		if testCase.existingMount {
+			testBuilder.Definition.Spec.Containers[0].VolumeMounts = []corev1.VolumeMount{{
+				Name:      defaultVolumeName,
+				MountPath: defaultMountPath,
+			}}
+		}
+
+		if testCase.hasInit {
+			testBuilder.Definition.Spec.InitContainers = []corev1.Container{{Name: "init"}}
+		}
+
+		testBuilder = testBuilder.withVolumeAndMount(corev1.Volume{Name: defaultVolumeName}, defaultMountPath, true)
+		assertBuilderError(t, testCase.expectedError, testBuilder)
+
+		if testCase.expectedError == "" {
+			expectedMount := corev1.VolumeMount{Name: defaultVolumeName, MountPath: defaultMountPath, ReadOnly: true}
+			assert.Equal(t, []corev1.VolumeMount{expectedMount}, testBuilder.Definition.Spec.Containers[0].VolumeMounts)
+			assert.Equal(t, []corev1.VolumeMount{expectedMount}, testBuilder.Definition.Spec.InitContainers[0].VolumeMounts)
+		}
+	}
+}
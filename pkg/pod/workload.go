@@ -0,0 +1,179 @@
+package pod
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common"
+	multus "gopkg.in/k8snetworkplumbingwg/multus-cni.v4/pkg/types"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/klog/v2"
+)
+
+const (
+	// defaultSRIOVResourcePrefix is the prefix the SR-IOV network operator uses for resource names.
+	defaultSRIOVResourcePrefix = "openshift.io"
+	// resourceHugePages1Gi is the resource name of 1Gi hugepages.
+	resourceHugePages1Gi = "hugepages-1Gi"
+	// sctpServerContainerName is the name of the container running the SCTP echo server.
+	sctpServerContainerName = "sctp-server"
+	// testpmdContainerName is the name of the container running testpmd.
+	testpmdContainerName = "testpmd"
+)
+
+// NewSCTPServerBuilder creates a new instance of Builder for a pod running an SCTP echo server on port. If networks
+// are provided, they are attached as secondary networks so the server can be reached over them. The image must provide
+// ncat.
+func NewSCTPServerBuilder(
+	apiClient *clients.Settings,
+	name, nsname, image string,
+	port int32,
+	networks []*multus.NetworkSelectionElement) *Builder {
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	klog.V(100).Infof("Initializing new SCTP server pod structure with the following params: "+
+		"name: %s, namespace: %s, image: %s, port: %d, networks: %v", name, nsname, image, port, networks)
+
+	builder := NewBuilder(apiClient, name, nsname, image)
+	if err := common.Validate(builder); err != nil {
+		return builder
+	}
+
+	if port < 1 || port > 65535 {
+		klog.V(100).Infof("The SCTP server port %d is invalid", port)
+
+		builder.SetError(fmt.Errorf("SCTP server port must be between 1 and 65535, got %d", port))
+
+		return builder
+	}
+
+	container, err := NewContainerBuilder(sctpServerContainerName, image, []string{
+		"ncat", "--sctp", "--listen", "--keep-open", "--exec", "/bin/cat", fmt.Sprintf("%d", port),
+	}).WithPorts([]corev1.ContainerPort{{
+		Name:          "sctp",
+		ContainerPort: port,
+		Protocol:      corev1.ProtocolSCTP,
+	}}).GetContainerCfg()
+	if err != nil {
+		klog.V(100).Infof("Failed to define the SCTP server container: %v", err)
+
+		builder.SetError(err)
+
+		return builder
+	}
+
+	builder.Definition.Spec.Containers[0] = *container
+
+	if len(networks) > 0 {
+		builder = builder.WithSecondaryNetwork(networks)
+	}
+
+	return builder
+}
+
+// NewDPDKTestpmdBuilder creates a new instance of Builder for a pod running testpmd in mac forwarding mode. One VF of
+// the SR-IOV resourceName is requested for each of the networks, which must be NADs backed by that resource. If
+// resourceName has no prefix, openshift.io is used. The cpu, memory, and 1Gi hugePages requests are set equal to the
+// limits so the pod has the Guaranteed QoS class required for CPU pinning. The image must provide testpmd.
+func NewDPDKTestpmdBuilder(
+	apiClient *clients.Settings,
+	name, nsname, image, resourceName string,
+	networks []*multus.NetworkSelectionElement,
+	cpu int64,
+	memory, hugePages string) *Builder {
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	klog.V(100).Infof("Initializing new DPDK testpmd pod structure with the following params: "+
+		"name: %s, namespace: %s, image: %s, resourceName: %s, networks: %v, cpu: %d, memory: %s, hugePages: %s",
+		name, nsname, image, resourceName, networks, cpu, memory, hugePages)
+
+	builder := NewBuilder(apiClient, name, nsname, image)
+	if err := common.Validate(builder); err != nil {
+		return builder
+	}
+
+	if resourceName == "" {
+		klog.V(100).Info("The DPDK resourceName is empty")
+
+		builder.SetError(fmt.Errorf("DPDK 'resourceName' cannot be empty"))
+
+		return builder
+	}
+
+	if len(networks) == 0 {
+		klog.V(100).Info("The DPDK networks are empty")
+
+		builder.SetError(fmt.Errorf("DPDK 'networks' cannot be empty"))
+
+		return builder
+	}
+
+	if cpu < 1 {
+		klog.V(100).Infof("The DPDK cpu %d is invalid", cpu)
+
+		builder.SetError(fmt.Errorf("DPDK 'cpu' must be greater than 0"))
+
+		return builder
+	}
+
+	memoryQuantity, err := resource.ParseQuantity(memory)
+	if err != nil {
+		klog.V(100).Infof("The DPDK memory %s is invalid: %v", memory, err)
+
+		builder.SetError(fmt.Errorf("DPDK 'memory' is invalid: %w", err))
+
+		return builder
+	}
+
+	hugePagesQuantity, err := resource.ParseQuantity(hugePages)
+	if err != nil {
+		klog.V(100).Infof("The DPDK hugePages %s is invalid: %v", hugePages, err)
+
+		builder.SetError(fmt.Errorf("DPDK 'hugePages' is invalid: %w", err))
+
+		return builder
+	}
+
+	if !strings.Contains(resourceName, "/") {
+		resourceName = fmt.Sprintf("%s/%s", defaultSRIOVResourcePrefix, resourceName)
+	}
+
+	resources := corev1.ResourceList{
+		corev1.ResourceCPU:                *resource.NewQuantity(cpu, resource.DecimalSI),
+		corev1.ResourceMemory:             memoryQuantity,
+		resourceHugePages1Gi:              hugePagesQuantity,
+		corev1.ResourceName(resourceName): *resource.NewQuantity(int64(len(networks)), resource.DecimalSI),
+	}
+
+	container, err := NewContainerBuilder(testpmdContainerName, image, getTestpmdCommand(resourceName)).
+		WithSecurityContext(dpdkSecurityContext()).
+		WithCustomResourcesRequests(resources).
+		WithCustomResourcesLimits(resources.DeepCopy()).
+		GetContainerCfg()
+	if err != nil {
+		klog.V(100).Infof("Failed to define the testpmd container: %v", err)
+
+		builder.SetError(err)
+
+		return builder
+	}
+
+	builder.Definition.Spec.Containers[0] = *container
+
+	return builder.WithSecondaryNetwork(networks).WithHugePages()
+}
+
+// getTestpmdCommand returns the command that runs testpmd in mac forwarding mode on the PCI devices allocated from
+// resourceName. The device plugin exposes their addresses in a comma separated PCIDEVICE environment variable.
+// Statistics are printed every 10 seconds until the pod is deleted.
+func getTestpmdCommand(resourceName string) []string {
+	envName := "PCIDEVICE_" + strings.ToUpper(strings.NewReplacer(".", "_", "/", "_", "-", "_").Replace(resourceName))
+
+	return []string{
+		defaultShellBinBash, "-c",
+		fmt.Sprintf("exec testpmd $(echo ${%s} | tr ',' '\\n' | sed 's/^/-a /') -- "+
+			"--forward-mode=mac --auto-start --stats-period=10", envName),
+	}
+}
@@ -0,0 +1,167 @@
+package pod
+
+import (
+	"testing"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	commonerrors "github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common/errors"
+	"github.com/stretchr/testify/assert"
+	multus "gopkg.in/k8snetworkplumbingwg/multus-cni.v4/pkg/types"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+var defaultWorkloadNetworks = []*multus.NetworkSelectionElement{{Name: "dpdk-net-1"}, {Name: "dpdk-net-2"}}
+
+func TestNewSCTPServerBuilder(t *testing.T) {
+	testCases := []struct {
+		name          string
+		port          int32
+		networks      []*multus.NetworkSelectionElement
+		expectedError string
+	}{
+		{
+			name:     defaultPodName,
+			port:     30100,
+			networks: defaultWorkloadNetworks,
+		},
+		{
+			name: defaultPodName,
+			port: 30100,
+		},
+		{
+			name:          defaultPodName,
+			port:          0,
+			expectedError: "SCTP server port must be between 1 and 65535, got 0",
+		},
+		{
+			name:          "",
+			port:          30100,
+			expectedError: "name of the builder for Pod is empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := NewSCTPServerBuilder(clients.GetTestClients(clients.TestClientParams{}),
+			testCase.name, defaultPodNsName, defaultPodImage, testCase.port, testCase.networks)
+		assertBuilderError(t, testCase.expectedError, testBuilder)
+
+		if testCase.expectedError != "" {
+			continue
+		}
+
+		container := testBuilder.Definition.Spec.Containers[0]
+		assert.Equal(t, sctpServerContainerName, container.Name)
+		assert.Contains(t, container.Command, "--sctp")
+		assert.Equal(t, []corev1.ContainerPort{{Name: "sctp", ContainerPort: testCase.port, Protocol: corev1.ProtocolSCTP}},
+			container.Ports)
+
+		if len(testCase.networks) > 0 {
+			assert.Contains(t, testBuilder.Definition.Annotations, "k8s.v1.cni.cncf.io/networks")
+		} else {
+			assert.Empty(t, testBuilder.Definition.Annotations)
+		}
+	}
+
+	testBuilder := NewSCTPServerBuilder(nil, defaultPodName, defaultPodNsName, defaultPodImage, 30100, nil)
+	assert.True(t, commonerrors.IsAPIClientNil(testBuilder.GetError()))
+}
+
+func TestNewDPDKTestpmdBuilder(t *testing.T) {
+	testCases := []struct {
+		resourceName         string
+		networks             []*multus.NetworkSelectionElement
+		cpu                  int64
+		memory               string
+		hugePages            string
+		expectedResourceName corev1.ResourceName
+		expectedError        string
+	}{
+		{
+			resourceName:         "dpdk_nic",
+			networks:             defaultWorkloadNetworks,
+			cpu:                  4,
+			memory:               "1Gi",
+			hugePages:            "2Gi",
+			expectedResourceName: "openshift.io/dpdk_nic",
+		},
+		{
+			resourceName:         "example.com/dpdk_nic",
+			networks:             defaultWorkloadNetworks[:1],
+			cpu:                  2,
+			memory:               "512Mi",
+			hugePages:            "1Gi",
+			expectedResourceName: "example.com/dpdk_nic",
+		},
+		{
+			resourceName:  "",
+			networks:      defaultWorkloadNetworks,
+			cpu:           4,
+			memory:        "1Gi",
+			hugePages:     "2Gi",
+			expectedError: "DPDK 'resourceName' cannot be empty",
+		},
+		{
+			resourceName:  "dpdk_nic",
+			cpu:           4,
+			memory:        "1Gi",
+			hugePages:     "2Gi",
+			expectedError: "DPDK 'networks' cannot be empty",
+		},
+		{
+			resourceName:  "dpdk_nic",
+			networks:      defaultWorkloadNetworks,
+			cpu:           0,
+			memory:        "1Gi",
+			hugePages:     "2Gi",
+			expectedError: "DPDK 'cpu' must be greater than 0",
+		},
+		{
+			resourceName: "dpdk_nic",
+			networks:     defaultWorkloadNetworks,
+			cpu:          4,
+			memory:       "invalid",
+			hugePages:    "2Gi",
+			expectedError: "DPDK 'memory' is invalid: quantities must match the regular expression " +
+				"'^([+-]?[0-9.]+)([eEinumkKMGTP]*[-+]?[0-9]*)$'",
+		},
+		{
+			resourceName: "dpdk_nic",
+			networks:     defaultWorkloadNetworks,
+			cpu:          4,
+			memory:       "1Gi",
+			hugePages:    "",
+			expectedError: "DPDK 'hugePages' is invalid: quantities must match the regular expression " +
+				"'^([+-]?[0-9.]+)([eEinumkKMGTP]*[-+]?[0-9]*)$'",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := NewDPDKTestpmdBuilder(clients.GetTestClients(clients.TestClientParams{}),
+			defaultPodName, defaultPodNsName, defaultPodImage, testCase.resourceName, testCase.networks,
+			testCase.cpu, testCase.memory, testCase.hugePages)
+		assertBuilderError(t, testCase.expectedError, testBuilder)
+
+		if testCase.expectedError != "" {
+			continue
+		}
+
+		container := testBuilder.Definition.Spec.Containers[0]
+		assert.Equal(t, testpmdContainerName, container.Name)
+		assert.Equal(t, container.Resources.Requests, container.Resources.Limits)
+		assert.Equal(t, *resource.NewQuantity(int64(len(testCase.networks)), resource.DecimalSI),
+			container.Resources.Limits[testCase.expectedResourceName])
+		assert.Equal(t, resource.MustParse(testCase.hugePages), container.Resources.Limits[resourceHugePages1Gi])
+		assert.Equal(t, int64(0), *container.SecurityContext.RunAsUser)
+		assert.Contains(t, container.VolumeMounts, corev1.VolumeMount{Name: volumeNameHugepages, MountPath: "/mnt/huge"})
+		assert.Contains(t, testBuilder.Definition.Annotations, "k8s.v1.cni.cncf.io/networks")
+	}
+}
+
+func TestGetTestpmdCommand(t *testing.T) {
+	command := getTestpmdCommand("openshift.io/dpdk-nic")
+
+	assert.Len(t, command, 3)
+	assert.Contains(t, command[2], "${PCIDEVICE_OPENSHIFT_IO_DPDK_NIC}")
+	assert.Contains(t, command[2], "--forward-mode=mac")
+}
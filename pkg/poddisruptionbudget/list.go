@@ -13,6 +13,8 @@ import (
 
 // List returns podDisruptionBudget inventory in the given namespace.
 func List(apiClient *clients.Settings, nsname string, options ...metav1.ListOptions) ([]*Builder, error) {
+	nsname = apiClient.ResolveNamespace(nsname)
+
 	if apiClient == nil {
 		klog.V(100).Info("podDisruptionBudget apiClient is empty")
 
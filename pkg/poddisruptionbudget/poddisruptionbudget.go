@@ -30,6 +30,8 @@ type Builder struct {
 
 // NewBuilder creates a new PodDisruptionBudget builder.
 func NewBuilder(apiClient *clients.Settings, name, nsname string) *Builder {
+	nsname = apiClient.ResolveNamespace(nsname)
+
 	klog.V(100).Infof("Initializing new PodDisruptionBudget structure with the following params: "+
 		"name=%s, namespace=%s", name, nsname)
 
@@ -70,6 +72,8 @@ func NewBuilder(apiClient *clients.Settings, name, nsname string) *Builder {
 
 // Pull retrieves the PodDisruptionBudget from the cluster.
 func Pull(apiClient *clients.Settings, name, nsname string) (*Builder, error) {
+	nsname = apiClient.ResolveNamespace(nsname)
+
 	if apiClient == nil {
 		klog.V(100).Info("apiClient is nil")
 
@@ -0,0 +1,176 @@
+// Package podnetwork parses the k8s.v1.cni.cncf.io/network-status annotation that Multus sets on pods, which lists
+// each network attached to the pod with its interface name, IP addresses, MAC address and, for device plugin backed
+// networks such as SR-IOV, the device info containing the PCI address of the allocated device.
+//
+// Networks attached through a NetworkAttachmentDefinition are named <namespace>/<name> in the annotation while the
+// cluster default network is named after its CNI, such as ovn-kubernetes.
+package podnetwork
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/netip"
+	"strings"
+
+	nadv1 "github.com/k8snetworkplumbingwg/network-attachment-definition-client/pkg/apis/k8s.cni.cncf.io/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/klog/v2"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/netparam"
+)
+
+// NetworkStatusAnnotation is the pod annotation Multus sets to the status of the networks attached to the pod.
+const NetworkStatusAnnotation = nadv1.NetworkStatusAnnot
+
+// ParseNetworkStatus parses the value of the network-status annotation into the status of each network.
+func ParseNetworkStatus(annotation string) ([]nadv1.NetworkStatus, error) {
+	if annotation == "" {
+		return nil, fmt.Errorf("network-status annotation cannot be empty")
+	}
+
+	var statuses []nadv1.NetworkStatus
+
+	if err := json.Unmarshal([]byte(annotation), &statuses); err != nil {
+		return nil, fmt.Errorf("failed to parse network-status annotation: %w", err)
+	}
+
+	return statuses, nil
+}
+
+// GetNetworkStatus returns the status of each network attached to the pod, as reported by its network-status
+// annotation. It returns an error if the pod does not have the annotation, for example because Multus has not yet
+// attached its networks.
+func GetNetworkStatus(pod *corev1.Pod) ([]nadv1.NetworkStatus, error) {
+	if pod == nil {
+		return nil, fmt.Errorf("pod cannot be nil")
+	}
+
+	klog.V(100).Infof("Getting network status of pod %s in namespace %s", pod.Name, pod.Namespace)
+
+	annotation, ok := pod.Annotations[NetworkStatusAnnotation]
+	if !ok {
+		return nil, fmt.Errorf("pod %s in namespace %s has no %s annotation",
+			pod.Name, pod.Namespace, NetworkStatusAnnotation)
+	}
+
+	statuses, err := ParseNetworkStatus(annotation)
+	if err != nil {
+		return nil, fmt.Errorf("pod %s in namespace %s: %w", pod.Name, pod.Namespace, err)
+	}
+
+	return statuses, nil
+}
+
+// GetNetworkStatusByNetwork returns the status of the network with the provided name attached to the pod. The name may
+// be given as <namespace>/<name> or, for networks in the namespace of the pod, as just the name of the
+// NetworkAttachmentDefinition. If the network is attached more than once, the first attachment is returned.
+func GetNetworkStatusByNetwork(pod *corev1.Pod, networkName string) (*nadv1.NetworkStatus, error) {
+	if networkName == "" {
+		return nil, fmt.Errorf("network status 'networkName' cannot be empty")
+	}
+
+	statuses, err := GetNetworkStatus(pod)
+	if err != nil {
+		return nil, err
+	}
+
+	qualifiedName := networkName
+	if !strings.Contains(networkName, "/") {
+		qualifiedName = fmt.Sprintf("%s/%s", pod.Namespace, networkName)
+	}
+
+	for idx := range statuses {
+		if statuses[idx].Name == networkName || statuses[idx].Name == qualifiedName {
+			return &statuses[idx], nil
+		}
+	}
+
+	return nil, fmt.Errorf("network %s not found in network status of pod %s in namespace %s",
+		networkName, pod.Name, pod.Namespace)
+}
+
+// GetNetworkStatusByInterface returns the status of the network attached to the pod on the interface with the provided
+// name, such as net1.
+func GetNetworkStatusByInterface(pod *corev1.Pod, interfaceName string) (*nadv1.NetworkStatus, error) {
+	if interfaceName == "" {
+		return nil, fmt.Errorf("network status 'interfaceName' cannot be empty")
+	}
+
+	statuses, err := GetNetworkStatus(pod)
+	if err != nil {
+		return nil, err
+	}
+
+	for idx := range statuses {
+		if statuses[idx].Interface == interfaceName {
+			return &statuses[idx], nil
+		}
+	}
+
+	return nil, fmt.Errorf("interface %s not found in network status of pod %s in namespace %s",
+		interfaceName, pod.Name, pod.Namespace)
+}
+
+// GetDefaultNetworkStatus returns the status of the cluster default network of the pod.
+func GetDefaultNetworkStatus(pod *corev1.Pod) (*nadv1.NetworkStatus, error) {
+	statuses, err := GetNetworkStatus(pod)
+	if err != nil {
+		return nil, err
+	}
+
+	for idx := range statuses {
+		if statuses[idx].Default {
+			return &statuses[idx], nil
+		}
+	}
+
+	return nil, fmt.Errorf("default network not found in network status of pod %s in namespace %s",
+		pod.Name, pod.Namespace)
+}
+
+// GetIPAddresses returns the parsed IP addresses of the network status. It returns an error if the network has no IP
+// addresses, which is expected for networks without IPAM.
+func GetIPAddresses(status *nadv1.NetworkStatus) ([]netip.Addr, error) {
+	if status == nil {
+		return nil, fmt.Errorf("network status cannot be nil")
+	}
+
+	if len(status.IPs) == 0 {
+		return nil, fmt.Errorf("network %s has no IP addresses", status.Name)
+	}
+
+	ipAddresses := make([]netip.Addr, 0, len(status.IPs))
+
+	for _, address := range status.IPs {
+		ipAddress, err := netparam.ParseIP(address)
+		if err != nil {
+			return nil, fmt.Errorf("network %s: %w", status.Name, err)
+		}
+
+		ipAddresses = append(ipAddresses, ipAddress)
+	}
+
+	return ipAddresses, nil
+}
+
+// GetPCIAddress returns the PCI address of the device allocated to the network, such as the SR-IOV VF, from the device
+// info of the network status. Both PCI and vDPA devices are supported.
+func GetPCIAddress(status *nadv1.NetworkStatus) (string, error) {
+	if status == nil {
+		return "", fmt.Errorf("network status cannot be nil")
+	}
+
+	if status.DeviceInfo == nil {
+		return "", fmt.Errorf("network %s has no device info", status.Name)
+	}
+
+	switch {
+	case status.DeviceInfo.Pci != nil && status.DeviceInfo.Pci.PciAddress != "":
+		return status.DeviceInfo.Pci.PciAddress, nil
+	case status.DeviceInfo.Vdpa != nil && status.DeviceInfo.Vdpa.PciAddress != "":
+		return status.DeviceInfo.Vdpa.PciAddress, nil
+	default:
+		return "", fmt.Errorf("network %s has no PCI address in device info of type %s",
+			status.Name, status.DeviceInfo.Type)
+	}
+}
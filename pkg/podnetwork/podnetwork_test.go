@@ -0,0 +1,307 @@
+package podnetwork
+
+import (
+	"net/netip"
+	"testing"
+
+	nadv1 "github.com/k8snetworkplumbingwg/network-attachment-definition-client/pkg/apis/k8s.cni.cncf.io/v1"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	defaultPodName      = "test-pod"
+	defaultPodNamespace = "test-ns"
+	dummyNetworkStatus  = `[{
+    "name": "ovn-kubernetes",
+    "interface": "eth0",
+    "ips": ["10.128.2.15", "fd01:0:0:5::f"],
+    "mac": "0a:58:0a:80:02:0f",
+    "default": true,
+    "dns": {}
+},{
+    "name": "test-ns/sriov-net",
+    "interface": "net1",
+    "ips": ["192.0.2.10"],
+    "mac": "52:54:00:12:34:56",
+    "dns": {},
+    "device-info": {
+        "type": "pci",
+        "version": "1.1.0",
+        "pci": {"pci-address": "0000:3b:02.1", "pf-pci-address": "0000:3b:00.0"}
+    }
+},{
+    "name": "other-ns/macvlan-net",
+    "interface": "net2",
+    "mac": "52:54:00:65:43:21",
+    "dns": {}
+}]`
+)
+
+func TestParseNetworkStatus(t *testing.T) {
+	testCases := []struct {
+		annotation    string
+		expectedCount int
+		expectedError string
+	}{
+		{
+			annotation:    dummyNetworkStatus,
+			expectedCount: 3,
+		},
+		{
+			annotation:    "",
+			expectedError: "network-status annotation cannot be empty",
+		},
+		{
+			annotation: "{",
+			expectedError: "failed to parse network-status annotation: " +
+				"unexpected end of JSON input",
+		},
+	}
+
+	for _, testCase := range testCases {
+		statuses, err := ParseNetworkStatus(testCase.annotation)
+		if testCase.expectedError != "" {
+			assert.EqualError(t, err, testCase.expectedError)
+
+			continue
+		}
+
+		assert.NoError(t, err)
+		assert.Len(t, statuses, testCase.expectedCount)
+	}
+}
+
+func TestGetNetworkStatus(t *testing.T) {
+	testCases := []struct {
+		pod           *corev1.Pod
+		expectedCount int
+		expectedError string
+	}{
+		{
+			pod:           buildDummyPodWithNetworkStatus(dummyNetworkStatus),
+			expectedCount: 3,
+		},
+		{
+			pod:           nil,
+			expectedError: "pod cannot be nil",
+		},
+		{
+			pod: buildDummyPodWithNetworkStatus(""),
+			expectedError: "pod test-pod in namespace test-ns has no k8s.v1.cni.cncf.io/network-status " +
+				"annotation",
+		},
+		{
+			pod: buildDummyPodWithNetworkStatus("[{"),
+			expectedError: "pod test-pod in namespace test-ns: failed to parse network-status annotation: " +
+				"unexpected end of JSON input",
+		},
+	}
+
+	for _, testCase := range testCases {
+		statuses, err := GetNetworkStatus(testCase.pod)
+		if testCase.expectedError != "" {
+			assert.EqualError(t, err, testCase.expectedError)
+
+			continue
+		}
+
+		assert.NoError(t, err)
+		assert.Len(t, statuses, testCase.expectedCount)
+	}
+}
+
+func TestGetNetworkStatusByNetwork(t *testing.T) {
+	testCases := []struct {
+		networkName       string
+		expectedInterface string
+		expectedError     string
+	}{
+		{
+			networkName:       "test-ns/sriov-net",
+			expectedInterface: "net1",
+		},
+		{
+			networkName:       "sriov-net",
+			expectedInterface: "net1",
+		},
+		{
+			networkName:       "other-ns/macvlan-net",
+			expectedInterface: "net2",
+		},
+		{
+			networkName:       "ovn-kubernetes",
+			expectedInterface: "eth0",
+		},
+		{
+			networkName:   "macvlan-net",
+			expectedError: "network macvlan-net not found in network status of pod test-pod in namespace test-ns",
+		},
+		{
+			networkName:   "",
+			expectedError: "network status 'networkName' cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		status, err := GetNetworkStatusByNetwork(buildDummyPodWithNetworkStatus(dummyNetworkStatus), testCase.networkName)
+		if testCase.expectedError != "" {
+			assert.EqualError(t, err, testCase.expectedError)
+
+			continue
+		}
+
+		assert.NoError(t, err)
+		assert.Equal(t, testCase.expectedInterface, status.Interface)
+	}
+}
+
+func TestGetNetworkStatusByInterface(t *testing.T) {
+	testCases := []struct {
+		interfaceName string
+		expectedName  string
+		expectedError string
+	}{
+		{
+			interfaceName: "net1",
+			expectedName:  "test-ns/sriov-net",
+		},
+		{
+			interfaceName: "net3",
+			expectedError: "interface net3 not found in network status of pod test-pod in namespace test-ns",
+		},
+		{
+			interfaceName: "",
+			expectedError: "network status 'interfaceName' cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		status, err := GetNetworkStatusByInterface(
+			buildDummyPodWithNetworkStatus(dummyNetworkStatus), testCase.interfaceName)
+		if testCase.expectedError != "" {
+			assert.EqualError(t, err, testCase.expectedError)
+
+			continue
+		}
+
+		assert.NoError(t, err)
+		assert.Equal(t, testCase.expectedName, status.Name)
+	}
+}
+
+func TestGetDefaultNetworkStatus(t *testing.T) {
+	status, err := GetDefaultNetworkStatus(buildDummyPodWithNetworkStatus(dummyNetworkStatus))
+	assert.NoError(t, err)
+	assert.Equal(t, "ovn-kubernetes", status.Name)
+
+	_, err = GetDefaultNetworkStatus(buildDummyPodWithNetworkStatus(`[{"name": "test-ns/sriov-net"}]`))
+	assert.EqualError(t, err, "default network not found in network status of pod test-pod in namespace test-ns")
+}
+
+func TestGetIPAddresses(t *testing.T) {
+	testCases := []struct {
+		status        *nadv1.NetworkStatus
+		expectedIPs   []netip.Addr
+		expectedError string
+	}{
+		{
+			status: &nadv1.NetworkStatus{Name: "ovn-kubernetes", IPs: []string{"10.128.2.15", "fd01:0:0:5::f"}},
+			expectedIPs: []netip.Addr{
+				netip.MustParseAddr("10.128.2.15"), netip.MustParseAddr("fd01:0:0:5::f"),
+			},
+		},
+		{
+			status:        &nadv1.NetworkStatus{Name: "test-ns/macvlan-net"},
+			expectedError: "network test-ns/macvlan-net has no IP addresses",
+		},
+		{
+			status:        &nadv1.NetworkStatus{Name: "test-ns/macvlan-net", IPs: []string{"192.0.2"}},
+			expectedError: "network test-ns/macvlan-net: \"192.0.2\" is not a valid IP address",
+		},
+		{
+			status:        nil,
+			expectedError: "network status cannot be nil",
+		},
+	}
+
+	for _, testCase := range testCases {
+		ipAddresses, err := GetIPAddresses(testCase.status)
+		if testCase.expectedError != "" {
+			assert.EqualError(t, err, testCase.expectedError)
+
+			continue
+		}
+
+		assert.NoError(t, err)
+		assert.Equal(t, testCase.expectedIPs, ipAddresses)
+	}
+}
+
+func TestGetPCIAddress(t *testing.T) {
+	testCases := []struct {
+		status          *nadv1.NetworkStatus
+		expectedAddress string
+		expectedError   string
+	}{
+		{
+			status: &nadv1.NetworkStatus{
+				Name: "test-ns/sriov-net",
+				DeviceInfo: &nadv1.DeviceInfo{
+					Type: nadv1.DeviceInfoTypePCI, Pci: &nadv1.PciDevice{PciAddress: "0000:3b:02.1"}},
+			},
+			expectedAddress: "0000:3b:02.1",
+		},
+		{
+			status: &nadv1.NetworkStatus{
+				Name: "test-ns/vdpa-net",
+				DeviceInfo: &nadv1.DeviceInfo{
+					Type: nadv1.DeviceInfoTypeVDPA, Vdpa: &nadv1.VdpaDevice{PciAddress: "0000:3b:02.2"}},
+			},
+			expectedAddress: "0000:3b:02.2",
+		},
+		{
+			status: &nadv1.NetworkStatus{
+				Name: "test-ns/memif-net", DeviceInfo: &nadv1.DeviceInfo{Type: nadv1.DeviceInfoTypeMemif}},
+			expectedError: "network test-ns/memif-net has no PCI address in device info of type memif",
+		},
+		{
+			status:        &nadv1.NetworkStatus{Name: "test-ns/macvlan-net"},
+			expectedError: "network test-ns/macvlan-net has no device info",
+		},
+		{
+			status:        nil,
+			expectedError: "network status cannot be nil",
+		},
+	}
+
+	for _, testCase := range testCases {
+		pciAddress, err := GetPCIAddress(testCase.status)
+		if testCase.expectedError != "" {
+			assert.EqualError(t, err, testCase.expectedError)
+
+			continue
+		}
+
+		assert.NoError(t, err)
+		assert.Equal(t, testCase.expectedAddress, pciAddress)
+	}
+}
+
+// buildDummyPodWithNetworkStatus returns a pod with the provided network-status annotation. If the annotation is
+// empty, the pod has no annotations.
+func buildDummyPodWithNetworkStatus(annotation string) *corev1.Pod {
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      defaultPodName,
+			Namespace: defaultPodNamespace,
+		},
+	}
+
+	if annotation != "" {
+		pod.Annotations = map[string]string{NetworkStatusAnnotation: annotation}
+	}
+
+	return pod
+}
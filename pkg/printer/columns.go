@@ -0,0 +1,174 @@
+package printer
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
+	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// nodeRoleLabelPrefix is the prefix of the labels used to assign roles to nodes.
+const nodeRoleLabelPrefix = "node-role.kubernetes.io/"
+
+// kindColumns returns the columns specific to the kind of object, which follow the default columns.
+func kindColumns(object runtimeclient.Object) []Column {
+	switch object.(type) {
+	case *corev1.Pod:
+		return []Column{
+			{Header: "STATUS", Value: podValue(func(pod *corev1.Pod) string { return string(pod.Status.Phase) })},
+			{Header: "RESTARTS", Value: podValue(getPodRestarts)},
+			{Header: "NODE", Value: podValue(func(pod *corev1.Pod) string { return valueOrNone(pod.Spec.NodeName) })},
+		}
+	case *appsv1.Deployment:
+		return []Column{
+			{Header: "UP-TO-DATE", Value: deploymentValue(func(deployment *appsv1.Deployment) string {
+				return fmt.Sprint(deployment.Status.UpdatedReplicas)
+			})},
+			{Header: "AVAILABLE", Value: deploymentValue(func(deployment *appsv1.Deployment) string {
+				return fmt.Sprint(deployment.Status.AvailableReplicas)
+			})},
+		}
+	case *corev1.Node:
+		return []Column{
+			{Header: "ROLES", Value: nodeValue(getNodeRoles)},
+			{Header: "VERSION", Value: nodeValue(func(node *corev1.Node) string {
+				return node.Status.NodeInfo.KubeletVersion
+			})},
+		}
+	case *corev1.PersistentVolumeClaim:
+		return []Column{
+			{Header: "STATUS", Value: pvcValue(func(pvc *corev1.PersistentVolumeClaim) string {
+				return string(pvc.Status.Phase)
+			})},
+			{Header: "VOLUME", Value: pvcValue(func(pvc *corev1.PersistentVolumeClaim) string {
+				return valueOrNone(pvc.Spec.VolumeName)
+			})},
+			{Header: "CAPACITY", Value: pvcValue(getPVCCapacity)},
+		}
+	case *corev1.Service:
+		return []Column{
+			{Header: "TYPE", Value: serviceValue(func(service *corev1.Service) string { return string(service.Spec.Type) })},
+			{Header: "CLUSTER-IP", Value: serviceValue(func(service *corev1.Service) string {
+				return valueOrNone(service.Spec.ClusterIP)
+			})},
+		}
+	default:
+		return nil
+	}
+}
+
+// getWorkloadReady returns the ready and desired replicas of workloads, such as 2/3. It returns false if the object
+// is not a workload.
+func getWorkloadReady(object runtimeclient.Object) (string, bool) {
+	switch typedObject := object.(type) {
+	case *corev1.Pod:
+		ready := 0
+
+		for _, status := range typedObject.Status.ContainerStatuses {
+			if status.Ready {
+				ready++
+			}
+		}
+
+		return fmt.Sprintf("%d/%d", ready, len(typedObject.Spec.Containers)), true
+	case *appsv1.Deployment:
+		return fmt.Sprintf("%d/%d",
+			typedObject.Status.ReadyReplicas, ptr.Deref(typedObject.Spec.Replicas, 1)), true
+	case *appsv1.StatefulSet:
+		return fmt.Sprintf("%d/%d",
+			typedObject.Status.ReadyReplicas, ptr.Deref(typedObject.Spec.Replicas, 1)), true
+	case *appsv1.ReplicaSet:
+		return fmt.Sprintf("%d/%d",
+			typedObject.Status.ReadyReplicas, ptr.Deref(typedObject.Spec.Replicas, 1)), true
+	case *appsv1.DaemonSet:
+		return fmt.Sprintf("%d/%d",
+			typedObject.Status.NumberReady, typedObject.Status.DesiredNumberScheduled), true
+	default:
+		return "", false
+	}
+}
+
+// getPodRestarts returns the total number of container restarts of the pod.
+func getPodRestarts(pod *corev1.Pod) string {
+	var restarts int32
+
+	for _, status := range pod.Status.ContainerStatuses {
+		restarts += status.RestartCount
+	}
+
+	return fmt.Sprint(restarts)
+}
+
+// getNodeRoles returns the comma separated roles of the node, sorted alphabetically.
+func getNodeRoles(node *corev1.Node) string {
+	var roles []string
+
+	for label := range node.Labels {
+		if role, found := strings.CutPrefix(label, nodeRoleLabelPrefix); found && role != "" {
+			roles = append(roles, role)
+		}
+	}
+
+	slices.Sort(roles)
+
+	return valueOrNone(strings.Join(roles, ","))
+}
+
+// getPVCCapacity returns the storage capacity of the bound PersistentVolumeClaim.
+func getPVCCapacity(pvc *corev1.PersistentVolumeClaim) string {
+	capacity, ok := pvc.Status.Capacity[corev1.ResourceStorage]
+	if !ok {
+		return noneValue
+	}
+
+	return capacity.String()
+}
+
+// typedValue converts a function of a typed object into a column value function. Objects of other types, which only
+// happen when a table mixes kinds, have an unknown value.
+func typedValue[T runtimeclient.Object](value func(T) string) func(runtimeclient.Object) string {
+	return func(object runtimeclient.Object) string {
+		typedObject, ok := object.(T)
+		if !ok {
+			return unknownValue
+		}
+
+		return value(typedObject)
+	}
+}
+
+func podValue(value func(*corev1.Pod) string) func(runtimeclient.Object) string {
+	return typedValue(value)
+}
+
+func deploymentValue(value func(*appsv1.Deployment) string) func(runtimeclient.Object) string {
+	return typedValue(value)
+}
+
+func nodeValue(value func(*corev1.Node) string) func(runtimeclient.Object) string {
+	return typedValue(value)
+}
+
+func pvcValue(value func(*corev1.PersistentVolumeClaim) string) func(runtimeclient.Object) string {
+	return typedValue(value)
+}
+
+func serviceValue(value func(*corev1.Service) string) func(runtimeclient.Object) string {
+	return typedValue(value)
+}
+
+// isNil returns whether object is nil, including typed nil pointers stored in the interface.
+func isNil(object runtimeclient.Object) bool {
+	if object == nil {
+		return true
+	}
+
+	value := reflect.ValueOf(object)
+
+	return value.Kind() == reflect.Ptr && value.IsNil()
+}
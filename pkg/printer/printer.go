@@ -0,0 +1,190 @@
+// Package printer renders collections of builders as kubectl-like tables, so the state of the resources involved in a
+// test can be included in its logs. Every table has the NAME, NAMESPACE, READY and AGE columns, followed by columns
+// specific to the kind of the objects, such as STATUS and NODE for pods, and any columns provided by the caller.
+package printer
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/duration"
+	"k8s.io/utils/clock"
+	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// noneValue is printed for cells without a value, as kubectl does.
+	noneValue = "<none>"
+	// unknownValue is printed for cells whose value cannot be determined.
+	unknownValue = "<unknown>"
+)
+
+// Column is a column of a table. Value returns the cell of the column for an object.
+type Column struct {
+	Header string
+	Value  func(object runtimeclient.Object) string
+}
+
+// Printer renders objects as tables.
+type Printer struct {
+	extraColumns []Column
+	clock        clock.PassiveClock
+}
+
+// NewPrinter returns a Printer that appends the provided columns after the default and per-kind columns.
+func NewPrinter(extraColumns ...Column) *Printer {
+	return &Printer{extraColumns: extraColumns, clock: clock.RealClock{}}
+}
+
+// WithClock sets the clock used to compute the AGE column. It defaults to the real clock.
+func (printer *Printer) WithClock(passiveClock clock.PassiveClock) *Printer {
+	if passiveClock != nil {
+		printer.clock = passiveClock
+	}
+
+	return printer
+}
+
+// Fprint writes the objects to writer as a table. Nil objects, such as those of builders that were never created, are
+// skipped. Nothing is written if there are no objects left.
+func (printer *Printer) Fprint(writer io.Writer, objects []runtimeclient.Object) error {
+	objects = nonNilObjects(objects)
+	if len(objects) == 0 {
+		return nil
+	}
+
+	columns := printer.columns(objects[0])
+	tabWriter := tabwriter.NewWriter(writer, 6, 4, 3, ' ', 0)
+
+	headers := make([]string, 0, len(columns))
+	for _, column := range columns {
+		headers = append(headers, column.Header)
+	}
+
+	if _, err := fmt.Fprintln(tabWriter, strings.Join(headers, "\t")); err != nil {
+		return err
+	}
+
+	for _, object := range objects {
+		cells := make([]string, 0, len(columns))
+		for _, column := range columns {
+			cells = append(cells, column.Value(object))
+		}
+
+		if _, err := fmt.Fprintln(tabWriter, strings.Join(cells, "\t")); err != nil {
+			return err
+		}
+	}
+
+	return tabWriter.Flush()
+}
+
+// Render returns the objects as a table. It returns an empty string if there are no objects.
+func (printer *Printer) Render(objects []runtimeclient.Object) string {
+	var buffer bytes.Buffer
+
+	// Writing to a bytes.Buffer cannot fail.
+	_ = printer.Fprint(&buffer, objects)
+
+	return buffer.String()
+}
+
+// Render returns the objects as a table using a default Printer.
+func Render(objects []runtimeclient.Object) string {
+	return NewPrinter().Render(objects)
+}
+
+// BuilderObjects returns the objects of the provided builders, using getObject to get the object of each builder. It
+// works with any builder, for example printer.BuilderObjects(configMaps, (*configmap.Builder).GetObject) or
+// printer.BuilderObjects(pods, func(builder *pod.Builder) *corev1.Pod { return builder.Object }).
+func BuilderObjects[B any, O runtimeclient.Object](builders []B, getObject func(B) O) []runtimeclient.Object {
+	objects := make([]runtimeclient.Object, 0, len(builders))
+
+	for _, builder := range builders {
+		objects = append(objects, getObject(builder))
+	}
+
+	return objects
+}
+
+// columns returns the columns of a table for objects of the same kind as object.
+func (printer *Printer) columns(object runtimeclient.Object) []Column {
+	columns := []Column{
+		{Header: "NAME", Value: func(object runtimeclient.Object) string { return object.GetName() }},
+		{Header: "NAMESPACE", Value: func(object runtimeclient.Object) string {
+			return valueOrNone(object.GetNamespace())
+		}},
+		{Header: "READY", Value: getReady},
+		{Header: "AGE", Value: printer.getAge},
+	}
+
+	columns = append(columns, kindColumns(object)...)
+
+	return append(columns, printer.extraColumns...)
+}
+
+// getAge returns the time since the object was created in the short format used by kubectl, such as 5m or 3d.
+func (printer *Printer) getAge(object runtimeclient.Object) string {
+	creationTimestamp := object.GetCreationTimestamp()
+	if creationTimestamp.IsZero() {
+		return unknownValue
+	}
+
+	return duration.HumanDuration(printer.clock.Since(creationTimestamp.Time))
+}
+
+// getReady returns the readiness of the object. Workloads report ready and desired replicas, such as 2/3, and other
+// objects report the status of their Ready or Available condition.
+func getReady(object runtimeclient.Object) string {
+	if ready, ok := getWorkloadReady(object); ok {
+		return ready
+	}
+
+	unstructuredObject, err := runtime.DefaultUnstructuredConverter.ToUnstructured(object)
+	if err != nil {
+		return unknownValue
+	}
+
+	conditions, _, _ := unstructured.NestedSlice(unstructuredObject, "status", "conditions")
+
+	for _, conditionType := range []string{string(corev1.NodeReady), "Available"} {
+		for _, condition := range conditions {
+			conditionMap, ok := condition.(map[string]any)
+			if ok && conditionMap["type"] == conditionType {
+				if status, ok := conditionMap["status"].(string); ok {
+					return status
+				}
+			}
+		}
+	}
+
+	return noneValue
+}
+
+// nonNilObjects returns the objects that are not nil.
+func nonNilObjects(objects []runtimeclient.Object) []runtimeclient.Object {
+	var filtered []runtimeclient.Object
+
+	for _, object := range objects {
+		if !isNil(object) {
+			filtered = append(filtered, object)
+		}
+	}
+
+	return filtered
+}
+
+// valueOrNone returns value, or <none> if it is empty.
+func valueOrNone(value string) string {
+	if value == "" {
+		return noneValue
+	}
+
+	return value
+}
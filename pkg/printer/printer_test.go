@@ -0,0 +1,170 @@
+package printer
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/configmap"
+	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var testNow = time.Date(2025, time.January, 1, 12, 0, 0, 0, time.UTC)
+
+func TestPrinterRender(t *testing.T) {
+	testCases := []struct {
+		name         string
+		objects      []runtimeclient.Object
+		extraColumns []Column
+		expected     string
+	}{
+		{
+			name: "pods",
+			objects: []runtimeclient.Object{
+				buildDummyPod("pod-a", "node-0", true, 0),
+				buildDummyPod("pod-b", "", false, 3),
+			},
+			expected: "NAME    NAMESPACE   READY   AGE   STATUS    RESTARTS   NODE\n" +
+				"pod-a   test-ns     1/1     5m    Running   0          node-0\n" +
+				"pod-b   test-ns     0/1     5m    Pending   3          <none>\n",
+		},
+		{
+			name: "deployments",
+			objects: []runtimeclient.Object{
+				&appsv1.Deployment{
+					ObjectMeta: buildDummyObjectMeta("deployment", "test-ns"),
+					Spec:       appsv1.DeploymentSpec{Replicas: ptr.To[int32](3)},
+					Status:     appsv1.DeploymentStatus{ReadyReplicas: 2, UpdatedReplicas: 3, AvailableReplicas: 2},
+				},
+			},
+			expected: "NAME         NAMESPACE   READY   AGE   UP-TO-DATE   AVAILABLE\n" +
+				"deployment   test-ns     2/3     5m    3            2\n",
+		},
+		{
+			name: "nodes",
+			objects: []runtimeclient.Object{
+				&corev1.Node{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:              "node-0",
+						CreationTimestamp: metav1.NewTime(testNow.Add(-50 * time.Hour)),
+						Labels: map[string]string{
+							nodeRoleLabelPrefix + "worker":        "",
+							nodeRoleLabelPrefix + "control-plane": "",
+						},
+					},
+					Status: corev1.NodeStatus{
+						Conditions: []corev1.NodeCondition{{Type: corev1.NodeReady, Status: corev1.ConditionTrue}},
+						NodeInfo:   corev1.NodeSystemInfo{KubeletVersion: "v1.30.4"},
+					},
+				},
+			},
+			expected: "NAME     NAMESPACE   READY   AGE    ROLES                  VERSION\n" +
+				"node-0   <none>      True    2d2h   control-plane,worker   v1.30.4\n",
+		},
+		{
+			name: "persistent volume claims",
+			objects: []runtimeclient.Object{
+				&corev1.PersistentVolumeClaim{
+					ObjectMeta: buildDummyObjectMeta("pvc", "test-ns"),
+					Spec:       corev1.PersistentVolumeClaimSpec{VolumeName: "pv-0"},
+					Status: corev1.PersistentVolumeClaimStatus{
+						Phase:    corev1.ClaimBound,
+						Capacity: corev1.ResourceList{corev1.ResourceStorage: resource.MustParse("1Gi")},
+					},
+				},
+			},
+			expected: "NAME   NAMESPACE   READY    AGE   STATUS   VOLUME   CAPACITY\n" +
+				"pvc    test-ns     <none>   5m    Bound    pv-0     1Gi\n",
+		},
+		{
+			name: "other kinds with extra columns",
+			objects: []runtimeclient.Object{
+				&corev1.ConfigMap{
+					ObjectMeta: buildDummyObjectMeta("config", "test-ns"),
+					Data:       map[string]string{"a": "1", "b": "2"},
+				},
+				nil,
+				(*corev1.ConfigMap)(nil),
+			},
+			extraColumns: []Column{{Header: "KEYS", Value: func(object runtimeclient.Object) string {
+				return string(rune('0' + len(object.(*corev1.ConfigMap).Data)))
+			}}},
+			expected: "NAME     NAMESPACE   READY    AGE   KEYS\n" +
+				"config   test-ns     <none>   5m    2\n",
+		},
+		{
+			name:     "no objects",
+			objects:  []runtimeclient.Object{nil},
+			expected: "",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testPrinter := NewPrinter(testCase.extraColumns...).WithClock(clients.NewTestClock(testNow))
+
+			assert.Equal(t, testCase.expected, testPrinter.Render(testCase.objects))
+
+			var buffer bytes.Buffer
+
+			assert.NoError(t, testPrinter.Fprint(&buffer, testCase.objects))
+			assert.Equal(t, testCase.expected, buffer.String())
+		})
+	}
+}
+
+func TestRenderUnknownAge(t *testing.T) {
+	rendered := Render([]runtimeclient.Object{&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "config"}}})
+
+	assert.Equal(t, "NAME     NAMESPACE   READY    AGE\n"+
+		"config   <none>      <none>   <unknown>\n", rendered)
+}
+
+func TestBuilderObjects(t *testing.T) {
+	testSettings := clients.GetTestClients(clients.TestClientParams{})
+	created := configmap.NewBuilder(testSettings, "created", "test-ns")
+	created.Object = created.Definition
+	notCreated := configmap.NewBuilder(testSettings, "not-created", "test-ns")
+
+	objects := BuilderObjects([]*configmap.Builder{created, notCreated}, (*configmap.Builder).GetObject)
+
+	assert.Len(t, objects, 2)
+	assert.Equal(t, "created", objects[0].GetName())
+	assert.True(t, isNil(objects[1]))
+	assert.Contains(t, Render(objects), "created")
+	assert.NotContains(t, Render(objects), "not-created")
+}
+
+func buildDummyPod(name, nodeName string, ready bool, restarts int32) *corev1.Pod {
+	phase := corev1.PodRunning
+	if !ready {
+		phase = corev1.PodPending
+	}
+
+	return &corev1.Pod{
+		ObjectMeta: buildDummyObjectMeta(name, "test-ns"),
+		Spec: corev1.PodSpec{
+			NodeName:   nodeName,
+			Containers: []corev1.Container{{Name: "test"}},
+		},
+		Status: corev1.PodStatus{
+			Phase:             phase,
+			ContainerStatuses: []corev1.ContainerStatus{{Name: "test", Ready: ready, RestartCount: restarts}},
+		},
+	}
+}
+
+func buildDummyObjectMeta(name, nsname string) metav1.ObjectMeta {
+	return metav1.ObjectMeta{
+		Name:              name,
+		Namespace:         nsname,
+		CreationTimestamp: metav1.NewTime(testNow.Add(-5 * time.Minute)),
+	}
+}
@@ -0,0 +1,162 @@
+// Package progress provides a way for long running operations, such as the WaitUntil* methods of builders, to report
+// their progress while they wait. A Reporter is attached to the context passed to the operation and receives an Event
+// after every attempt, allowing test frameworks like ginkgo to show live progress instead of minutes of silence.
+package progress
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+// Event describes the progress of an operation after a single attempt.
+type Event struct {
+	// Operation is a short description of the operation, such as "pod test-ns/test to be Running".
+	Operation string
+	// Attempt is the number of the attempt, starting at 1.
+	Attempt int
+	// Elapsed is the time since the operation started.
+	Elapsed time.Duration
+	// State is the last observed state of the resource, such as the phase of a pod. It may be empty.
+	State string
+	// Done is true for the final event of the operation, which is reported once it succeeds or fails.
+	Done bool
+	// Err is the error of the attempt or, for the final event, the error returned by the operation.
+	Err error
+}
+
+// String returns a single line describing the event.
+func (event Event) String() string {
+	line := fmt.Sprintf("waiting for %s: attempt %d, elapsed %s", event.Operation, event.Attempt,
+		event.Elapsed.Round(time.Millisecond))
+
+	if event.State != "" {
+		line += fmt.Sprintf(", state: %s", event.State)
+	}
+
+	if event.Err != nil {
+		line += fmt.Sprintf(", error: %v", event.Err)
+	}
+
+	if event.Done {
+		line += ", done"
+	}
+
+	return line
+}
+
+// Reporter receives the progress events of operations. Implementations must be safe for concurrent use since
+// operations may run in parallel.
+type Reporter interface {
+	Report(event Event)
+}
+
+// ReporterFunc allows using an ordinary function as a Reporter.
+type ReporterFunc func(event Event)
+
+// Report calls reporterFunc with the event.
+func (reporterFunc ReporterFunc) Report(event Event) {
+	reporterFunc(event)
+}
+
+type reporterKey struct{}
+
+// WithReporter returns a copy of ctx with reporter attached. Operations that receive the returned context report their
+// progress to reporter.
+func WithReporter(ctx context.Context, reporter Reporter) context.Context {
+	return context.WithValue(ctx, reporterKey{}, reporter)
+}
+
+// FromContext returns the Reporter attached to ctx, or nil if there is none.
+func FromContext(ctx context.Context) Reporter {
+	if ctx == nil {
+		return nil
+	}
+
+	reporter, _ := ctx.Value(reporterKey{}).(Reporter)
+
+	return reporter
+}
+
+// WriterReporter is a Reporter that writes one line per event to a writer, such as GinkgoWriter or os.Stdout.
+type WriterReporter struct {
+	writer   io.Writer
+	interval time.Duration
+
+	mutex        sync.Mutex
+	lastReported map[string]time.Duration
+}
+
+// NewWriterReporter returns a WriterReporter writing to writer. To avoid flooding the output, events of an operation
+// are only written if at least interval has elapsed since the last one written. Final events are always written.
+func NewWriterReporter(writer io.Writer, interval time.Duration) *WriterReporter {
+	return &WriterReporter{writer: writer, interval: interval, lastReported: make(map[string]time.Duration)}
+}
+
+// Report writes the event to the writer unless it is too soon after the last event of the same operation.
+func (reporter *WriterReporter) Report(event Event) {
+	reporter.mutex.Lock()
+	defer reporter.mutex.Unlock()
+
+	lastReported, reported := reporter.lastReported[event.Operation]
+
+	switch {
+	case event.Done:
+		delete(reporter.lastReported, event.Operation)
+	case reported && event.Elapsed-lastReported < reporter.interval:
+		return
+	default:
+		reporter.lastReported[event.Operation] = event.Elapsed
+	}
+
+	_, _ = fmt.Fprintln(reporter.writer, event.String())
+}
+
+// ConditionFunc is the condition polled by PollUntilContextTimeout. Besides whether the operation is done, it returns
+// the observed state of the resource, which is included in the progress events.
+type ConditionFunc func(ctx context.Context) (done bool, state string, err error)
+
+// PollUntilContextTimeout behaves like wait.PollUntilContextTimeout, but reports an Event to the Reporter attached to
+// ctx after every attempt and once the operation finishes. If there is no Reporter, it only polls the condition.
+func PollUntilContextTimeout(
+	ctx context.Context,
+	interval, timeout time.Duration,
+	immediate bool,
+	operation string,
+	condition ConditionFunc) error {
+	reporter := FromContext(ctx)
+	start := time.Now()
+	attempt := 0
+
+	var lastState string
+
+	err := wait.PollUntilContextTimeout(ctx, interval, timeout, immediate, func(ctx context.Context) (bool, error) {
+		attempt++
+
+		done, state, err := condition(ctx)
+		lastState = state
+
+		if reporter != nil && !done && err == nil {
+			reporter.Report(Event{Operation: operation, Attempt: attempt, Elapsed: time.Since(start), State: state})
+		}
+
+		return done, err
+	})
+
+	if reporter != nil {
+		reporter.Report(Event{
+			Operation: operation,
+			Attempt:   attempt,
+			Elapsed:   time.Since(start),
+			State:     lastState,
+			Done:      true,
+			Err:       err,
+		})
+	}
+
+	return err
+}
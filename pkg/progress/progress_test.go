@@ -0,0 +1,160 @@
+package progress
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFromContext(t *testing.T) {
+	assert.Nil(t, FromContext(context.TODO()))
+
+	reporter := ReporterFunc(func(Event) {})
+	ctx := WithReporter(context.TODO(), reporter)
+
+	assert.NotNil(t, FromContext(ctx))
+}
+
+func TestEventString(t *testing.T) {
+	testCases := []struct {
+		event    Event
+		expected string
+	}{
+		{
+			event:    Event{Operation: "pod to be ready", Attempt: 2, Elapsed: 1500 * time.Millisecond},
+			expected: "waiting for pod to be ready: attempt 2, elapsed 1.5s",
+		},
+		{
+			event: Event{
+				Operation: "pod to be ready",
+				Attempt:   3,
+				Elapsed:   3 * time.Second,
+				State:     "Pending",
+				Done:      true,
+				Err:       context.DeadlineExceeded,
+			},
+			expected: "waiting for pod to be ready: attempt 3, elapsed 3s, state: Pending, " +
+				"error: context deadline exceeded, done",
+		},
+	}
+
+	for _, testCase := range testCases {
+		assert.Equal(t, testCase.expected, testCase.event.String())
+	}
+}
+
+func TestPollUntilContextTimeout(t *testing.T) {
+	testCases := []struct {
+		name           string
+		condition      ConditionFunc
+		expectedError  error
+		expectedEvents int
+		expectedState  string
+	}{
+		{
+			name: "succeeds after attempts",
+			condition: countingCondition(func(attempt int) (bool, string, error) {
+				return attempt == 3, "attempt", nil
+			}),
+			expectedError:  nil,
+			expectedEvents: 3,
+			expectedState:  "attempt",
+		},
+		{
+			name: "condition error",
+			condition: func(context.Context) (bool, string, error) {
+				return false, "broken", errors.New("test error")
+			},
+			expectedError:  errors.New("test error"),
+			expectedEvents: 1,
+			expectedState:  "broken",
+		},
+		{
+			name: "timeout",
+			condition: func(context.Context) (bool, string, error) {
+				return false, "Pending", nil
+			},
+			expectedError:  context.DeadlineExceeded,
+			expectedEvents: -1,
+			expectedState:  "Pending",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			var events []Event
+
+			ctx := WithReporter(context.TODO(), ReporterFunc(func(event Event) {
+				events = append(events, event)
+			}))
+
+			err := PollUntilContextTimeout(ctx, 10*time.Millisecond, 100*time.Millisecond, true, "test", testCase.condition)
+
+			if testCase.expectedError == nil {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, testCase.expectedError.Error())
+			}
+
+			if testCase.expectedEvents >= 0 {
+				assert.Len(t, events, testCase.expectedEvents)
+			} else {
+				assert.Greater(t, len(events), 1)
+			}
+
+			lastEvent := events[len(events)-1]
+			assert.True(t, lastEvent.Done)
+			assert.Equal(t, testCase.expectedState, lastEvent.State)
+			assert.Equal(t, "test", lastEvent.Operation)
+			assert.Positive(t, lastEvent.Attempt)
+
+			for _, event := range events[:len(events)-1] {
+				assert.False(t, event.Done)
+			}
+		})
+	}
+}
+
+func TestPollUntilContextTimeoutWithoutReporter(t *testing.T) {
+	err := PollUntilContextTimeout(context.TODO(), 10*time.Millisecond, time.Second, true, "test",
+		func(context.Context) (bool, string, error) {
+			return true, "", nil
+		})
+	assert.NoError(t, err)
+}
+
+func TestWriterReporter(t *testing.T) {
+	var buffer bytes.Buffer
+
+	reporter := NewWriterReporter(&buffer, time.Second)
+
+	reporter.Report(Event{Operation: "first", Attempt: 1, Elapsed: 0})
+	reporter.Report(Event{Operation: "first", Attempt: 2, Elapsed: 500 * time.Millisecond})
+	reporter.Report(Event{Operation: "second", Attempt: 1, Elapsed: 500 * time.Millisecond})
+	reporter.Report(Event{Operation: "first", Attempt: 3, Elapsed: time.Second})
+	reporter.Report(Event{Operation: "first", Attempt: 4, Elapsed: 1200 * time.Millisecond, Done: true})
+
+	lines := strings.Split(strings.TrimSpace(buffer.String()), "\n")
+	assert.Equal(t, []string{
+		"waiting for first: attempt 1, elapsed 0s",
+		"waiting for second: attempt 1, elapsed 500ms",
+		"waiting for first: attempt 3, elapsed 1s",
+		"waiting for first: attempt 4, elapsed 1.2s, done",
+	}, lines)
+}
+
+// countingCondition returns a ConditionFunc that calls check with the number of the attempt.
+func countingCondition(check func(attempt int) (bool, string, error)) ConditionFunc {
+	attempt := 0
+
+	return func(context.Context) (bool, string, error) {
+		attempt++
+
+		return check(attempt)
+	}
+}
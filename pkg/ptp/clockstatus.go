@@ -0,0 +1,271 @@
+package ptp
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/pod"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/klog/v2"
+)
+
+// LinuxPtpDaemonContainer is the name of the container of the linuxptp-daemon pods that logs the clock status.
+const LinuxPtpDaemonContainer = "linuxptp-daemon-container"
+
+const (
+	// clockStatusLogWindow is how far back the logs of the daemon are read to find the latest status. The daemon logs
+	// the status every second, so this only needs to cover a few intervals.
+	clockStatusLogWindow = 30 * time.Second
+	// clockStatusPollInterval is how often the status is read while waiting for a state.
+	clockStatusPollInterval = 3 * time.Second
+)
+
+// ClockState is the state the linuxptp-daemon reports for a clock source, such as the GNSS receiver or the DPLL.
+type ClockState string
+
+const (
+	// ClockStateFreerun is the state of a clock that is not synchronized to its source.
+	ClockStateFreerun ClockState = "s0"
+	// ClockStateHoldover is the state of a clock that lost its source and is within the holdover specification.
+	ClockStateHoldover ClockState = "s1"
+	// ClockStateLocked is the state of a clock that is synchronized to its source.
+	ClockStateLocked ClockState = "s2"
+)
+
+// DPLLStatus is the status of the DPLL of an interface, as logged by the linuxptp-daemon.
+type DPLLStatus struct {
+	// ConfigName is the name of the ts2phc config the DPLL belongs to, such as ts2phc.0.config.
+	ConfigName string
+	// Interface is the interface the DPLL belongs to.
+	Interface string
+	// Timestamp is the time the status was logged.
+	Timestamp time.Time
+	// FrequencyStatus is the lock status of the frequency DPLL, following the kernel DPLL lock status values.
+	FrequencyStatus int64
+	// PhaseStatus is the lock status of the phase DPLL, following the kernel DPLL lock status values.
+	PhaseStatus int64
+	// PhaseOffset is the phase offset of the DPLL.
+	PhaseOffset int64
+	// PPSStatus is whether the DPLL has a valid 1PPS input.
+	PPSStatus int64
+	// State is the state of the DPLL derived by the daemon from the fields above.
+	State ClockState
+}
+
+// GNSSStatus is the status of the GNSS receiver of an interface, as logged by the linuxptp-daemon.
+type GNSSStatus struct {
+	// ConfigName is the name of the ts2phc config the receiver belongs to, such as ts2phc.0.config.
+	ConfigName string
+	// Interface is the interface the receiver belongs to.
+	Interface string
+	// Timestamp is the time the status was logged.
+	Timestamp time.Time
+	// FixStatus is the GNSS fix type, where 3 is a 3D fix.
+	FixStatus int64
+	// Offset is the offset of the receiver.
+	Offset int64
+	// State is the state of the receiver derived by the daemon from the fields above.
+	State ClockState
+}
+
+// clockStatusRegex matches the status lines logged by the linuxptp-daemon for the GNSS receiver and the DPLL, such as
+// dpll[1718366400]:[ts2phc.0.config] ens7f0 frequency_status 3 offset 5 phase_status 3 pps_status 1 s2. The
+// submatches are the process, timestamp, config name, interface, key value fields and state.
+var clockStatusRegex = regexp.MustCompile(`(dpll|gnss)\[(\d+)\]:\[([^\]]*)\] (\S+) ((?:\S+ -?\d+ )+)(s\d)\s*$`)
+
+// ClockStatusReader reads the GNSS and DPLL status of an interface from the logs of the linuxptp-daemon pod running on
+// the node of the interface.
+type ClockStatusReader struct {
+	daemonPod     *pod.Builder
+	interfaceName string
+}
+
+// NewClockStatusReader creates a new ClockStatusReader for the interface using the logs of the provided
+// linuxptp-daemon pod, which must be the one running on the node of the interface.
+func NewClockStatusReader(daemonPod *pod.Builder, interfaceName string) (*ClockStatusReader, error) {
+	if daemonPod == nil {
+		klog.V(100).Info("The linuxptp-daemon pod is nil")
+
+		return nil, fmt.Errorf("clock status reader 'daemonPod' cannot be nil")
+	}
+
+	if interfaceName == "" {
+		klog.V(100).Info("The clock status reader interfaceName is empty")
+
+		return nil, fmt.Errorf("clock status reader 'interfaceName' cannot be empty")
+	}
+
+	return &ClockStatusReader{daemonPod: daemonPod, interfaceName: interfaceName}, nil
+}
+
+// GetDPLLStatus returns the latest DPLL status of the interface logged by the daemon.
+func (reader *ClockStatusReader) GetDPLLStatus() (*DPLLStatus, error) {
+	logs, err := reader.getLogs()
+	if err != nil {
+		return nil, err
+	}
+
+	return ParseDPLLStatus(logs, reader.interfaceName)
+}
+
+// GetGNSSStatus returns the latest GNSS status of the interface logged by the daemon.
+func (reader *ClockStatusReader) GetGNSSStatus() (*GNSSStatus, error) {
+	logs, err := reader.getLogs()
+	if err != nil {
+		return nil, err
+	}
+
+	return ParseGNSSStatus(logs, reader.interfaceName)
+}
+
+// WaitForLockState waits up to timeout for both the GNSS receiver and the DPLL of the interface to be in the provided
+// state, such as ClockStateLocked after connecting the antenna or ClockStateHoldover after disconnecting it.
+func (reader *ClockStatusReader) WaitForLockState(state ClockState, timeout time.Duration) error {
+	if reader == nil {
+		return fmt.Errorf("clock status reader cannot be nil")
+	}
+
+	klog.V(100).Infof("Waiting for GNSS and DPLL of interface %s to be in state %s", reader.interfaceName, state)
+
+	var lastErr error
+
+	err := wait.PollUntilContextTimeout(
+		context.TODO(), clockStatusPollInterval, timeout, true, func(ctx context.Context) (bool, error) {
+			logs, err := reader.getLogs()
+			if err != nil {
+				lastErr = err
+
+				return false, nil
+			}
+
+			gnssStatus, err := ParseGNSSStatus(logs, reader.interfaceName)
+			if err != nil {
+				lastErr = err
+
+				return false, nil
+			}
+
+			dpllStatus, err := ParseDPLLStatus(logs, reader.interfaceName)
+			if err != nil {
+				lastErr = err
+
+				return false, nil
+			}
+
+			lastErr = fmt.Errorf("GNSS is in state %s and DPLL is in state %s", gnssStatus.State, dpllStatus.State)
+
+			return gnssStatus.State == state && dpllStatus.State == state, nil
+		})
+	if err != nil {
+		if lastErr != nil {
+			err = fmt.Errorf("%w: %w", err, lastErr)
+		}
+
+		return fmt.Errorf("GNSS and DPLL of interface %s did not reach state %s: %w", reader.interfaceName, state, err)
+	}
+
+	return nil
+}
+
+// getLogs returns the recent logs of the daemon container.
+func (reader *ClockStatusReader) getLogs() (string, error) {
+	if reader == nil {
+		return "", fmt.Errorf("clock status reader cannot be nil")
+	}
+
+	logs, err := reader.daemonPod.GetLog(clockStatusLogWindow, LinuxPtpDaemonContainer)
+	if err != nil {
+		return "", fmt.Errorf("failed to get linuxptp-daemon logs for interface %s: %w", reader.interfaceName, err)
+	}
+
+	return logs, nil
+}
+
+// ParseDPLLStatus returns the latest DPLL status of the interface in the provided linuxptp-daemon logs.
+func ParseDPLLStatus(logs, interfaceName string) (*DPLLStatus, error) {
+	match, fields, err := findLatestClockStatus(logs, "dpll", interfaceName)
+	if err != nil {
+		return nil, err
+	}
+
+	status := &DPLLStatus{
+		ConfigName:      match.configName,
+		Interface:       interfaceName,
+		Timestamp:       match.timestamp,
+		FrequencyStatus: fields["frequency_status"],
+		PhaseStatus:     fields["phase_status"],
+		PhaseOffset:     fields["offset"],
+		PPSStatus:       fields["pps_status"],
+		State:           match.state,
+	}
+
+	return status, nil
+}
+
+// ParseGNSSStatus returns the latest GNSS status of the interface in the provided linuxptp-daemon logs.
+func ParseGNSSStatus(logs, interfaceName string) (*GNSSStatus, error) {
+	match, fields, err := findLatestClockStatus(logs, "gnss", interfaceName)
+	if err != nil {
+		return nil, err
+	}
+
+	status := &GNSSStatus{
+		ConfigName: match.configName,
+		Interface:  interfaceName,
+		Timestamp:  match.timestamp,
+		FixStatus:  fields["gnss_status"],
+		Offset:     fields["offset"],
+		State:      match.state,
+	}
+
+	return status, nil
+}
+
+// clockStatusMatch holds the fields common to every status line.
+type clockStatusMatch struct {
+	configName string
+	timestamp  time.Time
+	state      ClockState
+}
+
+// findLatestClockStatus returns the last status line of the process for the interface in the logs, along with its key
+// value fields.
+func findLatestClockStatus(logs, process, interfaceName string) (*clockStatusMatch, map[string]int64, error) {
+	if interfaceName == "" {
+		return nil, nil, fmt.Errorf("clock status 'interfaceName' cannot be empty")
+	}
+
+	lines := strings.Split(logs, "\n")
+
+	for idx := len(lines) - 1; idx >= 0; idx-- {
+		submatches := clockStatusRegex.FindStringSubmatch(lines[idx])
+		if submatches == nil || submatches[1] != process || submatches[4] != interfaceName {
+			continue
+		}
+
+		unixTime, err := strconv.ParseInt(submatches[2], 10, 64)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to parse %s status timestamp %q: %w", process, submatches[2], err)
+		}
+
+		fields := make(map[string]int64)
+		words := strings.Fields(submatches[5])
+
+		for wordIdx := 0; wordIdx+1 < len(words); wordIdx += 2 {
+			// The regex guarantees every value is an integer.
+			fields[words[wordIdx]], _ = strconv.ParseInt(words[wordIdx+1], 10, 64)
+		}
+
+		return &clockStatusMatch{
+			configName: submatches[3],
+			timestamp:  time.Unix(unixTime, 0),
+			state:      ClockState(submatches[6]),
+		}, fields, nil
+	}
+
+	return nil, nil, fmt.Errorf("no %s status found for interface %s", process, interfaceName)
+}
@@ -0,0 +1,156 @@
+package ptp
+
+import (
+	"testing"
+	"time"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/pod"
+	"github.com/stretchr/testify/assert"
+)
+
+const dummyDaemonLogs = `I0614 12:00:00.000000 1 dpll.go:100] dpll[1718366400]:[ts2phc.0.config] ens7f0 ` +
+	`frequency_status 3 offset 5 phase_status 3 pps_status 1 s2
+I0614 12:00:00.000000 1 gnss.go:100] gnss[1718366400]:[ts2phc.0.config] ens7f0 gnss_status 3 offset 0 s2
+I0614 12:00:00.000000 1 dpll.go:100] dpll[1718366400]:[ts2phc.0.config] ens2f0 ` +
+	`frequency_status 1 offset 0 phase_status 1 pps_status 0 s0
+I0614 12:00:01.000000 1 dpll.go:100] dpll[1718366401]:[ts2phc.0.config] ens7f0 ` +
+	`frequency_status 4 offset -12 phase_status 4 pps_status 0 s1
+I0614 12:00:01.000000 1 gnss.go:100] gnss[1718366401]:[ts2phc.0.config] ens7f0 gnss_status 0 offset 0 s1
+ts2phc[1718366401]: [ts2phc.0.config] ens7f0 offset          1 s2 freq      -2
+`
+
+func TestParseDPLLStatus(t *testing.T) {
+	testCases := []struct {
+		interfaceName  string
+		expectedStatus *DPLLStatus
+		expectedError  string
+	}{
+		{
+			interfaceName: "ens7f0",
+			expectedStatus: &DPLLStatus{
+				ConfigName:      "ts2phc.0.config",
+				Interface:       "ens7f0",
+				Timestamp:       time.Unix(1718366401, 0),
+				FrequencyStatus: 4,
+				PhaseStatus:     4,
+				PhaseOffset:     -12,
+				PPSStatus:       0,
+				State:           ClockStateHoldover,
+			},
+		},
+		{
+			interfaceName: "ens2f0",
+			expectedStatus: &DPLLStatus{
+				ConfigName:      "ts2phc.0.config",
+				Interface:       "ens2f0",
+				Timestamp:       time.Unix(1718366400, 0),
+				FrequencyStatus: 1,
+				PhaseStatus:     1,
+				State:           ClockStateFreerun,
+			},
+		},
+		{
+			interfaceName: "ens1f0",
+			expectedError: "no dpll status found for interface ens1f0",
+		},
+		{
+			interfaceName: "",
+			expectedError: "clock status 'interfaceName' cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		status, err := ParseDPLLStatus(dummyDaemonLogs, testCase.interfaceName)
+		if testCase.expectedError != "" {
+			assert.EqualError(t, err, testCase.expectedError)
+
+			continue
+		}
+
+		assert.NoError(t, err)
+		assert.Equal(t, testCase.expectedStatus, status)
+	}
+}
+
+func TestParseGNSSStatus(t *testing.T) {
+	testCases := []struct {
+		logs           string
+		expectedStatus *GNSSStatus
+		expectedError  string
+	}{
+		{
+			logs: dummyDaemonLogs,
+			expectedStatus: &GNSSStatus{
+				ConfigName: "ts2phc.0.config",
+				Interface:  "ens7f0",
+				Timestamp:  time.Unix(1718366401, 0),
+				FixStatus:  0,
+				State:      ClockStateHoldover,
+			},
+		},
+		{
+			logs: "gnss[1718366400]:[ts2phc.0.config] ens7f0 gnss_status 3 offset 0 s2",
+			expectedStatus: &GNSSStatus{
+				ConfigName: "ts2phc.0.config",
+				Interface:  "ens7f0",
+				Timestamp:  time.Unix(1718366400, 0),
+				FixStatus:  3,
+				State:      ClockStateLocked,
+			},
+		},
+		{
+			logs:          "fake logs",
+			expectedError: "no gnss status found for interface ens7f0",
+		},
+	}
+
+	for _, testCase := range testCases {
+		status, err := ParseGNSSStatus(testCase.logs, "ens7f0")
+		if testCase.expectedError != "" {
+			assert.EqualError(t, err, testCase.expectedError)
+
+			continue
+		}
+
+		assert.NoError(t, err)
+		assert.Equal(t, testCase.expectedStatus, status)
+	}
+}
+
+func TestNewClockStatusReader(t *testing.T) {
+	daemonPod := pod.NewBuilder(clients.GetTestClients(clients.TestClientParams{}), "linuxptp-daemon", "test-ns", "test")
+
+	reader, err := NewClockStatusReader(daemonPod, "ens7f0")
+	assert.NoError(t, err)
+	assert.NotNil(t, reader)
+
+	_, err = NewClockStatusReader(nil, "ens7f0")
+	assert.EqualError(t, err, "clock status reader 'daemonPod' cannot be nil")
+
+	_, err = NewClockStatusReader(daemonPod, "")
+	assert.EqualError(t, err, "clock status reader 'interfaceName' cannot be empty")
+}
+
+func TestClockStatusReaderGetStatus(t *testing.T) {
+	daemonPod := pod.NewBuilder(clients.GetTestClients(clients.TestClientParams{}), "linuxptp-daemon", "test-ns", "test")
+
+	// The fake clientset always returns "fake logs", which contain no status.
+	reader, err := NewClockStatusReader(daemonPod, "ens7f0")
+	assert.NoError(t, err)
+
+	_, err = reader.GetDPLLStatus()
+	assert.EqualError(t, err, "no dpll status found for interface ens7f0")
+
+	_, err = reader.GetGNSSStatus()
+	assert.EqualError(t, err, "no gnss status found for interface ens7f0")
+
+	err = reader.WaitForLockState(ClockStateLocked, 100*time.Millisecond)
+	assert.EqualError(t, err, "GNSS and DPLL of interface ens7f0 did not reach state s2: "+
+		"context deadline exceeded: no gnss status found for interface ens7f0")
+
+	var nilReader *ClockStatusReader
+
+	_, err = nilReader.GetDPLLStatus()
+	assert.EqualError(t, err, "clock status reader cannot be nil")
+}
@@ -39,3 +39,10 @@ func ListHardwareConfigs(apiClient *clients.Settings, options ...runtimeclient.L
 	return common.List[ptpv2alpha1.HardwareConfig, ptpv2alpha1.HardwareConfigList, HardwareConfigBuilder](
 		context.TODO(), apiClient, ptpv2alpha1.AddToScheme, options...)
 }
+
+// GetHardwareConfigGVR returns HardwareConfig's GroupVersionResource which could be used for Clean function.
+func GetHardwareConfigGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group: "ptp.openshift.io", Version: "v2alpha1", Resource: "hardwareconfigs",
+	}
+}
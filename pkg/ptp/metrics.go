@@ -0,0 +1,371 @@
+package ptp
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/pod"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/klog/v2"
+)
+
+const (
+	// DaemonMetricsURL is the URL the linuxptp-daemon serves its metrics on from inside its container.
+	DaemonMetricsURL = "http://127.0.0.1:9091/metrics"
+	// metricsPollInterval is how often the metrics are scraped while waiting for a condition.
+	metricsPollInterval = 3 * time.Second
+	// metricsScrapeTimeout is how long a single scrape of the metrics endpoint may take.
+	metricsScrapeTimeout = 30 * time.Second
+)
+
+const (
+	offsetMetricName        = "openshift_ptp_offset_ns"
+	clockClassMetricName    = "openshift_ptp_clock_class"
+	interfaceRoleMetricName = "openshift_ptp_interface_role"
+)
+
+// PortRole is the role of a PTP port as reported by the interface role metric of the linuxptp-daemon.
+type PortRole int64
+
+const (
+	// PortRolePassive is the role of a port that is neither sending nor receiving time.
+	PortRolePassive PortRole = 0
+	// PortRoleFollower is the role of a port that receives time from a leader, also known as slave.
+	PortRoleFollower PortRole = 1
+	// PortRoleLeader is the role of a port that sends time to followers, also known as master.
+	PortRoleLeader PortRole = 2
+	// PortRoleFaulty is the role of a port that is in a fault state.
+	PortRoleFaulty PortRole = 3
+	// PortRoleUnknown is the role of a port whose state could not be determined.
+	PortRoleUnknown PortRole = 4
+	// PortRoleListening is the role of a port that is waiting for announce messages.
+	PortRoleListening PortRole = 5
+)
+
+// String returns the name ptp4l uses for the port role.
+func (role PortRole) String() string {
+	switch role {
+	case PortRolePassive:
+		return "PASSIVE"
+	case PortRoleFollower:
+		return "SLAVE"
+	case PortRoleLeader:
+		return "MASTER"
+	case PortRoleFaulty:
+		return "FAULTY"
+	case PortRoleUnknown:
+		return "UNKNOWN"
+	case PortRoleListening:
+		return "LISTENING"
+	default:
+		return fmt.Sprintf("PortRole(%d)", int64(role))
+	}
+}
+
+// OffsetMetric is the offset of a clock from its source, as reported by the linuxptp-daemon.
+type OffsetMetric struct {
+	// Process is the process reporting the offset, such as ptp4l, phc2sys or ts2phc.
+	Process string
+	// Interface is the interface the offset belongs to, or CLOCK_REALTIME for the system clock.
+	Interface string
+	// From is the clock the offset is measured against, such as master or phc.
+	From string
+	// Offset is the offset from the source, which is reported with nanosecond resolution.
+	Offset time.Duration
+}
+
+// ClockClassMetric is the clock class of a ptp4l instance, as reported by the linuxptp-daemon.
+type ClockClassMetric struct {
+	// Process is the process reporting the clock class, usually ptp4l.
+	Process string
+	// ConfigName is the name of the config the process runs with, such as ptp4l.0.config.
+	ConfigName string
+	// ClockClass is the clock class the clock advertises, such as 6 when locked to a primary reference.
+	ClockClass int64
+}
+
+// PortStateMetric is the role of a PTP port, as reported by the linuxptp-daemon.
+type PortStateMetric struct {
+	// Process is the process the port belongs to, usually ptp4l.
+	Process string
+	// Interface is the interface of the port.
+	Interface string
+	// Role is the current role of the port.
+	Role PortRole
+}
+
+// DaemonMetrics holds the synchronization metrics scraped from a linuxptp-daemon.
+type DaemonMetrics struct {
+	Offsets      []OffsetMetric
+	ClockClasses []ClockClassMetric
+	PortStates   []PortStateMetric
+}
+
+// metricSampleRegex matches a sample line of the Prometheus text format, such as
+// openshift_ptp_offset_ns{from="master",iface="ens7f0",node="worker-0",process="ptp4l"} -5. The submatches are the
+// metric name, labels and value. The optional timestamp is ignored.
+var metricSampleRegex = regexp.MustCompile(`^([a-zA-Z_:][a-zA-Z0-9_:]*)(?:\{(.*)\})?\s+(\S+)(?:\s+-?\d+)?\s*$`)
+
+// metricLabelRegex matches a single label pair in the labels of a sample line.
+var metricLabelRegex = regexp.MustCompile(`([a-zA-Z_][a-zA-Z0-9_]*)="((?:[^"\\]|\\.)*)"`)
+
+// MetricsReader scrapes the synchronization metrics of an interface from the metrics endpoint of the linuxptp-daemon
+// pod running on the node of the interface.
+type MetricsReader struct {
+	daemonPod     *pod.Builder
+	interfaceName string
+}
+
+// NewMetricsReader creates a new MetricsReader for the interface using the metrics endpoint of the provided
+// linuxptp-daemon pod, which must be the one running on the node of the interface. The interfaceName may be
+// CLOCK_REALTIME to read the offset of the system clock reported by phc2sys.
+func NewMetricsReader(daemonPod *pod.Builder, interfaceName string) (*MetricsReader, error) {
+	if daemonPod == nil {
+		klog.V(100).Info("The linuxptp-daemon pod is nil")
+
+		return nil, fmt.Errorf("metrics reader 'daemonPod' cannot be nil")
+	}
+
+	if interfaceName == "" {
+		klog.V(100).Info("The metrics reader interfaceName is empty")
+
+		return nil, fmt.Errorf("metrics reader 'interfaceName' cannot be empty")
+	}
+
+	return &MetricsReader{daemonPod: daemonPod, interfaceName: interfaceName}, nil
+}
+
+// GetMetrics scrapes and returns all the synchronization metrics of the daemon, not only those of the interface.
+func (reader *MetricsReader) GetMetrics() (*DaemonMetrics, error) {
+	if reader == nil {
+		return nil, fmt.Errorf("metrics reader cannot be nil")
+	}
+
+	klog.V(100).Infof("Scraping linuxptp-daemon metrics from pod %s in namespace %s",
+		reader.daemonPod.Definition.Name, reader.daemonPod.Definition.Namespace)
+
+	output, err := reader.daemonPod.ExecCommandWithTimeout(
+		[]string{"curl", "-s", DaemonMetricsURL}, metricsScrapeTimeout, LinuxPtpDaemonContainer)
+	if err != nil {
+		return nil, fmt.Errorf("failed to scrape linuxptp-daemon metrics for interface %s: %w", reader.interfaceName, err)
+	}
+
+	return ParseDaemonMetrics(output.String())
+}
+
+// GetOffsets returns the offsets reported for the interface or the alias of its NIC by every process, such as ptp4l and
+// ts2phc.
+func (reader *MetricsReader) GetOffsets() ([]OffsetMetric, error) {
+	metrics, err := reader.GetMetrics()
+	if err != nil {
+		return nil, err
+	}
+
+	return metrics.GetOffsets(reader.interfaceName)
+}
+
+// GetPortRole returns the role of the PTP port of the interface.
+func (reader *MetricsReader) GetPortRole() (PortRole, error) {
+	metrics, err := reader.GetMetrics()
+	if err != nil {
+		return PortRoleUnknown, err
+	}
+
+	return metrics.GetPortRole(reader.interfaceName)
+}
+
+// GetClockClass returns the clock class reported by ptp4l. Since the clock class belongs to the clock rather than the
+// interface, when there are several ptp4l instances the first one is returned.
+func (reader *MetricsReader) GetClockClass() (int64, error) {
+	metrics, err := reader.GetMetrics()
+	if err != nil {
+		return 0, err
+	}
+
+	return metrics.GetClockClass("ptp4l")
+}
+
+// WaitForOffsetWithin waits up to timeout for the absolute value of every offset reported for the interface to be at
+// most bound.
+func (reader *MetricsReader) WaitForOffsetWithin(bound, timeout time.Duration) error {
+	if reader == nil {
+		return fmt.Errorf("metrics reader cannot be nil")
+	}
+
+	if bound < 0 {
+		klog.V(100).Infof("The offset bound %s is negative", bound)
+
+		return fmt.Errorf("metrics reader offset 'bound' cannot be negative")
+	}
+
+	klog.V(100).Infof("Waiting for offset of interface %s to be within %s", reader.interfaceName, bound)
+
+	var lastErr error
+
+	err := wait.PollUntilContextTimeout(
+		context.TODO(), metricsPollInterval, timeout, true, func(ctx context.Context) (bool, error) {
+			offsets, err := reader.GetOffsets()
+			if err != nil {
+				lastErr = err
+
+				return false, nil
+			}
+
+			for _, offset := range offsets {
+				if offset.Offset > bound || offset.Offset < -bound {
+					lastErr = fmt.Errorf("%s offset is %s", offset.Process, offset.Offset)
+
+					return false, nil
+				}
+			}
+
+			return true, nil
+		})
+	if err != nil {
+		if lastErr != nil {
+			err = fmt.Errorf("%w: %w", err, lastErr)
+		}
+
+		return fmt.Errorf("offset of interface %s did not get within %s: %w", reader.interfaceName, bound, err)
+	}
+
+	return nil
+}
+
+// GetOffsets returns the offsets reported for the interface by every process. Since linuxptp-daemon reports the ptp4l
+// offset under the alias of the NIC rather than the interface name, offsets reported for the alias are included too.
+func (metrics *DaemonMetrics) GetOffsets(interfaceName string) ([]OffsetMetric, error) {
+	if metrics == nil {
+		return nil, fmt.Errorf("daemon metrics cannot be nil")
+	}
+
+	alias := interfaceAlias(interfaceName)
+
+	var offsets []OffsetMetric
+
+	for _, offset := range metrics.Offsets {
+		if offset.Interface == interfaceName || offset.Interface == alias {
+			offsets = append(offsets, offset)
+		}
+	}
+
+	if len(offsets) == 0 {
+		return nil, fmt.Errorf("no offset metric found for interface %s", interfaceName)
+	}
+
+	return offsets, nil
+}
+
+// GetPortRole returns the role of the PTP port of the interface.
+func (metrics *DaemonMetrics) GetPortRole(interfaceName string) (PortRole, error) {
+	if metrics == nil {
+		return PortRoleUnknown, fmt.Errorf("daemon metrics cannot be nil")
+	}
+
+	for _, portState := range metrics.PortStates {
+		if portState.Interface == interfaceName {
+			return portState.Role, nil
+		}
+	}
+
+	return PortRoleUnknown, fmt.Errorf("no port state metric found for interface %s", interfaceName)
+}
+
+// GetClockClass returns the first clock class reported by the process.
+func (metrics *DaemonMetrics) GetClockClass(process string) (int64, error) {
+	if metrics == nil {
+		return 0, fmt.Errorf("daemon metrics cannot be nil")
+	}
+
+	for _, clockClass := range metrics.ClockClasses {
+		if clockClass.Process == process {
+			return clockClass.ClockClass, nil
+		}
+	}
+
+	return 0, fmt.Errorf("no clock class metric found for process %s", process)
+}
+
+// ParseDaemonMetrics returns the offset, clock class and port state metrics in the provided output of the
+// linuxptp-daemon metrics endpoint. Other metrics are ignored.
+func ParseDaemonMetrics(output string) (*DaemonMetrics, error) {
+	metrics := &DaemonMetrics{}
+
+	for _, line := range strings.Split(output, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		submatches := metricSampleRegex.FindStringSubmatch(line)
+		if submatches == nil {
+			continue
+		}
+
+		name := submatches[1]
+		if name != offsetMetricName && name != clockClassMetricName && name != interfaceRoleMetricName {
+			continue
+		}
+
+		value, err := strconv.ParseFloat(submatches[3], 64)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse value %q of metric %s: %w", submatches[3], name, err)
+		}
+
+		labels := parseMetricLabels(submatches[2])
+
+		switch name {
+		case offsetMetricName:
+			metrics.Offsets = append(metrics.Offsets, OffsetMetric{
+				Process:   labels["process"],
+				Interface: labels["iface"],
+				From:      labels["from"],
+				Offset:    time.Duration(value),
+			})
+		case clockClassMetricName:
+			metrics.ClockClasses = append(metrics.ClockClasses, ClockClassMetric{
+				Process:    labels["process"],
+				ConfigName: labels["config"],
+				ClockClass: int64(value),
+			})
+		case interfaceRoleMetricName:
+			metrics.PortStates = append(metrics.PortStates, PortStateMetric{
+				Process:   labels["process"],
+				Interface: labels["iface"],
+				Role:      PortRole(value),
+			})
+		}
+	}
+
+	return metrics, nil
+}
+
+// parseMetricLabels returns the label pairs in the labels of a sample line, without the surrounding braces.
+func parseMetricLabels(labels string) map[string]string {
+	parsed := make(map[string]string)
+
+	for _, submatches := range metricLabelRegex.FindAllStringSubmatch(labels, -1) {
+		value, err := strconv.Unquote(`"` + submatches[2] + `"`)
+		if err != nil {
+			value = submatches[2]
+		}
+
+		parsed[submatches[1]] = value
+	}
+
+	return parsed
+}
+
+// interfaceAlias returns the alias linuxptp-daemon uses for the NIC of the interface, which is the interface name with
+// its last character replaced by x, such as ens7fx for ens7f1.
+func interfaceAlias(interfaceName string) string {
+	if interfaceName == "" {
+		return ""
+	}
+
+	return interfaceName[:len(interfaceName)-1] + "x"
+}
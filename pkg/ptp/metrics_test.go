@@ -0,0 +1,130 @@
+package ptp
+
+import (
+	"testing"
+	"time"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/pod"
+	"github.com/stretchr/testify/assert"
+)
+
+const dummyDaemonMetrics = `# HELP openshift_ptp_clock_class 6 = Locked, 7 = PRC unlocked in-spec
+# TYPE openshift_ptp_clock_class gauge
+openshift_ptp_clock_class{config="ptp4l.0.config",node="worker-0",process="ptp4l"} 6
+# HELP openshift_ptp_interface_role 0 = PASSIVE, 1 = SLAVE, 2 = MASTER, 3 = FAULTY, 4 = UNKNOWN, 5 = LISTENING
+# TYPE openshift_ptp_interface_role gauge
+openshift_ptp_interface_role{iface="ens7f0",node="worker-0",process="ptp4l"} 2
+openshift_ptp_interface_role{iface="ens7f1",node="worker-0",process="ptp4l"} 1
+# HELP openshift_ptp_offset_ns
+# TYPE openshift_ptp_offset_ns gauge
+openshift_ptp_offset_ns{from="master",iface="ens7f1",node="worker-0",process="ptp4l"} -5
+openshift_ptp_offset_ns{from="phc",iface="CLOCK_REALTIME",node="worker-0",process="phc2sys"} 12
+openshift_ptp_offset_ns{from="master",iface="ens7f0",node="worker-0",process="ts2phc"} 1e+03 1718366400000
+openshift_ptp_offset_ns{from="master",iface="ens7fx",node="worker-0",process="ptp4l"} 8
+openshift_ptp_max_offset_ns{from="master",iface="ens7f1",node="worker-0",process="ptp4l"} 20
+`
+
+func TestParseDaemonMetrics(t *testing.T) {
+	metrics, err := ParseDaemonMetrics(dummyDaemonMetrics)
+	assert.NoError(t, err)
+	assert.Equal(t, &DaemonMetrics{
+		Offsets: []OffsetMetric{
+			{Process: "ptp4l", Interface: "ens7f1", From: "master", Offset: -5 * time.Nanosecond},
+			{Process: "phc2sys", Interface: "CLOCK_REALTIME", From: "phc", Offset: 12 * time.Nanosecond},
+			{Process: "ts2phc", Interface: "ens7f0", From: "master", Offset: time.Microsecond},
+			{Process: "ptp4l", Interface: "ens7fx", From: "master", Offset: 8 * time.Nanosecond},
+		},
+		ClockClasses: []ClockClassMetric{{Process: "ptp4l", ConfigName: "ptp4l.0.config", ClockClass: 6}},
+		PortStates: []PortStateMetric{
+			{Process: "ptp4l", Interface: "ens7f0", Role: PortRoleLeader},
+			{Process: "ptp4l", Interface: "ens7f1", Role: PortRoleFollower},
+		},
+	}, metrics)
+
+	_, err = ParseDaemonMetrics(`openshift_ptp_clock_class{process="ptp4l"} six`)
+	assert.EqualError(t, err, "failed to parse value \"six\" of metric openshift_ptp_clock_class: "+
+		"strconv.ParseFloat: parsing \"six\": invalid syntax")
+}
+
+func TestDaemonMetricsGetters(t *testing.T) {
+	metrics, err := ParseDaemonMetrics(dummyDaemonMetrics)
+	assert.NoError(t, err)
+
+	offsets, err := metrics.GetOffsets("ens7f1")
+	assert.NoError(t, err)
+	assert.Equal(t, []OffsetMetric{
+		{Process: "ptp4l", Interface: "ens7f1", From: "master", Offset: -5},
+		{Process: "ptp4l", Interface: "ens7fx", From: "master", Offset: 8},
+	}, offsets)
+
+	// The ptp4l offset of the NIC is only reported under its alias.
+	offsets, err = metrics.GetOffsets("ens7f0")
+	assert.NoError(t, err)
+	assert.Equal(t, []OffsetMetric{
+		{Process: "ts2phc", Interface: "ens7f0", From: "master", Offset: time.Microsecond},
+		{Process: "ptp4l", Interface: "ens7fx", From: "master", Offset: 8},
+	}, offsets)
+
+	_, err = metrics.GetOffsets("ens1f0")
+	assert.EqualError(t, err, "no offset metric found for interface ens1f0")
+
+	role, err := metrics.GetPortRole("ens7f1")
+	assert.NoError(t, err)
+	assert.Equal(t, PortRoleFollower, role)
+	assert.Equal(t, "SLAVE", role.String())
+
+	_, err = metrics.GetPortRole("ens1f0")
+	assert.EqualError(t, err, "no port state metric found for interface ens1f0")
+
+	clockClass, err := metrics.GetClockClass("ptp4l")
+	assert.NoError(t, err)
+	assert.Equal(t, int64(6), clockClass)
+
+	_, err = metrics.GetClockClass("ts2phc")
+	assert.EqualError(t, err, "no clock class metric found for process ts2phc")
+
+	var nilMetrics *DaemonMetrics
+
+	_, err = nilMetrics.GetOffsets("ens7f1")
+	assert.EqualError(t, err, "daemon metrics cannot be nil")
+}
+
+func TestNewMetricsReader(t *testing.T) {
+	daemonPod := pod.NewBuilder(clients.GetTestClients(clients.TestClientParams{}), "linuxptp-daemon", "test-ns", "test")
+
+	reader, err := NewMetricsReader(daemonPod, "ens7f0")
+	assert.NoError(t, err)
+	assert.NotNil(t, reader)
+
+	_, err = NewMetricsReader(nil, "ens7f0")
+	assert.EqualError(t, err, "metrics reader 'daemonPod' cannot be nil")
+
+	_, err = NewMetricsReader(daemonPod, "")
+	assert.EqualError(t, err, "metrics reader 'interfaceName' cannot be empty")
+}
+
+func TestMetricsReaderWaitForOffsetWithin(t *testing.T) {
+	daemonPod := pod.NewBuilder(clients.GetTestClients(clients.TestClientParams{}), "linuxptp-daemon", "test-ns", "test")
+
+	// The daemon pod does not exist on the fake client, so scraping always fails.
+	reader, err := NewMetricsReader(daemonPod, "ens7f0")
+	assert.NoError(t, err)
+
+	_, err = reader.GetPortRole()
+	assert.EqualError(t, err, "failed to scrape linuxptp-daemon metrics for interface ens7f0: "+
+		"pod object linuxptp-daemon does not exist in namespace test-ns")
+
+	err = reader.WaitForOffsetWithin(100*time.Nanosecond, 100*time.Millisecond)
+	assert.EqualError(t, err, "offset of interface ens7f0 did not get within 100ns: context deadline exceeded: "+
+		"failed to scrape linuxptp-daemon metrics for interface ens7f0: "+
+		"pod object linuxptp-daemon does not exist in namespace test-ns")
+
+	err = reader.WaitForOffsetWithin(-time.Nanosecond, time.Second)
+	assert.EqualError(t, err, "metrics reader offset 'bound' cannot be negative")
+
+	var nilReader *MetricsReader
+
+	err = nilReader.WaitForOffsetWithin(time.Nanosecond, time.Second)
+	assert.EqualError(t, err, "metrics reader cannot be nil")
+}
@@ -1,15 +1,13 @@
 package ptp
 
 import (
-	"fmt"
 	"testing"
 
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common/testhelper"
 	ptpv1 "github.com/rh-ecosystem-edge/eco-goinfra/pkg/schemes/ptp/v1"
 	"github.com/stretchr/testify/assert"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/utils/ptr"
 )
 
@@ -17,68 +15,47 @@ const (
 	defaultPtpConfigName      = "test-ptp-config"
 	defaultPtpConfigNamespace = "test-ns"
 	testProfileName           = "test"
+	// errInvalidPtpConfigBuilder is the error of the builder returned by buildInvalidPtpConfigBuilder.
+	errInvalidPtpConfigBuilder = "namespace of the builder for PtpConfig test-ptp-config is empty"
 )
 
-var testSchemes = []clients.SchemeAttacher{
-	ptpv1.AddToScheme,
-}
+var (
+	testSchemes = []clients.SchemeAttacher{
+		ptpv1.AddToScheme,
+	}
+	ptpConfigGVK = ptpv1.GroupVersion.WithKind("PtpConfig")
+)
 
 func TestNewPtpConfigBuilder(t *testing.T) {
-	testCases := []struct {
-		name          string
-		nsname        string
-		client        bool
-		expectedError string
-	}{
-		{
-			name:          defaultPtpConfigName,
-			nsname:        defaultPtpConfigNamespace,
-			client:        true,
-			expectedError: "",
-		},
-		{
-			name:          "",
-			nsname:        defaultPtpConfigNamespace,
-			client:        true,
-			expectedError: "ptpConfig 'name' cannot be empty",
-		},
-		{
-			name:          defaultPtpConfigName,
-			nsname:        "",
-			client:        true,
-			expectedError: errEmptyNsname,
-		},
-		{
-			name:          defaultPtpConfigName,
-			nsname:        defaultPtpConfigNamespace,
-			client:        false,
-			expectedError: "",
-		},
-	}
+	t.Parallel()
 
-	for _, testCase := range testCases {
-		var testSettings *clients.Settings
+	testhelper.NewNamespacedBuilderTestConfig(NewPtpConfigBuilder, ptpv1.AddToScheme, ptpConfigGVK).ExecuteTests(t)
+}
 
-		if testCase.client {
-			testSettings = buildTestClientWithPtpScheme()
-		}
+func TestPullPtpConfig(t *testing.T) {
+	t.Parallel()
 
-		testBuilder := NewPtpConfigBuilder(testSettings, testCase.name, testCase.nsname)
+	testhelper.NewNamespacedPullTestConfig(PullPtpConfig, ptpv1.AddToScheme, ptpConfigGVK).ExecuteTests(t)
+}
 
-		if testCase.client {
-			assert.Equal(t, testCase.expectedError, testBuilder.errorMsg)
+func TestPtpConfigMethods(t *testing.T) {
+	t.Parallel()
 
-			if testCase.expectedError == "" {
-				assert.Equal(t, testCase.name, testBuilder.Definition.Name)
-				assert.Equal(t, testCase.nsname, testBuilder.Definition.Namespace)
-			}
-		} else {
-			assert.Nil(t, testBuilder)
-		}
-	}
+	commonTestConfig := testhelper.NewCommonTestConfig[ptpv1.PtpConfig, PtpConfigBuilder](
+		ptpv1.AddToScheme,
+		ptpConfigGVK,
+		testhelper.ResourceScopeNamespaced,
+	)
+
+	testhelper.NewTestSuite().
+		With(testhelper.NewGetTestConfig(commonTestConfig)).
+		With(testhelper.NewExistsTestConfig(commonTestConfig)).
+		With(testhelper.NewCreateTestConfig(commonTestConfig)).
+		With(testhelper.NewDeleterTestConfig(commonTestConfig)).
+		With(testhelper.NewUpdateTestConfig(commonTestConfig)).
+		Run(t)
 }
 
-//nolint:funlen // long due to the number of test cases
 func TestGetIntelPlugin(t *testing.T) {
 	t.Parallel()
 
@@ -125,7 +102,7 @@ func TestGetIntelPlugin(t *testing.T) {
 			name:           "invalid ptpConfig",
 			ptpConfigValid: false,
 			profileExists:  true,
-			expectedError:  errEmptyNsname,
+			expectedError:  "failed to validate: " + errInvalidPtpConfigBuilder,
 		},
 		{
 			name:           "profile not found",
@@ -229,7 +206,7 @@ func TestWithIntelPlugin(t *testing.T) {
 			ptpConfigValid: false,
 			profileExists:  true,
 			pluginType:     PluginTypeE810,
-			expectedError:  errEmptyNsname,
+			expectedError:  errInvalidPtpConfigBuilder,
 		},
 		{
 			name:           "profile does not exist",
@@ -283,7 +260,12 @@ func TestWithIntelPlugin(t *testing.T) {
 			}
 
 			testBuilder = testBuilder.WithIntelPlugin(profileName, plugin)
-			assert.Equal(t, testCase.expectedError, testBuilder.errorMsg)
+
+			if testCase.expectedError != "" {
+				assert.EqualError(t, testBuilder.GetError(), testCase.expectedError)
+			} else {
+				assert.NoError(t, testBuilder.GetError())
+			}
 
 			if testCase.expectedError == "" {
 				plugins := testBuilder.Definition.Spec.Profile[0].Plugins
@@ -338,7 +320,7 @@ func TestGetPluginType(t *testing.T) {
 			name:           "invalid ptpConfig",
 			ptpConfigValid: false,
 			profileExists:  true,
-			expectedError:  errEmptyNsname,
+			expectedError:  "failed to validate: " + errInvalidPtpConfigBuilder,
 		},
 		{
 			name:           "profile not found",
@@ -395,325 +377,6 @@ func TestGetPluginType(t *testing.T) {
 	}
 }
 
-func TestPullPtpConfig(t *testing.T) {
-	testCases := []struct {
-		name                string
-		nsname              string
-		addToRuntimeObjects bool
-		client              bool
-		expectedError       error
-	}{
-		{
-			name:                defaultPtpConfigName,
-			nsname:              defaultPtpConfigNamespace,
-			addToRuntimeObjects: true,
-			client:              true,
-			expectedError:       nil,
-		},
-		{
-			name:                "",
-			nsname:              defaultPtpConfigNamespace,
-			addToRuntimeObjects: true,
-			client:              true,
-			expectedError:       fmt.Errorf("ptpConfig 'name' cannot be empty"),
-		},
-		{
-			name:                defaultPtpConfigName,
-			nsname:              "",
-			addToRuntimeObjects: true,
-			client:              true,
-			expectedError:       fmt.Errorf(errEmptyNsname),
-		},
-		{
-			name:                defaultPtpConfigName,
-			nsname:              defaultPtpConfigNamespace,
-			addToRuntimeObjects: false,
-			client:              true,
-			expectedError: fmt.Errorf(
-				"ptpConfig object %s does not exist in namespace %s", defaultPtpConfigName, defaultPtpConfigNamespace),
-		},
-		{
-			name:                defaultPtpConfigName,
-			nsname:              defaultPtpConfigNamespace,
-			addToRuntimeObjects: true,
-			client:              false,
-			expectedError:       fmt.Errorf("ptpConfig 'apiClient' cannot be nil"),
-		},
-	}
-
-	for _, testCase := range testCases {
-		var (
-			runtimeObjects []runtime.Object
-			testSettings   *clients.Settings
-		)
-
-		testPtpConfig := buildDummyPtpConfig(testCase.name, testCase.nsname)
-
-		if testCase.addToRuntimeObjects {
-			runtimeObjects = append(runtimeObjects, testPtpConfig)
-		}
-
-		if testCase.client {
-			testSettings = clients.GetTestClients(clients.TestClientParams{
-				K8sMockObjects:  runtimeObjects,
-				SchemeAttachers: testSchemes,
-			})
-		}
-
-		testBuilder, err := PullPtpConfig(testSettings, testCase.name, testCase.nsname)
-		assert.Equal(t, testCase.expectedError, err)
-
-		if testCase.expectedError == nil {
-			assert.Equal(t, testPtpConfig.Name, testBuilder.Definition.Name)
-			assert.Equal(t, testPtpConfig.Namespace, testBuilder.Definition.Namespace)
-		}
-	}
-}
-
-func TestPtpConfigGet(t *testing.T) {
-	testCases := []struct {
-		testBuilder   *PtpConfigBuilder
-		expectedError string
-	}{
-		{
-			testBuilder:   buildValidPtpConfigBuilder(buildTestClientWithDummyPtpConfig()),
-			expectedError: "",
-		},
-		{
-			testBuilder:   buildInvalidPtpConfigBuilder(buildTestClientWithDummyPtpConfig()),
-			expectedError: errEmptyNsname,
-		},
-		{
-			testBuilder:   buildValidPtpConfigBuilder(buildTestClientWithPtpScheme()),
-			expectedError: "ptpconfigs.ptp.openshift.io \"test-ptp-config\" not found",
-		},
-	}
-
-	for _, testCase := range testCases {
-		ptpConfig, err := testCase.testBuilder.Get()
-
-		if testCase.expectedError == "" {
-			assert.Nil(t, err)
-			assert.Equal(t, testCase.testBuilder.Definition.Name, ptpConfig.Name)
-			assert.Equal(t, testCase.testBuilder.Definition.Namespace, ptpConfig.Namespace)
-		} else {
-			assert.EqualError(t, err, testCase.expectedError)
-		}
-	}
-}
-
-func TestPtpConfigExists(t *testing.T) {
-	testCases := []struct {
-		testBuilder *PtpConfigBuilder
-		exists      bool
-	}{
-		{
-			testBuilder: buildValidPtpConfigBuilder(buildTestClientWithDummyPtpConfig()),
-			exists:      true,
-		},
-		{
-			testBuilder: buildInvalidPtpConfigBuilder(buildTestClientWithDummyPtpConfig()),
-			exists:      false,
-		},
-		{
-			testBuilder: buildValidPtpConfigBuilder(buildTestClientWithPtpScheme()),
-			exists:      false,
-		},
-	}
-
-	for _, testCase := range testCases {
-		exists := testCase.testBuilder.Exists()
-		assert.Equal(t, testCase.exists, exists)
-	}
-}
-
-func TestPtpConfigCreate(t *testing.T) {
-	testCases := []struct {
-		testBuilder   *PtpConfigBuilder
-		expectedError error
-	}{
-		{
-			testBuilder:   buildValidPtpConfigBuilder(buildTestClientWithPtpScheme()),
-			expectedError: nil,
-		},
-		{
-			testBuilder:   buildValidPtpConfigBuilder(buildTestClientWithDummyPtpConfig()),
-			expectedError: nil,
-		},
-		{
-			testBuilder:   buildInvalidPtpConfigBuilder(buildTestClientWithPtpScheme()),
-			expectedError: fmt.Errorf(errEmptyNsname),
-		},
-	}
-
-	for _, testCase := range testCases {
-		testBuilder, err := testCase.testBuilder.Create()
-		assert.Equal(t, testCase.expectedError, err)
-
-		if testCase.expectedError == nil {
-			assert.Equal(t, testBuilder.Definition.Name, testBuilder.Object.Name)
-			assert.Equal(t, testBuilder.Definition.Namespace, testBuilder.Object.Namespace)
-		}
-	}
-}
-
-func TestPtpConfigUpdate(t *testing.T) {
-	testCases := []struct {
-		alreadyExists bool
-		expectedError error
-	}{
-		{
-			alreadyExists: false,
-			expectedError: fmt.Errorf("cannot update non-existent ptpConfig"),
-		},
-		{
-			alreadyExists: true,
-			expectedError: nil,
-		},
-	}
-
-	for _, testCase := range testCases {
-		testSettings := buildTestClientWithPtpScheme()
-
-		if testCase.alreadyExists {
-			testSettings = buildTestClientWithDummyPtpConfig()
-		}
-
-		testBuilder := buildValidPtpConfigBuilder(testSettings)
-
-		assert.NotNil(t, testBuilder.Definition)
-		assert.Empty(t, testBuilder.Definition.Spec.Profile)
-
-		testBuilder.Definition.Spec.Profile = []ptpv1.PtpProfile{{}}
-
-		testBuilder, err := testBuilder.Update()
-		assert.Equal(t, testCase.expectedError, err)
-
-		if testCase.expectedError == nil {
-			assert.NotEmpty(t, testBuilder.Object.Spec.Profile)
-		}
-	}
-}
-
-func TestPtpConfigDelete(t *testing.T) {
-	testCases := []struct {
-		testBuilder   *PtpConfigBuilder
-		expectedError error
-	}{
-		{
-			testBuilder:   buildValidPtpConfigBuilder(buildTestClientWithDummyPtpConfig()),
-			expectedError: nil,
-		},
-		{
-			testBuilder:   buildValidPtpConfigBuilder(buildTestClientWithPtpScheme()),
-			expectedError: nil,
-		},
-		{
-			testBuilder:   buildInvalidPtpConfigBuilder(buildTestClientWithDummyPtpConfig()),
-			expectedError: fmt.Errorf(errEmptyNsname),
-		},
-	}
-
-	for _, testCase := range testCases {
-		err := testCase.testBuilder.Delete()
-		assert.Equal(t, testCase.expectedError, err)
-
-		if testCase.expectedError == nil {
-			assert.Nil(t, testCase.testBuilder.Object)
-		}
-	}
-}
-
-func TestPtpConfigValidate(t *testing.T) {
-	testCases := []struct {
-		builderNil      bool
-		definitionNil   bool
-		apiClientNil    bool
-		builderErrorMsg string
-		expectedError   error
-	}{
-		{
-			builderNil:      false,
-			definitionNil:   false,
-			apiClientNil:    false,
-			builderErrorMsg: "",
-			expectedError:   nil,
-		},
-		{
-			builderNil:      true,
-			definitionNil:   false,
-			apiClientNil:    false,
-			builderErrorMsg: "",
-			expectedError:   fmt.Errorf("error: received nil ptpConfig builder"),
-		},
-		{
-			builderNil:      false,
-			definitionNil:   true,
-			apiClientNil:    false,
-			builderErrorMsg: "",
-			expectedError:   fmt.Errorf("can not redefine the undefined ptpConfig"),
-		},
-		{
-			builderNil:      false,
-			definitionNil:   false,
-			apiClientNil:    true,
-			builderErrorMsg: "",
-			expectedError:   fmt.Errorf("ptpConfig builder cannot have nil apiClient"),
-		},
-		{
-			builderNil:      false,
-			definitionNil:   false,
-			apiClientNil:    false,
-			builderErrorMsg: "test error",
-			expectedError:   fmt.Errorf("test error"),
-		},
-	}
-
-	for _, testCase := range testCases {
-		testBuilder := buildValidPtpConfigBuilder(buildTestClientWithPtpScheme())
-
-		if testCase.builderNil {
-			testBuilder = nil
-		}
-
-		if testCase.definitionNil {
-			testBuilder.Definition = nil
-		}
-
-		if testCase.apiClientNil {
-			testBuilder.apiClient = nil
-		}
-
-		if testCase.builderErrorMsg != "" {
-			testBuilder.errorMsg = testCase.builderErrorMsg
-		}
-
-		valid, err := testBuilder.validate()
-		assert.Equal(t, testCase.expectedError, err)
-		assert.Equal(t, testCase.expectedError == nil, valid)
-	}
-}
-
-// buildDummyPtpConfig returns a PtpConfig with the provided name and namespace.
-func buildDummyPtpConfig(name, namespace string) *ptpv1.PtpConfig {
-	return &ptpv1.PtpConfig{
-		ObjectMeta: metav1.ObjectMeta{
-			Name:      name,
-			Namespace: namespace,
-		},
-	}
-}
-
-// buildTestClientWithDummyPtpConfig returns a client with a mock PtpConfig.
-func buildTestClientWithDummyPtpConfig() *clients.Settings {
-	return clients.GetTestClients(clients.TestClientParams{
-		K8sMockObjects: []runtime.Object{
-			buildDummyPtpConfig(defaultPtpConfigName, defaultPtpConfigNamespace),
-		},
-		SchemeAttachers: testSchemes,
-	})
-}
-
 // buildTestClientWithPtpScheme returns a client with no objects but the ptp v1 scheme attached.
 func buildTestClientWithPtpScheme() *clients.Settings {
 	return clients.GetTestClients(clients.TestClientParams{
@@ -12,6 +12,7 @@ import (
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/msg"
 	ptpv1 "github.com/rh-ecosystem-edge/eco-goinfra/pkg/schemes/ptp/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/klog/v2"
 )
 
@@ -177,6 +178,13 @@ func (builder *PtpOperatorConfigBuilder) WithEventConfig(eventConfig ptpv1.PtpEv
 	return builder
 }
 
+// GetPtpOperatorConfigGVR returns PtpOperatorConfig's GroupVersionResource which could be used for Clean function.
+func GetPtpOperatorConfigGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group: "ptp.openshift.io", Version: "v1", Resource: "ptpoperatorconfigs",
+	}
+}
+
 // validate checks that the builder, definition, and apiClient are properly initialized and there is no errorMsg.
 func (builder *PtpOperatorConfigBuilder) validate() (bool, error) {
 	resourceCRD := "ptpOperatorConfig"
@@ -0,0 +1,337 @@
+// Package pullsecret reads and updates the global pull secret of the cluster, openshift-config/pull-secret, which is
+// used by every node to pull images. Updating it makes the machine-config-operator render a new MachineConfig for
+// every MachineConfigPool and roll it out to the nodes, so the update helpers wait for the rollout to complete and
+// return a Backup that restores the previous pull secret the same way.
+package pullsecret
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"time"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/mco"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/secret"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/klog/v2"
+
+	mcv1 "github.com/openshift/api/machineconfiguration/v1"
+)
+
+const (
+	// GlobalPullSecretName is the name of the global pull secret.
+	GlobalPullSecretName = "pull-secret"
+	// GlobalPullSecretNamespace is the namespace of the global pull secret.
+	GlobalPullSecretNamespace = "openshift-config"
+	// TelemetryRegistry is the registry whose credentials are used by the cluster to report telemetry and by the
+	// Insights operator to upload gathered data. Removing it from the global pull secret opts the cluster out of both.
+	TelemetryRegistry = "cloud.openshift.com"
+
+	// rolloutPollInterval is how often the MachineConfigPools are checked while waiting for the rollout.
+	rolloutPollInterval = 5 * time.Second
+)
+
+// DockerConfig is the content of the .dockerconfigjson key of a pull secret.
+type DockerConfig struct {
+	// Auths maps registries, optionally followed by a repository path, to their credentials.
+	Auths map[string]RegistryAuth `json:"auths"`
+}
+
+// RegistryAuth holds the credentials for a single registry.
+type RegistryAuth struct {
+	// Auth is the base64 encoding of username:password.
+	Auth string `json:"auth,omitempty"`
+	// Email is the optional email of the user.
+	Email string `json:"email,omitempty"`
+	// IdentityToken is used instead of Auth by registries using token based authentication.
+	IdentityToken string `json:"identitytoken,omitempty"`
+}
+
+// NewRegistryAuth returns the RegistryAuth for the provided username and password.
+func NewRegistryAuth(username, password string) RegistryAuth {
+	return RegistryAuth{Auth: base64.StdEncoding.EncodeToString([]byte(username + ":" + password))}
+}
+
+// ParseDockerConfig parses the content of the .dockerconfigjson key of a pull secret.
+func ParseDockerConfig(data []byte) (*DockerConfig, error) {
+	if len(data) == 0 {
+		return nil, fmt.Errorf("docker config cannot be empty")
+	}
+
+	config := &DockerConfig{}
+
+	if err := json.Unmarshal(data, config); err != nil {
+		return nil, fmt.Errorf("failed to parse docker config: %w", err)
+	}
+
+	if config.Auths == nil {
+		config.Auths = make(map[string]RegistryAuth)
+	}
+
+	return config, nil
+}
+
+// Merge returns a new DockerConfig containing the auths of both configs. Auths in other take precedence over those in
+// config for the same registry.
+func (config *DockerConfig) Merge(other *DockerConfig) *DockerConfig {
+	merged := &DockerConfig{Auths: make(map[string]RegistryAuth)}
+
+	if config != nil {
+		maps.Copy(merged.Auths, config.Auths)
+	}
+
+	if other != nil {
+		maps.Copy(merged.Auths, other.Auths)
+	}
+
+	return merged
+}
+
+// Get returns the content of the global pull secret.
+func Get(apiClient *clients.Settings) (*DockerConfig, error) {
+	data, err := getGlobalPullSecretData(apiClient)
+	if err != nil {
+		return nil, err
+	}
+
+	return ParseDockerConfig(data)
+}
+
+// AddRegistryAuths merges the provided auths into the global pull secret, replacing the credentials of registries that
+// are already present, and waits up to timeout for the MachineConfigPools to roll out the change. See Update.
+func AddRegistryAuths(
+	apiClient *clients.Settings, auths map[string]RegistryAuth, timeout time.Duration) (*Backup, error) {
+	if len(auths) == 0 {
+		klog.V(100).Info("The registry auths to add to the global pull secret are empty")
+
+		return nil, fmt.Errorf("pull secret 'auths' cannot be empty")
+	}
+
+	current, err := Get(apiClient)
+	if err != nil {
+		return nil, err
+	}
+
+	return Update(apiClient, current.Merge(&DockerConfig{Auths: auths}), timeout)
+}
+
+// RemoveRegistryAuths removes the credentials of the provided registries from the global pull secret and waits up to
+// timeout for the MachineConfigPools to roll out the change. Registries that are not present are ignored. See Update.
+func RemoveRegistryAuths(apiClient *clients.Settings, registries []string, timeout time.Duration) (*Backup, error) {
+	if len(registries) == 0 {
+		klog.V(100).Info("The registries to remove from the global pull secret are empty")
+
+		return nil, fmt.Errorf("pull secret 'registries' cannot be empty")
+	}
+
+	current, err := Get(apiClient)
+	if err != nil {
+		return nil, err
+	}
+
+	updated := current.Merge(nil)
+
+	for _, registry := range registries {
+		delete(updated.Auths, registry)
+	}
+
+	return Update(apiClient, updated, timeout)
+}
+
+// DisableTelemetry opts the cluster out of remote health reporting by removing the TelemetryRegistry credentials from
+// the global pull secret, and waits up to timeout for the MachineConfigPools to roll out the change. Restoring the
+// returned Backup opts the cluster back in. See Update.
+func DisableTelemetry(apiClient *clients.Settings, timeout time.Duration) (*Backup, error) {
+	klog.V(100).Info("Disabling telemetry by removing its registry from the global pull secret")
+
+	return RemoveRegistryAuths(apiClient, []string{TelemetryRegistry}, timeout)
+}
+
+// Update replaces the content of the global pull secret with config and waits up to timeout for every
+// MachineConfigPool to roll out the change. The returned Backup restores the previous content and is returned even if
+// the update or rollout fails, so that the change can always be rolled back. If config does not change the pull
+// secret, nothing is updated and there is no rollout to wait for. Paused pools never roll out the change, so this
+// times out if any pool is paused.
+func Update(apiClient *clients.Settings, config *DockerConfig, timeout time.Duration) (*Backup, error) {
+	if config == nil {
+		klog.V(100).Info("The global pull secret config is nil")
+
+		return nil, fmt.Errorf("pull secret 'config' cannot be nil")
+	}
+
+	data, err := json.Marshal(config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal docker config: %w", err)
+	}
+
+	previous, err := getGlobalPullSecretData(apiClient)
+	if err != nil {
+		return nil, err
+	}
+
+	backup := &Backup{apiClient: apiClient, data: previous}
+
+	if err := updateAndWait(apiClient, previous, data, timeout); err != nil {
+		return backup, err
+	}
+
+	return backup, nil
+}
+
+// Backup holds the content of the global pull secret before it was updated.
+type Backup struct {
+	apiClient *clients.Settings
+	data      []byte
+}
+
+// Restore sets the global pull secret back to the content it had before the update and waits up to timeout for
+// every MachineConfigPool to roll out the change.
+func (backup *Backup) Restore(timeout time.Duration) error {
+	if backup == nil {
+		klog.V(100).Info("The pull secret backup is nil")
+
+		return fmt.Errorf("pull secret backup cannot be nil")
+	}
+
+	klog.V(100).Info("Restoring global pull secret from backup")
+
+	current, err := getGlobalPullSecretData(backup.apiClient)
+	if err != nil {
+		return err
+	}
+
+	return updateAndWait(backup.apiClient, current, backup.data, timeout)
+}
+
+// getGlobalPullSecretData returns the .dockerconfigjson content of the global pull secret.
+func getGlobalPullSecretData(apiClient *clients.Settings) ([]byte, error) {
+	if apiClient == nil {
+		klog.V(100).Info("The apiClient is nil")
+
+		return nil, fmt.Errorf("pull secret 'apiClient' cannot be nil")
+	}
+
+	klog.V(100).Infof("Getting global pull secret %s/%s", GlobalPullSecretNamespace, GlobalPullSecretName)
+
+	secretBuilder, err := secret.Pull(apiClient, GlobalPullSecretName, GlobalPullSecretNamespace)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get global pull secret: %w", err)
+	}
+
+	data, ok := secretBuilder.Object.Data[corev1.DockerConfigJsonKey]
+	if !ok {
+		return nil, fmt.Errorf("global pull secret has no %s key", corev1.DockerConfigJsonKey)
+	}
+
+	return data, nil
+}
+
+// updateAndWait sets the global pull secret to data, unless it is already equal to current, and waits for the
+// resulting rollout.
+func updateAndWait(apiClient *clients.Settings, current, data []byte, timeout time.Duration) error {
+	if bytes.Equal(current, data) {
+		klog.V(100).Info("The global pull secret is unchanged, skipping update")
+
+		return nil
+	}
+
+	renderedConfigs, err := getRenderedConfigs(apiClient)
+	if err != nil {
+		return err
+	}
+
+	secretBuilder, err := secret.Pull(apiClient, GlobalPullSecretName, GlobalPullSecretNamespace)
+	if err != nil {
+		return fmt.Errorf("failed to get global pull secret: %w", err)
+	}
+
+	if secretBuilder.Definition.Data == nil {
+		secretBuilder.Definition.Data = make(map[string][]byte)
+	}
+
+	secretBuilder.Definition.Data[corev1.DockerConfigJsonKey] = data
+
+	_, err = secretBuilder.Update()
+	if err != nil {
+		return fmt.Errorf("failed to update global pull secret: %w", err)
+	}
+
+	return waitForRollout(apiClient, renderedConfigs, timeout)
+}
+
+// getRenderedConfigs returns the name of the rendered MachineConfig targeted by each MachineConfigPool.
+func getRenderedConfigs(apiClient *clients.Settings) (map[string]string, error) {
+	mcpBuilders, err := mco.ListMCP(apiClient)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list MachineConfigPools: %w", err)
+	}
+
+	renderedConfigs := make(map[string]string)
+
+	for _, mcpBuilder := range mcpBuilders {
+		renderedConfigs[mcpBuilder.Object.Name] = mcpBuilder.Object.Spec.Configuration.Name
+	}
+
+	return renderedConfigs, nil
+}
+
+// waitForRollout waits for every MachineConfigPool in previousConfigs to target a new rendered MachineConfig and to
+// have updated all of its machines to it.
+func waitForRollout(apiClient *clients.Settings, previousConfigs map[string]string, timeout time.Duration) error {
+	klog.V(100).Infof("Waiting up to %s for MachineConfigPools to roll out the global pull secret", timeout)
+
+	var pending string
+
+	err := wait.PollUntilContextTimeout(
+		context.TODO(), rolloutPollInterval, timeout, true, func(ctx context.Context) (bool, error) {
+			mcpBuilders, err := mco.ListMCP(apiClient)
+			if err != nil {
+				klog.V(100).Infof("Failed to list MachineConfigPools: %v", err)
+
+				return false, nil
+			}
+
+			for _, mcpBuilder := range mcpBuilders {
+				previousConfig, ok := previousConfigs[mcpBuilder.Object.Name]
+				if !ok {
+					continue
+				}
+
+				if !isRolledOut(mcpBuilder.Object, previousConfig) {
+					pending = mcpBuilder.Object.Name
+
+					return false, nil
+				}
+			}
+
+			return true, nil
+		})
+	if err != nil {
+		return fmt.Errorf("MachineConfigPool %s did not roll out the global pull secret: %w", pending, err)
+	}
+
+	return nil
+}
+
+// isRolledOut returns whether the MachineConfigPool targets a rendered MachineConfig other than previousConfig and has
+// updated all of its machines to it.
+func isRolledOut(mcp *mcv1.MachineConfigPool, previousConfig string) bool {
+	if mcp.Spec.Configuration.Name == previousConfig ||
+		mcp.Status.Configuration.Name != mcp.Spec.Configuration.Name ||
+		mcp.Status.UpdatedMachineCount != mcp.Status.MachineCount {
+		return false
+	}
+
+	for _, condition := range mcp.Status.Conditions {
+		if condition.Type == mcv1.MachineConfigPoolUpdated {
+			return condition.Status == corev1.ConditionTrue
+		}
+	}
+
+	return false
+}
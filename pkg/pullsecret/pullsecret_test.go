@@ -0,0 +1,296 @@
+package pullsecret
+
+import (
+	"testing"
+	"time"
+
+	mcv1 "github.com/openshift/api/machineconfiguration/v1"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const dummyDockerConfig = `{"auths":{"quay.io":{"auth":"dXNlcjpwYXNz","email":"user@example.com"}}}`
+
+var testSchemes = []clients.SchemeAttacher{mcv1.Install}
+
+func TestParseDockerConfig(t *testing.T) {
+	testCases := []struct {
+		data           string
+		expectedConfig *DockerConfig
+		expectedError  string
+	}{
+		{
+			data: dummyDockerConfig,
+			expectedConfig: &DockerConfig{Auths: map[string]RegistryAuth{
+				"quay.io": {Auth: "dXNlcjpwYXNz", Email: "user@example.com"},
+			}},
+		},
+		{
+			data:           "{}",
+			expectedConfig: &DockerConfig{Auths: map[string]RegistryAuth{}},
+		},
+		{
+			data:          "",
+			expectedError: "docker config cannot be empty",
+		},
+		{
+			data:          "{",
+			expectedError: "failed to parse docker config: unexpected end of JSON input",
+		},
+	}
+
+	for _, testCase := range testCases {
+		config, err := ParseDockerConfig([]byte(testCase.data))
+		if testCase.expectedError != "" {
+			assert.EqualError(t, err, testCase.expectedError)
+
+			continue
+		}
+
+		assert.NoError(t, err)
+		assert.Equal(t, testCase.expectedConfig, config)
+	}
+}
+
+func TestDockerConfigMerge(t *testing.T) {
+	config := &DockerConfig{Auths: map[string]RegistryAuth{
+		"quay.io":         {Auth: "old"},
+		"registry.io:443": {Auth: "kept"},
+	}}
+	other := &DockerConfig{Auths: map[string]RegistryAuth{
+		"quay.io":          {Auth: "new"},
+		"mirror.lab:5000":  {Auth: "added"},
+		"mirror.lab:5000/": {IdentityToken: "token"},
+	}}
+
+	merged := config.Merge(other)
+	assert.Equal(t, map[string]RegistryAuth{
+		"quay.io":          {Auth: "new"},
+		"registry.io:443":  {Auth: "kept"},
+		"mirror.lab:5000":  {Auth: "added"},
+		"mirror.lab:5000/": {IdentityToken: "token"},
+	}, merged.Auths)
+
+	// The original configs are not modified.
+	assert.Equal(t, "old", config.Auths["quay.io"].Auth)
+
+	var nilConfig *DockerConfig
+
+	assert.Equal(t, other.Auths, nilConfig.Merge(other).Auths)
+	assert.Empty(t, nilConfig.Merge(nil).Auths)
+}
+
+func TestNewRegistryAuth(t *testing.T) {
+	assert.Equal(t, RegistryAuth{Auth: "dXNlcjpwYXNz"}, NewRegistryAuth("user", "pass"))
+}
+
+func TestGet(t *testing.T) {
+	testCases := []struct {
+		objects       []runtime.Object
+		client        bool
+		expectedError string
+	}{
+		{
+			objects: []runtime.Object{buildDummyPullSecret(dummyDockerConfig)},
+			client:  true,
+		},
+		{
+			objects:       []runtime.Object{buildDummyPullSecret("")},
+			client:        true,
+			expectedError: "global pull secret has no .dockerconfigjson key",
+		},
+		{
+			objects: []runtime.Object{},
+			client:  true,
+			expectedError: "failed to get global pull secret: secret object pull-secret does not exist in namespace " +
+				"openshift-config",
+		},
+		{
+			client:        false,
+			expectedError: "pull secret 'apiClient' cannot be nil",
+		},
+	}
+
+	for _, testCase := range testCases {
+		var testSettings *clients.Settings
+
+		if testCase.client {
+			testSettings = clients.GetTestClients(clients.TestClientParams{
+				K8sMockObjects:  testCase.objects,
+				SchemeAttachers: testSchemes,
+			})
+		}
+
+		config, err := Get(testSettings)
+		if testCase.expectedError != "" {
+			assert.EqualError(t, err, testCase.expectedError)
+
+			continue
+		}
+
+		assert.NoError(t, err)
+		assert.Equal(t, "dXNlcjpwYXNz", config.Auths["quay.io"].Auth)
+	}
+}
+
+func TestAddRegistryAuthsAndRestore(t *testing.T) {
+	// There are no MachineConfigPools, so there is no rollout to wait for.
+	testSettings := clients.GetTestClients(clients.TestClientParams{
+		K8sMockObjects:  []runtime.Object{buildDummyPullSecret(dummyDockerConfig)},
+		SchemeAttachers: testSchemes,
+	})
+
+	backup, err := AddRegistryAuths(
+		testSettings, map[string]RegistryAuth{"mirror.lab:5000": NewRegistryAuth("user", "pass")}, time.Second)
+	assert.NoError(t, err)
+
+	config, err := Get(testSettings)
+	assert.NoError(t, err)
+	assert.Len(t, config.Auths, 2)
+	assert.Equal(t, "dXNlcjpwYXNz", config.Auths["mirror.lab:5000"].Auth)
+
+	err = backup.Restore(time.Second)
+	assert.NoError(t, err)
+
+	data, err := getGlobalPullSecretData(testSettings)
+	assert.NoError(t, err)
+	assert.Equal(t, dummyDockerConfig, string(data))
+
+	_, err = AddRegistryAuths(testSettings, nil, time.Second)
+	assert.EqualError(t, err, "pull secret 'auths' cannot be empty")
+
+	_, err = Update(testSettings, nil, time.Second)
+	assert.EqualError(t, err, "pull secret 'config' cannot be nil")
+
+	var nilBackup *Backup
+
+	assert.EqualError(t, nilBackup.Restore(time.Second), "pull secret backup cannot be nil")
+}
+
+func TestDisableTelemetryAndRestore(t *testing.T) {
+	dockerConfig := `{"auths":{"cloud.openshift.com":{"auth":"dGVsZW1ldHJ5"},"quay.io":{"auth":"dXNlcjpwYXNz"}}}`
+
+	testSettings := clients.GetTestClients(clients.TestClientParams{
+		K8sMockObjects:  []runtime.Object{buildDummyPullSecret(dockerConfig)},
+		SchemeAttachers: testSchemes,
+	})
+
+	backup, err := DisableTelemetry(testSettings, time.Second)
+	assert.NoError(t, err)
+
+	config, err := Get(testSettings)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]RegistryAuth{"quay.io": {Auth: "dXNlcjpwYXNz"}}, config.Auths)
+
+	// Removing a registry that is not present does not change the pull secret.
+	_, err = RemoveRegistryAuths(testSettings, []string{TelemetryRegistry}, time.Second)
+	assert.NoError(t, err)
+
+	err = backup.Restore(time.Second)
+	assert.NoError(t, err)
+
+	data, err := getGlobalPullSecretData(testSettings)
+	assert.NoError(t, err)
+	assert.Equal(t, dockerConfig, string(data))
+
+	_, err = RemoveRegistryAuths(testSettings, nil, time.Second)
+	assert.EqualError(t, err, "pull secret 'registries' cannot be empty")
+}
+
+func TestUpdateWaitsForRollout(t *testing.T) {
+	// The pool never targets a new rendered config since there is no machine-config-operator.
+	testSettings := clients.GetTestClients(clients.TestClientParams{
+		K8sMockObjects: []runtime.Object{
+			buildDummyPullSecret(dummyDockerConfig),
+			buildDummyMCP("rendered-worker-1", "rendered-worker-1", corev1.ConditionTrue),
+		},
+		SchemeAttachers: testSchemes,
+	})
+
+	config, err := Get(testSettings)
+	assert.NoError(t, err)
+
+	config.Auths["mirror.lab:5000"] = NewRegistryAuth("user", "pass")
+
+	backup, err := Update(testSettings, config, 100*time.Millisecond)
+	assert.EqualError(t, err,
+		"MachineConfigPool worker did not roll out the global pull secret: context deadline exceeded")
+	assert.NotNil(t, backup)
+
+	// Updating with the same content does not wait for a rollout.
+	_, err = Update(testSettings, config, 100*time.Millisecond)
+	assert.NoError(t, err)
+}
+
+func TestIsRolledOut(t *testing.T) {
+	testCases := []struct {
+		mcp      *mcv1.MachineConfigPool
+		expected bool
+	}{
+		{
+			mcp:      buildDummyMCP("rendered-worker-2", "rendered-worker-2", corev1.ConditionTrue),
+			expected: true,
+		},
+		{
+			mcp:      buildDummyMCP("rendered-worker-1", "rendered-worker-1", corev1.ConditionTrue),
+			expected: false,
+		},
+		{
+			mcp:      buildDummyMCP("rendered-worker-2", "rendered-worker-1", corev1.ConditionFalse),
+			expected: false,
+		},
+		{
+			mcp:      buildDummyMCP("rendered-worker-2", "rendered-worker-2", corev1.ConditionFalse),
+			expected: false,
+		},
+	}
+
+	for _, testCase := range testCases {
+		assert.Equal(t, testCase.expected, isRolledOut(testCase.mcp, "rendered-worker-1"))
+	}
+}
+
+// buildDummyPullSecret returns a global pull secret with the provided docker config. If the docker config is empty,
+// the secret has no data.
+func buildDummyPullSecret(dockerConfig string) *corev1.Secret {
+	pullSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      GlobalPullSecretName,
+			Namespace: GlobalPullSecretNamespace,
+		},
+		Type: corev1.SecretTypeDockerConfigJson,
+	}
+
+	if dockerConfig != "" {
+		pullSecret.Data = map[string][]byte{corev1.DockerConfigJsonKey: []byte(dockerConfig)}
+	}
+
+	return pullSecret
+}
+
+// buildDummyMCP returns a worker MachineConfigPool targeting specConfig with all machines updated to statusConfig.
+func buildDummyMCP(specConfig, statusConfig string, updated corev1.ConditionStatus) *mcv1.MachineConfigPool {
+	return &mcv1.MachineConfigPool{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "worker",
+		},
+		Spec: mcv1.MachineConfigPoolSpec{
+			Configuration: mcv1.MachineConfigPoolStatusConfiguration{
+				ObjectReference: corev1.ObjectReference{Name: specConfig},
+			},
+		},
+		Status: mcv1.MachineConfigPoolStatus{
+			MachineCount:        2,
+			UpdatedMachineCount: 2,
+			Configuration: mcv1.MachineConfigPoolStatusConfiguration{
+				ObjectReference: corev1.ObjectReference{Name: statusConfig},
+			},
+			Conditions: []mcv1.MachineConfigPoolCondition{
+				{Type: mcv1.MachineConfigPoolUpdated, Status: updated},
+			},
+		},
+	}
+}
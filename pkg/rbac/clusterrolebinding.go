@@ -10,6 +10,7 @@ import (
 	rbacv1 "k8s.io/api/rbac/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/klog/v2"
 )
 
@@ -238,6 +239,127 @@ func (builder *ClusterRoleBindingBuilder) Exists() bool {
 	return err == nil || !k8serrors.IsNotFound(err)
 }
 
+// AddSubject adds the subject to the clusterrolebinding definition unless a matching subject is already present, so it
+// can be called on every run without duplicating subjects. Subjects are matched by kind, name and, for service
+// accounts, namespace.
+func (builder *ClusterRoleBindingBuilder) AddSubject(subject rbacv1.Subject) *ClusterRoleBindingBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	klog.V(100).Infof("Adding subject %v to the clusterrolebinding %s", subject, builder.Definition.Name)
+
+	if err := validateSubject("clusterrolebinding", subject); err != nil {
+		klog.V(100).Infof("The clusterrolebinding subject is invalid: %v", err)
+
+		builder.errorMsg = err.Error()
+
+		return builder
+	}
+
+	if containsSubject(builder.Definition.Subjects, subject, "") {
+		klog.V(100).Infof("The clusterrolebinding %s already has subject %v", builder.Definition.Name, subject)
+
+		return builder
+	}
+
+	builder.Definition.Subjects = append(builder.Definition.Subjects, subject)
+
+	return builder
+}
+
+// RemoveSubject removes every subject matching the subject from the clusterrolebinding definition. It does nothing if
+// there is no matching subject.
+func (builder *ClusterRoleBindingBuilder) RemoveSubject(subject rbacv1.Subject) *ClusterRoleBindingBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	klog.V(100).Infof("Removing subject %v from the clusterrolebinding %s", subject, builder.Definition.Name)
+
+	builder.Definition.Subjects = removeSubject(builder.Definition.Subjects, subject, "")
+
+	return builder
+}
+
+// EnsureBinding makes the clusterrolebinding on the cluster match the definition, so setup code can be run repeatedly.
+// It creates the clusterrolebinding if it does not exist, updates its subjects if they differ, and since the role
+// reference cannot be changed, deletes and recreates the clusterrolebinding if it refers to a different role.
+func (builder *ClusterRoleBindingBuilder) EnsureBinding() (*ClusterRoleBindingBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	klog.V(100).Infof("Ensuring clusterrolebinding %s matches its definition", builder.Definition.Name)
+
+	if !builder.Exists() {
+		return builder.Create()
+	}
+
+	if builder.Object == nil {
+		return builder, fmt.Errorf("failed to get clusterrolebinding %s", builder.Definition.Name)
+	}
+
+	if builder.Object.RoleRef != builder.Definition.RoleRef {
+		klog.V(100).Infof("The clusterrolebinding %s refers to %v instead of %v, recreating it",
+			builder.Definition.Name, builder.Object.RoleRef, builder.Definition.RoleRef)
+
+		if err := builder.Delete(); err != nil {
+			return builder, err
+		}
+
+		builder.Definition.ResourceVersion = ""
+
+		return builder.Create()
+	}
+
+	if sameSubjects(builder.Object.Subjects, builder.Definition.Subjects, "") {
+		return builder, nil
+	}
+
+	builder.Definition.ResourceVersion = builder.Object.ResourceVersion
+
+	return builder.Update()
+}
+
+// GetStaleSubjects returns the service account subjects of the clusterrolebinding on the cluster that refer to service
+// accounts which no longer exist, such as those deleted along with their namespace by a previous run.
+func (builder *ClusterRoleBindingBuilder) GetStaleSubjects() ([]rbacv1.Subject, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	klog.V(100).Infof("Getting stale subjects of clusterrolebinding %s", builder.Definition.Name)
+
+	if !builder.Exists() || builder.Object == nil {
+		return nil, fmt.Errorf("clusterrolebinding object %s does not exist", builder.Definition.Name)
+	}
+
+	return getStaleServiceAccountSubjects(builder.apiClient, builder.Object.Subjects, "")
+}
+
+// RemoveStaleSubjects removes the subjects returned by GetStaleSubjects from the definition and then uses
+// EnsureBinding to update the clusterrolebinding on the cluster.
+func (builder *ClusterRoleBindingBuilder) RemoveStaleSubjects() (*ClusterRoleBindingBuilder, error) {
+	staleSubjects, err := builder.GetStaleSubjects()
+	if err != nil {
+		return builder, err
+	}
+
+	for _, subject := range staleSubjects {
+		builder.Definition.Subjects = removeSubject(builder.Definition.Subjects, subject, "")
+	}
+
+	return builder.EnsureBinding()
+}
+
+// GetClusterRoleBindingGVR returns ClusterRoleBinding's GroupVersionResource which could be used for Clean function.
+func GetClusterRoleBindingGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group: "rbac.authorization.k8s.io", Version: "v1", Resource: "clusterrolebindings",
+	}
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *ClusterRoleBindingBuilder) validate() (bool, error) {
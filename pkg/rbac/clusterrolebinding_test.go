@@ -0,0 +1,70 @@
+package rbac
+
+import (
+	"testing"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var (
+	defaultClusterRoleBindingName    = "test-clusterrolebinding"
+	defaultClusterRoleBindingRole    = "test-clusterrole"
+	defaultClusterRoleBindingSubject = rbacv1.Subject{
+		Kind: rbacv1.ServiceAccountKind, Name: "test-sa", Namespace: "testns"}
+)
+
+func TestClusterRoleBindingAddAndRemoveSubject(t *testing.T) {
+	testBuilder := buildValidClusterRoleBindingBuilder(clients.GetTestClients(clients.TestClientParams{}))
+
+	testBuilder.AddSubject(defaultClusterRoleBindingSubject)
+	testBuilder.AddSubject(rbacv1.Subject{Kind: rbacv1.ServiceAccountKind, Name: "test-sa", Namespace: "otherns"})
+
+	assert.Empty(t, testBuilder.errorMsg)
+	assert.Len(t, testBuilder.Definition.Subjects, 2)
+
+	testBuilder.RemoveSubject(rbacv1.Subject{Kind: rbacv1.ServiceAccountKind, Name: "test-sa", Namespace: "otherns"})
+	assert.Equal(t, []rbacv1.Subject{defaultClusterRoleBindingSubject}, testBuilder.Definition.Subjects)
+
+	testBuilder.AddSubject(rbacv1.Subject{Kind: rbacv1.GroupKind})
+	assert.Equal(t, "clusterrolebinding subject name cannot be empty", testBuilder.errorMsg)
+}
+
+func TestClusterRoleBindingEnsureBinding(t *testing.T) {
+	testSettings := clients.GetTestClients(clients.TestClientParams{K8sMockObjects: []runtime.Object{
+		&rbacv1.ClusterRoleBinding{
+			ObjectMeta: metav1.ObjectMeta{Name: defaultClusterRoleBindingName},
+			RoleRef:    rbacv1.RoleRef{APIGroup: rbacv1.GroupName, Kind: "ClusterRole", Name: "other-clusterrole"},
+		},
+		&corev1.ServiceAccount{ObjectMeta: metav1.ObjectMeta{Name: "test-sa", Namespace: "testns"}},
+	}})
+
+	testBuilder, err := buildValidClusterRoleBindingBuilder(testSettings).EnsureBinding()
+	require.NoError(t, err)
+	assert.Equal(t, defaultClusterRoleBindingRole, testBuilder.Object.RoleRef.Name)
+	assert.Equal(t, []rbacv1.Subject{defaultClusterRoleBindingSubject}, testBuilder.Object.Subjects)
+
+	testBuilder, err = testBuilder.AddSubject(rbacv1.Subject{Kind: rbacv1.ServiceAccountKind, Name: "deleted-sa",
+		Namespace: "testns"}).EnsureBinding()
+	require.NoError(t, err)
+	assert.Len(t, testBuilder.Object.Subjects, 2)
+
+	staleSubjects, err := testBuilder.GetStaleSubjects()
+	assert.NoError(t, err)
+	assert.Equal(t, []rbacv1.Subject{
+		{Kind: rbacv1.ServiceAccountKind, Name: "deleted-sa", Namespace: "testns"}}, staleSubjects)
+
+	testBuilder, err = testBuilder.RemoveStaleSubjects()
+	assert.NoError(t, err)
+	assert.Equal(t, []rbacv1.Subject{defaultClusterRoleBindingSubject}, testBuilder.Object.Subjects)
+}
+
+func buildValidClusterRoleBindingBuilder(apiClient *clients.Settings) *ClusterRoleBindingBuilder {
+	return NewClusterRoleBindingBuilder(
+		apiClient, defaultClusterRoleBindingName, defaultClusterRoleBindingRole, defaultClusterRoleBindingSubject)
+}
@@ -1,6 +1,109 @@
 package rbac
 
+import (
+	"fmt"
+	"slices"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/logging"
+	rbacv1 "k8s.io/api/rbac/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+)
+
 // allowedSubjectKinds returns a list of supported v1.Subject kinds.
 func allowedSubjectKinds() []string {
 	return []string{"ServiceAccount", "User", "Group"}
 }
+
+// validateSubject checks that the subject has a supported kind and a name. The resource is used in the error message.
+func validateSubject(resource string, subject rbacv1.Subject) error {
+	if !slices.Contains(allowedSubjectKinds(), subject.Kind) {
+		return fmt.Errorf("%s subject kind must be one of 'ServiceAccount', 'User', or 'Group'", resource)
+	}
+
+	if subject.Name == "" {
+		return fmt.Errorf("%s subject name cannot be empty", resource)
+	}
+
+	return nil
+}
+
+// subjectsMatch returns whether two subjects refer to the same entity. The APIGroup is ignored since the API server
+// defaults it based on the kind, and a ServiceAccount subject without a namespace is considered to be in
+// defaultNamespace, the namespace of the binding.
+func subjectsMatch(first, second rbacv1.Subject, defaultNamespace string) bool {
+	if first.Kind != second.Kind || first.Name != second.Name {
+		return false
+	}
+
+	if first.Kind != rbacv1.ServiceAccountKind {
+		return true
+	}
+
+	return subjectNamespace(first, defaultNamespace) == subjectNamespace(second, defaultNamespace)
+}
+
+// sameSubjects returns whether the two lists hold matching subjects in the same order.
+func sameSubjects(first, second []rbacv1.Subject, defaultNamespace string) bool {
+	return slices.EqualFunc(first, second, func(firstSubject, secondSubject rbacv1.Subject) bool {
+		return subjectsMatch(firstSubject, secondSubject, defaultNamespace)
+	})
+}
+
+// containsSubject returns whether subjects contains a subject matching subject.
+func containsSubject(subjects []rbacv1.Subject, subject rbacv1.Subject, defaultNamespace string) bool {
+	return slices.ContainsFunc(subjects, func(existing rbacv1.Subject) bool {
+		return subjectsMatch(existing, subject, defaultNamespace)
+	})
+}
+
+// removeSubject returns subjects without any subject matching subject.
+func removeSubject(subjects []rbacv1.Subject, subject rbacv1.Subject, defaultNamespace string) []rbacv1.Subject {
+	return slices.DeleteFunc(slices.Clone(subjects), func(existing rbacv1.Subject) bool {
+		return subjectsMatch(existing, subject, defaultNamespace)
+	})
+}
+
+// subjectNamespace returns the namespace of the subject, or defaultNamespace if it does not have one.
+func subjectNamespace(subject rbacv1.Subject, defaultNamespace string) string {
+	if subject.Namespace == "" {
+		return defaultNamespace
+	}
+
+	return subject.Namespace
+}
+
+// getStaleServiceAccountSubjects returns the ServiceAccount subjects referring to service accounts that do not exist.
+// ServiceAccount subjects without a namespace are looked up in defaultNamespace, or skipped if it is empty.
+func getStaleServiceAccountSubjects(
+	apiClient *clients.Settings, subjects []rbacv1.Subject, defaultNamespace string) ([]rbacv1.Subject, error) {
+	var staleSubjects []rbacv1.Subject
+
+	for _, subject := range subjects {
+		if subject.Kind != rbacv1.ServiceAccountKind {
+			continue
+		}
+
+		namespace := subjectNamespace(subject, defaultNamespace)
+		if namespace == "" {
+			continue
+		}
+
+		_, err := apiClient.ServiceAccounts(namespace).Get(logging.DiscardContext(), subject.Name, metav1.GetOptions{})
+		if k8serrors.IsNotFound(err) {
+			klog.V(100).Infof("ServiceAccount %s in namespace %s of subject does not exist", subject.Name, namespace)
+
+			staleSubjects = append(staleSubjects, subject)
+
+			continue
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("failed to get serviceaccount %s in namespace %s: %w", subject.Name, namespace, err)
+		}
+	}
+
+	return staleSubjects, nil
+}
@@ -10,6 +10,7 @@ import (
 	rbacv1 "k8s.io/api/rbac/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/klog/v2"
 )
 
@@ -34,6 +35,8 @@ type RoleBindingAdditionalOptions func(builder *RoleBindingBuilder) (*RoleBindin
 func NewRoleBindingBuilder(apiClient *clients.Settings,
 	name, nsname, role string,
 	subject rbacv1.Subject) *RoleBindingBuilder {
+	nsname = apiClient.ResolveNamespace(nsname)
+
 	klog.V(100).Infof(
 		"Initializing new rolebinding structure with the following params: "+
 			"name: %s, namespace: %s, role: %s, subject %v", name, nsname, role, subject)
@@ -140,6 +143,8 @@ func (builder *RoleBindingBuilder) WithOptions(options ...RoleBindingAdditionalO
 
 // PullRoleBinding pulls existing rolebinding from cluster.
 func PullRoleBinding(apiClient *clients.Settings, name, nsname string) (*RoleBindingBuilder, error) {
+	nsname = apiClient.ResolveNamespace(nsname)
+
 	klog.V(100).Infof("Pulling existing rolebinding name %s under namespace %s from cluster", name, nsname)
 
 	builder := &RoleBindingBuilder{
@@ -251,6 +256,131 @@ func (builder *RoleBindingBuilder) Exists() bool {
 	return err == nil || !k8serrors.IsNotFound(err)
 }
 
+// AddSubject adds the subject to the rolebinding definition unless a matching subject is already present, so it can be
+// called on every run without duplicating subjects. Subjects are matched by kind, name and, for service accounts,
+// namespace.
+func (builder *RoleBindingBuilder) AddSubject(subject rbacv1.Subject) *RoleBindingBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	klog.V(100).Infof("Adding subject %v to the rolebinding %s", subject, builder.Definition.Name)
+
+	if err := validateSubject("rolebinding", subject); err != nil {
+		klog.V(100).Infof("The rolebinding subject is invalid: %v", err)
+
+		builder.errorMsg = err.Error()
+
+		return builder
+	}
+
+	if containsSubject(builder.Definition.Subjects, subject, builder.Definition.Namespace) {
+		klog.V(100).Infof("The rolebinding %s already has subject %v", builder.Definition.Name, subject)
+
+		return builder
+	}
+
+	builder.Definition.Subjects = append(builder.Definition.Subjects, subject)
+
+	return builder
+}
+
+// RemoveSubject removes every subject matching the subject from the rolebinding definition. It does nothing if there is
+// no matching subject.
+func (builder *RoleBindingBuilder) RemoveSubject(subject rbacv1.Subject) *RoleBindingBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	klog.V(100).Infof("Removing subject %v from the rolebinding %s", subject, builder.Definition.Name)
+
+	builder.Definition.Subjects = removeSubject(builder.Definition.Subjects, subject, builder.Definition.Namespace)
+
+	return builder
+}
+
+// EnsureBinding makes the rolebinding on the cluster match the definition, so setup code can be run repeatedly. It
+// creates the rolebinding if it does not exist, updates its subjects if they differ, and since the role reference
+// cannot be changed, deletes and recreates the rolebinding if it refers to a different role.
+func (builder *RoleBindingBuilder) EnsureBinding() (*RoleBindingBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	klog.V(100).Infof("Ensuring rolebinding %s in namespace %s matches its definition",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if !builder.Exists() {
+		return builder.Create()
+	}
+
+	if builder.Object == nil {
+		return builder, fmt.Errorf("failed to get rolebinding %s in namespace %s",
+			builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	if builder.Object.RoleRef != builder.Definition.RoleRef {
+		klog.V(100).Infof("The rolebinding %s refers to %v instead of %v, recreating it",
+			builder.Definition.Name, builder.Object.RoleRef, builder.Definition.RoleRef)
+
+		if err := builder.Delete(); err != nil {
+			return builder, err
+		}
+
+		builder.Definition.ResourceVersion = ""
+
+		return builder.Create()
+	}
+
+	if sameSubjects(builder.Object.Subjects, builder.Definition.Subjects, builder.Definition.Namespace) {
+		return builder, nil
+	}
+
+	builder.Definition.ResourceVersion = builder.Object.ResourceVersion
+
+	return builder.Update()
+}
+
+// GetStaleSubjects returns the service account subjects of the rolebinding on the cluster that refer to service
+// accounts which no longer exist, such as those deleted along with their namespace by a previous run.
+func (builder *RoleBindingBuilder) GetStaleSubjects() ([]rbacv1.Subject, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	klog.V(100).Infof("Getting stale subjects of rolebinding %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if !builder.Exists() || builder.Object == nil {
+		return nil, fmt.Errorf("rolebinding object %s does not exist in namespace %s",
+			builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	return getStaleServiceAccountSubjects(builder.apiClient, builder.Object.Subjects, builder.Definition.Namespace)
+}
+
+// RemoveStaleSubjects removes the subjects returned by GetStaleSubjects from the definition and then uses
+// EnsureBinding to update the rolebinding on the cluster.
+func (builder *RoleBindingBuilder) RemoveStaleSubjects() (*RoleBindingBuilder, error) {
+	staleSubjects, err := builder.GetStaleSubjects()
+	if err != nil {
+		return builder, err
+	}
+
+	for _, subject := range staleSubjects {
+		builder.Definition.Subjects = removeSubject(builder.Definition.Subjects, subject, builder.Definition.Namespace)
+	}
+
+	return builder.EnsureBinding()
+}
+
+// GetRoleBindingGVR returns RoleBinding's GroupVersionResource which could be used for Clean function.
+func GetRoleBindingGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group: "rbac.authorization.k8s.io", Version: "v1", Resource: "rolebindings",
+	}
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *RoleBindingBuilder) validate() (bool, error) {
@@ -0,0 +1,135 @@
+package rbac
+
+import (
+	"testing"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var (
+	defaultRoleBindingName   = "test-rolebinding"
+	defaultRoleBindingNsName = "testns"
+	defaultRoleBindingRole   = "test-role"
+	defaultBindingSubject    = rbacv1.Subject{Kind: rbacv1.ServiceAccountKind, Name: "test-sa"}
+)
+
+func TestRoleBindingAddAndRemoveSubject(t *testing.T) {
+	testBuilder := buildValidRoleBindingBuilder(clients.GetTestClients(clients.TestClientParams{}))
+
+	// The same service account with an explicit namespace matches the existing subject.
+	testBuilder.AddSubject(rbacv1.Subject{
+		Kind: rbacv1.ServiceAccountKind, Name: "test-sa", Namespace: defaultRoleBindingNsName})
+	testBuilder.AddSubject(rbacv1.Subject{Kind: rbacv1.UserKind, Name: "test-user"})
+	testBuilder.AddSubject(rbacv1.Subject{Kind: rbacv1.UserKind, Name: "test-user", APIGroup: rbacv1.GroupName})
+
+	assert.Empty(t, testBuilder.errorMsg)
+	assert.Len(t, testBuilder.Definition.Subjects, 2)
+
+	testBuilder.RemoveSubject(rbacv1.Subject{Kind: rbacv1.UserKind, Name: "test-user"})
+	testBuilder.RemoveSubject(rbacv1.Subject{Kind: rbacv1.GroupKind, Name: "missing"})
+
+	assert.Equal(t, []rbacv1.Subject{defaultBindingSubject}, testBuilder.Definition.Subjects)
+
+	testBuilder.AddSubject(rbacv1.Subject{Kind: "Pod", Name: "test"})
+	assert.Equal(t, "rolebinding subject kind must be one of 'ServiceAccount', 'User', or 'Group'", testBuilder.errorMsg)
+
+	testBuilder = buildValidRoleBindingBuilder(clients.GetTestClients(clients.TestClientParams{}))
+	testBuilder.AddSubject(rbacv1.Subject{Kind: rbacv1.UserKind})
+	assert.Equal(t, "rolebinding subject name cannot be empty", testBuilder.errorMsg)
+}
+
+func TestRoleBindingEnsureBinding(t *testing.T) {
+	testCases := []struct {
+		name     string
+		existing *rbacv1.RoleBinding
+	}{
+		{
+			name: "binding does not exist",
+		},
+		{
+			name:     "binding is up to date",
+			existing: buildDummyRoleBinding(defaultRoleBindingRole, defaultBindingSubject),
+		},
+		{
+			name: "binding has different subjects",
+			existing: buildDummyRoleBinding(
+				defaultRoleBindingRole, rbacv1.Subject{Kind: rbacv1.UserKind, Name: "other"}),
+		},
+		{
+			name:     "binding has different role",
+			existing: buildDummyRoleBinding("other-role", defaultBindingSubject),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			var objects []runtime.Object
+
+			if testCase.existing != nil {
+				objects = append(objects, testCase.existing)
+			}
+
+			testSettings := clients.GetTestClients(clients.TestClientParams{K8sMockObjects: objects})
+
+			testBuilder, err := buildValidRoleBindingBuilder(testSettings).EnsureBinding()
+			require.NoError(t, err)
+
+			roleBinding, err := testSettings.RoleBindings(defaultRoleBindingNsName).Get(
+				t.Context(), defaultRoleBindingName, metav1.GetOptions{})
+			require.NoError(t, err)
+			assert.Equal(t, defaultRoleBindingRole, roleBinding.RoleRef.Name)
+			assert.Equal(t, []rbacv1.Subject{defaultBindingSubject}, roleBinding.Subjects)
+			assert.Equal(t, roleBinding.Subjects, testBuilder.Object.Subjects)
+		})
+	}
+}
+
+func TestRoleBindingStaleSubjects(t *testing.T) {
+	otherSubject := rbacv1.Subject{Kind: rbacv1.ServiceAccountKind, Name: "existing-sa", Namespace: "otherns"}
+	userSubject := rbacv1.Subject{Kind: rbacv1.UserKind, Name: "test-user"}
+
+	testSettings := clients.GetTestClients(clients.TestClientParams{K8sMockObjects: []runtime.Object{
+		buildDummyRoleBinding(defaultRoleBindingRole, defaultBindingSubject, otherSubject, userSubject),
+		&corev1.ServiceAccount{ObjectMeta: metav1.ObjectMeta{Name: "existing-sa", Namespace: "otherns"}},
+	}})
+
+	testBuilder, err := PullRoleBinding(testSettings, defaultRoleBindingName, defaultRoleBindingNsName)
+	require.NoError(t, err)
+
+	staleSubjects, err := testBuilder.GetStaleSubjects()
+	assert.NoError(t, err)
+	assert.Equal(t, []rbacv1.Subject{defaultBindingSubject}, staleSubjects)
+
+	testBuilder, err = testBuilder.RemoveStaleSubjects()
+	assert.NoError(t, err)
+	assert.Equal(t, []rbacv1.Subject{otherSubject, userSubject}, testBuilder.Object.Subjects)
+
+	staleSubjects, err = testBuilder.GetStaleSubjects()
+	assert.NoError(t, err)
+	assert.Empty(t, staleSubjects)
+
+	_, err = buildValidRoleBindingBuilder(clients.GetTestClients(clients.TestClientParams{})).GetStaleSubjects()
+	assert.EqualError(t, err, "rolebinding object test-rolebinding does not exist in namespace testns")
+}
+
+func buildValidRoleBindingBuilder(apiClient *clients.Settings) *RoleBindingBuilder {
+	return NewRoleBindingBuilder(
+		apiClient, defaultRoleBindingName, defaultRoleBindingNsName, defaultRoleBindingRole, defaultBindingSubject)
+}
+
+func buildDummyRoleBinding(role string, subjects ...rbacv1.Subject) *rbacv1.RoleBinding {
+	return &rbacv1.RoleBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      defaultRoleBindingName,
+			Namespace: defaultRoleBindingNsName,
+		},
+		RoleRef:  rbacv1.RoleRef{APIGroup: rbacv1.GroupName, Kind: "Role", Name: role},
+		Subjects: subjects,
+	}
+}
@@ -0,0 +1,440 @@
+package registry
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	routev1 "github.com/openshift/api/route/v1"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/deployment"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/route"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/secret"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/service"
+	"golang.org/x/crypto/bcrypt"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/klog/v2"
+)
+
+const (
+	// DefaultImage is the registry image deployed when WithImage is not used.
+	DefaultImage = "quay.io/libpod/registry:2.8.2"
+	// DefaultUsername is the username used to authenticate to the registry when WithCredentials is not used.
+	DefaultUsername = "eco-registry"
+	// Port is the port the registry listens on, both in the pod and on the service.
+	Port int32 = 5000
+
+	tlsMountPath     = "/certs"
+	authMountPath    = "/auth"
+	storageMountPath = "/var/lib/registry"
+	htpasswdFileName = "htpasswd"
+	generatedPwBytes = 16
+)
+
+// Builder provides a struct for deploying an in-cluster test registry. The registry consists of a deployment, a
+// service, a passthrough route, a TLS secret, and an htpasswd secret, all sharing the same name.
+type Builder struct {
+	// Name of the registry and all the resources created for it.
+	Name string
+	// Namespace the registry is deployed in.
+	Namespace string
+	// image used for the registry container.
+	image string
+	// username used to authenticate to the registry.
+	username string
+	// password used to authenticate to the registry.
+	password string
+	// tlsCertificate is the PEM encoded certificate served by the registry.
+	tlsCertificate []byte
+	// tlsKey is the PEM encoded private key of tlsCertificate.
+	tlsKey []byte
+	// api client to interact with the cluster.
+	apiClient *clients.Settings
+	// Used in functions that define or mutate the registry. errorMsg is processed before the registry is deployed.
+	errorMsg string
+}
+
+// Registry contains the endpoints and credentials of a deployed test registry.
+type Registry struct {
+	// PushEndpoint is the host:port the registry is reachable at from outside the cluster. If the route has not been
+	// admitted, this is the same as PullEndpoint.
+	PushEndpoint string
+	// PullEndpoint is the host:port the registry is reachable at from inside the cluster.
+	PullEndpoint string
+	// Username used to authenticate to the registry.
+	Username string
+	// Password used to authenticate to the registry.
+	Password string
+	// CACertificate is the PEM encoded certificate that signed the registry serving certificate. It should be
+	// trusted by clients pushing to or pulling from the registry.
+	CACertificate []byte
+}
+
+// NewBuilder creates a new instance of Builder. A random password and a self-signed certificate are generated at
+// deploy time unless WithCredentials and WithTLS are used.
+func NewBuilder(apiClient *clients.Settings, name, nsname string) *Builder {
+	nsname = apiClient.ResolveNamespace(nsname)
+
+	klog.V(100).Infof(
+		"Initializing new registry structure with the following params: name: %s, namespace: %s", name, nsname)
+
+	builder := &Builder{
+		Name:      name,
+		Namespace: nsname,
+		image:     DefaultImage,
+		username:  DefaultUsername,
+		apiClient: apiClient,
+	}
+
+	if name == "" {
+		klog.V(100).Info("The name of the registry is empty")
+
+		builder.errorMsg = "registry 'name' cannot be empty"
+
+		return builder
+	}
+
+	if nsname == "" {
+		klog.V(100).Info("The namespace of the registry is empty")
+
+		builder.errorMsg = "registry 'nsname' cannot be empty"
+
+		return builder
+	}
+
+	return builder
+}
+
+// WithImage sets the registry image. The image must be compatible with the distribution registry configuration
+// environment variables.
+func (builder *Builder) WithImage(image string) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	klog.V(100).Infof("Setting image of registry %s in namespace %s to %s", builder.Name, builder.Namespace, image)
+
+	if image == "" {
+		klog.V(100).Info("The registry image is empty")
+
+		builder.errorMsg = "registry 'image' cannot be empty"
+
+		return builder
+	}
+
+	builder.image = image
+
+	return builder
+}
+
+// WithCredentials sets the username and password used to authenticate to the registry.
+func (builder *Builder) WithCredentials(username, password string) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	klog.V(100).Infof("Setting credentials of registry %s in namespace %s for user %s",
+		builder.Name, builder.Namespace, username)
+
+	if username == "" {
+		klog.V(100).Info("The registry username is empty")
+
+		builder.errorMsg = "registry 'username' cannot be empty"
+
+		return builder
+	}
+
+	if password == "" {
+		klog.V(100).Info("The registry password is empty")
+
+		builder.errorMsg = "registry 'password' cannot be empty"
+
+		return builder
+	}
+
+	builder.username = username
+	builder.password = password
+
+	return builder
+}
+
+// WithTLS sets the PEM encoded certificate and key served by the registry. The certificate must be valid for the
+// service and route hostnames of the registry.
+func (builder *Builder) WithTLS(certificate, key []byte) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	klog.V(100).Infof("Setting TLS certificate of registry %s in namespace %s", builder.Name, builder.Namespace)
+
+	if len(certificate) == 0 || len(key) == 0 {
+		klog.V(100).Info("The registry TLS certificate or key is empty")
+
+		builder.errorMsg = "registry TLS 'certificate' and 'key' cannot be empty"
+
+		return builder
+	}
+
+	builder.tlsCertificate = certificate
+	builder.tlsKey = key
+
+	return builder
+}
+
+// Deploy creates the registry resources and waits up to timeout for the registry deployment to be ready. Resources
+// that already exist are left as they are.
+func (builder *Builder) Deploy(timeout time.Duration) (*Registry, error) {
+	if valid, err := builder.validate(); !valid {
+		return nil, err
+	}
+
+	klog.V(100).Infof("Deploying registry %s in namespace %s", builder.Name, builder.Namespace)
+
+	servicePort, err := service.DefineServicePort(Port, Port, corev1.ProtocolTCP)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = service.NewBuilder(builder.apiClient, builder.Name, builder.Namespace, builder.labels(), *servicePort).
+		Create()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create registry service: %w", err)
+	}
+
+	routeBuilder := route.NewBuilder(builder.apiClient, builder.Name, builder.Namespace, builder.Name).
+		WithTargetPortNumber(Port)
+	if routeBuilder.GetError() == nil {
+		routeBuilder.Definition.Spec.TLS = &routev1.TLSConfig{Termination: routev1.TLSTerminationPassthrough}
+	}
+
+	routeBuilder, err = routeBuilder.Create()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create registry route: %w", err)
+	}
+
+	registry := &Registry{
+		PullEndpoint: fmt.Sprintf("%s.%s.svc:%d", builder.Name, builder.Namespace, Port),
+		Username:     builder.username,
+		Password:     builder.password,
+	}
+
+	registry.PushEndpoint = registry.PullEndpoint
+	if routeBuilder.Object.Spec.Host != "" {
+		registry.PushEndpoint = routeBuilder.Object.Spec.Host
+	}
+
+	err = builder.createSecrets(registry, routeBuilder.Object.Spec.Host)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = builder.newDeploymentBuilder().CreateAndWaitUntilReady(timeout)
+	if err != nil {
+		return nil, fmt.Errorf("failed to deploy registry: %w", err)
+	}
+
+	return registry, nil
+}
+
+// Delete removes all the resources created for the registry. Resources that do not exist are ignored.
+func (builder *Builder) Delete() error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	klog.V(100).Infof("Deleting registry %s in namespace %s", builder.Name, builder.Namespace)
+
+	err := builder.newDeploymentBuilder().Delete()
+	if err != nil {
+		return fmt.Errorf("failed to delete registry deployment: %w", err)
+	}
+
+	_, err = route.NewBuilder(builder.apiClient, builder.Name, builder.Namespace, builder.Name).Delete()
+	if err != nil {
+		return fmt.Errorf("failed to delete registry route: %w", err)
+	}
+
+	err = service.NewBuilder(builder.apiClient, builder.Name, builder.Namespace, builder.labels(), corev1.ServicePort{}).
+		Delete()
+	if err != nil {
+		return fmt.Errorf("failed to delete registry service: %w", err)
+	}
+
+	for _, secretName := range []string{builder.tlsSecretName(), builder.authSecretName()} {
+		err = secret.NewBuilder(builder.apiClient, secretName, builder.Namespace, corev1.SecretTypeOpaque).Delete()
+		if err != nil {
+			return fmt.Errorf("failed to delete registry secret %s: %w", secretName, err)
+		}
+	}
+
+	return nil
+}
+
+// DockerConfigJSON returns a .dockerconfigjson document containing the registry credentials for both the push and
+// pull endpoints. It can be used as the data of a kubernetes.io/dockerconfigjson secret.
+func (registry *Registry) DockerConfigJSON() ([]byte, error) {
+	if registry == nil {
+		return nil, fmt.Errorf("registry cannot be nil")
+	}
+
+	auth := base64.StdEncoding.EncodeToString([]byte(registry.Username + ":" + registry.Password))
+	auths := map[string]map[string]string{}
+
+	for _, endpoint := range []string{registry.PushEndpoint, registry.PullEndpoint} {
+		auths[endpoint] = map[string]string{"auth": auth}
+	}
+
+	return json.Marshal(map[string]any{"auths": auths})
+}
+
+// createSecrets creates the TLS and htpasswd secrets of the registry, generating the password and the certificate if
+// they were not provided. The generated values are stored in registry.
+func (builder *Builder) createSecrets(registry *Registry, routeHost string) error {
+	if registry.Password == "" {
+		password, err := generatePassword()
+		if err != nil {
+			return err
+		}
+
+		registry.Password = password
+	}
+
+	certificate, key := builder.tlsCertificate, builder.tlsKey
+	if len(certificate) == 0 {
+		var err error
+
+		certificate, key, err = generateSelfSignedCertificate(builder.hostnames(routeHost))
+		if err != nil {
+			return fmt.Errorf("failed to generate registry certificate: %w", err)
+		}
+	}
+
+	registry.CACertificate = certificate
+
+	_, err := secret.NewBuilder(builder.apiClient, builder.tlsSecretName(), builder.Namespace, corev1.SecretTypeTLS).
+		WithData(map[string][]byte{
+			corev1.TLSCertKey:       certificate,
+			corev1.TLSPrivateKeyKey: key,
+		}).Create()
+	if err != nil {
+		return fmt.Errorf("failed to create registry TLS secret: %w", err)
+	}
+
+	htpasswd, err := bcrypt.GenerateFromPassword([]byte(registry.Password), bcrypt.DefaultCost)
+	if err != nil {
+		return fmt.Errorf("failed to hash registry password: %w", err)
+	}
+
+	_, err = secret.NewBuilder(builder.apiClient, builder.authSecretName(), builder.Namespace, corev1.SecretTypeOpaque).
+		WithData(map[string][]byte{
+			htpasswdFileName: []byte(registry.Username + ":" + string(htpasswd) + "\n"),
+		}).Create()
+	if err != nil {
+		return fmt.Errorf("failed to create registry htpasswd secret: %w", err)
+	}
+
+	return nil
+}
+
+// newDeploymentBuilder returns the deployment builder for the registry, mounting the TLS and htpasswd secrets.
+func (builder *Builder) newDeploymentBuilder() *deployment.Builder {
+	container := corev1.Container{
+		Name:  "registry",
+		Image: builder.image,
+		Ports: []corev1.ContainerPort{{Name: "registry", ContainerPort: Port, Protocol: corev1.ProtocolTCP}},
+		Env: []corev1.EnvVar{
+			{Name: "REGISTRY_HTTP_ADDR", Value: fmt.Sprintf(":%d", Port)},
+			{Name: "REGISTRY_HTTP_TLS_CERTIFICATE", Value: tlsMountPath + "/" + corev1.TLSCertKey},
+			{Name: "REGISTRY_HTTP_TLS_KEY", Value: tlsMountPath + "/" + corev1.TLSPrivateKeyKey},
+			{Name: "REGISTRY_AUTH", Value: "htpasswd"},
+			{Name: "REGISTRY_AUTH_HTPASSWD_REALM", Value: builder.Name},
+			{Name: "REGISTRY_AUTH_HTPASSWD_PATH", Value: authMountPath + "/" + htpasswdFileName},
+			{Name: "REGISTRY_STORAGE_DELETE_ENABLED", Value: "true"},
+		},
+		VolumeMounts: []corev1.VolumeMount{
+			{Name: "tls", MountPath: tlsMountPath, ReadOnly: true},
+			{Name: "auth", MountPath: authMountPath, ReadOnly: true},
+			{Name: "storage", MountPath: storageMountPath},
+		},
+	}
+
+	return deployment.NewBuilder(builder.apiClient, builder.Name, builder.Namespace, builder.labels(), container).
+		WithVolume(corev1.Volume{
+			Name:         "tls",
+			VolumeSource: corev1.VolumeSource{Secret: &corev1.SecretVolumeSource{SecretName: builder.tlsSecretName()}},
+		}).
+		WithVolume(corev1.Volume{
+			Name:         "auth",
+			VolumeSource: corev1.VolumeSource{Secret: &corev1.SecretVolumeSource{SecretName: builder.authSecretName()}},
+		}).
+		WithVolume(corev1.Volume{
+			Name:         "storage",
+			VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}},
+		})
+}
+
+// hostnames returns the hostnames the registry serving certificate must be valid for.
+func (builder *Builder) hostnames(routeHost string) []string {
+	hostnames := []string{
+		builder.Name,
+		fmt.Sprintf("%s.%s", builder.Name, builder.Namespace),
+		fmt.Sprintf("%s.%s.svc", builder.Name, builder.Namespace),
+		fmt.Sprintf("%s.%s.svc.cluster.local", builder.Name, builder.Namespace),
+	}
+
+	if routeHost != "" {
+		hostnames = append(hostnames, routeHost)
+	}
+
+	return hostnames
+}
+
+func (builder *Builder) labels() map[string]string {
+	return map[string]string{"app": builder.Name}
+}
+
+func (builder *Builder) tlsSecretName() string {
+	return builder.Name + "-tls"
+}
+
+func (builder *Builder) authSecretName() string {
+	return builder.Name + "-auth"
+}
+
+// generatePassword returns a random hex encoded password.
+func generatePassword() (string, error) {
+	password := make([]byte, generatedPwBytes)
+
+	_, err := rand.Read(password)
+	if err != nil {
+		return "", fmt.Errorf("failed to generate registry password: %w", err)
+	}
+
+	return hex.EncodeToString(password), nil
+}
+
+// validate will check that the builder is properly initialized before accessing any member fields.
+func (builder *Builder) validate() (bool, error) {
+	if builder == nil {
+		klog.V(100).Info("The registry builder is uninitialized")
+
+		return false, fmt.Errorf("error: received nil registry builder")
+	}
+
+	if builder.apiClient == nil {
+		klog.V(100).Info("The registry builder apiclient is nil")
+
+		return false, fmt.Errorf("registry builder cannot have nil apiClient")
+	}
+
+	if builder.errorMsg != "" {
+		klog.V(100).Infof("The registry builder has error message: %s", builder.errorMsg)
+
+		return false, fmt.Errorf("%s", builder.errorMsg)
+	}
+
+	return true, nil
+}
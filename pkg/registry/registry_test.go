@@ -0,0 +1,273 @@
+package registry
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/deployment"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/route"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/secret"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/service"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	defaultRegistryName      = "test-registry"
+	defaultRegistryNamespace = "test-registry-ns"
+)
+
+func TestNewBuilder(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		registryName  string
+		nsname        string
+		client        bool
+		expectedError string
+	}{
+		{
+			name:         "valid builder",
+			registryName: defaultRegistryName,
+			nsname:       defaultRegistryNamespace,
+			client:       true,
+		},
+		{
+			name:          "empty name",
+			nsname:        defaultRegistryNamespace,
+			client:        true,
+			expectedError: "registry 'name' cannot be empty",
+		},
+		{
+			name:          "empty namespace",
+			registryName:  defaultRegistryName,
+			client:        true,
+			expectedError: "registry 'nsname' cannot be empty",
+		},
+		{
+			name:          "nil client",
+			registryName:  defaultRegistryName,
+			nsname:        defaultRegistryNamespace,
+			expectedError: "registry builder cannot have nil apiClient",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			var testSettings *clients.Settings
+
+			if testCase.client {
+				testSettings = clients.GetTestClients(clients.TestClientParams{})
+			}
+
+			testBuilder := NewBuilder(testSettings, testCase.registryName, testCase.nsname)
+			require.NotNil(t, testBuilder)
+
+			_, err := testBuilder.validate()
+			if testCase.expectedError == "" {
+				assert.NoError(t, err)
+				assert.Equal(t, DefaultImage, testBuilder.image)
+				assert.Equal(t, DefaultUsername, testBuilder.username)
+			} else {
+				assert.EqualError(t, err, testCase.expectedError)
+			}
+		})
+	}
+}
+
+func TestBuilderWithOptions(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		configure     func(builder *Builder) *Builder
+		expectedError string
+	}{
+		{
+			name:      "valid image",
+			configure: func(builder *Builder) *Builder { return builder.WithImage("quay.io/example/registry:latest") },
+		},
+		{
+			name:          "empty image",
+			configure:     func(builder *Builder) *Builder { return builder.WithImage("") },
+			expectedError: "registry 'image' cannot be empty",
+		},
+		{
+			name:      "valid credentials",
+			configure: func(builder *Builder) *Builder { return builder.WithCredentials("user", "pass") },
+		},
+		{
+			name:          "empty username",
+			configure:     func(builder *Builder) *Builder { return builder.WithCredentials("", "pass") },
+			expectedError: "registry 'username' cannot be empty",
+		},
+		{
+			name:          "empty password",
+			configure:     func(builder *Builder) *Builder { return builder.WithCredentials("user", "") },
+			expectedError: "registry 'password' cannot be empty",
+		},
+		{
+			name:      "valid TLS",
+			configure: func(builder *Builder) *Builder { return builder.WithTLS([]byte("cert"), []byte("key")) },
+		},
+		{
+			name:          "empty TLS key",
+			configure:     func(builder *Builder) *Builder { return builder.WithTLS([]byte("cert"), nil) },
+			expectedError: "registry TLS 'certificate' and 'key' cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			testBuilder := testCase.configure(buildValidTestBuilder(clients.GetTestClients(clients.TestClientParams{})))
+
+			if testCase.expectedError == "" {
+				assert.Empty(t, testBuilder.errorMsg)
+			} else {
+				assert.Equal(t, testCase.expectedError, testBuilder.errorMsg)
+			}
+		})
+	}
+}
+
+func TestBuilderDeploy(t *testing.T) {
+	t.Parallel()
+
+	t.Run("ready deployment", func(t *testing.T) {
+		t.Parallel()
+
+		testSettings := clients.GetTestClients(clients.TestClientParams{
+			K8sMockObjects: []runtime.Object{buildDummyReadyDeployment()},
+		})
+
+		registry, err := buildValidTestBuilder(testSettings).Deploy(time.Second)
+		require.NoError(t, err)
+
+		assert.Equal(t, "test-registry.test-registry-ns.svc:5000", registry.PullEndpoint)
+		assert.Equal(t, registry.PullEndpoint, registry.PushEndpoint)
+		assert.Equal(t, DefaultUsername, registry.Username)
+		assert.Len(t, registry.Password, 2*generatedPwBytes)
+		assert.NotEmpty(t, registry.CACertificate)
+
+		_, err = service.Pull(testSettings, defaultRegistryName, defaultRegistryNamespace)
+		assert.NoError(t, err)
+
+		_, err = route.Pull(testSettings, defaultRegistryName, defaultRegistryNamespace)
+		assert.NoError(t, err)
+
+		tlsSecret, err := secret.Pull(testSettings, defaultRegistryName+"-tls", defaultRegistryNamespace)
+		require.NoError(t, err)
+		assert.Equal(t, registry.CACertificate, tlsSecret.Object.Data[corev1.TLSCertKey])
+
+		authSecret, err := secret.Pull(testSettings, defaultRegistryName+"-auth", defaultRegistryNamespace)
+		require.NoError(t, err)
+
+		username, hash, found := strings.Cut(strings.TrimSpace(string(authSecret.Object.Data[htpasswdFileName])), ":")
+		require.True(t, found)
+		assert.Equal(t, DefaultUsername, username)
+		assert.NoError(t, bcrypt.CompareHashAndPassword([]byte(hash), []byte(registry.Password)))
+	})
+
+	t.Run("deployment never ready", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := buildValidTestBuilder(clients.GetTestClients(clients.TestClientParams{})).Deploy(time.Second)
+		assert.ErrorContains(t, err, "failed to deploy registry")
+	})
+
+	t.Run("invalid builder", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := NewBuilder(clients.GetTestClients(clients.TestClientParams{}), "", defaultRegistryNamespace).
+			Deploy(time.Second)
+		assert.EqualError(t, err, "registry 'name' cannot be empty")
+	})
+}
+
+func TestBuilderDelete(t *testing.T) {
+	t.Parallel()
+
+	testSettings := clients.GetTestClients(clients.TestClientParams{
+		K8sMockObjects: []runtime.Object{buildDummyReadyDeployment()},
+	})
+
+	testBuilder := buildValidTestBuilder(testSettings).WithCredentials("user", "pass")
+
+	_, err := testBuilder.Deploy(time.Second)
+	require.NoError(t, err)
+
+	err = testBuilder.Delete()
+	require.NoError(t, err)
+
+	_, err = deployment.Pull(testSettings, defaultRegistryName, defaultRegistryNamespace)
+	assert.Error(t, err)
+
+	_, err = service.Pull(testSettings, defaultRegistryName, defaultRegistryNamespace)
+	assert.Error(t, err)
+
+	_, err = secret.Pull(testSettings, defaultRegistryName+"-auth", defaultRegistryNamespace)
+	assert.Error(t, err)
+
+	err = testBuilder.Delete()
+	assert.NoError(t, err)
+}
+
+func TestRegistryDockerConfigJSON(t *testing.T) {
+	t.Parallel()
+
+	registry := &Registry{
+		PushEndpoint: "registry.apps.example.com",
+		PullEndpoint: "registry.ns.svc:5000",
+		Username:     "user",
+		Password:     "pass",
+	}
+
+	dockerConfig, err := registry.DockerConfigJSON()
+	require.NoError(t, err)
+
+	var parsed struct {
+		Auths map[string]struct {
+			Auth string `json:"auth"`
+		} `json:"auths"`
+	}
+
+	require.NoError(t, json.Unmarshal(dockerConfig, &parsed))
+	assert.Len(t, parsed.Auths, 2)
+	assert.Equal(t, "dXNlcjpwYXNz", parsed.Auths[registry.PushEndpoint].Auth)
+	assert.Equal(t, "dXNlcjpwYXNz", parsed.Auths[registry.PullEndpoint].Auth)
+
+	registry = nil
+
+	_, err = registry.DockerConfigJSON()
+	assert.EqualError(t, err, "registry cannot be nil")
+}
+
+func buildValidTestBuilder(apiClient *clients.Settings) *Builder {
+	return NewBuilder(apiClient, defaultRegistryName, defaultRegistryNamespace)
+}
+
+func buildDummyReadyDeployment() *appsv1.Deployment {
+	return &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      defaultRegistryName,
+			Namespace: defaultRegistryNamespace,
+		},
+		Status: appsv1.DeploymentStatus{
+			Replicas:      1,
+			ReadyReplicas: 1,
+		},
+	}
+}
@@ -0,0 +1,35 @@
+package registry
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateSelfSignedCertificate(t *testing.T) {
+	t.Parallel()
+
+	hostnames := []string{"registry", "registry.ns.svc", "registry.apps.example.com"}
+
+	certificatePEM, keyPEM, err := generateSelfSignedCertificate(hostnames)
+	require.NoError(t, err)
+
+	_, err = tls.X509KeyPair(certificatePEM, keyPEM)
+	require.NoError(t, err)
+
+	block, _ := pem.Decode(certificatePEM)
+	require.NotNil(t, block)
+
+	certificate, err := x509.ParseCertificate(block.Bytes)
+	require.NoError(t, err)
+	assert.Equal(t, hostnames, certificate.DNSNames)
+	assert.Equal(t, "registry", certificate.Subject.CommonName)
+	assert.NoError(t, certificate.VerifyHostname("registry.apps.example.com"))
+
+	_, _, err = generateSelfSignedCertificate(nil)
+	assert.EqualError(t, err, "at least one hostname is required to generate a certificate")
+}
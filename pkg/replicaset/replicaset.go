@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common"
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/logging"
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/msg"
 	appsv1 "k8s.io/api/apps/v1"
@@ -47,6 +48,8 @@ func NewBuilder(
 	name, nsname string,
 	labels map[string]string,
 	containerSpec []corev1.Container) *Builder {
+	nsname = apiClient.ResolveNamespace(nsname)
+
 	klog.V(100).Infof(
 		"Initializing new replicaset structure with the following params: "+
 			"name: %s, namespace: %s, containerSpec %v",
@@ -113,6 +116,8 @@ func NewBuilder(
 
 // Pull loads an existing replicaset into the Builder struct.
 func Pull(apiClient *clients.Settings, name, nsname string) (*Builder, error) {
+	nsname = apiClient.ResolveNamespace(nsname)
+
 	klog.V(100).Infof("Pulling existing replicaset name:%s under namespace:%s", name, nsname)
 
 	if apiClient == nil {
@@ -174,6 +179,14 @@ func (builder *Builder) WithLabel(labels map[string]string) *Builder {
 		}
 	}
 
+	if err := common.ValidateLabels(labels); err != nil {
+		klog.V(100).Infof("Invalid labels: %v", err)
+
+		builder.errorMsg = err.Error()
+
+		return builder
+	}
+
 	builder.Definition.Labels = labels
 
 	return builder
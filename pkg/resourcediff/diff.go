@@ -0,0 +1,215 @@
+package resourcediff
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+
+	"k8s.io/klog/v2"
+)
+
+// FieldChange is a field whose value differs between two captures of a resource.
+type FieldChange struct {
+	// Path is the path of the field, such as spec.template.spec.containers[0].image.
+	Path string
+	// Before is the value of the field in the first capture, or nil if the field was added.
+	Before any
+	// After is the value of the field in the second capture, or nil if the field was removed.
+	After any
+}
+
+// String returns the change in the path: before -> after form.
+func (change FieldChange) String() string {
+	return fmt.Sprintf("%s: %s -> %s", change.Path, formatValue(change.Before), formatValue(change.After))
+}
+
+// ResourceChange is a resource present in both captures whose content differs.
+type ResourceChange struct {
+	Key    ResourceKey
+	Fields []FieldChange
+}
+
+// Report is the difference between two bundles.
+type Report struct {
+	// Added are the resources only present in the second bundle.
+	Added []ResourceKey
+	// Removed are the resources only present in the first bundle.
+	Removed []ResourceKey
+	// Changed are the resources present in both bundles whose content differs.
+	Changed []ResourceChange
+}
+
+// Diff compares the before and after bundles and returns the resources that were added, removed or changed, sorted by
+// key. Fields under any of ignoredPaths, such as metadata.annotations or spec.template.spec.containers[0].image, are
+// not compared, which is useful to skip fields expected to change during an upgrade.
+func Diff(before, after *Bundle, ignoredPaths ...string) (*Report, error) {
+	if before == nil || after == nil {
+		klog.V(100).Info("The bundles to diff cannot be nil")
+
+		return nil, fmt.Errorf("resourcediff bundles cannot be nil")
+	}
+
+	klog.V(100).Infof("Comparing bundles of %d and %d resources, ignoring paths %v",
+		len(before.Resources), len(after.Resources), ignoredPaths)
+
+	report := &Report{}
+
+	for key, beforeObject := range before.Resources {
+		afterObject, ok := after.Resources[key]
+		if !ok {
+			report.Removed = append(report.Removed, key)
+
+			continue
+		}
+
+		var fields []FieldChange
+
+		diffValues("", beforeObject.Object, afterObject.Object, ignoredPaths, &fields)
+
+		if len(fields) > 0 {
+			report.Changed = append(report.Changed, ResourceChange{Key: key, Fields: fields})
+		}
+	}
+
+	for key := range after.Resources {
+		if _, ok := before.Resources[key]; !ok {
+			report.Added = append(report.Added, key)
+		}
+	}
+
+	compareKeys := func(first, second ResourceKey) int {
+		return strings.Compare(first.String(), second.String())
+	}
+
+	slices.SortFunc(report.Added, compareKeys)
+	slices.SortFunc(report.Removed, compareKeys)
+	slices.SortFunc(report.Changed, func(first, second ResourceChange) int {
+		return compareKeys(first.Key, second.Key)
+	})
+
+	return report, nil
+}
+
+// IsEmpty returns whether the bundles compared by the report are identical.
+func (report *Report) IsEmpty() bool {
+	return report == nil || len(report.Added) == 0 && len(report.Removed) == 0 && len(report.Changed) == 0
+}
+
+// String returns the report in a human readable form, with one line per added or removed resource followed by the
+// changed resources and their field changes.
+func (report *Report) String() string {
+	if report.IsEmpty() {
+		return "no differences"
+	}
+
+	var builder strings.Builder
+
+	for _, key := range report.Added {
+		fmt.Fprintf(&builder, "added: %s\n", key)
+	}
+
+	for _, key := range report.Removed {
+		fmt.Fprintf(&builder, "removed: %s\n", key)
+	}
+
+	for _, change := range report.Changed {
+		fmt.Fprintf(&builder, "changed: %s\n", change.Key)
+
+		for _, field := range change.Fields {
+			fmt.Fprintf(&builder, "  %s\n", field)
+		}
+	}
+
+	return strings.TrimSuffix(builder.String(), "\n")
+}
+
+// diffValues recursively compares the before and after values at path, appending the leaf fields that differ to
+// changes. Maps are compared key by key and slices index by index, so only the fields that changed are reported.
+func diffValues(path string, before, after any, ignoredPaths []string, changes *[]FieldChange) {
+	if isIgnored(path, ignoredPaths) {
+		return
+	}
+
+	beforeMap, beforeIsMap := before.(map[string]any)
+	afterMap, afterIsMap := after.(map[string]any)
+
+	if beforeIsMap && afterIsMap {
+		keys := make([]string, 0, len(beforeMap)+len(afterMap))
+
+		for key := range beforeMap {
+			keys = append(keys, key)
+		}
+
+		for key := range afterMap {
+			if _, ok := beforeMap[key]; !ok {
+				keys = append(keys, key)
+			}
+		}
+
+		slices.Sort(keys)
+
+		for _, key := range keys {
+			childPath := key
+			if path != "" {
+				childPath = path + "." + key
+			}
+
+			diffValues(childPath, beforeMap[key], afterMap[key], ignoredPaths, changes)
+		}
+
+		return
+	}
+
+	beforeSlice, beforeIsSlice := before.([]any)
+	afterSlice, afterIsSlice := after.([]any)
+
+	if beforeIsSlice && afterIsSlice {
+		for idx := range max(len(beforeSlice), len(afterSlice)) {
+			var beforeElement, afterElement any
+
+			if idx < len(beforeSlice) {
+				beforeElement = beforeSlice[idx]
+			}
+
+			if idx < len(afterSlice) {
+				afterElement = afterSlice[idx]
+			}
+
+			diffValues(fmt.Sprintf("%s[%d]", path, idx), beforeElement, afterElement, ignoredPaths, changes)
+		}
+
+		return
+	}
+
+	if !reflect.DeepEqual(before, after) {
+		*changes = append(*changes, FieldChange{Path: path, Before: before, After: after})
+	}
+}
+
+// isIgnored returns whether the path is one of the ignored paths or is nested under one of them.
+func isIgnored(path string, ignoredPaths []string) bool {
+	for _, ignoredPath := range ignoredPaths {
+		if path == ignoredPath ||
+			strings.HasPrefix(path, ignoredPath+".") || strings.HasPrefix(path, ignoredPath+"[") {
+			return true
+		}
+	}
+
+	return false
+}
+
+// formatValue returns the JSON encoding of the value, or <none> if the field is not set.
+func formatValue(value any) string {
+	if value == nil {
+		return "<none>"
+	}
+
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprintf("%v", value)
+	}
+
+	return string(encoded)
+}
@@ -0,0 +1,99 @@
+package resourcediff
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestDiff(t *testing.T) {
+	operatorKey := ResourceKey{Group: "apps", Resource: "deployments", Namespace: defaultNamespace, Name: "operator"}
+	webhookKey := ResourceKey{Group: "apps", Resource: "deployments", Namespace: defaultNamespace, Name: "webhook"}
+	configKey := ResourceKey{Resource: "configmaps", Namespace: defaultNamespace, Name: "config"}
+
+	before := &Bundle{Resources: map[ResourceKey]*unstructured.Unstructured{
+		operatorKey: buildDummyObject("operator:v1", []any{"--v=1"}, map[string]any{"version": "1"}),
+		webhookKey:  buildDummyObject("webhook:v1", nil, nil),
+	}}
+	after := &Bundle{Resources: map[ResourceKey]*unstructured.Unstructured{
+		operatorKey: buildDummyObject("operator:v2", []any{"--v=1", "--leader-elect"}, map[string]any{"version": "2"}),
+		configKey:   buildDummyObject("", nil, nil),
+	}}
+
+	report, err := Diff(before, after)
+	assert.NoError(t, err)
+	assert.False(t, report.IsEmpty())
+	assert.Equal(t, []ResourceKey{configKey}, report.Added)
+	assert.Equal(t, []ResourceKey{webhookKey}, report.Removed)
+	assert.Equal(t, []ResourceChange{{
+		Key: operatorKey,
+		Fields: []FieldChange{
+			{Path: "metadata.labels.version", Before: "1", After: "2"},
+			{Path: "spec.template.spec.containers[0].args[1]", After: "--leader-elect"},
+			{Path: "spec.template.spec.containers[0].image", Before: "operator:v1", After: "operator:v2"},
+		},
+	}}, report.Changed)
+
+	assert.Equal(t, `added: configmaps/openshift-kmm/config
+removed: deployments.apps/openshift-kmm/webhook
+changed: deployments.apps/openshift-kmm/operator
+  metadata.labels.version: "1" -> "2"
+  spec.template.spec.containers[0].args[1]: <none> -> "--leader-elect"
+  spec.template.spec.containers[0].image: "operator:v1" -> "operator:v2"`, report.String())
+
+	report, err = Diff(before, after, "metadata.labels", "spec.template.spec.containers[0]")
+	assert.NoError(t, err)
+	assert.Empty(t, report.Changed)
+
+	report, err = Diff(before, before)
+	assert.NoError(t, err)
+	assert.True(t, report.IsEmpty())
+	assert.Equal(t, "no differences", report.String())
+
+	_, err = Diff(nil, after)
+	assert.EqualError(t, err, "resourcediff bundles cannot be nil")
+}
+
+func TestIsIgnored(t *testing.T) {
+	testCases := []struct {
+		path     string
+		expected bool
+	}{
+		{path: "spec", expected: true},
+		{path: "spec.replicas", expected: true},
+		{path: "metadata.labels", expected: true},
+		{path: "metadata.labels.version", expected: true},
+		{path: "metadata.labelsExtra", expected: false},
+		{path: "containers[0].image", expected: true},
+		{path: "containers[1].image", expected: false},
+	}
+
+	for _, testCase := range testCases {
+		assert.Equal(t, testCase.expected,
+			isIgnored(testCase.path, []string{"spec", "metadata.labels", "containers[0]"}), testCase.path)
+	}
+}
+
+func buildDummyObject(image string, args []any, labels map[string]any) *unstructured.Unstructured {
+	container := map[string]any{"name": "manager", "image": image}
+	if args != nil {
+		container["args"] = args
+	}
+
+	metadata := map[string]any{"name": "dummy"}
+	if labels != nil {
+		metadata["labels"] = labels
+	}
+
+	return &unstructured.Unstructured{Object: map[string]any{
+		"metadata": metadata,
+		"spec": map[string]any{
+			"template": map[string]any{
+				"spec": map[string]any{
+					"containers": []any{container},
+				},
+			},
+		},
+	}}
+}
@@ -0,0 +1,157 @@
+// Package resourcediff captures the resources owned by an operator and reports how they changed, for example across
+// an operator upgrade. Capture a Bundle before the upgrade and another one after it, then call Diff to get a Report of
+// the resources that were added, removed or changed, down to the individual fields that changed.
+package resourcediff
+
+import (
+	"fmt"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/logging"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/klog/v2"
+)
+
+// volatileMetadataFields are the metadata fields set by the API server that change without the resource changing, so
+// they are dropped from captured resources.
+var volatileMetadataFields = []string{
+	"uid", "resourceVersion", "generation", "creationTimestamp", "managedFields", "selfLink",
+}
+
+// Options select the resources captured in a Bundle.
+type Options struct {
+	// Resources are the resources to capture, such as deployments or daemonsets. The version is only used to list
+	// them, so bundles captured using different versions of the same resource can still be compared.
+	Resources []schema.GroupVersionResource
+	// Namespace is the namespace the resources are captured from. It must be empty for cluster-scoped resources, and
+	// captures namespaced resources from all namespaces if so.
+	Namespace string
+	// LabelSelector restricts the capture to resources matching it, such as app.kubernetes.io/part-of=kmm.
+	LabelSelector string
+	// OwnerKind restricts the capture to resources with an owner reference of this kind, such as
+	// ClusterServiceVersion, if set.
+	OwnerKind string
+	// OwnerName restricts the capture to resources with an owner reference of this name, if set.
+	OwnerName string
+	// IncludeStatus keeps the status of the captured resources, which is dropped by default since it changes on its
+	// own.
+	IncludeStatus bool
+}
+
+// ResourceKey identifies a captured resource independently of its API version.
+type ResourceKey struct {
+	Group     string
+	Resource  string
+	Namespace string
+	Name      string
+}
+
+// String returns the key in the resource.group/namespace/name form, omitting the group of core resources and the
+// namespace of cluster-scoped resources.
+func (key ResourceKey) String() string {
+	resource := schema.GroupResource{Group: key.Group, Resource: key.Resource}.String()
+
+	if key.Namespace == "" {
+		return fmt.Sprintf("%s/%s", resource, key.Name)
+	}
+
+	return fmt.Sprintf("%s/%s/%s", resource, key.Namespace, key.Name)
+}
+
+// Bundle holds the resources captured at a point in time.
+type Bundle struct {
+	// Resources are the captured resources, without the metadata fields set by the API server and, unless
+	// Options.IncludeStatus is set, without their status.
+	Resources map[ResourceKey]*unstructured.Unstructured
+}
+
+// Capture lists the resources selected by options and returns them as a Bundle.
+func Capture(apiClient *clients.Settings, options Options) (*Bundle, error) {
+	if apiClient == nil {
+		klog.V(100).Info("The apiClient of the resource capture is nil")
+
+		return nil, fmt.Errorf("resourcediff 'apiClient' cannot be nil")
+	}
+
+	if len(options.Resources) == 0 {
+		klog.V(100).Info("The resources to capture are empty")
+
+		return nil, fmt.Errorf("resourcediff 'resources' cannot be empty")
+	}
+
+	klog.V(100).Infof("Capturing resources %v in namespace %q with label selector %q and owner %s/%s",
+		options.Resources, options.Namespace, options.LabelSelector, options.OwnerKind, options.OwnerName)
+
+	bundle := &Bundle{Resources: make(map[ResourceKey]*unstructured.Unstructured)}
+
+	for _, gvr := range options.Resources {
+		objectList, err := apiClient.Resource(gvr).Namespace(options.Namespace).List(
+			logging.DiscardContext(), metav1.ListOptions{LabelSelector: options.LabelSelector})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list %s: %w", gvr.GroupResource(), err)
+		}
+
+		for idx := range objectList.Items {
+			object := &objectList.Items[idx]
+
+			if !isOwnedBy(object, options.OwnerKind, options.OwnerName) {
+				continue
+			}
+
+			key := ResourceKey{
+				Group:     gvr.Group,
+				Resource:  gvr.Resource,
+				Namespace: object.GetNamespace(),
+				Name:      object.GetName(),
+			}
+
+			bundle.Resources[key] = normalize(object, options.IncludeStatus)
+		}
+	}
+
+	return bundle, nil
+}
+
+// isOwnedBy returns whether the object has an owner reference matching ownerKind and ownerName, either of which
+// matches any owner if empty.
+func isOwnedBy(object *unstructured.Unstructured, ownerKind, ownerName string) bool {
+	if ownerKind == "" && ownerName == "" {
+		return true
+	}
+
+	for _, ownerReference := range object.GetOwnerReferences() {
+		if (ownerKind == "" || ownerReference.Kind == ownerKind) &&
+			(ownerName == "" || ownerReference.Name == ownerName) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// normalize returns a copy of the object without the fields that change without the resource changing.
+func normalize(object *unstructured.Unstructured, includeStatus bool) *unstructured.Unstructured {
+	normalized := object.DeepCopy()
+
+	for _, field := range volatileMetadataFields {
+		unstructured.RemoveNestedField(normalized.Object, "metadata", field)
+	}
+
+	// Owners are recreated during upgrades, so their UIDs change even if the ownership does not.
+	ownerReferences := normalized.GetOwnerReferences()
+	for idx := range ownerReferences {
+		ownerReferences[idx].UID = ""
+	}
+
+	if len(ownerReferences) > 0 {
+		normalized.SetOwnerReferences(ownerReferences)
+	}
+
+	if !includeStatus {
+		unstructured.RemoveNestedField(normalized.Object, "status")
+	}
+
+	return normalized
+}
@@ -0,0 +1,156 @@
+package resourcediff
+
+import (
+	"testing"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+)
+
+const defaultNamespace = "openshift-kmm"
+
+var deploymentGVR = schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "deployments"}
+
+func TestCapture(t *testing.T) {
+	testCases := []struct {
+		options       Options
+		expectedNames []string
+		expectedError string
+	}{
+		{
+			options:       Options{Resources: []schema.GroupVersionResource{deploymentGVR}, Namespace: defaultNamespace},
+			expectedNames: []string{"kmm-operator", "kmm-webhook", "unrelated"},
+		},
+		{
+			options: Options{
+				Resources:     []schema.GroupVersionResource{deploymentGVR},
+				Namespace:     defaultNamespace,
+				LabelSelector: "app=kmm",
+			},
+			expectedNames: []string{"kmm-operator", "kmm-webhook"},
+		},
+		{
+			options: Options{
+				Resources: []schema.GroupVersionResource{deploymentGVR},
+				Namespace: defaultNamespace,
+				OwnerKind: "ClusterServiceVersion",
+			},
+			expectedNames: []string{"kmm-operator"},
+		},
+		{
+			options: Options{
+				Resources: []schema.GroupVersionResource{deploymentGVR},
+				Namespace: defaultNamespace,
+				OwnerName: "other",
+			},
+			expectedNames: []string{},
+		},
+		{
+			options:       Options{Namespace: defaultNamespace},
+			expectedError: "resourcediff 'resources' cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testSettings := buildTestClientWithDeployments(
+			buildDummyDeployment("kmm-operator", map[string]string{"app": "kmm"}, "ClusterServiceVersion"),
+			buildDummyDeployment("kmm-webhook", map[string]string{"app": "kmm"}, ""),
+			buildDummyDeployment("unrelated", nil, ""))
+
+		bundle, err := Capture(testSettings, testCase.options)
+		if testCase.expectedError != "" {
+			assert.EqualError(t, err, testCase.expectedError)
+
+			continue
+		}
+
+		assert.NoError(t, err)
+
+		names := []string{}
+
+		for key := range bundle.Resources {
+			assert.Equal(t, "apps", key.Group)
+			assert.Equal(t, "deployments", key.Resource)
+			assert.Equal(t, defaultNamespace, key.Namespace)
+
+			names = append(names, key.Name)
+		}
+
+		assert.ElementsMatch(t, testCase.expectedNames, names)
+	}
+
+	_, err := Capture(nil, Options{Resources: []schema.GroupVersionResource{deploymentGVR}})
+	assert.EqualError(t, err, "resourcediff 'apiClient' cannot be nil")
+}
+
+func TestCaptureNormalizes(t *testing.T) {
+	testSettings := buildTestClientWithDeployments(
+		buildDummyDeployment("kmm-operator", nil, "ClusterServiceVersion"))
+
+	bundle, err := Capture(testSettings, Options{Resources: []schema.GroupVersionResource{deploymentGVR}})
+	assert.NoError(t, err)
+
+	object := bundle.Resources[ResourceKey{
+		Group: "apps", Resource: "deployments", Namespace: defaultNamespace, Name: "kmm-operator"}]
+	assert.NotNil(t, object)
+	assert.Empty(t, object.GetUID())
+	assert.Empty(t, object.GetResourceVersion())
+	assert.Empty(t, object.GetOwnerReferences()[0].UID)
+	assert.NotContains(t, object.Object, "status")
+
+	bundle, err = Capture(testSettings, Options{
+		Resources: []schema.GroupVersionResource{deploymentGVR}, IncludeStatus: true})
+	assert.NoError(t, err)
+
+	for _, object := range bundle.Resources {
+		assert.Contains(t, object.Object, "status")
+	}
+}
+
+func TestResourceKeyString(t *testing.T) {
+	assert.Equal(t, "deployments.apps/ns/name",
+		ResourceKey{Group: "apps", Resource: "deployments", Namespace: "ns", Name: "name"}.String())
+	assert.Equal(t, "configmaps/ns/name", ResourceKey{Resource: "configmaps", Namespace: "ns", Name: "name"}.String())
+	assert.Equal(t, "clusterroles.rbac.authorization.k8s.io/name",
+		ResourceKey{Group: "rbac.authorization.k8s.io", Resource: "clusterroles", Name: "name"}.String())
+}
+
+func buildTestClientWithDeployments(objects ...runtime.Object) *clients.Settings {
+	testSettings := clients.GetTestClients(clients.TestClientParams{})
+	testSettings.Interface = dynamicfake.NewSimpleDynamicClientWithCustomListKinds(
+		runtime.NewScheme(), map[schema.GroupVersionResource]string{deploymentGVR: "DeploymentList"}, objects...)
+
+	return testSettings
+}
+
+func buildDummyDeployment(name string, labels map[string]string, ownerKind string) *unstructured.Unstructured {
+	object := &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"metadata": map[string]any{
+			"name":            name,
+			"namespace":       defaultNamespace,
+			"uid":             "uid-" + name,
+			"resourceVersion": "1",
+		},
+		"spec": map[string]any{
+			"replicas": int64(1),
+		},
+		"status": map[string]any{
+			"readyReplicas": int64(1),
+		},
+	}}
+
+	object.SetLabels(labels)
+
+	if ownerKind != "" {
+		object.SetOwnerReferences([]metav1.OwnerReference{{Kind: ownerKind, Name: "kmm.v2.0.0", UID: "owner-uid"}})
+	}
+
+	return object
+}
@@ -11,6 +11,8 @@ import (
 
 // List returns resource quota inventory in the given namespace.
 func List(apiClient *clients.Settings, nsname string, options ...metav1.ListOptions) ([]*Builder, error) {
+	nsname = apiClient.ResolveNamespace(nsname)
+
 	klog.V(100).Infof("Listing resource quotas in the namespace %s", nsname)
 
 	if nsname == "" {
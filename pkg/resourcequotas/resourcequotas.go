@@ -35,6 +35,8 @@ type Builder struct {
 
 // NewBuilder creates a new resource quota builder.
 func NewBuilder(apiClient *clients.Settings, name, nsname string) *Builder {
+	nsname = apiClient.ResolveNamespace(nsname)
+
 	klog.V(100).Infof("Initializing new resource quota structure with the following params: "+
 		"name=%s, namespace=%s", name, nsname)
 
@@ -75,6 +77,8 @@ func NewBuilder(apiClient *clients.Settings, name, nsname string) *Builder {
 
 // Pull retrieves the resource quota from the cluster.
 func Pull(apiClient *clients.Settings, name, nsname string) (*Builder, error) {
+	nsname = apiClient.ResolveNamespace(nsname)
+
 	if apiClient == nil {
 		klog.V(100).Info("apiClient is nil")
 
@@ -0,0 +1,3 @@
+package route
+
+//go:generate go run ../../internal/listgen -builder Builder
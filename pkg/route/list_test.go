@@ -0,0 +1,32 @@
+package route
+
+import (
+	"testing"
+
+	routev1 "github.com/openshift/api/route/v1"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common/testhelper"
+	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func TestList(t *testing.T) {
+	t.Parallel()
+
+	testhelper.NewNamespacedListTestConfig(
+		func(apiClient *clients.Settings, nsname string, _ ...runtimeclient.ListOptions) ([]*Builder, error) {
+			return List(apiClient, nsname)
+		},
+		routev1.AddToScheme,
+		routev1.GroupVersion.WithKind("Route"),
+	).ExecuteTests(t)
+}
+
+func TestListInAllNamespaces(t *testing.T) {
+	t.Parallel()
+
+	testhelper.NewListTestConfig(
+		ListInAllNamespaces,
+		routev1.AddToScheme,
+		routev1.GroupVersion.WithKind("Route"),
+	).ExecuteTests(t)
+}
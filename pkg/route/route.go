@@ -169,3 +169,10 @@ func getUnsupportedWildCardPoliciesError() error {
 		"received unsupported route wildcardPolicy: expected one of %v",
 		supportedWildCardPolicies())
 }
+
+// GetGVR returns Route's GroupVersionResource which could be used for Clean function.
+func GetGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group: "route.openshift.io", Version: "v1", Resource: "routes",
+	}
+}
@@ -0,0 +1,162 @@
+/*
+Copyright 2021 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ClusterPhase is a string representation of a Cluster Phase.
+type ClusterPhase string
+
+const (
+	// ClusterPhasePending is the first state a Cluster is assigned by Cluster API Cluster controller after being
+	// created.
+	ClusterPhasePending = ClusterPhase("Pending")
+
+	// ClusterPhaseProvisioning is the state when the Cluster has a infrastructure object or a control plane object
+	// that can start provisioning the control plane endpoint.
+	ClusterPhaseProvisioning = ClusterPhase("Provisioning")
+
+	// ClusterPhaseProvisioned is the state when its control plane endpoint has been created and configured and the
+	// infrastructure object is ready (if defined).
+	ClusterPhaseProvisioned = ClusterPhase("Provisioned")
+
+	// ClusterPhaseDeleting is the Cluster state when a delete request has been sent to the API Server, but its
+	// infrastructure has not yet been fully deleted.
+	ClusterPhaseDeleting = ClusterPhase("Deleting")
+
+	// ClusterPhaseFailed is the Cluster state when the system might require user intervention.
+	ClusterPhaseFailed = ClusterPhase("Failed")
+
+	// ClusterPhaseUnknown is returned if the Cluster state cannot be determined.
+	ClusterPhaseUnknown = ClusterPhase("Unknown")
+)
+
+// ClusterSpec defines the desired state of Cluster.
+type ClusterSpec struct {
+	// paused can be used to prevent controllers from processing the Cluster and all its associated objects.
+	// +optional
+	Paused bool `json:"paused,omitempty"`
+
+	// clusterNetwork represents the cluster network configuration.
+	// +optional
+	ClusterNetwork *ClusterNetwork `json:"clusterNetwork,omitempty"`
+
+	// controlPlaneEndpoint represents the endpoint used to communicate with the control plane.
+	// +optional
+	ControlPlaneEndpoint APIEndpoint `json:"controlPlaneEndpoint,omitempty"`
+
+	// controlPlaneRef is an optional reference to a provider-specific resource that holds the details for
+	// provisioning the Control Plane for a Cluster.
+	// +optional
+	ControlPlaneRef *corev1.ObjectReference `json:"controlPlaneRef,omitempty"`
+
+	// infrastructureRef is a reference to a provider-specific resource that holds the details for provisioning
+	// infrastructure for a cluster in said provider.
+	// +optional
+	InfrastructureRef *corev1.ObjectReference `json:"infrastructureRef,omitempty"`
+}
+
+// ClusterNetwork specifies the different networking parameters for a cluster.
+type ClusterNetwork struct {
+	// apiServerPort specifies the port the API Server should bind to. Defaults to 6443.
+	// +optional
+	APIServerPort *int32 `json:"apiServerPort,omitempty"`
+
+	// services is the network ranges from which service VIPs are allocated.
+	// +optional
+	Services *NetworkRanges `json:"services,omitempty"`
+
+	// pods is the network ranges from which Pod networks are allocated.
+	// +optional
+	Pods *NetworkRanges `json:"pods,omitempty"`
+
+	// serviceDomain is the domain name for services.
+	// +optional
+	ServiceDomain string `json:"serviceDomain,omitempty"`
+}
+
+// NetworkRanges represents ranges of network addresses.
+type NetworkRanges struct {
+	// cidrBlocks is a list of CIDR blocks.
+	CIDRBlocks []string `json:"cidrBlocks"`
+}
+
+// ClusterStatus defines the observed state of Cluster.
+type ClusterStatus struct {
+	// failureReason indicates that there is a fatal problem reconciling the state, and will be set to a token value
+	// suitable for programmatic interpretation.
+	// +optional
+	FailureReason *string `json:"failureReason,omitempty"`
+
+	// failureMessage indicates that there is a fatal problem reconciling the state, and will be set to a descriptive
+	// error message.
+	// +optional
+	FailureMessage *string `json:"failureMessage,omitempty"`
+
+	// phase represents the current phase of cluster actuation.
+	// +optional
+	Phase string `json:"phase,omitempty"`
+
+	// infrastructureReady is the state of the infrastructure provider.
+	// +optional
+	InfrastructureReady bool `json:"infrastructureReady"`
+
+	// controlPlaneReady denotes if the control plane became ready during initial provisioning to receive requests.
+	// +optional
+	ControlPlaneReady bool `json:"controlPlaneReady"`
+
+	// conditions defines current service state of the cluster.
+	// +optional
+	Conditions Conditions `json:"conditions,omitempty"`
+
+	// observedGeneration is the latest generation observed by the controller.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:path=clusters,shortName=cl,scope=Namespaced,categories=cluster-api
+// +kubebuilder:subresource:status
+
+// Cluster is the Schema for the clusters API.
+type Cluster struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// spec is the desired state of Cluster.
+	// +optional
+	Spec ClusterSpec `json:"spec,omitempty"`
+	// status is the observed state of Cluster.
+	// +optional
+	Status ClusterStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ClusterList contains a list of Cluster.
+type ClusterList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Cluster `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&Cluster{}, &ClusterList{})
+}
@@ -0,0 +1,116 @@
+/*
+Copyright 2021 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// ClusterNameLabel is the label set on machines and other objects linked to a cluster.
+	ClusterNameLabel = "cluster.x-k8s.io/cluster-name"
+	// MachineDeploymentNameLabel is the label set on machines if they're controlled by MachineDeployment.
+	MachineDeploymentNameLabel = "cluster.x-k8s.io/deployment-name"
+)
+
+// ConditionType is a valid value for Condition.Type.
+type ConditionType string
+
+// ConditionSeverity expresses the severity of a Condition Type failing.
+type ConditionSeverity string
+
+const (
+	// ReadyCondition defines the Ready condition type that summarizes the operational state of a Cluster API object.
+	ReadyCondition ConditionType = "Ready"
+
+	// ConditionSeverityError specifies that a condition with `Status=False` is an error.
+	ConditionSeverityError ConditionSeverity = "Error"
+	// ConditionSeverityWarning specifies that a condition with `Status=False` is a warning.
+	ConditionSeverityWarning ConditionSeverity = "Warning"
+	// ConditionSeverityInfo specifies that a condition with `Status=False` is informative.
+	ConditionSeverityInfo ConditionSeverity = "Info"
+	// ConditionSeverityNone should apply only to conditions with `Status=True`.
+	ConditionSeverityNone ConditionSeverity = ""
+)
+
+// Condition defines an observation of a Cluster API resource operational state.
+type Condition struct {
+	// type of condition in CamelCase or in foo.example.com/CamelCase.
+	Type ConditionType `json:"type"`
+
+	// status of the condition, one of True, False, Unknown.
+	Status corev1.ConditionStatus `json:"status"`
+
+	// severity provides an explicit classification of Reason code, so the users or machines can immediately
+	// understand the current situation and act accordingly.
+	// The Severity field MUST be set only when Status=False.
+	// +optional
+	Severity ConditionSeverity `json:"severity,omitempty"`
+
+	// lastTransitionTime is the last time the condition transitioned from one status to another.
+	LastTransitionTime metav1.Time `json:"lastTransitionTime"`
+
+	// reason is the reason for the condition's last transition in CamelCase.
+	// +optional
+	Reason string `json:"reason,omitempty"`
+
+	// message is a human readable message indicating details about the transition.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// Conditions provide observations of the operational state of a Cluster API resource.
+type Conditions []Condition
+
+// ObjectMeta is metadata that all persisted resources must have, which includes all objects users must create. This
+// is a copy of customizable fields from metav1.ObjectMeta.
+type ObjectMeta struct {
+	// labels is a map of string keys and values that can be used to organize and categorize (scope and select)
+	// objects.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// annotations is an unstructured key value map stored with a resource that may be set by external tools to store
+	// and retrieve arbitrary metadata.
+	// +optional
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+// APIEndpoint represents a reachable Kubernetes API endpoint.
+type APIEndpoint struct {
+	// host is the hostname on which the API server is serving.
+	Host string `json:"host"`
+
+	// port is the port on which the API server is serving.
+	Port int32 `json:"port"`
+}
+
+// MachineAddressType describes a valid MachineAddress type.
+type MachineAddressType string
+
+// MachineAddress contains information for the node's address.
+type MachineAddress struct {
+	// type is the machine address type, one of Hostname, ExternalIP, InternalIP, ExternalDNS or InternalDNS.
+	Type MachineAddressType `json:"type"`
+
+	// address is the machine address.
+	Address string `json:"address"`
+}
+
+// MachineAddresses is a slice of MachineAddress items to be used by infrastructure providers.
+type MachineAddresses []MachineAddress
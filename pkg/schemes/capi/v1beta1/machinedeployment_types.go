@@ -0,0 +1,193 @@
+/*
+Copyright 2021 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// MachineDeploymentPhase indicates the progress of the machine deployment.
+type MachineDeploymentPhase string
+
+const (
+	// MachineDeploymentPhaseScalingUp indicates the MachineDeployment is scaling up.
+	MachineDeploymentPhaseScalingUp = MachineDeploymentPhase("ScalingUp")
+
+	// MachineDeploymentPhaseScalingDown indicates the MachineDeployment is scaling down.
+	MachineDeploymentPhaseScalingDown = MachineDeploymentPhase("ScalingDown")
+
+	// MachineDeploymentPhaseRunning indicates scaling has completed and all Machines are running.
+	MachineDeploymentPhaseRunning = MachineDeploymentPhase("Running")
+
+	// MachineDeploymentPhaseFailed indicates there was a problem scaling and user intervention might be required.
+	MachineDeploymentPhaseFailed = MachineDeploymentPhase("Failed")
+
+	// MachineDeploymentPhaseUnknown indicates the state of the MachineDeployment cannot be determined.
+	MachineDeploymentPhaseUnknown = MachineDeploymentPhase("Unknown")
+)
+
+// MachineDeploymentSpec defines the desired state of MachineDeployment.
+type MachineDeploymentSpec struct {
+	// clusterName is the name of the Cluster this object belongs to.
+	ClusterName string `json:"clusterName"`
+
+	// replicas is the number of desired machines.
+	// +optional
+	Replicas *int32 `json:"replicas,omitempty"`
+
+	// selector is the label selector for machines. Existing MachineSets whose machines are selected by this will be
+	// the ones affected by this deployment. It must match the machine template's labels.
+	Selector metav1.LabelSelector `json:"selector"`
+
+	// template describes the machines that will be created.
+	Template MachineTemplateSpec `json:"template"`
+
+	// minReadySeconds is the minimum number of seconds for which a Node for a newly created machine should be ready
+	// before considering the replica available.
+	// +optional
+	MinReadySeconds *int32 `json:"minReadySeconds,omitempty"`
+
+	// paused indicates that the deployment is paused.
+	// +optional
+	Paused bool `json:"paused,omitempty"`
+}
+
+// MachineTemplateSpec describes the data needed to create a Machine from a template.
+type MachineTemplateSpec struct {
+	// metadata is the standard object's metadata.
+	// +optional
+	ObjectMeta `json:"metadata,omitempty"`
+
+	// spec is the specification of the desired behavior of the machine.
+	// +optional
+	Spec MachineSpec `json:"spec,omitempty"`
+}
+
+// MachineSpec defines the desired state of Machine.
+type MachineSpec struct {
+	// clusterName is the name of the Cluster this object belongs to.
+	ClusterName string `json:"clusterName"`
+
+	// bootstrap is a reference to a local struct which encapsulates fields to configure the Machine’s bootstrapping
+	// mechanism.
+	Bootstrap Bootstrap `json:"bootstrap"`
+
+	// infrastructureRef is a required reference to a custom resource offered by an infrastructure provider.
+	InfrastructureRef corev1.ObjectReference `json:"infrastructureRef"`
+
+	// version defines the desired Kubernetes version.
+	// +optional
+	Version *string `json:"version,omitempty"`
+
+	// providerID is the identification ID of the machine provided by the provider.
+	// +optional
+	ProviderID *string `json:"providerID,omitempty"`
+
+	// failureDomain is the failure domain the machine will be created in.
+	// +optional
+	FailureDomain *string `json:"failureDomain,omitempty"`
+
+	// nodeDrainTimeout is the total amount of time that the controller will spend on draining a node.
+	// +optional
+	NodeDrainTimeout *metav1.Duration `json:"nodeDrainTimeout,omitempty"`
+}
+
+// Bootstrap encapsulates fields to configure the Machine’s bootstrapping mechanism.
+type Bootstrap struct {
+	// configRef is a reference to a bootstrap provider-specific resource that holds configuration details.
+	// +optional
+	ConfigRef *corev1.ObjectReference `json:"configRef,omitempty"`
+
+	// dataSecretName is the name of the secret that stores the bootstrap data script. If nil, the Machine should
+	// remain in the Pending state.
+	// +optional
+	DataSecretName *string `json:"dataSecretName,omitempty"`
+}
+
+// MachineDeploymentStatus defines the observed state of MachineDeployment.
+type MachineDeploymentStatus struct {
+	// observedGeneration is the generation observed by the deployment controller.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// selector is the same as the label selector but in the string format to avoid introspection by clients.
+	// +optional
+	Selector string `json:"selector,omitempty"`
+
+	// replicas is the total number of non-terminated machines targeted by this deployment (their labels match the
+	// selector).
+	// +optional
+	Replicas int32 `json:"replicas"`
+
+	// updatedReplicas is the total number of non-terminated machines targeted by this deployment that have the
+	// desired template spec.
+	// +optional
+	UpdatedReplicas int32 `json:"updatedReplicas"`
+
+	// readyReplicas is the total number of ready machines targeted by this deployment.
+	// +optional
+	ReadyReplicas int32 `json:"readyReplicas"`
+
+	// availableReplicas is the total number of available machines (ready for at least minReadySeconds) targeted by
+	// this deployment.
+	// +optional
+	AvailableReplicas int32 `json:"availableReplicas"`
+
+	// unavailableReplicas is the total number of unavailable machines targeted by this deployment.
+	// +optional
+	UnavailableReplicas int32 `json:"unavailableReplicas"`
+
+	// phase represents the current phase of a MachineDeployment (ScalingUp, ScalingDown, Running, Failed, or
+	// Unknown).
+	// +optional
+	Phase string `json:"phase,omitempty"`
+
+	// conditions defines current service state of the MachineDeployment.
+	// +optional
+	Conditions Conditions `json:"conditions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:path=machinedeployments,shortName=md,scope=Namespaced,categories=cluster-api
+// +kubebuilder:subresource:status
+
+// MachineDeployment is the Schema for the machinedeployments API.
+type MachineDeployment struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// spec is the desired state of MachineDeployment.
+	// +optional
+	Spec MachineDeploymentSpec `json:"spec,omitempty"`
+	// status is the observed state of MachineDeployment.
+	// +optional
+	Status MachineDeploymentStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// MachineDeploymentList contains a list of MachineDeployment.
+type MachineDeploymentList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []MachineDeployment `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&MachineDeployment{}, &MachineDeploymentList{})
+}
@@ -0,0 +1,212 @@
+/*
+Copyright 2019 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	clusterv1 "github.com/rh-ecosystem-edge/eco-goinfra/pkg/schemes/capi/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// MachineFinalizer allows ReconcileMetal3Machine to clean up resources associated with Metal3Machine before
+	// removing it from the apiserver.
+	MachineFinalizer = "metal3machine.infrastructure.cluster.x-k8s.io"
+
+	// HostAnnotation is the key for an annotation that should go on a Metal3Machine to reference what BareMetalHost
+	// it corresponds to, in the form namespace/name.
+	HostAnnotation = "metal3.io/BareMetalHost"
+)
+
+// AutomatedCleaningMode is the cleaning mode of the BareMetalHost consumed by the Metal3Machine.
+type AutomatedCleaningMode string
+
+const (
+	// CleaningModeDisabled means automated cleaning is disabled for the host.
+	CleaningModeDisabled AutomatedCleaningMode = "disabled"
+	// CleaningModeMetadata means metadata cleaning is performed on the host.
+	CleaningModeMetadata AutomatedCleaningMode = "metadata"
+)
+
+// Metal3MachinePhase describes the state of a Metal3Machine.
+type Metal3MachinePhase string
+
+// Metal3MachineSpec defines the desired state of Metal3Machine.
+type Metal3MachineSpec struct {
+	// ProviderID will be the Metal3 machine in ProviderID format
+	// (metal3://<bmh-uuid>)
+	// +optional
+	ProviderID *string `json:"providerID,omitempty"`
+
+	// Image is the image to be provisioned.
+	// +optional
+	Image Image `json:"image,omitempty"`
+
+	// A custom deploy procedure.
+	// +optional
+	CustomDeploy *CustomDeploy `json:"customDeploy,omitempty"`
+
+	// UserData references the Secret that holds user data needed by the bare metal operator. The Namespace is
+	// optional; it will default to the metal3machine's namespace if not specified.
+	// +optional
+	UserData *corev1.SecretReference `json:"userData,omitempty"`
+
+	// HostSelector specifies matching criteria for labels on BareMetalHosts. This is used to limit the set of
+	// BareMetalHost objects considered for claiming for a metal3machine.
+	// +optional
+	HostSelector HostSelector `json:"hostSelector,omitempty"`
+
+	// MetadataTemplate is a reference to a Metal3DataTemplate object containing a template of metadata for the host
+	// provisioned by the Metal3Machine.
+	// +optional
+	DataTemplate *corev1.ObjectReference `json:"dataTemplate,omitempty"`
+
+	// MetaData is an object storing the reference to the secret containing the Metadata given by the user.
+	// +optional
+	MetaData *corev1.SecretReference `json:"metaData,omitempty"`
+
+	// NetworkData is an object storing the reference to the secret containing the network data given by the user.
+	// +optional
+	NetworkData *corev1.SecretReference `json:"networkData,omitempty"`
+
+	// When set to disabled, automated cleaning of host disks will be skipped during provisioning and
+	// deprovisioning.
+	// +optional
+	// +kubebuilder:validation:Enum:=metadata;disabled
+	AutomatedCleaningMode *string `json:"automatedCleaningMode,omitempty"`
+}
+
+// Image holds the details of an image to use during provisioning.
+type Image struct {
+	// URL is a location of an image to deploy.
+	URL string `json:"url"`
+
+	// Checksum is a md5sum, sha256sum or sha512sum value or a URL to retrieve one.
+	Checksum string `json:"checksum"`
+
+	// ChecksumType is the checksum algorithm for the image, e.g md5, sha256 or sha512. The special value "auto"
+	// can be used to detect the algorithm from the checksum.
+	// +optional
+	ChecksumType *string `json:"checksumType,omitempty"`
+
+	// DiskFormat contains the image disk format.
+	// +optional
+	DiskFormat *string `json:"format,omitempty"`
+}
+
+// CustomDeploy is a description of a custom deploy procedure.
+type CustomDeploy struct {
+	// Custom deploy method name. This name is specific to the deploy ramdisk used.
+	Method string `json:"method"`
+}
+
+// HostSelector specifies matching criteria for labels on BareMetalHosts. This is used to limit the set of
+// BareMetalHost objects considered for claiming for a Machine.
+type HostSelector struct {
+	// Key/Value pairs of labels that must exist on a chosen BareMetalHost
+	// +optional
+	MatchLabels map[string]string `json:"matchLabels,omitempty"`
+
+	// Label match expressions that must be true on a chosen BareMetalHost
+	// +optional
+	MatchExpressions []HostSelectorRequirement `json:"matchExpressions,omitempty"`
+}
+
+// HostSelectorRequirement defines a requirement used for MatchExpressions to select host machines.
+type HostSelectorRequirement struct {
+	Key      string   `json:"key"`
+	Operator string   `json:"operator"`
+	Values   []string `json:"values"`
+}
+
+// Metal3MachineStatus defines the observed state of Metal3Machine.
+type Metal3MachineStatus struct {
+	// LastUpdated identifies when this status was last observed.
+	// +optional
+	LastUpdated *metav1.Time `json:"lastUpdated,omitempty"`
+
+	// FailureReason will be set in the event that there is a terminal problem reconciling the Metal3Machine and will
+	// contain a succinct value suitable for machine interpretation.
+	// +optional
+	FailureReason *string `json:"failureReason,omitempty"`
+
+	// FailureMessage will be set in the event that there is a terminal problem reconciling the Metal3Machine and will
+	// contain a more verbose string suitable for logging and human consumption.
+	// +optional
+	FailureMessage *string `json:"failureMessage,omitempty"`
+
+	// Addresses is a list of addresses assigned to the machine. This field is copied from the infrastructure
+	// provider reference.
+	// +optional
+	Addresses clusterv1.MachineAddresses `json:"addresses,omitempty"`
+
+	// Phase represents the current phase of machine actuation.
+	// +optional
+	Phase string `json:"phase,omitempty"`
+
+	// Ready is the state of the metal3.
+	// +optional
+	Ready bool `json:"ready"`
+
+	// UserData references the Secret that holds user data needed by the bare metal operator.
+	// +optional
+	UserData *corev1.SecretReference `json:"userData,omitempty"`
+
+	// RenderedData is a reference to a rendered Metal3Data object containing the references to metaData and
+	// networkData secrets.
+	// +optional
+	RenderedData *corev1.ObjectReference `json:"renderedData,omitempty"`
+
+	// MetaData is an object storing the reference to the secret containing the Metadata used by the BareMetalHost.
+	// +optional
+	MetaData *corev1.SecretReference `json:"metaData,omitempty"`
+
+	// NetworkData is an object storing the reference to the secret containing the network data used by the
+	// BareMetalHost.
+	// +optional
+	NetworkData *corev1.SecretReference `json:"networkData,omitempty"`
+
+	// Conditions defines current service state of the Metal3Machine.
+	// +optional
+	Conditions clusterv1.Conditions `json:"conditions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:path=metal3machines,scope=Namespaced,categories=cluster-api,shortName=m3m;m3machine
+// +kubebuilder:subresource:status
+
+// Metal3Machine is the Schema for the metal3machines API.
+type Metal3Machine struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   Metal3MachineSpec   `json:"spec,omitempty"`
+	Status Metal3MachineStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// Metal3MachineList contains a list of Metal3Machine.
+type Metal3MachineList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Metal3Machine `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&Metal3Machine{}, &Metal3MachineList{})
+}
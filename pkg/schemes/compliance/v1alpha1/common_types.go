@@ -0,0 +1,66 @@
+/*
+Copyright 2023.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ConditionType is a camel-cased condition type.
+type ConditionType string
+
+const (
+	// ConditionReady means the resource has finished processing and its results are available.
+	ConditionReady ConditionType = "Ready"
+	// ConditionProcessing means the resource is being processed.
+	ConditionProcessing ConditionType = "Processing"
+)
+
+// ConditionReason is intended to be a one-word, CamelCase representation of the category of cause of the current
+// status.
+type ConditionReason string
+
+// Condition represents an observation of an object's state.
+type Condition struct {
+	Type               ConditionType          `json:"type"`
+	Status             corev1.ConditionStatus `json:"status"`
+	Reason             ConditionReason        `json:"reason,omitempty"`
+	Message            string                 `json:"message,omitempty"`
+	LastTransitionTime metav1.Time            `json:"lastTransitionTime"`
+}
+
+// Conditions is a set of Condition instances.
+type Conditions []Condition
+
+// GetCondition returns the condition with the given type, or nil if it is not present.
+func (conditions Conditions) GetCondition(t ConditionType) *Condition {
+	for i := range conditions {
+		if conditions[i].Type == t {
+			return &conditions[i]
+		}
+	}
+
+	return nil
+}
+
+// NamedObjectReference represents a reference to an object by name, kind and API group.
+type NamedObjectReference struct {
+	Name     string `json:"name,omitempty"`
+	Kind     string `json:"kind,omitempty"`
+	APIGroup string `json:"apiGroup,omitempty"`
+}
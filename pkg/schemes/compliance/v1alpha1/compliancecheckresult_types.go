@@ -0,0 +1,85 @@
+/*
+Copyright 2023.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ComplianceCheckStatus describes the result of a single check
+type ComplianceCheckStatus string
+
+const (
+	// CheckResultPass represents a check that passed
+	CheckResultPass ComplianceCheckStatus = "PASS"
+	// CheckResultFail represents a check that failed
+	CheckResultFail ComplianceCheckStatus = "FAIL"
+	// CheckResultInfo represents a check that has no result but provides information
+	CheckResultInfo ComplianceCheckStatus = "INFO"
+	// CheckResultManual represents a check that must be verified manually
+	CheckResultManual ComplianceCheckStatus = "MANUAL"
+	// CheckResultError represents a check that could not be evaluated
+	CheckResultError ComplianceCheckStatus = "ERROR"
+	// CheckResultNotApplicable represents a check that does not apply to the scanned target
+	CheckResultNotApplicable ComplianceCheckStatus = "NOT-APPLICABLE"
+	// CheckResultInconsistent represents a check that differs across the scanned targets
+	CheckResultInconsistent ComplianceCheckStatus = "INCONSISTENT"
+)
+
+// ComplianceCheckResultSeverity is the severity of a check
+type ComplianceCheckResultSeverity string
+
+// ComplianceCheckStatusLabel is the label holding the status of a check result
+const ComplianceCheckStatusLabel = "compliance.openshift.io/check-status"
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:path=compliancecheckresults,scope=Namespaced,shortName=ccr;checkresults;checkresult
+
+// ComplianceCheckResult represent a result of a single compliance "test"
+type ComplianceCheckResult struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// A unique identifier of a check
+	ID string `json:"id"`
+	// The result of a check
+	Status ComplianceCheckStatus `json:"status"`
+	// The severity of a check status
+	Severity ComplianceCheckResultSeverity `json:"severity"`
+	// A human-readable check description, what and why it does
+	Description string `json:"description,omitempty"`
+	// How to evaluate if the rule status manually. If no automatic test is present, the rule status will be MANUAL
+	// and the administrator should follow these instructions.
+	Instructions string `json:"instructions,omitempty"`
+	// The rationale of the Rule
+	Rationale string `json:"rationale,omitempty"`
+	// It stores a list of warnings returned by the scan
+	Warnings []string `json:"warnings,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ComplianceCheckResultList contains a list of ComplianceCheckResult
+type ComplianceCheckResultList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ComplianceCheckResult `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ComplianceCheckResult{}, &ComplianceCheckResultList{})
+}
@@ -0,0 +1,117 @@
+/*
+Copyright 2023.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// RemediationApplicationState is the state of a remediation
+type RemediationApplicationState string
+
+const (
+	// RemediationNotApplied means the remediation has not been applied
+	RemediationNotApplied RemediationApplicationState = "NotApplied"
+	// RemediationApplied means the remediation has been applied
+	RemediationApplied RemediationApplicationState = "Applied"
+	// RemediationOutdated means the remediation has a newer version available
+	RemediationOutdated RemediationApplicationState = "Outdated"
+	// RemediationError means the remediation could not be applied
+	RemediationError RemediationApplicationState = "Error"
+	// RemediationMissingDependencies means the remediation depends on other remediations which are not applied
+	RemediationMissingDependencies RemediationApplicationState = "MissingDependencies"
+	// RemediationNeedsReview means the remediation has variables which must be reviewed before being applied
+	RemediationNeedsReview RemediationApplicationState = "NeedsReview"
+	// RemediationPending means the remediation is waiting to be applied
+	RemediationPending RemediationApplicationState = "Pending"
+)
+
+// RemediationType is the type of a remediation
+type RemediationType string
+
+const (
+	// ConfigurationRemediation is a remediation that configures the cluster or a node
+	ConfigurationRemediation RemediationType = "Configuration"
+	// EnforcementRemediation is a remediation that enforces a policy
+	EnforcementRemediation RemediationType = "Enforcement"
+)
+
+// ComplianceRemediationSpecMeta defines the metadata of the remediation
+type ComplianceRemediationSpecMeta struct {
+	// Whether the remediation should be picked up and applied by the operator
+	Apply bool `json:"apply"`
+	// The type of remediation that this object applies.
+	// +kubebuilder:default=Configuration
+	Type RemediationType `json:"type,omitempty"`
+}
+
+// ComplianceRemediationPayload defines the remediation payload that gets applied
+type ComplianceRemediationPayload struct {
+	// The remediation payload. This would normally be a full Kubernetes object.
+	// +kubebuilder:validation:EmbeddedResource
+	// +kubebuilder:validation:nullable
+	// +kubebuilder:pruning:PreserveUnknownFields
+	Object *unstructured.Unstructured `json:"object,omitempty"`
+}
+
+// ComplianceRemediationSpec defines the desired state of ComplianceRemediation
+type ComplianceRemediationSpec struct {
+	ComplianceRemediationSpecMeta `json:",inline"`
+	// Defines the remediation that is proposed by the scan. If there is no "outdated" remediation in this object, the
+	// "current" remediation is what will be applied.
+	Current ComplianceRemediationPayload `json:"current,omitempty"`
+	// In case there was a previous remediation proposed by a previous scan, and that remediation now differs, the old
+	// remediation will be kept in this "outdated" key.
+	Outdated ComplianceRemediationPayload `json:"outdated,omitempty"`
+}
+
+// ComplianceRemediationStatus defines the observed state of ComplianceRemediation
+type ComplianceRemediationStatus struct {
+	// Whether the remediation is already applied or not
+	// +kubebuilder:default="NotApplied"
+	ApplicationState RemediationApplicationState `json:"applicationState,omitempty"`
+	ErrorMessage     string                      `json:"errorMessage,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:path=complianceremediations,scope=Namespaced,shortName=cr;remediations;remediation;rems
+
+// ComplianceRemediation represents a remediation that can be applied to the cluster to fix the found issues.
+type ComplianceRemediation struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Contains the definition of what the remediation should be
+	Spec ComplianceRemediationSpec `json:"spec,omitempty"`
+	// Contains information on the remediation (whether it's applied or not)
+	Status ComplianceRemediationStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ComplianceRemediationList contains a list of ComplianceRemediation
+type ComplianceRemediationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ComplianceRemediation `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ComplianceRemediation{}, &ComplianceRemediationList{})
+}
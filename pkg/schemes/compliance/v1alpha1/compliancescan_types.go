@@ -0,0 +1,149 @@
+/*
+Copyright 2023.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ComplianceScanRerunAnnotation indicates that a scan should be re-run
+const ComplianceScanRerunAnnotation = "compliance.openshift.io/rescan"
+
+// ComplianceScanLabel serves as an indicator for which ComplianceScan owns the referenced object
+const ComplianceScanLabel = "compliance.openshift.io/scan-name"
+
+// SuiteLabel indicates that an object (normally the ComplianceScan or a ComplianceCheckResult) belongs to a
+// ComplianceSuite
+const SuiteLabel = "compliance.openshift.io/suite"
+
+// ComplianceScanType is the type of a scan
+type ComplianceScanType string
+
+const (
+	// ScanTypeNode represents a scan type that runs on nodes
+	ScanTypeNode ComplianceScanType = "Node"
+	// ScanTypePlatform represents a scan type that runs on the platform
+	ScanTypePlatform ComplianceScanType = "Platform"
+)
+
+// ComplianceScanStatusPhase defines the phase that the ComplianceScan is in
+type ComplianceScanStatusPhase string
+
+const (
+	// PhasePending represents the scan pending to be scheduled
+	PhasePending ComplianceScanStatusPhase = "PENDING"
+	// PhaseLaunching represents being scheduled and launching pods to run the scans
+	PhaseLaunching ComplianceScanStatusPhase = "LAUNCHING"
+	// PhaseRunning represents the scan being ran by the pods and waiting for the results
+	PhaseRunning ComplianceScanStatusPhase = "RUNNING"
+	// PhaseAggregating represents the scan aggregating the results
+	PhaseAggregating ComplianceScanStatusPhase = "AGGREGATING"
+	// PhaseDone represents the scan pods being done and the results being available
+	PhaseDone ComplianceScanStatusPhase = "DONE"
+)
+
+// ComplianceScanStatusResult defines the result of the ComplianceScan
+type ComplianceScanStatusResult string
+
+const (
+	// ResultCompliant represents the compliance scan having succeeded
+	ResultCompliant ComplianceScanStatusResult = "COMPLIANT"
+	// ResultNotApplicable represents the compliance scan having no useful results after finished
+	ResultNotApplicable ComplianceScanStatusResult = "NOT-APPLICABLE"
+	// ResultError represents a compliance scan pod having failed to run the scan or encountered an error
+	ResultError ComplianceScanStatusResult = "ERROR"
+	// ResultNonCompliant represents the compliance scan having found a gap
+	ResultNonCompliant ComplianceScanStatusResult = "NON-COMPLIANT"
+	// ResultNotAvailable represents the compliance scan not having finished yet
+	ResultNotAvailable ComplianceScanStatusResult = ""
+	// ResultInconsistent represents checks differing across the machines
+	ResultInconsistent ComplianceScanStatusResult = "INCONSISTENT"
+)
+
+// ComplianceScanSpec defines the desired state of ComplianceScan
+type ComplianceScanSpec struct {
+	// The type of Compliance scan.
+	// +kubebuilder:default=Node
+	ScanType ComplianceScanType `json:"scanType,omitempty"`
+	// Is the image with the content (Data Stream), that will be used to run OpenSCAP.
+	ContentImage string `json:"contentImage,omitempty"`
+	// Is the profile in the data stream to be used. This is the collection of rules that will be checked for.
+	Profile string `json:"profile,omitempty"`
+	// A Rule can be specified if the scan should check only for a specific rule.
+	Rule string `json:"rule,omitempty"`
+	// Is the path to the file that contains the content (the data stream).
+	Content string `json:"content,omitempty"`
+	// By setting this, it's possible to only run the scan on certain nodes in the cluster.
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+	// Specifies tolerations needed for the scan to run on the nodes.
+	ScanTolerations []corev1.Toleration `json:"scanTolerations,omitempty"`
+	// Enable debug logging of workloads and OpenSCAP
+	Debug bool `json:"debug,omitempty"`
+}
+
+// ComplianceScanStatus defines the observed state of ComplianceScan
+type ComplianceScanStatus struct {
+	// Is the phase where the scan is at. Normally, one must wait for the scan to reach the phase DONE.
+	Phase ComplianceScanStatusPhase `json:"phase,omitempty"`
+	// Once the scan reaches the phase DONE, this will contain the result of the scan.
+	Result ComplianceScanStatusResult `json:"result,omitempty"`
+	// If there are issues on the scan, this will be filled up with an error message.
+	ErrorMessage string `json:"errormsg,omitempty"`
+	// Specifies the current index of the scan. Given multiple scans, this marks the amount that have been executed.
+	CurrentIndex int64 `json:"currentIndex,omitempty"`
+	// Specifies how many times the scan will be retried if it fails.
+	RemainingRetries int `json:"remainingRetries,omitempty"`
+	// If there are warnings on the scan, this will be filled up with warning messages.
+	Warnings string `json:"warnings,omitempty"`
+	// Defines the conditions for the ComplianceScan.
+	Conditions Conditions `json:"conditions,omitempty"`
+	// Specifies the time at which the scan started.
+	StartTimestamp *metav1.Time `json:"startTimestamp,omitempty"`
+	// Specifies the time at which the scan finished.
+	EndTimestamp *metav1.Time `json:"endTimestamp,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:path=compliancescans,scope=Namespaced,shortName=scans;scan
+
+// ComplianceScan represents a scan with a certain configuration that will be applied to objects of a certain entity
+// in the host. These could be nodes that apply to a certain nodeSelector, or the cluster itself.
+type ComplianceScan struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// The spec is the configuration for the compliance scan.
+	Spec ComplianceScanSpec `json:"spec,omitempty"`
+	// The status will give valuable information on what's going on with the scan; and, more importantly, if the scan
+	// is successful (compliant) or not (non-compliant)
+	Status ComplianceScanStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ComplianceScanList contains a list of ComplianceScan
+type ComplianceScanList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ComplianceScan `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ComplianceScan{}, &ComplianceScanList{})
+}
@@ -0,0 +1,76 @@
+/*
+Copyright 2023.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ScanSettingBindingStatusPhase defines the phase that the ScanSettingBinding is in
+type ScanSettingBindingStatusPhase string
+
+const (
+	// ScanSettingBindingPhasePending means the binding has not been processed yet
+	ScanSettingBindingPhasePending ScanSettingBindingStatusPhase = "PENDING"
+	// ScanSettingBindingPhaseReady means the binding has generated a ComplianceSuite
+	ScanSettingBindingPhaseReady ScanSettingBindingStatusPhase = "READY"
+	// ScanSettingBindingPhaseInvalid means the binding references invalid profiles or settings
+	ScanSettingBindingPhaseInvalid ScanSettingBindingStatusPhase = "INVALID"
+	// ScanSettingBindingPhaseSuspended means the scans generated by this binding are suspended
+	ScanSettingBindingPhaseSuspended ScanSettingBindingStatusPhase = "SUSPENDED"
+)
+
+// ScanSettingBindingStatus defines the observed state of ScanSettingBinding
+type ScanSettingBindingStatus struct {
+	Phase ScanSettingBindingStatusPhase `json:"phase,omitempty"`
+	// +optional
+	Conditions Conditions `json:"conditions,omitempty"`
+	// Reference to the object generated from this ScanSettingBinding
+	// +nullable
+	// +optional
+	OutputRef *corev1.TypedLocalObjectReference `json:"outputRef,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:path=scansettingbindings,scope=Namespaced,shortName=ssb
+
+// ScanSettingBinding is the Schema for the scansettingbindings API
+type ScanSettingBinding struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Profiles []NamedObjectReference `json:"profiles,omitempty"`
+	// +kubebuilder:default={"name":"default","kind": "ScanSetting", "apiGroup": "compliance.openshift.io/v1alpha1"}
+	SettingsRef *NamedObjectReference `json:"settingsRef,omitempty"`
+	// +optional
+	Status ScanSettingBindingStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ScanSettingBindingList contains a list of ScanSettingBinding
+type ScanSettingBindingList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ScanSettingBinding `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ScanSettingBinding{}, &ScanSettingBindingList{})
+}
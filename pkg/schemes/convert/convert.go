@@ -0,0 +1,74 @@
+// Package convert copies objects between the API types synced under pkg/schemes and the canonical types of the
+// upstream operators. Both are generated from the same API, so they share their JSON representation and objects can be
+// converted by round tripping through it instead of copying each field by hand. For example, a consumer that already
+// imports the upstream PTP types can convert the definition of a ptp.PtpConfigBuilder with:
+//
+//	upstreamConfig, err := convert.Convert[ptpv1.PtpConfig](builder.Definition)
+//
+// Conversion fails rather than silently dropping data when the source has a field set that the destination type does
+// not know about, which happens when the synced copy and the upstream version have drifted apart.
+package convert
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+)
+
+// Convert returns a new object of type Out with the contents of in. It is meant for converting between pointers to the
+// synced and upstream versions of the same type, such as *nfdv1.NodeFeatureDiscovery, but works for any pair of types
+// with compatible JSON representations, including lists.
+func Convert[Out any](in any) (*Out, error) {
+	out := new(Out)
+
+	err := Into(in, out)
+	if err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
+
+// Into copies the contents of in into out, which must be a non-nil pointer. Fields of out that are not set in in are
+// left unchanged.
+func Into(in, out any) error {
+	if isNil(in) {
+		return fmt.Errorf("cannot convert nil object to %T", out)
+	}
+
+	if isNil(out) || reflect.ValueOf(out).Kind() != reflect.Pointer {
+		return fmt.Errorf("cannot convert %T into %T: destination must be a non-nil pointer", in, out)
+	}
+
+	data, err := json.Marshal(in)
+	if err != nil {
+		return fmt.Errorf("failed to marshal %T: %w", in, err)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+
+	err = decoder.Decode(out)
+	if err != nil {
+		return fmt.Errorf("failed to convert %T into %T: %w", in, out, err)
+	}
+
+	return nil
+}
+
+// isNil checks whether value is nil or a nil pointer, map, slice or interface.
+func isNil(value any) bool {
+	if value == nil {
+		return true
+	}
+
+	reflectValue := reflect.ValueOf(value)
+
+	switch reflectValue.Kind() {
+	case reflect.Pointer, reflect.Map, reflect.Slice, reflect.Interface:
+		return reflectValue.IsNil()
+	default:
+		return false
+	}
+}
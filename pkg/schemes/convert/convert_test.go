@@ -0,0 +1,127 @@
+package convert
+
+import (
+	"testing"
+
+	nfdv1 "github.com/openshift/cluster-nfd-operator/api/v1"
+	nfdschemev1 "github.com/rh-ecosystem-edge/eco-goinfra/pkg/schemes/nfd/v1"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestConvert(t *testing.T) {
+	t.Parallel()
+
+	upstream := &nfdv1.NodeFeatureDiscovery{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "nfd.openshift.io/v1", Kind: "NodeFeatureDiscovery"},
+		ObjectMeta: metav1.ObjectMeta{Name: "nfd-instance", Namespace: "openshift-nfd", Labels: map[string]string{"a": "b"}},
+		Spec: nfdv1.NodeFeatureDiscoverySpec{
+			Instance: "nfd-master",
+			Operand: nfdv1.OperandSpec{
+				Image:           "registry.example.com/nfd:latest",
+				ImagePullPolicy: string(corev1.PullAlways),
+			},
+		},
+	}
+
+	synced, err := Convert[nfdschemev1.NodeFeatureDiscovery](upstream)
+	assert.NoError(t, err)
+	assert.Equal(t, upstream.TypeMeta, synced.TypeMeta)
+	assert.Equal(t, upstream.ObjectMeta, synced.ObjectMeta)
+	assert.Equal(t, upstream.Spec.Instance, synced.Spec.Instance)
+	assert.Equal(t, upstream.Spec.Operand.Image, synced.Spec.Operand.Image)
+
+	roundTripped, err := Convert[nfdv1.NodeFeatureDiscovery](synced)
+	assert.NoError(t, err)
+	assert.Equal(t, upstream, roundTripped)
+}
+
+func TestConvertList(t *testing.T) {
+	t.Parallel()
+
+	upstreamList := &nfdv1.NodeFeatureDiscoveryList{
+		Items: []nfdv1.NodeFeatureDiscovery{
+			{ObjectMeta: metav1.ObjectMeta{Name: "first"}},
+			{ObjectMeta: metav1.ObjectMeta{Name: "second"}},
+		},
+	}
+
+	syncedList, err := Convert[nfdschemev1.NodeFeatureDiscoveryList](upstreamList)
+	assert.NoError(t, err)
+	assert.Len(t, syncedList.Items, 2)
+	assert.Equal(t, "second", syncedList.Items[1].Name)
+}
+
+func TestConvertDroppedField(t *testing.T) {
+	t.Parallel()
+
+	upstream := &nfdv1.NodeFeatureDiscovery{
+		Spec: nfdv1.NodeFeatureDiscoverySpec{
+			Operand: nfdv1.OperandSpec{GCNodeSelector: map[string]string{"node-role": "worker"}},
+		},
+	}
+
+	synced, err := Convert[nfdschemev1.NodeFeatureDiscovery](upstream)
+	assert.ErrorContains(t, err, `unknown field "gcNodeSelector"`)
+	assert.Nil(t, synced)
+}
+
+func TestInto(t *testing.T) {
+	t.Parallel()
+
+	var nilUpstream *nfdv1.NodeFeatureDiscovery
+
+	testCases := []struct {
+		name          string
+		in            any
+		out           any
+		expectedError string
+	}{
+		{
+			name: "valid conversion",
+			in:   &nfdv1.NodeFeatureDiscovery{ObjectMeta: metav1.ObjectMeta{Name: "nfd-instance"}},
+			out:  &nfdschemev1.NodeFeatureDiscovery{},
+		},
+		{
+			name:          "nil source",
+			in:            nil,
+			out:           &nfdschemev1.NodeFeatureDiscovery{},
+			expectedError: "cannot convert nil object to *v1.NodeFeatureDiscovery",
+		},
+		{
+			name:          "typed nil source",
+			in:            nilUpstream,
+			out:           &nfdschemev1.NodeFeatureDiscovery{},
+			expectedError: "cannot convert nil object to *v1.NodeFeatureDiscovery",
+		},
+		{
+			name:          "nil destination",
+			in:            &nfdv1.NodeFeatureDiscovery{},
+			out:           nil,
+			expectedError: "cannot convert *v1.NodeFeatureDiscovery into <nil>: destination must be a non-nil pointer",
+		},
+		{
+			name: "non-pointer destination",
+			in:   &nfdv1.NodeFeatureDiscovery{},
+			out:  nfdschemev1.NodeFeatureDiscovery{},
+			expectedError: "cannot convert *v1.NodeFeatureDiscovery into v1.NodeFeatureDiscovery: " +
+				"destination must be a non-nil pointer",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := Into(testCase.in, testCase.out)
+			if testCase.expectedError == "" {
+				assert.NoError(t, err)
+
+				return
+			}
+
+			assert.EqualError(t, err, testCase.expectedError)
+		})
+	}
+}
@@ -0,0 +1,239 @@
+/*
+Copyright © 2025 ESO Maintainer Team
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    https://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// SecretStoreSpec defines the desired state of SecretStore.
+type SecretStoreSpec struct {
+	// Used to select the correct ESO controller (think: ingress.ingressClassName)
+	// The ESO controller is instantiated with a specific controller name and filters ES based on this property
+	// +optional
+	Controller string `json:"controller,omitempty"`
+
+	// Used to configure the provider. Only one provider may be set
+	Provider *SecretStoreProvider `json:"provider"`
+
+	// Used to configure store refresh interval in seconds. Empty or 0 will default to the controller config.
+	// +optional
+	RefreshInterval int `json:"refreshInterval,omitempty"`
+
+	// Used to constraint a ClusterSecretStore to specific namespaces. Relevant only to ClusterSecretStore
+	// +optional
+	Conditions []ClusterSecretStoreCondition `json:"conditions,omitempty"`
+}
+
+// ClusterSecretStoreCondition describes a condition by which to choose namespaces to process ExternalSecrets in
+// for a ClusterSecretStore instance.
+type ClusterSecretStoreCondition struct {
+	// Choose namespace using a labelSelector
+	// +optional
+	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector,omitempty"`
+
+	// Choose namespaces by name
+	// +optional
+	Namespaces []string `json:"namespaces,omitempty"`
+}
+
+// SecretStoreProvider contains the provider-specific configuration.
+// +kubebuilder:validation:MinProperties=1
+// +kubebuilder:validation:MaxProperties=1
+type SecretStoreProvider struct {
+	// Kubernetes configures this store to sync secrets using a Kubernetes cluster provider
+	// +optional
+	Kubernetes *KubernetesProvider `json:"kubernetes,omitempty"`
+
+	// Fake configures a store with static key/value pairs
+	// +optional
+	Fake *FakeProvider `json:"fake,omitempty"`
+}
+
+// KubernetesProvider configures a store to sync secrets with a Kubernetes instance.
+type KubernetesProvider struct {
+	// configures the Kubernetes server Address.
+	// +optional
+	Server KubernetesServer `json:"server,omitempty"`
+
+	// Auth configures how secret-manager authenticates with a Kubernetes instance.
+	// +optional
+	Auth *KubernetesAuth `json:"auth,omitempty"`
+
+	// Remote namespace to fetch the secrets from
+	// +optional
+	// +kubebuilder:default= default
+	RemoteNamespace string `json:"remoteNamespace,omitempty"`
+}
+
+// KubernetesServer configures the Kubernetes server address and CA.
+type KubernetesServer struct {
+	// configures the Kubernetes server Address.
+	// +kubebuilder:default=kubernetes.default
+	// +optional
+	URL string `json:"url,omitempty"`
+
+	// CABundle is a base64-encoded CA certificate
+	// +optional
+	CABundle []byte `json:"caBundle,omitempty"`
+}
+
+// KubernetesAuth configures how secret-manager authenticates with a Kubernetes instance.
+// +kubebuilder:validation:MinProperties=1
+// +kubebuilder:validation:MaxProperties=1
+type KubernetesAuth struct {
+	// points to a service account that should be used for authentication
+	// +optional
+	ServiceAccount *ServiceAccountSelector `json:"serviceAccount,omitempty"`
+}
+
+// ServiceAccountSelector is a reference to a ServiceAccount resource.
+type ServiceAccountSelector struct {
+	// The name of the ServiceAccount resource being referred to.
+	Name string `json:"name"`
+
+	// Namespace of the resource being referred to.
+	// Ignored if referent is not cluster-scoped, otherwise defaults to the namespace of the referent.
+	// +optional
+	Namespace *string `json:"namespace,omitempty"`
+
+	// Audience specifies the `aud` claim for the service account token
+	// If the service account uses a well-known annotation for e.g. IRSA or GCP Workload Identity
+	// then this audiences will be appended to the list
+	// +optional
+	Audiences []string `json:"audiences,omitempty"`
+}
+
+// FakeProvider configures a fake provider that returns static values.
+type FakeProvider struct {
+	Data []FakeProviderData `json:"data"`
+}
+
+// FakeProviderData is a key/value pair served by the fake provider.
+type FakeProviderData struct {
+	Key   string `json:"key"`
+	Value string `json:"value,omitempty"`
+
+	// +optional
+	Version string `json:"version,omitempty"`
+}
+
+// SecretStoreConditionType defines the condition type of a SecretStore.
+type SecretStoreConditionType string
+
+const (
+	// SecretStoreReady indicates that the store has been validated and is usable by ExternalSecrets.
+	SecretStoreReady SecretStoreConditionType = "Ready"
+)
+
+const (
+	// ReasonInvalidStore indicates that the store configuration is invalid.
+	ReasonInvalidStore = "InvalidStoreConfiguration"
+	// ReasonInvalidProviderConfig indicates that the provider configuration is invalid.
+	ReasonInvalidProviderConfig = "InvalidProviderConfig"
+	// ReasonValidationFailed indicates that the store could not be validated against the provider.
+	ReasonValidationFailed = "ValidationFailed"
+	// ReasonStoreValid indicates that the store is valid.
+	ReasonStoreValid = "Valid"
+)
+
+// SecretStoreStatusCondition defines a status condition of a SecretStore.
+type SecretStoreStatusCondition struct {
+	Type   SecretStoreConditionType `json:"type"`
+	Status corev1.ConditionStatus   `json:"status"`
+
+	// +optional
+	Reason string `json:"reason,omitempty"`
+
+	// +optional
+	Message string `json:"message,omitempty"`
+
+	// +optional
+	LastTransitionTime metav1.Time `json:"lastTransitionTime,omitempty"`
+}
+
+// SecretStoreCapabilities defines the possible operations a SecretStore can do.
+type SecretStoreCapabilities string
+
+const (
+	// SecretStoreReadOnly indicates that the store can only be read from.
+	SecretStoreReadOnly SecretStoreCapabilities = "ReadOnly"
+	// SecretStoreWriteOnly indicates that the store can only be written to.
+	SecretStoreWriteOnly SecretStoreCapabilities = "WriteOnly"
+	// SecretStoreReadWrite indicates that the store can be read from and written to.
+	SecretStoreReadWrite SecretStoreCapabilities = "ReadWrite"
+)
+
+// SecretStoreStatus defines the observed state of the SecretStore.
+type SecretStoreStatus struct {
+	// +optional
+	Conditions []SecretStoreStatusCondition `json:"conditions,omitempty"`
+
+	// +optional
+	Capabilities SecretStoreCapabilities `json:"capabilities,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,categories={external-secrets},shortName=ss
+
+// SecretStore represents a secure external location for storing secrets, which can be referenced as part of
+// `storeRef` fields.
+type SecretStore struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   SecretStoreSpec   `json:"spec,omitempty"`
+	Status SecretStoreStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// SecretStoreList contains a list of SecretStore resources.
+type SecretStoreList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []SecretStore `json:"items"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={external-secrets},shortName=css
+
+// ClusterSecretStore represents a secure external location for storing secrets, which can be referenced as part of
+// `storeRef` fields.
+type ClusterSecretStore struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   SecretStoreSpec   `json:"spec,omitempty"`
+	Status SecretStoreStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ClusterSecretStoreList contains a list of ClusterSecretStore resources.
+type ClusterSecretStoreList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ClusterSecretStore `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&SecretStore{}, &SecretStoreList{}, &ClusterSecretStore{}, &ClusterSecretStoreList{})
+}
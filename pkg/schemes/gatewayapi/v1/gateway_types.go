@@ -0,0 +1,366 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// GatewayKind is the kind of the Gateway resource.
+	GatewayKind = "Gateway"
+	// GatewayName is the plural name of the Gateway resource.
+	GatewayName = "gateways"
+)
+
+// +genclient
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:categories=gateway-api,shortName=gtw
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+
+// Gateway represents an instance of a service-traffic handling infrastructure by binding Listeners to a set of IP
+// addresses.
+type Gateway struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec defines the desired state of Gateway.
+	Spec GatewaySpec `json:"spec"`
+
+	// Status defines the current state of Gateway.
+	//
+	// +kubebuilder:default={conditions: {{type: "Accepted", status: "Unknown", reason:"Pending",
+	// message:"Waiting for controller", lastTransitionTime: "1970-01-01T00:00:00Z"},{type: "Programmed",
+	// status: "Unknown", reason:"Pending", message:"Waiting for controller",
+	// lastTransitionTime: "1970-01-01T00:00:00Z"}}}
+	Status GatewayStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// GatewayList contains a list of Gateways.
+type GatewayList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Gateway `json:"items"`
+}
+
+// GatewaySpec defines the desired state of Gateway.
+type GatewaySpec struct {
+	// GatewayClassName used for this Gateway. This is the name of a GatewayClass resource.
+	GatewayClassName ObjectName `json:"gatewayClassName"`
+
+	// Listeners associated with this Gateway. Listeners define logical endpoints that are bound on this Gateway's
+	// addresses. At least one Listener MUST be specified.
+	//
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=64
+	Listeners []Listener `json:"listeners"`
+
+	// Addresses requested for this Gateway.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxItems=16
+	Addresses []GatewaySpecAddress `json:"addresses,omitempty"`
+}
+
+// Listener embodies the concept of a logical endpoint where a Gateway accepts network connections.
+type Listener struct {
+	// Name is the name of the Listener. This name MUST be unique within a Gateway.
+	Name SectionName `json:"name"`
+
+	// Hostname specifies the virtual hostname to match for protocol types that define this concept. When
+	// unspecified, all hostnames are matched.
+	//
+	// +optional
+	Hostname *Hostname `json:"hostname,omitempty"`
+
+	// Port is the network port. Multiple listeners may use the same port, subject to the Listener compatibility
+	// rules.
+	Port PortNumber `json:"port"`
+
+	// Protocol specifies the network protocol this listener expects to receive.
+	Protocol ProtocolType `json:"protocol"`
+
+	// TLS is the TLS configuration for the Listener. This field is required if the Protocol field is "HTTPS" or
+	// "TLS". It is invalid to set this field if the Protocol field is "HTTP", "TCP", or "UDP".
+	//
+	// +optional
+	TLS *GatewayTLSConfig `json:"tls,omitempty"`
+
+	// AllowedRoutes defines the types of routes that MAY be attached to a Listener and the trusted namespaces where
+	// those Route resources MAY be present.
+	//
+	// +kubebuilder:default={namespaces:{from: Same}}
+	// +optional
+	AllowedRoutes *AllowedRoutes `json:"allowedRoutes,omitempty"`
+}
+
+// ProtocolType defines the application protocol accepted by a Listener.
+//
+// +kubebuilder:validation:MinLength=1
+// +kubebuilder:validation:MaxLength=255
+type ProtocolType string
+
+const (
+	// HTTPProtocolType accepts cleartext HTTP/1.1 sessions over TCP.
+	HTTPProtocolType ProtocolType = "HTTP"
+
+	// HTTPSProtocolType accepts HTTP/1.1 or HTTP/2 sessions over TLS.
+	HTTPSProtocolType ProtocolType = "HTTPS"
+
+	// TLSProtocolType accepts TLS sessions over TCP.
+	TLSProtocolType ProtocolType = "TLS"
+
+	// TCPProtocolType accepts TCP sessions.
+	TCPProtocolType ProtocolType = "TCP"
+
+	// UDPProtocolType accepts UDP packets.
+	UDPProtocolType ProtocolType = "UDP"
+)
+
+// GatewayTLSConfig describes a TLS configuration.
+type GatewayTLSConfig struct {
+	// Mode defines the TLS behavior for the TLS session initiated by the client.
+	//
+	// +optional
+	// +kubebuilder:default=Terminate
+	Mode *TLSModeType `json:"mode,omitempty"`
+
+	// CertificateRefs contains a series of references to Kubernetes objects that contains TLS certificates and
+	// private keys.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxItems=64
+	CertificateRefs []SecretObjectReference `json:"certificateRefs,omitempty"`
+}
+
+// TLSModeType type defines how a Gateway handles TLS sessions.
+//
+// +kubebuilder:validation:Enum=Terminate;Passthrough
+type TLSModeType string
+
+const (
+	// TLSModeTerminate terminates the TLS session at the Gateway.
+	TLSModeTerminate TLSModeType = "Terminate"
+
+	// TLSModePassthrough passes the TLS session through to the backend.
+	TLSModePassthrough TLSModeType = "Passthrough"
+)
+
+// AllowedRoutes defines which Routes may be attached to this Listener.
+type AllowedRoutes struct {
+	// Namespaces indicates namespaces from which Routes may be attached to this Listener. This is restricted to the
+	// namespace of this Gateway by default.
+	//
+	// +optional
+	// +kubebuilder:default={from: Same}
+	Namespaces *RouteNamespaces `json:"namespaces,omitempty"`
+
+	// Kinds specifies the groups and kinds of Routes that are allowed to bind to this Gateway Listener.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxItems=8
+	Kinds []RouteGroupKind `json:"kinds,omitempty"`
+}
+
+// FromNamespaces specifies namespace from which Routes may be attached to a Gateway.
+//
+// +kubebuilder:validation:Enum=All;Selector;Same
+type FromNamespaces string
+
+const (
+	// NamespacesFromAll allows Routes in all namespaces to be attached to this Gateway.
+	NamespacesFromAll FromNamespaces = "All"
+
+	// NamespacesFromSelector allows Routes in namespaces selected by the selector to be attached to this Gateway.
+	NamespacesFromSelector FromNamespaces = "Selector"
+
+	// NamespacesFromSame allows only Routes in the same namespace as the Gateway to be attached to it.
+	NamespacesFromSame FromNamespaces = "Same"
+)
+
+// RouteNamespaces indicate which namespaces Routes should be selected from.
+type RouteNamespaces struct {
+	// From indicates where Routes will be selected for this Gateway.
+	//
+	// +optional
+	// +kubebuilder:default=Same
+	From *FromNamespaces `json:"from,omitempty"`
+
+	// Selector must be specified when From is set to "Selector". In that case, only Routes in Namespaces matching
+	// this Selector will be selected by this Gateway.
+	//
+	// +optional
+	Selector *metav1.LabelSelector `json:"selector,omitempty"`
+}
+
+// RouteGroupKind indicates the group and kind of a Route resource.
+type RouteGroupKind struct {
+	// Group is the group of the Route.
+	//
+	// +optional
+	// +kubebuilder:default=gateway.networking.k8s.io
+	Group *Group `json:"group,omitempty"`
+
+	// Kind is the kind of the Route.
+	Kind Kind `json:"kind"`
+}
+
+// GatewaySpecAddress describes an address that can be bound to a Gateway.
+type GatewaySpecAddress struct {
+	// Type of the address.
+	//
+	// +optional
+	// +kubebuilder:default=IPAddress
+	Type *AddressType `json:"type,omitempty"`
+
+	// Value of the address. The validity of the values will depend on the type and support by the controller.
+	//
+	// +optional
+	Value string `json:"value,omitempty"`
+}
+
+// GatewayStatusAddress describes a network address that is bound to a Gateway.
+type GatewayStatusAddress struct {
+	// Type of the address.
+	//
+	// +optional
+	// +kubebuilder:default=IPAddress
+	Type *AddressType `json:"type,omitempty"`
+
+	// Value of the address. The validity of the values will depend on the type and support by the controller.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	Value string `json:"value"`
+}
+
+// GatewayStatus defines the observed state of Gateway.
+type GatewayStatus struct {
+	// Addresses lists the network addresses that have been bound to the Gateway.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxItems=16
+	Addresses []GatewayStatusAddress `json:"addresses,omitempty"`
+
+	// Conditions describe the current conditions of the Gateway.
+	//
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	// +kubebuilder:validation:MaxItems=8
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// Listeners provide status for each unique listener port defined in the Spec.
+	//
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MaxItems=64
+	Listeners []ListenerStatus `json:"listeners,omitempty"`
+}
+
+// GatewayConditionType is a type of condition associated with a Gateway. This type should be used with the
+// GatewayStatus.Conditions field.
+type GatewayConditionType string
+
+// GatewayConditionReason defines the set of reasons that explain why a particular Gateway condition type has been
+// raised.
+type GatewayConditionReason string
+
+const (
+	// GatewayConditionProgrammed indicates whether a Gateway has generated some configuration that is assumed to be
+	// ready soon in the underlying data plane.
+	GatewayConditionProgrammed GatewayConditionType = "Programmed"
+
+	// GatewayReasonProgrammed is used with the "Programmed" condition when the condition is true.
+	GatewayReasonProgrammed GatewayConditionReason = "Programmed"
+
+	// GatewayReasonInvalid is used with the "Programmed" condition when the Gateway is syntactically or semantically
+	// invalid.
+	GatewayReasonInvalid GatewayConditionReason = "Invalid"
+
+	// GatewayReasonNoResources is used with the "Programmed" condition when the Gateway is not scheduled because
+	// insufficient infrastructure resources are available.
+	GatewayReasonNoResources GatewayConditionReason = "NoResources"
+
+	// GatewayReasonAddressNotAssigned is used with the "Programmed" condition when none of the requested addresses
+	// have been assigned to the Gateway.
+	GatewayReasonAddressNotAssigned GatewayConditionReason = "AddressNotAssigned"
+
+	// GatewayConditionAccepted is true when the controller managing the Gateway is syntactically and semantically
+	// valid enough to produce some configuration in the underlying data plane.
+	GatewayConditionAccepted GatewayConditionType = "Accepted"
+
+	// GatewayReasonAccepted is used with the "Accepted" condition when the condition is True.
+	GatewayReasonAccepted GatewayConditionReason = "Accepted"
+
+	// GatewayReasonListenersNotValid is used with the "Accepted" condition when one or more Listeners have an
+	// invalid or unsupported configuration and cannot be configured on the Gateway.
+	GatewayReasonListenersNotValid GatewayConditionReason = "ListenersNotValid"
+
+	// GatewayReasonPending is used with the "Accepted" and "Programmed" conditions when the status is "Unknown" and
+	// no controller has reconciled the Gateway.
+	GatewayReasonPending GatewayConditionReason = "Pending"
+)
+
+// ListenerStatus is the status associated with a Listener.
+type ListenerStatus struct {
+	// Name is the name of the Listener that this status corresponds to.
+	Name SectionName `json:"name"`
+
+	// SupportedKinds is the list indicating the Kinds supported by this listener.
+	//
+	// +kubebuilder:validation:MaxItems=8
+	SupportedKinds []RouteGroupKind `json:"supportedKinds"`
+
+	// AttachedRoutes represents the total number of Routes that have been successfully attached to this Listener.
+	AttachedRoutes int32 `json:"attachedRoutes"`
+
+	// Conditions describe the current condition of this listener.
+	//
+	// +listType=map
+	// +listMapKey=type
+	// +kubebuilder:validation:MaxItems=8
+	Conditions []metav1.Condition `json:"conditions"`
+}
+
+// ListenerConditionType is a type of condition associated with the listener. This type should be used with the
+// ListenerStatus.Conditions field.
+type ListenerConditionType string
+
+const (
+	// ListenerConditionAccepted indicates that the listener is syntactically and semantically valid, and that all
+	// features used in the listener's spec are supported.
+	ListenerConditionAccepted ListenerConditionType = "Accepted"
+
+	// ListenerConditionResolvedRefs indicates whether the controller was able to resolve all the object references
+	// for the Listener.
+	ListenerConditionResolvedRefs ListenerConditionType = "ResolvedRefs"
+
+	// ListenerConditionProgrammed indicates whether a Listener has generated some configuration that will soon be
+	// ready in the underlying data plane.
+	ListenerConditionProgrammed ListenerConditionType = "Programmed"
+)
+
+func init() {
+	SchemeBuilder.Register(&Gateway{}, &GatewayList{})
+}
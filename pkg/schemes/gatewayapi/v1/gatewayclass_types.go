@@ -0,0 +1,146 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// GatewayClassKind is the kind of the GatewayClass resource.
+	GatewayClassKind = "GatewayClass"
+	// GatewayClassName is the plural name of the GatewayClass resource.
+	GatewayClassName = "gatewayclasses"
+)
+
+// +genclient
+// +genclient:nonNamespaced
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Cluster,shortName=gc
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+
+// GatewayClass describes a class of Gateways available to the user for creating Gateway resources.
+type GatewayClass struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec defines the desired state of GatewayClass.
+	Spec GatewayClassSpec `json:"spec"`
+
+	// Status defines the current state of GatewayClass.
+	//
+	// +kubebuilder:default={conditions: {{type: "Accepted", status: "Unknown", message: "Waiting for controller",
+	// reason: "Pending", lastTransitionTime: "1970-01-01T00:00:00Z"}}}
+	Status GatewayClassStatus `json:"status,omitempty"`
+}
+
+// GatewayClassSpec reflects the configuration of a class of Gateways.
+type GatewayClassSpec struct {
+	// ControllerName is the name of the controller that is managing Gateways of this class. The value of this field
+	// MUST be a domain prefixed path.
+	//
+	// +kubebuilder:validation:XValidation:message="Value is immutable",rule="self == oldSelf"
+	ControllerName GatewayController `json:"controllerName"`
+
+	// ParametersRef is a reference to a resource that contains the configuration parameters corresponding to the
+	// GatewayClass.
+	//
+	// +optional
+	ParametersRef *ParametersReference `json:"parametersRef,omitempty"`
+
+	// Description helps describe a GatewayClass with more details.
+	//
+	// +kubebuilder:validation:MaxLength=64
+	// +optional
+	Description *string `json:"description,omitempty"`
+}
+
+// ParametersReference identifies an API object containing controller-specific configuration resource within the
+// cluster.
+type ParametersReference struct {
+	// Group is the group of the referent.
+	Group Group `json:"group"`
+
+	// Kind is kind of the referent.
+	Kind Kind `json:"kind"`
+
+	// Name is the name of the referent.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	Name string `json:"name"`
+
+	// Namespace is the namespace of the referent. This field is required when referring to a Namespace-scoped
+	// resource and MUST be unset when referring to a Cluster-scoped resource.
+	//
+	// +optional
+	Namespace *Namespace `json:"namespace,omitempty"`
+}
+
+// GatewayClassConditionType is the type for status conditions on Gateway resources. This type should be used with
+// the GatewayClassStatus.Conditions field.
+type GatewayClassConditionType string
+
+// GatewayClassConditionReason defines the set of reasons that explain why a particular GatewayClass condition type
+// has been raised.
+type GatewayClassConditionReason string
+
+const (
+	// GatewayClassConditionStatusAccepted indicates whether the GatewayClass has been accepted by the controller
+	// requested in the `spec.controller` field.
+	GatewayClassConditionStatusAccepted GatewayClassConditionType = "Accepted"
+
+	// GatewayClassReasonAccepted is used with the "Accepted" condition when the condition is true.
+	GatewayClassReasonAccepted GatewayClassConditionReason = "Accepted"
+
+	// GatewayClassReasonInvalidParameters is used with the "Accepted" condition when the GatewayClass was not
+	// accepted because the parametersRef field refers to an invalid resource.
+	GatewayClassReasonInvalidParameters GatewayClassConditionReason = "InvalidParameters"
+
+	// GatewayClassReasonPending is used with the "Accepted" condition when the requested controller has not yet
+	// made a decision about whether to admit the GatewayClass.
+	GatewayClassReasonPending GatewayClassConditionReason = "Pending"
+
+	// GatewayClassReasonUnsupported is used with the "Accepted" condition when the GatewayClass was not accepted
+	// because the implementation does not support a feature of it.
+	GatewayClassReasonUnsupported GatewayClassConditionReason = "Unsupported"
+)
+
+// GatewayClassStatus is the current status for the GatewayClass.
+type GatewayClassStatus struct {
+	// Conditions is the current status from the controller for this GatewayClass.
+	//
+	// +optional
+	// +listType=map
+	// +listMapKey=type
+	// +kubebuilder:validation:MaxItems=8
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// GatewayClassList contains a list of GatewayClass.
+type GatewayClassList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []GatewayClass `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&GatewayClass{}, &GatewayClassList{})
+}
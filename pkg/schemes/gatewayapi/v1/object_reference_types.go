@@ -0,0 +1,71 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+// BackendObjectReference defines how an ObjectReference that is specific to BackendRef.
+type BackendObjectReference struct {
+	// Group is the group of the referent. For example, "gateway.networking.k8s.io". When unspecified or empty
+	// string, core API group is inferred.
+	//
+	// +optional
+	// +kubebuilder:default=""
+	Group *Group `json:"group,omitempty"`
+
+	// Kind is the Kubernetes resource kind of the referent. For example "Service".
+	//
+	// +optional
+	// +kubebuilder:default=Service
+	Kind *Kind `json:"kind,omitempty"`
+
+	// Name is the name of the referent.
+	Name ObjectName `json:"name"`
+
+	// Namespace is the namespace of the backend. When unspecified, the local namespace is inferred.
+	//
+	// +optional
+	Namespace *Namespace `json:"namespace,omitempty"`
+
+	// Port specifies the destination port number to use for this resource. Port is required when the referent is a
+	// Kubernetes Service.
+	//
+	// +optional
+	Port *PortNumber `json:"port,omitempty"`
+}
+
+// SecretObjectReference identifies an API object including its namespace, defaulting to Secret.
+type SecretObjectReference struct {
+	// Group is the group of the referent. For example, "gateway.networking.k8s.io". When unspecified or empty
+	// string, core API group is inferred.
+	//
+	// +optional
+	// +kubebuilder:default=""
+	Group *Group `json:"group,omitempty"`
+
+	// Kind is kind of the referent. For example "Secret".
+	//
+	// +optional
+	// +kubebuilder:default=Secret
+	Kind *Kind `json:"kind,omitempty"`
+
+	// Name is the name of the referent.
+	Name ObjectName `json:"name"`
+
+	// Namespace is the namespace of the referenced object. When unspecified, the local namespace is inferred.
+	//
+	// +optional
+	Namespace *Namespace `json:"namespace,omitempty"`
+}
@@ -0,0 +1,210 @@
+/*
+Copyright 2020 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ParentReference identifies an API object (usually a Gateway) that can be considered a parent of this resource
+// (usually a route).
+type ParentReference struct {
+	// Group is the group of the referent. When unspecified, "gateway.networking.k8s.io" is inferred.
+	//
+	// +kubebuilder:default=gateway.networking.k8s.io
+	// +optional
+	Group *Group `json:"group,omitempty"`
+
+	// Kind is kind of the referent. When unspecified, "Gateway" is inferred.
+	//
+	// +kubebuilder:default=Gateway
+	// +optional
+	Kind *Kind `json:"kind,omitempty"`
+
+	// Namespace is the namespace of the referent. When unspecified, this refers to the local namespace of the Route.
+	//
+	// +optional
+	Namespace *Namespace `json:"namespace,omitempty"`
+
+	// Name is the name of the referent.
+	Name ObjectName `json:"name"`
+
+	// SectionName is the name of a section within the target resource. For Gateways, this refers to the name of a
+	// Listener.
+	//
+	// +optional
+	SectionName *SectionName `json:"sectionName,omitempty"`
+
+	// Port is the network port this Route targets.
+	//
+	// +optional
+	Port *PortNumber `json:"port,omitempty"`
+}
+
+// CommonRouteSpec defines the common attributes that all Routes MUST include within their spec.
+type CommonRouteSpec struct {
+	// ParentRefs references the resources (usually Gateways) that a Route wants to be attached to.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxItems=32
+	ParentRefs []ParentReference `json:"parentRefs,omitempty"`
+}
+
+// PortNumber defines a network port.
+//
+// +kubebuilder:validation:Minimum=1
+// +kubebuilder:validation:Maximum=65535
+type PortNumber int32
+
+// BackendRef defines how a Route should forward a request to a Kubernetes resource.
+type BackendRef struct {
+	// BackendObjectReference references a Kubernetes object.
+	BackendObjectReference `json:",inline"`
+
+	// Weight specifies the proportion of requests forwarded to the referenced backend.
+	//
+	// +optional
+	// +kubebuilder:default=1
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=1000000
+	Weight *int32 `json:"weight,omitempty"`
+}
+
+// RouteConditionType is a type of condition for a route.
+type RouteConditionType string
+
+// RouteConditionReason is a reason for a route condition.
+type RouteConditionReason string
+
+const (
+	// RouteConditionAccepted indicates whether the route has been accepted or rejected by a Gateway, and why.
+	RouteConditionAccepted RouteConditionType = "Accepted"
+
+	// RouteReasonAccepted is used with the "Accepted" condition when the Route has been accepted by the Gateway.
+	RouteReasonAccepted RouteConditionReason = "Accepted"
+
+	// RouteReasonNotAllowedByListeners is used with the "Accepted" condition when the route has not been accepted by
+	// a Gateway because the Gateway has no Listener whose allowedRoutes criteria permit the route.
+	RouteReasonNotAllowedByListeners RouteConditionReason = "NotAllowedByListeners"
+
+	// RouteReasonNoMatchingParent is used with the "Accepted" condition when there are no matching Parents.
+	RouteReasonNoMatchingParent RouteConditionReason = "NoMatchingParent"
+
+	// RouteConditionResolvedRefs indicates whether the controller was able to resolve all the object references for
+	// the Route.
+	RouteConditionResolvedRefs RouteConditionType = "ResolvedRefs"
+
+	// RouteReasonResolvedRefs is used with the "ResolvedRefs" condition when the condition is true.
+	RouteReasonResolvedRefs RouteConditionReason = "ResolvedRefs"
+
+	// RouteReasonBackendNotFound is used with the "ResolvedRefs" condition when one of the Route's rules has a
+	// reference to a resource that does not exist.
+	RouteReasonBackendNotFound RouteConditionReason = "BackendNotFound"
+)
+
+// RouteParentStatus describes the status of a route with respect to an associated Parent.
+type RouteParentStatus struct {
+	// ParentRef corresponds with a ParentRef in the spec that this RouteParentStatus struct describes the status of.
+	ParentRef ParentReference `json:"parentRef"`
+
+	// ControllerName is a domain/path string that indicates the name of the controller that wrote this status.
+	ControllerName GatewayController `json:"controllerName"`
+
+	// Conditions describes the status of the route with respect to the Gateway.
+	//
+	// +listType=map
+	// +listMapKey=type
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=8
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// RouteStatus defines the common attributes that all Routes MUST include within their status.
+type RouteStatus struct {
+	// Parents is a list of parent resources (usually Gateways) that are associated with the route, and the status of
+	// the route with respect to each parent.
+	//
+	// +kubebuilder:validation:MaxItems=32
+	Parents []RouteParentStatus `json:"parents"`
+}
+
+// Hostname is the fully qualified domain name of a network host, optionally prefixed with a wildcard label.
+//
+// +kubebuilder:validation:MinLength=1
+// +kubebuilder:validation:MaxLength=253
+type Hostname string
+
+// PreciseHostname is the fully qualified domain name of a network host. Wildcards are not allowed.
+//
+// +kubebuilder:validation:MinLength=1
+// +kubebuilder:validation:MaxLength=253
+type PreciseHostname string
+
+// Group refers to a Kubernetes Group. It must either be an empty string or a RFC 1123 subdomain.
+//
+// +kubebuilder:validation:MaxLength=253
+type Group string
+
+// Kind refers to a Kubernetes Kind.
+//
+// +kubebuilder:validation:MinLength=1
+// +kubebuilder:validation:MaxLength=63
+type Kind string
+
+// ObjectName refers to the name of a Kubernetes object.
+//
+// +kubebuilder:validation:MinLength=1
+// +kubebuilder:validation:MaxLength=253
+type ObjectName string
+
+// Namespace refers to a Kubernetes namespace.
+//
+// +kubebuilder:validation:MinLength=1
+// +kubebuilder:validation:MaxLength=63
+type Namespace string
+
+// SectionName is the name of a section in a Kubernetes resource, such as a Listener of a Gateway.
+//
+// +kubebuilder:validation:MinLength=1
+// +kubebuilder:validation:MaxLength=253
+type SectionName string
+
+// GatewayController is the name of a Gateway API controller. It must be a domain prefixed path, such as
+// example.net/gateway-controller.
+//
+// +kubebuilder:validation:MinLength=1
+// +kubebuilder:validation:MaxLength=253
+type GatewayController string
+
+// Duration is a string value representing a duration in time, such as 1h or 500ms.
+//
+// +kubebuilder:validation:Pattern=`^([0-9]{1,5}(h|m|s|ms)){1,4}$`
+type Duration string
+
+// AddressType defines how a network address is represented as a text string.
+//
+// +kubebuilder:validation:MinLength=1
+// +kubebuilder:validation:MaxLength=253
+type AddressType string
+
+const (
+	// IPAddressType is a textual representation of a numeric IP address.
+	IPAddressType AddressType = "IPAddress"
+
+	// HostnameAddressType represents a DNS based ingress point.
+	HostnameAddressType AddressType = "Hostname"
+)
@@ -0,0 +1,36 @@
+// Copyright 2020-2024 Intel Corporation. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+)
+
+// DevicePluginStatus defines the observed state of a device plugin DaemonSet.
+type DevicePluginStatus struct {
+	// ControllerRef is a reference to the DaemonSet running the plugin.
+	ControllerRef corev1.ObjectReference `json:"controllerRef,omitempty"`
+
+	// The list of Node names where the device plugin pods are running.
+	NodeNames []string `json:"nodeNames,omitempty"`
+
+	// The total number of nodes that should be running the device plugin pod
+	// (including nodes correctly running the device plugin pod).
+	DesiredNumberScheduled int32 `json:"desiredNumberScheduled"`
+
+	// The number of nodes that should be running the device plugin pod and have one
+	// or more of the device plugin pod running and ready.
+	NumberReady int32 `json:"numberReady"`
+}
@@ -0,0 +1,78 @@
+// Copyright 2020-2024 Intel Corporation. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// DsaDevicePluginSpec defines the desired state of DsaDevicePlugin.
+type DsaDevicePluginSpec struct {
+	// Image is a container image with DSA device plugin executable.
+	Image string `json:"image,omitempty"`
+
+	// InitImage is a container image with a script that initialize devices.
+	InitImage string `json:"initImage,omitempty"`
+
+	// ProvisioningConfig is a ConfigMap used to pass the DSA devices and workqueues configuration into idxd
+	// initcontainer.
+	ProvisioningConfig string `json:"provisioningConfig,omitempty"`
+
+	// NodeSelector provides a simple way to constrain device plugin pods to nodes with particular labels.
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+
+	// Specialized nodes (e.g., with accelerators) can be Tainted to make sure unwanted pods are not scheduled on them.
+	Tolerations []corev1.Toleration `json:"tolerations,omitempty"`
+
+	// SharedDevNum is a number of containers that can share the same DSA device.
+	// +kubebuilder:validation:Minimum=1
+	SharedDevNum int `json:"sharedDevNum,omitempty"`
+
+	// LogLevel sets the plugin's log level.
+	// +kubebuilder:validation:Minimum=0
+	LogLevel int `json:"logLevel,omitempty"`
+}
+
+// DsaDevicePluginStatus defines the observed state of DsaDevicePlugin.
+type DsaDevicePluginStatus DevicePluginStatus
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Cluster
+// +kubebuilder:subresource:status
+
+// DsaDevicePlugin is the Schema for the dsadeviceplugins API. It represents
+// the DSA device plugin responsible for advertising Intel DSA hardware resources to
+// the kubelet.
+type DsaDevicePlugin struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DsaDevicePluginSpec   `json:"spec,omitempty"`
+	Status DsaDevicePluginStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// DsaDevicePluginList contains a list of DsaDevicePlugin.
+type DsaDevicePluginList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []DsaDevicePlugin `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&DsaDevicePlugin{}, &DsaDevicePluginList{})
+}
@@ -0,0 +1,88 @@
+// Copyright 2020-2024 Intel Corporation. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// KernelVfDriver is a VF device driver for QuickAssist devices.
+// +kubebuilder:validation:Enum={"dh895xccvf","c6xxvf","c3xxxvf","d15xxvf","4xxxvf","420xxvf","c4xxxvf"}
+type KernelVfDriver string
+
+// QatDevicePluginSpec defines the desired state of QatDevicePlugin.
+type QatDevicePluginSpec struct {
+	// Image is a container image with QAT device plugin executable.
+	Image string `json:"image,omitempty"`
+
+	// InitImage is a container image with a script that initialize devices.
+	InitImage string `json:"initImage,omitempty"`
+
+	// ProvisioningConfig is a ConfigMap used to pass the configuration of QAT devices into qat initcontainer.
+	ProvisioningConfig string `json:"provisioningConfig,omitempty"`
+
+	// PreferredAllocationPolicy sets the mode of allocating QAT devices on a node.
+	// +kubebuilder:validation:Enum=balanced;packed
+	PreferredAllocationPolicy string `json:"preferredAllocationPolicy,omitempty"`
+
+	// NodeSelector provides a simple way to constrain device plugin pods to nodes with particular labels.
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+
+	// KernelVfDrivers is a list of VF device drivers for the QuickAssist devices in the system.
+	KernelVfDrivers []KernelVfDriver `json:"kernelVfDrivers,omitempty"`
+
+	// Specialized nodes (e.g., with accelerators) can be Tainted to make sure unwanted pods are not scheduled on them.
+	Tolerations []corev1.Toleration `json:"tolerations,omitempty"`
+
+	// MaxNumDevices is a maximum number of QAT devices to be provided to the QuickAssist device plugin
+	// +kubebuilder:validation:Minimum=1
+	MaxNumDevices int `json:"maxNumDevices,omitempty"`
+
+	// LogLevel sets the plugin's log level.
+	// +kubebuilder:validation:Minimum=0
+	LogLevel int `json:"logLevel,omitempty"`
+}
+
+// QatDevicePluginStatus defines the observed state of QatDevicePlugin.
+type QatDevicePluginStatus DevicePluginStatus
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Cluster
+// +kubebuilder:subresource:status
+
+// QatDevicePlugin is the Schema for the qatdeviceplugins API. It represents
+// the QAT device plugin responsible for advertising Intel QuickAssist Technology hardware resources to
+// the kubelet.
+type QatDevicePlugin struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   QatDevicePluginSpec   `json:"spec,omitempty"`
+	Status QatDevicePluginStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// QatDevicePluginList contains a list of QatDevicePlugin.
+type QatDevicePluginList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []QatDevicePlugin `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&QatDevicePlugin{}, &QatDevicePluginList{})
+}
@@ -0,0 +1,78 @@
+// Copyright 2020-2024 Intel Corporation. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// SgxDevicePluginSpec defines the desired state of SgxDevicePlugin.
+type SgxDevicePluginSpec struct {
+	// Image is a container image with SGX device plugin executable.
+	Image string `json:"image,omitempty"`
+
+	// InitImage is a container image with tools (e.g., SGX NFD source hook) installed on each node.
+	InitImage string `json:"initImage,omitempty"`
+
+	// NodeSelector provides a simple way to constrain device plugin pods to nodes with particular labels.
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+
+	// Specialized nodes (e.g., with accelerators) can be Tainted to make sure unwanted pods are not scheduled on them.
+	Tolerations []corev1.Toleration `json:"tolerations,omitempty"`
+
+	// EnclaveLimit is a number of containers that can share the same SGX enclave device.
+	// +kubebuilder:validation:Minimum=1
+	EnclaveLimit int `json:"enclaveLimit,omitempty"`
+
+	// ProvisionLimit is a number of containers that can share the same SGX provision device.
+	// +kubebuilder:validation:Minimum=1
+	ProvisionLimit int `json:"provisionLimit,omitempty"`
+
+	// LogLevel sets the plugin's log level.
+	// +kubebuilder:validation:Minimum=0
+	LogLevel int `json:"logLevel,omitempty"`
+}
+
+// SgxDevicePluginStatus defines the observed state of SgxDevicePlugin.
+type SgxDevicePluginStatus DevicePluginStatus
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Cluster
+// +kubebuilder:subresource:status
+
+// SgxDevicePlugin is the Schema for the sgxdeviceplugins API. It represents
+// the SGX device plugin responsible for advertising SGX device nodes to
+// the kubelet.
+type SgxDevicePlugin struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   SgxDevicePluginSpec   `json:"spec,omitempty"`
+	Status SgxDevicePluginStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// SgxDevicePluginList contains a list of SgxDevicePlugin.
+type SgxDevicePluginList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []SgxDevicePlugin `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&SgxDevicePlugin{}, &SgxDevicePluginList{})
+}
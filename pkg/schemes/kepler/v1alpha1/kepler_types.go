@@ -0,0 +1,157 @@
+/*
+Copyright 2023.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ConditionType is the type of a Kepler condition.
+type ConditionType string
+
+// ConditionStatus is the status of a Kepler condition.
+type ConditionStatus string
+
+// ConditionReason is the reason of a Kepler condition.
+type ConditionReason string
+
+const (
+	// Available indicates whether all the pods of the exporter are available.
+	Available ConditionType = "Available"
+	// Reconciled indicates whether the operator reconciled the Kepler resource.
+	Reconciled ConditionType = "Reconciled"
+
+	// ConditionTrue means the condition is fulfilled.
+	ConditionTrue ConditionStatus = "True"
+	// ConditionFalse means the condition is not fulfilled.
+	ConditionFalse ConditionStatus = "False"
+	// ConditionUnknown means it is not known whether the condition is fulfilled.
+	ConditionUnknown ConditionStatus = "Unknown"
+	// ConditionDegraded means the condition is fulfilled, but not all of the expected pods are running.
+	ConditionDegraded ConditionStatus = "Degraded"
+)
+
+// ExporterDeploymentSpec defines the desired state of the Kepler exporter DaemonSet.
+type ExporterDeploymentSpec struct {
+	// +kubebuilder:default=9103
+	// +kubebuilder:validation:Maximum=65535
+	// +kubebuilder:validation:Minimum=1
+	Port int32 `json:"port,omitempty"`
+
+	// Defines which Nodes the Pod is scheduled on
+	// +optional
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+
+	// If specified, define Pod's tolerations
+	// +optional
+	Tolerations []corev1.Toleration `json:"tolerations,omitempty"`
+}
+
+// ExporterSpec defines the desired state of the Kepler exporter.
+type ExporterSpec struct {
+	Deployment ExporterDeploymentSpec `json:"deployment,omitempty"`
+}
+
+// KeplerSpec defines the desired state of Kepler.
+type KeplerSpec struct {
+	Exporter ExporterSpec `json:"exporter,omitempty"`
+}
+
+// Condition describes the state of the Kepler resource at a certain point.
+type Condition struct {
+	// Type of Kepler Condition - Reconciled, Available ...
+	Type ConditionType `json:"type"`
+	// status of the condition, one of True, False, Unknown.
+	Status ConditionStatus `json:"status"`
+	// observedGeneration represents the .metadata.generation that the condition was set based upon.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// lastTransitionTime is the last time the condition transitioned from one status to another.
+	LastTransitionTime metav1.Time `json:"lastTransitionTime"`
+	// reason contains a programmatic identifier indicating the reason for the condition's last transition.
+	Reason ConditionReason `json:"reason"`
+	// message is a human readable message indicating details about the transition.
+	Message string `json:"message"`
+}
+
+// ExporterStatus defines the observed state of the Kepler exporter.
+type ExporterStatus struct {
+	// The number of nodes that are running at least 1 kepler pod and are
+	// supposed to run the kepler pod.
+	CurrentNumberScheduled int32 `json:"currentNumberScheduled"`
+
+	// The number of nodes that are running the kepler pod, but are not supposed
+	// to run the kepler pod.
+	NumberMisscheduled int32 `json:"numberMisscheduled"`
+
+	// The total number of nodes that should be running the kepler
+	// pod (including nodes correctly running the kepler pod).
+	DesiredNumberScheduled int32 `json:"desiredNumberScheduled"`
+
+	// numberReady is the number of nodes that should be running the kepler pod
+	// and have one or more of the kepler pod running with a Ready Condition.
+	NumberReady int32 `json:"numberReady"`
+
+	// The total number of nodes that are running updated kepler pod
+	// +optional
+	UpdatedNumberScheduled int32 `json:"updatedNumberScheduled,omitempty"`
+
+	// The number of nodes that should be running the kepler pod and have one or
+	// more of the kepler pod running and available
+	// +optional
+	NumberAvailable int32 `json:"numberAvailable,omitempty"`
+
+	// The number of nodes that should be running the
+	// kepler pod and have none of the kepler pod running and available
+	// +optional
+	NumberUnavailable int32 `json:"numberUnavailable,omitempty"`
+
+	// conditions represent the latest available observations of the kepler-exporter
+	Conditions []Condition `json:"conditions"`
+}
+
+// KeplerStatus defines the observed state of Kepler.
+type KeplerStatus struct {
+	Exporter ExporterStatus `json:"exporter,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope="Cluster"
+
+// Kepler is the Schema for the keplers API.
+type Kepler struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   KeplerSpec   `json:"spec,omitempty"`
+	Status KeplerStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// KeplerList contains a list of Kepler.
+type KeplerList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Kepler `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&Kepler{}, &KeplerList{})
+}
@@ -0,0 +1,144 @@
+/*
+Copyright 2023.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// FenceAgentsRemediationFinalizer is a finalizer for a FenceAgentsRemediation CR deletion
+	FenceAgentsRemediationFinalizer string = "fence-agents-remediation.medik8s.io/far-finalizer"
+
+	// Taints
+	FARNoExecuteTaintKey = "remediation.medik8s.io/fence-agents-remediation"
+)
+
+// ConditionsChangeReason represents the reason of updating the some or all the conditions
+type ConditionsChangeReason string
+
+const (
+	// RemediationStarted - CR was found, its name matches a node, and a finalizer was set
+	RemediationStarted ConditionsChangeReason = "RemediationStarted"
+	// FenceAgentSucceeded - FAR taint was added, fence agent command has been created and succeeded
+	FenceAgentSucceeded ConditionsChangeReason = "FenceAgentSucceeded"
+	// RemediationFinishedSuccessfully - The unhealthy node was fully remediated/fenced (it was tainted, fenced by FA and all of its resources have been deleted)
+	RemediationFinishedSuccessfully ConditionsChangeReason = "RemediationFinishedSuccessfully"
+)
+
+const (
+	// FenceAgentActionSucceededType is the condition type used to signal whether the Fence Agent action was succeeded successfully or not
+	FenceAgentActionSucceededType = "FenceAgentActionSucceeded"
+	// SucceededType is the condition type used to signal whether the remediation was finished successfully or not
+	SucceededType = "Succeeded"
+)
+
+// ParameterName is the name of a fence agent parameter
+type ParameterName string
+
+// NodeName is the name of a node
+type NodeName string
+
+// RemediationStrategyType is the remediation strategy to apply on a fenced node
+type RemediationStrategyType string
+
+const (
+	// ResourceDeletionRemediationStrategy deletes the workloads of the fenced node
+	ResourceDeletionRemediationStrategy = RemediationStrategyType("ResourceDeletion")
+	// OutOfServiceTaintRemediationStrategy adds the out-of-service taint to the fenced node
+	OutOfServiceTaintRemediationStrategy = RemediationStrategyType("OutOfServiceTaint")
+)
+
+// FenceAgentsRemediationSpec defines the desired state of FenceAgentsRemediation
+type FenceAgentsRemediationSpec struct {
+	// Agent is the name of fence agent that will be used.
+	// It should have a fence_ prefix.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern=fence_.+
+	Agent string `json:"agent"`
+
+	// SharedSecretName is the name of the Secret which will contain params needed for FAR in order to remediate any node.
+	// +kubebuilder:default:=fence-agents-credentials-shared
+	// +optional
+	SharedSecretName *string `json:"sharedSecretName,omitempty"`
+
+	// RetryCount is the number of times the fencing agent will be executed
+	// +kubebuilder:default:=5
+	RetryCount int `json:"retrycount,omitempty"`
+
+	// RetryInterval is the interval between each fencing agent execution
+	// +kubebuilder:default:="5s"
+	RetryInterval metav1.Duration `json:"retryinterval,omitempty"`
+
+	// Timeout is the timeout for each fencing agent execution
+	// +kubebuilder:default:="60s"
+	Timeout metav1.Duration `json:"timeout,omitempty"`
+
+	// SharedParameters are parameters common to all nodes
+	// +optional
+	SharedParameters map[ParameterName]string `json:"sharedparameters,omitempty"`
+
+	// NodeParameters are passed to the fencing agent according to the node that is fenced, since they are node specific
+	// +optional
+	NodeParameters map[ParameterName]map[NodeName]string `json:"nodeparameters,omitempty"`
+
+	// RemediationStrategy is the remediation method for unhealthy nodes.
+	// +kubebuilder:default:="ResourceDeletion"
+	// +kubebuilder:validation:Enum=ResourceDeletion;OutOfServiceTaint
+	RemediationStrategy RemediationStrategyType `json:"remediationStrategy,omitempty"`
+}
+
+// FenceAgentsRemediationStatus defines the observed state of FenceAgentsRemediation
+type FenceAgentsRemediationStatus struct {
+	// Represents the observations of a FenceAgentsRemediation's current state.
+	// Known .status.conditions.type are: "Processing", "FenceAgentActionSucceeded", and "Succeeded".
+	// +listType=map
+	// +listMapKey=type
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// LastUpdateTime is the last time the status was updated.
+	//
+	// +optional
+	LastUpdateTime *metav1.Time `json:"lastUpdateTime,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:shortName=far
+
+// FenceAgentsRemediation is the Schema for the fenceagentsremediations API
+type FenceAgentsRemediation struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   FenceAgentsRemediationSpec   `json:"spec,omitempty"`
+	Status FenceAgentsRemediationStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// FenceAgentsRemediationList contains a list of FenceAgentsRemediation
+type FenceAgentsRemediationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []FenceAgentsRemediation `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&FenceAgentsRemediation{}, &FenceAgentsRemediationList{})
+}
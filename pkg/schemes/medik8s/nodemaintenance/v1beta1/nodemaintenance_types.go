@@ -0,0 +1,97 @@
+/*
+Copyright 2023.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// MaintenancePhase contains the phase of maintenance
+type MaintenancePhase string
+
+const (
+	// MaintenanceRunning - maintenance has started its proccessing
+	MaintenanceRunning MaintenancePhase = "Running"
+	// MaintenanceSucceeded - node maintenance has finished succesfuly, cordoned the node and evicted all pods (that could be evicted)
+	MaintenanceSucceeded MaintenancePhase = "Succeeded"
+	// MaintenanceFailed - node maintenance has failed the last time due to an error
+	MaintenanceFailed MaintenancePhase = "Failed"
+)
+
+// NodeMaintenanceSpec defines the desired state of NodeMaintenance
+type NodeMaintenanceSpec struct {
+	// Node name to apply maintanance on/off
+	NodeName string `json:"nodeName"`
+	// Reason for maintanance
+	Reason string `json:"reason,omitempty"`
+}
+
+// PodReference represents a simple object reference for pods
+type PodReference struct {
+	// +operator-sdk:csv:customresourcedefinitions:type=status,displayName="Namespace"
+	Namespace string `json:"namespace,omitempty"`
+	// +operator-sdk:csv:customresourcedefinitions:type=status,displayName="Name"
+	Name string `json:"name,omitempty"`
+}
+
+// NodeMaintenanceStatus defines the observed state of NodeMaintenance
+type NodeMaintenanceStatus struct {
+	// Phase is the represtation of the maintenance progress (Running,Succeeded,Failed)
+	Phase MaintenancePhase `json:"phase,omitempty"`
+	// Percentage completion of draining the node
+	DrainProgress int `json:"drainProgress,omitempty"`
+	// The last time the status has been updated
+	LastUpdate metav1.Time `json:"lastUpdate,omitempty"`
+	// LastError represents the latest error if any in the latest reconciliation
+	LastError string `json:"lastError,omitempty"`
+	// PendingPods is a list of pending pods for eviction
+	PendingPods []string `json:"pendingPods,omitempty"`
+	// PendingPodsRefs is a list of refs of pending pods for eviction
+	PendingPodsRefs []PodReference `json:"pendingPodsRefs,omitempty"`
+	// TotalPods is the total number of all pods on the node from the start
+	TotalPods int `json:"totalpods,omitempty"`
+	// EvictionPods is the total number of pods up for eviction from the start
+	EvictionPods int `json:"evictionPods,omitempty"`
+	// Consecutive number of errors upon obtaining a lease
+	ErrorOnLeaseCount int `json:"errorOnLeaseCount,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:shortName=nm,scope=Cluster
+
+// NodeMaintenance is the Schema for the nodemaintenances API
+type NodeMaintenance struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   NodeMaintenanceSpec   `json:"spec,omitempty"`
+	Status NodeMaintenanceStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// NodeMaintenanceList contains a list of NodeMaintenance
+type NodeMaintenanceList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []NodeMaintenance `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&NodeMaintenance{}, &NodeMaintenanceList{})
+}
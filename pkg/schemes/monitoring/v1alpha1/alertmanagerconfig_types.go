@@ -0,0 +1,254 @@
+// Copyright The prometheus-operator Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package v1alpha1
+
+import (
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// AlertmanagerConfigKind is the kind of the AlertmanagerConfig resource.
+	AlertmanagerConfigKind = "AlertmanagerConfig"
+	// AlertmanagerConfigName is the plural name of the AlertmanagerConfig resource.
+	AlertmanagerConfigName = "alertmanagerconfigs"
+)
+
+// AlertmanagerConfig configures the Prometheus Alertmanager,
+// specifying how alerts should be grouped, inhibited and notified to external systems.
+//
+// +genclient
+// +k8s:openapi-gen=true
+// +kubebuilder:resource:categories="prometheus-operator",shortName="amcfg"
+// +kubebuilder:object:root=true
+type AlertmanagerConfig struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec AlertmanagerConfigSpec `json:"spec"`
+}
+
+// AlertmanagerConfigList is a list of AlertmanagerConfig.
+//
+// +kubebuilder:object:root=true
+type AlertmanagerConfigList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	// List of AlertmanagerConfig
+	Items []AlertmanagerConfig `json:"items"`
+}
+
+// AlertmanagerConfigSpec is a specification of the desired behavior of the
+// Alertmanager configuration.
+// By default, the Alertmanager configuration only applies to alerts for which
+// the `namespace` label is equal to the namespace of the AlertmanagerConfig
+// resource (see the `.spec.alertmanagerConfigMatcherStrategy` field of the
+// Alertmanager CRD).
+type AlertmanagerConfigSpec struct {
+	// The Alertmanager route definition for alerts matching the resource's
+	// namespace. If present, it will be added to the generated Alertmanager
+	// configuration as a first-level route.
+	// +optional
+	Route *Route `json:"route"`
+	// List of receivers.
+	Receivers []Receiver `json:"receivers"`
+	// List of inhibition rules. The rules will only apply to alerts matching
+	// the resource's namespace.
+	InhibitRules []InhibitRule `json:"inhibitRules,omitempty"`
+}
+
+// Route defines a node in the routing tree.
+type Route struct {
+	// Name of the receiver for this route. If not empty, it should be listed in
+	// the `receivers` field.
+	// +optional
+	Receiver string `json:"receiver"`
+	// List of labels to group by.
+	// Labels must not be repeated (unique list).
+	// Special label "..." (aggregate by all possible labels), if provided, must be the only element in the list.
+	// +optional
+	GroupBy []string `json:"groupBy,omitempty"`
+	// How long to wait before sending the initial notification.
+	// Must match the regular expression`^(([0-9]+)y)?(([0-9]+)w)?(([0-9]+)d)?(([0-9]+)h)?(([0-9]+)m)?(([0-9]+)s)?(([0-9]+)ms)?$`
+	// Example: "30s"
+	// +optional
+	GroupWait string `json:"groupWait,omitempty"`
+	// How long to wait before sending an updated notification.
+	// Must match the regular expression`^(([0-9]+)y)?(([0-9]+)w)?(([0-9]+)d)?(([0-9]+)h)?(([0-9]+)m)?(([0-9]+)s)?(([0-9]+)ms)?$`
+	// Example: "5m"
+	// +optional
+	GroupInterval string `json:"groupInterval,omitempty"`
+	// How long to wait before repeating the last notification.
+	// Must match the regular expression`^(([0-9]+)y)?(([0-9]+)w)?(([0-9]+)d)?(([0-9]+)h)?(([0-9]+)m)?(([0-9]+)s)?(([0-9]+)ms)?$`
+	// Example: "4h"
+	// +optional
+	RepeatInterval string `json:"repeatInterval,omitempty"`
+	// List of matchers that the alert's labels should match. For the first
+	// level route, the operator removes any existing equality and regexp
+	// matcher on the `namespace` label and adds a `namespace: <object
+	// namespace>` matcher.
+	// +optional
+	Matchers []Matcher `json:"matchers,omitempty"`
+	// Boolean indicating whether an alert should continue matching subsequent
+	// sibling nodes. It will always be overridden to true for the first-level
+	// route by the Prometheus operator.
+	// +optional
+	Continue bool `json:"continue,omitempty"`
+	// Child routes.
+	Routes []apiextensionsv1.JSON `json:"routes,omitempty"`
+	// Note: this comment applies to the field definition above but appears
+	// below otherwise it gets included in the generated manifest.
+	// CRD schema doesn't support self-referential types for now (see
+	// https://github.com/kubernetes/kubernetes/issues/62872). We have to use
+	// an alternative type to circumvent the limitation. The downside is that
+	// the Kube API can't validate the data beyond the fact that it is a valid
+	// JSON representation.
+
+	// MuteTimeIntervals is a list of MuteTimeInterval names that will mute this route when matched,
+	// +optional
+	MuteTimeIntervals []string `json:"muteTimeIntervals,omitempty"`
+	// ActiveTimeIntervals is a list of MuteTimeInterval names when this route should be active.
+	// +optional
+	ActiveTimeIntervals []string `json:"activeTimeIntervals,omitempty"`
+}
+
+// Receiver defines one or more notification integrations.
+type Receiver struct {
+	// Name of the receiver. Must be unique across all items from the list.
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+	// List of webhook configurations.
+	WebhookConfigs []WebhookConfig `json:"webhookConfigs,omitempty"`
+	// List of Email configurations.
+	EmailConfigs []EmailConfig `json:"emailConfigs,omitempty"`
+}
+
+// WebhookConfig configures notifications via a generic receiver supporting the webhook payload.
+// See https://prometheus.io/docs/alerting/latest/configuration/#webhook_config
+type WebhookConfig struct {
+	// Whether or not to notify about resolved alerts.
+	// +optional
+	SendResolved *bool `json:"sendResolved,omitempty"`
+	// The URL to send HTTP POST requests to. `urlSecret` takes precedence over
+	// `url`. One of `urlSecret` and `url` should be defined.
+	// +optional
+	URL *string `json:"url,omitempty"`
+	// The secret's key that contains the webhook URL to send HTTP requests to.
+	// `urlSecret` takes precedence over `url`. One of `urlSecret` and `url`
+	// should be defined.
+	// The secret needs to be in the same namespace as the AlertmanagerConfig
+	// object and accessible by the Prometheus Operator.
+	// +optional
+	URLSecret *SecretKeySelector `json:"urlSecret,omitempty"`
+	// Maximum number of alerts to be sent per webhook message. When 0, all alerts are included.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MaxAlerts int32 `json:"maxAlerts,omitempty"`
+}
+
+// EmailConfig configures notifications via Email.
+type EmailConfig struct {
+	// Whether or not to notify about resolved alerts.
+	// +optional
+	SendResolved *bool `json:"sendResolved,omitempty"`
+	// The email address to send notifications to.
+	// +optional
+	To string `json:"to,omitempty"`
+	// The sender address.
+	// +optional
+	From string `json:"from,omitempty"`
+	// The hostname to identify to the SMTP server.
+	// +optional
+	Hello string `json:"hello,omitempty"`
+	// The SMTP host and port through which emails are sent. E.g. example.com:25
+	// +optional
+	Smarthost string `json:"smarthost,omitempty"`
+	// The username to use for authentication.
+	// +optional
+	AuthUsername string `json:"authUsername,omitempty"`
+	// The secret's key that contains the password to use for authentication.
+	// The secret needs to be in the same namespace as the AlertmanagerConfig
+	// object and accessible by the Prometheus Operator.
+	// +optional
+	AuthPassword *SecretKeySelector `json:"authPassword,omitempty"`
+	// The identity to use for authentication.
+	// +optional
+	AuthIdentity string `json:"authIdentity,omitempty"`
+	// The SMTP TLS requirement.
+	// Note that Go does not support unencrypted connections to remote SMTP endpoints.
+	// +optional
+	RequireTLS *bool `json:"requireTLS,omitempty"`
+}
+
+// InhibitRule defines an inhibition rule that allows to mute alerts when other
+// alerts are already firing.
+// See https://prometheus.io/docs/alerting/latest/configuration/#inhibit_rule
+type InhibitRule struct {
+	// Matchers that have to be fulfilled in the alerts to be muted. The
+	// operator enforces that the alert matches the resource's namespace.
+	TargetMatch []Matcher `json:"targetMatch,omitempty"`
+	// Matchers for which one or more alerts have to exist for the inhibition
+	// to take effect. The operator enforces that the alert matches the
+	// resource's namespace.
+	SourceMatch []Matcher `json:"sourceMatch,omitempty"`
+	// Labels that must have an equal value in the source and target alert for
+	// the inhibition to take effect.
+	Equal []string `json:"equal,omitempty"`
+}
+
+// Matcher defines how to match on alert's labels.
+type Matcher struct {
+	// Label to match.
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+	// Label value to match.
+	// +optional
+	Value string `json:"value"`
+	// Match operation available with AlertManager >= v0.22.0 and
+	// takes precedence over Regex (deprecated) if non-empty.
+	// +kubebuilder:validation:Enum=!~;=~;=;!=
+	// +optional
+	MatchType MatchType `json:"matchType,omitempty"`
+	// Whether to match on equality (false) or regular-expression (true).
+	// Deprecated: for AlertManager >= v0.22.0, `matchType` should be used instead.
+	// +optional
+	Regex bool `json:"regex,omitempty"`
+}
+
+// MatchType is a comparison operator on a Matcher.
+type MatchType string
+
+// MatchType is a comparison operator on a Matcher.
+const (
+	MatchEqual     MatchType = "="
+	MatchNotEqual  MatchType = "!="
+	MatchRegexp    MatchType = "=~"
+	MatchNotRegexp MatchType = "!~"
+)
+
+// SecretKeySelector selects a key of a Secret.
+type SecretKeySelector struct {
+	// The name of the secret in the object's namespace to select from.
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+	// The key of the secret to select from.  Must be a valid secret key.
+	// +kubebuilder:validation:MinLength=1
+	Key string `json:"key"`
+}
+
+func init() {
+	SchemeBuilder.Register(&AlertmanagerConfig{}, &AlertmanagerConfigList{})
+}
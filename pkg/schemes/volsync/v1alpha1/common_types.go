@@ -0,0 +1,148 @@
+/*
+Copyright 2021 The VolSync authors.
+
+This file may be used, at your option, according to either the GNU AGPL 3.0 or
+the Apache V2 license.
+
+---
+This program is free software: you can redistribute it and/or modify it under
+the terms of the GNU Affero General Public License as published by the Free
+Software Foundation, either version 3 of the License, or (at your option) any
+later version.
+
+This program is distributed in the hope that it will be useful, but WITHOUT ANY
+WARRANTY; without even the implied warranty of MERCHANTABILITY or FITNESS FOR A
+PARTICULAR PURPOSE.  See the GNU Affero General Public License for more
+details.
+
+You should have received a copy of the GNU Affero General Public License along
+with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+---
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// ConditionSynchronizing is the condition type reporting whether a synchronization is in progress.
+	ConditionSynchronizing string = "Synchronizing"
+	// SynchronizingReasonSync means a synchronization is in progress.
+	SynchronizingReasonSync string = "SyncInProgress"
+	// SynchronizingReasonSched means the next synchronization waits for its schedule.
+	SynchronizingReasonSched string = "WaitingForSchedule"
+	// SynchronizingReasonManual means the next synchronization waits for a new manual trigger.
+	SynchronizingReasonManual string = "WaitingForManual"
+	// SynchronizingReasonCleanup means the resources of the last synchronization are being removed.
+	SynchronizingReasonCleanup string = "CleaningUp"
+	// SynchronizingReasonError means the last synchronization failed.
+	SynchronizingReasonError string = "Error"
+)
+
+// CopyMethodType defines the methods for creating point-in-time copies of volumes.
+// +kubebuilder:validation:Enum=Direct;None;Clone;Snapshot
+type CopyMethodType string
+
+const (
+	// CopyMethodDirect indicates a copy should not be performed. Data will be copied directly to/from the PVC.
+	CopyMethodDirect CopyMethodType = "Direct"
+	// CopyMethodNone indicates a copy should not be performed. Deprecated (replaced by CopyMethodDirect).
+	CopyMethodNone CopyMethodType = "None"
+	// CopyMethodClone indicates a copy of a volume should be performed using a Clone operation.
+	CopyMethodClone CopyMethodType = "Clone"
+	// CopyMethodSnapshot indicates a copy of a volume should be performed using a volume snapshot.
+	CopyMethodSnapshot CopyMethodType = "Snapshot"
+)
+
+// MoverResult is the result of the last run of a data mover.
+type MoverResult string
+
+const (
+	// MoverResultSuccessful means the last data mover run succeeded.
+	MoverResultSuccessful MoverResult = "Successful"
+	// MoverResultFailed means the last data mover run failed.
+	MoverResultFailed MoverResult = "Failed"
+)
+
+// MoverStatus reports the result and logs of the last data mover run.
+type MoverStatus struct {
+	Result MoverResult `json:"result,omitempty"`
+	Logs   string      `json:"logs,omitempty"`
+}
+
+// SyncStatus holds the synchronization status fields shared by ReplicationSources and ReplicationDestinations.
+type SyncStatus struct {
+	// lastSyncTime is the time of the most recent successful synchronization.
+	// +optional
+	LastSyncTime *metav1.Time `json:"lastSyncTime,omitempty"`
+	// lastSyncStartTime is the time the most recent synchronization started.
+	// +optional
+	LastSyncStartTime *metav1.Time `json:"lastSyncStartTime,omitempty"`
+	// lastSyncDuration is the amount of time required to send the most recent update.
+	// +optional
+	LastSyncDuration *metav1.Duration `json:"lastSyncDuration,omitempty"`
+	// nextSyncTime is the time when the next volume synchronization is scheduled to start (for schedule-based
+	// synchronization).
+	// +optional
+	NextSyncTime *metav1.Time `json:"nextSyncTime,omitempty"`
+	// lastManualSync is set to equal spec.trigger.manual when the manual sync is done.
+	// +optional
+	LastManualSync string `json:"lastManualSync,omitempty"`
+	// conditions represent the latest available observations of the replication state.
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+	// latestMoverStatus is the result and logs of the last data mover run.
+	// +optional
+	LatestMoverStatus *MoverStatus `json:"latestMoverStatus,omitempty"`
+}
+
+// ResticRetainPolicy defines the feilds for Restic backup.
+type ResticRetainPolicy struct {
+	// Hourly defines the number of snapshots to be kept hourly
+	// +optional
+	Hourly *int32 `json:"hourly,omitempty"`
+	// Daily defines the number of snapshots to be kept daily
+	// +optional
+	Daily *int32 `json:"daily,omitempty"`
+	// Weekly defines the number of snapshots to be kept weekly
+	// +optional
+	Weekly *int32 `json:"weekly,omitempty"`
+	// Monthly defines the number of snapshots to be kept monthly
+	// +optional
+	Monthly *int32 `json:"monthly,omitempty"`
+	// Yearly defines the number of snapshots to be kept yearly
+	// +optional
+	Yearly *int32 `json:"yearly,omitempty"`
+	// Within defines the number of snapshots to be kept Within the given time period
+	// +optional
+	Within *string `json:"within,omitempty"`
+}
+
+// ResticCacheOptions defines the volume used by restic for its local cache.
+type ResticCacheOptions struct {
+	// CacheCapacity can be used to set the size of the restic metadata cache volume
+	// +optional
+	CacheCapacity *resource.Quantity `json:"cacheCapacity,omitempty"`
+	// CacheStorageClassName can be used to set the StorageClass of the restic metadata cache volume
+	// +optional
+	CacheStorageClassName *string `json:"cacheStorageClassName,omitempty"`
+	// CacheAccessModes can be used to set the accessModes of restic metadata cache volume
+	// +optional
+	CacheAccessModes []corev1.PersistentVolumeAccessMode `json:"cacheAccessModes,omitempty"`
+}
@@ -0,0 +1,186 @@
+/*
+Copyright 2021 The VolSync authors.
+
+This file may be used, at your option, according to either the GNU AGPL 3.0 or
+the Apache V2 license.
+
+---
+This program is free software: you can redistribute it and/or modify it under
+the terms of the GNU Affero General Public License as published by the Free
+Software Foundation, either version 3 of the License, or (at your option) any
+later version.
+
+This program is distributed in the hope that it will be useful, but WITHOUT ANY
+WARRANTY; without even the implied warranty of MERCHANTABILITY or FITNESS FOR A
+PARTICULAR PURPOSE.  See the GNU Affero General Public License for more
+details.
+
+You should have received a copy of the GNU Affero General Public License along
+with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+---
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ReplicationDestinationTriggerSpec defines when a volume will be synchronized with the source.
+type ReplicationDestinationTriggerSpec struct {
+	// schedule is a cronspec (https://en.wikipedia.org/wiki/Cron#Overview) that can be used to schedule replication
+	// to occur at regular, time-based intervals.
+	// +optional
+	Schedule *string `json:"schedule,omitempty"`
+	// manual is a string value that schedules a manual trigger. Once a sync completes then status.lastManualSync is
+	// set to the same string value.
+	// +optional
+	Manual string `json:"manual,omitempty"`
+}
+
+// ReplicationDestinationVolumeOptions defines the set of configuration options that can be used to create the
+// destination volume and its point-in-time images.
+type ReplicationDestinationVolumeOptions struct {
+	// copyMethod specifies the method used to create a point-in-time copy of the destination volume.
+	CopyMethod CopyMethodType `json:"copyMethod,omitempty"`
+	// capacity is the size of the destination volume to create.
+	// +optional
+	Capacity *resource.Quantity `json:"capacity,omitempty"`
+	// storageClassName can be used to specify the StorageClass of the destination volume. If not set, the default
+	// StorageClass will be used.
+	// +optional
+	StorageClassName *string `json:"storageClassName,omitempty"`
+	// accessModes specifies the access modes for the destination volume.
+	// +optional
+	AccessModes []corev1.PersistentVolumeAccessMode `json:"accessModes,omitempty"`
+	// volumeSnapshotClassName can be used to specify the VSC to be used if copyMethod is Snapshot. If not set, the
+	// default VSC is used.
+	// +optional
+	VolumeSnapshotClassName *string `json:"volumeSnapshotClassName,omitempty"`
+	// destinationPVC is a PVC to use as the transfer destination instead of automatically provisioning one.
+	// +optional
+	DestinationPVC *string `json:"destinationPVC,omitempty"`
+}
+
+// ReplicationDestinationRsyncSpec defines the configuration when using Rsync-based replication.
+type ReplicationDestinationRsyncSpec struct {
+	ReplicationDestinationVolumeOptions `json:",inline"`
+	// sshKeys is the name of a Secret that contains the SSH keys to be used for authentication. If not provided, the
+	// keys will be generated.
+	// +optional
+	SSHKeys *string `json:"sshKeys,omitempty"`
+	// serviceType determines the Service type that will be created for incoming SSH connections.
+	// +optional
+	ServiceType *corev1.ServiceType `json:"serviceType,omitempty"`
+	// address is the remote address to connect to for replication.
+	// +optional
+	Address *string `json:"address,omitempty"`
+	// port is the SSH port to connect to for replication. Defaults to 22.
+	// +optional
+	Port *int32 `json:"port,omitempty"`
+	// path is the remote path to rsync from. Defaults to "/"
+	// +optional
+	Path *string `json:"path,omitempty"`
+	// sshUser is the username for outgoing SSH connections. Defaults to "root".
+	// +optional
+	SSHUser *string `json:"sshUser,omitempty"`
+}
+
+// ReplicationDestinationResticSpec defines the field for restic in replicationDestination.
+type ReplicationDestinationResticSpec struct {
+	ReplicationDestinationVolumeOptions `json:",inline"`
+	ResticCacheOptions                  `json:",inline"`
+	// Repository is the secret name containing repository info
+	Repository string `json:"repository,omitempty"`
+	// RestoreAsOf refers to the backup that is most recent as of that time.
+	// +kubebuilder:validation:Format="date-time"
+	// +optional
+	RestoreAsOf *string `json:"restoreAsOf,omitempty"`
+	// Previous specifies the number of image to skip before selecting one to restore from
+	// +optional
+	Previous *int32 `json:"previous,omitempty"`
+}
+
+// ReplicationDestinationSpec defines the desired state of the ReplicationDestination.
+type ReplicationDestinationSpec struct {
+	// trigger determines if/when the destination should attempt to synchronize data with the source.
+	// +optional
+	Trigger *ReplicationDestinationTriggerSpec `json:"trigger,omitempty"`
+	// rsync defines the configuration when using Rsync-based replication.
+	// +optional
+	Rsync *ReplicationDestinationRsyncSpec `json:"rsync,omitempty"`
+	// restic defines the configuration when using Restic-based replication.
+	// +optional
+	Restic *ReplicationDestinationResticSpec `json:"restic,omitempty"`
+	// paused can be used to temporarily stop replication. Defaults to "false".
+	// +optional
+	Paused bool `json:"paused,omitempty"`
+}
+
+// ReplicationDestinationRsyncStatus defines the status of Rsync-based replication.
+type ReplicationDestinationRsyncStatus struct {
+	// sshKeys is the name of a Secret that contains the SSH keys to be used for authentication. If not provided in
+	// .spec.rsync.sshKeys, SSH keys will be generated and the appropriate keys for the remote side will be placed here.
+	// +optional
+	SSHKeys *string `json:"sshKeys,omitempty"`
+	// address is the address to connect to for incoming SSH replication connections.
+	// +optional
+	Address *string `json:"address,omitempty"`
+	// port is the SSH port to connect to for incoming SSH replication connections.
+	// +optional
+	Port *int32 `json:"port,omitempty"`
+}
+
+// ReplicationDestinationStatus defines the observed state of ReplicationDestination.
+type ReplicationDestinationStatus struct {
+	SyncStatus `json:",inline"`
+	// latestImage in the object holding the most recent consistent replicated image.
+	// +optional
+	LatestImage *corev1.TypedLocalObjectReference `json:"latestImage,omitempty"`
+	// rsync contains status information for Rsync-based replication.
+	// +optional
+	Rsync *ReplicationDestinationRsyncStatus `json:"rsync,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// ReplicationDestination defines the destination for a replicated volume.
+type ReplicationDestination struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// spec is the desired state of the ReplicationDestination, including the replication method to use and its
+	// configuration.
+	Spec ReplicationDestinationSpec `json:"spec,omitempty"`
+	// status is the observed state of the ReplicationDestination as determined by the controller.
+	// +optional
+	Status *ReplicationDestinationStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ReplicationDestinationList contains a list of ReplicationDestination.
+type ReplicationDestinationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ReplicationDestination `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ReplicationDestination{}, &ReplicationDestinationList{})
+}
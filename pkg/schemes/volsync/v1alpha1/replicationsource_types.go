@@ -0,0 +1,193 @@
+/*
+Copyright 2021 The VolSync authors.
+
+This file may be used, at your option, according to either the GNU AGPL 3.0 or
+the Apache V2 license.
+
+---
+This program is free software: you can redistribute it and/or modify it under
+the terms of the GNU Affero General Public License as published by the Free
+Software Foundation, either version 3 of the License, or (at your option) any
+later version.
+
+This program is distributed in the hope that it will be useful, but WITHOUT ANY
+WARRANTY; without even the implied warranty of MERCHANTABILITY or FITNESS FOR A
+PARTICULAR PURPOSE.  See the GNU Affero General Public License for more
+details.
+
+You should have received a copy of the GNU Affero General Public License along
+with this program.  If not, see <https://www.gnu.org/licenses/>.
+
+---
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ReplicationSourceTriggerSpec defines when a volume will be synchronized with the destination.
+type ReplicationSourceTriggerSpec struct {
+	// schedule is a cronspec (https://en.wikipedia.org/wiki/Cron#Overview) that can be used to schedule replication
+	// to occur at regular, time-based intervals.
+	// +optional
+	Schedule *string `json:"schedule,omitempty"`
+	// manual is a string value that schedules a manual trigger. Once a sync completes then status.lastManualSync is
+	// set to the same string value. A consumer of a manual trigger should set spec.trigger.manual to a known value
+	// and then wait for lastManualSync to be updated by the operator to the same value, which means that the manual
+	// trigger will then pause and wait for further updates to the trigger.
+	// +optional
+	Manual string `json:"manual,omitempty"`
+}
+
+// ReplicationSourceVolumeOptions defines the set of configuration options that can be used to create a
+// point-in-time copy of the source volume.
+type ReplicationSourceVolumeOptions struct {
+	// copyMethod specifies the method used to create a point-in-time copy of the source volume.
+	CopyMethod CopyMethodType `json:"copyMethod,omitempty"`
+	// capacity can be used to override the capacity of the point-in-time image.
+	// +optional
+	Capacity *resource.Quantity `json:"capacity,omitempty"`
+	// storageClassName can be used to override the StorageClass of the point-in-time image.
+	// +optional
+	StorageClassName *string `json:"storageClassName,omitempty"`
+	// accessModes can be used to override the accessModes of the point-in-time image.
+	// +optional
+	AccessModes []corev1.PersistentVolumeAccessMode `json:"accessModes,omitempty"`
+	// volumeSnapshotClassName can be used to specify the VSC to be used if copyMethod is Snapshot. If not set, the
+	// default VSC is used.
+	// +optional
+	VolumeSnapshotClassName *string `json:"volumeSnapshotClassName,omitempty"`
+}
+
+// ReplicationSourceRsyncSpec defines the field for rsync in replicationSource.
+type ReplicationSourceRsyncSpec struct {
+	ReplicationSourceVolumeOptions `json:",inline"`
+	// sshKeys is the name of a Secret that contains the SSH keys to be used for authentication. If not provided, the
+	// keys will be generated.
+	// +optional
+	SSHKeys *string `json:"sshKeys,omitempty"`
+	// serviceType determines the Service type that will be created for incoming SSH connections.
+	// +optional
+	ServiceType *corev1.ServiceType `json:"serviceType,omitempty"`
+	// address is the remote address to connect to for replication.
+	// +optional
+	Address *string `json:"address,omitempty"`
+	// port is the SSH port to connect to for replication. Defaults to 22.
+	// +optional
+	Port *int32 `json:"port,omitempty"`
+	// path is the remote path to rsync to. Defaults to "/"
+	// +optional
+	Path *string `json:"path,omitempty"`
+	// sshUser is the username for outgoing SSH connections. Defaults to "root".
+	// +optional
+	SSHUser *string `json:"sshUser,omitempty"`
+}
+
+// ReplicationSourceResticSpec defines the field for restic in replicationSource.
+type ReplicationSourceResticSpec struct {
+	ReplicationSourceVolumeOptions `json:",inline"`
+	ResticCacheOptions             `json:",inline"`
+	// PruneIntervalDays define how often to prune the repository
+	// +optional
+	PruneIntervalDays *int32 `json:"pruneIntervalDays,omitempty"`
+	// Repository is the secret name containing repository info
+	Repository string `json:"repository,omitempty"`
+	// ResticRetainPolicy define the retain policy
+	// +optional
+	Retain *ResticRetainPolicy `json:"retain,omitempty"`
+}
+
+// ReplicationSourceSpec defines the desired state of ReplicationSource.
+type ReplicationSourceSpec struct {
+	// sourcePVC is the name of the PersistentVolumeClaim (PVC) to replicate.
+	SourcePVC string `json:"sourcePVC,omitempty"`
+	// trigger determines when the latest state of the volume will be captured (and potentially replicated to the
+	// destination).
+	// +optional
+	Trigger *ReplicationSourceTriggerSpec `json:"trigger,omitempty"`
+	// rsync defines the configuration when using Rsync-based replication.
+	// +optional
+	Rsync *ReplicationSourceRsyncSpec `json:"rsync,omitempty"`
+	// restic defines the configuration when using Restic-based replication.
+	// +optional
+	Restic *ReplicationSourceResticSpec `json:"restic,omitempty"`
+	// paused can be used to temporarily stop replication. Defaults to "false".
+	// +optional
+	Paused bool `json:"paused,omitempty"`
+}
+
+// ReplicationSourceRsyncStatus defines the status of Rsync-based replication.
+type ReplicationSourceRsyncStatus struct {
+	// sshKeys is the name of a Secret that contains the SSH keys to be used for authentication. If not provided in
+	// .spec.rsync.sshKeys, SSH keys will be generated and the appropriate keys for the remote side will be placed here.
+	// +optional
+	SSHKeys *string `json:"sshKeys,omitempty"`
+	// address is the address to connect to for incoming SSH replication connections.
+	// +optional
+	Address *string `json:"address,omitempty"`
+	// port is the SSH port to connect to for incoming SSH replication connections.
+	// +optional
+	Port *int32 `json:"port,omitempty"`
+}
+
+// ReplicationSourceResticStatus defines the status of Restic-based replication.
+type ReplicationSourceResticStatus struct {
+	// lastPruned in the object holding the time of last pruned
+	// +optional
+	LastPruned *metav1.Time `json:"lastPruned,omitempty"`
+}
+
+// ReplicationSourceStatus defines the observed state of ReplicationSource.
+type ReplicationSourceStatus struct {
+	SyncStatus `json:",inline"`
+	// rsync contains status information for Rsync-based replication.
+	// +optional
+	Rsync *ReplicationSourceRsyncStatus `json:"rsync,omitempty"`
+	// restic contains status information for Restic-based replication.
+	// +optional
+	Restic *ReplicationSourceResticStatus `json:"restic,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+
+// ReplicationSource defines the source for a replicated volume.
+type ReplicationSource struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// spec is the desired state of the ReplicationSource, including the replication method to use and its
+	// configuration.
+	Spec ReplicationSourceSpec `json:"spec,omitempty"`
+	// status is the observed state of the ReplicationSource as determined by the controller.
+	// +optional
+	Status *ReplicationSourceStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ReplicationSourceList contains a list of ReplicationSource.
+type ReplicationSourceList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ReplicationSource `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ReplicationSource{}, &ReplicationSourceList{})
+}
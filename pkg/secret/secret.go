@@ -4,11 +4,14 @@ import (
 	"fmt"
 
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common"
+	commonkey "github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common/key"
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/logging"
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/msg"
 	corev1 "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/klog/v2"
 )
 
@@ -35,6 +38,8 @@ type AdditionalOptions func(builder *Builder) (*Builder, error)
 
 // NewBuilder creates a new instance of Builder.
 func NewBuilder(apiClient *clients.Settings, name, nsname string, secretType corev1.SecretType) *Builder {
+	nsname = apiClient.ResolveNamespace(nsname)
+
 	klog.V(100).Infof(
 		"Initializing new secret structure with the following params: %s, %s, %s",
 		name, nsname, string(secretType))
@@ -85,6 +90,8 @@ func NewBuilder(apiClient *clients.Settings, name, nsname string, secretType cor
 
 // Pull loads an existing secret into Builder struct.
 func Pull(apiClient *clients.Settings, name, nsname string) (*Builder, error) {
+	nsname = apiClient.ResolveNamespace(nsname)
+
 	klog.V(100).Infof("Pulling existing secret name: %s under namespace: %s", name, nsname)
 
 	if apiClient == nil {
@@ -196,7 +203,16 @@ func (builder *Builder) Update() (*Builder, error) {
 		builder.Definition.Name,
 		builder.Definition.Namespace)
 
-	var err error
+	latestSecret, err := builder.apiClient.Secrets(builder.Definition.Namespace).Get(
+		logging.DiscardContext(), builder.Definition.Name, metav1.GetOptions{})
+	if err == nil {
+		err = common.ValidateImmutableFields(
+			commonkey.NewResourceKey("Secret", builder.Definition.Name, builder.Definition.Namespace),
+			latestSecret, builder.Definition, getImmutableFields(latestSecret)...)
+		if err != nil {
+			return builder, err
+		}
+	}
 
 	builder.Object, err = builder.apiClient.Secrets(builder.Definition.Namespace).Update(
 		logging.DiscardContext(), builder.Definition, metav1.UpdateOptions{})
@@ -277,6 +293,14 @@ func (builder *Builder) WithAnnotations(annotations map[string]string) *Builder
 		}
 	}
 
+	if err := common.ValidateAnnotations(annotations); err != nil {
+		klog.V(100).Infof("Invalid annotations: %v", err)
+
+		builder.errorMsg = err.Error()
+
+		return builder
+	}
+
 	builder.Definition.Annotations = annotations
 
 	return builder
@@ -306,6 +330,13 @@ func (builder *Builder) WithOptions(options ...AdditionalOptions) *Builder {
 	return builder
 }
 
+// GetGVR returns Secret's GroupVersionResource which could be used for Clean function.
+func GetGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group: "", Version: "v1", Resource: "secrets",
+	}
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *Builder) validate() (bool, error) {
@@ -337,3 +368,13 @@ func (builder *Builder) validate() (bool, error) {
 
 	return true, nil
 }
+
+// getImmutableFields returns the fields of the secret which cannot be changed given the latest secret on the cluster.
+// The type can never be changed and the data cannot be changed once the secret is marked immutable.
+func getImmutableFields(latest *corev1.Secret) []string {
+	if latest.Immutable == nil || !*latest.Immutable {
+		return []string{"type"}
+	}
+
+	return []string{"type", "data", "stringData", "immutable"}
+}
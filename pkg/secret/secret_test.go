@@ -6,10 +6,13 @@ import (
 	"testing"
 
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	commonerrors "github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common/errors"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
 )
 
 var (
@@ -139,6 +142,13 @@ func TestSecretNewBuilder(t *testing.T) {
 			assert.Equal(t, testCase.namespace, testSecretBuilder.Definition.Namespace)
 		}
 	}
+
+	namespacedSettings, err := clients.GetTestClients(clients.TestClientParams{}).NamespacedClient(defaultSecretNamespace)
+	assert.Nil(t, err)
+
+	testSecretBuilder := NewBuilder(namespacedSettings, defaultSecretName, "", corev1.SecretType(defaultSecretType))
+	assert.Empty(t, testSecretBuilder.errorMsg)
+	assert.Equal(t, defaultSecretNamespace, testSecretBuilder.Definition.Namespace)
 }
 
 func TestSecretCreate(t *testing.T) {
@@ -389,6 +399,57 @@ func TestSecretUpdate(t *testing.T) {
 	}
 }
 
+func TestSecretUpdateImmutable(t *testing.T) {
+	testCases := []struct {
+		immutable     *bool
+		stringData    map[string]string
+		data          map[string][]byte
+		expectedError bool
+	}{
+		{
+			immutable:  ptr.To(false),
+			stringData: map[string]string{"key": "other"},
+		},
+		{
+			immutable:     ptr.To(true),
+			stringData:    map[string]string{"key": "other"},
+			expectedError: true,
+		},
+		{
+			immutable:     ptr.To(true),
+			data:          map[string][]byte{"key": []byte("other")},
+			expectedError: true,
+		},
+		{
+			immutable: ptr.To(true),
+			data:      map[string][]byte{"key": []byte("value")},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testSettings := clients.GetTestClients(clients.TestClientParams{
+			K8sMockObjects: []runtime.Object{&corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: defaultSecretName, Namespace: defaultSecretNamespace},
+				Data:       map[string][]byte{"key": []byte("value")},
+				Immutable:  testCase.immutable,
+			}},
+		})
+
+		testBuilder, err := Pull(testSettings, defaultSecretName, defaultSecretNamespace)
+		require.NoError(t, err)
+
+		testBuilder.Definition.Data = testCase.data
+		testBuilder.Definition.StringData = testCase.stringData
+
+		_, err = testBuilder.Update()
+		assert.Equal(t, testCase.expectedError, commonerrors.IsImmutableFieldChange(err))
+
+		if !testCase.expectedError {
+			assert.NoError(t, err)
+		}
+	}
+}
+
 func TestSecretValidate(t *testing.T) {
 	testCases := []struct {
 		builderNil    bool
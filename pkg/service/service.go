@@ -2,10 +2,13 @@ package service
 
 import (
 	"fmt"
+	"slices"
 
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common"
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/logging"
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/msg"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/network"
 	corev1 "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -45,6 +48,8 @@ func NewBuilder(
 	nsname string,
 	selector map[string]string,
 	servicePort corev1.ServicePort) *Builder {
+	nsname = apiClient.ResolveNamespace(nsname)
+
 	klog.V(100).Infof(
 		"Initializing new service structure with the following params: %s, %s", name, nsname)
 
@@ -102,6 +107,8 @@ func (builder *Builder) WithNodePort() *Builder {
 
 // Pull loads an existing service into Builder struct.
 func Pull(apiClient *clients.Settings, name, nsname string) (*Builder, error) {
+	nsname = apiClient.ResolveNamespace(nsname)
+
 	klog.V(100).Infof("Pulling existing service name: %s under namespace: %s", name, nsname)
 
 	if apiClient == nil {
@@ -266,6 +273,58 @@ func (builder *Builder) WithExternalTrafficPolicy(policyType corev1.ServiceExter
 	return builder
 }
 
+// WithInternalTrafficPolicy sets whether traffic originating inside the cluster is routed to all the endpoints of the
+// service or only to the endpoints on the node of the client. Unlike WithExternalTrafficPolicy, it does not change the
+// service type.
+func (builder *Builder) WithInternalTrafficPolicy(policy corev1.ServiceInternalTrafficPolicy) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	klog.V(100).Infof("Setting InternalTrafficPolicy of service %s in namespace %s to %s",
+		builder.Definition.Name, builder.Definition.Namespace, policy)
+
+	if policy != corev1.ServiceInternalTrafficPolicyCluster && policy != corev1.ServiceInternalTrafficPolicyLocal {
+		klog.V(100).Infof("Invalid InternalTrafficPolicy %s for service %s", policy, builder.Definition.Name)
+
+		builder.errorMsg = fmt.Sprintf("internalTrafficPolicy %s is not supported", policy)
+
+		return builder
+	}
+
+	builder.Definition.Spec.InternalTrafficPolicy = &policy
+
+	return builder
+}
+
+// WithTrafficDistribution sets the preference for routing traffic to topologically close endpoints, such as
+// corev1.ServiceTrafficDistributionPreferSameZone. The preference is implemented using hints on the endpointslices of
+// the service, which can be checked using endpointslice.AssertTrafficDistributionForService.
+func (builder *Builder) WithTrafficDistribution(trafficDistribution string) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	klog.V(100).Infof("Setting TrafficDistribution of service %s in namespace %s to %s",
+		builder.Definition.Name, builder.Definition.Namespace, trafficDistribution)
+
+	if !slices.Contains([]string{
+		corev1.ServiceTrafficDistributionPreferClose,
+		corev1.ServiceTrafficDistributionPreferSameZone,
+		corev1.ServiceTrafficDistributionPreferSameNode,
+	}, trafficDistribution) {
+		klog.V(100).Infof("Invalid TrafficDistribution %s for service %s", trafficDistribution, builder.Definition.Name)
+
+		builder.errorMsg = fmt.Sprintf("trafficDistribution %s is not supported", trafficDistribution)
+
+		return builder
+	}
+
+	builder.Definition.Spec.TrafficDistribution = &trafficDistribution
+
+	return builder
+}
+
 // WithLabels redefines the service with label.
 func (builder *Builder) WithLabels(labels map[string]string) *Builder {
 	if valid, _ := builder.validate(); !valid {
@@ -292,6 +351,14 @@ func (builder *Builder) WithLabels(labels map[string]string) *Builder {
 		}
 	}
 
+	if err := common.ValidateLabels(labels); err != nil {
+		klog.V(100).Infof("Invalid labels: %v", err)
+
+		builder.errorMsg = err.Error()
+
+		return builder
+	}
+
 	builder.Definition.Labels = labels
 
 	return builder
@@ -357,6 +424,14 @@ func (builder *Builder) WithAnnotation(annotation map[string]string) *Builder {
 		}
 	}
 
+	if err := common.ValidateAnnotations(annotation); err != nil {
+		klog.V(100).Infof("Invalid annotations: %v", err)
+
+		builder.errorMsg = err.Error()
+
+		return builder
+	}
+
 	builder.Definition.Annotations = annotation
 
 	return builder
@@ -396,6 +471,66 @@ func (builder *Builder) WithIPFamily(ipFamily []corev1.IPFamily, ipStackPolicy c
 	return builder
 }
 
+// WithIPFamilies sets the IP families of the service, with the primary family first. Combined with
+// WithIPFamilyPolicy, it replaces WithIPFamily and validates that the families and policy are compatible.
+func (builder *Builder) WithIPFamilies(families ...corev1.IPFamily) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	klog.V(100).Infof("Setting IPFamilies of service %s in namespace %s to %v",
+		builder.Definition.Name, builder.Definition.Namespace, families)
+
+	if err := network.ValidateIPFamilies(families); err != nil {
+		klog.V(100).Infof("Invalid IPFamilies for service %s: %v", builder.Definition.Name, err)
+
+		builder.errorMsg = err.Error()
+
+		return builder
+	}
+
+	if err := validateIPFamilyPolicy(families, builder.Definition.Spec.IPFamilyPolicy); err != nil {
+		builder.errorMsg = err.Error()
+
+		return builder
+	}
+
+	builder.Definition.Spec.IPFamilies = families
+
+	return builder
+}
+
+// WithIPFamilyPolicy sets the IP family policy of the service. If IP families are already set, the policy must be
+// compatible with them.
+func (builder *Builder) WithIPFamilyPolicy(policy corev1.IPFamilyPolicy) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	klog.V(100).Infof("Setting IPFamilyPolicy of service %s in namespace %s to %s",
+		builder.Definition.Name, builder.Definition.Namespace, policy)
+
+	if !slices.Contains([]corev1.IPFamilyPolicy{
+		corev1.IPFamilyPolicySingleStack, corev1.IPFamilyPolicyPreferDualStack, corev1.IPFamilyPolicyRequireDualStack,
+	}, policy) {
+		klog.V(100).Infof("Invalid IPFamilyPolicy %s for service %s", policy, builder.Definition.Name)
+
+		builder.errorMsg = fmt.Sprintf("ipFamilyPolicy %s is not supported", policy)
+
+		return builder
+	}
+
+	if err := validateIPFamilyPolicy(builder.Definition.Spec.IPFamilies, &policy); err != nil {
+		builder.errorMsg = err.Error()
+
+		return builder
+	}
+
+	builder.Definition.Spec.IPFamilyPolicy = &policy
+
+	return builder
+}
+
 // DefineServicePort helper for creating a Service with a ServicePort.
 func DefineServicePort(port, targetPort int32, protocol corev1.Protocol) (*corev1.ServicePort, error) {
 	klog.V(100).Infof(
@@ -424,6 +559,16 @@ func GetGVR() schema.GroupVersionResource {
 	return schema.GroupVersionResource{Group: "", Version: "v1", Resource: "services"}
 }
 
+// validateIPFamilyPolicy checks that a single-stack policy is not combined with two IP families. A missing policy or
+// families are always valid since the API server defaults them.
+func validateIPFamilyPolicy(families []corev1.IPFamily, policy *corev1.IPFamilyPolicy) error {
+	if policy != nil && *policy == corev1.IPFamilyPolicySingleStack && len(families) > 1 {
+		return fmt.Errorf("ipFamilyPolicy %s cannot be used with ipFamilies %v", *policy, families)
+	}
+
+	return nil
+}
+
 // isValidPort checks if a port is valid.
 func isValidPort(port int32) bool {
 	if (port > 0) && (port < 65535) {
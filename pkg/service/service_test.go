@@ -383,6 +383,70 @@ func TestServiceWithExternalTrafficPolicy(t *testing.T) {
 	}
 }
 
+func TestServiceWithInternalTrafficPolicy(t *testing.T) {
+	testCases := []struct {
+		policy            corev1.ServiceInternalTrafficPolicy
+		expectedErrorText string
+	}{
+		{
+			policy: corev1.ServiceInternalTrafficPolicyLocal,
+		},
+		{
+			policy: corev1.ServiceInternalTrafficPolicyCluster,
+		},
+		{
+			policy:            "Zone",
+			expectedErrorText: "internalTrafficPolicy Zone is not supported",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidServiceBuilder(buildServiceClientWithDummyObject())
+
+		result := testBuilder.WithInternalTrafficPolicy(testCase.policy)
+
+		assert.Equal(t, testCase.expectedErrorText, result.errorMsg)
+
+		if testCase.expectedErrorText == "" {
+			assert.Equal(t, &testCase.policy, result.Definition.Spec.InternalTrafficPolicy)
+			assert.Empty(t, result.Definition.Spec.Type)
+		}
+	}
+}
+
+func TestServiceWithTrafficDistribution(t *testing.T) {
+	testCases := []struct {
+		trafficDistribution string
+		expectedErrorText   string
+	}{
+		{
+			trafficDistribution: corev1.ServiceTrafficDistributionPreferClose,
+		},
+		{
+			trafficDistribution: corev1.ServiceTrafficDistributionPreferSameZone,
+		},
+		{
+			trafficDistribution: corev1.ServiceTrafficDistributionPreferSameNode,
+		},
+		{
+			trafficDistribution: "",
+			expectedErrorText:   "trafficDistribution  is not supported",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidServiceBuilder(buildServiceClientWithDummyObject())
+
+		result := testBuilder.WithTrafficDistribution(testCase.trafficDistribution)
+
+		assert.Equal(t, testCase.expectedErrorText, result.errorMsg)
+
+		if testCase.expectedErrorText == "" {
+			assert.Equal(t, &testCase.trafficDistribution, result.Definition.Spec.TrafficDistribution)
+		}
+	}
+}
+
 func TestServiceWithSelector(t *testing.T) {
 	testCases := []struct {
 		testSelector   map[string]string
@@ -576,6 +640,96 @@ func TestServiceWithIPFamily(t *testing.T) {
 	}
 }
 
+func TestServiceWithIPFamilies(t *testing.T) {
+	singleStack := corev1.IPFamilyPolicySingleStack
+
+	testCases := []struct {
+		families          []corev1.IPFamily
+		existingPolicy    *corev1.IPFamilyPolicy
+		expectedErrorText string
+	}{
+		{
+			families: []corev1.IPFamily{corev1.IPv6Protocol},
+		},
+		{
+			families: []corev1.IPFamily{corev1.IPv6Protocol, corev1.IPv4Protocol},
+		},
+		{
+			families:          []corev1.IPFamily{corev1.IPv4Protocol},
+			existingPolicy:    &singleStack,
+			expectedErrorText: "",
+		},
+		{
+			families:          []corev1.IPFamily{corev1.IPv6Protocol, corev1.IPv4Protocol},
+			existingPolicy:    &singleStack,
+			expectedErrorText: "ipFamilyPolicy SingleStack cannot be used with ipFamilies [IPv6 IPv4]",
+		},
+		{
+			families:          []corev1.IPFamily{corev1.IPv4Protocol, corev1.IPv4Protocol},
+			expectedErrorText: "ipFamilies cannot contain IPv4 twice",
+		},
+		{
+			families:          nil,
+			expectedErrorText: "ipFamilies must contain one or two families, got 0",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidServiceBuilder(buildServiceClientWithDummyObject())
+		testBuilder.Definition.Spec.IPFamilyPolicy = testCase.existingPolicy
+
+		result := testBuilder.WithIPFamilies(testCase.families...)
+
+		assert.Equal(t, testCase.expectedErrorText, result.errorMsg)
+
+		if testCase.expectedErrorText == "" {
+			assert.Equal(t, testCase.families, result.Definition.Spec.IPFamilies)
+		}
+	}
+}
+
+func TestServiceWithIPFamilyPolicy(t *testing.T) {
+	testCases := []struct {
+		policy            corev1.IPFamilyPolicy
+		existingFamilies  []corev1.IPFamily
+		expectedErrorText string
+	}{
+		{
+			policy: corev1.IPFamilyPolicySingleStack,
+		},
+		{
+			policy:           corev1.IPFamilyPolicyPreferDualStack,
+			existingFamilies: []corev1.IPFamily{corev1.IPv4Protocol, corev1.IPv6Protocol},
+		},
+		{
+			policy:           corev1.IPFamilyPolicyRequireDualStack,
+			existingFamilies: []corev1.IPFamily{corev1.IPv4Protocol},
+		},
+		{
+			policy:            corev1.IPFamilyPolicySingleStack,
+			existingFamilies:  []corev1.IPFamily{corev1.IPv4Protocol, corev1.IPv6Protocol},
+			expectedErrorText: "ipFamilyPolicy SingleStack cannot be used with ipFamilies [IPv4 IPv6]",
+		},
+		{
+			policy:            "",
+			expectedErrorText: "ipFamilyPolicy  is not supported",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidServiceBuilder(buildServiceClientWithDummyObject())
+		testBuilder.Definition.Spec.IPFamilies = testCase.existingFamilies
+
+		result := testBuilder.WithIPFamilyPolicy(testCase.policy)
+
+		assert.Equal(t, testCase.expectedErrorText, result.errorMsg)
+
+		if testCase.expectedErrorText == "" {
+			assert.Equal(t, &testCase.policy, result.Definition.Spec.IPFamilyPolicy)
+		}
+	}
+}
+
 func TestServiceDefineServicePort(t *testing.T) {
 	testCases := []struct {
 		testPort       int32
@@ -8,6 +8,7 @@ import (
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/msg"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/klog/v2"
 	istiov2 "maistra.io/api/core/v2"
 	goclient "sigs.k8s.io/controller-runtime/pkg/client"
@@ -33,6 +34,8 @@ type ControlPlaneBuilder struct {
 
 // NewControlPlaneBuilder method creates new instance of builder.
 func NewControlPlaneBuilder(apiClient *clients.Settings, name, nsname string) *ControlPlaneBuilder {
+	nsname = apiClient.ResolveNamespace(nsname)
+
 	klog.V(100).Infof("Initializing new ControlPlaneBuilder structure with the following "+
 		"params: name: %s, namespace: %s", name, nsname)
 
@@ -334,6 +337,8 @@ func (builder *ControlPlaneBuilder) WithGatewaysEnablement(enablement bool) *Con
 
 // PullControlPlane retrieves an existing serviceMeshControlPlane object from the cluster.
 func PullControlPlane(apiClient *clients.Settings, name, nsname string) (*ControlPlaneBuilder, error) {
+	nsname = apiClient.ResolveNamespace(nsname)
+
 	klog.V(100).Infof(
 		"Pulling serviceMeshControlPlane object name %s in namespace: %s", name, nsname)
 
@@ -502,6 +507,14 @@ func (builder *ControlPlaneBuilder) Exists() bool {
 	return err == nil || !k8serrors.IsNotFound(err)
 }
 
+// GetServiceMeshControlPlaneGVR returns ServiceMeshControlPlane's GroupVersionResource
+// which could be used for Clean function.
+func GetServiceMeshControlPlaneGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group: "maistra.io", Version: "v2", Resource: "servicemeshcontrolplanes",
+	}
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *ControlPlaneBuilder) validate() (bool, error) {
@@ -7,6 +7,7 @@ import (
 
 	corev1 "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/wait"
 
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
@@ -38,6 +39,8 @@ type MemberRollBuilder struct {
 
 // NewMemberRollBuilder method creates new instance of builder.
 func NewMemberRollBuilder(apiClient *clients.Settings, name, nsname string) *MemberRollBuilder {
+	nsname = apiClient.ResolveNamespace(nsname)
+
 	klog.V(100).Infof("Initializing new serviceMeshMemberRollBuilder structure with the following "+
 		"params: name: %s, namespace: %s", name, nsname)
 
@@ -85,6 +88,8 @@ func NewMemberRollBuilder(apiClient *clients.Settings, name, nsname string) *Mem
 
 // PullMemberRoll retrieves an existing serviceMeshMemberRoll object from the cluster.
 func PullMemberRoll(apiClient *clients.Settings, name, nsname string) (*MemberRollBuilder, error) {
+	nsname = apiClient.ResolveNamespace(nsname)
+
 	klog.V(100).Infof(
 		"Pulling serviceMeshMemberRoll object name: %s in namespace: %s", name, nsname)
 
@@ -315,6 +320,14 @@ func (builder *MemberRollBuilder) IsReady(timeout time.Duration) (bool, error) {
 	return true, nil
 }
 
+// GetServiceMeshMemberRollGVR returns ServiceMeshMemberRoll's GroupVersionResource
+// which could be used for Clean function.
+func GetServiceMeshMemberRollGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group: "maistra.io", Version: "v1", Resource: "servicemeshmemberrolls",
+	}
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *MemberRollBuilder) validate() (bool, error) {
@@ -0,0 +1,185 @@
+// Package serviceprobe port-forwards to the pods backing operator services, such as metrics, webhook, catalog registry
+// and gRPC services, and checks their health and TLS configuration from the test host, returning typed results. The
+// port-forward goes through the API server, so the checks work without a route or access to the cluster network.
+package serviceprobe
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/certificate"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/pod"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/service"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/klog/v2"
+)
+
+// Forward is a port-forward from a local port to a port of a pod, which is closed using Close.
+type Forward struct {
+	// Address is the local address forwarded to the pod, such as localhost:43127.
+	Address string
+	// PodName is the name of the pod the local address is forwarded to.
+	PodName string
+	// Namespace is the namespace of the pod.
+	Namespace string
+	// PodPort is the port of the pod the local address is forwarded to.
+	PodPort int32
+	// ServerName is the name TLS certificates of the forwarded port are expected to be valid for. For services, it is
+	// the DNS name of the service, such as metrics.openshift-ptp.svc, and for pods it is empty.
+	ServerName string
+	// stop closes the port-forward.
+	stop func()
+}
+
+// ForwardService port-forwards a local port to the target port of the service port on a running and ready pod backing
+// the service. The port must be one of the ports of the service, such as 443 for webhook services, and named target
+// ports are resolved using the container ports of the pod.
+func ForwardService(apiClient *clients.Settings, name, nsname string, port int32) (*Forward, error) {
+	klog.V(100).Infof("Port-forwarding to port %d of service %s in namespace %s", port, name, nsname)
+
+	serviceBuilder, err := service.Pull(apiClient, name, nsname)
+	if err != nil {
+		return nil, fmt.Errorf("failed to pull service %s in namespace %s: %w", name, nsname, err)
+	}
+
+	servicePort, err := getServicePort(serviceBuilder.Object, port)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(serviceBuilder.Object.Spec.Selector) == 0 {
+		return nil, fmt.Errorf("service %s in namespace %s has no selector", name, nsname)
+	}
+
+	podBuilders, err := pod.List(apiClient, nsname, metav1.ListOptions{
+		LabelSelector: labels.SelectorFromSet(serviceBuilder.Object.Spec.Selector).String(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pods of service %s in namespace %s: %w", name, nsname, err)
+	}
+
+	podBuilder, err := getReadyPod(podBuilders)
+	if err != nil {
+		return nil, fmt.Errorf("service %s in namespace %s: %w", name, nsname, err)
+	}
+
+	podPort, err := resolveTargetPort(servicePort, podBuilder.Object)
+	if err != nil {
+		return nil, fmt.Errorf("service %s in namespace %s: %w", name, nsname, err)
+	}
+
+	forward, err := forwardPod(podBuilder, podPort)
+	if err != nil {
+		return nil, err
+	}
+
+	forward.ServerName = fmt.Sprintf("%s.%s.svc", name, nsname)
+
+	return forward, nil
+}
+
+// ForwardPod port-forwards a local port to the port of the pod.
+func ForwardPod(apiClient *clients.Settings, name, nsname string, port int32) (*Forward, error) {
+	klog.V(100).Infof("Port-forwarding to port %d of pod %s in namespace %s", port, name, nsname)
+
+	podBuilder, err := pod.Pull(apiClient, name, nsname)
+	if err != nil {
+		return nil, fmt.Errorf("failed to pull pod %s in namespace %s: %w", name, nsname, err)
+	}
+
+	return forwardPod(podBuilder, port)
+}
+
+// Close closes the port-forward. It is safe to call more than once.
+func (forward *Forward) Close() {
+	if forward == nil || forward.stop == nil {
+		return
+	}
+
+	klog.V(100).Infof("Closing port-forward %s to pod %s in namespace %s",
+		forward.Address, forward.PodName, forward.Namespace)
+
+	forward.stop()
+}
+
+// TLSEndpoint returns the forwarded port as a TLS endpoint, so it can be checked using certificate.InspectTLSEndpoint
+// and certificate.ValidateTLSProfile. Certificates are expected to be valid for the ServerName of the forward.
+func (forward *Forward) TLSEndpoint() *certificate.TLSEndpoint {
+	return &certificate.TLSEndpoint{Address: forward.Address, ServerName: forward.ServerName}
+}
+
+// forwardPod port-forwards a random local port to the port of the pod.
+func forwardPod(podBuilder *pod.Builder, port int32) (*Forward, error) {
+	address, stop, err := podBuilder.PortForward(0, int(port))
+	if err != nil {
+		return nil, fmt.Errorf("failed to port-forward to port %d of pod %s in namespace %s: %w",
+			port, podBuilder.Definition.Name, podBuilder.Definition.Namespace, err)
+	}
+
+	return &Forward{
+		Address:   address,
+		PodName:   podBuilder.Definition.Name,
+		Namespace: podBuilder.Definition.Namespace,
+		PodPort:   port,
+		stop:      stop,
+	}, nil
+}
+
+// getServicePort returns the port of the service with the port number.
+func getServicePort(serviceObject *corev1.Service, port int32) (*corev1.ServicePort, error) {
+	for _, servicePort := range serviceObject.Spec.Ports {
+		if servicePort.Port == port {
+			return &servicePort, nil
+		}
+	}
+
+	return nil, fmt.Errorf("service %s in namespace %s has no port %d",
+		serviceObject.Name, serviceObject.Namespace, port)
+}
+
+// getReadyPod returns the first pod by name that is running and ready, so repeated probes use the same pod.
+func getReadyPod(podBuilders []*pod.Builder) (*pod.Builder, error) {
+	slices.SortFunc(podBuilders, func(a, b *pod.Builder) int {
+		return strings.Compare(a.Object.Name, b.Object.Name)
+	})
+
+	for _, podBuilder := range podBuilders {
+		if podBuilder.Object.Status.Phase != corev1.PodRunning || podBuilder.Object.DeletionTimestamp != nil {
+			continue
+		}
+
+		for _, condition := range podBuilder.Object.Status.Conditions {
+			if condition.Type == corev1.PodReady && condition.Status == corev1.ConditionTrue {
+				return podBuilder, nil
+			}
+		}
+	}
+
+	return nil, fmt.Errorf("no running and ready pods among %d pods", len(podBuilders))
+}
+
+// resolveTargetPort returns the port of the pod traffic to the service port is sent to.
+func resolveTargetPort(servicePort *corev1.ServicePort, podObject *corev1.Pod) (int32, error) {
+	switch {
+	case servicePort.TargetPort.Type == intstr.Int && servicePort.TargetPort.IntVal != 0:
+		return servicePort.TargetPort.IntVal, nil
+	case servicePort.TargetPort.Type == intstr.String && servicePort.TargetPort.StrVal != "":
+		for _, container := range podObject.Spec.Containers {
+			for _, containerPort := range container.Ports {
+				if containerPort.Name == servicePort.TargetPort.StrVal {
+					return containerPort.ContainerPort, nil
+				}
+			}
+		}
+
+		return 0, fmt.Errorf("pod %s has no container port named %s", podObject.Name, servicePort.TargetPort.StrVal)
+	default:
+		// An unset target port defaults to the port of the service.
+		return servicePort.Port, nil
+	}
+}
@@ -0,0 +1,172 @@
+package serviceprobe
+
+import (
+	"testing"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+const (
+	defaultServiceName      = "ptp-event-publisher"
+	defaultServiceNamespace = "openshift-ptp"
+	defaultServicePort      = 9043
+)
+
+func TestForwardServiceErrors(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name          string
+		port          int32
+		objects       []runtime.Object
+		expectedError string
+	}{
+		{
+			name: "no service",
+			port: defaultServicePort,
+			expectedError: "failed to pull service ptp-event-publisher in namespace openshift-ptp: service object " +
+				"ptp-event-publisher does not exist in namespace openshift-ptp",
+		},
+		{
+			name:          "no port",
+			port:          443,
+			objects:       []runtime.Object{buildDummyService(intstr.FromInt32(defaultServicePort))},
+			expectedError: "service ptp-event-publisher in namespace openshift-ptp has no port 443",
+		},
+		{
+			name: "no ready pods",
+			port: defaultServicePort,
+			objects: []runtime.Object{
+				buildDummyService(intstr.FromInt32(defaultServicePort)),
+				buildDummyPod("publisher-0", corev1.PodPending, false),
+				buildDummyPod("publisher-1", corev1.PodRunning, false),
+			},
+			expectedError: "service ptp-event-publisher in namespace openshift-ptp: no running and ready pods among " +
+				"2 pods",
+		},
+		{
+			name: "unknown named port",
+			port: defaultServicePort,
+			objects: []runtime.Object{
+				buildDummyService(intstr.FromString("grpc")),
+				buildDummyPod("publisher-0", corev1.PodRunning, true),
+			},
+			expectedError: "service ptp-event-publisher in namespace openshift-ptp: pod publisher-0 has no container " +
+				"port named grpc",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			testSettings := clients.GetTestClients(clients.TestClientParams{
+				K8sMockObjects:  testCase.objects,
+				SchemeAttachers: []clients.SchemeAttacher{corev1.AddToScheme},
+			})
+
+			forward, err := ForwardService(testSettings, defaultServiceName, defaultServiceNamespace, testCase.port)
+			assert.Nil(t, forward)
+			assert.EqualError(t, err, testCase.expectedError)
+		})
+	}
+}
+
+func TestResolveTargetPort(t *testing.T) {
+	t.Parallel()
+
+	podObject := buildDummyPod("publisher-0", corev1.PodRunning, true)
+
+	testCases := []struct {
+		name         string
+		targetPort   intstr.IntOrString
+		expectedPort int32
+	}{
+		{
+			name:         "numeric target port",
+			targetPort:   intstr.FromInt32(8443),
+			expectedPort: 8443,
+		},
+		{
+			name:         "named target port",
+			targetPort:   intstr.FromString("metrics"),
+			expectedPort: 9091,
+		},
+		{
+			name:         "unset target port",
+			expectedPort: defaultServicePort,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			port, err := resolveTargetPort(
+				&corev1.ServicePort{Port: defaultServicePort, TargetPort: testCase.targetPort}, podObject)
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expectedPort, port)
+		})
+	}
+}
+
+func TestForwardTLSEndpoint(t *testing.T) {
+	t.Parallel()
+
+	forward := &Forward{Address: "localhost:43127", ServerName: "ptp-event-publisher.openshift-ptp.svc"}
+
+	endpoint := forward.TLSEndpoint()
+	assert.Equal(t, "localhost:43127", endpoint.Address)
+	assert.Equal(t, "ptp-event-publisher.openshift-ptp.svc", endpoint.ServerName)
+
+	// Closing a forward without a port-forward does nothing.
+	forward.Close()
+
+	var nilForward *Forward
+
+	nilForward.Close()
+}
+
+func buildDummyService(targetPort intstr.IntOrString) *corev1.Service {
+	return &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      defaultServiceName,
+			Namespace: defaultServiceNamespace,
+		},
+		Spec: corev1.ServiceSpec{
+			Selector: map[string]string{"app": defaultServiceName},
+			Ports:    []corev1.ServicePort{{Port: defaultServicePort, TargetPort: targetPort}},
+		},
+	}
+}
+
+func buildDummyPod(name string, phase corev1.PodPhase, ready bool) *corev1.Pod {
+	readyStatus := corev1.ConditionFalse
+	if ready {
+		readyStatus = corev1.ConditionTrue
+	}
+
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: defaultServiceNamespace,
+			Labels:    map[string]string{"app": defaultServiceName},
+		},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{{
+				Name:  "publisher",
+				Ports: []corev1.ContainerPort{{Name: "metrics", ContainerPort: 9091}},
+			}},
+		},
+		Status: corev1.PodStatus{
+			Phase:      phase,
+			Conditions: []corev1.PodCondition{{Type: corev1.PodReady, Status: readyStatus}},
+		},
+	}
+}
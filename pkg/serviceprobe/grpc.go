@@ -0,0 +1,249 @@
+package serviceprobe
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"k8s.io/klog/v2"
+)
+
+const (
+	// grpcHealthCheckPath is the path of the Check method of the standard gRPC health service, grpc.health.v1.Health.
+	grpcHealthCheckPath = "/grpc.health.v1.Health/Check"
+	// grpcFrameHeaderLength is the length of the header of gRPC messages: a compressed flag and the message length.
+	grpcFrameHeaderLength = 5
+	// grpcMaxResponseLength is the maximum length of the health check responses read, which only hold a status.
+	grpcMaxResponseLength = 1024
+)
+
+// GRPCHealthStatus is the serving status reported by the standard gRPC health service.
+type GRPCHealthStatus string
+
+const (
+	// GRPCHealthUnknown means the server did not report whether the service is serving.
+	GRPCHealthUnknown GRPCHealthStatus = "UNKNOWN"
+	// GRPCHealthServing means the service is serving requests.
+	GRPCHealthServing GRPCHealthStatus = "SERVING"
+	// GRPCHealthNotServing means the service is not serving requests.
+	GRPCHealthNotServing GRPCHealthStatus = "NOT_SERVING"
+	// GRPCHealthServiceUnknown means the server does not know the service. It is only reported by the Watch method,
+	// servers report unknown services of the Check method as a NOT_FOUND GRPCStatusError.
+	GRPCHealthServiceUnknown GRPCHealthStatus = "SERVICE_UNKNOWN"
+)
+
+// grpcHealthStatuses maps the values of the HealthCheckResponse.ServingStatus protobuf enum to statuses.
+var grpcHealthStatuses = map[uint64]GRPCHealthStatus{
+	0: GRPCHealthUnknown,
+	1: GRPCHealthServing,
+	2: GRPCHealthNotServing,
+	3: GRPCHealthServiceUnknown,
+}
+
+// grpcCodeNames are the names of the gRPC status codes.
+var grpcCodeNames = []string{
+	"OK", "CANCELLED", "UNKNOWN", "INVALID_ARGUMENT", "DEADLINE_EXCEEDED", "NOT_FOUND", "ALREADY_EXISTS",
+	"PERMISSION_DENIED", "RESOURCE_EXHAUSTED", "FAILED_PRECONDITION", "ABORTED", "OUT_OF_RANGE", "UNIMPLEMENTED",
+	"INTERNAL", "UNAVAILABLE", "DATA_LOSS", "UNAUTHENTICATED",
+}
+
+// GRPCHealthResult is the result of a gRPC health check.
+type GRPCHealthResult struct {
+	// Service is the checked service. It is empty when the overall health of the server was checked.
+	Service string
+	// Status is the serving status of the service.
+	Status GRPCHealthStatus
+	// Latency is how long the health check took, including connecting to the server.
+	Latency time.Duration
+}
+
+// Serving returns whether the service is serving requests.
+func (result *GRPCHealthResult) Serving() bool {
+	return result != nil && result.Status == GRPCHealthServing
+}
+
+// GRPCStatusError is returned when the server fails a gRPC call with a status other than OK, such as UNIMPLEMENTED when
+// it does not provide the health service or NOT_FOUND when it does not know the checked service.
+type GRPCStatusError struct {
+	// Code is the gRPC status code.
+	Code int
+	// Message is the status message sent by the server.
+	Message string
+}
+
+// CodeName returns the name of the status code, such as UNIMPLEMENTED.
+func (err *GRPCStatusError) CodeName() string {
+	if err.Code >= 0 && err.Code < len(grpcCodeNames) {
+		return grpcCodeNames[err.Code]
+	}
+
+	return strconv.Itoa(err.Code)
+}
+
+// Error returns the status code and message.
+func (err *GRPCStatusError) Error() string {
+	return fmt.Sprintf("grpc status %s: %s", err.CodeName(), err.Message)
+}
+
+// CheckGRPCHealth calls the Check method of the standard gRPC health service at the address, such as the Address of a
+// Forward, for the service. An empty service checks the overall health of the server. If the server fails the call,
+// the error is a *GRPCStatusError.
+func CheckGRPCHealth(address, service string, options Options) (*GRPCHealthResult, error) {
+	klog.V(100).Infof("Checking gRPC health of service %q at %s", service, address)
+
+	requestURL := fmt.Sprintf("%s://%s%s", options.getScheme(), address, grpcHealthCheckPath)
+
+	request, err := http.NewRequest(http.MethodPost, requestURL, bytes.NewReader(encodeGRPCHealthCheckRequest(service)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create grpc health check request for %s: %w", address, err)
+	}
+
+	request.Header = options.newRequestHeader()
+	request.Header.Set("Content-Type", "application/grpc")
+	request.Header.Set("TE", "trailers")
+
+	start := time.Now()
+
+	response, err := options.newHTTPClient(true).Do(request)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check grpc health at %s: %w", address, err)
+	}
+
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("grpc health check at %s returned HTTP status %s", address, response.Status)
+	}
+
+	body, err := io.ReadAll(io.LimitReader(response.Body, grpcMaxResponseLength))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read grpc health check response from %s: %w", address, err)
+	}
+
+	latency := time.Since(start)
+
+	// Servers failing a call without a response message send the status in the headers rather than the trailers.
+	grpcStatus := response.Trailer.Get("Grpc-Status")
+	grpcMessage := response.Trailer.Get("Grpc-Message")
+
+	if grpcStatus == "" {
+		grpcStatus = response.Header.Get("Grpc-Status")
+		grpcMessage = response.Header.Get("Grpc-Message")
+	}
+
+	if grpcStatus != "0" {
+		return nil, newGRPCStatusError(grpcStatus, grpcMessage)
+	}
+
+	status, err := decodeGRPCHealthCheckResponse(body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode grpc health check response from %s: %w", address, err)
+	}
+
+	return &GRPCHealthResult{Service: service, Status: status, Latency: latency}, nil
+}
+
+// newGRPCStatusError returns the error for the grpc-status and grpc-message of a failed call.
+func newGRPCStatusError(grpcStatus, grpcMessage string) error {
+	if grpcStatus == "" {
+		return fmt.Errorf("grpc health check response has no grpc-status")
+	}
+
+	code, err := strconv.Atoi(grpcStatus)
+	if err != nil {
+		return fmt.Errorf("grpc health check response has invalid grpc-status %q", grpcStatus)
+	}
+
+	// The message is percent-encoded, see the gRPC over HTTP/2 protocol.
+	message, err := url.PathUnescape(grpcMessage)
+	if err != nil {
+		message = grpcMessage
+	}
+
+	return &GRPCStatusError{Code: code, Message: message}
+}
+
+// encodeGRPCHealthCheckRequest returns the framed HealthCheckRequest message for the service, whose only field is the
+// service name with field number 1.
+func encodeGRPCHealthCheckRequest(service string) []byte {
+	var message []byte
+
+	if service != "" {
+		message = append(message, 0x0a)
+		message = binary.AppendUvarint(message, uint64(len(service)))
+		message = append(message, service...)
+	}
+
+	frame := make([]byte, grpcFrameHeaderLength, grpcFrameHeaderLength+len(message))
+	binary.BigEndian.PutUint32(frame[1:], uint32(len(message)))
+
+	return append(frame, message...)
+}
+
+// decodeGRPCHealthCheckResponse returns the status of the framed HealthCheckResponse message, whose only field is the
+// status enum with field number 1. Unknown fields are skipped.
+func decodeGRPCHealthCheckResponse(body []byte) (GRPCHealthStatus, error) {
+	if len(body) < grpcFrameHeaderLength {
+		return "", fmt.Errorf("response of %d bytes has no message", len(body))
+	}
+
+	if body[0] != 0 {
+		return "", fmt.Errorf("response message is compressed")
+	}
+
+	length := binary.BigEndian.Uint32(body[1:grpcFrameHeaderLength])
+	message := body[grpcFrameHeaderLength:]
+
+	if uint32(len(message)) != length {
+		return "", fmt.Errorf("response message has %d bytes, expected %d", len(message), length)
+	}
+
+	// A message without the status field has the default status, UNKNOWN.
+	var statusValue uint64
+
+	for len(message) > 0 {
+		tag, tagLength := binary.Uvarint(message)
+		if tagLength <= 0 {
+			return "", fmt.Errorf("response message has invalid field tag")
+		}
+
+		message = message[tagLength:]
+
+		fieldNumber, wireType := tag>>3, tag&0x7
+
+		switch wireType {
+		case 0:
+			value, valueLength := binary.Uvarint(message)
+			if valueLength <= 0 {
+				return "", fmt.Errorf("response message has invalid varint field %d", fieldNumber)
+			}
+
+			message = message[valueLength:]
+
+			if fieldNumber == 1 {
+				statusValue = value
+			}
+		case 2:
+			fieldLength, lengthLength := binary.Uvarint(message)
+			if lengthLength <= 0 || uint64(len(message)-lengthLength) < fieldLength {
+				return "", fmt.Errorf("response message has invalid length-delimited field %d", fieldNumber)
+			}
+
+			message = message[uint64(lengthLength)+fieldLength:]
+		default:
+			return "", fmt.Errorf("response message has field %d of unsupported wire type %d", fieldNumber, wireType)
+		}
+	}
+
+	status, ok := grpcHealthStatuses[statusValue]
+	if !ok {
+		return "", fmt.Errorf("response message has unknown status %d", statusValue)
+	}
+
+	return status, nil
+}
@@ -0,0 +1,198 @@
+package serviceprobe
+
+import (
+	"crypto/x509"
+	"encoding/binary"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const defaultGRPCService = "ptp-event-publisher"
+
+func TestCheckGRPCHealth(t *testing.T) {
+	t.Parallel()
+
+	address := startGRPCHealthServer(t, map[string]uint64{"": 1, defaultGRPCService: 2})
+
+	result, err := CheckGRPCHealth(address, "", Options{})
+	require.NoError(t, err)
+	assert.True(t, result.Serving())
+	assert.Empty(t, result.Service)
+	assert.Positive(t, result.Latency)
+
+	result, err = CheckGRPCHealth(address, defaultGRPCService, Options{})
+	require.NoError(t, err)
+	assert.False(t, result.Serving())
+	assert.Equal(t, GRPCHealthNotServing, result.Status)
+	assert.Equal(t, defaultGRPCService, result.Service)
+
+	_, err = CheckGRPCHealth(address, "unknown", Options{})
+
+	var statusErr *GRPCStatusError
+
+	require.ErrorAs(t, err, &statusErr)
+	assert.Equal(t, 5, statusErr.Code)
+	assert.EqualError(t, err, "grpc status NOT_FOUND: unknown service unknown")
+}
+
+func TestCheckGRPCHealthTLS(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewUnstartedServer(newGRPCHealthHandler(t, map[string]uint64{"": 1}))
+	server.EnableHTTP2 = true
+	server.StartTLS()
+	t.Cleanup(server.Close)
+
+	roots := x509.NewCertPool()
+	roots.AddCert(server.Certificate())
+
+	address := server.Listener.Addr().String()
+
+	result, err := CheckGRPCHealth(address, "", Options{TLS: true, RootCAs: roots, ServerName: "example.com"})
+	require.NoError(t, err)
+	assert.True(t, result.Serving())
+
+	_, err = CheckGRPCHealth(address, "", Options{TLS: true, ServerName: "example.com"})
+	assert.ErrorContains(t, err, "certificate signed by unknown authority")
+}
+
+func TestDecodeGRPCHealthCheckResponse(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name           string
+		body           []byte
+		expectedStatus GRPCHealthStatus
+		expectedError  string
+	}{
+		{
+			name:           "serving",
+			body:           frameGRPCMessage([]byte{0x08, 0x01}),
+			expectedStatus: GRPCHealthServing,
+		},
+		{
+			name:           "default status",
+			body:           frameGRPCMessage(nil),
+			expectedStatus: GRPCHealthUnknown,
+		},
+		{
+			name:           "unknown fields",
+			body:           frameGRPCMessage([]byte{0x12, 0x02, 'o', 'k', 0x08, 0x02}),
+			expectedStatus: GRPCHealthNotServing,
+		},
+		{
+			name:          "unknown status",
+			body:          frameGRPCMessage([]byte{0x08, 0x09}),
+			expectedError: "response message has unknown status 9",
+		},
+		{
+			name:          "compressed",
+			body:          append([]byte{1}, frameGRPCMessage(nil)[1:]...),
+			expectedError: "response message is compressed",
+		},
+		{
+			name:          "truncated",
+			body:          frameGRPCMessage([]byte{0x08, 0x01})[:6],
+			expectedError: "response message has 1 bytes, expected 2",
+		},
+		{
+			name:          "empty",
+			expectedError: "response of 0 bytes has no message",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			status, err := decodeGRPCHealthCheckResponse(testCase.body)
+			if testCase.expectedError != "" {
+				assert.EqualError(t, err, testCase.expectedError)
+
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.expectedStatus, status)
+		})
+	}
+}
+
+// startGRPCHealthServer starts a plain text HTTP/2 server implementing the Check method of the gRPC health service and
+// returns its address.
+func startGRPCHealthServer(t *testing.T, statuses map[string]uint64) string {
+	t.Helper()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	server := &http.Server{Handler: newGRPCHealthHandler(t, statuses), Protocols: &http.Protocols{}}
+	server.Protocols.SetUnencryptedHTTP2(true)
+
+	go func() {
+		err := server.Serve(listener)
+		if !errors.Is(err, http.ErrServerClosed) {
+			t.Errorf("grpc health server failed: %v", err)
+		}
+	}()
+
+	t.Cleanup(func() { _ = server.Close() })
+
+	return listener.Addr().String()
+}
+
+// newGRPCHealthHandler returns a handler reporting the status of each service, and NOT_FOUND for other services.
+func newGRPCHealthHandler(t *testing.T, statuses map[string]uint64) http.Handler {
+	t.Helper()
+
+	return http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		if request.URL.Path != grpcHealthCheckPath || request.Header.Get("Content-Type") != "application/grpc" {
+			writer.WriteHeader(http.StatusNotFound)
+
+			return
+		}
+
+		body, err := io.ReadAll(request.Body)
+		if err != nil || len(body) < grpcFrameHeaderLength {
+			writer.WriteHeader(http.StatusBadRequest)
+
+			return
+		}
+
+		// The request is either empty or holds only the service name, whose length fits in a single byte.
+		var service string
+		if len(body) > grpcFrameHeaderLength+2 {
+			service = string(body[grpcFrameHeaderLength+2:])
+		}
+
+		writer.Header().Set("Content-Type", "application/grpc")
+
+		status, ok := statuses[service]
+		if !ok {
+			writer.Header().Set("Grpc-Status", "5")
+			writer.Header().Set("Grpc-Message", "unknown%20service%20"+service)
+			writer.WriteHeader(http.StatusOK)
+
+			return
+		}
+
+		writer.Header().Set("Trailer", "Grpc-Status, Grpc-Message")
+		writer.WriteHeader(http.StatusOK)
+		_, _ = writer.Write(frameGRPCMessage(binary.AppendUvarint([]byte{0x08}, status)))
+		writer.Header().Set("Grpc-Status", "0")
+	})
+}
+
+func frameGRPCMessage(message []byte) []byte {
+	frame := make([]byte, grpcFrameHeaderLength)
+	binary.BigEndian.PutUint32(frame[1:], uint32(len(message)))
+
+	return append(frame, message...)
+}
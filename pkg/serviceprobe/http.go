@@ -0,0 +1,75 @@
+package serviceprobe
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"k8s.io/klog/v2"
+)
+
+// httpMaxBodyLength is the maximum length of the response bodies kept in HTTPResult, which is large enough for the
+// metrics of operators.
+const httpMaxBodyLength = 4 << 20
+
+// HTTPResult is the result of an HTTP probe.
+type HTTPResult struct {
+	// URL is the probed URL.
+	URL string
+	// StatusCode is the HTTP status code of the response.
+	StatusCode int
+	// Protocol is the protocol of the response, such as HTTP/2.0.
+	Protocol string
+	// Body is the body of the response, truncated to 4 MiB.
+	Body string
+	// Latency is how long the probe took, including connecting to the server and reading the body.
+	Latency time.Duration
+}
+
+// Healthy returns whether the response has a 2xx status code.
+func (result *HTTPResult) Healthy() bool {
+	return result != nil && result.StatusCode >= http.StatusOK && result.StatusCode < http.StatusMultipleChoices
+}
+
+// CheckHTTP sends a GET request for the path to the address, such as the Address of a Forward, and returns the
+// response. Responses with error status codes do not cause an error, use HTTPResult.Healthy to check them.
+func CheckHTTP(address, path string, options Options) (*HTTPResult, error) {
+	if !strings.HasPrefix(path, "/") {
+		path = "/" + path
+	}
+
+	requestURL := fmt.Sprintf("%s://%s%s", options.getScheme(), address, path)
+
+	klog.V(100).Infof("Probing %s", requestURL)
+
+	request, err := http.NewRequest(http.MethodGet, requestURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request for %s: %w", requestURL, err)
+	}
+
+	request.Header = options.newRequestHeader()
+
+	start := time.Now()
+
+	response, err := options.newHTTPClient(false).Do(request)
+	if err != nil {
+		return nil, fmt.Errorf("failed to probe %s: %w", requestURL, err)
+	}
+
+	defer response.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(response.Body, httpMaxBodyLength))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response from %s: %w", requestURL, err)
+	}
+
+	return &HTTPResult{
+		URL:        requestURL,
+		StatusCode: response.StatusCode,
+		Protocol:   response.Proto,
+		Body:       string(body),
+		Latency:    time.Since(start),
+	}, nil
+}
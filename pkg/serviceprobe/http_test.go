@@ -0,0 +1,60 @@
+package serviceprobe
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const defaultBearerToken = "sha256~token"
+
+func TestCheckHTTP(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewTLSServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		if request.Header.Get("Authorization") != "Bearer "+defaultBearerToken {
+			writer.WriteHeader(http.StatusUnauthorized)
+
+			return
+		}
+
+		switch request.URL.Path {
+		case "/metrics":
+			_, _ = writer.Write([]byte("openshift_ptp_clock_state 1\n"))
+		default:
+			writer.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	address := strings.TrimPrefix(server.URL, "https://")
+	options := Options{TLS: true, InsecureSkipVerify: true, BearerToken: defaultBearerToken}
+
+	result, err := CheckHTTP(address, "metrics", options)
+	require.NoError(t, err)
+	assert.True(t, result.Healthy())
+	assert.Equal(t, "https://"+address+"/metrics", result.URL)
+	assert.Equal(t, "openshift_ptp_clock_state 1\n", result.Body)
+
+	result, err = CheckHTTP(address, "/healthz", options)
+	require.NoError(t, err)
+	assert.False(t, result.Healthy())
+	assert.Equal(t, http.StatusNotFound, result.StatusCode)
+
+	result, err = CheckHTTP(address, "/metrics", Options{TLS: true, InsecureSkipVerify: true})
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusUnauthorized, result.StatusCode)
+
+	// Go TLS servers answer plain text requests with 400 Bad Request.
+	result, err = CheckHTTP(address, "/metrics", Options{})
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusBadRequest, result.StatusCode)
+
+	var nilResult *HTTPResult
+
+	assert.False(t, nilResult.Healthy())
+}
@@ -0,0 +1,85 @@
+package serviceprobe
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"net/http"
+	"time"
+)
+
+// defaultProbeTimeout is the timeout of probes when Options does not set one.
+const defaultProbeTimeout = 10 * time.Second
+
+// Options configures how probes connect to the address.
+type Options struct {
+	// TLS connects using TLS. Otherwise, plain text is used, with HTTP/2 without TLS for gRPC probes.
+	TLS bool
+	// RootCAs are used to verify the certificate of the server, such as the pool returned by certificate.GetCAPool
+	// for the service CA. If nil, the system roots are used.
+	RootCAs *x509.CertPool
+	// ServerName is sent using SNI and the certificate of the server is verified against it, such as the ServerName of
+	// a Forward to a service.
+	ServerName string
+	// InsecureSkipVerify skips the verification of the certificate of the server.
+	InsecureSkipVerify bool
+	// BearerToken is sent in the Authorization header, such as the token of the cluster client for metrics endpoints
+	// protected by kube-rbac-proxy.
+	BearerToken string
+	// Timeout is the timeout of the whole probe. If zero, a timeout of 10 seconds is used.
+	Timeout time.Duration
+}
+
+// getTimeout returns the timeout of the probe.
+func (options Options) getTimeout() time.Duration {
+	if options.Timeout > 0 {
+		return options.Timeout
+	}
+
+	return defaultProbeTimeout
+}
+
+// getScheme returns the URL scheme of the probe.
+func (options Options) getScheme() string {
+	if options.TLS {
+		return "https"
+	}
+
+	return "http"
+}
+
+// newHTTPClient returns a client connecting as configured by the options. If http2Only is true, the client only uses
+// HTTP/2, as required by gRPC.
+func (options Options) newHTTPClient(http2Only bool) *http.Client {
+	transport := &http.Transport{
+		Protocols: &http.Protocols{},
+		TLSClientConfig: &tls.Config{
+			RootCAs:            options.RootCAs,
+			ServerName:         options.ServerName,
+			InsecureSkipVerify: options.InsecureSkipVerify,
+		},
+		ForceAttemptHTTP2: true,
+	}
+
+	switch {
+	case http2Only && options.TLS:
+		transport.Protocols.SetHTTP2(true)
+	case http2Only:
+		transport.Protocols.SetUnencryptedHTTP2(true)
+	default:
+		transport.Protocols.SetHTTP1(true)
+		transport.Protocols.SetHTTP2(true)
+	}
+
+	return &http.Client{Transport: transport, Timeout: options.getTimeout()}
+}
+
+// newRequestHeader returns the headers common to all probes.
+func (options Options) newRequestHeader() http.Header {
+	header := http.Header{}
+
+	if options.BearerToken != "" {
+		header.Set("Authorization", "Bearer "+options.BearerToken)
+	}
+
+	return header
+}
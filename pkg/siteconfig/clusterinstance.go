@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net"
+	"slices"
 	"strings"
 	"time"
 
@@ -15,6 +16,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/klog/v2"
 
 	"k8s.io/apimachinery/pkg/util/wait"
@@ -36,6 +38,8 @@ type CIBuilder struct {
 
 // NewCIBuilder creates a new instance of CIBuilder.
 func NewCIBuilder(apiClient *clients.Settings, name, nsname string) *CIBuilder {
+	nsname = apiClient.ResolveNamespace(nsname)
+
 	klog.V(100).Infof(
 		"Initializing new ClusterInstance structure with the following params: name: %s, nsname: %s",
 		name, nsname)
@@ -84,6 +88,8 @@ func NewCIBuilder(apiClient *clients.Settings, name, nsname string) *CIBuilder {
 
 // PullClusterInstance retrieves an existing ClusterInstance from the cluster.
 func PullClusterInstance(apiClient *clients.Settings, name, nsname string) (*CIBuilder, error) {
+	nsname = apiClient.ResolveNamespace(nsname)
+
 	klog.V(100).Infof(
 		"Pulling existing clusterinstance with name %s from namespace %s", name, nsname)
 
@@ -471,6 +477,201 @@ func (builder *CIBuilder) WithExtraLabels(key string, labels map[string]string)
 	return builder
 }
 
+// WithClusterType sets the type of the cluster installed by the clusterinstance, such as SNO or HighlyAvailable.
+func (builder *CIBuilder) WithClusterType(clusterType siteconfigv1alpha1.ClusterType) *CIBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	klog.V(100).Infof("Setting clusterType %s on clusterinstance %s in namespace %s",
+		clusterType, builder.Definition.Name, builder.Definition.Namespace)
+
+	if !slices.Contains([]siteconfigv1alpha1.ClusterType{
+		siteconfigv1alpha1.ClusterTypeSNO,
+		siteconfigv1alpha1.ClusterTypeHighlyAvailable,
+		siteconfigv1alpha1.ClusterTypeHostedControlPlane,
+		siteconfigv1alpha1.ClusterTypeHighlyAvailableArbiter,
+	}, clusterType) {
+		klog.V(100).Infof("The clusterinstance clusterType %s is invalid", clusterType)
+
+		builder.errorMsg = "clusterinstance clusterType must be one of: " +
+			"SNO, HighlyAvailable, HostedControlPlane, HighlyAvailableArbiter"
+
+		return builder
+	}
+
+	builder.Definition.Spec.ClusterType = clusterType
+
+	return builder
+}
+
+// WithNetworkType sets the CNI of the cluster installed by the clusterinstance.
+func (builder *CIBuilder) WithNetworkType(networkType string) *CIBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	klog.V(100).Infof("Setting networkType %s on clusterinstance %s in namespace %s",
+		networkType, builder.Definition.Name, builder.Definition.Namespace)
+
+	if !slices.Contains([]string{"OVNKubernetes", "OpenShiftSDN"}, networkType) {
+		klog.V(100).Infof("The clusterinstance networkType %s is invalid", networkType)
+
+		builder.errorMsg = "clusterinstance networkType must be one of: OVNKubernetes, OpenShiftSDN"
+
+		return builder
+	}
+
+	builder.Definition.Spec.NetworkType = networkType
+
+	return builder
+}
+
+// WithClusterNetwork adds a clusterNetwork from which pod IPs are allocated to the clusterinstance. Each node is
+// assigned a subnet of the provided hostPrefix from the cidr.
+func (builder *CIBuilder) WithClusterNetwork(cidr string, hostPrefix int32) *CIBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	klog.V(100).Infof("Adding clusterNetwork %s with hostPrefix %d to clusterinstance %s in namespace %s",
+		cidr, hostPrefix, builder.Definition.Name, builder.Definition.Namespace)
+
+	_, ipNet, err := net.ParseCIDR(cidr)
+	if err != nil {
+		klog.V(100).Infof("The clusterinstance clusterNetwork cidr %s is invalid cidr", cidr)
+
+		builder.errorMsg = "clusterinstance contains invalid clusterNetwork cidr"
+
+		return builder
+	}
+
+	prefixLength, bits := ipNet.Mask.Size()
+	if hostPrefix < int32(prefixLength) || hostPrefix > int32(bits) {
+		klog.V(100).Infof("The clusterinstance clusterNetwork hostPrefix %d is out of range for cidr %s",
+			hostPrefix, cidr)
+
+		builder.errorMsg = "clusterinstance clusterNetwork hostPrefix must be within the cidr prefix length"
+
+		return builder
+	}
+
+	builder.Definition.Spec.ClusterNetwork =
+		append(builder.Definition.Spec.ClusterNetwork, siteconfigv1alpha1.ClusterNetworkEntry{
+			CIDR:       cidr,
+			HostPrefix: hostPrefix,
+		})
+
+	return builder
+}
+
+// WithServiceNetwork adds a serviceNetwork from which service IPs are allocated to the clusterinstance.
+func (builder *CIBuilder) WithServiceNetwork(cidr string) *CIBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	klog.V(100).Infof("Adding serviceNetwork %s to clusterinstance %s in namespace %s",
+		cidr, builder.Definition.Name, builder.Definition.Namespace)
+
+	if _, _, err := net.ParseCIDR(cidr); err != nil {
+		klog.V(100).Infof("The clusterinstance serviceNetwork cidr %s is invalid cidr", cidr)
+
+		builder.errorMsg = "clusterinstance contains invalid serviceNetwork cidr"
+
+		return builder
+	}
+
+	builder.Definition.Spec.ServiceNetwork =
+		append(builder.Definition.Spec.ServiceNetwork, siteconfigv1alpha1.ServiceNetworkEntry{
+			CIDR: cidr,
+		})
+
+	return builder
+}
+
+// WithAPIVIPs sets the virtual IPs used for the API of a multi-node clusterinstance. Up to one IPv4 and one IPv6
+// address may be provided for dual-stack clusters.
+func (builder *CIBuilder) WithAPIVIPs(vips ...string) *CIBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	klog.V(100).Infof("Setting apiVIPs %v on clusterinstance %s in namespace %s",
+		vips, builder.Definition.Name, builder.Definition.Namespace)
+
+	if errorMsg := validateVIPs("apiVIPs", vips); errorMsg != "" {
+		builder.errorMsg = errorMsg
+
+		return builder
+	}
+
+	builder.Definition.Spec.ApiVIPs = vips
+
+	return builder
+}
+
+// WithIngressVIPs sets the virtual IPs used for ingress of a multi-node clusterinstance. Up to one IPv4 and one IPv6
+// address may be provided for dual-stack clusters.
+func (builder *CIBuilder) WithIngressVIPs(vips ...string) *CIBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	klog.V(100).Infof("Setting ingressVIPs %v on clusterinstance %s in namespace %s",
+		vips, builder.Definition.Name, builder.Definition.Namespace)
+
+	if errorMsg := validateVIPs("ingressVIPs", vips); errorMsg != "" {
+		builder.errorMsg = errorMsg
+
+		return builder
+	}
+
+	builder.Definition.Spec.IngressVIPs = vips
+
+	return builder
+}
+
+// WithReinstall requests that the siteconfig operator reinstalls the cluster. A reinstall is triggered whenever the
+// generation differs from the one of the last reinstall, so a new generation must be provided for each request. The
+// preservationMode controls which data labeled for preservation is backed up and restored across the reinstall.
+func (builder *CIBuilder) WithReinstall(
+	generation string, preservationMode siteconfigv1alpha1.PreservationMode) *CIBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	klog.V(100).Infof("Requesting reinstall generation %s with preservationMode %s on clusterinstance %s in namespace %s",
+		generation, preservationMode, builder.Definition.Name, builder.Definition.Namespace)
+
+	if generation == "" {
+		klog.V(100).Info("The clusterinstance reinstall generation is empty")
+
+		builder.errorMsg = "clusterinstance reinstall generation cannot be empty"
+
+		return builder
+	}
+
+	if !slices.Contains([]siteconfigv1alpha1.PreservationMode{
+		siteconfigv1alpha1.PreservationModeNone,
+		siteconfigv1alpha1.PreservationModeAll,
+		siteconfigv1alpha1.PreservationModeClusterIdentity,
+	}, preservationMode) {
+		klog.V(100).Infof("The clusterinstance reinstall preservationMode %s is invalid", preservationMode)
+
+		builder.errorMsg = "clusterinstance reinstall preservationMode must be one of: None, All, ClusterIdentity"
+
+		return builder
+	}
+
+	builder.Definition.Spec.Reinstall = &siteconfigv1alpha1.ReinstallSpec{
+		Generation:       generation,
+		PreservationMode: preservationMode,
+	}
+
+	return builder
+}
+
 // WaitForCondition waits until the ClusterInstance
 // has a condition that matches the expected, checking only the Type, Status, Reason, and Message fields.
 // For the message field, it matches if the message contains the expected.
@@ -642,6 +843,106 @@ func (builder *CIBuilder) WaitForExtraLabel(kind, label string, timeout time.Dur
 	return builder, nil
 }
 
+// WaitForSpecChangeApplied waits up to timeout until the siteconfig operator has observed the latest generation of the
+// ClusterInstance and successfully applied the manifests rendered from it. It should be used after Update to wait for a
+// spec change to be rolled out.
+func (builder *CIBuilder) WaitForSpecChangeApplied(timeout time.Duration) (*CIBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	klog.V(100).Infof("Waiting up to %s until ClusterInstance %s in namespace %s has applied its latest spec",
+		timeout, builder.Definition.Name, builder.Definition.Namespace)
+
+	if !builder.Exists() {
+		klog.V(100).Info("The clusterinstance does not exist on the cluster")
+
+		return builder, fmt.Errorf(
+			"clusterinstance object %s does not exist in namespace %s", builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	err := wait.PollUntilContextTimeout(
+		context.TODO(), 3*time.Second, timeout, true, func(ctx context.Context) (bool, error) {
+			var err error
+
+			builder.Object, err = builder.Get()
+			if err != nil {
+				klog.V(100).Infof("Failed to get ClusterInstance %s in namespace %s: %v",
+					builder.Definition.Name, builder.Definition.Namespace, err)
+
+				return false, nil
+			}
+
+			builder.Definition = builder.Object
+
+			if builder.Object.Status.ObservedGeneration < builder.Object.Generation {
+				klog.V(100).Infof("ClusterInstance %s in namespace %s has observed generation %d of %d",
+					builder.Definition.Name, builder.Definition.Namespace,
+					builder.Object.Status.ObservedGeneration, builder.Object.Generation)
+
+				return false, nil
+			}
+
+			return hasConditionStatus(builder.Object.Status.Conditions,
+				string(siteconfigv1alpha1.RenderedTemplatesApplied), metav1.ConditionTrue), nil
+		})
+
+	return builder, err
+}
+
+// WaitForReinstallComplete waits up to timeout until the siteconfig operator has finished processing the reinstall
+// requested in the spec of the ClusterInstance, as set using WithReinstall. It returns an error if no reinstall is
+// requested. Waiting for the reinstalled cluster to be provisioned requires a separate call to WaitForCondition.
+func (builder *CIBuilder) WaitForReinstallComplete(timeout time.Duration) (*CIBuilder, error) {
+	if valid, err := builder.validate(); !valid {
+		return builder, err
+	}
+
+	klog.V(100).Infof("Waiting up to %s until ClusterInstance %s in namespace %s has completed its reinstall",
+		timeout, builder.Definition.Name, builder.Definition.Namespace)
+
+	if !builder.Exists() {
+		klog.V(100).Info("The clusterinstance does not exist on the cluster")
+
+		return builder, fmt.Errorf(
+			"clusterinstance object %s does not exist in namespace %s", builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	if builder.Object.Spec.Reinstall == nil || builder.Object.Spec.Reinstall.Generation == "" {
+		klog.V(100).Info("The clusterinstance does not request a reinstall")
+
+		return builder, fmt.Errorf("clusterinstance object %s in namespace %s does not request a reinstall",
+			builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	generation := builder.Object.Spec.Reinstall.Generation
+
+	err := wait.PollUntilContextTimeout(
+		context.TODO(), 3*time.Second, timeout, true, func(ctx context.Context) (bool, error) {
+			var err error
+
+			builder.Object, err = builder.Get()
+			if err != nil {
+				klog.V(100).Infof("Failed to get ClusterInstance %s in namespace %s: %v",
+					builder.Definition.Name, builder.Definition.Namespace, err)
+
+				return false, nil
+			}
+
+			builder.Definition = builder.Object
+
+			reinstallStatus := builder.Object.Status.Reinstall
+			if reinstallStatus == nil || reinstallStatus.ObservedGeneration != generation {
+				return false, nil
+			}
+
+			return hasConditionStatus(reinstallStatus.Conditions,
+				string(siteconfigv1alpha1.ReinstallRequestProcessed), metav1.ConditionTrue), nil
+		})
+
+	return builder, err
+}
+
 // Get fetches the defined ClusterInstance from the cluster.
 func (builder *CIBuilder) Get() (*siteconfigv1alpha1.ClusterInstance, error) {
 	if valid, err := builder.validate(); !valid {
@@ -767,6 +1068,13 @@ func (builder *CIBuilder) Exists() bool {
 	return err == nil || !k8serrors.IsNotFound(err)
 }
 
+// GetGVR returns ClusterInstance's GroupVersionResource which could be used for Clean function.
+func GetGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group: "siteconfig.open-cluster-management.io", Version: "v1alpha1", Resource: "clusterinstances",
+	}
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *CIBuilder) validate() (bool, error) {
@@ -798,3 +1106,47 @@ func (builder *CIBuilder) validate() (bool, error) {
 
 	return true, nil
 }
+
+// hasConditionStatus returns whether conditions contains a condition of conditionType with the provided status.
+func hasConditionStatus(conditions []metav1.Condition, conditionType string, status metav1.ConditionStatus) bool {
+	for _, condition := range conditions {
+		if condition.Type == conditionType {
+			return condition.Status == status
+		}
+	}
+
+	return false
+}
+
+// validateVIPs checks that vips contains one valid IP address or, for dual-stack, one IPv4 and one IPv6 address,
+// returning the error message if not.
+func validateVIPs(field string, vips []string) string {
+	if len(vips) == 0 || len(vips) > 2 {
+		klog.V(100).Infof("The clusterinstance %s must contain one or two addresses", field)
+
+		return fmt.Sprintf("clusterinstance %s must contain one or two addresses", field)
+	}
+
+	ipv4Count := 0
+
+	for _, vip := range vips {
+		ipAddress := net.ParseIP(vip)
+		if ipAddress == nil {
+			klog.V(100).Infof("The clusterinstance %s address %s is invalid", field, vip)
+
+			return fmt.Sprintf("clusterinstance %s contains invalid address %s", field, vip)
+		}
+
+		if ipAddress.To4() != nil {
+			ipv4Count++
+		}
+	}
+
+	if len(vips) == 2 && ipv4Count != 1 {
+		klog.V(100).Infof("The clusterinstance %s addresses %v are not dual-stack", field, vips)
+
+		return fmt.Sprintf("clusterinstance %s must contain one IPv4 and one IPv6 address", field)
+	}
+
+	return ""
+}
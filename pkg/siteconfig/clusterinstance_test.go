@@ -562,6 +562,228 @@ func TestClusterInstanceWithExtraLabels(t *testing.T) {
 	}
 }
 
+func TestClusterInstanceWithClusterType(t *testing.T) {
+	testCases := []struct {
+		clusterType      siteconfigv1alpha1.ClusterType
+		expectedErrorMsg string
+	}{
+		{
+			clusterType:      siteconfigv1alpha1.ClusterTypeSNO,
+			expectedErrorMsg: "",
+		},
+		{
+			clusterType:      siteconfigv1alpha1.ClusterTypeHighlyAvailableArbiter,
+			expectedErrorMsg: "",
+		},
+		{
+			clusterType: "Compact",
+			expectedErrorMsg: "clusterinstance clusterType must be one of: " +
+				"SNO, HighlyAvailable, HostedControlPlane, HighlyAvailableArbiter",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := generateClusterInstanceBuilderWithFakeObjects([]runtime.Object{})
+
+		testBuilder.WithClusterType(testCase.clusterType)
+		assert.Equal(t, testCase.expectedErrorMsg, testBuilder.errorMsg)
+
+		if testCase.expectedErrorMsg == "" {
+			assert.Equal(t, testCase.clusterType, testBuilder.Definition.Spec.ClusterType)
+		}
+	}
+}
+
+func TestClusterInstanceWithNetworkType(t *testing.T) {
+	testCases := []struct {
+		networkType      string
+		expectedErrorMsg string
+	}{
+		{
+			networkType:      "OVNKubernetes",
+			expectedErrorMsg: "",
+		},
+		{
+			networkType:      "Calico",
+			expectedErrorMsg: "clusterinstance networkType must be one of: OVNKubernetes, OpenShiftSDN",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := generateClusterInstanceBuilderWithFakeObjects([]runtime.Object{})
+
+		testBuilder.WithNetworkType(testCase.networkType)
+		assert.Equal(t, testCase.expectedErrorMsg, testBuilder.errorMsg)
+
+		if testCase.expectedErrorMsg == "" {
+			assert.Equal(t, testCase.networkType, testBuilder.Definition.Spec.NetworkType)
+		}
+	}
+}
+
+func TestClusterInstanceWithClusterNetwork(t *testing.T) {
+	testCases := []struct {
+		network          string
+		hostPrefix       int32
+		expectedErrorMsg string
+	}{
+		{
+			network:          "10.128.0.0/14",
+			hostPrefix:       23,
+			expectedErrorMsg: "",
+		},
+		{
+			network:          "fd01::/48",
+			hostPrefix:       64,
+			expectedErrorMsg: "",
+		},
+		{
+			network:          "10.128.0.0",
+			hostPrefix:       23,
+			expectedErrorMsg: "clusterinstance contains invalid clusterNetwork cidr",
+		},
+		{
+			network:          "10.128.0.0/14",
+			hostPrefix:       12,
+			expectedErrorMsg: "clusterinstance clusterNetwork hostPrefix must be within the cidr prefix length",
+		},
+		{
+			network:          "10.128.0.0/14",
+			hostPrefix:       33,
+			expectedErrorMsg: "clusterinstance clusterNetwork hostPrefix must be within the cidr prefix length",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := generateClusterInstanceBuilderWithFakeObjects([]runtime.Object{})
+
+		testBuilder.WithClusterNetwork(testCase.network, testCase.hostPrefix)
+		assert.Equal(t, testCase.expectedErrorMsg, testBuilder.errorMsg)
+
+		if testCase.expectedErrorMsg == "" {
+			assert.Equal(t, []siteconfigv1alpha1.ClusterNetworkEntry{{CIDR: testCase.network, HostPrefix: testCase.hostPrefix}},
+				testBuilder.Definition.Spec.ClusterNetwork)
+		}
+	}
+}
+
+func TestClusterInstanceWithServiceNetwork(t *testing.T) {
+	testCases := []struct {
+		network          string
+		expectedErrorMsg string
+	}{
+		{
+			network:          "172.30.0.0/16",
+			expectedErrorMsg: "",
+		},
+		{
+			network:          "172.30.0.0",
+			expectedErrorMsg: "clusterinstance contains invalid serviceNetwork cidr",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := generateClusterInstanceBuilderWithFakeObjects([]runtime.Object{})
+
+		testBuilder.WithServiceNetwork(testCase.network)
+		assert.Equal(t, testCase.expectedErrorMsg, testBuilder.errorMsg)
+
+		if testCase.expectedErrorMsg == "" {
+			assert.Equal(t, testCase.network, testBuilder.Definition.Spec.ServiceNetwork[0].CIDR)
+		}
+	}
+}
+
+func TestClusterInstanceWithVIPs(t *testing.T) {
+	testCases := []struct {
+		vips             []string
+		expectedErrorMsg string
+	}{
+		{
+			vips:             []string{"192.168.122.10"},
+			expectedErrorMsg: "",
+		},
+		{
+			vips:             []string{"192.168.122.10", "2001:db8::10"},
+			expectedErrorMsg: "",
+		},
+		{
+			vips:             []string{},
+			expectedErrorMsg: "clusterinstance %s must contain one or two addresses",
+		},
+		{
+			vips:             []string{"192.168.122.10", "2001:db8::10", "192.168.122.11"},
+			expectedErrorMsg: "clusterinstance %s must contain one or two addresses",
+		},
+		{
+			vips:             []string{"192.168.122"},
+			expectedErrorMsg: "clusterinstance %s contains invalid address 192.168.122",
+		},
+		{
+			vips:             []string{"192.168.122.10", "192.168.122.11"},
+			expectedErrorMsg: "clusterinstance %s must contain one IPv4 and one IPv6 address",
+		},
+	}
+
+	for _, testCase := range testCases {
+		apiBuilder := generateClusterInstanceBuilderWithFakeObjects([]runtime.Object{})
+		ingressBuilder := generateClusterInstanceBuilderWithFakeObjects([]runtime.Object{})
+
+		apiBuilder.WithAPIVIPs(testCase.vips...)
+		ingressBuilder.WithIngressVIPs(testCase.vips...)
+
+		if testCase.expectedErrorMsg != "" {
+			assert.Equal(t, fmt.Sprintf(testCase.expectedErrorMsg, "apiVIPs"), apiBuilder.errorMsg)
+			assert.Equal(t, fmt.Sprintf(testCase.expectedErrorMsg, "ingressVIPs"), ingressBuilder.errorMsg)
+
+			continue
+		}
+
+		assert.Empty(t, apiBuilder.errorMsg)
+		assert.Empty(t, ingressBuilder.errorMsg)
+		assert.Equal(t, testCase.vips, apiBuilder.Definition.Spec.ApiVIPs)
+		assert.Equal(t, testCase.vips, ingressBuilder.Definition.Spec.IngressVIPs)
+	}
+}
+
+func TestClusterInstanceWithReinstall(t *testing.T) {
+	testCases := []struct {
+		generation       string
+		preservationMode siteconfigv1alpha1.PreservationMode
+		expectedErrorMsg string
+	}{
+		{
+			generation:       "reinstall-1",
+			preservationMode: siteconfigv1alpha1.PreservationModeClusterIdentity,
+			expectedErrorMsg: "",
+		},
+		{
+			generation:       "",
+			preservationMode: siteconfigv1alpha1.PreservationModeNone,
+			expectedErrorMsg: "clusterinstance reinstall generation cannot be empty",
+		},
+		{
+			generation:       "reinstall-1",
+			preservationMode: "Some",
+			expectedErrorMsg: "clusterinstance reinstall preservationMode must be one of: None, All, ClusterIdentity",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := generateClusterInstanceBuilderWithFakeObjects([]runtime.Object{})
+
+		testBuilder.WithReinstall(testCase.generation, testCase.preservationMode)
+		assert.Equal(t, testCase.expectedErrorMsg, testBuilder.errorMsg)
+
+		if testCase.expectedErrorMsg == "" {
+			assert.Equal(t, &siteconfigv1alpha1.ReinstallSpec{
+				Generation:       testCase.generation,
+				PreservationMode: testCase.preservationMode,
+			}, testBuilder.Definition.Spec.Reinstall)
+		}
+	}
+}
+
 func TestClusterInstanceWaitForCondition(t *testing.T) {
 	testCases := []struct {
 		condition     metav1.Condition
@@ -772,6 +994,173 @@ func TestClusterInstanceWaitForExtraLabel(t *testing.T) {
 	}
 }
 
+func TestClusterInstanceWaitForSpecChangeApplied(t *testing.T) {
+	testCases := []struct {
+		exists             bool
+		observedGeneration int64
+		applied            bool
+		valid              bool
+		expectedError      error
+	}{
+		{
+			exists:             true,
+			observedGeneration: 2,
+			applied:            true,
+			valid:              true,
+			expectedError:      nil,
+		},
+		{
+			exists:             true,
+			observedGeneration: 1,
+			applied:            true,
+			valid:              true,
+			expectedError:      context.DeadlineExceeded,
+		},
+		{
+			exists:             true,
+			observedGeneration: 2,
+			applied:            false,
+			valid:              true,
+			expectedError:      context.DeadlineExceeded,
+		},
+		{
+			exists:             false,
+			observedGeneration: 2,
+			applied:            true,
+			valid:              true,
+			expectedError: fmt.Errorf("clusterinstance object %s does not exist in namespace %s",
+				testClusterInstance, testClusterInstance),
+		},
+		{
+			exists:             true,
+			observedGeneration: 2,
+			applied:            true,
+			valid:              false,
+			expectedError:      fmt.Errorf("clusterinstance 'nsname' cannot be empty"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		var (
+			runtimeObjects         []runtime.Object
+			clusterInstanceBuilder *CIBuilder
+		)
+
+		if testCase.exists {
+			clusterinstance := generateClusterInstance()
+			clusterinstance.Generation = 2
+			clusterinstance.Status.ObservedGeneration = testCase.observedGeneration
+
+			appliedStatus := metav1.ConditionFalse
+			if testCase.applied {
+				appliedStatus = metav1.ConditionTrue
+			}
+
+			clusterinstance.Status.Conditions = []metav1.Condition{{
+				Type:   string(siteconfigv1alpha1.RenderedTemplatesApplied),
+				Status: appliedStatus,
+			}}
+
+			runtimeObjects = append(runtimeObjects, clusterinstance)
+		}
+
+		testSettings := clients.GetTestClients(clients.TestClientParams{
+			K8sMockObjects:  runtimeObjects,
+			SchemeAttachers: testSchemes,
+		})
+
+		if testCase.valid {
+			clusterInstanceBuilder = buildValidClusterInstanceTestBuilder(testSettings)
+		} else {
+			clusterInstanceBuilder = buildInvalidClusterInstanceTestBuilder(testSettings)
+		}
+
+		_, err := clusterInstanceBuilder.WaitForSpecChangeApplied(time.Second)
+		assert.Equal(t, testCase.expectedError, err)
+	}
+}
+
+func TestClusterInstanceWaitForReinstallComplete(t *testing.T) {
+	testCases := []struct {
+		exists             bool
+		requested          bool
+		observedGeneration string
+		processed          bool
+		expectedError      error
+	}{
+		{
+			exists:             true,
+			requested:          true,
+			observedGeneration: "reinstall-2",
+			processed:          true,
+			expectedError:      nil,
+		},
+		{
+			exists:             true,
+			requested:          true,
+			observedGeneration: "reinstall-1",
+			processed:          true,
+			expectedError:      context.DeadlineExceeded,
+		},
+		{
+			exists:             true,
+			requested:          true,
+			observedGeneration: "reinstall-2",
+			processed:          false,
+			expectedError:      context.DeadlineExceeded,
+		},
+		{
+			exists:    true,
+			requested: false,
+			expectedError: fmt.Errorf("clusterinstance object %s in namespace %s does not request a reinstall",
+				testClusterInstance, testClusterInstance),
+		},
+		{
+			exists: false,
+			expectedError: fmt.Errorf("clusterinstance object %s does not exist in namespace %s",
+				testClusterInstance, testClusterInstance),
+		},
+	}
+
+	for _, testCase := range testCases {
+		var runtimeObjects []runtime.Object
+
+		if testCase.exists {
+			clusterinstance := generateClusterInstance()
+
+			if testCase.requested {
+				clusterinstance.Spec.Reinstall = &siteconfigv1alpha1.ReinstallSpec{
+					Generation:       "reinstall-2",
+					PreservationMode: siteconfigv1alpha1.PreservationModeNone,
+				}
+			}
+
+			processedStatus := metav1.ConditionFalse
+			if testCase.processed {
+				processedStatus = metav1.ConditionTrue
+			}
+
+			clusterinstance.Status.Reinstall = &siteconfigv1alpha1.ReinstallStatus{
+				ObservedGeneration: testCase.observedGeneration,
+				Conditions: []metav1.Condition{{
+					Type:   string(siteconfigv1alpha1.ReinstallRequestProcessed),
+					Status: processedStatus,
+				}},
+			}
+
+			runtimeObjects = append(runtimeObjects, clusterinstance)
+		}
+
+		testSettings := clients.GetTestClients(clients.TestClientParams{
+			K8sMockObjects:  runtimeObjects,
+			SchemeAttachers: testSchemes,
+		})
+
+		_, err := buildValidClusterInstanceTestBuilder(testSettings).WaitForReinstallComplete(time.Second)
+		assert.Equal(t, testCase.expectedError, err)
+	}
+}
+
 func TestClusterInstanceGet(t *testing.T) {
 	testCases := []struct {
 		exists bool
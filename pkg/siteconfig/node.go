@@ -2,6 +2,8 @@ package siteconfig
 
 import (
 	"fmt"
+	"net"
+	"net/netip"
 	"slices"
 
 	bmhv1alpha1 "github.com/metal3-io/baremetal-operator/apis/metal3.io/v1alpha1"
@@ -9,6 +11,7 @@ import (
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/schemes/assisted/api/v1beta1"
 	siteconfigv1alpha1 "github.com/rh-ecosystem-edge/eco-goinfra/pkg/schemes/siteconfig/v1alpha1"
 	"k8s.io/klog/v2"
+	"sigs.k8s.io/yaml"
 )
 
 const (
@@ -145,6 +148,101 @@ func (builder *NodeBuilder) WithNodeNetwork(networkConfig *v1beta1.NMStateConfig
 	return builder
 }
 
+// WithRole sets the role of the node in the installed cluster.
+func (builder *NodeBuilder) WithRole(role string) *NodeBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	klog.V(100).Infof("Setting role to %s on siteconfig node", role)
+
+	if !slices.Contains([]string{"master", "worker", "arbiter"}, role) {
+		builder.errorMsg = "siteconfig node role must be one of: master, worker, arbiter"
+
+		return builder
+	}
+
+	builder.definition.Role = role
+
+	return builder
+}
+
+// WithBootMode sets the boot mode of the node, which defaults to UEFI.
+func (builder *NodeBuilder) WithBootMode(bootMode bmhv1alpha1.BootMode) *NodeBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	klog.V(100).Infof("Setting bootMode to %s on siteconfig node", bootMode)
+
+	validBootModes := []bmhv1alpha1.BootMode{bmhv1alpha1.UEFI, bmhv1alpha1.UEFISecureBoot, bmhv1alpha1.Legacy}
+	if !slices.Contains(validBootModes, bootMode) {
+		builder.errorMsg = "siteconfig node bootMode must be one of: UEFI, UEFISecureBoot, legacy"
+
+		return builder
+	}
+
+	builder.definition.BootMode = bootMode
+
+	return builder
+}
+
+// WithRootDeviceHints sets the hints used to select the disk the node is installed on.
+func (builder *NodeBuilder) WithRootDeviceHints(rootDeviceHints *bmhv1alpha1.RootDeviceHints) *NodeBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	klog.V(100).Infof("Setting rootDeviceHints to %v on siteconfig node", rootDeviceHints)
+
+	if rootDeviceHints == nil {
+		klog.V(100).Info("The siteconfig node rootDeviceHints is nil")
+
+		builder.errorMsg = "siteconfig node rootDeviceHints cannot be nil"
+
+		return builder
+	}
+
+	builder.definition.RootDeviceHints = rootDeviceHints
+
+	return builder
+}
+
+// WithNodeLabels adds the provided labels to the node in the installed cluster.
+func (builder *NodeBuilder) WithNodeLabels(labels map[string]string) *NodeBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	klog.V(100).Infof("Adding nodeLabels %v to siteconfig node", labels)
+
+	if len(labels) == 0 {
+		klog.V(100).Info("The siteconfig node labels are empty")
+
+		builder.errorMsg = "siteconfig node labels cannot be empty"
+
+		return builder
+	}
+
+	if builder.definition.NodeLabels == nil {
+		builder.definition.NodeLabels = map[string]string{}
+	}
+
+	for key, value := range labels {
+		if key == "" {
+			klog.V(100).Info("The siteconfig node labels contain an empty key")
+
+			builder.errorMsg = "siteconfig node labels cannot have an empty key"
+
+			return builder
+		}
+
+		builder.definition.NodeLabels[key] = value
+	}
+
+	return builder
+}
+
 // Generate returns the NodeSpec struct from the NodeBuilder.
 func (builder *NodeBuilder) Generate() (*siteconfigv1alpha1.NodeSpec, error) {
 	if valid, err := builder.validate(); !valid {
@@ -181,3 +279,85 @@ func (builder *NodeBuilder) validate() (bool, error) {
 
 	return true, nil
 }
+
+// NewStaticIPNodeNetwork templates a node network configuration, for use with WithNodeNetwork, that statically assigns
+// the address in cidr, such as 192.168.122.100/24 or 2001:db8::100/64, to the interface with the provided name and MAC
+// address. A default route through gateway is added and dnsServers, if any, are used for name resolution. The other IP
+// family is disabled on the interface.
+func NewStaticIPNodeNetwork(
+	interfaceName, macAddress, cidr, gateway string, dnsServers ...string) (*v1beta1.NMStateConfigSpec, error) {
+	klog.V(100).Infof("Templating static IP node network for interface %s with MAC %s, address %s and gateway %s",
+		interfaceName, macAddress, cidr, gateway)
+
+	if interfaceName == "" {
+		return nil, fmt.Errorf("siteconfig node network 'interfaceName' cannot be empty")
+	}
+
+	if _, err := net.ParseMAC(macAddress); err != nil {
+		return nil, fmt.Errorf("siteconfig node network contains invalid macAddress %s", macAddress)
+	}
+
+	prefix, err := netip.ParsePrefix(cidr)
+	if err != nil {
+		return nil, fmt.Errorf("siteconfig node network contains invalid cidr %s", cidr)
+	}
+
+	gatewayAddress, err := netip.ParseAddr(gateway)
+	if err != nil || gatewayAddress.Is4() != prefix.Addr().Is4() {
+		return nil, fmt.Errorf("siteconfig node network gateway %s must be an address of the same family as %s",
+			gateway, cidr)
+	}
+
+	for _, dnsServer := range dnsServers {
+		if _, err := netip.ParseAddr(dnsServer); err != nil {
+			return nil, fmt.Errorf("siteconfig node network contains invalid dnsServer %s", dnsServer)
+		}
+	}
+
+	staticFamily, disabledFamily, defaultDestination := "ipv4", "ipv6", "0.0.0.0/0"
+	if prefix.Addr().Is6() {
+		staticFamily, disabledFamily, defaultDestination = "ipv6", "ipv4", "::/0"
+	}
+
+	staticConfig := map[string]any{
+		"enabled": true,
+		"dhcp":    false,
+		"address": []map[string]any{{"ip": prefix.Addr().String(), "prefix-length": prefix.Bits()}},
+	}
+
+	if staticFamily == "ipv6" {
+		staticConfig["autoconf"] = false
+	}
+
+	netConfig := map[string]any{
+		"interfaces": []map[string]any{{
+			"name":         interfaceName,
+			"type":         "ethernet",
+			"state":        "up",
+			staticFamily:   staticConfig,
+			disabledFamily: map[string]any{"enabled": false},
+		}},
+		"routes": map[string]any{
+			"config": []map[string]any{{
+				"destination":        defaultDestination,
+				"next-hop-address":   gateway,
+				"next-hop-interface": interfaceName,
+				"table-id":           254,
+			}},
+		},
+	}
+
+	if len(dnsServers) > 0 {
+		netConfig["dns-resolver"] = map[string]any{"config": map[string]any{"server": dnsServers}}
+	}
+
+	rawConfig, err := yaml.Marshal(netConfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal siteconfig node network: %w", err)
+	}
+
+	return &v1beta1.NMStateConfigSpec{
+		Interfaces: []*v1beta1.Interface{{Name: interfaceName, MacAddress: macAddress}},
+		NetConfig:  v1beta1.NetConfig{Raw: rawConfig},
+	}, nil
+}
@@ -3,6 +3,7 @@ package siteconfig
 import (
 	"testing"
 
+	bmhv1alpha1 "github.com/metal3-io/baremetal-operator/apis/metal3.io/v1alpha1"
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/schemes/assisted/api/v1beta1"
 	"github.com/stretchr/testify/assert"
 )
@@ -161,6 +162,122 @@ func TestClusterInstanceNodeWithNodeNetwork(t *testing.T) {
 	}
 }
 
+func TestClusterInstanceNodeWithRole(t *testing.T) {
+	testCases := []struct {
+		role              string
+		expectedErrorText string
+	}{
+		{
+			role:              "master",
+			expectedErrorText: "",
+		},
+		{
+			role:              "worker",
+			expectedErrorText: "",
+		},
+		{
+			role:              "infra",
+			expectedErrorText: "siteconfig node role must be one of: master, worker, arbiter",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := generateNodeBuilder()
+
+		testBuilder.WithRole(testCase.role)
+		assert.Equal(t, testCase.expectedErrorText, testBuilder.errorMsg)
+
+		if testCase.expectedErrorText == "" {
+			assert.Equal(t, testCase.role, testBuilder.definition.Role)
+		}
+	}
+}
+
+func TestClusterInstanceNodeWithBootMode(t *testing.T) {
+	testCases := []struct {
+		bootMode          bmhv1alpha1.BootMode
+		expectedErrorText string
+	}{
+		{
+			bootMode:          bmhv1alpha1.UEFISecureBoot,
+			expectedErrorText: "",
+		},
+		{
+			bootMode:          "BIOS",
+			expectedErrorText: "siteconfig node bootMode must be one of: UEFI, UEFISecureBoot, legacy",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := generateNodeBuilder()
+
+		testBuilder.WithBootMode(testCase.bootMode)
+		assert.Equal(t, testCase.expectedErrorText, testBuilder.errorMsg)
+
+		if testCase.expectedErrorText == "" {
+			assert.Equal(t, testCase.bootMode, testBuilder.definition.BootMode)
+		}
+	}
+}
+
+func TestClusterInstanceNodeWithRootDeviceHints(t *testing.T) {
+	testCases := []struct {
+		rootDeviceHints   *bmhv1alpha1.RootDeviceHints
+		expectedErrorText string
+	}{
+		{
+			rootDeviceHints:   &bmhv1alpha1.RootDeviceHints{DeviceName: "/dev/disk/by-path/pci-0000:88:00.0-nvme-1"},
+			expectedErrorText: "",
+		},
+		{
+			rootDeviceHints:   nil,
+			expectedErrorText: "siteconfig node rootDeviceHints cannot be nil",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := generateNodeBuilder()
+
+		testBuilder.WithRootDeviceHints(testCase.rootDeviceHints)
+		assert.Equal(t, testCase.expectedErrorText, testBuilder.errorMsg)
+
+		if testCase.expectedErrorText == "" {
+			assert.Equal(t, testCase.rootDeviceHints, testBuilder.definition.RootDeviceHints)
+		}
+	}
+}
+
+func TestClusterInstanceNodeWithNodeLabels(t *testing.T) {
+	testCases := []struct {
+		labels            map[string]string
+		expectedErrorText string
+	}{
+		{
+			labels:            map[string]string{"node-role.kubernetes.io/infra": ""},
+			expectedErrorText: "",
+		},
+		{
+			labels:            map[string]string{},
+			expectedErrorText: "siteconfig node labels cannot be empty",
+		},
+		{
+			labels:            map[string]string{"": "value"},
+			expectedErrorText: "siteconfig node labels cannot have an empty key",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := generateNodeBuilder()
+
+		testBuilder.WithNodeLabels(testCase.labels)
+		assert.Equal(t, testCase.expectedErrorText, testBuilder.errorMsg)
+
+		if testCase.expectedErrorText == "" {
+			assert.Equal(t, testCase.labels, testBuilder.definition.NodeLabels)
+		}
+	}
+}
+
 func TestClusterInstanceNodeGenerate(t *testing.T) {
 	testCases := []struct {
 		builder *NodeBuilder
@@ -177,6 +294,127 @@ func TestClusterInstanceNodeGenerate(t *testing.T) {
 	}
 }
 
+func TestNewStaticIPNodeNetwork(t *testing.T) {
+	testCases := []struct {
+		interfaceName     string
+		macAddress        string
+		cidr              string
+		gateway           string
+		dnsServers        []string
+		expectedConfig    string
+		expectedErrorText string
+	}{
+		{
+			interfaceName: "eno1",
+			macAddress:    "00:00:00:00:00:01",
+			cidr:          "192.168.122.100/24",
+			gateway:       "192.168.122.1",
+			dnsServers:    []string{"192.168.122.1"},
+			expectedConfig: `dns-resolver:
+  config:
+    server:
+    - 192.168.122.1
+interfaces:
+- ipv4:
+    address:
+    - ip: 192.168.122.100
+      prefix-length: 24
+    dhcp: false
+    enabled: true
+  ipv6:
+    enabled: false
+  name: eno1
+  state: up
+  type: ethernet
+routes:
+  config:
+  - destination: 0.0.0.0/0
+    next-hop-address: 192.168.122.1
+    next-hop-interface: eno1
+    table-id: 254
+`,
+		},
+		{
+			interfaceName: "eno1",
+			macAddress:    "00:00:00:00:00:01",
+			cidr:          "2001:db8::100/64",
+			gateway:       "2001:db8::1",
+			expectedConfig: `interfaces:
+- ipv4:
+    enabled: false
+  ipv6:
+    address:
+    - ip: 2001:db8::100
+      prefix-length: 64
+    autoconf: false
+    dhcp: false
+    enabled: true
+  name: eno1
+  state: up
+  type: ethernet
+routes:
+  config:
+  - destination: ::/0
+    next-hop-address: 2001:db8::1
+    next-hop-interface: eno1
+    table-id: 254
+`,
+		},
+		{
+			interfaceName:     "",
+			macAddress:        "00:00:00:00:00:01",
+			cidr:              "192.168.122.100/24",
+			gateway:           "192.168.122.1",
+			expectedErrorText: "siteconfig node network 'interfaceName' cannot be empty",
+		},
+		{
+			interfaceName:     "eno1",
+			macAddress:        "00:00:00:00:01",
+			cidr:              "192.168.122.100/24",
+			gateway:           "192.168.122.1",
+			expectedErrorText: "siteconfig node network contains invalid macAddress 00:00:00:00:01",
+		},
+		{
+			interfaceName:     "eno1",
+			macAddress:        "00:00:00:00:00:01",
+			cidr:              "192.168.122.100",
+			gateway:           "192.168.122.1",
+			expectedErrorText: "siteconfig node network contains invalid cidr 192.168.122.100",
+		},
+		{
+			interfaceName: "eno1",
+			macAddress:    "00:00:00:00:00:01",
+			cidr:          "192.168.122.100/24",
+			gateway:       "2001:db8::1",
+			expectedErrorText: "siteconfig node network gateway 2001:db8::1 must be an address of the same family as " +
+				"192.168.122.100/24",
+		},
+		{
+			interfaceName:     "eno1",
+			macAddress:        "00:00:00:00:00:01",
+			cidr:              "192.168.122.100/24",
+			gateway:           "192.168.122.1",
+			dnsServers:        []string{"dns.example.com"},
+			expectedErrorText: "siteconfig node network contains invalid dnsServer dns.example.com",
+		},
+	}
+
+	for _, testCase := range testCases {
+		networkConfig, err := NewStaticIPNodeNetwork(
+			testCase.interfaceName, testCase.macAddress, testCase.cidr, testCase.gateway, testCase.dnsServers...)
+		if testCase.expectedErrorText != "" {
+			assert.EqualError(t, err, testCase.expectedErrorText)
+
+			continue
+		}
+
+		assert.Nil(t, err)
+		assert.Equal(t, []*v1beta1.Interface{{Name: testCase.interfaceName, MacAddress: testCase.macAddress}},
+			networkConfig.Interfaces)
+		assert.Equal(t, testCase.expectedConfig, string(networkConfig.NetConfig.Raw))
+	}
+}
+
 func TestClusterInstanceNodeValidate(t *testing.T) {
 	testCases := []struct {
 		builderNil    bool
@@ -1,7 +1,9 @@
 package sriovfec
 
 import (
+	"context"
 	"fmt"
+	"time"
 
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/logging"
@@ -10,6 +12,7 @@ import (
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/klog/v2"
 	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -39,6 +42,8 @@ type ClusterAdditionalOptions func(builder *ClusterConfigBuilder) (*ClusterConfi
 func NewClusterConfigBuilder(
 	apiClient *clients.Settings,
 	name, nsname string) *ClusterConfigBuilder {
+	nsname = apiClient.ResolveNamespace(nsname)
+
 	klog.V(100).Infof(
 		"Initializing new SriovFecClusterConfig structure with the following params: %s, %s",
 		name, nsname)
@@ -87,6 +92,8 @@ func NewClusterConfigBuilder(
 
 // PullClusterConfig retrieves an existing SriovFecClusterConfig.io object from the cluster.
 func PullClusterConfig(apiClient *clients.Settings, name, nsname string) (*ClusterConfigBuilder, error) {
+	nsname = apiClient.ResolveNamespace(nsname)
+
 	klog.V(100).Infof(
 		"Pulling SriovFecClusterConfig.io object name: %s in namespace: %s", name, nsname)
 
@@ -299,6 +306,209 @@ func (builder *ClusterConfigBuilder) WithOptions(options ...ClusterAdditionalOpt
 	return builder
 }
 
+// WithNodeSelector sets the nodeSelector of the SriovFecClusterConfig, limiting the nodes it is applied to.
+func (builder *ClusterConfigBuilder) WithNodeSelector(nodeSelector map[string]string) *ClusterConfigBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	klog.V(100).Infof("Setting SriovFecClusterConfig %s in namespace %s nodeSelector to %v",
+		builder.Definition.Name, builder.Definition.Namespace, nodeSelector)
+
+	if len(nodeSelector) == 0 {
+		klog.V(100).Info("The nodeSelector of the SriovFecClusterConfig is empty")
+
+		builder.errorMsg = "SriovFecClusterConfig 'nodeSelector' cannot be empty"
+
+		return builder
+	}
+
+	builder.Definition.Spec.NodeSelector = nodeSelector
+
+	return builder
+}
+
+// WithAcceleratorSelector sets the acceleratorSelector of the SriovFecClusterConfig, limiting the accelerator cards it
+// is applied to. Empty fields of the selector match any accelerator.
+func (builder *ClusterConfigBuilder) WithAcceleratorSelector(
+	selector sriovfectypes.AcceleratorSelector) *ClusterConfigBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	klog.V(100).Infof("Setting SriovFecClusterConfig %s in namespace %s acceleratorSelector to %v",
+		builder.Definition.Name, builder.Definition.Namespace, selector)
+
+	if selector == (sriovfectypes.AcceleratorSelector{}) {
+		klog.V(100).Info("The acceleratorSelector of the SriovFecClusterConfig is empty")
+
+		builder.errorMsg = "SriovFecClusterConfig 'acceleratorSelector' cannot be empty"
+
+		return builder
+	}
+
+	builder.Definition.Spec.AcceleratorSelector = selector
+
+	return builder
+}
+
+// WithPhysicalFunction sets the drivers the physical and virtual functions of the accelerator are bound to and the
+// number of virtual functions to create. Any previously set bbDevConfig is kept and must have a matching number of VF
+// bundles.
+func (builder *ClusterConfigBuilder) WithPhysicalFunction(
+	pfDriver, vfDriver string, vfAmount int) *ClusterConfigBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	klog.V(100).Infof("Setting SriovFecClusterConfig %s in namespace %s physicalFunction to pfDriver %s, "+
+		"vfDriver %s and vfAmount %d", builder.Definition.Name, builder.Definition.Namespace, pfDriver, vfDriver, vfAmount)
+
+	if pfDriver == "" {
+		klog.V(100).Info("The pfDriver of the SriovFecClusterConfig is empty")
+
+		builder.errorMsg = "SriovFecClusterConfig 'pfDriver' cannot be empty"
+
+		return builder
+	}
+
+	if vfDriver == "" {
+		klog.V(100).Info("The vfDriver of the SriovFecClusterConfig is empty")
+
+		builder.errorMsg = "SriovFecClusterConfig 'vfDriver' cannot be empty"
+
+		return builder
+	}
+
+	if vfAmount <= 0 {
+		klog.V(100).Infof("The vfAmount of the SriovFecClusterConfig is not positive: %d", vfAmount)
+
+		builder.errorMsg = "SriovFecClusterConfig 'vfAmount' must be greater than 0"
+
+		return builder
+	}
+
+	if numVfBundles := builder.getNumVfBundles(); numVfBundles != 0 && numVfBundles != vfAmount {
+		klog.V(100).Infof("The vfAmount %d of the SriovFecClusterConfig does not match numVfBundles %d",
+			vfAmount, numVfBundles)
+
+		builder.errorMsg = fmt.Sprintf(
+			"SriovFecClusterConfig 'numVfBundles' %d must match 'vfAmount' %d", numVfBundles, vfAmount)
+
+		return builder
+	}
+
+	builder.Definition.Spec.PhysicalFunction.PFDriver = pfDriver
+	builder.Definition.Spec.PhysicalFunction.VFDriver = vfDriver
+	builder.Definition.Spec.PhysicalFunction.VFAmount = vfAmount
+
+	return builder
+}
+
+// WithACC100Config sets the bbDevConfig of the SriovFecClusterConfig to the provided ACC100 queue configuration,
+// replacing the configuration for any other card. The number of VF bundles must match the vfAmount if it is already
+// set and the total number of queue groups may not exceed what the card supports.
+func (builder *ClusterConfigBuilder) WithACC100Config(config sriovfectypes.ACC100BBDevConfig) *ClusterConfigBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	klog.V(100).Infof("Setting SriovFecClusterConfig %s in namespace %s ACC100 bbDevConfig",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if err := builder.validateBBDevConfig(&config, config.NumVfBundles); err != nil {
+		builder.errorMsg = err.Error()
+
+		return builder
+	}
+
+	builder.Definition.Spec.PhysicalFunction.BBDevConfig = sriovfectypes.BBDevConfig{ACC100: &config}
+
+	return builder
+}
+
+// WithACC200Config sets the bbDevConfig of the SriovFecClusterConfig to the provided ACC200 queue configuration,
+// replacing the configuration for any other card. The number of VF bundles must match the vfAmount if it is already
+// set and the total number of queue groups may not exceed what the card supports.
+func (builder *ClusterConfigBuilder) WithACC200Config(config sriovfectypes.ACC200BBDevConfig) *ClusterConfigBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	klog.V(100).Infof("Setting SriovFecClusterConfig %s in namespace %s ACC200 bbDevConfig",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if err := builder.validateBBDevConfig(&config, config.NumVfBundles); err != nil {
+		builder.errorMsg = err.Error()
+
+		return builder
+	}
+
+	builder.Definition.Spec.PhysicalFunction.BBDevConfig = sriovfectypes.BBDevConfig{ACC200: &config}
+
+	return builder
+}
+
+// WithPriority sets the priority of the SriovFecClusterConfig. When several configs match the same accelerator, the
+// one with the highest priority is applied.
+func (builder *ClusterConfigBuilder) WithPriority(priority int) *ClusterConfigBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	klog.V(100).Infof("Setting SriovFecClusterConfig %s in namespace %s priority to %d",
+		builder.Definition.Name, builder.Definition.Namespace, priority)
+
+	builder.Definition.Spec.Priority = priority
+
+	return builder
+}
+
+// WithDrainSkip sets whether the node is drained before the SriovFecClusterConfig is applied to it.
+func (builder *ClusterConfigBuilder) WithDrainSkip(drainSkip bool) *ClusterConfigBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	klog.V(100).Infof("Setting SriovFecClusterConfig %s in namespace %s drainSkip to %t",
+		builder.Definition.Name, builder.Definition.Namespace, drainSkip)
+
+	builder.Definition.Spec.DrainSkip = &drainSkip
+
+	return builder
+}
+
+// WaitForSyncSucceeded waits up to timeout for the SriovFecClusterConfig to report a syncStatus of Succeeded. It
+// returns early with the lastSyncError if the syncStatus becomes Failed.
+func (builder *ClusterConfigBuilder) WaitForSyncSucceeded(timeout time.Duration) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	klog.V(100).Infof("Waiting up to %s for SriovFecClusterConfig %s in namespace %s to sync successfully",
+		timeout, builder.Definition.Name, builder.Definition.Namespace)
+
+	return wait.PollUntilContextTimeout(
+		context.TODO(), time.Second, timeout, true, func(ctx context.Context) (bool, error) {
+			var err error
+
+			builder.Object, err = builder.Get()
+			if err != nil {
+				return false, nil
+			}
+
+			switch builder.Object.Status.SyncStatus {
+			case sriovfectypes.SucceededSync:
+				return true, nil
+			case sriovfectypes.FailedSync:
+				return false, fmt.Errorf("SriovFecClusterConfig %s in namespace %s failed to sync: %s",
+					builder.Definition.Name, builder.Definition.Namespace, builder.Object.Status.LastSyncError)
+			default:
+				return false, nil
+			}
+		})
+}
+
 // GetSriovFecClusterConfigIoGVR returns SriovFecClusterConfig's GroupVersionResource.
 func GetSriovFecClusterConfigIoGVR() schema.GroupVersionResource {
 	return schema.GroupVersionResource{
@@ -337,3 +547,38 @@ func (builder *ClusterConfigBuilder) validate() (bool, error) {
 
 	return true, nil
 }
+
+// validateBBDevConfig checks that the queue configuration of a card is valid and that its number of VF bundles matches
+// the vfAmount of the physical function, if set.
+func (builder *ClusterConfigBuilder) validateBBDevConfig(
+	config interface{ Validate() error }, numVfBundles int) error {
+	vfAmount := builder.Definition.Spec.PhysicalFunction.VFAmount
+	if vfAmount != 0 && vfAmount != numVfBundles {
+		klog.V(100).Infof("The numVfBundles %d of the SriovFecClusterConfig does not match vfAmount %d",
+			numVfBundles, vfAmount)
+
+		return fmt.Errorf("SriovFecClusterConfig 'numVfBundles' %d must match 'vfAmount' %d", numVfBundles, vfAmount)
+	}
+
+	if err := config.Validate(); err != nil {
+		klog.V(100).Infof("The bbDevConfig of the SriovFecClusterConfig is invalid: %v", err)
+
+		return fmt.Errorf("SriovFecClusterConfig 'bbDevConfig' is invalid: %w", err)
+	}
+
+	return nil
+}
+
+// getNumVfBundles returns the number of VF bundles in the currently set bbDevConfig, or 0 if none is set.
+func (builder *ClusterConfigBuilder) getNumVfBundles() int {
+	bbDevConfig := builder.Definition.Spec.PhysicalFunction.BBDevConfig
+
+	switch {
+	case bbDevConfig.ACC100 != nil:
+		return bbDevConfig.ACC100.NumVfBundles
+	case bbDevConfig.ACC200 != nil:
+		return bbDevConfig.ACC200.NumVfBundles
+	default:
+		return 0
+	}
+}
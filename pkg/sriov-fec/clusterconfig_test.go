@@ -1,8 +1,10 @@
 package sriovfec
 
 import (
+	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
 	sriovfectypes "github.com/rh-ecosystem-edge/eco-goinfra/pkg/schemes/fec/fectypes"
@@ -333,6 +335,259 @@ func TestFecClusterConfigWithOptions(t *testing.T) {
 	}
 }
 
+func TestFecClusterConfigWithNodeSelector(t *testing.T) {
+	testCases := []struct {
+		nodeSelector  map[string]string
+		expectedError string
+	}{
+		{
+			nodeSelector:  map[string]string{"kubernetes.io/hostname": "worker-0"},
+			expectedError: "",
+		},
+		{
+			nodeSelector:  nil,
+			expectedError: "SriovFecClusterConfig 'nodeSelector' cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidClusterConfigBuilder(buildTestClientWithDummyClusterConfig()).
+			WithNodeSelector(testCase.nodeSelector)
+		assert.Equal(t, testCase.expectedError, testBuilder.errorMsg)
+
+		if testCase.expectedError == "" {
+			assert.Equal(t, testCase.nodeSelector, testBuilder.Definition.Spec.NodeSelector)
+		}
+	}
+}
+
+func TestFecClusterConfigWithAcceleratorSelector(t *testing.T) {
+	testCases := []struct {
+		selector      sriovfectypes.AcceleratorSelector
+		expectedError string
+	}{
+		{
+			selector:      sriovfectypes.AcceleratorSelector{PCIAddress: "0000:17:00.0"},
+			expectedError: "",
+		},
+		{
+			selector:      sriovfectypes.AcceleratorSelector{},
+			expectedError: "SriovFecClusterConfig 'acceleratorSelector' cannot be empty",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidClusterConfigBuilder(buildTestClientWithDummyClusterConfig()).
+			WithAcceleratorSelector(testCase.selector)
+		assert.Equal(t, testCase.expectedError, testBuilder.errorMsg)
+
+		if testCase.expectedError == "" {
+			assert.Equal(t, testCase.selector, testBuilder.Definition.Spec.AcceleratorSelector)
+		}
+	}
+}
+
+func TestFecClusterConfigWithPhysicalFunction(t *testing.T) {
+	testCases := []struct {
+		pfDriver      string
+		vfDriver      string
+		vfAmount      int
+		numVfBundles  int
+		expectedError string
+	}{
+		{
+			pfDriver:      "vfio-pci",
+			vfDriver:      "vfio-pci",
+			vfAmount:      2,
+			expectedError: "",
+		},
+		{
+			pfDriver:      "vfio-pci",
+			vfDriver:      "vfio-pci",
+			vfAmount:      2,
+			numVfBundles:  2,
+			expectedError: "",
+		},
+		{
+			pfDriver:      "",
+			vfDriver:      "vfio-pci",
+			vfAmount:      2,
+			expectedError: "SriovFecClusterConfig 'pfDriver' cannot be empty",
+		},
+		{
+			pfDriver:      "vfio-pci",
+			vfDriver:      "",
+			vfAmount:      2,
+			expectedError: "SriovFecClusterConfig 'vfDriver' cannot be empty",
+		},
+		{
+			pfDriver:      "vfio-pci",
+			vfDriver:      "vfio-pci",
+			vfAmount:      0,
+			expectedError: "SriovFecClusterConfig 'vfAmount' must be greater than 0",
+		},
+		{
+			pfDriver:      "vfio-pci",
+			vfDriver:      "vfio-pci",
+			vfAmount:      2,
+			numVfBundles:  4,
+			expectedError: "SriovFecClusterConfig 'numVfBundles' 4 must match 'vfAmount' 2",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidClusterConfigBuilder(buildTestClientWithDummyClusterConfig())
+
+		if testCase.numVfBundles != 0 {
+			testBuilder = testBuilder.WithACC100Config(sriovfectypes.ACC100BBDevConfig{NumVfBundles: testCase.numVfBundles})
+		}
+
+		testBuilder = testBuilder.WithPhysicalFunction(testCase.pfDriver, testCase.vfDriver, testCase.vfAmount)
+		assert.Equal(t, testCase.expectedError, testBuilder.errorMsg)
+
+		if testCase.expectedError == "" {
+			assert.Equal(t, testCase.pfDriver, testBuilder.Definition.Spec.PhysicalFunction.PFDriver)
+			assert.Equal(t, testCase.vfDriver, testBuilder.Definition.Spec.PhysicalFunction.VFDriver)
+			assert.Equal(t, testCase.vfAmount, testBuilder.Definition.Spec.PhysicalFunction.VFAmount)
+		}
+	}
+}
+
+func TestFecClusterConfigWithACC100Config(t *testing.T) {
+	testCases := []struct {
+		config        sriovfectypes.ACC100BBDevConfig
+		expectedError string
+	}{
+		{
+			config:        buildDummyACC100Config(2, 2),
+			expectedError: "",
+		},
+		{
+			config:        buildDummyACC100Config(4, 2),
+			expectedError: "SriovFecClusterConfig 'numVfBundles' 4 must match 'vfAmount' 2",
+		},
+		{
+			config: buildDummyACC100Config(2, 3),
+			expectedError: "SriovFecClusterConfig 'bbDevConfig' is invalid: " +
+				"total number of requested queue groups (4G/5G) 12 exceeds the maximum (8)",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidClusterConfigBuilder(buildTestClientWithDummyClusterConfig()).
+			WithPhysicalFunction("vfio-pci", "vfio-pci", 2).
+			WithACC100Config(testCase.config)
+		assert.Equal(t, testCase.expectedError, testBuilder.errorMsg)
+
+		if testCase.expectedError == "" {
+			assert.Equal(t, &testCase.config, testBuilder.Definition.Spec.PhysicalFunction.BBDevConfig.ACC100)
+			assert.Nil(t, testBuilder.Definition.Spec.PhysicalFunction.BBDevConfig.ACC200)
+		}
+	}
+}
+
+func TestFecClusterConfigWithACC200Config(t *testing.T) {
+	testCases := []struct {
+		config        sriovfectypes.ACC200BBDevConfig
+		expectedError string
+	}{
+		{
+			config: sriovfectypes.ACC200BBDevConfig{
+				ACC100BBDevConfig: buildDummyACC100Config(2, 2),
+				QFFT:              sriovfectypes.QueueGroupConfig{NumQueueGroups: 4},
+			},
+			expectedError: "",
+		},
+		{
+			config: sriovfectypes.ACC200BBDevConfig{
+				ACC100BBDevConfig: buildDummyACC100Config(2, 4),
+				QFFT:              sriovfectypes.QueueGroupConfig{NumQueueGroups: 4},
+			},
+			expectedError: "SriovFecClusterConfig 'bbDevConfig' is invalid: " +
+				"total number of requested queue groups (4G/5G/QFFT) 20 exceeds the maximum (16)",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testBuilder := buildValidClusterConfigBuilder(buildTestClientWithDummyClusterConfig()).
+			WithACC100Config(buildDummyACC100Config(2, 1)).
+			WithACC200Config(testCase.config)
+		assert.Equal(t, testCase.expectedError, testBuilder.errorMsg)
+
+		if testCase.expectedError == "" {
+			assert.Equal(t, &testCase.config, testBuilder.Definition.Spec.PhysicalFunction.BBDevConfig.ACC200)
+			assert.Nil(t, testBuilder.Definition.Spec.PhysicalFunction.BBDevConfig.ACC100)
+		}
+	}
+}
+
+func TestFecClusterConfigWithPriorityAndDrainSkip(t *testing.T) {
+	testBuilder := buildValidClusterConfigBuilder(buildTestClientWithDummyClusterConfig()).
+		WithPriority(5).
+		WithDrainSkip(true)
+	assert.Empty(t, testBuilder.errorMsg)
+	assert.Equal(t, 5, testBuilder.Definition.Spec.Priority)
+	assert.NotNil(t, testBuilder.Definition.Spec.DrainSkip)
+	assert.True(t, *testBuilder.Definition.Spec.DrainSkip)
+
+	testBuilder = buildInvalidClusterConfigBuilder(buildTestClientWithDummyClusterConfig()).WithPriority(5)
+	assert.Equal(t, errEmptyFecClusterConfigNsname, testBuilder.errorMsg)
+	assert.Equal(t, 0, testBuilder.Definition.Spec.Priority)
+}
+
+func TestFecClusterConfigWaitForSyncSucceeded(t *testing.T) {
+	testCases := []struct {
+		syncStatus    sriovfectypes.SyncStatus
+		exists        bool
+		expectedError error
+	}{
+		{
+			syncStatus:    sriovfectypes.SucceededSync,
+			exists:        true,
+			expectedError: nil,
+		},
+		{
+			syncStatus: sriovfectypes.FailedSync,
+			exists:     true,
+			expectedError: fmt.Errorf("SriovFecClusterConfig %s in namespace %s failed to sync: bbdev config failed",
+				defaultClusterConfigName, defaultClusterConfigNamespace),
+		},
+		{
+			syncStatus:    sriovfectypes.InProgressSync,
+			exists:        true,
+			expectedError: context.DeadlineExceeded,
+		},
+		{
+			syncStatus:    sriovfectypes.SucceededSync,
+			exists:        false,
+			expectedError: context.DeadlineExceeded,
+		},
+	}
+
+	for _, testCase := range testCases {
+		var runtimeObjects []runtime.Object
+
+		if testCase.exists {
+			clusterConfig := buildDummyClusterConfig(defaultClusterConfigName, defaultClusterConfigNamespace)
+			clusterConfig.Status.SyncStatus = testCase.syncStatus
+
+			if testCase.syncStatus == sriovfectypes.FailedSync {
+				clusterConfig.Status.LastSyncError = "bbdev config failed"
+			}
+
+			runtimeObjects = append(runtimeObjects, clusterConfig)
+		}
+
+		testSettings := clients.GetTestClients(clients.TestClientParams{
+			K8sMockObjects:  runtimeObjects,
+			SchemeAttachers: testSchemes,
+		})
+
+		err := buildValidClusterConfigBuilder(testSettings).WaitForSyncSucceeded(time.Second)
+		assert.Equal(t, testCase.expectedError, err)
+	}
+}
+
 func TestFecClusterConfigGetGVR(t *testing.T) {
 	gvr := GetSriovFecClusterConfigIoGVR()
 	assert.Equal(t, APIGroup, gvr.Group)
@@ -373,3 +628,22 @@ func buildValidClusterConfigBuilder(apiClient *clients.Settings) *ClusterConfigB
 func buildInvalidClusterConfigBuilder(apiClient *clients.Settings) *ClusterConfigBuilder {
 	return NewClusterConfigBuilder(apiClient, defaultClusterConfigName, "")
 }
+
+// buildDummyACC100Config returns an ACC100BBDevConfig with the provided number of VF bundles and the same number of
+// queue groups in each direction.
+func buildDummyACC100Config(numVfBundles, numQueueGroups int) sriovfectypes.ACC100BBDevConfig {
+	queueGroupConfig := sriovfectypes.QueueGroupConfig{
+		NumQueueGroups:  numQueueGroups,
+		NumAqsPerGroups: 16,
+		AqDepthLog2:     4,
+	}
+
+	return sriovfectypes.ACC100BBDevConfig{
+		NumVfBundles: numVfBundles,
+		MaxQueueSize: 1024,
+		Uplink4G:     queueGroupConfig,
+		Downlink4G:   queueGroupConfig,
+		Uplink5G:     queueGroupConfig,
+		Downlink5G:   queueGroupConfig,
+	}
+}
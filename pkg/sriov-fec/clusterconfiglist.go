@@ -15,6 +15,8 @@ func ListClusterConfig(
 	apiClient *clients.Settings,
 	nsname string,
 	options ...client.ListOptions) ([]*ClusterConfigBuilder, error) {
+	nsname = apiClient.ResolveNamespace(nsname)
+
 	if apiClient == nil {
 		klog.V(100).Info("SriovFecClusterConfigList 'apiClient' parameter can not be empty")
 
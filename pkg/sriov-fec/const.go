@@ -9,4 +9,6 @@ const (
 	NodeConfigsResource = "sriovfecnodeconfigs"
 	// ClusterConfigsResource represents sriovfecclusterconfigs resource.
 	ClusterConfigsResource = "sriovfecclusterconfigs"
+	// ConfiguredCondition is the type of the sriovfecnodeconfig condition reporting the sync status of the node.
+	ConfiguredCondition = "Configured"
 )
@@ -4,13 +4,16 @@ import (
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 
+	"context"
 	"fmt"
+	"time"
 
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/logging"
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/msg"
 	sriovfectypes "github.com/rh-ecosystem-edge/eco-goinfra/pkg/schemes/fec/fectypes"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/klog/v2"
 	runtimeclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -41,6 +44,8 @@ func NewNodeConfigBuilder(
 	apiClient *clients.Settings,
 	name, nsname string,
 	label map[string]string) *NodeConfigBuilder {
+	nsname = apiClient.ResolveNamespace(nsname)
+
 	klog.V(100).Infof(
 		"Initializing new SriovFecNodeConfig structure with the following params: %s, %s, %v",
 		name, nsname, label)
@@ -89,6 +94,8 @@ func NewNodeConfigBuilder(
 
 // Pull retrieves an existing SriovFecNodeConfig.io object from the cluster.
 func Pull(apiClient *clients.Settings, name, nsname string) (*NodeConfigBuilder, error) {
+	nsname = apiClient.ResolveNamespace(nsname)
+
 	klog.V(100).Infof(
 		"Pulling SriovFecNodeConfig.io object name: %s in namespace: %s", name, nsname)
 
@@ -301,6 +308,42 @@ func (builder *NodeConfigBuilder) WithOptions(options ...AdditionalOptions) *Nod
 	return builder
 }
 
+// WaitForSyncSucceeded waits up to timeout for the Configured condition of the SriovFecNodeConfig to report a reason
+// of Succeeded. It returns early with the condition message if the reason becomes Failed.
+func (builder *NodeConfigBuilder) WaitForSyncSucceeded(timeout time.Duration) error {
+	if valid, err := builder.validate(); !valid {
+		return err
+	}
+
+	klog.V(100).Infof("Waiting up to %s for SriovFecNodeConfig %s in namespace %s to sync successfully",
+		timeout, builder.Definition.Name, builder.Definition.Namespace)
+
+	return wait.PollUntilContextTimeout(
+		context.TODO(), time.Second, timeout, true, func(ctx context.Context) (bool, error) {
+			var err error
+
+			builder.Object, err = builder.Get()
+			if err != nil {
+				return false, nil
+			}
+
+			condition := builder.Object.FindCondition(ConfiguredCondition)
+			if condition == nil {
+				return false, nil
+			}
+
+			switch sriovfectypes.SyncStatus(condition.Reason) {
+			case sriovfectypes.SucceededSync:
+				return true, nil
+			case sriovfectypes.FailedSync:
+				return false, fmt.Errorf("SriovFecNodeConfig %s in namespace %s failed to sync: %s",
+					builder.Definition.Name, builder.Definition.Namespace, condition.Message)
+			default:
+				return false, nil
+			}
+		})
+}
+
 // GetSriovFecNodeConfigIoGVR returns SriovFecNodeConfig's GroupVersionResource which could be used for Clean function.
 func GetSriovFecNodeConfigIoGVR() schema.GroupVersionResource {
 	return schema.GroupVersionResource{
@@ -1,8 +1,10 @@
 package sriovfec
 
 import (
+	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
 	sriovfectypes "github.com/rh-ecosystem-edge/eco-goinfra/pkg/schemes/fec/fectypes"
@@ -339,6 +341,51 @@ func TestFecNodeConfigWithOptions(t *testing.T) {
 	}
 }
 
+func TestFecNodeConfigWaitForSyncSucceeded(t *testing.T) {
+	testCases := []struct {
+		condition     *metav1.Condition
+		expectedError error
+	}{
+		{
+			condition:     &metav1.Condition{Type: ConfiguredCondition, Reason: string(sriovfectypes.SucceededSync)},
+			expectedError: nil,
+		},
+		{
+			condition: &metav1.Condition{
+				Type:    ConfiguredCondition,
+				Reason:  string(sriovfectypes.FailedSync),
+				Message: "failed to configure accelerator",
+			},
+			expectedError: fmt.Errorf("SriovFecNodeConfig %s in namespace %s failed to sync: "+
+				"failed to configure accelerator", defaultNodeConfigName, defaultNodeConfigNamespace),
+		},
+		{
+			condition:     &metav1.Condition{Type: ConfiguredCondition, Reason: string(sriovfectypes.InProgressSync)},
+			expectedError: context.DeadlineExceeded,
+		},
+		{
+			condition:     nil,
+			expectedError: context.DeadlineExceeded,
+		},
+	}
+
+	for _, testCase := range testCases {
+		nodeConfig := buildDummyNodeConfig(defaultNodeConfigName, defaultNodeConfigNamespace)
+
+		if testCase.condition != nil {
+			nodeConfig.Status.Conditions = []metav1.Condition{*testCase.condition}
+		}
+
+		testSettings := clients.GetTestClients(clients.TestClientParams{
+			K8sMockObjects:  []runtime.Object{nodeConfig},
+			SchemeAttachers: testSchemes,
+		})
+
+		err := buildValidNodeConfigBuilder(testSettings).WaitForSyncSucceeded(time.Second)
+		assert.Equal(t, testCase.expectedError, err)
+	}
+}
+
 func TestFecNodeConfigGetGVR(t *testing.T) {
 	gvr := GetSriovFecNodeConfigIoGVR()
 	assert.Equal(t, APIGroup, gvr.Group)
@@ -12,6 +12,8 @@ import (
 
 // List returns SriovFecNodeConfigList from given namespace.
 func List(apiClient *clients.Settings, nsname string, options ...client.ListOptions) ([]*NodeConfigBuilder, error) {
+	nsname = apiClient.ResolveNamespace(nsname)
+
 	if apiClient == nil {
 		klog.V(100).Info("SriovFecNodeConfigList 'apiClient' parameter can not be empty")
 
@@ -39,6 +39,8 @@ type ClusterAdditionalOptions func(builder *ClusterConfigBuilder) (*ClusterConfi
 func NewClusterConfigBuilder(
 	apiClient *clients.Settings,
 	name, nsname string) *ClusterConfigBuilder {
+	nsname = apiClient.ResolveNamespace(nsname)
+
 	klog.V(100).Infof(
 		"Initializing new SriovVrbClusterConfig structure with the following params: %s, %s",
 		name, nsname)
@@ -87,6 +89,8 @@ func NewClusterConfigBuilder(
 
 // PullClusterConfig retrieves an existing SriovVrbClusterConfig.io object from the cluster.
 func PullClusterConfig(apiClient *clients.Settings, name, nsname string) (*ClusterConfigBuilder, error) {
+	nsname = apiClient.ResolveNamespace(nsname)
+
 	klog.V(100).Infof(
 		"Pulling SriovVrbClusterConfig.io object name: %s in namespace: %s", name, nsname)
 
@@ -39,6 +39,8 @@ type NodeAdditionalOptions func(builder *NodeConfigBuilder) (*NodeConfigBuilder,
 func NewNodeConfigBuilder(
 	apiClient *clients.Settings,
 	name, nsname string) *NodeConfigBuilder {
+	nsname = apiClient.ResolveNamespace(nsname)
+
 	klog.V(100).Infof(
 		"Initializing new sriovVrbNodeConfig structure with the following params: %s, %s",
 		name, nsname)
@@ -87,6 +89,8 @@ func NewNodeConfigBuilder(
 
 // PullNodeConfig retrieves an existing SriovVrbNodeConfig.io object from the cluster.
 func PullNodeConfig(apiClient *clients.Settings, name, nsname string) (*NodeConfigBuilder, error) {
+	nsname = apiClient.ResolveNamespace(nsname)
+
 	klog.V(100).Infof(
 		"Pulling SriovVrbNodeConfig.io object name: %s in namespace: %s", name, nsname)
 
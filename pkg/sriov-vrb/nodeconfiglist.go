@@ -15,6 +15,8 @@ func ListNodeConfig(
 	apiClient *clients.Settings,
 	nsname string,
 	options ...client.ListOptions) ([]*NodeConfigBuilder, error) {
+	nsname = apiClient.ResolveNamespace(nsname)
+
 	if apiClient == nil {
 		klog.V(100).Info("SriovVrbNodeConfigList 'apiClient' parameter can not be empty")
 
@@ -5,6 +5,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"slices"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/logging"
@@ -47,6 +49,8 @@ type NetworkAdditionalOptions func(builder *NetworkBuilder) (*NetworkBuilder, er
 // NewNetworkBuilder creates new instance of Builder.
 func NewNetworkBuilder(
 	apiClient *clients.Settings, name, nsname, targetNsname, resName string) *NetworkBuilder {
+	nsname = apiClient.ResolveNamespace(nsname)
+
 	if apiClient == nil {
 		klog.V(100).Info("The apiClient cannot be nil")
 
@@ -189,6 +193,28 @@ func (builder *NetworkBuilder) WithMetaPluginRdma() *NetworkBuilder {
 	return builder
 }
 
+// WithRdmaExclusiveMode adds the rdma meta plugin to the SriovNetwork configuration, keeping any meta plugins already
+// configured such as tuning. When the SriovNetworkPoolConfig rdmaMode is exclusive, the rdma plugin moves the RDMA
+// device of the VF into the pod network namespace.
+func (builder *NetworkBuilder) WithRdmaExclusiveMode() *NetworkBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	klog.V(100).Infof("Adding rdma meta plugin to SriovNetwork %s", builder.Definition.Name)
+
+	rdmaPlugin := `{ "type": "rdma" }`
+
+	switch {
+	case builder.Definition.Spec.MetaPluginsConfig == "":
+		builder.Definition.Spec.MetaPluginsConfig = rdmaPlugin
+	case !strings.Contains(builder.Definition.Spec.MetaPluginsConfig, `"rdma"`):
+		builder.Definition.Spec.MetaPluginsConfig += ", " + rdmaPlugin
+	}
+
+	return builder
+}
+
 // WithLinkState sets linkState parameters in the SrIovNetwork definition spec.
 func (builder *NetworkBuilder) WithLinkState(linkState string) *NetworkBuilder {
 	if valid, _ := builder.validate(); !valid {
@@ -348,6 +374,53 @@ func (builder *NetworkBuilder) WithWhereaboutsIPAM(ipRange, gateway, exclude, ne
 	return builder
 }
 
+// WithWhereaboutsNodeSliceSize enables whereabouts fast IPAM on the SriovNetwork, which preallocates a slice of the
+// range of sliceSize, such as /28, to each node using NodeSlicePools. WithWhereaboutsIPAM must be called first.
+func (builder *NetworkBuilder) WithWhereaboutsNodeSliceSize(sliceSize string) *NetworkBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	klog.V(100).Infof("Setting SriovNetwork %s whereabouts node_slice_size to %s", builder.Definition.Name, sliceSize)
+
+	prefixLength, err := strconv.Atoi(strings.TrimPrefix(sliceSize, "/"))
+	if err != nil || prefixLength < 1 || prefixLength > 128 {
+		builder.errorMsg = fmt.Sprintf("invalid whereabouts node slice size %q, must be a prefix length such as /28", sliceSize)
+
+		return builder
+	}
+
+	ipamConfig := map[string]any{}
+
+	if builder.Definition.Spec.IPAM != "" {
+		err = json.Unmarshal([]byte(builder.Definition.Spec.IPAM), &ipamConfig)
+		if err != nil {
+			builder.errorMsg = fmt.Sprintf("failed to unmarshal IPAM config: %v", err)
+
+			return builder
+		}
+	}
+
+	if ipamConfig["type"] != "whereabouts" {
+		builder.errorMsg = "failed to configure node slice size, whereabouts IPAM is not configured"
+
+		return builder
+	}
+
+	ipamConfig["node_slice_size"] = fmt.Sprintf("/%d", prefixLength)
+
+	ipamJSON, err := json.Marshal(ipamConfig)
+	if err != nil {
+		builder.errorMsg = fmt.Sprintf("failed to marshal IPAM config: %v", err)
+
+		return builder
+	}
+
+	builder.Definition.Spec.IPAM = string(ipamJSON)
+
+	return builder
+}
+
 // WithOptions creates SriovNetwork with generic mutation options.
 func (builder *NetworkBuilder) WithOptions(options ...NetworkAdditionalOptions) *NetworkBuilder {
 	if valid, _ := builder.validate(); !valid {
@@ -374,6 +447,8 @@ func (builder *NetworkBuilder) WithOptions(options ...NetworkAdditionalOptions)
 
 // PullNetwork pulls existing sriovnetwork from cluster.
 func PullNetwork(apiClient *clients.Settings, name, nsname string) (*NetworkBuilder, error) {
+	nsname = apiClient.ResolveNamespace(nsname)
+
 	klog.V(100).Infof("Pulling existing sriovnetwork name %s under namespace %s from cluster", name, nsname)
 
 	if apiClient == nil {
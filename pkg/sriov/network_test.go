@@ -496,6 +496,86 @@ func TestWithStaticIpam(t *testing.T) {
 	assert.Equal(t, netBuilder.Definition.Spec.IPAM, `{ "type": "static" }`)
 }
 
+func TestSriovNetworkWithRdmaExclusiveMode(t *testing.T) {
+	testCases := []struct {
+		metaPlugins         string
+		expectedMetaPlugins string
+	}{
+		{
+			metaPlugins:         "",
+			expectedMetaPlugins: `{ "type": "rdma" }`,
+		},
+		{
+			metaPlugins:         `{ "type": "tuning", "allmulti": true }`,
+			expectedMetaPlugins: `{ "type": "tuning", "allmulti": true }, { "type": "rdma" }`,
+		},
+		{
+			metaPlugins:         `{ "type": "rdma" }`,
+			expectedMetaPlugins: `{ "type": "rdma" }`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testSettings := buildTestClientWithDummyObject()
+		netBuilder := buildValidSriovNetworkTestBuilder(testSettings)
+		netBuilder.Definition.Spec.MetaPluginsConfig = testCase.metaPlugins
+
+		netBuilder = netBuilder.WithRdmaExclusiveMode()
+		assert.Empty(t, netBuilder.errorMsg)
+		assert.Equal(t, testCase.expectedMetaPlugins, netBuilder.Definition.Spec.MetaPluginsConfig)
+	}
+}
+
+func TestSriovNetworkWithWhereaboutsNodeSliceSize(t *testing.T) {
+	testCases := []struct {
+		withWhereabouts   bool
+		sliceSize         string
+		expectedErrorText string
+		expectedIPAM      string
+	}{
+		{
+			withWhereabouts: true,
+			sliceSize:       "/28",
+			expectedIPAM: `{"gateway":"10.0.0.1","node_slice_size":"/28","range":"10.0.0.0/16",` +
+				`"type":"whereabouts"}`,
+		},
+		{
+			withWhereabouts: true,
+			sliceSize:       "26",
+			expectedIPAM: `{"gateway":"10.0.0.1","node_slice_size":"/26","range":"10.0.0.0/16",` +
+				`"type":"whereabouts"}`,
+		},
+		{
+			withWhereabouts:   true,
+			sliceSize:         "/abc",
+			expectedErrorText: "invalid whereabouts node slice size \"/abc\", must be a prefix length such as /28",
+		},
+		{
+			withWhereabouts:   false,
+			sliceSize:         "/28",
+			expectedErrorText: "failed to configure node slice size, whereabouts IPAM is not configured",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testSettings := buildTestClientWithDummyObject()
+		netBuilder := buildValidSriovNetworkTestBuilder(testSettings)
+
+		if testCase.withWhereabouts {
+			netBuilder = netBuilder.WithWhereaboutsIPAM("10.0.0.0/16", "10.0.0.1", "", "")
+		} else {
+			netBuilder = netBuilder.WithStaticIpam()
+		}
+
+		netBuilder = netBuilder.WithWhereaboutsNodeSliceSize(testCase.sliceSize)
+		assert.Equal(t, testCase.expectedErrorText, netBuilder.errorMsg)
+
+		if testCase.expectedErrorText == "" {
+			assert.Equal(t, testCase.expectedIPAM, netBuilder.Definition.Spec.IPAM)
+		}
+	}
+}
+
 func TestWithWhereaboutsIPAM(t *testing.T) {
 	testCases := []struct {
 		ipRange           string
@@ -10,6 +10,7 @@ import (
 	srIovV1 "github.com/k8snetworkplumbingwg/sriov-network-operator/api/v1"
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/logging"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/klog/v2"
 )
@@ -31,6 +32,8 @@ type NetworkNodeStateBuilder struct {
 
 // NewNetworkNodeStateBuilder creates new instance of NetworkNodeStateBuilder.
 func NewNetworkNodeStateBuilder(apiClient *clients.Settings, nodeName, nsname string) *NetworkNodeStateBuilder {
+	nsname = apiClient.ResolveNamespace(nsname)
+
 	klog.V(100).Infof(
 		"Initializing new NetworkNodeStateBuilder structure with the following params: %s, %s",
 		nodeName, nsname)
@@ -251,6 +254,14 @@ func (builder *NetworkNodeStateBuilder) findInterfaceByName(sriovInterfaceName s
 	return nil, fmt.Errorf("interface %s was not found", sriovInterfaceName)
 }
 
+// GetSriovNetworkNodeStateGVR returns SriovNetworkNodeState's GroupVersionResource
+// which could be used for Clean function.
+func GetSriovNetworkNodeStateGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group: "sriovnetwork.openshift.io", Version: "v1", Resource: "sriovnetworknodestates",
+	}
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *NetworkNodeStateBuilder) validate() (bool, error) {
@@ -14,6 +14,8 @@ import (
 // ListNetworkNodeState returns SriovNetworkNodeStates inventory in the given namespace.
 func ListNetworkNodeState(
 	apiClient *clients.Settings, nsname string, options ...client.ListOptions) ([]*NetworkNodeStateBuilder, error) {
+	nsname = apiClient.ResolveNamespace(nsname)
+
 	if apiClient == nil {
 		klog.V(100).Info("SriovNetworkNodeStates 'apiClient' parameter can not be empty")
 
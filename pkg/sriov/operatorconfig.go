@@ -15,6 +15,7 @@ import (
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metaV1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 )
 
 const (
@@ -41,6 +42,8 @@ type OperatorConfigBuilder struct {
 
 // NewOperatorConfigBuilder creates new instance of OperatorConfigBuilder.
 func NewOperatorConfigBuilder(apiClient *clients.Settings, nsname string) *OperatorConfigBuilder {
+	nsname = apiClient.ResolveNamespace(nsname)
+
 	klog.V(100).Infof(
 		"Initializing new OperatorConfigBuilder structure with the following params: namespace: %s", nsname)
 
@@ -102,6 +105,8 @@ func (builder *OperatorConfigBuilder) Create() (*OperatorConfigBuilder, error) {
 
 // PullOperatorConfig loads an existing SriovOperatorConfig into OperatorConfigBuilder struct.
 func PullOperatorConfig(apiClient *clients.Settings, nsname string) (*OperatorConfigBuilder, error) {
+	nsname = apiClient.ResolveNamespace(nsname)
+
 	klog.V(100).Infof("Pulling existing default SriovOperatorConfig: %s", sriovOperatorConfigName)
 
 	if apiClient == nil {
@@ -335,6 +340,13 @@ func (builder *OperatorConfigBuilder) Delete() (*OperatorConfigBuilder, error) {
 	return builder, nil
 }
 
+// GetSriovOperatorConfigGVR returns SriovOperatorConfig's GroupVersionResource which could be used for Clean function.
+func GetSriovOperatorConfigGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group: "sriovnetwork.openshift.io", Version: "v1", Resource: "sriovoperatorconfigs",
+	}
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *OperatorConfigBuilder) validate() (bool, error) {
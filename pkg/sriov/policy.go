@@ -14,10 +14,22 @@ import (
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 )
 
 const (
 	errInvalidDeviceType = "invalid device type, allowed devType values are: vfio-pci or netdevice"
+	deviceTypeVfioPci    = "vfio-pci"
+	deviceTypeNetdevice  = "netdevice"
+	// EswitchModeLegacy is the eSwitch mode of a NIC whose VFs are switched in hardware by the legacy SR-IOV eSwitch.
+	EswitchModeLegacy = "legacy"
+	// EswitchModeSwitchdev is the eSwitch mode of a NIC whose VFs have representors, required for OVS hardware
+	// offload and vDPA.
+	EswitchModeSwitchdev = "switchdev"
+	// VdpaTypeVirtio is the vDPA type exposing VFs through the virtio-vdpa bus driver.
+	VdpaTypeVirtio = "virtio"
+	// VdpaTypeVhost is the vDPA type exposing VFs through the vhost-vdpa bus driver.
+	VdpaTypeVhost = "vhost"
 )
 
 // PolicyBuilder provides struct for srIovPolicy object containing connection to the cluster and the srIovPolicy
@@ -46,6 +58,8 @@ func NewPolicyBuilder(
 	vfsNumber int,
 	nicNames []string,
 	nodeSelector map[string]string) *PolicyBuilder {
+	nsname = apiClient.ResolveNamespace(nsname)
+
 	if apiClient == nil {
 		klog.V(100).Info("The apiClient cannot be nil")
 
@@ -123,7 +137,7 @@ func (builder *PolicyBuilder) WithDevType(devType string) *PolicyBuilder {
 		return builder
 	}
 
-	allowedDevTypes := []string{"vfio-pci", "netdevice"}
+	allowedDevTypes := []string{deviceTypeVfioPci, deviceTypeNetdevice}
 
 	if !slices.Contains(allowedDevTypes, devType) {
 		builder.errorMsg = errInvalidDeviceType
@@ -226,6 +240,74 @@ func (builder *PolicyBuilder) WithExternallyManaged(externallyManaged bool) *Pol
 	return builder
 }
 
+// WithEswitchMode sets the eSwitch mode of the selected PFs in the SriovNetworkNodePolicy definition. Allowed modes are
+// legacy and switchdev.
+func (builder *PolicyBuilder) WithEswitchMode(eSwitchMode string) *PolicyBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	klog.V(100).Infof("Redefining SriovNetworkNodePolicy %s with eSwitchMode: %s", builder.Definition.Name, eSwitchMode)
+
+	if eSwitchMode != EswitchModeLegacy && eSwitchMode != EswitchModeSwitchdev {
+		klog.V(100).Infof("The eSwitchMode %s is invalid", eSwitchMode)
+
+		builder.errorMsg = fmt.Sprintf("invalid eSwitchMode %q, allowed eSwitchMode values are: %s or %s",
+			eSwitchMode, EswitchModeLegacy, EswitchModeSwitchdev)
+
+		return builder
+	}
+
+	builder.Definition.Spec.EswitchMode = eSwitchMode
+
+	return builder
+}
+
+// WithVdpaType sets the vDPA type of the VFs in the SriovNetworkNodePolicy definition. Allowed types are virtio and
+// vhost. vDPA requires the switchdev eSwitch mode and the netdevice device type.
+func (builder *PolicyBuilder) WithVdpaType(vdpaType string) *PolicyBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	klog.V(100).Infof("Redefining SriovNetworkNodePolicy %s with vdpaType: %s", builder.Definition.Name, vdpaType)
+
+	if vdpaType != VdpaTypeVirtio && vdpaType != VdpaTypeVhost {
+		klog.V(100).Infof("The vdpaType %s is invalid", vdpaType)
+
+		builder.errorMsg = fmt.Sprintf("invalid vdpaType %q, allowed vdpaType values are: %s or %s",
+			vdpaType, VdpaTypeVirtio, VdpaTypeVhost)
+
+		return builder
+	}
+
+	builder.Definition.Spec.VdpaType = vdpaType
+
+	return builder
+}
+
+// WithLinkType sets the link type of the selected PFs in the SriovNetworkNodePolicy definition. Allowed link types are
+// eth and ib, in either case.
+func (builder *PolicyBuilder) WithLinkType(linkType string) *PolicyBuilder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	klog.V(100).Infof("Redefining SriovNetworkNodePolicy %s with linkType: %s", builder.Definition.Name, linkType)
+
+	if !slices.Contains([]string{"eth", "ETH", "ib", "IB"}, linkType) {
+		klog.V(100).Infof("The linkType %s is invalid", linkType)
+
+		builder.errorMsg = fmt.Sprintf("invalid linkType %q, allowed linkType values are: eth or ib", linkType)
+
+		return builder
+	}
+
+	builder.Definition.Spec.LinkType = linkType
+
+	return builder
+}
+
 // WithOptions creates SriovNetworkNodePolicy with generic mutation options.
 func (builder *PolicyBuilder) WithOptions(options ...PolicyAdditionalOptions) *PolicyBuilder {
 	if valid, _ := builder.validate(); !valid {
@@ -252,6 +334,8 @@ func (builder *PolicyBuilder) WithOptions(options ...PolicyAdditionalOptions) *P
 
 // PullPolicy pulls existing sriovnetworknodepolicy from cluster.
 func PullPolicy(apiClient *clients.Settings, name, nsname string) (*PolicyBuilder, error) {
+	nsname = apiClient.ResolveNamespace(nsname)
+
 	klog.V(100).Infof("Pulling existing sriovnetworknodepolicy name %s under namespace %s from cluster", name, nsname)
 
 	if apiClient == nil {
@@ -330,6 +414,12 @@ func (builder *PolicyBuilder) Create() (*PolicyBuilder, error) {
 		return builder, err
 	}
 
+	if err := validatePolicyDeviceSpec(&builder.Definition.Spec); err != nil {
+		klog.V(100).Infof("SriovNetworkNodePolicy %s has an invalid device configuration: %v", builder.Definition.Name, err)
+
+		return builder, err
+	}
+
 	if !builder.Exists() {
 		err := builder.apiClient.Create(logging.DiscardContext(), builder.Definition)
 		if err != nil {
@@ -382,6 +472,47 @@ func (builder *PolicyBuilder) Exists() bool {
 	return err == nil || !k8serrors.IsNotFound(err)
 }
 
+// validatePolicyDeviceSpec checks that the device options of the policy spec are not mutually exclusive. It mirrors
+// the checks done by the operator admission webhook so invalid policies fail before they are created.
+func validatePolicyDeviceSpec(spec *srIovV1.SriovNetworkNodePolicySpec) error {
+	if spec.IsRdma && spec.DeviceType == deviceTypeVfioPci {
+		return fmt.Errorf("SriovNetworkNodePolicy RDMA mode cannot be combined with the %s device type", deviceTypeVfioPci)
+	}
+
+	if spec.NeedVhostNet && spec.DeviceType == deviceTypeVfioPci {
+		return fmt.Errorf("SriovNetworkNodePolicy vhost-net cannot be combined with the %s device type", deviceTypeVfioPci)
+	}
+
+	if spec.VdpaType != "" {
+		if spec.EswitchMode != EswitchModeSwitchdev {
+			return fmt.Errorf("SriovNetworkNodePolicy vdpaType requires the %s eSwitchMode", EswitchModeSwitchdev)
+		}
+
+		if spec.DeviceType == deviceTypeVfioPci {
+			return fmt.Errorf("SriovNetworkNodePolicy vdpaType cannot be combined with the %s device type", deviceTypeVfioPci)
+		}
+
+		if spec.IsRdma {
+			return fmt.Errorf("SriovNetworkNodePolicy vdpaType cannot be combined with RDMA mode")
+		}
+	}
+
+	if spec.ExternallyManaged && spec.EswitchMode == EswitchModeSwitchdev {
+		return fmt.Errorf("SriovNetworkNodePolicy externallyManaged cannot be combined with the %s eSwitchMode",
+			EswitchModeSwitchdev)
+	}
+
+	return nil
+}
+
+// GetSriovNetworkNodePolicyGVR returns SriovNetworkNodePolicy's GroupVersionResource
+// which could be used for Clean function.
+func GetSriovNetworkNodePolicyGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group: "sriovnetwork.openshift.io", Version: "v1", Resource: "sriovnetworknodepolicies",
+	}
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *PolicyBuilder) validate() (bool, error) {
@@ -382,6 +382,145 @@ func TestPolicyWithExternallyManaged(t *testing.T) {
 	}
 }
 
+func TestPolicyWithEswitchMode(t *testing.T) {
+	testCases := []struct {
+		eSwitchMode       string
+		expectedErrorText string
+	}{
+		{
+			eSwitchMode:       EswitchModeSwitchdev,
+			expectedErrorText: "",
+		},
+		{
+			eSwitchMode:       EswitchModeLegacy,
+			expectedErrorText: "",
+		},
+		{
+			eSwitchMode:       "invalid",
+			expectedErrorText: "invalid eSwitchMode \"invalid\", allowed eSwitchMode values are: legacy or switchdev",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testSettings := buildTestClientWithDummyPolicyObject()
+		policyBuilder := buildValidSriovPolicyTestBuilder(testSettings).WithEswitchMode(testCase.eSwitchMode)
+		assert.Equal(t, testCase.expectedErrorText, policyBuilder.errorMsg)
+
+		if testCase.expectedErrorText == "" {
+			assert.Equal(t, testCase.eSwitchMode, policyBuilder.Definition.Spec.EswitchMode)
+		}
+	}
+}
+
+func TestPolicyWithVdpaType(t *testing.T) {
+	testCases := []struct {
+		vdpaType          string
+		expectedErrorText string
+	}{
+		{
+			vdpaType:          VdpaTypeVirtio,
+			expectedErrorText: "",
+		},
+		{
+			vdpaType:          VdpaTypeVhost,
+			expectedErrorText: "",
+		},
+		{
+			vdpaType:          "",
+			expectedErrorText: "invalid vdpaType \"\", allowed vdpaType values are: virtio or vhost",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testSettings := buildTestClientWithDummyPolicyObject()
+		policyBuilder := buildValidSriovPolicyTestBuilder(testSettings).WithVdpaType(testCase.vdpaType)
+		assert.Equal(t, testCase.expectedErrorText, policyBuilder.errorMsg)
+
+		if testCase.expectedErrorText == "" {
+			assert.Equal(t, testCase.vdpaType, policyBuilder.Definition.Spec.VdpaType)
+		}
+	}
+}
+
+func TestPolicyWithLinkType(t *testing.T) {
+	testCases := []struct {
+		linkType          string
+		expectedErrorText string
+	}{
+		{
+			linkType:          "eth",
+			expectedErrorText: "",
+		},
+		{
+			linkType:          "IB",
+			expectedErrorText: "",
+		},
+		{
+			linkType:          "roce",
+			expectedErrorText: "invalid linkType \"roce\", allowed linkType values are: eth or ib",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testSettings := buildTestClientWithDummyPolicyObject()
+		policyBuilder := buildValidSriovPolicyTestBuilder(testSettings).WithLinkType(testCase.linkType)
+		assert.Equal(t, testCase.expectedErrorText, policyBuilder.errorMsg)
+
+		if testCase.expectedErrorText == "" {
+			assert.Equal(t, testCase.linkType, policyBuilder.Definition.Spec.LinkType)
+		}
+	}
+}
+
+func TestValidatePolicyDeviceSpec(t *testing.T) {
+	testCases := []struct {
+		spec          srIovV1.SriovNetworkNodePolicySpec
+		expectedError error
+	}{
+		{
+			spec:          srIovV1.SriovNetworkNodePolicySpec{DeviceType: deviceTypeNetdevice, IsRdma: true},
+			expectedError: nil,
+		},
+		{
+			spec: srIovV1.SriovNetworkNodePolicySpec{
+				DeviceType: deviceTypeNetdevice, EswitchMode: EswitchModeSwitchdev, VdpaType: VdpaTypeVhost},
+			expectedError: nil,
+		},
+		{
+			spec:          srIovV1.SriovNetworkNodePolicySpec{DeviceType: deviceTypeVfioPci, IsRdma: true},
+			expectedError: fmt.Errorf("SriovNetworkNodePolicy RDMA mode cannot be combined with the vfio-pci device type"),
+		},
+		{
+			spec:          srIovV1.SriovNetworkNodePolicySpec{DeviceType: deviceTypeVfioPci, NeedVhostNet: true},
+			expectedError: fmt.Errorf("SriovNetworkNodePolicy vhost-net cannot be combined with the vfio-pci device type"),
+		},
+		{
+			spec:          srIovV1.SriovNetworkNodePolicySpec{EswitchMode: EswitchModeLegacy, VdpaType: VdpaTypeVirtio},
+			expectedError: fmt.Errorf("SriovNetworkNodePolicy vdpaType requires the switchdev eSwitchMode"),
+		},
+		{
+			spec: srIovV1.SriovNetworkNodePolicySpec{
+				DeviceType: deviceTypeVfioPci, EswitchMode: EswitchModeSwitchdev, VdpaType: VdpaTypeVirtio},
+			expectedError: fmt.Errorf("SriovNetworkNodePolicy vdpaType cannot be combined with the vfio-pci device type"),
+		},
+		{
+			spec: srIovV1.SriovNetworkNodePolicySpec{
+				EswitchMode: EswitchModeSwitchdev, VdpaType: VdpaTypeVirtio, IsRdma: true},
+			expectedError: fmt.Errorf("SriovNetworkNodePolicy vdpaType cannot be combined with RDMA mode"),
+		},
+		{
+			spec: srIovV1.SriovNetworkNodePolicySpec{EswitchMode: EswitchModeSwitchdev, ExternallyManaged: true},
+			expectedError: fmt.Errorf(
+				"SriovNetworkNodePolicy externallyManaged cannot be combined with the switchdev eSwitchMode"),
+		},
+	}
+
+	for _, testCase := range testCases {
+		err := validatePolicyDeviceSpec(&testCase.spec)
+		assert.Equal(t, testCase.expectedError, err)
+	}
+}
+
 func TestPolicyWithOptions(t *testing.T) {
 	testSettings := buildTestClientWithDummyObject()
 	testBuilder := buildValidSriovPolicyTestBuilder(testSettings).WithOptions(
@@ -435,6 +574,11 @@ func TestPolicyCreate(t *testing.T) {
 			testPolicy:    buildInvalidSriovPolicyTestBuilder(buildTestClientWithDummyPolicyObject()),
 			expectedError: fmt.Errorf("SriovNetworkNodePolicy 'nsname' cannot be empty"),
 		},
+		{
+			testPolicy: buildValidSriovPolicyTestBuilder(buildTestClientWithDummyPolicyObject()).
+				WithDevType(deviceTypeVfioPci).WithRDMA(true),
+			expectedError: fmt.Errorf("SriovNetworkNodePolicy RDMA mode cannot be combined with the vfio-pci device type"),
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -13,6 +13,8 @@ import (
 
 // ListPolicy returns SriovNetworkNodePolicies inventory in the given namespace.
 func ListPolicy(apiClient *clients.Settings, nsname string, options ...client.ListOptions) ([]*PolicyBuilder, error) {
+	nsname = apiClient.ResolveNamespace(nsname)
+
 	if apiClient == nil {
 		klog.V(100).Info("SriovNetworkNodePolicies 'apiClient' parameter can not be empty")
 
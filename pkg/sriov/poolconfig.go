@@ -14,6 +14,7 @@ import (
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	intstrutil "k8s.io/apimachinery/pkg/util/intstr"
 	goclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -38,6 +39,8 @@ type PoolConfigBuilder struct {
 
 // NewPoolConfigBuilder creates a new instance of PoolConfigBuilder.
 func NewPoolConfigBuilder(apiClient *clients.Settings, name, nsname string) *PoolConfigBuilder {
+	nsname = apiClient.ResolveNamespace(nsname)
+
 	klog.V(100).Infof(
 		"Initializing new SriovNetworkPoolConfig structure with the name %s in the namespace %s", name, nsname)
 
@@ -286,6 +289,8 @@ func (builder *PoolConfigBuilder) WithRDMAMode(mode string) *PoolConfigBuilder {
 
 // PullPoolConfig pulls existing SriovNetworkPoolConfig from cluster.
 func PullPoolConfig(apiClient *clients.Settings, name, nsname string) (*PoolConfigBuilder, error) {
+	nsname = apiClient.ResolveNamespace(nsname)
+
 	klog.V(100).Infof("Pulling existing SriovNetworkPoolConfig name %s under namespace %s from cluster", name, nsname)
 
 	if apiClient == nil {
@@ -332,6 +337,14 @@ func PullPoolConfig(apiClient *clients.Settings, name, nsname string) (*PoolConf
 	return &builder, nil
 }
 
+// GetSriovNetworkPoolConfigGVR returns SriovNetworkPoolConfig's GroupVersionResource
+// which could be used for Clean function.
+func GetSriovNetworkPoolConfigGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group: "sriovnetwork.openshift.io", Version: "v1", Resource: "sriovnetworkpoolconfigs",
+	}
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *PoolConfigBuilder) validate() (bool, error) {
@@ -7,7 +7,9 @@ import (
 
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/logging"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/podspec"
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/msg"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/pod"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
@@ -39,6 +41,8 @@ func NewBuilder(
 	nsname string,
 	labels map[string]string,
 	containerSpec *corev1.Container) *Builder {
+	nsname = apiClient.ResolveNamespace(nsname)
+
 	klog.V(100).Infof(
 		"Initializing new statefulset structure with the following params: "+
 			"name: %s, namespace: %s, labels: %s, containerSpec %v",
@@ -115,6 +119,72 @@ func (builder *Builder) WithAdditionalContainerSpecs(specs []corev1.Container) *
 	return builder
 }
 
+// WithAdditionalInitContainerSpecs appends a list of init container specs to the statefulset definition.
+func (builder *Builder) WithAdditionalInitContainerSpecs(specs []corev1.Container) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	klog.V(100).Infof("Appending a list of init container specs %v to statefulset %s in namespace %s",
+		specs, builder.Definition.Name, builder.Definition.Namespace)
+
+	if len(specs) == 0 {
+		klog.V(100).Info("The init container specs are empty")
+
+		builder.errorMsg = "cannot accept empty list as init container specs"
+
+		return builder
+	}
+
+	builder.Definition.Spec.Template.Spec.InitContainers = append(
+		builder.Definition.Spec.Template.Spec.InitContainers, specs...)
+
+	return builder
+}
+
+// WithContainerBuilder appends the containers defined by the provided container builders to the statefulset definition.
+func (builder *Builder) WithContainerBuilder(containerBuilders ...*pod.ContainerBuilder) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	klog.V(100).Infof("Appending %d containers from container builders to statefulset %s in namespace %s",
+		len(containerBuilders), builder.Definition.Name, builder.Definition.Namespace)
+
+	specs, err := getContainerSpecs(containerBuilders)
+	if err != nil {
+		klog.V(100).Infof("Failed to get container specs from container builders: %v", err)
+
+		builder.errorMsg = err.Error()
+
+		return builder
+	}
+
+	return builder.WithAdditionalContainerSpecs(specs)
+}
+
+// WithInitContainerBuilder appends the containers defined by the provided container builders to the statefulset
+// definition as init containers.
+func (builder *Builder) WithInitContainerBuilder(containerBuilders ...*pod.ContainerBuilder) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	klog.V(100).Infof("Appending %d init containers from container builders to statefulset %s in namespace %s",
+		len(containerBuilders), builder.Definition.Name, builder.Definition.Namespace)
+
+	specs, err := getContainerSpecs(containerBuilders)
+	if err != nil {
+		klog.V(100).Infof("Failed to get init container specs from container builders: %v", err)
+
+		builder.errorMsg = err.Error()
+
+		return builder
+	}
+
+	return builder.WithAdditionalInitContainerSpecs(specs)
+}
+
 // WithOptions creates StatefulSet with generic mutation options.
 func (builder *Builder) WithOptions(options ...AdditionalOptions) *Builder {
 	if valid, _ := builder.validate(); !valid {
@@ -169,8 +239,88 @@ func (builder *Builder) WithPodAnnotations(annotations map[string]string) *Build
 	return builder
 }
 
+// WithNodeSelector applies nodeSelector to the pod template of the statefulset.
+func (builder *Builder) WithNodeSelector(selector map[string]string) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	klog.V(100).Infof("Applying nodeSelector %v to statefulset %s in namespace %s",
+		selector, builder.Definition.Name, builder.Definition.Namespace)
+
+	if err := podspec.WithNodeSelector(&builder.Definition.Spec.Template.Spec, selector); err != nil {
+		klog.V(100).Infof("Failed to apply nodeSelector to statefulset %s in namespace %s: %v",
+			builder.Definition.Name, builder.Definition.Namespace, err)
+
+		builder.errorMsg = err.Error()
+	}
+
+	return builder
+}
+
+// WithTolerations appends the provided tolerations to the statefulset pod template. None of the
+// tolerations can be empty.
+func (builder *Builder) WithTolerations(tolerations ...corev1.Toleration) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	klog.V(100).Infof("Adding tolerations %v to statefulset %s in namespace %s",
+		tolerations, builder.Definition.Name, builder.Definition.Namespace)
+
+	if err := podspec.WithTolerations(&builder.Definition.Spec.Template.Spec, tolerations); err != nil {
+		klog.V(100).Infof("Failed to add tolerations to statefulset %s in namespace %s: %v",
+			builder.Definition.Name, builder.Definition.Namespace, err)
+
+		builder.errorMsg = err.Error()
+	}
+
+	return builder
+}
+
+// WithRuntimeClass sets the runtimeClassName of the statefulset pod template, such as the one
+// created by a performance profile.
+func (builder *Builder) WithRuntimeClass(runtimeClassName string) *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	klog.V(100).Infof("Setting runtimeClassName %s on statefulset %s in namespace %s",
+		runtimeClassName, builder.Definition.Name, builder.Definition.Namespace)
+
+	if err := podspec.WithRuntimeClass(&builder.Definition.Spec.Template.Spec, runtimeClassName); err != nil {
+		klog.V(100).Infof("Failed to set runtimeClassName on statefulset %s in namespace %s: %v",
+			builder.Definition.Name, builder.Definition.Namespace, err)
+
+		builder.errorMsg = err.Error()
+	}
+
+	return builder
+}
+
+// WithHostNetwork applies HostNetwork to the pod template of the statefulset.
+func (builder *Builder) WithHostNetwork() *Builder {
+	if valid, _ := builder.validate(); !valid {
+		return builder
+	}
+
+	klog.V(100).Infof("Enabling hostnetwork flag on statefulset %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if err := podspec.WithHostNetwork(&builder.Definition.Spec.Template.Spec, true); err != nil {
+		klog.V(100).Infof("Failed to enable hostnetwork on statefulset %s in namespace %s: %v",
+			builder.Definition.Name, builder.Definition.Namespace, err)
+
+		builder.errorMsg = err.Error()
+	}
+
+	return builder
+}
+
 // Pull loads an existing statefulset into Builder struct.
 func Pull(apiClient *clients.Settings, name, nsname string) (*Builder, error) {
+	nsname = apiClient.ResolveNamespace(nsname)
+
 	klog.V(100).Infof("Pulling existing statefulset name: %s under namespace: %s", name, nsname)
 
 	builder := Builder{
@@ -334,3 +484,28 @@ func (builder *Builder) validate() (bool, error) {
 
 	return true, nil
 }
+
+// getContainerSpecs returns the container specs defined by containerBuilders, failing if any of them is nil or
+// invalid.
+func getContainerSpecs(containerBuilders []*pod.ContainerBuilder) ([]corev1.Container, error) {
+	if len(containerBuilders) == 0 {
+		return nil, fmt.Errorf("cannot accept empty list as container builders")
+	}
+
+	specs := make([]corev1.Container, 0, len(containerBuilders))
+
+	for index, containerBuilder := range containerBuilders {
+		if containerBuilder == nil {
+			return nil, fmt.Errorf("container builder at index %d is nil", index)
+		}
+
+		container, err := containerBuilder.GetContainerCfg()
+		if err != nil {
+			return nil, fmt.Errorf("container builder at index %d is invalid: %w", index, err)
+		}
+
+		specs = append(specs, *container)
+	}
+
+	return specs, nil
+}
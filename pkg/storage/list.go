@@ -58,6 +58,8 @@ func ListPV(apiClient *clients.Settings, options ...metav1.ListOptions) ([]*PVBu
 
 // ListPVC returns a list of builders for persistentVolumeClaim.
 func ListPVC(apiClient *clients.Settings, nsname string, options ...metav1.ListOptions) ([]*PVCBuilder, error) {
+	nsname = apiClient.ResolveNamespace(nsname)
+
 	if apiClient == nil {
 		klog.V(100).Info("persistentVolumeClaim 'apiClient' can not be empty")
 
@@ -8,6 +8,7 @@ import (
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/msg"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/klog/v2"
 	goclient "sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -29,6 +30,8 @@ type ObjectBucketClaimBuilder struct {
 // NewObjectBucketClaimBuilder creates new instance of builder.
 func NewObjectBucketClaimBuilder(
 	apiClient *clients.Settings, name, nsname string) *ObjectBucketClaimBuilder {
+	nsname = apiClient.ResolveNamespace(nsname)
+
 	klog.V(100).Infof("Initializing new objectBucketClaim structure with the following params: "+
 		"name: %s, namespace: %s", name, nsname)
 
@@ -76,6 +79,8 @@ func NewObjectBucketClaimBuilder(
 
 // PullObjectBucketClaim retrieves an existing objectBucketClaim object from the cluster.
 func PullObjectBucketClaim(apiClient *clients.Settings, name, nsname string) (*ObjectBucketClaimBuilder, error) {
+	nsname = apiClient.ResolveNamespace(nsname)
+
 	klog.V(100).Infof(
 		"Pulling objectBucketClaim object name:%s in namespace: %s", name, nsname)
 
@@ -279,6 +284,13 @@ func (builder *ObjectBucketClaimBuilder) WithGenerateBucketName(
 	return builder
 }
 
+// GetObjectBucketClaimGVR returns ObjectBucketClaim's GroupVersionResource which could be used for Clean function.
+func GetObjectBucketClaimGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group: "objectbucket.io", Version: "v1alpha1", Resource: "objectbucketclaims",
+	}
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *ObjectBucketClaimBuilder) validate() (bool, error) {
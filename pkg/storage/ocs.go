@@ -4,11 +4,13 @@ import (
 	"fmt"
 
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common"
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/logging"
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/msg"
 	ocsoperatorv1 "github.com/rh-ecosystem-edge/eco-goinfra/pkg/schemes/ocs/operatorv1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/klog/v2"
 	goclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -33,6 +35,8 @@ type StorageClusterBuilder struct {
 
 // NewStorageClusterBuilder creates a new instance of StorageClusterBuilder.
 func NewStorageClusterBuilder(apiClient *clients.Settings, name, nsname string) *StorageClusterBuilder {
+	nsname = apiClient.ResolveNamespace(nsname)
+
 	klog.V(100).Infof(
 		"Initializing new storageCluster structure with the following params: %s, %s", name, nsname)
 
@@ -80,6 +84,8 @@ func NewStorageClusterBuilder(apiClient *clients.Settings, name, nsname string)
 
 // PullStorageCluster gets an existing storageCluster object from the cluster.
 func PullStorageCluster(apiClient *clients.Settings, name, namespace string) (*StorageClusterBuilder, error) {
+	namespace = apiClient.ResolveNamespace(namespace)
+
 	klog.V(100).Infof("Pulling existing storageCluster object %s from namespace %s",
 		name, namespace)
 
@@ -420,11 +426,26 @@ func (builder *StorageClusterBuilder) WithAnnotations(
 		return builder
 	}
 
+	if err := common.ValidateAnnotations(annotations); err != nil {
+		klog.V(100).Infof("Invalid annotations: %v", err)
+
+		builder.errorMsg = err.Error()
+
+		return builder
+	}
+
 	builder.Definition.Annotations = annotations
 
 	return builder
 }
 
+// GetStorageClusterGVR returns StorageCluster's GroupVersionResource which could be used for Clean function.
+func GetStorageClusterGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group: "ocs.openshift.io", Version: "v1", Resource: "storageclusters",
+	}
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *StorageClusterBuilder) validate() (bool, error) {
@@ -11,6 +11,7 @@ import (
 	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/msg"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/klog/v2"
 )
 
@@ -30,6 +31,8 @@ type SystemODFBuilder struct {
 
 // NewSystemODFBuilder creates a new instance of Builder.
 func NewSystemODFBuilder(apiClient *clients.Settings, name, nsname string) *SystemODFBuilder {
+	nsname = apiClient.ResolveNamespace(nsname)
+
 	klog.V(100).Infof(
 		"Initializing new SystemODF structure with the following params: %s, %s", name, nsname)
 
@@ -77,6 +80,8 @@ func NewSystemODFBuilder(apiClient *clients.Settings, name, nsname string) *Syst
 
 // PullSystemODF gets an existing SystemODF object from the cluster.
 func PullSystemODF(apiClient *clients.Settings, name, namespace string) (*SystemODFBuilder, error) {
+	namespace = apiClient.ResolveNamespace(namespace)
+
 	klog.V(100).Infof("Pulling existing SystemODF object %s from namespace %s",
 		name, namespace)
 
@@ -259,6 +264,13 @@ func (builder *SystemODFBuilder) WithSpec(
 	return builder
 }
 
+// GetStorageSystemGVR returns StorageSystem's GroupVersionResource which could be used for Clean function.
+func GetStorageSystemGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group: "odf.openshift.io", Version: "v1alpha1", Resource: "storagesystems",
+	}
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *SystemODFBuilder) validate() (bool, error) {
@@ -11,6 +11,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/klog/v2"
 )
@@ -151,6 +152,13 @@ func (builder *PVBuilder) WaitUntilDeleted(timeout time.Duration) error {
 		})
 }
 
+// GetPersistentVolumeGVR returns PersistentVolume's GroupVersionResource which could be used for Clean function.
+func GetPersistentVolumeGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group: "", Version: "v1", Resource: "persistentvolumes",
+	}
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *PVBuilder) validate() (bool, error) {
@@ -43,6 +43,8 @@ type PVCBuilder struct {
 
 // NewPVCBuilder creates a new structure for persistentvolumeclaim.
 func NewPVCBuilder(apiClient *clients.Settings, name, nsname string) *PVCBuilder {
+	nsname = apiClient.ResolveNamespace(nsname)
+
 	klog.V(100).Infof("Creating PersistentVolumeClaim %s in namespace %s",
 		name, nsname)
 
@@ -282,6 +284,8 @@ func (builder *PVCBuilder) DeleteAndWait(timeout time.Duration) error {
 func PullPersistentVolumeClaim(
 	apiClient *clients.Settings, name string, nsname string) (
 	*PVCBuilder, error) {
+	nsname = apiClient.ResolveNamespace(nsname)
+
 	klog.V(100).Infof("Pulling existing PersistentVolumeClaim object: %s from namespace %s",
 		name, nsname)
 
@@ -12,6 +12,7 @@ import (
 	storageV1 "k8s.io/api/storage/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/klog/v2"
 )
@@ -360,6 +361,13 @@ func (builder *ClassBuilder) Update(force bool) (*ClassBuilder, error) {
 	return builder, err
 }
 
+// GetStorageClassGVR returns StorageClass' GroupVersionResource which could be used for Clean function.
+func GetStorageClassGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group: "storage.k8s.io", Version: "v1", Resource: "storageclasses",
+	}
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *ClassBuilder) validate() (bool, error) {
@@ -9,6 +9,7 @@ import (
 	velerov1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/klog/v2"
 	goclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -31,6 +32,8 @@ type BackupBuilder struct {
 
 // NewBackupBuilder creates a new instance of BackupBuilder.
 func NewBackupBuilder(apiClient *clients.Settings, name, nsname string) *BackupBuilder {
+	nsname = apiClient.ResolveNamespace(nsname)
+
 	klog.V(100).Infof(
 		"Initializing new backup structure with the following params: "+
 			"name: %s, namespace: %s", name, nsname)
@@ -79,6 +82,8 @@ func NewBackupBuilder(apiClient *clients.Settings, name, nsname string) *BackupB
 
 // PullBackup loads an existing backup into BackupBuilder struct.
 func PullBackup(apiClient *clients.Settings, name, nsname string) (*BackupBuilder, error) {
+	nsname = apiClient.ResolveNamespace(nsname)
+
 	klog.V(100).Infof("Pulling existing backup name: %s under namespace: %s", name, nsname)
 
 	if apiClient == nil {
@@ -383,6 +388,13 @@ func (builder *BackupBuilder) Delete() (*BackupBuilder, error) {
 	return builder, nil
 }
 
+// GetBackupGVR returns Backup's GroupVersionResource which could be used for Clean function.
+func GetBackupGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group: "velero.io", Version: "v1", Resource: "backups",
+	}
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *BackupBuilder) validate() (bool, error) {
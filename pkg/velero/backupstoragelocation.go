@@ -11,6 +11,7 @@ import (
 	velerov1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/klog/v2"
 	goclient "sigs.k8s.io/controller-runtime/pkg/client"
@@ -36,6 +37,8 @@ func NewBackupStorageLocationBuilder(
 	namespace string,
 	provider string,
 	objectStorage velerov1.ObjectStorageLocation) *BackupStorageLocationBuilder {
+	namespace = apiClient.ResolveNamespace(namespace)
+
 	klog.V(100).Infof(
 		"Initializing new backupstoragelocation structure with the following params: "+
 			"name: %s, namespace: %s, provider: %s, objectStorage: %v", name, namespace, provider, objectStorage)
@@ -99,6 +102,8 @@ func NewBackupStorageLocationBuilder(
 // PullBackupStorageLocationBuilder pulls existing backupstoragelocation from cluster.
 func PullBackupStorageLocationBuilder(
 	apiClient *clients.Settings, name, namespace string) (*BackupStorageLocationBuilder, error) {
+	namespace = apiClient.ResolveNamespace(namespace)
+
 	klog.V(100).Infof("Pulling existing backupstoragelocation name: %s under namespace: %s", name, namespace)
 
 	if apiClient == nil {
@@ -336,6 +341,14 @@ func (builder *BackupStorageLocationBuilder) Delete() error {
 	return nil
 }
 
+// GetBackupStorageLocationGVR returns BackupStorageLocation's GroupVersionResource
+// which could be used for Clean function.
+func GetBackupStorageLocationGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group: "velero.io", Version: "v1", Resource: "backupstoragelocations",
+	}
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *BackupStorageLocationBuilder) validate() (bool, error) {
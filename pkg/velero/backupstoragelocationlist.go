@@ -13,6 +13,8 @@ import (
 // ListBackupStorageLocationBuilder returns backupstoragelocation inventory in the given namespace.
 func ListBackupStorageLocationBuilder(
 	apiClient *clients.Settings, nsname string, options ...client.ListOptions) ([]*BackupStorageLocationBuilder, error) {
+	nsname = apiClient.ResolveNamespace(nsname)
+
 	if apiClient == nil {
 		klog.V(100).Info("The apiClient cannot be nil")
 
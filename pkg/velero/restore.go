@@ -9,6 +9,7 @@ import (
 	velerov1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/klog/v2"
 	goclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -31,6 +32,8 @@ type RestoreBuilder struct {
 
 // NewRestoreBuilder creates a new instance of RestoreBuilder.
 func NewRestoreBuilder(apiClient *clients.Settings, name, nsname, backupName string) *RestoreBuilder {
+	nsname = apiClient.ResolveNamespace(nsname)
+
 	klog.V(100).Infof(
 		"Initializing new restore structure with the following params: "+
 			"name: %s, namespace: %s, restoreName: %s", name, nsname, backupName)
@@ -90,6 +93,8 @@ func NewRestoreBuilder(apiClient *clients.Settings, name, nsname, backupName str
 
 // PullRestore loads an existing restore into RestoreBuilder struct.
 func PullRestore(apiClient *clients.Settings, name, nsname string) (*RestoreBuilder, error) {
+	nsname = apiClient.ResolveNamespace(nsname)
+
 	klog.V(100).Infof("Pulling existing restore name: %s under namespace: %s", name, nsname)
 
 	if apiClient == nil {
@@ -277,6 +282,13 @@ func (builder *RestoreBuilder) Delete() (*RestoreBuilder, error) {
 	return builder, nil
 }
 
+// GetRestoreGVR returns Restore's GroupVersionResource which could be used for Clean function.
+func GetRestoreGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group: "velero.io", Version: "v1", Resource: "restores",
+	}
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *RestoreBuilder) validate() (bool, error) {
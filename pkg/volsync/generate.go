@@ -0,0 +1,4 @@
+package volsync
+
+//go:generate go run ../../internal/listgen -builder ReplicationDestinationBuilder
+//go:generate go run ../../internal/listgen -builder ReplicationSourceBuilder
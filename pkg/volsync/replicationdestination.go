@@ -0,0 +1,257 @@
+package volsync
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common"
+	volsyncv1alpha1 "github.com/rh-ecosystem-edge/eco-goinfra/pkg/schemes/volsync/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/klog/v2"
+)
+
+// ReplicationDestinationBuilder provides a struct for the ReplicationDestination resource containing a connection to
+// the cluster and the ReplicationDestination definition. A ReplicationDestination receives the data of a
+// ReplicationSource or restores it from a restic repository.
+type ReplicationDestinationBuilder struct {
+	common.EmbeddableBuilder[volsyncv1alpha1.ReplicationDestination, *volsyncv1alpha1.ReplicationDestination]
+	common.EmbeddableCreator[volsyncv1alpha1.ReplicationDestination, ReplicationDestinationBuilder,
+		*volsyncv1alpha1.ReplicationDestination, *ReplicationDestinationBuilder]
+	common.EmbeddableDeleter[volsyncv1alpha1.ReplicationDestination, *volsyncv1alpha1.ReplicationDestination]
+	common.EmbeddableUpdater[volsyncv1alpha1.ReplicationDestination, ReplicationDestinationBuilder,
+		*volsyncv1alpha1.ReplicationDestination, *ReplicationDestinationBuilder]
+	common.EmbeddableConditionGetter[metav1.Condition, volsyncv1alpha1.ReplicationDestination,
+		*volsyncv1alpha1.ReplicationDestination]
+}
+
+// AttachMixins wires the embedded CRUD mixins to this builder instance.
+func (builder *ReplicationDestinationBuilder) AttachMixins() {
+	builder.EmbeddableCreator.SetBase(builder)
+	builder.EmbeddableDeleter.SetBase(builder)
+	builder.EmbeddableUpdater.SetBase(builder)
+	builder.EmbeddableConditionGetter.SetBase(builder)
+}
+
+// GetGVK returns the ReplicationDestination GVK for this builder.
+func (builder *ReplicationDestinationBuilder) GetGVK() schema.GroupVersionKind {
+	return volsyncv1alpha1.GroupVersion.WithKind("ReplicationDestination")
+}
+
+// NewReplicationDestinationBuilder creates a new instance of ReplicationDestinationBuilder. A data mover must be
+// configured using WithRsync or WithRestic before it is created.
+func NewReplicationDestinationBuilder(apiClient *clients.Settings, name, nsname string) *ReplicationDestinationBuilder {
+	klog.V(100).Infof(
+		"Initializing new ReplicationDestination structure with the following params: name: %s, namespace: %s",
+		name, nsname)
+
+	return common.NewNamespacedBuilder[volsyncv1alpha1.ReplicationDestination, ReplicationDestinationBuilder](
+		apiClient, volsyncv1alpha1.AddToScheme, name, nsname)
+}
+
+// PullReplicationDestination retrieves an existing ReplicationDestination from the cluster.
+func PullReplicationDestination(
+	apiClient *clients.Settings, name, nsname string) (*ReplicationDestinationBuilder, error) {
+	return common.PullNamespacedBuilder[volsyncv1alpha1.ReplicationDestination, ReplicationDestinationBuilder](
+		context.TODO(), apiClient, volsyncv1alpha1.AddToScheme, name, nsname)
+}
+
+// WithSchedule synchronizes the destination on the cron schedule, such as */5 * * * *, replacing any manual trigger.
+func (builder *ReplicationDestinationBuilder) WithSchedule(schedule string) *ReplicationDestinationBuilder {
+	if err := common.Validate(builder); err != nil {
+		return builder
+	}
+
+	klog.V(100).Infof("Setting schedule of ReplicationDestination %s in namespace %s to %s",
+		builder.Definition.Name, builder.Definition.Namespace, schedule)
+
+	if schedule == "" {
+		builder.SetError(fmt.Errorf("replicationDestination 'schedule' cannot be empty"))
+
+		return builder
+	}
+
+	builder.Definition.Spec.Trigger = &volsyncv1alpha1.ReplicationDestinationTriggerSpec{Schedule: &schedule}
+
+	return builder
+}
+
+// WithManualTrigger synchronizes the destination once for each new value of trigger, replacing any schedule. Updating
+// the trigger of an existing ReplicationDestination starts a new synchronization, which WaitUntilManualSyncComplete
+// waits for.
+func (builder *ReplicationDestinationBuilder) WithManualTrigger(trigger string) *ReplicationDestinationBuilder {
+	if err := common.Validate(builder); err != nil {
+		return builder
+	}
+
+	klog.V(100).Infof("Setting manual trigger of ReplicationDestination %s in namespace %s to %s",
+		builder.Definition.Name, builder.Definition.Namespace, trigger)
+
+	if trigger == "" {
+		builder.SetError(fmt.Errorf("replicationDestination manual 'trigger' cannot be empty"))
+
+		return builder
+	}
+
+	builder.Definition.Spec.Trigger = &volsyncv1alpha1.ReplicationDestinationTriggerSpec{Manual: trigger}
+
+	return builder
+}
+
+// WithRsync receives data from a ReplicationSource over SSH using the rsync data mover, replacing any restic
+// configuration. Unless the rsync spec sets a destination PVC, its capacity and access modes are used to provision
+// one.
+func (builder *ReplicationDestinationBuilder) WithRsync(
+	rsync volsyncv1alpha1.ReplicationDestinationRsyncSpec) *ReplicationDestinationBuilder {
+	if err := common.Validate(builder); err != nil {
+		return builder
+	}
+
+	klog.V(100).Infof("Setting rsync data mover of ReplicationDestination %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if rsync.CopyMethod == "" {
+		builder.SetError(fmt.Errorf("replicationDestination rsync 'copyMethod' cannot be empty"))
+
+		return builder
+	}
+
+	builder.Definition.Spec.Rsync = &rsync
+	builder.Definition.Spec.Restic = nil
+
+	return builder
+}
+
+// WithRestic restores data from the restic repository described by the secret named by the Repository field of the
+// restic spec, replacing any rsync configuration.
+func (builder *ReplicationDestinationBuilder) WithRestic(
+	restic volsyncv1alpha1.ReplicationDestinationResticSpec) *ReplicationDestinationBuilder {
+	if err := common.Validate(builder); err != nil {
+		return builder
+	}
+
+	klog.V(100).Infof("Setting restic data mover of ReplicationDestination %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if restic.Repository == "" {
+		builder.SetError(fmt.Errorf("replicationDestination restic 'repository' cannot be empty"))
+
+		return builder
+	}
+
+	if restic.CopyMethod == "" {
+		builder.SetError(fmt.Errorf("replicationDestination restic 'copyMethod' cannot be empty"))
+
+		return builder
+	}
+
+	builder.Definition.Spec.Restic = &restic
+	builder.Definition.Spec.Rsync = nil
+
+	return builder
+}
+
+// WaitUntilRsyncReady waits up to timeout for the rsync data mover of the ReplicationDestination to be reachable and
+// returns the address and the name of the SSH keys secret to configure the ReplicationSource with. The secret must be
+// copied to the namespace of the ReplicationSource if it is in another namespace or cluster.
+func (builder *ReplicationDestinationBuilder) WaitUntilRsyncReady(timeout time.Duration) (string, string, error) {
+	if err := common.Validate(builder); err != nil {
+		return "", "", err
+	}
+
+	klog.V(100).Infof("Waiting up to %s for rsync of ReplicationDestination %s in namespace %s to be ready",
+		timeout, builder.Definition.Name, builder.Definition.Namespace)
+
+	var address, sshKeys string
+
+	err := wait.PollUntilContextTimeout(
+		context.TODO(), syncPollInterval, timeout, true, func(ctx context.Context) (bool, error) {
+			replicationDestination, err := builder.Get()
+			if err != nil {
+				klog.V(100).Infof("Failed to get ReplicationDestination %s in namespace %s: %v",
+					builder.Definition.Name, builder.Definition.Namespace, err)
+
+				return false, nil
+			}
+
+			builder.Object = replicationDestination
+
+			if replicationDestination.Status == nil || replicationDestination.Status.Rsync == nil ||
+				replicationDestination.Status.Rsync.Address == nil || replicationDestination.Status.Rsync.SSHKeys == nil {
+				return false, nil
+			}
+
+			address = *replicationDestination.Status.Rsync.Address
+			sshKeys = *replicationDestination.Status.Rsync.SSHKeys
+
+			return true, nil
+		})
+	if err != nil {
+		return "", "", fmt.Errorf("rsync of replicationDestination %s in namespace %s is not ready: %w",
+			builder.Definition.Name, builder.Definition.Namespace, err)
+	}
+
+	return address, sshKeys, nil
+}
+
+// WaitUntilManualSyncComplete waits up to timeout for the synchronization started by the current manual trigger of the
+// ReplicationDestination to complete. On timeout, the error includes the last Synchronizing condition and the result
+// of the last data mover run.
+func (builder *ReplicationDestinationBuilder) WaitUntilManualSyncComplete(timeout time.Duration) error {
+	if err := common.Validate(builder); err != nil {
+		return err
+	}
+
+	if builder.Definition.Spec.Trigger == nil || builder.Definition.Spec.Trigger.Manual == "" {
+		return fmt.Errorf("replicationDestination %s in namespace %s has no manual trigger",
+			builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	return waitForManualSync(
+		builder.syncResource(), builder.Definition.Spec.Trigger.Manual, timeout, builder.getSyncStatus)
+}
+
+// WaitForNextSync waits up to timeout for the ReplicationDestination to complete a synchronization after the last one
+// completed before it was called, which is useful for scheduled synchronizations. On timeout, the error includes the
+// last Synchronizing condition and the result of the last data mover run.
+func (builder *ReplicationDestinationBuilder) WaitForNextSync(timeout time.Duration) error {
+	if err := common.Validate(builder); err != nil {
+		return err
+	}
+
+	return waitForNextSync(builder.syncResource(), timeout, builder.getSyncStatus)
+}
+
+// getSyncStatus refreshes the ReplicationDestination and returns its sync status, or nil if it has no status yet.
+func (builder *ReplicationDestinationBuilder) getSyncStatus() (*volsyncv1alpha1.SyncStatus, error) {
+	replicationDestination, err := builder.Get()
+	if err != nil {
+		return nil, err
+	}
+
+	builder.Object = replicationDestination
+
+	if replicationDestination.Status == nil {
+		return nil, nil
+	}
+
+	return &replicationDestination.Status.SyncStatus, nil
+}
+
+// syncResource returns the description of the ReplicationDestination used in logs and errors while waiting for it to
+// sync.
+func (builder *ReplicationDestinationBuilder) syncResource() syncResource {
+	return syncResource{
+		kind: "replicationDestination", name: builder.Definition.Name, nsname: builder.Definition.Namespace}
+}
+
+// GetReplicationDestinationGVR returns ReplicationDestination's GroupVersionResource
+// which could be used for Clean function.
+func GetReplicationDestinationGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group: "volsync.backube", Version: "v1alpha1", Resource: "replicationdestinations",
+	}
+}
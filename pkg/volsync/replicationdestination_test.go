@@ -0,0 +1,163 @@
+package volsync
+
+import (
+	"testing"
+	"time"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common/testhelper"
+	volsyncv1alpha1 "github.com/rh-ecosystem-edge/eco-goinfra/pkg/schemes/volsync/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+)
+
+const (
+	defaultReplicationDestinationName = "test-destination"
+	defaultRsyncAddress               = "volsync-rsync-dst-test-destination.test-namespace.svc"
+	defaultRsyncSSHKeys               = "volsync-rsync-dst-src-test-destination"
+)
+
+var replicationDestinationGVK = volsyncv1alpha1.GroupVersion.WithKind("ReplicationDestination")
+
+func TestNewReplicationDestinationBuilder(t *testing.T) {
+	t.Parallel()
+
+	testhelper.NewNamespacedBuilderTestConfig(
+		NewReplicationDestinationBuilder, volsyncv1alpha1.AddToScheme, replicationDestinationGVK).ExecuteTests(t)
+}
+
+func TestPullReplicationDestination(t *testing.T) {
+	t.Parallel()
+
+	testhelper.NewNamespacedPullTestConfig(
+		PullReplicationDestination, volsyncv1alpha1.AddToScheme, replicationDestinationGVK).ExecuteTests(t)
+}
+
+func TestReplicationDestinationBuilderMethods(t *testing.T) {
+	t.Parallel()
+
+	commonConfig := testhelper.NewCommonTestConfig[
+		volsyncv1alpha1.ReplicationDestination, ReplicationDestinationBuilder](
+		volsyncv1alpha1.AddToScheme, replicationDestinationGVK, testhelper.ResourceScopeNamespaced)
+
+	testhelper.NewTestSuite().
+		With(testhelper.NewGetTestConfig(commonConfig)).
+		With(testhelper.NewExistsTestConfig(commonConfig)).
+		With(testhelper.NewCreateTestConfig(commonConfig)).
+		With(testhelper.NewDeleterTestConfig(commonConfig)).
+		With(testhelper.NewUpdateTestConfig(commonConfig)).
+		Run(t)
+}
+
+func TestReplicationDestinationWithTrigger(t *testing.T) {
+	t.Parallel()
+
+	testBuilder := newTestReplicationDestinationBuilder(nil).WithSchedule("0 * * * *")
+	assert.NoError(t, testBuilder.GetError())
+	assert.Equal(t, &volsyncv1alpha1.ReplicationDestinationTriggerSpec{Schedule: ptr.To("0 * * * *")},
+		testBuilder.Definition.Spec.Trigger)
+
+	testBuilder = testBuilder.WithManualTrigger(defaultManualTrigger)
+	assert.NoError(t, testBuilder.GetError())
+	assert.Equal(t, &volsyncv1alpha1.ReplicationDestinationTriggerSpec{Manual: defaultManualTrigger},
+		testBuilder.Definition.Spec.Trigger)
+
+	testBuilder = newTestReplicationDestinationBuilder(nil).WithSchedule("")
+	assert.EqualError(t, testBuilder.GetError(), "replicationDestination 'schedule' cannot be empty")
+
+	testBuilder = newTestReplicationDestinationBuilder(nil).WithManualTrigger("")
+	assert.EqualError(t, testBuilder.GetError(), "replicationDestination manual 'trigger' cannot be empty")
+}
+
+func TestReplicationDestinationWithDataMover(t *testing.T) {
+	t.Parallel()
+
+	rsync := volsyncv1alpha1.ReplicationDestinationRsyncSpec{
+		ReplicationDestinationVolumeOptions: volsyncv1alpha1.ReplicationDestinationVolumeOptions{
+			CopyMethod:     volsyncv1alpha1.CopyMethodDirect,
+			DestinationPVC: ptr.To(defaultSourcePVC),
+		},
+	}
+	restic := volsyncv1alpha1.ReplicationDestinationResticSpec{
+		ReplicationDestinationVolumeOptions: volsyncv1alpha1.ReplicationDestinationVolumeOptions{
+			CopyMethod: volsyncv1alpha1.CopyMethodSnapshot,
+		},
+		Repository: defaultResticRepository,
+	}
+
+	testBuilder := newTestReplicationDestinationBuilder(nil).WithRestic(restic).WithRsync(rsync)
+	assert.NoError(t, testBuilder.GetError())
+	assert.Equal(t, &rsync, testBuilder.Definition.Spec.Rsync)
+	assert.Nil(t, testBuilder.Definition.Spec.Restic)
+
+	testBuilder = testBuilder.WithRestic(restic)
+	assert.NoError(t, testBuilder.GetError())
+	assert.Equal(t, &restic, testBuilder.Definition.Spec.Restic)
+	assert.Nil(t, testBuilder.Definition.Spec.Rsync)
+
+	testBuilder = newTestReplicationDestinationBuilder(nil).WithRsync(volsyncv1alpha1.ReplicationDestinationRsyncSpec{})
+	assert.EqualError(t, testBuilder.GetError(), "replicationDestination rsync 'copyMethod' cannot be empty")
+
+	testBuilder = newTestReplicationDestinationBuilder(nil).WithRestic(
+		volsyncv1alpha1.ReplicationDestinationResticSpec{})
+	assert.EqualError(t, testBuilder.GetError(), "replicationDestination restic 'repository' cannot be empty")
+}
+
+func TestReplicationDestinationWaitUntilRsyncReady(t *testing.T) {
+	t.Parallel()
+
+	replicationDestination := buildDummyReplicationDestination()
+	replicationDestination.Status = &volsyncv1alpha1.ReplicationDestinationStatus{
+		Rsync: &volsyncv1alpha1.ReplicationDestinationRsyncStatus{
+			Address: ptr.To(defaultRsyncAddress),
+			SSHKeys: ptr.To(defaultRsyncSSHKeys),
+		},
+	}
+
+	address, sshKeys, err := newTestReplicationDestinationBuilder(
+		[]runtime.Object{replicationDestination}).WaitUntilRsyncReady(time.Second)
+	assert.NoError(t, err)
+	assert.Equal(t, defaultRsyncAddress, address)
+	assert.Equal(t, defaultRsyncSSHKeys, sshKeys)
+
+	_, _, err = newTestReplicationDestinationBuilder(
+		[]runtime.Object{buildDummyReplicationDestination()}).WaitUntilRsyncReady(time.Second)
+	assert.EqualError(t, err, "rsync of replicationDestination test-destination in namespace test-namespace "+
+		"is not ready: context deadline exceeded")
+}
+
+func TestReplicationDestinationWaitForNextSync(t *testing.T) {
+	t.Parallel()
+
+	replicationDestination := buildDummyReplicationDestination()
+	replicationDestination.Status = &volsyncv1alpha1.ReplicationDestinationStatus{
+		SyncStatus: volsyncv1alpha1.SyncStatus{
+			LastSyncTime: &metav1.Time{Time: time.Now().Add(-time.Hour)},
+			LatestMoverStatus: &volsyncv1alpha1.MoverStatus{
+				Result: volsyncv1alpha1.MoverResultFailed,
+				Logs:   "rsync: connection unexpectedly closed",
+			},
+		},
+	}
+
+	err := newTestReplicationDestinationBuilder(
+		[]runtime.Object{replicationDestination}).WaitForNextSync(time.Second)
+	assert.EqualError(t, err, "replicationDestination test-destination in namespace test-namespace did not sync: "+
+		"no Synchronizing condition; data mover failed: rsync: connection unexpectedly closed: "+
+		"context deadline exceeded")
+}
+
+func newTestReplicationDestinationBuilder(objects []runtime.Object) *ReplicationDestinationBuilder {
+	return NewReplicationDestinationBuilder(
+		buildTestClientWithVolsync(objects), defaultReplicationDestinationName, defaultVolsyncNamespace)
+}
+
+func buildDummyReplicationDestination() *volsyncv1alpha1.ReplicationDestination {
+	return &volsyncv1alpha1.ReplicationDestination{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      defaultReplicationDestinationName,
+			Namespace: defaultVolsyncNamespace,
+		},
+	}
+}
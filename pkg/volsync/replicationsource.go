@@ -0,0 +1,226 @@
+// Package volsync provides builders for the ReplicationSource and ReplicationDestination resources of VolSync, which
+// replicate the data of PersistentVolumeClaims between clusters or to object storage using the rsync or restic data
+// movers, and waiters for their synchronization cycles.
+package volsync
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common"
+	volsyncv1alpha1 "github.com/rh-ecosystem-edge/eco-goinfra/pkg/schemes/volsync/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/klog/v2"
+)
+
+// ReplicationSourceBuilder provides a struct for the ReplicationSource resource containing a connection to the cluster
+// and the ReplicationSource definition. A ReplicationSource replicates the data of a PVC to a ReplicationDestination
+// or a restic repository.
+type ReplicationSourceBuilder struct {
+	common.EmbeddableBuilder[volsyncv1alpha1.ReplicationSource, *volsyncv1alpha1.ReplicationSource]
+	common.EmbeddableCreator[volsyncv1alpha1.ReplicationSource, ReplicationSourceBuilder,
+		*volsyncv1alpha1.ReplicationSource, *ReplicationSourceBuilder]
+	common.EmbeddableDeleter[volsyncv1alpha1.ReplicationSource, *volsyncv1alpha1.ReplicationSource]
+	common.EmbeddableUpdater[volsyncv1alpha1.ReplicationSource, ReplicationSourceBuilder,
+		*volsyncv1alpha1.ReplicationSource, *ReplicationSourceBuilder]
+	common.EmbeddableConditionGetter[metav1.Condition, volsyncv1alpha1.ReplicationSource,
+		*volsyncv1alpha1.ReplicationSource]
+}
+
+// AttachMixins wires the embedded CRUD mixins to this builder instance.
+func (builder *ReplicationSourceBuilder) AttachMixins() {
+	builder.EmbeddableCreator.SetBase(builder)
+	builder.EmbeddableDeleter.SetBase(builder)
+	builder.EmbeddableUpdater.SetBase(builder)
+	builder.EmbeddableConditionGetter.SetBase(builder)
+}
+
+// GetGVK returns the ReplicationSource GVK for this builder.
+func (builder *ReplicationSourceBuilder) GetGVK() schema.GroupVersionKind {
+	return volsyncv1alpha1.GroupVersion.WithKind("ReplicationSource")
+}
+
+// NewReplicationSourceBuilder creates a new instance of ReplicationSourceBuilder replicating the data of the PVC
+// sourcePVC in the same namespace. A data mover must be configured using WithRsync or WithRestic before it is
+// created.
+func NewReplicationSourceBuilder(
+	apiClient *clients.Settings, name, nsname, sourcePVC string) *ReplicationSourceBuilder {
+	klog.V(100).Infof(
+		"Initializing new ReplicationSource structure with the following params: name: %s, namespace: %s, pvc: %s",
+		name, nsname, sourcePVC)
+
+	builder := common.NewNamespacedBuilder[volsyncv1alpha1.ReplicationSource, ReplicationSourceBuilder](
+		apiClient, volsyncv1alpha1.AddToScheme, name, nsname)
+	if builder.GetError() != nil {
+		return builder
+	}
+
+	if sourcePVC == "" {
+		builder.SetError(fmt.Errorf("replicationSource 'sourcePVC' cannot be empty"))
+
+		return builder
+	}
+
+	builder.Definition.Spec.SourcePVC = sourcePVC
+
+	return builder
+}
+
+// PullReplicationSource retrieves an existing ReplicationSource from the cluster.
+func PullReplicationSource(apiClient *clients.Settings, name, nsname string) (*ReplicationSourceBuilder, error) {
+	return common.PullNamespacedBuilder[volsyncv1alpha1.ReplicationSource, ReplicationSourceBuilder](
+		context.TODO(), apiClient, volsyncv1alpha1.AddToScheme, name, nsname)
+}
+
+// WithSchedule synchronizes the PVC on the cron schedule, such as */5 * * * *, replacing any manual trigger.
+func (builder *ReplicationSourceBuilder) WithSchedule(schedule string) *ReplicationSourceBuilder {
+	if err := common.Validate(builder); err != nil {
+		return builder
+	}
+
+	klog.V(100).Infof("Setting schedule of ReplicationSource %s in namespace %s to %s",
+		builder.Definition.Name, builder.Definition.Namespace, schedule)
+
+	if schedule == "" {
+		builder.SetError(fmt.Errorf("replicationSource 'schedule' cannot be empty"))
+
+		return builder
+	}
+
+	builder.Definition.Spec.Trigger = &volsyncv1alpha1.ReplicationSourceTriggerSpec{Schedule: &schedule}
+
+	return builder
+}
+
+// WithManualTrigger synchronizes the PVC once for each new value of trigger, replacing any schedule. Updating the
+// trigger of an existing ReplicationSource starts a new synchronization, which WaitUntilManualSyncComplete waits for.
+func (builder *ReplicationSourceBuilder) WithManualTrigger(trigger string) *ReplicationSourceBuilder {
+	if err := common.Validate(builder); err != nil {
+		return builder
+	}
+
+	klog.V(100).Infof("Setting manual trigger of ReplicationSource %s in namespace %s to %s",
+		builder.Definition.Name, builder.Definition.Namespace, trigger)
+
+	if trigger == "" {
+		builder.SetError(fmt.Errorf("replicationSource manual 'trigger' cannot be empty"))
+
+		return builder
+	}
+
+	builder.Definition.Spec.Trigger = &volsyncv1alpha1.ReplicationSourceTriggerSpec{Manual: trigger}
+
+	return builder
+}
+
+// WithRsync replicates the PVC to a ReplicationDestination over SSH using the rsync data mover, replacing any restic
+// configuration. The address and sshKeys of the rsync spec are usually taken from
+// ReplicationDestinationBuilder.WaitUntilRsyncReady.
+func (builder *ReplicationSourceBuilder) WithRsync(
+	rsync volsyncv1alpha1.ReplicationSourceRsyncSpec) *ReplicationSourceBuilder {
+	if err := common.Validate(builder); err != nil {
+		return builder
+	}
+
+	klog.V(100).Infof("Setting rsync data mover of ReplicationSource %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if rsync.CopyMethod == "" {
+		builder.SetError(fmt.Errorf("replicationSource rsync 'copyMethod' cannot be empty"))
+
+		return builder
+	}
+
+	builder.Definition.Spec.Rsync = &rsync
+	builder.Definition.Spec.Restic = nil
+
+	return builder
+}
+
+// WithRestic backs up the PVC to the restic repository described by the secret named by the Repository field of the
+// restic spec, replacing any rsync configuration.
+func (builder *ReplicationSourceBuilder) WithRestic(
+	restic volsyncv1alpha1.ReplicationSourceResticSpec) *ReplicationSourceBuilder {
+	if err := common.Validate(builder); err != nil {
+		return builder
+	}
+
+	klog.V(100).Infof("Setting restic data mover of ReplicationSource %s in namespace %s",
+		builder.Definition.Name, builder.Definition.Namespace)
+
+	if restic.Repository == "" {
+		builder.SetError(fmt.Errorf("replicationSource restic 'repository' cannot be empty"))
+
+		return builder
+	}
+
+	if restic.CopyMethod == "" {
+		builder.SetError(fmt.Errorf("replicationSource restic 'copyMethod' cannot be empty"))
+
+		return builder
+	}
+
+	builder.Definition.Spec.Restic = &restic
+	builder.Definition.Spec.Rsync = nil
+
+	return builder
+}
+
+// WaitUntilManualSyncComplete waits up to timeout for the synchronization started by the current manual trigger of the
+// ReplicationSource to complete. On timeout, the error includes the last Synchronizing condition and the result of the
+// last data mover run.
+func (builder *ReplicationSourceBuilder) WaitUntilManualSyncComplete(timeout time.Duration) error {
+	if err := common.Validate(builder); err != nil {
+		return err
+	}
+
+	if builder.Definition.Spec.Trigger == nil || builder.Definition.Spec.Trigger.Manual == "" {
+		return fmt.Errorf("replicationSource %s in namespace %s has no manual trigger",
+			builder.Definition.Name, builder.Definition.Namespace)
+	}
+
+	return waitForManualSync(
+		builder.syncResource(), builder.Definition.Spec.Trigger.Manual, timeout, builder.getSyncStatus)
+}
+
+// WaitForNextSync waits up to timeout for the ReplicationSource to complete a synchronization after the last one
+// completed before it was called, which is useful for scheduled synchronizations. On timeout, the error includes the
+// last Synchronizing condition and the result of the last data mover run.
+func (builder *ReplicationSourceBuilder) WaitForNextSync(timeout time.Duration) error {
+	if err := common.Validate(builder); err != nil {
+		return err
+	}
+
+	return waitForNextSync(builder.syncResource(), timeout, builder.getSyncStatus)
+}
+
+// getSyncStatus refreshes the ReplicationSource and returns its sync status, or nil if it has no status yet.
+func (builder *ReplicationSourceBuilder) getSyncStatus() (*volsyncv1alpha1.SyncStatus, error) {
+	replicationSource, err := builder.Get()
+	if err != nil {
+		return nil, err
+	}
+
+	builder.Object = replicationSource
+
+	if replicationSource.Status == nil {
+		return nil, nil
+	}
+
+	return &replicationSource.Status.SyncStatus, nil
+}
+
+// syncResource returns the description of the ReplicationSource used in logs and errors while waiting for it to sync.
+func (builder *ReplicationSourceBuilder) syncResource() syncResource {
+	return syncResource{kind: "replicationSource", name: builder.Definition.Name, nsname: builder.Definition.Namespace}
+}
+
+// GetReplicationSourceGVR returns ReplicationSource's GroupVersionResource which could be used for Clean function.
+func GetReplicationSourceGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group: "volsync.backube", Version: "v1alpha1", Resource: "replicationsources",
+	}
+}
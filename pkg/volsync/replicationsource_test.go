@@ -0,0 +1,209 @@
+package volsync
+
+import (
+	"testing"
+	"time"
+
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/clients"
+	"github.com/rh-ecosystem-edge/eco-goinfra/pkg/internal/common/testhelper"
+	volsyncv1alpha1 "github.com/rh-ecosystem-edge/eco-goinfra/pkg/schemes/volsync/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+)
+
+const (
+	defaultReplicationSourceName = "test-source"
+	defaultVolsyncNamespace      = "test-namespace"
+	defaultSourcePVC             = "test-pvc"
+	defaultResticRepository      = "restic-config"
+	defaultManualTrigger         = "sync-1"
+)
+
+var replicationSourceGVK = volsyncv1alpha1.GroupVersion.WithKind("ReplicationSource")
+
+func TestNewReplicationSourceBuilder(t *testing.T) {
+	t.Parallel()
+
+	testhelper.NewNamespacedBuilderTestConfig[volsyncv1alpha1.ReplicationSource, ReplicationSourceBuilder](
+		func(apiClient *clients.Settings, name, nsname string) *ReplicationSourceBuilder {
+			return NewReplicationSourceBuilder(apiClient, name, nsname, defaultSourcePVC)
+		}, volsyncv1alpha1.AddToScheme, replicationSourceGVK).ExecuteTests(t)
+}
+
+func TestNewReplicationSourceBuilderSourcePVC(t *testing.T) {
+	t.Parallel()
+
+	testBuilder := NewReplicationSourceBuilder(
+		buildTestClientWithVolsync(nil), defaultReplicationSourceName, defaultVolsyncNamespace, "")
+	assert.EqualError(t, testBuilder.GetError(), "replicationSource 'sourcePVC' cannot be empty")
+
+	testBuilder = newTestReplicationSourceBuilder()
+	assert.NoError(t, testBuilder.GetError())
+	assert.Equal(t, defaultSourcePVC, testBuilder.Definition.Spec.SourcePVC)
+}
+
+func TestPullReplicationSource(t *testing.T) {
+	t.Parallel()
+
+	testhelper.NewNamespacedPullTestConfig[volsyncv1alpha1.ReplicationSource, ReplicationSourceBuilder](
+		PullReplicationSource, volsyncv1alpha1.AddToScheme, replicationSourceGVK).ExecuteTests(t)
+}
+
+func TestReplicationSourceBuilderMethods(t *testing.T) {
+	t.Parallel()
+
+	commonConfig := testhelper.NewCommonTestConfig[volsyncv1alpha1.ReplicationSource, ReplicationSourceBuilder](
+		volsyncv1alpha1.AddToScheme, replicationSourceGVK, testhelper.ResourceScopeNamespaced)
+
+	testhelper.NewTestSuite().
+		With(testhelper.NewGetTestConfig(commonConfig)).
+		With(testhelper.NewExistsTestConfig(commonConfig)).
+		With(testhelper.NewCreateTestConfig(commonConfig)).
+		With(testhelper.NewDeleterTestConfig(commonConfig)).
+		With(testhelper.NewUpdateTestConfig(commonConfig)).
+		Run(t)
+}
+
+func TestReplicationSourceWithTrigger(t *testing.T) {
+	t.Parallel()
+
+	testBuilder := newTestReplicationSourceBuilder().WithSchedule("*/5 * * * *")
+	assert.NoError(t, testBuilder.GetError())
+	assert.Equal(t, &volsyncv1alpha1.ReplicationSourceTriggerSpec{Schedule: ptr.To("*/5 * * * *")},
+		testBuilder.Definition.Spec.Trigger)
+
+	testBuilder = testBuilder.WithManualTrigger(defaultManualTrigger)
+	assert.NoError(t, testBuilder.GetError())
+	assert.Equal(t, &volsyncv1alpha1.ReplicationSourceTriggerSpec{Manual: defaultManualTrigger},
+		testBuilder.Definition.Spec.Trigger)
+
+	testBuilder = newTestReplicationSourceBuilder().WithSchedule("")
+	assert.EqualError(t, testBuilder.GetError(), "replicationSource 'schedule' cannot be empty")
+
+	testBuilder = newTestReplicationSourceBuilder().WithManualTrigger("")
+	assert.EqualError(t, testBuilder.GetError(), "replicationSource manual 'trigger' cannot be empty")
+}
+
+func TestReplicationSourceWithDataMover(t *testing.T) {
+	t.Parallel()
+
+	rsync := volsyncv1alpha1.ReplicationSourceRsyncSpec{
+		ReplicationSourceVolumeOptions: volsyncv1alpha1.ReplicationSourceVolumeOptions{
+			CopyMethod: volsyncv1alpha1.CopyMethodSnapshot,
+		},
+		Address: ptr.To("10.0.0.10"),
+		SSHKeys: ptr.To("volsync-rsync-dst-src-test"),
+	}
+	restic := volsyncv1alpha1.ReplicationSourceResticSpec{
+		ReplicationSourceVolumeOptions: volsyncv1alpha1.ReplicationSourceVolumeOptions{
+			CopyMethod: volsyncv1alpha1.CopyMethodClone,
+		},
+		Repository: defaultResticRepository,
+	}
+
+	testBuilder := newTestReplicationSourceBuilder().WithRestic(restic).WithRsync(rsync)
+	assert.NoError(t, testBuilder.GetError())
+	assert.Equal(t, &rsync, testBuilder.Definition.Spec.Rsync)
+	assert.Nil(t, testBuilder.Definition.Spec.Restic)
+
+	testBuilder = testBuilder.WithRestic(restic)
+	assert.NoError(t, testBuilder.GetError())
+	assert.Equal(t, &restic, testBuilder.Definition.Spec.Restic)
+	assert.Nil(t, testBuilder.Definition.Spec.Rsync)
+
+	testBuilder = newTestReplicationSourceBuilder().WithRsync(volsyncv1alpha1.ReplicationSourceRsyncSpec{})
+	assert.EqualError(t, testBuilder.GetError(), "replicationSource rsync 'copyMethod' cannot be empty")
+
+	testBuilder = newTestReplicationSourceBuilder().WithRestic(volsyncv1alpha1.ReplicationSourceResticSpec{})
+	assert.EqualError(t, testBuilder.GetError(), "replicationSource restic 'repository' cannot be empty")
+
+	testBuilder = newTestReplicationSourceBuilder().WithRestic(
+		volsyncv1alpha1.ReplicationSourceResticSpec{Repository: defaultResticRepository})
+	assert.EqualError(t, testBuilder.GetError(), "replicationSource restic 'copyMethod' cannot be empty")
+}
+
+func TestReplicationSourceWaitUntilManualSyncComplete(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		trigger       string
+		status        *volsyncv1alpha1.ReplicationSourceStatus
+		expectedError string
+	}{
+		{
+			trigger: defaultManualTrigger,
+			status: &volsyncv1alpha1.ReplicationSourceStatus{
+				SyncStatus: volsyncv1alpha1.SyncStatus{LastManualSync: defaultManualTrigger},
+			},
+		},
+		{
+			trigger: defaultManualTrigger,
+			status: &volsyncv1alpha1.ReplicationSourceStatus{
+				SyncStatus: volsyncv1alpha1.SyncStatus{
+					Conditions: []metav1.Condition{{
+						Type:    volsyncv1alpha1.ConditionSynchronizing,
+						Status:  metav1.ConditionTrue,
+						Reason:  volsyncv1alpha1.SynchronizingReasonSync,
+						Message: "Synchronization in-progress",
+					}},
+				},
+			},
+			expectedError: "replicationSource test-source in namespace test-namespace did not sync: " +
+				"SyncInProgress: Synchronization in-progress: context deadline exceeded",
+		},
+		{
+			trigger: defaultManualTrigger,
+			expectedError: "replicationSource test-source in namespace test-namespace has no sync status: " +
+				"context deadline exceeded",
+		},
+		{
+			expectedError: "replicationSource test-source in namespace test-namespace has no manual trigger",
+		},
+	}
+
+	for _, testCase := range testCases {
+		replicationSource := buildDummyReplicationSource()
+		replicationSource.Status = testCase.status
+
+		testBuilder := newTestReplicationSourceBuilderWithObjects([]runtime.Object{replicationSource})
+
+		if testCase.trigger != "" {
+			testBuilder = testBuilder.WithManualTrigger(testCase.trigger)
+		}
+
+		err := testBuilder.WaitUntilManualSyncComplete(time.Second)
+		if testCase.expectedError != "" {
+			assert.EqualError(t, err, testCase.expectedError)
+		} else {
+			assert.NoError(t, err)
+		}
+	}
+}
+
+func newTestReplicationSourceBuilder() *ReplicationSourceBuilder {
+	return newTestReplicationSourceBuilderWithObjects(nil)
+}
+
+func newTestReplicationSourceBuilderWithObjects(objects []runtime.Object) *ReplicationSourceBuilder {
+	return NewReplicationSourceBuilder(
+		buildTestClientWithVolsync(objects), defaultReplicationSourceName, defaultVolsyncNamespace, defaultSourcePVC)
+}
+
+func buildDummyReplicationSource() *volsyncv1alpha1.ReplicationSource {
+	return &volsyncv1alpha1.ReplicationSource{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      defaultReplicationSourceName,
+			Namespace: defaultVolsyncNamespace,
+		},
+		Spec: volsyncv1alpha1.ReplicationSourceSpec{SourcePVC: defaultSourcePVC},
+	}
+}
+
+func buildTestClientWithVolsync(objects []runtime.Object) *clients.Settings {
+	return clients.GetTestClients(clients.TestClientParams{
+		K8sMockObjects:  objects,
+		SchemeAttachers: []clients.SchemeAttacher{volsyncv1alpha1.AddToScheme},
+	})
+}
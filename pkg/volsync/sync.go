@@ -0,0 +1,111 @@
+package volsync
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	volsyncv1alpha1 "github.com/rh-ecosystem-edge/eco-goinfra/pkg/schemes/volsync/v1alpha1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/klog/v2"
+)
+
+// syncPollInterval is how often the status of ReplicationSources and ReplicationDestinations is checked while waiting
+// for a synchronization.
+const syncPollInterval = 5 * time.Second
+
+// syncStatusGetter refreshes a ReplicationSource or ReplicationDestination and returns its sync status, or nil if it
+// has no status yet.
+type syncStatusGetter func() (*volsyncv1alpha1.SyncStatus, error)
+
+// waitForManualSync waits up to timeout for the status of the resource to report that the synchronization of the
+// manual trigger completed. The resource is only used in logs and errors.
+func waitForManualSync(resource fmt.Stringer, trigger string, timeout time.Duration, getStatus syncStatusGetter) error {
+	klog.V(100).Infof("Waiting up to %s for %s to complete manual sync %s", timeout, resource, trigger)
+
+	return pollSyncStatus(resource, timeout, getStatus, func(status *volsyncv1alpha1.SyncStatus) bool {
+		return status.LastManualSync == trigger
+	})
+}
+
+// waitForNextSync waits up to timeout for the status of the resource to report a synchronization completing after the
+// last one completed before it was called. The resource is only used in logs and errors.
+func waitForNextSync(resource fmt.Stringer, timeout time.Duration, getStatus syncStatusGetter) error {
+	status, err := getStatus()
+	if err != nil {
+		return fmt.Errorf("failed to get status of %s: %w", resource, err)
+	}
+
+	var lastSyncTime time.Time
+
+	if status != nil && status.LastSyncTime != nil {
+		lastSyncTime = status.LastSyncTime.Time
+	}
+
+	klog.V(100).Infof("Waiting up to %s for %s to complete a sync after %s", timeout, resource, lastSyncTime)
+
+	return pollSyncStatus(resource, timeout, getStatus, func(status *volsyncv1alpha1.SyncStatus) bool {
+		return status.LastSyncTime != nil && status.LastSyncTime.After(lastSyncTime)
+	})
+}
+
+// pollSyncStatus waits up to timeout for isSynced to return true for the status of the resource. On timeout, the error
+// includes the last Synchronizing condition and, if it failed, the result and logs of the last data mover run.
+func pollSyncStatus(
+	resource fmt.Stringer,
+	timeout time.Duration,
+	getStatus syncStatusGetter,
+	isSynced func(status *volsyncv1alpha1.SyncStatus) bool) error {
+	var lastStatus *volsyncv1alpha1.SyncStatus
+
+	err := wait.PollUntilContextTimeout(
+		context.TODO(), syncPollInterval, timeout, true, func(ctx context.Context) (bool, error) {
+			status, err := getStatus()
+			if err != nil {
+				klog.V(100).Infof("Failed to get status of %s: %v", resource, err)
+
+				return false, nil
+			}
+
+			if status == nil {
+				return false, nil
+			}
+
+			lastStatus = status
+
+			return isSynced(status), nil
+		})
+	if err == nil {
+		return nil
+	}
+
+	if lastStatus == nil {
+		return fmt.Errorf("%s has no sync status: %w", resource, err)
+	}
+
+	details := "no Synchronizing condition"
+
+	condition := meta.FindStatusCondition(lastStatus.Conditions, volsyncv1alpha1.ConditionSynchronizing)
+	if condition != nil {
+		details = fmt.Sprintf("%s: %s", condition.Reason, condition.Message)
+	}
+
+	if lastStatus.LatestMoverStatus != nil && lastStatus.LatestMoverStatus.Result == volsyncv1alpha1.MoverResultFailed {
+		details = fmt.Sprintf("%s; data mover failed: %s", details, lastStatus.LatestMoverStatus.Logs)
+	}
+
+	return fmt.Errorf("%s did not sync: %s: %w", resource, details, err)
+}
+
+// syncResource describes a ReplicationSource or ReplicationDestination in logs and errors.
+type syncResource struct {
+	kind   string
+	name   string
+	nsname string
+}
+
+// String returns the kind, name, and namespace of the resource.
+func (resource syncResource) String() string {
+	return fmt.Sprintf("%s %s in namespace %s", resource.kind, resource.name, resource.nsname)
+}
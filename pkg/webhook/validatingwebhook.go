@@ -11,6 +11,7 @@ import (
 	admregv1 "k8s.io/api/admissionregistration/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	goclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -156,6 +157,14 @@ func (builder *ValidatingConfigurationBuilder) Update() (*ValidatingConfiguratio
 	return builder, err
 }
 
+// GetValidatingWebhookConfigurationGVR returns ValidatingWebhookConfiguration's GroupVersionResource
+// which could be used for Clean function.
+func GetValidatingWebhookConfigurationGVR() schema.GroupVersionResource {
+	return schema.GroupVersionResource{
+		Group: "admissionregistration.k8s.io", Version: "v1", Resource: "validatingwebhookconfigurations",
+	}
+}
+
 // validate will check that the builder and builder definition are properly initialized before
 // accessing any member fields.
 func (builder *ValidatingConfigurationBuilder) validate() (bool, error) {